@@ -0,0 +1,67 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// binlogmigrate rewrites the insert binlog files in place into the format version,
+// e.g. back to version 1 before rolling back to the releases not aware of version 2.
+func main() {
+	version := flag.Int("version", storage.BinlogVersionV2, "the binlog format version to migrate to, options: 1, 2")
+	pageRows := flag.Int("pageRows", 8192, "max number of rows of a page in the version 2 binlogs")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		fmt.Println("usage: binlogmigrate [-version 2] [-pageRows 8192] file1 file2 ...")
+		return
+	}
+
+	format := storage.BinlogFormat{Version: *version, PageRows: *pageRows}
+	for _, file := range flag.Args() {
+		if err := migrate(file, format); err != nil {
+			fmt.Printf("error: migrate %s failed, %s\n", file, err.Error())
+			os.Exit(1)
+		}
+	}
+	fmt.Printf("migrate binlog complete.\n")
+}
+
+func migrate(file string, format storage.BinlogFormat) error {
+	info, err := os.Stat(file)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	migrated, err := storage.MigrateBinlog(data, format)
+	if err != nil {
+		return err
+	}
+	// write to a temporary file first so that the binlog is never left half written
+	tmp := file + ".migrating"
+	if err := os.WriteFile(tmp, migrated, info.Mode()); err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
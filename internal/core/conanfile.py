@@ -58,6 +58,7 @@ class MilvusConan(ConanFile):
         "arrow:compute": True,
         "arrow:with_re2": True,
         "arrow:with_zstd": True,
+        "arrow:with_snappy": True,
         "arrow:with_boost": True,
         "arrow:with_thrift": True,
         "arrow:with_jemalloc": True,
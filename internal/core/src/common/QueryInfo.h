@@ -31,6 +31,8 @@ struct SearchInfo {
     MetricType metric_type_;
     knowhere::Json search_params_;
     std::optional<FieldId> group_by_field_id_;
+    // max number of hits kept for each group when grouping search
+    int64_t group_size_ = 1;
     tracer::TraceContext trace_ctx_;
     bool materialized_view_involved = false;
 };
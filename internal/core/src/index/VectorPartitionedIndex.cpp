@@ -0,0 +1,387 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "index/VectorPartitionedIndex.h"
+
+#include <algorithm>
+#include <cstring>
+#include <filesystem>
+#include <limits>
+#include <queue>
+#include <utility>
+
+#include "boost/algorithm/string.hpp"
+#include "common/EasyAssert.h"
+#include "common/QueryResult.h"
+#include "common/Utils.h"
+#include "index/IndexFactory.h"
+#include "index/Utils.h"
+#include "log/Log.h"
+
+namespace milvus::index {
+
+namespace {
+
+// SliceBitset returns the view of the bits of the partition, the bits are
+// copied into buffer if the partition doesn't start at a byte boundary.
+BitsetView
+SliceBitset(const BitsetView& bitset,
+            int64_t offset,
+            int64_t size,
+            std::vector<uint8_t>& buffer) {
+    if (bitset.empty()) {
+        return {};
+    }
+    if ((offset & 0x7) == 0) {
+        return bitset.subview(offset, size);
+    }
+    buffer.assign((size + 7) / 8, 0);
+    for (int64_t i = 0; i < size; ++i) {
+        if (bitset.test(offset + i)) {
+            buffer[i >> 3] |= (1 << (i & 0x7));
+        }
+    }
+    return {buffer.data(), static_cast<size_t>(size)};
+}
+
+// PartitionedIterator merges the iterators of the partitions of a query,
+// converting the partition offsets to the segment offsets.
+class PartitionedIterator : public knowhere::IndexNode::iterator {
+ public:
+    PartitionedIterator(
+        std::vector<std::shared_ptr<knowhere::IndexNode::iterator>> iterators,
+        std::vector<int64_t> offsets,
+        std::shared_ptr<std::vector<std::vector<uint8_t>>> bitsets)
+        : iterators_(std::move(iterators)),
+          offsets_(std::move(offsets)),
+          bitsets_(std::move(bitsets)) {
+        for (int i = 0; i < iterators_.size(); ++i) {
+            if (iterators_[i] != nullptr && iterators_[i]->HasNext()) {
+                Push(i);
+            }
+        }
+    }
+
+    std::pair<int64_t, float>
+    Next() override {
+        auto top = heap_.top();
+        heap_.pop();
+        if (iterators_[top->GetIteratorIdx()]->HasNext()) {
+            Push(top->GetIteratorIdx());
+        }
+        return top->GetOffDis();
+    }
+
+    bool
+    HasNext() override {
+        return !heap_.empty();
+    }
+
+ private:
+    void
+    Push(int idx) {
+        auto off_dis = iterators_[idx]->Next();
+        off_dis.first += offsets_[idx];
+        heap_.push(std::make_shared<OffsetDisPair>(off_dis, idx));
+    }
+
+ private:
+    std::vector<std::shared_ptr<knowhere::IndexNode::iterator>> iterators_;
+    std::vector<int64_t> offsets_;
+    // the sliced bitsets are referenced by the iterators until exhausted
+    std::shared_ptr<std::vector<std::vector<uint8_t>>> bitsets_;
+    std::priority_queue<std::shared_ptr<OffsetDisPair>,
+                        std::vector<std::shared_ptr<OffsetDisPair>>,
+                        OffsetDisPairComparator>
+        heap_;
+};
+
+}  // namespace
+
+std::vector<IndexPartitionInfo>
+ParseIndexPartitions(const std::string& value) {
+    std::vector<IndexPartitionInfo> partitions;
+    std::vector<std::string> items;
+    boost::split(items, value, boost::is_any_of(","));
+    for (auto& item : items) {
+        if (item.empty()) {
+            continue;
+        }
+        std::vector<std::string> fields;
+        boost::split(fields, item, boost::is_any_of(":"));
+        AssertInfo(fields.size() == 3, "invalid index partition: {}", item);
+        partitions.push_back({std::stoll(fields[0]),
+                              std::stoll(fields[1]),
+                              std::stoll(fields[2])});
+    }
+    AssertInfo(!partitions.empty(), "index partitions are empty");
+    return partitions;
+}
+
+VectorPartitionedIndex::VectorPartitionedIndex(
+    const CreateIndexInfo& create_index_info,
+    const storage::FileManagerContext& file_manager_context,
+    std::vector<IndexPartitionInfo> partitions)
+    : VectorIndex(create_index_info.index_type, create_index_info.metric_type),
+      create_index_info_(create_index_info),
+      file_manager_context_(file_manager_context),
+      partitions_(std::move(partitions)) {
+    int64_t offset = 0;
+    for (auto& partition : partitions_) {
+        offsets_.push_back(offset);
+        offset += partition.num_rows;
+    }
+}
+
+void
+VectorPartitionedIndex::Load(milvus::tracer::TraceContext ctx,
+                             const Config& config) {
+    auto index_files =
+        GetValueFromConfig<std::vector<std::string>>(config, "index_files");
+    AssertInfo(index_files.has_value(),
+               "index file paths is empty when load index");
+
+    indexes_.clear();
+    for (auto& partition : partitions_) {
+        // index files are stored under {root}/index_files/{buildID}/{version}/
+        auto prefix = "/" + std::to_string(partition.build_id) + "/" +
+                      std::to_string(partition.index_version) + "/";
+        std::vector<std::string> partition_files;
+        for (auto& file : index_files.value()) {
+            if (file.find(prefix) != std::string::npos) {
+                partition_files.push_back(file);
+            }
+        }
+        AssertInfo(!partition_files.empty(),
+                   "index files of partition {} are missing",
+                   partition.build_id);
+
+        auto partition_config = config;
+        partition_config.erase(kPartitionedIndex);
+        partition_config["index_files"] = partition_files;
+        if (config.contains(kMmapFilepath)) {
+            auto filepath =
+                GetValueFromConfig<std::string>(config, kMmapFilepath);
+            partition_config[kMmapFilepath] =
+                (std::filesystem::path(filepath.value()) /
+                 std::to_string(partition.build_id))
+                    .string();
+        }
+
+        auto index_meta = file_manager_context_.indexMeta;
+        index_meta.build_id = partition.build_id;
+        index_meta.index_version = partition.index_version;
+        storage::FileManagerContext partition_context(
+            file_manager_context_.fieldDataMeta,
+            index_meta,
+            file_manager_context_.chunkManagerPtr);
+        auto index = IndexFactory::GetInstance().CreateIndex(
+            create_index_info_, partition_context);
+        auto vec_index = dynamic_cast<VectorIndex*>(index.get());
+        AssertInfo(vec_index != nullptr,
+                   "index type {} of partition is not vector index",
+                   create_index_info_.index_type);
+        index.release();
+        indexes_.emplace_back(vec_index);
+
+        indexes_.back()->Load(ctx, partition_config);
+        AssertInfo(indexes_.back()->Count() == partition.num_rows,
+                   "row count of partition {} mismatch, expected {}, got {}",
+                   partition.build_id,
+                   partition.num_rows,
+                   indexes_.back()->Count());
+        SetDim(indexes_.back()->GetDim());
+        LOG_INFO("load index partition {} of version {}, rows: {}",
+                 partition.build_id,
+                 partition.index_version,
+                 partition.num_rows);
+    }
+}
+
+int64_t
+VectorPartitionedIndex::Count() {
+    int64_t count = 0;
+    for (auto& index : indexes_) {
+        count += index->Count();
+    }
+    return count;
+}
+
+void
+VectorPartitionedIndex::Query(const DatasetPtr dataset,
+                              const SearchInfo& search_info,
+                              const BitsetView& bitset,
+                              SearchResult& search_result) const {
+    auto num_queries = dataset->GetRows();
+    auto topk = search_info.topk_;
+
+    std::vector<SearchResult> results(indexes_.size());
+    for (size_t i = 0; i < indexes_.size(); ++i) {
+        std::vector<uint8_t> buffer;
+        auto partition_bitset =
+            SliceBitset(bitset, offsets_[i], partitions_[i].num_rows, buffer);
+        indexes_[i]->Query(dataset, search_info, partition_bitset, results[i]);
+    }
+
+    // merge the topk of the partitions, the results of each query are
+    // sorted by distance in every partition
+    auto larger_is_closer = PositivelyRelated(GetMetricType());
+    auto total_num = num_queries * topk;
+    search_result.seg_offsets_.assign(total_num, -1);
+    search_result.distances_.assign(
+        total_num,
+        larger_is_closer ? std::numeric_limits<float>::lowest()
+                         : std::numeric_limits<float>::max());
+    search_result.total_nq_ = num_queries;
+    search_result.unity_topK_ = topk;
+
+    std::vector<int64_t> cursors(indexes_.size());
+    for (int64_t q = 0; q < num_queries; ++q) {
+        std::fill(cursors.begin(), cursors.end(), 0);
+        for (int64_t k = 0; k < topk; ++k) {
+            int best = -1;
+            float best_distance = 0;
+            for (size_t i = 0; i < results.size(); ++i) {
+                auto partition_topk = results[i].unity_topK_;
+                if (cursors[i] >= partition_topk) {
+                    continue;
+                }
+                auto pos = q * partition_topk + cursors[i];
+                if (results[i].seg_offsets_[pos] == -1) {
+                    continue;
+                }
+                auto distance = results[i].distances_[pos];
+                if (best == -1 ||
+                    (larger_is_closer ? distance > best_distance
+                                      : distance < best_distance)) {
+                    best = i;
+                    best_distance = distance;
+                }
+            }
+            if (best == -1) {
+                break;
+            }
+            auto pos = q * results[best].unity_topK_ + cursors[best];
+            search_result.seg_offsets_[q * topk + k] =
+                results[best].seg_offsets_[pos] + offsets_[best];
+            search_result.distances_[q * topk + k] = best_distance;
+            cursors[best]++;
+        }
+    }
+}
+
+knowhere::expected<std::vector<std::shared_ptr<knowhere::IndexNode::iterator>>>
+VectorPartitionedIndex::VectorIterators(const DatasetPtr dataset,
+                                        const SearchInfo& search_info,
+                                        const BitsetView& bitset) const {
+    auto num_queries = dataset->GetRows();
+    auto bitsets = std::make_shared<std::vector<std::vector<uint8_t>>>(
+        indexes_.size());
+    // iterators of each query, indexed by partition
+    std::vector<std::vector<std::shared_ptr<knowhere::IndexNode::iterator>>>
+        query_iterators(
+            num_queries,
+            std::vector<std::shared_ptr<knowhere::IndexNode::iterator>>(
+                indexes_.size()));
+    for (size_t i = 0; i < indexes_.size(); ++i) {
+        auto partition_bitset = SliceBitset(
+            bitset, offsets_[i], partitions_[i].num_rows, bitsets->at(i));
+        auto res = indexes_[i]->VectorIterators(
+            dataset, search_info, partition_bitset);
+        if (!res.has_value()) {
+            return res;
+        }
+        auto& iterators = res.value();
+        AssertInfo(iterators.size() == num_queries,
+                   "iterator count {} of partition {} mismatch queries {}",
+                   iterators.size(),
+                   partitions_[i].build_id,
+                   num_queries);
+        for (int64_t q = 0; q < num_queries; ++q) {
+            query_iterators[q][i] = iterators[q];
+        }
+    }
+
+    std::vector<std::shared_ptr<knowhere::IndexNode::iterator>> iterators;
+    iterators.reserve(num_queries);
+    for (int64_t q = 0; q < num_queries; ++q) {
+        iterators.push_back(std::make_shared<PartitionedIterator>(
+            std::move(query_iterators[q]), offsets_, bitsets));
+    }
+    return iterators;
+}
+
+const bool
+VectorPartitionedIndex::HasRawData() const {
+    return std::all_of(indexes_.begin(), indexes_.end(), [](auto& index) {
+        return index->HasRawData();
+    });
+}
+
+std::vector<uint8_t>
+VectorPartitionedIndex::GetVector(const DatasetPtr dataset) const {
+    auto count = dataset->GetRows();
+    auto ids = dataset->GetIds();
+
+    // group the ids by partition, keeping the positions in the request
+    std::vector<std::vector<int64_t>> partition_ids(indexes_.size());
+    std::vector<std::vector<int64_t>> positions(indexes_.size());
+    for (int64_t i = 0; i < count; ++i) {
+        auto partition = LocatePartition(ids[i]);
+        partition_ids[partition].push_back(ids[i] - offsets_[partition]);
+        positions[partition].push_back(i);
+    }
+
+    std::vector<uint8_t> raw_data;
+    size_t row_size = 0;
+    for (size_t p = 0; p < indexes_.size(); ++p) {
+        if (partition_ids[p].empty()) {
+            continue;
+        }
+        auto ids_ds = GenIdsDataset(partition_ids[p].size(),
+                                    partition_ids[p].data());
+        auto vectors = indexes_[p]->GetVector(ids_ds);
+        if (row_size == 0) {
+            row_size = vectors.size() / partition_ids[p].size();
+            raw_data.resize(row_size * count);
+        }
+        for (size_t i = 0; i < positions[p].size(); ++i) {
+            std::memcpy(raw_data.data() + positions[p][i] * row_size,
+                        vectors.data() + i * row_size,
+                        row_size);
+        }
+    }
+    return raw_data;
+}
+
+void
+VectorPartitionedIndex::CleanLocalData() {
+    for (auto& index : indexes_) {
+        index->CleanLocalData();
+    }
+}
+
+size_t
+VectorPartitionedIndex::LocatePartition(int64_t offset) const {
+    AssertInfo(
+        offset >= 0 && offset < offsets_.back() + partitions_.back().num_rows,
+        "segment offset {} out of range",
+        offset);
+    auto it = std::upper_bound(offsets_.begin(), offsets_.end(), offset);
+    return std::distance(offsets_.begin(), it) - 1;
+}
+
+}  // namespace milvus::index
@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <memory>
+#include <string>
+#include <vector>
+
+#include "index/IndexInfo.h"
+#include "index/VectorIndex.h"
+#include "storage/FileManager.h"
+
+namespace milvus::index {
+
+// index param holding the partitions of the index built across index nodes,
+// formatted as "buildID:indexVersion:numRows,..." in the order of the rows
+const std::string kPartitionedIndex = "partitioned_index";
+
+struct IndexPartitionInfo {
+    int64_t build_id;
+    int64_t index_version;
+    int64_t num_rows;
+};
+
+std::vector<IndexPartitionInfo>
+ParseIndexPartitions(const std::string& value);
+
+// VectorPartitionedIndex serves the vector index of a huge segment whose rows
+// are split into contiguous partitions, each partition has its own index
+// built by a separate index node. Search results of the partitions are
+// merged by topk.
+class VectorPartitionedIndex : public VectorIndex {
+ public:
+    explicit VectorPartitionedIndex(
+        const CreateIndexInfo& create_index_info,
+        const storage::FileManagerContext& file_manager_context,
+        std::vector<IndexPartitionInfo> partitions);
+
+    BinarySet
+    Serialize(const Config& config) override {
+        PanicInfo(Unsupported,
+                  "partitioned index doesn't support serialization");
+    }
+
+    void
+    Load(const BinarySet& binary_set, const Config& config = {}) override {
+        PanicInfo(Unsupported,
+                  "partitioned index doesn't support loading binary set");
+    }
+
+    void
+    Load(milvus::tracer::TraceContext ctx, const Config& config = {}) override;
+
+    void
+    LoadV2(const Config& config = {}) override {
+        PanicInfo(Unsupported, "partitioned index doesn't support storage v2");
+    }
+
+    void
+    BuildWithDataset(const DatasetPtr& dataset,
+                     const Config& config = {}) override {
+        PanicInfo(Unsupported, "partitioned index is built by index nodes");
+    }
+
+    void
+    Build(const Config& config = {}) override {
+        PanicInfo(Unsupported, "partitioned index is built by index nodes");
+    }
+
+    void
+    BuildV2(const Config& config = {}) override {
+        PanicInfo(Unsupported, "partitioned index is built by index nodes");
+    }
+
+    BinarySet
+    Upload(const Config& config = {}) override {
+        PanicInfo(Unsupported, "partitioned index is built by index nodes");
+    }
+
+    BinarySet
+    UploadV2(const Config& config = {}) override {
+        PanicInfo(Unsupported, "partitioned index is built by index nodes");
+    }
+
+    int64_t
+    Count() override;
+
+    void
+    Query(const DatasetPtr dataset,
+          const SearchInfo& search_info,
+          const BitsetView& bitset,
+          SearchResult& search_result) const override;
+
+    knowhere::expected<
+        std::vector<std::shared_ptr<knowhere::IndexNode::iterator>>>
+    VectorIterators(const DatasetPtr dataset,
+                    const SearchInfo& search_info,
+                    const BitsetView& bitset) const override;
+
+    const bool
+    HasRawData() const override;
+
+    std::vector<uint8_t>
+    GetVector(const DatasetPtr dataset) const override;
+
+    std::unique_ptr<const knowhere::sparse::SparseRow<float>[]>
+    GetSparseVector(const DatasetPtr dataset) const override {
+        PanicInfo(Unsupported,
+                  "partitioned index doesn't support sparse vector");
+    }
+
+    void
+    CleanLocalData() override;
+
+ private:
+    // returns the partition of the segment offset
+    size_t
+    LocatePartition(int64_t offset) const;
+
+ private:
+    CreateIndexInfo create_index_info_;
+    storage::FileManagerContext file_manager_context_;
+    std::vector<IndexPartitionInfo> partitions_;
+    // segment offset of the first row of each partition
+    std::vector<int64_t> offsets_;
+    std::vector<std::unique_ptr<VectorIndex>> indexes_;
+};
+
+}  // namespace milvus::index
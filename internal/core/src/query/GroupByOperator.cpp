@@ -14,6 +14,9 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 #include "GroupByOperator.h"
+
+#include <algorithm>
+
 #include "common/Consts.h"
 #include "segcore/SegmentSealedImpl.h"
 #include "Utils.h"
@@ -37,6 +40,7 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
             auto dataGetter = GetDataGetter<int8_t>(segment, group_by_field_id);
             GroupIteratorsByType<int8_t>(iterators,
                                          search_info.topk_,
+                                         search_info.group_size_,
                                          *dataGetter,
                                          group_by_values,
                                          seg_offsets,
@@ -49,6 +53,7 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
                 GetDataGetter<int16_t>(segment, group_by_field_id);
             GroupIteratorsByType<int16_t>(iterators,
                                           search_info.topk_,
+                                          search_info.group_size_,
                                           *dataGetter,
                                           group_by_values,
                                           seg_offsets,
@@ -61,6 +66,7 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
                 GetDataGetter<int32_t>(segment, group_by_field_id);
             GroupIteratorsByType<int32_t>(iterators,
                                           search_info.topk_,
+                                          search_info.group_size_,
                                           *dataGetter,
                                           group_by_values,
                                           seg_offsets,
@@ -73,6 +79,7 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
                 GetDataGetter<int64_t>(segment, group_by_field_id);
             GroupIteratorsByType<int64_t>(iterators,
                                           search_info.topk_,
+                                          search_info.group_size_,
                                           *dataGetter,
                                           group_by_values,
                                           seg_offsets,
@@ -84,6 +91,7 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
             auto dataGetter = GetDataGetter<bool>(segment, group_by_field_id);
             GroupIteratorsByType<bool>(iterators,
                                        search_info.topk_,
+                                       search_info.group_size_,
                                        *dataGetter,
                                        group_by_values,
                                        seg_offsets,
@@ -96,6 +104,7 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
                 GetDataGetter<std::string>(segment, group_by_field_id);
             GroupIteratorsByType<std::string>(iterators,
                                               search_info.topk_,
+                                              search_info.group_size_,
                                               *dataGetter,
                                               group_by_values,
                                               seg_offsets,
@@ -117,6 +126,7 @@ void
 GroupIteratorsByType(
     const std::vector<std::shared_ptr<VectorIterator>>& iterators,
     int64_t topK,
+    int64_t group_size,
     const DataGetter<T>& data_getter,
     std::vector<GroupByValueType>& group_by_values,
     std::vector<int64_t>& seg_offsets,
@@ -125,6 +135,7 @@ GroupIteratorsByType(
     for (auto& iterator : iterators) {
         GroupIteratorResult<T>(iterator,
                                topK,
+                               group_size,
                                data_getter,
                                group_by_values,
                                seg_offsets,
@@ -137,13 +148,15 @@ template <typename T>
 void
 GroupIteratorResult(const std::shared_ptr<VectorIterator>& iterator,
                     int64_t topK,
+                    int64_t group_size,
                     const DataGetter<T>& data_getter,
                     std::vector<GroupByValueType>& group_by_values,
                     std::vector<int64_t>& offsets,
                     std::vector<float>& distances,
                     const knowhere::MetricType& metrics_type) {
-    //1.
-    std::unordered_map<T, std::pair<int64_t, float>> groupMap;
+    //1. each group keeps at most group_size hits
+    std::unordered_map<T, std::vector<std::pair<int64_t, float>>> groupMap;
+    int64_t full_group_count = 0;
 
     //2. do iteration until fill the whole map or run out of all data
     //note it may enumerate all data inside a segment and can block following
@@ -153,7 +166,11 @@ GroupIteratorResult(const std::shared_ptr<VectorIterator>& iterator,
             return l > r;
         return l < r;
     };
-    while (iterator->HasNext() && groupMap.size() < topK) {
+    auto hit_closer = [&](const std::pair<int64_t, float>& lhs,
+                          const std::pair<int64_t, float>& rhs) {
+        return dis_closer(lhs.second, rhs.second);
+    };
+    while (iterator->HasNext() && full_group_count < topK) {
         auto offset_dis_pair = iterator->Next();
         AssertInfo(
             offset_dis_pair.has_value(),
@@ -164,33 +181,63 @@ GroupIteratorResult(const std::shared_ptr<VectorIterator>& iterator,
         T row_data = data_getter.Get(offset);
         auto it = groupMap.find(row_data);
         if (it == groupMap.end()) {
-            groupMap.emplace(row_data, std::make_pair(offset, dis));
-        } else if (dis_closer(dis, it->second.second)) {
-            it->second = {offset, dis};
+            if (groupMap.size() >= topK) {
+                continue;
+            }
+            it = groupMap.emplace(row_data,
+                                  std::vector<std::pair<int64_t, float>>())
+                     .first;
+            it->second.reserve(group_size);
+        }
+        auto& hits = it->second;
+        if (static_cast<int64_t>(hits.size()) < group_size) {
+            hits.emplace_back(offset, dis);
+            if (static_cast<int64_t>(hits.size()) == group_size) {
+                full_group_count++;
+            }
+            continue;
+        }
+        // the iterator doesn't guarantee the order of distances strictly,
+        // replace the farthest hit of the full group by the closer one
+        auto farthest = std::min_element(
+            hits.begin(), hits.end(), [&](const auto& lhs, const auto& rhs) {
+                return hit_closer(rhs, lhs);
+            });
+        if (dis_closer(dis, farthest->second)) {
+            *farthest = {offset, dis};
         }
     }
 
-    //3. sorted based on distances and metrics
-    std::vector<std::pair<T, std::pair<int64_t, float>>> sortedGroupVals(
-        groupMap.begin(), groupMap.end());
+    //3. sort hits inside each group, then sort groups by their closest hits
+    std::vector<std::pair<T, std::vector<std::pair<int64_t, float>>>>
+        sortedGroupVals;
+    sortedGroupVals.reserve(groupMap.size());
+    for (auto& [group_val, hits] : groupMap) {
+        std::sort(hits.begin(), hits.end(), hit_closer);
+        sortedGroupVals.emplace_back(group_val, std::move(hits));
+    }
     auto customComparator = [&](const auto& lhs, const auto& rhs) {
-        return dis_closer(lhs.second.second, rhs.second.second);
+        return hit_closer(lhs.second.front(), rhs.second.front());
     };
     std::sort(sortedGroupVals.begin(), sortedGroupVals.end(), customComparator);
 
-    //4. save groupBy results
-    group_by_values.reserve(sortedGroupVals.size());
-    offsets.reserve(sortedGroupVals.size());
-    distances.reserve(sortedGroupVals.size());
+    //4. save groupBy results, hits of the same group are placed together
+    int64_t hit_count = 0;
+    group_by_values.reserve(topK * group_size);
+    offsets.reserve(topK * group_size);
+    distances.reserve(topK * group_size);
     for (auto iter = sortedGroupVals.cbegin(); iter != sortedGroupVals.cend();
          iter++) {
-        group_by_values.emplace_back(iter->first);
-        offsets.push_back(iter->second.first);
-        distances.push_back(iter->second.second);
+        for (const auto& hit : iter->second) {
+            group_by_values.emplace_back(iter->first);
+            offsets.push_back(hit.first);
+            distances.push_back(hit.second);
+            hit_count++;
+        }
     }
 
-    //5. padding topK results, extra memory consumed will be removed when reducing
-    for (std::size_t idx = groupMap.size(); idx < topK; idx++) {
+    //5. padding topK * group_size results, extra memory consumed will be removed when reducing
+    for (auto idx = hit_count; idx < topK * group_size; idx++) {
         offsets.push_back(INVALID_SEG_OFFSET);
         distances.push_back(0.0);
         group_by_values.emplace_back(std::monostate{});
@@ -127,6 +127,15 @@ class ExecPlanNodeVisitor : public PlanNodeVisitor {
                                 cache_offsets);
     }
 
+    // ExecuteExprNodeWithCache evaluates the filter,
+    // the cached result of segment is used if any
+    void
+    ExecuteExprNodeWithCache(
+        const std::shared_ptr<milvus::plan::PlanNode>& plannode,
+        const milvus::segcore::SegmentInternalInterface* segment,
+        int64_t active_count,
+        BitsetType& result);
+
  private:
     template <typename VectorType>
     void
@@ -141,6 +141,30 @@ ExecPlanNodeVisitor::ExecuteExprNodeInternal(
     //    std::cout << bitset_holder->size() << " .  " << s << std::endl;
 }
 
+void
+ExecPlanNodeVisitor::ExecuteExprNodeWithCache(
+    const std::shared_ptr<milvus::plan::PlanNode>& plannode,
+    const milvus::segcore::SegmentInternalInterface* segment,
+    int64_t active_count,
+    BitsetType& result) {
+    auto cache = segment->get_expr_res_cache();
+    if (cache == nullptr) {
+        ExecuteExprNode(plannode, segment, active_count, result);
+        return;
+    }
+
+    // the version must be got before evaluating,
+    // so that the result evaluated on the data being changed is never hit
+    auto version = segment->get_data_version();
+    auto expr = plannode->ToString();
+    if (auto cached = cache->Get(expr, version, active_count)) {
+        result = std::move(cached.value());
+        return;
+    }
+    ExecuteExprNode(plannode, segment, active_count, result);
+    cache->Put(expr, version, result);
+}
+
 expr::ExprInfo
 GatherInfoBasedOnExpr(const std::shared_ptr<milvus::plan::PlanNode>& node) {
     return node->GatherInfo();
@@ -189,7 +213,7 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         }
 
         BitsetType expr_res;
-        ExecuteExprNode(
+        ExecuteExprNodeWithCache(
             node.filter_plannode_.value(), segment, active_count, expr_res);
         bitset_holder = std::make_unique<BitsetType>(expr_res.clone());
         bitset_holder->flip();
@@ -223,6 +247,9 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
                 search_result.seg_offsets_,
                 search_result.distances_);
         search_result.group_by_values_ = std::move(group_by_values);
+        // each group contributes at most group_size hits for every query
+        search_result.unity_topK_ =
+            node.search_info_.topk_ * node.search_info_.group_size_;
         AssertInfo(search_result.seg_offsets_.size() ==
                        search_result.group_by_values_.value().size(),
                    "Wrong state! search_result group_by_values_ size:{} is not "
@@ -0,0 +1,109 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <functional>
+#include <list>
+#include <mutex>
+#include <optional>
+#include <string>
+#include <unordered_map>
+
+#include "common/Types.h"
+
+namespace milvus::segcore {
+
+// ExprResCache caches the evaluated bitsets of filter expressions of a sealed
+// segment, keyed by the hash of expression and the data version of segment.
+// The bitsets of an outdated data version are never returned,
+// and dropped once a newer version put.
+class ExprResCache {
+ public:
+    explicit ExprResCache(int64_t capacity) : capacity_(capacity) {
+    }
+
+    // Get returns the cached bitset of the expression evaluated on the data
+    // version, std::nullopt if missed.
+    std::optional<BitsetType>
+    Get(const std::string& expr, int64_t version, int64_t active_count) {
+        std::lock_guard<std::mutex> lock(mutex_);
+        if (version != version_) {
+            return std::nullopt;
+        }
+        auto it = entries_.find(std::hash<std::string>{}(expr));
+        if (it == entries_.end() || it->second->expr != expr ||
+            static_cast<int64_t>(it->second->bitset.size()) != active_count) {
+            return std::nullopt;
+        }
+        lru_.splice(lru_.begin(), lru_, it->second);
+        return it->second->bitset.clone();
+    }
+
+    // Put caches the bitset of the expression evaluated on the data version,
+    // the least recently used one is evicted if the capacity reached.
+    void
+    Put(const std::string& expr, int64_t version, const BitsetType& bitset) {
+        std::lock_guard<std::mutex> lock(mutex_);
+        if (capacity_ <= 0 || version < version_) {
+            return;
+        }
+        if (version > version_) {
+            clear();
+            version_ = version;
+        }
+
+        auto key = std::hash<std::string>{}(expr);
+        auto it = entries_.find(key);
+        if (it != entries_.end()) {
+            lru_.erase(it->second);
+            entries_.erase(it);
+        }
+        lru_.push_front(Entry{key, expr, bitset.clone()});
+        entries_[key] = lru_.begin();
+        while (static_cast<int64_t>(lru_.size()) > capacity_) {
+            entries_.erase(lru_.back().key);
+            lru_.pop_back();
+        }
+    }
+
+    int64_t
+    Size() const {
+        std::lock_guard<std::mutex> lock(mutex_);
+        return lru_.size();
+    }
+
+ private:
+    void
+    clear() {
+        entries_.clear();
+        lru_.clear();
+    }
+
+    struct Entry {
+        size_t key;
+        std::string expr;
+        BitsetType bitset;
+    };
+
+    const int64_t capacity_;
+    mutable std::mutex mutex_;
+    int64_t version_ = 0;
+    std::list<Entry> lru_;
+    std::unordered_map<size_t, std::list<Entry>::iterator> entries_;
+};
+
+}  // namespace milvus::segcore
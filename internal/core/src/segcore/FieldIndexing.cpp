@@ -160,6 +160,33 @@ VectorFieldIndexing::AppendSegmentIndexDense(int64_t reserved_offset,
                                              int64_t size,
                                              const VectorBase* field_raw_data,
                                              const void* data_source) {
+    std::lock_guard<std::mutex> lck(mutex_);
+    // the index is built in background, only record the rows appended,
+    // which are kept in the raw data as the index not synced yet
+    if (!built_ && segcore_config_.get_enable_interim_index_async_build()) {
+        pending_rows_ = std::max(pending_rows_, reserved_offset + size);
+        return;
+    }
+    append_segment_index_dense(
+        reserved_offset, size, field_raw_data, data_source);
+}
+
+void
+VectorFieldIndexing::BuildSegmentIndexDense(const VectorBase* field_raw_data) {
+    std::lock_guard<std::mutex> lck(mutex_);
+    if (built_ || pending_rows_ < get_build_threshold()) {
+        return;
+    }
+    // all the pending rows are read from the raw data
+    append_segment_index_dense(0, pending_rows_, field_raw_data, nullptr);
+}
+
+void
+VectorFieldIndexing::append_segment_index_dense(
+    int64_t reserved_offset,
+    int64_t size,
+    const VectorBase* field_raw_data,
+    const void* data_source) {
     AssertInfo(field_meta_.get_data_type() == DataType::VECTOR_FLOAT,
                "Data type of vector field is not VECTOR_FLOAT");
     auto dim = field_meta_.get_dim();
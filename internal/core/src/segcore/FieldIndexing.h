@@ -15,6 +15,7 @@
 #include <optional>
 #include <map>
 #include <memory>
+#include <mutex>
 
 #include <tbb/concurrent_vector.h>
 #include <index/Index.h>
@@ -64,6 +65,11 @@ class FieldIndexing {
                              const VectorBase* vec_base,
                              const void* data_source) = 0;
 
+    // build the dense vector index deferred by the appendings,
+    // only takes effect if the interim index is built asynchronously
+    virtual void
+    BuildSegmentIndexDense(const VectorBase* vec_base) = 0;
+
     virtual void
     GetDataFromIndex(const int64_t* seg_offsets,
                      int64_t count,
@@ -132,6 +138,12 @@ class ScalarFieldIndexing : public FieldIndexing {
                   "scalar index doesn't support append vector segment index");
     }
 
+    void
+    BuildSegmentIndexDense(const VectorBase* vec_base) override {
+        PanicInfo(Unsupported,
+                  "scalar index doesn't support build vector segment index");
+    }
+
     void
     GetDataFromIndex(const int64_t* seg_offsets,
                      int64_t count,
@@ -194,6 +206,9 @@ class VectorFieldIndexing : public FieldIndexing {
                              const VectorBase* field_raw_data,
                              const void* data_source) override;
 
+    void
+    BuildSegmentIndexDense(const VectorBase* field_raw_data) override;
+
     // for sparse float vector:
     //   * element_size is not used
     //   * output_raw pooints at a milvus::schema::proto::SparseFloatArray.
@@ -234,6 +249,17 @@ class VectorFieldIndexing : public FieldIndexing {
  private:
     void
     recreate_index();
+
+    void
+    append_segment_index_dense(int64_t reserved_offset,
+                               int64_t size,
+                               const VectorBase* field_raw_data,
+                               const void* data_source);
+
+    // serializes the appendings and the asynchronous building of the index.
+    std::mutex mutex_;
+    // number of rows appended before the index built asynchronously.
+    int64_t pending_rows_ = 0;
     // current number of rows in index.
     std::atomic<idx_t> index_cur_ = 0;
     // whether the growing index has been built.
@@ -365,6 +391,20 @@ class IndexingRecord {
         }
     }
 
+    // build the interim index of the dense vector fields deferred by appendings
+    template <bool is_sealed>
+    void
+    BuildInterimIndex(const InsertRecord<is_sealed>& record) {
+        for (auto& [field_id, indexing] : field_indexings_) {
+            if (indexing->get_field_meta().get_data_type() !=
+                DataType::VECTOR_FLOAT) {
+                continue;
+            }
+            indexing->BuildSegmentIndexDense(
+                record.get_field_data_base(field_id));
+        }
+    }
+
     // for sparse float vector:
     //   * element_size is not used
     //   * output_raw pooints at a milvus::schema::proto::SparseFloatArray.
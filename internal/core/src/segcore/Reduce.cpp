@@ -228,7 +228,7 @@ ReduceHelper::ReduceSearchResultForOneNQ(int64_t qi,
     }
     pk_set_.clear();
     pairs_.clear();
-    group_by_val_set_.clear();
+    group_by_val_count_.clear();
 
     pairs_.reserve(num_segments_);
     for (int i = 0; i < num_segments_; i++) {
@@ -272,7 +272,17 @@ ReduceHelper::ReduceSearchResultForOneNQ(int64_t qi,
 
     int64_t dup_cnt = 0;
     auto start = offset;
-    while (offset - start < topk && !heap_.empty()) {
+    // for group by, topk is the number of groups, each of which keeps
+    // at most group_size hits
+    auto group_size = plan_->plan_node_->search_info_.group_size_;
+    int64_t full_group_cnt = 0;
+    auto enough = [&]() {
+        if (plan_->plan_node_->search_info_.group_by_field_id_.has_value()) {
+            return full_group_cnt >= topk;
+        }
+        return offset - start >= topk;
+    };
+    while (!enough() && !heap_.empty()) {
         auto pilot = heap_.top();
         heap_.pop();
 
@@ -286,17 +296,27 @@ ReduceHelper::ReduceSearchResultForOneNQ(int64_t qi,
         if (pk_set_.count(pk) == 0) {
             bool skip_for_group_by = false;
             if (pilot->group_by_value_.has_value()) {
-                if (group_by_val_set_.count(pilot->group_by_value_.value()) >
-                    0) {
-                    skip_for_group_by = true;
+                auto it =
+                    group_by_val_count_.find(pilot->group_by_value_.value());
+                if (it == group_by_val_count_.end()) {
+                    skip_for_group_by =
+                        static_cast<int64_t>(group_by_val_count_.size()) >=
+                        topk;
+                } else {
+                    skip_for_group_by = it->second >= group_size;
                 }
             }
             if (!skip_for_group_by) {
                 pilot->search_result_->result_offsets_.push_back(offset++);
                 final_search_records_[index][qi].push_back(pilot->offset_);
                 pk_set_.insert(pk);
-                if (pilot->group_by_value_.has_value())
-                    group_by_val_set_.insert(pilot->group_by_value_.value());
+                if (pilot->group_by_value_.has_value()) {
+                    auto cnt =
+                        ++group_by_val_count_[pilot->group_by_value_.value()];
+                    if (cnt == group_size) {
+                        full_group_cnt++;
+                    }
+                }
             }
         } else {
             // skip entity with same primary key
@@ -16,6 +16,7 @@
 #include <memory>
 #include <vector>
 #include <queue>
+#include <unordered_map>
 #include <unordered_set>
 
 #include "common/type_c.h"
@@ -113,7 +114,8 @@ class ReduceHelper {
                         SearchResultPairComparator>
         heap_;
     std::unordered_set<milvus::PkType> pk_set_;
-    std::unordered_set<milvus::GroupByValueType> group_by_val_set_;
+    // number of selected hits of each group
+    std::unordered_map<milvus::GroupByValueType, int64_t> group_by_val_count_;
 };
 
 }  // namespace milvus::segcore
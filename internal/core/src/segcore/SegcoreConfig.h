@@ -73,8 +73,31 @@ class SegcoreConfig {
         return enable_interim_segment_index_;
     }
 
+    void
+    set_enable_interim_index_async_build(bool enable_async_build) {
+        this->enable_interim_index_async_build_ = enable_async_build;
+    }
+
+    bool
+    get_enable_interim_index_async_build() const {
+        return enable_interim_index_async_build_;
+    }
+
+    void
+    set_expr_res_cache_size(int64_t expr_res_cache_size) {
+        this->expr_res_cache_size_ = expr_res_cache_size;
+    }
+
+    int64_t
+    get_expr_res_cache_size() const {
+        return expr_res_cache_size_;
+    }
+
  private:
     inline static bool enable_interim_segment_index_ = false;
+    inline static bool enable_interim_index_async_build_ = false;
+    // number of filter bitsets cached per sealed segment, 0 means disabled
+    inline static int64_t expr_res_cache_size_ = 0;
     inline static int64_t chunk_rows_ = 32 * 1024;
     inline static int64_t nlist_ = 100;
     inline static int64_t nprobe_ = 4;
@@ -34,6 +34,11 @@ class SegmentGrowing : public SegmentInternalInterface {
            const Timestamp* timestamps,
            const InsertRecordProto* insert_record_proto) = 0;
 
+    // build the interim index deferred by the insertions,
+    // only takes effect if the interim index is built asynchronously
+    virtual void
+    BuildInterimIndex() = 0;
+
     SegmentType
     type() const override {
         return SegmentType::Growing;
@@ -168,6 +168,14 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
                                              reserved_offset + num_rows);
 }
 
+void
+SegmentGrowingImpl::BuildInterimIndex() {
+    if (!segcore_config_.get_enable_interim_segment_index()) {
+        return;
+    }
+    indexing_record_.BuildInterimIndex(insert_record_);
+}
+
 void
 SegmentGrowingImpl::LoadFieldData(const LoadFieldDataInfo& infos) {
     // schema don't include system field
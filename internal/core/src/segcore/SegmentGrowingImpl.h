@@ -47,6 +47,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
            const Timestamp* timestamps,
            const InsertRecordProto* insert_record_proto) override;
 
+    void
+    BuildInterimIndex() override;
+
     bool
     Contain(const PkType& pk) const override {
         return insert_record_.contain(pk);
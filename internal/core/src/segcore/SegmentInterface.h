@@ -20,6 +20,7 @@
 #include <index/ScalarIndex.h>
 
 #include "DeletedRecord.h"
+#include "ExprResCache.h"
 #include "FieldIndexing.h"
 #include "common/Schema.h"
 #include "common/Span.h"
@@ -173,6 +174,20 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual std::string
     debug() const = 0;
 
+    // the cache of evaluated filter bitsets,
+    // nullptr if the segment doesn't cache them
+    virtual ExprResCache*
+    get_expr_res_cache() const {
+        return nullptr;
+    }
+
+    // the version of loaded data,
+    // which changes once any field data or index loaded or dropped
+    virtual int64_t
+    get_data_version() const {
+        return 0;
+    }
+
     int64_t
     get_real_count() const override;
 
@@ -79,6 +79,7 @@ SegmentSealedImpl::LoadIndex(const LoadIndexInfo& info) {
     } else {
         LoadScalarIndex(info);
     }
+    ++data_version_;
 }
 
 void
@@ -94,9 +95,13 @@ SegmentSealedImpl::LoadVecIndex(const LoadIndexInfo& info) {
     AssertInfo(row_count > 0, "Index count is 0");
 
     std::unique_lock lck(mutex_);
-    AssertInfo(
-        !get_bit(index_ready_bitset_, field_id),
-        "vector index has been exist at " + std::to_string(field_id.get()));
+    if (get_bit(index_ready_bitset_, field_id)) {
+        // the serving index is replaced by the one rebuilt for the field,
+        // searches in flight hold the previous index until they finish
+        LOG_INFO("segment {} replaces vector index of field {}",
+                 this->get_segment_id(),
+                 field_id.get());
+    }
     if (num_rows_.has_value()) {
         AssertInfo(num_rows_.value() == row_count,
                    "field (" + std::to_string(field_id.get()) +
@@ -511,6 +516,7 @@ SegmentSealedImpl::LoadFieldData(FieldId field_id, FieldDataInfo& data) {
         std::unique_lock lck(mutex_);
         update_row_count(num_rows);
     }
+    ++data_version_;
 }
 
 void
@@ -609,6 +615,7 @@ SegmentSealedImpl::MapFieldData(const FieldId field_id, FieldDataInfo& data) {
 
     std::unique_lock lck(mutex_);
     set_bit(field_data_ready_bitset_, field_id, true);
+    ++data_version_;
 }
 
 void
@@ -939,6 +946,7 @@ SegmentSealedImpl::DropFieldData(const FieldId field_id) {
         }
         lck.unlock();
     }
+    ++data_version_;
 }
 
 void
@@ -954,6 +962,7 @@ SegmentSealedImpl::DropIndex(const FieldId field_id) {
     std::unique_lock lck(mutex_);
     vector_indexings_.drop_field_indexing(field_id);
     set_bit(index_ready_bitset_, field_id, false);
+    ++data_version_;
 }
 
 void
@@ -1001,7 +1010,8 @@ SegmentSealedImpl::SegmentSealedImpl(SchemaPtr schema,
       insert_record_(*schema, MAX_ROW_COUNT),
       schema_(schema),
       id_(segment_id),
-      col_index_meta_(index_meta) {
+      col_index_meta_(index_meta),
+      expr_res_cache_(segcore_config.get_expr_res_cache_size()) {
 }
 
 SegmentSealedImpl::~SegmentSealedImpl() {
@@ -85,6 +85,19 @@ class SegmentSealedImpl : public SegmentSealed {
     bool
     HasRawData(int64_t field_id) const override;
 
+    ExprResCache*
+    get_expr_res_cache() const override {
+        if (segcore_config_.get_expr_res_cache_size() <= 0) {
+            return nullptr;
+        }
+        return &expr_res_cache_;
+    }
+
+    int64_t
+    get_data_version() const override {
+        return data_version_.load();
+    }
+
     DataType
     GetFieldDataType(FieldId fieldId) const override;
 
@@ -302,6 +315,11 @@ class SegmentSealedImpl : public SegmentSealed {
     SegcoreConfig segcore_config_;
     std::unordered_map<FieldId, std::unique_ptr<VecIndexConfig>>
         vec_binlog_config_;
+
+    // increased once any field data or index loaded or dropped,
+    // the cached filter results of previous versions are never hit
+    std::atomic<int64_t> data_version_ = 0;
+    mutable ExprResCache expr_res_cache_;
 };
 
 inline SegmentSealedUPtr
@@ -798,10 +798,8 @@ LoadFieldDatasFromRemote(const std::vector<std::string>& remote_files,
         futures.reserve(remote_files.size());
         for (const auto& file : remote_files) {
             auto future = pool.Submit([&]() {
-                auto fileSize = rcm->Size(file);
-                auto buf = std::shared_ptr<uint8_t[]>(new uint8_t[fileSize]);
-                rcm->Read(file, buf.get(), fileSize);
-                auto result = storage::DeserializeFileData(buf, fileSize);
+                auto result =
+                    storage::DownloadAndDecodeRemoteFile(rcm.get(), file);
                 return result->GetFieldData();
             });
             futures.emplace_back(std::move(future));
@@ -18,6 +18,7 @@
 #include "index/IndexFactory.h"
 #include "index/Meta.h"
 #include "index/Utils.h"
+#include "index/VectorPartitionedIndex.h"
 #include "log/Log.h"
 #include "storage/FileManager.h"
 #include "segcore/Types.h"
@@ -145,7 +146,7 @@ appendVecIndex(CLoadIndexInfo c_load_index_info, CBinarySet c_binary_set) {
                                               load_index_info->index_version};
         auto remote_chunk_manager =
             milvus::storage::RemoteChunkManagerSingleton::GetInstance()
-                .GetRemoteChunkManager();
+                .GetRemoteChunkManager(load_index_info->collection_id);
 
         auto config = milvus::index::ParseConfigFromIndexParams(
             load_index_info->index_params);
@@ -264,7 +265,7 @@ AppendIndexV2(CTraceContext c_trace, CLoadIndexInfo c_load_index_info) {
                                               load_index_info->index_version};
         auto remote_chunk_manager =
             milvus::storage::RemoteChunkManagerSingleton::GetInstance()
-                .GetRemoteChunkManager();
+                .GetRemoteChunkManager(load_index_info->collection_id);
 
         auto config = milvus::index::ParseConfigFromIndexParams(
             load_index_info->index_params);
@@ -272,9 +273,21 @@ AppendIndexV2(CTraceContext c_trace, CLoadIndexInfo c_load_index_info) {
 
         milvus::storage::FileManagerContext fileManagerContext(
             field_meta, index_meta, remote_chunk_manager);
-        load_index_info->index =
-            milvus::index::IndexFactory::GetInstance().CreateIndex(
-                index_info, fileManagerContext);
+        auto partitioned =
+            index_params.find(milvus::index::kPartitionedIndex);
+        if (milvus::datatype_is_vector(field_type) &&
+            partitioned != index_params.end()) {
+            // the index is built by partitions across index nodes
+            load_index_info->index =
+                std::make_unique<milvus::index::VectorPartitionedIndex>(
+                    index_info,
+                    fileManagerContext,
+                    milvus::index::ParseIndexPartitions(partitioned->second));
+        } else {
+            load_index_info->index =
+                milvus::index::IndexFactory::GetInstance().CreateIndex(
+                    index_info, fileManagerContext);
+        }
 
         if (load_index_info->enable_mmap &&
             load_index_info->index->IsMmapSupported()) {
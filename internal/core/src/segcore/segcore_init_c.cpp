@@ -38,6 +38,20 @@ SegcoreSetEnableTempSegmentIndex(const bool value) {
     config.set_enable_interim_segment_index(value);
 }
 
+extern "C" void
+SegcoreSetEnableInterimIndexAsyncBuild(const bool value) {
+    milvus::segcore::SegcoreConfig& config =
+        milvus::segcore::SegcoreConfig::default_config();
+    config.set_enable_interim_index_async_build(value);
+}
+
+extern "C" void
+SegcoreSetExprResCacheSize(const int64_t value) {
+    milvus::segcore::SegcoreConfig& config =
+        milvus::segcore::SegcoreConfig::default_config();
+    config.set_expr_res_cache_size(value);
+}
+
 extern "C" void
 SegcoreSetNlist(const int64_t value) {
     milvus::segcore::SegcoreConfig& config =
@@ -24,6 +24,12 @@ SegcoreSetChunkRows(const int64_t);
 void
 SegcoreSetEnableTempSegmentIndex(const bool);
 
+void
+SegcoreSetEnableInterimIndexAsyncBuild(const bool);
+
+void
+SegcoreSetExprResCacheSize(const int64_t);
+
 void
 SegcoreSetNlist(const int64_t);
 
@@ -235,6 +235,17 @@ PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset) {
     }
 }
 
+CStatus
+BuildInterimIndex(CSegmentInterface c_segment) {
+    try {
+        auto segment = static_cast<milvus::segcore::SegmentGrowing*>(c_segment);
+        segment->BuildInterimIndex();
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(&e);
+    }
+}
+
 CStatus
 Delete(CSegmentInterface c_segment,
        int64_t reserved_offset,  // deprecated
@@ -326,6 +337,89 @@ LoadFieldRawData(CSegmentInterface c_segment,
     }
 }
 
+template <typename T>
+void
+FillDefaultFieldData(const milvus::FieldDataPtr& field_data,
+                     const T& value,
+                     int64_t row_count) {
+    auto data = std::make_unique<T[]>(row_count);
+    std::fill_n(data.get(), row_count, value);
+    field_data->FillFieldData(data.get(), row_count);
+}
+
+CStatus
+LoadFieldDefaultData(CSegmentInterface c_segment,
+                     int64_t field_id,
+                     const void* default_value_blob,
+                     int64_t blob_size,
+                     int64_t row_count) {
+    try {
+        auto segment_interface =
+            reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment =
+            dynamic_cast<milvus::segcore::SegmentSealed*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+
+        milvus::proto::schema::ValueField default_value;
+        auto suc = default_value.ParseFromArray(default_value_blob, blob_size);
+        AssertInfo(suc, "unmarshal default value failed");
+
+        auto& field_meta = segment->get_schema()[milvus::FieldId(field_id)];
+        auto data_type = field_meta.get_data_type();
+        auto field_data = milvus::storage::CreateFieldData(data_type);
+        switch (data_type) {
+            case milvus::DataType::BOOL:
+                FillDefaultFieldData<bool>(
+                    field_data, default_value.bool_data(), row_count);
+                break;
+            case milvus::DataType::INT8:
+                FillDefaultFieldData<int8_t>(
+                    field_data, default_value.int_data(), row_count);
+                break;
+            case milvus::DataType::INT16:
+                FillDefaultFieldData<int16_t>(
+                    field_data, default_value.int_data(), row_count);
+                break;
+            case milvus::DataType::INT32:
+                FillDefaultFieldData<int32_t>(
+                    field_data, default_value.int_data(), row_count);
+                break;
+            case milvus::DataType::INT64:
+                FillDefaultFieldData<int64_t>(
+                    field_data, default_value.long_data(), row_count);
+                break;
+            case milvus::DataType::FLOAT:
+                FillDefaultFieldData<float>(
+                    field_data, default_value.float_data(), row_count);
+                break;
+            case milvus::DataType::DOUBLE:
+                FillDefaultFieldData<double>(
+                    field_data, default_value.double_data(), row_count);
+                break;
+            case milvus::DataType::STRING:
+            case milvus::DataType::VARCHAR:
+                FillDefaultFieldData<std::string>(
+                    field_data, default_value.string_data(), row_count);
+                break;
+            default:
+                PanicInfo(milvus::DataTypeInvalid,
+                          "unsupported default value of type: {}",
+                          data_type);
+        }
+
+        milvus::FieldDataChannelPtr channel =
+            std::make_shared<milvus::FieldDataChannel>();
+        channel->push(field_data);
+        channel->close();
+        auto field_data_info = milvus::FieldDataInfo(
+            field_id, static_cast<size_t>(row_count), channel);
+        segment->LoadFieldData(milvus::FieldId(field_id), field_data_info);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(&e);
+    }
+}
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment,
                   CLoadDeletedRecordInfo deleted_record_info) {
@@ -90,6 +90,9 @@ Insert(CSegmentInterface c_segment,
 CStatus
 PreInsert(CSegmentInterface c_segment, int64_t size, int64_t* offset);
 
+CStatus
+BuildInterimIndex(CSegmentInterface c_segment);
+
 //////////////////////////////    interfaces for sealed segment    //////////////////////////////
 CStatus
 LoadFieldData(CSegmentInterface c_segment,
@@ -105,6 +108,13 @@ LoadFieldRawData(CSegmentInterface c_segment,
                  const void* data,
                  int64_t row_count);
 
+CStatus
+LoadFieldDefaultData(CSegmentInterface c_segment,
+                     int64_t field_id,
+                     const void* default_value_blob,
+                     int64_t blob_size,
+                     int64_t row_count);
+
 CStatus
 LoadDeletedRecord(CSegmentInterface c_segment,
                   CLoadDeletedRecordInfo deleted_record_info);
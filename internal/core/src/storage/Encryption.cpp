@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "storage/Encryption.h"
+
+#include <cstring>
+#include <mutex>
+
+#include <openssl/evp.h>
+#include <openssl/rand.h>
+
+#include "common/EasyAssert.h"
+
+namespace milvus::storage {
+
+namespace {
+
+struct CipherCtxDeleter {
+    void
+    operator()(EVP_CIPHER_CTX* ctx) const {
+        EVP_CIPHER_CTX_free(ctx);
+    }
+};
+
+using CipherCtxPtr = std::unique_ptr<EVP_CIPHER_CTX, CipherCtxDeleter>;
+
+void
+PutInt64(uint8_t* buf, int64_t value) {
+    auto v = static_cast<uint64_t>(value);
+    for (int i = 0; i < 8; i++) {
+        buf[i] = static_cast<uint8_t>(v >> (8 * i));
+    }
+}
+
+int64_t
+GetInt64(const uint8_t* buf) {
+    uint64_t v = 0;
+    for (int i = 0; i < 8; i++) {
+        v |= static_cast<uint64_t>(buf[i]) << (8 * i);
+    }
+    return static_cast<int64_t>(v);
+}
+
+}  // namespace
+
+bool
+IsEncrypted(const uint8_t* data, int64_t size) {
+    return size > static_cast<int64_t>(ENCRYPTION_MAGIC_SIZE) &&
+           std::memcmp(data, ENCRYPTION_MAGIC, ENCRYPTION_MAGIC_SIZE) == 0 &&
+           data[ENCRYPTION_MAGIC_SIZE] == ENCRYPTION_FORMAT_VERSION;
+}
+
+void
+DataKeyRing::SetKey(int64_t collection_id,
+                    int64_t version,
+                    std::string key,
+                    bool current) {
+    AssertInfo(key.size() == DATA_KEY_SIZE,
+               "data key of collection {} is {} bytes, expect {}",
+               collection_id,
+               key.size(),
+               DATA_KEY_SIZE);
+    std::unique_lock lck(mutex_);
+    auto& keys = collections_[collection_id];
+    keys.keys[version] = std::move(key);
+    if (current) {
+        keys.current = version;
+    }
+}
+
+std::vector<uint8_t>
+DataKeyRing::Encrypt(int64_t collection_id,
+                     const uint8_t* data,
+                     int64_t size) {
+    int64_t version = 0;
+    std::string key;
+    {
+        std::shared_lock lck(mutex_);
+        auto it = collections_.find(collection_id);
+        if (it != collections_.end() && it->second.current != 0) {
+            version = it->second.current;
+            key = it->second.keys.at(version);
+        }
+    }
+    if (version == 0) {
+        return std::vector<uint8_t>(data, data + size);
+    }
+
+    std::vector<uint8_t> result(ENCRYPTION_HEADER_SIZE + size +
+                                ENCRYPTION_TAG_SIZE);
+    auto header = result.data();
+    std::memcpy(header, ENCRYPTION_MAGIC, ENCRYPTION_MAGIC_SIZE);
+    header[ENCRYPTION_MAGIC_SIZE] = ENCRYPTION_FORMAT_VERSION;
+    PutInt64(header + ENCRYPTION_MAGIC_SIZE + 1, collection_id);
+    PutInt64(header + ENCRYPTION_MAGIC_SIZE + 9, version);
+    auto nonce = header + ENCRYPTION_HEADER_SIZE - ENCRYPTION_NONCE_SIZE;
+    AssertInfo(RAND_bytes(nonce, ENCRYPTION_NONCE_SIZE) == 1,
+               "failed to generate nonce");
+
+    CipherCtxPtr ctx(EVP_CIPHER_CTX_new());
+    int len = 0;
+    auto ciphertext = header + ENCRYPTION_HEADER_SIZE;
+    auto ok =
+        ctx != nullptr &&
+        EVP_EncryptInit_ex(
+            ctx.get(), EVP_aes_256_gcm(), nullptr, nullptr, nullptr) == 1 &&
+        EVP_CIPHER_CTX_ctrl(ctx.get(),
+                            EVP_CTRL_GCM_SET_IVLEN,
+                            ENCRYPTION_NONCE_SIZE,
+                            nullptr) == 1 &&
+        EVP_EncryptInit_ex(ctx.get(),
+                           nullptr,
+                           nullptr,
+                           reinterpret_cast<const uint8_t*>(key.data()),
+                           nonce) == 1 &&
+        EVP_EncryptUpdate(
+            ctx.get(), nullptr, &len, header, ENCRYPTION_HEADER_SIZE) == 1 &&
+        EVP_EncryptUpdate(ctx.get(), ciphertext, &len, data, size) == 1 &&
+        EVP_EncryptFinal_ex(ctx.get(), ciphertext + len, &len) == 1 &&
+        EVP_CIPHER_CTX_ctrl(ctx.get(),
+                            EVP_CTRL_GCM_GET_TAG,
+                            ENCRYPTION_TAG_SIZE,
+                            ciphertext + size) == 1;
+    AssertInfo(ok, "failed to encrypt data of collection {}", collection_id);
+    return result;
+}
+
+std::vector<uint8_t>
+DataKeyRing::Decrypt(const uint8_t* data, int64_t size) {
+    AssertInfo(IsEncrypted(data, size) &&
+                   size >= static_cast<int64_t>(ENCRYPTION_HEADER_SIZE +
+                                                ENCRYPTION_TAG_SIZE),
+               "invalid encrypted object of {} bytes",
+               size);
+    auto collection_id = GetInt64(data + ENCRYPTION_MAGIC_SIZE + 1);
+    auto version = GetInt64(data + ENCRYPTION_MAGIC_SIZE + 9);
+    std::string key;
+    {
+        std::shared_lock lck(mutex_);
+        auto it = collections_.find(collection_id);
+        if (it != collections_.end()) {
+            auto key_it = it->second.keys.find(version);
+            if (key_it != it->second.keys.end()) {
+                key = key_it->second;
+            }
+        }
+    }
+    if (key.empty()) {
+        PanicInfo(DataFormatBroken,
+                  "data key {} of collection {} not found",
+                  version,
+                  collection_id);
+    }
+
+    auto nonce = data + ENCRYPTION_HEADER_SIZE - ENCRYPTION_NONCE_SIZE;
+    auto ciphertext = data + ENCRYPTION_HEADER_SIZE;
+    auto ciphertext_size = size - ENCRYPTION_HEADER_SIZE - ENCRYPTION_TAG_SIZE;
+    std::vector<uint8_t> result(ciphertext_size);
+
+    CipherCtxPtr ctx(EVP_CIPHER_CTX_new());
+    int len = 0;
+    auto ok =
+        ctx != nullptr &&
+        EVP_DecryptInit_ex(
+            ctx.get(), EVP_aes_256_gcm(), nullptr, nullptr, nullptr) == 1 &&
+        EVP_CIPHER_CTX_ctrl(ctx.get(),
+                            EVP_CTRL_GCM_SET_IVLEN,
+                            ENCRYPTION_NONCE_SIZE,
+                            nullptr) == 1 &&
+        EVP_DecryptInit_ex(ctx.get(),
+                           nullptr,
+                           nullptr,
+                           reinterpret_cast<const uint8_t*>(key.data()),
+                           nonce) == 1 &&
+        EVP_DecryptUpdate(
+            ctx.get(), nullptr, &len, data, ENCRYPTION_HEADER_SIZE) == 1 &&
+        EVP_DecryptUpdate(
+            ctx.get(), result.data(), &len, ciphertext, ciphertext_size) ==
+            1 &&
+        EVP_CIPHER_CTX_ctrl(
+            ctx.get(),
+            EVP_CTRL_GCM_SET_TAG,
+            ENCRYPTION_TAG_SIZE,
+            const_cast<uint8_t*>(ciphertext + ciphertext_size)) == 1 &&
+        EVP_DecryptFinal_ex(ctx.get(), result.data() + len, &len) == 1;
+    if (!ok) {
+        PanicInfo(DataFormatBroken,
+                  "failed to decrypt data of collection {}, corrupted or "
+                  "tampered",
+                  collection_id);
+    }
+    return result;
+}
+
+}  // namespace milvus::storage
@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <cstdint>
+#include <map>
+#include <memory>
+#include <shared_mutex>
+#include <string>
+#include <unordered_map>
+#include <vector>
+
+namespace milvus::storage {
+
+// The encrypted objects are the AES-256-GCM ciphertext of the plain ones prefixed by the header,
+// | magic "MENC" | format version, 1 byte | collection ID, 8 bytes | key version, 8 bytes | nonce, 12 bytes |,
+// the integers are little endian and the header is authenticated as the additional data.
+// It is the same format as the encrypted chunk manager of the go side, which pushes the data keys here.
+constexpr char ENCRYPTION_MAGIC[] = "MENC";
+constexpr size_t ENCRYPTION_MAGIC_SIZE = 4;
+constexpr uint8_t ENCRYPTION_FORMAT_VERSION = 1;
+constexpr size_t ENCRYPTION_NONCE_SIZE = 12;
+constexpr size_t ENCRYPTION_HEADER_SIZE =
+    ENCRYPTION_MAGIC_SIZE + 1 + 8 + 8 + ENCRYPTION_NONCE_SIZE;
+constexpr size_t ENCRYPTION_TAG_SIZE = 16;
+constexpr size_t DATA_KEY_SIZE = 32;
+
+bool
+IsEncrypted(const uint8_t* data, int64_t size);
+
+// DataKeyRing holds the data keys of the collections pushed by the go side,
+// the current key of a collection encrypts the files written, which is absent if the encryption disabled.
+class DataKeyRing {
+ private:
+    DataKeyRing() {
+    }
+
+ public:
+    DataKeyRing(const DataKeyRing&) = delete;
+    DataKeyRing&
+    operator=(const DataKeyRing&) = delete;
+
+    static DataKeyRing&
+    GetInstance() {
+        static DataKeyRing instance;
+        return instance;
+    }
+
+    void
+    SetKey(int64_t collection_id,
+           int64_t version,
+           std::string key,
+           bool current);
+
+    // Encrypt encrypts the data by the current key of the collection,
+    // returns the data as is if there is no current key.
+    std::vector<uint8_t>
+    Encrypt(int64_t collection_id, const uint8_t* data, int64_t size);
+
+    // Decrypt decrypts the encrypted object, the key of the version in the header must be set.
+    std::vector<uint8_t>
+    Decrypt(const uint8_t* data, int64_t size);
+
+ private:
+    struct CollectionKeys {
+        int64_t current = 0;
+        std::map<int64_t, std::string> keys;
+    };
+
+    std::shared_mutex mutex_;
+    std::unordered_map<int64_t, CollectionKeys> collections_;
+};
+
+}  // namespace milvus::storage
@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "storage/ReadCache.h"
+
+#include <algorithm>
+#include <filesystem>
+#include <fstream>
+#include <random>
+#include <unistd.h>
+
+#include <openssl/evp.h>
+
+#include "log/Log.h"
+
+namespace milvus::storage {
+
+namespace {
+
+constexpr int READ_CACHE_VIRTUAL_NODES = 64;
+constexpr char READ_CACHE_TEMP_INFIX[] = ".tmp.";
+
+std::vector<uint8_t>
+Sha256(const std::string& data) {
+    std::vector<uint8_t> digest(EVP_MAX_MD_SIZE);
+    unsigned int len = 0;
+    EVP_Digest(data.data(),
+               data.size(),
+               digest.data(),
+               &len,
+               EVP_sha256(),
+               nullptr);
+    digest.resize(len);
+    return digest;
+}
+
+uint64_t
+RingPoint(const std::vector<uint8_t>& digest) {
+    uint64_t point = 0;
+    for (int i = 0; i < 8; i++) {
+        point = (point << 8) | digest[i];
+    }
+    return point;
+}
+
+}  // namespace
+
+void
+ReadCache::Init(std::vector<std::string> dirs, std::string bucket_name) {
+    ring_.clear();
+    for (size_t i = 0; i < dirs.size(); i++) {
+        for (int v = 0; v < READ_CACHE_VIRTUAL_NODES; v++) {
+            ring_.emplace_back(
+                RingPoint(Sha256(dirs[i] + "#" + std::to_string(v))), i);
+        }
+    }
+    std::sort(ring_.begin(), ring_.end());
+    dirs_ = std::move(dirs);
+    bucket_name_ = std::move(bucket_name);
+}
+
+std::string
+ReadCache::FilePath(const std::string& key) const {
+    auto digest = Sha256(bucket_name_ + "/" + key);
+    static const char hex[] = "0123456789abcdef";
+    std::string name;
+    name.reserve(digest.size() * 2);
+    for (auto b : digest) {
+        name.push_back(hex[b >> 4]);
+        name.push_back(hex[b & 0xf]);
+    }
+
+    auto point = RingPoint(digest);
+    auto it = std::lower_bound(
+        ring_.begin(), ring_.end(), std::make_pair(point, size_t(0)));
+    if (it == ring_.end()) {
+        it = ring_.begin();
+    }
+    return (std::filesystem::path(dirs_[it->second]) / name.substr(0, 2) /
+            name)
+        .string();
+}
+
+bool
+ReadCache::Get(const std::string& key,
+               std::shared_ptr<uint8_t[]>& buf,
+               int64_t& size) {
+    auto file = FilePath(key);
+    std::ifstream in(file, std::ios::binary | std::ios::ate);
+    if (!in.is_open()) {
+        return false;
+    }
+    size = in.tellg();
+    in.seekg(0);
+    buf = std::shared_ptr<uint8_t[]>(new uint8_t[size]);
+    if (!in.read(reinterpret_cast<char*>(buf.get()), size)) {
+        return false;
+    }
+    std::error_code ec;
+    std::filesystem::last_write_time(
+        file, std::filesystem::file_time_type::clock::now(), ec);
+    return true;
+}
+
+void
+ReadCache::Put(const std::string& key, const uint8_t* data, int64_t size) {
+    auto file = FilePath(key);
+    std::error_code ec;
+    std::filesystem::create_directories(
+        std::filesystem::path(file).parent_path(), ec);
+    if (ec) {
+        LOG_DEBUG("failed to create read cache dir of {}: {}",
+                  file,
+                  ec.message());
+        return;
+    }
+
+    thread_local std::mt19937_64 rng(std::random_device{}());
+    auto temp = file + READ_CACHE_TEMP_INFIX + std::to_string(getpid()) +
+                "." + std::to_string(rng() >> 1);
+    {
+        std::ofstream out(temp, std::ios::binary | std::ios::trunc);
+        if (!out.write(reinterpret_cast<const char*>(data), size)) {
+            LOG_DEBUG("failed to write read cache {}", file);
+            std::filesystem::remove(temp, ec);
+            return;
+        }
+    }
+    std::filesystem::rename(temp, file, ec);
+    if (ec) {
+        LOG_DEBUG("failed to write read cache {}: {}", file, ec.message());
+        std::filesystem::remove(temp, ec);
+    }
+}
+
+}  // namespace milvus::storage
@@ -0,0 +1,71 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <cstdint>
+#include <memory>
+#include <string>
+#include <vector>
+
+namespace milvus::storage {
+
+// ReadCache keeps the binlogs and index files read from the object storage on the local disks,
+// shared with the go side of the processes on the same host configured with the same dirs.
+// The object is cached at {dir}/{hex[:2]}/{hex}, hex is the sha256 of {bucket}/{key},
+// the dir is chosen by the consistent hashing of the digest. It's evicted by the go side.
+class ReadCache {
+ private:
+    ReadCache() {
+    }
+
+ public:
+    ReadCache(const ReadCache&) = delete;
+    ReadCache&
+    operator=(const ReadCache&) = delete;
+
+    static ReadCache&
+    GetInstance() {
+        static ReadCache instance;
+        return instance;
+    }
+
+    void
+    Init(std::vector<std::string> dirs, std::string bucket_name);
+
+    bool
+    Enabled() const {
+        return !dirs_.empty();
+    }
+
+    // Get reads the cached object, returns false on miss.
+    bool
+    Get(const std::string& key, std::shared_ptr<uint8_t[]>& buf, int64_t& size);
+
+    // Put caches the object, the failures are ignored as the cache is best effort.
+    void
+    Put(const std::string& key, const uint8_t* data, int64_t size);
+
+ private:
+    std::string
+    FilePath(const std::string& key) const;
+
+    std::vector<std::string> dirs_;
+    std::string bucket_name_;
+    std::vector<std::pair<uint64_t, size_t>> ring_;
+};
+
+}  // namespace milvus::storage
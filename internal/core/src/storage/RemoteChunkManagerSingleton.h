@@ -19,6 +19,8 @@
 #include <memory>
 #include <shared_mutex>
 
+#include "storage/RequestBudget.h"
+#include "storage/RoutingChunkManager.h"
 #include "storage/Util.h"
 
 namespace milvus::storage {
@@ -42,10 +44,24 @@ class RemoteChunkManagerSingleton {
     void
     Init(const StorageConfig& storage_config) {
         if (rcm_ == nullptr) {
-            rcm_ = CreateChunkManager(storage_config);
+            rcm_ = std::make_shared<RoutingChunkManager>(
+                CreateBudgetedChunkManager(storage_config));
         }
     }
 
+    // SetCollectionStorage routes the files of the collection to its own bucket.
+    void
+    SetCollectionStorage(int64_t collection_id,
+                         const StorageConfig& storage_config) {
+        rcm_->SetCollectionStorage(
+            collection_id, CreateBudgetedChunkManager(storage_config));
+    }
+
+    void
+    RemoveCollectionStorage(int64_t collection_id) {
+        rcm_->RemoveCollectionStorage(collection_id);
+    }
+
     void
     Release() {
     }
@@ -55,8 +71,26 @@ class RemoteChunkManagerSingleton {
         return rcm_;
     }
 
+    // GetRemoteChunkManager returns the chunk manager of the collection,
+    // for the files whose paths don't carry the collection ID.
+    ChunkManagerPtr
+    GetRemoteChunkManager(int64_t collection_id) {
+        return rcm_->ForCollection(collection_id);
+    }
+
  private:
-    ChunkManagerPtr rcm_ = nullptr;
+    // the requests to the object storage are made within the request budget
+    // of the process
+    static ChunkManagerPtr
+    CreateBudgetedChunkManager(const StorageConfig& storage_config) {
+        auto cm = CreateChunkManager(storage_config);
+        if (storage_config.storage_type == "local") {
+            return cm;
+        }
+        return std::make_shared<BudgetedChunkManager>(cm);
+    }
+
+    std::shared_ptr<RoutingChunkManager> rcm_ = nullptr;
 };
 
 }  // namespace milvus::storage
@@ -0,0 +1,125 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "storage/RequestBudget.h"
+
+#include <atomic>
+#include <type_traits>
+
+namespace milvus::storage {
+
+namespace {
+
+// the op labels of the Go side
+const char* OP_GET = "get";
+const char* OP_PUT = "put";
+const char* OP_STAT = "stat";
+const char* OP_LIST = "list";
+const char* OP_REMOVE = "remove";
+
+std::atomic<CAcquireRequestBudgetFunc> acquire_func{nullptr};
+std::atomic<CReleaseRequestBudgetFunc> release_func{nullptr};
+
+// WithBudget runs the request within the budget, it fails if it throws.
+template <typename Func>
+auto
+WithBudget(const char* op, Func&& func) -> decltype(func()) {
+    RequestBudgetGuard guard(op);
+    if constexpr (std::is_void_v<decltype(func())>) {
+        func();
+        guard.Succeed();
+    } else {
+        auto result = func();
+        guard.Succeed();
+        return result;
+    }
+}
+
+}  // namespace
+
+void
+SetRequestBudgetFuncs(CAcquireRequestBudgetFunc acquire,
+                      CReleaseRequestBudgetFunc release) {
+    release_func.store(release);
+    acquire_func.store(acquire);
+}
+
+RequestBudgetGuard::RequestBudgetGuard(const char* op) {
+    auto acquire = acquire_func.load();
+    if (acquire != nullptr) {
+        handle_ = acquire(op);
+    }
+}
+
+RequestBudgetGuard::~RequestBudgetGuard() {
+    auto release = release_func.load();
+    if (handle_ != 0 && release != nullptr) {
+        release(handle_, failed_);
+    }
+}
+
+bool
+BudgetedChunkManager::Exist(const std::string& filepath) {
+    return WithBudget(OP_STAT, [&] { return cm_->Exist(filepath); });
+}
+
+uint64_t
+BudgetedChunkManager::Size(const std::string& filepath) {
+    return WithBudget(OP_STAT, [&] { return cm_->Size(filepath); });
+}
+
+uint64_t
+BudgetedChunkManager::Read(const std::string& filepath,
+                           void* buf,
+                           uint64_t len) {
+    return WithBudget(OP_GET, [&] { return cm_->Read(filepath, buf, len); });
+}
+
+void
+BudgetedChunkManager::Write(const std::string& filepath,
+                            void* buf,
+                            uint64_t len) {
+    WithBudget(OP_PUT, [&] { cm_->Write(filepath, buf, len); });
+}
+
+uint64_t
+BudgetedChunkManager::Read(const std::string& filepath,
+                           uint64_t offset,
+                           void* buf,
+                           uint64_t len) {
+    return WithBudget(OP_GET,
+                      [&] { return cm_->Read(filepath, offset, buf, len); });
+}
+
+void
+BudgetedChunkManager::Write(const std::string& filepath,
+                            uint64_t offset,
+                            void* buf,
+                            uint64_t len) {
+    WithBudget(OP_PUT, [&] { cm_->Write(filepath, offset, buf, len); });
+}
+
+std::vector<std::string>
+BudgetedChunkManager::ListWithPrefix(const std::string& filepath) {
+    return WithBudget(OP_LIST, [&] { return cm_->ListWithPrefix(filepath); });
+}
+
+void
+BudgetedChunkManager::Remove(const std::string& filepath) {
+    WithBudget(OP_REMOVE, [&] { cm_->Remove(filepath); });
+}
+
+}  // namespace milvus::storage
@@ -0,0 +1,107 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <string>
+#include <vector>
+
+#include "storage/ChunkManager.h"
+#include "storage/storage_c.h"
+
+namespace milvus::storage {
+
+// SetRequestBudgetFuncs sets the callbacks to the request budget of the
+// process, so that the requests of segcore share the budget with the ones of
+// the Go side.
+void
+SetRequestBudgetFuncs(CAcquireRequestBudgetFunc acquire,
+                      CReleaseRequestBudgetFunc release);
+
+// RequestBudgetGuard holds the request budget during the request,
+// the request is reported failed unless Succeed is called.
+class RequestBudgetGuard {
+ public:
+    explicit RequestBudgetGuard(const char* op);
+
+    ~RequestBudgetGuard();
+
+    RequestBudgetGuard(const RequestBudgetGuard&) = delete;
+    RequestBudgetGuard&
+    operator=(const RequestBudgetGuard&) = delete;
+
+    void
+    Succeed() {
+        failed_ = false;
+    }
+
+ private:
+    int64_t handle_ = 0;
+    bool failed_ = true;
+};
+
+// BudgetedChunkManager makes the requests of the remote chunk manager within
+// the request budget.
+class BudgetedChunkManager : public ChunkManager {
+ public:
+    explicit BudgetedChunkManager(ChunkManagerPtr cm) : cm_(std::move(cm)) {
+    }
+
+    bool
+    Exist(const std::string& filepath) override;
+
+    uint64_t
+    Size(const std::string& filepath) override;
+
+    uint64_t
+    Read(const std::string& filepath, void* buf, uint64_t len) override;
+
+    void
+    Write(const std::string& filepath, void* buf, uint64_t len) override;
+
+    uint64_t
+    Read(const std::string& filepath,
+         uint64_t offset,
+         void* buf,
+         uint64_t len) override;
+
+    void
+    Write(const std::string& filepath,
+          uint64_t offset,
+          void* buf,
+          uint64_t len) override;
+
+    std::vector<std::string>
+    ListWithPrefix(const std::string& filepath) override;
+
+    void
+    Remove(const std::string& filepath) override;
+
+    std::string
+    GetName() const override {
+        return cm_->GetName();
+    }
+
+    std::string
+    GetRootPath() const override {
+        return cm_->GetRootPath();
+    }
+
+ private:
+    ChunkManagerPtr cm_;
+};
+
+}  // namespace milvus::storage
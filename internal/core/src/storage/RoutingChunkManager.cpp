@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include "storage/RoutingChunkManager.h"
+
+#include <mutex>
+
+namespace milvus::storage {
+
+namespace {
+
+// the binlogs are under <root>/<log>/<collection ID>/...
+const char* COLLECTION_LOG_PATHS[] = {"insert_log", "delta_log", "stats_log"};
+
+std::string
+RebaseRootPath(const std::string& filepath,
+               const std::string& from,
+               const std::string& to) {
+    if (from.empty()) {
+        return to.empty() ? filepath : to + "/" + filepath;
+    }
+    if (filepath == from) {
+        return to;
+    }
+    if (filepath.compare(0, from.size(), from) != 0 ||
+        filepath[from.size()] != '/') {
+        return filepath;
+    }
+    auto rest = filepath.substr(from.size() + 1);
+    return to.empty() ? rest : to + "/" + rest;
+}
+
+}  // namespace
+
+std::string
+CollectionChunkManager::Physical(const std::string& filepath) const {
+    return RebaseRootPath(filepath, root_path_, cm_->GetRootPath());
+}
+
+std::vector<std::string>
+CollectionChunkManager::ListWithPrefix(const std::string& filepath) {
+    auto files = cm_->ListWithPrefix(Physical(filepath));
+    for (auto& file : files) {
+        file = RebaseRootPath(file, cm_->GetRootPath(), root_path_);
+    }
+    return files;
+}
+
+void
+RoutingChunkManager::SetCollectionStorage(int64_t collection_id,
+                                          ChunkManagerPtr cm) {
+    auto collection_cm =
+        std::make_shared<CollectionChunkManager>(std::move(cm), GetRootPath());
+    std::unique_lock lck(mutex_);
+    collections_[collection_id] = std::move(collection_cm);
+}
+
+void
+RoutingChunkManager::RemoveCollectionStorage(int64_t collection_id) {
+    std::unique_lock lck(mutex_);
+    collections_.erase(collection_id);
+}
+
+ChunkManagerPtr
+RoutingChunkManager::ForCollection(int64_t collection_id) {
+    std::shared_lock lck(mutex_);
+    auto it = collections_.find(collection_id);
+    if (it == collections_.end()) {
+        return cm_;
+    }
+    return it->second;
+}
+
+ChunkManagerPtr
+RoutingChunkManager::Route(const std::string& filepath) {
+    auto collection_id = CollectionOf(filepath);
+    if (!collection_id.has_value()) {
+        return cm_;
+    }
+    return ForCollection(collection_id.value());
+}
+
+std::optional<int64_t>
+RoutingChunkManager::CollectionOf(const std::string& filepath) const {
+    auto root_path = GetRootPath();
+    auto rest = RebaseRootPath(filepath, root_path, "");
+    if (!root_path.empty() && rest == filepath) {
+        return std::nullopt;
+    }
+    for (auto log_path : COLLECTION_LOG_PATHS) {
+        auto prefix = std::string(log_path) + "/";
+        if (rest.compare(0, prefix.size(), prefix) != 0) {
+            continue;
+        }
+        auto end = rest.find('/', prefix.size());
+        auto id = rest.substr(prefix.size(),
+                              end == std::string::npos
+                                  ? std::string::npos
+                                  : end - prefix.size());
+        try {
+            size_t pos = 0;
+            auto collection_id = std::stoll(id, &pos);
+            if (pos == id.size()) {
+                return collection_id;
+            }
+        } catch (std::exception&) {
+        }
+        return std::nullopt;
+    }
+    return std::nullopt;
+}
+
+}  // namespace milvus::storage
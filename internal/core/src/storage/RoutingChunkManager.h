@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#pragma once
+
+#include <optional>
+#include <shared_mutex>
+#include <string>
+#include <unordered_map>
+#include <vector>
+
+#include "storage/ChunkManager.h"
+
+namespace milvus::storage {
+
+// CollectionChunkManager accesses the files of a collection in its own bucket,
+// the paths under the root path of the cluster are rebased onto the root path of the collection bucket.
+class CollectionChunkManager : public ChunkManager {
+ public:
+    CollectionChunkManager(ChunkManagerPtr cm, std::string root_path)
+        : cm_(std::move(cm)), root_path_(std::move(root_path)) {
+    }
+
+    bool
+    Exist(const std::string& filepath) override {
+        return cm_->Exist(Physical(filepath));
+    }
+
+    uint64_t
+    Size(const std::string& filepath) override {
+        return cm_->Size(Physical(filepath));
+    }
+
+    uint64_t
+    Read(const std::string& filepath, void* buf, uint64_t len) override {
+        return cm_->Read(Physical(filepath), buf, len);
+    }
+
+    void
+    Write(const std::string& filepath, void* buf, uint64_t len) override {
+        cm_->Write(Physical(filepath), buf, len);
+    }
+
+    uint64_t
+    Read(const std::string& filepath,
+         uint64_t offset,
+         void* buf,
+         uint64_t len) override {
+        return cm_->Read(Physical(filepath), offset, buf, len);
+    }
+
+    void
+    Write(const std::string& filepath,
+          uint64_t offset,
+          void* buf,
+          uint64_t len) override {
+        cm_->Write(Physical(filepath), offset, buf, len);
+    }
+
+    std::vector<std::string>
+    ListWithPrefix(const std::string& filepath) override;
+
+    void
+    Remove(const std::string& filepath) override {
+        cm_->Remove(Physical(filepath));
+    }
+
+    std::string
+    GetName() const override {
+        return cm_->GetName();
+    }
+
+    std::string
+    GetRootPath() const override {
+        return root_path_;
+    }
+
+ private:
+    std::string
+    Physical(const std::string& filepath) const;
+
+    ChunkManagerPtr cm_;
+    // the root path of the cluster
+    std::string root_path_;
+};
+
+// RoutingChunkManager routes the binlogs of the collections which bring their own buckets
+// by the collection ID in the paths, the others go to the bucket of the cluster.
+class RoutingChunkManager : public ChunkManager {
+ public:
+    explicit RoutingChunkManager(ChunkManagerPtr cm) : cm_(std::move(cm)) {
+    }
+
+    bool
+    Exist(const std::string& filepath) override {
+        return Route(filepath)->Exist(filepath);
+    }
+
+    uint64_t
+    Size(const std::string& filepath) override {
+        return Route(filepath)->Size(filepath);
+    }
+
+    uint64_t
+    Read(const std::string& filepath, void* buf, uint64_t len) override {
+        return Route(filepath)->Read(filepath, buf, len);
+    }
+
+    void
+    Write(const std::string& filepath, void* buf, uint64_t len) override {
+        Route(filepath)->Write(filepath, buf, len);
+    }
+
+    uint64_t
+    Read(const std::string& filepath,
+         uint64_t offset,
+         void* buf,
+         uint64_t len) override {
+        return Route(filepath)->Read(filepath, offset, buf, len);
+    }
+
+    void
+    Write(const std::string& filepath,
+          uint64_t offset,
+          void* buf,
+          uint64_t len) override {
+        Route(filepath)->Write(filepath, offset, buf, len);
+    }
+
+    std::vector<std::string>
+    ListWithPrefix(const std::string& filepath) override {
+        return Route(filepath)->ListWithPrefix(filepath);
+    }
+
+    void
+    Remove(const std::string& filepath) override {
+        Route(filepath)->Remove(filepath);
+    }
+
+    std::string
+    GetName() const override {
+        return cm_->GetName();
+    }
+
+    std::string
+    GetRootPath() const override {
+        return cm_->GetRootPath();
+    }
+
+    // SetCollectionStorage makes the files of the collection accessed by the chunk manager,
+    // whose root path is the one of the collection bucket.
+    void
+    SetCollectionStorage(int64_t collection_id, ChunkManagerPtr cm);
+
+    void
+    RemoveCollectionStorage(int64_t collection_id);
+
+    // ForCollection returns the chunk manager of the collection,
+    // which accesses the files not carrying the collection ID in the paths, like the index files.
+    ChunkManagerPtr
+    ForCollection(int64_t collection_id);
+
+ private:
+    ChunkManagerPtr
+    Route(const std::string& filepath);
+
+    std::optional<int64_t>
+    CollectionOf(const std::string& filepath) const;
+
+    ChunkManagerPtr cm_;
+    std::shared_mutex mutex_;
+    std::unordered_map<int64_t, ChunkManagerPtr> collections_;
+};
+
+}  // namespace milvus::storage
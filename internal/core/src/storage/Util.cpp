@@ -15,6 +15,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+#include <cstring>
 #include <memory>
 
 #include "arrow/array/builder_binary.h"
@@ -31,10 +32,12 @@
 #endif
 #include "storage/ChunkManager.h"
 #include "storage/DiskFileManagerImpl.h"
+#include "storage/Encryption.h"
 #include "storage/InsertData.h"
 #include "storage/LocalChunkManager.h"
 #include "storage/MemFileManagerImpl.h"
 #include "storage/MinioChunkManager.h"
+#include "storage/ReadCache.h"
 #ifdef USE_OPENDAL
 #include "storage/OpenDALChunkManager.h"
 #endif
@@ -451,10 +454,27 @@ GetSegmentRawDataPathPrefix(ChunkManagerPtr cm, int64_t segment_id) {
 std::unique_ptr<DataCodec>
 DownloadAndDecodeRemoteFile(ChunkManager* chunk_manager,
                             const std::string& file) {
-    auto fileSize = chunk_manager->Size(file);
-    auto buf = std::shared_ptr<uint8_t[]>(new uint8_t[fileSize]);
-    chunk_manager->Read(file, buf.get(), fileSize);
+    auto& cache = ReadCache::GetInstance();
+    auto cacheable =
+        cache.Enabled() && chunk_manager->GetName() != "LocalChunkManager";
+    std::shared_ptr<uint8_t[]> buf;
+    int64_t fileSize = 0;
+    if (!cacheable || !cache.Get(file, buf, fileSize)) {
+        fileSize = chunk_manager->Size(file);
+        buf = std::shared_ptr<uint8_t[]>(new uint8_t[fileSize]);
+        chunk_manager->Read(file, buf.get(), fileSize);
+        if (cacheable) {
+            cache.Put(file, buf.get(), fileSize);
+        }
+    }
 
+    if (IsEncrypted(buf.get(), fileSize)) {
+        auto plaintext =
+            DataKeyRing::GetInstance().Decrypt(buf.get(), fileSize);
+        fileSize = plaintext.size();
+        buf = std::shared_ptr<uint8_t[]>(new uint8_t[fileSize]);
+        std::memcpy(buf.get(), plaintext.data(), fileSize);
+    }
     return DeserializeFileData(buf, fileSize);
 }
 
@@ -487,6 +507,10 @@ EncodeAndUploadIndexSlice(ChunkManager* chunk_manager,
     indexData->set_index_meta(index_meta);
     indexData->SetFieldDataMeta(field_meta);
     auto serialized_index_data = indexData->serialize_to_remote_file();
+    serialized_index_data =
+        DataKeyRing::GetInstance().Encrypt(field_meta.collection_id,
+                                           serialized_index_data.data(),
+                                           serialized_index_data.size());
     auto serialized_index_size = serialized_index_data.size();
     chunk_manager->Write(
         object_key, serialized_index_data.data(), serialized_index_size);
@@ -526,6 +550,10 @@ EncodeAndUploadFieldSlice(ChunkManager* chunk_manager,
     auto insertData = std::make_shared<InsertData>(field_data);
     insertData->SetFieldDataMeta(field_data_meta);
     auto serialized_inserted_data = insertData->serialize_to_remote_file();
+    serialized_inserted_data =
+        DataKeyRing::GetInstance().Encrypt(field_data_meta.collection_id,
+                                           serialized_inserted_data.data(),
+                                           serialized_inserted_data.size());
     auto serialized_inserted_data_size = serialized_inserted_data.size();
     chunk_manager->Write(object_key,
                          serialized_inserted_data.data(),
@@ -14,11 +14,15 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+#include <sstream>
+
 #include "storage/storage_c.h"
 #include "storage/prometheus_client.h"
 #include "storage/RemoteChunkManagerSingleton.h"
 #include "storage/LocalChunkManagerSingleton.h"
 #include "storage/ChunkCacheSingleton.h"
+#include "storage/Encryption.h"
+#include "storage/ReadCache.h"
 
 CStatus
 GetLocalUsedSize(const char* c_dir, int64_t* size) {
@@ -50,34 +54,43 @@ InitLocalChunkManagerSingleton(const char* c_path) {
     }
 }
 
+namespace {
+
+milvus::storage::StorageConfig
+ToStorageConfig(const CStorageConfig& c_storage_config) {
+    milvus::storage::StorageConfig storage_config;
+    storage_config.address = std::string(c_storage_config.address);
+    storage_config.bucket_name = std::string(c_storage_config.bucket_name);
+    storage_config.access_key_id =
+        std::string(c_storage_config.access_key_id);
+    storage_config.access_key_value =
+        std::string(c_storage_config.access_key_value);
+    storage_config.root_path = std::string(c_storage_config.root_path);
+    storage_config.storage_type =
+        std::string(c_storage_config.storage_type);
+    storage_config.cloud_provider =
+        std::string(c_storage_config.cloud_provider);
+    storage_config.iam_endpoint =
+        std::string(c_storage_config.iam_endpoint);
+    storage_config.cloud_provider =
+        std::string(c_storage_config.cloud_provider);
+    storage_config.log_level = std::string(c_storage_config.log_level);
+    storage_config.useSSL = c_storage_config.useSSL;
+    storage_config.sslCACert = std::string(c_storage_config.sslCACert);
+    storage_config.useIAM = c_storage_config.useIAM;
+    storage_config.useVirtualHost = c_storage_config.useVirtualHost;
+    storage_config.region = c_storage_config.region;
+    storage_config.requestTimeoutMs = c_storage_config.requestTimeoutMs;
+    return storage_config;
+}
+
+}  // namespace
+
 CStatus
 InitRemoteChunkManagerSingleton(CStorageConfig c_storage_config) {
     try {
-        milvus::storage::StorageConfig storage_config;
-        storage_config.address = std::string(c_storage_config.address);
-        storage_config.bucket_name = std::string(c_storage_config.bucket_name);
-        storage_config.access_key_id =
-            std::string(c_storage_config.access_key_id);
-        storage_config.access_key_value =
-            std::string(c_storage_config.access_key_value);
-        storage_config.root_path = std::string(c_storage_config.root_path);
-        storage_config.storage_type =
-            std::string(c_storage_config.storage_type);
-        storage_config.cloud_provider =
-            std::string(c_storage_config.cloud_provider);
-        storage_config.iam_endpoint =
-            std::string(c_storage_config.iam_endpoint);
-        storage_config.cloud_provider =
-            std::string(c_storage_config.cloud_provider);
-        storage_config.log_level = std::string(c_storage_config.log_level);
-        storage_config.useSSL = c_storage_config.useSSL;
-        storage_config.sslCACert = std::string(c_storage_config.sslCACert);
-        storage_config.useIAM = c_storage_config.useIAM;
-        storage_config.useVirtualHost = c_storage_config.useVirtualHost;
-        storage_config.region = c_storage_config.region;
-        storage_config.requestTimeoutMs = c_storage_config.requestTimeoutMs;
         milvus::storage::RemoteChunkManagerSingleton::GetInstance().Init(
-            storage_config);
+            ToStorageConfig(c_storage_config));
 
         return milvus::SuccessCStatus();
     } catch (std::exception& e) {
@@ -101,6 +114,61 @@ CleanRemoteChunkManagerSingleton() {
     milvus::storage::RemoteChunkManagerSingleton::GetInstance().Release();
 }
 
+CStatus
+InitReadCacheSingleton(const char* c_dirs, const char* c_bucket_name) {
+    try {
+        std::vector<std::string> dirs;
+        std::stringstream ss(c_dirs);
+        std::string dir;
+        while (std::getline(ss, dir, ',')) {
+            if (!dir.empty()) {
+                dirs.push_back(dir);
+            }
+        }
+        milvus::storage::ReadCache::GetInstance().Init(std::move(dirs),
+                                                       c_bucket_name);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(&e);
+    }
+}
+
+CStatus
+SetCollectionDataKey(int64_t collection_id,
+                     int64_t version,
+                     const uint8_t* key,
+                     int64_t key_size,
+                     bool current) {
+    try {
+        milvus::storage::DataKeyRing::GetInstance().SetKey(
+            collection_id,
+            version,
+            std::string(reinterpret_cast<const char*>(key), key_size),
+            current);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(&e);
+    }
+}
+
+CStatus
+SetCollectionStorage(int64_t collection_id, CStorageConfig c_storage_config) {
+    try {
+        milvus::storage::RemoteChunkManagerSingleton::GetInstance()
+            .SetCollectionStorage(collection_id,
+                                  ToStorageConfig(c_storage_config));
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(&e);
+    }
+}
+
+void
+RemoveCollectionStorage(int64_t collection_id) {
+    milvus::storage::RemoteChunkManagerSingleton::GetInstance()
+        .RemoveCollectionStorage(collection_id);
+}
+
 char*
 GetStorageMetrics() {
     auto str = milvus::storage::prometheusClient->GetMetrics();
@@ -110,3 +178,9 @@ GetStorageMetrics() {
     res[len] = '\0';
     return res;
 }
+
+void
+SetStorageRequestBudget(CAcquireRequestBudgetFunc acquire,
+                        CReleaseRequestBudgetFunc release) {
+    milvus::storage::SetRequestBudgetFuncs(acquire, release);
+}
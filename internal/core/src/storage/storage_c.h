@@ -21,6 +21,12 @@ extern "C" {
 
 #include "common/type_c.h"
 
+// acquires the request budget of the op,
+// returns the handle to release it, 0 if not acquired
+typedef int64_t (*CAcquireRequestBudgetFunc)(const char* op);
+// releases the request budget acquired with the result of the request
+typedef void (*CReleaseRequestBudgetFunc)(int64_t handle, bool failed);
+
 CStatus
 GetLocalUsedSize(const char* c_path, int64_t* size);
 
@@ -36,9 +42,29 @@ InitChunkCacheSingleton(const char* c_dir_path, const char* read_ahead_policy);
 void
 CleanRemoteChunkManagerSingleton();
 
+CStatus
+InitReadCacheSingleton(const char* c_dirs, const char* c_bucket_name);
+
+CStatus
+SetCollectionDataKey(int64_t collection_id,
+                     int64_t version,
+                     const uint8_t* key,
+                     int64_t key_size,
+                     bool current);
+
+CStatus
+SetCollectionStorage(int64_t collection_id, CStorageConfig c_storage_config);
+
+void
+RemoveCollectionStorage(int64_t collection_id);
+
 char*
 GetStorageMetrics();
 
+void
+SetStorageRequestBudget(CAcquireRequestBudgetFunc acquire,
+                        CReleaseRequestBudgetFunc release);
+
 #ifdef __cplusplus
 };
 #endif
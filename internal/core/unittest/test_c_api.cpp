@@ -3821,6 +3821,32 @@ TEST(CApiTest, SealedSegmentTest) {
     DeleteSegment(segment);
 }
 
+TEST(CApiTest, SealedSegmentLoadDefaultDataTest) {
+    auto collection = NewCollection(get_default_schema_config());
+    CSegmentInterface segment;
+    auto status = NewSegment(collection, Sealed, -1, &segment);
+    ASSERT_EQ(status.error_code, Success);
+
+    int N = 1000;
+    milvus::proto::schema::ValueField default_value;
+    default_value.set_long_data(7);
+    auto blob = default_value.SerializeAsString();
+    auto res =
+        LoadFieldDefaultData(segment, 101, blob.data(), blob.size(), N);
+    ASSERT_EQ(res.error_code, Success);
+    auto count = GetRowCount(segment);
+    ASSERT_EQ(count, N);
+
+    // invalid default value blob
+    std::string invalid_blob = "invalid";
+    res = LoadFieldDefaultData(
+        segment, 101, invalid_blob.data(), invalid_blob.size(), N);
+    ASSERT_NE(res.error_code, Success);
+
+    DeleteCollection(collection);
+    DeleteSegment(segment);
+}
+
 TEST(CApiTest, SealedSegment_search_float_Predicate_Range) {
     constexpr auto TOPK = 5;
 
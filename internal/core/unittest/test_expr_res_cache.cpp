@@ -0,0 +1,78 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+#include <gtest/gtest.h>
+
+#include "segcore/ExprResCache.h"
+
+using namespace milvus;
+using namespace milvus::segcore;
+
+namespace {
+BitsetType
+make_bitset(int64_t size, int64_t set_bit) {
+    BitsetType bitset(size, false);
+    bitset.set(set_bit);
+    return bitset;
+}
+}  // namespace
+
+TEST(ExprResCache, GetAndPut) {
+    ExprResCache cache(2);
+    ASSERT_FALSE(cache.Get("a", 0, 10).has_value());
+
+    cache.Put("a", 0, make_bitset(10, 1));
+    auto res = cache.Get("a", 0, 10);
+    ASSERT_TRUE(res.has_value());
+    ASSERT_TRUE(res.value()[1]);
+    ASSERT_EQ(res.value().count(), 1);
+
+    // the row count mismatched
+    ASSERT_FALSE(cache.Get("a", 0, 20).has_value());
+
+    // the least recently used one is evicted
+    cache.Put("b", 0, make_bitset(10, 2));
+    ASSERT_TRUE(cache.Get("a", 0, 10).has_value());
+    cache.Put("c", 0, make_bitset(10, 3));
+    ASSERT_EQ(cache.Size(), 2);
+    ASSERT_TRUE(cache.Get("a", 0, 10).has_value());
+    ASSERT_FALSE(cache.Get("b", 0, 10).has_value());
+    ASSERT_TRUE(cache.Get("c", 0, 10).has_value());
+}
+
+TEST(ExprResCache, DataVersion) {
+    ExprResCache cache(2);
+    cache.Put("a", 1, make_bitset(10, 1));
+    ASSERT_FALSE(cache.Get("a", 2, 10).has_value());
+
+    // the result of outdated version is never put
+    cache.Put("b", 0, make_bitset(10, 2));
+    ASSERT_FALSE(cache.Get("b", 0, 10).has_value());
+    ASSERT_FALSE(cache.Get("b", 1, 10).has_value());
+
+    // the results of outdated version are dropped once newer one put
+    cache.Put("b", 2, make_bitset(10, 2));
+    ASSERT_EQ(cache.Size(), 1);
+    ASSERT_FALSE(cache.Get("a", 2, 10).has_value());
+    ASSERT_TRUE(cache.Get("b", 2, 10).has_value());
+}
+
+TEST(ExprResCache, Disabled) {
+    ExprResCache cache(0);
+    cache.Put("a", 0, make_bitset(10, 1));
+    ASSERT_EQ(cache.Size(), 0);
+    ASSERT_FALSE(cache.Get("a", 0, 10).has_value());
+}
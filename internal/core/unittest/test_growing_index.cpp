@@ -209,6 +209,67 @@ TEST_P(GrowingIndexTest, Correctness) {
     }
 }
 
+TEST_P(GrowingIndexTest, AsyncBuild) {
+    // sparse index is always built in the insertion
+    if (is_sparse) {
+        return;
+    }
+    auto schema = std::make_shared<Schema>();
+    auto pk = schema->AddDebugField("pk", DataType::INT64);
+    auto vec = schema->AddDebugField("embeddings", data_type, 128, metric_type);
+    schema->set_primary_field_id(pk);
+
+    std::map<std::string, std::string> index_params = {
+        {"index_type", index_type},
+        {"metric_type", metric_type},
+        {"nlist", "128"}};
+    std::map<std::string, std::string> type_params = {{"dim", "128"}};
+    FieldIndexMeta fieldIndexMeta(
+        vec, std::move(index_params), std::move(type_params));
+    auto& config = SegcoreConfig::default_config();
+    config.set_chunk_rows(1024);
+    config.set_enable_interim_segment_index(true);
+    config.set_enable_interim_index_async_build(true);
+    std::map<FieldId, FieldIndexMeta> filedMap = {{vec, fieldIndexMeta}};
+    IndexMetaPtr metaPtr =
+        std::make_shared<CollectionIndexMeta>(226985, std::move(filedMap));
+    auto segment = CreateGrowingSegment(schema, metaPtr);
+    auto segmentImplPtr = dynamic_cast<SegmentGrowingImpl*>(segment.get());
+
+    int64_t per_batch = 10000;
+    auto insert = [&]() {
+        auto dataset = DataGen(schema, per_batch);
+        auto offset = segment->PreInsert(per_batch);
+        segment->Insert(offset,
+                        per_batch,
+                        dataset.row_ids_.data(),
+                        dataset.timestamps_.data(),
+                        dataset.raw_);
+    };
+    auto& indexing_record = segmentImplPtr->get_indexing_record();
+
+    // the index is not built in the insertion
+    for (int i = 0; i < 3; i++) {
+        insert();
+    }
+    EXPECT_FALSE(indexing_record.SyncDataWithIndex(vec));
+    auto field_data = segmentImplPtr->get_insert_record()
+                          .get_field_data<milvus::FloatVector>(vec);
+    EXPECT_EQ(field_data->num_chunk(),
+              upper_div(3 * per_batch, field_data->get_size_per_chunk()));
+
+    // all the inserted rows are indexed
+    segment->BuildInterimIndex();
+    EXPECT_TRUE(indexing_record.SyncDataWithIndex(vec));
+
+    // the following insertions are appended into the index
+    insert();
+    EXPECT_TRUE(indexing_record.SyncDataWithIndex(vec));
+    EXPECT_EQ(field_data->num_chunk(), 0);
+
+    config.set_enable_interim_index_async_build(false);
+}
+
 TEST_P(GrowingIndexTest, MissIndexMeta) {
     auto schema = std::make_shared<Schema>();
     auto pk = schema->AddDebugField("pk", DataType::INT64);
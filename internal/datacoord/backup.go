@@ -0,0 +1,222 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// the backups are under <root>/backup/<name>, the manifests under meta/<collection ID> and the binlogs copied under files
+	backupPrefix         = "backup"
+	backupManifestPrefix = "meta"
+	backupFilesPrefix    = "files"
+	backupSnapshotPrefix = "backup-"
+)
+
+func backupRootPath(rootPath, name string) string {
+	return path.Join(rootPath, backupPrefix, name)
+}
+
+func backupManifestPath(rootPath, name string, collectionID UniqueID) string {
+	return path.Join(backupRootPath(rootPath, name), backupManifestPrefix, strconv.FormatInt(collectionID, 10))
+}
+
+// backupSnapshotName returns the name of the snapshot keeping the binlogs referenced by the backup from gc.
+func backupSnapshotName(name string) string {
+	return backupSnapshotPrefix + name
+}
+
+// createBackup writes the manifests of the collections, all the collections are backed up if not specified.
+// The binlogs are referenced by the manifests and kept by the snapshots of the backup,
+// or copied into the backup if copyFiles, so that the backup survives the loss of the binlogs of the cluster.
+func (s *Server) createBackup(ctx context.Context, name string, collectionIDs []UniqueID, copyFiles bool) error {
+	if name == "" || strings.Contains(name, "/") {
+		return merr.WrapErrParameterInvalidMsg("invalid backup name %q", name)
+	}
+	s.backupLock.Lock()
+	defer s.backupLock.Unlock()
+
+	cm := s.meta.chunkManager
+	rootPath := backupRootPath(cm.RootPath(), name)
+	files, _, err := cm.ListWithPrefix(ctx, rootPath+"/", true)
+	if err != nil {
+		return err
+	}
+	if len(files) > 0 {
+		return merr.WrapErrParameterInvalidMsg("backup %s already exists", name)
+	}
+	if len(collectionIDs) == 0 {
+		collectionIDs, err = listCollectionIDs(ctx, s.broker)
+		if err != nil {
+			return err
+		}
+	}
+
+	backedUp := make([]UniqueID, 0, len(collectionIDs))
+	err = func() error {
+		for _, collectionID := range collectionIDs {
+			manifest, err := newCollectionManifest(ctx, s.meta, s.broker, s.allocator, collectionID, backupSnapshotName(name))
+			if err != nil {
+				return err
+			}
+			if copyFiles {
+				_, err := shipLogs(ctx, cm, cm, manifest.GetSnapshot(), path.Join(rootPath, backupFilesPrefix), typeutil.NewSet[string]())
+				if err != nil {
+					return err
+				}
+			}
+			bytes, err := proto.Marshal(manifest)
+			if err != nil {
+				return err
+			}
+			if err := cm.Write(ctx, backupManifestPath(cm.RootPath(), name, collectionID), bytes); err != nil {
+				return err
+			}
+			if err := s.meta.snapshotMeta.AddSnapshot(manifest.GetSnapshot()); err != nil {
+				return err
+			}
+			backedUp = append(backedUp, collectionID)
+		}
+		return nil
+	}()
+	if err != nil {
+		for _, collectionID := range backedUp {
+			if err := s.meta.snapshotMeta.DropSnapshot(collectionID, backupSnapshotName(name)); err != nil {
+				log.Ctx(ctx).Warn("failed to drop snapshot of backup", zap.String("backup", name), zap.Int64("collectionID", collectionID), zap.Error(err))
+			}
+		}
+		if err := cm.RemoveWithPrefix(ctx, rootPath+"/"); err != nil {
+			log.Ctx(ctx).Warn("failed to remove backup files", zap.String("backup", name), zap.Error(err))
+		}
+		return err
+	}
+	return nil
+}
+
+// dropBackup removes the manifests and the snapshots of the backup, the binlogs copied are removed
+// unless shared with the segments restored, which are recycled by gc once the segments dropped.
+func (s *Server) dropBackup(ctx context.Context, name string) error {
+	s.backupLock.Lock()
+	defer s.backupLock.Unlock()
+
+	cm := s.meta.chunkManager
+	manifests, err := readBackupManifests(ctx, cm, name)
+	if err != nil {
+		return err
+	}
+	if len(manifests) == 0 {
+		return merr.WrapErrParameterInvalidMsg("backup %s not found", name)
+	}
+	for collectionID := range manifests {
+		if s.meta.snapshotMeta.GetSnapshot(collectionID, backupSnapshotName(name)) == nil {
+			continue
+		}
+		if err := s.meta.snapshotMeta.DropSnapshot(collectionID, backupSnapshotName(name)); err != nil {
+			return err
+		}
+	}
+
+	inUse := typeutil.NewSet[string]()
+	for _, segment := range s.meta.SelectSegments(func(segment *SegmentInfo) bool { return true }) {
+		for _, l := range getLogs(segment) {
+			inUse.Insert(l.GetLogPath())
+		}
+	}
+	files, _, err := cm.ListWithPrefix(ctx, backupRootPath(cm.RootPath(), name)+"/", true)
+	if err != nil {
+		return err
+	}
+	removed := make([]string, 0, len(files))
+	for _, file := range files {
+		if !inUse.Contain(file) {
+			removed = append(removed, file)
+		}
+	}
+	return cm.MultiRemove(ctx, removed)
+}
+
+// listBackups returns the backups sorted by name, with the collections sorted by id.
+func (s *Server) listBackups(ctx context.Context) ([]*datapb.BackupInfo, error) {
+	cm := s.meta.chunkManager
+	prefix := path.Join(cm.RootPath(), backupPrefix) + "/"
+	files, _, err := cm.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return nil, err
+	}
+	names := typeutil.NewSet[string]()
+	for _, file := range files {
+		parts := strings.Split(strings.TrimPrefix(file, prefix), "/")
+		if len(parts) == 3 && parts[1] == backupManifestPrefix {
+			names.Insert(parts[0])
+		}
+	}
+	sortedNames := names.Collect()
+	sort.Strings(sortedNames)
+
+	backups := make([]*datapb.BackupInfo, 0, len(sortedNames))
+	for _, name := range sortedNames {
+		manifests, err := readBackupManifests(ctx, cm, name)
+		if err != nil {
+			return nil, err
+		}
+		collectionIDs := make([]UniqueID, 0, len(manifests))
+		for collectionID := range manifests {
+			collectionIDs = append(collectionIDs, collectionID)
+		}
+		sort.Slice(collectionIDs, func(i, j int) bool { return collectionIDs[i] < collectionIDs[j] })
+		backup := &datapb.BackupInfo{Name: name}
+		for _, collectionID := range collectionIDs {
+			backup.Collections = append(backup.Collections, newReplicaInfo(manifests[collectionID]))
+		}
+		backups = append(backups, backup)
+	}
+	return backups, nil
+}
+
+func readBackupManifests(ctx context.Context, cm storage.ChunkManager, name string) (map[UniqueID]*datapb.ReplicaManifest, error) {
+	files, _, err := cm.ListWithPrefix(ctx, path.Join(backupRootPath(cm.RootPath(), name), backupManifestPrefix)+"/", true)
+	if err != nil {
+		return nil, err
+	}
+	manifests := make(map[UniqueID]*datapb.ReplicaManifest, len(files))
+	for _, file := range files {
+		collectionID, err := strconv.ParseInt(path.Base(file), 10, 64)
+		if err != nil {
+			continue
+		}
+		manifest, err := readManifest(ctx, cm, file)
+		if err != nil {
+			return nil, err
+		}
+		manifests[collectionID] = manifest
+	}
+	return manifests, nil
+}
@@ -0,0 +1,282 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	broker2 "github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type BackupSuite struct {
+	suite.Suite
+
+	server  *Server
+	cm      storage.ChunkManager
+	catalog *mocks.DataCoordCatalog
+	broker  *broker2.MockBroker
+	alloc   *NMockAllocator
+	logPath string
+}
+
+func (s *BackupSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *BackupSuite) SetupTest() {
+	s.cm = storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+	s.catalog = mocks.NewDataCoordCatalog(s.T())
+	s.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
+	s.catalog.EXPECT().SaveSnapshot(mock.Anything, mock.Anything).Return(nil).Maybe()
+	s.catalog.EXPECT().DropSnapshot(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+	snapshotMeta, err := newSnapshotMeta(context.Background(), s.catalog)
+	s.Require().NoError(err)
+
+	meta := &meta{
+		ctx:          context.Background(),
+		catalog:      s.catalog,
+		collections:  make(map[UniqueID]*collectionInfo),
+		segments:     NewSegmentsInfo(),
+		channelCPs:   newChannelCps(),
+		snapshotMeta: snapshotMeta,
+		chunkManager: s.cm,
+		indexMeta: &indexMeta{
+			indexes: map[UniqueID]map[UniqueID]*model.Index{
+				100: {1: {CollectionID: 100, FieldID: 101, IndexID: 1, IndexName: "vector_index"}},
+			},
+		},
+	}
+	meta.channelCPs.checkpoints["ch-1"] = &msgpb.MsgPosition{ChannelName: "ch-1", Timestamp: 1000}
+	s.logPath = path.Join(s.cm.RootPath(), "insert_log", "100", "10", "1", "101", "1")
+	s.Require().NoError(s.cm.Write(context.Background(), s.logPath, []byte{1}))
+	meta.segments.SetSegment(1, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            1,
+		CollectionID:  100,
+		PartitionID:   10,
+		InsertChannel: "ch-1",
+		State:         commonpb.SegmentState_Flushed,
+		NumOfRows:     100,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{
+			{LogID: 1, LogPath: s.logPath},
+		}}},
+	}))
+
+	s.broker = broker2.NewMockBroker(s.T())
+	s.broker.EXPECT().DescribeCollectionInternal(mock.Anything, int64(100)).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionID:        100,
+		CollectionName:      "test_collection",
+		DbName:              "default",
+		VirtualChannelNames: []string{"ch-1"},
+	}, nil).Maybe()
+	s.broker.EXPECT().ShowPartitions(mock.Anything, int64(100)).Return(&milvuspb.ShowPartitionsResponse{
+		PartitionNames: []string{"_default"},
+		PartitionIDs:   []int64{10},
+	}, nil).Maybe()
+	s.alloc = NewNMockAllocator(s.T())
+	s.alloc.EXPECT().allocTimestamp(mock.Anything).Return(2000, nil).Maybe()
+
+	s.server = &Server{meta: meta, broker: s.broker, allocator: s.alloc}
+	s.server.stateCode.Store(commonpb.StateCode_Healthy)
+}
+
+func (s *BackupSuite) restore(name string) *datapb.RestoreSnapshotResponse {
+	s.broker.EXPECT().DescribeCollectionInternal(mock.Anything, int64(200)).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionID:        200,
+		VirtualChannelNames: []string{"dml_1_200v0"},
+		StartPositions:      []*commonpb.KeyDataPair{{Key: "dml_1", Data: []byte{2}}},
+		CreatedTimestamp:    3000,
+	}, nil).Once()
+	s.broker.EXPECT().ShowPartitionsInternal(mock.Anything, int64(200)).Return([]int64{20}, nil).Once()
+	s.catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil).Once()
+	s.alloc.EXPECT().allocN(int64(1)).Return(1000, 1001, nil).Once()
+
+	resp, err := s.server.RestoreBackup(context.Background(), &datapb.RestoreBackupRequest{
+		Name: name, CollectionID: 100, TargetCollectionID: 200, PartitionMapping: map[int64]int64{10: 20},
+	})
+	s.NoError(err)
+	return resp
+}
+
+func (s *BackupSuite) TestReferenceFiles() {
+	ctx := context.Background()
+	s.broker.EXPECT().ListDatabases(mock.Anything).Return(&milvuspb.ListDatabasesResponse{DbNames: []string{"default"}}, nil)
+	s.broker.EXPECT().ShowCollections(mock.Anything, "default").Return(&milvuspb.ShowCollectionsResponse{CollectionIds: []int64{100}}, nil)
+	status, err := s.server.CreateBackup(ctx, &datapb.CreateBackupRequest{Name: "backup"})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+	// the binlogs are kept by the snapshot of the backup
+	s.Contains(s.server.meta.snapshotMeta.GetReferencedFiles(), s.logPath)
+
+	status, err = s.server.CreateBackup(ctx, &datapb.CreateBackupRequest{Name: "backup", CollectionIDs: []int64{100}})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(status), merr.ErrParameterInvalid)
+
+	resp, err := s.server.ListBackups(ctx, &datapb.ListBackupsRequest{})
+	s.NoError(err)
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.Require().Len(resp.GetBackups(), 1)
+	s.Equal("backup", resp.GetBackups()[0].GetName())
+	s.Require().Len(resp.GetBackups()[0].GetCollections(), 1)
+	collection := resp.GetBackups()[0].GetCollections()[0]
+	s.Equal("test_collection", collection.GetCollection().GetCollectionName())
+	s.Len(collection.GetIndexes(), 1)
+	s.EqualValues(100, collection.GetSnapshot().GetNumRows())
+
+	restored := s.restore("backup")
+	s.NoError(merr.Error(restored.GetStatus()))
+	s.Equal(s.logPath, s.server.meta.GetSegment(1000).GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+
+	status, err = s.server.DropBackup(ctx, &datapb.DropBackupRequest{Name: "backup"})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+	s.Nil(s.server.meta.snapshotMeta.GetSnapshot(100, backupSnapshotName("backup")))
+	exist, err := s.cm.Exist(ctx, s.logPath)
+	s.NoError(err)
+	s.True(exist)
+
+	status, err = s.server.DropBackup(ctx, &datapb.DropBackupRequest{Name: "backup"})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(status), merr.ErrParameterInvalid)
+}
+
+func (s *BackupSuite) TestCopyFiles() {
+	ctx := context.Background()
+	status, err := s.server.CreateBackup(ctx, &datapb.CreateBackupRequest{Name: "backup", CollectionIDs: []int64{100}, CopyFiles: true})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+
+	copied := path.Join(backupRootPath(s.cm.RootPath(), "backup"), backupFilesPrefix, "insert_log", "100", "10", "1", "101", "1")
+	data, err := s.cm.Read(ctx, copied)
+	s.NoError(err)
+	s.Equal([]byte{1}, data)
+	s.Contains(s.server.meta.snapshotMeta.GetReferencedFiles(), copied)
+
+	// the backup survives the loss of the binlogs of the cluster
+	s.NoError(s.cm.Remove(ctx, s.logPath))
+	restored := s.restore("backup")
+	s.NoError(merr.Error(restored.GetStatus()))
+	s.Equal(copied, s.server.meta.GetSegment(1000).GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+
+	// the copies shared with the segments restored are kept
+	status, err = s.server.DropBackup(ctx, &datapb.DropBackupRequest{Name: "backup"})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+	exist, err := s.cm.Exist(ctx, copied)
+	s.NoError(err)
+	s.True(exist)
+	exist, err = s.cm.Exist(ctx, backupManifestPath(s.cm.RootPath(), "backup", 100))
+	s.NoError(err)
+	s.False(exist)
+}
+
+func (s *BackupSuite) TestRestoreIndexFiles() {
+	ctx := context.Background()
+	indexParams := []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "HNSW"}}
+	sourceIdx := &model.SegmentIndex{
+		SegmentID:     1,
+		CollectionID:  100,
+		PartitionID:   10,
+		NumRows:       100,
+		IndexID:       1,
+		BuildID:       500,
+		IndexVersion:  1,
+		IndexState:    commonpb.IndexState_Finished,
+		IndexFileKeys: []string{"index_file"},
+		IndexSize:     1024,
+	}
+	s.server.meta.indexMeta = &indexMeta{
+		ctx:     ctx,
+		catalog: s.catalog,
+		indexes: map[UniqueID]map[UniqueID]*model.Index{
+			100: {1: {CollectionID: 100, FieldID: 101, IndexID: 1, IndexName: "vector_index", IndexParams: indexParams}},
+			200: {2: {CollectionID: 200, FieldID: 101, IndexID: 2, IndexName: "vector_index", IndexParams: indexParams}},
+		},
+		buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{500: sourceIdx},
+		segmentIndexes:       map[UniqueID]map[UniqueID]*model.SegmentIndex{1: {1: sourceIdx}},
+		partitionBuildIDs:    make(map[UniqueID]UniqueID),
+		reusedBuildIDs:       make(map[UniqueID]typeutil.UniqueSet),
+	}
+	s.server.indexEngineVersionManager = newMockVersionManager()
+	indexFile := segmentIndexFilePaths(s.cm.RootPath(), sourceIdx)[0]
+	s.Require().NoError(s.cm.Write(ctx, indexFile, []byte("index")))
+
+	status, err := s.server.CreateBackup(ctx, &datapb.CreateBackupRequest{Name: "backup", CollectionIDs: []int64{100}})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+	snapshot := s.server.meta.snapshotMeta.GetSnapshot(100, backupSnapshotName("backup"))
+	s.Require().Len(snapshot.GetSegmentIndexes(), 1)
+	s.Equal("vector_index", snapshot.GetSegmentIndexes()[0].GetIndexName())
+	// the index files are kept by the snapshot of the backup even if the source segment dropped
+	s.True(s.server.meta.snapshotMeta.GetReferencedIndexBuildIDs().Contain(500))
+
+	s.alloc.EXPECT().allocID(mock.Anything).Return(600, nil).Once()
+	s.catalog.EXPECT().CreateSegmentIndex(mock.Anything, mock.Anything).Return(nil).Once()
+	s.catalog.EXPECT().AlterSegmentIndexes(mock.Anything, mock.Anything).Return(nil).Once()
+	restored := s.restore("backup")
+	s.NoError(merr.Error(restored.GetStatus()))
+
+	// the index of the restored segment reuses the index files rather than building
+	segIdx, ok := s.server.meta.indexMeta.GetIndexJob(600)
+	s.Require().True(ok)
+	s.EqualValues(1000, segIdx.SegmentID)
+	s.EqualValues(2, segIdx.IndexID)
+	s.Equal(commonpb.IndexState_Finished, segIdx.IndexState)
+	s.Require().NotNil(segIdx.ReusedFrom)
+	s.EqualValues(500, segIdx.ReusedFrom.BuildID)
+	s.Equal([]string{indexFile}, segmentIndexFilePaths(s.cm.RootPath(), segIdx))
+}
+
+func (s *BackupSuite) TestInvalid() {
+	ctx := context.Background()
+	status, err := s.server.CreateBackup(ctx, &datapb.CreateBackupRequest{Name: "a/b"})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(status), merr.ErrParameterInvalid)
+
+	resp, err := s.server.RestoreBackup(ctx, &datapb.RestoreBackupRequest{Name: "unknown", CollectionID: 100, TargetCollectionID: 200})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+	// the backup cleaned up once failed
+	s.broker.EXPECT().DescribeCollectionInternal(mock.Anything, int64(101)).Return(nil, merr.WrapErrCollectionNotFound(101))
+	status, err = s.server.CreateBackup(ctx, &datapb.CreateBackupRequest{Name: "backup", CollectionIDs: []int64{100, 101}})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(status), merr.ErrCollectionNotFound)
+	s.Nil(s.server.meta.snapshotMeta.GetSnapshot(100, backupSnapshotName("backup")))
+	backups, err := s.server.ListBackups(ctx, &datapb.ListBackupsRequest{})
+	s.NoError(err)
+	s.Empty(backups.GetBackups())
+}
+
+func TestBackup(t *testing.T) {
+	suite.Run(t, new(BackupSuite))
+}
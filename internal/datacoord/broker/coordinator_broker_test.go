@@ -136,6 +136,41 @@ func (s *BrokerSuite) TestShowPartitionsInternal() {
 	})
 }
 
+func (s *BrokerSuite) TestShowPartitions() {
+	s.Run("return_success", func() {
+		s.SetupTest()
+
+		collID := int64(1000 + rand.Intn(500))
+
+		s.rootCoordClient.EXPECT().ShowPartitionsInternal(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.ShowPartitionsRequest, options ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error) {
+			s.Equal(collID, req.GetCollectionID())
+			return &milvuspb.ShowPartitionsResponse{
+				Status:         merr.Status(nil),
+				PartitionIDs:   []int64{1, 2},
+				PartitionNames: []string{"_default", "p1"},
+			}, nil
+		})
+
+		resp, err := s.broker.ShowPartitions(context.Background(), collID)
+		s.NoError(err)
+		s.Equal([]int64{1, 2}, resp.GetPartitionIDs())
+		s.Equal([]string{"_default", "p1"}, resp.GetPartitionNames())
+
+		s.TearDownTest()
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+
+		s.rootCoordClient.EXPECT().ShowPartitionsInternal(mock.Anything, mock.Anything).Return(nil, errors.New("mocked"))
+
+		_, err := s.broker.ShowPartitions(context.Background(), 1)
+		s.Error(err)
+
+		s.TearDownTest()
+	})
+}
+
 func (s *BrokerSuite) TestShowCollections() {
 	s.Run("return_success", func() {
 		s.SetupTest()
@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/eventlog"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// channelCheckpointMonitor tracks the wall-clock lags and the unflushed rows of the channel checkpoints,
+// and records an event once a channel starts or stops lagging, so that the stuck flush pipelines are caught early.
+type channelCheckpointMonitor struct {
+	meta            *meta
+	getCollectionID func(channel string) (bool, UniqueID)
+
+	mu      sync.Mutex
+	lagging typeutil.Set[string]
+}
+
+func newChannelCheckpointMonitor(meta *meta, getCollectionID func(channel string) (bool, UniqueID)) *channelCheckpointMonitor {
+	return &channelCheckpointMonitor{
+		meta:            meta,
+		getCollectionID: getCollectionID,
+		lagging:         typeutil.NewSet[string](),
+	}
+}
+
+func (m *channelCheckpointMonitor) start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(Params.DataCoordCfg.ChannelCPLagCheckInterval.GetAsDuration(time.Second))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("channel checkpoint monitor exited")
+				return
+			case <-ticker.C:
+				m.check()
+			}
+		}
+	}()
+}
+
+// getLags returns the checkpoint lags of the channels of the collection, or of all the channels if collectionID is 0.
+func (m *channelCheckpointMonitor) getLags(collectionID UniqueID) []*datapb.ChannelCheckpointLag {
+	var (
+		lagThreshold  = Params.DataCoordCfg.ChannelCPLagThreshold.GetAsDuration(time.Second)
+		rowsThreshold = Params.DataCoordCfg.ChannelUnflushedRowsThreshold.GetAsInt64()
+	)
+
+	unflushedRows := make(map[string]int64)
+	for _, segment := range m.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		switch segment.GetState() {
+		case commonpb.SegmentState_Growing, commonpb.SegmentState_Sealed, commonpb.SegmentState_Flushing:
+			return true
+		}
+		return false
+	}) {
+		unflushedRows[segment.GetInsertChannel()] += segment.currRows
+	}
+
+	now := time.Now()
+	lags := make([]*datapb.ChannelCheckpointLag, 0)
+	for channel, cp := range m.meta.GetChannelCheckpoints() {
+		_, collID := m.getCollectionID(channel)
+		if collectionID != 0 && collID != collectionID {
+			continue
+		}
+		lag := now.Sub(tsoutil.PhysicalTime(cp.GetTimestamp()))
+		lags = append(lags, &datapb.ChannelCheckpointLag{
+			Channel:       channel,
+			CollectionID:  collID,
+			Checkpoint:    cp,
+			LagMs:         lag.Milliseconds(),
+			UnflushedRows: unflushedRows[channel],
+			Lagging: (lagThreshold > 0 && lag > lagThreshold) ||
+				(rowsThreshold > 0 && unflushedRows[channel] > rowsThreshold),
+		})
+	}
+	sort.Slice(lags, func(i, j int) bool {
+		return lags[i].GetChannel() < lags[j].GetChannel()
+	})
+	return lags
+}
+
+// check updates the lag metrics of the channels and records the events of the channels starting or stopping lagging.
+func (m *channelCheckpointMonitor) check() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	lagging := typeutil.NewSet[string]()
+	for _, lag := range m.getLags(0) {
+		channel := lag.GetChannel()
+		metrics.DataCoordCheckpointLagSeconds.WithLabelValues(nodeID, channel).Set(float64(lag.GetLagMs()) / 1000)
+		metrics.DataCoordChannelUnflushedRows.WithLabelValues(nodeID, channel).Set(float64(lag.GetUnflushedRows()))
+		if !lag.GetLagging() {
+			continue
+		}
+		lagging.Insert(channel)
+		if !m.lagging.Contain(channel) {
+			log.Warn("channel checkpoint lagging",
+				zap.String("channel", channel),
+				zap.Int64("collectionID", lag.GetCollectionID()),
+				zap.Duration("lag", time.Duration(lag.GetLagMs())*time.Millisecond),
+				zap.Int64("unflushedRows", lag.GetUnflushedRows()))
+			eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Warn,
+				fmt.Sprintf("Channel %s checkpoint lagging, lag: %dms, unflushed rows: %d", channel, lag.GetLagMs(), lag.GetUnflushedRows())))
+		}
+	}
+	for channel := range m.lagging {
+		if !lagging.Contain(channel) {
+			log.Info("channel checkpoint caught up", zap.String("channel", channel))
+			eventlog.Record(eventlog.NewRawEvt(eventlog.Level_Info, fmt.Sprintf("Channel %s checkpoint caught up", channel)))
+		}
+	}
+	m.lagging = lagging
+}
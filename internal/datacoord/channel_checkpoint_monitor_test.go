@@ -0,0 +1,109 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+type ChannelCheckpointMonitorSuite struct {
+	suite.Suite
+
+	meta    *meta
+	monitor *channelCheckpointMonitor
+}
+
+func (s *ChannelCheckpointMonitorSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *ChannelCheckpointMonitorSuite) SetupTest() {
+	s.meta = &meta{
+		channelCPs: newChannelCps(),
+		segments:   NewSegmentsInfo(),
+	}
+	s.meta.channelCPs.checkpoints["ch-1"] = &msgpb.MsgPosition{
+		ChannelName: "ch-1",
+		Timestamp:   tsoutil.ComposeTSByTime(time.Now().Add(-time.Hour), 0),
+	}
+	s.meta.channelCPs.checkpoints["ch-2"] = &msgpb.MsgPosition{
+		ChannelName: "ch-2",
+		Timestamp:   tsoutil.ComposeTSByTime(time.Now(), 0),
+	}
+	s.meta.segments.SetSegment(1, NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 1, CollectionID: 100, InsertChannel: "ch-2", State: commonpb.SegmentState_Growing, NumOfRows: 100,
+	}))
+	s.meta.segments.SetSegment(2, NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 2, CollectionID: 100, InsertChannel: "ch-2", State: commonpb.SegmentState_Sealed, NumOfRows: 50,
+	}))
+	s.meta.segments.SetSegment(3, NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 3, CollectionID: 100, InsertChannel: "ch-2", State: commonpb.SegmentState_Flushed, NumOfRows: 1000,
+	}))
+
+	collections := map[string]int64{"ch-1": 100, "ch-2": 200}
+	s.monitor = newChannelCheckpointMonitor(s.meta, func(channel string) (bool, UniqueID) {
+		collectionID, ok := collections[channel]
+		return ok, collectionID
+	})
+}
+
+func (s *ChannelCheckpointMonitorSuite) TearDownTest() {
+	paramtable.Get().Reset(Params.DataCoordCfg.ChannelUnflushedRowsThreshold.Key)
+}
+
+func (s *ChannelCheckpointMonitorSuite) TestGetLags() {
+	lags := s.monitor.getLags(0)
+	s.Require().Len(lags, 2)
+	s.Equal("ch-1", lags[0].GetChannel())
+	s.EqualValues(100, lags[0].GetCollectionID())
+	s.GreaterOrEqual(lags[0].GetLagMs(), time.Hour.Milliseconds())
+	s.True(lags[0].GetLagging())
+	s.Equal("ch-2", lags[1].GetChannel())
+	s.EqualValues(150, lags[1].GetUnflushedRows())
+	s.False(lags[1].GetLagging())
+
+	lags = s.monitor.getLags(200)
+	s.Require().Len(lags, 1)
+	s.Equal("ch-2", lags[0].GetChannel())
+
+	paramtable.Get().Save(Params.DataCoordCfg.ChannelUnflushedRowsThreshold.Key, "100")
+	lags = s.monitor.getLags(200)
+	s.True(lags[0].GetLagging())
+}
+
+func (s *ChannelCheckpointMonitorSuite) TestCheck() {
+	s.monitor.check()
+	s.ElementsMatch([]string{"ch-1"}, s.monitor.lagging.Collect())
+
+	// caught up
+	s.meta.channelCPs.checkpoints["ch-1"].Timestamp = tsoutil.ComposeTSByTime(time.Now(), 0)
+	s.monitor.check()
+	s.Empty(s.monitor.lagging)
+}
+
+func TestChannelCheckpointMonitor(t *testing.T) {
+	suite.Run(t, new(ChannelCheckpointMonitorSuite))
+}
@@ -0,0 +1,215 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// defaultChannelGroup is the group of the channels and datanodes not configured with any group.
+const defaultChannelGroup = ""
+
+// channelGroups pins the channels to the datanodes of the same exclusive channel group.
+// The channel of a group configured by dataCoord.channel.groups is only assigned to the datanodes
+// declaring the group, and the other channels are only assigned to the datanodes without group.
+// Channel groups are disabled if no group configured.
+type channelGroups struct {
+	// getNodeGroup returns the channel group declared by the datanode session
+	getNodeGroup func(nodeID int64) string
+	nodes        map[int64]string // nodeID => group, guarded by the lock of channel manager
+}
+
+func newChannelGroups(getNodeGroup func(nodeID int64) string) *channelGroups {
+	return &channelGroups{
+		getNodeGroup: getNodeGroup,
+		nodes:        make(map[int64]string),
+	}
+}
+
+// getConfig returns the configured channel or collection to group mapping.
+func (g *channelGroups) getConfig() map[string]string {
+	groups := Params.DataCoordCfg.ChannelGroups.GetAsJSONMap()
+	names := lo.Keys(groups)
+	sort.Strings(names)
+
+	config := make(map[string]string)
+	for _, name := range names {
+		for _, item := range strings.Split(groups[name], ",") {
+			item = strings.TrimSpace(item)
+			if len(item) == 0 {
+				continue
+			}
+			if _, ok := config[item]; ok {
+				log.RatedWarn(60, "channel or collection configured in multiple channel groups", zap.String("item", item))
+				continue
+			}
+			config[item] = name
+		}
+	}
+	return config
+}
+
+func (g *channelGroups) enabled() bool {
+	return g != nil && len(Params.DataCoordCfg.ChannelGroups.GetAsJSONMap()) > 0
+}
+
+// addNode records the group of the datanode, which is kept until the node is removed,
+// as the session may be gone before the node removed.
+func (g *channelGroups) addNode(nodeID int64) {
+	if g == nil || g.getNodeGroup == nil {
+		return
+	}
+	g.nodes[nodeID] = g.getNodeGroup(nodeID)
+}
+
+func (g *channelGroups) removeNode(nodeID int64) {
+	if g == nil {
+		return
+	}
+	delete(g.nodes, nodeID)
+}
+
+func (g *channelGroups) nodeGroup(nodeID int64) string {
+	if g == nil {
+		return defaultChannelGroup
+	}
+	return g.nodes[nodeID]
+}
+
+func (g *channelGroups) channelGroup(config map[string]string, ch ROChannel) string {
+	if group, ok := config[ch.GetName()]; ok {
+		return group
+	}
+	if group, ok := config[strconv.FormatInt(ch.GetCollectionID(), 10)]; ok {
+		return group
+	}
+	return defaultChannelGroup
+}
+
+// nodeView returns the view of the store with the nodes in the same group of the node only.
+func (g *channelGroups) nodeView(store ROChannelStore, nodeID int64) ROChannelStore {
+	if !g.enabled() {
+		return store
+	}
+	return g.view(store, g.nodeGroup(nodeID))
+}
+
+// channelView returns the view of the store with the nodes of the group the channel belongs to only.
+func (g *channelGroups) channelView(store ROChannelStore, ch ROChannel) ROChannelStore {
+	if !g.enabled() {
+		return store
+	}
+	return g.view(store, g.channelGroup(g.getConfig(), ch))
+}
+
+func (g *channelGroups) view(store ROChannelStore, group string) ROChannelStore {
+	return &channelGroupStore{
+		ROChannelStore: store,
+		groups:         g,
+		group:          group,
+		config:         g.getConfig(),
+	}
+}
+
+// groups returns the groups of the registered nodes.
+func (g *channelGroups) groups() []string {
+	groups := typeutil.NewSet[string]()
+	for _, group := range g.nodes {
+		groups.Insert(group)
+	}
+	names := groups.Collect()
+	sort.Strings(names)
+	return names
+}
+
+// misplaced returns the channels watched by the datanodes out of their groups,
+// only the ones which have any node of their groups to move to are returned.
+func (g *channelGroups) misplaced(store ROChannelStore) []*NodeChannelInfo {
+	if !g.enabled() {
+		return nil
+	}
+	config := g.getConfig()
+	groups := typeutil.NewSet(g.groups()...)
+
+	var misplaced []*NodeChannelInfo
+	for _, info := range store.GetNodesChannels() {
+		nodeGroup := g.nodeGroup(info.NodeID)
+		channels := lo.Filter(info.Channels, func(ch RWChannel, _ int) bool {
+			group := g.channelGroup(config, ch)
+			return group != nodeGroup && groups.Contain(group)
+		})
+		if len(channels) > 0 {
+			misplaced = append(misplaced, &NodeChannelInfo{NodeID: info.NodeID, Channels: channels})
+		}
+	}
+	return misplaced
+}
+
+var _ ROChannelStore = (*channelGroupStore)(nil)
+
+// channelGroupStore is the view of the channel store with the nodes and buffer channels of one group only,
+// so that the channel policies applied on it assign the channels within the group.
+type channelGroupStore struct {
+	ROChannelStore
+	groups *channelGroups
+	group  string
+	config map[string]string
+}
+
+func (s *channelGroupStore) inGroup(nodeID int64) bool {
+	return s.groups.nodeGroup(nodeID) == s.group
+}
+
+func (s *channelGroupStore) GetNode(nodeID int64) *NodeChannelInfo {
+	if !s.inGroup(nodeID) {
+		return nil
+	}
+	return s.ROChannelStore.GetNode(nodeID)
+}
+
+func (s *channelGroupStore) GetNodesChannels() []*NodeChannelInfo {
+	return lo.Filter(s.ROChannelStore.GetNodesChannels(), func(info *NodeChannelInfo, _ int) bool {
+		return s.inGroup(info.NodeID)
+	})
+}
+
+func (s *channelGroupStore) GetBufferChannelInfo() *NodeChannelInfo {
+	info := s.ROChannelStore.GetBufferChannelInfo()
+	if info == nil {
+		return nil
+	}
+	return &NodeChannelInfo{
+		NodeID: info.NodeID,
+		Channels: lo.Filter(info.Channels, func(ch RWChannel, _ int) bool {
+			return s.groups.channelGroup(s.config, ch) == s.group
+		}),
+	}
+}
+
+func (s *channelGroupStore) GetNodes() []int64 {
+	return lo.Filter(s.ROChannelStore.GetNodes(), func(nodeID int64, _ int) bool {
+		return s.inGroup(nodeID)
+	})
+}
@@ -0,0 +1,129 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type ChannelGroupSuite struct {
+	suite.Suite
+
+	groups *channelGroups
+	store  *ChannelStore
+}
+
+func (s *ChannelGroupSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *ChannelGroupSuite) SetupTest() {
+	paramtable.Get().Save(Params.DataCoordCfg.ChannelGroups.Key, `{"bulk": "100, ch-200"}`)
+
+	nodeGroups := map[int64]string{1: "", 2: "", 3: "bulk"}
+	s.groups = newChannelGroups(func(nodeID int64) string { return nodeGroups[nodeID] })
+	for nodeID := range nodeGroups {
+		s.groups.addNode(nodeID)
+	}
+	s.store = &ChannelStore{
+		store: memkv.NewMemoryKV(),
+		channelsInfo: map[int64]*NodeChannelInfo{
+			1:        {1, []RWChannel{getChannel("ch-1", 1), getChannel("ch-100", 100)}},
+			2:        {2, []RWChannel{}},
+			3:        {3, []RWChannel{getChannel("ch-200", 200)}},
+			bufferID: {bufferID, []RWChannel{getChannel("ch-2", 2), getChannel("ch-101", 100)}},
+		},
+	}
+}
+
+func (s *ChannelGroupSuite) TearDownTest() {
+	paramtable.Get().Reset(Params.DataCoordCfg.ChannelGroups.Key)
+}
+
+func (s *ChannelGroupSuite) TestDisabled() {
+	paramtable.Get().Reset(Params.DataCoordCfg.ChannelGroups.Key)
+	s.False(s.groups.enabled())
+	s.Equal(s.store, s.groups.nodeView(s.store, 3))
+	s.Equal(s.store, s.groups.channelView(s.store, getChannel("ch-100", 100)))
+	s.Empty(s.groups.misplaced(s.store))
+
+	var groups *channelGroups
+	s.False(groups.enabled())
+	s.Equal(defaultChannelGroup, groups.nodeGroup(1))
+}
+
+func (s *ChannelGroupSuite) TestView() {
+	config := s.groups.getConfig()
+	s.Equal("bulk", s.groups.channelGroup(config, getChannel("ch-100", 100)))
+	s.Equal("bulk", s.groups.channelGroup(config, getChannel("ch-200", 1)))
+	s.Equal(defaultChannelGroup, s.groups.channelGroup(config, getChannel("ch-1", 1)))
+
+	view := s.groups.nodeView(s.store, 3)
+	s.ElementsMatch([]int64{3}, view.GetNodes())
+	s.Nil(view.GetNode(1))
+	s.NotNil(view.GetNode(3))
+	s.Len(view.GetNodesChannels(), 1)
+	s.Equal([]string{"ch-101"}, channelNames(view.GetBufferChannelInfo().Channels))
+
+	view = s.groups.channelView(s.store, getChannel("ch-3", 3))
+	s.ElementsMatch([]int64{1, 2}, view.GetNodes())
+	s.Equal([]string{"ch-2"}, channelNames(view.GetBufferChannelInfo().Channels))
+}
+
+func (s *ChannelGroupSuite) TestAssign() {
+	updates := AverageAssignPolicy(s.groups.channelView(s.store, getChannel("ch-102", 100)), []RWChannel{getChannel("ch-102", 100)})
+	s.Require().Equal(1, updates.Len())
+	s.EqualValues(3, updates.Collect()[0].NodeID)
+
+	updates = AverageAssignPolicy(s.groups.channelView(s.store, getChannel("ch-3", 3)), []RWChannel{getChannel("ch-3", 3)})
+	s.Require().Equal(1, updates.Len())
+	s.EqualValues(2, updates.Collect()[0].NodeID)
+
+	// no node in the group, put into buffer
+	s.groups.removeNode(3)
+	updates = AverageAssignPolicy(s.groups.channelView(s.store, getChannel("ch-102", 100)), []RWChannel{getChannel("ch-102", 100)})
+	s.Require().Equal(1, updates.Len())
+	s.EqualValues(bufferID, updates.Collect()[0].NodeID)
+}
+
+func (s *ChannelGroupSuite) TestMisplaced() {
+	misplaced := s.groups.misplaced(s.store)
+	s.Require().Len(misplaced, 1)
+	s.EqualValues(1, misplaced[0].NodeID)
+	s.Equal([]string{"ch-100"}, channelNames(misplaced[0].Channels))
+
+	// no node of the group to move to
+	s.groups.removeNode(3)
+	s.Empty(s.groups.misplaced(s.store))
+}
+
+func channelNames(channels []RWChannel) []string {
+	names := make([]string, 0, len(channels))
+	for _, ch := range channels {
+		names = append(names, ch.GetName())
+	}
+	return names
+}
+
+func TestChannelGroup(t *testing.T) {
+	suite.Run(t, new(ChannelGroupSuite))
+}
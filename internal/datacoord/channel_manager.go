@@ -69,6 +69,7 @@ type ChannelManagerImpl struct {
 	bgChecker        ChannelBGChecker
 	balancePolicy    BalanceChannelPolicy
 	msgstreamFactory msgstream.Factory
+	groups           *channelGroups
 
 	stateChecker channelStateChecker
 	stopChecker  context.CancelFunc
@@ -96,6 +97,10 @@ func withBgChecker() ChannelManagerOpt {
 	return func(c *ChannelManagerImpl) { c.bgChecker = c.bgCheckChannelsWork }
 }
 
+func withChannelGroups(getNodeGroup func(nodeID int64) string) ChannelManagerOpt {
+	return func(c *ChannelManagerImpl) { c.groups = newChannelGroups(getNodeGroup) }
+}
+
 // NewChannelManager creates and returns a new ChannelManager instance.
 func NewChannelManager(
 	kv kv.WatchKV, // for TxnKv, MetaKv and WatchKV
@@ -130,6 +135,11 @@ func NewChannelManager(
 // Startup adjusts the channel store according to current cluster states.
 func (c *ChannelManagerImpl) Startup(ctx context.Context, nodes []int64) error {
 	c.ctx = ctx
+	c.mu.Lock()
+	for _, nodeID := range nodes {
+		c.groups.addNode(nodeID)
+	}
+	c.mu.Unlock()
 	channels := c.store.GetNodesChannels()
 	// Retrieve the current old nodes.
 	oNodes := make([]int64, 0, len(channels))
@@ -284,7 +294,7 @@ func (c *ChannelManagerImpl) bgCheckChannelsWork(ctx context.Context) {
 			if !c.isSilent() {
 				log.Info("ChannelManager is not silent, skip channel balance this round")
 			} else {
-				toReleases := c.balancePolicy(c.store, time.Now())
+				toReleases := c.balance(time.Now())
 				log.Info("channel manager bg check balance", zap.Array("toReleases", toReleases))
 				if err := c.updateWithTimer(toReleases, datapb.ChannelWatchState_ToRelease); err != nil {
 					log.Warn("channel store update error", zap.Error(err))
@@ -295,6 +305,31 @@ func (c *ChannelManagerImpl) bgCheckChannelsWork(ctx context.Context) {
 	}
 }
 
+// balance returns the channels to release for balance, the channels are balanced within each channel group,
+// and the channels watched by the nodes out of their groups are released to be reassigned to their groups.
+func (c *ChannelManagerImpl) balance(ts time.Time) *ChannelOpSet {
+	if !c.groups.enabled() {
+		return c.balancePolicy(c.store, ts)
+	}
+
+	toReleases := NewChannelOpSet()
+	misplaced := c.groups.misplaced(c.store)
+	for _, info := range misplaced {
+		log.Info("release the channels out of channel group",
+			zap.Int64("nodeID", info.NodeID),
+			zap.String("nodeGroup", c.groups.nodeGroup(info.NodeID)),
+			zap.Strings("channels", lo.Map(info.Channels, func(ch RWChannel, _ int) string { return ch.GetName() })))
+		toReleases.Add(info.NodeID, info.Channels...)
+	}
+	if len(misplaced) > 0 {
+		return toReleases
+	}
+	for _, group := range c.groups.groups() {
+		toReleases.Insert(c.balancePolicy(c.groups.view(c.store, group), ts).Collect()...)
+	}
+	return toReleases
+}
+
 // getOldOnlines returns a list of old online node ids in `old` and in `curr`.
 func (c *ChannelManagerImpl) getOldOnlines(curr []int64, old []int64) []int64 {
 	mcurr := make(map[int64]struct{})
@@ -346,8 +381,9 @@ func (c *ChannelManagerImpl) AddNode(nodeID int64) error {
 	defer c.mu.Unlock()
 
 	c.store.Add(nodeID)
+	c.groups.addNode(nodeID)
 
-	bufferedUpdates, balanceUpdates := c.registerPolicy(c.store, nodeID)
+	bufferedUpdates, balanceUpdates := c.registerPolicy(c.groups.nodeView(c.store, nodeID), nodeID)
 
 	updates := bufferedUpdates
 	// try bufferedUpdates first
@@ -391,8 +427,9 @@ func (c *ChannelManagerImpl) DeleteNode(nodeID int64) error {
 
 	c.unsubAttempt(nodeChannelInfo)
 
-	updates := c.deregisterPolicy(c.store, nodeID)
+	updates := c.deregisterPolicy(c.groups.nodeView(c.store, nodeID), nodeID)
 	if updates == nil {
+		c.groups.removeNode(nodeID)
 		return nil
 	}
 	log.Info("deregister node", zap.Int64("nodeID", nodeID), zap.Array("updates", updates))
@@ -416,6 +453,7 @@ func (c *ChannelManagerImpl) DeleteNode(nodeID int64) error {
 		return err
 	}
 
+	c.groups.removeNode(nodeID)
 	// No channels will be return
 	_, err := c.store.Delete(nodeID)
 	return err
@@ -447,7 +485,7 @@ func (c *ChannelManagerImpl) Watch(ctx context.Context, ch RWChannel) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	updates := c.assignPolicy(c.store, []RWChannel{ch})
+	updates := c.assignPolicy(c.groups.channelView(c.store, ch), []RWChannel{ch})
 	if updates == nil {
 		return nil
 	}
@@ -829,7 +867,7 @@ func (c *ChannelManagerImpl) Reassign(originNodeID UniqueID, channelName string)
 	}
 
 	// Reassign policy won't choose the original node when a reassigning a channel.
-	updates := c.reassignPolicy(c.store, []*NodeChannelInfo{reallocates})
+	updates := c.reassignPolicy(c.groups.channelView(c.store, ch), []*NodeChannelInfo{reallocates})
 	if updates == nil {
 		// Skip the remove if reassign to the original node.
 		log.Warn("failed to reassign channel to other nodes, assigning to the original DataNode",
@@ -887,7 +925,7 @@ func (c *ChannelManagerImpl) CleanupAndReassign(nodeID UniqueID, channelName str
 	}
 
 	// Reassign policy won't choose the original node when a reassigning a channel.
-	updates := c.reassignPolicy(c.store, []*NodeChannelInfo{reallocates})
+	updates := c.reassignPolicy(c.groups.channelView(c.store, chToCleanUp), []*NodeChannelInfo{reallocates})
 	if updates == nil {
 		// Skip the remove if reassign to the original node.
 		log.Warn("failed to reassign channel to other nodes, add channel to the original node",
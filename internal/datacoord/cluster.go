@@ -38,6 +38,8 @@ type Cluster interface {
 	Watch(ctx context.Context, ch string, collectionID UniqueID) error
 	Flush(ctx context.Context, nodeID int64, channel string, segments []*datapb.SegmentInfo) error
 	FlushChannels(ctx context.Context, nodeID int64, flushTs Timestamp, channels []string) error
+	PauseChannels(ctx context.Context, nodeID int64, channels []string, paused bool) error
+	SetChannelParallelism(ctx context.Context, nodeID int64, channel string, parallelism int32) error
 	PreImport(nodeID int64, in *datapb.PreImportRequest) error
 	ImportV2(nodeID int64, in *datapb.ImportRequest) error
 	QueryPreImport(nodeID int64, in *datapb.QueryPreImportRequest) (*datapb.QueryPreImportResponse, error)
@@ -147,6 +149,38 @@ func (c *ClusterImpl) FlushChannels(ctx context.Context, nodeID int64, flushTs T
 	return c.sessionManager.FlushChannels(ctx, nodeID, req)
 }
 
+// PauseChannels pauses or resumes the datanode consuming the channels.
+func (c *ClusterImpl) PauseChannels(ctx context.Context, nodeID int64, channels []string, paused bool) error {
+	if len(channels) == 0 {
+		return nil
+	}
+
+	req := &datapb.PauseChannelsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			commonpbutil.WithTargetID(nodeID),
+		),
+		Channels: channels,
+		Paused:   paused,
+	}
+
+	return c.sessionManager.PauseChannels(ctx, nodeID, req)
+}
+
+// SetChannelParallelism updates the flowgraph parallelism of the channel watched by the datanode.
+func (c *ClusterImpl) SetChannelParallelism(ctx context.Context, nodeID int64, channel string, parallelism int32) error {
+	req := &datapb.SetChannelParallelismRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			commonpbutil.WithTargetID(nodeID),
+		),
+		Channel:     channel,
+		Parallelism: parallelism,
+	}
+
+	return c.sessionManager.SetChannelParallelism(ctx, nodeID, req)
+}
+
 func (c *ClusterImpl) PreImport(nodeID int64, in *datapb.PreImportRequest) error {
 	return c.sessionManager.PreImport(nodeID, in)
 }
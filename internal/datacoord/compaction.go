@@ -58,6 +58,8 @@ type compactionPlanContext interface {
 	// get compaction tasks by signal id
 	getCompactionTasksBySignalID(signalID int64) []*compactionTask
 	removeTasksByChannel(channel string)
+	// cancelCompaction cancels the unfinished plans of the compaction
+	cancelCompaction(signalID int64) error
 }
 
 type compactionTaskState int8
@@ -93,6 +95,8 @@ type compactionTask struct {
 	dataNodeID  int64
 	result      *datapb.CompactionPlanResult
 	span        trace.Span
+	// cancelled plans are failed once stopped in DataNode, the results are not applied
+	cancelled bool
 }
 
 func (t *compactionTask) shadowClone(opts ...compactionTaskOpt) *compactionTask {
@@ -102,6 +106,7 @@ func (t *compactionTask) shadowClone(opts ...compactionTaskOpt) *compactionTask
 		state:       t.state,
 		dataNodeID:  t.dataNodeID,
 		span:        t.span,
+		cancelled:   t.cancelled,
 	}
 	for _, opt := range opts {
 		opt(task)
@@ -134,7 +139,7 @@ func newCompactionPlanHandler(sessions SessionManager, cm ChannelManager, meta C
 		meta:      meta,
 		sessions:  sessions,
 		allocator: allocator,
-		scheduler: NewCompactionScheduler(),
+		scheduler: NewCompactionScheduler(sessions),
 	}
 }
 
@@ -371,6 +376,13 @@ func (c *compactionPlanHandler) notifyTasks(tasks []*compactionTask) {
 	for _, task := range tasks {
 		// avoid closure capture iteration variable
 		innerTask := task
+		if latest := c.getCompaction(innerTask.plan.GetPlanID()); latest != nil && latest.cancelled {
+			c.updateTask(innerTask.plan.GetPlanID(), setState(failed), endSpan())
+			c.setSegmentsCompacting(innerTask.plan, false)
+			c.scheduler.Finish(innerTask.dataNodeID, innerTask.plan)
+			log.Info("compaction plan cancelled before executing", zap.Int64("plan", innerTask.plan.GetPlanID()))
+			continue
+		}
 		err := c.RefreshPlan(innerTask)
 		if err != nil {
 			c.updateTask(innerTask.plan.GetPlanID(), setState(failed), endSpan())
@@ -542,6 +554,11 @@ func (c *compactionPlanHandler) updateCompaction(ts Timestamp) error {
 	for _, task := range executingTasks {
 		log := log.With(zap.Int64("planID", task.plan.PlanID), zap.Int64("nodeID", task.dataNodeID))
 		planID := task.plan.PlanID
+		if nodePlan, ok := planStates[planID]; ok && c.isCancelledResult(planID, nodePlan.B) {
+			// taken as unknown plan, DataNode would be notified to drop the result
+			log.Info("compaction cancelled, discard the result")
+			continue
+		}
 		cachedPlans = append(cachedPlans, planID)
 		if nodePlan, ok := planStates[planID]; ok {
 			planResult := nodePlan.B
@@ -576,6 +593,10 @@ func (c *compactionPlanHandler) updateCompaction(ts Timestamp) error {
 	for _, task := range timeoutTasks {
 		log := log.With(zap.Int64("planID", task.plan.PlanID), zap.Int64("nodeID", task.dataNodeID))
 		planID := task.plan.PlanID
+		if nodePlan, ok := planStates[planID]; ok && c.isCancelledResult(planID, nodePlan.B) {
+			log.Info("compaction cancelled after timeout, discard the result")
+			continue
+		}
 		cachedPlans = append(cachedPlans, planID)
 		if nodePlan, ok := planStates[planID]; ok {
 			if nodePlan.B.GetState() == commonpb.CompactionState_Executing {
@@ -621,6 +642,70 @@ func (c *compactionPlanHandler) updateCompaction(ts Timestamp) error {
 	return nil
 }
 
+// isCancelledResult returns true if the plan is cancelled and the result is completed, which shall be discarded.
+// The plans are cancelled before any of them applied, so the result is never applied.
+// not threadsafe, only can be used internally
+func (c *compactionPlanHandler) isCancelledResult(planID int64, result *datapb.CompactionPlanResult) bool {
+	// the latest task is checked, which may be cancelled after the executing tasks listed
+	task, ok := c.plans[planID]
+	return ok && task.cancelled && result.GetState() == commonpb.CompactionState_Completed
+}
+
+// isApplied returns true if the result of the plan has been applied to meta, even partially,
+// which drops or compacts the segments of the plan.
+func (c *compactionPlanHandler) isApplied(task *compactionTask) bool {
+	if task.state == completed {
+		return true
+	}
+	return lo.ContainsBy(task.plan.GetSegmentBinlogs(), func(segment *datapb.CompactionSegmentBinlogs) bool {
+		return c.meta.GetHealthySegment(segment.GetSegmentID()) == nil
+	})
+}
+
+// cancelCompaction marks the unfinished plans of the compaction cancelled,
+// and stops the executing ones in DataNode.
+// The compaction is cancelled atomically, it's refused once the result of any plan has been applied,
+// otherwise none of the results is applied and the segments stay unchanged.
+func (c *compactionPlanHandler) cancelCompaction(signalID int64) error {
+	c.mu.Lock()
+	tasks := lo.Filter(lo.Values(c.plans), func(task *compactionTask, _ int) bool {
+		return task.triggerInfo.id == signalID
+	})
+	if len(tasks) == 0 {
+		c.mu.Unlock()
+		return merr.WrapErrParameterInvalidMsg("compaction %d not found", signalID)
+	}
+	if lo.ContainsBy(tasks, c.isApplied) {
+		c.mu.Unlock()
+		return merr.WrapErrParameterInvalidMsg("compaction %d has been applied, could not be cancelled", signalID)
+	}
+
+	var stopping []*compactionTask
+	for _, task := range tasks {
+		switch task.state {
+		case pipelining:
+			c.plans[task.plan.GetPlanID()] = task.shadowClone(setCancelled())
+		case executing, timeout:
+			c.plans[task.plan.GetPlanID()] = task.shadowClone(setCancelled())
+			stopping = append(stopping, task)
+		}
+	}
+	c.mu.Unlock()
+
+	for _, task := range stopping {
+		err := c.sessions.StopCompaction(task.dataNodeID, &datapb.StopCompactionRequest{
+			PlanID:  task.plan.GetPlanID(),
+			Channel: task.plan.GetChannel(),
+		})
+		if err != nil {
+			// the result is discarded even if not stopped
+			log.Warn("failed to stop compaction", zap.Int64("planID", task.plan.GetPlanID()), zap.Error(err))
+		}
+	}
+	log.Info("compaction cancelled", zap.Int64("signalID", signalID), zap.Int("stopping", len(stopping)))
+	return nil
+}
+
 func (c *compactionPlanHandler) isTimeout(now Timestamp, start Timestamp, timeout int32) bool {
 	startTime, _ := tsoutil.ParseTS(start)
 	ts, _ := tsoutil.ParseTS(now)
@@ -679,6 +764,12 @@ func endSpan() compactionTaskOpt {
 	}
 }
 
+func setCancelled() compactionTaskOpt {
+	return func(task *compactionTask) {
+		task.cancelled = true
+	}
+}
+
 func setStartTime(startTime uint64) compactionTaskOpt {
 	return func(task *compactionTask) {
 		task.plan.StartTime = startTime
@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	itypeutil "github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// MixCompactionPolicyName is the built-in policy merging the small segments,
+	// and compacting the segments with too many deleted or expired rows.
+	MixCompactionPolicyName = "mix"
+	// L0CompactionPolicyName is the built-in policy applying the deletes of L0 segments,
+	// which is triggered by the changes of L0 segment views.
+	L0CompactionPolicyName = "l0"
+	// SortKeyCompactionPolicyName is the built-in policy merging the small segments adjacent
+	// in the order of the sort key, so the compacted segments cover narrow ranges of the key.
+	SortKeyCompactionPolicyName = "sort_key"
+)
+
+// CompactionPolicyInput holds the compactable segments of the same collection, partition and channel.
+type CompactionPolicyInput struct {
+	Label *CompactionGroupLabel
+	// Properties is the properties of the collection
+	Properties map[string]string
+	Schema     *schemapb.CollectionSchema
+	// Segments is the flushed non-L0 segments which are not compacting or importing
+	Segments []*SegmentInfo
+	// Force is true if triggered by manual compaction
+	Force       bool
+	IsDiskIndex bool
+	// ExpireTime is the timestamp before which the data is expired, 0 if the collection ttl is not set
+	ExpireTime    Timestamp
+	CollectionTTL time.Duration
+}
+
+// CompactionPolicy generates the compaction plans of the segments in a compaction group,
+// the plan ID and timeout of the plans are filled by the compaction trigger.
+// The policies are selected by names per collection, the segments planned by a policy
+// are not passed to the latter ones.
+type CompactionPolicy interface {
+	// Name returns the unique name of the policy
+	Name() string
+	GeneratePlans(ctx context.Context, input *CompactionPolicyInput) []*datapb.CompactionPlan
+}
+
+var compactionPolicies = typeutil.NewConcurrentMap[string, CompactionPolicy]()
+
+// RegisterCompactionPolicy registers the custom compaction policy,
+// the registered one with the same name is replaced.
+func RegisterCompactionPolicy(policy CompactionPolicy) {
+	compactionPolicies.Insert(policy.Name(), policy)
+	log.Info("compaction policy registered", zap.String("name", policy.Name()))
+}
+
+// NewMixCompactionPlan builds the mix compaction plan merging the segments into one,
+// which helps the custom policies to generate plans.
+func NewMixCompactionPlan(segments []*SegmentInfo, collectionTTL time.Duration) *datapb.CompactionPlan {
+	return segmentsToPlan(segments, &compactTime{collectionTTL: collectionTTL})
+}
+
+// getCollectionCompactionPolicies returns the names of the compaction policies applied to the collection.
+func getCollectionCompactionPolicies(properties map[string]string) []string {
+	v, ok := properties[common.CollectionCompactionPolicyKey]
+	if !ok {
+		return Params.DataCoordCfg.CompactionPolicies.GetAsStrings()
+	}
+	return lo.FilterMap(strings.Split(v, ","), func(name string, _ int) (string, bool) {
+		name = strings.TrimSpace(name)
+		return name, len(name) > 0
+	})
+}
+
+func isCompactionPolicySelected(properties map[string]string, name string) bool {
+	return lo.Contains(getCollectionCompactionPolicies(properties), name)
+}
+
+// mixCompactionPolicy is the built-in mix compaction policy.
+type mixCompactionPolicy struct {
+	trigger *compactionTrigger
+}
+
+func (policy *mixCompactionPolicy) Name() string {
+	return MixCompactionPolicyName
+}
+
+func (policy *mixCompactionPolicy) GeneratePlans(ctx context.Context, input *CompactionPolicyInput) []*datapb.CompactionPlan {
+	ct := &compactTime{
+		expireTime:    input.ExpireTime,
+		collectionTTL: input.CollectionTTL,
+	}
+	// the segments of different partition key buckets are not merged
+	buckets := lo.GroupBy(input.Segments, func(segment *SegmentInfo) int64 {
+		return segment.GetBucketID()
+	})
+	if len(buckets) <= 1 {
+		return policy.trigger.generatePlans(input.Segments, input.Force, input.IsDiskIndex, ct)
+	}
+	bucketIDs := lo.Keys(buckets)
+	sort.Slice(bucketIDs, func(i, j int) bool { return bucketIDs[i] < bucketIDs[j] })
+	var plans []*datapb.CompactionPlan
+	for _, bucketID := range bucketIDs {
+		plans = append(plans, policy.trigger.generatePlans(buckets[bucketID], input.Force, input.IsDiskIndex, ct)...)
+	}
+	return plans
+}
+
+// sortKeyCompactionPolicy is the built-in policy for the collections with a scalar clustering key,
+// the rows of the compacted segment are sorted by the key in DataNode.
+type sortKeyCompactionPolicy struct {
+	trigger *compactionTrigger
+}
+
+func (policy *sortKeyCompactionPolicy) Name() string {
+	return SortKeyCompactionPolicyName
+}
+
+func (policy *sortKeyCompactionPolicy) GeneratePlans(ctx context.Context, input *CompactionPolicyInput) []*datapb.CompactionPlan {
+	field := itypeutil.GetSortKeyField(input.Schema)
+	if field == nil {
+		return nil
+	}
+
+	type keyedSegment struct {
+		segment *SegmentInfo
+		min     storage.ScalarFieldValue
+	}
+	// the segments without the stats of the key are left to the latter policies
+	var candidates []keyedSegment
+	for _, segment := range input.Segments {
+		if !policy.trigger.isSmallSegment(segment) {
+			continue
+		}
+		stats, ok := lo.Find(segment.GetScalarStats(), func(stats *datapb.FieldScalarStats) bool {
+			return stats.GetFieldID() == field.GetFieldID()
+		})
+		if !ok {
+			continue
+		}
+		fieldStats := &storage.FieldStats{}
+		if err := json.Unmarshal(stats.GetStats(), fieldStats); err != nil || fieldStats.Min == nil {
+			continue
+		}
+		candidates = append(candidates, keyedSegment{segment: segment, min: fieldStats.Min})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].min.LT(candidates[j].min)
+	})
+
+	// merge the adjacent segments in the key order until the merged one is full
+	var plans []*datapb.CompactionPlan
+	var bucket []*SegmentInfo
+	var rows int64
+	flush := func() {
+		if len(bucket) > 1 {
+			plans = append(plans, NewMixCompactionPlan(bucket, input.CollectionTTL))
+		}
+		bucket, rows = nil, 0
+	}
+	for _, candidate := range candidates {
+		if rows+candidate.segment.GetNumOfRows() > candidate.segment.GetMaxRowNum() {
+			flush()
+		}
+		bucket = append(bucket, candidate.segment)
+		rows += candidate.segment.GetNumOfRows()
+	}
+	flush()
+	return plans
+}
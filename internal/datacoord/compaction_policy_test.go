@@ -0,0 +1,200 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// timeWindowPolicy merges the segments whose start positions are in the same time window.
+type timeWindowPolicy struct {
+	window Timestamp
+}
+
+func (policy *timeWindowPolicy) Name() string {
+	return "timeWindow"
+}
+
+func (policy *timeWindowPolicy) GeneratePlans(ctx context.Context, input *CompactionPolicyInput) []*datapb.CompactionPlan {
+	windows := make(map[Timestamp][]*SegmentInfo)
+	for _, segment := range input.Segments {
+		window := segment.GetStartPosition().GetTimestamp() / policy.window
+		windows[window] = append(windows[window], segment)
+	}
+
+	var plans []*datapb.CompactionPlan
+	for _, segments := range windows {
+		if len(segments) > 1 {
+			plans = append(plans, NewMixCompactionPlan(segments, input.CollectionTTL))
+		}
+	}
+	return plans
+}
+
+type CompactionPolicySuite struct {
+	suite.Suite
+
+	label   *CompactionGroupLabel
+	trigger *compactionTrigger
+}
+
+func (s *CompactionPolicySuite) SetupSuite() {
+	paramtable.Init()
+	RegisterCompactionPolicy(&timeWindowPolicy{window: 100})
+}
+
+func (s *CompactionPolicySuite) SetupTest() {
+	s.label = &CompactionGroupLabel{
+		CollectionID: 1,
+		PartitionID:  10,
+		Channel:      "ch-1",
+	}
+	s.trigger = &compactionTrigger{}
+}
+
+func (s *CompactionPolicySuite) genSegment(id UniqueID, startTs Timestamp) *SegmentInfo {
+	segment := genTestSegmentInfo(s.label, id, datapb.SegmentLevel_L1, commonpb.SegmentState_Flushed)
+	segment.StartPosition = &msgpb.MsgPosition{Timestamp: startTs}
+	segment.NumOfRows = 100
+	segment.MaxRowNum = 10000
+	return segment
+}
+
+func (s *CompactionPolicySuite) TestGetCollectionCompactionPolicies() {
+	s.Equal([]string{MixCompactionPolicyName, L0CompactionPolicyName}, getCollectionCompactionPolicies(nil))
+	s.Equal([]string{"timeWindow", MixCompactionPolicyName}, getCollectionCompactionPolicies(map[string]string{
+		common.CollectionCompactionPolicyKey: " timeWindow, mix,",
+	}))
+	s.Empty(getCollectionCompactionPolicies(map[string]string{common.CollectionCompactionPolicyKey: ""}))
+
+	s.True(isCompactionPolicySelected(nil, L0CompactionPolicyName))
+	s.False(isCompactionPolicySelected(map[string]string{
+		common.CollectionCompactionPolicyKey: MixCompactionPolicyName,
+	}, L0CompactionPolicyName))
+}
+
+func (s *CompactionPolicySuite) TestGeneratePolicyPlans() {
+	segments := []*SegmentInfo{
+		s.genSegment(100, 10),
+		s.genSegment(101, 20),
+		s.genSegment(102, 210),
+		s.genSegment(103, 220),
+		s.genSegment(104, 330),
+	}
+	ct := &compactTime{collectionTTL: time.Hour}
+
+	s.Run("custom policy", func() {
+		coll := &collectionInfo{ID: 1, Properties: map[string]string{common.CollectionCompactionPolicyKey: "timeWindow"}}
+		plans := s.trigger.generatePolicyPlans(coll, s.label, segments, false, false, ct)
+		s.Len(plans, 2)
+		for _, plan := range plans {
+			s.Equal(datapb.CompactionType_MixCompaction, plan.GetType())
+			s.Len(plan.GetSegmentBinlogs(), 2)
+			s.Equal(time.Hour.Nanoseconds(), plan.GetCollectionTtl())
+		}
+	})
+
+	s.Run("segments planned excluded", func() {
+		coll := &collectionInfo{ID: 1, Properties: map[string]string{common.CollectionCompactionPolicyKey: "timeWindow,mix"}}
+		plans := s.trigger.generatePolicyPlans(coll, s.label, segments, true, false, ct)
+		s.Len(plans, 3)
+		s.Equal([]int64{104}, fetchSegIDs(plans[2].GetSegmentBinlogs()))
+	})
+
+	s.Run("policy not found", func() {
+		coll := &collectionInfo{ID: 1, Properties: map[string]string{common.CollectionCompactionPolicyKey: "unknown,l0"}}
+		plans := s.trigger.generatePolicyPlans(coll, s.label, segments, true, false, ct)
+		s.Empty(plans)
+	})
+}
+
+func (s *CompactionPolicySuite) TestMixPolicyBuckets() {
+	segments := []*SegmentInfo{
+		s.genSegment(100, 10),
+		s.genSegment(101, 20),
+		s.genSegment(102, 30),
+		s.genSegment(103, 40),
+	}
+	segments[2].BucketID = 1
+	segments[3].BucketID = 1
+	policy := &mixCompactionPolicy{trigger: &compactionTrigger{}}
+	plans := policy.GeneratePlans(context.TODO(), &CompactionPolicyInput{
+		Label:    s.label,
+		Segments: segments,
+		Force:    true,
+	})
+	s.Require().Len(plans, 2)
+	s.ElementsMatch([]int64{100, 101}, fetchSegIDs(plans[0].GetSegmentBinlogs()))
+	s.ElementsMatch([]int64{102, 103}, fetchSegIDs(plans[1].GetSegmentBinlogs()))
+}
+
+func (s *CompactionPolicySuite) TestSortKeyPolicy() {
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+		{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		{FieldID: 101, DataType: schemapb.DataType_Int64, IsClusteringKey: true},
+	}}
+	genSegment := func(id UniqueID, min int64) *SegmentInfo {
+		segment := s.genSegment(id, 10)
+		segment.MaxRowNum = 250
+		if min >= 0 {
+			stats := storage.NewScalarFieldStats(101, schemapb.DataType_Int64, false)
+			stats.Update(storage.NewInt64FieldValue(min))
+			stats.Update(storage.NewInt64FieldValue(min + 5))
+			bs, err := json.Marshal(stats)
+			s.Require().NoError(err)
+			segment.ScalarStats = []*datapb.FieldScalarStats{{FieldID: 101, Stats: bs}}
+		}
+		return segment
+	}
+	segments := []*SegmentInfo{
+		genSegment(100, 30),
+		genSegment(101, 10),
+		genSegment(102, 20),
+		genSegment(103, 40),
+		// without stats
+		genSegment(104, -1),
+	}
+
+	policy := s.trigger.getCompactionPolicy(SortKeyCompactionPolicyName)
+	s.Empty(policy.GeneratePlans(context.TODO(), &CompactionPolicyInput{Label: s.label, Segments: segments}))
+
+	plans := policy.GeneratePlans(context.TODO(), &CompactionPolicyInput{
+		Label:    s.label,
+		Schema:   schema,
+		Segments: segments,
+	})
+	s.Require().Len(plans, 2)
+	s.Equal([]int64{101, 102}, fetchSegIDs(plans[0].GetSegmentBinlogs()))
+	s.Equal([]int64{100, 103}, fetchSegIDs(plans[1].GetSegmentBinlogs()))
+}
+
+func TestCompactionPolicy(t *testing.T) {
+	suite.Run(t, new(CompactionPolicySuite))
+}
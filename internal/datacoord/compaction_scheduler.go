@@ -2,6 +2,8 @@ package datacoord
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"sync"
 
 	"github.com/samber/lo"
@@ -35,15 +37,19 @@ type CompactionScheduler struct {
 	taskGuard     sync.RWMutex
 
 	planHandler *compactionPlanHandler
+	// sessions provides the compaction resources reported by the datanodes,
+	// no resource budget applied to the datanodes if nil
+	sessions SessionManager
 }
 
 var _ Scheduler = (*CompactionScheduler)(nil)
 
-func NewCompactionScheduler() *CompactionScheduler {
+func NewCompactionScheduler(sessions SessionManager) *CompactionScheduler {
 	return &CompactionScheduler{
 		taskNumber:    atomic.NewInt32(0),
 		queuingTasks:  make([]*compactionTask, 0),
 		parallelTasks: make(map[int64][]*compactionTask),
+		sessions:      sessions,
 	}
 }
 
@@ -60,13 +66,20 @@ func (s *CompactionScheduler) Submit(tasks ...*compactionTask) {
 	s.LogStatus()
 }
 
-// Schedule pick 1 or 0 tasks for 1 node
+// Schedule pick 1 or 0 tasks for 1 node, L0 tasks are picked prior to the others.
+// The picked tasks are limited by the resources of the datanodes and the total size of the executing tasks.
 func (s *CompactionScheduler) Schedule() []*compactionTask {
 	nodeTasks := make(map[int64][]*compactionTask) // nodeID
 
 	s.taskGuard.Lock()
 	defer s.taskGuard.Unlock()
-	for _, task := range s.queuingTasks {
+
+	queuing := make([]*compactionTask, len(s.queuingTasks))
+	copy(queuing, s.queuingTasks)
+	sort.SliceStable(queuing, func(i, j int) bool {
+		return isLevelZeroCompaction(queuing[i]) && !isLevelZeroCompaction(queuing[j])
+	})
+	for _, task := range queuing {
 		if _, ok := nodeTasks[task.dataNodeID]; !ok {
 			nodeTasks[task.dataNodeID] = make([]*compactionTask, 0)
 		}
@@ -76,12 +89,20 @@ func (s *CompactionScheduler) Schedule() []*compactionTask {
 
 	executable := make(map[int64]*compactionTask)
 
-	pickPriorPolicy := func(tasks []*compactionTask, exclusiveChannels []string, executing []string) *compactionTask {
+	pickPriorPolicy := func(tasks []*compactionTask, exclusiveChannels []string, executing []string, memoryBudget int64) *compactionTask {
 		for _, task := range tasks {
 			if lo.Contains(exclusiveChannels, task.plan.GetChannel()) {
 				continue
 			}
 
+			if getCompactionTaskSize(task) > memoryBudget {
+				// Don't schedule smaller tasks ahead of the L0 task waiting for memory
+				if isLevelZeroCompaction(task) {
+					return nil
+				}
+				continue
+			}
+
 			if task.plan.GetType() == datapb.CompactionType_Level0DeleteCompaction {
 				// Channel of LevelZeroCompaction task with no executing compactions
 				if !lo.Contains(executing, task.plan.GetChannel()) {
@@ -100,10 +121,11 @@ func (s *CompactionScheduler) Schedule() []*compactionTask {
 		return nil
 	}
 
+	resources := s.getNodeResources()
 	// pick 1 or 0 task for 1 node
 	for node, tasks := range nodeTasks {
 		parallel := s.parallelTasks[node]
-		if len(parallel) >= calculateParallel() {
+		if len(parallel) >= calculateNodeParallel(resources[node]) {
 			log.Info("Compaction parallel in DataNode reaches the limit", zap.Int64("nodeID", node), zap.Int("parallel", len(parallel)))
 			continue
 		}
@@ -119,12 +141,14 @@ func (s *CompactionScheduler) Schedule() []*compactionTask {
 			}
 		}
 
-		picked := pickPriorPolicy(tasks, channelsExecPrior.Collect(), executing.Collect())
+		picked := pickPriorPolicy(tasks, channelsExecPrior.Collect(), executing.Collect(), calculateNodeMemoryBudget(resources[node], parallel))
 		if picked != nil {
 			executable[node] = picked
 		}
 	}
 
+	s.limitConcurrentSize(executable)
+
 	var pickPlans []int64
 	for node, task := range executable {
 		pickPlans = append(pickPlans, task.plan.PlanID)
@@ -153,6 +177,53 @@ func (s *CompactionScheduler) Schedule() []*compactionTask {
 	return lo.Values(executable)
 }
 
+func (s *CompactionScheduler) getNodeResources() map[int64]*datapb.CompactionNodeResource {
+	if s.sessions == nil {
+		return nil
+	}
+	return s.sessions.GetCompactionNodeResources()
+}
+
+// limitConcurrentSize removes the picked tasks exceeding dataCoord.compaction.maxConcurrentSize,
+// at least one task is kept when there is no executing task.
+func (s *CompactionScheduler) limitConcurrentSize(executable map[int64]*compactionTask) {
+	limit := Params.DataCoordCfg.CompactionMaxConcurrentSize.GetAsInt64() * 1024 * 1024
+	if limit <= 0 {
+		return
+	}
+
+	var total int64
+	for _, tasks := range s.parallelTasks {
+		for _, t := range tasks {
+			total += getCompactionTaskSize(t)
+		}
+	}
+
+	picked := lo.Values(executable)
+	sort.Slice(picked, func(i, j int) bool {
+		if isLevelZeroCompaction(picked[i]) != isLevelZeroCompaction(picked[j]) {
+			return isLevelZeroCompaction(picked[i])
+		}
+		return picked[i].plan.GetPlanID() < picked[j].plan.GetPlanID()
+	})
+
+	blocked := false
+	for _, task := range picked {
+		size := getCompactionTaskSize(task)
+		if !blocked && (total == 0 || total+size <= limit) {
+			total += size
+			continue
+		}
+		// Don't schedule the other tasks ahead of the L0 task waiting for the quota
+		if isLevelZeroCompaction(task) {
+			blocked = true
+		}
+		log.Info("Compaction concurrent size reaches the limit", zap.Int64("planID", task.plan.GetPlanID()),
+			zap.Int64("size", size), zap.Int64("executingSize", total), zap.Int64("limit", limit))
+		delete(executable, task.dataNodeID)
+	}
+}
+
 func (s *CompactionScheduler) Finish(nodeID UniqueID, plan *datapb.CompactionPlan) {
 	planID := plan.GetPlanID()
 	log := log.With(zap.Int64("planID", planID), zap.Int64("nodeID", nodeID))
@@ -211,3 +282,52 @@ func (s *CompactionScheduler) LogStatus() {
 func (s *CompactionScheduler) GetTaskCount() int {
 	return int(s.taskNumber.Load())
 }
+
+func isLevelZeroCompaction(task *compactionTask) bool {
+	return task.plan.GetType() == datapb.CompactionType_Level0DeleteCompaction
+}
+
+// getCompactionTaskSize returns the total binlog size of the segments to compact.
+func getCompactionTaskSize(task *compactionTask) int64 {
+	var size int64
+	for _, segment := range task.plan.GetSegmentBinlogs() {
+		for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetFieldBinlogs(), segment.GetField2StatslogPaths(), segment.GetDeltalogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, binlog := range fieldBinlog.GetBinlogs() {
+					size += binlog.GetLogSize()
+				}
+			}
+		}
+	}
+	return size
+}
+
+// calculateNodeParallel returns the max executing compaction tasks of the datanode,
+// which is limited by the CPU cores of the datanode as well.
+func calculateNodeParallel(resource *datapb.CompactionNodeResource) int {
+	parallel := calculateParallel()
+	if resource.GetCpuNum() <= 0 {
+		return parallel
+	}
+	cpuParallel := int(float64(resource.GetCpuNum()) * Params.DataCoordCfg.CompactionNodeCPURatio.GetAsFloat())
+	if cpuParallel < 1 {
+		cpuParallel = 1
+	}
+	if cpuParallel < parallel {
+		return cpuParallel
+	}
+	return parallel
+}
+
+// calculateNodeMemoryBudget returns the available memory for a new compaction task of the datanode,
+// a task of any size could be executed if no task executing in the datanode.
+func calculateNodeMemoryBudget(resource *datapb.CompactionNodeResource, executing []*compactionTask) int64 {
+	if resource.GetTotalMemory() == 0 || len(executing) == 0 {
+		return math.MaxInt64
+	}
+	budget := int64(float64(resource.GetTotalMemory()) * Params.DataCoordCfg.CompactionNodeMemoryRatio.GetAsFloat())
+	for _, t := range executing {
+		budget -= getCompactionTaskSize(t)
+	}
+	return budget
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/testutils"
 )
 
@@ -22,7 +23,7 @@ type SchedulerSuite struct {
 }
 
 func (s *SchedulerSuite) SetupTest() {
-	s.scheduler = NewCompactionScheduler()
+	s.scheduler = NewCompactionScheduler(nil)
 	s.scheduler.parallelTasks = map[int64][]*compactionTask{
 		100: {
 			{dataNodeID: 100, plan: &datapb.CompactionPlan{PlanID: 1, Channel: "ch-1", Type: datapb.CompactionType_MixCompaction}},
@@ -39,7 +40,7 @@ func (s *SchedulerSuite) SetupTest() {
 }
 
 func (s *SchedulerSuite) TestScheduleEmpty() {
-	emptySch := NewCompactionScheduler()
+	emptySch := NewCompactionScheduler(nil)
 
 	tasks := emptySch.Schedule()
 	s.Empty(tasks)
@@ -178,6 +179,87 @@ func (s *SchedulerSuite) TestScheduleNodeWithL0Executing() {
 	}
 }
 
+func (s *SchedulerSuite) TestScheduleNodeResource() {
+	sessions := NewMockSessionManager(s.T())
+	sessions.EXPECT().GetCompactionNodeResources().Return(map[int64]*datapb.CompactionNodeResource{
+		101: {CpuNum: 1, TotalMemory: 2048},
+		103: {CpuNum: 8, TotalMemory: 2048},
+	})
+
+	s.Run("cpu limit", func() {
+		s.SetupTest()
+		s.scheduler.sessions = sessions
+		s.scheduler.Submit(&compactionTask{dataNodeID: 101, plan: &datapb.CompactionPlan{PlanID: 10, Channel: "ch-10", Type: datapb.CompactionType_MixCompaction}})
+		s.Empty(s.scheduler.Schedule())
+	})
+
+	s.Run("memory limit", func() {
+		s.SetupTest()
+		s.scheduler.sessions = sessions
+		s.scheduler.parallelTasks[103] = []*compactionTask{newSizedCompactionTask(103, 5, "ch-5", datapb.CompactionType_MixCompaction, 512)}
+		s.scheduler.Submit(
+			newSizedCompactionTask(103, 10, "ch-10", datapb.CompactionType_MixCompaction, 1024),
+			newSizedCompactionTask(103, 11, "ch-11", datapb.CompactionType_MixCompaction, 256),
+		)
+		s.Equal([]int64{11}, lo.Map(s.scheduler.Schedule(), func(t *compactionTask, _ int) int64 { return t.plan.PlanID }))
+	})
+
+	s.Run("L0 waiting for memory", func() {
+		s.SetupTest()
+		s.scheduler.sessions = sessions
+		s.scheduler.parallelTasks[103] = []*compactionTask{newSizedCompactionTask(103, 5, "ch-5", datapb.CompactionType_MixCompaction, 512)}
+		s.scheduler.Submit(
+			newSizedCompactionTask(103, 11, "ch-11", datapb.CompactionType_MixCompaction, 256),
+			newSizedCompactionTask(103, 10, "ch-10", datapb.CompactionType_Level0DeleteCompaction, 1024),
+		)
+		s.Empty(s.scheduler.Schedule())
+	})
+
+	s.Run("no executing task", func() {
+		s.SetupTest()
+		s.scheduler.sessions = sessions
+		s.scheduler.Submit(newSizedCompactionTask(103, 10, "ch-10", datapb.CompactionType_MixCompaction, 4096))
+		s.Equal([]int64{10}, lo.Map(s.scheduler.Schedule(), func(t *compactionTask, _ int) int64 { return t.plan.PlanID }))
+	})
+}
+
+func (s *SchedulerSuite) TestScheduleConcurrentSize() {
+	paramtable.Get().Save(Params.DataCoordCfg.CompactionMaxConcurrentSize.Key, "1")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionMaxConcurrentSize.Key)
+
+	s.Run("L0 first", func() {
+		s.scheduler = NewCompactionScheduler(nil)
+		s.scheduler.Submit(
+			newSizedCompactionTask(100, 10, "ch-10", datapb.CompactionType_MixCompaction, 1024*1024),
+			newSizedCompactionTask(101, 11, "ch-11", datapb.CompactionType_Level0DeleteCompaction, 512*1024),
+			newSizedCompactionTask(102, 12, "ch-12", datapb.CompactionType_MixCompaction, 256*1024),
+		)
+		s.ElementsMatch([]int64{11, 12}, lo.Map(s.scheduler.Schedule(), func(t *compactionTask, _ int) int64 { return t.plan.PlanID }))
+		s.Empty(s.scheduler.Schedule())
+	})
+
+	s.Run("always one task", func() {
+		s.scheduler = NewCompactionScheduler(nil)
+		s.scheduler.Submit(
+			newSizedCompactionTask(100, 10, "ch-10", datapb.CompactionType_MixCompaction, 2*1024*1024),
+			newSizedCompactionTask(101, 11, "ch-11", datapb.CompactionType_MixCompaction, 2*1024*1024),
+		)
+		s.Equal([]int64{10}, lo.Map(s.scheduler.Schedule(), func(t *compactionTask, _ int) int64 { return t.plan.PlanID }))
+	})
+}
+
+func newSizedCompactionTask(nodeID, planID int64, channel string, tp datapb.CompactionType, size int64) *compactionTask {
+	return &compactionTask{dataNodeID: nodeID, plan: &datapb.CompactionPlan{
+		PlanID:  planID,
+		Channel: channel,
+		Type:    tp,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{{
+			SegmentID:    planID,
+			FieldBinlogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogSize: size}}}},
+		}},
+	}}
+}
+
 func (s *SchedulerSuite) TestFinish() {
 	s.Run("finish from parallelTasks", func() {
 		s.SetupTest()
@@ -746,6 +746,113 @@ func (s *CompactionPlanHandlerSuite) TestUpdateCompaction() {
 	s.Equal(failed, task.state)
 }
 
+func (s *CompactionPlanHandlerSuite) TestCancelCompaction() {
+	newPlan := func(planID int64, channel string) *datapb.CompactionPlan {
+		return &datapb.CompactionPlan{PlanID: planID, Channel: channel, SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: planID * 100},
+		}}
+	}
+	handler := newCompactionPlanHandler(s.mockSessMgr, s.mockCm, s.mockMeta, s.mockAlloc)
+	handler.plans = map[int64]*compactionTask{
+		1: {triggerInfo: &compactionSignal{id: 10}, plan: newPlan(1, ""), state: pipelining, dataNodeID: 111},
+		2: {triggerInfo: &compactionSignal{id: 10}, plan: newPlan(2, "ch-1"), state: executing, dataNodeID: 111},
+		3: {triggerInfo: &compactionSignal{id: 20}, plan: newPlan(3, ""), state: executing, dataNodeID: 111},
+		4: {triggerInfo: &compactionSignal{id: 30}, plan: newPlan(4, ""), state: completed, dataNodeID: 111},
+		5: {triggerInfo: &compactionSignal{id: 30}, plan: newPlan(5, ""), state: executing, dataNodeID: 111},
+		6: {triggerInfo: &compactionSignal{id: 40}, plan: newPlan(6, ""), state: executing, dataNodeID: 111},
+	}
+	s.mockMeta.EXPECT().GetHealthySegment(mock.Anything).RunAndReturn(func(segmentID int64) *SegmentInfo {
+		// the result of plan 6 has been applied but not synced with DataNode yet
+		if segmentID == 600 {
+			return nil
+		}
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: segmentID}}
+	})
+	s.mockSessMgr.EXPECT().StopCompaction(int64(111), mock.Anything).RunAndReturn(func(nodeID int64, req *datapb.StopCompactionRequest) error {
+		s.EqualValues(2, req.GetPlanID())
+		s.Equal("ch-1", req.GetChannel())
+		return errors.New("mock error")
+	}).Once()
+
+	s.NoError(handler.cancelCompaction(10))
+	s.True(handler.plans[1].cancelled)
+	s.True(handler.plans[2].cancelled)
+	s.False(handler.plans[3].cancelled)
+
+	// refused once any plan applied
+	s.ErrorIs(handler.cancelCompaction(30), merr.ErrParameterInvalid)
+	s.False(handler.plans[5].cancelled)
+	s.ErrorIs(handler.cancelCompaction(40), merr.ErrParameterInvalid)
+	s.False(handler.plans[6].cancelled)
+
+	s.ErrorIs(handler.cancelCompaction(50), merr.ErrParameterInvalid)
+}
+
+func (s *CompactionPlanHandlerSuite) TestNotifyCancelledTasks() {
+	task := &compactionTask{
+		triggerInfo: &compactionSignal{id: 10},
+		plan: &datapb.CompactionPlan{PlanID: 1, Type: datapb.CompactionType_MixCompaction, SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: 100},
+		}},
+		state:      pipelining,
+		dataNodeID: 111,
+	}
+	handler := newCompactionPlanHandler(s.mockSessMgr, s.mockCm, s.mockMeta, s.mockAlloc)
+	handler.scheduler = s.mockSch
+	handler.plans[1] = task.shadowClone(setCancelled())
+
+	s.mockMeta.EXPECT().SetSegmentCompacting(int64(100), false).Once()
+	s.mockSch.EXPECT().Finish(int64(111), mock.Anything).Once()
+	handler.notifyTasks([]*compactionTask{task})
+	s.Equal(failed, handler.plans[1].state)
+}
+
+func (s *CompactionPlanHandlerSuite) TestUpdateCancelledCompaction() {
+	newTask := func(planID int64, cancelled bool) *compactionTask {
+		return &compactionTask{
+			triggerInfo: &compactionSignal{id: 10},
+			plan: &datapb.CompactionPlan{PlanID: planID, Type: datapb.CompactionType_MixCompaction, SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+				{SegmentID: planID * 10},
+			}},
+			state:      executing,
+			dataNodeID: 111,
+			cancelled:  cancelled,
+		}
+	}
+	handler := newCompactionPlanHandler(s.mockSessMgr, s.mockCm, s.mockMeta, s.mockAlloc)
+	handler.scheduler = s.mockSch
+	handler.plans = map[int64]*compactionTask{
+		1: newTask(1, true),
+		2: newTask(2, false),
+		3: newTask(3, true),
+	}
+
+	s.mockSessMgr.EXPECT().GetCompactionPlansResults().RunAndReturn(func() (map[int64]*typeutil.Pair[int64, *datapb.CompactionPlanResult], error) {
+		// plan 2 is cancelled after the executing plans listed
+		handler.plans[2] = handler.plans[2].shadowClone(setCancelled())
+		return map[int64]*typeutil.Pair[int64, *datapb.CompactionPlanResult]{
+			1: {A: 111, B: &datapb.CompactionPlanResult{PlanID: 1, State: commonpb.CompactionState_Completed, Segments: []*datapb.CompactionSegment{{SegmentID: 100}}}},
+			2: {A: 111, B: &datapb.CompactionPlanResult{PlanID: 2, State: commonpb.CompactionState_Completed, Segments: []*datapb.CompactionSegment{{SegmentID: 200}}}},
+		}, nil
+	})
+
+	// the results of the cancelled plans are discarded, and DataNode is notified to drop them
+	synced := make(map[int64][]int64)
+	s.mockSessMgr.EXPECT().SyncSegments(int64(111), mock.Anything).RunAndReturn(func(nodeID int64, req *datapb.SyncSegmentsRequest) error {
+		synced[req.GetPlanID()] = req.GetCompactedFrom()
+		return nil
+	}).Twice()
+	// plan 3 stopped in DataNode
+	s.mockMeta.EXPECT().SetSegmentCompacting(int64(30), false).Once()
+	s.mockSch.EXPECT().Finish(int64(111), mock.Anything).Once()
+
+	s.NoError(handler.updateCompaction(0))
+	s.Equal(executing, handler.plans[1].state)
+	s.Equal(executing, handler.plans[2].state)
+	s.Equal(failed, handler.plans[3].state)
+	s.Equal(map[int64][]int64{1: nil, 2: nil}, synced)
+}
+
 func getFieldBinlogIDs(fieldID int64, logIDs ...int64) *datapb.FieldBinlog {
 	l := &datapb.FieldBinlog{
 		FieldID: fieldID,
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -55,6 +56,8 @@ type trigger interface {
 	triggerSingleCompaction(collectionID, partitionID, segmentID int64, channel string, blockToSendSignal bool) error
 	// forceTriggerCompaction force to start a compaction
 	forceTriggerCompaction(collectionID int64) (UniqueID, error)
+	// triggerCollectionCompaction triggers a compaction of the collection if any compaction condition satisfy
+	triggerCollectionCompaction(collectionID int64) error
 }
 
 type compactionSignal struct {
@@ -284,6 +287,32 @@ func (t *compactionTrigger) triggerSingleCompaction(collectionID, partitionID, s
 	return nil
 }
 
+// triggerCollectionCompaction triggers a global compaction limited to the collection,
+// invoked once the collection gets many small segments without waiting for the global compaction loop.
+func (t *compactionTrigger) triggerCollectionCompaction(collectionID int64) error {
+	// If AutoCompaction disabled, the global compaction is not triggered either
+	if !Params.DataCoordCfg.EnableAutoCompaction.GetAsBool() {
+		return nil
+	}
+
+	id, err := t.allocSignalID()
+	if err != nil {
+		return err
+	}
+	signal := &compactionSignal{
+		id:           id,
+		isForce:      false,
+		isGlobal:     true,
+		collectionID: collectionID,
+	}
+	select {
+	case t.signals <- signal:
+	default:
+		log.Info("no space to send compaction signal", zap.Int64("collectionID", collectionID))
+	}
+	return nil
+}
+
 // forceTriggerCompaction force to start a compaction
 // invoked by user `ManualCompaction` operation
 func (t *compactionTrigger) forceTriggerCompaction(collectionID int64) (UniqueID, error) {
@@ -479,7 +508,14 @@ func (t *compactionTrigger) handleGlobalSignal(signal *compactionSignal) error {
 			return err
 		}
 
-		plans := t.generatePlans(group.segments, signal.isForce, isDiskIndex, ct)
+		group.segments = t.dropExpiredSegments(group.segments, ct)
+
+		label := &CompactionGroupLabel{
+			CollectionID: group.collectionID,
+			PartitionID:  group.partitionID,
+			Channel:      group.channelName,
+		}
+		plans := t.generatePolicyPlans(coll, label, group.segments, signal.isForce, isDiskIndex, ct)
 		for _, plan := range plans {
 			segIDs := fetchSegIDs(plan.GetSegmentBinlogs())
 
@@ -589,7 +625,12 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 		return
 	}
 
-	plans := t.generatePlans(segments, signal.isForce, isDiskIndex, ct)
+	label := &CompactionGroupLabel{
+		CollectionID: collectionID,
+		PartitionID:  partitionID,
+		Channel:      channel,
+	}
+	plans := t.generatePolicyPlans(coll, label, segments, signal.isForce, isDiskIndex, ct)
 	for _, plan := range plans {
 		if t.compactionHandler.isFull() {
 			log.Warn("compaction plan skipped due to handler full", zap.Int64("collection", signal.collectionID), zap.Int64("planID", plan.PlanID))
@@ -618,6 +659,59 @@ func (t *compactionTrigger) handleSignal(signal *compactionSignal) {
 	}
 }
 
+// getCompactionPolicy returns the compaction policy by name, nil if not found.
+func (t *compactionTrigger) getCompactionPolicy(name string) CompactionPolicy {
+	switch name {
+	case MixCompactionPolicyName:
+		return &mixCompactionPolicy{trigger: t}
+	case SortKeyCompactionPolicyName:
+		return &sortKeyCompactionPolicy{trigger: t}
+	}
+	policy, _ := compactionPolicies.Get(name)
+	return policy
+}
+
+// generatePolicyPlans generates the compaction plans by the policies selected by collection in order.
+func (t *compactionTrigger) generatePolicyPlans(coll *collectionInfo, label *CompactionGroupLabel, segments []*SegmentInfo, force bool, isDiskIndex bool, compactTime *compactTime) []*datapb.CompactionPlan {
+	var plans []*datapb.CompactionPlan
+	for _, name := range getCollectionCompactionPolicies(coll.Properties) {
+		// L0 compaction is triggered by compaction view manager
+		if name == L0CompactionPolicyName {
+			continue
+		}
+		policy := t.getCompactionPolicy(name)
+		if policy == nil {
+			log.RatedWarn(60, "compaction policy not found, skip it",
+				zap.Int64("collectionID", coll.ID),
+				zap.String("policy", name))
+			continue
+		}
+		if len(segments) == 0 {
+			break
+		}
+
+		policyPlans := policy.GeneratePlans(context.TODO(), &CompactionPolicyInput{
+			Label:         label,
+			Properties:    coll.Properties,
+			Schema:        coll.Schema,
+			Segments:      segments,
+			Force:         force,
+			IsDiskIndex:   isDiskIndex,
+			ExpireTime:    compactTime.expireTime,
+			CollectionTTL: compactTime.collectionTTL,
+		})
+		planned := typeutil.NewUniqueSet()
+		for _, plan := range policyPlans {
+			planned.Insert(fetchSegIDs(plan.GetSegmentBinlogs())...)
+		}
+		segments = lo.Filter(segments, func(segment *SegmentInfo, _ int) bool {
+			return !planned.Contain(segment.GetID())
+		})
+		plans = append(plans, policyPlans...)
+	}
+	return plans
+}
+
 func (t *compactionTrigger) generatePlans(segments []*SegmentInfo, force bool, isDiskIndex bool, compactTime *compactTime) []*datapb.CompactionPlan {
 	// find segments need internal compaction
 	// TODO add low priority candidates, for example if the segment is smaller than full 0.9 * max segment size but larger than small segment boundary, we only execute compaction when there are no compaction running actively
@@ -901,6 +995,50 @@ func (t *compactionTrigger) isStaleSegment(segment *SegmentInfo) bool {
 	return time.Since(segment.lastFlushTime).Minutes() >= segmentTimedFlushDuration
 }
 
+// dropExpiredSegments drops the segments with all the rows expired by the collection ttl
+// directly instead of compacting them, and returns the remaining segments.
+func (t *compactionTrigger) dropExpiredSegments(segments []*SegmentInfo, compactTime *compactTime) []*SegmentInfo {
+	remains := make([]*SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		if !isSegmentExpired(segment, compactTime.expireTime) {
+			remains = append(remains, segment)
+			continue
+		}
+		// the segment being compacted is left to the compaction, which drops it anyway
+		dropped, err := t.meta.DropSegmentIfNotCompacting(segment.GetID())
+		if err != nil {
+			log.Warn("failed to drop expired segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			continue
+		}
+		if !dropped {
+			log.Info("skip dropping the expired segment being compacted", zap.Int64("segmentID", segment.GetID()))
+			continue
+		}
+		log.Info("drop expired segment",
+			zap.Int64("collectionID", segment.GetCollectionID()),
+			zap.Int64("segmentID", segment.GetID()),
+			zap.Int64("numRows", segment.GetNumOfRows()),
+			zap.Uint64("expireTime", compactTime.expireTime))
+		metrics.DataCoordExpiredRows.WithLabelValues(fmt.Sprint(segment.GetCollectionID())).Add(float64(segment.GetNumOfRows()))
+	}
+	return remains
+}
+
+// isSegmentExpired returns whether all the binlogs of the segment are written before the expire time.
+func isSegmentExpired(segment *SegmentInfo, expireTime Timestamp) bool {
+	if expireTime == 0 || len(segment.GetBinlogs()) == 0 {
+		return false
+	}
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetTimestampTo() == 0 || binlog.GetTimestampTo() >= expireTime {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (t *compactionTrigger) ShouldDoSingleCompaction(segment *SegmentInfo, isDiskIndex bool, compactTime *compactTime) bool {
 	// no longer restricted binlog numbers because this is now related to field numbers
 
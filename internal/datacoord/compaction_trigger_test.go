@@ -80,6 +80,10 @@ func (h *spyCompactionHandler) getCompactionTasksBySignalID(signalID int64) []*c
 	panic("not implemented") // TODO: Implement
 }
 
+func (h *spyCompactionHandler) cancelCompaction(signalID int64) error {
+	panic("not implemented") // TODO: Implement
+}
+
 func (h *spyCompactionHandler) start() {}
 
 func (h *spyCompactionHandler) stop() {}
@@ -2018,12 +2022,12 @@ func Test_compactionTrigger_new(t *testing.T) {
 }
 
 func Test_compactionTrigger_allocTs(t *testing.T) {
-	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{scheduler: NewCompactionScheduler()}, newMockAllocator(), newMockHandler(), newMockVersionManager())
+	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{scheduler: NewCompactionScheduler(nil)}, newMockAllocator(), newMockHandler(), newMockVersionManager())
 	ts, err := got.allocTs()
 	assert.NoError(t, err)
 	assert.True(t, ts > 0)
 
-	got = newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{scheduler: NewCompactionScheduler()}, &FailsAllocator{}, newMockHandler(), newMockVersionManager())
+	got = newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{scheduler: NewCompactionScheduler(nil)}, &FailsAllocator{}, newMockHandler(), newMockVersionManager())
 	ts, err = got.allocTs()
 	assert.Error(t, err)
 	assert.Equal(t, uint64(0), ts)
@@ -2050,7 +2054,7 @@ func Test_compactionTrigger_getCompactTime(t *testing.T) {
 	}
 
 	m := &meta{segments: NewSegmentsInfo(), collections: collections}
-	got := newCompactionTrigger(m, &compactionPlanHandler{scheduler: NewCompactionScheduler()}, newMockAllocator(),
+	got := newCompactionTrigger(m, &compactionPlanHandler{scheduler: NewCompactionScheduler(nil)}, newMockAllocator(),
 		&ServerHandler{
 			&Server{
 				meta: m,
@@ -2572,6 +2576,35 @@ func (s *CompactionTriggerSuite) TestHandleGlobalSignal() {
 	})
 }
 
+func (s *CompactionTriggerSuite) TestDropExpiredSegments() {
+	defer s.SetupTest()
+	catalog := s.meta.catalog.(*mocks.DataCoordCatalog)
+	catalog.EXPECT().AlterSegments(mock.Anything, mock.Anything).Return(nil).Once()
+
+	s.meta.segments.GetSegment(1).Binlogs[0].Binlogs[0].TimestampTo = 100
+	s.meta.segments.GetSegment(2).Binlogs[0].Binlogs[0].TimestampTo = 300
+	segments := []*SegmentInfo{s.meta.GetSegment(1), s.meta.GetSegment(2), s.meta.GetSegment(3)}
+
+	// no ttl
+	remains := s.tr.dropExpiredSegments(segments, &compactTime{})
+	s.Len(remains, 3)
+
+	remains = s.tr.dropExpiredSegments(segments, &compactTime{expireTime: 200})
+	s.ElementsMatch([]int64{2, 3}, lo.Map(remains, func(segment *SegmentInfo, _ int) int64 {
+		return segment.GetID()
+	}))
+	s.Equal(commonpb.SegmentState_Dropped, s.meta.GetSegment(1).GetState())
+	s.Equal(commonpb.SegmentState_Flushed, s.meta.GetSegment(2).GetState())
+
+	// the segment being compacted is not dropped
+	s.meta.SetSegmentCompacting(2, true)
+	remains = s.tr.dropExpiredSegments([]*SegmentInfo{s.meta.GetSegment(2), s.meta.GetSegment(3)}, &compactTime{expireTime: 400})
+	s.ElementsMatch([]int64{3}, lo.Map(remains, func(segment *SegmentInfo, _ int) int64 {
+		return segment.GetID()
+	}))
+	s.Equal(commonpb.SegmentState_Flushed, s.meta.GetSegment(2).GetState())
+}
+
 func (s *CompactionTriggerSuite) TestIsChannelCheckpointHealthy() {
 	ptKey := paramtable.Get().DataCoordCfg.ChannelCheckpointMaxLag.Key
 	s.Run("ok", func() {
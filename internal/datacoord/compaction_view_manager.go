@@ -171,6 +171,11 @@ func (m *CompactionViewManager) Check(ctx context.Context) (events map[Compactio
 func (m *CompactionViewManager) RefreshLevelZeroViews(latestCollSegs map[int64][]*SegmentInfo) []CompactionView {
 	var allRefreshedL0Veiws []CompactionView
 	for collID, segments := range latestCollSegs {
+		if coll := m.meta.GetCollection(collID); coll != nil && !isCompactionPolicySelected(coll.Properties, L0CompactionPolicyName) {
+			delete(m.view.collections, collID)
+			continue
+		}
+
 		levelZeroSegments := lo.Filter(segments, func(info *SegmentInfo, _ int) bool {
 			return info.GetLevel() == datapb.SegmentLevel_L0
 		})
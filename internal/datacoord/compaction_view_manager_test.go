@@ -13,6 +13,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -230,6 +231,19 @@ func (s *CompactionViewManagerSuite) TestNotifyTrigger() {
 	s.m.notifyTrigger(ctx, events)
 }
 
+func (s *CompactionViewManagerSuite) TestCheckL0PolicyNotSelected() {
+	s.m.meta.collections = map[int64]*collectionInfo{
+		s.testLabel.CollectionID: {
+			ID:         s.testLabel.CollectionID,
+			Properties: map[string]string{common.CollectionCompactionPolicyKey: MixCompactionPolicyName},
+		},
+	}
+
+	events := s.m.Check(context.Background())
+	s.Empty(events)
+	s.Empty(s.m.view.collections)
+}
+
 func (s *CompactionViewManagerSuite) TestCheck() {
 	// nothing in the view before the test
 	ctx := context.Background()
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"path"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -51,6 +52,7 @@ type GcOption struct {
 	checkInterval    time.Duration        // each interval
 	missingTolerance time.Duration        // key missing in meta tolerance time
 	dropTolerance    time.Duration        // dropped segment related key tolerance time
+	restoreWindow    time.Duration        // dropped segment could be restored within it, not collected before expired
 	scanInterval     time.Duration        // interval for scan residue for interupted log wrttien
 
 	removeLogPool *conc.Pool[struct{}]
@@ -83,7 +85,8 @@ func newGarbageCollector(meta *meta, handler Handler, opt GcOption) *garbageColl
 		zap.Duration("interval", opt.checkInterval),
 		zap.Duration("scanInterval", opt.scanInterval),
 		zap.Duration("missingTolerance", opt.missingTolerance),
-		zap.Duration("dropTolerance", opt.dropTolerance))
+		zap.Duration("dropTolerance", opt.dropTolerance),
+		zap.Duration("restoreWindow", opt.restoreWindow))
 	opt.removeLogPool = conc.NewPool[struct{}](Params.DataCoordCfg.GCRemoveConcurrent.GetAsInt(), conc.WithExpiryDuration(time.Minute))
 	return &garbageCollector{
 		meta:    meta,
@@ -163,6 +166,7 @@ func (gc *garbageCollector) work() {
 			gc.recycleUnusedIndexes()
 			gc.recycleUnusedSegIndexes()
 			gc.recycleUnusedIndexFiles()
+			gc.recycleIdempotencyRecords()
 		case <-scanTicker.C:
 			log.Info("Garbage collector start to scan interrupted write residue")
 			gc.scan()
@@ -199,7 +203,7 @@ func (gc *garbageCollector) close() {
 // scan load meta file info and compares OSS keys
 // if missing found, performs gc cleanup
 func (gc *garbageCollector) scan() {
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(storage.WithRequestClass(context.Background(), storage.RequestClassGC))
 	defer cancel()
 
 	var (
@@ -207,77 +211,67 @@ func (gc *garbageCollector) scan() {
 		valid   = 0
 		missing = 0
 	)
-	getMetaMap := func() (typeutil.UniqueSet, typeutil.Set[string]) {
-		segmentMap := typeutil.NewUniqueSet()
-		filesMap := typeutil.NewSet[string]()
-		segments := gc.meta.GetAllSegmentsUnsafe()
-		for _, segment := range segments {
-			cloned := segment.Clone()
-			binlog.DecompressBinLogs(cloned.SegmentInfo)
-			segmentMap.Insert(segment.GetID())
-			for _, log := range getLogs(cloned) {
-				filesMap.Insert(log.GetLogPath())
-			}
-		}
-		return segmentMap, filesMap
-	}
-
 	// walk only data cluster related prefixes
-	prefixes := make([]string, 0, 3)
+	prefixes := make([]string, 0, 5)
 	prefixes = append(prefixes, path.Join(gc.option.cli.RootPath(), common.SegmentInsertLogPath))
 	prefixes = append(prefixes, path.Join(gc.option.cli.RootPath(), common.SegmentStatslogPath))
 	prefixes = append(prefixes, path.Join(gc.option.cli.RootPath(), common.SegmentDeltaLogPath))
-	labels := []string{metrics.InsertFileLabel, metrics.StatFileLabel, metrics.DeleteFileLabel}
+	prefixes = append(prefixes, path.Join(gc.option.cli.RootPath(), common.SegmentArtifactLogPath))
+	prefixes = append(prefixes, path.Join(gc.option.cli.RootPath(), common.SegmentParquetLogPath))
+	labels := []string{metrics.InsertFileLabel, metrics.StatFileLabel, metrics.DeleteFileLabel, metrics.ArtifactFileLabel, metrics.ParquetFileLabel}
 	var removedKeys []string
 
-	for idx, prefix := range prefixes {
-		startTs := time.Now()
-		infoKeys, modTimes, err := gc.option.cli.ListWithPrefix(ctx, prefix, true)
-		if err != nil {
-			log.Error("failed to list files with prefix",
-				zap.String("prefix", prefix),
-				zap.Error(err),
-			)
-		}
-		cost := time.Since(startTs)
-		segmentMap, filesMap := getMetaMap()
-		metrics.GarbageCollectorListLatency.
-			WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), labels[idx]).
-			Observe(float64(cost.Milliseconds()))
-		log.Info("gc scan finish list object", zap.String("prefix", prefix), zap.Duration("time spent", cost), zap.Int("keys", len(infoKeys)))
-		for i, infoKey := range infoKeys {
-			total++
-			_, has := filesMap[infoKey]
-			if has {
-				valid++
-				continue
-			}
-
-			segmentID, err := storage.ParseSegmentIDByBinlog(gc.option.cli.RootPath(), infoKey)
+	// the files of the collections placed in their own buckets are listed by the paths under the cluster root path as well
+	for _, cli := range gc.buckets(ctx) {
+		for idx, prefix := range prefixes {
+			startTs := time.Now()
+			infoKeys, modTimes, err := cli.ListWithPrefix(ctx, prefix, true)
 			if err != nil {
-				missing++
-				log.Warn("parse segment id error",
-					zap.String("infoKey", infoKey),
-					zap.Error(err))
-				continue
-			}
-
-			if strings.Contains(prefix, common.SegmentInsertLogPath) &&
-				segmentMap.Contain(segmentID) {
-				valid++
-				continue
+				log.Error("failed to list files with prefix",
+					zap.String("prefix", prefix),
+					zap.Error(err),
+				)
 			}
+			cost := time.Since(startTs)
+			segmentMap, filesMap := gc.getMetaFiles()
+			metrics.GarbageCollectorListLatency.
+				WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), labels[idx]).
+				Observe(float64(cost.Milliseconds()))
+			log.Info("gc scan finish list object", zap.String("prefix", prefix), zap.Duration("time spent", cost), zap.Int("keys", len(infoKeys)))
+			for i, infoKey := range infoKeys {
+				total++
+				_, has := filesMap[infoKey]
+				if has {
+					valid++
+					continue
+				}
 
-			// not found in meta, check last modified time exceeds tolerance duration
-			if time.Since(modTimes[i]) > gc.option.missingTolerance {
-				// ignore error since it could be cleaned up next time
-				removedKeys = append(removedKeys, infoKey)
-				err = gc.option.cli.Remove(ctx, infoKey)
+				segmentID, err := storage.ParseSegmentIDByBinlog(gc.option.cli.RootPath(), infoKey)
 				if err != nil {
 					missing++
-					log.Error("failed to remove object",
+					log.Warn("parse segment id error",
 						zap.String("infoKey", infoKey),
 						zap.Error(err))
+					continue
+				}
+
+				if strings.Contains(prefix, common.SegmentInsertLogPath) &&
+					segmentMap.Contain(segmentID) {
+					valid++
+					continue
+				}
+
+				// not found in meta, check last modified time exceeds tolerance duration
+				if time.Since(modTimes[i]) > gc.option.missingTolerance {
+					// ignore error since it could be cleaned up next time
+					removedKeys = append(removedKeys, infoKey)
+					err = cli.Remove(ctx, infoKey)
+					if err != nil {
+						missing++
+						log.Error("failed to remove object",
+							zap.String("infoKey", infoKey),
+							zap.Error(err))
+					}
 				}
 			}
 		}
@@ -290,6 +284,31 @@ func (gc *garbageCollector) scan() {
 		zap.Strings("removedKeys", removedKeys))
 }
 
+// buckets returns the chunk managers of the cluster bucket and the collection buckets, the collections of the
+// healthy segments are described to register their storages in case not yet.
+func (gc *garbageCollector) buckets(ctx context.Context) []storage.ChunkManager {
+	routing, ok := gc.option.cli.(*storage.RoutingChunkManager)
+	if !ok {
+		return []storage.ChunkManager{gc.option.cli}
+	}
+	collectionIDs := typeutil.NewUniqueSet()
+	for _, segment := range gc.meta.SelectSegments(isSegmentHealthy) {
+		collectionIDs.Insert(segment.GetCollectionID())
+	}
+	for collectionID := range collectionIDs {
+		if gc.meta.GetCollection(collectionID) == nil && gc.handler != nil {
+			// the dropped ones are ignored
+			_, _ = gc.handler.GetCollection(ctx, collectionID)
+		}
+	}
+	buckets, err := routing.CollectionBuckets(ctx)
+	if err != nil {
+		log.Warn("failed to get the collection buckets, only the cluster bucket collected", zap.Error(err))
+		return []storage.ChunkManager{gc.option.cli}
+	}
+	return append([]storage.ChunkManager{gc.option.cli}, buckets...)
+}
+
 func (gc *garbageCollector) checkDroppedSegmentGC(segment *SegmentInfo,
 	childSegment *SegmentInfo,
 	indexSet typeutil.UniqueSet,
@@ -330,7 +349,8 @@ func (gc *garbageCollector) checkDroppedSegmentGC(segment *SegmentInfo,
 	return true
 }
 
-func (gc *garbageCollector) clearEtcd() {
+// recyclableSegments returns the dropped segments whose meta and logs could be recycled, sorted by segment id.
+func (gc *garbageCollector) recyclableSegments() []*SegmentInfo {
 	all := gc.meta.SelectSegments(func(si *SegmentInfo) bool { return true })
 	drops := make(map[int64]*SegmentInfo, 0)
 	compactTo := make(map[int64]*SegmentInfo)
@@ -372,30 +392,40 @@ func (gc *garbageCollector) clearEtcd() {
 		return dropIDs[i] < dropIDs[j]
 	})
 
-	log.Info("start to GC segments", zap.Int("drop_num", len(dropIDs)))
+	recyclable := make([]*SegmentInfo, 0, len(dropIDs))
 	for _, segmentID := range dropIDs {
-		segment, ok := drops[segmentID]
-		if !ok {
-			log.Warn("segmentID is not in drops", zap.Int64("segmentID", segmentID))
-			continue
+		segment := drops[segmentID]
+		if gc.checkDroppedSegmentGC(segment, compactTo[segment.GetID()], indexedSet, channelCPs[segment.GetInsertChannel()]) {
+			recyclable = append(recyclable, segment)
 		}
+	}
+	return recyclable
+}
 
+func (gc *garbageCollector) clearEtcd() {
+	segments := gc.recyclableSegments()
+	log.Info("start to GC segments", zap.Int("drop_num", len(segments)))
+	refs := gc.getFileReferences()
+	for _, segment := range segments {
 		segInsertChannel := segment.GetInsertChannel()
-		if !gc.checkDroppedSegmentGC(segment, compactTo[segment.GetID()], indexedSet, channelCPs[segInsertChannel]) {
-			continue
-		}
-
-		logs := getLogs(segment)
+		// the logs shared with the other segments or snapshots are kept
+		logs := lo.Filter(getLogs(segment), func(l *datapb.Binlog, _ int) bool {
+			return refs[l.GetLogPath()] <= 1
+		})
 		log.Info("GC segment", zap.Int64("segmentID", segment.GetID()),
 			zap.Int("insert_logs", len(segment.GetBinlogs())),
 			zap.Int("delta_logs", len(segment.GetDeltalogs())),
-			zap.Int("stats_logs", len(segment.GetStatslogs())))
+			zap.Int("stats_logs", len(segment.GetStatslogs())),
+			zap.Int("shared_logs", len(getLogs(segment))-len(logs)))
 		if gc.removeLogs(logs) {
 			err := gc.meta.DropSegment(segment.GetID())
 			if err != nil {
 				log.Info("GC segment meta failed to drop segment", zap.Int64("segment id", segment.GetID()), zap.Error(err))
 			} else {
 				log.Info("GC segment meta drop semgent", zap.Int64("segment id", segment.GetID()))
+				for _, l := range getLogs(segment) {
+					refs[l.GetLogPath()]--
+				}
 			}
 		}
 		if segList := gc.meta.GetSegmentsByChannel(segInsertChannel); len(segList) == 0 &&
@@ -410,7 +440,11 @@ func (gc *garbageCollector) clearEtcd() {
 
 func (gc *garbageCollector) isExpire(dropts Timestamp) bool {
 	droptime := time.Unix(0, int64(dropts))
-	return time.Since(droptime) > gc.option.dropTolerance
+	tolerance := gc.option.dropTolerance
+	if gc.option.restoreWindow > tolerance {
+		tolerance = gc.option.restoreWindow
+	}
+	return time.Since(droptime) > tolerance
 }
 
 func getLogs(sinfo *SegmentInfo) []*datapb.Binlog {
@@ -426,11 +460,19 @@ func getLogs(sinfo *SegmentInfo) []*datapb.Binlog {
 	for _, flog := range sinfo.GetDeltalogs() {
 		logs = append(logs, flog.GetBinlogs()...)
 	}
+
+	for _, alog := range sinfo.GetArtifactlogs() {
+		logs = append(logs, alog.GetBinlogs()...)
+	}
+
+	for _, plog := range sinfo.GetParquetlogs() {
+		logs = append(logs, plog.GetBinlogs()...)
+	}
 	return logs
 }
 
 func (gc *garbageCollector) removeLogs(logs []*datapb.Binlog) bool {
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(storage.WithRequestClass(context.Background(), storage.RequestClassGC))
 	defer cancel()
 	var w sync.WaitGroup
 	w.Add(len(logs))
@@ -497,12 +539,26 @@ func (gc *garbageCollector) recycleUnusedSegIndexes() {
 // recycleUnusedIndexFiles is used to delete those index files that no longer exist in the meta.
 func (gc *garbageCollector) recycleUnusedIndexFiles() {
 	log.Info("start recycleUnusedIndexFiles")
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(storage.WithRequestClass(context.Background(), storage.RequestClassGC))
 	defer cancel()
+	// the index files referenced by the index cache are kept until the cache entries expired,
+	// and the ones referenced by the snapshots until the snapshots dropped
+	keptBuildIDs, err := gc.keptIndexBuildIDs(ctx, true)
+	if err != nil {
+		log.Warn("garbageCollector recycleUnusedIndexFiles list index cache failed", zap.Error(err))
+		return
+	}
+	for _, cli := range gc.buckets(ctx) {
+		gc.recycleUnusedIndexFilesOf(ctx, cli, keptBuildIDs)
+	}
+}
+
+// recycleUnusedIndexFilesOf recycles the unused index files in the bucket.
+func (gc *garbageCollector) recycleUnusedIndexFilesOf(ctx context.Context, cli storage.ChunkManager, keptBuildIDs typeutil.UniqueSet) {
 	startTs := time.Now()
 	prefix := path.Join(gc.option.cli.RootPath(), common.SegmentIndexPath) + "/"
 	// list dir first
-	keys, _, err := gc.option.cli.ListWithPrefix(ctx, prefix, false)
+	keys, _, err := cli.ListWithPrefix(ctx, prefix, false)
 	if err != nil {
 		log.Warn("garbageCollector recycleUnusedIndexFiles list keys from chunk manager failed", zap.Error(err))
 		return
@@ -523,11 +579,15 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 			log.Info("garbageCollector can not recycle index files", zap.Int64("buildID", buildID))
 			continue
 		}
+		if segIdx == nil && keptBuildIDs.Contain(buildID) {
+			log.Info("garbageCollector can not recycle index files referenced by the index cache or snapshots", zap.Int64("buildID", buildID))
+			continue
+		}
 		if segIdx == nil {
 			// buildID no longer exists in meta, remove all index files
 			log.Info("garbageCollector recycleUnusedIndexFiles find meta has not exist, remove index files",
 				zap.Int64("buildID", buildID))
-			err = gc.option.cli.RemoveWithPrefix(ctx, key)
+			err = cli.RemoveWithPrefix(ctx, key)
 			if err != nil {
 				log.Warn("garbageCollector recycleUnusedIndexFiles remove index files failed",
 					zap.Int64("buildID", buildID), zap.String("prefix", key), zap.Error(err))
@@ -543,7 +603,7 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 				segIdx.PartitionID, segIdx.SegmentID, fileID)
 			filesMap[filepath] = struct{}{}
 		}
-		files, _, err := gc.option.cli.ListWithPrefix(ctx, key, true)
+		files, _, err := cli.ListWithPrefix(ctx, key, true)
 		if err != nil {
 			log.Warn("garbageCollector recycleUnusedIndexFiles list files failed",
 				zap.Int64("buildID", buildID), zap.String("prefix", key), zap.Error(err))
@@ -554,7 +614,7 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 		deletedFilesNum := 0
 		for _, file := range files {
 			if _, ok := filesMap[file]; !ok {
-				if err = gc.option.cli.Remove(ctx, file); err != nil {
+				if err = cli.Remove(ctx, file); err != nil {
 					log.Warn("garbageCollector recycleUnusedIndexFiles remove file failed",
 						zap.Int64("buildID", buildID), zap.String("file", file), zap.Error(err))
 					continue
@@ -566,3 +626,228 @@ func (gc *garbageCollector) recycleUnusedIndexFiles() {
 			zap.Int("delete index files num", deletedFilesNum))
 	}
 }
+
+// cachedIndexBuildIDs returns the builds whose index files are referenced by the index cache,
+// the index files are not kept for the cache once it's disabled.
+func (gc *garbageCollector) cachedIndexBuildIDs(ctx context.Context, removeExpired bool) (typeutil.UniqueSet, error) {
+	if !Params.DataCoordCfg.IndexCacheEnabled.GetAsBool() {
+		return typeutil.NewUniqueSet(), nil
+	}
+	return listIndexCache(ctx, gc.option.cli, Params.DataCoordCfg.IndexCacheTTL.GetAsDuration(time.Second), removeExpired)
+}
+
+// keptIndexBuildIDs returns the builds whose index files are kept even if the segment indexes dropped,
+// which are referenced by the index cache or the snapshots.
+func (gc *garbageCollector) keptIndexBuildIDs(ctx context.Context, removeExpired bool) (typeutil.UniqueSet, error) {
+	buildIDs, err := gc.cachedIndexBuildIDs(ctx, removeExpired)
+	if err != nil {
+		return nil, err
+	}
+	if gc.meta.snapshotMeta != nil {
+		buildIDs.Insert(gc.meta.snapshotMeta.GetReferencedIndexBuildIDs().Collect()...)
+	}
+	return buildIDs, nil
+}
+
+// recycleIdempotencyRecords drops the idempotency records out of the deduplication window.
+func (gc *garbageCollector) recycleIdempotencyRecords() {
+	if gc.meta.idempotencyMeta == nil {
+		return
+	}
+	gc.meta.idempotencyMeta.RemoveExpiredRecords(time.Now())
+}
+
+// audit runs the garbage collection in audit mode, which reports the orphan files in object storage,
+// the files referenced by meta but missing and the recyclable dropped segments, nothing is removed.
+// At most limit orphan and missing files are listed in the response if limit is positive.
+func (gc *garbageCollector) audit(ctx context.Context, limit int) (*datapb.AuditGarbageResponse, error) {
+	ctx = storage.WithRequestClass(ctx, storage.RequestClassGC)
+	resp := &datapb.AuditGarbageResponse{}
+	rootPath := gc.option.cli.RootPath()
+
+	// the files are written before meta updated, so the meta files collected before listing shall be listed
+	missingCandidates := gc.getAuditMetaFiles()
+	listed := typeutil.NewSet[string]()
+
+	prefixes := []string{
+		path.Join(rootPath, common.SegmentInsertLogPath),
+		path.Join(rootPath, common.SegmentStatslogPath),
+		path.Join(rootPath, common.SegmentDeltaLogPath),
+		path.Join(rootPath, common.SegmentArtifactLogPath),
+		path.Join(rootPath, common.SegmentParquetLogPath),
+	}
+	labels := []string{metrics.InsertFileLabel, metrics.StatFileLabel, metrics.DeleteFileLabel, metrics.ArtifactFileLabel, metrics.ParquetFileLabel}
+	keptBuildIDs, err := gc.keptIndexBuildIDs(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	indexPrefix := path.Join(rootPath, common.SegmentIndexPath) + "/"
+	for _, cli := range gc.buckets(ctx) {
+		for idx, prefix := range prefixes {
+			keys, modTimes, err := cli.ListWithPrefix(ctx, prefix, true)
+			if err != nil {
+				return nil, err
+			}
+			segmentSet, filesSet := gc.getMetaFiles()
+			for i, key := range keys {
+				listed.Insert(key)
+				if filesSet.Contain(key) {
+					continue
+				}
+				segmentID, err := storage.ParseSegmentIDByBinlog(rootPath, key)
+				if err != nil {
+					continue
+				}
+				if idx == 0 && segmentSet.Contain(segmentID) {
+					continue
+				}
+				gc.auditOrphan(ctx, cli, resp, key, labels[idx], time.Since(modTimes[i]) > gc.option.missingTolerance, limit)
+			}
+		}
+
+		// index files of the build not in meta or not referenced by the finished build are orphans
+		keys, _, err := cli.ListWithPrefix(ctx, indexPrefix, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			listed.Insert(key)
+			buildID, err := strconv.ParseInt(strings.Split(strings.TrimPrefix(key, indexPrefix), "/")[0], 10, 64)
+			if err != nil {
+				continue
+			}
+			canRecycle, segIdx := gc.meta.indexMeta.CleanSegmentIndex(buildID)
+			if !canRecycle || (segIdx == nil && keptBuildIDs.Contain(buildID)) {
+				continue
+			}
+			if segIdx != nil && lo.ContainsBy(segIdx.IndexFileKeys, func(fileKey string) bool {
+				return metautil.BuildSegmentIndexFilePath(rootPath, segIdx.BuildID, segIdx.IndexVersion,
+					segIdx.PartitionID, segIdx.SegmentID, fileKey) == key
+			}) {
+				continue
+			}
+			gc.auditOrphan(ctx, cli, resp, key, metrics.IndexFileLabel, true, limit)
+		}
+	}
+
+	for _, file := range missingCandidates {
+		if listed.Contain(file.GetPath()) {
+			continue
+		}
+		resp.MissingFileNum++
+		if limit <= 0 || len(resp.MissingFiles) < limit {
+			resp.MissingFiles = append(resp.MissingFiles, file)
+		}
+	}
+
+	for _, segment := range gc.recyclableSegments() {
+		resp.RecyclableSegmentIDs = append(resp.RecyclableSegmentIDs, segment.GetID())
+		for _, l := range getLogs(segment) {
+			resp.RecyclableSegmentBytes += l.GetLogSize()
+		}
+		for _, segIdx := range gc.meta.indexMeta.getSegmentIndexes(segment.GetID()) {
+			resp.RecyclableSegmentBytes += int64(segIdx.IndexSize)
+		}
+	}
+	resp.ReclaimableBytes = resp.OrphanBytes + resp.RecyclableSegmentBytes
+
+	log.Info("garbage collection audit done",
+		zap.Int64("orphanFileNum", resp.GetOrphanFileNum()),
+		zap.Int64("orphanBytes", resp.GetOrphanBytes()),
+		zap.Int64("missingFileNum", resp.GetMissingFileNum()),
+		zap.Int("recyclableSegmentNum", len(resp.GetRecyclableSegmentIDs())),
+		zap.Int64("reclaimableBytes", resp.GetReclaimableBytes()))
+	return resp, nil
+}
+
+func (gc *garbageCollector) auditOrphan(ctx context.Context, cli storage.ChunkManager, resp *datapb.AuditGarbageResponse, key string, fileType string, expired bool, limit int) {
+	size, err := cli.Size(ctx, key)
+	if err != nil {
+		log.Warn("failed to get size of orphan file", zap.String("key", key), zap.Error(err))
+	}
+	resp.OrphanFileNum++
+	resp.OrphanBytes += size
+	if limit <= 0 || len(resp.OrphanFiles) < limit {
+		resp.OrphanFiles = append(resp.OrphanFiles, &datapb.OrphanFile{
+			Path:     key,
+			FileType: fileType,
+			Size:     size,
+			Expired:  expired,
+		})
+	}
+}
+
+// getMetaFiles returns the ids of the segments and the log paths in meta, including the ones referenced by snapshots.
+func (gc *garbageCollector) getMetaFiles() (typeutil.UniqueSet, typeutil.Set[string]) {
+	segmentSet := typeutil.NewUniqueSet()
+	filesSet := typeutil.NewSet[string]()
+	for _, segment := range gc.meta.GetAllSegmentsUnsafe() {
+		cloned := segment.Clone()
+		binlog.DecompressBinLogs(cloned.SegmentInfo)
+		segmentSet.Insert(segment.GetID())
+		for _, log := range getLogs(cloned) {
+			filesSet.Insert(log.GetLogPath())
+		}
+	}
+	if gc.meta.snapshotMeta != nil {
+		filesSet.Insert(lo.Keys(gc.meta.snapshotMeta.GetReferencedFiles())...)
+	}
+	return segmentSet, filesSet
+}
+
+// getFileReferences returns the reference counts of the log paths by the segments in meta and the snapshots,
+// the segments restored from snapshots share the binlogs with the source segments.
+func (gc *garbageCollector) getFileReferences() map[string]int {
+	refs := make(map[string]int)
+	if gc.meta.snapshotMeta != nil {
+		refs = gc.meta.snapshotMeta.GetReferencedFiles()
+	}
+	for _, segment := range gc.meta.GetAllSegmentsUnsafe() {
+		cloned := segment.Clone()
+		binlog.DecompressBinLogs(cloned.SegmentInfo)
+		for _, l := range getLogs(cloned) {
+			refs[l.GetLogPath()]++
+		}
+	}
+	return refs
+}
+
+// getAuditMetaFiles returns the files referenced by the segments not dropped and their finished indexes,
+// the dropped segments are skipped as their files may be removed by gc at any time.
+func (gc *garbageCollector) getAuditMetaFiles() []*datapb.MissingFile {
+	var files []*datapb.MissingFile
+	segments := gc.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetState() != commonpb.SegmentState_Dropped
+	})
+	rootPath := gc.option.cli.RootPath()
+	for _, segment := range segments {
+		cloned := segment.Clone()
+		binlog.DecompressBinLogs(cloned.SegmentInfo)
+		fileTypes := []string{metrics.InsertFileLabel, metrics.StatFileLabel, metrics.DeleteFileLabel}
+		for idx, fieldBinlogs := range [][]*datapb.FieldBinlog{cloned.GetBinlogs(), cloned.GetStatslogs(), cloned.GetDeltalogs()} {
+			for _, fieldBinlog := range fieldBinlogs {
+				for _, l := range fieldBinlog.GetBinlogs() {
+					files = append(files, &datapb.MissingFile{
+						Path:      l.GetLogPath(),
+						FileType:  fileTypes[idx],
+						SegmentID: segment.GetID(),
+					})
+				}
+			}
+		}
+		for _, segIdx := range gc.meta.indexMeta.getSegmentIndexes(segment.GetID()) {
+			if segIdx.IsDeleted || segIdx.IndexState != commonpb.IndexState_Finished {
+				continue
+			}
+			for _, indexFile := range segmentIndexFilePaths(rootPath, segIdx) {
+				files = append(files, &datapb.MissingFile{
+					Path:      indexFile,
+					FileType:  metrics.IndexFileLabel,
+					SegmentID: segment.GetID(),
+					BuildID:   segIdx.BuildID,
+				})
+			}
+		}
+	}
+	return files
+}
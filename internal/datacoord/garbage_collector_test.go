@@ -32,6 +32,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -51,6 +52,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -783,6 +785,140 @@ func TestGarbageCollector_recycleUnusedIndexFiles(t *testing.T) {
 	})
 }
 
+func TestGarbageCollector_audit(t *testing.T) {
+	rootPath := "files"
+	insertLog := func(segmentID, logID int64) string {
+		return metautil.BuildInsertLogPath(rootPath, 100, 10, segmentID, 101, logID)
+	}
+	m, err := newMemoryMeta()
+	require.NoError(t, err)
+	err = m.AddSegment(context.TODO(), NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            1,
+		CollectionID:  100,
+		PartitionID:   10,
+		InsertChannel: "ch-1",
+		State:         commonpb.SegmentState_Flushed,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{
+			{LogID: 1, LogPath: insertLog(1, 1), LogSize: 10},
+			{LogID: 2, LogPath: insertLog(1, 2), LogSize: 10},
+		}}},
+	}))
+	require.NoError(t, err)
+	err = m.AddSegment(context.TODO(), NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            2,
+		CollectionID:  100,
+		PartitionID:   10,
+		InsertChannel: "ch-1",
+		State:         commonpb.SegmentState_Dropped,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{
+			{LogID: 3, LogPath: insertLog(2, 3), LogSize: 100},
+		}}},
+	}))
+	require.NoError(t, err)
+
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().RootPath().Return(rootPath)
+	cm.EXPECT().Size(mock.Anything, mock.Anything).Return(20, nil)
+	cm.EXPECT().ListWithPrefix(mock.Anything, mock.Anything, true).RunAndReturn(
+		func(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+			var keys []string
+			switch prefix {
+			case path.Join(rootPath, common.SegmentInsertLogPath):
+				// log 2 of segment 1 is missing, segment 3 is not in meta
+				keys = []string{insertLog(1, 1), insertLog(3, 4)}
+			case path.Join(rootPath, common.SegmentIndexPath) + "/":
+				keys = []string{metautil.BuildSegmentIndexFilePath(rootPath, 500, 1, 10, 3, "file")}
+			}
+			return keys, lo.RepeatBy(len(keys), func(int) time.Time { return time.Now() }), nil
+		})
+
+	gc := newGarbageCollector(m, nil, GcOption{
+		cli:              cm,
+		missingTolerance: time.Hour,
+	})
+	resp, err := gc.audit(context.TODO(), 1)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, resp.GetOrphanFileNum())
+	assert.EqualValues(t, 40, resp.GetOrphanBytes())
+	require.Len(t, resp.GetOrphanFiles(), 1)
+	assert.Equal(t, insertLog(3, 4), resp.GetOrphanFiles()[0].GetPath())
+	assert.False(t, resp.GetOrphanFiles()[0].GetExpired())
+	assert.EqualValues(t, 1, resp.GetMissingFileNum())
+	require.Len(t, resp.GetMissingFiles(), 1)
+	assert.Equal(t, insertLog(1, 2), resp.GetMissingFiles()[0].GetPath())
+	assert.EqualValues(t, 1, resp.GetMissingFiles()[0].GetSegmentID())
+	assert.Equal(t, []int64{2}, resp.GetRecyclableSegmentIDs())
+	assert.EqualValues(t, 100, resp.GetRecyclableSegmentBytes())
+	assert.EqualValues(t, 140, resp.GetReclaimableBytes())
+
+	// nothing removed
+	cm.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything)
+}
+
+func TestGarbageCollector_sharedLogs(t *testing.T) {
+	rootPath := "files"
+	insertLog := func(segmentID, logID int64) string {
+		return metautil.BuildInsertLogPath(rootPath, 100, 10, segmentID, 101, logID)
+	}
+	m, err := newMemoryMeta()
+	require.NoError(t, err)
+	err = m.AddSegment(context.TODO(), NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            1,
+		CollectionID:  100,
+		PartitionID:   10,
+		InsertChannel: "ch-1",
+		State:         commonpb.SegmentState_Dropped,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{
+			{LogID: 1, LogPath: insertLog(1, 1)},
+			{LogID: 2, LogPath: insertLog(1, 2)},
+			{LogID: 3, LogPath: insertLog(1, 3)},
+		}}},
+	}))
+	require.NoError(t, err)
+	// segment restored from snapshot shares log 2
+	err = m.AddSegment(context.TODO(), NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            2,
+		CollectionID:  200,
+		PartitionID:   20,
+		InsertChannel: "ch-2",
+		State:         commonpb.SegmentState_Flushed,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{
+			{LogID: 2, LogPath: insertLog(1, 2)},
+		}}},
+	}))
+	require.NoError(t, err)
+	// snapshot references log 1
+	err = m.snapshotMeta.AddSnapshot(&datapb.CollectionSnapshot{
+		Name:         "snapshot",
+		CollectionID: 100,
+		Segments: []*datapb.SegmentInfo{{
+			ID: 1,
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{
+				{LogID: 1, LogPath: insertLog(1, 1)},
+			}}},
+		}},
+	})
+	require.NoError(t, err)
+
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().Remove(mock.Anything, insertLog(1, 3)).Return(nil)
+	gc := newGarbageCollector(m, newMockHandler(), GcOption{
+		cli:           cm,
+		dropTolerance: 0,
+	})
+
+	refs := gc.getFileReferences()
+	assert.Equal(t, 2, refs[insertLog(1, 1)])
+	assert.Equal(t, 2, refs[insertLog(1, 2)])
+	assert.Equal(t, 1, refs[insertLog(1, 3)])
+	_, files := gc.getMetaFiles()
+	assert.True(t, files.Contain(insertLog(1, 1)))
+
+	gc.clearEtcd()
+	assert.Nil(t, m.GetSegment(1))
+	assert.NotNil(t, m.GetSegment(2))
+}
+
 func TestGarbageCollector_clearETCD(t *testing.T) {
 	catalog := catalogmocks.NewDataCoordCatalog(t)
 	catalog.On("ChannelExists",
@@ -1403,6 +1539,18 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 	assert.Nil(t, segB)
 }
 
+func TestGarbageCollector_isExpire(t *testing.T) {
+	paramtable.Init()
+	gc := newGarbageCollector(nil, nil, GcOption{dropTolerance: time.Minute})
+	droppedAt := uint64(time.Now().Add(-time.Hour).UnixNano())
+	assert.True(t, gc.isExpire(droppedAt))
+
+	// kept within the restore window
+	gc.option.restoreWindow = 2 * time.Hour
+	assert.False(t, gc.isExpire(droppedAt))
+	assert.True(t, gc.isExpire(uint64(time.Now().Add(-3*time.Hour).UnixNano())))
+}
+
 func TestGarbageCollector_removelogs(t *testing.T) {
 	paramtable.Init()
 	cm := &mocks.ChunkManager{}
@@ -97,6 +97,7 @@ func (h *ServerHandler) GetDataVChanPositions(channel RWChannel, partitionID Uni
 		FlushedSegmentIds:   flushedIDs.Collect(),
 		UnflushedSegmentIds: unflushedIDs.Collect(),
 		DroppedSegmentIds:   droppedIDs.Collect(),
+		IngestionPaused:     h.s.meta.ingestionMeta != nil && h.s.meta.ingestionMeta.IsPaused(channel.GetCollectionID()),
 	}
 }
 
@@ -0,0 +1,154 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/lock"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// idempotencyMeta keeps the results of the requests carrying the idempotency keys within the deduplication window,
+// so that the client retries after timeouts return the results recorded rather than executing the requests again.
+type idempotencyMeta struct {
+	sync.RWMutex
+	ctx     context.Context
+	catalog metastore.DataCoordCatalog
+
+	// serializes the requests with the same key, from the check to the record of the result
+	keyLock *lock.KeyLock[string]
+	// collectionID -> key -> record
+	records map[UniqueID]map[string]*datapb.IdempotencyRecord
+}
+
+func newIdempotencyMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*idempotencyMeta, error) {
+	records, err := catalog.ListIdempotencyRecords(ctx)
+	if err != nil {
+		log.Error("idempotencyMeta reloadFromKV load records fail", zap.Error(err))
+		return nil, err
+	}
+	m := &idempotencyMeta{
+		ctx:     ctx,
+		catalog: catalog,
+		keyLock: lock.NewKeyLock[string](),
+		records: make(map[UniqueID]map[string]*datapb.IdempotencyRecord),
+	}
+	for _, record := range records {
+		m.updateRecord(record)
+	}
+	return m, nil
+}
+
+func (m *idempotencyMeta) updateRecord(record *datapb.IdempotencyRecord) {
+	if _, ok := m.records[record.GetCollectionID()]; !ok {
+		m.records[record.GetCollectionID()] = make(map[string]*datapb.IdempotencyRecord)
+	}
+	m.records[record.GetCollectionID()][record.GetKey()] = record
+}
+
+func idempotencyLockKey(collectionID UniqueID, key string) string {
+	return fmt.Sprintf("%d/%s", collectionID, key)
+}
+
+// LockKey blocks the other requests with the same key until UnlockKey called.
+func (m *idempotencyMeta) LockKey(collectionID UniqueID, key string) {
+	m.keyLock.Lock(idempotencyLockKey(collectionID, key))
+}
+
+func (m *idempotencyMeta) UnlockKey(collectionID UniqueID, key string) {
+	m.keyLock.Unlock(idempotencyLockKey(collectionID, key))
+}
+
+// GetRecord returns the record of the key, nil if not recorded or expired.
+func (m *idempotencyMeta) GetRecord(collectionID UniqueID, key string) *datapb.IdempotencyRecord {
+	m.RLock()
+	defer m.RUnlock()
+	record, ok := m.records[collectionID][key]
+	if !ok || isIdempotencyRecordExpired(record, time.Now()) {
+		return nil
+	}
+	return record
+}
+
+// AcquireKey records the key pending if the key not recorded, expired or aborted and returns true,
+// otherwise returns the record of the key. Only one of the requests with the same key is acquired,
+// even if they are sent by different proxies.
+func (m *idempotencyMeta) AcquireKey(collectionID UniqueID, key string) (*datapb.IdempotencyRecord, bool, error) {
+	m.LockKey(collectionID, key)
+	defer m.UnlockKey(collectionID, key)
+	record := m.GetRecord(collectionID, key)
+	if record != nil && record.GetState() != datapb.IdempotencyRecordState_IdempotencyAborted {
+		return record, false, nil
+	}
+	err := m.SaveRecord(&datapb.IdempotencyRecord{
+		Key:          key,
+		CollectionID: collectionID,
+		CreateTime:   time.Now().UnixMilli(),
+		State:        datapb.IdempotencyRecordState_IdempotencyPending,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return nil, true, nil
+}
+
+// SaveRecord saves the record, the record of the same key is overwritten.
+func (m *idempotencyMeta) SaveRecord(record *datapb.IdempotencyRecord) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.catalog.SaveIdempotencyRecord(m.ctx, record); err != nil {
+		return err
+	}
+	m.updateRecord(record)
+	return nil
+}
+
+// RemoveExpiredRecords drops the records out of the deduplication window.
+func (m *idempotencyMeta) RemoveExpiredRecords(now time.Time) {
+	m.Lock()
+	defer m.Unlock()
+	for collectionID, records := range m.records {
+		for key, record := range records {
+			if !isIdempotencyRecordExpired(record, now) {
+				continue
+			}
+			if err := m.catalog.DropIdempotencyRecord(m.ctx, collectionID, key); err != nil {
+				log.Warn("failed to drop expired idempotency record",
+					zap.Int64("collectionID", collectionID), zap.String("key", key), zap.Error(err))
+				continue
+			}
+			delete(records, key)
+		}
+		if len(records) == 0 {
+			delete(m.records, collectionID)
+		}
+	}
+}
+
+func isIdempotencyRecordExpired(record *datapb.IdempotencyRecord, now time.Time) bool {
+	window := paramtable.Get().DataCoordCfg.IdempotencyWindow.GetAsDuration(time.Second)
+	return now.Sub(time.UnixMilli(record.GetCreateTime())) > window
+}
@@ -0,0 +1,184 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type IdempotencySuite struct {
+	suite.Suite
+
+	server  *Server
+	catalog *mocks.DataCoordCatalog
+}
+
+func (s *IdempotencySuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *IdempotencySuite) SetupTest() {
+	s.catalog = mocks.NewDataCoordCatalog(s.T())
+	s.catalog.EXPECT().ListIdempotencyRecords(mock.Anything).Return([]*datapb.IdempotencyRecord{
+		{Key: "key", CollectionID: 100, Result: []byte("result"), CreateTime: time.Now().UnixMilli()},
+		{Key: "expired", CollectionID: 100, Result: []byte("result"), CreateTime: time.Now().Add(-48 * time.Hour).UnixMilli()},
+	}, nil)
+	idempotencyMeta, err := newIdempotencyMeta(context.Background(), s.catalog)
+	s.Require().NoError(err)
+
+	s.server = &Server{meta: &meta{ctx: context.Background(), catalog: s.catalog, idempotencyMeta: idempotencyMeta}}
+	s.server.stateCode.Store(commonpb.StateCode_Healthy)
+}
+
+func (s *IdempotencySuite) TearDownTest() {
+	paramtable.Get().Reset(paramtable.Get().DataCoordCfg.IdempotencyWindow.Key)
+}
+
+func (s *IdempotencySuite) TestGetRecord() {
+	resp, err := s.server.GetIdempotencyRecord(context.Background(), &datapb.GetIdempotencyRecordRequest{CollectionID: 100, Key: "key"})
+	s.NoError(err)
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.Equal([]byte("result"), resp.GetRecord().GetResult())
+
+	// expired
+	resp, err = s.server.GetIdempotencyRecord(context.Background(), &datapb.GetIdempotencyRecordRequest{CollectionID: 100, Key: "expired"})
+	s.NoError(err)
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.Nil(resp.GetRecord())
+
+	// other collection
+	resp, err = s.server.GetIdempotencyRecord(context.Background(), &datapb.GetIdempotencyRecordRequest{CollectionID: 200, Key: "key"})
+	s.NoError(err)
+	s.Nil(resp.GetRecord())
+
+	resp, err = s.server.GetIdempotencyRecord(context.Background(), &datapb.GetIdempotencyRecordRequest{CollectionID: 100})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+	// disabled
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.IdempotencyWindow.Key, "0")
+	resp, err = s.server.GetIdempotencyRecord(context.Background(), &datapb.GetIdempotencyRecordRequest{CollectionID: 100, Key: "key"})
+	s.NoError(err)
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.Nil(resp.GetRecord())
+}
+
+func (s *IdempotencySuite) TestAcquireKey() {
+	acquire := func(key string) *datapb.GetIdempotencyRecordResponse {
+		resp, err := s.server.GetIdempotencyRecord(context.Background(), &datapb.GetIdempotencyRecordRequest{CollectionID: 100, Key: key, Acquire: true})
+		s.Require().NoError(err)
+		return resp
+	}
+
+	// recorded
+	resp := acquire("key")
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.False(resp.GetAcquired())
+	s.Equal([]byte("result"), resp.GetRecord().GetResult())
+
+	// expired, recorded pending
+	s.catalog.EXPECT().SaveIdempotencyRecord(mock.Anything, mock.Anything).Return(nil).Once()
+	resp = acquire("expired")
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.True(resp.GetAcquired())
+	s.Nil(resp.GetRecord())
+
+	// pending, the retries are not acquired
+	resp = acquire("expired")
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.False(resp.GetAcquired())
+	s.Equal(datapb.IdempotencyRecordState_IdempotencyPending, resp.GetRecord().GetState())
+
+	// aborted, acquired again
+	s.catalog.EXPECT().SaveIdempotencyRecord(mock.Anything, mock.Anything).Return(nil).Twice()
+	s.NoError(s.server.meta.idempotencyMeta.SaveRecord(&datapb.IdempotencyRecord{
+		Key: "expired", CollectionID: 100, CreateTime: time.Now().UnixMilli(), State: datapb.IdempotencyRecordState_IdempotencyAborted,
+	}))
+	resp = acquire("expired")
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.True(resp.GetAcquired())
+
+	s.catalog.EXPECT().SaveIdempotencyRecord(mock.Anything, mock.Anything).Return(errors.New("mock")).Once()
+	resp = acquire("new")
+	s.Error(merr.Error(resp.GetStatus()))
+	s.Nil(s.server.meta.idempotencyMeta.GetRecord(100, "new"))
+
+	// disabled
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.IdempotencyWindow.Key, "0")
+	resp = acquire("key")
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.True(resp.GetAcquired())
+	s.Nil(resp.GetRecord())
+}
+
+func (s *IdempotencySuite) TestSaveRecord() {
+	s.catalog.EXPECT().SaveIdempotencyRecord(mock.Anything, mock.Anything).Return(nil).Once()
+	status, err := s.server.SaveIdempotencyRecord(context.Background(), &datapb.SaveIdempotencyRecordRequest{
+		Record: &datapb.IdempotencyRecord{Key: "new", CollectionID: 100, Result: []byte("new")},
+	})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+	record := s.server.meta.idempotencyMeta.GetRecord(100, "new")
+	s.Require().NotNil(record)
+	s.NotZero(record.GetCreateTime())
+
+	s.catalog.EXPECT().SaveIdempotencyRecord(mock.Anything, mock.Anything).Return(errors.New("mock")).Once()
+	status, err = s.server.SaveIdempotencyRecord(context.Background(), &datapb.SaveIdempotencyRecordRequest{
+		Record: &datapb.IdempotencyRecord{Key: "failed", CollectionID: 100},
+	})
+	s.NoError(err)
+	s.Error(merr.Error(status))
+	s.Nil(s.server.meta.idempotencyMeta.GetRecord(100, "failed"))
+
+	// disabled
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.IdempotencyWindow.Key, "0")
+	status, err = s.server.SaveIdempotencyRecord(context.Background(), &datapb.SaveIdempotencyRecordRequest{
+		Record: &datapb.IdempotencyRecord{Key: "disabled", CollectionID: 100},
+	})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+}
+
+func (s *IdempotencySuite) TestRemoveExpiredRecords() {
+	s.catalog.EXPECT().DropIdempotencyRecord(mock.Anything, int64(100), "expired").Return(nil).Once()
+	s.server.meta.idempotencyMeta.RemoveExpiredRecords(time.Now())
+	s.Len(s.server.meta.idempotencyMeta.records[100], 1)
+
+	s.catalog.EXPECT().DropIdempotencyRecord(mock.Anything, int64(100), "key").Return(errors.New("mock")).Once()
+	s.server.meta.idempotencyMeta.RemoveExpiredRecords(time.Now().Add(48 * time.Hour))
+	s.Len(s.server.meta.idempotencyMeta.records[100], 1)
+
+	s.catalog.EXPECT().DropIdempotencyRecord(mock.Anything, int64(100), "key").Return(nil).Once()
+	s.server.meta.idempotencyMeta.RemoveExpiredRecords(time.Now().Add(48 * time.Hour))
+	s.Empty(s.server.meta.idempotencyMeta.records)
+}
+
+func TestIdempotency(t *testing.T) {
+	suite.Run(t, new(IdempotencySuite))
+}
@@ -45,6 +45,7 @@ type importChecker struct {
 	alloc   allocator
 	sm      Manager
 	imeta   ImportMeta
+	trigger trigger // nil if compaction disabled
 
 	closeOnce sync.Once
 	closeChan chan struct{}
@@ -56,6 +57,7 @@ func NewImportChecker(meta *meta,
 	alloc allocator,
 	sm Manager,
 	imeta ImportMeta,
+	trigger trigger,
 ) ImportChecker {
 	return &importChecker{
 		meta:      meta,
@@ -64,6 +66,7 @@ func NewImportChecker(meta *meta,
 		alloc:     alloc,
 		sm:        sm,
 		imeta:     imeta,
+		trigger:   trigger,
 		closeChan: make(chan struct{}),
 	}
 }
@@ -83,9 +86,13 @@ func (c *importChecker) Start() {
 			return
 		case <-ticker1.C:
 			jobs := c.imeta.GetJobBy()
+			queue := GetImportJobQueue(c.imeta)
 			for _, job := range jobs {
 				switch job.GetState() {
 				case internalpb.ImportJobState_Pending:
+					if _, ok := queue[job.GetJobID()]; ok {
+						continue
+					}
 					c.checkPendingJob(job)
 				case internalpb.ImportJobState_PreImporting:
 					c.checkPreImportingJob(job)
@@ -295,6 +302,34 @@ func (c *importChecker) checkImportingJob(job ImportJob) {
 		return
 	}
 	log.Info("import job completed")
+
+	c.tryTriggerCompaction(job, segmentIDs)
+}
+
+// tryTriggerCompaction triggers the compaction of the collection promptly
+// if the import job produced many small segments, rather than waiting for the global compaction loop.
+func (c *importChecker) tryTriggerCompaction(job ImportJob, segmentIDs []int64) {
+	minNum := Params.DataCoordCfg.ImportCompactionSegNum.GetAsInt()
+	if c.trigger == nil || minNum <= 0 {
+		return
+	}
+	maxSize := Params.DataCoordCfg.SegmentMaxSize.GetAsFloat() * 1024 * 1024
+	smallSize := int64(maxSize * Params.DataCoordCfg.SegmentSmallProportion.GetAsFloat())
+	small := lo.Filter(segmentIDs, func(segmentID int64, _ int) bool {
+		segment := c.meta.GetHealthySegment(segmentID)
+		return segment != nil && segment.getSegmentSize() < smallSize
+	})
+	if len(small) < minNum {
+		return
+	}
+
+	log := log.With(zap.Int64("jobID", job.GetJobID()), zap.Int64("collectionID", job.GetCollectionID()))
+	err := c.trigger.triggerCollectionCompaction(job.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to trigger compaction after import", zap.Error(err))
+		return
+	}
+	log.Info("trigger compaction for the small segments imported", zap.Int("smallSegmentNum", len(small)))
 }
 
 func (c *importChecker) tryFailingTasks(job ImportJob) {
@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
 
@@ -52,6 +53,9 @@ func (s *ImportCheckerSuite) SetupTest() {
 	catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListIndexes(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListIdempotencyRecords(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListIngestionPauses(mock.Anything).Return(nil, nil)
 
 	cluster := NewMockCluster(s.T())
 	alloc := NewNMockAllocator(s.T())
@@ -66,7 +70,7 @@ func (s *ImportCheckerSuite) SetupTest() {
 	broker := broker2.NewMockBroker(s.T())
 	sm := NewMockManager(s.T())
 
-	checker := NewImportChecker(meta, broker, cluster, alloc, sm, imeta).(*importChecker)
+	checker := NewImportChecker(meta, broker, cluster, alloc, sm, imeta, nil).(*importChecker)
 	s.checker = checker
 
 	job := &importJob{
@@ -208,6 +212,49 @@ func (s *ImportCheckerSuite) TestCheckJob() {
 	s.Equal(internalpb.ImportJobState_Completed, s.imeta.GetJob(job.GetJobID()).GetState())
 }
 
+func (s *ImportCheckerSuite) TestTryTriggerCompaction() {
+	catalog := s.imeta.(*importMeta).catalog.(*mocks.DataCoordCatalog)
+	catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil)
+
+	var segmentIDs []int64
+	for i := 0; i < 4; i++ {
+		segment := &SegmentInfo{
+			SegmentInfo: &datapb.SegmentInfo{
+				ID:            int64(100 + i),
+				CollectionID:  1,
+				State:         commonpb.SegmentState_Flushed,
+				InsertChannel: "ch0",
+			},
+		}
+		err := s.checker.meta.AddSegment(context.Background(), segment)
+		s.NoError(err)
+		segmentIDs = append(segmentIDs, segment.GetID())
+	}
+
+	triggered := 0
+	s.checker.trigger = &mockCompactionTrigger{methods: map[string]interface{}{
+		"triggerCollectionCompaction": func(collectionID int64) error {
+			s.EqualValues(1, collectionID)
+			triggered++
+			return nil
+		},
+	}}
+	job := s.imeta.GetJob(s.jobID)
+
+	// not enough small segments
+	s.checker.tryTriggerCompaction(job, segmentIDs[:3])
+	s.Equal(0, triggered)
+
+	s.checker.tryTriggerCompaction(job, segmentIDs)
+	s.Equal(1, triggered)
+
+	// disabled
+	paramtable.Get().Save(Params.DataCoordCfg.ImportCompactionSegNum.Key, "0")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.ImportCompactionSegNum.Key)
+	s.checker.tryTriggerCompaction(job, segmentIDs)
+	s.Equal(1, triggered)
+}
+
 func (s *ImportCheckerSuite) TestCheckJob_Failed() {
 	mockErr := errors.New("mock err")
 	job := s.imeta.GetJob(s.jobID)
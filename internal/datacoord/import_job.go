@@ -72,6 +72,7 @@ func UpdateJobCompleteTime(completeTime string) UpdateJobAction {
 
 type ImportJob interface {
 	GetJobID() int64
+	GetDbName() string
 	GetCollectionID() int64
 	GetCollectionName() string
 	GetPartitionIDs() []int64
@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -286,6 +287,75 @@ func CheckDiskQuota(job ImportJob, meta *meta, imeta ImportMeta) (int64, error)
 	return requestSize, nil
 }
 
+// GetImportJobQueue returns the queue positions of the pending jobs held back by the import
+// concurrency limits, the pending jobs absent from the result are admitted to run.
+// The running jobs count toward the limits of their collections and databases,
+// and the pending jobs are admitted in the order of their IDs.
+func GetImportJobQueue(imeta ImportMeta) map[int64]int64 {
+	var (
+		maxJobPerColl = Params.DataCoordCfg.MaxImportJobPerColl.GetAsInt()
+		maxJobPerDB   = Params.DataCoordCfg.MaxImportJobPerDB.GetAsInt()
+		maxSize       = Params.DataCoordCfg.MaxImportSizeInMB.GetAsInt64() * 1024 * 1024
+	)
+
+	jobs := imeta.GetJobBy()
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].GetJobID() < jobs[j].GetJobID()
+	})
+
+	var (
+		collJobs    = make(map[int64]int)
+		dbJobs      = make(map[string]int)
+		runningSize int64
+		pending     = make([]ImportJob, 0)
+	)
+	for _, job := range jobs {
+		switch job.GetState() {
+		case internalpb.ImportJobState_Pending:
+			pending = append(pending, job)
+		case internalpb.ImportJobState_PreImporting, internalpb.ImportJobState_Importing:
+			collJobs[job.GetCollectionID()]++
+			dbJobs[getImportDBName(job)]++
+			runningSize += getImportJobSize(job.GetJobID(), imeta)
+		}
+	}
+
+	// the size of the pending jobs is unknown until preimported,
+	// so all of them are held once the running ones exceed the size limit
+	sizeExceeded := maxSize > 0 && runningSize >= maxSize
+	queue := make(map[int64]int64)
+	for _, job := range pending {
+		collID, dbName := job.GetCollectionID(), getImportDBName(job)
+		if sizeExceeded ||
+			(maxJobPerColl > 0 && collJobs[collID] >= maxJobPerColl) ||
+			(maxJobPerDB > 0 && dbJobs[dbName] >= maxJobPerDB) {
+			queue[job.GetJobID()] = int64(len(queue) + 1)
+			continue
+		}
+		collJobs[collID]++
+		dbJobs[dbName]++
+	}
+	return queue
+}
+
+func getImportDBName(job ImportJob) string {
+	if job.GetDbName() == "" {
+		return util.DefaultDBName
+	}
+	return job.GetDbName()
+}
+
+// getImportJobSize returns the total size of the files preimported of the job.
+func getImportJobSize(jobID int64, imeta ImportMeta) int64 {
+	var size int64
+	for _, task := range imeta.GetTaskBy(WithJob(jobID), WithType(PreImportTaskType)) {
+		size += lo.SumBy(task.GetFileStats(), func(file *datapb.ImportFileStats) int64 {
+			return file.GetFileSize()
+		})
+	}
+	return size
+}
+
 func getPendingProgress(jobID int64, imeta ImportMeta) float32 {
 	tasks := imeta.GetTaskBy(WithJob(jobID), WithType(PreImportTaskType))
 	preImportingFiles := lo.SumBy(tasks, func(task ImportTask) int {
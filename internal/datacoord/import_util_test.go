@@ -150,6 +150,9 @@ func TestImportUtil_AssembleRequest(t *testing.T) {
 	catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListIndexes(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListIdempotencyRecords(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListIngestionPauses(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil)
 
 	alloc := NewNMockAllocator(t)
@@ -227,6 +230,9 @@ func TestImportUtil_CheckDiskQuota(t *testing.T) {
 	catalog.EXPECT().SavePreImportTask(mock.Anything).Return(nil)
 	catalog.EXPECT().ListIndexes(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListIdempotencyRecords(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListIngestionPauses(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListSegments(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil)
@@ -290,6 +296,65 @@ func TestImportUtil_CheckDiskQuota(t *testing.T) {
 	assert.True(t, errors.Is(err, merr.ErrServiceQuotaExceeded))
 }
 
+func TestImportUtil_GetImportJobQueue(t *testing.T) {
+	catalog := mocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().ListImportJobs().Return(nil, nil)
+	catalog.EXPECT().ListImportTasks().Return(nil, nil)
+	catalog.EXPECT().ListPreImportTasks().Return(nil, nil)
+	catalog.EXPECT().SaveImportJob(mock.Anything).Return(nil)
+	catalog.EXPECT().SavePreImportTask(mock.Anything).Return(nil)
+
+	imeta, err := NewImportMeta(catalog)
+	assert.NoError(t, err)
+
+	addJob := func(jobID, collectionID int64, dbName string, state internalpb.ImportJobState) {
+		err := imeta.AddJob(&importJob{
+			ImportJob: &datapb.ImportJob{
+				JobID:        jobID,
+				DbName:       dbName,
+				CollectionID: collectionID,
+				State:        state,
+			},
+		})
+		assert.NoError(t, err)
+	}
+	addJob(1, 100, "", internalpb.ImportJobState_Importing)
+	addJob(2, 100, "default", internalpb.ImportJobState_Pending)
+	addJob(3, 100, "", internalpb.ImportJobState_Pending)
+	addJob(4, 101, "", internalpb.ImportJobState_Pending)
+	addJob(5, 200, "db1", internalpb.ImportJobState_Pending)
+	addJob(6, 100, "", internalpb.ImportJobState_Completed)
+
+	err = imeta.AddTask(&preImportTask{
+		PreImportTask: &datapb.PreImportTask{
+			JobID:  1,
+			TaskID: 10,
+			FileStats: []*datapb.ImportFileStats{
+				{FileSize: 1024 * 1024},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	Params.Save(Params.DataCoordCfg.MaxImportJobPerColl.Key, "0")
+	Params.Save(Params.DataCoordCfg.MaxImportJobPerDB.Key, "0")
+	defer Params.Reset(Params.DataCoordCfg.MaxImportJobPerColl.Key)
+	defer Params.Reset(Params.DataCoordCfg.MaxImportJobPerDB.Key)
+	defer Params.Reset(Params.DataCoordCfg.MaxImportSizeInMB.Key)
+	assert.Empty(t, GetImportJobQueue(imeta))
+
+	Params.Save(Params.DataCoordCfg.MaxImportJobPerColl.Key, "2")
+	assert.Equal(t, map[int64]int64{3: 1}, GetImportJobQueue(imeta))
+
+	Params.Save(Params.DataCoordCfg.MaxImportJobPerDB.Key, "2")
+	assert.Equal(t, map[int64]int64{3: 1, 4: 2}, GetImportJobQueue(imeta))
+
+	Params.Save(Params.DataCoordCfg.MaxImportJobPerColl.Key, "0")
+	Params.Save(Params.DataCoordCfg.MaxImportJobPerDB.Key, "0")
+	Params.Save(Params.DataCoordCfg.MaxImportSizeInMB.Key, "1")
+	assert.Equal(t, map[int64]int64{2: 1, 3: 2, 4: 3, 5: 4}, GetImportJobQueue(imeta))
+}
+
 func TestImportUtil_DropImportTask(t *testing.T) {
 	cluster := NewMockCluster(t)
 	cluster.EXPECT().DropImport(mock.Anything, mock.Anything).Return(nil)
@@ -383,6 +448,9 @@ func TestImportUtil_GetImportProgress(t *testing.T) {
 	catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListIndexes(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListIdempotencyRecords(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListIngestionPauses(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().SaveImportJob(mock.Anything).Return(nil)
 	catalog.EXPECT().SavePreImportTask(mock.Anything).Return(nil)
 	catalog.EXPECT().SaveImportTask(mock.Anything).Return(nil)
@@ -0,0 +1,210 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// adviseScalarIndex advises the scalar index type of the field by its cardinality, -1 if unknown.
+// There is no bitmap index, the inverted index serves the low cardinality fields by its posting lists.
+func adviseScalarIndex(dataType schemapb.DataType, cardinality int64, lowCardinality int64) (string, string) {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return indexparamcheck.IndexINVERTED, "boolean field of two distinct values at most"
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double:
+		if cardinality < 0 {
+			return indexparamcheck.IndexINVERTED, "cardinality unknown, inverted index fits most filters"
+		}
+		if cardinality <= lowCardinality {
+			return indexparamcheck.IndexINVERTED, "low cardinality, inverted index keeps a posting list for each value"
+		}
+		return indexparamcheck.IndexSTLSORT, "high cardinality numbers, sorted index serves the range filters with binary search"
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		if cardinality < 0 {
+			return indexparamcheck.IndexINVERTED, "cardinality unknown, inverted index fits most filters"
+		}
+		if cardinality <= lowCardinality {
+			return indexparamcheck.IndexINVERTED, "low cardinality, inverted index keeps a posting list for each value"
+		}
+		return indexparamcheck.IndexTrie, "high cardinality strings, trie index keeps the distinct strings compactly"
+	default:
+		return "", ""
+	}
+}
+
+// fieldCardinality is the cardinality sketch merged from the scalar stats of the segments.
+type fieldCardinality struct {
+	sketch  *storage.HyperLogLog
+	numRows int64
+}
+
+// collectFieldCardinalities merges the cardinality sketches of the fields in the scalar stats of the segments,
+// the segments without sketch of the field are skipped.
+func collectFieldCardinalities(segments []*SegmentInfo) map[int64]*fieldCardinality {
+	cardinalities := make(map[int64]*fieldCardinality)
+	for _, segment := range segments {
+		for _, scalarStats := range segment.GetScalarStats() {
+			stats := &storage.FieldStats{}
+			if err := json.Unmarshal(scalarStats.GetStats(), stats); err != nil {
+				log.Warn("failed to unmarshal scalar stats", zap.Int64("segmentID", segment.GetID()),
+					zap.Int64("fieldID", scalarStats.GetFieldID()), zap.Error(err))
+				continue
+			}
+			if stats.Sketch == nil {
+				continue
+			}
+			cardinality, ok := cardinalities[scalarStats.GetFieldID()]
+			if !ok {
+				cardinalities[scalarStats.GetFieldID()] = &fieldCardinality{sketch: stats.Sketch, numRows: segment.GetNumOfRows()}
+				continue
+			}
+			if err := cardinality.sketch.Merge(stats.Sketch); err != nil {
+				log.Warn("failed to merge cardinality sketch", zap.Int64("segmentID", segment.GetID()),
+					zap.Int64("fieldID", scalarStats.GetFieldID()), zap.Error(err))
+				continue
+			}
+			cardinality.numRows += segment.GetNumOfRows()
+		}
+	}
+	return cardinalities
+}
+
+// adviseScalarIndexes advises the scalar index types of the fields of the collection,
+// by the cardinality merged from the scalar stats of the flushed segments.
+func (s *Server) adviseScalarIndexes(ctx context.Context, collectionID UniqueID) ([]*datapb.ScalarIndexAdvice, error) {
+	coll, err := s.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if coll == nil {
+		return nil, merr.WrapErrCollectionNotFound(collectionID)
+	}
+
+	indexTypes := make(map[int64]string)
+	for _, index := range s.meta.indexMeta.GetIndexesForCollection(collectionID, "") {
+		indexTypes[index.FieldID] = GetIndexType(index.IndexParams)
+	}
+	segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == collectionID && isFlush(segment) && isSegmentHealthy(segment)
+	})
+	var numRows int64
+	for _, segment := range segments {
+		numRows += segment.GetNumOfRows()
+	}
+	cardinalities := collectFieldCardinalities(segments)
+
+	lowCardinality := Params.DataCoordCfg.IndexAdvisorLowCardinality.GetAsInt64()
+	advices := make([]*datapb.ScalarIndexAdvice, 0)
+	for _, field := range coll.Schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || field.GetIsPrimaryKey() {
+			continue
+		}
+		advice := &datapb.ScalarIndexAdvice{
+			FieldID:          field.GetFieldID(),
+			FieldName:        field.GetName(),
+			Cardinality:      -1,
+			CurrentIndexType: indexTypes[field.GetFieldID()],
+		}
+		if field.GetDataType() == schemapb.DataType_Bool {
+			advice.NumRows = numRows
+		} else if cardinality, ok := cardinalities[field.GetFieldID()]; ok {
+			advice.Cardinality = cardinality.sketch.Count()
+			advice.NumRows = cardinality.numRows
+		}
+		advice.IndexType, advice.Reason = adviseScalarIndex(field.GetDataType(), advice.Cardinality, lowCardinality)
+		if advice.IndexType == "" {
+			continue
+		}
+		advices = append(advices, advice)
+	}
+	return advices, nil
+}
+
+// createAdvisedScalarIndexes creates the advised scalar indexes on the fields without index of the collection,
+// only if the cardinality is collected from enough rows.
+func (s *Server) createAdvisedScalarIndexes(ctx context.Context, collectionID UniqueID) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	advices, err := s.adviseScalarIndexes(ctx, collectionID)
+	if err != nil {
+		log.Warn("failed to advise scalar indexes", zap.Error(err))
+		return
+	}
+	minRows := Params.DataCoordCfg.IndexAdvisorMinRows.GetAsInt64()
+	for _, advice := range advices {
+		if advice.GetCurrentIndexType() != "" || advice.GetNumRows() < minRows {
+			continue
+		}
+		ts, err := s.allocator.allocTimestamp(ctx)
+		if err != nil {
+			log.Warn("failed to alloc timestamp", zap.Error(err))
+			return
+		}
+		params := []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: advice.GetIndexType()}}
+		status, err := s.CreateIndex(ctx, &indexpb.CreateIndexRequest{
+			CollectionID:    collectionID,
+			FieldID:         advice.GetFieldID(),
+			IndexParams:     params,
+			UserIndexParams: params,
+			Timestamp:       ts,
+		})
+		if err = merr.CheckRPCCall(status, err); err != nil {
+			log.Warn("failed to create advised scalar index", zap.Int64("fieldID", advice.GetFieldID()),
+				zap.String("indexType", advice.GetIndexType()), zap.Error(err))
+			continue
+		}
+		log.Info("advised scalar index created", zap.Int64("fieldID", advice.GetFieldID()),
+			zap.String("indexType", advice.GetIndexType()), zap.Int64("cardinality", advice.GetCardinality()),
+			zap.String("reason", advice.GetReason()))
+	}
+}
+
+// indexAdvisorLoop creates the advised scalar indexes periodically if enabled.
+func (s *Server) indexAdvisorLoop(ctx context.Context) {
+	defer s.serverLoopWg.Done()
+	ticker := time.NewTicker(Params.DataCoordCfg.IndexAdvisorInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("index advisor loop exit")
+			return
+		case <-ticker.C:
+			if !Params.DataCoordCfg.IndexAdvisorAutoCreate.GetAsBool() {
+				continue
+			}
+			for collectionID := range s.meta.GetAllCollectionNumRows() {
+				s.createAdvisedScalarIndexes(ctx, collectionID)
+			}
+		}
+	}
+}
@@ -0,0 +1,220 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	catalogmocks "github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestAdviseScalarIndex(t *testing.T) {
+	cases := []struct {
+		dataType    schemapb.DataType
+		cardinality int64
+		indexType   string
+	}{
+		{schemapb.DataType_Bool, -1, indexparamcheck.IndexINVERTED},
+		{schemapb.DataType_Int64, -1, indexparamcheck.IndexINVERTED},
+		{schemapb.DataType_Int32, 10, indexparamcheck.IndexINVERTED},
+		{schemapb.DataType_Double, 10000, indexparamcheck.IndexSTLSORT},
+		{schemapb.DataType_VarChar, 100, indexparamcheck.IndexINVERTED},
+		{schemapb.DataType_VarChar, 10000, indexparamcheck.IndexTrie},
+		{schemapb.DataType_JSON, 10, ""},
+		{schemapb.DataType_FloatVector, -1, ""},
+	}
+	for _, c := range cases {
+		indexType, reason := adviseScalarIndex(c.dataType, c.cardinality, 1000)
+		assert.Equal(t, c.indexType, indexType, c.dataType.String())
+		assert.Equal(t, indexType == "", reason == "")
+	}
+}
+
+func TestServer_GetScalarIndexAdvice(t *testing.T) {
+	paramtable.Init()
+	var (
+		collID = UniqueID(1)
+		partID = UniqueID(2)
+		segID  = UniqueID(1000)
+		ctx    = context.Background()
+	)
+	schema := &schemapb.CollectionSchema{
+		Name: "test_advice",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "id_card", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "price", DataType: schemapb.DataType_Double},
+			{FieldID: 104, Name: "flag", DataType: schemapb.DataType_Bool},
+			{FieldID: 105, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().CreateIndex(mock.Anything, mock.Anything).Return(nil).Maybe()
+	handler := NewNMockHandler(t)
+	handler.EXPECT().GetCollection(mock.Anything, collID).Return(&collectionInfo{ID: collID, Schema: schema}, nil).Maybe()
+	handler.EXPECT().GetCollection(mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	rootCoord.EXPECT().DescribeCollectionInternal(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Status: merr.Success(),
+		Schema: schema,
+	}, nil).Maybe()
+
+	s := &Server{
+		meta: &meta{
+			catalog:     catalog,
+			collections: map[UniqueID]*collectionInfo{collID: {ID: collID, Schema: schema}},
+			indexMeta:   newSegmentIndexMeta(catalog),
+			segments:    NewSegmentsInfo(),
+		},
+		handler:         handler,
+		broker:          broker.NewCoordinatorBroker(rootCoord),
+		allocator:       newMockAllocator(),
+		notifyIndexChan: make(chan UniqueID, 1),
+	}
+	s.meta.indexMeta.indexes[collID] = map[UniqueID]*model.Index{
+		10: {
+			CollectionID: collID,
+			FieldID:      105,
+			IndexID:      10,
+			IndexName:    "vec_idx",
+			IndexParams:  []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: indexparamcheck.IndexHNSW}},
+		},
+	}
+
+	// 10 distinct ages, distinct id cards, no stats of price collected
+	for i := 0; i < 2; i++ {
+		collector := storage.NewScalarStatsCollector(schema, false, true)
+		ages := make([]int32, 0, 1000)
+		idCards := make([]string, 0, 1000)
+		for j := 0; j < 1000; j++ {
+			ages = append(ages, int32(j%10))
+			idCards = append(idCards, fmt.Sprintf("%d-%d", i, j))
+		}
+		collector.Update(&storage.InsertData{Data: map[storage.FieldID]storage.FieldData{
+			101: &storage.Int32FieldData{Data: ages},
+			102: &storage.StringFieldData{Data: idCards},
+		}})
+		scalarStats := make([]*datapb.FieldScalarStats, 0)
+		for _, stats := range collector.Stats() {
+			bs, err := json.Marshal(stats)
+			assert.NoError(t, err)
+			scalarStats = append(scalarStats, &datapb.FieldScalarStats{FieldID: stats.FieldID, Stats: bs})
+		}
+		s.meta.segments.SetSegment(segID+int64(i), NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           segID + int64(i),
+			CollectionID: collID,
+			PartitionID:  partID,
+			NumOfRows:    1000,
+			State:        commonpb.SegmentState_Flushed,
+			ScalarStats:  scalarStats,
+		}))
+	}
+	// growing segment is skipped
+	s.meta.segments.SetSegment(segID+2, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:           segID + 2,
+		CollectionID: collID,
+		PartitionID:  partID,
+		NumOfRows:    1000,
+		State:        commonpb.SegmentState_Growing,
+	}))
+
+	t.Run("server not available", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.GetScalarIndexAdvice(ctx, &datapb.GetScalarIndexAdviceRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	t.Run("collection not found", func(t *testing.T) {
+		resp, err := s.GetScalarIndexAdvice(ctx, &datapb.GetScalarIndexAdviceRequest{CollectionID: collID + 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("advise", func(t *testing.T) {
+		resp, err := s.GetScalarIndexAdvice(ctx, &datapb.GetScalarIndexAdviceRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		advices := resp.GetAdvices()
+		assert.Len(t, advices, 4)
+
+		assert.Equal(t, "age", advices[0].GetFieldName())
+		assert.EqualValues(t, 10, advices[0].GetCardinality())
+		assert.EqualValues(t, 2000, advices[0].GetNumRows())
+		assert.Equal(t, indexparamcheck.IndexINVERTED, advices[0].GetIndexType())
+
+		assert.Equal(t, "id_card", advices[1].GetFieldName())
+		assert.InEpsilon(t, 2000, advices[1].GetCardinality(), 0.3)
+		assert.Equal(t, indexparamcheck.IndexTrie, advices[1].GetIndexType())
+
+		assert.Equal(t, "price", advices[2].GetFieldName())
+		assert.EqualValues(t, -1, advices[2].GetCardinality())
+		assert.EqualValues(t, 0, advices[2].GetNumRows())
+		assert.Equal(t, indexparamcheck.IndexINVERTED, advices[2].GetIndexType())
+
+		assert.Equal(t, "flag", advices[3].GetFieldName())
+		assert.EqualValues(t, 2000, advices[3].GetNumRows())
+		assert.Equal(t, indexparamcheck.IndexINVERTED, advices[3].GetIndexType())
+	})
+
+	t.Run("auto create", func(t *testing.T) {
+		paramtable.Get().Save(Params.DataCoordCfg.IndexAdvisorMinRows.Key, "1000")
+		defer paramtable.Get().Reset(Params.DataCoordCfg.IndexAdvisorMinRows.Key)
+
+		s.createAdvisedScalarIndexes(ctx, collID)
+		indexes := s.meta.indexMeta.GetIndexesForCollection(collID, "")
+		assert.Len(t, indexes, 4)
+		indexTypes := make(map[int64]string)
+		for _, index := range indexes {
+			indexTypes[index.FieldID] = GetIndexType(index.IndexParams)
+		}
+		assert.Equal(t, map[int64]string{
+			101: indexparamcheck.IndexINVERTED,
+			102: indexparamcheck.IndexTrie,
+			104: indexparamcheck.IndexINVERTED,
+			105: indexparamcheck.IndexHNSW,
+		}, indexTypes)
+
+		resp, err := s.GetScalarIndexAdvice(ctx, &datapb.GetScalarIndexAdviceRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetAdvices(), 4)
+		assert.Equal(t, indexparamcheck.IndexINVERTED, resp.GetAdvices()[0].GetCurrentIndexType())
+		assert.Equal(t, "", resp.GetAdvices()[2].GetCurrentIndexType())
+	})
+}
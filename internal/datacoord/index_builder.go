@@ -26,6 +26,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -87,6 +88,12 @@ type indexBuilder struct {
 	chunkManager              storage.ChunkManager
 	indexEngineVersionManager IndexEngineVersionManager
 	handler                   Handler
+	// allocates the buildIDs of the index partitions
+	allocator allocator
+	// reuses the index files of the same source data and index params
+	indexCache *indexCache
+
+	tracker indexTaskTracker
 }
 
 func newIndexBuilder(
@@ -95,6 +102,7 @@ func newIndexBuilder(
 	chunkManager storage.ChunkManager,
 	indexEngineVersionManager IndexEngineVersionManager,
 	handler Handler,
+	allocator allocator,
 ) *indexBuilder {
 	ctx, cancel := context.WithCancel(ctx)
 
@@ -110,6 +118,8 @@ func newIndexBuilder(
 		chunkManager:              chunkManager,
 		handler:                   handler,
 		indexEngineVersionManager: indexEngineVersionManager,
+		allocator:                 allocator,
+		indexCache:                newIndexCache(ctx, chunkManager),
 	}
 	ib.reloadFromKV()
 	return ib
@@ -233,12 +243,32 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 		ib.taskMutex.Lock()
 		defer ib.taskMutex.Unlock()
 		delete(ib.tasks, buildID)
+		if ib.indexCache != nil {
+			ib.indexCache.forget(buildID)
+		}
 	}
 
 	meta, exist := ib.meta.indexMeta.GetIndexJob(buildID)
 	if !exist {
 		log.Ctx(ib.ctx).Debug("index task has not exist in meta table, remove task", zap.Int64("buildID", buildID))
 		deleteFunc(buildID)
+		ib.tracker.forget(buildID)
+		return true
+	}
+
+	if state != indexTaskDone && ib.tracker.isCancelled(buildID) {
+		if err := ib.meta.indexMeta.FinishTask(&indexpb.IndexTaskInfo{
+			BuildID:    buildID,
+			State:      commonpb.IndexState_Failed,
+			FailReason: "index task cancelled",
+		}); err != nil {
+			log.Ctx(ib.ctx).Warn("IndexCoord update cancelled index task fail", zap.Int64("buildID", buildID), zap.Error(err))
+			return false
+		}
+		log.Ctx(ib.ctx).Info("index task cancelled", zap.Int64("buildID", buildID), zap.Int64("nodeID", meta.NodeID))
+		ib.tracker.forget(buildID)
+		// drop the job on the IndexNode if it's assigned
+		updateStateFunc(buildID, indexTaskDone)
 		return true
 	}
 
@@ -272,6 +302,35 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			updateStateFunc(buildID, indexTaskDone)
 			return true
 		}
+		cacheKey, ok := ib.getIndexCacheKey(meta, segment)
+		if !ok {
+			// wait for the cache key computed in background
+			return true
+		}
+		if cacheKey != "" && ib.reuseIndexCache(meta, cacheKey) {
+			updateStateFunc(buildID, indexTaskDone)
+			return true
+		}
+		if len(meta.Partitions) == 0 && ib.shouldBuildByPartitions(meta, indexType) {
+			partitioned, err := ib.planPartitions(meta, segment)
+			if err != nil {
+				log.Ctx(ib.ctx).Warn("index builder plan index partitions failed", zap.Int64("buildID", buildID), zap.Error(err))
+				return false
+			}
+			if partitioned {
+				meta, _ = ib.meta.indexMeta.GetIndexJob(buildID)
+			}
+		}
+		if len(meta.Partitions) > 0 {
+			// the partitions are assigned to the IndexNodes in progress
+			if err := ib.meta.indexMeta.BuildIndex(buildID); err != nil {
+				log.Ctx(ib.ctx).Warn("index builder update index meta to InProgress failed", zap.Int64("buildID", buildID), zap.Error(err))
+				return false
+			}
+			ib.tracker.start(buildID)
+			updateStateFunc(buildID, indexTaskInProgress)
+			return true
+		}
 		// peek client
 		// if all IndexNodes are executing task, wait for one of them to finish the task.
 		nodeID, client := ib.nodeManager.PeekClient(meta)
@@ -285,138 +344,9 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			return false
 		}
 
-		// vector index build needs information of optional scalar fields data
-		optionalFields := make([]*indexpb.OptionalFieldInfo, 0)
-		if Params.CommonCfg.EnableMaterializedView.GetAsBool() && isOptionalScalarFieldSupported(indexType) {
-			colSchema := ib.meta.GetCollection(meta.CollectionID).Schema
-			hasPartitionKey := typeutil.HasPartitionKey(colSchema)
-			if hasPartitionKey {
-				partitionKeyField, err := typeutil.GetPartitionKeyFieldSchema(colSchema)
-				if partitionKeyField == nil || err != nil {
-					log.Ctx(ib.ctx).Warn("index builder get partition key field failed", zap.Int64("build", buildID), zap.Error(err))
-				} else {
-					optionalFields = append(optionalFields, &indexpb.OptionalFieldInfo{
-						FieldID:   partitionKeyField.FieldID,
-						FieldName: partitionKeyField.Name,
-						FieldType: int32(partitionKeyField.DataType),
-						DataIds:   getBinLogIDs(segment, partitionKeyField.FieldID),
-					})
-				}
-			}
-		}
-
-		typeParams := ib.meta.indexMeta.GetTypeParams(meta.CollectionID, meta.IndexID)
-
-		var storageConfig *indexpb.StorageConfig
-		if Params.CommonCfg.StorageType.GetValue() == "local" {
-			storageConfig = &indexpb.StorageConfig{
-				RootPath:    Params.LocalStorageCfg.Path.GetValue(),
-				StorageType: Params.CommonCfg.StorageType.GetValue(),
-			}
-		} else {
-			storageConfig = &indexpb.StorageConfig{
-				Address:          Params.MinioCfg.Address.GetValue(),
-				AccessKeyID:      Params.MinioCfg.AccessKeyID.GetValue(),
-				SecretAccessKey:  Params.MinioCfg.SecretAccessKey.GetValue(),
-				UseSSL:           Params.MinioCfg.UseSSL.GetAsBool(),
-				SslCACert:        Params.MinioCfg.SslCACert.GetValue(),
-				BucketName:       Params.MinioCfg.BucketName.GetValue(),
-				RootPath:         Params.MinioCfg.RootPath.GetValue(),
-				UseIAM:           Params.MinioCfg.UseIAM.GetAsBool(),
-				IAMEndpoint:      Params.MinioCfg.IAMEndpoint.GetValue(),
-				StorageType:      Params.CommonCfg.StorageType.GetValue(),
-				Region:           Params.MinioCfg.Region.GetValue(),
-				UseVirtualHost:   Params.MinioCfg.UseVirtualHost.GetAsBool(),
-				CloudProvider:    Params.MinioCfg.CloudProvider.GetValue(),
-				RequestTimeoutMs: Params.MinioCfg.RequestTimeoutMs.GetAsInt64(),
-			}
-		}
-
-		fieldID := ib.meta.indexMeta.GetFieldIDByIndexID(meta.CollectionID, meta.IndexID)
-		binlogIDs := getBinLogIDs(segment, fieldID)
-		if isDiskANNIndex(GetIndexType(indexParams)) {
-			var err error
-			indexParams, err = indexparams.UpdateDiskIndexBuildParams(Params, indexParams)
-			if err != nil {
-				log.Ctx(ib.ctx).Warn("failed to append index build params", zap.Int64("buildID", buildID),
-					zap.Int64("nodeID", nodeID), zap.Error(err))
-			}
-		}
-		var req *indexpb.CreateJobRequest
-		if Params.CommonCfg.EnableStorageV2.GetAsBool() {
-			collectionInfo, err := ib.handler.GetCollection(ib.ctx, segment.GetCollectionID())
-			if err != nil {
-				log.Info("index builder get collection info failed", zap.Int64("collectionID", segment.GetCollectionID()), zap.Error(err))
-				return false
-			}
-
-			schema := collectionInfo.Schema
-			var field *schemapb.FieldSchema
-
-			for _, f := range schema.Fields {
-				if f.FieldID == fieldID {
-					field = f
-					break
-				}
-			}
-
-			dim, err := storage.GetDimFromParams(field.TypeParams)
-			if err != nil {
-				return false
-			}
-
-			storePath, err := itypeutil.GetStorageURI(params.Params.CommonCfg.StorageScheme.GetValue(), params.Params.CommonCfg.StoragePathPrefix.GetValue(), segment.GetID())
-			if err != nil {
-				log.Ctx(ib.ctx).Warn("failed to get storage uri", zap.Error(err))
-				return false
-			}
-			indexStorePath, err := itypeutil.GetStorageURI(params.Params.CommonCfg.StorageScheme.GetValue(), params.Params.CommonCfg.StoragePathPrefix.GetValue()+"/index", segment.GetID())
-			if err != nil {
-				log.Ctx(ib.ctx).Warn("failed to get storage uri", zap.Error(err))
-				return false
-			}
-
-			req = &indexpb.CreateJobRequest{
-				ClusterID:            Params.CommonCfg.ClusterPrefix.GetValue(),
-				IndexFilePrefix:      path.Join(ib.chunkManager.RootPath(), common.SegmentIndexPath),
-				BuildID:              buildID,
-				IndexVersion:         meta.IndexVersion + 1,
-				StorageConfig:        storageConfig,
-				IndexParams:          indexParams,
-				TypeParams:           typeParams,
-				NumRows:              meta.NumRows,
-				CollectionID:         segment.GetCollectionID(),
-				PartitionID:          segment.GetPartitionID(),
-				SegmentID:            segment.GetID(),
-				FieldID:              fieldID,
-				FieldName:            field.Name,
-				FieldType:            field.DataType,
-				StorePath:            storePath,
-				StoreVersion:         segment.GetStorageVersion(),
-				IndexStorePath:       indexStorePath,
-				Dim:                  int64(dim),
-				CurrentIndexVersion:  ib.indexEngineVersionManager.GetCurrentIndexEngineVersion(),
-				DataIds:              binlogIDs,
-				OptionalScalarFields: optionalFields,
-			}
-		} else {
-			req = &indexpb.CreateJobRequest{
-				ClusterID:            Params.CommonCfg.ClusterPrefix.GetValue(),
-				IndexFilePrefix:      path.Join(ib.chunkManager.RootPath(), common.SegmentIndexPath),
-				BuildID:              buildID,
-				IndexVersion:         meta.IndexVersion + 1,
-				StorageConfig:        storageConfig,
-				IndexParams:          indexParams,
-				TypeParams:           typeParams,
-				NumRows:              meta.NumRows,
-				CurrentIndexVersion:  ib.indexEngineVersionManager.GetCurrentIndexEngineVersion(),
-				DataIds:              binlogIDs,
-				CollectionID:         segment.GetCollectionID(),
-				PartitionID:          segment.GetPartitionID(),
-				SegmentID:            segment.GetID(),
-				FieldID:              fieldID,
-				OptionalScalarFields: optionalFields,
-			}
+		req, ok := ib.createJobRequest(meta, segment)
+		if !ok {
+			return false
 		}
 
 		if err := ib.assignTask(client, req); err != nil {
@@ -436,13 +366,15 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 			updateStateFunc(buildID, indexTaskRetry)
 			return false
 		}
+		ib.tracker.start(buildID)
 		updateStateFunc(buildID, indexTaskInProgress)
 
 	case indexTaskDone:
-		if !ib.dropIndexTask(buildID, meta.NodeID) {
+		if !ib.dropIndexTask(buildID, meta.NodeID) || !ib.dropPartitionTasks(meta) {
 			return true
 		}
 		deleteFunc(buildID)
+		ib.tracker.forget(buildID)
 	case indexTaskRetry:
 		if !ib.dropIndexTask(buildID, meta.NodeID) {
 			return true
@@ -451,12 +383,210 @@ func (ib *indexBuilder) process(buildID UniqueID) bool {
 
 	default:
 		// state: in_progress
-		updateStateFunc(buildID, ib.getTaskState(buildID, meta.NodeID))
+		if len(meta.Partitions) > 0 {
+			segment := ib.meta.GetSegment(meta.SegmentID)
+			if !isSegmentHealthy(segment) {
+				log.Ctx(ib.ctx).Info("task is no need to build index, remove it", zap.Int64("buildID", buildID))
+				if err := ib.meta.indexMeta.DeleteTask(buildID); err != nil {
+					log.Ctx(ib.ctx).Warn("IndexCoord delete index failed", zap.Int64("buildID", buildID), zap.Error(err))
+					return false
+				}
+				updateStateFunc(buildID, indexTaskDone)
+				return true
+			}
+			newState, ok := ib.processPartitions(meta, segment)
+			updateStateFunc(buildID, newState)
+			return ok
+		}
+		newState := ib.getTaskState(buildID, meta.NodeID)
+		if newState == indexTaskDone {
+			// only the finished tasks reflect the throughput of the IndexNode
+			if job, ok := ib.meta.indexMeta.GetIndexJob(buildID); ok && job.IndexState == commonpb.IndexState_Finished {
+				ib.tracker.finish(buildID, meta.NodeID, meta.NumRows)
+				ib.putIndexCache(buildID)
+			}
+		}
+		updateStateFunc(buildID, newState)
 	}
 	return true
 }
 
+// createJobRequest creates the request to build the index of the whole segment.
+func (ib *indexBuilder) createJobRequest(meta *model.SegmentIndex, segment *SegmentInfo) (*indexpb.CreateJobRequest, bool) {
+	buildID := meta.BuildID
+	indexParams := ib.meta.indexMeta.GetIndexParams(meta.CollectionID, meta.IndexID)
+	indexType := GetIndexType(indexParams)
+
+	// vector index build needs information of optional scalar fields data
+	optionalFields := make([]*indexpb.OptionalFieldInfo, 0)
+	if Params.CommonCfg.EnableMaterializedView.GetAsBool() && isOptionalScalarFieldSupported(indexType) {
+		colSchema := ib.meta.GetCollection(meta.CollectionID).Schema
+		hasPartitionKey := typeutil.HasPartitionKey(colSchema)
+		if hasPartitionKey {
+			partitionKeyField, err := typeutil.GetPartitionKeyFieldSchema(colSchema)
+			if partitionKeyField == nil || err != nil {
+				log.Ctx(ib.ctx).Warn("index builder get partition key field failed", zap.Int64("build", buildID), zap.Error(err))
+			} else {
+				optionalFields = append(optionalFields, &indexpb.OptionalFieldInfo{
+					FieldID:   partitionKeyField.FieldID,
+					FieldName: partitionKeyField.Name,
+					FieldType: int32(partitionKeyField.DataType),
+					DataIds:   getBinLogIDs(segment, partitionKeyField.FieldID),
+				})
+			}
+		}
+	}
+
+	typeParams := ib.meta.indexMeta.GetTypeParams(meta.CollectionID, meta.IndexID)
+
+	var storageConfig *indexpb.StorageConfig
+	if Params.CommonCfg.StorageType.GetValue() == "local" {
+		storageConfig = &indexpb.StorageConfig{
+			RootPath:    Params.LocalStorageCfg.Path.GetValue(),
+			StorageType: Params.CommonCfg.StorageType.GetValue(),
+		}
+	} else {
+		storageConfig = &indexpb.StorageConfig{
+			Address:          Params.MinioCfg.Address.GetValue(),
+			AccessKeyID:      Params.MinioCfg.AccessKeyID.GetValue(),
+			SecretAccessKey:  Params.MinioCfg.SecretAccessKey.GetValue(),
+			UseSSL:           Params.MinioCfg.UseSSL.GetAsBool(),
+			SslCACert:        Params.MinioCfg.SslCACert.GetValue(),
+			BucketName:       Params.MinioCfg.BucketName.GetValue(),
+			RootPath:         Params.MinioCfg.RootPath.GetValue(),
+			UseIAM:           Params.MinioCfg.UseIAM.GetAsBool(),
+			IAMEndpoint:      Params.MinioCfg.IAMEndpoint.GetValue(),
+			StorageType:      Params.CommonCfg.StorageType.GetValue(),
+			Region:           Params.MinioCfg.Region.GetValue(),
+			UseVirtualHost:   Params.MinioCfg.UseVirtualHost.GetAsBool(),
+			CloudProvider:    Params.MinioCfg.CloudProvider.GetValue(),
+			RequestTimeoutMs: Params.MinioCfg.RequestTimeoutMs.GetAsInt64(),
+		}
+		// the index node reads the binlogs from and writes the index files to the bucket of the collection
+		if collectionStorage, ok := storage.GetCollectionStorage(meta.CollectionID); ok {
+			applyCollectionStorage(storageConfig, collectionStorage)
+		}
+	}
+
+	fieldID := ib.meta.indexMeta.GetFieldIDByIndexID(meta.CollectionID, meta.IndexID)
+	binlogIDs := getBinLogIDs(segment, fieldID)
+	if isDiskANNIndex(GetIndexType(indexParams)) {
+		var err error
+		indexParams, err = indexparams.UpdateDiskIndexBuildParams(Params, indexParams)
+		if err != nil {
+			log.Ctx(ib.ctx).Warn("failed to append index build params", zap.Int64("buildID", buildID), zap.Error(err))
+		}
+	}
+	var req *indexpb.CreateJobRequest
+	if Params.CommonCfg.EnableStorageV2.GetAsBool() {
+		collectionInfo, err := ib.handler.GetCollection(ib.ctx, segment.GetCollectionID())
+		if err != nil {
+			log.Info("index builder get collection info failed", zap.Int64("collectionID", segment.GetCollectionID()), zap.Error(err))
+			return nil, false
+		}
+
+		schema := collectionInfo.Schema
+		var field *schemapb.FieldSchema
+
+		for _, f := range schema.Fields {
+			if f.FieldID == fieldID {
+				field = f
+				break
+			}
+		}
+
+		dim, err := storage.GetDimFromParams(field.TypeParams)
+		if err != nil {
+			return nil, false
+		}
+
+		storePath, err := itypeutil.GetStorageURI(params.Params.CommonCfg.StorageScheme.GetValue(), params.Params.CommonCfg.StoragePathPrefix.GetValue(), segment.GetID())
+		if err != nil {
+			log.Ctx(ib.ctx).Warn("failed to get storage uri", zap.Error(err))
+			return nil, false
+		}
+		indexStorePath, err := itypeutil.GetStorageURI(params.Params.CommonCfg.StorageScheme.GetValue(), params.Params.CommonCfg.StoragePathPrefix.GetValue()+"/index", segment.GetID())
+		if err != nil {
+			log.Ctx(ib.ctx).Warn("failed to get storage uri", zap.Error(err))
+			return nil, false
+		}
+
+		req = &indexpb.CreateJobRequest{
+			ClusterID:            Params.CommonCfg.ClusterPrefix.GetValue(),
+			IndexFilePrefix:      path.Join(ib.chunkManager.RootPath(), common.SegmentIndexPath),
+			BuildID:              buildID,
+			IndexVersion:         meta.IndexVersion + 1,
+			StorageConfig:        storageConfig,
+			IndexParams:          indexParams,
+			TypeParams:           typeParams,
+			NumRows:              meta.NumRows,
+			CollectionID:         segment.GetCollectionID(),
+			PartitionID:          segment.GetPartitionID(),
+			SegmentID:            segment.GetID(),
+			FieldID:              fieldID,
+			FieldName:            field.Name,
+			FieldType:            field.DataType,
+			StorePath:            storePath,
+			StoreVersion:         segment.GetStorageVersion(),
+			IndexStorePath:       indexStorePath,
+			Dim:                  int64(dim),
+			CurrentIndexVersion:  ib.indexEngineVersionManager.GetCurrentIndexEngineVersion(),
+			DataIds:              binlogIDs,
+			OptionalScalarFields: optionalFields,
+		}
+	} else {
+		req = &indexpb.CreateJobRequest{
+			ClusterID:            Params.CommonCfg.ClusterPrefix.GetValue(),
+			IndexFilePrefix:      path.Join(ib.chunkManager.RootPath(), common.SegmentIndexPath),
+			BuildID:              buildID,
+			IndexVersion:         meta.IndexVersion + 1,
+			StorageConfig:        storageConfig,
+			IndexParams:          indexParams,
+			TypeParams:           typeParams,
+			NumRows:              meta.NumRows,
+			CurrentIndexVersion:  ib.indexEngineVersionManager.GetCurrentIndexEngineVersion(),
+			DataIds:              binlogIDs,
+			CollectionID:         segment.GetCollectionID(),
+			PartitionID:          segment.GetPartitionID(),
+			SegmentID:            segment.GetID(),
+			FieldID:              fieldID,
+			OptionalScalarFields: optionalFields,
+		}
+	}
+	return req, true
+}
+
+// applyCollectionStorage overrides the storage config by the storage of the collection placed in its own bucket.
+func applyCollectionStorage(storageConfig *indexpb.StorageConfig, collectionStorage common.CollectionStorage) {
+	storageConfig.BucketName = collectionStorage.BucketName
+	if collectionStorage.Address != "" {
+		storageConfig.Address = collectionStorage.Address
+	}
+	if collectionStorage.RootPath != "" {
+		storageConfig.RootPath = collectionStorage.RootPath
+	}
+	if collectionStorage.AccessKeyID != "" || collectionStorage.SecretAccessKey != "" {
+		storageConfig.AccessKeyID = collectionStorage.AccessKeyID
+		storageConfig.SecretAccessKey = collectionStorage.SecretAccessKey
+		storageConfig.UseIAM = false
+	}
+}
+
 func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
+	info, state := ib.queryTaskInfo(buildID, nodeID)
+	if state != indexTaskDone {
+		return state
+	}
+	if err := ib.meta.indexMeta.FinishTask(info); err != nil {
+		log.Ctx(ib.ctx).Warn("IndexCoord update index state fail", zap.Int64("buildID", info.GetBuildID()),
+			zap.String("index state", info.GetState().String()), zap.Error(err))
+		return indexTaskInProgress
+	}
+	return indexTaskDone
+}
+
+// queryTaskInfo queries the state of the job from the IndexNode, the info is returned only if the job is done.
+func (ib *indexBuilder) queryTaskInfo(buildID, nodeID UniqueID) (*indexpb.IndexTaskInfo, indexTaskState) {
 	client, exist := ib.nodeManager.GetClientByID(nodeID)
 	if exist {
 		ctx1, cancel := context.WithTimeout(ib.ctx, reqTimeoutInterval)
@@ -468,12 +598,12 @@ func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
 		if err != nil {
 			log.Ctx(ib.ctx).Warn("IndexCoord get jobs info from IndexNode fail", zap.Int64("nodeID", nodeID),
 				zap.Error(err))
-			return indexTaskRetry
+			return nil, indexTaskRetry
 		}
 		if response.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
 			log.Ctx(ib.ctx).Warn("IndexCoord get jobs info from IndexNode fail", zap.Int64("nodeID", nodeID),
 				zap.Int64("buildID", buildID), zap.String("fail reason", response.GetStatus().GetReason()))
-			return indexTaskRetry
+			return nil, indexTaskRetry
 		}
 
 		// indexInfos length is always one.
@@ -482,27 +612,22 @@ func (ib *indexBuilder) getTaskState(buildID, nodeID UniqueID) indexTaskState {
 				if info.GetState() == commonpb.IndexState_Failed || info.GetState() == commonpb.IndexState_Finished {
 					log.Ctx(ib.ctx).Info("this task has been finished", zap.Int64("buildID", info.GetBuildID()),
 						zap.String("index state", info.GetState().String()))
-					if err := ib.meta.indexMeta.FinishTask(info); err != nil {
-						log.Ctx(ib.ctx).Warn("IndexCoord update index state fail", zap.Int64("buildID", info.GetBuildID()),
-							zap.String("index state", info.GetState().String()), zap.Error(err))
-						return indexTaskInProgress
-					}
-					return indexTaskDone
+					return info, indexTaskDone
 				} else if info.GetState() == commonpb.IndexState_Retry || info.GetState() == commonpb.IndexState_IndexStateNone {
 					log.Ctx(ib.ctx).Info("this task should be retry", zap.Int64("buildID", buildID), zap.String("fail reason", info.GetFailReason()))
-					return indexTaskRetry
+					return nil, indexTaskRetry
 				}
-				return indexTaskInProgress
+				return nil, indexTaskInProgress
 			}
 		}
 		log.Ctx(ib.ctx).Info("this task should be retry, indexNode does not have this task", zap.Int64("buildID", buildID),
 			zap.Int64("nodeID", nodeID))
-		return indexTaskRetry
+		return nil, indexTaskRetry
 	}
 	// !exist --> node down
 	log.Ctx(ib.ctx).Info("this task should be retry, indexNode is no longer exist", zap.Int64("buildID", buildID),
 		zap.Int64("nodeID", nodeID))
-	return indexTaskRetry
+	return nil, indexTaskRetry
 }
 
 func (ib *indexBuilder) dropIndexTask(buildID, nodeID UniqueID) bool {
@@ -553,6 +678,7 @@ func (ib *indexBuilder) assignTask(builderClient types.IndexNodeClient, req *ind
 func (ib *indexBuilder) nodeDown(nodeID UniqueID) {
 	defer ib.notify()
 
+	ib.tracker.removeNode(nodeID)
 	metas := ib.meta.indexMeta.GetMetasByNodeID(nodeID)
 
 	ib.taskMutex.Lock()
@@ -564,3 +690,37 @@ func (ib *indexBuilder) nodeDown(nodeID UniqueID) {
 		}
 	}
 }
+
+// cancelTasks marks the unfinished index tasks cancelled, they are failed and dropped from
+// the IndexNodes in the next schedule, returns the buildIDs cancelled.
+func (ib *indexBuilder) cancelTasks(buildIDs []UniqueID) []UniqueID {
+	defer ib.notify()
+
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	cancelled := make([]UniqueID, 0, len(buildIDs))
+	for _, buildID := range buildIDs {
+		state, ok := ib.tasks[buildID]
+		if !ok || state == indexTaskDone {
+			continue
+		}
+		ib.tracker.cancel(buildID)
+		cancelled = append(cancelled, buildID)
+	}
+	return cancelled
+}
+
+// unfinishedTasks returns the buildIDs of the index tasks not done yet.
+func (ib *indexBuilder) unfinishedTasks() []UniqueID {
+	ib.taskMutex.RLock()
+	defer ib.taskMutex.RUnlock()
+
+	buildIDs := make([]UniqueID, 0, len(ib.tasks))
+	for buildID, state := range ib.tasks {
+		if state != indexTaskDone {
+			buildIDs = append(buildIDs, buildID)
+		}
+	}
+	return buildIDs
+}
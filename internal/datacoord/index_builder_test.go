@@ -675,7 +675,7 @@ func TestIndexBuilder(t *testing.T) {
 	chunkManager := &mocks.ChunkManager{}
 	chunkManager.EXPECT().RootPath().Return("root")
 
-	ib := newIndexBuilder(ctx, mt, nodeManager, chunkManager, newIndexEngineVersionManager(), nil)
+	ib := newIndexBuilder(ctx, mt, nodeManager, chunkManager, newIndexEngineVersionManager(), nil, nil)
 
 	assert.Equal(t, 6, len(ib.tasks))
 	assert.Equal(t, indexTaskInit, ib.tasks[buildID])
@@ -1166,7 +1166,7 @@ func TestIndexBuilderV2(t *testing.T) {
 		},
 	}, nil)
 
-	ib := newIndexBuilder(ctx, mt, nodeManager, chunkManager, newIndexEngineVersionManager(), handler)
+	ib := newIndexBuilder(ctx, mt, nodeManager, chunkManager, newIndexEngineVersionManager(), handler, nil)
 
 	assert.Equal(t, 6, len(ib.tasks))
 	assert.Equal(t, indexTaskInit, ib.tasks[buildID])
@@ -1415,7 +1415,7 @@ func TestVecIndexWithOptionalScalarField(t *testing.T) {
 
 	paramtable.Get().CommonCfg.EnableMaterializedView.SwapTempValue("true")
 	defer paramtable.Get().CommonCfg.EnableMaterializedView.SwapTempValue("false")
-	ib := newIndexBuilder(ctx, &mt, nodeManager, cm, newIndexEngineVersionManager(), nil)
+	ib := newIndexBuilder(ctx, &mt, nodeManager, cm, newIndexEngineVersionManager(), nil, nil)
 
 	t.Run("success to get opt field on startup", func(t *testing.T) {
 		ic.EXPECT().CreateJob(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
@@ -1510,3 +1510,80 @@ func TestVecIndexWithOptionalScalarField(t *testing.T) {
 	ib.nodeDown(nodeID)
 	ib.Stop()
 }
+
+func TestIndexBuilder_CancelTasks(t *testing.T) {
+	paramtable.Init()
+
+	sc := catalogmocks.NewDataCoordCatalog(t)
+	sc.On("AlterSegmentIndexes",
+		mock.Anything,
+		mock.Anything,
+	).Return(nil)
+	ec := catalogmocks.NewDataCoordCatalog(t)
+	ec.On("AlterSegmentIndexes",
+		mock.Anything,
+		mock.Anything,
+	).Return(errors.New("fail"))
+
+	ic := mocks.NewMockIndexNodeClient(t)
+	ic.EXPECT().DropJobs(mock.Anything, mock.Anything, mock.Anything).Return(merr.Success(), nil)
+
+	ib := &indexBuilder{
+		ctx: context.Background(),
+		tasks: map[int64]indexTaskState{
+			buildID:     indexTaskInit,
+			buildID + 1: indexTaskInProgress,
+			buildID + 4: indexTaskDone,
+		},
+		meta: createMetaTable(sc),
+		nodeManager: &IndexNodeManager{
+			ctx:         context.Background(),
+			nodeClients: map[UniqueID]types.IndexNodeClient{nodeID: ic},
+		},
+	}
+
+	cancelled := ib.cancelTasks([]UniqueID{buildID, buildID + 1, buildID + 4, buildID + 100})
+	assert.ElementsMatch(t, []UniqueID{buildID, buildID + 1}, cancelled)
+	assert.ElementsMatch(t, []UniqueID{buildID, buildID + 1}, ib.unfinishedTasks())
+
+	t.Run("cancel in progress task", func(t *testing.T) {
+		assert.True(t, ib.process(buildID+1))
+		assert.Equal(t, indexTaskDone, ib.tasks[buildID+1])
+		segIdx, ok := ib.meta.indexMeta.GetIndexJob(buildID + 1)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Failed, segIdx.IndexState)
+		assert.Equal(t, "index task cancelled", segIdx.FailReason)
+
+		// the job is dropped from the IndexNode
+		assert.True(t, ib.process(buildID+1))
+		_, ok = ib.tasks[buildID+1]
+		assert.False(t, ok)
+		assert.False(t, ib.tracker.isCancelled(buildID+1))
+	})
+
+	t.Run("update catalog fail", func(t *testing.T) {
+		ib.meta.indexMeta.catalog = ec
+		assert.False(t, ib.process(buildID))
+		assert.Equal(t, indexTaskInit, ib.tasks[buildID])
+		assert.True(t, ib.tracker.isCancelled(buildID))
+	})
+}
+
+func TestApplyCollectionStorage(t *testing.T) {
+	storageConfig := &indexpb.StorageConfig{
+		Address:    "minio:9000",
+		BucketName: "a-bucket",
+		RootPath:   "files",
+		UseIAM:     true,
+	}
+	applyCollectionStorage(storageConfig, common.CollectionStorage{BucketName: "tenant-bucket", RootPath: "tenant"})
+	assert.Equal(t, "minio:9000", storageConfig.GetAddress())
+	assert.Equal(t, "tenant-bucket", storageConfig.GetBucketName())
+	assert.Equal(t, "tenant", storageConfig.GetRootPath())
+	assert.True(t, storageConfig.GetUseIAM())
+
+	applyCollectionStorage(storageConfig, common.CollectionStorage{BucketName: "tenant-bucket", AccessKeyID: "ak", SecretAccessKey: "sk"})
+	assert.Equal(t, "ak", storageConfig.GetAccessKeyID())
+	assert.Equal(t, "sk", storageConfig.GetSecretAccessKey())
+	assert.False(t, storageConfig.GetUseIAM())
+}
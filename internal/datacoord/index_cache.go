@@ -0,0 +1,339 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// The index files of the finished builds are keyed by the content hash of the source binlogs
+// and the index params in the object storage, so that the same data indexed again, e.g. a restored
+// backup or an index dropped and created again, reuses the index files instead of building.
+// The segment index reusing the files locates them by the cached build, the garbage collector
+// keeps the files as long as the cache entry is not expired or any segment index reuses them.
+
+// indexCache computes the cache keys in background and accesses the cache entries.
+type indexCache struct {
+	ctx          context.Context
+	chunkManager storage.ChunkManager
+
+	mu sync.Mutex
+	// buildID -> the cache key computing
+	pending map[UniqueID]*conc.Future[any]
+}
+
+func newIndexCache(ctx context.Context, chunkManager storage.ChunkManager) *indexCache {
+	return &indexCache{
+		ctx:          ctx,
+		chunkManager: chunkManager,
+		pending:      make(map[UniqueID]*conc.Future[any]),
+	}
+}
+
+func indexCacheEntryPath(rootPath string, cacheKey string) string {
+	return path.Join(rootPath, common.SegmentIndexCachePath, cacheKey)
+}
+
+// computeKey starts computing the cache key of the build if not started, it returns false
+// until the key is computed. The result is kept until forget is called.
+func (c *indexCache) computeKey(buildID UniqueID, req *indexpb.CreateJobRequest) (string, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	future, ok := c.pending[buildID]
+	if !ok {
+		future = getOrCreateIOPool().Submit(func() (any, error) {
+			return computeIndexCacheKey(c.ctx, c.chunkManager, req)
+		})
+		c.pending[buildID] = future
+	}
+	select {
+	case <-future.Inner():
+	default:
+		return "", false, nil
+	}
+	key, err := future.Await()
+	if err != nil {
+		return "", true, err
+	}
+	return key.(string), true, nil
+}
+
+func (c *indexCache) forget(buildID UniqueID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.pending, buildID)
+}
+
+// get returns the cache entry of the key, or nil if not cached.
+func (c *indexCache) get(cacheKey string) (*indexpb.IndexCacheEntry, error) {
+	entryPath := indexCacheEntryPath(c.chunkManager.RootPath(), cacheKey)
+	exist, err := c.chunkManager.Exist(c.ctx, entryPath)
+	if err != nil || !exist {
+		return nil, err
+	}
+	value, err := c.chunkManager.Read(c.ctx, entryPath)
+	if err != nil {
+		return nil, err
+	}
+	entry := &indexpb.IndexCacheEntry{}
+	if err := proto.Unmarshal(value, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// put caches the index files of the finished segment index.
+func (c *indexCache) put(segIdx *model.SegmentIndex) error {
+	entry := &indexpb.IndexCacheEntry{
+		Location: &indexpb.IndexFilesLocation{
+			BuildID:      segIdx.BuildID,
+			IndexVersion: segIdx.IndexVersion,
+			PartitionID:  segIdx.PartitionID,
+			SegmentID:    segIdx.SegmentID,
+		},
+		IndexFileKeys:       segIdx.IndexFileKeys,
+		SerializeSize:       segIdx.IndexSize,
+		NumRows:             segIdx.NumRows,
+		CurrentIndexVersion: segIdx.CurrentIndexVersion,
+	}
+	value, err := proto.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return c.chunkManager.Write(c.ctx, indexCacheEntryPath(c.chunkManager.RootPath(), segIdx.CacheKey), value)
+}
+
+// filesExist checks whether the index files of the entry are not recycled.
+func (c *indexCache) filesExist(entry *indexpb.IndexCacheEntry) (bool, error) {
+	return indexFilesExist(c.ctx, c.chunkManager, entry)
+}
+
+// indexFilesExist checks whether all the index files of the entry exist.
+func indexFilesExist(ctx context.Context, chunkManager storage.ChunkManager, entry *indexpb.IndexCacheEntry) (bool, error) {
+	location := entry.GetLocation()
+	for _, filePath := range metautil.BuildSegmentIndexFilePaths(chunkManager.RootPath(), location.GetBuildID(),
+		location.GetIndexVersion(), location.GetPartitionID(), location.GetSegmentID(), entry.GetIndexFileKeys()) {
+		exist, err := chunkManager.Exist(ctx, filePath)
+		if err != nil || !exist {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// computeIndexCacheKey hashes the contents of the source binlogs and the params of the build.
+// The ids of the collection, segment and binlogs are excluded, as they change once restored.
+func computeIndexCacheKey(ctx context.Context, chunkManager storage.ChunkManager, req *indexpb.CreateJobRequest) (string, error) {
+	h := sha256.New()
+	writeKeyValuePairs(h, req.GetIndexParams())
+	writeKeyValuePairs(h, req.GetTypeParams())
+	writeInt64(h, req.GetNumRows())
+
+	hashBinlogs := func(fieldID int64, logIDs []int64) error {
+		writeInt64(h, int64(len(logIDs)))
+		for _, logID := range logIDs {
+			logPath := metautil.BuildInsertLogPath(chunkManager.RootPath(), req.GetCollectionID(), req.GetPartitionID(),
+				req.GetSegmentID(), fieldID, logID)
+			reader, err := chunkManager.Reader(ctx, logPath)
+			if err != nil {
+				return err
+			}
+			n, err := io.Copy(h, reader)
+			reader.Close()
+			if err != nil {
+				return err
+			}
+			writeInt64(h, n)
+		}
+		return nil
+	}
+	if err := hashBinlogs(req.GetFieldID(), req.GetDataIds()); err != nil {
+		return "", err
+	}
+	for _, optionalField := range req.GetOptionalScalarFields() {
+		writeInt64(h, int64(optionalField.GetFieldType()))
+		if err := hashBinlogs(optionalField.GetFieldID(), optionalField.GetDataIds()); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func writeKeyValuePairs(h hash.Hash, pairs []*commonpb.KeyValuePair) {
+	items := make([]string, 0, len(pairs))
+	for _, pair := range pairs {
+		items = append(items, pair.GetKey()+"="+pair.GetValue())
+	}
+	sort.Strings(items)
+	writeInt64(h, int64(len(items)))
+	for _, item := range items {
+		writeInt64(h, int64(len(item)))
+		h.Write([]byte(item))
+	}
+}
+
+// writeInt64 writes the value to the hash, which never returns an error.
+func writeInt64(h hash.Hash, v int64) {
+	buf := make([]byte, 8)
+	common.Endian.PutUint64(buf, uint64(v))
+	h.Write(buf)
+}
+
+// getIndexCacheKey returns the cache key of the build, and false if the key is still computing.
+// An empty key means the build doesn't use the index cache.
+func (ib *indexBuilder) getIndexCacheKey(segIdx *model.SegmentIndex, segment *SegmentInfo) (string, bool) {
+	if ib.indexCache == nil || !Params.DataCoordCfg.IndexCacheEnabled.GetAsBool() ||
+		Params.CommonCfg.EnableStorageV2.GetAsBool() || len(segIdx.Partitions) > 0 {
+		return "", true
+	}
+	// the index files in the bucket of the collection aren't shared with the other collections
+	if _, ok := storage.GetCollectionStorage(segIdx.CollectionID); ok {
+		return "", true
+	}
+	if segIdx.CacheKey != "" {
+		return segIdx.CacheKey, true
+	}
+	req, ok := ib.createJobRequest(segIdx, segment)
+	if !ok {
+		return "", true
+	}
+	cacheKey, done, err := ib.indexCache.computeKey(segIdx.BuildID, req)
+	if !done {
+		return "", false
+	}
+	if err != nil {
+		log.Ctx(ib.ctx).Warn("index builder compute index cache key failed, build without cache",
+			zap.Int64("buildID", segIdx.BuildID), zap.Error(err))
+		return "", true
+	}
+	if err := ib.meta.indexMeta.UpdateCacheKey(segIdx.BuildID, cacheKey); err != nil {
+		log.Ctx(ib.ctx).Warn("index builder update index cache key failed", zap.Int64("buildID", segIdx.BuildID), zap.Error(err))
+		return "", false
+	}
+	ib.indexCache.forget(segIdx.BuildID)
+	return cacheKey, true
+}
+
+// reuseIndexCache finishes the build with the cached index files if any, it returns false if the
+// index needs building.
+func (ib *indexBuilder) reuseIndexCache(segIdx *model.SegmentIndex, cacheKey string) bool {
+	log := log.Ctx(ib.ctx).With(zap.Int64("buildID", segIdx.BuildID), zap.String("cacheKey", cacheKey))
+	entry, err := ib.indexCache.get(cacheKey)
+	if err != nil {
+		log.Warn("index builder get index cache failed", zap.Error(err))
+		return false
+	}
+	if entry == nil {
+		return false
+	}
+	// the index files must be loadable by all the QueryNodes
+	version := entry.GetCurrentIndexVersion()
+	if entry.GetNumRows() != segIdx.NumRows ||
+		version < ib.indexEngineVersionManager.GetMinimalIndexEngineVersion() ||
+		version > ib.indexEngineVersionManager.GetCurrentIndexEngineVersion() {
+		log.Info("index cache entry mismatch, build the index", zap.Int64("cachedRows", entry.GetNumRows()),
+			zap.Int64("numRows", segIdx.NumRows), zap.Int32("cachedIndexVersion", version))
+		return false
+	}
+	exist, err := ib.indexCache.filesExist(entry)
+	if err != nil || !exist {
+		log.Info("index files of the cache entry not available, build the index", zap.Error(err))
+		return false
+	}
+	if err := ib.meta.indexMeta.ReuseIndexFiles(segIdx.BuildID, entry); err != nil {
+		log.Warn("index builder reuse index files failed", zap.Error(err))
+		return false
+	}
+	log.Info("index task finished by reusing the cached index files",
+		zap.Int64("reusedBuildID", entry.GetLocation().GetBuildID()))
+	return true
+}
+
+// putIndexCache caches the index files of the build just finished.
+func (ib *indexBuilder) putIndexCache(buildID UniqueID) {
+	if ib.indexCache == nil {
+		return
+	}
+	segIdx, ok := ib.meta.indexMeta.GetIndexJob(buildID)
+	if !ok || segIdx.CacheKey == "" || segIdx.ReusedFrom != nil || len(segIdx.Partitions) > 0 ||
+		segIdx.IndexState != commonpb.IndexState_Finished {
+		return
+	}
+	if err := ib.indexCache.put(segIdx); err != nil {
+		log.Ctx(ib.ctx).Warn("index builder put index cache failed", zap.Int64("buildID", buildID), zap.Error(err))
+		return
+	}
+	log.Ctx(ib.ctx).Info("index files cached", zap.Int64("buildID", buildID), zap.String("cacheKey", segIdx.CacheKey))
+}
+
+// listIndexCache returns the builds whose index files are referenced by the cache entries not expired,
+// the expired entries are removed if remove is true.
+func listIndexCache(ctx context.Context, chunkManager storage.ChunkManager, ttl time.Duration, remove bool) (typeutil.UniqueSet, error) {
+	prefix := path.Join(chunkManager.RootPath(), common.SegmentIndexCachePath) + "/"
+	keys, modTimes, err := chunkManager.ListWithPrefix(ctx, prefix, true)
+	if err != nil {
+		return nil, err
+	}
+	buildIDs := typeutil.NewUniqueSet()
+	for i, key := range keys {
+		if time.Since(modTimes[i]) > ttl {
+			if !remove {
+				continue
+			}
+			if err := chunkManager.Remove(ctx, key); err != nil {
+				log.Warn("failed to remove expired index cache entry", zap.String("key", key), zap.Error(err))
+				return nil, err
+			}
+			log.Info("expired index cache entry removed", zap.String("cacheKey", strings.TrimPrefix(key, prefix)))
+			continue
+		}
+		value, err := chunkManager.Read(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		entry := &indexpb.IndexCacheEntry{}
+		if err := proto.Unmarshal(value, entry); err != nil {
+			log.Warn("invalid index cache entry", zap.String("key", key), zap.Error(err))
+			continue
+		}
+		buildIDs.Insert(entry.GetLocation().GetBuildID())
+	}
+	return buildIDs, nil
+}
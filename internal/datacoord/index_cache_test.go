@@ -0,0 +1,315 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	catalogmocks "github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func writeInsertLogs(t *testing.T, cm storage.ChunkManager, collectionID, segmentID int64, contents map[int64]string) {
+	for logID, content := range contents {
+		logPath := metautil.BuildInsertLogPath(cm.RootPath(), collectionID, partID, segmentID, fieldID, logID)
+		require.NoError(t, cm.Write(context.TODO(), logPath, []byte(content)))
+	}
+}
+
+func TestComputeIndexCacheKey(t *testing.T) {
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	writeInsertLogs(t, cm, collID, segID, map[int64]string{1: "a", 2: "b"})
+	// restored with other ids
+	writeInsertLogs(t, cm, collID+1, segID+1, map[int64]string{11: "a", 12: "b"})
+	writeInsertLogs(t, cm, collID+2, segID+2, map[int64]string{21: "ab", 22: ""})
+
+	newRequest := func(collectionID, segmentID int64, dataIDs ...int64) *indexpb.CreateJobRequest {
+		return &indexpb.CreateJobRequest{
+			CollectionID: collectionID,
+			PartitionID:  partID,
+			SegmentID:    segmentID,
+			FieldID:      fieldID,
+			DataIds:      dataIDs,
+			NumRows:      2,
+			IndexParams: []*commonpb.KeyValuePair{
+				{Key: common.IndexTypeKey, Value: indexparamcheck.IndexHNSW},
+				{Key: common.MetricTypeKey, Value: "L2"},
+			},
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "128"}},
+		}
+	}
+
+	key, err := computeIndexCacheKey(context.TODO(), cm, newRequest(collID, segID, 1, 2))
+	require.NoError(t, err)
+	assert.Len(t, key, 64)
+
+	// the ids and the order of the params don't matter
+	req := newRequest(collID+1, segID+1, 11, 12)
+	req.IndexParams[0], req.IndexParams[1] = req.IndexParams[1], req.IndexParams[0]
+	restored, err := computeIndexCacheKey(context.TODO(), cm, req)
+	require.NoError(t, err)
+	assert.Equal(t, key, restored)
+
+	// the contents of each binlog matter
+	other, err := computeIndexCacheKey(context.TODO(), cm, newRequest(collID+2, segID+2, 21, 22))
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+
+	// the params matter
+	req = newRequest(collID, segID, 1, 2)
+	req.IndexParams[1].Value = "IP"
+	other, err = computeIndexCacheKey(context.TODO(), cm, req)
+	require.NoError(t, err)
+	assert.NotEqual(t, key, other)
+
+	_, err = computeIndexCacheKey(context.TODO(), cm, newRequest(collID, segID, 3))
+	assert.Error(t, err)
+}
+
+func TestIndexCache(t *testing.T) {
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	cache := newIndexCache(context.TODO(), cm)
+
+	entry, err := cache.get("key")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+
+	segIdx := &model.SegmentIndex{
+		SegmentID:           segID,
+		PartitionID:         partID,
+		NumRows:             1025,
+		BuildID:             buildID,
+		IndexVersion:        1,
+		IndexState:          commonpb.IndexState_Finished,
+		IndexFileKeys:       []string{"file"},
+		IndexSize:           100,
+		CurrentIndexVersion: 2,
+		CacheKey:            "key",
+	}
+	require.NoError(t, cache.put(segIdx))
+	entry, err = cache.get("key")
+	require.NoError(t, err)
+	assert.EqualValues(t, buildID, entry.GetLocation().GetBuildID())
+	assert.EqualValues(t, segID, entry.GetLocation().GetSegmentID())
+	assert.Equal(t, []string{"file"}, entry.GetIndexFileKeys())
+	assert.EqualValues(t, 1025, entry.GetNumRows())
+	assert.EqualValues(t, 2, entry.GetCurrentIndexVersion())
+
+	exist, err := cache.filesExist(entry)
+	assert.NoError(t, err)
+	assert.False(t, exist)
+	require.NoError(t, cm.Write(context.TODO(), segmentIndexFilePaths(cm.RootPath(), segIdx)[0], []byte("index")))
+	exist, err = cache.filesExist(entry)
+	assert.NoError(t, err)
+	assert.True(t, exist)
+
+	buildIDs, err := listIndexCache(context.TODO(), cm, time.Hour, true)
+	assert.NoError(t, err)
+	assert.True(t, buildIDs.Contain(buildID))
+
+	// expired entries are removed
+	buildIDs, err = listIndexCache(context.TODO(), cm, 0, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, buildIDs.Len())
+	entry, _ = cache.get("key")
+	assert.NotNil(t, entry)
+	buildIDs, err = listIndexCache(context.TODO(), cm, 0, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, buildIDs.Len())
+	entry, _ = cache.get("key")
+	assert.Nil(t, entry)
+}
+
+func TestIndexBuilder_IndexCache(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.IndexCacheEnabled.Key, "true")
+	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.IndexCacheEnabled.Key)
+
+	cm := storage.NewLocalChunkManager(storage.RootPath(t.TempDir()))
+	// the segment restored from the backup of the indexed segment
+	writeInsertLogs(t, cm, collID, segID, map[int64]string{1: "a", 2: "b"})
+	writeInsertLogs(t, cm, collID, segID+1, map[int64]string{11: "a", 12: "b"})
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.On("AlterSegmentIndexes", mock.Anything, mock.Anything).Return(nil)
+	catalog.On("DropSegmentIndex", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	ic := mocks.NewMockIndexNodeClient(t)
+	ic.EXPECT().GetJobStats(mock.Anything, mock.Anything, mock.Anything).Return(&indexpb.GetJobStatsResponse{
+		Status:    merr.Success(),
+		TaskSlots: 1,
+	}, nil)
+	ic.EXPECT().CreateJob(mock.Anything, mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	ic.EXPECT().QueryJobs(mock.Anything, mock.Anything, mock.Anything).Return(&indexpb.QueryJobsResponse{
+		Status: merr.Success(),
+		IndexInfos: []*indexpb.IndexTaskInfo{{
+			BuildID:             buildID,
+			State:               commonpb.IndexState_Finished,
+			IndexFileKeys:       []string{"file"},
+			SerializedSize:      100,
+			CurrentIndexVersion: 0,
+		}},
+	}, nil)
+	ic.EXPECT().DropJobs(mock.Anything, mock.Anything, mock.Anything).Return(merr.Success(), nil)
+
+	newSegment := func(segmentID int64, logIDs ...int64) *SegmentInfo {
+		binlogs := make([]*datapb.Binlog, 0, len(logIDs))
+		for _, logID := range logIDs {
+			binlogs = append(binlogs, &datapb.Binlog{LogID: logID, EntriesNum: 1025})
+		}
+		return &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID:           segmentID,
+			CollectionID: collID,
+			PartitionID:  partID,
+			NumOfRows:    2050,
+			State:        commonpb.SegmentState_Flushed,
+			Binlogs:      []*datapb.FieldBinlog{{FieldID: fieldID, Binlogs: binlogs}},
+		}}
+	}
+	newSegmentIndex := func(segmentID, buildID int64) *model.SegmentIndex {
+		return &model.SegmentIndex{
+			SegmentID:    segmentID,
+			CollectionID: collID,
+			PartitionID:  partID,
+			NumRows:      2050,
+			IndexID:      indexID,
+			BuildID:      buildID,
+			IndexState:   commonpb.IndexState_Unissued,
+		}
+	}
+	segIdx := newSegmentIndex(segID, buildID)
+	restoredIdx := newSegmentIndex(segID+1, buildID+1)
+	mt := &meta{
+		catalog: catalog,
+		collections: map[UniqueID]*collectionInfo{
+			collID: {
+				ID: collID,
+				Schema: &schemapb.CollectionSchema{
+					Fields: []*schemapb.FieldSchema{
+						{FieldID: fieldID, Name: "vec", DataType: schemapb.DataType_FloatVector},
+					},
+				},
+			},
+		},
+		indexMeta: &indexMeta{
+			catalog: catalog,
+			indexes: map[UniqueID]map[UniqueID]*model.Index{
+				collID: {
+					indexID: {
+						CollectionID: collID,
+						FieldID:      fieldID,
+						IndexID:      indexID,
+						IndexName:    indexName,
+						TypeParams:   []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "128"}},
+						IndexParams: []*commonpb.KeyValuePair{
+							{Key: common.MetricTypeKey, Value: "L2"},
+							{Key: common.IndexTypeKey, Value: indexparamcheck.IndexHNSW},
+						},
+					},
+				},
+			},
+			segmentIndexes: map[UniqueID]map[UniqueID]*model.SegmentIndex{
+				segID:     {indexID: segIdx},
+				segID + 1: {indexID: restoredIdx},
+			},
+			buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{
+				buildID:     segIdx,
+				buildID + 1: restoredIdx,
+			},
+		},
+		segments: &SegmentsInfo{
+			segments: map[UniqueID]*SegmentInfo{
+				segID:     newSegment(segID, 1, 2),
+				segID + 1: newSegment(segID+1, 11, 12),
+			},
+		},
+	}
+
+	ib := &indexBuilder{
+		ctx:   context.Background(),
+		tasks: map[int64]indexTaskState{buildID: indexTaskInit},
+		meta:  mt,
+		nodeManager: &IndexNodeManager{
+			ctx:         context.Background(),
+			nodeClients: map[UniqueID]types.IndexNodeClient{nodeID: ic},
+		},
+		chunkManager:              cm,
+		indexEngineVersionManager: newIndexEngineVersionManager(),
+		indexCache:                newIndexCache(context.Background(), cm),
+	}
+
+	processUntil := func(buildID UniqueID, state indexTaskState) {
+		assert.Eventually(t, func() bool {
+			ib.process(buildID)
+			return ib.tasks[buildID] == state
+		}, 10*time.Second, 10*time.Millisecond)
+	}
+
+	// build the index and cache the index files
+	processUntil(buildID, indexTaskInProgress)
+	job, _ := mt.indexMeta.GetIndexJob(buildID)
+	assert.NotEmpty(t, job.CacheKey)
+	processUntil(buildID, indexTaskDone)
+	job, _ = mt.indexMeta.GetIndexJob(buildID)
+	assert.Equal(t, commonpb.IndexState_Finished, job.IndexState)
+	indexFiles := segmentIndexFilePaths(cm.RootPath(), job)
+	require.NoError(t, cm.Write(context.TODO(), indexFiles[0], []byte("index")))
+	entry, err := ib.indexCache.get(job.CacheKey)
+	require.NoError(t, err)
+	assert.EqualValues(t, buildID, entry.GetLocation().GetBuildID())
+	assert.True(t, ib.process(buildID))
+	_, ok := ib.tasks[buildID]
+	assert.False(t, ok)
+
+	// the restored segment reuses the index files
+	ib.tasks[buildID+1] = indexTaskInit
+	processUntil(buildID+1, indexTaskDone)
+	restored, _ := mt.indexMeta.GetIndexJob(buildID + 1)
+	assert.Equal(t, commonpb.IndexState_Finished, restored.IndexState)
+	assert.Equal(t, job.CacheKey, restored.CacheKey)
+	assert.EqualValues(t, buildID, restored.ReusedFrom.BuildID)
+	assert.EqualValues(t, 100, restored.IndexSize)
+	assert.Equal(t, indexFiles, segmentIndexFilePaths(cm.RootPath(), restored))
+
+	// the index files are kept after the segment index of the build removed
+	require.NoError(t, mt.indexMeta.RemoveSegmentIndex(collID, partID, segID, indexID, buildID))
+	canRecycle, reused := mt.indexMeta.CleanSegmentIndex(buildID)
+	assert.True(t, canRecycle)
+	assert.Equal(t, indexFiles, segmentIndexFilePaths(cm.RootPath(), reused))
+	require.NoError(t, mt.indexMeta.RemoveSegmentIndex(collID, partID, segID+1, indexID, buildID+1))
+	canRecycle, reused = mt.indexMeta.CleanSegmentIndex(buildID)
+	assert.True(t, canRecycle)
+	assert.Nil(t, reused)
+}
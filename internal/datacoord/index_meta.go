@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -53,6 +54,11 @@ type indexMeta struct {
 
 	// segmentID -> indexID -> segmentIndex
 	segmentIndexes map[UniqueID]map[UniqueID]*model.SegmentIndex
+
+	// buildID of the index partition -> buildID of the segment index
+	partitionBuildIDs map[UniqueID]UniqueID
+	// buildID of the cached build -> buildIDs of the segment indexes reusing its index files
+	reusedBuildIDs map[UniqueID]typeutil.UniqueSet
 }
 
 // NewMeta creates meta from provided `kv.TxnKV`
@@ -63,6 +69,8 @@ func newIndexMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*ind
 		indexes:              make(map[UniqueID]map[UniqueID]*model.Index),
 		buildID2SegmentIndex: make(map[UniqueID]*model.SegmentIndex),
 		segmentIndexes:       make(map[UniqueID]map[UniqueID]*model.SegmentIndex),
+		partitionBuildIDs:    make(map[UniqueID]UniqueID),
+		reusedBuildIDs:       make(map[UniqueID]typeutil.UniqueSet),
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -111,7 +119,48 @@ func (m *indexMeta) updateSegmentIndex(segIdx *model.SegmentIndex) {
 		m.segmentIndexes[segIdx.SegmentID] = make(map[UniqueID]*model.SegmentIndex)
 		m.segmentIndexes[segIdx.SegmentID][segIdx.IndexID] = segIdx
 	}
+	if old, ok := m.buildID2SegmentIndex[segIdx.BuildID]; ok {
+		m.removePartitionBuildIDs(old)
+		m.removeReusedBuildID(old)
+	}
 	m.buildID2SegmentIndex[segIdx.BuildID] = segIdx
+	if len(segIdx.Partitions) > 0 {
+		if m.partitionBuildIDs == nil {
+			m.partitionBuildIDs = make(map[UniqueID]UniqueID)
+		}
+		for _, partition := range segIdx.Partitions {
+			m.partitionBuildIDs[partition.BuildID] = segIdx.BuildID
+		}
+	}
+	if segIdx.ReusedFrom != nil {
+		if m.reusedBuildIDs == nil {
+			m.reusedBuildIDs = make(map[UniqueID]typeutil.UniqueSet)
+		}
+		if _, ok := m.reusedBuildIDs[segIdx.ReusedFrom.BuildID]; !ok {
+			m.reusedBuildIDs[segIdx.ReusedFrom.BuildID] = typeutil.NewUniqueSet()
+		}
+		m.reusedBuildIDs[segIdx.ReusedFrom.BuildID].Insert(segIdx.BuildID)
+	}
+}
+
+func (m *indexMeta) removePartitionBuildIDs(segIdx *model.SegmentIndex) {
+	for _, partition := range segIdx.Partitions {
+		delete(m.partitionBuildIDs, partition.BuildID)
+	}
+}
+
+func (m *indexMeta) removeReusedBuildID(segIdx *model.SegmentIndex) {
+	if segIdx.ReusedFrom == nil {
+		return
+	}
+	reusedBy, ok := m.reusedBuildIDs[segIdx.ReusedFrom.BuildID]
+	if !ok {
+		return
+	}
+	reusedBy.Remove(segIdx.BuildID)
+	if reusedBy.Len() == 0 {
+		delete(m.reusedBuildIDs, segIdx.ReusedFrom.BuildID)
+	}
 }
 
 func (m *indexMeta) alterSegmentIndexes(segIdxes []*model.SegmentIndex) error {
@@ -223,7 +272,7 @@ func (m *indexMeta) CanCreateIndex(req *indexpb.CreateIndexRequest) (UniqueID, e
 		return 0, nil
 	}
 	for _, index := range indexes {
-		if index.IsDeleted {
+		if index.IsDeleted || index.ReplaceIndexID != 0 {
 			continue
 		}
 		if req.IndexName == index.IndexName {
@@ -252,7 +301,7 @@ func (m *indexMeta) HasSameReq(req *indexpb.CreateIndexRequest) (bool, UniqueID)
 	defer m.RUnlock()
 
 	for _, fieldIndex := range m.indexes[req.CollectionID] {
-		if fieldIndex.IsDeleted {
+		if fieldIndex.IsDeleted || fieldIndex.ReplaceIndexID != 0 {
 			continue
 		}
 		if fieldIndex.FieldID != req.FieldID || fieldIndex.IndexName != req.IndexName {
@@ -341,7 +390,7 @@ func (m *indexMeta) GetIndexIDByName(collID int64, indexName string) map[int64]u
 	}
 
 	for _, index := range fieldIndexes {
-		if !index.IsDeleted && (indexName == "" || index.IndexName == indexName) {
+		if !index.IsDeleted && index.ReplaceIndexID == 0 && (indexName == "" || index.IndexName == indexName) {
 			indexID2CreateTs[index.IndexID] = index.CreateTime
 		}
 	}
@@ -399,7 +448,7 @@ func (m *indexMeta) GetIndexedSegments(collectionID int64, fieldIDs []UniqueID)
 	checkSegmentState := func(indexes map[int64]*model.SegmentIndex) bool {
 		indexedFields := 0
 		for indexID, index := range fieldIndexes {
-			if !fieldIDSet.Contain(index.FieldID) || index.IsDeleted {
+			if !fieldIDSet.Contain(index.FieldID) || index.IsDeleted || index.ReplaceIndexID != 0 {
 				continue
 			}
 
@@ -428,7 +477,7 @@ func (m *indexMeta) GetIndexesForCollection(collID UniqueID, indexName string) [
 
 	indexInfos := make([]*model.Index, 0)
 	for _, index := range m.indexes[collID] {
-		if index.IsDeleted {
+		if index.IsDeleted || index.ReplaceIndexID != 0 {
 			continue
 		}
 		if indexName == "" || indexName == index.IndexName {
@@ -444,7 +493,7 @@ func (m *indexMeta) GetFieldIndexes(collID, fieldID UniqueID, indexName string)
 
 	indexInfos := make([]*model.Index, 0)
 	for _, index := range m.indexes[collID] {
-		if index.IsDeleted || index.FieldID != fieldID {
+		if index.IsDeleted || index.ReplaceIndexID != 0 || index.FieldID != fieldID {
 			continue
 		}
 		if indexName == "" || indexName == index.IndexName {
@@ -454,6 +503,64 @@ func (m *indexMeta) GetFieldIndexes(collID, fieldID UniqueID, indexName string)
 	return indexInfos
 }
 
+// GetReindexingIndexes gets the indexes being rebuilt to replace the serving ones,
+// which are invisible until replacing.
+func (m *indexMeta) GetReindexingIndexes() []*model.Index {
+	m.RLock()
+	defer m.RUnlock()
+
+	indexInfos := make([]*model.Index, 0)
+	for _, fieldIndexes := range m.indexes {
+		for _, index := range fieldIndexes {
+			if !index.IsDeleted && index.ReplaceIndexID != 0 {
+				indexInfos = append(indexInfos, model.CloneIndex(index))
+			}
+		}
+	}
+	return indexInfos
+}
+
+func (m *indexMeta) IsReindexingIndex(collID, indexID UniqueID) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	index, ok := m.indexes[collID][indexID]
+	return ok && !index.IsDeleted && index.ReplaceIndexID != 0
+}
+
+// ReplaceIndex marks the serving index replaced by the rebuilt one as deleted,
+// and makes the rebuilt one serving in a single meta update.
+func (m *indexMeta) ReplaceIndex(collID, indexID UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+
+	index, ok := m.indexes[collID][indexID]
+	if !ok || index.IsDeleted || index.ReplaceIndexID == 0 {
+		return merr.WrapErrIndexNotFound(fmt.Sprint(indexID))
+	}
+	indexes := make([]*model.Index, 0, 2)
+	if replaced, ok := m.indexes[collID][index.ReplaceIndexID]; ok && !replaced.IsDeleted {
+		clonedReplaced := model.CloneIndex(replaced)
+		clonedReplaced.IsDeleted = true
+		indexes = append(indexes, clonedReplaced)
+	}
+	clonedIndex := model.CloneIndex(index)
+	clonedIndex.ReplaceIndexID = 0
+	indexes = append(indexes, clonedIndex)
+
+	if err := m.catalog.AlterIndexes(m.ctx, indexes); err != nil {
+		log.Error("failed to replace index in meta store", zap.Int64("collectionID", collID),
+			zap.Int64("indexID", indexID), zap.Int64("replacedIndexID", index.ReplaceIndexID), zap.Error(err))
+		return err
+	}
+	for _, index := range indexes {
+		m.updateCollectionIndex(index)
+	}
+	log.Info("meta update: ReplaceIndex success", zap.Int64("collectionID", collID),
+		zap.Int64("indexID", indexID), zap.Int64("replacedIndexID", index.ReplaceIndexID))
+	return nil
+}
+
 // MarkIndexAsDeleted will mark the corresponding index as deleted, and recycleUnusedIndexFiles will recycle these tasks.
 func (m *indexMeta) MarkIndexAsDeleted(collID UniqueID, indexIDs []UniqueID) error {
 	log.Info("IndexCoord metaTable MarkIndexAsDeleted", zap.Int64("collectionID", collID),
@@ -685,6 +792,8 @@ func (m *indexMeta) FinishTask(taskInfo *indexpb.IndexTaskInfo) error {
 		segIdx.FailReason = taskInfo.GetFailReason()
 		segIdx.IndexSize = taskInfo.GetSerializedSize()
 		segIdx.CurrentIndexVersion = taskInfo.GetCurrentIndexVersion()
+		// the index files are built by the task itself
+		segIdx.ReusedFrom = nil
 		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
 	}
 
@@ -755,6 +864,69 @@ func (m *indexMeta) BuildIndex(buildID UniqueID) error {
 	return nil
 }
 
+// UpdatePartitions replaces the partitions of the index built across IndexNodes.
+func (m *indexMeta) UpdatePartitions(buildID UniqueID, partitions []*model.IndexPartition) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok {
+		return fmt.Errorf("there is no index with buildID: %d", buildID)
+	}
+
+	updateFunc := func(segIdx *model.SegmentIndex) error {
+		segIdx.Partitions = model.CloneIndexPartitions(partitions)
+		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
+	}
+	return m.updateSegIndexMeta(segIdx, updateFunc)
+}
+
+// ReuseIndexFiles finishes the index task with the index files of the cached build instead of building.
+func (m *indexMeta) ReuseIndexFiles(buildID UniqueID, entry *indexpb.IndexCacheEntry) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok {
+		return fmt.Errorf("there is no index with buildID: %d", buildID)
+	}
+
+	updateFunc := func(segIdx *model.SegmentIndex) error {
+		segIdx.IndexState = commonpb.IndexState_Finished
+		segIdx.FailReason = ""
+		segIdx.IndexFileKeys = common.CloneStringList(entry.GetIndexFileKeys())
+		segIdx.IndexSize = entry.GetSerializeSize()
+		segIdx.CurrentIndexVersion = entry.GetCurrentIndexVersion()
+		segIdx.ReusedFrom = model.UnmarshalIndexFilesLocation(entry.GetLocation())
+		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
+	}
+	if err := m.updateSegIndexMeta(segIdx, updateFunc); err != nil {
+		return err
+	}
+
+	log.Info("finish index task by reusing cached index files", zap.Int64("buildID", buildID),
+		zap.Int64("reusedBuildID", entry.GetLocation().GetBuildID()))
+	m.updateIndexTasksMetrics()
+	return nil
+}
+
+// UpdateCacheKey records the key of the index cache to put the index files once the build finished.
+func (m *indexMeta) UpdateCacheKey(buildID UniqueID, cacheKey string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.buildID2SegmentIndex[buildID]
+	if !ok {
+		return fmt.Errorf("there is no index with buildID: %d", buildID)
+	}
+
+	updateFunc := func(segIdx *model.SegmentIndex) error {
+		segIdx.CacheKey = cacheKey
+		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
+	}
+	return m.updateSegIndexMeta(segIdx, updateFunc)
+}
+
 func (m *indexMeta) GetAllSegIndexes() map[int64]*model.SegmentIndex {
 	m.RLock()
 	defer m.RUnlock()
@@ -783,6 +955,10 @@ func (m *indexMeta) RemoveSegmentIndex(collID, partID, segID, indexID, buildID U
 		delete(m.segmentIndexes, segID)
 	}
 
+	if segIdx, ok := m.buildID2SegmentIndex[buildID]; ok {
+		m.removePartitionBuildIDs(segIdx)
+		m.removeReusedBuildID(segIdx)
+	}
 	delete(m.buildID2SegmentIndex, buildID)
 	m.updateIndexTasksMetrics()
 	return nil
@@ -836,6 +1012,49 @@ func (m *indexMeta) CleanSegmentIndex(buildID UniqueID) (bool, *model.SegmentInd
 		}
 		return false, model.CloneSegmentIndex(segIndex)
 	}
+	// the files of the partition are recycled along with the segment index
+	if parentID, ok := m.partitionBuildIDs[buildID]; ok {
+		segIndex := m.buildID2SegmentIndex[parentID]
+		for _, partition := range segIndex.Partitions {
+			if partition.BuildID != buildID {
+				continue
+			}
+			partitionIndex := &model.SegmentIndex{
+				SegmentID:     segIndex.SegmentID,
+				CollectionID:  segIndex.CollectionID,
+				PartitionID:   segIndex.PartitionID,
+				NumRows:       partition.NumRows,
+				IndexID:       segIndex.IndexID,
+				BuildID:       partition.BuildID,
+				NodeID:        partition.NodeID,
+				IndexVersion:  partition.IndexVersion,
+				IndexState:    partition.IndexState,
+				FailReason:    partition.FailReason,
+				IndexFileKeys: common.CloneStringList(partition.IndexFileKeys),
+				IndexSize:     partition.IndexSize,
+			}
+			return segIndex.IndexState == commonpb.IndexState_Finished, partitionIndex
+		}
+	}
+	// the index files reused by other segment indexes are kept
+	if reusedBy, ok := m.reusedBuildIDs[buildID]; ok {
+		for _, reuserID := range reusedBy.Collect() {
+			segIndex := m.buildID2SegmentIndex[reuserID]
+			return true, &model.SegmentIndex{
+				SegmentID:           segIndex.ReusedFrom.SegmentID,
+				CollectionID:        segIndex.CollectionID,
+				PartitionID:         segIndex.ReusedFrom.PartitionID,
+				NumRows:             segIndex.NumRows,
+				IndexID:             segIndex.IndexID,
+				BuildID:             segIndex.ReusedFrom.BuildID,
+				IndexVersion:        segIndex.ReusedFrom.IndexVersion,
+				IndexState:          segIndex.IndexState,
+				IndexFileKeys:       common.CloneStringList(segIndex.IndexFileKeys),
+				IndexSize:           segIndex.IndexSize,
+				CurrentIndexVersion: segIndex.CurrentIndexVersion,
+			}
+		}
+	}
 	return true, nil
 }
 
@@ -0,0 +1,304 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// The in-memory vector index of a huge segment is built by partitions across IndexNodes,
+// each partition indexes the contiguous rows of several binlogs, so that no single IndexNode
+// needs the memory of the whole segment. The segment index is finished once all the partitions
+// are finished, QueryNodes load the partitions as a whole and merge the search results of them.
+
+// planIndexPartitions splits the binlogs of the field into contiguous partitions with at most
+// partitionRows rows each, a single binlog is never split. It returns nil if the segment doesn't
+// need partitions or the rows of the binlogs mismatch the segment.
+func planIndexPartitions(segment *SegmentInfo, fieldID int64, numRows int64, partitionRows int64) []*model.IndexPartition {
+	if partitionRows <= 0 {
+		return nil
+	}
+	partitions := make([]*model.IndexPartition, 0)
+	var total int64
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		if fieldBinlog.GetFieldID() != fieldID {
+			continue
+		}
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if len(partitions) == 0 || partitions[len(partitions)-1].NumRows+binlog.GetEntriesNum() > partitionRows {
+				partitions = append(partitions, &model.IndexPartition{
+					IndexState: commonpb.IndexState_Unissued,
+				})
+			}
+			partition := partitions[len(partitions)-1]
+			partition.DataIDs = append(partition.DataIDs, binlog.GetLogID())
+			partition.NumRows += binlog.GetEntriesNum()
+			total += binlog.GetEntriesNum()
+		}
+		break
+	}
+	if total != numRows || len(partitions) < 2 {
+		return nil
+	}
+	return partitions
+}
+
+// segmentIndexFilePaths returns the paths of the index files of the segment index,
+// including the files of all the partitions if it's built by partitions,
+// or the files of the cached build if they are reused.
+func segmentIndexFilePaths(rootPath string, segIdx *model.SegmentIndex) []string {
+	if location := segIdx.ReusedFrom; location != nil {
+		return metautil.BuildSegmentIndexFilePaths(rootPath, location.BuildID, location.IndexVersion,
+			location.PartitionID, location.SegmentID, segIdx.IndexFileKeys)
+	}
+	if len(segIdx.Partitions) == 0 {
+		return metautil.BuildSegmentIndexFilePaths(rootPath, segIdx.BuildID, segIdx.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys)
+	}
+	paths := make([]string, 0)
+	for _, partition := range segIdx.Partitions {
+		paths = append(paths, metautil.BuildSegmentIndexFilePaths(rootPath, partition.BuildID, partition.IndexVersion,
+			segIdx.PartitionID, segIdx.SegmentID, partition.IndexFileKeys)...)
+	}
+	return paths
+}
+
+// indexPartitionsParam formats the partitions as "buildID:indexVersion:numRows,...",
+// which tells the QueryNode how to load the index files of the partitions.
+func indexPartitionsParam(partitions []*model.IndexPartition) string {
+	items := make([]string, 0, len(partitions))
+	for _, partition := range partitions {
+		items = append(items, fmt.Sprintf("%d:%d:%d", partition.BuildID, partition.IndexVersion, partition.NumRows))
+	}
+	return strings.Join(items, ",")
+}
+
+// partitionOptionalFields returns the optional scalar fields of the partition, the binlogs of
+// all the fields are synced in the same batches, so they are sliced at the same positions.
+func partitionOptionalFields(optionalFields []*indexpb.OptionalFieldInfo, binlogIDs []int64, partition *model.IndexPartition) []*indexpb.OptionalFieldInfo {
+	start := -1
+	for i, binlogID := range binlogIDs {
+		if len(partition.DataIDs) > 0 && binlogID == partition.DataIDs[0] {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		return nil
+	}
+	end := start + len(partition.DataIDs)
+	ret := make([]*indexpb.OptionalFieldInfo, 0, len(optionalFields))
+	for _, field := range optionalFields {
+		if len(field.GetDataIds()) != len(binlogIDs) {
+			continue
+		}
+		ret = append(ret, &indexpb.OptionalFieldInfo{
+			FieldID:   field.GetFieldID(),
+			FieldName: field.GetFieldName(),
+			FieldType: field.GetFieldType(),
+			DataIds:   field.GetDataIds()[start:end],
+		})
+	}
+	return ret
+}
+
+// shouldBuildByPartitions returns whether the in-memory dense vector index of the segment is
+// huge enough to build by partitions.
+func (ib *indexBuilder) shouldBuildByPartitions(segIdx *model.SegmentIndex, indexType string) bool {
+	minRows := Params.DataCoordCfg.PartitionedBuildMinRows.GetAsInt64()
+	if minRows <= 0 || segIdx.NumRows < minRows || ib.allocator == nil ||
+		isDiskANNIndex(indexType) || Params.CommonCfg.EnableStorageV2.GetAsBool() {
+		return false
+	}
+	coll := ib.meta.GetCollection(segIdx.CollectionID)
+	if coll == nil {
+		return false
+	}
+	fieldID := ib.meta.indexMeta.GetFieldIDByIndexID(segIdx.CollectionID, segIdx.IndexID)
+	field := typeutil.GetField(coll.Schema, fieldID)
+	return field != nil && typeutil.IsVectorType(field.GetDataType()) && !typeutil.IsSparseVectorType(field.GetDataType())
+}
+
+// planPartitions splits the segment into partitions and allocates the buildIDs of them,
+// returns false if the segment should be built as a whole.
+func (ib *indexBuilder) planPartitions(segIdx *model.SegmentIndex, segment *SegmentInfo) (bool, error) {
+	fieldID := ib.meta.indexMeta.GetFieldIDByIndexID(segIdx.CollectionID, segIdx.IndexID)
+	partitions := planIndexPartitions(segment, fieldID, segIdx.NumRows, Params.DataCoordCfg.PartitionedBuildRows.GetAsInt64())
+	if len(partitions) == 0 {
+		return false, nil
+	}
+	start, _, err := ib.allocator.allocN(int64(len(partitions)))
+	if err != nil {
+		return false, err
+	}
+	for i, partition := range partitions {
+		partition.BuildID = start + int64(i)
+	}
+	if err := ib.meta.indexMeta.UpdatePartitions(segIdx.BuildID, partitions); err != nil {
+		return false, err
+	}
+	log.Ctx(ib.ctx).Info("index task is built by partitions", zap.Int64("buildID", segIdx.BuildID),
+		zap.Int64("segmentID", segIdx.SegmentID), zap.Int64("numRows", segIdx.NumRows),
+		zap.Int("partitionNum", len(partitions)))
+	return true, nil
+}
+
+// processPartitions assigns the partitions not issued yet to the IndexNodes and checks the ones in
+// progress. The segment index is finished once all the partitions are finished, or failed if any of
+// them fails. It returns false if there is no idle IndexNode.
+func (ib *indexBuilder) processPartitions(segIdx *model.SegmentIndex, segment *SegmentInfo) (indexTaskState, bool) {
+	log := log.Ctx(ib.ctx).With(zap.Int64("buildID", segIdx.BuildID), zap.Int64("segmentID", segIdx.SegmentID))
+	partitions := segIdx.Partitions
+	changed := false
+	persist := func() bool {
+		if !changed {
+			return true
+		}
+		if err := ib.meta.indexMeta.UpdatePartitions(segIdx.BuildID, partitions); err != nil {
+			log.Warn("index builder update index partitions failed", zap.Error(err))
+			return false
+		}
+		changed = false
+		return true
+	}
+
+	var (
+		req      *indexpb.CreateJobRequest
+		finished int
+		size     uint64
+	)
+	for _, partition := range partitions {
+		switch partition.IndexState {
+		case commonpb.IndexState_Finished:
+			finished++
+			size += partition.IndexSize
+		case commonpb.IndexState_Failed:
+			if !persist() {
+				return indexTaskInProgress, true
+			}
+			if err := ib.meta.indexMeta.FinishTask(&indexpb.IndexTaskInfo{
+				BuildID:    segIdx.BuildID,
+				State:      commonpb.IndexState_Failed,
+				FailReason: fmt.Sprintf("index partition %d failed: %s", partition.BuildID, partition.FailReason),
+			}); err != nil {
+				log.Warn("IndexCoord update index state fail", zap.Error(err))
+				return indexTaskInProgress, true
+			}
+			return indexTaskDone, true
+		case commonpb.IndexState_InProgress:
+			info, state := ib.queryTaskInfo(partition.BuildID, partition.NodeID)
+			switch state {
+			case indexTaskDone:
+				partition.IndexState = info.GetState()
+				partition.FailReason = info.GetFailReason()
+				partition.IndexFileKeys = info.GetIndexFileKeys()
+				partition.IndexSize = info.GetSerializedSize()
+				changed = true
+				if partition.IndexState == commonpb.IndexState_Finished {
+					ib.tracker.finish(partition.BuildID, partition.NodeID, partition.NumRows)
+					finished++
+					size += partition.IndexSize
+				}
+			case indexTaskRetry:
+				if ib.dropIndexTask(partition.BuildID, partition.NodeID) {
+					partition.IndexState = commonpb.IndexState_Unissued
+					changed = true
+				}
+			}
+		case commonpb.IndexState_Unissued:
+			nodeID, client := ib.nodeManager.PeekClient(segIdx)
+			if client == nil {
+				log.WithRateGroup("dc.indexBuilder", 1, 60).RatedInfo(5, "index builder peek client error, there is no available")
+				persist()
+				return indexTaskInProgress, false
+			}
+			if req == nil {
+				var ok bool
+				if req, ok = ib.createJobRequest(segIdx, segment); !ok {
+					persist()
+					return indexTaskInProgress, false
+				}
+			}
+			// the version is persisted before assigning, the files of the previous attempts are recycled by GC
+			partition.NodeID = nodeID
+			partition.IndexVersion++
+			changed = true
+			if !persist() {
+				return indexTaskInProgress, false
+			}
+			partitionReq := proto.Clone(req).(*indexpb.CreateJobRequest)
+			partitionReq.BuildID = partition.BuildID
+			partitionReq.IndexVersion = partition.IndexVersion
+			partitionReq.NumRows = partition.NumRows
+			partitionReq.DataIds = partition.DataIDs
+			partitionReq.OptionalScalarFields = partitionOptionalFields(req.GetOptionalScalarFields(), req.GetDataIds(), partition)
+			if err := ib.assignTask(client, partitionReq); err != nil {
+				log.Warn("index builder assign index partition to IndexNode failed", zap.Int64("partitionBuildID", partition.BuildID),
+					zap.Int64("nodeID", nodeID), zap.Error(err))
+				return indexTaskInProgress, false
+			}
+			log.Info("index partition assigned successfully", zap.Int64("partitionBuildID", partition.BuildID),
+				zap.Int64("numRows", partition.NumRows), zap.Int64("nodeID", nodeID))
+			partition.IndexState = commonpb.IndexState_InProgress
+			changed = true
+			ib.tracker.start(partition.BuildID)
+		}
+	}
+
+	if !persist() || finished < len(partitions) {
+		return indexTaskInProgress, true
+	}
+	// merge the partitions, the segment index refers to the files of them
+	if err := ib.meta.indexMeta.FinishTask(&indexpb.IndexTaskInfo{
+		BuildID:             segIdx.BuildID,
+		State:               commonpb.IndexState_Finished,
+		SerializedSize:      size,
+		CurrentIndexVersion: ib.indexEngineVersionManager.GetCurrentIndexEngineVersion(),
+	}); err != nil {
+		log.Warn("IndexCoord update index state fail", zap.Error(err))
+		return indexTaskInProgress, true
+	}
+	log.Info("all the index partitions are finished", zap.Int("partitionNum", len(partitions)), zap.Uint64("size", size))
+	return indexTaskDone, true
+}
+
+// dropPartitionTasks drops the jobs of the partitions from the IndexNodes.
+func (ib *indexBuilder) dropPartitionTasks(segIdx *model.SegmentIndex) bool {
+	for _, partition := range segIdx.Partitions {
+		if partition.NodeID == 0 {
+			continue
+		}
+		if !ib.dropIndexTask(partition.BuildID, partition.NodeID) {
+			return false
+		}
+	}
+	for _, partition := range segIdx.Partitions {
+		ib.tracker.forget(partition.BuildID)
+	}
+	return true
+}
@@ -0,0 +1,347 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	catalogmocks "github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func newPartitionedSegment(entries ...int64) *SegmentInfo {
+	binlogs := make([]*datapb.Binlog, 0, len(entries))
+	var numRows int64
+	for i, n := range entries {
+		binlogs = append(binlogs, &datapb.Binlog{LogID: int64(i + 1), EntriesNum: n})
+		numRows += n
+	}
+	return &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:           segID,
+			CollectionID: collID,
+			PartitionID:  partID,
+			NumOfRows:    numRows,
+			State:        commonpb.SegmentState_Flushed,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: fieldID + 1, Binlogs: []*datapb.Binlog{{LogID: 100, EntriesNum: numRows}}},
+				{FieldID: fieldID, Binlogs: binlogs},
+			},
+		},
+	}
+}
+
+func TestPlanIndexPartitions(t *testing.T) {
+	segment := newPartitionedSegment(1000, 1000, 1000, 500)
+
+	partitions := planIndexPartitions(segment, fieldID, 3500, 2000)
+	assert.Len(t, partitions, 2)
+	assert.Equal(t, []int64{1, 2}, partitions[0].DataIDs)
+	assert.EqualValues(t, 2000, partitions[0].NumRows)
+	assert.Equal(t, []int64{3, 4}, partitions[1].DataIDs)
+	assert.EqualValues(t, 1500, partitions[1].NumRows)
+	assert.Equal(t, commonpb.IndexState_Unissued, partitions[1].IndexState)
+
+	// a binlog larger than the partition rows is not split
+	partitions = planIndexPartitions(segment, fieldID, 3500, 800)
+	assert.Len(t, partitions, 4)
+
+	// small enough to build as a whole
+	assert.Nil(t, planIndexPartitions(segment, fieldID, 3500, 5000))
+	// rows mismatch
+	assert.Nil(t, planIndexPartitions(segment, fieldID, 4000, 2000))
+	assert.Nil(t, planIndexPartitions(segment, fieldID, 3500, 0))
+}
+
+func TestIndexPartitionHelpers(t *testing.T) {
+	segIdx := &model.SegmentIndex{
+		SegmentID:     segID,
+		PartitionID:   partID,
+		BuildID:       buildID,
+		IndexVersion:  1,
+		IndexFileKeys: []string{"file"},
+	}
+	assert.Equal(t, []string{"root/index_files/600/1/200/500/file"}, segmentIndexFilePaths("root", segIdx))
+
+	segIdx.Partitions = []*model.IndexPartition{
+		{BuildID: 1000, IndexVersion: 1, NumRows: 2000, DataIDs: []int64{1, 2}, IndexFileKeys: []string{"a", "b"}},
+		{BuildID: 1001, IndexVersion: 2, NumRows: 1500, DataIDs: []int64{3, 4}, IndexFileKeys: []string{"c"}},
+	}
+	assert.Equal(t, []string{
+		"root/index_files/1000/1/200/500/a",
+		"root/index_files/1000/1/200/500/b",
+		"root/index_files/1001/2/200/500/c",
+	}, segmentIndexFilePaths("root", segIdx))
+	assert.Equal(t, "1000:1:2000,1001:2:1500", indexPartitionsParam(segIdx.Partitions))
+
+	optionalFields := []*indexpb.OptionalFieldInfo{
+		{FieldID: 1, DataIds: []int64{11, 12, 13, 14}},
+		{FieldID: 2, DataIds: []int64{21}},
+	}
+	fields := partitionOptionalFields(optionalFields, []int64{1, 2, 3, 4}, segIdx.Partitions[1])
+	assert.Len(t, fields, 1)
+	assert.Equal(t, []int64{13, 14}, fields[0].GetDataIds())
+	assert.Nil(t, partitionOptionalFields(optionalFields, []int64{5, 6}, segIdx.Partitions[1]))
+}
+
+func TestIndexBuilder_Partitions(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.PartitionedBuildMinRows.Key, "2000")
+	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.PartitionedBuildMinRows.Key)
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.PartitionedBuildRows.Key, "2000")
+	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.PartitionedBuildRows.Key)
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.On("AlterSegmentIndexes", mock.Anything, mock.Anything).Return(nil)
+	catalog.On("DropSegmentIndex", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	var (
+		mu     sync.Mutex
+		jobs   = make(map[UniqueID]*indexpb.CreateJobRequest)
+		states = make(map[UniqueID]commonpb.IndexState)
+	)
+	ic := mocks.NewMockIndexNodeClient(t)
+	ic.EXPECT().GetJobStats(mock.Anything, mock.Anything, mock.Anything).Return(&indexpb.GetJobStatsResponse{
+		Status:    merr.Success(),
+		TaskSlots: 1,
+	}, nil)
+	ic.EXPECT().CreateJob(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *indexpb.CreateJobRequest, option ...grpc.CallOption) (*commonpb.Status, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			jobs[req.GetBuildID()] = req
+			states[req.GetBuildID()] = commonpb.IndexState_InProgress
+			return merr.Success(), nil
+		})
+	ic.EXPECT().QueryJobs(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, req *indexpb.QueryJobsRequest, option ...grpc.CallOption) (*indexpb.QueryJobsResponse, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			infos := make([]*indexpb.IndexTaskInfo, 0)
+			for _, buildID := range req.GetBuildIDs() {
+				infos = append(infos, &indexpb.IndexTaskInfo{
+					BuildID:        buildID,
+					State:          states[buildID],
+					IndexFileKeys:  []string{"file"},
+					SerializedSize: 100,
+				})
+			}
+			return &indexpb.QueryJobsResponse{Status: merr.Success(), IndexInfos: infos}, nil
+		})
+	ic.EXPECT().DropJobs(mock.Anything, mock.Anything, mock.Anything).Return(merr.Success(), nil)
+
+	alloc := NewNMockAllocator(t)
+	alloc.EXPECT().allocN(int64(2)).Return(1000, 1002, nil)
+
+	segIdx := &model.SegmentIndex{
+		SegmentID:    segID,
+		CollectionID: collID,
+		PartitionID:  partID,
+		NumRows:      3500,
+		IndexID:      indexID,
+		BuildID:      buildID,
+		IndexState:   commonpb.IndexState_Unissued,
+	}
+	mt := &meta{
+		catalog: catalog,
+		collections: map[UniqueID]*collectionInfo{
+			collID: {
+				ID: collID,
+				Schema: &schemapb.CollectionSchema{
+					Fields: []*schemapb.FieldSchema{
+						{FieldID: fieldID, Name: "vec", DataType: schemapb.DataType_FloatVector},
+					},
+				},
+			},
+		},
+		indexMeta: &indexMeta{
+			catalog: catalog,
+			indexes: map[UniqueID]map[UniqueID]*model.Index{
+				collID: {
+					indexID: {
+						CollectionID: collID,
+						FieldID:      fieldID,
+						IndexID:      indexID,
+						IndexName:    indexName,
+						TypeParams:   []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "128"}},
+						IndexParams: []*commonpb.KeyValuePair{
+							{Key: common.MetricTypeKey, Value: "L2"},
+							{Key: common.IndexTypeKey, Value: indexparamcheck.IndexHNSW},
+						},
+					},
+				},
+			},
+			segmentIndexes:       map[UniqueID]map[UniqueID]*model.SegmentIndex{segID: {indexID: segIdx}},
+			buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{buildID: segIdx},
+		},
+		segments: &SegmentsInfo{
+			segments: map[UniqueID]*SegmentInfo{segID: newPartitionedSegment(1000, 1000, 1000, 500)},
+		},
+	}
+	cm := mocks.NewChunkManager(t)
+	cm.EXPECT().RootPath().Return("root")
+
+	ib := &indexBuilder{
+		ctx:   context.Background(),
+		tasks: map[int64]indexTaskState{buildID: indexTaskInit},
+		meta:  mt,
+		nodeManager: &IndexNodeManager{
+			ctx:         context.Background(),
+			nodeClients: map[UniqueID]types.IndexNodeClient{nodeID: ic},
+		},
+		chunkManager:              cm,
+		indexEngineVersionManager: newIndexEngineVersionManager(),
+		allocator:                 alloc,
+	}
+
+	getJob := func() *model.SegmentIndex {
+		job, ok := mt.indexMeta.GetIndexJob(buildID)
+		assert.True(t, ok)
+		return job
+	}
+
+	// plan the partitions
+	assert.True(t, ib.process(buildID))
+	assert.Equal(t, indexTaskInProgress, ib.tasks[buildID])
+	job := getJob()
+	assert.Equal(t, commonpb.IndexState_InProgress, job.IndexState)
+	assert.Len(t, job.Partitions, 2)
+	assert.EqualValues(t, 1000, job.Partitions[0].BuildID)
+	assert.EqualValues(t, 1001, job.Partitions[1].BuildID)
+
+	// assign the partitions
+	assert.True(t, ib.process(buildID))
+	assert.Len(t, jobs, 2)
+	assert.Equal(t, []int64{1, 2}, jobs[1000].GetDataIds())
+	assert.EqualValues(t, 2000, jobs[1000].GetNumRows())
+	assert.Equal(t, []int64{3, 4}, jobs[1001].GetDataIds())
+	assert.EqualValues(t, 1500, jobs[1001].GetNumRows())
+	assert.EqualValues(t, 1, jobs[1001].GetIndexVersion())
+	job = getJob()
+	assert.Equal(t, commonpb.IndexState_InProgress, job.Partitions[0].IndexState)
+	assert.EqualValues(t, nodeID, job.Partitions[0].NodeID)
+
+	// the partition to retry is assigned again with a new version
+	states[1000] = commonpb.IndexState_Finished
+	states[1001] = commonpb.IndexState_Retry
+	assert.True(t, ib.process(buildID))
+	job = getJob()
+	assert.Equal(t, commonpb.IndexState_Finished, job.Partitions[0].IndexState)
+	assert.Equal(t, []string{"file"}, job.Partitions[0].IndexFileKeys)
+	assert.Equal(t, commonpb.IndexState_Unissued, job.Partitions[1].IndexState)
+	assert.Equal(t, indexTaskInProgress, ib.tasks[buildID])
+
+	// the files of the partition are kept until the segment index is finished
+	canRecycle, partitionIdx := mt.indexMeta.CleanSegmentIndex(1000)
+	assert.False(t, canRecycle)
+	assert.EqualValues(t, 1000, partitionIdx.BuildID)
+
+	assert.True(t, ib.process(buildID))
+	assert.EqualValues(t, 2, jobs[1001].GetIndexVersion())
+
+	// merge the partitions once all finished
+	states[1001] = commonpb.IndexState_Finished
+	assert.True(t, ib.process(buildID))
+	assert.Equal(t, indexTaskDone, ib.tasks[buildID])
+	job = getJob()
+	assert.Equal(t, commonpb.IndexState_Finished, job.IndexState)
+	assert.EqualValues(t, 200, job.IndexSize)
+	assert.Equal(t, []string{
+		"root/index_files/1000/1/200/500/file",
+		"root/index_files/1001/2/200/500/file",
+	}, segmentIndexFilePaths("root", job))
+
+	canRecycle, partitionIdx = mt.indexMeta.CleanSegmentIndex(1001)
+	assert.True(t, canRecycle)
+	assert.EqualValues(t, 2, partitionIdx.IndexVersion)
+	assert.Equal(t, []string{"file"}, partitionIdx.IndexFileKeys)
+
+	// drop the jobs of the partitions
+	assert.True(t, ib.process(buildID))
+	_, ok := ib.tasks[buildID]
+	assert.False(t, ok)
+
+	// the files of the partitions are recycled along with the segment index
+	assert.NoError(t, mt.indexMeta.RemoveSegmentIndex(collID, partID, segID, indexID, buildID))
+	canRecycle, partitionIdx = mt.indexMeta.CleanSegmentIndex(1001)
+	assert.True(t, canRecycle)
+	assert.Nil(t, partitionIdx)
+}
+
+func TestIndexBuilder_PartitionFailed(t *testing.T) {
+	paramtable.Init()
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.On("AlterSegmentIndexes", mock.Anything, mock.Anything).Return(nil)
+
+	segIdx := &model.SegmentIndex{
+		SegmentID:    segID,
+		CollectionID: collID,
+		PartitionID:  partID,
+		NumRows:      3500,
+		IndexID:      indexID,
+		BuildID:      buildID,
+		IndexState:   commonpb.IndexState_InProgress,
+		Partitions: []*model.IndexPartition{
+			{BuildID: 1000, NumRows: 2000, IndexState: commonpb.IndexState_Finished},
+			{BuildID: 1001, NumRows: 1500, IndexState: commonpb.IndexState_Failed, FailReason: "out of memory"},
+		},
+	}
+	mt := &meta{
+		catalog: catalog,
+		indexMeta: &indexMeta{
+			catalog:              catalog,
+			segmentIndexes:       map[UniqueID]map[UniqueID]*model.SegmentIndex{segID: {indexID: segIdx}},
+			buildID2SegmentIndex: map[UniqueID]*model.SegmentIndex{buildID: segIdx},
+		},
+		segments: &SegmentsInfo{
+			segments: map[UniqueID]*SegmentInfo{segID: newPartitionedSegment(2000, 1500)},
+		},
+	}
+	ib := &indexBuilder{
+		ctx:   context.Background(),
+		tasks: map[int64]indexTaskState{buildID: indexTaskInProgress},
+		meta:  mt,
+		nodeManager: &IndexNodeManager{
+			ctx:         context.Background(),
+			nodeClients: map[UniqueID]types.IndexNodeClient{},
+		},
+	}
+
+	assert.True(t, ib.process(buildID))
+	assert.Equal(t, indexTaskDone, ib.tasks[buildID])
+	job, ok := mt.indexMeta.GetIndexJob(buildID)
+	assert.True(t, ok)
+	assert.Equal(t, commonpb.IndexState_Failed, job.IndexState)
+	assert.Equal(t, "index partition 1001 failed: out of memory", job.FailReason)
+}
@@ -19,6 +19,7 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -27,13 +28,14 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/merr"
-	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -50,8 +52,9 @@ func (s *Server) serverID() int64 {
 func (s *Server) startIndexService(ctx context.Context) {
 	s.indexBuilder.Start()
 
-	s.serverLoopWg.Add(1)
+	s.serverLoopWg.Add(2)
 	go s.createIndexForSegmentLoop(ctx)
+	go s.indexAdvisorLoop(ctx)
 }
 
 func (s *Server) createIndexForSegment(segment *SegmentInfo, indexID UniqueID) error {
@@ -79,6 +82,11 @@ func (s *Server) createIndexForSegment(segment *SegmentInfo, indexID UniqueID) e
 
 func (s *Server) createIndexesForSegment(segment *SegmentInfo) error {
 	indexes := s.meta.indexMeta.GetIndexesForCollection(segment.CollectionID, "")
+	for _, index := range s.meta.indexMeta.GetReindexingIndexes() {
+		if index.CollectionID == segment.CollectionID {
+			indexes = append(indexes, index)
+		}
+	}
 	indexIDToSegIndexes := s.meta.indexMeta.GetSegmentIndexes(segment.CollectionID, segment.ID)
 	for _, index := range indexes {
 		if _, ok := indexIDToSegIndexes[index.IndexID]; !ok {
@@ -118,6 +126,7 @@ func (s *Server) createIndexForSegmentLoop(ctx context.Context) {
 			log.Warn("DataCoord context done, exit...")
 			return
 		case <-ticker.C:
+			s.replaceReindexedIndexes()
 			segments := s.getUnIndexTaskSegments()
 			for _, segment := range segments {
 				if err := s.createIndexesForSegment(segment); err != nil {
@@ -327,6 +336,18 @@ func (s *Server) AlterIndex(ctx context.Context, req *indexpb.AlterIndexRequest)
 		return merr.Status(err), nil
 	}
 
+	// altering the index type rebuilds the index instead of updating the params
+	if _, ok := funcutil.KeyValuePair2Map(req.GetParams())[common.IndexTypeKey]; ok {
+		if len(indexes) > 1 {
+			return merr.Status(merr.WrapErrParameterInvalidMsg("index name %s is ambiguous", req.GetIndexName())), nil
+		}
+		if err := s.reindex(ctx, indexes[0], req.GetParams()); err != nil {
+			log.Warn("failed to rebuild index", zap.Error(err))
+			return merr.Status(err), nil
+		}
+		return merr.Success(), nil
+	}
+
 	for _, index := range indexes {
 		// update user index params
 		newUserIndexParams, err := UpdateParams(index, index.UserIndexParams, req.GetParams())
@@ -360,6 +381,129 @@ func (s *Server) AlterIndex(ctx context.Context, req *indexpb.AlterIndexRequest)
 	return merr.Success(), nil
 }
 
+// reindex builds a new index with the altered index type for all segments in background,
+// the serving index keeps serving until it's replaced by the rebuilt one in replaceReindexedIndexes.
+func (s *Server) reindex(ctx context.Context, index *model.Index, params []*commonpb.KeyValuePair) error {
+	for _, reindexing := range s.meta.indexMeta.GetReindexingIndexes() {
+		if reindexing.ReplaceIndexID == index.IndexID {
+			return merr.WrapErrParameterInvalidMsg("index %s is being rebuilt", index.IndexName)
+		}
+	}
+
+	resp, err := s.broker.DescribeCollectionInternal(ctx, index.CollectionID)
+	if err != nil {
+		return err
+	}
+	field := typeutil.GetField(resp.GetSchema(), index.FieldID)
+	if field == nil {
+		return merr.WrapErrFieldNotFound(index.FieldID)
+	}
+	// only the vector index is able to be replaced in query nodes without releasing the segment
+	if !typeutil.IsVectorType(field.GetDataType()) {
+		return merr.WrapErrParameterInvalidMsg("index type of scalar field %s could not be altered", field.GetName())
+	}
+
+	indexParams := funcutil.KeyValuePair2Map(params)
+	if _, ok := indexParams[common.MetricTypeKey]; !ok {
+		if metricType, ok := funcutil.KeyValuePair2Map(index.IndexParams)[common.MetricTypeKey]; ok {
+			indexParams[common.MetricTypeKey] = metricType
+		}
+	}
+	indexType := indexParams[common.IndexTypeKey]
+	checker, err := indexparamcheck.GetIndexCheckerMgrInstance().GetChecker(indexType)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid index type %s", indexType)
+	}
+	if err := checker.CheckValidDataType(field.GetDataType()); err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid params for index type %s: %s", indexType, err.Error())
+	}
+	trainParams := funcutil.KeyValuePair2Map(index.TypeParams)
+	for k, v := range indexParams {
+		trainParams[k] = v
+	}
+	if err := checker.CheckTrain(trainParams); err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid params for index type %s: %s", indexType, err.Error())
+	}
+	if indexType == indexparamcheck.IndexDISKANN && !s.indexNodeManager.ClientSupportDisk() {
+		return merr.WrapErrIndexNotSupported(indexparamcheck.IndexDISKANN)
+	}
+
+	newParams := lo.MapToSlice(indexParams, func(k string, v string) *commonpb.KeyValuePair {
+		return &commonpb.KeyValuePair{Key: k, Value: v}
+	})
+	newIndex := &model.Index{
+		CollectionID:    index.CollectionID,
+		FieldID:         index.FieldID,
+		IndexName:       index.IndexName,
+		TypeParams:      index.TypeParams,
+		IndexParams:     newParams,
+		CreateTime:      index.CreateTime,
+		UserIndexParams: newParams,
+		ReplaceIndexID:  index.IndexID,
+	}
+	for _, param := range newParams {
+		if err := ValidateIndexParams(newIndex, param.GetKey(), param.GetValue()); err != nil {
+			return err
+		}
+	}
+
+	newIndex.IndexID, err = s.allocator.allocID(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.meta.indexMeta.CreateIndex(newIndex); err != nil {
+		return err
+	}
+
+	select {
+	case s.notifyIndexChan <- index.CollectionID:
+	default:
+	}
+
+	log.Ctx(ctx).Info("start rebuilding index", zap.Int64("collectionID", index.CollectionID),
+		zap.Int64("indexID", newIndex.IndexID), zap.Int64("replaceIndexID", index.IndexID),
+		zap.String("indexType", indexType))
+	return nil
+}
+
+// replaceReindexedIndexes replaces the serving indexes with the rebuilt ones once they're
+// finished on all flushed segments indexed by the serving ones. The query nodes swap the
+// index of segments when they find the index of the segment changed.
+func (s *Server) replaceReindexedIndexes() {
+	for _, index := range s.meta.indexMeta.GetReindexingIndexes() {
+		log := log.With(zap.Int64("collectionID", index.CollectionID),
+			zap.Int64("indexID", index.IndexID), zap.Int64("replaceIndexID", index.ReplaceIndexID))
+
+		// the serving index has been dropped during rebuilding
+		if !s.meta.indexMeta.IsIndexExist(index.CollectionID, index.ReplaceIndexID) {
+			if err := s.meta.indexMeta.MarkIndexAsDeleted(index.CollectionID, []UniqueID{index.IndexID}); err != nil {
+				log.Warn("failed to drop the rebuilt index", zap.Error(err))
+			}
+			continue
+		}
+
+		segments := s.meta.SelectSegments(func(info *SegmentInfo) bool {
+			return isFlush(info) && index.CollectionID == info.CollectionID
+		})
+		finished := lo.EveryBy(segments, func(segment *SegmentInfo) bool {
+			segIdxes := s.meta.indexMeta.GetSegmentIndexes(segment.CollectionID, segment.ID)
+			if replaced, ok := segIdxes[index.ReplaceIndexID]; !ok || replaced.IndexState != commonpb.IndexState_Finished {
+				return true
+			}
+			rebuilt, ok := segIdxes[index.IndexID]
+			return ok && rebuilt.IndexState == commonpb.IndexState_Finished
+		})
+		if !finished {
+			continue
+		}
+		if err := s.meta.indexMeta.ReplaceIndex(index.CollectionID, index.IndexID); err != nil {
+			log.Warn("failed to replace the serving index", zap.Error(err))
+			continue
+		}
+		log.Info("serving index replaced by the rebuilt one")
+	}
+}
+
 // GetIndexState gets the index state of the index name in the request from Proxy.
 // Deprecated
 func (s *Server) GetIndexState(ctx context.Context, req *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
@@ -654,14 +798,58 @@ func (s *Server) GetIndexBuildProgress(ctx context.Context, req *indexpb.GetInde
 	})
 
 	s.completeIndexInfo(indexInfo, indexes[0], segments, false, indexes[0].CreateTime)
-	log.Info("GetIndexBuildProgress success", zap.Int64("collectionID", req.GetCollectionID()),
-		zap.String("indexName", req.GetIndexName()))
-	return &indexpb.GetIndexBuildProgressResponse{
+	resp := &indexpb.GetIndexBuildProgressResponse{
 		Status:           merr.Success(),
 		IndexedRows:      indexInfo.IndexedRows,
 		TotalRows:        indexInfo.TotalRows,
 		PendingIndexRows: indexInfo.PendingIndexRows,
-	}, nil
+	}
+	if req.GetWithSegmentProgress() {
+		tracker := s.indexTaskTracker()
+		resp.SegmentProgresses = s.getSegmentIndexProgresses(tracker, req.GetCollectionID(), indexes[0].IndexID)
+		resp.EstimatedRemainingSeconds = tracker.estimateRemainingSeconds(resp.SegmentProgresses)
+	}
+	log.Info("GetIndexBuildProgress success", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("indexName", req.GetIndexName()))
+	return resp, nil
+}
+
+// indexTaskTracker returns the tracker of the index tasks, a fresh one if the index builder is not started.
+func (s *Server) indexTaskTracker() *indexTaskTracker {
+	if s.indexBuilder == nil {
+		return &indexTaskTracker{}
+	}
+	return &s.indexBuilder.tracker
+}
+
+// getSegmentIndexProgresses returns the build progress of the index on each flushed segment of the collection,
+// the segments without the segment index are reported as unissued.
+func (s *Server) getSegmentIndexProgresses(tracker *indexTaskTracker, collectionID, indexID UniqueID) []*indexpb.SegmentIndexProgress {
+	segments := s.meta.SelectSegments(func(info *SegmentInfo) bool {
+		return info.GetCollectionID() == collectionID && isFlush(info)
+	})
+	indexName := s.meta.indexMeta.GetIndexNameByID(collectionID, indexID)
+	progresses := make([]*indexpb.SegmentIndexProgress, 0, len(segments))
+	for _, segment := range segments {
+		segIdx, ok := s.meta.indexMeta.GetSegmentIndexes(collectionID, segment.GetID())[indexID]
+		if !ok {
+			segIdx = &model.SegmentIndex{
+				SegmentID:    segment.GetID(),
+				CollectionID: collectionID,
+				PartitionID:  segment.GetPartitionID(),
+				NumRows:      segment.GetNumOfRows(),
+				IndexID:      indexID,
+				IndexState:   commonpb.IndexState_Unissued,
+			}
+		}
+		progress := tracker.progress(segIdx)
+		progress.IndexName = indexName
+		progresses = append(progresses, progress)
+	}
+	sort.Slice(progresses, func(i, j int) bool {
+		return progresses[i].GetSegmentID() < progresses[j].GetSegmentID()
+	})
+	return progresses
 }
 
 // indexStats just for indexing statistics.
@@ -727,6 +915,11 @@ func (s *Server) DescribeIndex(ctx context.Context, req *indexpb.DescribeIndexRe
 			createTs = req.GetTimestamp()
 		}
 		s.completeIndexInfo(indexInfo, index, segments, false, createTs)
+		if req.GetWithSegmentProgress() {
+			tracker := s.indexTaskTracker()
+			indexInfo.SegmentProgresses = s.getSegmentIndexProgresses(tracker, index.CollectionID, index.IndexID)
+			indexInfo.EstimatedRemainingSeconds = tracker.estimateRemainingSeconds(indexInfo.SegmentProgresses)
+		}
 		indexInfos = append(indexInfos, indexInfo)
 	}
 	log.Info("DescribeIndex success")
@@ -867,11 +1060,20 @@ func (s *Server) GetIndexInfos(ctx context.Context, req *indexpb.GetIndexInfoReq
 		if len(segIdxes) != 0 {
 			ret.SegmentInfo[segID].EnableIndex = true
 			for _, segIdx := range segIdxes {
+				// the index being rebuilt is invisible until it replaces the serving one
+				if s.meta.indexMeta.IsReindexingIndex(segIdx.CollectionID, segIdx.IndexID) {
+					continue
+				}
 				if segIdx.IndexState == commonpb.IndexState_Finished {
-					indexFilePaths := metautil.BuildSegmentIndexFilePaths(s.meta.chunkManager.RootPath(), segIdx.BuildID, segIdx.IndexVersion,
-						segIdx.PartitionID, segIdx.SegmentID, segIdx.IndexFileKeys)
+					indexFilePaths := segmentIndexFilePaths(s.meta.chunkManager.RootPath(), segIdx)
 					indexParams := s.meta.indexMeta.GetIndexParams(segIdx.CollectionID, segIdx.IndexID)
 					indexParams = append(indexParams, s.meta.indexMeta.GetTypeParams(segIdx.CollectionID, segIdx.IndexID)...)
+					if len(segIdx.Partitions) > 0 {
+						indexParams = append(indexParams, &commonpb.KeyValuePair{
+							Key:   common.PartitionedIndexKey,
+							Value: indexPartitionsParam(segIdx.Partitions),
+						})
+					}
 					ret.SegmentInfo[segID].IndexInfos = append(ret.SegmentInfo[segID].IndexInfos,
 						&indexpb.IndexFilePathInfo{
 							SegmentID:           segID,
@@ -929,3 +1131,122 @@ func (s *Server) ListIndexes(ctx context.Context, req *indexpb.ListIndexesReques
 		IndexInfos: indexInfos,
 	}, nil
 }
+
+// ListIndexTasks lists the unfinished index tasks, of the collection and the index if specified.
+func (s *Server) ListIndexTasks(ctx context.Context, req *datapb.ListIndexTasksRequest) (*datapb.ListIndexTasksResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("indexName", req.GetIndexName()),
+	)
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
+		return &datapb.ListIndexTasksResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	tracker := s.indexTaskTracker()
+	tasks := lo.Map(s.selectIndexTasks(req.GetCollectionID(), req.GetIndexName()), func(segIdx *model.SegmentIndex, _ int) *indexpb.SegmentIndexProgress {
+		progress := tracker.progress(segIdx)
+		progress.IndexName = s.meta.indexMeta.GetIndexNameByID(segIdx.CollectionID, segIdx.IndexID)
+		return progress
+	})
+	log.Info("ListIndexTasks success", zap.Int("numTasks", len(tasks)))
+	return &datapb.ListIndexTasksResponse{
+		Status:                    merr.Success(),
+		Tasks:                     tasks,
+		EstimatedRemainingSeconds: tracker.estimateRemainingSeconds(tasks),
+	}, nil
+}
+
+// CancelIndexTasks cancels the unfinished index tasks, the segment indexes are marked failed,
+// and rebuilt only if the index is recreated.
+func (s *Server) CancelIndexTasks(ctx context.Context, req *datapb.CancelIndexTasksRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("indexName", req.GetIndexName()),
+		zap.Int64s("buildIDs", req.GetBuildIDs()),
+	)
+	log.Info("receive CancelIndexTasks request")
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if s.indexBuilder == nil {
+		return merr.Status(merr.WrapErrServiceNotReady(paramtable.GetRole(), paramtable.GetNodeID(), "index builder not started")), nil
+	}
+
+	buildIDs := req.GetBuildIDs()
+	if len(buildIDs) == 0 {
+		if req.GetCollectionID() == 0 {
+			return merr.Status(merr.WrapErrParameterInvalidMsg("either buildIDs or collectionID must be specified")), nil
+		}
+		buildIDs = lo.Map(s.selectIndexTasks(req.GetCollectionID(), req.GetIndexName()), func(segIdx *model.SegmentIndex, _ int) int64 {
+			return segIdx.BuildID
+		})
+	}
+	cancelled := s.indexBuilder.cancelTasks(buildIDs)
+	if len(cancelled) == 0 {
+		err := merr.WrapErrParameterInvalidMsg("no unfinished index task to cancel")
+		log.Warn("CancelIndexTasks fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("CancelIndexTasks success", zap.Int64s("cancelled", cancelled))
+	return merr.Success(), nil
+}
+
+// GetScalarIndexAdvice advises the scalar index types of the fields of the collection,
+// by the cardinality collected at flush.
+func (s *Server) GetScalarIndexAdvice(ctx context.Context, req *datapb.GetScalarIndexAdviceRequest) (*datapb.GetScalarIndexAdviceResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+	)
+
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		log.Warn(msgDataCoordIsUnhealthy(paramtable.GetNodeID()), zap.Error(err))
+		return &datapb.GetScalarIndexAdviceResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	advices, err := s.adviseScalarIndexes(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("GetScalarIndexAdvice fail", zap.Error(err))
+		return &datapb.GetScalarIndexAdviceResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	log.Info("GetScalarIndexAdvice success", zap.Int("numAdvices", len(advices)))
+	return &datapb.GetScalarIndexAdviceResponse{
+		Status:  merr.Success(),
+		Advices: advices,
+	}, nil
+}
+
+// selectIndexTasks returns the segment indexes of the unfinished index tasks sorted by buildID,
+// filtered by the collection and the index name if specified.
+func (s *Server) selectIndexTasks(collectionID UniqueID, indexName string) []*model.SegmentIndex {
+	if s.indexBuilder == nil {
+		return nil
+	}
+	tasks := make([]*model.SegmentIndex, 0)
+	for _, buildID := range s.indexBuilder.unfinishedTasks() {
+		segIdx, ok := s.meta.indexMeta.GetIndexJob(buildID)
+		if !ok {
+			continue
+		}
+		if collectionID != 0 && segIdx.CollectionID != collectionID {
+			continue
+		}
+		if indexName != "" && s.meta.indexMeta.GetIndexNameByID(segIdx.CollectionID, segIdx.IndexID) != indexName {
+			continue
+		}
+		tasks = append(tasks, segIdx)
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].BuildID < tasks[j].BuildID
+	})
+	return tasks
+}
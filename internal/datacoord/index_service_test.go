@@ -41,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
@@ -2382,3 +2383,315 @@ func TestMeta_GetHasUnindexTaskSegments(t *testing.T) {
 		assert.Equal(t, 0, len(segments))
 	})
 }
+
+func TestServer_AlterIndexType(t *testing.T) {
+	var (
+		collID    = UniqueID(1)
+		fieldID   = UniqueID(10)
+		indexID   = UniqueID(100)
+		indexName = "vec_idx"
+		ctx       = context.Background()
+	)
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().CreateIndex(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	indexMeta := newSegmentIndexMeta(catalog)
+	indexMeta.indexes[collID] = map[UniqueID]*model.Index{
+		indexID: {
+			CollectionID: collID,
+			FieldID:      fieldID,
+			IndexID:      indexID,
+			IndexName:    indexName,
+			TypeParams:   []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "128"}},
+			IndexParams: []*commonpb.KeyValuePair{
+				{Key: common.IndexTypeKey, Value: indexparamcheck.IndexFaissIvfFlat},
+				{Key: common.MetricTypeKey, Value: "L2"},
+			},
+			CreateTime: 10,
+		},
+		indexID + 1: {
+			CollectionID: collID,
+			FieldID:      fieldID + 1,
+			IndexID:      indexID + 1,
+			IndexName:    "scalar_idx",
+			IndexParams:  []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: indexparamcheck.IndexSTLSORT}},
+		},
+	}
+
+	b := broker.NewMockBroker(t)
+	b.EXPECT().DescribeCollectionInternal(mock.Anything, collID).Return(&milvuspb.DescribeCollectionResponse{
+		Status: merr.Success(),
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: fieldID, Name: "vec", DataType: schemapb.DataType_FloatVector},
+				{FieldID: fieldID + 1, Name: "scalar", DataType: schemapb.DataType_Int64},
+			},
+		},
+	}, nil).Maybe()
+
+	s := &Server{
+		meta:            &meta{catalog: catalog, indexMeta: indexMeta},
+		broker:          b,
+		allocator:       newMockAllocator(),
+		notifyIndexChan: make(chan UniqueID, 1),
+	}
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+
+	hnswParams := []*commonpb.KeyValuePair{
+		{Key: common.IndexTypeKey, Value: indexparamcheck.IndexHNSW},
+		{Key: "M", Value: "16"},
+		{Key: "efConstruction", Value: "200"},
+	}
+
+	t.Run("scalar index", func(t *testing.T) {
+		resp, err := s.AlterIndex(ctx, &indexpb.AlterIndexRequest{
+			CollectionID: collID,
+			IndexName:    "scalar_idx",
+			Params:       []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: indexparamcheck.IndexINVERTED}},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("invalid index type", func(t *testing.T) {
+		resp, err := s.AlterIndex(ctx, &indexpb.AlterIndexRequest{
+			CollectionID: collID,
+			IndexName:    indexName,
+			Params:       []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "invalid"}},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("invalid build params", func(t *testing.T) {
+		resp, err := s.AlterIndex(ctx, &indexpb.AlterIndexRequest{
+			CollectionID: collID,
+			IndexName:    indexName,
+			Params:       []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: indexparamcheck.IndexHNSW}},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
+	t.Run("rebuild index", func(t *testing.T) {
+		resp, err := s.AlterIndex(ctx, &indexpb.AlterIndexRequest{
+			CollectionID: collID,
+			IndexName:    indexName,
+			Params:       hnswParams,
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+
+		reindexing := s.meta.indexMeta.GetReindexingIndexes()
+		assert.Equal(t, 1, len(reindexing))
+		assert.Equal(t, indexID, reindexing[0].ReplaceIndexID)
+		assert.Equal(t, indexName, reindexing[0].IndexName)
+		assert.Equal(t, indexparamcheck.IndexHNSW, GetIndexType(reindexing[0].IndexParams))
+		assert.Equal(t, "L2", funcutil.KeyValuePair2Map(reindexing[0].IndexParams)[common.MetricTypeKey])
+
+		// the serving index is still visible
+		indexes := s.meta.indexMeta.GetIndexesForCollection(collID, indexName)
+		assert.Equal(t, 1, len(indexes))
+		assert.Equal(t, indexID, indexes[0].IndexID)
+	})
+
+	t.Run("index being rebuilt", func(t *testing.T) {
+		resp, err := s.AlterIndex(ctx, &indexpb.AlterIndexRequest{
+			CollectionID: collID,
+			IndexName:    indexName,
+			Params:       hnswParams,
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+}
+
+func TestServer_replaceReindexedIndexes(t *testing.T) {
+	var (
+		collID  = UniqueID(1)
+		segID   = UniqueID(1000)
+		indexID = UniqueID(100)
+	)
+
+	catalog := catalogmocks.NewDataCoordCatalog(t)
+	catalog.EXPECT().AlterIndexes(mock.Anything, mock.Anything).Return(nil)
+
+	indexMeta := newSegmentIndexMeta(catalog)
+	indexMeta.indexes[collID] = map[UniqueID]*model.Index{
+		// serving
+		indexID: {CollectionID: collID, IndexID: indexID, IndexName: "idx"},
+		// rebuilding
+		indexID + 1: {CollectionID: collID, IndexID: indexID + 1, IndexName: "idx", ReplaceIndexID: indexID},
+		// serving dropped
+		indexID + 2: {CollectionID: collID, IndexID: indexID + 2, IndexName: "idx_2", IsDeleted: true},
+		// rebuilding for the dropped index
+		indexID + 3: {CollectionID: collID, IndexID: indexID + 3, IndexName: "idx_2", ReplaceIndexID: indexID + 2},
+	}
+	indexMeta.segmentIndexes[segID] = map[UniqueID]*model.SegmentIndex{
+		indexID:     {CollectionID: collID, SegmentID: segID, IndexID: indexID, IndexState: commonpb.IndexState_Finished},
+		indexID + 1: {CollectionID: collID, SegmentID: segID, IndexID: indexID + 1, IndexState: commonpb.IndexState_InProgress},
+	}
+
+	segments := NewSegmentsInfo()
+	segments.SetSegment(segID, &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+		ID:           segID,
+		CollectionID: collID,
+		State:        commonpb.SegmentState_Flushed,
+	}})
+	s := &Server{meta: &meta{catalog: catalog, indexMeta: indexMeta, segments: segments}}
+
+	s.replaceReindexedIndexes()
+	assert.True(t, indexMeta.IsReindexingIndex(collID, indexID+1))
+	assert.True(t, indexMeta.IsIndexExist(collID, indexID))
+	assert.False(t, indexMeta.IsIndexExist(collID, indexID+3))
+
+	indexMeta.segmentIndexes[segID][indexID+1].IndexState = commonpb.IndexState_Finished
+	s.replaceReindexedIndexes()
+	assert.False(t, indexMeta.IsReindexingIndex(collID, indexID+1))
+	assert.True(t, indexMeta.IsIndexExist(collID, indexID+1))
+	assert.False(t, indexMeta.IsIndexExist(collID, indexID))
+}
+
+func TestServer_IndexTasks(t *testing.T) {
+	var (
+		collID    = UniqueID(1)
+		partID    = UniqueID(2)
+		fieldID   = UniqueID(10)
+		indexID   = UniqueID(100)
+		segID     = UniqueID(1000)
+		buildID   = UniqueID(10000)
+		nodeID    = UniqueID(1)
+		indexName = "default_idx"
+		ctx       = context.Background()
+	)
+
+	catalog := &datacoord.Catalog{MetaKv: mockkv.NewMetaKv(t)}
+	s := &Server{
+		meta: &meta{
+			catalog:   catalog,
+			indexMeta: newSegmentIndexMeta(catalog),
+			segments:  NewSegmentsInfo(),
+		},
+	}
+	s.meta.indexMeta.indexes[collID] = map[UniqueID]*model.Index{
+		indexID: {
+			CollectionID: collID,
+			FieldID:      fieldID,
+			IndexID:      indexID,
+			IndexName:    indexName,
+		},
+	}
+	for i, numRows := range []int64{1000, 2000} {
+		s.meta.segments.SetSegment(segID+int64(i), NewSegmentInfo(&datapb.SegmentInfo{
+			ID:           segID + int64(i),
+			CollectionID: collID,
+			PartitionID:  partID,
+			NumOfRows:    numRows,
+			State:        commonpb.SegmentState_Flushed,
+		}))
+	}
+	s.meta.indexMeta.updateSegmentIndex(&model.SegmentIndex{
+		SegmentID:    segID,
+		CollectionID: collID,
+		PartitionID:  partID,
+		NumRows:      1000,
+		IndexID:      indexID,
+		BuildID:      buildID,
+		NodeID:       nodeID,
+		IndexState:   commonpb.IndexState_InProgress,
+	})
+
+	t.Run("server not available", func(t *testing.T) {
+		s.stateCode.Store(commonpb.StateCode_Initializing)
+		resp, err := s.ListIndexTasks(ctx, &datapb.ListIndexTasksRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+
+		status, err := s.CancelIndexTasks(ctx, &datapb.CancelIndexTasksRequest{BuildIDs: []int64{buildID}})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	})
+
+	s.stateCode.Store(commonpb.StateCode_Healthy)
+	t.Run("index builder not started", func(t *testing.T) {
+		resp, err := s.ListIndexTasks(ctx, &datapb.ListIndexTasksRequest{})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Empty(t, resp.GetTasks())
+
+		status, err := s.CancelIndexTasks(ctx, &datapb.CancelIndexTasksRequest{BuildIDs: []int64{buildID}})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	})
+
+	s.indexBuilder = &indexBuilder{
+		tasks: map[int64]indexTaskState{
+			buildID: indexTaskInProgress,
+		},
+		tracker: indexTaskTracker{
+			startTimes:  map[UniqueID]time.Time{buildID: time.Now().Add(-2 * time.Second)},
+			throughputs: map[UniqueID]float64{nodeID: 100},
+		},
+	}
+
+	t.Run("segment progress", func(t *testing.T) {
+		resp, err := s.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{CollectionID: collID, WithSegmentProgress: true})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetIndexInfos(), 1)
+		progresses := resp.GetIndexInfos()[0].GetSegmentProgresses()
+		assert.Len(t, progresses, 2)
+		assert.Equal(t, buildID, progresses[0].GetBuildID())
+		assert.Equal(t, indexName, progresses[0].GetIndexName())
+		assert.Equal(t, commonpb.IndexState_InProgress, progresses[0].GetState())
+		assert.GreaterOrEqual(t, progresses[0].GetElapsedMs(), int64(2000))
+		assert.EqualValues(t, 8, progresses[0].GetEstimatedRemainingSeconds())
+		assert.EqualValues(t, 0, progresses[1].GetBuildID())
+		assert.Equal(t, commonpb.IndexState_Unissued, progresses[1].GetState())
+		assert.EqualValues(t, 20, progresses[1].GetEstimatedRemainingSeconds())
+		assert.EqualValues(t, 28, resp.GetIndexInfos()[0].GetEstimatedRemainingSeconds())
+
+		resp, err = s.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.Empty(t, resp.GetIndexInfos()[0].GetSegmentProgresses())
+
+		progressResp, err := s.GetIndexBuildProgress(ctx, &indexpb.GetIndexBuildProgressRequest{CollectionID: collID, WithSegmentProgress: true})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(progressResp.GetStatus()))
+		assert.Len(t, progressResp.GetSegmentProgresses(), 2)
+		assert.EqualValues(t, 28, progressResp.GetEstimatedRemainingSeconds())
+	})
+
+	t.Run("list tasks", func(t *testing.T) {
+		resp, err := s.ListIndexTasks(ctx, &datapb.ListIndexTasksRequest{CollectionID: collID, IndexName: indexName})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetTasks(), 1)
+		assert.Equal(t, buildID, resp.GetTasks()[0].GetBuildID())
+		assert.EqualValues(t, 8, resp.GetEstimatedRemainingSeconds())
+
+		resp, err = s.ListIndexTasks(ctx, &datapb.ListIndexTasksRequest{CollectionID: collID, IndexName: "other_idx"})
+		assert.NoError(t, err)
+		assert.Empty(t, resp.GetTasks())
+	})
+
+	t.Run("cancel tasks", func(t *testing.T) {
+		status, err := s.CancelIndexTasks(ctx, &datapb.CancelIndexTasksRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+
+		status, err = s.CancelIndexTasks(ctx, &datapb.CancelIndexTasksRequest{BuildIDs: []int64{buildID + 1}})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+
+		status, err = s.CancelIndexTasks(ctx, &datapb.CancelIndexTasksRequest{CollectionID: collID, IndexName: indexName})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+
+		resp, err := s.ListIndexTasks(ctx, &datapb.ListIndexTasksRequest{CollectionID: collID})
+		assert.NoError(t, err)
+		assert.Len(t, resp.GetTasks(), 1)
+		assert.True(t, resp.GetTasks()[0].GetCancelling())
+	})
+}
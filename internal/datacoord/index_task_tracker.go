@@ -0,0 +1,204 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// throughputSmoothing is the weight of the latest finished task in the throughput of the index node.
+const throughputSmoothing = 0.3
+
+// indexTaskTracker tracks the start time and the cancellation of the index tasks,
+// and the throughput of the index nodes observed from the finished tasks,
+// which estimates the remaining time of the index building.
+// The zero value is ready to use, nothing is persisted, the timing of the tasks
+// in progress is unknown after DataCoord restarts.
+type indexTaskTracker struct {
+	mu sync.RWMutex
+	// buildID -> time the task is assigned to the index node
+	startTimes map[UniqueID]time.Time
+	// nodeID -> rows indexed per second
+	throughputs map[UniqueID]float64
+	cancelled   typeutil.UniqueSet
+}
+
+func (t *indexTaskTracker) start(buildID UniqueID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.startTimes == nil {
+		t.startTimes = make(map[UniqueID]time.Time)
+	}
+	t.startTimes[buildID] = time.Now()
+}
+
+// finish records the throughput of the index node by the finished task.
+func (t *indexTaskTracker) finish(buildID, nodeID UniqueID, numRows int64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	startTime, ok := t.startTimes[buildID]
+	delete(t.startTimes, buildID)
+	elapsed := time.Since(startTime).Seconds()
+	if !ok || elapsed <= 0 || numRows <= 0 {
+		return
+	}
+	if t.throughputs == nil {
+		t.throughputs = make(map[UniqueID]float64)
+	}
+	throughput := float64(numRows) / elapsed
+	if last, ok := t.throughputs[nodeID]; ok {
+		throughput = last*(1-throughputSmoothing) + throughput*throughputSmoothing
+	}
+	t.throughputs[nodeID] = throughput
+}
+
+// forget removes the task once it's done or dropped.
+func (t *indexTaskTracker) forget(buildID UniqueID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.startTimes, buildID)
+	t.cancelled.Remove(buildID)
+}
+
+func (t *indexTaskTracker) removeNode(nodeID UniqueID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.throughputs, nodeID)
+}
+
+func (t *indexTaskTracker) cancel(buildID UniqueID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cancelled == nil {
+		t.cancelled = typeutil.NewUniqueSet()
+	}
+	t.cancelled.Insert(buildID)
+}
+
+func (t *indexTaskTracker) isCancelled(buildID UniqueID) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.cancelled.Contain(buildID)
+}
+
+func (t *indexTaskTracker) elapsed(buildID UniqueID) time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	startTime, ok := t.startTimes[buildID]
+	if !ok {
+		return 0
+	}
+	return time.Since(startTime)
+}
+
+// throughput returns the throughput of the index node,
+// or the average of all the index nodes if the node is unknown, 0 if none observed.
+func (t *indexTaskTracker) throughput(nodeID UniqueID) float64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if throughput, ok := t.throughputs[nodeID]; ok {
+		return throughput
+	}
+	if len(t.throughputs) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, throughput := range t.throughputs {
+		sum += throughput
+	}
+	return sum / float64(len(t.throughputs))
+}
+
+// totalThroughput returns the sum of the throughputs of the index nodes building in parallel.
+func (t *indexTaskTracker) totalThroughput() float64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var sum float64
+	for _, throughput := range t.throughputs {
+		sum += throughput
+	}
+	return sum
+}
+
+// progress returns the build progress of the segment index,
+// the remaining time of the task not issued yet excludes the time waiting in queue.
+func (t *indexTaskTracker) progress(segIdx *model.SegmentIndex) *indexpb.SegmentIndexProgress {
+	progress := &indexpb.SegmentIndexProgress{
+		CollectionID: segIdx.CollectionID,
+		PartitionID:  segIdx.PartitionID,
+		SegmentID:    segIdx.SegmentID,
+		IndexID:      segIdx.IndexID,
+		BuildID:      segIdx.BuildID,
+		NodeID:       segIdx.NodeID,
+		State:        segIdx.IndexState,
+		FailReason:   segIdx.FailReason,
+		NumRows:      segIdx.NumRows,
+	}
+	switch segIdx.IndexState {
+	case commonpb.IndexState_Finished:
+		progress.IndexedRows = segIdx.NumRows
+	case commonpb.IndexState_InProgress:
+		elapsed := t.elapsed(segIdx.BuildID)
+		progress.ElapsedMs = elapsed.Milliseconds()
+		progress.EstimatedRemainingSeconds = estimateSeconds(segIdx.NumRows, t.throughput(segIdx.NodeID), elapsed)
+		progress.Cancelling = t.isCancelled(segIdx.BuildID)
+	case commonpb.IndexState_Unissued:
+		progress.EstimatedRemainingSeconds = estimateSeconds(segIdx.NumRows, t.throughput(0), 0)
+		progress.Cancelling = t.isCancelled(segIdx.BuildID)
+	}
+	return progress
+}
+
+// estimateRemainingSeconds estimates the time to finish all the unfinished segment indexes,
+// by the rows not indexed yet and the total throughput of the index nodes, 0 if unknown.
+func (t *indexTaskTracker) estimateRemainingSeconds(progresses []*indexpb.SegmentIndexProgress) int64 {
+	var remainingRows float64
+	for _, progress := range progresses {
+		switch progress.GetState() {
+		case commonpb.IndexState_InProgress:
+			built := t.throughput(progress.GetNodeID()) * float64(progress.GetElapsedMs()) / 1000
+			remainingRows += math.Max(float64(progress.GetNumRows())-built, 0)
+		case commonpb.IndexState_Unissued, commonpb.IndexState_Retry:
+			remainingRows += float64(progress.GetNumRows())
+		}
+	}
+	total := t.totalThroughput()
+	if remainingRows == 0 || total == 0 {
+		return 0
+	}
+	return int64(math.Ceil(remainingRows / total))
+}
+
+// estimateSeconds returns the remaining seconds to index the rows, 0 if the throughput is unknown.
+func estimateSeconds(numRows int64, throughput float64, elapsed time.Duration) int64 {
+	if throughput <= 0 {
+		return 0
+	}
+	remaining := float64(numRows)/throughput - elapsed.Seconds()
+	if remaining <= 0 {
+		// overdue, it's expected to finish soon
+		return 1
+	}
+	return int64(math.Ceil(remaining))
+}
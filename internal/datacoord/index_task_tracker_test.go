@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+)
+
+func TestIndexTaskTracker(t *testing.T) {
+	t.Run("unknown throughput", func(t *testing.T) {
+		tracker := &indexTaskTracker{}
+		tracker.start(1)
+		progress := tracker.progress(&model.SegmentIndex{BuildID: 1, NodeID: 10, NumRows: 1000, IndexState: commonpb.IndexState_InProgress})
+		assert.EqualValues(t, 0, progress.GetEstimatedRemainingSeconds())
+		assert.EqualValues(t, 0, tracker.estimateRemainingSeconds([]*indexpb.SegmentIndexProgress{progress}))
+	})
+
+	t.Run("throughput", func(t *testing.T) {
+		tracker := &indexTaskTracker{}
+		tracker.start(1)
+		tracker.startTimes[1] = time.Now().Add(-10 * time.Second)
+		tracker.finish(1, 10, 1000)
+		assert.InDelta(t, 100, tracker.throughput(10), 1)
+
+		// smoothed by the latest task
+		tracker.start(2)
+		tracker.startTimes[2] = time.Now().Add(-10 * time.Second)
+		tracker.finish(2, 10, 2000)
+		assert.InDelta(t, 130, tracker.throughput(10), 1)
+
+		// unknown node falls back to the average
+		tracker.throughputs[11] = 70
+		assert.InDelta(t, 100, tracker.throughput(12), 1)
+		assert.InDelta(t, 200, tracker.totalThroughput(), 1)
+
+		tracker.removeNode(11)
+		assert.InDelta(t, 130, tracker.totalThroughput(), 1)
+
+		// finish without start is ignored
+		tracker.finish(3, 11, 1000)
+		_, ok := tracker.throughputs[11]
+		assert.False(t, ok)
+	})
+
+	t.Run("progress", func(t *testing.T) {
+		tracker := &indexTaskTracker{throughputs: map[UniqueID]float64{10: 100}}
+		tracker.start(1)
+		tracker.startTimes[1] = time.Now().Add(-2 * time.Second)
+
+		inProgress := tracker.progress(&model.SegmentIndex{BuildID: 1, NodeID: 10, NumRows: 1000, IndexState: commonpb.IndexState_InProgress})
+		assert.GreaterOrEqual(t, inProgress.GetElapsedMs(), int64(2000))
+		assert.EqualValues(t, 8, inProgress.GetEstimatedRemainingSeconds())
+		assert.EqualValues(t, 0, inProgress.GetIndexedRows())
+
+		unissued := tracker.progress(&model.SegmentIndex{BuildID: 2, NumRows: 2000, IndexState: commonpb.IndexState_Unissued})
+		assert.EqualValues(t, 20, unissued.GetEstimatedRemainingSeconds())
+
+		finished := tracker.progress(&model.SegmentIndex{BuildID: 3, NumRows: 500, IndexState: commonpb.IndexState_Finished})
+		assert.EqualValues(t, 500, finished.GetIndexedRows())
+		assert.EqualValues(t, 0, finished.GetEstimatedRemainingSeconds())
+
+		assert.EqualValues(t, 28, tracker.estimateRemainingSeconds([]*indexpb.SegmentIndexProgress{inProgress, unissued, finished}))
+
+		// overdue task is expected to finish soon
+		tracker.startTimes[1] = time.Now().Add(-time.Minute)
+		overdue := tracker.progress(&model.SegmentIndex{BuildID: 1, NodeID: 10, NumRows: 1000, IndexState: commonpb.IndexState_InProgress})
+		assert.EqualValues(t, 1, overdue.GetEstimatedRemainingSeconds())
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		tracker := &indexTaskTracker{}
+		assert.False(t, tracker.isCancelled(1))
+		tracker.cancel(1)
+		assert.True(t, tracker.isCancelled(1))
+		progress := tracker.progress(&model.SegmentIndex{BuildID: 1, IndexState: commonpb.IndexState_InProgress})
+		assert.True(t, progress.GetCancelling())
+
+		tracker.forget(1)
+		assert.False(t, tracker.isCancelled(1))
+	})
+}
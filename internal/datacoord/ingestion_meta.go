@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ingestionMeta keeps the collections the ingestion of which paused,
+// the datanodes don't consume the vchannels of these collections until resumed.
+type ingestionMeta struct {
+	sync.RWMutex
+	ctx     context.Context
+	catalog metastore.DataCoordCatalog
+
+	paused typeutil.UniqueSet
+}
+
+func newIngestionMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*ingestionMeta, error) {
+	collectionIDs, err := catalog.ListIngestionPauses(ctx)
+	if err != nil {
+		log.Error("ingestionMeta reloadFromKV load paused collections fail", zap.Error(err))
+		return nil, err
+	}
+	return &ingestionMeta{
+		ctx:     ctx,
+		catalog: catalog,
+		paused:  typeutil.NewUniqueSet(collectionIDs...),
+	}, nil
+}
+
+func (m *ingestionMeta) Pause(collectionID UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+	if m.paused.Contain(collectionID) {
+		return nil
+	}
+	if err := m.catalog.SaveIngestionPause(m.ctx, collectionID); err != nil {
+		return err
+	}
+	m.paused.Insert(collectionID)
+	return nil
+}
+
+func (m *ingestionMeta) Resume(collectionID UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+	if !m.paused.Contain(collectionID) {
+		return nil
+	}
+	if err := m.catalog.DropIngestionPause(m.ctx, collectionID); err != nil {
+		return err
+	}
+	m.paused.Remove(collectionID)
+	return nil
+}
+
+func (m *ingestionMeta) IsPaused(collectionID UniqueID) bool {
+	m.RLock()
+	defer m.RUnlock()
+	return m.paused.Contain(collectionID)
+}
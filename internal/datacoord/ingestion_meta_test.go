@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type IngestionSuite struct {
+	suite.Suite
+
+	server         *Server
+	catalog        *mocks.DataCoordCatalog
+	cluster        *MockCluster
+	channelManager *MockChannelManager
+}
+
+func (s *IngestionSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *IngestionSuite) SetupTest() {
+	s.catalog = mocks.NewDataCoordCatalog(s.T())
+	s.catalog.EXPECT().ListIngestionPauses(mock.Anything).Return([]int64{200}, nil)
+	ingestionMeta, err := newIngestionMeta(context.Background(), s.catalog)
+	s.Require().NoError(err)
+
+	s.cluster = NewMockCluster(s.T())
+	s.channelManager = NewMockChannelManager(s.T())
+	s.server = &Server{
+		meta: &meta{
+			ctx:           context.Background(),
+			catalog:       s.catalog,
+			segments:      NewSegmentsInfo(),
+			channelCPs:    newChannelCps(),
+			ingestionMeta: ingestionMeta,
+		},
+		cluster:        s.cluster,
+		channelManager: s.channelManager,
+	}
+	s.server.meta.channelCPs.checkpoints["ch-2"] = &msgpb.MsgPosition{ChannelName: "ch-2", Timestamp: 1000}
+	s.server.handler = newServerHandler(s.server)
+	s.server.stateCode.Store(commonpb.StateCode_Healthy)
+}
+
+func (s *IngestionSuite) TestPauseAndResume() {
+	s.True(s.server.meta.ingestionMeta.IsPaused(200))
+	s.False(s.server.meta.ingestionMeta.IsPaused(100))
+
+	s.channelManager.EXPECT().GetNodeChannelsByCollectionID(int64(100)).Return(map[int64][]string{
+		1: {"ch-1"},
+		2: {},
+	})
+	s.catalog.EXPECT().SaveIngestionPause(mock.Anything, int64(100)).Return(nil).Once()
+	s.cluster.EXPECT().PauseChannels(mock.Anything, int64(1), []string{"ch-1"}, true).Return(nil).Once()
+	status, err := s.server.PauseIngestion(context.Background(), &datapb.PauseIngestionRequest{CollectionID: 100})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+	s.True(s.server.meta.ingestionMeta.IsPaused(100))
+
+	// the channels watched later are paused as well
+	info := s.server.handler.GetDataVChanPositions(&channelMeta{Name: "ch-2", CollectionID: 100}, allPartitionID)
+	s.True(info.GetIngestionPaused())
+
+	// paused again
+	s.cluster.EXPECT().PauseChannels(mock.Anything, int64(1), []string{"ch-1"}, true).Return(nil).Once()
+	status, err = s.server.PauseIngestion(context.Background(), &datapb.PauseIngestionRequest{CollectionID: 100})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+
+	s.catalog.EXPECT().DropIngestionPause(mock.Anything, int64(100)).Return(nil).Once()
+	s.cluster.EXPECT().PauseChannels(mock.Anything, int64(1), []string{"ch-1"}, false).Return(nil).Once()
+	status, err = s.server.ResumeIngestion(context.Background(), &datapb.ResumeIngestionRequest{CollectionID: 100})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+	s.False(s.server.meta.ingestionMeta.IsPaused(100))
+
+	info = s.server.handler.GetDataVChanPositions(&channelMeta{Name: "ch-2", CollectionID: 100}, allPartitionID)
+	s.False(info.GetIngestionPaused())
+}
+
+func (s *IngestionSuite) TestFailed() {
+	s.Run("catalog failed", func() {
+		s.catalog.EXPECT().SaveIngestionPause(mock.Anything, int64(100)).Return(errors.New("mock")).Once()
+		status, err := s.server.PauseIngestion(context.Background(), &datapb.PauseIngestionRequest{CollectionID: 100})
+		s.NoError(err)
+		s.Error(merr.Error(status))
+		s.False(s.server.meta.ingestionMeta.IsPaused(100))
+	})
+
+	s.Run("datanode failed", func() {
+		s.channelManager.EXPECT().GetNodeChannelsByCollectionID(int64(200)).Return(map[int64][]string{1: {"ch-1"}}).Once()
+		s.cluster.EXPECT().PauseChannels(mock.Anything, int64(1), []string{"ch-1"}, true).Return(merr.WrapErrNodeNotFound(1)).Once()
+		status, err := s.server.PauseIngestion(context.Background(), &datapb.PauseIngestionRequest{CollectionID: 200})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrNodeNotFound)
+	})
+
+	s.Run("not healthy", func() {
+		s.server.stateCode.Store(commonpb.StateCode_Abnormal)
+		defer s.server.stateCode.Store(commonpb.StateCode_Healthy)
+		status, err := s.server.ResumeIngestion(context.Background(), &datapb.ResumeIngestionRequest{CollectionID: 200})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrServiceNotReady)
+	})
+}
+
+func TestIngestion(t *testing.T) {
+	suite.Run(t, new(IngestionSuite))
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -56,7 +57,10 @@ type meta struct {
 	channelCPs   *channelCPs                  // vChannel -> channel checkpoint/see position
 	chunkManager storage.ChunkManager
 
-	indexMeta *indexMeta
+	indexMeta       *indexMeta
+	snapshotMeta    *snapshotMeta
+	idempotencyMeta *idempotencyMeta
+	ingestionMeta   *ingestionMeta
 }
 
 type channelCPs struct {
@@ -93,15 +97,30 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 	if err != nil {
 		return nil, err
 	}
+	snapshotMeta, err := newSnapshotMeta(ctx, catalog)
+	if err != nil {
+		return nil, err
+	}
+	idempotencyMeta, err := newIdempotencyMeta(ctx, catalog)
+	if err != nil {
+		return nil, err
+	}
+	ingestionMeta, err := newIngestionMeta(ctx, catalog)
+	if err != nil {
+		return nil, err
+	}
 
 	mt := &meta{
-		ctx:          ctx,
-		catalog:      catalog,
-		collections:  make(map[UniqueID]*collectionInfo),
-		segments:     NewSegmentsInfo(),
-		channelCPs:   newChannelCps(),
-		indexMeta:    indexMeta,
-		chunkManager: chunkManager,
+		ctx:             ctx,
+		catalog:         catalog,
+		collections:     make(map[UniqueID]*collectionInfo),
+		segments:        NewSegmentsInfo(),
+		channelCPs:      newChannelCps(),
+		indexMeta:       indexMeta,
+		snapshotMeta:    snapshotMeta,
+		idempotencyMeta: idempotencyMeta,
+		ingestionMeta:   ingestionMeta,
+		chunkManager:    chunkManager,
 	}
 	err = mt.reloadFromKV()
 	if err != nil {
@@ -168,6 +187,8 @@ func (m *meta) AddCollection(collection *collectionInfo) {
 	m.Lock()
 	defer m.Unlock()
 	m.collections[collection.ID] = collection
+	// the files of the collection placed in its own bucket are routed to it
+	storage.RegisterCollectionStorage(collection.ID, funcutil.Map2KeyValuePair(collection.Properties))
 	metrics.DataCoordNumCollections.WithLabelValues().Set(float64(len(m.collections)))
 	log.Info("meta update: add collection - complete", zap.Int64("collectionID", collection.ID))
 }
@@ -459,6 +480,36 @@ func (m *meta) SetState(segmentID UniqueID, targetState commonpb.SegmentState) e
 	return nil
 }
 
+// RestoreSegment marks the dropped segment flushed again.
+func (m *meta) RestoreSegment(segmentID UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil {
+		return merr.WrapErrSegmentNotFound(segmentID)
+	}
+	if segment.GetState() != commonpb.SegmentState_Dropped {
+		return merr.WrapErrSegmentNotFound(segmentID, "segment not dropped")
+	}
+
+	clonedSegment := segment.Clone()
+	metricMutation := &segMetricMutation{
+		stateChange: make(map[string]map[string]int),
+	}
+	updateSegStateAndPrepareMetrics(clonedSegment, commonpb.SegmentState_Flushed, metricMutation)
+	clonedSegment.DroppedAt = 0
+	if err := m.catalog.AlterSegments(m.ctx, []*datapb.SegmentInfo{clonedSegment.SegmentInfo}); err != nil {
+		log.Warn("meta update: restore segment - failed to alter segments",
+			zap.Int64("segmentID", segmentID),
+			zap.Error(err))
+		return err
+	}
+	metricMutation.commit()
+	m.segments.SetSegment(segmentID, clonedSegment)
+	log.Info("meta update: restore segment - complete", zap.Int64("segmentID", segmentID))
+	return nil
+}
+
 func (m *meta) UpdateSegment(segmentID int64, operators ...SegmentOperator) error {
 	m.Lock()
 	defer m.Unlock()
@@ -620,6 +671,61 @@ func AddBinlogsOperator(segmentID int64, binlogs, statslogs, deltalogs []*datapb
 	}
 }
 
+// AddArtifactLogsOperator adds the artifact logs generated by the stats generators into the segment.
+func AddArtifactLogsOperator(segmentID int64, artifactLogs []*datapb.FieldArtifactLogs) UpdateOperator {
+	return func(modPack *updateSegmentPack) bool {
+		if len(artifactLogs) == 0 {
+			return true
+		}
+		segment := modPack.Get(segmentID)
+		if segment == nil {
+			log.Warn("meta update: add artifact logs failed - segment not found",
+				zap.Int64("segmentID", segmentID))
+			return false
+		}
+
+		segment.Artifactlogs = mergeArtifactLogs(segment.GetArtifactlogs(), artifactLogs)
+		return true
+	}
+}
+
+// AddParquetLogsOperator adds the parquet files of the field groups into the segment.
+func AddParquetLogsOperator(segmentID int64, parquetLogs []*datapb.FieldGroupParquetLogs) UpdateOperator {
+	return func(modPack *updateSegmentPack) bool {
+		if len(parquetLogs) == 0 {
+			return true
+		}
+		segment := modPack.Get(segmentID)
+		if segment == nil {
+			log.Warn("meta update: add parquet logs failed - segment not found",
+				zap.Int64("segmentID", segmentID))
+			return false
+		}
+
+		segment.Parquetlogs = mergeParquetLogs(segment.GetParquetlogs(), parquetLogs)
+		return true
+	}
+}
+
+// UpdateScalarStatsOperator merges the scalar stats of the synced binlogs into the segment,
+// which shall be applied before the binlogs added to tell whether any rows of the segment synced before.
+func UpdateScalarStatsOperator(segmentID int64, binlogs []*datapb.FieldBinlog, stats []*datapb.FieldScalarStats) UpdateOperator {
+	return func(modPack *updateSegmentPack) bool {
+		if len(binlogs) == 0 {
+			return true
+		}
+		segment := modPack.Get(segmentID)
+		if segment == nil {
+			log.Warn("meta update: update scalar stats failed - segment not found",
+				zap.Int64("segmentID", segmentID))
+			return false
+		}
+
+		segment.ScalarStats = mergeScalarStats(segment.GetScalarStats(), len(segment.GetBinlogs()) > 0, stats)
+		return true
+	}
+}
+
 func UpdateBinlogsOperator(segmentID int64, binlogs, statslogs, deltalogs []*datapb.FieldBinlog) UpdateOperator {
 	return func(modPack *updateSegmentPack) bool {
 		segment := modPack.Get(segmentID)
@@ -812,6 +918,9 @@ func (m *meta) UpdateDropChannelSegmentInfo(channel string, segments []*SegmentI
 		// seg inf mod segments are all in dropped state
 		if !ok {
 			clonedSeg := seg.Clone()
+			if isSegmentHealthy(seg) {
+				markDroppedAt(clonedSeg)
+			}
 			updateSegStateAndPrepareMetrics(clonedSeg, commonpb.SegmentState_Dropped, metricMutation)
 			modSegments[seg.ID] = clonedSeg
 		}
@@ -830,6 +939,14 @@ func (m *meta) UpdateDropChannelSegmentInfo(channel string, segments []*SegmentI
 	return err
 }
 
+// markDroppedAt records the drop time of the segment dropped along with its channel,
+// so that it's kept by gc within the restore window rather than collected at once.
+func markDroppedAt(segment *SegmentInfo) {
+	if Params.DataCoordCfg.GCRestoreWindow.GetAsInt64() > 0 {
+		segment.DroppedAt = uint64(time.Now().UnixNano())
+	}
+}
+
 // mergeDropSegment merges drop segment information with meta segments
 func (m *meta) mergeDropSegment(seg2Drop *SegmentInfo) (*SegmentInfo, *segMetricMutation) {
 	metricMutation := &segMetricMutation{
@@ -845,6 +962,7 @@ func (m *meta) mergeDropSegment(seg2Drop *SegmentInfo) (*SegmentInfo, *segMetric
 	}
 
 	clonedSegment := segment.Clone()
+	markDroppedAt(clonedSegment)
 	updateSegStateAndPrepareMetrics(clonedSegment, commonpb.SegmentState_Dropped, metricMutation)
 
 	currBinlogs := clonedSegment.GetBinlogs()
@@ -1091,6 +1209,29 @@ func (m *meta) SetLastFlushTime(segmentID UniqueID, t time.Time) {
 	m.segments.SetFlushTime(segmentID, t)
 }
 
+// DropSegmentIfNotCompacting drops the segment unless it's being compacted, the check and the drop are done
+// under the same lock so that the segment is never dropped under the compaction started meanwhile.
+// It returns whether the segment is dropped.
+func (m *meta) DropSegmentIfNotCompacting(segmentID UniqueID) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	segment := m.segments.GetSegment(segmentID)
+	if segment == nil || !isSegmentHealthy(segment) || segment.isCompacting {
+		return false, nil
+	}
+	cloned := segment.Clone()
+	metricMutation := &segMetricMutation{
+		stateChange: make(map[string]map[string]int),
+	}
+	updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Dropped, metricMutation)
+	if err := m.catalog.AlterSegments(m.ctx, []*datapb.SegmentInfo{cloned.SegmentInfo}); err != nil {
+		return false, err
+	}
+	metricMutation.commit()
+	m.segments.SetState(segmentID, commonpb.SegmentState_Dropped)
+	return true, nil
+}
+
 // SetSegmentCompacting sets compaction state for segment
 func (m *meta) SetSegmentCompacting(segmentID UniqueID, compacting bool) {
 	m.Lock()
@@ -1163,6 +1304,7 @@ func (m *meta) CompleteCompactionMutation(plan *datapb.CompactionPlan, result *d
 			ID:            compactToSegment.GetSegmentID(),
 			CollectionID:  latestCompactFromSegments[0].CollectionID,
 			PartitionID:   latestCompactFromSegments[0].PartitionID,
+			BucketID:      latestCompactFromSegments[0].GetBucketID(),
 			InsertChannel: plan.GetChannel(),
 			NumOfRows:     compactToSegment.NumOfRows,
 			State:         commonpb.SegmentState_Flushed,
@@ -1170,6 +1312,9 @@ func (m *meta) CompleteCompactionMutation(plan *datapb.CompactionPlan, result *d
 			Binlogs:       compactToSegment.GetInsertLogs(),
 			Statslogs:     compactToSegment.GetField2StatslogPaths(),
 			Deltalogs:     compactToSegment.GetDeltalogs(),
+			ScalarStats:   compactToSegment.GetScalarStats(),
+			Artifactlogs:  compactToSegment.GetArtifactlogs(),
+			Parquetlogs:   compactToSegment.GetParquetlogs(),
 
 			CreatedByCompaction: true,
 			CompactionFrom:      compactFromSegIDs,
@@ -1365,6 +1510,17 @@ func (m *meta) GetChannelCheckpoint(vChannel string) *msgpb.MsgPosition {
 	return proto.Clone(cp).(*msgpb.MsgPosition)
 }
 
+// GetChannelCheckpoints returns the checkpoints of all the channels.
+func (m *meta) GetChannelCheckpoints() map[string]*msgpb.MsgPosition {
+	m.channelCPs.RLock()
+	defer m.channelCPs.RUnlock()
+	checkpoints := make(map[string]*msgpb.MsgPosition, len(m.channelCPs.checkpoints))
+	for channel, cp := range m.channelCPs.checkpoints {
+		checkpoints[channel] = proto.Clone(cp).(*msgpb.MsgPosition)
+	}
+	return checkpoints
+}
+
 func (m *meta) DropChannelCheckpoint(vChannel string) error {
 	m.channelCPs.Lock()
 	defer m.channelCPs.Unlock()
@@ -18,6 +18,7 @@ package datacoord
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 
 	"github.com/cockroachdb/errors"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/kv"
 	mockkv "github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
@@ -35,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -67,6 +70,9 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListSegments(mock.Anything).Return(nil, errors.New("mock"))
 		suite.catalog.EXPECT().ListIndexes(mock.Anything).Return([]*model.Index{}, nil)
 		suite.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{}, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListIdempotencyRecords(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListIngestionPauses(mock.Anything).Return(nil, nil)
 
 		_, err := newMeta(ctx, suite.catalog, nil)
 		suite.Error(err)
@@ -79,6 +85,9 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		suite.catalog.EXPECT().ListChannelCheckpoint(mock.Anything).Return(nil, errors.New("mock"))
 		suite.catalog.EXPECT().ListIndexes(mock.Anything).Return([]*model.Index{}, nil)
 		suite.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{}, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListIdempotencyRecords(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListIngestionPauses(mock.Anything).Return(nil, nil)
 
 		_, err := newMeta(ctx, suite.catalog, nil)
 		suite.Error(err)
@@ -88,6 +97,9 @@ func (suite *MetaReloadSuite) TestReloadFromKV() {
 		defer suite.resetMock()
 		suite.catalog.EXPECT().ListIndexes(mock.Anything).Return([]*model.Index{}, nil)
 		suite.catalog.EXPECT().ListSegmentIndexes(mock.Anything).Return([]*model.SegmentIndex{}, nil)
+		suite.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListIdempotencyRecords(mock.Anything).Return(nil, nil)
+		suite.catalog.EXPECT().ListIngestionPauses(mock.Anything).Return(nil, nil)
 		suite.catalog.EXPECT().ListSegments(mock.Anything).Return([]*datapb.SegmentInfo{
 			{
 				ID:           1,
@@ -228,6 +240,9 @@ func (suite *MetaBasicSuite) TestCompleteCompactionMutation() {
 		InsertLogs:          []*datapb.FieldBinlog{getFieldBinlogIDs(0, 50000)},
 		Field2StatslogPaths: []*datapb.FieldBinlog{getFieldBinlogIDs(0, 50001)},
 		NumOfRows:           2,
+		Parquetlogs: []*datapb.FieldGroupParquetLogs{
+			{GroupID: 0, FieldIDs: []int64{0, 1, 100}, Binlogs: []*datapb.Binlog{{LogPath: "parquet", EntriesNum: 2}}},
+		},
 	}
 
 	result := &datapb.CompactionPlanResult{
@@ -271,6 +286,7 @@ func (suite *MetaBasicSuite) TestCompleteCompactionMutation() {
 		}
 	}
 	suite.ElementsMatch([]int64{30001, 31001}, deltalogIDs)
+	suite.Equal(compactToSeg.GetParquetlogs(), info.GetParquetlogs())
 
 	// check compactFrom segments
 	for _, segID := range []int64{1, 2} {
@@ -715,6 +731,98 @@ func TestUpdateSegmentsInfo(t *testing.T) {
 		)
 		assert.NoError(t, err)
 	})
+	t.Run("update scalar stats", func(t *testing.T) {
+		meta, err := newMemoryMeta()
+		assert.NoError(t, err)
+
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing}}
+		err = meta.AddSegment(context.TODO(), segment1)
+		assert.NoError(t, err)
+
+		genStats := func(fieldID int64, values ...int64) *datapb.FieldScalarStats {
+			stats := storage.NewScalarFieldStats(fieldID, schemapb.DataType_Int64, false)
+			stats.UpdateByMsgs(&storage.Int64FieldData{Data: values})
+			bs, err := json.Marshal(stats)
+			assert.NoError(t, err)
+			return &datapb.FieldScalarStats{FieldID: fieldID, Stats: bs}
+		}
+		sync := func(stats ...*datapb.FieldScalarStats) {
+			binlogs := []*datapb.FieldBinlog{getFieldBinlogIDsWithEntry(1, 10, 1)}
+			err := meta.UpdateSegmentsInfo(
+				UpdateScalarStatsOperator(1, binlogs, stats),
+				AddBinlogsOperator(1, binlogs, nil, nil),
+			)
+			assert.NoError(t, err)
+		}
+
+		sync(genStats(101, 5, 10), genStats(102, 1))
+		assert.Len(t, meta.GetHealthySegment(1).GetScalarStats(), 2)
+
+		// field 102 not collected, the stats of it are dropped
+		sync(genStats(101, 1, 7))
+		scalarStats := meta.GetHealthySegment(1).GetScalarStats()
+		assert.Len(t, scalarStats, 1)
+		stats := &storage.FieldStats{}
+		assert.NoError(t, json.Unmarshal(scalarStats[0].GetStats(), stats))
+		assert.EqualValues(t, 101, stats.FieldID)
+		assert.EqualValues(t, 1, stats.Min.GetValue())
+		assert.EqualValues(t, 10, stats.Max.GetValue())
+
+		// stats not collected for the rows synced before
+		sync(genStats(102, 2))
+		assert.Empty(t, meta.GetHealthySegment(1).GetScalarStats())
+	})
+
+	t.Run("add artifact logs", func(t *testing.T) {
+		meta, err := newMemoryMeta()
+		assert.NoError(t, err)
+
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing}}
+		err = meta.AddSegment(context.TODO(), segment1)
+		assert.NoError(t, err)
+
+		sync := func(logs ...*datapb.FieldArtifactLogs) {
+			err := meta.UpdateSegmentsInfo(AddArtifactLogsOperator(1, logs))
+			assert.NoError(t, err)
+		}
+		sync(&datapb.FieldArtifactLogs{FieldID: 101, Name: "terms", Binlogs: []*datapb.Binlog{{LogPath: "a"}}})
+		sync(&datapb.FieldArtifactLogs{FieldID: 101, Name: "terms", Binlogs: []*datapb.Binlog{{LogPath: "b"}}},
+			&datapb.FieldArtifactLogs{FieldID: 101, Name: "sketch", Binlogs: []*datapb.Binlog{{LogPath: "c"}}})
+		sync()
+
+		artifactLogs := meta.GetHealthySegment(1).GetArtifactlogs()
+		assert.Len(t, artifactLogs, 2)
+		assert.Equal(t, "terms", artifactLogs[0].GetName())
+		assert.Len(t, artifactLogs[0].GetBinlogs(), 2)
+		assert.Equal(t, "sketch", artifactLogs[1].GetName())
+		assert.Len(t, getLogs(meta.GetHealthySegment(1)), 3)
+	})
+
+	t.Run("add parquet logs", func(t *testing.T) {
+		meta, err := newMemoryMeta()
+		assert.NoError(t, err)
+
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing}}
+		err = meta.AddSegment(context.TODO(), segment1)
+		assert.NoError(t, err)
+
+		sync := func(logs ...*datapb.FieldGroupParquetLogs) {
+			err := meta.UpdateSegmentsInfo(AddParquetLogsOperator(1, logs))
+			assert.NoError(t, err)
+		}
+		sync(&datapb.FieldGroupParquetLogs{GroupID: 0, FieldIDs: []int64{0, 1, 100}, Binlogs: []*datapb.Binlog{{LogPath: "a"}}},
+			&datapb.FieldGroupParquetLogs{GroupID: 101, FieldIDs: []int64{101}, Binlogs: []*datapb.Binlog{{LogPath: "b"}}})
+		sync(&datapb.FieldGroupParquetLogs{GroupID: 0, FieldIDs: []int64{0, 1, 100}, Binlogs: []*datapb.Binlog{{LogPath: "c"}}})
+		sync()
+
+		parquetLogs := meta.GetHealthySegment(1).GetParquetlogs()
+		assert.Len(t, parquetLogs, 2)
+		assert.Equal(t, []int64{0, 1, 100}, parquetLogs[0].GetFieldIDs())
+		assert.Len(t, parquetLogs[0].GetBinlogs(), 2)
+		assert.Len(t, parquetLogs[1].GetBinlogs(), 1)
+		assert.Len(t, getLogs(meta.GetHealthySegment(1)), 3)
+	})
+
 	t.Run("update non-existed segment", func(t *testing.T) {
 		meta, err := newMemoryMeta()
 		assert.NoError(t, err)
@@ -1106,3 +1214,30 @@ func Test_meta_GcConfirm(t *testing.T) {
 
 	assert.False(t, m.GcConfirm(context.TODO(), 100, 10000))
 }
+
+func Test_meta_UpdateDropChannelSegmentInfo(t *testing.T) {
+	paramtable.Init()
+	catalog := mocks2.NewDataCoordCatalog(t)
+	catalog.EXPECT().SaveDroppedSegmentsInBatch(mock.Anything, mock.Anything).Return(nil)
+	catalog.EXPECT().MarkChannelDeleted(mock.Anything, "ch1").Return(nil)
+	m := &meta{
+		ctx:      context.TODO(),
+		catalog:  catalog,
+		segments: NewSegmentsInfo(),
+	}
+	m.segments.SetSegment(1, NewSegmentInfo(&datapb.SegmentInfo{ID: 1, InsertChannel: "ch1", State: commonpb.SegmentState_Growing}))
+	m.segments.SetSegment(2, NewSegmentInfo(&datapb.SegmentInfo{ID: 2, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed}))
+	m.segments.SetSegment(3, NewSegmentInfo(&datapb.SegmentInfo{ID: 3, InsertChannel: "ch1", State: commonpb.SegmentState_Dropped, DroppedAt: 100}))
+
+	// the segments dropped along with the channel are kept within the restore window
+	paramtable.Get().Save(Params.DataCoordCfg.GCRestoreWindow.Key, "3600")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.GCRestoreWindow.Key)
+	err := m.UpdateDropChannelSegmentInfo("ch1", []*SegmentInfo{NewSegmentInfo(&datapb.SegmentInfo{ID: 1})})
+	assert.NoError(t, err)
+	for _, id := range []int64{1, 2} {
+		segment := m.GetSegment(id)
+		assert.Equal(t, commonpb.SegmentState_Dropped, segment.GetState())
+		assert.NotZero(t, segment.GetDroppedAt())
+	}
+	assert.EqualValues(t, 100, m.GetSegment(3).GetDroppedAt())
+}
@@ -273,6 +273,51 @@ func (_c *MockCluster_ImportV2_Call) RunAndReturn(run func(int64, *datapb.Import
 	return _c
 }
 
+// PauseChannels provides a mock function with given fields: ctx, nodeID, channels, paused
+func (_m *MockCluster) PauseChannels(ctx context.Context, nodeID int64, channels []string, paused bool) error {
+	ret := _m.Called(ctx, nodeID, channels, paused)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, []string, bool) error); ok {
+		r0 = rf(ctx, nodeID, channels, paused)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCluster_PauseChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseChannels'
+type MockCluster_PauseChannels_Call struct {
+	*mock.Call
+}
+
+// PauseChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+//   - channels []string
+//   - paused bool
+func (_e *MockCluster_Expecter) PauseChannels(ctx interface{}, nodeID interface{}, channels interface{}, paused interface{}) *MockCluster_PauseChannels_Call {
+	return &MockCluster_PauseChannels_Call{Call: _e.mock.On("PauseChannels", ctx, nodeID, channels, paused)}
+}
+
+func (_c *MockCluster_PauseChannels_Call) Run(run func(ctx context.Context, nodeID int64, channels []string, paused bool)) *MockCluster_PauseChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].([]string), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockCluster_PauseChannels_Call) Return(_a0 error) *MockCluster_PauseChannels_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCluster_PauseChannels_Call) RunAndReturn(run func(context.Context, int64, []string, bool) error) *MockCluster_PauseChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PreImport provides a mock function with given fields: nodeID, in
 func (_m *MockCluster) PreImport(nodeID int64, in *datapb.PreImportRequest) error {
 	ret := _m.Called(nodeID, in)
@@ -468,6 +513,51 @@ func (_c *MockCluster_Register_Call) RunAndReturn(run func(*NodeInfo) error) *Mo
 	return _c
 }
 
+// SetChannelParallelism provides a mock function with given fields: ctx, nodeID, channel, parallelism
+func (_m *MockCluster) SetChannelParallelism(ctx context.Context, nodeID int64, channel string, parallelism int32) error {
+	ret := _m.Called(ctx, nodeID, channel, parallelism)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, int32) error); ok {
+		r0 = rf(ctx, nodeID, channel, parallelism)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCluster_SetChannelParallelism_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetChannelParallelism'
+type MockCluster_SetChannelParallelism_Call struct {
+	*mock.Call
+}
+
+// SetChannelParallelism is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+//   - channel string
+//   - parallelism int32
+func (_e *MockCluster_Expecter) SetChannelParallelism(ctx interface{}, nodeID interface{}, channel interface{}, parallelism interface{}) *MockCluster_SetChannelParallelism_Call {
+	return &MockCluster_SetChannelParallelism_Call{Call: _e.mock.On("SetChannelParallelism", ctx, nodeID, channel, parallelism)}
+}
+
+func (_c *MockCluster_SetChannelParallelism_Call) Run(run func(ctx context.Context, nodeID int64, channel string, parallelism int32)) *MockCluster_SetChannelParallelism_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string), args[3].(int32))
+	})
+	return _c
+}
+
+func (_c *MockCluster_SetChannelParallelism_Call) Return(_a0 error) *MockCluster_SetChannelParallelism_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCluster_SetChannelParallelism_Call) RunAndReturn(run func(context.Context, int64, string, int32) error) *MockCluster_SetChannelParallelism_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Startup provides a mock function with given fields: ctx, nodes
 func (_m *MockCluster) Startup(ctx context.Context, nodes []*NodeInfo) error {
 	ret := _m.Called(ctx, nodes)
@@ -20,6 +20,48 @@ func (_m *MockCompactionPlanContext) EXPECT() *MockCompactionPlanContext_Expecte
 	return &MockCompactionPlanContext_Expecter{mock: &_m.Mock}
 }
 
+// cancelCompaction provides a mock function with given fields: signalID
+func (_m *MockCompactionPlanContext) cancelCompaction(signalID int64) error {
+	ret := _m.Called(signalID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(signalID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCompactionPlanContext_cancelCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'cancelCompaction'
+type MockCompactionPlanContext_cancelCompaction_Call struct {
+	*mock.Call
+}
+
+// cancelCompaction is a helper method to define mock.On call
+//   - signalID int64
+func (_e *MockCompactionPlanContext_Expecter) cancelCompaction(signalID interface{}) *MockCompactionPlanContext_cancelCompaction_Call {
+	return &MockCompactionPlanContext_cancelCompaction_Call{Call: _e.mock.On("cancelCompaction", signalID)}
+}
+
+func (_c *MockCompactionPlanContext_cancelCompaction_Call) Run(run func(signalID int64)) *MockCompactionPlanContext_cancelCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_cancelCompaction_Call) Return(_a0 error) *MockCompactionPlanContext_cancelCompaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_cancelCompaction_Call) RunAndReturn(run func(int64) error) *MockCompactionPlanContext_cancelCompaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // execCompactionPlan provides a mock function with given fields: signal, plan
 func (_m *MockCompactionPlanContext) execCompactionPlan(signal *compactionSignal, plan *datapb.CompactionPlan) error {
 	ret := _m.Called(signal, plan)
@@ -137,6 +137,65 @@ func (_c *MockManager_AllocSegment_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AllocSegmentInBucket provides a mock function with given fields: ctx, collectionID, partitionID, channelName, bucketID, requestRows
+func (_m *MockManager) AllocSegmentInBucket(ctx context.Context, collectionID int64, partitionID int64, channelName string, bucketID int64, requestRows int64) ([]*Allocation, error) {
+	ret := _m.Called(ctx, collectionID, partitionID, channelName, bucketID, requestRows)
+
+	var r0 []*Allocation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string, int64, int64) ([]*Allocation, error)); ok {
+		return rf(ctx, collectionID, partitionID, channelName, bucketID, requestRows)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int64, string, int64, int64) []*Allocation); ok {
+		r0 = rf(ctx, collectionID, partitionID, channelName, bucketID, requestRows)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*Allocation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int64, string, int64, int64) error); ok {
+		r1 = rf(ctx, collectionID, partitionID, channelName, bucketID, requestRows)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockManager_AllocSegmentInBucket_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AllocSegmentInBucket'
+type MockManager_AllocSegmentInBucket_Call struct {
+	*mock.Call
+}
+
+// AllocSegmentInBucket is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - partitionID int64
+//   - channelName string
+//   - bucketID int64
+//   - requestRows int64
+func (_e *MockManager_Expecter) AllocSegmentInBucket(ctx interface{}, collectionID interface{}, partitionID interface{}, channelName interface{}, bucketID interface{}, requestRows interface{}) *MockManager_AllocSegmentInBucket_Call {
+	return &MockManager_AllocSegmentInBucket_Call{Call: _e.mock.On("AllocSegmentInBucket", ctx, collectionID, partitionID, channelName, bucketID, requestRows)}
+}
+
+func (_c *MockManager_AllocSegmentInBucket_Call) Run(run func(ctx context.Context, collectionID int64, partitionID int64, channelName string, bucketID int64, requestRows int64)) *MockManager_AllocSegmentInBucket_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(int64), args[3].(string), args[4].(int64), args[5].(int64))
+	})
+	return _c
+}
+
+func (_c *MockManager_AllocSegmentInBucket_Call) Return(_a0 []*Allocation, _a1 error) *MockManager_AllocSegmentInBucket_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockManager_AllocSegmentInBucket_Call) RunAndReturn(run func(context.Context, int64, int64, string, int64, int64) ([]*Allocation, error)) *MockManager_AllocSegmentInBucket_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropSegment provides a mock function with given fields: ctx, segmentID
 func (_m *MockManager) DropSegment(ctx context.Context, segmentID int64) {
 	_m.Called(ctx, segmentID)
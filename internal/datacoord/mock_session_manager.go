@@ -386,6 +386,49 @@ func (_c *MockSessionManager_FlushChannels_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// GetCompactionNodeResources provides a mock function with given fields:
+func (_m *MockSessionManager) GetCompactionNodeResources() map[int64]*datapb.CompactionNodeResource {
+	ret := _m.Called()
+
+	var r0 map[int64]*datapb.CompactionNodeResource
+	if rf, ok := ret.Get(0).(func() map[int64]*datapb.CompactionNodeResource); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]*datapb.CompactionNodeResource)
+		}
+	}
+
+	return r0
+}
+
+// MockSessionManager_GetCompactionNodeResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionNodeResources'
+type MockSessionManager_GetCompactionNodeResources_Call struct {
+	*mock.Call
+}
+
+// GetCompactionNodeResources is a helper method to define mock.On call
+func (_e *MockSessionManager_Expecter) GetCompactionNodeResources() *MockSessionManager_GetCompactionNodeResources_Call {
+	return &MockSessionManager_GetCompactionNodeResources_Call{Call: _e.mock.On("GetCompactionNodeResources")}
+}
+
+func (_c *MockSessionManager_GetCompactionNodeResources_Call) Run(run func()) *MockSessionManager_GetCompactionNodeResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockSessionManager_GetCompactionNodeResources_Call) Return(_a0 map[int64]*datapb.CompactionNodeResource) *MockSessionManager_GetCompactionNodeResources_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionManager_GetCompactionNodeResources_Call) RunAndReturn(run func() map[int64]*datapb.CompactionNodeResource) *MockSessionManager_GetCompactionNodeResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCompactionPlansResults provides a mock function with given fields:
 func (_m *MockSessionManager) GetCompactionPlansResults() (map[int64]*typeutil.Pair[int64, *datapb.CompactionPlanResult], error) {
 	ret := _m.Called()
@@ -612,6 +655,50 @@ func (_c *MockSessionManager_NotifyChannelOperation_Call) RunAndReturn(run func(
 	return _c
 }
 
+// PauseChannels provides a mock function with given fields: ctx, nodeID, req
+func (_m *MockSessionManager) PauseChannels(ctx context.Context, nodeID int64, req *datapb.PauseChannelsRequest) error {
+	ret := _m.Called(ctx, nodeID, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *datapb.PauseChannelsRequest) error); ok {
+		r0 = rf(ctx, nodeID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionManager_PauseChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseChannels'
+type MockSessionManager_PauseChannels_Call struct {
+	*mock.Call
+}
+
+// PauseChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+//   - req *datapb.PauseChannelsRequest
+func (_e *MockSessionManager_Expecter) PauseChannels(ctx interface{}, nodeID interface{}, req interface{}) *MockSessionManager_PauseChannels_Call {
+	return &MockSessionManager_PauseChannels_Call{Call: _e.mock.On("PauseChannels", ctx, nodeID, req)}
+}
+
+func (_c *MockSessionManager_PauseChannels_Call) Run(run func(ctx context.Context, nodeID int64, req *datapb.PauseChannelsRequest)) *MockSessionManager_PauseChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*datapb.PauseChannelsRequest))
+	})
+	return _c
+}
+
+func (_c *MockSessionManager_PauseChannels_Call) Return(_a0 error) *MockSessionManager_PauseChannels_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionManager_PauseChannels_Call) RunAndReturn(run func(context.Context, int64, *datapb.PauseChannelsRequest) error) *MockSessionManager_PauseChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PreImport provides a mock function with given fields: nodeID, in
 func (_m *MockSessionManager) PreImport(nodeID int64, in *datapb.PreImportRequest) error {
 	ret := _m.Called(nodeID, in)
@@ -765,6 +852,93 @@ func (_c *MockSessionManager_QueryPreImport_Call) RunAndReturn(run func(int64, *
 	return _c
 }
 
+// SetChannelParallelism provides a mock function with given fields: ctx, nodeID, req
+func (_m *MockSessionManager) SetChannelParallelism(ctx context.Context, nodeID int64, req *datapb.SetChannelParallelismRequest) error {
+	ret := _m.Called(ctx, nodeID, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *datapb.SetChannelParallelismRequest) error); ok {
+		r0 = rf(ctx, nodeID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionManager_SetChannelParallelism_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetChannelParallelism'
+type MockSessionManager_SetChannelParallelism_Call struct {
+	*mock.Call
+}
+
+// SetChannelParallelism is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+//   - req *datapb.SetChannelParallelismRequest
+func (_e *MockSessionManager_Expecter) SetChannelParallelism(ctx interface{}, nodeID interface{}, req interface{}) *MockSessionManager_SetChannelParallelism_Call {
+	return &MockSessionManager_SetChannelParallelism_Call{Call: _e.mock.On("SetChannelParallelism", ctx, nodeID, req)}
+}
+
+func (_c *MockSessionManager_SetChannelParallelism_Call) Run(run func(ctx context.Context, nodeID int64, req *datapb.SetChannelParallelismRequest)) *MockSessionManager_SetChannelParallelism_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*datapb.SetChannelParallelismRequest))
+	})
+	return _c
+}
+
+func (_c *MockSessionManager_SetChannelParallelism_Call) Return(_a0 error) *MockSessionManager_SetChannelParallelism_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionManager_SetChannelParallelism_Call) RunAndReturn(run func(context.Context, int64, *datapb.SetChannelParallelismRequest) error) *MockSessionManager_SetChannelParallelism_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StopCompaction provides a mock function with given fields: nodeID, req
+func (_m *MockSessionManager) StopCompaction(nodeID int64, req *datapb.StopCompactionRequest) error {
+	ret := _m.Called(nodeID, req)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, *datapb.StopCompactionRequest) error); ok {
+		r0 = rf(nodeID, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSessionManager_StopCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopCompaction'
+type MockSessionManager_StopCompaction_Call struct {
+	*mock.Call
+}
+
+// StopCompaction is a helper method to define mock.On call
+//   - nodeID int64
+//   - req *datapb.StopCompactionRequest
+func (_e *MockSessionManager_Expecter) StopCompaction(nodeID interface{}, req interface{}) *MockSessionManager_StopCompaction_Call {
+	return &MockSessionManager_StopCompaction_Call{Call: _e.mock.On("StopCompaction", nodeID, req)}
+}
+
+func (_c *MockSessionManager_StopCompaction_Call) Run(run func(nodeID int64, req *datapb.StopCompactionRequest)) *MockSessionManager_StopCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64), args[1].(*datapb.StopCompactionRequest))
+	})
+	return _c
+}
+
+func (_c *MockSessionManager_StopCompaction_Call) Return(_a0 error) *MockSessionManager_StopCompaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSessionManager_StopCompaction_Call) RunAndReturn(run func(int64, *datapb.StopCompactionRequest) error) *MockSessionManager_StopCompaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SyncSegments provides a mock function with given fields: nodeID, req
 func (_m *MockSessionManager) SyncSegments(nodeID int64, req *datapb.SyncSegmentsRequest) error {
 	ret := _m.Called(nodeID, req)
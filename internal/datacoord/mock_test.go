@@ -287,6 +287,18 @@ func (c *mockDataNodeClient) SyncSegments(ctx context.Context, req *datapb.SyncS
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (c *mockDataNodeClient) StopCompaction(ctx context.Context, req *datapb.StopCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (c *mockDataNodeClient) PauseChannels(ctx context.Context, req *datapb.PauseChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (c *mockDataNodeClient) SetChannelParallelism(ctx context.Context, req *datapb.SetChannelParallelismRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 func (c *mockDataNodeClient) FlushChannels(ctx context.Context, req *datapb.FlushChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
@@ -339,6 +351,50 @@ func (m *mockRootCoordClient) RenameCollection(ctx context.Context, req *milvusp
 	panic("implement me")
 }
 
+func (m *mockRootCoordClient) AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) AlterDatabase(ctx context.Context, req *proxypb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) UndropCollection(ctx context.Context, req *proxypb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) DropCollectionAsync(ctx context.Context, req *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) AlterCollectionAsync(ctx context.Context, req *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) DescribeDDLJob(ctx context.Context, req *proxypb.DescribeDDLJobRequest, opts ...grpc.CallOption) (*proxypb.DescribeDDLJobResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) CreateAPIKey(ctx context.Context, req *proxypb.CreateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) RotateAPIKey(ctx context.Context, req *proxypb.RotateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) RevokeAPIKey(ctx context.Context, req *proxypb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) ListAPIKeys(ctx context.Context, req *proxypb.ListAPIKeysRequest, opts ...grpc.CallOption) (*proxypb.ListAPIKeysResponse, error) {
+	panic("implement me")
+}
+
+func (m *mockRootCoordClient) VerifyAPIKey(ctx context.Context, req *rootcoordpb.VerifyAPIKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.VerifyAPIKeyResponse, error) {
+	panic("implement me")
+}
+
 func (m *mockRootCoordClient) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	panic("implement me")
 }
@@ -620,6 +676,16 @@ func (t *mockCompactionTrigger) forceTriggerCompaction(collectionID int64) (Uniq
 	panic("not implemented")
 }
 
+// triggerCollectionCompaction triggers a compaction of the collection
+func (t *mockCompactionTrigger) triggerCollectionCompaction(collectionID int64) error {
+	if f, ok := t.methods["triggerCollectionCompaction"]; ok {
+		if ff, ok := f.(func(collectionID int64) error); ok {
+			return ff(collectionID)
+		}
+	}
+	panic("not implemented")
+}
+
 func (t *mockCompactionTrigger) start() {
 	if f, ok := t.methods["start"]; ok {
 		if ff, ok := f.(func()); ok {
@@ -0,0 +1,342 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// the manifests of the collections replicated are under <replica root>/replica_meta/<collection ID>
+	replicaManifestPrefix = "replica_meta"
+	replicaSnapshotName   = "replica"
+)
+
+// replicator ships the flushed segments and the meta of the collections to the replica storage periodically,
+// from which the standby cluster in another region promotes the collections once the primary cluster is lost.
+// The binlogs are copied before the manifest referencing them is written, so the manifest is always complete,
+// and the files no longer referenced are removed from the replica storage after the manifest is updated.
+type replicator struct {
+	meta      *meta
+	broker    broker.Broker
+	allocator allocator
+	source    storage.ChunkManager
+	target    storage.ChunkManager
+
+	// collectionID -> the paths in the replica storage referenced by the manifest
+	shipped map[UniqueID]typeutil.Set[string]
+}
+
+func newReplicator(meta *meta, broker broker.Broker, allocator allocator, source, target storage.ChunkManager) *replicator {
+	return &replicator{
+		meta:      meta,
+		broker:    broker,
+		allocator: allocator,
+		source:    source,
+		target:    target,
+		shipped:   make(map[UniqueID]typeutil.Set[string]),
+	}
+}
+
+func (r *replicator) start(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				log.Info("replicator exited")
+				return
+			case <-time.After(Params.DataCoordCfg.ReplicationInterval.GetAsDuration(time.Second)):
+				r.replicate(ctx)
+			}
+		}
+	}()
+}
+
+// replicate ships the changes of all the collections, and removes the replicas of the collections dropped.
+func (r *replicator) replicate(ctx context.Context) {
+	collectionIDs, err := listCollectionIDs(ctx, r.broker)
+	if err != nil {
+		log.Warn("failed to list collections to replicate", zap.Error(err))
+		return
+	}
+	replicated, err := listReplicaManifests(ctx, r.target)
+	if err != nil {
+		log.Warn("failed to list replica manifests", zap.Error(err))
+		return
+	}
+	alive := typeutil.NewUniqueSet(collectionIDs...)
+	for _, collectionID := range collectionIDs {
+		if err := r.replicateCollection(ctx, collectionID); err != nil {
+			log.Warn("failed to replicate collection", zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+	}
+	for collectionID := range replicated {
+		if alive.Contain(collectionID) {
+			continue
+		}
+		if err := r.removeReplica(ctx, collectionID); err != nil {
+			log.Warn("failed to remove replica of collection dropped", zap.Int64("collectionID", collectionID), zap.Error(err))
+		}
+	}
+}
+
+// listCollectionIDs returns the ids of the collections in all the databases.
+func listCollectionIDs(ctx context.Context, broker broker.Broker) ([]UniqueID, error) {
+	dbs, err := broker.ListDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	collectionIDs := make([]UniqueID, 0)
+	for _, dbName := range dbs.GetDbNames() {
+		resp, err := broker.ShowCollections(ctx, dbName)
+		if err != nil {
+			return nil, err
+		}
+		collectionIDs = append(collectionIDs, resp.GetCollectionIds()...)
+	}
+	return collectionIDs, nil
+}
+
+// replicateCollection copies the binlogs of the flushed segments not shipped yet, and then writes the manifest of the collection.
+func (r *replicator) replicateCollection(ctx context.Context, collectionID UniqueID) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	manifest, err := newCollectionManifest(ctx, r.meta, r.broker, r.allocator, collectionID, replicaSnapshotName)
+	if err != nil {
+		return err
+	}
+	snapshot := manifest.GetSnapshot()
+	// the index files aren't shipped, the indexes are built in the replica cluster once promoted
+	snapshot.SegmentIndexes = nil
+
+	shipped, ok := r.shipped[collectionID]
+	if !ok {
+		// the files referenced by the manifest written before restarting are shipped
+		shipped, err = r.loadShipped(ctx, collectionID)
+		if err != nil {
+			return err
+		}
+		r.shipped[collectionID] = shipped
+	}
+	referenced, err := shipLogs(ctx, r.source, r.target, snapshot, r.target.RootPath(), shipped)
+	if err != nil {
+		return err
+	}
+
+	bytes, err := proto.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	if err := r.target.Write(ctx, replicaManifestPath(r.target.RootPath(), collectionID), bytes); err != nil {
+		return err
+	}
+
+	// the files of the segments compacted or dropped are no longer referenced
+	stale := shipped.Complement(referenced).Collect()
+	if err := r.target.MultiRemove(ctx, stale); err != nil {
+		log.Warn("failed to remove stale replica files", zap.Error(err))
+	} else {
+		r.shipped[collectionID] = referenced
+	}
+
+	var oldest uint64
+	for _, cp := range snapshot.GetCheckpoints() {
+		if oldest == 0 || cp.GetTimestamp() < oldest {
+			oldest = cp.GetTimestamp()
+		}
+	}
+	if oldest != 0 {
+		metrics.DataCoordReplicationLagSeconds.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(collectionID)).
+			Set(time.Since(tsoutil.PhysicalTime(oldest)).Seconds())
+	}
+	log.Info("collection replicated", zap.Int("segmentNum", len(snapshot.GetSegments())), zap.Int("staleFiles", len(stale)))
+	return nil
+}
+
+// newCollectionManifest records the meta of the collection with the snapshot of its flushed segments.
+func newCollectionManifest(ctx context.Context, meta *meta, broker broker.Broker, allocator allocator, collectionID UniqueID, name string) (*datapb.ReplicaManifest, error) {
+	coll, err := broker.DescribeCollectionInternal(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	partitions, err := broker.ShowPartitions(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := newCollectionSnapshot(ctx, meta, broker, allocator, collectionID, name)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &datapb.ReplicaManifest{
+		Collection:     coll,
+		PartitionNames: partitions.GetPartitionNames(),
+		PartitionIDs:   partitions.GetPartitionIDs(),
+		Snapshot:       snapshot,
+	}
+	for _, index := range meta.indexMeta.GetIndexesForCollection(collectionID, "") {
+		manifest.Indexes = append(manifest.Indexes, &indexpb.IndexInfo{
+			CollectionID:    index.CollectionID,
+			FieldID:         index.FieldID,
+			IndexName:       index.IndexName,
+			IndexID:         index.IndexID,
+			TypeParams:      index.TypeParams,
+			IndexParams:     index.IndexParams,
+			IsAutoIndex:     index.IsAutoIndex,
+			UserIndexParams: index.UserIndexParams,
+		})
+	}
+	return manifest, nil
+}
+
+// shipLogs copies the binlogs of the snapshot not shipped yet under the target root path, keeping the paths relative to the root path,
+// the log paths of the snapshot are rewritten to the copies, and the paths of the copies are returned.
+func shipLogs(ctx context.Context, source, target storage.ChunkManager, snapshot *datapb.CollectionSnapshot,
+	targetRootPath string, shipped typeutil.Set[string],
+) (typeutil.Set[string], error) {
+	referenced := typeutil.NewSet[string]()
+	for _, segment := range snapshot.GetSegments() {
+		for _, l := range getLogs(NewSegmentInfo(segment)) {
+			targetPath := rebaseReplicaPath(l.GetLogPath(), source.RootPath(), targetRootPath)
+			if !shipped.Contain(targetPath) {
+				data, err := source.Read(ctx, l.GetLogPath())
+				if err != nil {
+					return nil, err
+				}
+				if err := target.Write(ctx, targetPath, data); err != nil {
+					return nil, err
+				}
+				shipped.Insert(targetPath)
+			}
+			referenced.Insert(targetPath)
+			l.LogPath = targetPath
+		}
+	}
+	return referenced, nil
+}
+
+func (r *replicator) loadShipped(ctx context.Context, collectionID UniqueID) (typeutil.Set[string], error) {
+	shipped := typeutil.NewSet[string]()
+	manifest, err := readReplicaManifest(ctx, r.target, collectionID)
+	if err != nil {
+		if errors.Is(err, merr.ErrIoKeyNotFound) {
+			return shipped, nil
+		}
+		return nil, err
+	}
+	for _, segment := range manifest.GetSnapshot().GetSegments() {
+		for _, l := range getLogs(NewSegmentInfo(segment)) {
+			shipped.Insert(l.GetLogPath())
+		}
+	}
+	return shipped, nil
+}
+
+// removeReplica removes the files referenced by the manifest of the collection dropped, and then the manifest.
+func (r *replicator) removeReplica(ctx context.Context, collectionID UniqueID) error {
+	shipped, err := r.loadShipped(ctx, collectionID)
+	if err != nil {
+		return err
+	}
+	if err := r.target.MultiRemove(ctx, shipped.Collect()); err != nil {
+		return err
+	}
+	if err := r.target.Remove(ctx, replicaManifestPath(r.target.RootPath(), collectionID)); err != nil {
+		return err
+	}
+	delete(r.shipped, collectionID)
+	metrics.DataCoordReplicationLagSeconds.DeleteLabelValues(fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(collectionID))
+	log.Info("replica of collection dropped removed", zap.Int64("collectionID", collectionID), zap.Int("fileNum", shipped.Len()))
+	return nil
+}
+
+func replicaManifestPath(rootPath string, collectionID UniqueID) string {
+	return path.Join(rootPath, replicaManifestPrefix, strconv.FormatInt(collectionID, 10))
+}
+
+// rebaseReplicaPath returns the path of the file in the replica storage, which keeps the path relative to the root path.
+func rebaseReplicaPath(filePath, rootPath, replicaRootPath string) string {
+	if rootPath != "" && strings.HasPrefix(filePath, rootPath+"/") {
+		filePath = strings.TrimPrefix(filePath, rootPath+"/")
+	}
+	return path.Join(replicaRootPath, filePath)
+}
+
+func readReplicaManifest(ctx context.Context, cm storage.ChunkManager, collectionID UniqueID) (*datapb.ReplicaManifest, error) {
+	return readManifest(ctx, cm, replicaManifestPath(cm.RootPath(), collectionID))
+}
+
+func readManifest(ctx context.Context, cm storage.ChunkManager, filePath string) (*datapb.ReplicaManifest, error) {
+	bytes, err := cm.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &datapb.ReplicaManifest{}
+	if err := proto.Unmarshal(bytes, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// newReplicaInfo summarizes the manifest.
+func newReplicaInfo(manifest *datapb.ReplicaManifest) *datapb.ReplicaInfo {
+	return &datapb.ReplicaInfo{
+		Collection:     manifest.GetCollection(),
+		PartitionNames: manifest.GetPartitionNames(),
+		PartitionIDs:   manifest.GetPartitionIDs(),
+		Indexes:        manifest.GetIndexes(),
+		Snapshot:       newSnapshotInfo(manifest.GetSnapshot()),
+	}
+}
+
+// listReplicaManifests returns the ids of the collections replicated into the replica storage.
+func listReplicaManifests(ctx context.Context, cm storage.ChunkManager) (typeutil.UniqueSet, error) {
+	files, _, err := cm.ListWithPrefix(ctx, path.Join(cm.RootPath(), replicaManifestPrefix)+"/", true)
+	if err != nil {
+		return nil, err
+	}
+	collectionIDs := typeutil.NewUniqueSet()
+	for _, file := range files {
+		collectionID, err := strconv.ParseInt(path.Base(file), 10, 64)
+		if err != nil {
+			continue
+		}
+		collectionIDs.Insert(collectionID)
+	}
+	return collectionIDs, nil
+}
@@ -0,0 +1,222 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	broker2 "github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type ReplicatorSuite struct {
+	suite.Suite
+
+	meta       *meta
+	broker     *broker2.MockBroker
+	alloc      *NMockAllocator
+	source     storage.ChunkManager
+	target     storage.ChunkManager
+	replicator *replicator
+}
+
+func (s *ReplicatorSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *ReplicatorSuite) SetupTest() {
+	s.source = storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+	s.target = storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+
+	s.meta = &meta{
+		ctx:         context.Background(),
+		collections: make(map[UniqueID]*collectionInfo),
+		segments:    NewSegmentsInfo(),
+		channelCPs:  newChannelCps(),
+		indexMeta: &indexMeta{
+			indexes: map[UniqueID]map[UniqueID]*model.Index{
+				100: {1: {CollectionID: 100, FieldID: 101, IndexID: 1, IndexName: "vector_index"}},
+			},
+		},
+	}
+	s.meta.channelCPs.checkpoints["ch-1"] = &msgpb.MsgPosition{ChannelName: "ch-1", Timestamp: 1000}
+	s.addSegment(1)
+
+	s.broker = broker2.NewMockBroker(s.T())
+	s.broker.EXPECT().DescribeCollectionInternal(mock.Anything, int64(100)).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionID:        100,
+		CollectionName:      "test_collection",
+		VirtualChannelNames: []string{"ch-1"},
+	}, nil).Maybe()
+	s.broker.EXPECT().ShowPartitions(mock.Anything, int64(100)).Return(&milvuspb.ShowPartitionsResponse{
+		PartitionNames: []string{"_default"},
+		PartitionIDs:   []int64{10},
+	}, nil).Maybe()
+	s.alloc = NewNMockAllocator(s.T())
+	s.alloc.EXPECT().allocTimestamp(mock.Anything).Return(2000, nil).Maybe()
+	s.replicator = newReplicator(s.meta, s.broker, s.alloc, s.source, s.target)
+}
+
+func (s *ReplicatorSuite) addSegment(id UniqueID) string {
+	logPath := path.Join(s.source.RootPath(), "insert_log", "100", "10", strconv.FormatInt(id, 10), "101", "1")
+	s.Require().NoError(s.source.Write(context.Background(), logPath, []byte{byte(id)}))
+	s.meta.segments.SetSegment(id, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  100,
+		PartitionID:   10,
+		InsertChannel: "ch-1",
+		State:         commonpb.SegmentState_Flushed,
+		NumOfRows:     100,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{
+			{LogID: 1, LogPath: logPath},
+		}}},
+	}))
+	return logPath
+}
+
+func (s *ReplicatorSuite) replicaPath(id UniqueID) string {
+	return path.Join(s.target.RootPath(), "insert_log", "100", "10", strconv.FormatInt(id, 10), "101", "1")
+}
+
+func (s *ReplicatorSuite) TestReplicateCollection() {
+	ctx := context.Background()
+	s.NoError(s.replicator.replicateCollection(ctx, 100))
+
+	data, err := s.target.Read(ctx, s.replicaPath(1))
+	s.NoError(err)
+	s.Equal([]byte{1}, data)
+
+	manifest, err := readReplicaManifest(ctx, s.target, 100)
+	s.Require().NoError(err)
+	s.Equal("test_collection", manifest.GetCollection().GetCollectionName())
+	s.Equal([]string{"_default"}, manifest.GetPartitionNames())
+	s.Require().Len(manifest.GetIndexes(), 1)
+	s.Equal("vector_index", manifest.GetIndexes()[0].GetIndexName())
+	s.Require().Len(manifest.GetSnapshot().GetSegments(), 1)
+	// log paths point at the replica storage
+	s.Equal(s.replicaPath(1), manifest.GetSnapshot().GetSegments()[0].GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+	// the meta of the primary cluster untouched
+	s.NotEqual(s.replicaPath(1), s.meta.GetSegment(1).GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+
+	// segment 1 compacted into segment 2, the files of segment 1 removed
+	s.meta.segments.DropSegment(1)
+	s.addSegment(2)
+	s.NoError(s.replicator.replicateCollection(ctx, 100))
+	exist, err := s.target.Exist(ctx, s.replicaPath(1))
+	s.NoError(err)
+	s.False(exist)
+	exist, err = s.target.Exist(ctx, s.replicaPath(2))
+	s.NoError(err)
+	s.True(exist)
+
+	// the files shipped are loaded from the manifest after restarting
+	restarted := newReplicator(s.meta, s.broker, s.alloc, s.source, s.target)
+	s.NoError(s.source.Remove(ctx, s.meta.GetSegment(2).GetBinlogs()[0].GetBinlogs()[0].GetLogPath()))
+	s.NoError(restarted.replicateCollection(ctx, 100))
+	s.True(restarted.shipped[100].Contain(s.replicaPath(2)))
+}
+
+func (s *ReplicatorSuite) TestReplicateCollectionDropped() {
+	ctx := context.Background()
+	s.NoError(s.replicator.replicateCollection(ctx, 100))
+
+	s.broker.EXPECT().ListDatabases(mock.Anything).Return(&milvuspb.ListDatabasesResponse{DbNames: []string{"default"}}, nil)
+	s.broker.EXPECT().ShowCollections(mock.Anything, "default").Return(&milvuspb.ShowCollectionsResponse{}, nil)
+	s.replicator.replicate(ctx)
+
+	_, err := readReplicaManifest(ctx, s.target, 100)
+	s.ErrorIs(err, merr.ErrIoKeyNotFound)
+	exist, err := s.target.Exist(ctx, s.replicaPath(1))
+	s.NoError(err)
+	s.False(exist)
+	s.NotContains(s.replicator.shipped, int64(100))
+}
+
+func (s *ReplicatorSuite) TestListAndPromote() {
+	ctx := context.Background()
+	s.NoError(s.replicator.replicateCollection(ctx, 100))
+
+	server := &Server{meta: s.meta, broker: s.broker, allocator: s.alloc}
+	server.stateCode.Store(commonpb.StateCode_Healthy)
+
+	s.Run("storage not configured", func() {
+		resp, err := server.ListReplicas(ctx, &datapb.ListReplicasRequest{})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+		promoted, err := server.PromoteReplica(ctx, &datapb.PromoteReplicaRequest{CollectionID: 100, TargetCollectionID: 200})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(promoted.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	server.replicaStorage = s.target
+	s.Run("list", func() {
+		resp, err := server.ListReplicas(ctx, &datapb.ListReplicasRequest{})
+		s.NoError(err)
+		s.NoError(merr.Error(resp.GetStatus()))
+		s.Require().Len(resp.GetReplicas(), 1)
+		s.EqualValues(100, resp.GetReplicas()[0].GetCollection().GetCollectionID())
+		s.EqualValues(1, resp.GetReplicas()[0].GetSnapshot().GetNumSegments())
+		s.EqualValues(100, resp.GetReplicas()[0].GetSnapshot().GetNumRows())
+	})
+
+	s.Run("promote", func() {
+		paramtable.Get().Save(Params.DataCoordCfg.ReplicationBucketName.Key, Params.MinioCfg.BucketName.GetValue())
+		defer paramtable.Get().Reset(Params.DataCoordCfg.ReplicationBucketName.Key)
+
+		s.broker.EXPECT().DescribeCollectionInternal(mock.Anything, int64(200)).Return(&milvuspb.DescribeCollectionResponse{
+			CollectionID:        200,
+			VirtualChannelNames: []string{"dml_1_200v0"},
+			StartPositions:      []*commonpb.KeyDataPair{{Key: "dml_1", Data: []byte{2}}},
+			CreatedTimestamp:    3000,
+		}, nil)
+		s.broker.EXPECT().ShowPartitionsInternal(mock.Anything, int64(200)).Return([]int64{20}, nil)
+		catalog := s.meta.catalog
+		defer func() { s.meta.catalog = catalog }()
+		mockCatalog := mocks.NewDataCoordCatalog(s.T())
+		mockCatalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil).Once()
+		s.meta.catalog = mockCatalog
+		s.alloc.EXPECT().allocN(int64(1)).Return(1000, 1001, nil).Once()
+
+		resp, err := server.PromoteReplica(ctx, &datapb.PromoteReplicaRequest{
+			CollectionID: 100, TargetCollectionID: 200, PartitionMapping: map[int64]int64{10: 20},
+		})
+		s.NoError(err)
+		s.NoError(merr.Error(resp.GetStatus()))
+		s.Equal([]int64{1000}, resp.GetSegmentIDs())
+		// the promoted segment shares the binlogs in the replica storage
+		s.Equal(s.replicaPath(1), s.meta.GetSegment(1000).GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+	})
+}
+
+func TestReplicator(t *testing.T) {
+	suite.Run(t, new(ReplicatorSuite))
+}
@@ -75,6 +75,8 @@ type Manager interface {
 
 	// AllocSegment allocates rows and record the allocation.
 	AllocSegment(ctx context.Context, collectionID, partitionID UniqueID, channelName string, requestRows int64) ([]*Allocation, error)
+	// AllocSegmentInBucket allocates rows in the segments of the partition key bucket and record the allocation.
+	AllocSegmentInBucket(ctx context.Context, collectionID, partitionID UniqueID, channelName string, bucketID int64, requestRows int64) ([]*Allocation, error)
 	AllocImportSegment(ctx context.Context, taskID int64, collectionID UniqueID, partitionID UniqueID, channelName string) (*SegmentInfo, error)
 	// DropSegment drops the segment from manager.
 	DropSegment(ctx context.Context, segmentID UniqueID)
@@ -265,11 +267,20 @@ func (s *SegmentManager) maybeResetLastExpireForSegments() error {
 // AllocSegment allocate segment per request collcation, partication, channel and rows
 func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID,
 	partitionID UniqueID, channelName string, requestRows int64,
+) ([]*Allocation, error) {
+	return s.AllocSegmentInBucket(ctx, collectionID, partitionID, channelName, 0, requestRows)
+}
+
+// AllocSegmentInBucket allocate segment per request collcation, partication, channel, partition key bucket and rows,
+// the rows of different buckets are never allocated in the same segment.
+func (s *SegmentManager) AllocSegmentInBucket(ctx context.Context, collectionID UniqueID,
+	partitionID UniqueID, channelName string, bucketID int64, requestRows int64,
 ) ([]*Allocation, error) {
 	log := log.Ctx(ctx).
 		With(zap.Int64("collectionID", collectionID)).
 		With(zap.Int64("partitionID", partitionID)).
 		With(zap.String("channelName", channelName)).
+		With(zap.Int64("bucketID", bucketID)).
 		With(zap.Int64("requestRows", requestRows))
 	_, sp := otel.Tracer(typeutil.DataCoordRole).Start(ctx, "Alloc-Segment")
 	defer sp.End()
@@ -284,7 +295,8 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 			log.Warn("Failed to get segment info from meta", zap.Int64("id", segmentID))
 			continue
 		}
-		if !satisfy(segment, collectionID, partitionID, channelName) || !isGrowing(segment) || segment.GetLevel() == datapb.SegmentLevel_L0 {
+		if !satisfy(segment, collectionID, partitionID, channelName) || segment.GetBucketID() != bucketID ||
+			!isGrowing(segment) || segment.GetLevel() == datapb.SegmentLevel_L0 {
 			continue
 		}
 		segments = append(segments, segment)
@@ -304,7 +316,7 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 		return nil, err
 	}
 	for _, allocation := range newSegmentAllocations {
-		segment, err := s.openNewSegment(ctx, collectionID, partitionID, channelName, commonpb.SegmentState_Growing, datapb.SegmentLevel_L1)
+		segment, err := s.openNewSegment(ctx, collectionID, partitionID, channelName, bucketID, commonpb.SegmentState_Growing, datapb.SegmentLevel_L1)
 		if err != nil {
 			log.Error("Failed to open new segment for segment allocation")
 			return nil, err
@@ -401,7 +413,7 @@ func (s *SegmentManager) AllocImportSegment(ctx context.Context, taskID int64, c
 }
 
 func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID UniqueID, partitionID UniqueID,
-	channelName string, segmentState commonpb.SegmentState, level datapb.SegmentLevel,
+	channelName string, bucketID int64, segmentState commonpb.SegmentState, level datapb.SegmentLevel,
 ) (*SegmentInfo, error) {
 	log := log.Ctx(ctx)
 	ctx, sp := otel.Tracer(typeutil.DataCoordRole).Start(ctx, "open-Segment")
@@ -427,6 +439,7 @@ func (s *SegmentManager) openNewSegment(ctx context.Context, collectionID Unique
 		MaxRowNum:      int64(maxNumOfRows),
 		Level:          level,
 		LastExpireTime: 0,
+		BucketID:       bucketID,
 	}
 	segment := NewSegmentInfo(segmentInfo)
 	if err := s.meta.AddSegment(ctx, segment); err != nil {
@@ -122,6 +122,26 @@ func TestAllocSegment(t *testing.T) {
 		assert.NotEqualValues(t, 0, allocations[0].ExpireTime)
 	})
 
+	t.Run("allocation in bucket", func(t *testing.T) {
+		allocations, err := segmentManager.AllocSegmentInBucket(ctx, collID, 100, "c1", 2, 100)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, len(allocations))
+		segment := meta.GetHealthySegment(allocations[0].SegmentID)
+		assert.NotNil(t, segment)
+		assert.EqualValues(t, 2, segment.GetBucketID())
+
+		// segments of the other buckets are not reused
+		other, err := segmentManager.AllocSegment(ctx, collID, 100, "c1", 100)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, len(other))
+		assert.NotEqual(t, allocations[0].SegmentID, other[0].SegmentID)
+
+		same, err := segmentManager.AllocSegmentInBucket(ctx, collID, 100, "c1", 2, 100)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, len(same))
+		assert.Equal(t, allocations[0].SegmentID, same[0].SegmentID)
+	})
+
 	t.Run("allocation fails 1", func(t *testing.T) {
 		failsAllocator := &FailsAllocator{
 			allocTsSucceed: true,
@@ -45,12 +45,16 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/cdc"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kafka"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/tiered"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/expr"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -125,6 +129,13 @@ type Server struct {
 	importMeta       ImportMeta
 	importScheduler  ImportScheduler
 	importChecker    ImportChecker
+	cpMonitor        *channelCheckpointMonitor
+	replicaStorage   storage.ChunkManager
+	replicator       *replicator
+	walOffloader     *tiered.Offloader
+	cdcPublisher     *cdc.Publisher
+	// serializes the creation and the dropping of the backups
+	backupLock sync.Mutex
 
 	compactionTrigger     trigger
 	compactionHandler     compactionPlanContext
@@ -348,6 +359,18 @@ func (s *Server) initDataCoord() error {
 	}
 
 	s.handler = newServerHandler(s)
+	// the files of the collections placed in their own buckets are routed by the collection properties,
+	// the dropped collections not described are regarded as in the cluster bucket.
+	storage.SetCollectionPropertiesResolver(func(ctx context.Context, collectionID int64) ([]*commonpb.KeyValuePair, error) {
+		coll, err := s.handler.GetCollection(ctx, collectionID)
+		if err != nil && !errors.Is(err, merr.ErrCollectionNotFound) {
+			return nil, err
+		}
+		if coll == nil {
+			return nil, nil
+		}
+		return funcutil.Map2KeyValuePair(coll.Properties), nil
+	})
 
 	// check whether old node exist, if yes suspend auto balance until all old nodes down
 	s.updateBalanceConfigLoop(s.ctx)
@@ -379,13 +402,23 @@ func (s *Server) initDataCoord() error {
 
 	s.initGarbageCollection(storageCli)
 	s.initIndexBuilder(storageCli)
+	if err = s.initReplication(storageCli); err != nil {
+		return err
+	}
+	if err = s.initWALOffloader(storageCli); err != nil {
+		return err
+	}
+	if err = s.initCDC(); err != nil {
+		return err
+	}
 
 	s.importMeta, err = NewImportMeta(s.meta.catalog)
 	if err != nil {
 		return err
 	}
 	s.importScheduler = NewImportScheduler(s.meta, s.cluster, s.allocator, s.importMeta, s.buildIndexCh)
-	s.importChecker = NewImportChecker(s.meta, s.broker, s.cluster, s.allocator, s.segmentManager, s.importMeta)
+	s.importChecker = NewImportChecker(s.meta, s.broker, s.cluster, s.allocator, s.segmentManager, s.importMeta, s.compactionTrigger)
+	s.cpMonitor = newChannelCheckpointMonitor(s.meta, s.channelManager.GetCollectionIDByChannel)
 
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
 
@@ -468,7 +501,7 @@ func (s *Server) initCluster() error {
 
 	var err error
 	s.channelManager, err = NewChannelManager(s.watchClient, s.handler, withMsgstreamFactory(s.factory),
-		withStateChecker(), withBgChecker())
+		withStateChecker(), withBgChecker(), withChannelGroups(s.getDataNodeChannelGroup))
 	if err != nil {
 		return err
 	}
@@ -477,6 +510,16 @@ func (s *Server) initCluster() error {
 	return nil
 }
 
+// getDataNodeChannelGroup returns the channel group declared by the datanode.
+func (s *Server) getDataNodeChannelGroup(nodeID int64) string {
+	for _, session := range s.sessionManager.GetSessions() {
+		if session.info.NodeID == nodeID {
+			return session.info.ChannelGroup
+		}
+	}
+	return defaultChannelGroup
+}
+
 func (s *Server) SetAddress(address string) {
 	s.address = address
 }
@@ -539,9 +582,104 @@ func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
 		scanInterval:     Params.DataCoordCfg.GCScanIntervalInHour.GetAsDuration(time.Hour),
 		missingTolerance: Params.DataCoordCfg.GCMissingTolerance.GetAsDuration(time.Second),
 		dropTolerance:    Params.DataCoordCfg.GCDropTolerance.GetAsDuration(time.Second),
+		restoreWindow:    Params.DataCoordCfg.GCRestoreWindow.GetAsDuration(time.Second),
 	})
 }
 
+// initReplication creates the chunk manager of the replica storage if configured, which the primary cluster ships
+// the collections to if the replication enabled, and the standby cluster promotes the collections from.
+func (s *Server) initReplication(cli storage.ChunkManager) error {
+	if Params.DataCoordCfg.ReplicationBucketName.GetValue() == "" {
+		return nil
+	}
+	replicaStorage, err := storage.NewChunkManagerFactoryWithParam(Params).NewStorageChunkManager(s.ctx, common.CollectionStorage{
+		Address:         Params.DataCoordCfg.ReplicationAddress.GetValue(),
+		BucketName:      Params.DataCoordCfg.ReplicationBucketName.GetValue(),
+		RootPath:        Params.DataCoordCfg.ReplicationRootPath.GetValue(),
+		AccessKeyID:     Params.DataCoordCfg.ReplicationAccessKeyID.GetValue(),
+		SecretAccessKey: Params.DataCoordCfg.ReplicationSecretAccessKey.GetValue(),
+	})
+	if err != nil {
+		log.Error("replica storage chunk manager init failed", zap.Error(err))
+		return err
+	}
+	s.replicaStorage = replicaStorage
+	if Params.DataCoordCfg.ReplicationEnabled.GetAsBool() {
+		s.replicator = newReplicator(s.meta, s.broker, s.allocator, cli, replicaStorage)
+	}
+	log.Info("init replication done", zap.Bool("enabled", s.replicator != nil))
+	return nil
+}
+
+// initWALOffloader creates the offloader of the physical dml channels if the mq tiering enabled,
+// which offloads the messages acknowledged to the object storage for the consumers catching up.
+func (s *Server) initWALOffloader(cli storage.ChunkManager) error {
+	if !Params.MQCfg.TieringEnabled.GetAsBool() {
+		return nil
+	}
+	clientFactory, ok := s.factory.(msgstream.ClientFactory)
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("mq tiering is not supported by the mq")
+	}
+	client, err := clientFactory.NewClient(s.ctx)
+	if err != nil {
+		log.Error("failed to create mq client of wal offloader", zap.Error(err))
+		return err
+	}
+	pchannels := dmlPChannels()
+	s.walOffloader = tiered.NewOffloader(client, cli, pchannels, Params.CommonCfg.DataCoordSubName.GetValue()+"-wal-offloader")
+	log.Info("init wal offloader done", zap.Strings("pchannels", pchannels))
+	return nil
+}
+
+// initCDC creates the publisher of the changes of the collections with cdc enabled if the cdc enabled,
+// the checkpoints of the publisher are kept in the meta.
+func (s *Server) initCDC() error {
+	if !Params.DataCoordCfg.CDCEnabled.GetAsBool() {
+		return nil
+	}
+	var sink cdc.Sink
+	switch Params.DataCoordCfg.CDCSink.GetValue() {
+	case cdc.SinkKafka:
+		address := Params.DataCoordCfg.CDCKafkaAddress.GetValue()
+		if address == "" {
+			return merr.WrapErrParameterInvalidMsg("the address of the cdc kafka sink is not set")
+		}
+		sink = cdc.NewMQSink(kafka.NewKafkaClientInstance(address), Params.DataCoordCfg.CDCKafkaTopicPrefix.GetValue())
+	case cdc.SinkWebhook:
+		url := Params.DataCoordCfg.CDCWebhookURL.GetValue()
+		if url == "" {
+			return merr.WrapErrParameterInvalidMsg("the url of the cdc webhook sink is not set")
+		}
+		sink = cdc.NewWebhookSink(url, Params.DataCoordCfg.CDCWebhookTimeout.GetAsDuration(time.Second))
+	default:
+		return merr.WrapErrParameterInvalidMsg("unknown cdc sink %s", Params.DataCoordCfg.CDCSink.GetValue())
+	}
+	filter := func(ctx context.Context, collectionID int64) bool {
+		coll, err := s.handler.GetCollection(ctx, collectionID)
+		if err != nil || coll == nil {
+			return false
+		}
+		return common.IsCollectionCDCEnabled(funcutil.Map2KeyValuePair(coll.Properties)...)
+	}
+	pchannels := dmlPChannels()
+	s.cdcPublisher = cdc.NewPublisher(s.factory, s.kv, sink, pchannels, Params.CommonCfg.DataCoordSubName.GetValue()+"-cdc", filter)
+	log.Info("init cdc publisher done", zap.String("sink", sink.Name()), zap.Strings("pchannels", pchannels))
+	return nil
+}
+
+// dmlPChannels returns the physical dml channels of the cluster.
+func dmlPChannels() []string {
+	if Params.CommonCfg.PreCreatedTopicEnabled.GetAsBool() {
+		return Params.CommonCfg.TopicNames.GetAsStrings()
+	}
+	pchannels := make([]string, 0, Params.RootCoordCfg.DmlChannelNum.GetAsInt())
+	for i := 0; i < Params.RootCoordCfg.DmlChannelNum.GetAsInt(); i++ {
+		pchannels = append(pchannels, fmt.Sprintf("%s_%d", Params.CommonCfg.RootCoordDml.GetValue(), i))
+	}
+	return pchannels
+}
+
 func (s *Server) initServiceDiscovery() error {
 	r := semver.MustParseRange(">=2.2.3")
 	sessions, rev, err := s.session.GetSessionsWithVersionRange(typeutil.DataNodeRole, r)
@@ -554,8 +692,9 @@ func (s *Server) initServiceDiscovery() error {
 	datanodes := make([]*NodeInfo, 0, len(sessions))
 	for _, session := range sessions {
 		info := &NodeInfo{
-			NodeID:  session.ServerID,
-			Address: session.Address,
+			NodeID:       session.ServerID,
+			Address:      session.Address,
+			ChannelGroup: session.ChannelGroup,
 		}
 		datanodes = append(datanodes, info)
 	}
@@ -651,7 +790,7 @@ func (s *Server) initMeta(chunkManager storage.ChunkManager) error {
 
 func (s *Server) initIndexBuilder(manager storage.ChunkManager) {
 	if s.indexBuilder == nil {
-		s.indexBuilder = newIndexBuilder(s.ctx, s.meta, s.indexNodeManager, manager, s.indexEngineVersionManager, s.handler)
+		s.indexBuilder = newIndexBuilder(s.ctx, s.meta, s.indexNodeManager, manager, s.indexEngineVersionManager, s.handler, s.allocator)
 	}
 }
 
@@ -672,6 +811,16 @@ func (s *Server) startServerLoop() {
 	s.startIndexService(s.serverLoopCtx)
 	go s.importScheduler.Start()
 	go s.importChecker.Start()
+	s.cpMonitor.start(s.serverLoopCtx, &s.serverLoopWg)
+	if s.replicator != nil {
+		s.replicator.start(s.serverLoopCtx, &s.serverLoopWg)
+	}
+	if s.walOffloader != nil {
+		s.walOffloader.Start()
+	}
+	if s.cdcPublisher != nil {
+		s.cdcPublisher.Start()
+	}
 	s.garbageCollector.start()
 }
 
@@ -934,8 +1083,9 @@ func (s *Server) handleSessionEvent(ctx context.Context, role string, event *ses
 			Channels: []*datapb.ChannelStatus{},
 		}
 		node := &NodeInfo{
-			NodeID:  event.Session.ServerID,
-			Address: event.Session.Address,
+			NodeID:       event.Session.ServerID,
+			Address:      event.Session.Address,
+			ChannelGroup: event.Session.ChannelGroup,
 		}
 		switch event.EventType {
 		case sessionutil.SessionAddEvent:
@@ -1116,6 +1266,12 @@ func (s *Server) Stop() error {
 	logutil.Logger(s.ctx).Info("datacoord garbage collector stopped")
 
 	s.stopServerLoop()
+	if s.walOffloader != nil {
+		s.walOffloader.Stop()
+	}
+	if s.cdcPublisher != nil {
+		s.cdcPublisher.Stop()
+	}
 
 	s.importScheduler.Close()
 	s.importChecker.Close()
@@ -991,6 +991,11 @@ func (s *spySegmentManager) AllocSegment(ctx context.Context, collectionID Uniqu
 	panic("not implemented") // TODO: Implement
 }
 
+// AllocSegmentInBucket allocates rows in the segments of the partition key bucket and record the allocation.
+func (s *spySegmentManager) AllocSegmentInBucket(ctx context.Context, collectionID UniqueID, partitionID UniqueID, channelName string, bucketID int64, requestRows int64) ([]*Allocation, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func (s *spySegmentManager) allocSegmentForImport(ctx context.Context, collectionID UniqueID, partitionID UniqueID, channelName string, requestRows int64, taskID int64) (*Allocation, error) {
 	panic("not implemented") // TODO: Implement
 }
@@ -3836,3 +3841,23 @@ func TestUpdateAutoBalanceConfigLoop(t *testing.T) {
 		wg.Wait()
 	})
 }
+
+func TestServer_initCDC(t *testing.T) {
+	server := &Server{}
+	assert.NoError(t, server.initCDC())
+	assert.Nil(t, server.cdcPublisher)
+
+	Params.Save(Params.DataCoordCfg.CDCEnabled.Key, "true")
+	defer Params.Reset(Params.DataCoordCfg.CDCEnabled.Key)
+	Params.Save(Params.DataCoordCfg.CDCSink.Key, "webhook")
+	defer Params.Reset(Params.DataCoordCfg.CDCSink.Key)
+	assert.ErrorIs(t, server.initCDC(), merr.ErrParameterInvalid)
+
+	Params.Save(Params.DataCoordCfg.CDCWebhookURL.Key, "http://localhost:8080/cdc")
+	defer Params.Reset(Params.DataCoordCfg.CDCWebhookURL.Key)
+	assert.NoError(t, server.initCDC())
+	assert.NotNil(t, server.cdcPublisher)
+
+	Params.Save(Params.DataCoordCfg.CDCSink.Key, "unknown")
+	assert.ErrorIs(t, server.initCDC(), merr.ErrParameterInvalid)
+}
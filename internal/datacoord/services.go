@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -193,8 +195,8 @@ func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentI
 		s.cluster.Watch(ctx, r.ChannelName, r.CollectionID)
 
 		// Have segment manager allocate and return the segment allocation info.
-		segmentAllocations, err := s.segmentManager.AllocSegment(ctx,
-			r.CollectionID, r.PartitionID, r.ChannelName, int64(r.Count))
+		segmentAllocations, err := s.segmentManager.AllocSegmentInBucket(ctx,
+			r.CollectionID, r.PartitionID, r.ChannelName, r.GetBucketID(), int64(r.Count))
 		if err != nil {
 			log.Warn("failed to alloc segment", zap.Any("request", r), zap.Error(err))
 			continue
@@ -211,6 +213,7 @@ func (s *Server) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentI
 				PartitionID:  r.PartitionID,
 				ExpireTime:   allocation.ExpireTime,
 				Status:       merr.Success(),
+				BucketID:     r.GetBucketID(),
 			}
 			assigns = append(assigns, result)
 		}
@@ -390,6 +393,7 @@ func (s *Server) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoR
 				clonedInfo.DmlPosition = clonedChild.GetDmlPosition()
 			}
 			segmentutil.ReCalcRowCount(info.SegmentInfo, clonedInfo.SegmentInfo)
+			clonedInfo.FlushedRanges = getFlushedRanges(clonedInfo.SegmentInfo)
 			infos = append(infos, clonedInfo.SegmentInfo)
 		} else {
 			info = s.meta.GetHealthySegment(id)
@@ -400,6 +404,7 @@ func (s *Server) GetSegmentInfo(ctx context.Context, req *datapb.GetSegmentInfoR
 			}
 			clonedInfo := info.Clone()
 			segmentutil.ReCalcRowCount(info.SegmentInfo, clonedInfo.SegmentInfo)
+			clonedInfo.FlushedRanges = getFlushedRanges(clonedInfo.SegmentInfo)
 			infos = append(infos, clonedInfo.SegmentInfo)
 		}
 		vchannel := info.InsertChannel
@@ -492,7 +497,10 @@ func (s *Server) SaveBinlogPaths(ctx context.Context, req *datapb.SaveBinlogPath
 
 	// save binlogs, start positions and checkpoints
 	operators = append(operators,
+		UpdateScalarStatsOperator(req.GetSegmentID(), req.GetField2BinlogPaths(), req.GetScalarStats()),
 		AddBinlogsOperator(req.GetSegmentID(), req.GetField2BinlogPaths(), req.GetField2StatslogPaths(), req.GetDeltalogs()),
+		AddArtifactLogsOperator(req.GetSegmentID(), req.GetArtifactlogs()),
+		AddParquetLogsOperator(req.GetSegmentID(), req.GetParquetlogs()),
 		UpdateStartPosition(req.GetStartPositions()),
 		UpdateCheckPointOperator(req.GetSegmentID(), req.GetCheckPoints()),
 	)
@@ -599,6 +607,8 @@ func (s *Server) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtual
 
 	metrics.CleanupDataCoordNumStoredRows(collectionID)
 	metrics.DataCoordCheckpointUnixSeconds.DeleteLabelValues(fmt.Sprint(paramtable.GetNodeID()), channel)
+	metrics.DataCoordCheckpointLagSeconds.DeleteLabelValues(fmt.Sprint(paramtable.GetNodeID()), channel)
+	metrics.DataCoordChannelUnflushedRows.DeleteLabelValues(fmt.Sprint(paramtable.GetNodeID()), channel)
 	metrics.CleanupDataCoordBulkInsertVectors(collectionID)
 
 	// no compaction triggered in Drop procedure
@@ -1165,6 +1175,66 @@ func (s *Server) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.
 	return resp, nil
 }
 
+// GetCompactionProgress returns the progress of the compaction,
+// including the segments rewritten and the size written by the completed plans.
+func (s *Server) GetCompactionProgress(ctx context.Context, req *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("compactionID", req.GetCompactionID()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GetCompactionProgressResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+		return &datapb.GetCompactionProgressResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("compaction disabled")),
+		}, nil
+	}
+
+	tasks := s.compactionHandler.getCompactionTasksBySignalID(req.GetCompactionID())
+	if req.GetCompactionID() == 0 || len(tasks) == 0 {
+		return &datapb.GetCompactionProgressResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("compaction %d not found", req.GetCompactionID())),
+		}, nil
+	}
+
+	resp := getCompactionProgress(tasks, time.Now())
+	resp.Status = merr.Success()
+	log.Info("success to get compaction progress", zap.Any("state", resp.GetState()),
+		zap.Int64("totalSegments", resp.GetTotalSegments()),
+		zap.Int64("rewrittenSegments", resp.GetRewrittenSegments()),
+		zap.Int64("writtenBytes", resp.GetWrittenBytes()))
+	return resp, nil
+}
+
+// CancelCompaction cancels the unfinished plans of the compaction atomically,
+// it's refused once the result of any plan has been applied.
+func (s *Server) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("compactionID", req.GetCompactionID()),
+	)
+	log.Info("received cancel compaction request")
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if !Params.DataCoordCfg.EnableCompaction.GetAsBool() {
+		return merr.Status(merr.WrapErrServiceUnavailable("compaction disabled")), nil
+	}
+
+	if req.GetCompactionID() == 0 {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("compaction id not specified")), nil
+	}
+
+	if err := s.compactionHandler.cancelCompaction(req.GetCompactionID()); err != nil {
+		log.Warn("failed to cancel compaction", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
 func getCompactionMergeInfo(task *compactionTask) *milvuspb.CompactionMergeInfo {
 	segments := task.plan.GetSegmentBinlogs()
 	var sources []int64
@@ -1209,6 +1279,62 @@ func getCompactionState(tasks []*compactionTask) (state commonpb.CompactionState
 	return
 }
 
+func getCompactionProgress(tasks []*compactionTask, now time.Time) *datapb.GetCompactionProgressResponse {
+	resp := &datapb.GetCompactionProgressResponse{
+		TotalPlans: int64(len(tasks)),
+	}
+	resp.State, _, _, _, _ = getCompactionState(tasks)
+
+	var (
+		startTime         time.Time
+		remainingSegments int64
+	)
+	for _, t := range tasks {
+		segments := int64(len(t.plan.GetSegmentBinlogs()))
+		resp.TotalSegments += segments
+		if t.plan.GetStartTime() > tsTimeout {
+			planStart := tsoutil.PhysicalTime(t.plan.GetStartTime())
+			if startTime.IsZero() || planStart.Before(startTime) {
+				startTime = planStart
+			}
+		}
+		switch {
+		case t.state == completed:
+			resp.CompletedPlans++
+			resp.RewrittenSegments += segments
+			for _, segment := range t.result.GetSegments() {
+				resp.WrittenBytes += getCompactionSegmentSize(segment)
+			}
+		case t.cancelled:
+			resp.CancelledPlans++
+		case t.state == failed || t.state == timeout:
+			resp.FailedPlans++
+		default:
+			remainingSegments += segments
+		}
+	}
+
+	// estimate by the rate of rewritten segments
+	if resp.GetRewrittenSegments() > 0 && remainingSegments > 0 && !startTime.IsZero() {
+		elapsed := now.Sub(startTime)
+		remaining := time.Duration(float64(elapsed) * float64(remainingSegments) / float64(resp.GetRewrittenSegments()))
+		resp.EstimatedRemainingSeconds = int64(remaining.Seconds())
+	}
+	return resp
+}
+
+func getCompactionSegmentSize(segment *datapb.CompactionSegment) int64 {
+	var size int64
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetInsertLogs(), segment.GetField2StatslogPaths(), segment.GetDeltalogs()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				size += binlog.GetLogSize()
+			}
+		}
+	}
+	return size
+}
+
 // WatchChannels notifies DataCoord to watch vchannels of a collection.
 func (s *Server) WatchChannels(ctx context.Context, req *datapb.WatchChannelsRequest) (*datapb.WatchChannelsResponse, error) {
 	log := log.Ctx(ctx).With(
@@ -1545,7 +1671,21 @@ func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.Alt
 	}
 
 	clonedColl.Properties = properties
+	fieldsAdded := len(req.GetSchema().GetFields()) > len(clonedColl.Schema.GetFields())
+	if fieldsAdded {
+		clonedColl.Schema = req.GetSchema()
+	}
 	s.meta.AddCollection(clonedColl)
+
+	if fieldsAdded {
+		// seal the growing segments written with the old schema, the rows with the fields added go to new segments
+		sealed, err := s.segmentManager.SealAllSegments(ctx, req.GetCollectionID(), nil)
+		if err != nil {
+			log.Warn("failed to seal segments after fields added", zap.Int64("collectionID", req.GetCollectionID()), zap.Error(err))
+			return merr.Status(err), nil
+		}
+		log.Info("segments sealed after fields added", zap.Int64("collectionID", req.GetCollectionID()), zap.Int64s("segments", sealed))
+	}
 	return merr.Success(), nil
 }
 
@@ -1621,6 +1761,706 @@ func (s *Server) GcControl(ctx context.Context, request *datapb.GcControlRequest
 	return status, nil
 }
 
+// AuditGarbage runs garbage collection in audit mode, which reports the orphan files,
+// the files missing for meta and the estimated reclaimable bytes without deleting anything.
+func (s *Server) AuditGarbage(ctx context.Context, req *datapb.AuditGarbageRequest) (*datapb.AuditGarbageResponse, error) {
+	log := log.Ctx(ctx)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.AuditGarbageResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	if s.garbageCollector == nil || s.garbageCollector.option.cli == nil {
+		return &datapb.AuditGarbageResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("garbage collector not available")),
+		}, nil
+	}
+
+	resp, err := s.garbageCollector.audit(ctx, int(req.GetLimit()))
+	if err != nil {
+		log.Warn("failed to audit garbage", zap.Error(err))
+		return &datapb.AuditGarbageResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	resp.Status = merr.Success()
+	return resp, nil
+}
+
+// ListChannelCheckpoints lists the checkpoints of the channels with their wall-clock lags and unflushed rows.
+func (s *Server) ListChannelCheckpoints(ctx context.Context, req *datapb.ListChannelCheckpointsRequest) (*datapb.ListChannelCheckpointsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ListChannelCheckpointsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	return &datapb.ListChannelCheckpointsResponse{
+		Status:      merr.Success(),
+		Checkpoints: s.cpMonitor.getLags(req.GetCollectionID()),
+	}, nil
+}
+
+// RestoreSegment resurrects the dropped segments within the gc restore window,
+// all the restorable dropped segments of the collection are restored if segment IDs not specified.
+func (s *Server) RestoreSegment(ctx context.Context, req *datapb.RestoreSegmentRequest) (*datapb.RestoreSegmentResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("segmentIDs", req.GetSegmentIDs()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.RestoreSegmentResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	log.Info("receive restore segment request")
+	window := Params.DataCoordCfg.GCRestoreWindow.GetAsDuration(time.Second)
+	if window <= 0 {
+		err := merr.WrapErrParameterInvalidMsg("segment restore disabled, %s not set", Params.DataCoordCfg.GCRestoreWindow.Key)
+		return &datapb.RestoreSegmentResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	coll, err := s.handler.GetCollection(ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to get collection", zap.Error(err))
+		return &datapb.RestoreSegmentResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if coll == nil {
+		return &datapb.RestoreSegmentResponse{
+			Status: merr.Status(merr.WrapErrCollectionNotFound(req.GetCollectionID())),
+		}, nil
+	}
+
+	var segments []*SegmentInfo
+	if len(req.GetSegmentIDs()) == 0 {
+		dropped := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+			return segment.GetCollectionID() == req.GetCollectionID() &&
+				segment.GetState() == commonpb.SegmentState_Dropped
+		})
+		segments = lo.Filter(dropped, func(segment *SegmentInfo, _ int) bool {
+			return s.checkSegmentRestorable(ctx, coll, segment, window) == nil
+		})
+	} else {
+		for _, segmentID := range req.GetSegmentIDs() {
+			segment := s.meta.GetSegment(segmentID)
+			if segment == nil || segment.GetCollectionID() != req.GetCollectionID() {
+				return &datapb.RestoreSegmentResponse{
+					Status: merr.Status(merr.WrapErrSegmentNotFound(segmentID)),
+				}, nil
+			}
+			if err := s.checkSegmentRestorable(ctx, coll, segment, window); err != nil {
+				log.Warn("segment not restorable", zap.Int64("segmentID", segmentID), zap.Error(err))
+				return &datapb.RestoreSegmentResponse{
+					Status: merr.Status(err),
+				}, nil
+			}
+			segments = append(segments, segment)
+		}
+	}
+
+	restored := make([]int64, 0, len(segments))
+	for _, segment := range segments {
+		if err := s.meta.RestoreSegment(segment.GetID()); err != nil {
+			log.Warn("failed to restore segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			return &datapb.RestoreSegmentResponse{
+				Status:             merr.Status(err),
+				RestoredSegmentIDs: restored,
+			}, nil
+		}
+		restored = append(restored, segment.GetID())
+	}
+	log.Info("restore segment done", zap.Int64s("restoredSegments", restored))
+	return &datapb.RestoreSegmentResponse{
+		Status:             merr.Success(),
+		RestoredSegmentIDs: restored,
+	}, nil
+}
+
+// checkSegmentRestorable checks whether the dropped segment could be restored,
+// which is dropped within the restore window, not compacted,
+// and all the binlogs of it still exist in object storage.
+func (s *Server) checkSegmentRestorable(ctx context.Context, coll *collectionInfo, segment *SegmentInfo, window time.Duration) error {
+	switch {
+	case segment.GetState() != commonpb.SegmentState_Dropped:
+		return merr.WrapErrParameterInvalidMsg("segment %d not dropped", segment.GetID())
+	case segment.GetCompacted():
+		return merr.WrapErrParameterInvalidMsg("segment %d compacted", segment.GetID())
+	case segment.GetDroppedAt() == 0 || time.Since(time.Unix(0, int64(segment.GetDroppedAt()))) > window:
+		return merr.WrapErrParameterInvalidMsg("segment %d dropped out of restore window", segment.GetID())
+	case segment.GetPartitionID() != common.AllPartitionsID && !lo.Contains(coll.Partitions, segment.GetPartitionID()):
+		return merr.WrapErrPartitionNotFound(segment.GetPartitionID())
+	case !s.meta.catalog.ChannelExists(ctx, segment.GetInsertChannel()):
+		return merr.WrapErrChannelNotFound(segment.GetInsertChannel())
+	}
+	if _, ok := s.meta.GetCompactionTo(segment.GetID()); ok {
+		return merr.WrapErrParameterInvalidMsg("segment %d compacted", segment.GetID())
+	}
+
+	cloned := segment.Clone()
+	if err := binlog.DecompressBinLogs(cloned.SegmentInfo); err != nil {
+		return err
+	}
+	for _, l := range getLogs(cloned) {
+		exist, err := s.meta.chunkManager.Exist(ctx, l.GetLogPath())
+		if err != nil {
+			return err
+		}
+		if !exist {
+			return merr.WrapErrParameterInvalidMsg("binlog %s of segment %d not found", l.GetLogPath(), segment.GetID())
+		}
+	}
+	return nil
+}
+
+// CreateSnapshot captures the flushed segments and the channel checkpoints of the collection as a named snapshot,
+// the binlogs of the segments are kept until the snapshot dropped. Rows not flushed yet are not included.
+func (s *Server) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("snapshot", req.GetName()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log.Info("receive create snapshot request")
+	if req.GetName() == "" {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("snapshot name not specified")), nil
+	}
+	snapshot, err := newCollectionSnapshot(ctx, s.meta, s.broker, s.allocator, req.GetCollectionID(), req.GetName())
+	if err != nil {
+		log.Warn("failed to capture snapshot", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	if err := s.meta.snapshotMeta.AddSnapshot(snapshot); err != nil {
+		log.Warn("failed to add snapshot", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("create snapshot done", zap.Int("segmentNum", len(snapshot.GetSegments())))
+	return merr.Success(), nil
+}
+
+// DropSnapshot drops the snapshot, the binlogs only referenced by it would be recycled by gc.
+func (s *Server) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("snapshot", req.GetName()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if err := s.meta.snapshotMeta.DropSnapshot(req.GetCollectionID(), req.GetName()); err != nil {
+		log.Warn("failed to drop snapshot", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("drop snapshot done")
+	return merr.Success(), nil
+}
+
+func (s *Server) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ListSnapshotsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	snapshots := s.meta.snapshotMeta.ListSnapshots(req.GetCollectionID())
+	infos := make([]*datapb.SnapshotInfo, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		infos = append(infos, newSnapshotInfo(snapshot))
+	}
+	return &datapb.ListSnapshotsResponse{
+		Status:    merr.Success(),
+		Snapshots: infos,
+	}, nil
+}
+
+// RestoreSnapshot clones the segments of the snapshot into the target collection as flushed segments,
+// which share the binlogs of the snapshot instead of copying them. The target collection shall be empty,
+// with the same schema and shards number as the snapshot taken from.
+func (s *Server) RestoreSnapshot(ctx context.Context, req *datapb.RestoreSnapshotRequest) (*datapb.RestoreSnapshotResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("snapshot", req.GetName()),
+		zap.Int64("targetCollectionID", req.GetTargetCollectionID()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	log.Info("receive restore snapshot request")
+	snapshot := s.meta.snapshotMeta.GetSnapshot(req.GetCollectionID(), req.GetName())
+	if snapshot == nil {
+		err := merr.WrapErrParameterInvalidMsg("snapshot %s of collection %d not found", req.GetName(), req.GetCollectionID())
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return s.restoreSnapshot(ctx, snapshot, req.GetTargetCollectionID(), req.GetPartitionMapping()), nil
+}
+
+// ListReplicas lists the collections replicated into the replica storage by the primary cluster.
+func (s *Server) ListReplicas(ctx context.Context, req *datapb.ListReplicasRequest) (*datapb.ListReplicasResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ListReplicasResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if s.replicaStorage == nil {
+		return &datapb.ListReplicasResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("replica storage not configured")),
+		}, nil
+	}
+
+	collectionIDs, err := listReplicaManifests(ctx, s.replicaStorage)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to list replica manifests", zap.Error(err))
+		return &datapb.ListReplicasResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	sortedIDs := collectionIDs.Collect()
+	sort.Slice(sortedIDs, func(i, j int) bool { return sortedIDs[i] < sortedIDs[j] })
+	replicas := make([]*datapb.ReplicaInfo, 0, len(sortedIDs))
+	for _, collectionID := range sortedIDs {
+		manifest, err := readReplicaManifest(ctx, s.replicaStorage, collectionID)
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to read replica manifest", zap.Int64("collectionID", collectionID), zap.Error(err))
+			return &datapb.ListReplicasResponse{
+				Status: merr.Status(err),
+			}, nil
+		}
+		replicas = append(replicas, newReplicaInfo(manifest))
+	}
+	return &datapb.ListReplicasResponse{
+		Status:   merr.Success(),
+		Replicas: replicas,
+	}, nil
+}
+
+// PromoteReplica restores the segments replicated of the collection into the target collection,
+// which share the binlogs in the replica storage, so the replica storage shall be in the bucket of the cluster.
+func (s *Server) PromoteReplica(ctx context.Context, req *datapb.PromoteReplicaRequest) (*datapb.RestoreSnapshotResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("targetCollectionID", req.GetTargetCollectionID()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if s.replicaStorage == nil {
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("replica storage not configured")),
+		}, nil
+	}
+	if Params.DataCoordCfg.ReplicationBucketName.GetValue() != Params.MinioCfg.BucketName.GetValue() {
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("replica storage not in the bucket of the cluster")),
+		}, nil
+	}
+
+	log.Info("receive promote replica request")
+	manifest, err := readReplicaManifest(ctx, s.replicaStorage, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to read replica manifest", zap.Error(err))
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return s.restoreSnapshot(ctx, manifest.GetSnapshot(), req.GetTargetCollectionID(), req.GetPartitionMapping()), nil
+}
+
+// CreateBackup backs up the meta and the segments of the collections into the object storage.
+func (s *Server) CreateBackup(ctx context.Context, req *datapb.CreateBackupRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.String("backup", req.GetName()),
+		zap.Int64s("collectionIDs", req.GetCollectionIDs()),
+		zap.Bool("copyFiles", req.GetCopyFiles()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log.Info("receive create backup request")
+	if err := s.createBackup(ctx, req.GetName(), req.GetCollectionIDs(), req.GetCopyFiles()); err != nil {
+		log.Warn("failed to create backup", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("create backup done")
+	return merr.Success(), nil
+}
+
+func (s *Server) ListBackups(ctx context.Context, req *datapb.ListBackupsRequest) (*datapb.ListBackupsResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.ListBackupsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	backups, err := s.listBackups(ctx)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to list backups", zap.Error(err))
+		return &datapb.ListBackupsResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return &datapb.ListBackupsResponse{
+		Status:  merr.Success(),
+		Backups: backups,
+	}, nil
+}
+
+// DropBackup drops the backup, the binlogs only referenced by it would be recycled by gc.
+func (s *Server) DropBackup(ctx context.Context, req *datapb.DropBackupRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.String("backup", req.GetName()))
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	if err := s.dropBackup(ctx, req.GetName()); err != nil {
+		log.Warn("failed to drop backup", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("drop backup done")
+	return merr.Success(), nil
+}
+
+// RestoreBackup restores the backed up segments of the collection into the target collection, which share the binlogs of the backup.
+func (s *Server) RestoreBackup(ctx context.Context, req *datapb.RestoreBackupRequest) (*datapb.RestoreSnapshotResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.String("backup", req.GetName()),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("targetCollectionID", req.GetTargetCollectionID()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	log.Info("receive restore backup request")
+	cm := s.meta.chunkManager
+	manifest, err := readManifest(ctx, cm, backupManifestPath(cm.RootPath(), req.GetName(), req.GetCollectionID()))
+	if err != nil {
+		log.Warn("failed to read backup manifest", zap.Error(err))
+		if errors.Is(err, merr.ErrIoKeyNotFound) {
+			err = merr.WrapErrParameterInvalidMsg("collection %d not in backup %s", req.GetCollectionID(), req.GetName())
+		}
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	return s.restoreSnapshot(ctx, manifest.GetSnapshot(), req.GetTargetCollectionID(), req.GetPartitionMapping()), nil
+}
+
+// restoreSnapshot clones the segments of the snapshot into the target collection, which shall be empty.
+func (s *Server) restoreSnapshot(ctx context.Context, snapshot *datapb.CollectionSnapshot, targetCollectionID UniqueID,
+	partitionMapping map[int64]int64,
+) *datapb.RestoreSnapshotResponse {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", snapshot.GetCollectionID()),
+		zap.String("snapshot", snapshot.GetName()),
+		zap.Int64("targetCollectionID", targetCollectionID),
+	)
+	target, err := s.broker.DescribeCollectionInternal(ctx, targetCollectionID)
+	if err != nil {
+		log.Warn("failed to describe target collection", zap.Error(err))
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}
+	}
+	partitionIDs, err := s.broker.ShowPartitionsInternal(ctx, targetCollectionID)
+	if err != nil {
+		log.Warn("failed to show target partitions", zap.Error(err))
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}
+	}
+	if err := checkSnapshotRestorable(snapshot, target.GetVirtualChannelNames(), partitionIDs, partitionMapping); err != nil {
+		log.Warn("snapshot not restorable", zap.Error(err))
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}
+	}
+	if len(s.meta.GetSegmentsOfCollection(targetCollectionID)) > 0 {
+		err := merr.WrapErrParameterInvalidMsg("target collection %d not empty", targetCollectionID)
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}
+	}
+
+	channels := make(map[string]string, len(snapshot.GetChannels()))
+	for i, channel := range snapshot.GetChannels() {
+		channels[channel] = target.GetVirtualChannelNames()[i]
+	}
+	startID, _, err := s.allocator.allocN(int64(len(snapshot.GetSegments())))
+	if err != nil {
+		log.Warn("failed to alloc segment ids", zap.Error(err))
+		return &datapb.RestoreSnapshotResponse{
+			Status: merr.Status(err),
+		}
+	}
+
+	segmentIndexes := lo.GroupBy(snapshot.GetSegmentIndexes(), func(segmentIndex *datapb.SnapshotSegmentIndex) int64 {
+		return segmentIndex.GetSegmentID()
+	})
+	resp := &datapb.RestoreSnapshotResponse{
+		Status: merr.Success(),
+	}
+	for i, segment := range snapshot.GetSegments() {
+		channel := channels[segment.GetInsertChannel()]
+		// the positions of the source channel are meaningless to the target one,
+		// seek from the start position of the target channel instead
+		startPos := toMsgPosition(channel, target.GetStartPositions())
+		if startPos == nil {
+			err := merr.WrapErrChannelNotFound(channel, "start position not found")
+			return &datapb.RestoreSnapshotResponse{
+				Status:     merr.Status(err),
+				SegmentIDs: resp.GetSegmentIDs(),
+			}
+		}
+		startPos.Timestamp = target.GetCreatedTimestamp()
+
+		partitionID := segment.GetPartitionID()
+		if partitionID != common.AllPartitionsID {
+			partitionID = partitionMapping[partitionID]
+		}
+		cloned := cloneSnapshotSegment(segment, startID+int64(i), targetCollectionID, partitionID, channel, startPos)
+		// the indexes are restored before the segment is visible, so that they are not built again
+		restoredIndexes := s.restoreSegmentIndexes(ctx, cloned, segmentIndexes[segment.GetID()])
+		if err := s.meta.AddSegment(ctx, NewSegmentInfo(cloned)); err != nil {
+			log.Warn("failed to add cloned segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			for _, segIdx := range restoredIndexes {
+				if err := s.meta.indexMeta.RemoveSegmentIndex(segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID,
+					segIdx.IndexID, segIdx.BuildID); err != nil {
+					log.Warn("failed to remove restored segment index", zap.Int64("buildID", segIdx.BuildID), zap.Error(err))
+				}
+			}
+			return &datapb.RestoreSnapshotResponse{
+				Status:     merr.Status(err),
+				SegmentIDs: resp.GetSegmentIDs(),
+			}
+		}
+		resp.SegmentIDs = append(resp.SegmentIDs, cloned.GetID())
+		resp.NumRows += cloned.GetNumOfRows()
+	}
+	log.Info("restore snapshot done", zap.Int64s("segmentIDs", resp.GetSegmentIDs()), zap.Int64("numRows", resp.GetNumRows()))
+	return resp
+}
+
+// restoreSegmentIndexes finishes the indexes of the restored segment by reusing the index files of the snapshot,
+// the index files not reusable are skipped and the indexes are built as usual once the segment is added.
+func (s *Server) restoreSegmentIndexes(ctx context.Context, segment *datapb.SegmentInfo, segmentIndexes []*datapb.SnapshotSegmentIndex) []*model.SegmentIndex {
+	// the index files in the bucket of the collection aren't shared with the other collections
+	if _, ok := storage.GetCollectionStorage(segment.GetCollectionID()); ok {
+		return nil
+	}
+	restored := make([]*model.SegmentIndex, 0, len(segmentIndexes))
+	for _, segmentIndex := range segmentIndexes {
+		log := log.Ctx(ctx).With(
+			zap.Int64("segmentID", segment.GetID()),
+			zap.String("indexName", segmentIndex.GetIndexName()),
+			zap.Int64("reusedBuildID", segmentIndex.GetFiles().GetLocation().GetBuildID()),
+		)
+		indexes := s.meta.indexMeta.GetFieldIndexes(segment.GetCollectionID(), segmentIndex.GetFieldID(), segmentIndex.GetIndexName())
+		if len(indexes) == 0 || !common.KeyValuePairs(indexes[0].IndexParams).Equal(segmentIndex.GetIndexParams()) {
+			log.Info("index of the snapshot not found in target collection, skip restoring the index")
+			continue
+		}
+		// the index files must be loadable by all the QueryNodes
+		files := segmentIndex.GetFiles()
+		version := files.GetCurrentIndexVersion()
+		if files.GetNumRows() != segment.GetNumOfRows() ||
+			version < s.indexEngineVersionManager.GetMinimalIndexEngineVersion() ||
+			version > s.indexEngineVersionManager.GetCurrentIndexEngineVersion() {
+			log.Info("index files of the snapshot not reusable, build the index", zap.Int32("indexVersion", version))
+			continue
+		}
+		exist, err := indexFilesExist(ctx, s.meta.chunkManager, files)
+		if err != nil || !exist {
+			log.Info("index files of the snapshot not available, build the index", zap.Error(err))
+			continue
+		}
+
+		buildID, err := s.allocator.allocID(ctx)
+		if err != nil {
+			log.Warn("failed to alloc build id, build the index", zap.Error(err))
+			continue
+		}
+		segIdx := &model.SegmentIndex{
+			SegmentID:    segment.GetID(),
+			CollectionID: segment.GetCollectionID(),
+			PartitionID:  segment.GetPartitionID(),
+			NumRows:      segment.GetNumOfRows(),
+			IndexID:      indexes[0].IndexID,
+			BuildID:      buildID,
+			CreateTime:   uint64(segment.GetID()),
+		}
+		if err := s.meta.indexMeta.AddSegmentIndex(segIdx); err != nil {
+			log.Warn("failed to add segment index, build the index", zap.Error(err))
+			continue
+		}
+		if err := s.meta.indexMeta.ReuseIndexFiles(buildID, files); err != nil {
+			log.Warn("failed to reuse index files, build the index", zap.Error(err))
+			if err := s.meta.indexMeta.RemoveSegmentIndex(segIdx.CollectionID, segIdx.PartitionID, segIdx.SegmentID,
+				segIdx.IndexID, buildID); err != nil {
+				log.Warn("failed to remove segment index", zap.Int64("buildID", buildID), zap.Error(err))
+			}
+			continue
+		}
+		restored = append(restored, segIdx)
+	}
+	return restored
+}
+
+// PauseIngestion stops the datanodes consuming the vchannels of the collection, the messages are kept in the
+// message stream and consumed after ResumeIngestion called. The channels watched later are paused as well.
+// Note that the drop of a paused collection is not completed until its ingestion resumed.
+func (s *Server) PauseIngestion(ctx context.Context, req *datapb.PauseIngestionRequest) (*commonpb.Status, error) {
+	return s.setIngestionPaused(ctx, req.GetCollectionID(), true), nil
+}
+
+// ResumeIngestion resumes the datanodes consuming the vchannels of the collection paused by PauseIngestion.
+func (s *Server) ResumeIngestion(ctx context.Context, req *datapb.ResumeIngestionRequest) (*commonpb.Status, error) {
+	return s.setIngestionPaused(ctx, req.GetCollectionID(), false), nil
+}
+
+func (s *Server) setIngestionPaused(ctx context.Context, collectionID int64, paused bool) *commonpb.Status {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", collectionID),
+		zap.Bool("paused", paused),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err)
+	}
+
+	var err error
+	if paused {
+		err = s.meta.ingestionMeta.Pause(collectionID)
+	} else {
+		err = s.meta.ingestionMeta.Resume(collectionID)
+	}
+	if err != nil {
+		log.Warn("failed to update ingestion state", zap.Error(err))
+		return merr.Status(err)
+	}
+
+	for nodeID, channels := range s.channelManager.GetNodeChannelsByCollectionID(collectionID) {
+		if len(channels) == 0 {
+			continue
+		}
+		if err := s.cluster.PauseChannels(ctx, nodeID, channels, paused); err != nil {
+			log.Warn("failed to notify datanode", zap.Int64("nodeID", nodeID), zap.Strings("channels", channels), zap.Error(err))
+			return merr.Status(err)
+		}
+	}
+	log.Info("update ingestion state done")
+	return merr.Success()
+}
+
+// SetChannelParallelism updates the flowgraph parallelism of the channel on the datanode watching it.
+// The parallelism is not persisted, the channel watched again runs with the configured one.
+func (s *Server) SetChannelParallelism(ctx context.Context, req *datapb.SetChannelParallelismRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.String("channel", req.GetChannel()),
+		zap.Int32("parallelism", req.GetParallelism()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if req.GetParallelism() < 1 {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("parallelism must be positive, got %d", req.GetParallelism())), nil
+	}
+
+	nodeID, err := s.channelManager.FindWatcher(req.GetChannel())
+	if err != nil {
+		log.Warn("failed to find the datanode watching the channel", zap.Error(err))
+		return merr.Status(merr.WrapErrChannelNotFound(req.GetChannel(), err.Error())), nil
+	}
+	if err := s.cluster.SetChannelParallelism(ctx, nodeID, req.GetChannel(), req.GetParallelism()); err != nil {
+		log.Warn("failed to notify datanode", zap.Int64("nodeID", nodeID), zap.Error(err))
+		return merr.Status(err), nil
+	}
+	log.Info("set channel parallelism done", zap.Int64("nodeID", nodeID))
+	return merr.Success(), nil
+}
+
+// GetIdempotencyRecord returns the result recorded for the idempotency key, the record is nil
+// if the key not recorded within the deduplication window. The key is recorded pending if acquired.
+func (s *Server) GetIdempotencyRecord(ctx context.Context, req *datapb.GetIdempotencyRecordRequest) (*datapb.GetIdempotencyRecordResponse, error) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return &datapb.GetIdempotencyRecordResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if req.GetKey() == "" {
+		return &datapb.GetIdempotencyRecordResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("empty idempotency key")),
+		}, nil
+	}
+
+	resp := &datapb.GetIdempotencyRecordResponse{
+		Status: merr.Success(),
+	}
+	if paramtable.Get().DataCoordCfg.IdempotencyWindow.GetAsInt64() <= 0 {
+		resp.Acquired = req.GetAcquire()
+		return resp, nil
+	}
+	if !req.GetAcquire() {
+		resp.Record = s.meta.idempotencyMeta.GetRecord(req.GetCollectionID(), req.GetKey())
+		return resp, nil
+	}
+	record, acquired, err := s.meta.idempotencyMeta.AcquireKey(req.GetCollectionID(), req.GetKey())
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to acquire idempotency key", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.String("idempotencyKey", req.GetKey()), zap.Error(err))
+		return &datapb.GetIdempotencyRecordResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+	resp.Record, resp.Acquired = record, acquired
+	return resp, nil
+}
+
+// SaveIdempotencyRecord records the result of the request carrying the idempotency key,
+// it's a no-op if the deduplication disabled.
+func (s *Server) SaveIdempotencyRecord(ctx context.Context, req *datapb.SaveIdempotencyRecordRequest) (*commonpb.Status, error) {
+	record := req.GetRecord()
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", record.GetCollectionID()),
+		zap.String("idempotencyKey", record.GetKey()),
+	)
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if record.GetKey() == "" {
+		return merr.Status(merr.WrapErrParameterInvalidMsg("empty idempotency key")), nil
+	}
+	if paramtable.Get().DataCoordCfg.IdempotencyWindow.GetAsInt64() <= 0 {
+		return merr.Success(), nil
+	}
+
+	if record.GetCreateTime() == 0 {
+		record.CreateTime = time.Now().UnixMilli()
+	}
+	if err := s.meta.idempotencyMeta.SaveRecord(record); err != nil {
+		log.Warn("failed to save idempotency record", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
 func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
 		return &internalpb.ImportResponse{
@@ -1637,6 +2477,21 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 		zap.Strings("channels", in.GetChannelNames()))
 	log.Info("receive import request", zap.Any("files", in.GetFiles()))
 
+	// the retries of the import request carrying the same idempotency key get the job created by the first one
+	idempotencyKey := importutilv2.GetIdempotencyKey(in.GetOptions())
+	dedup := idempotencyKey != "" && paramtable.Get().DataCoordCfg.IdempotencyWindow.GetAsInt64() > 0
+	if dedup {
+		s.meta.idempotencyMeta.LockKey(in.GetCollectionID(), idempotencyKey)
+		defer s.meta.idempotencyMeta.UnlockKey(in.GetCollectionID(), idempotencyKey)
+		record := s.meta.idempotencyMeta.GetRecord(in.GetCollectionID(), idempotencyKey)
+		if record != nil && record.GetState() == datapb.IdempotencyRecordState_IdempotencyCompleted {
+			resp.JobID = string(record.GetResult())
+			log.Info("import request deduplicated by idempotency key",
+				zap.String("idempotencyKey", idempotencyKey), zap.String("jobID", resp.GetJobID()))
+			return resp, nil
+		}
+	}
+
 	var timeoutTs uint64 = math.MaxUint64
 	timeoutStr, err := funcutil.GetAttrByKeyFromRepeatedKV("timeout", in.GetOptions())
 	if err == nil {
@@ -1685,6 +2540,7 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 	job := &importJob{
 		ImportJob: &datapb.ImportJob{
 			JobID:          idStart,
+			DbName:         in.GetDbName(),
 			CollectionID:   in.GetCollectionID(),
 			CollectionName: in.GetCollectionName(),
 			PartitionIDs:   in.GetPartitionIDs(),
@@ -1705,6 +2561,18 @@ func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInter
 	}
 
 	resp.JobID = fmt.Sprint(job.GetJobID())
+	if dedup {
+		err = s.meta.idempotencyMeta.SaveRecord(&datapb.IdempotencyRecord{
+			Key:          idempotencyKey,
+			CollectionID: in.GetCollectionID(),
+			Result:       []byte(resp.GetJobID()),
+			CreateTime:   time.Now().UnixMilli(),
+		})
+		if err != nil {
+			// the job is added anyway, only the retries are not deduplicated
+			log.Warn("failed to save idempotency record of import job", zap.String("idempotencyKey", idempotencyKey), zap.Error(err))
+		}
+	}
 	log.Info("add import job done", zap.Int64("jobID", job.GetJobID()), zap.Any("files", files))
 	return resp, nil
 }
@@ -1736,6 +2604,7 @@ func (s *Server) GetImportProgress(ctx context.Context, in *internalpb.GetImport
 	resp.ImportedRows = importedRows
 	resp.TotalRows = totalRows
 	resp.TaskProgresses = GetTaskProgresses(jobID, s.importMeta, s.meta)
+	resp.QueuePosition = GetImportJobQueue(s.importMeta)[jobID]
 	log.Info("GetImportProgress done", zap.Any("resp", resp))
 	return resp, nil
 }
@@ -1762,6 +2631,7 @@ func (s *Server) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 		jobs = s.importMeta.GetJobBy()
 	}
 
+	queue := GetImportJobQueue(s.importMeta)
 	for _, job := range jobs {
 		progress, state, _, _, reason := GetJobProgress(job.GetJobID(), s.importMeta, s.meta)
 		resp.JobIDs = append(resp.JobIDs, fmt.Sprintf("%d", job.GetJobID()))
@@ -1769,6 +2639,7 @@ func (s *Server) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 		resp.Reasons = append(resp.Reasons, reason)
 		resp.Progresses = append(resp.Progresses, progress)
 		resp.CollectionNames = append(resp.CollectionNames, job.GetCollectionName())
+		resp.QueuePositions = append(resp.QueuePositions, queue[job.GetJobID()])
 	}
 	return resp, nil
 }
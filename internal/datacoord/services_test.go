@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
 
 type ServerSuite struct {
@@ -669,6 +670,30 @@ func TestBroadcastAlteredCollection(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, s.meta.collections[1].Properties)
 	})
+
+	t.Run("test fields added", func(t *testing.T) {
+		segmentManager := NewMockManager(t)
+		s := &Server{
+			meta: &meta{collections: map[UniqueID]*collectionInfo{
+				1: {ID: 1, Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}}}},
+			}},
+			segmentManager: segmentManager,
+		}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		req := &datapb.AlterCollectionRequest{
+			CollectionID: 1,
+			Schema:       &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{{FieldID: 100}, {FieldID: 101}}},
+		}
+
+		segmentManager.EXPECT().SealAllSegments(mock.Anything, int64(1), []int64(nil)).Return(nil, errors.New("mock")).Once()
+		resp, err := s.BroadcastAlteredCollection(context.Background(), req)
+		assert.Error(t, merr.CheckRPCCall(resp, err))
+
+		segmentManager.EXPECT().SealAllSegments(mock.Anything, int64(1), []int64(nil)).Return([]int64{1000}, nil).Once()
+		resp, err = s.BroadcastAlteredCollection(context.Background(), req)
+		assert.NoError(t, merr.CheckRPCCall(resp, err))
+		assert.Equal(t, 2, len(s.meta.collections[1].Schema.GetFields()))
+	})
 }
 
 func TestServer_GcConfirm(t *testing.T) {
@@ -1308,6 +1333,37 @@ func TestImportV2(t *testing.T) {
 		assert.Equal(t, int32(0), resp.GetStatus().GetCode())
 		jobs = s.importMeta.GetJobBy()
 		assert.Equal(t, 1, len(jobs))
+
+		// retries with the idempotency key
+		catalog.EXPECT().ListIdempotencyRecords(mock.Anything).Return(nil, nil)
+		catalog.EXPECT().SaveIdempotencyRecord(mock.Anything, mock.Anything).Return(nil).Once()
+		s.meta.idempotencyMeta, err = newIdempotencyMeta(ctx, catalog)
+		assert.NoError(t, err)
+		alloc = NewNMockAllocator(t)
+		alloc.EXPECT().allocN(mock.Anything).Return(100, 102, nil).Once()
+		s.allocator = alloc
+		for i := 0; i < 2; i++ {
+			resp, err = s.ImportV2(ctx, &internalpb.ImportRequestInternal{
+				CollectionID: 1,
+				Files: []*internalpb.ImportFile{
+					{
+						Id:    1,
+						Paths: []string{"a.json"},
+					},
+				},
+				Options: []*commonpb.KeyValuePair{
+					{
+						Key:   "idempotency_key",
+						Value: "key",
+					},
+				},
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, int32(0), resp.GetStatus().GetCode())
+			assert.Equal(t, "100", resp.GetJobID())
+		}
+		jobs = s.importMeta.GetJobBy()
+		assert.Equal(t, 2, len(jobs))
 	})
 
 	t.Run("GetImportProgress", func(t *testing.T) {
@@ -1397,6 +1453,7 @@ func TestImportV2(t *testing.T) {
 		assert.Equal(t, 1, len(resp.GetStates()))
 		assert.Equal(t, 1, len(resp.GetReasons()))
 		assert.Equal(t, 1, len(resp.GetProgresses()))
+		assert.Equal(t, []int64{0}, resp.GetQueuePositions())
 	})
 }
 
@@ -1490,3 +1547,263 @@ func (s *GcControlServiceSuite) TestTimeoutCtx() {
 func TestGcControlService(t *testing.T) {
 	suite.Run(t, new(GcControlServiceSuite))
 }
+
+type RestoreSegmentServiceSuite struct {
+	suite.Suite
+
+	server  *Server
+	catalog *mocks.DataCoordCatalog
+	cm      *mocks2.ChunkManager
+}
+
+func (s *RestoreSegmentServiceSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *RestoreSegmentServiceSuite) SetupTest() {
+	s.catalog = mocks.NewDataCoordCatalog(s.T())
+	s.cm = mocks2.NewChunkManager(s.T())
+	meta := &meta{
+		ctx:          context.Background(),
+		catalog:      s.catalog,
+		collections:  make(map[UniqueID]*collectionInfo),
+		segments:     NewSegmentsInfo(),
+		channelCPs:   newChannelCps(),
+		chunkManager: s.cm,
+	}
+	meta.AddCollection(&collectionInfo{ID: 1, Partitions: []int64{10}})
+
+	s.server = &Server{meta: meta, handler: newMockHandlerWithMeta(meta)}
+	s.server.stateCode.Store(commonpb.StateCode_Healthy)
+	paramtable.Get().Save(Params.DataCoordCfg.GCRestoreWindow.Key, "3600")
+}
+
+func (s *RestoreSegmentServiceSuite) TearDownTest() {
+	paramtable.Get().Reset(Params.DataCoordCfg.GCRestoreWindow.Key)
+}
+
+func (s *RestoreSegmentServiceSuite) addSegment(id UniqueID, droppedAt time.Time, compacted bool) {
+	s.server.meta.segments.SetSegment(id, NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  1,
+		PartitionID:   10,
+		InsertChannel: "ch-1",
+		State:         commonpb.SegmentState_Dropped,
+		DroppedAt:     uint64(droppedAt.UnixNano()),
+		Compacted:     compacted,
+		Binlogs: []*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{
+			{LogPath: metautil.BuildInsertLogPath("files", 1, 10, id, 100, 1)},
+		}}},
+	}))
+}
+
+func (s *RestoreSegmentServiceSuite) TestServerNotHealthy() {
+	s.server.stateCode.Store(commonpb.StateCode_Abnormal)
+	resp, err := s.server.RestoreSegment(context.Background(), &datapb.RestoreSegmentRequest{CollectionID: 1})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+}
+
+func (s *RestoreSegmentServiceSuite) TestRestoreDisabled() {
+	paramtable.Get().Save(Params.DataCoordCfg.GCRestoreWindow.Key, "0")
+	resp, err := s.server.RestoreSegment(context.Background(), &datapb.RestoreSegmentRequest{CollectionID: 1})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+}
+
+func (s *RestoreSegmentServiceSuite) TestCollectionNotFound() {
+	resp, err := s.server.RestoreSegment(context.Background(), &datapb.RestoreSegmentRequest{CollectionID: 2})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotFound)
+}
+
+func (s *RestoreSegmentServiceSuite) TestRestoreAll() {
+	s.addSegment(100, time.Now(), false)
+	s.addSegment(101, time.Now().Add(-2*time.Hour), false)
+	s.addSegment(102, time.Now(), true)
+	s.catalog.EXPECT().ChannelExists(mock.Anything, "ch-1").Return(true)
+	s.catalog.EXPECT().AlterSegments(mock.Anything, mock.Anything).Return(nil)
+	s.cm.EXPECT().Exist(mock.Anything, mock.Anything).Return(true, nil)
+
+	resp, err := s.server.RestoreSegment(context.Background(), &datapb.RestoreSegmentRequest{CollectionID: 1})
+	s.NoError(err)
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.Equal([]int64{100}, resp.GetRestoredSegmentIDs())
+
+	segment := s.server.meta.GetSegment(100)
+	s.Equal(commonpb.SegmentState_Flushed, segment.GetState())
+	s.Zero(segment.GetDroppedAt())
+	s.Equal(commonpb.SegmentState_Dropped, s.server.meta.GetSegment(101).GetState())
+}
+
+func (s *RestoreSegmentServiceSuite) TestRestoreNotRestorable() {
+	s.addSegment(100, time.Now(), false)
+	s.addSegment(101, time.Now(), true)
+
+	s.Run("segment not found", func() {
+		resp, err := s.server.RestoreSegment(context.Background(), &datapb.RestoreSegmentRequest{CollectionID: 1, SegmentIDs: []int64{200}})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrSegmentNotFound)
+	})
+
+	s.Run("compacted", func() {
+		resp, err := s.server.RestoreSegment(context.Background(), &datapb.RestoreSegmentRequest{CollectionID: 1, SegmentIDs: []int64{101}})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	s.Run("binlog missing", func() {
+		s.catalog.EXPECT().ChannelExists(mock.Anything, "ch-1").Return(true).Once()
+		s.cm.EXPECT().Exist(mock.Anything, mock.Anything).Return(false, nil).Once()
+		resp, err := s.server.RestoreSegment(context.Background(), &datapb.RestoreSegmentRequest{CollectionID: 1, SegmentIDs: []int64{100}})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+		s.Equal(commonpb.SegmentState_Dropped, s.server.meta.GetSegment(100).GetState())
+	})
+
+	s.Run("channel dropped", func() {
+		s.catalog.EXPECT().ChannelExists(mock.Anything, "ch-1").Return(false).Once()
+		resp, err := s.server.RestoreSegment(context.Background(), &datapb.RestoreSegmentRequest{CollectionID: 1, SegmentIDs: []int64{100}})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotFound)
+	})
+}
+
+func TestRestoreSegmentService(t *testing.T) {
+	suite.Run(t, new(RestoreSegmentServiceSuite))
+}
+
+func TestGetCompactionProgress(t *testing.T) {
+	now := time.Now()
+	newTask := func(state compactionTaskState, segments ...int64) *compactionTask {
+		return &compactionTask{
+			triggerInfo: &compactionSignal{id: 10},
+			plan: &datapb.CompactionPlan{
+				StartTime: tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0),
+				SegmentBinlogs: lo.Map(segments, func(segmentID int64, _ int) *datapb.CompactionSegmentBinlogs {
+					return &datapb.CompactionSegmentBinlogs{SegmentID: segmentID}
+				}),
+			},
+			state: state,
+		}
+	}
+
+	t.Run("progress", func(t *testing.T) {
+		done := newTask(completed, 1, 2)
+		done.result = &datapb.CompactionPlanResult{Segments: []*datapb.CompactionSegment{{
+			SegmentID:  100,
+			InsertLogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogSize: 1024}, {LogSize: 1024}}}},
+			Deltalogs:  []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogSize: 100}}}},
+		}}}
+		cancelled := newTask(failed, 3)
+		cancelled.cancelled = true
+		tasks := []*compactionTask{done, cancelled, newTask(timeout, 4), newTask(executing, 5, 6, 7, 8)}
+
+		resp := getCompactionProgress(tasks, now)
+		assert.Equal(t, commonpb.CompactionState_Executing, resp.GetState())
+		assert.EqualValues(t, 4, resp.GetTotalPlans())
+		assert.EqualValues(t, 1, resp.GetCompletedPlans())
+		assert.EqualValues(t, 1, resp.GetCancelledPlans())
+		assert.EqualValues(t, 1, resp.GetFailedPlans())
+		assert.EqualValues(t, 8, resp.GetTotalSegments())
+		assert.EqualValues(t, 2, resp.GetRewrittenSegments())
+		assert.EqualValues(t, 2148, resp.GetWrittenBytes())
+		// 4 segments remaining, 2 segments rewritten in 1 minute
+		assert.EqualValues(t, 120, resp.GetEstimatedRemainingSeconds())
+	})
+
+	t.Run("nothing rewritten", func(t *testing.T) {
+		resp := getCompactionProgress([]*compactionTask{newTask(pipelining, 1)}, now)
+		assert.Equal(t, commonpb.CompactionState_Executing, resp.GetState())
+		assert.EqualValues(t, 0, resp.GetEstimatedRemainingSeconds())
+	})
+}
+
+func TestCancelCompaction(t *testing.T) {
+	paramtable.Init()
+	t.Run("server not healthy", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Abnormal)
+		status, err := s.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{CompactionID: 1})
+		assert.ErrorIs(t, merr.CheckRPCCall(status, err), merr.ErrServiceNotReady)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		handler := NewMockCompactionPlanContext(t)
+		s := &Server{compactionHandler: handler}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		status, err := s.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{})
+		assert.ErrorIs(t, merr.CheckRPCCall(status, err), merr.ErrParameterInvalid)
+
+		handler.EXPECT().cancelCompaction(int64(1)).Return(nil).Once()
+		status, err = s.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{CompactionID: 1})
+		assert.NoError(t, merr.CheckRPCCall(status, err))
+
+		handler.EXPECT().cancelCompaction(int64(2)).Return(merr.WrapErrParameterInvalidMsg("compaction 2 not found")).Once()
+		status, err = s.CancelCompaction(context.TODO(), &datapb.CancelCompactionRequest{CompactionID: 2})
+		assert.ErrorIs(t, merr.CheckRPCCall(status, err), merr.ErrParameterInvalid)
+	})
+}
+
+func TestAuditGarbage(t *testing.T) {
+	paramtable.Init()
+	t.Run("server not healthy", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Abnormal)
+		resp, err := s.AuditGarbage(context.TODO(), &datapb.AuditGarbageRequest{})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrServiceNotReady)
+	})
+
+	t.Run("gc not available", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := s.AuditGarbage(context.TODO(), &datapb.AuditGarbageRequest{})
+		assert.ErrorIs(t, merr.CheckRPCCall(resp, err), merr.ErrServiceUnavailable)
+	})
+
+	t.Run("list failed", func(t *testing.T) {
+		m, err := newMemoryMeta()
+		require.NoError(t, err)
+		cm := mocks2.NewChunkManager(t)
+		cm.EXPECT().RootPath().Return("files")
+		cm.EXPECT().ListWithPrefix(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, errors.New("mock"))
+		s := &Server{garbageCollector: newGarbageCollector(m, nil, GcOption{cli: cm})}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+		resp, err := s.AuditGarbage(context.TODO(), &datapb.AuditGarbageRequest{})
+		assert.Error(t, merr.CheckRPCCall(resp, err))
+	})
+}
+
+func TestSetChannelParallelism(t *testing.T) {
+	paramtable.Init()
+	t.Run("server not healthy", func(t *testing.T) {
+		s := &Server{}
+		s.stateCode.Store(commonpb.StateCode_Abnormal)
+		status, err := s.SetChannelParallelism(context.TODO(), &datapb.SetChannelParallelismRequest{Channel: "ch-1", Parallelism: 2})
+		assert.ErrorIs(t, merr.CheckRPCCall(status, err), merr.ErrServiceNotReady)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		channelManager := NewMockChannelManager(t)
+		cluster := NewMockCluster(t)
+		s := &Server{channelManager: channelManager, cluster: cluster}
+		s.stateCode.Store(commonpb.StateCode_Healthy)
+
+		status, err := s.SetChannelParallelism(context.TODO(), &datapb.SetChannelParallelismRequest{Channel: "ch-1"})
+		assert.ErrorIs(t, merr.CheckRPCCall(status, err), merr.ErrParameterInvalid)
+
+		channelManager.EXPECT().FindWatcher("ch-2").Return(0, errChannelNotWatched).Once()
+		status, err = s.SetChannelParallelism(context.TODO(), &datapb.SetChannelParallelismRequest{Channel: "ch-2", Parallelism: 2})
+		assert.ErrorIs(t, merr.CheckRPCCall(status, err), merr.ErrChannelNotFound)
+
+		channelManager.EXPECT().FindWatcher("ch-1").Return(1, nil)
+		cluster.EXPECT().SetChannelParallelism(mock.Anything, int64(1), "ch-1", int32(2)).Return(nil).Once()
+		status, err = s.SetChannelParallelism(context.TODO(), &datapb.SetChannelParallelismRequest{Channel: "ch-1", Parallelism: 2})
+		assert.NoError(t, merr.CheckRPCCall(status, err))
+
+		cluster.EXPECT().SetChannelParallelism(mock.Anything, int64(1), "ch-1", int32(3)).Return(merr.WrapErrNodeNotFound(1)).Once()
+		status, err = s.SetChannelParallelism(context.TODO(), &datapb.SetChannelParallelismRequest{Channel: "ch-1", Parallelism: 3})
+		assert.ErrorIs(t, merr.CheckRPCCall(status, err), merr.ErrNodeNotFound)
+	})
+}
@@ -32,6 +32,8 @@ var errDisposed = errors.New("client is disposed")
 type NodeInfo struct {
 	NodeID  int64
 	Address string
+	// ChannelGroup is the exclusive channel group declared by the node
+	ChannelGroup string
 }
 
 // Session contains session info of a node
@@ -54,9 +54,13 @@ type SessionManager interface {
 
 	Flush(ctx context.Context, nodeID int64, req *datapb.FlushSegmentsRequest)
 	FlushChannels(ctx context.Context, nodeID int64, req *datapb.FlushChannelsRequest) error
+	PauseChannels(ctx context.Context, nodeID int64, req *datapb.PauseChannelsRequest) error
+	SetChannelParallelism(ctx context.Context, nodeID int64, req *datapb.SetChannelParallelismRequest) error
 	Compaction(ctx context.Context, nodeID int64, plan *datapb.CompactionPlan) error
 	SyncSegments(nodeID int64, req *datapb.SyncSegmentsRequest) error
+	StopCompaction(nodeID int64, req *datapb.StopCompactionRequest) error
 	GetCompactionPlansResults() (map[int64]*typeutil.Pair[int64, *datapb.CompactionPlanResult], error)
+	GetCompactionNodeResources() map[int64]*datapb.CompactionNodeResource
 	NotifyChannelOperation(ctx context.Context, nodeID int64, req *datapb.ChannelOperationsRequest) error
 	CheckChannelOperationProgress(ctx context.Context, nodeID int64, info *datapb.ChannelWatchInfo) (*datapb.ChannelOperationProgressResponse, error)
 	PreImport(nodeID int64, in *datapb.PreImportRequest) error
@@ -77,6 +81,9 @@ type SessionManagerImpl struct {
 		data map[int64]*Session
 	}
 	sessionCreator dataNodeCreatorFunc
+
+	// compaction resources of the datanodes, reported along with the compaction states
+	resources *typeutil.ConcurrentMap[int64, *datapb.CompactionNodeResource]
 }
 
 // SessionOpt provides a way to set params in SessionManagerImpl
@@ -100,6 +107,7 @@ func NewSessionManagerImpl(options ...SessionOpt) *SessionManagerImpl {
 			data map[int64]*Session
 		}{data: make(map[int64]*Session)},
 		sessionCreator: defaultSessionCreator(),
+		resources:      typeutil.NewConcurrentMap[int64, *datapb.CompactionNodeResource](),
 	}
 	for _, opt := range options {
 		opt(m)
@@ -126,6 +134,7 @@ func (c *SessionManagerImpl) DeleteSession(node *NodeInfo) {
 		session.Dispose()
 		delete(c.sessions.data, node.NodeID)
 	}
+	c.resources.Remove(node.NodeID)
 	metrics.DataCoordNumDataNodes.WithLabelValues().Set(float64(len(c.sessions.data)))
 }
 
@@ -270,6 +279,9 @@ func (c *SessionManagerImpl) GetCompactionPlansResults() (map[int64]*typeutil.Pa
 				return err
 			}
 
+			if resp.GetResource() != nil {
+				c.resources.Insert(nodeID, resp.GetResource())
+			}
 			for _, rst := range resp.GetResults() {
 				binlog.CompressCompactionBinlogs(rst.GetSegments())
 				nodeRst := typeutil.NewPair(nodeID, rst)
@@ -294,6 +306,17 @@ func (c *SessionManagerImpl) GetCompactionPlansResults() (map[int64]*typeutil.Pa
 	return rst, nil
 }
 
+// GetCompactionNodeResources returns the latest compaction resources reported by the datanodes,
+// datanodes not reporting any resource are absent.
+func (c *SessionManagerImpl) GetCompactionNodeResources() map[int64]*datapb.CompactionNodeResource {
+	resources := make(map[int64]*datapb.CompactionNodeResource)
+	c.resources.Range(func(nodeID int64, resource *datapb.CompactionNodeResource) bool {
+		resources[nodeID] = resource
+		return true
+	})
+	return resources
+}
+
 func (c *SessionManagerImpl) FlushChannels(ctx context.Context, nodeID int64, req *datapb.FlushChannelsRequest) error {
 	log := log.Ctx(ctx).With(zap.Int64("nodeID", nodeID),
 		zap.Time("flushTs", tsoutil.PhysicalTime(req.GetFlushTs())),
@@ -315,6 +338,46 @@ func (c *SessionManagerImpl) FlushChannels(ctx context.Context, nodeID int64, re
 	return nil
 }
 
+func (c *SessionManagerImpl) PauseChannels(ctx context.Context, nodeID int64, req *datapb.PauseChannelsRequest) error {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", nodeID),
+		zap.Strings("channels", req.GetChannels()),
+		zap.Bool("paused", req.GetPaused()))
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Error(err))
+		return err
+	}
+
+	resp, err := cli.PauseChannels(ctx, req)
+	err = VerifyResponse(resp, err)
+	if err != nil {
+		log.Warn("SessionManagerImpl.PauseChannels failed", zap.Error(err))
+		return err
+	}
+	log.Info("SessionManagerImpl.PauseChannels successfully")
+	return nil
+}
+
+func (c *SessionManagerImpl) SetChannelParallelism(ctx context.Context, nodeID int64, req *datapb.SetChannelParallelismRequest) error {
+	log := log.Ctx(ctx).With(zap.Int64("nodeID", nodeID),
+		zap.String("channel", req.GetChannel()),
+		zap.Int32("parallelism", req.GetParallelism()))
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Error(err))
+		return err
+	}
+
+	resp, err := cli.SetChannelParallelism(ctx, req)
+	err = VerifyResponse(resp, err)
+	if err != nil {
+		log.Warn("SessionManagerImpl.SetChannelParallelism failed", zap.Error(err))
+		return err
+	}
+	log.Info("SessionManagerImpl.SetChannelParallelism successfully")
+	return nil
+}
+
 func (c *SessionManagerImpl) NotifyChannelOperation(ctx context.Context, nodeID int64, req *datapb.ChannelOperationsRequest) error {
 	log := log.Ctx(ctx).With(zap.Int64("nodeID", nodeID))
 	cli, err := c.getClient(ctx, nodeID)
@@ -449,6 +512,28 @@ func (c *SessionManagerImpl) DropImport(nodeID int64, in *datapb.DropImportReque
 	return VerifyResponse(status, err)
 }
 
+// StopCompaction stops the compaction plan executing in the DataNode.
+func (c *SessionManagerImpl) StopCompaction(nodeID int64, req *datapb.StopCompactionRequest) error {
+	log := log.With(
+		zap.Int64("nodeID", nodeID),
+		zap.Int64("planID", req.GetPlanID()),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), Params.DataCoordCfg.CompactionRPCTimeout.GetAsDuration(time.Second))
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Warn("failed to get client", zap.Error(err))
+		return err
+	}
+	status, err := cli.StopCompaction(ctx, req)
+	if err := VerifyResponse(status, err); err != nil {
+		log.Warn("failed to stop compaction", zap.Error(err))
+		return err
+	}
+	log.Info("success to stop compaction")
+	return nil
+}
+
 func (c *SessionManagerImpl) CheckHealth(ctx context.Context) error {
 	group, ctx := errgroup.WithContext(ctx)
 
@@ -0,0 +1,305 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// snapshotMeta keeps the snapshots of the collections, the binlogs referenced by the snapshots
+// shall be kept by gc until the snapshots dropped.
+type snapshotMeta struct {
+	sync.RWMutex
+	ctx     context.Context
+	catalog metastore.DataCoordCatalog
+
+	// collectionID -> name -> snapshot
+	snapshots map[UniqueID]map[string]*datapb.CollectionSnapshot
+}
+
+func newSnapshotMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*snapshotMeta, error) {
+	snapshots, err := catalog.ListSnapshots(ctx)
+	if err != nil {
+		log.Error("snapshotMeta reloadFromKV load snapshots fail", zap.Error(err))
+		return nil, err
+	}
+	m := &snapshotMeta{
+		ctx:       ctx,
+		catalog:   catalog,
+		snapshots: make(map[UniqueID]map[string]*datapb.CollectionSnapshot),
+	}
+	for _, snapshot := range snapshots {
+		m.updateSnapshot(snapshot)
+	}
+	return m, nil
+}
+
+func (m *snapshotMeta) updateSnapshot(snapshot *datapb.CollectionSnapshot) {
+	if _, ok := m.snapshots[snapshot.GetCollectionID()]; !ok {
+		m.snapshots[snapshot.GetCollectionID()] = make(map[string]*datapb.CollectionSnapshot)
+	}
+	m.snapshots[snapshot.GetCollectionID()][snapshot.GetName()] = snapshot
+}
+
+// AddSnapshot saves the snapshot, the name of which shall be unique within the collection.
+func (m *snapshotMeta) AddSnapshot(snapshot *datapb.CollectionSnapshot) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.snapshots[snapshot.GetCollectionID()][snapshot.GetName()]; ok {
+		return merr.WrapErrParameterInvalidMsg("snapshot %s of collection %d already exists", snapshot.GetName(), snapshot.GetCollectionID())
+	}
+	if err := m.catalog.SaveSnapshot(m.ctx, snapshot); err != nil {
+		return err
+	}
+	m.updateSnapshot(snapshot)
+	return nil
+}
+
+func (m *snapshotMeta) DropSnapshot(collectionID UniqueID, name string) error {
+	m.Lock()
+	defer m.Unlock()
+	if _, ok := m.snapshots[collectionID][name]; !ok {
+		return merr.WrapErrParameterInvalidMsg("snapshot %s of collection %d not found", name, collectionID)
+	}
+	if err := m.catalog.DropSnapshot(m.ctx, collectionID, name); err != nil {
+		return err
+	}
+	delete(m.snapshots[collectionID], name)
+	if len(m.snapshots[collectionID]) == 0 {
+		delete(m.snapshots, collectionID)
+	}
+	return nil
+}
+
+func (m *snapshotMeta) GetSnapshot(collectionID UniqueID, name string) *datapb.CollectionSnapshot {
+	m.RLock()
+	defer m.RUnlock()
+	return m.snapshots[collectionID][name]
+}
+
+// ListSnapshots returns the snapshots of the collection, or of all the collections if collectionID is 0,
+// sorted by collection id and name.
+func (m *snapshotMeta) ListSnapshots(collectionID UniqueID) []*datapb.CollectionSnapshot {
+	m.RLock()
+	defer m.RUnlock()
+	snapshots := make([]*datapb.CollectionSnapshot, 0)
+	for collID, collSnapshots := range m.snapshots {
+		if collectionID != 0 && collID != collectionID {
+			continue
+		}
+		for _, snapshot := range collSnapshots {
+			snapshots = append(snapshots, snapshot)
+		}
+	}
+	sort.Slice(snapshots, func(i, j int) bool {
+		if snapshots[i].GetCollectionID() != snapshots[j].GetCollectionID() {
+			return snapshots[i].GetCollectionID() < snapshots[j].GetCollectionID()
+		}
+		return snapshots[i].GetName() < snapshots[j].GetName()
+	})
+	return snapshots
+}
+
+// GetReferencedIndexBuildIDs returns the builds whose index files are referenced by the snapshots.
+func (m *snapshotMeta) GetReferencedIndexBuildIDs() typeutil.UniqueSet {
+	m.RLock()
+	defer m.RUnlock()
+	buildIDs := typeutil.NewUniqueSet()
+	for _, collSnapshots := range m.snapshots {
+		for _, snapshot := range collSnapshots {
+			for _, segmentIndex := range snapshot.GetSegmentIndexes() {
+				buildIDs.Insert(segmentIndex.GetFiles().GetLocation().GetBuildID())
+			}
+		}
+	}
+	return buildIDs
+}
+
+// GetReferencedFiles returns the log paths referenced by the snapshots with the reference counts.
+func (m *snapshotMeta) GetReferencedFiles() map[string]int {
+	m.RLock()
+	defer m.RUnlock()
+	refs := make(map[string]int)
+	for _, collSnapshots := range m.snapshots {
+		for _, snapshot := range collSnapshots {
+			for _, segment := range snapshot.GetSegments() {
+				for _, l := range getLogs(NewSegmentInfo(segment)) {
+					refs[l.GetLogPath()]++
+				}
+			}
+		}
+	}
+	return refs
+}
+
+// newCollectionSnapshot captures the flushed segments with the binlog paths decompressed and the channel checkpoints of the collection.
+func newCollectionSnapshot(ctx context.Context, meta *meta, broker broker.Broker, allocator allocator, collectionID UniqueID, name string) (*datapb.CollectionSnapshot, error) {
+	coll, err := broker.DescribeCollectionInternal(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := allocator.allocTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &datapb.CollectionSnapshot{
+		Name:         name,
+		CollectionID: collectionID,
+		Channels:     coll.GetVirtualChannelNames(),
+		CreateTs:     ts,
+	}
+	for _, channel := range coll.GetVirtualChannelNames() {
+		if cp := meta.GetChannelCheckpoint(channel); cp != nil {
+			snapshot.Checkpoints = append(snapshot.Checkpoints, cp)
+		}
+	}
+	segments := meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == collectionID &&
+			segment.GetState() == commonpb.SegmentState_Flushed &&
+			!segment.GetIsImporting()
+	})
+	for _, segment := range segments {
+		cloned := segment.Clone()
+		if err := binlog.DecompressBinLogs(cloned.SegmentInfo); err != nil {
+			log.Ctx(ctx).Warn("failed to decompress binlogs", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			return nil, err
+		}
+		snapshot.Segments = append(snapshot.Segments, cloned.SegmentInfo)
+		snapshot.SegmentIndexes = append(snapshot.SegmentIndexes, snapshotSegmentIndexes(meta.indexMeta, segment)...)
+	}
+	return snapshot, nil
+}
+
+// snapshotSegmentIndexes locates the index files of the finished builds of the segment,
+// the index files in the bucket of the collection and the partitioned builds are excluded.
+func snapshotSegmentIndexes(indexMeta *indexMeta, segment *SegmentInfo) []*datapb.SnapshotSegmentIndex {
+	if indexMeta == nil {
+		return nil
+	}
+	if _, ok := storage.GetCollectionStorage(segment.GetCollectionID()); ok {
+		return nil
+	}
+	segmentIndexes := make([]*datapb.SnapshotSegmentIndex, 0)
+	for _, segIdx := range indexMeta.GetSegmentIndexes(segment.GetCollectionID(), segment.GetID()) {
+		if segIdx.IndexState != commonpb.IndexState_Finished || len(segIdx.Partitions) > 0 {
+			continue
+		}
+		location := &indexpb.IndexFilesLocation{
+			BuildID:      segIdx.BuildID,
+			IndexVersion: segIdx.IndexVersion,
+			PartitionID:  segIdx.PartitionID,
+			SegmentID:    segIdx.SegmentID,
+		}
+		if segIdx.ReusedFrom != nil {
+			location = model.MarshalIndexFilesLocation(segIdx.ReusedFrom)
+		}
+		segmentIndexes = append(segmentIndexes, &datapb.SnapshotSegmentIndex{
+			SegmentID:   segment.GetID(),
+			FieldID:     indexMeta.GetFieldIDByIndexID(segIdx.CollectionID, segIdx.IndexID),
+			IndexName:   indexMeta.GetIndexNameByID(segIdx.CollectionID, segIdx.IndexID),
+			IndexParams: indexMeta.GetIndexParams(segIdx.CollectionID, segIdx.IndexID),
+			Files: &indexpb.IndexCacheEntry{
+				Location:            location,
+				IndexFileKeys:       common.CloneStringList(segIdx.IndexFileKeys),
+				SerializeSize:       segIdx.IndexSize,
+				NumRows:             segIdx.NumRows,
+				CurrentIndexVersion: segIdx.CurrentIndexVersion,
+			},
+		})
+	}
+	return segmentIndexes
+}
+
+// newSnapshotInfo summarizes the snapshot.
+func newSnapshotInfo(snapshot *datapb.CollectionSnapshot) *datapb.SnapshotInfo {
+	info := &datapb.SnapshotInfo{
+		Name:         snapshot.GetName(),
+		CollectionID: snapshot.GetCollectionID(),
+		Checkpoints:  snapshot.GetCheckpoints(),
+		NumSegments:  int64(len(snapshot.GetSegments())),
+		CreateTs:     snapshot.GetCreateTs(),
+	}
+	for _, segment := range snapshot.GetSegments() {
+		info.NumRows += segment.GetNumOfRows()
+	}
+	return info
+}
+
+// checkSnapshotRestorable checks the snapshot could be restored into the target channels and partitions,
+// each partition of the snapshot segments shall be mapped to a partition of the target collection.
+func checkSnapshotRestorable(snapshot *datapb.CollectionSnapshot, channels []string, partitionIDs []int64, partitionMapping map[int64]int64) error {
+	if len(channels) != len(snapshot.GetChannels()) {
+		return merr.WrapErrParameterInvalidMsg("shards number mismatched, snapshot %d, target %d", len(snapshot.GetChannels()), len(channels))
+	}
+	for _, segment := range snapshot.GetSegments() {
+		if !lo.Contains(snapshot.GetChannels(), segment.GetInsertChannel()) {
+			return merr.WrapErrChannelNotFound(segment.GetInsertChannel())
+		}
+		if segment.GetPartitionID() == common.AllPartitionsID {
+			continue
+		}
+		partitionID, ok := partitionMapping[segment.GetPartitionID()]
+		if !ok {
+			return merr.WrapErrParameterInvalidMsg("partition %d of snapshot not mapped", segment.GetPartitionID())
+		}
+		if !lo.Contains(partitionIDs, partitionID) {
+			return merr.WrapErrPartitionNotFound(partitionID)
+		}
+	}
+	return nil
+}
+
+// cloneSnapshotSegment clones the segment of the snapshot as a flushed segment of the target collection,
+// the binlogs keep the full log paths of the source segment, so that they are shared rather than copied.
+func cloneSnapshotSegment(segment *datapb.SegmentInfo, segmentID, collectionID, partitionID UniqueID,
+	channel string, startPos *msgpb.MsgPosition,
+) *datapb.SegmentInfo {
+	cloned := proto.Clone(segment).(*datapb.SegmentInfo)
+	cloned.ID = segmentID
+	cloned.CollectionID = collectionID
+	cloned.PartitionID = partitionID
+	cloned.InsertChannel = channel
+	cloned.State = commonpb.SegmentState_Flushed
+	cloned.StartPosition = startPos
+	cloned.DmlPosition = proto.Clone(startPos).(*msgpb.MsgPosition)
+	cloned.CompactionFrom = nil
+	cloned.Compacted = false
+	cloned.DroppedAt = 0
+	cloned.IsImporting = false
+	return cloned
+}
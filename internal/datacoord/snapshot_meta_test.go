@@ -0,0 +1,221 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	broker2 "github.com/milvus-io/milvus/internal/datacoord/broker"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type SnapshotSuite struct {
+	suite.Suite
+
+	server  *Server
+	catalog *mocks.DataCoordCatalog
+	broker  *broker2.MockBroker
+	alloc   *NMockAllocator
+}
+
+func (s *SnapshotSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *SnapshotSuite) SetupTest() {
+	s.catalog = mocks.NewDataCoordCatalog(s.T())
+	s.catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
+	snapshotMeta, err := newSnapshotMeta(context.Background(), s.catalog)
+	s.Require().NoError(err)
+
+	meta := &meta{
+		ctx:          context.Background(),
+		catalog:      s.catalog,
+		collections:  make(map[UniqueID]*collectionInfo),
+		segments:     NewSegmentsInfo(),
+		channelCPs:   newChannelCps(),
+		snapshotMeta: snapshotMeta,
+	}
+	meta.channelCPs.checkpoints["ch-1"] = &msgpb.MsgPosition{ChannelName: "ch-1", Timestamp: 1000}
+	for id, state := range map[int64]commonpb.SegmentState{
+		1: commonpb.SegmentState_Flushed,
+		2: commonpb.SegmentState_Growing,
+		3: commonpb.SegmentState_Dropped,
+	} {
+		meta.segments.SetSegment(id, NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  100,
+			PartitionID:   10,
+			InsertChannel: "ch-1",
+			State:         state,
+			NumOfRows:     100,
+			StartPosition: &msgpb.MsgPosition{ChannelName: "ch-1", MsgID: []byte{1}},
+			Binlogs: []*datapb.FieldBinlog{{FieldID: 101, Binlogs: []*datapb.Binlog{
+				{LogID: 1},
+			}}},
+		}))
+	}
+
+	s.broker = broker2.NewMockBroker(s.T())
+	s.alloc = NewNMockAllocator(s.T())
+	s.server = &Server{meta: meta, broker: s.broker, allocator: s.alloc}
+	s.server.stateCode.Store(commonpb.StateCode_Healthy)
+}
+
+func (s *SnapshotSuite) createSnapshot() {
+	s.broker.EXPECT().DescribeCollectionInternal(mock.Anything, int64(100)).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionID:        100,
+		VirtualChannelNames: []string{"ch-1"},
+	}, nil).Once()
+	s.alloc.EXPECT().allocTimestamp(mock.Anything).Return(2000, nil).Once()
+	s.catalog.EXPECT().SaveSnapshot(mock.Anything, mock.Anything).Return(nil).Once()
+
+	status, err := s.server.CreateSnapshot(context.Background(), &datapb.CreateSnapshotRequest{CollectionID: 100, Name: "snapshot"})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+}
+
+func (s *SnapshotSuite) TestCreateAndList() {
+	s.createSnapshot()
+
+	snapshot := s.server.meta.snapshotMeta.GetSnapshot(100, "snapshot")
+	s.Require().NotNil(snapshot)
+	s.Require().Len(snapshot.GetSegments(), 1)
+	s.EqualValues(1, snapshot.GetSegments()[0].GetID())
+	// log paths are decompressed
+	s.Equal(metautil.BuildInsertLogPath(paramtable.Get().MinioCfg.RootPath.GetValue(), 100, 10, 1, 101, 1),
+		snapshot.GetSegments()[0].GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+	s.Empty(s.server.meta.GetSegment(1).GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+
+	resp, err := s.server.ListSnapshots(context.Background(), &datapb.ListSnapshotsRequest{CollectionID: 100})
+	s.NoError(err)
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.Require().Len(resp.GetSnapshots(), 1)
+	s.EqualValues(1, resp.GetSnapshots()[0].GetNumSegments())
+	s.EqualValues(100, resp.GetSnapshots()[0].GetNumRows())
+	s.EqualValues(2000, resp.GetSnapshots()[0].GetCreateTs())
+	s.Len(resp.GetSnapshots()[0].GetCheckpoints(), 1)
+
+	// duplicated
+	s.broker.EXPECT().DescribeCollectionInternal(mock.Anything, int64(100)).Return(&milvuspb.DescribeCollectionResponse{}, nil).Once()
+	s.alloc.EXPECT().allocTimestamp(mock.Anything).Return(3000, nil).Once()
+	status, err := s.server.CreateSnapshot(context.Background(), &datapb.CreateSnapshotRequest{CollectionID: 100, Name: "snapshot"})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(status), merr.ErrParameterInvalid)
+
+	s.catalog.EXPECT().DropSnapshot(mock.Anything, int64(100), "snapshot").Return(nil).Once()
+	status, err = s.server.DropSnapshot(context.Background(), &datapb.DropSnapshotRequest{CollectionID: 100, Name: "snapshot"})
+	s.NoError(err)
+	s.NoError(merr.Error(status))
+	s.Empty(s.server.meta.snapshotMeta.ListSnapshots(0))
+
+	status, err = s.server.DropSnapshot(context.Background(), &datapb.DropSnapshotRequest{CollectionID: 100, Name: "snapshot"})
+	s.NoError(err)
+	s.ErrorIs(merr.Error(status), merr.ErrParameterInvalid)
+}
+
+func (s *SnapshotSuite) TestRestore() {
+	s.createSnapshot()
+
+	target := &milvuspb.DescribeCollectionResponse{
+		CollectionID:        200,
+		VirtualChannelNames: []string{"dml_1_200v0"},
+		StartPositions:      []*commonpb.KeyDataPair{{Key: "dml_1", Data: []byte{2}}},
+		CreatedTimestamp:    3000,
+	}
+	s.broker.EXPECT().DescribeCollectionInternal(mock.Anything, int64(200)).Return(target, nil)
+	s.broker.EXPECT().ShowPartitionsInternal(mock.Anything, int64(200)).Return([]int64{20}, nil)
+
+	s.Run("partition not mapped", func() {
+		resp, err := s.server.RestoreSnapshot(context.Background(), &datapb.RestoreSnapshotRequest{
+			CollectionID: 100, Name: "snapshot", TargetCollectionID: 200,
+		})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	s.Run("normal", func() {
+		s.alloc.EXPECT().allocN(int64(1)).Return(1000, 1001, nil).Once()
+		s.catalog.EXPECT().AddSegment(mock.Anything, mock.Anything).Return(nil).Once()
+		resp, err := s.server.RestoreSnapshot(context.Background(), &datapb.RestoreSnapshotRequest{
+			CollectionID: 100, Name: "snapshot", TargetCollectionID: 200,
+			PartitionMapping: map[int64]int64{10: 20},
+		})
+		s.NoError(err)
+		s.NoError(merr.Error(resp.GetStatus()))
+		s.Equal([]int64{1000}, resp.GetSegmentIDs())
+		s.EqualValues(100, resp.GetNumRows())
+
+		cloned := s.server.meta.GetSegment(1000)
+		s.Require().NotNil(cloned)
+		s.EqualValues(200, cloned.GetCollectionID())
+		s.EqualValues(20, cloned.GetPartitionID())
+		s.Equal("dml_1_200v0", cloned.GetInsertChannel())
+		s.Equal(commonpb.SegmentState_Flushed, cloned.GetState())
+		s.Equal([]byte{2}, cloned.GetDmlPosition().GetMsgID())
+		s.EqualValues(3000, cloned.GetDmlPosition().GetTimestamp())
+		// binlogs shared with the source segment
+		snapshot := s.server.meta.snapshotMeta.GetSnapshot(100, "snapshot")
+		s.Equal(snapshot.GetSegments()[0].GetBinlogs()[0].GetBinlogs()[0].GetLogPath(),
+			cloned.GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+	})
+
+	s.Run("target not empty", func() {
+		resp, err := s.server.RestoreSnapshot(context.Background(), &datapb.RestoreSnapshotRequest{
+			CollectionID: 100, Name: "snapshot", TargetCollectionID: 200,
+			PartitionMapping: map[int64]int64{10: 20},
+		})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	s.Run("snapshot not found", func() {
+		resp, err := s.server.RestoreSnapshot(context.Background(), &datapb.RestoreSnapshotRequest{
+			CollectionID: 100, Name: "other", TargetCollectionID: 200,
+		})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+}
+
+func (s *SnapshotSuite) TestCheckSnapshotRestorable() {
+	snapshot := &datapb.CollectionSnapshot{
+		Channels: []string{"ch-1", "ch-2"},
+		Segments: []*datapb.SegmentInfo{
+			{ID: 1, PartitionID: 10, InsertChannel: "ch-1"},
+			{ID: 2, PartitionID: -1, InsertChannel: "ch-2"},
+		},
+	}
+	s.NoError(checkSnapshotRestorable(snapshot, []string{"a", "b"}, []int64{20}, map[int64]int64{10: 20}))
+	s.ErrorIs(checkSnapshotRestorable(snapshot, []string{"a"}, []int64{20}, map[int64]int64{10: 20}), merr.ErrParameterInvalid)
+	s.ErrorIs(checkSnapshotRestorable(snapshot, []string{"a", "b"}, []int64{30}, map[int64]int64{10: 20}), merr.ErrPartitionNotFound)
+}
+
+func TestSnapshot(t *testing.T) {
+	suite.Run(t, new(SnapshotSuite))
+}
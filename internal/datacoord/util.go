@@ -18,6 +18,8 @@ package datacoord
 
 import (
 	"context"
+	"encoding/json"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -27,6 +29,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -208,6 +211,39 @@ func parseBuildIDFromFilePath(key string) (UniqueID, error) {
 	return strconv.ParseInt(ss[len(ss)-1], 10, 64)
 }
 
+// getFlushedRanges returns the timestamp ranges of the insert binlogs of the segment synced batch by batch,
+// the overlapped ranges are merged. All the fields are synced in the same batches, so the binlogs of
+// the first field are used, and the binlogs without timestamp range are skipped.
+func getFlushedRanges(segment *datapb.SegmentInfo) []*datapb.FlushedRange {
+	if len(segment.GetBinlogs()) == 0 {
+		return nil
+	}
+	binlogs := lo.Filter(segment.GetBinlogs()[0].GetBinlogs(), func(binlog *datapb.Binlog, _ int) bool {
+		return binlog.GetTimestampTo() > 0
+	})
+	sort.Slice(binlogs, func(i, j int) bool {
+		return binlogs[i].GetTimestampFrom() < binlogs[j].GetTimestampFrom()
+	})
+
+	var ranges []*datapb.FlushedRange
+	for _, binlog := range binlogs {
+		if len(ranges) > 0 && binlog.GetTimestampFrom() <= ranges[len(ranges)-1].GetTimestampTo() {
+			last := ranges[len(ranges)-1]
+			if binlog.GetTimestampTo() > last.TimestampTo {
+				last.TimestampTo = binlog.GetTimestampTo()
+			}
+			last.NumRows += binlog.GetEntriesNum()
+			continue
+		}
+		ranges = append(ranges, &datapb.FlushedRange{
+			TimestampFrom: binlog.GetTimestampFrom(),
+			TimestampTo:   binlog.GetTimestampTo(),
+			NumRows:       binlog.GetEntriesNum(),
+		})
+	}
+	return ranges
+}
+
 func getFieldBinlogs(id UniqueID, binlogs []*datapb.FieldBinlog) *datapb.FieldBinlog {
 	for _, binlog := range binlogs {
 		if id == binlog.GetFieldID() {
@@ -229,6 +265,82 @@ func mergeFieldBinlogs(currentBinlogs []*datapb.FieldBinlog, newBinlogs []*datap
 	return currentBinlogs
 }
 
+// mergeArtifactLogs appends the new artifact logs to the ones of the same field and generator.
+func mergeArtifactLogs(current []*datapb.FieldArtifactLogs, newLogs []*datapb.FieldArtifactLogs) []*datapb.FieldArtifactLogs {
+	for _, newLog := range newLogs {
+		logs, ok := lo.Find(current, func(logs *datapb.FieldArtifactLogs) bool {
+			return logs.GetFieldID() == newLog.GetFieldID() && logs.GetName() == newLog.GetName()
+		})
+		if !ok {
+			current = append(current, newLog)
+			continue
+		}
+		logs.Binlogs = append(logs.Binlogs, newLog.GetBinlogs()...)
+	}
+	return current
+}
+
+// mergeParquetLogs appends the new parquet logs to the ones of the same field group.
+func mergeParquetLogs(current []*datapb.FieldGroupParquetLogs, newLogs []*datapb.FieldGroupParquetLogs) []*datapb.FieldGroupParquetLogs {
+	for _, newLog := range newLogs {
+		logs, ok := lo.Find(current, func(logs *datapb.FieldGroupParquetLogs) bool {
+			return logs.GetGroupID() == newLog.GetGroupID()
+		})
+		if !ok {
+			current = append(current, newLog)
+			continue
+		}
+		logs.Binlogs = append(logs.Binlogs, newLog.GetBinlogs()...)
+	}
+	return current
+}
+
+// mergeScalarStats merges the scalar stats of the newly synced rows into the current ones of the segment,
+// the stats of a field are kept only if they cover all the rows of the segment.
+func mergeScalarStats(current []*datapb.FieldScalarStats, hasRows bool, incoming []*datapb.FieldScalarStats) []*datapb.FieldScalarStats {
+	if !hasRows {
+		return incoming
+	}
+	incomingStats := lo.SliceToMap(incoming, func(stats *datapb.FieldScalarStats) (int64, *datapb.FieldScalarStats) {
+		return stats.GetFieldID(), stats
+	})
+	var result []*datapb.FieldScalarStats
+	for _, stats := range current {
+		other, ok := incomingStats[stats.GetFieldID()]
+		if !ok {
+			continue
+		}
+		merged, err := mergeFieldScalarStats(stats, other)
+		if err != nil {
+			log.Warn("failed to merge scalar stats, drop the stats of the field", zap.Int64("fieldID", stats.GetFieldID()), zap.Error(err))
+			continue
+		}
+		result = append(result, merged)
+	}
+	return result
+}
+
+func mergeFieldScalarStats(current, other *datapb.FieldScalarStats) (*datapb.FieldScalarStats, error) {
+	stats := &storage.FieldStats{}
+	if err := json.Unmarshal(current.GetStats(), stats); err != nil {
+		return nil, err
+	}
+	otherStats := &storage.FieldStats{}
+	if err := json.Unmarshal(other.GetStats(), otherStats); err != nil {
+		return nil, err
+	}
+	stats.Merge(otherStats)
+	bs, err := json.Marshal(stats)
+	if err != nil {
+		return nil, err
+	}
+	return &datapb.FieldScalarStats{
+		FieldID:   current.GetFieldID(),
+		NullCount: current.GetNullCount() + other.GetNullCount(),
+		Stats:     bs,
+	}, nil
+}
+
 func calculateL0SegmentSize(fields []*datapb.FieldBinlog) float64 {
 	size := int64(0)
 	for _, field := range fields {
@@ -118,6 +118,32 @@ func (suite *UtilSuite) TestVerifyResponse() {
 	}
 }
 
+func (suite *UtilSuite) TestGetFlushedRanges() {
+	suite.Nil(getFlushedRanges(&datapb.SegmentInfo{}))
+
+	segment := &datapb.SegmentInfo{
+		Binlogs: []*datapb.FieldBinlog{{
+			FieldID: 100,
+			Binlogs: []*datapb.Binlog{
+				{TimestampFrom: 300, TimestampTo: 400, EntriesNum: 10},
+				{TimestampFrom: 100, TimestampTo: 200, EntriesNum: 10},
+				// overlapped with the previous one
+				{TimestampFrom: 350, TimestampTo: 500, EntriesNum: 5},
+				// without timestamp range
+				{EntriesNum: 1},
+			},
+		}},
+	}
+	ranges := getFlushedRanges(segment)
+	suite.Require().Len(ranges, 2)
+	suite.EqualValues(100, ranges[0].GetTimestampFrom())
+	suite.EqualValues(200, ranges[0].GetTimestampTo())
+	suite.EqualValues(10, ranges[0].GetNumRows())
+	suite.EqualValues(300, ranges[1].GetTimestampFrom())
+	suite.EqualValues(500, ranges[1].GetTimestampTo())
+	suite.EqualValues(15, ranges[1].GetNumRows())
+}
+
 func TestUtil(t *testing.T) {
 	suite.Run(t, new(UtilSuite))
 }
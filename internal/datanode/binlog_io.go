@@ -24,6 +24,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/datanode/allocator"
 	"github.com/milvus-io/milvus/internal/datanode/io"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
@@ -106,7 +107,7 @@ func genInsertBlobs(b io.BinlogIO, allocator allocator.Allocator, data *InsertDa
 		kvs[key] = value
 		inpaths[fID] = &datapb.FieldBinlog{
 			FieldID: fID,
-			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key, EntriesNum: blob.RowNum}},
+			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key, EntriesNum: blob.RowNum, Compression: blob.Compression}},
 		}
 	}
 
@@ -170,6 +171,102 @@ func uploadStatsLog(
 	return statPaths, nil
 }
 
+// uploadArtifactLogs uploads the artifacts of the segment, each covers all the rows of the segment.
+func uploadArtifactLogs(
+	ctx context.Context,
+	b io.BinlogIO,
+	allocator allocator.Allocator,
+	collectionID UniqueID,
+	partID UniqueID,
+	segID UniqueID,
+	artifacts []*storage.StatsArtifact,
+	totRows int64,
+) ([]*datapb.FieldArtifactLogs, error) {
+	if len(artifacts) == 0 {
+		return nil, nil
+	}
+	ctx, span := otel.Tracer(typeutil.DataNodeRole).Start(ctx, "UploadArtifactLog")
+	defer span.End()
+	kvs := make(map[string][]byte)
+
+	artifactLogs := make([]*datapb.FieldArtifactLogs, 0, len(artifacts))
+	for _, artifact := range artifacts {
+		idx, err := allocator.AllocOne()
+		if err != nil {
+			return nil, err
+		}
+		k := metautil.JoinIDPath(collectionID, partID, segID, artifact.FieldID, idx)
+		key := b.JoinFullPath(common.SegmentArtifactLogPath, k)
+		kvs[key] = artifact.Value
+		artifactLogs = append(artifactLogs, &datapb.FieldArtifactLogs{
+			FieldID: artifact.FieldID,
+			Name:    artifact.Name,
+			Binlogs: []*datapb.Binlog{{LogSize: int64(len(artifact.Value)), LogPath: key, EntriesNum: totRows}},
+		})
+	}
+
+	err := b.Upload(ctx, kvs)
+	if err != nil {
+		return nil, err
+	}
+	return artifactLogs, nil
+}
+
+// uploadParquetLogs uploads the rows of the insert data as the parquet files of the field groups.
+func uploadParquetLogs(
+	ctx context.Context,
+	b io.BinlogIO,
+	allocator allocator.Allocator,
+	collectionID UniqueID,
+	partID UniqueID,
+	segID UniqueID,
+	schema *schemapb.CollectionSchema,
+	iData *InsertData,
+	timestampFrom, timestampTo uint64,
+) ([]*datapb.FieldGroupParquetLogs, error) {
+	if iData.IsEmpty() {
+		return nil, nil
+	}
+	ctx, span := otel.Tracer(typeutil.DataNodeRole).Start(ctx, "UploadParquetLog")
+	defer span.End()
+	kvs := make(map[string][]byte)
+
+	compression := storage.GetBinlogCompression()
+	groups := storage.NewParquetFieldGroups(schema)
+	parquetLogs := make([]*datapb.FieldGroupParquetLogs, 0, len(groups))
+	for _, group := range groups {
+		value, err := storage.SerializeParquetGroup(group, iData, compression)
+		if err != nil {
+			return nil, err
+		}
+		idx, err := allocator.AllocOne()
+		if err != nil {
+			return nil, err
+		}
+		k := metautil.JoinIDPath(collectionID, partID, segID, group.GroupID, idx)
+		key := b.JoinFullPath(common.SegmentParquetLogPath, k) + ".parquet"
+		kvs[key] = value
+		parquetLogs = append(parquetLogs, &datapb.FieldGroupParquetLogs{
+			GroupID:  group.GroupID,
+			FieldIDs: group.FieldIDs(),
+			Binlogs: []*datapb.Binlog{{
+				EntriesNum:    int64(iData.GetRowNum()),
+				TimestampFrom: timestampFrom,
+				TimestampTo:   timestampTo,
+				LogPath:       key,
+				LogSize:       int64(len(value)),
+				Compression:   compression.Codec,
+			}},
+		})
+	}
+
+	err := b.Upload(ctx, kvs)
+	if err != nil {
+		return nil, err
+	}
+	return parquetLogs, nil
+}
+
 func uploadInsertLog(
 	ctx context.Context,
 	b io.BinlogIO,
@@ -157,6 +157,24 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 			assert.Error(t, err)
 		})
 	})
+
+	t.Run("Test upload artifact logs", func(t *testing.T) {
+		alloc := allocator.NewMockAllocator(t)
+		alloc.EXPECT().AllocOne().Return(int64(100), nil)
+		binlogIO := io.NewBinlogIO(cm, getOrCreateIOPool())
+		artifactLogs, err := uploadArtifactLogs(context.Background(), binlogIO, alloc, 1, 10, 100, []*storage.StatsArtifact{
+			{FieldID: 101, Name: storage.ScalarBloomFilterArtifact, Value: []byte("bf")},
+		}, 10)
+		assert.NoError(t, err)
+		assert.Len(t, artifactLogs, 1)
+		assert.EqualValues(t, 101, artifactLogs[0].GetFieldID())
+		assert.Equal(t, storage.ScalarBloomFilterArtifact, artifactLogs[0].GetName())
+		assert.EqualValues(t, 10, artifactLogs[0].GetBinlogs()[0].GetEntriesNum())
+
+		values, err := downloadBlobs(context.Background(), binlogIO, []string{artifactLogs[0].GetBinlogs()[0].GetLogPath()})
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("bf"), values[0].GetValue())
+	})
 }
 
 func prepareBlob(cm storage.ChunkManager, key string) ([]byte, string, error) {
@@ -0,0 +1,150 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	sio "io"
+
+	"github.com/milvus-io/milvus/internal/datanode/io"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// compactionVerifier records the rows read from the compaction inputs, and verifies the compacted segment
+// written against them, to catch the silent corruption before the compaction result committed.
+type compactionVerifier struct {
+	readRows    int64
+	droppedRows int64 // rows deleted or expired
+	// checksum is the order independent checksum of the primary keys and timestamps of the rows kept
+	checksum uint64
+	// pks counts the occurrences of the primary keys kept
+	pks map[any]int64
+}
+
+func newCompactionVerifier() *compactionVerifier {
+	return &compactionVerifier{
+		pks: make(map[any]int64),
+	}
+}
+
+// read records the row read from the inputs, and whether it is kept in the compacted segment.
+func (v *compactionVerifier) read(value *storage.Value, kept bool) {
+	v.readRows++
+	if !kept {
+		v.droppedRows++
+		return
+	}
+	v.pks[value.PK.GetValue()]++
+	v.checksum += rowChecksum(value.PK, value.Timestamp)
+}
+
+// verify checks the rows read against the row number of the inputs, and reads back the compacted segment
+// to check its row count, primary key uniqueness and checksum.
+func (v *compactionVerifier) verify(ctx context.Context, binlogIO io.BinlogIO, inputRows int64, insertLogs []*datapb.FieldBinlog, numRows int64, pkID int64) error {
+	if v.readRows != inputRows {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("compaction verification failed, read %d rows from the inputs of %d rows", v.readRows, inputRows))
+	}
+	if v.readRows-v.droppedRows != numRows {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("compaction verification failed, %d rows kept but %d rows written", v.readRows-v.droppedRows, numRows))
+	}
+
+	var binlogNum int
+	for _, fieldBinlog := range insertLogs {
+		if fieldBinlog.GetFieldID() == pkID {
+			binlogNum = len(fieldBinlog.GetBinlogs())
+		}
+	}
+
+	var (
+		rows     int64
+		checksum uint64
+	)
+	for idx := 0; idx < binlogNum; idx++ {
+		paths := make([]string, 0, len(insertLogs))
+		for _, fieldBinlog := range insertLogs {
+			binlogs := fieldBinlog.GetBinlogs()
+			if idx >= len(binlogs) {
+				return merr.WrapErrServiceInternal(fmt.Sprintf("compaction verification failed, binlogs of field %d missing", fieldBinlog.GetFieldID()))
+			}
+			paths = append(paths, binlogs[idx].GetLogPath())
+		}
+		blobs, err := downloadBlobs(ctx, binlogIO, paths)
+		if err != nil {
+			return err
+		}
+		iter, err := storage.NewBinlogDeserializeReader(blobs, pkID)
+		if err != nil {
+			return err
+		}
+		for {
+			err := iter.Next()
+			if err == sio.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			value := iter.Value()
+			pk := value.PK.GetValue()
+			if v.pks[pk] <= 0 {
+				return merr.WrapErrServiceInternal(fmt.Sprintf("compaction verification failed, primary key %v duplicated or unknown", pk))
+			}
+			v.pks[pk]--
+			rows++
+			checksum += rowChecksum(value.PK, value.Timestamp)
+		}
+	}
+
+	if rows != numRows {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("compaction verification failed, %d rows expected but %d rows read back", numRows, rows))
+	}
+	if checksum != v.checksum {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("compaction verification failed, checksum %d expected but got %d", v.checksum, checksum))
+	}
+	return nil
+}
+
+// getCompactionInputRows returns the total row number of the insert binlogs of the plan.
+func getCompactionInputRows(plan *datapb.CompactionPlan) int64 {
+	var rows int64
+	for _, segment := range plan.GetSegmentBinlogs() {
+		for _, fieldBinlog := range segment.GetFieldBinlogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				rows += binlog.GetEntriesNum()
+			}
+			break
+		}
+	}
+	return rows
+}
+
+func rowChecksum(pk storage.PrimaryKey, ts int64) uint64 {
+	h := fnv.New64a()
+	switch value := pk.GetValue().(type) {
+	case int64:
+		h.Write(binary.LittleEndian.AppendUint64(nil, uint64(value)))
+	case string:
+		h.Write([]byte(value))
+	}
+	h.Write(binary.LittleEndian.AppendUint64(nil, uint64(ts)))
+	return h.Sum64()
+}
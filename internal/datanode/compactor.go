@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -79,6 +80,11 @@ type compactionTask struct {
 	allocator.Allocator
 
 	plan *datapb.CompactionPlan
+	// verifier verifies the compacted segment against the inputs, nil if verification disabled
+	verifier *compactionVerifier
+	// parquetLogs are the parquet files of the compacted segment written by merge,
+	// only if the parquet segment storage enabled
+	parquetLogs []*datapb.FieldGroupParquetLogs
 
 	ctx    context.Context
 	cancel context.CancelFunc
@@ -218,6 +224,29 @@ func (t *compactionTask) uploadSingleInsertLog(
 	return inPaths, nil
 }
 
+// uploadScalarStats serializes the scalar stats of the compacted segment, the bloom filters are uploaded as the artifact logs.
+func (t *compactionTask) uploadScalarStats(
+	ctx context.Context,
+	targetSegID UniqueID,
+	partID UniqueID,
+	meta *etcdpb.CollectionMeta,
+	collector *storage.ScalarStatsCollector,
+	numRows int64,
+) ([]*datapb.FieldScalarStats, []*datapb.FieldArtifactLogs, error) {
+	if collector == nil {
+		return nil, nil, nil
+	}
+	scalarStats, artifacts, err := syncmgr.SerializeScalarStats(collector)
+	if err != nil {
+		return nil, nil, err
+	}
+	artifactLogs, err := uploadArtifactLogs(ctx, t.binlogIO, t.Allocator, meta.GetID(), partID, targetSegID, artifacts, numRows)
+	if err != nil {
+		return nil, nil, err
+	}
+	return scalarStats, artifactLogs, nil
+}
+
 func (t *compactionTask) merge(
 	ctx context.Context,
 	unMergedInsertlogs [][]string,
@@ -225,7 +254,7 @@ func (t *compactionTask) merge(
 	partID UniqueID,
 	meta *etcdpb.CollectionMeta,
 	delta map[interface{}]Timestamp,
-) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, int64, error) {
+) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, *storage.ScalarStatsCollector, int64, error) {
 	ctx, span := otel.Tracer(typeutil.DataNodeRole).Start(ctx, fmt.Sprintf("CompactMerge-%d", t.getPlanID()))
 	defer span.End()
 	log := log.With(zap.Int64("planID", t.getPlanID()))
@@ -244,7 +273,38 @@ func (t *compactionTask) merge(
 	)
 	writeBuffer, err := storage.NewInsertData(meta.GetSchema())
 	if err != nil {
-		return nil, nil, -1, err
+		return nil, nil, nil, -1, err
+	}
+
+	// the compacted rows are written as the parquet files as well, the deleted rows have been filtered out
+	t.parquetLogs = nil
+	writeParquet := paramtable.Get().DataNodeCfg.ParquetSegmentEnabled.GetAsBool()
+	uploadParquet := func(data *storage.InsertData, timestampFrom, timestampTo int64) error {
+		if !writeParquet {
+			return nil
+		}
+		parquetLogs, err := uploadParquetLogs(ctx, t.binlogIO, t.Allocator, meta.GetID(), partID, targetSegID,
+			meta.GetSchema(), data, uint64(timestampFrom), uint64(timestampTo))
+		if err != nil {
+			return err
+		}
+		for _, newLogs := range parquetLogs {
+			logs, ok := lo.Find(t.parquetLogs, func(logs *datapb.FieldGroupParquetLogs) bool {
+				return logs.GetGroupID() == newLogs.GetGroupID()
+			})
+			if !ok {
+				t.parquetLogs = append(t.parquetLogs, newLogs)
+				continue
+			}
+			logs.Binlogs = append(logs.Binlogs, newLogs.GetBinlogs()...)
+		}
+		return nil
+	}
+
+	var scalarStats *storage.ScalarStatsCollector
+	if paramtable.Get().DataNodeCfg.ScalarStatsEnabled.GetAsBool() {
+		scalarStats = storage.NewScalarStatsCollector(meta.GetSchema(), paramtable.Get().DataNodeCfg.ScalarStatsBloomFilter.GetAsBool(),
+			paramtable.Get().DataNodeCfg.ScalarStatsCardinality.GetAsBool())
 	}
 
 	isDeletedValue := func(v *storage.Value) bool {
@@ -296,12 +356,20 @@ func (t *compactionTask) merge(
 
 	if pkField == nil {
 		log.Warn("failed to get pk field from schema")
-		return nil, nil, 0, fmt.Errorf("no pk field in schema")
+		return nil, nil, nil, 0, fmt.Errorf("no pk field in schema")
 	}
 
 	pkID := pkField.GetFieldID()
 	pkType := pkField.GetDataType()
 
+	// the rows written before a field added to the collection are filled with its default value
+	defaultRow := make(map[UniqueID]interface{})
+	for _, fs := range meta.GetSchema().GetFields() {
+		if v, ok := storage.GetDefaultValueRow(fs); ok {
+			defaultRow[fs.GetFieldID()] = v
+		}
+	}
+
 	expired = 0
 	numRows = 0
 	numBinlogs = 0
@@ -312,12 +380,12 @@ func (t *compactionTask) merge(
 
 	oldRowNums, err := t.getNumRows()
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, nil, 0, err
 	}
 
 	stats, err := storage.NewPrimaryKeyStats(pkID, int64(pkType), oldRowNums)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, nil, 0, err
 	}
 	// initial timestampFrom, timestampTo = -1, -1 is an illegal value, only to mark initial state
 	var (
@@ -325,19 +393,75 @@ func (t *compactionTask) merge(
 		timestampFrom int64 = -1
 	)
 
+	// the rows are sorted by the sort key before written when the collection has a scalar
+	// clustering key, so each compacted segment and binlog covers a narrow range of the key.
+	sortKeyField := typeutil2.GetSortKeyField(meta.GetSchema())
+	var sorter *keySorter
+	if sortKeyField != nil {
+		sorter = newKeySorter(meta, sortKeyField.GetFieldID(), pkID, sortSpillPath(),
+			paramtable.Get().DataNodeCfg.CompactionSortBufferSize.GetAsInt64())
+		// remove the spilled runs if failed before sorted
+		defer sorter.cleanup()
+	}
+
+	writeValue := func(v *storage.Value) error {
+		// Update timestampFrom, timestampTo
+		if v.Timestamp < timestampFrom || timestampFrom == -1 {
+			timestampFrom = v.Timestamp
+		}
+		if v.Timestamp > timestampTo || timestampFrom == -1 {
+			timestampTo = v.Timestamp
+		}
+
+		err := writeBuffer.Append(v.Value.(map[UniqueID]interface{}))
+		if err != nil {
+			return err
+		}
+
+		currentRows++
+		stats.Update(v.PK)
+
+		// check size every 100 rows in case of too many `GetMemorySize` call
+		if (currentRows+1)%100 == 0 && writeBuffer.GetMemorySize() > paramtable.Get().DataNodeCfg.BinLogMaxSize.GetAsInt() {
+			numRows += int64(writeBuffer.GetRowNum())
+			if scalarStats != nil {
+				scalarStats.Update(writeBuffer)
+			}
+			uploadInsertStart := time.Now()
+			inPaths, err := t.uploadSingleInsertLog(ctx, targetSegID, partID, meta, writeBuffer)
+			if err != nil {
+				log.Warn("failed to upload single insert log", zap.Error(err))
+				return err
+			}
+			if err := uploadParquet(writeBuffer, timestampFrom, timestampTo); err != nil {
+				log.Warn("failed to upload parquet logs", zap.Error(err))
+				return err
+			}
+			uploadInsertTimeCost += time.Since(uploadInsertStart)
+			addInsertFieldPath(inPaths, timestampFrom, timestampTo)
+			timestampFrom = -1
+			timestampTo = -1
+
+			writeBuffer, _ = storage.NewInsertData(meta.GetSchema())
+			currentRows = 0
+			numBinlogs++
+		}
+		return nil
+	}
+
 	for _, path := range unMergedInsertlogs {
 		downloadStart := time.Now()
 		data, err := downloadBlobs(ctx, t.binlogIO, path)
 		if err != nil {
 			log.Warn("download insertlogs wrong", zap.Strings("path", path), zap.Error(err))
-			return nil, nil, 0, err
+			return nil, nil, nil, 0, err
 		}
 		downloadTimeCost += time.Since(downloadStart)
 
 		iter, err := storage.NewBinlogDeserializeReader(data, pkID)
 		if err != nil {
 			log.Warn("new insert binlogs reader wrong", zap.Strings("path", path), zap.Error(err))
-			return nil, nil, 0, err
+			return nil, nil, nil, 0, err
 		}
 
 		for {
@@ -347,11 +471,14 @@ func (t *compactionTask) merge(
 					break
 				} else {
 					log.Warn("transfer interface to Value wrong", zap.Strings("path", path))
-					return nil, nil, 0, errors.New("unexpected error")
+					return nil, nil, nil, 0, errors.New("unexpected error")
 				}
 			}
 			v := iter.Value()
 			if isDeletedValue(v) {
+				if t.verifier != nil {
+					t.verifier.read(v, false)
+				}
 				continue
 			}
 
@@ -359,60 +486,72 @@ func (t *compactionTask) merge(
 			// Filtering expired entity
 			if t.isExpiredEntity(ts, currentTs) {
 				expired++
+				if t.verifier != nil {
+					t.verifier.read(v, false)
+				}
 				continue
 			}
-
-			// Update timestampFrom, timestampTo
-			if v.Timestamp < timestampFrom || timestampFrom == -1 {
-				timestampFrom = v.Timestamp
-			}
-			if v.Timestamp > timestampTo || timestampFrom == -1 {
-				timestampTo = v.Timestamp
+			if t.verifier != nil {
+				t.verifier.read(v, true)
 			}
 
 			row, ok := v.Value.(map[UniqueID]interface{})
 			if !ok {
 				log.Warn("transfer interface to map wrong", zap.Strings("path", path))
-				return nil, nil, 0, errors.New("unexpected error")
+				return nil, nil, nil, 0, errors.New("unexpected error")
 			}
-
-			err = writeBuffer.Append(row)
-			if err != nil {
-				return nil, nil, 0, err
+			for fID, v := range defaultRow {
+				if _, ok := row[fID]; !ok {
+					row[fID] = v
+				}
 			}
 
-			currentRows++
-			stats.Update(v.PK)
-
-			// check size every 100 rows in case of too many `GetMemorySize` call
-			if (currentRows+1)%100 == 0 && writeBuffer.GetMemorySize() > paramtable.Get().DataNodeCfg.BinLogMaxSize.GetAsInt() {
-				numRows += int64(writeBuffer.GetRowNum())
-				uploadInsertStart := time.Now()
-				inPaths, err := t.uploadSingleInsertLog(ctx, targetSegID, partID, meta, writeBuffer)
+			if sortKeyField != nil {
+				// the value returned by the iterator is reused
+				err := sorter.Add(&storage.Value{
+					ID:        v.ID,
+					PK:        v.PK,
+					Timestamp: v.Timestamp,
+					Value:     lo.Assign(row),
+				})
 				if err != nil {
-					log.Warn("failed to upload single insert log", zap.Error(err))
-					return nil, nil, 0, err
+					log.Warn("failed to spill sorted rows", zap.Error(err))
+					return nil, nil, nil, 0, err
 				}
-				uploadInsertTimeCost += time.Since(uploadInsertStart)
-				addInsertFieldPath(inPaths, timestampFrom, timestampTo)
-				timestampFrom = -1
-				timestampTo = -1
-
-				writeBuffer, _ = storage.NewInsertData(meta.GetSchema())
-				currentRows = 0
-				numBinlogs++
+				continue
 			}
+			if err := writeValue(v); err != nil {
+				return nil, nil, nil, 0, err
+			}
+		}
+	}
+
+	if sortKeyField != nil {
+		sortStart := time.Now()
+		spilledRuns := len(sorter.runs)
+		if err := sorter.Sort(writeValue); err != nil {
+			log.Warn("failed to write rows sorted by sort key", zap.Error(err))
+			return nil, nil, nil, 0, err
 		}
+		log.Info("compact sorted rows by sort key", zap.Int64("sortKeyFieldID", sortKeyField.GetFieldID()),
+			zap.Int("spilledRuns", spilledRuns), zap.Duration("elapse", time.Since(sortStart)))
 	}
 
 	// upload stats log and remain insert rows
 	if writeBuffer.GetRowNum() > 0 || numRows > 0 {
 		numRows += int64(writeBuffer.GetRowNum())
+		if scalarStats != nil {
+			scalarStats.Update(writeBuffer)
+		}
 		uploadStart := time.Now()
 		inPaths, statsPaths, err := t.uploadRemainLog(ctx, targetSegID, partID, meta,
 			stats, numRows+int64(currentRows), writeBuffer)
 		if err != nil {
-			return nil, nil, 0, err
+			return nil, nil, nil, 0, err
+		}
+		if err := uploadParquet(writeBuffer, timestampFrom, timestampTo); err != nil {
+			log.Warn("failed to upload parquet logs", zap.Error(err))
+			return nil, nil, nil, 0, err
 		}
 
 		uploadInsertTimeCost += time.Since(uploadStart)
@@ -429,6 +568,10 @@ func (t *compactionTask) merge(
 		statPaths = append(statPaths, path)
 	}
 
+	if expired > 0 {
+		metrics.DataNodeCompactionExpiredRows.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(meta.GetID())).Add(float64(expired))
+	}
+
 	log.Info("compact merge end",
 		zap.Int64("remaining insert numRows", numRows),
 		zap.Int64("expired entities", expired),
@@ -437,7 +580,7 @@ func (t *compactionTask) merge(
 		zap.Duration("upload insert log elapse", uploadInsertTimeCost),
 		zap.Duration("merge elapse", time.Since(mergeStart)))
 
-	return insertPaths, statPaths, numRows, nil
+	return insertPaths, statPaths, scalarStats, numRows, nil
 }
 
 func (t *compactionTask) compact() (*datapb.CompactionPlanResult, error) {
@@ -539,18 +682,43 @@ func (t *compactionTask) compact() (*datapb.CompactionPlanResult, error) {
 	partID := segmentBinlog.GetPartitionID()
 	meta := &etcdpb.CollectionMeta{ID: t.metaCache.Collection(), Schema: t.metaCache.Schema()}
 
-	inPaths, statsPaths, numRows, err := t.merge(ctxTimeout, allPath, targetSegID, partID, meta, deltaPk2Ts)
+	if paramtable.Get().DataNodeCfg.CompactionVerifyEnabled.GetAsBool() {
+		t.verifier = newCompactionVerifier()
+	}
+	inPaths, statsPaths, scalarStatsCollector, numRows, err := t.merge(ctxTimeout, allPath, targetSegID, partID, meta, deltaPk2Ts)
 	if err != nil {
 		log.Warn("compact wrong, fail to merge", zap.Error(err))
 		return nil, err
 	}
 
+	scalarStats, artifactLogs, err := t.uploadScalarStats(ctxTimeout, targetSegID, partID, meta, scalarStatsCollector, numRows)
+	if err != nil {
+		log.Warn("compact wrong, fail to upload scalar stats", zap.Error(err))
+		return nil, err
+	}
+
+	if t.verifier != nil {
+		pkField, err := typeutil.GetPrimaryFieldSchema(meta.GetSchema())
+		if err != nil {
+			return nil, err
+		}
+		err = t.verifier.verify(ctxTimeout, t.binlogIO, getCompactionInputRows(t.plan), inPaths, numRows, pkField.GetFieldID())
+		if err != nil {
+			log.Warn("compact wrong, fail to verify the compacted segment", zap.Int64("targetSegmentID", targetSegID), zap.Error(err))
+			return nil, err
+		}
+		log.Info("compact verified", zap.Int64("targetSegmentID", targetSegID), zap.Duration("elapse", t.tr.RecordSpan()))
+	}
+
 	pack := &datapb.CompactionSegment{
 		SegmentID:           targetSegID,
 		InsertLogs:          inPaths,
 		Field2StatslogPaths: statsPaths,
 		NumOfRows:           numRows,
 		Channel:             t.plan.GetChannel(),
+		ScalarStats:         scalarStats,
+		Artifactlogs:        artifactLogs,
+		Parquetlogs:         t.parquetLogs,
 	}
 
 	log.Info("compact done",
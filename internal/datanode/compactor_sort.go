@@ -0,0 +1,493 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	sio "io"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/diskquota"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// sortMergeFanIn is the max number of the sorted runs merged at once,
+// the runs are merged in passes if more, so that at most fan-in chunks are held in memory.
+const sortMergeFanIn = 16
+
+// keySorter sorts the rows by the sort key with bounded memory. The rows are buffered and the buffer
+// is spilled to the local disk as a sorted run once it exceeds the buffer size,
+// the runs are merged by a k-way merge at last. The spilled chunks take the room of the local disk quota,
+// the sort fails if the quota exceeded.
+type keySorter struct {
+	meta       *etcdpb.CollectionMeta
+	fieldID    UniqueID
+	pkID       UniqueID
+	rootPath   string
+	bufferSize int64
+	// the size of a spilled chunk, a chunk of each run merged is held in memory
+	chunkSize int64
+
+	dir       string // created on the first spill
+	buffer    []*storage.Value
+	bufferMem int64
+	runs      []*sortedRun
+	chunkNum  int
+	// chunkSizes is the size of each spilled chunk reserved from the local disk quota
+	chunkSizes map[string]int64
+}
+
+// sortedRun is a run of the rows sorted by the sort key, which is spilled in chunks,
+// the rows of each chunk are a contiguous range of the run.
+type sortedRun struct {
+	chunks []string
+}
+
+// sortSpillPath returns the local path the sorted runs spilled to.
+func sortSpillPath() string {
+	return path.Join(paramtable.Get().LocalStorageCfg.Path.GetValue(), "compaction_sort", fmt.Sprint(paramtable.GetNodeID()))
+}
+
+func newKeySorter(meta *etcdpb.CollectionMeta, fieldID, pkID UniqueID, rootPath string, bufferSize int64) *keySorter {
+	return &keySorter{
+		meta:       meta,
+		fieldID:    fieldID,
+		pkID:       pkID,
+		rootPath:   rootPath,
+		bufferSize: bufferSize,
+		chunkSize:  bufferSize / sortMergeFanIn,
+		chunkSizes: make(map[string]int64),
+	}
+}
+
+// Add buffers the value, the buffer is spilled as a sorted run once exceeds the buffer size.
+func (s *keySorter) Add(v *storage.Value) error {
+	s.buffer = append(s.buffer, v)
+	s.bufferMem += rowMemorySize(v.Value.(map[UniqueID]interface{}))
+	if s.bufferMem < s.bufferSize {
+		return nil
+	}
+	return s.spill()
+}
+
+// Sort calls fn with the values added in the order of the sort key,
+// the values with the same key keep the order they're added. The spilled files are removed at last.
+func (s *keySorter) Sort(fn func(v *storage.Value) error) error {
+	defer s.cleanup()
+	if len(s.runs) == 0 {
+		sortValuesByKey(s.buffer, s.fieldID)
+		for _, v := range s.buffer {
+			if err := fn(v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if len(s.buffer) > 0 {
+		if err := s.spill(); err != nil {
+			return err
+		}
+	}
+	for len(s.runs) > sortMergeFanIn {
+		runs := make([]*sortedRun, 0, (len(s.runs)+sortMergeFanIn-1)/sortMergeFanIn)
+		for i := 0; i < len(s.runs); i += sortMergeFanIn {
+			run, err := s.writeRun(s.merge(s.runs[i:lo.Min([]int{i + sortMergeFanIn, len(s.runs)})]))
+			if err != nil {
+				return err
+			}
+			runs = append(runs, run)
+		}
+		s.runs = runs
+	}
+
+	next := s.merge(s.runs)
+	for {
+		v, err := next()
+		if err == sio.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(v); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *keySorter) spill() error {
+	sortValuesByKey(s.buffer, s.fieldID)
+	pos := 0
+	run, err := s.writeRun(func() (*storage.Value, error) {
+		if pos >= len(s.buffer) {
+			return nil, sio.EOF
+		}
+		v := s.buffer[pos]
+		s.buffer[pos] = nil
+		pos++
+		return v, nil
+	})
+	if err != nil {
+		return err
+	}
+	s.runs = append(s.runs, run)
+	s.buffer = nil
+	s.bufferMem = 0
+	return nil
+}
+
+// writeRun spills the values in order as a sorted run.
+func (s *keySorter) writeRun(next func() (*storage.Value, error)) (*sortedRun, error) {
+	run := &sortedRun{}
+	chunk := make([]*storage.Value, 0)
+	chunkMem := int64(0)
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+		file, err := s.writeChunk(chunk)
+		if err != nil {
+			return err
+		}
+		run.chunks = append(run.chunks, file)
+		chunk = make([]*storage.Value, 0)
+		chunkMem = 0
+		return nil
+	}
+
+	for {
+		v, err := next()
+		if err == sio.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		chunk = append(chunk, v)
+		chunkMem += rowMemorySize(v.Value.(map[UniqueID]interface{}))
+		if chunkMem >= s.chunkSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// writeChunk serializes the values as the insert binlogs, which are written into a local file.
+func (s *keySorter) writeChunk(values []*storage.Value) (string, error) {
+	if s.dir == "" {
+		if err := os.MkdirAll(s.rootPath, os.ModePerm); err != nil {
+			return "", err
+		}
+		dir, err := os.MkdirTemp(s.rootPath, "compaction-sort-")
+		if err != nil {
+			return "", err
+		}
+		s.dir = dir
+	}
+
+	data, err := storage.NewInsertData(s.meta.GetSchema())
+	if err != nil {
+		return "", err
+	}
+	for _, v := range values {
+		if err := data.Append(v.Value.(map[UniqueID]interface{})); err != nil {
+			return "", err
+		}
+	}
+	blobs, err := storage.NewInsertCodecWithSchema(s.meta).Serialize(0, 0, data)
+	if err != nil {
+		return "", err
+	}
+	size := int64(0)
+	for _, blob := range blobs {
+		size += int64(16 + len(blob.Key) + len(blob.Value))
+	}
+	if err := diskquota.Reserve(diskquota.ConsumerCompactionSort, size); err != nil {
+		return "", err
+	}
+
+	s.chunkNum++
+	name := path.Join(s.dir, fmt.Sprintf("chunk-%d", s.chunkNum))
+	s.chunkSizes[name] = size
+	file, err := os.Create(name)
+	if err != nil {
+		s.releaseChunk(name)
+		return "", err
+	}
+	defer file.Close()
+	w := bufio.NewWriter(file)
+	for _, blob := range blobs {
+		for _, b := range [][]byte{[]byte(blob.Key), blob.Value} {
+			if err := binary.Write(w, binary.LittleEndian, uint64(len(b))); err != nil {
+				return "", err
+			}
+			if _, err := w.Write(b); err != nil {
+				return "", err
+			}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// readChunk reads the values of the chunk in the order of the sort key, the file is removed once read.
+func (s *keySorter) readChunk(name string) ([]*storage.Value, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer s.releaseChunk(name)
+	defer os.Remove(name)
+	defer file.Close()
+
+	r := bufio.NewReader(file)
+	readBytes := func() ([]byte, error) {
+		var size uint64
+		if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+			return nil, err
+		}
+		b := make([]byte, size)
+		if _, err := sio.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	}
+	blobs := make([]*storage.Blob, 0)
+	for {
+		key, err := readBytes()
+		if err == sio.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		value, err := readBytes()
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, &storage.Blob{Key: string(key), Value: value})
+	}
+
+	iter, err := storage.NewBinlogDeserializeReader(blobs, s.pkID)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	values := make([]*storage.Value, 0)
+	for {
+		err := iter.Next()
+		if err == sio.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// the value returned by the iterator is reused
+		v := iter.Value()
+		values = append(values, &storage.Value{
+			ID:        v.ID,
+			PK:        v.PK,
+			Timestamp: v.Timestamp,
+			Value:     lo.Assign(v.Value.(map[UniqueID]interface{})),
+		})
+	}
+	// the binlogs are serialized in the order of the row IDs
+	sortValuesByKey(values, s.fieldID)
+	return values, nil
+}
+
+// merge returns the iterator of the values of the runs merged in the order of the sort key.
+func (s *keySorter) merge(runs []*sortedRun) func() (*storage.Value, error) {
+	h := &runHeap{fieldID: s.fieldID}
+	initialized := false
+	return func() (*storage.Value, error) {
+		if !initialized {
+			initialized = true
+			for i, run := range runs {
+				cursor := &runCursor{sorter: s, run: run, index: i}
+				if err := cursor.advance(); err == sio.EOF {
+					continue
+				} else if err != nil {
+					return nil, err
+				}
+				h.cursors = append(h.cursors, cursor)
+			}
+			heap.Init(h)
+		}
+		if h.Len() == 0 {
+			return nil, sio.EOF
+		}
+		cursor := h.cursors[0]
+		v := cursor.value
+		if err := cursor.advance(); err == sio.EOF {
+			heap.Pop(h)
+		} else if err != nil {
+			return nil, err
+		} else {
+			heap.Fix(h, 0)
+		}
+		return v, nil
+	}
+}
+
+func (s *keySorter) cleanup() {
+	if s.dir != "" {
+		os.RemoveAll(s.dir)
+		s.dir = ""
+	}
+	for name := range s.chunkSizes {
+		s.releaseChunk(name)
+	}
+	s.buffer = nil
+	s.runs = nil
+}
+
+// releaseChunk releases the room of the chunk removed from the local disk quota.
+func (s *keySorter) releaseChunk(name string) {
+	if size, ok := s.chunkSizes[name]; ok {
+		diskquota.Release(diskquota.ConsumerCompactionSort, size)
+		delete(s.chunkSizes, name)
+	}
+}
+
+// runCursor reads the values of a sorted run chunk by chunk.
+type runCursor struct {
+	sorter *keySorter
+	run    *sortedRun
+	index  int
+
+	values []*storage.Value
+	pos    int
+	value  *storage.Value
+}
+
+func (c *runCursor) advance() error {
+	for c.pos >= len(c.values) {
+		if len(c.run.chunks) == 0 {
+			c.values, c.value = nil, nil
+			return sio.EOF
+		}
+		values, err := c.sorter.readChunk(c.run.chunks[0])
+		if err != nil {
+			return err
+		}
+		c.run.chunks = c.run.chunks[1:]
+		c.values, c.pos = values, 0
+	}
+	c.value = c.values[c.pos]
+	c.values[c.pos] = nil
+	c.pos++
+	return nil
+}
+
+// runHeap orders the cursors by the sort key of their current values,
+// the runs spilled earlier first for the same key.
+type runHeap struct {
+	fieldID UniqueID
+	cursors []*runCursor
+}
+
+func (h *runHeap) Len() int { return len(h.cursors) }
+
+func (h *runHeap) Less(i, j int) bool {
+	left := h.cursors[i].value.Value.(map[UniqueID]interface{})[h.fieldID]
+	right := h.cursors[j].value.Value.(map[UniqueID]interface{})[h.fieldID]
+	if lessKey(left, right) {
+		return true
+	}
+	if lessKey(right, left) {
+		return false
+	}
+	return h.cursors[i].index < h.cursors[j].index
+}
+
+func (h *runHeap) Swap(i, j int) { h.cursors[i], h.cursors[j] = h.cursors[j], h.cursors[i] }
+
+func (h *runHeap) Push(x any) { h.cursors = append(h.cursors, x.(*runCursor)) }
+
+func (h *runHeap) Pop() any {
+	n := len(h.cursors)
+	cursor := h.cursors[n-1]
+	h.cursors = h.cursors[:n-1]
+	return cursor
+}
+
+// sortValuesByKey sorts the values by the sort key field in ascending order,
+// the rows with the same key keep the order they're read.
+func sortValuesByKey(values []*storage.Value, fieldID UniqueID) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return lessKey(values[i].Value.(map[UniqueID]interface{})[fieldID], values[j].Value.(map[UniqueID]interface{})[fieldID])
+	})
+}
+
+func lessKey(left, right interface{}) bool {
+	switch l := left.(type) {
+	case int8:
+		return l < right.(int8)
+	case int16:
+		return l < right.(int16)
+	case int32:
+		return l < right.(int32)
+	case int64:
+		return l < right.(int64)
+	case float32:
+		return l < right.(float32)
+	case float64:
+		return l < right.(float64)
+	case string:
+		return l < right.(string)
+	}
+	return false
+}
+
+// rowMemorySize estimates the memory size of the row buffered.
+func rowMemorySize(row map[UniqueID]interface{}) int64 {
+	size := int64(0)
+	for _, value := range row {
+		switch v := value.(type) {
+		case string:
+			size += int64(len(v)) + 16
+		case []byte:
+			size += int64(len(v)) + 24
+		case []float32:
+			size += int64(len(v))*4 + 24
+		case proto.Message:
+			size += int64(proto.Size(v))
+		default:
+			size += 8
+		}
+	}
+	return size
+}
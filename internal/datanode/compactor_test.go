@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"os"
 	"testing"
 	"time"
 
@@ -41,10 +42,13 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/diskquota"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/testutils"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 var compactTestDir = "/tmp/milvus_test/compact"
@@ -335,14 +339,107 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 					},
 				},
 			}
-			inPaths, statsPaths, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
+			inPaths, statsPaths, scalarStats, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
 			assert.NoError(t, err)
 			assert.Equal(t, int64(2), numOfRow)
 			assert.Equal(t, 1, len(inPaths[0].GetBinlogs()))
 			assert.Equal(t, 1, len(statsPaths))
+			assert.NotEmpty(t, scalarStats.Stats())
 			assert.NotEqual(t, -1, inPaths[0].GetBinlogs()[0].GetTimestampFrom())
 			assert.NotEqual(t, -1, inPaths[0].GetBinlogs()[0].GetTimestampTo())
 		})
+		t.Run("Merge with verification", func(t *testing.T) {
+			mockbIO := io.NewBinlogIO(cm, getOrCreateIOPool())
+			paramtable.Get().Save(Params.CommonCfg.EntityExpirationTTL.Key, "0")
+			iData := genInsertDataWithExpiredTS()
+			iCodec := storage.NewInsertCodecWithSchema(meta)
+			var allPaths [][]string
+			inpath, err := uploadInsertLog(context.Background(), mockbIO, alloc, meta.GetID(), 0, 1, iData, iCodec)
+			assert.NoError(t, err)
+			var ps []string
+			for _, path := range inpath {
+				ps = append(ps, path.GetBinlogs()[0].GetLogPath())
+			}
+			allPaths = append(allPaths, ps)
+
+			ct := &compactionTask{
+				metaCache: metaCache,
+				binlogIO:  mockbIO,
+				Allocator: alloc,
+				done:      make(chan struct{}, 1),
+				plan: &datapb.CompactionPlan{
+					SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+						{SegmentID: 1},
+					},
+				},
+				verifier: newCompactionVerifier(),
+			}
+			pkField, err := typeutil.GetPrimaryFieldSchema(meta.GetSchema())
+			assert.NoError(t, err)
+			inputRows := int64(iData.GetRowNum())
+			inPaths, _, _, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, map[interface{}]Timestamp{1: 10000})
+			assert.NoError(t, err)
+			err = ct.verifier.verify(context.Background(), mockbIO, inputRows, inPaths, numOfRow, pkField.GetFieldID())
+			assert.NoError(t, err)
+
+			// row number mismatched
+			err = ct.verifier.verify(context.Background(), mockbIO, inputRows+1, inPaths, numOfRow, pkField.GetFieldID())
+			assert.Error(t, err)
+
+			// checksum mismatched
+			ct.verifier = newCompactionVerifier()
+			inPaths, _, _, numOfRow, err = ct.merge(context.Background(), allPaths, 2, 0, meta, map[interface{}]Timestamp{1: 10000})
+			assert.NoError(t, err)
+			ct.verifier.checksum++
+			err = ct.verifier.verify(context.Background(), mockbIO, inputRows, inPaths, numOfRow, pkField.GetFieldID())
+			assert.Error(t, err)
+		})
+		t.Run("Merge with parquet", func(t *testing.T) {
+			params := paramtable.Get()
+			params.Save(params.DataNodeCfg.ParquetSegmentEnabled.Key, "true")
+			defer params.Reset(params.DataNodeCfg.ParquetSegmentEnabled.Key)
+
+			mockbIO := io.NewBinlogIO(cm, getOrCreateIOPool())
+			paramtable.Get().Save(Params.CommonCfg.EntityExpirationTTL.Key, "0")
+			iData := genInsertDataWithExpiredTS()
+			iCodec := storage.NewInsertCodecWithSchema(meta)
+			inpath, err := uploadInsertLog(context.Background(), mockbIO, alloc, meta.GetID(), 0, 1, iData, iCodec)
+			assert.NoError(t, err)
+			var ps []string
+			for _, path := range inpath {
+				ps = append(ps, path.GetBinlogs()[0].GetLogPath())
+			}
+
+			ct := &compactionTask{
+				metaCache: metaCache,
+				binlogIO:  mockbIO,
+				Allocator: alloc,
+				done:      make(chan struct{}, 1),
+				plan: &datapb.CompactionPlan{
+					SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+						{SegmentID: 1},
+					},
+				},
+			}
+			// the deleted row is not written into the parquet files
+			_, _, _, numOfRow, err := ct.merge(context.Background(), [][]string{ps}, 2, 0, meta, map[interface{}]Timestamp{int64(1): math.MaxUint64})
+			assert.NoError(t, err)
+			assert.EqualValues(t, 1, numOfRow)
+
+			groups := storage.NewParquetFieldGroups(meta.GetSchema())
+			require.Len(t, ct.parquetLogs, len(groups))
+			for i, logs := range ct.parquetLogs {
+				assert.Equal(t, groups[i].GroupID, logs.GetGroupID())
+				require.Len(t, logs.GetBinlogs(), 1)
+				assert.EqualValues(t, numOfRow, logs.GetBinlogs()[0].GetEntriesNum())
+				assert.Contains(t, logs.GetBinlogs()[0].GetLogPath(), common.SegmentParquetLogPath)
+			}
+			data, err := storage.LoadParquetGroup(context.Background(), cm, []string{ct.parquetLogs[0].GetBinlogs()[0].GetLogPath()}, groups[0].Fields)
+			assert.NoError(t, err)
+			pkField, err := typeutil.GetPrimaryFieldSchema(meta.GetSchema())
+			assert.NoError(t, err)
+			assert.Equal(t, []int64{2}, data.Data[pkField.GetFieldID()].GetRows())
+		})
 		t.Run("Merge without expiration2", func(t *testing.T) {
 			mockbIO := io.NewBinlogIO(cm, getOrCreateIOPool())
 			iCodec := storage.NewInsertCodecWithSchema(meta)
@@ -383,7 +480,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 					},
 				},
 			}
-			inPaths, statsPaths, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
+			inPaths, statsPaths, _, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
 			assert.NoError(t, err)
 			assert.Equal(t, int64(2), numOfRow)
 			assert.Equal(t, 1, len(inPaths[0].GetBinlogs()))
@@ -434,7 +531,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 					},
 				},
 			}
-			inPaths, statsPaths, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
+			inPaths, statsPaths, _, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
 			assert.NoError(t, err)
 			assert.Equal(t, int64(101), numOfRow)
 			assert.Equal(t, 2, len(inPaths[0].GetBinlogs()))
@@ -483,7 +580,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 				},
 				done: make(chan struct{}, 1),
 			}
-			inPaths, statsPaths, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
+			inPaths, statsPaths, _, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
 			assert.NoError(t, err)
 			assert.Equal(t, int64(0), numOfRow)
 			assert.Equal(t, 0, len(inPaths))
@@ -537,7 +634,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 					},
 				},
 			}
-			_, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
+			_, _, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
 				Schema: meta.GetSchema(),
 			}, dm)
 			assert.Error(t, err)
@@ -580,7 +677,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 					},
 				},
 			}
-			_, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
+			_, _, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
 				Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
 					{DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
 						{Key: common.DimKey, Value: "64"},
@@ -623,7 +720,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 				done:      make(chan struct{}, 1),
 			}
 
-			_, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
+			_, _, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
 				Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
 					{DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
 						{Key: common.DimKey, Value: "bad_dim"},
@@ -1140,3 +1237,124 @@ func TestInjectDone(t *testing.T) {
 	task.injectDone()
 	task.injectDone()
 }
+
+func TestSortValuesByKey(t *testing.T) {
+	t.Run("sort values", func(t *testing.T) {
+		newValue := func(id int64, key interface{}) *storage.Value {
+			return &storage.Value{ID: id, Value: map[UniqueID]interface{}{101: key}}
+		}
+		values := []*storage.Value{newValue(1, "c"), newValue(2, "a"), newValue(3, "b"), newValue(4, "a")}
+		sortValuesByKey(values, 101)
+		assert.Equal(t, []int64{2, 4, 3, 1}, lo.Map(values, func(v *storage.Value, _ int) int64 { return v.ID }))
+
+		values = []*storage.Value{newValue(1, int64(3)), newValue(2, int64(-1)), newValue(3, int64(2))}
+		sortValuesByKey(values, 101)
+		assert.Equal(t, []int64{2, 3, 1}, lo.Map(values, func(v *storage.Value, _ int) int64 { return v.ID }))
+	})
+}
+
+func TestKeySorter(t *testing.T) {
+	meta := &etcdpb.CollectionMeta{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+				{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "key", DataType: schemapb.DataType_VarChar, IsClusteringKey: true},
+			},
+		},
+	}
+	newValue := func(id int64) *storage.Value {
+		return &storage.Value{
+			ID:        id,
+			PK:        storage.NewInt64PrimaryKey(id),
+			Timestamp: id + 1000,
+			Value: map[UniqueID]interface{}{
+				common.RowIDField:     id,
+				common.TimeStampField: id + 1000,
+				100:                   id,
+				101:                   fmt.Sprintf("key-%02d", id*37%50),
+			},
+		}
+	}
+	sortAll := func(t *testing.T, sorter *keySorter, num int64) []*storage.Value {
+		for i := int64(0); i < num; i++ {
+			require.NoError(t, sorter.Add(newValue(i)))
+		}
+		values := make([]*storage.Value, 0)
+		err := sorter.Sort(func(v *storage.Value) error {
+			values = append(values, v)
+			return nil
+		})
+		require.NoError(t, err)
+		return values
+	}
+	expected := func(num int64) []*storage.Value {
+		values := lo.Map(lo.Range(int(num)), func(i int, _ int) *storage.Value { return newValue(int64(i)) })
+		sortValuesByKey(values, 101)
+		return values
+	}
+
+	t.Run("in memory", func(t *testing.T) {
+		dir := t.TempDir()
+		sorter := newKeySorter(meta, 101, 100, dir, 1<<20)
+		values := sortAll(t, sorter, 100)
+		assert.Equal(t, expected(100), values)
+		assert.Empty(t, sorter.runs)
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("spill and merge in passes", func(t *testing.T) {
+		dir := t.TempDir()
+		sorter := newKeySorter(meta, 101, 100, dir, 200)
+		for i := int64(0); i < 100; i++ {
+			require.NoError(t, sorter.Add(newValue(i)))
+		}
+		assert.Greater(t, len(sorter.runs), sortMergeFanIn)
+
+		values := make([]*storage.Value, 0)
+		err := sorter.Sort(func(v *storage.Value) error {
+			values = append(values, v)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, expected(100), values)
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+		assert.EqualValues(t, 0, diskquota.Usage(diskquota.ConsumerCompactionSort))
+	})
+
+	t.Run("local disk quota exceeded", func(t *testing.T) {
+		params := paramtable.Get()
+		params.Save(params.LocalStorageCfg.DiskCapacityLimit.Key, "0")
+		defer params.Reset(params.LocalStorageCfg.DiskCapacityLimit.Key)
+
+		dir := t.TempDir()
+		sorter := newKeySorter(meta, 101, 100, dir, 200)
+		var err error
+		for i := int64(0); i < 100 && err == nil; i++ {
+			err = sorter.Add(newValue(i))
+		}
+		assert.ErrorIs(t, err, merr.ErrServiceDiskLimitExceeded)
+		sorter.cleanup()
+		assert.EqualValues(t, 0, diskquota.Usage(diskquota.ConsumerCompactionSort))
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("write failed", func(t *testing.T) {
+		sorter := newKeySorter(meta, 101, 100, t.TempDir(), 200)
+		for i := int64(0); i < 20; i++ {
+			require.NoError(t, sorter.Add(newValue(i)))
+		}
+		err := sorter.Sort(func(v *storage.Value) error {
+			return errors.New("mock error")
+		})
+		assert.Error(t, err)
+	})
+}
@@ -207,7 +207,7 @@ func (node *DataNode) Register() error {
 }
 
 func (node *DataNode) initSession() error {
-	node.session = sessionutil.NewSession(node.ctx)
+	node.session = sessionutil.NewSession(node.ctx, sessionutil.WithChannelGroup(Params.DataNodeCfg.ChannelGroup.GetValue()))
 	if node.session == nil {
 		return errors.New("failed to initialize session")
 	}
@@ -281,6 +281,11 @@ func (node *DataNode) Init() error {
 		}
 
 		node.chunkManager = chunkManager
+		// the files of the collections placed in their own buckets are routed by the collection properties
+		storage.SetCollectionPropertiesResolver(func(ctx context.Context, collectionID int64) ([]*commonpb.KeyValuePair, error) {
+			resp, err := node.broker.DescribeCollection(ctx, collectionID, typeutil.MaxTimestamp)
+			return resp.GetProperties(), err
+		})
 		syncMgr, err := syncmgr.NewSyncManager(node.chunkManager, node.allocator)
 		if err != nil {
 			initError = err
@@ -97,6 +97,26 @@ func (dsService *dataSyncService) start() {
 	}
 }
 
+// setPaused pauses or resumes consuming the channel, the channel checkpoint stays at the position consumed while paused.
+func (dsService *dataSyncService) setPaused(paused bool) {
+	if dsService.fg == nil {
+		return
+	}
+	if paused {
+		dsService.fg.Pause()
+	} else {
+		dsService.fg.Resume()
+	}
+}
+
+// setParallelism sets the number of workers running the nodes of the flowgraph.
+func (dsService *dataSyncService) setParallelism(parallelism int) {
+	if dsService.fg == nil {
+		return
+	}
+	dsService.fg.SetParallelism(parallelism)
+}
+
 func (dsService *dataSyncService) GracefullyClose() {
 	if dsService.fg != nil {
 		log.Info("dataSyncService gracefully closing flowgraph")
@@ -115,6 +135,7 @@ func (dsService *dataSyncService) close() {
 			log.Info("dataSyncService closing flowgraph")
 			dsService.dispClient.Deregister(dsService.vchannelName)
 			dsService.fg.Close()
+			metrics.CleanupDataNodeChannelMetrics(dsService.serverID, dsService.vchannelName)
 			log.Info("dataSyncService flowgraph closed")
 		}
 
@@ -431,7 +452,14 @@ func getServiceWithChannel(initCtx context.Context, node *DataNode, info *datapb
 	if err := fg.AssembleNodes(dmStreamNode, ddNode, writeNode, ttNode); err != nil {
 		return nil, err
 	}
+	fg.SetParallelism(Params.DataNodeCfg.FlowGraphParallelism.GetAsInt())
+	fg.EnableMetrics(config.serverID, channelName)
 	ds.fg = fg
+	if info.GetVchan().GetIngestionPaused() {
+		log.Info("ingestion of the collection paused, the channel is not consumed until resumed",
+			zap.Int64("collectionID", collectionID), zap.String("channel", channelName))
+		ds.setPaused(true)
+	}
 
 	return ds, nil
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/writebuffer"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
@@ -81,6 +82,10 @@ func (ttn *ttNode) Operate(in []Msg) []Msg {
 	}
 
 	curTs, _ := tsoutil.ParseTS(fgMsg.timeRange.timestampMax)
+	if fgMsg.timeRange.timestampMax > 0 {
+		metrics.DataNodeChannelConsumeLag.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), ttn.vChannelName).
+			Set(float64(tsoutil.SubByNow(fgMsg.timeRange.timestampMax)))
+	}
 	if fgMsg.IsCloseMsg() {
 		if len(fgMsg.endPositions) > 0 {
 			channelPos, _, err := ttn.writeBufferManager.GetCheckpoint(ttn.vChannelName)
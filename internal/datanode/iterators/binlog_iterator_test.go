@@ -8,6 +8,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func TestInsertBinlogIteratorSuite(t *testing.T) {
@@ -42,6 +43,10 @@ type InsertBinlogIteratorSuite struct {
 	i *BinlogIterator
 }
 
+func (s *InsertBinlogIteratorSuite) SetupSuite() {
+	paramtable.Init()
+}
+
 func (s *InsertBinlogIteratorSuite) TestBinlogIterator() {
 	insertData, meta := genTestInsertData()
 	writer := storage.NewInsertCodecWithSchema(meta)
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func TestDeltalogIteratorSuite(t *testing.T) {
@@ -16,6 +17,10 @@ type DeltalogIteratorSuite struct {
 	suite.Suite
 }
 
+func (s *DeltalogIteratorSuite) SetupSuite() {
+	paramtable.Init()
+}
+
 func (s *DeltalogIteratorSuite) TestDeltalogIteratorIntPK() {
 	s.Run("invalid blobs", func() {
 		iter := NewDeltalogIterator([][]byte{}, nil)
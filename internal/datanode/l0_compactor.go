@@ -331,9 +331,10 @@ func (t *levelZeroCompactionTask) composeDeltalog(segmentID int64, dData *storag
 
 	// TODO Timestamp?
 	deltalog := &datapb.Binlog{
-		LogSize: int64(len(blob.GetValue())),
-		LogPath: blobPath,
-		LogID:   logID,
+		LogSize:     int64(len(blob.GetValue())),
+		LogPath:     blobPath,
+		LogID:       logID,
+		Compression: blob.Compression,
 	}
 
 	return uploadKv, deltalog, nil
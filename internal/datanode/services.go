@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/tracer"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
@@ -284,6 +285,11 @@ func (node *DataNode) GetCompactionState(ctx context.Context, req *datapb.Compac
 	return &datapb.CompactionStateResponse{
 		Status:  merr.Success(),
 		Results: results,
+		Resource: &datapb.CompactionNodeResource{
+			CpuNum:      int64(hardware.GetCPUNum()),
+			TotalMemory: hardware.GetMemoryCount(),
+			UsedMemory:  hardware.GetUsedMemoryCount(),
+		},
 	}, nil
 }
 
@@ -334,6 +340,24 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 	return merr.Success(), nil
 }
 
+// StopCompaction stops the executing compaction plan,
+// the completed result is kept and cleared by DataCoord with SyncSegments.
+func (node *DataNode) StopCompaction(ctx context.Context, req *datapb.StopCompactionRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("planID", req.GetPlanID()),
+		zap.String("channel", req.GetChannel()),
+	)
+	log.Info("DataNode receives StopCompaction")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		log.Warn("DataNode.StopCompaction failed", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	node.compactionExecutor.stopTask(req.GetPlanID())
+	return merr.Success(), nil
+}
+
 func (node *DataNode) NotifyChannelOperation(ctx context.Context, req *datapb.ChannelOperationsRequest) (*commonpb.Status, error) {
 	log.Ctx(ctx).Info("DataNode receives NotifyChannelOperation",
 		zap.Int("operation count", len(req.GetInfos())))
@@ -393,6 +417,62 @@ func (node *DataNode) FlushChannels(ctx context.Context, req *datapb.FlushChanne
 	return merr.Success(), nil
 }
 
+// PauseChannels pauses or resumes consuming the channels, the consumed positions are kept while paused.
+// The channels not watched are skipped, which get the pause state from the watch info once watched.
+func (node *DataNode) PauseChannels(ctx context.Context, req *datapb.PauseChannelsRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeId", node.GetNodeID()),
+		zap.Strings("channels", req.GetChannels()),
+		zap.Bool("paused", req.GetPaused()))
+
+	log.Info("DataNode receives PauseChannels request")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		log.Warn("DataNode.PauseChannels failed", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	for _, channel := range req.GetChannels() {
+		ds, ok := node.flowgraphManager.GetFlowgraphService(channel)
+		if !ok {
+			log.Warn("channel not watched, skip", zap.String("channel", channel))
+			continue
+		}
+		ds.setPaused(req.GetPaused())
+	}
+
+	return merr.Success(), nil
+}
+
+// SetChannelParallelism updates the number of stages the flowgraph of the channel runs in.
+func (node *DataNode) SetChannelParallelism(ctx context.Context, req *datapb.SetChannelParallelismRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("nodeId", node.GetNodeID()),
+		zap.String("channel", req.GetChannel()),
+		zap.Int32("parallelism", req.GetParallelism()))
+
+	log.Info("DataNode receives SetChannelParallelism request")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		log.Warn("DataNode.SetChannelParallelism failed", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if req.GetParallelism() < 1 {
+		err := merr.WrapErrParameterInvalidMsg("parallelism must be positive, got %d", req.GetParallelism())
+		log.Warn("DataNode.SetChannelParallelism failed", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	ds, ok := node.flowgraphManager.GetFlowgraphService(req.GetChannel())
+	if !ok {
+		err := merr.WrapErrChannelNotFound(req.GetChannel())
+		log.Warn("DataNode.SetChannelParallelism failed", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	ds.setParallelism(int(req.GetParallelism()))
+
+	return merr.Success(), nil
+}
+
 func (node *DataNode) PreImport(ctx context.Context, req *datapb.PreImportRequest) (*commonpb.Status, error) {
 	log := log.Ctx(ctx).With(zap.Int64("taskID", req.GetTaskID()),
 		zap.Int64("jobID", req.GetJobID()),
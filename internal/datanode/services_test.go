@@ -207,6 +207,29 @@ func (s *DataNodeServicesSuite) TestGetCompactionState() {
 	})
 }
 
+func (s *DataNodeServicesSuite) TestStopCompaction() {
+	s.Run("success", func() {
+		compactor := newMockCompactor(true)
+		compactor.complete()
+		s.node.compactionExecutor.executing.Insert(int64(1), compactor)
+		status, err := s.node.StopCompaction(s.ctx, &datapb.StopCompactionRequest{PlanID: 1})
+		s.NoError(merr.CheckRPCCall(status, err))
+		s.False(s.node.compactionExecutor.executing.Contain(1))
+		s.Error(compactor.ctx.Err())
+
+		// not executing
+		status, err = s.node.StopCompaction(s.ctx, &datapb.StopCompactionRequest{PlanID: 2})
+		s.NoError(merr.CheckRPCCall(status, err))
+	})
+
+	s.Run("unhealthy", func() {
+		node := &DataNode{}
+		node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		status, _ := node.StopCompaction(s.ctx, &datapb.StopCompactionRequest{PlanID: 1})
+		s.Equal(merr.Code(merr.ErrServiceNotReady), status.GetCode())
+	})
+}
+
 func (s *DataNodeServicesSuite) TestCompaction() {
 	dmChannelName := "by-dev-rootcoord-dml_0_100v0"
 	schema := &schemapb.CollectionSchema{
@@ -76,6 +76,8 @@ func (b *brokerMetaWriter) UpdateSync(pack *SyncTask) error {
 		zap.Int("binlogNum", lo.SumBy(insertFieldBinlogs, getBinlogNum)),
 		zap.Int("statslogNum", lo.SumBy(statsFieldBinlogs, getBinlogNum)),
 		zap.Int("deltalogNum", lo.SumBy(deltaFieldBinlogs, getBinlogNum)),
+		zap.Int("artifactlogNum", lo.SumBy(pack.artifactLogs, func(logs *datapb.FieldArtifactLogs) int { return len(logs.GetBinlogs()) })),
+		zap.Int("parquetlogNum", lo.SumBy(pack.parquetLogs, func(logs *datapb.FieldGroupParquetLogs) int { return len(logs.GetBinlogs()) })),
 		zap.String("vChannelName", pack.channelName),
 	)
 
@@ -99,6 +101,9 @@ func (b *brokerMetaWriter) UpdateSync(pack *SyncTask) error {
 		Dropped:        pack.isDrop,
 		Channel:        pack.channelName,
 		SegLevel:       pack.level,
+		ScalarStats:    pack.scalarStats,
+		Artifactlogs:   pack.artifactLogs,
+		Parquetlogs:    pack.parquetLogs,
 	}
 	err := retry.Do(context.Background(), func() error {
 		err := b.broker.SaveBinlogPaths(context.Background(), req)
@@ -18,6 +18,7 @@ package syncmgr
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 
@@ -104,6 +105,27 @@ func (s *storageV1Serializer) EncodeBuffer(ctx context.Context, pack *SyncPack)
 
 		task.batchStatsBlob = batchStatsBlob
 		s.metacache.UpdateSegments(metacache.RollStats(singlePKStats), metacache.WithSegmentIDs(pack.segmentID))
+
+		scalarStats, bloomFilters, err := s.serializeScalarStats(pack)
+		if err != nil {
+			log.Warn("failed to serialize scalar stats", zap.Error(err))
+			return nil, err
+		}
+		task.scalarStats = scalarStats
+
+		artifacts, err := s.serializeArtifacts(pack)
+		if err != nil {
+			log.Warn("failed to generate stats artifacts", zap.Error(err))
+			return nil, err
+		}
+		task.artifacts = append(artifacts, bloomFilters...)
+
+		parquetBlobs, err := s.serializeParquet(pack)
+		if err != nil {
+			log.Warn("failed to serialize parquet files", zap.Error(err))
+			return nil, err
+		}
+		task.parquetBlobs = parquetBlobs
 	}
 
 	if pack.isFlush {
@@ -193,6 +215,55 @@ func (s *storageV1Serializer) serializeStatslog(pack *SyncPack) (*storage.Primar
 	return stats, blob, nil
 }
 
+// serializeScalarStats returns the scalar stats kept in the segment meta and the bloom filters stored as artifacts.
+func (s *storageV1Serializer) serializeScalarStats(pack *SyncPack) ([]*datapb.FieldScalarStats, []*storage.StatsArtifact, error) {
+	if !paramtable.Get().DataNodeCfg.ScalarStatsEnabled.GetAsBool() {
+		return nil, nil, nil
+	}
+	collector := storage.NewScalarStatsCollector(s.schema, paramtable.Get().DataNodeCfg.ScalarStatsBloomFilter.GetAsBool(),
+		paramtable.Get().DataNodeCfg.ScalarStatsCardinality.GetAsBool())
+	collector.Update(pack.insertData)
+	return SerializeScalarStats(collector)
+}
+
+// serializeArtifacts generates the artifacts of the rows synced by the stats generators registered.
+func (s *storageV1Serializer) serializeArtifacts(pack *SyncPack) ([]*storage.StatsArtifact, error) {
+	generators, err := storage.NewStatsGenerators(s.schema)
+	if err != nil {
+		return nil, err
+	}
+	if generators.Empty() {
+		return nil, nil
+	}
+	if err := generators.Update(pack.insertData); err != nil {
+		return nil, err
+	}
+	return generators.Serialize()
+}
+
+// serializeParquet serializes the rows synced into the parquet files of the field groups,
+// if the parquet segment storage enabled.
+func (s *storageV1Serializer) serializeParquet(pack *SyncPack) (map[int64]*storage.Blob, error) {
+	if !paramtable.Get().DataNodeCfg.ParquetSegmentEnabled.GetAsBool() {
+		return nil, nil
+	}
+	compression := storage.GetBinlogCompression()
+	groups := storage.NewParquetFieldGroups(s.schema)
+	blobs := make(map[int64]*storage.Blob, len(groups))
+	for _, group := range groups {
+		value, err := storage.SerializeParquetGroup(group, pack.insertData, compression)
+		if err != nil {
+			return nil, err
+		}
+		blobs[group.GroupID] = &storage.Blob{
+			Value:       value,
+			RowNum:      int64(pack.insertData.GetRowNum()),
+			Compression: compression.Codec,
+		}
+	}
+	return blobs, nil
+}
+
 func (s *storageV1Serializer) serializeMergedPkStats(pack *SyncPack) (*storage.Blob, error) {
 	segment, ok := s.metacache.GetSegmentByID(pack.segmentID)
 	if !ok {
@@ -213,3 +284,28 @@ func (s *storageV1Serializer) serializeMergedPkStats(pack *SyncPack) (*storage.B
 func (s *storageV1Serializer) serializeDeltalog(pack *SyncPack) (*storage.Blob, error) {
 	return s.delCodec.Serialize(pack.collectionID, pack.partitionID, pack.segmentID, pack.deltaData)
 }
+
+// SerializeScalarStats converts the scalar field stats collected into the form kept in the segment meta,
+// the bloom filters are returned as the artifacts to store in the object storage instead.
+func SerializeScalarStats(collector *storage.ScalarStatsCollector) ([]*datapb.FieldScalarStats, []*storage.StatsArtifact, error) {
+	artifacts, err := collector.BloomFilterArtifacts()
+	if err != nil {
+		return nil, nil, err
+	}
+	stats := collector.Stats()
+	result := make([]*datapb.FieldScalarStats, 0, len(stats))
+	for _, fieldStats := range stats {
+		metaStats := *fieldStats
+		metaStats.BF = nil
+		bs, err := json.Marshal(&metaStats)
+		if err != nil {
+			return nil, nil, err
+		}
+		result = append(result, &datapb.FieldScalarStats{
+			FieldID:   fieldStats.FieldID,
+			NullCount: collector.NullCount(fieldStats.FieldID),
+			Stats:     bs,
+		})
+	}
+	return result, artifacts, nil
+}
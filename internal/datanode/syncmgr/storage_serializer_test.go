@@ -18,8 +18,10 @@ package syncmgr
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
+	"strconv"
 	"testing"
 	"time"
 
@@ -34,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
 
@@ -54,6 +57,8 @@ type StorageV1SerializerSuite struct {
 }
 
 func (s *StorageV1SerializerSuite) SetupSuite() {
+	paramtable.Init()
+
 	s.collectionID = rand.Int63n(100) + 1000
 	s.partitionID = rand.Int63n(100) + 2000
 	s.segmentID = rand.Int63n(1000) + 10000
@@ -77,6 +82,11 @@ func (s *StorageV1SerializerSuite) SetupSuite() {
 					{Key: common.DimKey, Value: "128"},
 				},
 			},
+			{
+				FieldID:  102,
+				Name:     "scalar",
+				DataType: schemapb.DataType_Int64,
+			},
 		},
 	}
 
@@ -113,6 +123,7 @@ func (s *StorageV1SerializerSuite) getInsertBuffer() *storage.InsertData {
 			return rand.Float32()
 		})
 		data[101] = vector
+		data[102] = int64(i * 10)
 		err := buf.Append(data)
 		s.Require().NoError(err)
 	}
@@ -227,8 +238,68 @@ func (s *StorageV1SerializerSuite) TestSerializeInsert() {
 		}, taskV1.checkpoint)
 		s.EqualValues(50, taskV1.tsFrom)
 		s.EqualValues(100, taskV1.tsTo)
-		s.Len(taskV1.binlogBlobs, 4)
+		s.Len(taskV1.binlogBlobs, 5)
 		s.NotNil(taskV1.batchStatsBlob)
+		s.Require().Len(taskV1.scalarStats, 1)
+		s.EqualValues(102, taskV1.scalarStats[0].GetFieldID())
+
+		stats := &storage.FieldStats{}
+		s.NoError(json.Unmarshal(taskV1.scalarStats[0].GetStats(), stats))
+		s.EqualValues(0, stats.Min.GetValue())
+		s.EqualValues(90, stats.Max.GetValue())
+		s.Nil(stats.BF)
+	})
+
+	s.Run("with_stats_generator", func() {
+		storage.RegisterStatsGenerator(schemapb.DataType_Int64, "count", func(field *schemapb.FieldSchema) (storage.StatsGenerator, error) {
+			return &countGenerator{}, nil
+		})
+		defer storage.UnregisterStatsGenerator(schemapb.DataType_Int64, "count")
+
+		pack := s.getBasicPack()
+		pack.WithTimeRange(50, 100)
+		pack.WithInsertData(s.getInsertBuffer()).WithBatchSize(10)
+
+		s.mockCache.EXPECT().UpdateSegments(mock.Anything, mock.Anything).Return().Once()
+
+		task, err := s.serializer.EncodeBuffer(ctx, pack)
+		s.NoError(err)
+
+		taskV1, ok := task.(*SyncTask)
+		s.Require().True(ok)
+		// system fields are skipped
+		s.Require().Len(taskV1.artifacts, 2)
+		s.EqualValues(100, taskV1.artifacts[0].FieldID)
+		s.EqualValues(102, taskV1.artifacts[1].FieldID)
+		s.Equal("count", taskV1.artifacts[1].Name)
+		s.Equal([]byte("10"), taskV1.artifacts[1].Value)
+	})
+
+	s.Run("with_parquet", func() {
+		params := paramtable.Get()
+		params.Save(params.DataNodeCfg.ParquetSegmentEnabled.Key, "true")
+		defer params.Reset(params.DataNodeCfg.ParquetSegmentEnabled.Key)
+
+		pack := s.getBasicPack()
+		pack.WithTimeRange(50, 100)
+		pack.WithInsertData(s.getInsertBuffer()).WithBatchSize(10)
+
+		s.mockCache.EXPECT().UpdateSegments(mock.Anything, mock.Anything).Return().Once()
+
+		task, err := s.serializer.EncodeBuffer(ctx, pack)
+		s.NoError(err)
+
+		taskV1, ok := task.(*SyncTask)
+		s.Require().True(ok)
+		// scalar fields in one group, the vector field in its own group
+		s.Require().Len(taskV1.parquetBlobs, 2)
+		s.Contains(taskV1.parquetBlobs, storage.ScalarFieldGroupID)
+		s.Require().Contains(taskV1.parquetBlobs, int64(101))
+		s.EqualValues(10, taskV1.parquetBlobs[101].RowNum)
+
+		data, err := storage.ReadParquetGroup(ctx, taskV1.parquetBlobs[storage.ScalarFieldGroupID].GetValue(), s.schema.GetFields()[2:3])
+		s.NoError(err)
+		s.Equal(pack.insertData.Data[100].GetRows(), data.Data[100].GetRows())
 	})
 
 	s.Run("with_flush_segment_not_found", func() {
@@ -269,7 +340,7 @@ func (s *StorageV1SerializerSuite) TestSerializeInsert() {
 		}, taskV1.checkpoint)
 		s.EqualValues(50, taskV1.tsFrom)
 		s.EqualValues(100, taskV1.tsTo)
-		s.Len(taskV1.binlogBlobs, 4)
+		s.Len(taskV1.binlogBlobs, 5)
 		s.NotNil(taskV1.batchStatsBlob)
 		s.NotNil(taskV1.mergedStatsBlob)
 	})
@@ -319,6 +390,20 @@ func (s *StorageV1SerializerSuite) TestBadSchema() {
 	s.Error(err)
 }
 
+// countGenerator counts the rows synced
+type countGenerator struct {
+	rows int
+}
+
+func (g *countGenerator) Update(data storage.FieldData) error {
+	g.rows += data.RowNum()
+	return nil
+}
+
+func (g *countGenerator) Serialize() ([]byte, error) {
+	return []byte(strconv.Itoa(g.rows)), nil
+}
+
 func TestStorageV1Serializer(t *testing.T) {
 	suite.Run(t, new(StorageV1SerializerSuite))
 }
@@ -59,6 +59,7 @@ type syncManager struct {
 	*keyLockDispatcher[int64]
 	chunkManager storage.ChunkManager
 	allocator    allocator.Interface
+	controller   *writeController
 
 	tasks *typeutil.ConcurrentMap[string, Task]
 }
@@ -78,6 +79,7 @@ func NewSyncManager(chunkManager storage.ChunkManager, allocator allocator.Inter
 		allocator:         allocator,
 		tasks:             typeutil.NewConcurrentMap[string, Task](),
 	}
+	syncMgr.controller = newWriteController(initPoolSize, dispatcher.workerPool.Resize)
 	// setup config update watcher
 	params.Watch(params.DataNodeCfg.MaxParallelSyncMgrTasks.Key, config.NewHandler("datanode.syncmgr.poolsize", syncMgr.resizeHandler))
 
@@ -95,7 +97,8 @@ func (mgr *syncManager) resizeHandler(evt *config.Event) {
 			log.Warn("failed to parse new datanode syncmgr pool size", zap.Error(err))
 			return
 		}
-		err = mgr.keyLockDispatcher.workerPool.Resize(int(size))
+		// the parallelism may be reduced by the write controller under the object storage throttling
+		err = mgr.keyLockDispatcher.workerPool.Resize(mgr.controller.SetMaxParallel(int(size)))
 		if err != nil {
 			log.Warn("failed to resize datanode syncmgr pool size", zap.String("key", evt.Key), zap.String("value", evt.Value), zap.Error(err))
 			return
@@ -108,6 +111,7 @@ func (mgr *syncManager) SyncData(ctx context.Context, task Task) *conc.Future[er
 	switch t := task.(type) {
 	case *SyncTask:
 		t.WithAllocator(mgr.allocator).WithChunkManager(mgr.chunkManager)
+		t.controller = mgr.controller
 	case *SyncTaskV2:
 		t.WithAllocator(mgr.allocator)
 	}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -49,6 +50,7 @@ var errTargetSegmentNotMatch = errors.New("target segment not match")
 type SyncTask struct {
 	chunkManager storage.ChunkManager
 	allocator    allocator.Interface
+	controller   *writeController
 
 	segment       *metacache.SegmentInfo
 	collectionID  int64
@@ -79,6 +81,8 @@ type SyncTask struct {
 	insertBinlogs map[int64]*datapb.FieldBinlog // map[int64]*datapb.Binlog
 	statsBinlogs  map[int64]*datapb.FieldBinlog // map[int64]*datapb.Binlog
 	deltaBinlog   *datapb.FieldBinlog
+	artifactLogs  []*datapb.FieldArtifactLogs
+	parquetLogs   []*datapb.FieldGroupParquetLogs
 
 	binlogBlobs     map[int64]*storage.Blob // fieldID => blob
 	binlogMemsize   map[int64]int64         // memory size
@@ -86,6 +90,9 @@ type SyncTask struct {
 	mergedStatsBlob *storage.Blob
 	deltaBlob       *storage.Blob
 	deltaRowCount   int64
+	scalarStats     []*datapb.FieldScalarStats
+	artifacts       []*storage.StatsArtifact
+	parquetBlobs    map[int64]*storage.Blob // groupID => blob
 
 	// prefetched log ids
 	ids []int64
@@ -170,6 +177,8 @@ func (t *SyncTask) Run() (err error) {
 
 	t.processInsertBlobs()
 	t.processStatsBlob()
+	t.processArtifacts()
+	t.processParquetBlobs()
 	t.processDeltaBlob()
 
 	err = t.writeLogs()
@@ -222,13 +231,15 @@ func (t *SyncTask) Run() (err error) {
 	t.deltaBlob = nil
 	t.mergedStatsBlob = nil
 	t.batchStatsBlob = nil
+	t.artifacts = nil
+	t.parquetBlobs = nil
 	t.segmentData = nil
 	return nil
 }
 
 // prefetchIDs pre-allcates ids depending on the number of blobs current task contains.
 func (t *SyncTask) prefetchIDs() error {
-	totalIDCount := len(t.binlogBlobs)
+	totalIDCount := len(t.binlogBlobs) + len(t.artifacts) + len(t.parquetBlobs)
 	if t.batchStatsBlob != nil {
 		totalIDCount++
 	}
@@ -263,6 +274,7 @@ func (t *SyncTask) processInsertBlobs() {
 			TimestampTo:   t.tsTo,
 			LogPath:       key,
 			LogSize:       t.binlogMemsize[fieldID],
+			Compression:   blob.Compression,
 		})
 	}
 }
@@ -277,6 +289,56 @@ func (t *SyncTask) processStatsBlob() {
 	}
 }
 
+// processArtifacts stores the artifacts of the stats generators as the artifact logs of the segment.
+func (t *SyncTask) processArtifacts() {
+	for _, artifact := range t.artifacts {
+		key := metautil.BuildArtifactLogPath(t.chunkManager.RootPath(), t.collectionID, t.partitionID, t.segmentID, artifact.FieldID, t.nextID())
+		t.segmentData[key] = artifact.Value
+		binlog := &datapb.Binlog{
+			EntriesNum:    t.batchSize,
+			TimestampFrom: t.tsFrom,
+			TimestampTo:   t.tsTo,
+			LogPath:       key,
+			LogSize:       int64(len(artifact.Value)),
+		}
+		logs, ok := lo.Find(t.artifactLogs, func(logs *datapb.FieldArtifactLogs) bool {
+			return logs.GetFieldID() == artifact.FieldID && logs.GetName() == artifact.Name
+		})
+		if !ok {
+			logs = &datapb.FieldArtifactLogs{FieldID: artifact.FieldID, Name: artifact.Name}
+			t.artifactLogs = append(t.artifactLogs, logs)
+		}
+		logs.Binlogs = append(logs.Binlogs, binlog)
+	}
+}
+
+// processParquetBlobs stores the parquet files of the field groups as the parquet logs of the segment.
+func (t *SyncTask) processParquetBlobs() {
+	if len(t.parquetBlobs) == 0 {
+		return
+	}
+	for _, group := range storage.NewParquetFieldGroups(t.schema) {
+		blob, ok := t.parquetBlobs[group.GroupID]
+		if !ok {
+			continue
+		}
+		key := metautil.BuildParquetLogPath(t.chunkManager.RootPath(), t.collectionID, t.partitionID, t.segmentID, group.GroupID, t.nextID())
+		t.segmentData[key] = blob.GetValue()
+		t.parquetLogs = append(t.parquetLogs, &datapb.FieldGroupParquetLogs{
+			GroupID:  group.GroupID,
+			FieldIDs: group.FieldIDs(),
+			Binlogs: []*datapb.Binlog{{
+				EntriesNum:    blob.RowNum,
+				TimestampFrom: t.tsFrom,
+				TimestampTo:   t.tsTo,
+				LogPath:       key,
+				LogSize:       int64(len(blob.GetValue())),
+				Compression:   blob.Compression,
+			}},
+		})
+	}
+}
+
 func (t *SyncTask) processDeltaBlob() {
 	if t.deltaBlob != nil {
 		value := t.deltaBlob.GetValue()
@@ -291,6 +353,7 @@ func (t *SyncTask) processDeltaBlob() {
 		data.TimestampFrom = t.tsFrom
 		data.TimestampTo = t.tsTo
 		data.EntriesNum = t.deltaRowCount
+		data.Compression = t.deltaBlob.Compression
 		t.appendDeltalog(data)
 	}
 }
@@ -338,9 +401,32 @@ func (t *SyncTask) appendDeltalog(deltalog *datapb.Binlog) {
 }
 
 // writeLogs writes log files (binlog/deltalog/statslog) into storage via chunkManger.
+// The logs are written in batches sized by the write controller if any, the batches written are not rewritten on retry.
 func (t *SyncTask) writeLogs() error {
+	if t.controller == nil {
+		return retry.Do(context.Background(), func() error {
+			return t.chunkManager.MultiWrite(context.Background(), t.segmentData)
+		}, t.writeRetryOpts...)
+	}
+
+	pending := lo.Keys(t.segmentData)
 	return retry.Do(context.Background(), func() error {
-		return t.chunkManager.MultiWrite(context.Background(), t.segmentData)
+		for len(pending) > 0 {
+			if backoff := t.controller.Backoff(); backoff > 0 {
+				time.Sleep(backoff)
+			}
+			batch := pending[:lo.Min([]int{t.controller.BatchSize(), len(pending)})]
+			kvs := lo.PickByKeys(t.segmentData, batch)
+			size := lo.SumBy(lo.Values(kvs), func(data []byte) int64 { return int64(len(data)) })
+			start := time.Now()
+			err := t.chunkManager.MultiWrite(context.Background(), kvs)
+			t.controller.Observe(size, time.Since(start), err)
+			if err != nil {
+				return err
+			}
+			pending = pending[len(batch):]
+		}
+		return nil
 	}, t.writeRetryOpts...)
 }
 
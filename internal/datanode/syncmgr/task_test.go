@@ -17,6 +17,7 @@
 package syncmgr
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"testing"
@@ -37,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
@@ -243,6 +245,59 @@ func (s *SyncTaskSuite) TestRunNormal() {
 		s.NoError(err)
 	})
 
+	s.Run("with_artifacts", func() {
+		task := s.getSuiteSyncTask()
+		task.WithTimeRange(50, 100)
+		task.WithBatchSize(10)
+		task.WithMetaWriter(BrokerMetaWriter(s.broker, 1))
+		task.WithCheckpoint(&msgpb.MsgPosition{
+			ChannelName: s.channelName,
+			MsgID:       []byte{1, 2, 3, 4},
+			Timestamp:   100,
+		})
+		task.artifacts = []*storage.StatsArtifact{
+			{FieldID: 100, Name: "sketch", Value: []byte("sketch_1")},
+			{FieldID: 100, Name: "terms", Value: []byte("terms")},
+		}
+
+		err := task.Run()
+		s.NoError(err)
+		s.Require().Len(task.artifactLogs, 2)
+		s.EqualValues(100, task.artifactLogs[0].GetFieldID())
+		s.Equal("sketch", task.artifactLogs[0].GetName())
+		s.Require().Len(task.artifactLogs[0].GetBinlogs(), 1)
+		s.EqualValues(10, task.artifactLogs[0].GetBinlogs()[0].GetEntriesNum())
+		s.Contains(task.artifactLogs[0].GetBinlogs()[0].GetLogPath(), common.SegmentArtifactLogPath)
+		s.Equal("terms", task.artifactLogs[1].GetName())
+	})
+
+	s.Run("with_parquet", func() {
+		task := s.getSuiteSyncTask()
+		task.WithTimeRange(50, 100)
+		task.WithBatchSize(10)
+		task.WithMetaWriter(BrokerMetaWriter(s.broker, 1))
+		task.WithCheckpoint(&msgpb.MsgPosition{
+			ChannelName: s.channelName,
+			MsgID:       []byte{1, 2, 3, 4},
+			Timestamp:   100,
+		})
+		task.parquetBlobs = map[int64]*storage.Blob{
+			storage.ScalarFieldGroupID: {Value: []byte("scalars"), RowNum: 10},
+			101:                        {Value: []byte("vector"), RowNum: 10},
+		}
+
+		err := task.Run()
+		s.NoError(err)
+		s.Require().Len(task.parquetLogs, 2)
+		s.Equal(storage.ScalarFieldGroupID, task.parquetLogs[0].GetGroupID())
+		s.Equal([]int64{common.RowIDField, common.TimeStampField, 100}, task.parquetLogs[0].GetFieldIDs())
+		s.Require().Len(task.parquetLogs[0].GetBinlogs(), 1)
+		s.EqualValues(10, task.parquetLogs[0].GetBinlogs()[0].GetEntriesNum())
+		s.Contains(task.parquetLogs[0].GetBinlogs()[0].GetLogPath(), common.SegmentParquetLogPath)
+		s.EqualValues(101, task.parquetLogs[1].GetGroupID())
+		s.Equal([]int64{101}, task.parquetLogs[1].GetFieldIDs())
+	})
+
 	s.Run("with_delta_data", func() {
 		task := s.getSuiteSyncTask()
 		task.WithTimeRange(50, 100)
@@ -412,6 +467,40 @@ func (s *SyncTaskSuite) TestRunError() {
 	})
 }
 
+func (s *SyncTaskSuite) TestWriteLogsInBatches() {
+	params := paramtable.Get()
+	params.Save(params.DataNodeCfg.SyncAdaptiveEnabled.Key, "true")
+	defer params.Reset(params.DataNodeCfg.SyncAdaptiveEnabled.Key)
+	params.Save(params.DataNodeCfg.SyncAdaptiveMaxBatchSize.Key, "2")
+	defer params.Reset(params.DataNodeCfg.SyncAdaptiveMaxBatchSize.Key)
+
+	task := s.getSuiteSyncTask()
+	task.controller = newWriteController(4, func(int) error { return nil })
+	task.segmentData = map[string][]byte{"a": {1}, "b": {2}, "c": {3}}
+	task.WithWriteRetryOptions(retry.Attempts(2), retry.Sleep(time.Millisecond))
+
+	written := make(map[string]int)
+	throttled := false
+	s.chunkManager.ExpectedCalls = nil
+	s.chunkManager.EXPECT().MultiWrite(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, kvs map[string][]byte) error {
+		// throttled once after the first batch written
+		if len(written) > 0 && !throttled {
+			throttled = true
+			return merr.WrapErrIoThrottled("key", errors.New("SlowDown"))
+		}
+		for key := range kvs {
+			written[key]++
+		}
+		return nil
+	})
+
+	s.NoError(task.writeLogs())
+	s.Equal(map[string]int{"a": 1, "b": 1, "c": 1}, written)
+	s.True(throttled)
+	// backoff recovered once the writes succeed
+	s.Zero(task.controller.Backoff())
+}
+
 func (s *SyncTaskSuite) TestNextID() {
 	task := s.getSuiteSyncTask()
 
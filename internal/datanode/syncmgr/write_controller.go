@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncmgr
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	// the weight of the latest observation in the moving averages
+	writeObserveWeight = 0.2
+	// the error rate above which the object storage is regarded as overloaded
+	writeErrorRateThreshold = 0.5
+	minWriteBackoff         = 200 * time.Millisecond
+	// the batches smaller than it are observed as its size, so that the fixed cost of the small requests
+	// is not regarded as slowness
+	minObservedWriteSize = 1024 * 1024
+)
+
+// writeController adapts the sync parallelism and the write batch size to the PUT latency and the error rate
+// of the object storage. It backs off multiplicatively once the writes slow down or get throttled,
+// and recovers additively after the writes succeed in time, so that the throttled object storage
+// is not hammered by the retries.
+type writeController struct {
+	mu sync.Mutex
+
+	maxParallel int
+	parallel    int
+	batchSize   int
+	backoff     time.Duration
+	// moving averages of the PUT latency per MB written and of the error rate
+	latency   time.Duration
+	errorRate float64
+
+	resize func(size int) error
+}
+
+func newWriteController(maxParallel int, resize func(size int) error) *writeController {
+	return &writeController{
+		maxParallel: maxParallel,
+		parallel:    maxParallel,
+		batchSize:   paramtable.Get().DataNodeCfg.SyncAdaptiveMaxBatchSize.GetAsInt(),
+		resize:      resize,
+	}
+}
+
+// SetMaxParallel updates the max parallelism, returns the parallelism shall be applied.
+// The parallelism reduced is kept unless above the new max parallelism,
+// and the invalid max parallelism is returned as is and left for the pool to reject.
+func (c *writeController) SetMaxParallel(maxParallel int) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if maxParallel < 1 {
+		return maxParallel
+	}
+	if c.parallel >= c.maxParallel || c.parallel > maxParallel || !paramtable.Get().DataNodeCfg.SyncAdaptiveEnabled.GetAsBool() {
+		c.parallel = maxParallel
+	}
+	c.maxParallel = maxParallel
+	return c.parallel
+}
+
+// BatchSize returns the number of objects shall be written in one batch.
+func (c *writeController) BatchSize() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !paramtable.Get().DataNodeCfg.SyncAdaptiveEnabled.GetAsBool() {
+		return paramtable.Get().DataNodeCfg.SyncAdaptiveMaxBatchSize.GetAsInt()
+	}
+	return c.batchSize
+}
+
+// Backoff returns the duration to wait before writing the next batch.
+func (c *writeController) Backoff() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !paramtable.Get().DataNodeCfg.SyncAdaptiveEnabled.GetAsBool() {
+		return 0
+	}
+	return c.backoff
+}
+
+// Observe records the result of writing a batch of objects of the size in bytes.
+// The latency is normalized by the size, so that writing the large binlogs is not regarded as slow.
+func (c *writeController) Observe(size int64, elapse time.Duration, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	params := paramtable.Get()
+	if !params.DataNodeCfg.SyncAdaptiveEnabled.GetAsBool() {
+		c.backoff = 0
+		c.batchSize = params.DataNodeCfg.SyncAdaptiveMaxBatchSize.GetAsInt()
+		c.setParallel(c.maxParallel)
+		return
+	}
+
+	var failure float64
+	if err != nil {
+		failure = 1
+	} else {
+		normalized := float64(elapse) * minObservedWriteSize / float64(lo.Max([]int64{size, minObservedWriteSize}))
+		c.latency = time.Duration(float64(c.latency)*(1-writeObserveWeight) + normalized*writeObserveWeight)
+	}
+	c.errorRate = c.errorRate*(1-writeObserveWeight) + failure*writeObserveWeight
+
+	var (
+		throttled        = errors.Is(err, merr.ErrIoThrottled)
+		latencyThreshold = params.DataNodeCfg.SyncAdaptiveLatencyThreshold.GetAsDuration(time.Millisecond)
+		maxBatchSize     = params.DataNodeCfg.SyncAdaptiveMaxBatchSize.GetAsInt()
+		maxBackoff       = params.DataNodeCfg.SyncAdaptiveMaxBackoff.GetAsDuration(time.Second)
+	)
+	switch {
+	case throttled || c.errorRate > writeErrorRateThreshold || (latencyThreshold > 0 && c.latency > latencyThreshold):
+		c.batchSize = lo.Max([]int{c.batchSize / 2, 1})
+		c.setParallel(lo.Max([]int{c.parallel / 2, 1}))
+		if throttled {
+			c.backoff = lo.Min([]time.Duration{lo.Max([]time.Duration{c.backoff * 2, minWriteBackoff}), maxBackoff})
+		}
+		log.Warn("object storage overloaded, sync slowed down",
+			zap.Bool("throttled", throttled),
+			zap.Duration("latency", c.latency),
+			zap.Float64("errorRate", c.errorRate),
+			zap.Int("parallel", c.parallel),
+			zap.Int("batchSize", c.batchSize),
+			zap.Duration("backoff", c.backoff))
+	case err == nil:
+		c.batchSize = lo.Min([]int{c.batchSize + 1, maxBatchSize})
+		c.setParallel(lo.Min([]int{c.parallel + 1, c.maxParallel}))
+		if c.backoff /= 2; c.backoff < minWriteBackoff {
+			c.backoff = 0
+		}
+	}
+}
+
+func (c *writeController) setParallel(parallel int) {
+	if parallel == c.parallel {
+		return
+	}
+	if err := c.resize(parallel); err != nil {
+		log.Warn("failed to resize sync parallelism", zap.Int("parallel", parallel), zap.Error(err))
+		return
+	}
+	c.parallel = parallel
+}
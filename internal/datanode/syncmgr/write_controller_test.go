@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncmgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type WriteControllerSuite struct {
+	suite.Suite
+
+	size       int
+	controller *writeController
+}
+
+func (s *WriteControllerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *WriteControllerSuite) SetupTest() {
+	paramtable.Get().Save(paramtable.Get().DataNodeCfg.SyncAdaptiveEnabled.Key, "true")
+	s.size = 8
+	s.controller = newWriteController(8, func(size int) error {
+		s.size = size
+		return nil
+	})
+}
+
+func (s *WriteControllerSuite) TearDownTest() {
+	params := paramtable.Get()
+	params.Reset(params.DataNodeCfg.SyncAdaptiveEnabled.Key)
+	params.Reset(params.DataNodeCfg.SyncAdaptiveLatencyThreshold.Key)
+}
+
+func (s *WriteControllerSuite) TestThrottled() {
+	s.Equal(16, s.controller.BatchSize())
+	s.Zero(s.controller.Backoff())
+
+	err := merr.WrapErrIoThrottled("key", errors.New("SlowDown"))
+	s.controller.Observe(4096, time.Millisecond, err)
+	s.Equal(4, s.size)
+	s.Equal(8, s.controller.BatchSize())
+	s.Equal(minWriteBackoff, s.controller.Backoff())
+
+	s.controller.Observe(4096, time.Millisecond, err)
+	s.Equal(2, s.size)
+	s.Equal(4, s.controller.BatchSize())
+	s.Equal(2*minWriteBackoff, s.controller.Backoff())
+
+	// recover additively once the writes succeed
+	s.controller.Observe(4096, time.Millisecond, nil)
+	s.Equal(3, s.size)
+	s.Equal(5, s.controller.BatchSize())
+	s.Equal(minWriteBackoff, s.controller.Backoff())
+	for i := 0; i < 20; i++ {
+		s.controller.Observe(4096, time.Millisecond, nil)
+	}
+	s.Equal(8, s.size)
+	s.Equal(16, s.controller.BatchSize())
+	s.Zero(s.controller.Backoff())
+}
+
+func (s *WriteControllerSuite) TestSlow() {
+	paramtable.Get().Save(paramtable.Get().DataNodeCfg.SyncAdaptiveLatencyThreshold.Key, "10")
+	for i := 0; i < 3; i++ {
+		s.controller.Observe(4096, time.Second, nil)
+	}
+	s.Less(s.size, 8)
+	s.Less(s.controller.BatchSize(), 16)
+	// slow writes reduce the parallelism without backoff
+	s.Zero(s.controller.Backoff())
+}
+
+func (s *WriteControllerSuite) TestLargeWrites() {
+	paramtable.Get().Save(paramtable.Get().DataNodeCfg.SyncAdaptiveLatencyThreshold.Key, "10")
+	// 1 GB written in a second is not slow
+	for i := 0; i < 3; i++ {
+		s.controller.Observe(1024*1024*1024, time.Second, nil)
+	}
+	s.Equal(8, s.size)
+	s.Equal(16, s.controller.BatchSize())
+}
+
+func (s *WriteControllerSuite) TestDisabled() {
+	s.controller.Observe(4096, time.Millisecond, merr.WrapErrIoThrottled("key", errors.New("SlowDown")))
+	s.Equal(4, s.size)
+
+	paramtable.Get().Save(paramtable.Get().DataNodeCfg.SyncAdaptiveEnabled.Key, "false")
+	s.Zero(s.controller.Backoff())
+	s.controller.Observe(4096, time.Millisecond, merr.WrapErrIoThrottled("key", errors.New("SlowDown")))
+	s.Equal(8, s.size)
+	s.Equal(16, s.controller.BatchSize())
+}
+
+func (s *WriteControllerSuite) TestSetMaxParallel() {
+	s.controller.Observe(4096, time.Millisecond, merr.WrapErrIoThrottled("key", errors.New("SlowDown")))
+	s.Equal(4, s.size)
+	s.Equal(4, s.controller.SetMaxParallel(16))
+	s.Equal(2, s.controller.SetMaxParallel(2))
+	s.Equal(-1, s.controller.SetMaxParallel(-1))
+	s.Equal(16, s.controller.SetMaxParallel(16))
+}
+
+func TestWriteController(t *testing.T) {
+	suite.Run(t, new(WriteControllerSuite))
+}
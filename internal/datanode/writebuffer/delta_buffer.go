@@ -44,11 +44,14 @@ func (ib *DeltaBuffer) getTimestampRange(tss []typeutil.Timestamp) TimeRange {
 	return tr
 }
 
+// Yield returns the deletes buffered, the duplicated deletes are removed,
+// and the deletes are sorted by primary key and timestamp.
 func (db *DeltaBuffer) Yield() *storage.DeleteData {
 	if db.IsEmpty() {
 		return nil
 	}
 
+	db.buffer.Compact()
 	return db.buffer
 }
 
@@ -64,6 +64,20 @@ func (s *DeltaBufferSuite) TestYield() {
 	s.ElementsMatch(pks, result.Pks)
 }
 
+func (s *DeltaBufferSuite) TestYieldCompacted() {
+	deltaBuffer := NewDeltaBuffer()
+
+	pks := []storage.PrimaryKey{storage.NewInt64PrimaryKey(2), storage.NewInt64PrimaryKey(1), storage.NewInt64PrimaryKey(2), storage.NewInt64PrimaryKey(2)}
+	deltaBuffer.Buffer(pks, []uint64{102, 101, 100, 102}, &msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 200})
+
+	// only the duplicated delete is removed, the earlier delete of the same pk is kept for the reads between them
+	result := deltaBuffer.Yield()
+	s.Require().NotNil(result)
+	s.Equal([]storage.PrimaryKey{pks[1], pks[2], pks[0]}, result.Pks)
+	s.Equal([]uint64{101, 100, 102}, result.Tss)
+	s.EqualValues(3, result.RowCount)
+}
+
 func (s *DeltaBufferSuite) SetupSuite() {
 	paramtable.Init()
 }
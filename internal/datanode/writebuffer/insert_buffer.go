@@ -1,14 +1,20 @@
 package writebuffer
 
 import (
+	"context"
 	"math"
+	"path"
+	"strconv"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/diskquota"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -78,6 +84,14 @@ type InsertBuffer struct {
 	collSchema *schemapb.CollectionSchema
 
 	buffer *storage.InsertData
+
+	// spilled holds the local files of each spill of the buffered data,
+	// which are loaded back before yield.
+	spilled     [][]string
+	spilledSize int64
+	// spilledDiskSize is the size of the spilled files reserved from the local disk quota
+	spilledDiskSize int64
+	spillCM         storage.ChunkManager
 }
 
 func NewInsertBuffer(sch *schemapb.CollectionSchema) (*InsertBuffer, error) {
@@ -116,6 +130,95 @@ func (ib *InsertBuffer) Yield() *storage.InsertData {
 	return ib.buffer
 }
 
+// MemorySize returns the size of the buffered data kept in memory, the data spilled excluded.
+func (ib *InsertBuffer) MemorySize() int64 {
+	return ib.size - ib.spilledSize
+}
+
+// Spill writes the buffered data kept in memory to the local files under the prefix,
+// returns the size in bytes spilled. It fails if the local disk quota exceeded.
+func (ib *InsertBuffer) Spill(cm storage.ChunkManager, prefix string) (int64, error) {
+	size := ib.MemorySize()
+	if size == 0 || ib.buffer.IsEmpty() {
+		return 0, nil
+	}
+
+	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{Schema: ib.collSchema})
+	blobs, err := codec.Serialize(0, 0, ib.buffer)
+	if err != nil {
+		return 0, err
+	}
+	dir := path.Join(prefix, strconv.Itoa(len(ib.spilled)))
+	kvs := lo.SliceToMap(blobs, func(blob *storage.Blob) (string, []byte) {
+		return path.Join(dir, blob.GetKey()), blob.GetValue()
+	})
+	diskSize := lo.SumBy(blobs, func(blob *storage.Blob) int64 { return int64(len(blob.GetValue())) })
+	if err := diskquota.Reserve(diskquota.ConsumerWriteBuffer, diskSize); err != nil {
+		return 0, err
+	}
+	if err := cm.MultiWrite(context.Background(), kvs); err != nil {
+		diskquota.Release(diskquota.ConsumerWriteBuffer, diskSize)
+		return 0, err
+	}
+	buffer, err := storage.NewInsertData(ib.collSchema)
+	if err != nil {
+		return 0, err
+	}
+
+	ib.buffer = buffer
+	ib.spilled = append(ib.spilled, lo.Keys(kvs))
+	ib.spilledSize += size
+	ib.spilledDiskSize += diskSize
+	ib.spillCM = cm
+	return size, nil
+}
+
+// Unspill loads the spilled data back into memory and removes the spilled files.
+func (ib *InsertBuffer) Unspill() error {
+	if len(ib.spilled) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{Schema: ib.collSchema})
+	buffer, err := storage.NewInsertData(ib.collSchema)
+	if err != nil {
+		return err
+	}
+	for _, paths := range ib.spilled {
+		values, err := ib.spillCM.MultiRead(ctx, paths)
+		if err != nil {
+			return err
+		}
+		blobs := lo.Map(paths, func(p string, i int) *storage.Blob {
+			return &storage.Blob{Key: path.Base(p), Value: values[i]}
+		})
+		_, _, data, err := codec.Deserialize(blobs)
+		if err != nil {
+			return err
+		}
+		storage.MergeInsertData(buffer, data)
+	}
+	storage.MergeInsertData(buffer, ib.buffer)
+
+	ib.buffer = buffer
+	ib.releaseSpilled()
+	return nil
+}
+
+// releaseSpilled removes the spilled files, the spilled data is discarded if not loaded yet.
+func (ib *InsertBuffer) releaseSpilled() {
+	for _, paths := range ib.spilled {
+		if err := ib.spillCM.MultiRemove(context.Background(), paths); err != nil {
+			log.Warn("failed to remove spilled files", zap.Strings("paths", paths), zap.Error(err))
+		}
+	}
+	diskquota.Release(diskquota.ConsumerWriteBuffer, ib.spilledDiskSize)
+	ib.spilled = nil
+	ib.spilledSize = 0
+	ib.spilledDiskSize = 0
+}
+
 func (ib *InsertBuffer) Buffer(inData *inData, startPos, endPos *msgpb.MsgPosition) int64 {
 	totalMemSize := int64(0)
 	for idx, data := range inData.data {
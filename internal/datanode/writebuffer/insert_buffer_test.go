@@ -1,7 +1,9 @@
 package writebuffer
 
 import (
+	"context"
 	"math/rand"
+	"path"
 	"testing"
 	"time"
 
@@ -11,8 +13,11 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/diskquota"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
@@ -174,6 +179,81 @@ func (s *InsertBufferSuite) TestYield() {
 	s.ElementsMatch(pks, pkData)
 }
 
+func (s *InsertBufferSuite) TestSpillQuotaExceeded() {
+	params := paramtable.Get()
+	params.Save(params.LocalStorageCfg.DiskCapacityLimit.Key, "0")
+	defer params.Reset(params.LocalStorageCfg.DiskCapacityLimit.Key)
+
+	wb := &writeBufferBase{
+		collSchema: s.collSchema,
+	}
+	cm := storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+	insertBuffer, err := NewInsertBuffer(s.collSchema)
+	s.Require().NoError(err)
+
+	_, insertMsg := s.composeInsertMsg(10, 128)
+	groups, err := wb.prepareInsert([]*msgstream.InsertMsg{insertMsg})
+	s.Require().NoError(err)
+	memSize := insertBuffer.Buffer(groups[0], &msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 200})
+
+	_, err = insertBuffer.Spill(cm, path.Join(cm.RootPath(), "ch-1", "1"))
+	s.ErrorIs(err, merr.ErrServiceDiskLimitExceeded)
+	s.Equal(memSize, insertBuffer.MemorySize())
+	s.Zero(diskquota.Usage(diskquota.ConsumerWriteBuffer))
+	files, _, err := cm.ListWithPrefix(context.Background(), cm.RootPath(), true)
+	s.NoError(err)
+	s.Empty(files)
+}
+
+func (s *InsertBufferSuite) TestSpill() {
+	wb := &writeBufferBase{
+		collSchema: s.collSchema,
+	}
+	cm := storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+	insertBuffer, err := NewInsertBuffer(s.collSchema)
+	s.Require().NoError(err)
+
+	// nothing to spill
+	size, err := insertBuffer.Spill(cm, path.Join(cm.RootPath(), "ch-1", "1"))
+	s.NoError(err)
+	s.Zero(size)
+
+	var pks []int64
+	for i := 0; i < 2; i++ {
+		batch, insertMsg := s.composeInsertMsg(10, 128)
+		pks = append(pks, batch...)
+		groups, err := wb.prepareInsert([]*msgstream.InsertMsg{insertMsg})
+		s.Require().NoError(err)
+		memSize := insertBuffer.Buffer(groups[0], &msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 200})
+
+		size, err = insertBuffer.Spill(cm, path.Join(cm.RootPath(), "ch-1", "1"))
+		s.NoError(err)
+		s.Equal(memSize, size)
+		s.Zero(insertBuffer.MemorySize())
+		s.False(insertBuffer.IsEmpty())
+	}
+	s.Positive(diskquota.Usage(diskquota.ConsumerWriteBuffer))
+	batch, insertMsg := s.composeInsertMsg(10, 128)
+	pks = append(pks, batch...)
+	groups, err := wb.prepareInsert([]*msgstream.InsertMsg{insertMsg})
+	s.Require().NoError(err)
+	insertBuffer.Buffer(groups[0], &msgpb.MsgPosition{Timestamp: 100}, &msgpb.MsgPosition{Timestamp: 200})
+
+	s.NoError(insertBuffer.Unspill())
+	s.Equal(insertBuffer.size, insertBuffer.MemorySize())
+	s.Zero(diskquota.Usage(diskquota.ConsumerWriteBuffer))
+	files, _, err := cm.ListWithPrefix(context.Background(), cm.RootPath(), true)
+	s.NoError(err)
+	s.Empty(files)
+
+	result := insertBuffer.Yield()
+	s.Require().NotNil(result)
+	pkField, ok := result.Data[common.StartOfUserFieldID]
+	s.Require().True(ok)
+	pkData := lo.RepeatBy(pkField.RowNum(), func(idx int) int64 { return pkField.GetRow(idx).(int64) })
+	s.ElementsMatch(pks, pkData)
+}
+
 type InsertBufferConstructSuite struct {
 	suite.Suite
 	schema *schemapb.CollectionSchema
@@ -2,6 +2,9 @@ package writebuffer
 
 import (
 	"context"
+	"fmt"
+	"path"
+	"sort"
 	"sync"
 	"time"
 
@@ -10,6 +13,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/datanode/metacache"
 	"github.com/milvus-io/milvus/internal/datanode/syncmgr"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
@@ -46,11 +50,14 @@ type BufferManager interface {
 
 // NewManager returns initialized manager as `Manager`
 func NewManager(syncMgr syncmgr.SyncManager) BufferManager {
+	spillPath := path.Join(paramtable.Get().LocalStorageCfg.Path.GetValue(), "datanode_spill", fmt.Sprint(paramtable.GetNodeID()))
 	return &bufferManager{
 		syncMgr: syncMgr,
 		buffers: make(map[string]WriteBuffer),
+		spillCM: storage.NewLocalChunkManager(storage.RootPath(spillPath)),
 
-		ch: lifetime.NewSafeChan(),
+		ch:       lifetime.NewSafeChan(),
+		notifyCh: make(chan struct{}, 1),
 	}
 }
 
@@ -58,12 +65,19 @@ type bufferManager struct {
 	syncMgr syncmgr.SyncManager
 	buffers map[string]WriteBuffer
 	mut     sync.RWMutex
+	// spillCM is the local chunk manager the write buffers spilled to
+	spillCM storage.ChunkManager
 
-	wg sync.WaitGroup
-	ch lifetime.SafeChan
+	wg       sync.WaitGroup
+	ch       lifetime.SafeChan
+	notifyCh chan struct{}
 }
 
 func (m *bufferManager) Start() {
+	// the spilled files left by the last run are useless, the data shall be consumed again from the checkpoints
+	if err := m.spillCM.RemoveWithPrefix(context.Background(), m.spillCM.RootPath()); err != nil {
+		log.Warn("failed to clean up spilled files", zap.String("path", m.spillCM.RootPath()), zap.Error(err))
+	}
 	m.wg.Add(1)
 	go func() {
 		defer m.wg.Done()
@@ -79,6 +93,8 @@ func (m *bufferManager) check() {
 		case <-ticker.C:
 			m.memoryCheck()
 			ticker.Reset(paramtable.Get().DataNodeCfg.MemoryCheckInterval.GetAsDuration(time.Millisecond))
+		case <-m.notifyCh:
+			m.memoryCheck()
 		case <-m.ch.CloseCh():
 			log.Info("buffer manager memory check stopped")
 			return
@@ -86,7 +102,25 @@ func (m *bufferManager) check() {
 	}
 }
 
+// notifyMemoryCheck triggers a memory check without waiting for the next check interval.
+func (m *bufferManager) notifyMemoryCheck() {
+	select {
+	case m.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+// memoryLimit returns the global cap of the write buffers of all the channels.
+func (m *bufferManager) memoryLimit() float64 {
+	if poolSize := paramtable.Get().DataNodeCfg.MemoryBufferPoolSize.GetAsInt64(); poolSize > 0 {
+		return float64(poolSize)
+	}
+	return float64(hardware.GetMemoryCount()) * paramtable.Get().DataNodeCfg.MemoryWatermark.GetAsFloat()
+}
+
 // memoryCheck performs check based on current memory usage & configuration.
+// Once the write buffers of all the channels exceed the limit, the largest buffers are spilled to
+// the local disk if enabled, or force synced, until the total memory usage drops under the limit.
 func (m *bufferManager) memoryCheck() {
 	if !paramtable.Get().DataNodeCfg.MemoryForceSyncEnable.GetAsBool() {
 		return
@@ -95,37 +129,52 @@ func (m *bufferManager) memoryCheck() {
 	m.mut.Lock()
 	defer m.mut.Unlock()
 
+	type candidate struct {
+		channel string
+		buf     WriteBuffer
+		size    int64
+	}
 	var total int64
-	var candidate WriteBuffer
-	var candiSize int64
-	var candiChan string
+	candidates := make([]candidate, 0, len(m.buffers))
 	for chanName, buf := range m.buffers {
 		size := buf.MemorySize()
 		total += size
-		if size > candiSize {
-			candiSize = size
-			candidate = buf
-			candiChan = chanName
-		}
+		candidates = append(candidates, candidate{channel: chanName, buf: buf, size: size})
 	}
 
 	toMB := func(mem float64) float64 {
 		return mem / 1024 / 1024
 	}
 
-	totalMemory := hardware.GetMemoryCount()
-	memoryWatermark := float64(totalMemory) * paramtable.Get().DataNodeCfg.MemoryWatermark.GetAsFloat()
-	if float64(total) < memoryWatermark {
+	memoryLimit := m.memoryLimit()
+	if float64(total) < memoryLimit {
 		log.RatedDebug(20, "skip force sync because memory level is not high enough",
 			zap.Float64("current_total_memory_usage", toMB(float64(total))),
-			zap.Float64("current_memory_watermark", toMB(memoryWatermark)))
+			zap.Float64("current_memory_watermark", toMB(memoryLimit)))
 		return
 	}
 
-	if candidate != nil {
-		candidate.EvictBuffer(GetOldestBufferPolicy(paramtable.Get().DataNodeCfg.MemoryForceSyncSegmentNum.GetAsInt()))
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].size > candidates[j].size
+	})
+	spillEnabled := paramtable.Get().DataNodeCfg.MemorySpillEnable.GetAsBool()
+	for _, candidate := range candidates {
+		if float64(total) < memoryLimit || candidate.size == 0 {
+			break
+		}
+		if spillEnabled {
+			spilled, err := candidate.buf.SpillBuffer(m.spillCM)
+			if err == nil && spilled > 0 {
+				total -= spilled
+				log.Info("spill writebuffer to disk",
+					zap.String("channel", candidate.channel), zap.Float64("spilledSize(MB)", toMB(float64(spilled))))
+				continue
+			}
+		}
+		candidate.buf.EvictBuffer(GetOldestBufferPolicy(paramtable.Get().DataNodeCfg.MemoryForceSyncSegmentNum.GetAsInt()))
+		total -= candidate.size - candidate.buf.MemorySize()
 		log.Info("notify writebuffer to sync",
-			zap.String("channel", candiChan), zap.Float64("bufferSize(MB)", toMB(float64(candiSize))))
+			zap.String("channel", candidate.channel), zap.Float64("bufferSize(MB)", toMB(float64(candidate.size))))
 	}
 }
 
@@ -194,7 +243,11 @@ func (m *bufferManager) BufferData(channel string, insertMsgs []*msgstream.Inser
 		return merr.WrapErrChannelNotFound(channel)
 	}
 
-	return buf.BufferData(insertMsgs, deleteMsgs, startPos, endPos)
+	if err := buf.BufferData(insertMsgs, deleteMsgs, startPos, endPos); err != nil {
+		return err
+	}
+	m.notifyMemoryCheck()
+	return nil
 }
 
 // GetCheckpoint returns checkpoint for provided channel.
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/allocator"
 	"github.com/milvus-io/milvus/internal/datanode/metacache"
 	"github.com/milvus-io/milvus/internal/datanode/syncmgr"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -238,6 +240,45 @@ func (s *ManagerSuite) TestMemoryCheck() {
 	wb.AssertExpectations(s.T())
 }
 
+func (s *ManagerSuite) TestMemoryCheckSpill() {
+	manager := s.manager
+	manager.spillCM = storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+	param := paramtable.Get()
+	param.Save(param.DataNodeCfg.MemoryBufferPoolSize.Key, "450")
+	param.Save(param.DataNodeCfg.MemorySpillEnable.Key, "true")
+	defer func() {
+		param.Reset(param.DataNodeCfg.MemoryBufferPoolSize.Key)
+		param.Reset(param.DataNodeCfg.MemorySpillEnable.Key)
+	}()
+
+	large := NewMockWriteBuffer(s.T())
+	large.EXPECT().MemorySize().Return(300)
+	medium := NewMockWriteBuffer(s.T())
+	medium.EXPECT().MemorySize().Return(200)
+	small := NewMockWriteBuffer(s.T())
+	small.EXPECT().MemorySize().Return(100)
+	manager.buffers = map[string]WriteBuffer{"large": large, "medium": medium, "small": small}
+
+	s.Run("spill_largest", func() {
+		large.EXPECT().SpillBuffer(manager.spillCM).Return(300, nil).Once()
+		manager.memoryCheck()
+		medium.AssertNotCalled(s.T(), "SpillBuffer", mock.Anything)
+	})
+
+	s.Run("sync_if_spill_failed", func() {
+		large.EXPECT().SpillBuffer(manager.spillCM).Return(0, errors.New("mocked")).Once()
+		large.EXPECT().EvictBuffer(mock.Anything).Return().Once()
+		medium.EXPECT().SpillBuffer(manager.spillCM).Return(200, nil).Once()
+		manager.memoryCheck()
+		small.AssertNotCalled(s.T(), "SpillBuffer", mock.Anything)
+	})
+
+	s.Run("under_pool_size", func() {
+		param.Save(param.DataNodeCfg.MemoryBufferPoolSize.Key, "1000")
+		manager.memoryCheck()
+	})
+}
+
 func TestManager(t *testing.T) {
 	suite.Run(t, new(ManagerSuite))
 }
@@ -9,6 +9,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	msgstream "github.com/milvus-io/milvus/pkg/mq/msgstream"
+
+	storage "github.com/milvus-io/milvus/internal/storage"
 )
 
 // MockWriteBuffer is an autogenerated mock type for the WriteBuffer type
@@ -391,6 +393,58 @@ func (_c *MockWriteBuffer_SetFlushTimestamp_Call) RunAndReturn(run func(uint64))
 	return _c
 }
 
+// SpillBuffer provides a mock function with given fields: cm
+func (_m *MockWriteBuffer) SpillBuffer(cm storage.ChunkManager) (int64, error) {
+	ret := _m.Called(cm)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(storage.ChunkManager) (int64, error)); ok {
+		return rf(cm)
+	}
+	if rf, ok := ret.Get(0).(func(storage.ChunkManager) int64); ok {
+		r0 = rf(cm)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(storage.ChunkManager) error); ok {
+		r1 = rf(cm)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWriteBuffer_SpillBuffer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SpillBuffer'
+type MockWriteBuffer_SpillBuffer_Call struct {
+	*mock.Call
+}
+
+// SpillBuffer is a helper method to define mock.On call
+//   - cm storage.ChunkManager
+func (_e *MockWriteBuffer_Expecter) SpillBuffer(cm interface{}) *MockWriteBuffer_SpillBuffer_Call {
+	return &MockWriteBuffer_SpillBuffer_Call{Call: _e.mock.On("SpillBuffer", cm)}
+}
+
+func (_c *MockWriteBuffer_SpillBuffer_Call) Run(run func(cm storage.ChunkManager)) *MockWriteBuffer_SpillBuffer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(storage.ChunkManager))
+	})
+	return _c
+}
+
+func (_c *MockWriteBuffer_SpillBuffer_Call) Return(_a0 int64, _a1 error) *MockWriteBuffer_SpillBuffer_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWriteBuffer_SpillBuffer_Call) RunAndReturn(run func(storage.ChunkManager) (int64, error)) *MockWriteBuffer_SpillBuffer_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWriteBuffer creates a new instance of MockWriteBuffer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWriteBuffer(t interface {
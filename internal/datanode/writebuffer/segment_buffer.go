@@ -67,7 +67,7 @@ func (buf *segmentBuffer) GetTimeRange() *TimeRange {
 
 // MemorySize returns total memory size of insert buffer & delta buffer.
 func (buf *segmentBuffer) MemorySize() int64 {
-	return buf.insertBuffer.size + buf.deltaBuffer.size
+	return buf.insertBuffer.MemorySize() + buf.deltaBuffer.size
 }
 
 // TimeRange is a range of timestamp contains the min-timestamp and max-timestamp
@@ -3,6 +3,8 @@ package writebuffer
 import (
 	"context"
 	"fmt"
+	"path"
+	"strconv"
 	"sync"
 
 	"github.com/bits-and-blooms/bloom/v3"
@@ -54,6 +56,9 @@ type WriteBuffer interface {
 	MemorySize() int64
 	// EvictBuffer evicts buffer to sync manager which match provided sync policies.
 	EvictBuffer(policies ...SyncPolicy)
+	// SpillBuffer spills the insert buffers kept in memory to the local files via the provided chunk manager,
+	// returns the size in bytes spilled. The spilled data is loaded back when the segment is synced.
+	SpillBuffer(cm storage.ChunkManager) (int64, error)
 	// Close is the method to close and sink current buffer data.
 	Close(drop bool)
 }
@@ -200,6 +205,27 @@ func (wb *writeBufferBase) EvictBuffer(policies ...SyncPolicy) {
 	}
 }
 
+func (wb *writeBufferBase) SpillBuffer(cm storage.ChunkManager) (int64, error) {
+	wb.mut.Lock()
+	defer wb.mut.Unlock()
+
+	var spilled int64
+	for segmentID, buf := range wb.buffers {
+		prefix := path.Join(cm.RootPath(), wb.channelName, strconv.FormatInt(segmentID, 10))
+		size, err := buf.insertBuffer.Spill(cm, prefix)
+		if err != nil {
+			log.Warn("failed to spill insert buffer", zap.String("channel", wb.channelName), zap.Int64("segmentID", segmentID), zap.Error(err))
+			return spilled, err
+		}
+		spilled += size
+	}
+	if spilled > 0 {
+		metrics.DataNodeFlowGraphBufferDataSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(wb.collectionID)).Sub(float64(spilled))
+		log.Info("write buffer spilled", zap.String("channel", wb.channelName), zap.Int64("spilledSize", spilled))
+	}
+	return spilled, nil
+}
+
 func (wb *writeBufferBase) GetCheckpoint() *msgpb.MsgPosition {
 	log := log.Ctx(context.Background()).
 		With(zap.String("channel", wb.channelName)).
@@ -345,11 +371,18 @@ func (wb *writeBufferBase) getOrCreateBuffer(segmentID int64) *segmentBuffer {
 	return buffer
 }
 
-func (wb *writeBufferBase) yieldBuffer(segmentID int64) (*storage.InsertData, *storage.DeleteData, *TimeRange, *msgpb.MsgPosition) {
+func (wb *writeBufferBase) yieldBuffer(segmentID int64) (*storage.InsertData, *storage.DeleteData, *TimeRange, *msgpb.MsgPosition, error) {
 	buffer, ok := wb.buffers[segmentID]
 	if !ok {
-		return nil, nil, nil, nil
+		return nil, nil, nil, nil, nil
+	}
+
+	// load the spilled data back before syncing
+	spilledSize := buffer.insertBuffer.spilledSize
+	if err := buffer.insertBuffer.Unspill(); err != nil {
+		return nil, nil, nil, nil, err
 	}
+	metrics.DataNodeFlowGraphBufferDataSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), fmt.Sprint(wb.collectionID)).Add(float64(spilledSize))
 
 	// remove buffer and move it to sync manager
 	delete(wb.buffers, segmentID)
@@ -357,7 +390,7 @@ func (wb *writeBufferBase) yieldBuffer(segmentID int64) (*storage.InsertData, *s
 	timeRange := buffer.GetTimeRange()
 	insert, delta := buffer.Yield()
 
-	return insert, delta, timeRange, start
+	return insert, delta, timeRange, start, nil
 }
 
 type inData struct {
@@ -507,7 +540,11 @@ func (wb *writeBufferBase) getSyncTask(ctx context.Context, segmentID int64) (sy
 	var totalMemSize float64 = 0
 	var tsFrom, tsTo uint64
 
-	insert, delta, timeRange, startPos := wb.yieldBuffer(segmentID)
+	insert, delta, timeRange, startPos, err := wb.yieldBuffer(segmentID)
+	if err != nil {
+		log.Warn("failed to yield buffer", zap.Error(err))
+		return nil, err
+	}
 	if timeRange != nil {
 		tsFrom, tsTo = timeRange.timestampMin, timeRange.timestampMax
 	}
@@ -558,6 +595,9 @@ func (wb *writeBufferBase) Close(drop bool) {
 	wb.mut.Lock()
 	defer wb.mut.Unlock()
 	if !drop {
+		for _, buf := range wb.buffers {
+			buf.insertBuffer.releaseSpilled()
+		}
 		return
 	}
 
@@ -525,6 +525,84 @@ func (c *Client) GcConfirm(ctx context.Context, req *datapb.GcConfirmRequest, op
 	})
 }
 
+func (c *Client) RestoreSegment(ctx context.Context, req *datapb.RestoreSegmentRequest, opts ...grpc.CallOption) (*datapb.RestoreSegmentResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.RestoreSegmentResponse, error) {
+		return client.RestoreSegment(ctx, req)
+	})
+}
+
+func (c *Client) RestoreSnapshot(ctx context.Context, req *datapb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.RestoreSnapshotResponse, error) {
+		return client.RestoreSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) GetIdempotencyRecord(ctx context.Context, req *datapb.GetIdempotencyRecordRequest, opts ...grpc.CallOption) (*datapb.GetIdempotencyRecordResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetIdempotencyRecordResponse, error) {
+		return client.GetIdempotencyRecord(ctx, req)
+	})
+}
+
+func (c *Client) ListReplicas(ctx context.Context, req *datapb.ListReplicasRequest, opts ...grpc.CallOption) (*datapb.ListReplicasResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ListReplicasResponse, error) {
+		return client.ListReplicas(ctx, req)
+	})
+}
+
+func (c *Client) PromoteReplica(ctx context.Context, req *datapb.PromoteReplicaRequest, opts ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.RestoreSnapshotResponse, error) {
+		return client.PromoteReplica(ctx, req)
+	})
+}
+
+func (c *Client) CreateBackup(ctx context.Context, req *datapb.CreateBackupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.CreateBackup(ctx, req)
+	})
+}
+
+func (c *Client) ListBackups(ctx context.Context, req *datapb.ListBackupsRequest, opts ...grpc.CallOption) (*datapb.ListBackupsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ListBackupsResponse, error) {
+		return client.ListBackups(ctx, req)
+	})
+}
+
+func (c *Client) DropBackup(ctx context.Context, req *datapb.DropBackupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.DropBackup(ctx, req)
+	})
+}
+
+func (c *Client) RestoreBackup(ctx context.Context, req *datapb.RestoreBackupRequest, opts ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.RestoreSnapshotResponse, error) {
+		return client.RestoreBackup(ctx, req)
+	})
+}
+
+func (c *Client) GetCompactionProgress(ctx context.Context, req *datapb.GetCompactionProgressRequest, opts ...grpc.CallOption) (*datapb.GetCompactionProgressResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetCompactionProgressResponse, error) {
+		return client.GetCompactionProgress(ctx, req)
+	})
+}
+
+func (c *Client) AuditGarbage(ctx context.Context, req *datapb.AuditGarbageRequest, opts ...grpc.CallOption) (*datapb.AuditGarbageResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.AuditGarbageResponse, error) {
+		return client.AuditGarbage(ctx, req)
+	})
+}
+
+func (c *Client) ListChannelCheckpoints(ctx context.Context, req *datapb.ListChannelCheckpointsRequest, opts ...grpc.CallOption) (*datapb.ListChannelCheckpointsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ListChannelCheckpointsResponse, error) {
+		return client.ListChannelCheckpoints(ctx, req)
+	})
+}
+
+func (c *Client) ListSnapshots(ctx context.Context, req *datapb.ListSnapshotsRequest, opts ...grpc.CallOption) (*datapb.ListSnapshotsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ListSnapshotsResponse, error) {
+		return client.ListSnapshots(ctx, req)
+	})
+}
+
 // CreateIndex sends the build index request to IndexCoord.
 func (c *Client) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	var resp *commonpb.Status
@@ -727,6 +805,48 @@ func (c *Client) GcControl(ctx context.Context, req *datapb.GcControlRequest, op
 	})
 }
 
+func (c *Client) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.CancelCompaction(ctx, req)
+	})
+}
+
+func (c *Client) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.CreateSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.DropSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) ResumeIngestion(ctx context.Context, req *datapb.ResumeIngestionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.ResumeIngestion(ctx, req)
+	})
+}
+
+func (c *Client) PauseIngestion(ctx context.Context, req *datapb.PauseIngestionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.PauseIngestion(ctx, req)
+	})
+}
+
+func (c *Client) SetChannelParallelism(ctx context.Context, req *datapb.SetChannelParallelismRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.SetChannelParallelism(ctx, req)
+	})
+}
+
+func (c *Client) SaveIdempotencyRecord(ctx context.Context, req *datapb.SaveIdempotencyRecordRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.SaveIdempotencyRecord(ctx, req)
+	})
+}
+
 func (c *Client) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*internalpb.ImportResponse, error) {
 		return client.ImportV2(ctx, in)
@@ -750,3 +870,21 @@ func (c *Client) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest
 		return client.ListIndexes(ctx, in)
 	})
 }
+
+func (c *Client) ListIndexTasks(ctx context.Context, in *datapb.ListIndexTasksRequest, opts ...grpc.CallOption) (*datapb.ListIndexTasksResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.ListIndexTasksResponse, error) {
+		return client.ListIndexTasks(ctx, in)
+	})
+}
+
+func (c *Client) CancelIndexTasks(ctx context.Context, in *datapb.CancelIndexTasksRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*commonpb.Status, error) {
+		return client.CancelIndexTasks(ctx, in)
+	})
+}
+
+func (c *Client) GetScalarIndexAdvice(ctx context.Context, in *datapb.GetScalarIndexAdviceRequest, opts ...grpc.CallOption) (*datapb.GetScalarIndexAdviceResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataCoordClient) (*datapb.GetScalarIndexAdviceResponse, error) {
+		return client.GetScalarIndexAdvice(ctx, in)
+	})
+}
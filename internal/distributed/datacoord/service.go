@@ -198,6 +198,7 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		)))
 	indexpb.RegisterIndexCoordServer(s.grpcServer, s)
 	datapb.RegisterDataCoordServer(s.grpcServer, s)
+	utils.RegisterHealthAndReflection(s.grpcServer, s, Params.ReflectionEnabled.GetAsBool())
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
 		s.grpcErrChan <- err
@@ -422,6 +423,58 @@ func (s *Server) GcConfirm(ctx context.Context, request *datapb.GcConfirmRequest
 	return s.dataCoord.GcConfirm(ctx, request)
 }
 
+func (s *Server) RestoreSegment(ctx context.Context, request *datapb.RestoreSegmentRequest) (*datapb.RestoreSegmentResponse, error) {
+	return s.dataCoord.RestoreSegment(ctx, request)
+}
+
+func (s *Server) RestoreSnapshot(ctx context.Context, request *datapb.RestoreSnapshotRequest) (*datapb.RestoreSnapshotResponse, error) {
+	return s.dataCoord.RestoreSnapshot(ctx, request)
+}
+
+func (s *Server) ListReplicas(ctx context.Context, request *datapb.ListReplicasRequest) (*datapb.ListReplicasResponse, error) {
+	return s.dataCoord.ListReplicas(ctx, request)
+}
+
+func (s *Server) PromoteReplica(ctx context.Context, request *datapb.PromoteReplicaRequest) (*datapb.RestoreSnapshotResponse, error) {
+	return s.dataCoord.PromoteReplica(ctx, request)
+}
+
+func (s *Server) CreateBackup(ctx context.Context, request *datapb.CreateBackupRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CreateBackup(ctx, request)
+}
+
+func (s *Server) ListBackups(ctx context.Context, request *datapb.ListBackupsRequest) (*datapb.ListBackupsResponse, error) {
+	return s.dataCoord.ListBackups(ctx, request)
+}
+
+func (s *Server) DropBackup(ctx context.Context, request *datapb.DropBackupRequest) (*commonpb.Status, error) {
+	return s.dataCoord.DropBackup(ctx, request)
+}
+
+func (s *Server) RestoreBackup(ctx context.Context, request *datapb.RestoreBackupRequest) (*datapb.RestoreSnapshotResponse, error) {
+	return s.dataCoord.RestoreBackup(ctx, request)
+}
+
+func (s *Server) GetIdempotencyRecord(ctx context.Context, request *datapb.GetIdempotencyRecordRequest) (*datapb.GetIdempotencyRecordResponse, error) {
+	return s.dataCoord.GetIdempotencyRecord(ctx, request)
+}
+
+func (s *Server) GetCompactionProgress(ctx context.Context, request *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error) {
+	return s.dataCoord.GetCompactionProgress(ctx, request)
+}
+
+func (s *Server) AuditGarbage(ctx context.Context, request *datapb.AuditGarbageRequest) (*datapb.AuditGarbageResponse, error) {
+	return s.dataCoord.AuditGarbage(ctx, request)
+}
+
+func (s *Server) ListChannelCheckpoints(ctx context.Context, request *datapb.ListChannelCheckpointsRequest) (*datapb.ListChannelCheckpointsResponse, error) {
+	return s.dataCoord.ListChannelCheckpoints(ctx, request)
+}
+
+func (s *Server) ListSnapshots(ctx context.Context, request *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
+	return s.dataCoord.ListSnapshots(ctx, request)
+}
+
 // CreateIndex sends the build index request to DataCoord.
 func (s *Server) CreateIndex(ctx context.Context, req *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
 	return s.dataCoord.CreateIndex(ctx, req)
@@ -474,6 +527,34 @@ func (s *Server) GcControl(ctx context.Context, req *datapb.GcControlRequest) (*
 	return s.dataCoord.GcControl(ctx, req)
 }
 
+func (s *Server) CancelCompaction(ctx context.Context, req *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CancelCompaction(ctx, req)
+}
+
+func (s *Server) CreateSnapshot(ctx context.Context, req *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CreateSnapshot(ctx, req)
+}
+
+func (s *Server) DropSnapshot(ctx context.Context, req *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
+	return s.dataCoord.DropSnapshot(ctx, req)
+}
+
+func (s *Server) ResumeIngestion(ctx context.Context, req *datapb.ResumeIngestionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.ResumeIngestion(ctx, req)
+}
+
+func (s *Server) PauseIngestion(ctx context.Context, req *datapb.PauseIngestionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.PauseIngestion(ctx, req)
+}
+
+func (s *Server) SetChannelParallelism(ctx context.Context, req *datapb.SetChannelParallelismRequest) (*commonpb.Status, error) {
+	return s.dataCoord.SetChannelParallelism(ctx, req)
+}
+
+func (s *Server) SaveIdempotencyRecord(ctx context.Context, req *datapb.SaveIdempotencyRecordRequest) (*commonpb.Status, error) {
+	return s.dataCoord.SaveIdempotencyRecord(ctx, req)
+}
+
 func (s *Server) ImportV2(ctx context.Context, in *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
 	return s.dataCoord.ImportV2(ctx, in)
 }
@@ -489,3 +570,15 @@ func (s *Server) ListImports(ctx context.Context, in *internalpb.ListImportsRequ
 func (s *Server) ListIndexes(ctx context.Context, in *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
 	return s.dataCoord.ListIndexes(ctx, in)
 }
+
+func (s *Server) ListIndexTasks(ctx context.Context, in *datapb.ListIndexTasksRequest) (*datapb.ListIndexTasksResponse, error) {
+	return s.dataCoord.ListIndexTasks(ctx, in)
+}
+
+func (s *Server) CancelIndexTasks(ctx context.Context, in *datapb.CancelIndexTasksRequest) (*commonpb.Status, error) {
+	return s.dataCoord.CancelIndexTasks(ctx, in)
+}
+
+func (s *Server) GetScalarIndexAdvice(ctx context.Context, in *datapb.GetScalarIndexAdviceRequest) (*datapb.GetScalarIndexAdviceResponse, error) {
+	return s.dataCoord.GetScalarIndexAdvice(ctx, in)
+}
@@ -207,6 +207,13 @@ func (c *Client) SyncSegments(ctx context.Context, req *datapb.SyncSegmentsReque
 	})
 }
 
+// StopCompaction is the DataNode client side code for StopCompaction call.
+func (c *Client) StopCompaction(ctx context.Context, req *datapb.StopCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*commonpb.Status, error) {
+		return client.StopCompaction(ctx, req)
+	})
+}
+
 // FlushChannels notifies DataNode to sync all the segments belongs to the target channels.
 func (c *Client) FlushChannels(ctx context.Context, req *datapb.FlushChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*commonpb.Status, error) {
@@ -214,6 +221,20 @@ func (c *Client) FlushChannels(ctx context.Context, req *datapb.FlushChannelsReq
 	})
 }
 
+// PauseChannels notifies DataNode to pause or resume consuming the target channels.
+func (c *Client) PauseChannels(ctx context.Context, req *datapb.PauseChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*commonpb.Status, error) {
+		return client.PauseChannels(ctx, req)
+	})
+}
+
+// SetChannelParallelism updates the flowgraph parallelism of the channel watched by DataNode.
+func (c *Client) SetChannelParallelism(ctx context.Context, req *datapb.SetChannelParallelismRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*commonpb.Status, error) {
+		return client.SetChannelParallelism(ctx, req)
+	})
+}
+
 func (c *Client) NotifyChannelOperation(ctx context.Context, req *datapb.ChannelOperationsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return wrapGrpcCall(ctx, c, func(client datapb.DataNodeClient) (*commonpb.Status, error) {
 		return client.NotifyChannelOperation(ctx, req)
@@ -160,6 +160,7 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 			}),
 		)))
 	datapb.RegisterDataNodeServer(s.grpcServer, s)
+	utils.RegisterHealthAndReflection(s.grpcServer, s, Params.ReflectionEnabled.GetAsBool())
 
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
@@ -371,10 +372,22 @@ func (s *Server) SyncSegments(ctx context.Context, request *datapb.SyncSegmentsR
 	return s.datanode.SyncSegments(ctx, request)
 }
 
+func (s *Server) StopCompaction(ctx context.Context, request *datapb.StopCompactionRequest) (*commonpb.Status, error) {
+	return s.datanode.StopCompaction(ctx, request)
+}
+
 func (s *Server) FlushChannels(ctx context.Context, req *datapb.FlushChannelsRequest) (*commonpb.Status, error) {
 	return s.datanode.FlushChannels(ctx, req)
 }
 
+func (s *Server) PauseChannels(ctx context.Context, req *datapb.PauseChannelsRequest) (*commonpb.Status, error) {
+	return s.datanode.PauseChannels(ctx, req)
+}
+
+func (s *Server) SetChannelParallelism(ctx context.Context, req *datapb.SetChannelParallelismRequest) (*commonpb.Status, error) {
+	return s.datanode.SetChannelParallelism(ctx, req)
+}
+
 func (s *Server) NotifyChannelOperation(ctx context.Context, req *datapb.ChannelOperationsRequest) (*commonpb.Status, error) {
 	return s.datanode.NotifyChannelOperation(ctx, req)
 }
@@ -145,10 +145,22 @@ func (m *MockDataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegment
 	return m.status, m.err
 }
 
+func (m *MockDataNode) StopCompaction(ctx context.Context, req *datapb.StopCompactionRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockDataNode) FlushChannels(ctx context.Context, req *datapb.FlushChannelsRequest) (*commonpb.Status, error) {
 	return m.status, m.err
 }
 
+func (m *MockDataNode) PauseChannels(ctx context.Context, req *datapb.PauseChannelsRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataNode) SetChannelParallelism(ctx context.Context, req *datapb.SetChannelParallelismRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 func (m *MockDataNode) NotifyChannelOperation(ctx context.Context, req *datapb.ChannelOperationsRequest) (*commonpb.Status, error) {
 	return m.status, m.err
 }
@@ -134,6 +134,7 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 			}),
 		)))
 	indexpb.RegisterIndexNodeServer(s.grpcServer, s)
+	utils.RegisterHealthAndReflection(s.grpcServer, s, Params.ReflectionEnabled.GetAsBool())
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
 		s.grpcErrChan <- err
@@ -25,8 +25,10 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/grpcclient"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
@@ -216,3 +218,125 @@ func (c *Client) ListImports(ctx context.Context, req *internalpb.ListImportsReq
 		return client.ListImports(ctx, req)
 	})
 }
+
+func (c *Client) SearchIterator(ctx context.Context, req *proxypb.SearchIteratorRequest, opts ...grpc.CallOption) (*proxypb.SearchIteratorResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.SearchIteratorResponse, error) {
+		return client.SearchIterator(ctx, req)
+	})
+}
+
+func (c *Client) QueryIterator(ctx context.Context, req *proxypb.QueryIteratorRequest, opts ...grpc.CallOption) (*proxypb.QueryIteratorResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.QueryIteratorResponse, error) {
+		return client.QueryIterator(ctx, req)
+	})
+}
+
+func (c *Client) BatchSearch(ctx context.Context, req *proxypb.BatchSearchRequest, opts ...grpc.CallOption) (*proxypb.BatchSearchResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.BatchSearchResponse, error) {
+		return client.BatchSearch(ctx, req)
+	})
+}
+
+func (c *Client) CreateAPIKey(ctx context.Context, req *proxypb.CreateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.APIKeyResponse, error) {
+		return client.CreateAPIKey(ctx, req)
+	})
+}
+
+func (c *Client) RotateAPIKey(ctx context.Context, req *proxypb.RotateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.APIKeyResponse, error) {
+		return client.RotateAPIKey(ctx, req)
+	})
+}
+
+func (c *Client) RevokeAPIKey(ctx context.Context, req *proxypb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.RevokeAPIKey(ctx, req)
+	})
+}
+
+func (c *Client) ListAPIKeys(ctx context.Context, req *proxypb.ListAPIKeysRequest, opts ...grpc.CallOption) (*proxypb.ListAPIKeysResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.ListAPIKeysResponse, error) {
+		return client.ListAPIKeys(ctx, req)
+	})
+}
+
+func (c *Client) DropCollectionAsync(ctx context.Context, req *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.DDLJobResponse, error) {
+		return client.DropCollectionAsync(ctx, req)
+	})
+}
+
+func (c *Client) AlterCollectionAsync(ctx context.Context, req *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.DDLJobResponse, error) {
+		return client.AlterCollectionAsync(ctx, req)
+	})
+}
+
+func (c *Client) DescribeDDLJob(ctx context.Context, req *proxypb.DescribeDDLJobRequest, opts ...grpc.CallOption) (*proxypb.DescribeDDLJobResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.DescribeDDLJobResponse, error) {
+		return client.DescribeDDLJob(ctx, req)
+	})
+}
+
+func (c *Client) AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.AddCollectionField(ctx, req)
+	})
+}
+
+func (c *Client) AlterDatabase(ctx context.Context, req *proxypb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.AlterDatabase(ctx, req)
+	})
+}
+
+func (c *Client) UndropCollection(ctx context.Context, req *proxypb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*commonpb.Status, error) {
+		return client.UndropCollection(ctx, req)
+	})
+}
+
+func (c *Client) GetLoadingProgressDetail(ctx context.Context, req *proxypb.GetLoadingProgressDetailRequest, opts ...grpc.CallOption) (*querypb.GetLoadProgressDetailResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*querypb.GetLoadProgressDetailResponse, error) {
+		return client.GetLoadingProgressDetail(ctx, req)
+	})
+}
+
+func (c *Client) DescribeIndexProgress(ctx context.Context, req *proxypb.DescribeIndexProgressRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*indexpb.DescribeIndexResponse, error) {
+		return client.DescribeIndexProgress(ctx, req)
+	})
+}
+
+func (c *Client) SubscribeStandingQuery(ctx context.Context, req *proxypb.SubscribeStandingQueryRequest, opts ...grpc.CallOption) (proxypb.Proxy_SubscribeStandingQueryClient, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client proxypb.ProxyClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+
+		return client.SubscribeStandingQuery(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(proxypb.Proxy_SubscribeStandingQueryClient), nil
+}
+
+func (c *Client) CreateCollectionFromSnapshot(ctx context.Context, req *proxypb.CreateCollectionFromSnapshotRequest, opts ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.RestoreCollectionsResponse, error) {
+		return client.CreateCollectionFromSnapshot(ctx, req)
+	})
+}
+
+func (c *Client) RestoreBackup(ctx context.Context, req *proxypb.RestoreBackupRequest, opts ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.RestoreCollectionsResponse, error) {
+		return client.RestoreBackup(ctx, req)
+	})
+}
+
+func (c *Client) PromoteReplica(ctx context.Context, req *proxypb.PromoteReplicaRequest, opts ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error) {
+	return wrapGrpcCall(ctx, c, func(client proxypb.ProxyClient) (*proxypb.RestoreCollectionsResponse, error) {
+		return client.PromoteReplica(ctx, req)
+	})
+}
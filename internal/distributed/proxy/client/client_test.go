@@ -28,6 +28,8 @@ import (
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -462,3 +464,252 @@ func Test_ImportV2(t *testing.T) {
 	_, err = client.ListImports(ctx, &internalpb.ListImportsRequest{})
 	assert.Nil(t, err)
 }
+
+func Test_Iterator(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "test", 1)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	mockProxy := mocks.NewMockProxyClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[proxypb.ProxyClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(proxypb.ProxyClient) (interface{}, error)) (interface{}, error) {
+		return f(mockProxy)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockProxy.EXPECT().QueryIterator(mock.Anything, mock.Anything).Return(&proxypb.QueryIteratorResponse{Status: merr.Success()}, nil)
+	_, err = client.QueryIterator(ctx, &proxypb.QueryIteratorRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().SearchIterator(mock.Anything, mock.Anything).Return(&proxypb.SearchIteratorResponse{Status: merr.Success()}, nil)
+	_, err = client.SearchIterator(ctx, &proxypb.SearchIteratorRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().BatchSearch(mock.Anything, mock.Anything).Return(&proxypb.BatchSearchResponse{Status: merr.Success()}, nil)
+	_, err = client.BatchSearch(ctx, &proxypb.BatchSearchRequest{})
+	assert.Nil(t, err)
+}
+
+func Test_APIKey(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "test", 1)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	mockProxy := mocks.NewMockProxyClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[proxypb.ProxyClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(proxypb.ProxyClient) (interface{}, error)) (interface{}, error) {
+		return f(mockProxy)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockProxy.EXPECT().CreateAPIKey(mock.Anything, mock.Anything).Return(&proxypb.APIKeyResponse{Status: merr.Success()}, nil)
+	_, err = client.CreateAPIKey(ctx, &proxypb.CreateAPIKeyRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().RotateAPIKey(mock.Anything, mock.Anything).Return(&proxypb.APIKeyResponse{Status: merr.Success()}, nil)
+	_, err = client.RotateAPIKey(ctx, &proxypb.RotateAPIKeyRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().RevokeAPIKey(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	_, err = client.RevokeAPIKey(ctx, &proxypb.RevokeAPIKeyRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().ListAPIKeys(mock.Anything, mock.Anything).Return(&proxypb.ListAPIKeysResponse{Status: merr.Success()}, nil)
+	_, err = client.ListAPIKeys(ctx, &proxypb.ListAPIKeysRequest{})
+	assert.Nil(t, err)
+}
+
+func Test_DDLJob(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "test", 1)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	mockProxy := mocks.NewMockProxyClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[proxypb.ProxyClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(proxypb.ProxyClient) (interface{}, error)) (interface{}, error) {
+		return f(mockProxy)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockProxy.EXPECT().DropCollectionAsync(mock.Anything, mock.Anything).Return(&proxypb.DDLJobResponse{Status: merr.Success()}, nil)
+	_, err = client.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().AlterCollectionAsync(mock.Anything, mock.Anything).Return(&proxypb.DDLJobResponse{Status: merr.Success()}, nil)
+	_, err = client.AlterCollectionAsync(ctx, &milvuspb.AlterCollectionRequest{})
+	assert.Nil(t, err)
+
+	mockProxy.EXPECT().DescribeDDLJob(mock.Anything, mock.Anything).Return(&proxypb.DescribeDDLJobResponse{Status: merr.Success()}, nil)
+	_, err = client.DescribeDDLJob(ctx, &proxypb.DescribeDDLJobRequest{})
+	assert.Nil(t, err)
+}
+
+func Test_AddCollectionField(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "test", 1)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	mockProxy := mocks.NewMockProxyClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[proxypb.ProxyClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(proxypb.ProxyClient) (interface{}, error)) (interface{}, error) {
+		return f(mockProxy)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockProxy.EXPECT().AddCollectionField(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	_, err = client.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{})
+	assert.Nil(t, err)
+}
+
+func Test_AlterDatabase(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "test", 1)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	mockProxy := mocks.NewMockProxyClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[proxypb.ProxyClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(proxypb.ProxyClient) (interface{}, error)) (interface{}, error) {
+		return f(mockProxy)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockProxy.EXPECT().AlterDatabase(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	_, err = client.AlterDatabase(ctx, &proxypb.AlterDatabaseRequest{})
+	assert.Nil(t, err)
+}
+
+func Test_SubscribeStandingQuery(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "test", 1)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	mockProxy := mocks.NewMockProxyClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[proxypb.ProxyClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(proxypb.ProxyClient) (interface{}, error)) (interface{}, error) {
+		return f(mockProxy)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	streamer := streamrpc.NewInMemoryStreamer[*querypb.StandingQueryEvent](ctx, 1)
+	mockProxy.EXPECT().SubscribeStandingQuery(mock.Anything, mock.Anything).Return(streamer, nil)
+	stream, err := client.SubscribeStandingQuery(ctx, &proxypb.SubscribeStandingQueryRequest{})
+	assert.NoError(t, err)
+	assert.NoError(t, streamer.Send(&querypb.StandingQueryEvent{Status: merr.Success()}))
+	event, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.NoError(t, merr.Error(event.GetStatus()))
+
+	// canceled
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = client.SubscribeStandingQuery(cancelCtx, &proxypb.SubscribeStandingQueryRequest{})
+	assert.Error(t, err)
+}
+
+func Test_CreateCollectionFromSnapshot(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "test", 1)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	mockProxy := mocks.NewMockProxyClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[proxypb.ProxyClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(proxypb.ProxyClient) (interface{}, error)) (interface{}, error) {
+		return f(mockProxy)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockProxy.EXPECT().CreateCollectionFromSnapshot(mock.Anything, mock.Anything).Return(&proxypb.RestoreCollectionsResponse{Status: merr.Success()}, nil)
+	_, err = client.CreateCollectionFromSnapshot(ctx, &proxypb.CreateCollectionFromSnapshotRequest{})
+	assert.Nil(t, err)
+}
+
+func Test_RestoreBackup(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "test", 1)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	mockProxy := mocks.NewMockProxyClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[proxypb.ProxyClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(proxypb.ProxyClient) (interface{}, error)) (interface{}, error) {
+		return f(mockProxy)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockProxy.EXPECT().RestoreBackup(mock.Anything, mock.Anything).Return(&proxypb.RestoreCollectionsResponse{Status: merr.Success()}, nil)
+	_, err = client.RestoreBackup(ctx, &proxypb.RestoreBackupRequest{})
+	assert.Nil(t, err)
+}
+
+func Test_PromoteReplica(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "test", 1)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	mockProxy := mocks.NewMockProxyClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[proxypb.ProxyClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(proxypb.ProxyClient) (interface{}, error)) (interface{}, error) {
+		return f(mockProxy)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockProxy.EXPECT().PromoteReplica(mock.Anything, mock.Anything).Return(&proxypb.RestoreCollectionsResponse{Status: merr.Success()}, nil)
+	_, err = client.PromoteReplica(ctx, &proxypb.PromoteReplicaRequest{})
+	assert.Nil(t, err)
+}
+
+func Test_UndropCollection(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	client, err := NewClient(ctx, "test", 1)
+	assert.NoError(t, err)
+	defer client.Close()
+
+	mockProxy := mocks.NewMockProxyClient(t)
+	mockGrpcClient := mocks.NewMockGrpcClient[proxypb.ProxyClient](t)
+	mockGrpcClient.EXPECT().Close().Return(nil)
+	mockGrpcClient.EXPECT().ReCall(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, f func(proxypb.ProxyClient) (interface{}, error)) (interface{}, error) {
+		return f(mockProxy)
+	})
+	client.(*Client).grpcClient = mockGrpcClient
+
+	mockProxy.EXPECT().UndropCollection(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+	_, err = client.UndropCollection(ctx, &proxypb.UndropCollectionRequest{})
+	assert.Nil(t, err)
+}
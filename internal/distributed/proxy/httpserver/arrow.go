@@ -0,0 +1,234 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/ipc"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+// appendArrowScalars appends the scalar values of the given type to the builder.
+func appendArrowScalars(builder array.Builder, dataType schemapb.DataType, scalars *schemapb.ScalarField) error {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		builder.(*array.BooleanBuilder).AppendValues(scalars.GetBoolData().GetData(), nil)
+	case schemapb.DataType_Int8:
+		for _, v := range scalars.GetIntData().GetData() {
+			builder.(*array.Int8Builder).Append(int8(v))
+		}
+	case schemapb.DataType_Int16:
+		for _, v := range scalars.GetIntData().GetData() {
+			builder.(*array.Int16Builder).Append(int16(v))
+		}
+	case schemapb.DataType_Int32:
+		builder.(*array.Int32Builder).AppendValues(scalars.GetIntData().GetData(), nil)
+	case schemapb.DataType_Int64:
+		builder.(*array.Int64Builder).AppendValues(scalars.GetLongData().GetData(), nil)
+	case schemapb.DataType_Float:
+		builder.(*array.Float32Builder).AppendValues(scalars.GetFloatData().GetData(), nil)
+	case schemapb.DataType_Double:
+		builder.(*array.Float64Builder).AppendValues(scalars.GetDoubleData().GetData(), nil)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		builder.(*array.StringBuilder).AppendValues(scalars.GetStringData().GetData(), nil)
+	case schemapb.DataType_JSON:
+		for _, v := range scalars.GetJsonData().GetData() {
+			builder.(*array.StringBuilder).Append(string(v))
+		}
+	default:
+		return fmt.Errorf("the type(%v) is not supported by arrow format", dataType)
+	}
+	return nil
+}
+
+func arrowScalarType(dataType schemapb.DataType) (arrow.DataType, error) {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case schemapb.DataType_Int8:
+		return arrow.PrimitiveTypes.Int8, nil
+	case schemapb.DataType_Int16:
+		return arrow.PrimitiveTypes.Int16, nil
+	case schemapb.DataType_Int32:
+		return arrow.PrimitiveTypes.Int32, nil
+	case schemapb.DataType_Int64:
+		return arrow.PrimitiveTypes.Int64, nil
+	case schemapb.DataType_Float:
+		return arrow.PrimitiveTypes.Float32, nil
+	case schemapb.DataType_Double:
+		return arrow.PrimitiveTypes.Float64, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar, schemapb.DataType_JSON:
+		return arrow.BinaryTypes.String, nil
+	default:
+		return nil, fmt.Errorf("the type(%v) is not supported by arrow format", dataType)
+	}
+}
+
+// buildArrowColumn converts the field data to an arrow column, json is encoded as string,
+// float vector as fixed size list of float32, other vectors as fixed size binary.
+func buildArrowColumn(mem memory.Allocator, fieldData *schemapb.FieldData) (arrow.Field, arrow.Array, error) {
+	var builder array.Builder
+	dim := int(fieldData.GetVectors().GetDim())
+	switch fieldData.GetType() {
+	case schemapb.DataType_Array:
+		elementType := fieldData.GetScalars().GetArrayData().GetElementType()
+		dataType, err := arrowScalarType(elementType)
+		if err != nil {
+			return arrow.Field{}, nil, err
+		}
+		listBuilder := array.NewListBuilder(mem, dataType)
+		for _, data := range fieldData.GetScalars().GetArrayData().GetData() {
+			listBuilder.Append(true)
+			if err := appendArrowScalars(listBuilder.ValueBuilder(), elementType, data); err != nil {
+				listBuilder.Release()
+				return arrow.Field{}, nil, err
+			}
+		}
+		builder = listBuilder
+	case schemapb.DataType_FloatVector:
+		listBuilder := array.NewFixedSizeListBuilder(mem, int32(dim), arrow.PrimitiveTypes.Float32)
+		data := fieldData.GetVectors().GetFloatVector().GetData()
+		for i := 0; dim > 0 && i < len(data)/dim; i++ {
+			listBuilder.Append(true)
+		}
+		listBuilder.ValueBuilder().(*array.Float32Builder).AppendValues(data, nil)
+		builder = listBuilder
+	case schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		var data []byte
+		var width int
+		switch fieldData.GetType() {
+		case schemapb.DataType_BinaryVector:
+			data, width = fieldData.GetVectors().GetBinaryVector(), dim/8
+		case schemapb.DataType_Float16Vector:
+			data, width = fieldData.GetVectors().GetFloat16Vector(), dim*2
+		default:
+			data, width = fieldData.GetVectors().GetBfloat16Vector(), dim*2
+		}
+		if width <= 0 {
+			return arrow.Field{}, nil, fmt.Errorf("invalid dim %d of field %s", dim, fieldData.GetFieldName())
+		}
+		binaryBuilder := array.NewFixedSizeBinaryBuilder(mem, &arrow.FixedSizeBinaryType{ByteWidth: width})
+		for i := 0; i+width <= len(data); i += width {
+			binaryBuilder.Append(data[i : i+width])
+		}
+		builder = binaryBuilder
+	case schemapb.DataType_SparseFloatVector:
+		binaryBuilder := array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary)
+		binaryBuilder.AppendValues(fieldData.GetVectors().GetSparseFloatVector().GetContents(), nil)
+		builder = binaryBuilder
+	default:
+		dataType, err := arrowScalarType(fieldData.GetType())
+		if err != nil {
+			return arrow.Field{}, nil, fmt.Errorf("field %s: %w", fieldData.GetFieldName(), err)
+		}
+		builder = array.NewBuilder(mem, dataType)
+		if err := appendArrowScalars(builder, fieldData.GetType(), fieldData.GetScalars()); err != nil {
+			builder.Release()
+			return arrow.Field{}, nil, err
+		}
+	}
+	defer builder.Release()
+	column := builder.NewArray()
+	return arrow.Field{Name: fieldData.GetFieldName(), Type: column.DataType()}, column, nil
+}
+
+// buildArrowResp encodes the query/search results as an arrow IPC stream with one record batch,
+// the primary keys and the distances of the search results are appended as the id and distance columns.
+func buildArrowResp(fieldDataList []*schemapb.FieldData, ids *schemapb.IDs, scores []float32) ([]byte, error) {
+	mem := memory.DefaultAllocator
+	fields := make([]arrow.Field, 0, len(fieldDataList)+2)
+	columns := make([]arrow.Array, 0, len(fieldDataList)+2)
+	defer func() {
+		for _, column := range columns {
+			column.Release()
+		}
+	}()
+	names := make(map[string]struct{})
+	addColumn := func(field arrow.Field, column arrow.Array) error {
+		if len(columns) > 0 && column.Len() != columns[0].Len() {
+			err := fmt.Errorf("the row num(%d) of field(%s) mismatches with others(%d)", column.Len(), field.Name, columns[0].Len())
+			column.Release()
+			return err
+		}
+		fields = append(fields, field)
+		columns = append(columns, column)
+		names[field.Name] = struct{}{}
+		return nil
+	}
+
+	for _, fieldData := range fieldDataList {
+		field, column, err := buildArrowColumn(mem, fieldData)
+		if err != nil {
+			return nil, err
+		}
+		if err := addColumn(field, column); err != nil {
+			return nil, err
+		}
+	}
+	// the primary key may be in the output fields already
+	if _, ok := names[DefaultPrimaryFieldName]; !ok && ids.GetIdField() != nil {
+		var builder array.Builder
+		switch ids.GetIdField().(type) {
+		case *schemapb.IDs_IntId:
+			intBuilder := array.NewInt64Builder(mem)
+			intBuilder.AppendValues(ids.GetIntId().GetData(), nil)
+			builder = intBuilder
+		case *schemapb.IDs_StrId:
+			strBuilder := array.NewStringBuilder(mem)
+			strBuilder.AppendValues(ids.GetStrId().GetData(), nil)
+			builder = strBuilder
+		}
+		column := builder.NewArray()
+		builder.Release()
+		if err := addColumn(arrow.Field{Name: DefaultPrimaryFieldName, Type: column.DataType()}, column); err != nil {
+			return nil, err
+		}
+	}
+	if scores != nil {
+		builder := array.NewFloat32Builder(mem)
+		builder.AppendValues(scores, nil)
+		column := builder.NewArray()
+		builder.Release()
+		if err := addColumn(arrow.Field{Name: HTTPReturnDistance, Type: column.DataType()}, column); err != nil {
+			return nil, err
+		}
+	}
+
+	rows := int64(0)
+	if len(columns) > 0 {
+		rows = int64(columns[0].Len())
+	}
+	schema := arrow.NewSchema(fields, nil)
+	record := array.NewRecord(schema, columns, rows)
+	defer record.Release()
+
+	var buf bytes.Buffer
+	writer := ipc.NewWriter(&buf, ipc.WithSchema(schema), ipc.WithAllocator(mem))
+	if err := writer.Write(record); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,155 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/ipc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func readArrowRecord(t *testing.T, data []byte) arrow.Record {
+	reader, err := ipc.NewReader(bytes.NewReader(data))
+	assert.NoError(t, err)
+	defer reader.Release()
+	assert.True(t, reader.Next())
+	record := reader.Record()
+	record.Retain()
+	assert.False(t, reader.Next())
+	return record
+}
+
+func TestBuildArrowResp(t *testing.T) {
+	t.Run("query", func(t *testing.T) {
+		fieldsData := generateFieldData()
+		fieldsData = append(fieldsData, &schemapb.FieldData{
+			Type:      schemapb.DataType_Array,
+			FieldName: "tags",
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_ArrayData{
+						ArrayData: &schemapb.ArrayArray{
+							ElementType: schemapb.DataType_VarChar,
+							Data: []*schemapb.ScalarField{
+								{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a"}}}},
+								{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{}}}},
+								{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"b", "c"}}}},
+							},
+						},
+					},
+				},
+			},
+		}, &schemapb.FieldData{
+			Type:      schemapb.DataType_JSON,
+			FieldName: "$meta",
+			IsDynamic: true,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: [][]byte{[]byte(`{"a":1}`), []byte(`{}`), []byte(`{"b":2}`)}}},
+				},
+			},
+		})
+
+		data, err := buildArrowResp(fieldsData, nil, nil)
+		assert.NoError(t, err)
+		record := readArrowRecord(t, data)
+		defer record.Release()
+
+		assert.EqualValues(t, 3, record.NumRows())
+		assert.EqualValues(t, 5, record.NumCols())
+		assert.Equal(t, []int64{1000, 2000, 3000}, record.Column(1).(*array.Int64).Int64Values())
+		vectors := record.Column(2).(*array.FixedSizeList)
+		assert.Equal(t, []float32{0.1, 0.11, 0.2, 0.22, 0.3, 0.33}, vectors.ListValues().(*array.Float32).Float32Values())
+		tags := record.Column(3).(*array.List)
+		assert.Equal(t, 3, tags.ListValues().Len())
+		assert.Equal(t, `{"b":2}`, record.Column(4).(*array.String).Value(2))
+	})
+
+	t.Run("search", func(t *testing.T) {
+		fieldsData := generateFieldData()[1:]
+		data, err := buildArrowResp(fieldsData, generateIDs(schemapb.DataType_VarChar, 3), []float32{0.1, 0.2, 0.3})
+		assert.NoError(t, err)
+		record := readArrowRecord(t, data)
+		defer record.Release()
+
+		assert.EqualValues(t, 3, record.NumRows())
+		assert.Equal(t, DefaultPrimaryFieldName, record.ColumnName(2))
+		assert.Equal(t, HTTPReturnDistance, record.ColumnName(3))
+		assert.Equal(t, []float32{0.1, 0.2, 0.3}, record.Column(3).(*array.Float32).Float32Values())
+	})
+
+	t.Run("row num mismatch", func(t *testing.T) {
+		_, err := buildArrowResp(generateFieldData(), nil, []float32{0.1})
+		assert.Error(t, err)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		data, err := buildArrowResp(nil, nil, nil)
+		assert.NoError(t, err)
+		record := readArrowRecord(t, data)
+		defer record.Release()
+		assert.EqualValues(t, 0, record.NumRows())
+	})
+}
+
+func TestQueryArrowFormat(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().Query(mock.Anything, mock.Anything).Return(&milvuspb.QueryResults{
+		Status:       commonSuccessStatus,
+		OutputFields: []string{FieldBookID, FieldWordCount, FieldBookIntro},
+		FieldsData:   generateFieldData(),
+	}, nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	t.Run("arrow", func(t *testing.T) {
+		body := []byte(`{"collectionName": "book", "filter": "book_id in [1, 2, 3]", "resultFormat": "arrow"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, QueryAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, HTTPContentTypeArrow, w.Header().Get("Content-Type"))
+		record := readArrowRecord(t, w.Body.Bytes())
+		defer record.Release()
+		assert.EqualValues(t, 3, record.NumRows())
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		body := []byte(`{"collectionName": "book", "filter": "book_id in [1, 2, 3]", "resultFormat": "csv"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, QueryAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		err := json.Unmarshal(w.Body.Bytes(), returnBody)
+		assert.NoError(t, err)
+		assert.Equal(t, merr.Code(merr.ErrParameterInvalid), returnBody.Code)
+	})
+}
@@ -9,14 +9,21 @@ import (
 // v2
 const (
 	// --- category ---
-	CollectionCategory = "/collections/"
-	EntityCategory     = "/entities/"
-	PartitionCategory  = "/partitions/"
-	UserCategory       = "/users/"
-	RoleCategory       = "/roles/"
-	IndexCategory      = "/indexes/"
-	AliasCategory      = "/aliases/"
-	ImportJobCategory  = "/jobs/import/"
+	CollectionCategory    = "/collections/"
+	EntityCategory        = "/entities/"
+	PartitionCategory     = "/partitions/"
+	UserCategory          = "/users/"
+	RoleCategory          = "/roles/"
+	IndexCategory         = "/indexes/"
+	AliasCategory         = "/aliases/"
+	ImportJobCategory     = "/jobs/import/"
+	ResourceGroupCategory = "/resource_groups/"
+	ReplicaCategory       = "/replicas/"
+	APIKeyCategory        = "/api_keys/"
+	DDLJobCategory        = "/jobs/ddl/"
+	DatabaseCategory      = "/databases/"
+	BackupCategory        = "/backups/"
+	ReplicationCategory   = "/replications/"
 
 	ListAction           = "list"
 	HasAction            = "has"
@@ -25,6 +32,7 @@ const (
 	DropAction           = "drop"
 	StatsAction          = "get_stats"
 	LoadStateAction      = "get_load_state"
+	LoadProgressAction   = "get_load_progress"
 	RenameAction         = "rename"
 	LoadAction           = "load"
 	ReleaseAction        = "release"
@@ -36,6 +44,9 @@ const (
 	SearchAction         = "search"
 	AdvancedSearchAction = "advanced_search"
 	HybridSearchAction   = "hybrid_search"
+	QueryIteratorAction  = "query_iterator"
+	SearchIteratorAction = "search_iterator"
+	BatchSearchAction    = "batch_search"
 
 	UpdatePasswordAction  = "update_password"
 	GrantRoleAction       = "grant_role"
@@ -44,10 +55,24 @@ const (
 	RevokePrivilegeAction = "revoke_privilege"
 	AlterAction           = "alter"
 	GetProgressAction     = "get_progress"
+	TransferNodeAction    = "transfer_node"
+	TransferReplicaAction = "transfer_replica"
+	CompactAction         = "compact"
+	CompactionStateAction = "get_compaction_state"
+	RotateAction          = "rotate"
+	RevokeAction          = "revoke"
+	DropAsyncAction       = "drop_async"
+	AlterAsyncAction      = "alter_async"
+	AddFieldAction        = "add_field"
+	FromSnapshotAction    = "create_from_snapshot"
+	RestoreAction         = "restore"
+	PromoteAction         = "promote"
+	UndropAction          = "undrop"
 )
 
 const (
 	ContextUsername               = "username"
+	ContextOIDCRoles              = "oidcRoles"
 	VectorCollectionsPath         = "/vector/collections"
 	VectorCollectionsCreatePath   = "/vector/collections/create"
 	VectorCollectionsDescribePath = "/vector/collections/describe"
@@ -82,10 +107,14 @@ const (
 	DefaultOutputFields      = "*"
 	HTTPHeaderAllowInt64     = "Accept-Type-Allow-Int64"
 	HTTPHeaderRequestTimeout = "Request-Timeout"
+	HTTPContentTypeArrow     = "application/vnd.apache.arrow.stream"
+	ResultFormatJSON         = "json"
+	ResultFormatArrow        = "arrow"
 	HTTPDefaultTimeout       = 30 * time.Second
 	HTTPReturnCode           = "code"
 	HTTPReturnMessage        = "message"
 	HTTPReturnData           = "data"
+	HTTPReturnCursor         = "cursor"
 	HTTPReturnLoadState      = "loadState"
 	HTTPReturnLoadProgress   = "loadProgress"
 
@@ -109,6 +138,9 @@ const (
 	HTTPReturnIndexFailReason  = "failReason"
 
 	HTTPReturnDistance = "distance"
+	HTTPReturnCoverage = "coverage"
+	// HTTPReturnDistanceHistogram is returned instead of the data if the range search requested the histogram
+	HTTPReturnDistanceHistogram = "distanceHistogram"
 
 	HTTPReturnRowCount = "rowCount"
 
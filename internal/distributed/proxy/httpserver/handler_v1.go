@@ -194,6 +194,7 @@ func (h *HandlersV1) listCollections(c *gin.Context) {
 	}
 	username, _ := c.Get(ContextUsername)
 	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx = withOIDCRoles(ctx, c)
 
 	resp, err := h.executeRestRequestInterceptor(ctx, c, req, func(reqCtx context.Context, req any) (any, error) {
 		return h.proxy.ShowCollections(reqCtx, req.(*milvuspb.ShowCollectionsRequest))
@@ -286,6 +287,7 @@ func (h *HandlersV1) createCollection(c *gin.Context) {
 	}
 	username, _ := c.Get(ContextUsername)
 	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx = withOIDCRoles(ctx, c)
 	response, err := h.executeRestRequestInterceptor(ctx, c, req, func(reqCtx context.Context, req any) (any, error) {
 		return h.proxy.CreateCollection(reqCtx, req.(*milvuspb.CreateCollectionRequest))
 	})
@@ -341,6 +343,7 @@ func (h *HandlersV1) getCollectionDetails(c *gin.Context) {
 	dbName := c.DefaultQuery(HTTPDbName, DefaultDbName)
 	username, _ := c.Get(ContextUsername)
 	ctx := proxy.NewContextWithMetadata(c, username.(string), dbName)
+	ctx = withOIDCRoles(ctx, c)
 
 	req := &milvuspb.DescribeCollectionRequest{
 		DbName:         dbName,
@@ -444,6 +447,7 @@ func (h *HandlersV1) dropCollection(c *gin.Context) {
 	}
 	username, _ := c.Get(ContextUsername)
 	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx = withOIDCRoles(ctx, c)
 	response, err := h.executeRestRequestInterceptor(ctx, c, req, func(reqCtx context.Context, req any) (any, error) {
 		has, err := h.hasCollection(ctx, c, httpReq.DbName, httpReq.CollectionName)
 		if err != nil {
@@ -509,6 +513,7 @@ func (h *HandlersV1) query(c *gin.Context) {
 	}
 	username, _ := c.Get(ContextUsername)
 	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx = withOIDCRoles(ctx, c)
 	response, err := h.executeRestRequestInterceptor(ctx, c, req, func(reqCtx context.Context, req any) (any, error) {
 		return h.proxy.Query(reqCtx, req.(*milvuspb.QueryRequest))
 	})
@@ -565,6 +570,7 @@ func (h *HandlersV1) get(c *gin.Context) {
 	}
 	username, _ := c.Get(ContextUsername)
 	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx = withOIDCRoles(ctx, c)
 	response, err := h.executeRestRequestInterceptor(ctx, c, req, func(reqCtx context.Context, req any) (any, error) {
 		collSchema, err := h.describeCollection(ctx, c, httpReq.DbName, httpReq.CollectionName)
 		if err != nil || collSchema == nil {
@@ -633,6 +639,7 @@ func (h *HandlersV1) delete(c *gin.Context) {
 	}
 	username, _ := c.Get(ContextUsername)
 	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx = withOIDCRoles(ctx, c)
 	response, err := h.executeRestRequestInterceptor(ctx, c, req, func(reqCtx context.Context, req any) (any, error) {
 		collSchema, err := h.describeCollection(ctx, c, httpReq.DbName, httpReq.CollectionName)
 		if err != nil || collSchema == nil {
@@ -702,6 +709,7 @@ func (h *HandlersV1) insert(c *gin.Context) {
 	}
 	username, _ := c.Get(ContextUsername)
 	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx = withOIDCRoles(ctx, c)
 	response, err := h.executeRestRequestInterceptor(ctx, c, req, func(reqCtx context.Context, req any) (any, error) {
 		collSchema, err := h.describeCollection(ctx, c, httpReq.DbName, httpReq.CollectionName)
 		if err != nil || collSchema == nil {
@@ -793,6 +801,7 @@ func (h *HandlersV1) upsert(c *gin.Context) {
 	}
 	username, _ := c.Get(ContextUsername)
 	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx = withOIDCRoles(ctx, c)
 	response, err := h.executeRestRequestInterceptor(ctx, c, req, func(reqCtx context.Context, req any) (any, error) {
 		collSchema, err := h.describeCollection(ctx, c, httpReq.DbName, httpReq.CollectionName)
 		if err != nil || collSchema == nil {
@@ -918,6 +927,7 @@ func (h *HandlersV1) search(c *gin.Context) {
 	}
 	username, _ := c.Get(ContextUsername)
 	ctx := proxy.NewContextWithMetadata(c, username.(string), req.DbName)
+	ctx = withOIDCRoles(ctx, c)
 	response, err := h.executeRestRequestInterceptor(ctx, c, req, func(reqCtx context.Context, req any) (any, error) {
 		return h.proxy.Search(ctx, req.(*milvuspb.SearchRequest))
 	})
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"sort"
 	"strconv"
 
 	"github.com/cockroachdb/errors"
@@ -21,9 +22,14 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/proxy/accesslog"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -53,8 +59,10 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(CollectionCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getCollectionDetails)))))
 	router.POST(CollectionCategory+StatsAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getCollectionStats)))))
 	router.POST(CollectionCategory+LoadStateAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getCollectionLoadState)))))
+	router.POST(CollectionCategory+LoadProgressAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getCollectionLoadProgress)))))
 	router.POST(CollectionCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionReq{AutoID: DisableAutoID} }, wrapperTraceLog(h.wrapperCheckDatabase(h.createCollection)))))
 	router.POST(CollectionCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.dropCollection)))))
+	router.POST(CollectionCategory+UndropAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.undropCollection)))))
 	router.POST(CollectionCategory+RenameAction, timeoutMiddleware(wrapperPost(func() any { return &RenameCollectionReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.renameCollection)))))
 	router.POST(CollectionCategory+LoadAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.loadCollection)))))
 	router.POST(CollectionCategory+ReleaseAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.releaseCollection)))))
@@ -94,6 +102,22 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 			Limit: 100,
 		}
 	}, wrapperTraceLog(h.wrapperCheckDatabase(h.advancedSearch)))))
+	router.POST(EntityCategory+QueryIteratorAction, timeoutMiddleware(wrapperPost(func() any {
+		return &QueryIteratorReqV2{
+			BatchSize:    100,
+			OutputFields: []string{DefaultOutputFields},
+		}
+	}, wrapperTraceLog(h.wrapperCheckDatabase(h.queryIterator)))))
+	router.POST(EntityCategory+SearchIteratorAction, timeoutMiddleware(wrapperPost(func() any {
+		return &SearchIteratorReqV2{
+			BatchSize: 100,
+		}
+	}, wrapperTraceLog(h.wrapperCheckDatabase(h.searchIterator)))))
+	router.POST(EntityCategory+BatchSearchAction, timeoutMiddleware(wrapperPost(func() any {
+		return &BatchSearchReq{
+			Limit: 100,
+		}
+	}, wrapperTraceLog(h.wrapperCheckDatabase(h.batchSearch)))))
 
 	router.POST(PartitionCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.listPartitions)))))
 	router.POST(PartitionCategory+HasAction, timeoutMiddleware(wrapperPost(func() any { return &PartitionReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.hasPartitions)))))
@@ -138,6 +162,36 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(ImportJobCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &OptionalCollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.listImportJob)))))
 	router.POST(ImportJobCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &ImportReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.createImportJob)))))
 	router.POST(ImportJobCategory+GetProgressAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getImportJobProcess)))))
+	router.POST(ImportJobCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &JobIDReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getImportJobProcess)))))
+
+	router.POST(ResourceGroupCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listResourceGroups))))
+	router.POST(ResourceGroupCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupReq{} }, wrapperTraceLog(h.describeResourceGroup))))
+	router.POST(ResourceGroupCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupReq{} }, wrapperTraceLog(h.createResourceGroup))))
+	router.POST(ResourceGroupCategory+AlterAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupReq{} }, wrapperTraceLog(h.alterResourceGroup))))
+	router.POST(ResourceGroupCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupReq{} }, wrapperTraceLog(h.dropResourceGroup))))
+	router.POST(ResourceGroupCategory+TransferNodeAction, timeoutMiddleware(wrapperPost(func() any { return &TransferNodeReq{} }, wrapperTraceLog(h.transferNode))))
+	router.POST(ResourceGroupCategory+TransferReplicaAction, timeoutMiddleware(wrapperPost(func() any { return &TransferReplicaReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.transferReplica)))))
+
+	router.POST(ReplicaCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.describeReplicas)))))
+
+	router.POST(CollectionCategory+CompactAction, timeoutMiddleware(wrapperPost(func() any { return &CompactReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.compact)))))
+	router.POST(CollectionCategory+CompactionStateAction, timeoutMiddleware(wrapperPost(func() any { return &CompactionIDReq{} }, wrapperTraceLog(h.getCompactionState))))
+
+	router.POST(APIKeyCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listAPIKeys))))
+	router.POST(APIKeyCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &APIKeyReq{} }, wrapperTraceLog(h.createAPIKey))))
+	router.POST(APIKeyCategory+RotateAction, timeoutMiddleware(wrapperPost(func() any { return &APIKeyNameReq{} }, wrapperTraceLog(h.rotateAPIKey))))
+	router.POST(APIKeyCategory+RevokeAction, timeoutMiddleware(wrapperPost(func() any { return &APIKeyNameReq{} }, wrapperTraceLog(h.revokeAPIKey))))
+
+	router.POST(CollectionCategory+DropAsyncAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.dropCollectionAsync)))))
+	router.POST(CollectionCategory+AlterAsyncAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionPropertiesReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.alterCollectionAsync)))))
+	router.POST(DDLJobCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &DDLJobIDReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.describeDDLJob)))))
+	router.POST(CollectionCategory+AddFieldAction, timeoutMiddleware(wrapperPost(func() any { return &AddCollectionFieldReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.addCollectionField)))))
+	router.POST(CollectionCategory+FromSnapshotAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionFromSnapshotReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.createCollectionFromSnapshot)))))
+
+	router.POST(DatabaseCategory+AlterAction, timeoutMiddleware(wrapperPost(func() any { return &DatabasePropertiesReq{} }, wrapperTraceLog(h.alterDatabase))))
+
+	router.POST(BackupCategory+RestoreAction, timeoutMiddleware(wrapperPost(func() any { return &BackupRestoreReq{} }, wrapperTraceLog(h.restoreBackup))))
+	router.POST(ReplicationCategory+PromoteAction, timeoutMiddleware(wrapperPost(func() any { return &ReplicaPromoteReq{} }, wrapperTraceLog(h.promoteReplica))))
 }
 
 type (
@@ -180,6 +234,7 @@ func wrapperPost(newReq newReqFunc, v2 handlerFuncV2) gin.HandlerFunc {
 		ctx, span := otel.Tracer(typeutil.ProxyRole).Start(context.Background(), c.Request.URL.Path)
 		defer span.End()
 		ctx = proxy.NewContextWithMetadata(ctx, username.(string), dbName)
+		ctx = withOIDCRoles(ctx, c)
 		traceID := span.SpanContext().TraceID().String()
 		ctx = log.WithTraceID(ctx, traceID)
 		c.Keys["traceID"] = traceID
@@ -227,14 +282,20 @@ func wrapperProxy(ctx context.Context, c *gin.Context, req any, checkAuth bool,
 		span := trace.SpanFromContext(ctx)
 		span.AddEvent(baseGetter.GetBase().GetMsgType().String())
 	}
+	// audit the grpc call issued by the restful request as the grpc interceptor does
+	accessInfo := accesslog.NewRestfulAccessInfo(ctx, c.Request.RemoteAddr, req)
 	if checkAuth {
 		err := checkAuthorization(ctx, c, req)
 		if err != nil {
+			accessInfo.SetResult(nil, err)
+			accessInfo.Audit()
 			return nil, err
 		}
 	}
 	log.Ctx(ctx).Debug("high level restful api, try to do a grpc call", zap.Any("grpcRequest", req))
 	response, err := handler(ctx, req)
+	accessInfo.SetResult(response, err)
+	accessInfo.Audit()
 	if err == nil {
 		status, ok := requestutil.GetStatusFromResponse(response)
 		if ok {
@@ -458,6 +519,48 @@ func (h *HandlersV2) getCollectionLoadState(ctx context.Context, c *gin.Context,
 	return resp, err
 }
 
+func (h *HandlersV2) getCollectionLoadProgress(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	collectionGetter, _ := anyReq.(requestutil.CollectionNameGetter)
+	partitionsGetter, _ := anyReq.(requestutil.PartitionNamesGetter)
+	req := &proxypb.GetLoadingProgressDetailRequest{
+		DbName:         dbName,
+		CollectionName: collectionGetter.GetCollectionName(),
+		PartitionNames: partitionsGetter.GetPartitionNames(),
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (any, error) {
+		return h.proxy.GetLoadingProgressDetail(reqCtx, req.(*proxypb.GetLoadingProgressDetailRequest))
+	})
+	if err == nil {
+		response := resp.(*querypb.GetLoadProgressDetailResponse)
+		stages := make([]gin.H, 0, len(response.GetStages()))
+		for _, stage := range response.GetStages() {
+			stages = append(stages, gin.H{
+				"stage":    stage.GetStage().String(),
+				"finished": stage.GetFinished(),
+				"total":    stage.GetTotal(),
+				"progress": stage.GetProgress(),
+			})
+		}
+		nodes := make([]gin.H, 0, len(response.GetNodes()))
+		for _, node := range response.GetNodes() {
+			nodes = append(nodes, gin.H{
+				"nodeId":                node.GetNodeID(),
+				"dispatchedSegmentNum":  node.GetDispatchedSegmentNum(),
+				"loadedSegmentNum":      node.GetLoadedSegmentNum(),
+				"indexReadySegmentNum":  node.GetIndexReadySegmentNum(),
+				"channelNum":            node.GetChannelNum(),
+				"serviceableChannelNum": node.GetServiceableChannelNum(),
+			})
+		}
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{
+			HTTPReturnLoadProgress: response.GetProgress(),
+			"stages":               stages,
+			"nodes":                nodes,
+		}})
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) dropCollection(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	getter, _ := anyReq.(requestutil.CollectionNameGetter)
 	req := &milvuspb.DropCollectionRequest{
@@ -473,6 +576,21 @@ func (h *HandlersV2) dropCollection(ctx context.Context, c *gin.Context, anyReq
 	return resp, err
 }
 
+func (h *HandlersV2) undropCollection(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	getter, _ := anyReq.(requestutil.CollectionNameGetter)
+	req := &proxypb.UndropCollectionRequest{
+		DbName:         dbName,
+		CollectionName: getter.GetCollectionName(),
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.UndropCollection(reqCtx, req.(*proxypb.UndropCollectionRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) renameCollection(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*RenameCollectionReq)
 	req := &milvuspb.RenameCollectionRequest{
@@ -523,8 +641,39 @@ func (h *HandlersV2) releaseCollection(ctx context.Context, c *gin.Context, anyR
 	return resp, err
 }
 
+// checkResultFormat checks the result format requested, json by default.
+func checkResultFormat(c *gin.Context, format string) error {
+	switch format {
+	case "", ResultFormatJSON, ResultFormatArrow:
+		return nil
+	}
+	err := merr.WrapErrParameterInvalid("json or arrow", format, "invalid result format")
+	c.AbortWithStatusJSON(http.StatusOK, gin.H{
+		HTTPReturnCode:    merr.Code(err),
+		HTTPReturnMessage: err.Error(),
+	})
+	return err
+}
+
+// writeArrowResp responds the results as an arrow IPC stream.
+func writeArrowResp(ctx context.Context, c *gin.Context, fieldDataList []*schemapb.FieldData, ids *schemapb.IDs, scores []float32) {
+	data, err := buildArrowResp(fieldDataList, ids, scores)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, fail to encode result as arrow", zap.Error(err))
+		c.JSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+			HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+		})
+		return
+	}
+	c.Data(http.StatusOK, HTTPContentTypeArrow, data)
+}
+
 func (h *HandlersV2) query(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*QueryReqV2)
+	if err := checkResultFormat(c, httpReq.ResultFormat); err != nil {
+		return nil, err
+	}
 	req := &milvuspb.QueryRequest{
 		DbName:             dbName,
 		CollectionName:     httpReq.CollectionName,
@@ -540,11 +689,21 @@ func (h *HandlersV2) query(ctx context.Context, c *gin.Context, anyReq any, dbNa
 	if httpReq.Limit > 0 {
 		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamLimit, Value: strconv.FormatInt(int64(httpReq.Limit), 10)})
 	}
+	if len(httpReq.FilterParams) > 0 {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: proxy.ExprParamsKey, Value: string(httpReq.FilterParams)})
+	}
+	if httpReq.GroupByField != "" {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: proxy.GroupByFieldKey, Value: httpReq.GroupByField})
+	}
 	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
 		return h.proxy.Query(reqCtx, req.(*milvuspb.QueryRequest))
 	})
 	if err == nil {
 		queryResp := resp.(*milvuspb.QueryResults)
+		if httpReq.ResultFormat == ResultFormatArrow {
+			writeArrowResp(ctx, c, queryResp.FieldsData, nil, nil)
+			return resp, err
+		}
 		allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
 		outputData, err := buildQueryResp(int64(0), queryResp.OutputFields, queryResp.FieldsData, nil, nil, allowJS)
 		if err != nil {
@@ -823,6 +982,9 @@ func generateSearchParams(ctx context.Context, c *gin.Context, reqParams map[str
 
 func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	httpReq := anyReq.(*SearchReqV2)
+	if err := checkResultFormat(c, httpReq.ResultFormat); err != nil {
+		return nil, err
+	}
 	collSchema, err := h.GetCollectionSchema(ctx, c, dbName, httpReq.CollectionName)
 	if err != nil {
 		return nil, err
@@ -836,6 +998,24 @@ func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbN
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamGroupByField, Value: httpReq.GroupByField})
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.AnnsFieldKey, Value: httpReq.AnnsField})
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamRoundDecimal, Value: "-1"})
+	if httpReq.GroupSize > 0 {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.GroupSizeKey, Value: strconv.FormatInt(int64(httpReq.GroupSize), 10)})
+	}
+	if httpReq.GroupOrderBy != "" {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.GroupOrderByKey, Value: httpReq.GroupOrderBy})
+	}
+	if httpReq.PartialResult {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.PartialResultKey, Value: "true"})
+	}
+	if len(httpReq.FilterParams) > 0 {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.ExprParamsKey, Value: string(httpReq.FilterParams)})
+	}
+	if httpReq.SearchProfile != "" {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.SearchProfileKey, Value: httpReq.SearchProfile})
+	}
+	if httpReq.DistanceHistogram > 0 {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.DistanceHistogramKey, Value: strconv.Itoa(httpReq.DistanceHistogram)})
+	}
 	body, _ := c.Get(gin.BodyBytesKey)
 	placeholderGroup, err := generatePlaceholderGroup(ctx, string(body.([]byte)), collSchema, httpReq.AnnsField)
 	if err != nil {
@@ -857,14 +1037,18 @@ func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbN
 		SearchParams:       searchParams,
 		GuaranteeTimestamp: BoundedTimestamp,
 		Nq:                 int64(1),
+		// the consistency level of the search profile takes effect if any
+		UseDefaultConsistency: httpReq.SearchProfile != "",
 	}
 	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
 		return h.proxy.Search(reqCtx, req.(*milvuspb.SearchRequest))
 	})
 	if err == nil {
 		searchResp := resp.(*milvuspb.SearchResults)
-		if searchResp.Results.TopK == int64(0) {
-			c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: []interface{}{}})
+		if httpReq.ResultFormat == ResultFormatArrow {
+			writeArrowResp(ctx, c, searchResp.Results.FieldsData, searchResp.Results.Ids, searchResp.Results.Scores)
+		} else if searchResp.Results.TopK == int64(0) {
+			c.JSON(http.StatusOK, withDistanceHistogram(withSearchCoverage(gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: []interface{}{}}, searchResp), searchResp))
 		} else {
 			allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
 			outputData, err := buildQueryResp(searchResp.Results.TopK, searchResp.Results.OutputFields, searchResp.Results.FieldsData, searchResp.Results.Ids, searchResp.Results.Scores, allowJS)
@@ -875,9 +1059,226 @@ func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbN
 					HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
 				})
 			} else {
-				c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData})
+				c.JSON(http.StatusOK, withSearchCoverage(gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData}, searchResp))
+			}
+		}
+	}
+	return resp, err
+}
+
+// withSearchCoverage reports the fraction of the data searched if the search allowed partial result.
+func withSearchCoverage(body gin.H, resp *milvuspb.SearchResults) gin.H {
+	if value, ok := resp.GetStatus().GetExtraInfo()[proxy.SearchCoverageKey]; ok {
+		if coverage, err := strconv.ParseFloat(value, 64); err == nil {
+			body[HTTPReturnCoverage] = coverage
+		}
+	}
+	return body
+}
+
+// withDistanceHistogram reports the histogram of the distances if requested by the range search.
+func withDistanceHistogram(body gin.H, resp *milvuspb.SearchResults) gin.H {
+	if value, ok := resp.GetStatus().GetExtraInfo()[proxy.DistanceHistogramKey]; ok {
+		histogram := &proxy.DistanceHistogramResult{}
+		if err := json.Unmarshal([]byte(value), histogram); err == nil {
+			body[HTTPReturnDistanceHistogram] = histogram
+		}
+	}
+	return body
+}
+
+func (h *HandlersV2) queryIterator(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*QueryIteratorReqV2)
+	req := &proxypb.QueryIteratorRequest{
+		Request: &milvuspb.QueryRequest{
+			DbName:             dbName,
+			CollectionName:     httpReq.CollectionName,
+			Expr:               httpReq.Filter,
+			OutputFields:       httpReq.OutputFields,
+			PartitionNames:     httpReq.PartitionNames,
+			GuaranteeTimestamp: BoundedTimestamp,
+			QueryParams:        []*commonpb.KeyValuePair{},
+		},
+		BatchSize: int64(httpReq.BatchSize),
+		Cursor:    httpReq.Cursor,
+	}
+	if h.checkAuth {
+		err := checkAuthorization(ctx, c, req.GetRequest())
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.QueryIterator(reqCtx, req.(*proxypb.QueryIteratorRequest))
+	})
+	if err == nil {
+		iteratorResp := resp.(*proxypb.QueryIteratorResponse)
+		queryResp := iteratorResp.GetResults()
+		allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+		outputData, err := buildQueryResp(int64(0), queryResp.GetOutputFields(), queryResp.GetFieldsData(), nil, nil, allowJS)
+		if err != nil {
+			log.Ctx(ctx).Warn("high level restful api, fail to deal with query iterator result", zap.Any("response", resp), zap.Error(err))
+			c.JSON(http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+				HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+			})
+		} else {
+			c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData, HTTPReturnCursor: iteratorResp.GetNextCursor()})
+		}
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) searchIterator(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*SearchIteratorReqV2)
+	collSchema, err := h.GetCollectionSchema(ctx, c, dbName, httpReq.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	searchParams, err := generateSearchParams(ctx, c, httpReq.Params)
+	if err != nil {
+		return nil, err
+	}
+	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.AnnsFieldKey, Value: httpReq.AnnsField})
+	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamRoundDecimal, Value: "-1"})
+	body, _ := c.Get(gin.BodyBytesKey)
+	placeholderGroup, err := generatePlaceholderGroup(ctx, string(body.([]byte)), collSchema, httpReq.AnnsField)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, search iterator with vector invalid", zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return nil, err
+	}
+	req := &proxypb.SearchIteratorRequest{
+		Request: &milvuspb.SearchRequest{
+			DbName:             dbName,
+			CollectionName:     httpReq.CollectionName,
+			Dsl:                httpReq.Filter,
+			PlaceholderGroup:   placeholderGroup,
+			DslType:            commonpb.DslType_BoolExprV1,
+			OutputFields:       httpReq.OutputFields,
+			PartitionNames:     httpReq.PartitionNames,
+			SearchParams:       searchParams,
+			GuaranteeTimestamp: BoundedTimestamp,
+			Nq:                 int64(1),
+		},
+		BatchSize: int64(httpReq.BatchSize),
+		Cursor:    httpReq.Cursor,
+	}
+	if h.checkAuth {
+		err := checkAuthorization(ctx, c, req.GetRequest())
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp, err := wrapperProxy(ctx, c, req, false, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.SearchIterator(reqCtx, req.(*proxypb.SearchIteratorRequest))
+	})
+	if err == nil {
+		iteratorResp := resp.(*proxypb.SearchIteratorResponse)
+		results := iteratorResp.GetResults().GetResults()
+		if results.GetTopK() == int64(0) {
+			c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: []interface{}{}, HTTPReturnCursor: iteratorResp.GetNextCursor()})
+		} else {
+			allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+			outputData, err := buildQueryResp(results.GetTopK(), results.GetOutputFields(), results.GetFieldsData(), results.GetIds(), results.GetScores(), allowJS)
+			if err != nil {
+				log.Ctx(ctx).Warn("high level restful api, fail to deal with search iterator result", zap.Any("result", results), zap.Error(err))
+				c.JSON(http.StatusOK, gin.H{
+					HTTPReturnCode:    merr.Code(merr.ErrInvalidSearchResult),
+					HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
+				})
+			} else {
+				c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData, HTTPReturnCursor: iteratorResp.GetNextCursor()})
+			}
+		}
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) batchSearch(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*BatchSearchReq)
+	if len(httpReq.Search) == 0 {
+		err := merr.WrapErrParameterMissing("search")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return nil, err
+	}
+	searchParams, err := generateSearchParams(ctx, c, httpReq.Params)
+	if err != nil {
+		return nil, err
+	}
+	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.AnnsFieldKey, Value: httpReq.AnnsField})
+	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamRoundDecimal, Value: "-1"})
+	body, _ := c.Get(gin.BodyBytesKey)
+	req := &proxypb.BatchSearchRequest{
+		Requests: make([]*milvuspb.SearchRequest, 0, len(httpReq.Search)),
+	}
+	for _, subReq := range httpReq.Search {
+		// the collections may have different vector types, so the placeholder group is generated for each of them
+		collSchema, err := h.GetCollectionSchema(ctx, c, dbName, subReq.CollectionName)
+		if err != nil {
+			return nil, err
+		}
+		placeholderGroup, err := generatePlaceholderGroup(ctx, string(body.([]byte)), collSchema, httpReq.AnnsField)
+		if err != nil {
+			log.Ctx(ctx).Warn("high level restful api, batch search with vector invalid", zap.String("collection", subReq.CollectionName), zap.Error(err))
+			c.AbortWithStatusJSON(http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+				HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+			})
+			return nil, err
+		}
+		limit := httpReq.Limit
+		if subReq.Limit > 0 {
+			limit = subReq.Limit
+		}
+		subSearchParams := append([]*commonpb.KeyValuePair{
+			{Key: common.TopKKey, Value: strconv.FormatInt(int64(limit), 10)},
+		}, searchParams...)
+		req.Requests = append(req.Requests, &milvuspb.SearchRequest{
+			DbName:             dbName,
+			CollectionName:     subReq.CollectionName,
+			Dsl:                subReq.Filter,
+			PlaceholderGroup:   placeholderGroup,
+			DslType:            commonpb.DslType_BoolExprV1,
+			OutputFields:       subReq.OutputFields,
+			PartitionNames:     subReq.PartitionNames,
+			SearchParams:       subSearchParams,
+			GuaranteeTimestamp: BoundedTimestamp,
+			Nq:                 int64(1),
+		})
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.BatchSearch(reqCtx, req.(*proxypb.BatchSearchRequest))
+	})
+	if err == nil {
+		allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
+		batchResp := resp.(*proxypb.BatchSearchResponse)
+		// the results are grouped by the collections, the failure of a collection doesn't fail the others
+		groups := make([]gin.H, 0, len(batchResp.GetResults()))
+		for i, searchResp := range batchResp.GetResults() {
+			group := gin.H{HTTPCollectionName: httpReq.Search[i].CollectionName, HTTPReturnCode: http.StatusOK}
+			results := searchResp.GetResults()
+			if err := merr.Error(searchResp.GetStatus()); err != nil {
+				group[HTTPReturnCode] = merr.Code(err)
+				group[HTTPReturnMessage] = err.Error()
+			} else if results.GetTopK() == int64(0) {
+				group[HTTPReturnData] = []interface{}{}
+			} else {
+				outputData, err := buildQueryResp(results.GetTopK(), results.GetOutputFields(), results.GetFieldsData(), results.GetIds(), results.GetScores(), allowJS)
+				if err != nil {
+					log.Ctx(ctx).Warn("high level restful api, fail to deal with batch search result", zap.Any("result", results), zap.Error(err))
+					group[HTTPReturnCode] = merr.Code(merr.ErrInvalidSearchResult)
+					group[HTTPReturnMessage] = merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error()
+				} else {
+					group[HTTPReturnData] = outputData
+				}
 			}
+			groups = append(groups, group)
 		}
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: groups})
 	}
 	return resp, err
 }
@@ -1562,34 +1963,72 @@ func (h *HandlersV2) describeIndex(ctx context.Context, c *gin.Context, anyReq a
 	resp, err := wrapperProxy(ctx, c, req, false, false, func(reqCtx context.Context, req any) (interface{}, error) {
 		return h.proxy.DescribeIndex(reqCtx, req.(*milvuspb.DescribeIndexRequest))
 	})
-	if err == nil {
-		indexInfos := [](map[string]any){}
-		for _, indexDescription := range resp.(*milvuspb.DescribeIndexResponse).IndexDescriptions {
-			metricType := ""
-			indexType := ""
-			for _, pair := range indexDescription.Params {
-				if pair.Key == common.MetricTypeKey {
-					metricType = pair.Value
-				} else if pair.Key == common.IndexTypeKey {
-					indexType = pair.Value
-				}
+	if err != nil {
+		return resp, err
+	}
+	progresses := make(map[string]*indexpb.IndexInfo)
+	if httpReq, ok := anyReq.(*IndexReq); ok && httpReq.WithSegmentProgress {
+		progressReq := &proxypb.DescribeIndexProgressRequest{
+			DbName:         dbName,
+			CollectionName: collectionGetter.GetCollectionName(),
+			IndexName:      indexGetter.GetIndexName(),
+		}
+		progressResp, err := wrapperProxy(ctx, c, progressReq, false, false, func(reqCtx context.Context, req any) (interface{}, error) {
+			return h.proxy.DescribeIndexProgress(reqCtx, req.(*proxypb.DescribeIndexProgressRequest))
+		})
+		if err != nil {
+			return progressResp, err
+		}
+		for _, info := range progressResp.(*indexpb.DescribeIndexResponse).GetIndexInfos() {
+			progresses[info.GetIndexName()] = info
+		}
+	}
+	indexInfos := [](map[string]any){}
+	for _, indexDescription := range resp.(*milvuspb.DescribeIndexResponse).IndexDescriptions {
+		metricType := ""
+		indexType := ""
+		for _, pair := range indexDescription.Params {
+			if pair.Key == common.MetricTypeKey {
+				metricType = pair.Value
+			} else if pair.Key == common.IndexTypeKey {
+				indexType = pair.Value
 			}
-			indexInfo := map[string]any{
-				HTTPIndexName:              indexDescription.IndexName,
-				HTTPIndexField:             indexDescription.FieldName,
-				HTTPReturnIndexType:        indexType,
-				HTTPReturnIndexMetricType:  metricType,
-				HTTPReturnIndexTotalRows:   indexDescription.TotalRows,
-				HTTPReturnIndexPendingRows: indexDescription.PendingIndexRows,
-				HTTPReturnIndexIndexedRows: indexDescription.IndexedRows,
-				HTTPReturnIndexState:       indexDescription.State.String(),
-				HTTPReturnIndexFailReason:  indexDescription.IndexStateFailReason,
+		}
+		indexInfo := map[string]any{
+			HTTPIndexName:              indexDescription.IndexName,
+			HTTPIndexField:             indexDescription.FieldName,
+			HTTPReturnIndexType:        indexType,
+			HTTPReturnIndexMetricType:  metricType,
+			HTTPReturnIndexTotalRows:   indexDescription.TotalRows,
+			HTTPReturnIndexPendingRows: indexDescription.PendingIndexRows,
+			HTTPReturnIndexIndexedRows: indexDescription.IndexedRows,
+			HTTPReturnIndexState:       indexDescription.State.String(),
+			HTTPReturnIndexFailReason:  indexDescription.IndexStateFailReason,
+		}
+		if progress, ok := progresses[indexDescription.IndexName]; ok {
+			segmentProgresses := make([]gin.H, 0, len(progress.GetSegmentProgresses()))
+			for _, segment := range progress.GetSegmentProgresses() {
+				segmentProgresses = append(segmentProgresses, gin.H{
+					"segmentId":                 segment.GetSegmentID(),
+					"partitionId":               segment.GetPartitionID(),
+					"buildId":                   segment.GetBuildID(),
+					"nodeId":                    segment.GetNodeID(),
+					"state":                     segment.GetState().String(),
+					"failReason":                segment.GetFailReason(),
+					"numRows":                   segment.GetNumRows(),
+					"indexedRows":               segment.GetIndexedRows(),
+					"elapsedMs":                 segment.GetElapsedMs(),
+					"estimatedRemainingSeconds": segment.GetEstimatedRemainingSeconds(),
+					"cancelling":                segment.GetCancelling(),
+				})
 			}
-			indexInfos = append(indexInfos, indexInfo)
+			indexInfo["segmentProgresses"] = segmentProgresses
+			indexInfo["estimatedRemainingSeconds"] = progress.GetEstimatedRemainingSeconds()
 		}
-		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: indexInfos})
+		indexInfos = append(indexInfos, indexInfo)
 	}
-	return resp, err
+	c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: indexInfos})
+	return resp, nil
 }
 
 func (h *HandlersV2) createIndex(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
@@ -1750,6 +2189,9 @@ func (h *HandlersV2) listImportJob(ctx context.Context, c *gin.Context, anyReq a
 			jobDetail["collectionName"] = response.GetCollectionNames()[i]
 			jobDetail["state"] = response.GetStates()[i].String()
 			jobDetail["progress"] = response.GetProgresses()[i]
+			if i < len(response.GetQueuePositions()) && response.GetQueuePositions()[i] > 0 {
+				jobDetail["queuePosition"] = response.GetQueuePositions()[i]
+			}
 			reason := response.GetReasons()[i]
 			if reason != "" {
 				jobDetail["reason"] = reason
@@ -1826,6 +2268,9 @@ func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, an
 		returnData["progress"] = response.GetProgress()
 		returnData["importedRows"] = response.GetImportedRows()
 		returnData["totalRows"] = response.GetTotalRows()
+		if response.GetQueuePosition() > 0 {
+			returnData["queuePosition"] = response.GetQueuePosition()
+		}
 		reason := response.GetReason()
 		if reason != "" {
 			returnData["reason"] = reason
@@ -1855,6 +2300,501 @@ func (h *HandlersV2) getImportJobProcess(ctx context.Context, c *gin.Context, an
 	return resp, err
 }
 
+func toPbResourceGroupLimit(limit *ResourceGroupLimit) *rgpb.ResourceGroupLimit {
+	if limit == nil {
+		return nil
+	}
+	return &rgpb.ResourceGroupLimit{NodeNum: limit.NodeNum}
+}
+
+func toPbResourceGroupTransfers(transfers []*ResourceGroupTransfer) []*rgpb.ResourceGroupTransfer {
+	return lo.Map(transfers, func(transfer *ResourceGroupTransfer, _ int) *rgpb.ResourceGroupTransfer {
+		return &rgpb.ResourceGroupTransfer{ResourceGroup: transfer.ResourceGroup}
+	})
+}
+
+func toPbResourceGroupConfig(config *ResourceGroupConfig) *rgpb.ResourceGroupConfig {
+	if config == nil {
+		return nil
+	}
+	return &rgpb.ResourceGroupConfig{
+		Requests: toPbResourceGroupLimit(config.Requests),
+		Limits:   toPbResourceGroupLimit(config.Limits),
+		From:     toPbResourceGroupTransfers(config.TransferFrom),
+		To:       toPbResourceGroupTransfers(config.TransferTo),
+	}
+}
+
+func fromPbResourceGroupConfig(config *rgpb.ResourceGroupConfig) *ResourceGroupConfig {
+	transfers := func(pbTransfers []*rgpb.ResourceGroupTransfer) []*ResourceGroupTransfer {
+		return lo.Map(pbTransfers, func(transfer *rgpb.ResourceGroupTransfer, _ int) *ResourceGroupTransfer {
+			return &ResourceGroupTransfer{ResourceGroup: transfer.GetResourceGroup()}
+		})
+	}
+	return &ResourceGroupConfig{
+		Requests:     &ResourceGroupLimit{NodeNum: config.GetRequests().GetNodeNum()},
+		Limits:       &ResourceGroupLimit{NodeNum: config.GetLimits().GetNodeNum()},
+		TransferFrom: transfers(config.GetFrom()),
+		TransferTo:   transfers(config.GetTo()),
+	}
+}
+
+func (h *HandlersV2) listResourceGroups(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	req := &milvuspb.ListResourceGroupsRequest{}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ListResourceGroups(reqCtx, req.(*milvuspb.ListResourceGroupsRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnList(resp.(*milvuspb.ListResourceGroupsResponse).GetResourceGroups()))
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) describeResourceGroup(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ResourceGroupReq)
+	req := &milvuspb.DescribeResourceGroupRequest{
+		ResourceGroup: httpReq.Name,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DescribeResourceGroup(reqCtx, req.(*milvuspb.DescribeResourceGroupRequest))
+	})
+	if err == nil {
+		rg := resp.(*milvuspb.DescribeResourceGroupResponse).GetResourceGroup()
+		nodes := make([]gin.H, 0, len(rg.GetNodes()))
+		for _, node := range rg.GetNodes() {
+			nodes = append(nodes, gin.H{
+				"nodeId":   node.GetNodeID(),
+				"address":  node.GetAddress(),
+				"hostname": node.GetHostname(),
+			})
+		}
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{
+			"name":             rg.GetName(),
+			"capacity":         rg.GetCapacity(),
+			"numAvailableNode": rg.GetNumAvailableNode(),
+			"numLoadedReplica": rg.GetNumLoadedReplica(),
+			"numOutgoingNode":  rg.GetNumOutgoingNode(),
+			"numIncomingNode":  rg.GetNumIncomingNode(),
+			"config":           fromPbResourceGroupConfig(rg.GetConfig()),
+			"nodes":            nodes,
+		}})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) createResourceGroup(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ResourceGroupReq)
+	req := &milvuspb.CreateResourceGroupRequest{
+		ResourceGroup: httpReq.Name,
+		Config:        toPbResourceGroupConfig(httpReq.Config),
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.CreateResourceGroup(reqCtx, req.(*milvuspb.CreateResourceGroupRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) alterResourceGroup(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ResourceGroupReq)
+	if httpReq.Config == nil {
+		err := merr.WrapErrParameterMissing("config")
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(err),
+			HTTPReturnMessage: err.Error(),
+		})
+		return nil, err
+	}
+	req := &milvuspb.UpdateResourceGroupsRequest{
+		ResourceGroups: map[string]*rgpb.ResourceGroupConfig{
+			httpReq.Name: toPbResourceGroupConfig(httpReq.Config),
+		},
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.UpdateResourceGroups(reqCtx, req.(*milvuspb.UpdateResourceGroupsRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) dropResourceGroup(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ResourceGroupReq)
+	req := &milvuspb.DropResourceGroupRequest{
+		ResourceGroup: httpReq.Name,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DropResourceGroup(reqCtx, req.(*milvuspb.DropResourceGroupRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) transferNode(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*TransferNodeReq)
+	req := &milvuspb.TransferNodeRequest{
+		SourceResourceGroup: httpReq.SourceRgName,
+		TargetResourceGroup: httpReq.TargetRgName,
+		NumNode:             httpReq.NumNode,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.TransferNode(reqCtx, req.(*milvuspb.TransferNodeRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) transferReplica(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*TransferReplicaReq)
+	req := &milvuspb.TransferReplicaRequest{
+		DbName:              dbName,
+		SourceResourceGroup: httpReq.SourceRgName,
+		TargetResourceGroup: httpReq.TargetRgName,
+		CollectionName:      httpReq.CollectionName,
+		NumReplica:          httpReq.ReplicaNum,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.TransferReplica(reqCtx, req.(*milvuspb.TransferReplicaRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) describeReplicas(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	getter, _ := anyReq.(requestutil.CollectionNameGetter)
+	req := &milvuspb.GetReplicasRequest{
+		DbName:         dbName,
+		CollectionName: getter.GetCollectionName(),
+		WithShardNodes: true,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.GetReplicas(reqCtx, req.(*milvuspb.GetReplicasRequest))
+	})
+	if err == nil {
+		replicas := make([]gin.H, 0)
+		for _, replica := range resp.(*milvuspb.GetReplicasResponse).GetReplicas() {
+			shards := make([]gin.H, 0, len(replica.GetShardReplicas()))
+			for _, shard := range replica.GetShardReplicas() {
+				shards = append(shards, gin.H{
+					"channelName": shard.GetDmChannelName(),
+					"leaderId":    shard.GetLeaderID(),
+					"leaderAddr":  shard.GetLeaderAddr(),
+					"nodeIds":     shard.GetNodeIds(),
+				})
+			}
+			replicas = append(replicas, gin.H{
+				"replicaId":         replica.GetReplicaID(),
+				"collectionId":      replica.GetCollectionID(),
+				"partitionIds":      replica.GetPartitionIds(),
+				"nodeIds":           replica.GetNodeIds(),
+				"resourceGroupName": replica.GetResourceGroupName(),
+				"numOutboundNode":   replica.GetNumOutboundNode(),
+				"shards":            shards,
+			})
+		}
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: replicas})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) compact(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*CompactReq)
+	// the compaction is triggered by the collection id
+	descReq := &milvuspb.DescribeCollectionRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+	}
+	descResp, err := wrapperProxy(ctx, c, descReq, false, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DescribeCollection(reqCtx, req.(*milvuspb.DescribeCollectionRequest))
+	})
+	if err != nil {
+		return descResp, err
+	}
+	req := &milvuspb.ManualCompactionRequest{
+		CollectionID:    descResp.(*milvuspb.DescribeCollectionResponse).GetCollectionID(),
+		MajorCompaction: httpReq.MajorCompaction,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ManualCompaction(reqCtx, req.(*milvuspb.ManualCompactionRequest))
+	})
+	if err == nil {
+		response := resp.(*milvuspb.ManualCompactionResponse)
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{
+			"jobId":     response.GetCompactionID(),
+			"planCount": response.GetCompactionPlanCount(),
+		}})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) getCompactionState(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*CompactionIDReq)
+	req := &milvuspb.GetCompactionStateRequest{
+		CompactionID: httpReq.JobID,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.GetCompactionState(reqCtx, req.(*milvuspb.GetCompactionStateRequest))
+	})
+	if err == nil {
+		response := resp.(*milvuspb.GetCompactionStateResponse)
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{
+			"jobId":           httpReq.JobID,
+			"state":           response.GetState().String(),
+			"executingPlanNo": response.GetExecutingPlanNo(),
+			"timeoutPlanNo":   response.GetTimeoutPlanNo(),
+			"completedPlanNo": response.GetCompletedPlanNo(),
+			"failedPlanNo":    response.GetFailedPlanNo(),
+		}})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) listAPIKeys(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	req := &proxypb.ListAPIKeysRequest{}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ListAPIKeys(reqCtx, req.(*proxypb.ListAPIKeysRequest))
+	})
+	if err == nil {
+		apiKeys := make([]gin.H, 0)
+		for _, info := range resp.(*proxypb.ListAPIKeysResponse).GetInfos() {
+			apiKeys = append(apiKeys, wrapperAPIKeyInfo(info))
+		}
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: apiKeys})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) createAPIKey(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*APIKeyReq)
+	req := &proxypb.CreateAPIKeyRequest{
+		Name:     httpReq.Name,
+		DbNames:  httpReq.DbNames,
+		Roles:    httpReq.Roles,
+		ExpireAt: httpReq.ExpireAt,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.CreateAPIKey(reqCtx, req.(*proxypb.CreateAPIKeyRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnAPIKey(resp.(*proxypb.APIKeyResponse)))
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) rotateAPIKey(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*APIKeyNameReq)
+	req := &proxypb.RotateAPIKeyRequest{
+		Name:     httpReq.Name,
+		ExpireAt: httpReq.ExpireAt,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.RotateAPIKey(reqCtx, req.(*proxypb.RotateAPIKeyRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnAPIKey(resp.(*proxypb.APIKeyResponse)))
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) revokeAPIKey(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*APIKeyNameReq)
+	req := &proxypb.RevokeAPIKeyRequest{
+		Name: httpReq.Name,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.RevokeAPIKey(reqCtx, req.(*proxypb.RevokeAPIKeyRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) dropCollectionAsync(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	getter, _ := anyReq.(requestutil.CollectionNameGetter)
+	req := &milvuspb.DropCollectionRequest{
+		DbName:         dbName,
+		CollectionName: getter.GetCollectionName(),
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DropCollectionAsync(reqCtx, req.(*milvuspb.DropCollectionRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{"jobId": resp.(*proxypb.DDLJobResponse).GetJobID()}})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) alterCollectionAsync(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*CollectionPropertiesReq)
+	properties := funcutil.Map2KeyValuePair(httpReq.Properties)
+	sort.Slice(properties, func(i, j int) bool {
+		return properties[i].GetKey() < properties[j].GetKey()
+	})
+	req := &milvuspb.AlterCollectionRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		Properties:     properties,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.AlterCollectionAsync(reqCtx, req.(*milvuspb.AlterCollectionRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{"jobId": resp.(*proxypb.DDLJobResponse).GetJobID()}})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) describeDDLJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*DDLJobIDReq)
+	req := &proxypb.DescribeDDLJobRequest{
+		JobID: httpReq.JobID,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DescribeDDLJob(reqCtx, req.(*proxypb.DescribeDDLJobRequest))
+	})
+	if err == nil {
+		response := resp.(*proxypb.DescribeDDLJobResponse)
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{
+			"jobId":          response.GetJobID(),
+			"jobType":        response.GetJobType(),
+			"state":          response.GetState().String(),
+			"reason":         response.GetFailReason(),
+			"dbName":         response.GetDbName(),
+			"collectionName": response.GetCollectionName(),
+			"startTime":      response.GetStartTime(),
+			"endTime":        response.GetEndTime(),
+		}})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) addCollectionField(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*AddCollectionFieldReq)
+	dataType, ok := schemapb.DataType_value[httpReq.DataType]
+	if !ok {
+		err := merr.WrapErrParameterInvalidMsg("data type %s is invalid(case sensitive)", httpReq.DataType)
+		log.Ctx(ctx).Warn("high level restful api, add collection field fail", zap.Error(err), zap.Any("request", anyReq))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return nil, err
+	}
+	defaultValue, err := convertDefaultValue(schemapb.DataType(dataType), httpReq.DefaultValue)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, add collection field fail", zap.Error(err), zap.Any("request", anyReq))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{HTTPReturnCode: merr.Code(err), HTTPReturnMessage: err.Error()})
+		return nil, err
+	}
+	field := &schemapb.FieldSchema{
+		Name:         httpReq.FieldName,
+		DataType:     schemapb.DataType(dataType),
+		Description:  httpReq.Description,
+		DefaultValue: defaultValue,
+		TypeParams:   funcutil.Map2KeyValuePair(httpReq.ElementTypeParams),
+	}
+	req := &proxypb.AddCollectionFieldRequest{
+		DbName:         dbName,
+		CollectionName: httpReq.CollectionName,
+		Field:          field,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.AddCollectionField(reqCtx, req.(*proxypb.AddCollectionFieldRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) createCollectionFromSnapshot(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*CollectionFromSnapshotReq)
+	req := &proxypb.CreateCollectionFromSnapshotRequest{
+		DbName:               dbName,
+		CollectionName:       httpReq.CollectionName,
+		SnapshotName:         httpReq.SnapshotName,
+		TargetCollectionName: httpReq.TargetCollectionName,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.CreateCollectionFromSnapshot(reqCtx, req.(*proxypb.CreateCollectionFromSnapshotRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: restoredCollections(resp.(*proxypb.RestoreCollectionsResponse))})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) restoreBackup(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*BackupRestoreReq)
+	req := &proxypb.RestoreBackupRequest{
+		BackupName:      httpReq.BackupName,
+		DbName:          httpReq.DbName,
+		CollectionNames: httpReq.CollectionNames,
+		TargetDbName:    httpReq.TargetDbName,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.RestoreBackup(reqCtx, req.(*proxypb.RestoreBackupRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: restoredCollections(resp.(*proxypb.RestoreCollectionsResponse))})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) promoteReplica(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ReplicaPromoteReq)
+	req := &proxypb.PromoteReplicaRequest{
+		DbName:         httpReq.DbName,
+		CollectionName: httpReq.CollectionName,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.PromoteReplica(reqCtx, req.(*proxypb.PromoteReplicaRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: restoredCollections(resp.(*proxypb.RestoreCollectionsResponse))})
+	}
+	return resp, err
+}
+
+func restoredCollections(resp *proxypb.RestoreCollectionsResponse) []gin.H {
+	collections := make([]gin.H, 0, len(resp.GetCollections()))
+	for _, collection := range resp.GetCollections() {
+		collections = append(collections, gin.H{
+			HTTPDbName:         collection.GetDbName(),
+			HTTPCollectionName: collection.GetCollectionName(),
+			"segmentIds":       collection.GetSegmentIDs(),
+			"numRows":          collection.GetNumRows(),
+		})
+	}
+	return collections
+}
+
+func (h *HandlersV2) alterDatabase(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*DatabasePropertiesReq)
+	properties := funcutil.Map2KeyValuePair(httpReq.Properties)
+	sort.Slice(properties, func(i, j int) bool {
+		return properties[i].GetKey() < properties[j].GetKey()
+	})
+	req := &proxypb.AlterDatabaseRequest{
+		DbName:     dbName,
+		Properties: properties,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.AlterDatabase(reqCtx, req.(*proxypb.AlterDatabaseRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) GetCollectionSchema(ctx context.Context, c *gin.Context, dbName, collectionName string) (*schemapb.CollectionSchema, error) {
 	collSchema, err := proxy.GetCachedCollectionSchema(ctx, dbName, collectionName)
 	if err == nil {
@@ -16,12 +16,18 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -1307,3 +1313,1012 @@ func TestSearchV2(t *testing.T) {
 		})
 	}
 }
+
+func TestIteratorV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionName: DefaultCollectionName,
+		Schema:         generateCollectionSchema(schemapb.DataType_Int64),
+		ShardsNum:      ShardNumDefault,
+		Status:         &StatusSuccess,
+	}, nil).Once()
+	mp.EXPECT().QueryIterator(mock.Anything, mock.Anything).Return(&proxypb.QueryIteratorResponse{
+		Status:     commonSuccessStatus,
+		Results:    &milvuspb.QueryResults{Status: commonSuccessStatus, OutputFields: []string{}, FieldsData: []*schemapb.FieldData{}},
+		NextCursor: "next",
+	}, nil).Once()
+	mp.EXPECT().QueryIterator(mock.Anything, mock.Anything).Return(&proxypb.QueryIteratorResponse{
+		Status: merr.Status(merr.WrapErrParameterInvalidMsg("invalid iterator cursor")),
+	}, nil).Once()
+	mp.EXPECT().SearchIterator(mock.Anything, mock.Anything).Return(&proxypb.SearchIteratorResponse{
+		Status:     commonSuccessStatus,
+		Results:    &milvuspb.SearchResults{Status: commonSuccessStatus, Results: &schemapb.SearchResultData{TopK: int64(0)}},
+		NextCursor: "next",
+	}, nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	type iteratorTestCase struct {
+		requestBodyTestCase
+		cursor string
+	}
+	testCases := []iteratorTestCase{
+		{
+			requestBodyTestCase: requestBodyTestCase{
+				path:        QueryIteratorAction,
+				requestBody: []byte(`{"collectionName": "book", "filter": "word_count > 10", "outputFields": ["book_id", "word_count"], "batchSize": 10}`),
+			},
+			cursor: "next",
+		},
+		{
+			requestBodyTestCase: requestBodyTestCase{
+				path:        QueryIteratorAction,
+				requestBody: []byte(`{"collectionName": "book", "batchSize": 10, "cursor": "invalid"}`),
+				errMsg:      "invalid iterator cursor: invalid parameter",
+				errCode:     1100, // ErrParameterInvalid
+			},
+		},
+		{
+			requestBodyTestCase: requestBodyTestCase{
+				path:        SearchIteratorAction,
+				requestBody: []byte(`{"collectionName": "book", "data": [[0.1, 0.2]], "filter": "word_count > 10", "batchSize": 10, "outputFields": ["word_count"]}`),
+			},
+			cursor: "next",
+		},
+	}
+
+	for _, testcase := range testCases {
+		t.Run("iterator", func(t *testing.T) {
+			bodyReader := bytes.NewReader(testcase.requestBody)
+			req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, testcase.path), bodyReader)
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			returnBody := &ReturnErrMsg{}
+			err := json.Unmarshal(w.Body.Bytes(), returnBody)
+			assert.Nil(t, err)
+			if testcase.errCode != 0 {
+				assert.Equal(t, testcase.errCode, returnBody.Code)
+				assert.Equal(t, testcase.errMsg, returnBody.Message)
+			} else {
+				assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+				returnCursor := map[string]any{}
+				assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &returnCursor))
+				assert.Equal(t, testcase.cursor, returnCursor[HTTPReturnCursor])
+			}
+		})
+	}
+}
+
+func TestSearchV2PartialResult(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionName: DefaultCollectionName,
+		Schema:         generateCollectionSchema(schemapb.DataType_Int64),
+		ShardsNum:      ShardNumDefault,
+		Status:         &StatusSuccess,
+	}, nil).Once()
+	mp.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		partialResult, err := funcutil.GetAttrByKeyFromRepeatedKV(proxy.PartialResultKey, req.GetSearchParams())
+		assert.NoError(t, err)
+		assert.Equal(t, "true", partialResult)
+		return &milvuspb.SearchResults{
+			Status: &commonpb.Status{ExtraInfo: map[string]string{proxy.SearchCoverageKey: "0.5"}},
+			Results: &schemapb.SearchResultData{
+				TopK:       3,
+				FieldsData: generateFieldData(),
+				Ids:        generateIDs(schemapb.DataType_Int64, 3),
+				Scores:     []float32{0.01, 0.04, 0.09},
+			},
+		}, nil
+	}).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	body := []byte(`{"collectionName": "book", "data": [[0.1, 0.2]], "limit": 3, "partialResult": true}`)
+	req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, SearchAction), bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	returnBody := struct {
+		Code     int32            `json:"code"`
+		Data     []map[string]any `json:"data"`
+		Coverage float64          `json:"coverage"`
+	}{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &returnBody))
+	assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+	assert.Len(t, returnBody.Data, 3)
+	assert.Equal(t, 0.5, returnBody.Coverage)
+}
+
+func TestQueryV2FilterParams(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		assert.Equal(t, "word_count > {count}", req.GetExpr())
+		exprParams, err := funcutil.GetAttrByKeyFromRepeatedKV(proxy.ExprParamsKey, req.GetQueryParams())
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"count": 9007199254740993}`, exprParams)
+		return &milvuspb.QueryResults{
+			Status:     &StatusSuccess,
+			FieldsData: generateFieldData(),
+		}, nil
+	}).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	// the large ints are passed through without losing precision
+	body := []byte(`{"collectionName": "book", "filter": "word_count > {count}", "filterParams": {"count": 9007199254740993}}`)
+	req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, QueryAction), bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	returnBody := &ReturnErrMsg{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+	assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+}
+
+func TestSearchV2SearchProfile(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionName: DefaultCollectionName,
+		Schema:         generateCollectionSchema(schemapb.DataType_Int64),
+		ShardsNum:      ShardNumDefault,
+		Status:         &StatusSuccess,
+	}, nil).Once()
+	mp.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		profile, err := funcutil.GetAttrByKeyFromRepeatedKV(proxy.SearchProfileKey, req.GetSearchParams())
+		assert.NoError(t, err)
+		assert.Equal(t, "fast", profile)
+		assert.True(t, req.GetUseDefaultConsistency())
+		return &milvuspb.SearchResults{Status: commonSuccessStatus, Results: &schemapb.SearchResultData{TopK: int64(0)}}, nil
+	}).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	body := []byte(`{"collectionName": "book", "data": [[0.1, 0.2]], "limit": 3, "searchProfile": "fast"}`)
+	req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, SearchAction), bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	returnBody := &ReturnErrMsg{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+	assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+}
+
+func TestSearchV2GroupSize(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionName: DefaultCollectionName,
+		Schema:         generateCollectionSchema(schemapb.DataType_Int64),
+		ShardsNum:      ShardNumDefault,
+		Status:         &StatusSuccess,
+	}, nil).Once()
+	mp.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		groupSize, err := funcutil.GetAttrByKeyFromRepeatedKV(proxy.GroupSizeKey, req.GetSearchParams())
+		assert.NoError(t, err)
+		assert.Equal(t, "3", groupSize)
+		orderBy, err := funcutil.GetAttrByKeyFromRepeatedKV(proxy.GroupOrderByKey, req.GetSearchParams())
+		assert.NoError(t, err)
+		assert.Equal(t, "word_count desc", orderBy)
+		return &milvuspb.SearchResults{Status: commonSuccessStatus, Results: &schemapb.SearchResultData{TopK: int64(0)}}, nil
+	}).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	body := []byte(`{"collectionName": "book", "data": [[0.1, 0.2]], "limit": 3, "groupingField": "book_id", "groupSize": 3, "groupOrderBy": "word_count desc"}`)
+	req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, SearchAction), bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	returnBody := &ReturnErrMsg{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+	assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+}
+
+func TestQueryV2Aggregates(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		assert.Equal(t, []string{"book_id", "count(*)"}, req.GetOutputFields())
+		groupBy, err := funcutil.GetAttrByKeyFromRepeatedKV(proxy.GroupByFieldKey, req.GetQueryParams())
+		assert.NoError(t, err)
+		assert.Equal(t, "book_id", groupBy)
+		return &milvuspb.QueryResults{
+			Status:     &StatusSuccess,
+			FieldsData: generateFieldData(),
+		}, nil
+	}).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	body := []byte(`{"collectionName": "book", "filter": "word_count > 10", "outputFields": ["book_id", "count(*)"], "groupingField": "book_id"}`)
+	req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, QueryAction), bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	returnBody := &ReturnErrMsg{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+	assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+}
+
+func TestSearchV2DistanceHistogram(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionName: DefaultCollectionName,
+		Schema:         generateCollectionSchema(schemapb.DataType_Int64),
+		ShardsNum:      ShardNumDefault,
+		Status:         &StatusSuccess,
+	}, nil).Once()
+	mp.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		buckets, err := funcutil.GetAttrByKeyFromRepeatedKV(proxy.DistanceHistogramKey, req.GetSearchParams())
+		assert.NoError(t, err)
+		assert.Equal(t, "2", buckets)
+		status := merr.Success()
+		status.ExtraInfo = map[string]string{proxy.DistanceHistogramKey: `{"bounds": [0.2, 0.6, 1.0], "counts": [[3, 5]]}`}
+		return &milvuspb.SearchResults{Status: status, Results: &schemapb.SearchResultData{NumQueries: 1, Topks: []int64{0}}}, nil
+	}).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	body := []byte(`{"collectionName": "book", "data": [[0.1, 0.2]], "limit": 100, "params": {"radius": 1.0, "range_filter": 0.2}, "distanceHistogram": 2}`)
+	req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, SearchAction), bytes.NewReader(body))
+	w := httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	returnBody := &struct {
+		Code      int32                          `json:"code"`
+		Histogram *proxy.DistanceHistogramResult `json:"distanceHistogram"`
+	}{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+	assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+	assert.NotNil(t, returnBody.Histogram)
+	assert.Equal(t, []float64{0.2, 0.6, 1.0}, returnBody.Histogram.Bounds)
+	assert.Equal(t, [][]int64{{3, 5}}, returnBody.Histogram.Counts)
+}
+
+func TestBatchSearchV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		CollectionName: DefaultCollectionName,
+		Schema:         generateCollectionSchema(schemapb.DataType_Int64),
+		ShardsNum:      ShardNumDefault,
+		Status:         &StatusSuccess,
+	}, nil).Twice()
+	mp.EXPECT().BatchSearch(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.BatchSearchRequest) (*proxypb.BatchSearchResponse, error) {
+		assert.Len(t, req.GetRequests(), 2)
+		assert.Equal(t, "book", req.GetRequests()[0].GetCollectionName())
+		assert.Equal(t, "word_count > 10", req.GetRequests()[0].GetDsl())
+		assert.Equal(t, "book2", req.GetRequests()[1].GetCollectionName())
+		assert.Equal(t, req.GetRequests()[0].GetPlaceholderGroup(), req.GetRequests()[1].GetPlaceholderGroup())
+		return &proxypb.BatchSearchResponse{
+			Status: commonSuccessStatus,
+			Results: []*milvuspb.SearchResults{
+				{
+					Status: commonSuccessStatus,
+					Results: &schemapb.SearchResultData{
+						TopK:       3,
+						FieldsData: generateFieldData(),
+						Ids:        generateIDs(schemapb.DataType_Int64, 3),
+						Scores:     []float32{0.01, 0.04, 0.09},
+					},
+				},
+				{Status: merr.Status(merr.WrapErrCollectionNotLoaded("book2"))},
+			},
+		}, nil
+	}).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	t.Run("batch search", func(t *testing.T) {
+		body := []byte(`{"data": [[0.1, 0.2]], "limit": 3, "search": [{"collectionName": "book", "filter": "word_count > 10"}, {"collectionName": "book2", "limit": 5}]}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, BatchSearchAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+
+		groups := struct {
+			Data []struct {
+				CollectionName string           `json:"collectionName"`
+				Code           int32            `json:"code"`
+				Message        string           `json:"message"`
+				Data           []map[string]any `json:"data"`
+			} `json:"data"`
+		}{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &groups))
+		assert.Len(t, groups.Data, 2)
+		assert.Equal(t, "book", groups.Data[0].CollectionName)
+		assert.Equal(t, int32(http.StatusOK), groups.Data[0].Code)
+		assert.Len(t, groups.Data[0].Data, 3)
+		assert.Equal(t, "book2", groups.Data[1].CollectionName)
+		assert.Equal(t, merr.Code(merr.ErrCollectionNotLoaded), groups.Data[1].Code)
+		assert.NotEmpty(t, groups.Data[1].Message)
+	})
+
+	t.Run("no search", func(t *testing.T) {
+		body := []byte(`{"data": [[0.1, 0.2]], "search": []}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, BatchSearchAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, merr.Code(merr.ErrParameterMissing), returnBody.Code)
+	})
+}
+
+func TestAdminOperations(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().ListResourceGroups(mock.Anything, mock.Anything).Return(&milvuspb.ListResourceGroupsResponse{
+		Status:         commonSuccessStatus,
+		ResourceGroups: []string{"__default_resource_group", "rg1"},
+	}, nil).Once()
+	mp.EXPECT().DescribeResourceGroup(mock.Anything, mock.Anything).Return(&milvuspb.DescribeResourceGroupResponse{
+		Status: commonSuccessStatus,
+		ResourceGroup: &milvuspb.ResourceGroup{
+			Name:             "rg1",
+			Capacity:         1,
+			NumAvailableNode: 1,
+			Config:           &rgpb.ResourceGroupConfig{Requests: &rgpb.ResourceGroupLimit{NodeNum: 1}},
+			Nodes:            []*commonpb.NodeInfo{{NodeID: 1, Address: "localhost:21123", Hostname: "localhost"}},
+		},
+	}, nil).Once()
+	mp.EXPECT().CreateResourceGroup(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.CreateResourceGroupRequest) (*commonpb.Status, error) {
+		assert.Equal(t, "rg1", req.GetResourceGroup())
+		assert.EqualValues(t, 1, req.GetConfig().GetRequests().GetNodeNum())
+		assert.EqualValues(t, 2, req.GetConfig().GetLimits().GetNodeNum())
+		assert.Equal(t, "__default_resource_group", req.GetConfig().GetFrom()[0].GetResourceGroup())
+		return commonSuccessStatus, nil
+	}).Once()
+	mp.EXPECT().UpdateResourceGroups(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.UpdateResourceGroupsRequest) (*commonpb.Status, error) {
+		assert.EqualValues(t, 2, req.GetResourceGroups()["rg1"].GetLimits().GetNodeNum())
+		return commonSuccessStatus, nil
+	}).Once()
+	mp.EXPECT().DropResourceGroup(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().TransferNode(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().TransferReplica(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().GetReplicas(mock.Anything, mock.Anything).Return(&milvuspb.GetReplicasResponse{
+		Status: commonSuccessStatus,
+		Replicas: []*milvuspb.ReplicaInfo{{
+			ReplicaID:         1,
+			CollectionID:      100,
+			NodeIds:           []int64{1},
+			ResourceGroupName: "rg1",
+			ShardReplicas:     []*milvuspb.ShardReplica{{LeaderID: 1, DmChannelName: "dml_0", NodeIds: []int64{1}}},
+		}},
+	}, nil).Once()
+	mp.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Status:       commonSuccessStatus,
+		CollectionID: 100,
+	}, nil).Once()
+	mp.EXPECT().ManualCompaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+		assert.EqualValues(t, 100, req.GetCollectionID())
+		return &milvuspb.ManualCompactionResponse{Status: commonSuccessStatus, CompactionID: 200}, nil
+	}).Once()
+	mp.EXPECT().GetCompactionState(mock.Anything, mock.Anything).Return(&milvuspb.GetCompactionStateResponse{
+		Status: commonSuccessStatus,
+		State:  commonpb.CompactionState_Completed,
+	}, nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	testCases := []requestBodyTestCase{}
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(ResourceGroupCategory, ListAction),
+		requestBody: []byte(`{}`),
+	})
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(ResourceGroupCategory, DescribeAction),
+		requestBody: []byte(`{"name": "rg1"}`),
+	})
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(ResourceGroupCategory, CreateAction),
+		requestBody: []byte(`{"name": "rg1", "config": {"requests": {"nodeNum": 1}, "limits": {"nodeNum": 2}, "transferFrom": [{"resourceGroup": "__default_resource_group"}]}}`),
+	})
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(ResourceGroupCategory, AlterAction),
+		requestBody: []byte(`{"name": "rg1", "config": {"limits": {"nodeNum": 2}}}`),
+	})
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(ResourceGroupCategory, AlterAction),
+		requestBody: []byte(`{"name": "rg1"}`),
+		errMsg:      "missing parameter[missing_param=config]",
+		errCode:     1101, // ErrParameterMissing
+	})
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(ResourceGroupCategory, DropAction),
+		requestBody: []byte(`{"name": "rg1"}`),
+	})
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(ResourceGroupCategory, TransferNodeAction),
+		requestBody: []byte(`{"sourceRgName": "__default_resource_group", "targetRgName": "rg1", "numNode": 1}`),
+	})
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(ResourceGroupCategory, TransferReplicaAction),
+		requestBody: []byte(`{"sourceRgName": "__default_resource_group", "targetRgName": "rg1", "collectionName": "book", "replicaNum": 1}`),
+	})
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(ResourceGroupCategory, TransferNodeAction),
+		requestBody: []byte(`{"sourceRgName": "__default_resource_group", "targetRgName": "rg1"}`),
+		errMsg:      "missing required parameters, error: Key: 'TransferNodeReq.NumNode' Error:Field validation for 'NumNode' failed on the 'required' tag",
+		errCode:     1802, // ErrMissingRequiredParameters
+	})
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(ReplicaCategory, DescribeAction),
+		requestBody: []byte(`{"collectionName": "book"}`),
+	})
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(CollectionCategory, CompactAction),
+		requestBody: []byte(`{"collectionName": "book"}`),
+	})
+	testCases = append(testCases, requestBodyTestCase{
+		path:        versionalV2(CollectionCategory, CompactionStateAction),
+		requestBody: []byte(`{"jobId": 200}`),
+	})
+
+	for _, testcase := range testCases {
+		t.Run(testcase.path, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, testcase.path, bytes.NewReader(testcase.requestBody))
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			returnBody := &ReturnErrMsg{}
+			err := json.Unmarshal(w.Body.Bytes(), returnBody)
+			assert.Nil(t, err)
+			if testcase.errCode != 0 {
+				assert.Equal(t, testcase.errCode, returnBody.Code)
+				assert.Equal(t, testcase.errMsg, returnBody.Message)
+			} else {
+				assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+			}
+		})
+	}
+}
+
+func TestAPIKeyV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	info := &proxypb.APIKeyInfo{Name: "key1", DbNames: []string{"db1"}, Roles: []string{"role1"}, ExpireAt: 2000000000}
+	mp.EXPECT().CreateAPIKey(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+		assert.Equal(t, "key1", req.GetName())
+		assert.Equal(t, []string{"db1"}, req.GetDbNames())
+		assert.Equal(t, []string{"role1"}, req.GetRoles())
+		assert.Equal(t, int64(2000000000), req.GetExpireAt())
+		return &proxypb.APIKeyResponse{Status: commonSuccessStatus, Key: "mk-key1-secret", Info: info}, nil
+	}).Once()
+	mp.EXPECT().RotateAPIKey(mock.Anything, mock.Anything).Return(&proxypb.APIKeyResponse{Status: commonSuccessStatus, Key: "mk-key1-secret2", Info: info}, nil).Once()
+	mp.EXPECT().RevokeAPIKey(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrPrivilegeNotPermitted("mock")), nil).Once()
+	mp.EXPECT().ListAPIKeys(mock.Anything, mock.Anything).Return(&proxypb.ListAPIKeysResponse{Status: commonSuccessStatus, Infos: []*proxypb.APIKeyInfo{info}}, nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	type apiKeyData struct {
+		Key   string   `json:"key"`
+		Name  string   `json:"name"`
+		Roles []string `json:"roles"`
+	}
+
+	t.Run("create", func(t *testing.T) {
+		body := []byte(`{"name": "key1", "dbNames": ["db1"], "roles": ["role1"], "expireAt": 2000000000}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(APIKeyCategory, CreateAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := struct {
+			Code int32      `json:"code"`
+			Data apiKeyData `json:"data"`
+		}{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+		assert.Equal(t, "mk-key1-secret", returnBody.Data.Key)
+		assert.Equal(t, []string{"role1"}, returnBody.Data.Roles)
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		body := []byte(`{"name": "key1"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(APIKeyCategory, RotateAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := struct {
+			Code int32      `json:"code"`
+			Data apiKeyData `json:"data"`
+		}{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+		assert.Equal(t, "mk-key1-secret2", returnBody.Data.Key)
+	})
+
+	t.Run("revoke not permitted", func(t *testing.T) {
+		body := []byte(`{"name": "key1"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(APIKeyCategory, RevokeAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, merr.Code(merr.ErrPrivilegeNotPermitted), returnBody.Code)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, versionalV2(APIKeyCategory, ListAction), bytes.NewReader([]byte(`{}`)))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := struct {
+			Code int32        `json:"code"`
+			Data []apiKeyData `json:"data"`
+		}{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+		assert.Len(t, returnBody.Data, 1)
+		assert.Equal(t, "key1", returnBody.Data[0].Name)
+		assert.Empty(t, returnBody.Data[0].Key)
+	})
+}
+
+func TestDDLJobV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().DropCollectionAsync(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error) {
+		assert.Equal(t, DefaultCollectionName, req.GetCollectionName())
+		return &proxypb.DDLJobResponse{Status: commonSuccessStatus, JobID: 100}, nil
+	}).Once()
+	mp.EXPECT().AlterCollectionAsync(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error) {
+		assert.Equal(t, DefaultCollectionName, req.GetCollectionName())
+		assert.Equal(t, []*commonpb.KeyValuePair{
+			{Key: "collection.ttl.seconds", Value: "60"},
+			{Key: "mmap.enabled", Value: "true"},
+		}, req.GetProperties())
+		return &proxypb.DDLJobResponse{Status: commonSuccessStatus, JobID: 101}, nil
+	}).Once()
+	mp.EXPECT().DescribeDDLJob(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error) {
+		assert.Equal(t, int64(100), req.GetJobID())
+		return &proxypb.DescribeDDLJobResponse{
+			Status:         commonSuccessStatus,
+			JobID:          100,
+			JobType:        "DropCollection",
+			State:          proxypb.DDLJobState_DDLJobCompleted,
+			DbName:         DefaultDbName,
+			CollectionName: DefaultCollectionName,
+		}, nil
+	}).Once()
+	mp.EXPECT().DescribeDDLJob(mock.Anything, mock.Anything).Return(&proxypb.DescribeDDLJobResponse{
+		Status: merr.Status(merr.WrapErrPrivilegeNotPermitted("mock")),
+	}, nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	type jobData struct {
+		JobID int64  `json:"jobId"`
+		State string `json:"state"`
+	}
+
+	t.Run("drop async", func(t *testing.T) {
+		body := []byte(`{"collectionName": "` + DefaultCollectionName + `"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, DropAsyncAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := struct {
+			Code int32   `json:"code"`
+			Data jobData `json:"data"`
+		}{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+		assert.Equal(t, int64(100), returnBody.Data.JobID)
+	})
+
+	t.Run("alter async", func(t *testing.T) {
+		body := []byte(`{"collectionName": "` + DefaultCollectionName + `", "properties": {"mmap.enabled": "true", "collection.ttl.seconds": "60"}}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, AlterAsyncAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := struct {
+			Code int32   `json:"code"`
+			Data jobData `json:"data"`
+		}{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+		assert.Equal(t, int64(101), returnBody.Data.JobID)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		body := []byte(`{"jobId": 100}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(DDLJobCategory, DescribeAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := struct {
+			Code int32   `json:"code"`
+			Data jobData `json:"data"`
+		}{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+		assert.Equal(t, int64(100), returnBody.Data.JobID)
+		assert.Equal(t, proxypb.DDLJobState_DDLJobCompleted.String(), returnBody.Data.State)
+	})
+
+	t.Run("describe not permitted", func(t *testing.T) {
+		body := []byte(`{"jobId": 100}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(DDLJobCategory, DescribeAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, merr.Code(merr.ErrPrivilegeNotPermitted), returnBody.Code)
+	})
+}
+
+func TestAddCollectionFieldV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().AddCollectionField(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+		assert.Equal(t, DefaultCollectionName, req.GetCollectionName())
+		assert.Equal(t, "age", req.GetField().GetName())
+		assert.Equal(t, schemapb.DataType_Int64, req.GetField().GetDataType())
+		assert.EqualValues(t, 18, req.GetField().GetDefaultValue().GetLongData())
+		return commonSuccessStatus, nil
+	}).Once()
+	mp.EXPECT().AddCollectionField(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrPrivilegeNotPermitted("mock")), nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	t.Run("add field", func(t *testing.T) {
+		body := []byte(`{"collectionName": "` + DefaultCollectionName + `", "fieldName": "age", "dataType": "Int64", "defaultValue": 18}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, AddFieldAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+	})
+
+	t.Run("not permitted", func(t *testing.T) {
+		body := []byte(`{"collectionName": "` + DefaultCollectionName + `", "fieldName": "name", "dataType": "VarChar", "defaultValue": "abc", "elementTypeParams": {"max_length": "16"}}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, AddFieldAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, merr.Code(merr.ErrPrivilegeNotPermitted), returnBody.Code)
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		for _, body := range []string{
+			`{"collectionName": "` + DefaultCollectionName + `", "fieldName": "age", "dataType": "Int128", "defaultValue": 18}`,
+			`{"collectionName": "` + DefaultCollectionName + `", "fieldName": "age", "dataType": "Int64", "defaultValue": "abc"}`,
+			`{"collectionName": "` + DefaultCollectionName + `", "fieldName": "age", "dataType": "Int64"}`,
+			`{"collectionName": "` + DefaultCollectionName + `", "fieldName": "vec", "dataType": "FloatVector", "defaultValue": 1}`,
+		} {
+			req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, AddFieldAction), bytes.NewReader([]byte(body)))
+			w := httptest.NewRecorder()
+			testEngine.ServeHTTP(w, req)
+			returnBody := &ReturnErrMsg{}
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+			assert.NotEqual(t, int32(http.StatusOK), returnBody.Code, body)
+		}
+	})
+}
+
+func TestAlterDatabaseV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().AlterDatabase(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.AlterDatabaseRequest) (*commonpb.Status, error) {
+		assert.Equal(t, "db1", req.GetDbName())
+		assert.Equal(t, []*commonpb.KeyValuePair{
+			{Key: common.DatabaseDDLRateMaxKey, Value: ""},
+			{Key: common.DatabaseMaxCollectionsKey, Value: "10"},
+		}, req.GetProperties())
+		return commonSuccessStatus, nil
+	}).Once()
+	mp.EXPECT().AlterDatabase(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrPrivilegeNotPermitted("mock")), nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	t.Run("alter", func(t *testing.T) {
+		body := []byte(`{"dbName": "db1", "properties": {"` + common.DatabaseMaxCollectionsKey + `": "10", "` + common.DatabaseDDLRateMaxKey + `": ""}}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(DatabaseCategory, AlterAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+	})
+
+	t.Run("not permitted", func(t *testing.T) {
+		body := []byte(`{"dbName": "db1", "properties": {"` + common.DatabaseMaxCollectionsKey + `": "10"}}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(DatabaseCategory, AlterAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, merr.Code(merr.ErrPrivilegeNotPermitted), returnBody.Code)
+	})
+
+	t.Run("missing db name", func(t *testing.T) {
+		body := []byte(`{"properties": {"` + common.DatabaseMaxCollectionsKey + `": "10"}}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(DatabaseCategory, AlterAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.NotEqual(t, int32(http.StatusOK), returnBody.Code)
+	})
+}
+
+func TestCreateCollectionFromSnapshotV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().CreateCollectionFromSnapshot(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.CreateCollectionFromSnapshotRequest) (*proxypb.RestoreCollectionsResponse, error) {
+		assert.Equal(t, "book", req.GetCollectionName())
+		assert.Equal(t, "snapshot", req.GetSnapshotName())
+		assert.Equal(t, "book_clone", req.GetTargetCollectionName())
+		return &proxypb.RestoreCollectionsResponse{
+			Status: commonSuccessStatus,
+			Collections: []*proxypb.RestoredCollection{{
+				DbName:         util.DefaultDBName,
+				CollectionName: "book_clone",
+				SegmentIDs:     []int64{1000},
+				NumRows:        100,
+			}},
+		}, nil
+	}).Once()
+	mp.EXPECT().CreateCollectionFromSnapshot(mock.Anything, mock.Anything).Return(&proxypb.RestoreCollectionsResponse{
+		Status: merr.Status(merr.WrapErrPrivilegeNotPermitted("mock")),
+	}, nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	t.Run("create", func(t *testing.T) {
+		body := []byte(`{"collectionName": "book", "snapshotName": "snapshot", "targetCollectionName": "book_clone"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, FromSnapshotAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"code":200,"data":[{"collectionName":"book_clone","dbName":"default","numRows":100,"segmentIds":[1000]}]}`, w.Body.String())
+	})
+
+	t.Run("not permitted", func(t *testing.T) {
+		body := []byte(`{"collectionName": "book", "snapshotName": "snapshot", "targetCollectionName": "book_clone"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, FromSnapshotAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, merr.Code(merr.ErrPrivilegeNotPermitted), returnBody.Code)
+	})
+
+	t.Run("missing target", func(t *testing.T) {
+		body := []byte(`{"collectionName": "book", "snapshotName": "snapshot"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, FromSnapshotAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.NotEqual(t, int32(http.StatusOK), returnBody.Code)
+	})
+}
+
+func TestRestoreBackupV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().RestoreBackup(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.RestoreBackupRequest) (*proxypb.RestoreCollectionsResponse, error) {
+		assert.Equal(t, "backup", req.GetBackupName())
+		assert.Equal(t, []string{"book"}, req.GetCollectionNames())
+		assert.Equal(t, "restored", req.GetTargetDbName())
+		return &proxypb.RestoreCollectionsResponse{
+			Status: commonSuccessStatus,
+			Collections: []*proxypb.RestoredCollection{{
+				DbName:         "restored",
+				CollectionName: "book",
+				SegmentIDs:     []int64{1000},
+				NumRows:        100,
+			}},
+		}, nil
+	}).Once()
+	mp.EXPECT().RestoreBackup(mock.Anything, mock.Anything).Return(&proxypb.RestoreCollectionsResponse{
+		Status: merr.Status(merr.WrapErrPrivilegeNotPermitted("mock")),
+	}, nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	t.Run("restore", func(t *testing.T) {
+		body := []byte(`{"backupName": "backup", "collectionNames": ["book"], "targetDbName": "restored"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(BackupCategory, RestoreAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"code":200,"data":[{"collectionName":"book","dbName":"restored","numRows":100,"segmentIds":[1000]}]}`, w.Body.String())
+	})
+
+	t.Run("not permitted", func(t *testing.T) {
+		body := []byte(`{"backupName": "backup"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(BackupCategory, RestoreAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, merr.Code(merr.ErrPrivilegeNotPermitted), returnBody.Code)
+	})
+
+	t.Run("missing backup name", func(t *testing.T) {
+		body := []byte(`{"targetDbName": "restored"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(BackupCategory, RestoreAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.NotEqual(t, int32(http.StatusOK), returnBody.Code)
+	})
+}
+
+func TestPromoteReplicaV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().PromoteReplica(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.PromoteReplicaRequest) (*proxypb.RestoreCollectionsResponse, error) {
+		assert.Equal(t, "db", req.GetDbName())
+		assert.Equal(t, "book", req.GetCollectionName())
+		return &proxypb.RestoreCollectionsResponse{
+			Status: commonSuccessStatus,
+			Collections: []*proxypb.RestoredCollection{{
+				DbName:         "db",
+				CollectionName: "book",
+				SegmentIDs:     []int64{1000},
+				NumRows:        100,
+			}},
+		}, nil
+	}).Once()
+	mp.EXPECT().PromoteReplica(mock.Anything, mock.Anything).Return(&proxypb.RestoreCollectionsResponse{
+		Status: merr.Status(merr.WrapErrPrivilegeNotPermitted("mock")),
+	}, nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	t.Run("promote", func(t *testing.T) {
+		body := []byte(`{"dbName": "db", "collectionName": "book"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(ReplicationCategory, PromoteAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"code":200,"data":[{"collectionName":"book","dbName":"db","numRows":100,"segmentIds":[1000]}]}`, w.Body.String())
+	})
+
+	t.Run("not permitted", func(t *testing.T) {
+		body := []byte(`{}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(ReplicationCategory, PromoteAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, merr.Code(merr.ErrPrivilegeNotPermitted), returnBody.Code)
+	})
+}
+
+func TestUndropCollectionV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().UndropCollection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.UndropCollectionRequest) (*commonpb.Status, error) {
+		assert.Equal(t, DefaultCollectionName, req.GetCollectionName())
+		return commonSuccessStatus, nil
+	}).Once()
+	mp.EXPECT().UndropCollection(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrCollectionNotFound(DefaultCollectionName)), nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	t.Run("undrop", func(t *testing.T) {
+		body := []byte(`{"collectionName": "` + DefaultCollectionName + `"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, UndropAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		body := []byte(`{"collectionName": "` + DefaultCollectionName + `"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, UndropAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, merr.Code(merr.ErrCollectionNotFound), returnBody.Code)
+	})
+
+	t.Run("missing collection name", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, UndropAction), bytes.NewReader([]byte(`{}`)))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.NotEqual(t, int32(http.StatusOK), returnBody.Code)
+	})
+}
+
+func TestGetCollectionLoadProgressV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().GetLoadingProgressDetail(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.GetLoadingProgressDetailRequest) (*querypb.GetLoadProgressDetailResponse, error) {
+		assert.Equal(t, DefaultCollectionName, req.GetCollectionName())
+		assert.Equal(t, []string{DefaultPartitionName}, req.GetPartitionNames())
+		return &querypb.GetLoadProgressDetailResponse{
+			Status:       commonSuccessStatus,
+			CollectionID: 1,
+			Progress:     50,
+			Stages: []*querypb.LoadStageProgress{
+				{Stage: querypb.LoadStage_SegmentsLoaded, Finished: 1, Total: 2, Progress: 50},
+			},
+			Nodes: []*querypb.NodeLoadProgress{
+				{NodeID: 1, DispatchedSegmentNum: 2, LoadedSegmentNum: 1},
+			},
+		}, nil
+	}).Once()
+	mp.EXPECT().GetLoadingProgressDetail(mock.Anything, mock.Anything).Return(&querypb.GetLoadProgressDetailResponse{
+		Status: merr.Status(merr.WrapErrCollectionNotLoaded(DefaultCollectionName)),
+	}, nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	t.Run("get load progress", func(t *testing.T) {
+		body := []byte(`{"collectionName": "` + DefaultCollectionName + `", "partitionNames": ["` + DefaultPartitionName + `"]}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, LoadProgressAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+		var returnData struct {
+			Data map[string]any `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &returnData))
+		assert.EqualValues(t, 50, returnData.Data[HTTPReturnLoadProgress])
+		assert.Len(t, returnData.Data["stages"], 1)
+		assert.Len(t, returnData.Data["nodes"], 1)
+	})
+
+	t.Run("not loaded", func(t *testing.T) {
+		body := []byte(`{"collectionName": "` + DefaultCollectionName + `"}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(CollectionCategory, LoadProgressAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, merr.Code(merr.ErrCollectionNotLoaded), returnBody.Code)
+	})
+}
+
+func TestDescribeIndexProgressV2(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().DescribeIndex(mock.Anything, mock.Anything).Return(&DefaultDescIndexesReqp, nil).Twice()
+	mp.EXPECT().DescribeIndexProgress(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.DescribeIndexProgressRequest) (*indexpb.DescribeIndexResponse, error) {
+		assert.Equal(t, DefaultCollectionName, req.GetCollectionName())
+		assert.Equal(t, DefaultIndexName, req.GetIndexName())
+		return &indexpb.DescribeIndexResponse{
+			Status: commonSuccessStatus,
+			IndexInfos: []*indexpb.IndexInfo{{
+				IndexName:                 DefaultIndexName,
+				EstimatedRemainingSeconds: 10,
+				SegmentProgresses: []*indexpb.SegmentIndexProgress{
+					{SegmentID: 100, NumRows: 1000, IndexedRows: 500, EstimatedRemainingSeconds: 10},
+				},
+			}},
+		}, nil
+	}).Once()
+	mp.EXPECT().DescribeIndexProgress(mock.Anything, mock.Anything).Return(&indexpb.DescribeIndexResponse{
+		Status: merr.Status(merr.WrapErrIndexNotFound(DefaultIndexName)),
+	}, nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	t.Run("with segment progress", func(t *testing.T) {
+		body := []byte(`{"collectionName": "` + DefaultCollectionName + `", "indexName": "` + DefaultIndexName + `", "withSegmentProgress": true}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(IndexCategory, DescribeAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		var returnBody struct {
+			Code int32            `json:"code"`
+			Data []map[string]any `json:"data"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &returnBody))
+		assert.Equal(t, int32(http.StatusOK), returnBody.Code)
+		assert.Len(t, returnBody.Data, 1)
+		assert.EqualValues(t, 10, returnBody.Data[0]["estimatedRemainingSeconds"])
+		assert.Len(t, returnBody.Data[0]["segmentProgresses"], 1)
+	})
+
+	t.Run("progress failed", func(t *testing.T) {
+		body := []byte(`{"collectionName": "` + DefaultCollectionName + `", "indexName": "` + DefaultIndexName + `", "withSegmentProgress": true}`)
+		req := httptest.NewRequest(http.MethodPost, versionalV2(IndexCategory, DescribeAction), bytes.NewReader(body))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		returnBody := &ReturnErrMsg{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), returnBody))
+		assert.Equal(t, merr.Code(merr.ErrIndexNotFound), returnBody.Code)
+	})
+}
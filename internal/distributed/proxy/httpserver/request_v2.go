@@ -1,12 +1,14 @@
 package httpserver
 
 import (
+	"encoding/json"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 )
 
 type DatabaseReq struct {
@@ -15,6 +17,13 @@ type DatabaseReq struct {
 
 func (req *DatabaseReq) GetDbName() string { return req.DbName }
 
+type DatabasePropertiesReq struct {
+	DbName     string            `json:"dbName" binding:"required"`
+	Properties map[string]string `json:"properties" binding:"required"`
+}
+
+func (req *DatabasePropertiesReq) GetDbName() string { return req.DbName }
+
 type CollectionNameReq struct {
 	DbName         string   `json:"dbName"`
 	CollectionName string   `json:"collectionName" binding:"required"`
@@ -108,10 +117,27 @@ type QueryReqV2 struct {
 	Filter         string   `json:"filter" binding:"required"`
 	Limit          int32    `json:"limit"`
 	Offset         int32    `json:"offset"`
+	ResultFormat   string   `json:"resultFormat"`
+	// FilterParams are the values of the placeholders in the filter template, such as `age > {age}`
+	FilterParams json.RawMessage `json:"filterParams"`
+	// GroupByField groups the entities before aggregating, such as outputFields ["color", "avg(price)"]
+	GroupByField string `json:"groupingField"`
 }
 
 func (req *QueryReqV2) GetDbName() string { return req.DbName }
 
+type QueryIteratorReqV2 struct {
+	DbName         string   `json:"dbName"`
+	CollectionName string   `json:"collectionName" binding:"required"`
+	PartitionNames []string `json:"partitionNames"`
+	OutputFields   []string `json:"outputFields"`
+	Filter         string   `json:"filter"`
+	BatchSize      int32    `json:"batchSize"`
+	Cursor         string   `json:"cursor"`
+}
+
+func (req *QueryIteratorReqV2) GetDbName() string { return req.DbName }
+
 type CollectionIDReq struct {
 	DbName         string      `json:"dbName"`
 	CollectionName string      `json:"collectionName" binding:"required"`
@@ -153,10 +179,56 @@ type SearchReqV2 struct {
 	Offset         int32              `json:"offset"`
 	OutputFields   []string           `json:"outputFields"`
 	Params         map[string]float64 `json:"params"`
+	ResultFormat   string             `json:"resultFormat"`
+	PartialResult  bool               `json:"partialResult"`
+	FilterParams   json.RawMessage    `json:"filterParams"`
+	// GroupSize is the max number of entities returned for each group of the grouping field
+	GroupSize int32 `json:"groupSize"`
+	// GroupOrderBy orders the entities inside each group by a scalar field, such as "chunk_id desc"
+	GroupOrderBy string `json:"groupOrderBy"`
+	// SearchProfile is the name of the search profile in the collection properties
+	SearchProfile string `json:"searchProfile"`
+	// DistanceHistogram is the buckets number of the distances histogram returned by the range search instead of the data
+	DistanceHistogram int `json:"distanceHistogram"`
 }
 
 func (req *SearchReqV2) GetDbName() string { return req.DbName }
 
+type SearchIteratorReqV2 struct {
+	DbName         string             `json:"dbName"`
+	CollectionName string             `json:"collectionName" binding:"required"`
+	Data           []interface{}      `json:"data" binding:"required"`
+	AnnsField      string             `json:"annsField"`
+	PartitionNames []string           `json:"partitionNames"`
+	Filter         string             `json:"filter"`
+	BatchSize      int32              `json:"batchSize"`
+	OutputFields   []string           `json:"outputFields"`
+	Params         map[string]float64 `json:"params"`
+	Cursor         string             `json:"cursor"`
+}
+
+func (req *SearchIteratorReqV2) GetDbName() string { return req.DbName }
+
+type BatchSubSearchReq struct {
+	CollectionName string   `json:"collectionName" binding:"required"`
+	PartitionNames []string `json:"partitionNames"`
+	Filter         string   `json:"filter"`
+	Limit          int32    `json:"limit"`
+	OutputFields   []string `json:"outputFields"`
+}
+
+// BatchSearchReq searches the same vector in multiple collections, each with its own filter.
+type BatchSearchReq struct {
+	DbName    string              `json:"dbName"`
+	Data      []interface{}       `json:"data" binding:"required"`
+	AnnsField string              `json:"annsField"`
+	Limit     int32               `json:"limit"`
+	Params    map[string]float64  `json:"params"`
+	Search    []BatchSubSearchReq `json:"search"`
+}
+
+func (req *BatchSearchReq) GetDbName() string { return req.DbName }
+
 type Rand struct {
 	Strategy string                 `json:"strategy"`
 	Params   map[string]interface{} `json:"params"`
@@ -282,6 +354,8 @@ type IndexReq struct {
 	DbName         string `json:"dbName"`
 	CollectionName string `json:"collectionName" binding:"required"`
 	IndexName      string `json:"indexName" binding:"required"`
+	// describe the build progress of each segment as well, only used by describing index
+	WithSegmentProgress bool `json:"withSegmentProgress"`
 }
 
 func (req *IndexReq) GetDbName() string { return req.DbName }
@@ -349,6 +423,141 @@ func (req *AliasCollectionReq) GetAliasName() string {
 	return req.AliasName
 }
 
+type CompactReq struct {
+	DbName          string `json:"dbName"`
+	CollectionName  string `json:"collectionName" binding:"required"`
+	MajorCompaction bool   `json:"majorCompaction"`
+}
+
+func (req *CompactReq) GetDbName() string { return req.DbName }
+
+func (req *CompactReq) GetCollectionName() string {
+	return req.CollectionName
+}
+
+type CollectionPropertiesReq struct {
+	DbName         string            `json:"dbName"`
+	CollectionName string            `json:"collectionName" binding:"required"`
+	Properties     map[string]string `json:"properties" binding:"required"`
+}
+
+func (req *CollectionPropertiesReq) GetDbName() string {
+	return req.DbName
+}
+
+func (req *CollectionPropertiesReq) GetCollectionName() string {
+	return req.CollectionName
+}
+
+type CollectionFromSnapshotReq struct {
+	DbName               string `json:"dbName"`
+	CollectionName       string `json:"collectionName" binding:"required"`
+	SnapshotName         string `json:"snapshotName" binding:"required"`
+	TargetCollectionName string `json:"targetCollectionName" binding:"required"`
+}
+
+func (req *CollectionFromSnapshotReq) GetDbName() string { return req.DbName }
+
+type BackupRestoreReq struct {
+	BackupName      string   `json:"backupName" binding:"required"`
+	DbName          string   `json:"dbName"`
+	CollectionNames []string `json:"collectionNames"`
+	TargetDbName    string   `json:"targetDbName"`
+}
+
+func (req *BackupRestoreReq) GetDbName() string { return req.DbName }
+
+type ReplicaPromoteReq struct {
+	DbName string `json:"dbName"`
+	// all the replicas are promoted if not specified
+	CollectionName string `json:"collectionName"`
+}
+
+func (req *ReplicaPromoteReq) GetDbName() string { return req.DbName }
+
+type AddCollectionFieldReq struct {
+	DbName            string            `json:"dbName"`
+	CollectionName    string            `json:"collectionName" binding:"required"`
+	FieldName         string            `json:"fieldName" binding:"required"`
+	DataType          string            `json:"dataType" binding:"required"`
+	DefaultValue      interface{}       `json:"defaultValue"`
+	Description       string            `json:"description"`
+	ElementTypeParams map[string]string `json:"elementTypeParams"`
+}
+
+func (req *AddCollectionFieldReq) GetDbName() string {
+	return req.DbName
+}
+
+func (req *AddCollectionFieldReq) GetCollectionName() string {
+	return req.CollectionName
+}
+
+type DDLJobIDReq struct {
+	DbName string `json:"dbName"`
+	JobID  int64  `json:"jobId" binding:"required"`
+}
+
+func (req *DDLJobIDReq) GetDbName() string {
+	return req.DbName
+}
+
+type CompactionIDReq struct {
+	JobID int64 `json:"jobId" binding:"required"`
+}
+
+type APIKeyReq struct {
+	Name     string   `json:"name" binding:"required"`
+	DbNames  []string `json:"dbNames"`
+	Roles    []string `json:"roles"`
+	ExpireAt int64    `json:"expireAt"`
+}
+
+type APIKeyNameReq struct {
+	Name     string `json:"name" binding:"required"`
+	ExpireAt int64  `json:"expireAt"`
+}
+
+type ResourceGroupLimit struct {
+	NodeNum int32 `json:"nodeNum"`
+}
+
+type ResourceGroupTransfer struct {
+	ResourceGroup string `json:"resourceGroup"`
+}
+
+type ResourceGroupConfig struct {
+	Requests     *ResourceGroupLimit      `json:"requests"`
+	Limits       *ResourceGroupLimit      `json:"limits"`
+	TransferFrom []*ResourceGroupTransfer `json:"transferFrom"`
+	TransferTo   []*ResourceGroupTransfer `json:"transferTo"`
+}
+
+type ResourceGroupReq struct {
+	Name   string               `json:"name" binding:"required"`
+	Config *ResourceGroupConfig `json:"config"`
+}
+
+type TransferNodeReq struct {
+	SourceRgName string `json:"sourceRgName" binding:"required"`
+	TargetRgName string `json:"targetRgName" binding:"required"`
+	NumNode      int32  `json:"numNode" binding:"required"`
+}
+
+type TransferReplicaReq struct {
+	DbName         string `json:"dbName"`
+	SourceRgName   string `json:"sourceRgName" binding:"required"`
+	TargetRgName   string `json:"targetRgName" binding:"required"`
+	CollectionName string `json:"collectionName" binding:"required"`
+	ReplicaNum     int64  `json:"replicaNum" binding:"required"`
+}
+
+func (req *TransferReplicaReq) GetDbName() string { return req.DbName }
+
+func (req *TransferReplicaReq) GetCollectionName() string {
+	return req.CollectionName
+}
+
 func wrapperReturnHas(has bool) gin.H {
 	return gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{HTTPReturnHas: has}}
 }
@@ -374,6 +583,24 @@ func wrapperReturnRowCount(pairs []*commonpb.KeyValuePair) gin.H {
 	return gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{HTTPReturnRowCount: rowCount}}
 }
 
+func wrapperAPIKeyInfo(info *proxypb.APIKeyInfo) gin.H {
+	return gin.H{
+		"name":      info.GetName(),
+		"dbNames":   info.GetDbNames(),
+		"roles":     info.GetRoles(),
+		"expireAt":  info.GetExpireAt(),
+		"createdAt": info.GetCreatedAt(),
+		"rotatedAt": info.GetRotatedAt(),
+	}
+}
+
+// wrapperReturnAPIKey returns the key along with its info, the key is only returned once.
+func wrapperReturnAPIKey(resp *proxypb.APIKeyResponse) gin.H {
+	data := wrapperAPIKeyInfo(resp.GetInfo())
+	data["key"] = resp.GetKey()
+	return gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: data}
+}
+
 func wrapperReturnDefault() gin.H {
 	return gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{}}
 }
@@ -2,6 +2,7 @@ package httpserver
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
@@ -19,6 +20,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util"
@@ -47,6 +49,15 @@ func GetAuthorization(c *gin.Context) string {
 	return strings.TrimPrefix(auth, "Bearer ")
 }
 
+// withOIDCRoles carries the roles of the oidc token the request is authenticated by in the context.
+func withOIDCRoles(ctx context.Context, c *gin.Context) context.Context {
+	roles, ok := c.Get(ContextOIDCRoles)
+	if !ok {
+		return ctx
+	}
+	return proxy.WithOIDCRoles(ctx, roles.([]string))
+}
+
 // find the primary field of collection
 func getPrimaryField(schema *schemapb.CollectionSchema) (*schemapb.FieldSchema, bool) {
 	for _, field := range schema.Fields {
@@ -509,6 +520,48 @@ func getDim(field *schemapb.FieldSchema) (int64, error) {
 	return int64(dim), nil
 }
 
+// convertDefaultValue converts the default value in the request body according to the data type of the field,
+// the value could be either the json value or the string of it.
+func convertDefaultValue(dataType schemapb.DataType, value interface{}) (*schemapb.ValueField, error) {
+	if value == nil {
+		return nil, merr.WrapErrParameterMissing("defaultValue")
+	}
+	var err error
+	defaultValue := &schemapb.ValueField{}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		var v bool
+		v, err = cast.ToBoolE(value)
+		defaultValue.Data = &schemapb.ValueField_BoolData{BoolData: v}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		var v int32
+		v, err = cast.ToInt32E(value)
+		defaultValue.Data = &schemapb.ValueField_IntData{IntData: v}
+	case schemapb.DataType_Int64:
+		var v int64
+		v, err = cast.ToInt64E(value)
+		defaultValue.Data = &schemapb.ValueField_LongData{LongData: v}
+	case schemapb.DataType_Float:
+		var v float32
+		v, err = cast.ToFloat32E(value)
+		defaultValue.Data = &schemapb.ValueField_FloatData{FloatData: v}
+	case schemapb.DataType_Double:
+		var v float64
+		v, err = cast.ToFloat64E(value)
+		defaultValue.Data = &schemapb.ValueField_DoubleData{DoubleData: v}
+	case schemapb.DataType_VarChar:
+		var v string
+		v, err = cast.ToStringE(value)
+		defaultValue.Data = &schemapb.ValueField_StringData{StringData: v}
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("field of type %s could not be added", dataType.String())
+	}
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid default value %v of type %s, %s", value, dataType.String(), err.Error())
+	}
+	return defaultValue, nil
+}
+
 func convertFloatVectorToArray(vector [][]float32, dim int64) ([]float32, error) {
 	floatArray := make([]float32, 0)
 	for _, arr := range vector {
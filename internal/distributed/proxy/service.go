@@ -44,6 +44,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -55,8 +56,10 @@ import (
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/distributed/utils"
 	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog"
 	"github.com/milvus-io/milvus/internal/proxy/connection"
@@ -136,8 +139,18 @@ func authenticate(c *gin.Context) {
 		}
 	}
 	rawToken := httpserver.GetAuthorization(c)
-	if rawToken != "" && !strings.Contains(rawToken, util.CredentialSeperator) {
-		user, err := proxy.VerifyAPIKey(rawToken)
+	if proxy.IsOIDCToken(rawToken) {
+		// the oidc id token issued by the sso provider, the roles mapped from the claims are granted to the request only
+		user, roles, err := proxy.VerifyOIDCToken(c, rawToken)
+		if err == nil {
+			c.Set(httpserver.ContextUsername, user)
+			c.Set(httpserver.ContextOIDCRoles, roles)
+			return
+		}
+		log.Warn("fail to verify oidc token", zap.Error(err))
+	} else if rawToken != "" && !strings.Contains(rawToken, util.CredentialSeperator) {
+		// the api keys managed by rootcoord are verified like the grpc requests, the others by the hook
+		user, err := proxy.VerifyToken(c, rawToken)
 		if err == nil {
 			c.Set(httpserver.ContextUsername, user)
 			return
@@ -277,6 +290,7 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 			proxy.UnaryServerHookInterceptor(),
 			proxy.UnaryServerInterceptor(proxy.PrivilegeInterceptor),
 			logutil.UnaryTraceLoggerInterceptor,
+			proxy.UserRateLimitInterceptor(proxy.NewUserRateLimiter()),
 			proxy.RateLimitInterceptor(limiter),
 			accesslog.UnaryUpdateAccessInfoInterceptor,
 			proxy.TraceLogInterceptor,
@@ -340,6 +354,9 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcExternalServer, s)
+	if Params.ReflectionEnabled.GetAsBool() {
+		reflection.Register(s.grpcExternalServer)
+	}
 	errChan <- nil
 
 	log.Debug("create Proxy grpc server",
@@ -404,6 +421,9 @@ func (s *Server) startInternalGrpc(grpcPort int, errChan chan error) {
 		)))
 	proxypb.RegisterProxyServer(s.grpcInternalServer, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcInternalServer, s)
+	if Params.ReflectionEnabled.GetAsBool() {
+		reflection.Register(s.grpcInternalServer)
+	}
 	errChan <- nil
 
 	log.Info("create Proxy internal grpc server",
@@ -1221,3 +1241,79 @@ func (s *Server) GetImportProgress(ctx context.Context, req *internalpb.GetImpor
 func (s *Server) ListImports(ctx context.Context, req *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error) {
 	return s.proxy.ListImports(ctx, req)
 }
+
+func (s *Server) SearchIterator(ctx context.Context, req *proxypb.SearchIteratorRequest) (*proxypb.SearchIteratorResponse, error) {
+	return s.proxy.SearchIterator(ctx, req)
+}
+
+func (s *Server) QueryIterator(ctx context.Context, req *proxypb.QueryIteratorRequest) (*proxypb.QueryIteratorResponse, error) {
+	return s.proxy.QueryIterator(ctx, req)
+}
+
+func (s *Server) BatchSearch(ctx context.Context, req *proxypb.BatchSearchRequest) (*proxypb.BatchSearchResponse, error) {
+	return s.proxy.BatchSearch(ctx, req)
+}
+
+func (s *Server) CreateAPIKey(ctx context.Context, req *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	return s.proxy.CreateAPIKey(ctx, req)
+}
+
+func (s *Server) RotateAPIKey(ctx context.Context, req *proxypb.RotateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	return s.proxy.RotateAPIKey(ctx, req)
+}
+
+func (s *Server) RevokeAPIKey(ctx context.Context, req *proxypb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return s.proxy.RevokeAPIKey(ctx, req)
+}
+
+func (s *Server) ListAPIKeys(ctx context.Context, req *proxypb.ListAPIKeysRequest) (*proxypb.ListAPIKeysResponse, error) {
+	return s.proxy.ListAPIKeys(ctx, req)
+}
+
+func (s *Server) DropCollectionAsync(ctx context.Context, req *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	return s.proxy.DropCollectionAsync(ctx, req)
+}
+
+func (s *Server) AlterCollectionAsync(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	return s.proxy.AlterCollectionAsync(ctx, req)
+}
+
+func (s *Server) DescribeDDLJob(ctx context.Context, req *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error) {
+	return s.proxy.DescribeDDLJob(ctx, req)
+}
+
+func (s *Server) AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddCollectionField(ctx, req)
+}
+
+func (s *Server) AlterDatabase(ctx context.Context, req *proxypb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterDatabase(ctx, req)
+}
+
+func (s *Server) UndropCollection(ctx context.Context, req *proxypb.UndropCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.UndropCollection(ctx, req)
+}
+
+func (s *Server) GetLoadingProgressDetail(ctx context.Context, req *proxypb.GetLoadingProgressDetailRequest) (*querypb.GetLoadProgressDetailResponse, error) {
+	return s.proxy.GetLoadingProgressDetail(ctx, req)
+}
+
+func (s *Server) DescribeIndexProgress(ctx context.Context, req *proxypb.DescribeIndexProgressRequest) (*indexpb.DescribeIndexResponse, error) {
+	return s.proxy.DescribeIndexProgress(ctx, req)
+}
+
+func (s *Server) SubscribeStandingQuery(req *proxypb.SubscribeStandingQueryRequest, srv proxypb.Proxy_SubscribeStandingQueryServer) error {
+	return s.proxy.SubscribeStandingQuery(req, srv)
+}
+
+func (s *Server) CreateCollectionFromSnapshot(ctx context.Context, req *proxypb.CreateCollectionFromSnapshotRequest) (*proxypb.RestoreCollectionsResponse, error) {
+	return s.proxy.CreateCollectionFromSnapshot(ctx, req)
+}
+
+func (s *Server) RestoreBackup(ctx context.Context, req *proxypb.RestoreBackupRequest) (*proxypb.RestoreCollectionsResponse, error) {
+	return s.proxy.RestoreBackup(ctx, req)
+}
+
+func (s *Server) PromoteReplica(ctx context.Context, req *proxypb.PromoteReplicaRequest) (*proxypb.RestoreCollectionsResponse, error) {
+	return s.proxy.PromoteReplica(ctx, req)
+}
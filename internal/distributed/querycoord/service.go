@@ -255,6 +255,7 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 			}),
 		)))
 	querypb.RegisterQueryCoordServer(s.grpcServer, s)
+	utils.RegisterHealthAndReflection(s.grpcServer, s, Params.ReflectionEnabled.GetAsBool())
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
@@ -482,3 +483,15 @@ func (s *Server) TransferChannel(ctx context.Context, req *querypb.TransferChann
 func (s *Server) CheckQueryNodeDistribution(ctx context.Context, req *querypb.CheckQueryNodeDistributionRequest) (*commonpb.Status, error) {
 	return s.queryCoord.CheckQueryNodeDistribution(ctx, req)
 }
+
+func (s *Server) GetLoadProgressDetail(ctx context.Context, req *querypb.GetLoadProgressDetailRequest) (*querypb.GetLoadProgressDetailResponse, error) {
+	return s.queryCoord.GetLoadProgressDetail(ctx, req)
+}
+
+func (s *Server) FreezeCollection(ctx context.Context, req *querypb.FreezeCollectionRequest) (*commonpb.Status, error) {
+	return s.queryCoord.FreezeCollection(ctx, req)
+}
+
+func (s *Server) UnfreezeCollection(ctx context.Context, req *querypb.UnfreezeCollectionRequest) (*commonpb.Status, error) {
+	return s.queryCoord.UnfreezeCollection(ctx, req)
+}
@@ -231,6 +231,20 @@ func (c *Client) QueryStream(ctx context.Context, req *querypb.QueryRequest, _ .
 	return ret.(querypb.QueryNode_QueryStreamClient), nil
 }
 
+func (c *Client) SubscribeStandingQuery(ctx context.Context, req *querypb.SubscribeStandingQueryRequest, _ ...grpc.CallOption) (querypb.QueryNode_SubscribeStandingQueryClient, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client querypb.QueryNodeClient) (any, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+
+		return client.SubscribeStandingQuery(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(querypb.QueryNode_SubscribeStandingQueryClient), nil
+}
+
 func (c *Client) QuerySegments(ctx context.Context, req *querypb.QueryRequest, _ ...grpc.CallOption) (*internalpb.RetrieveResults, error) {
 	return wrapGrpcCall(ctx, c, func(client querypb.QueryNodeClient) (*internalpb.RetrieveResults, error) {
 		return client.QuerySegments(ctx, req)
@@ -333,6 +347,18 @@ func (c *Client) Delete(ctx context.Context, req *querypb.DeleteRequest, _ ...gr
 	})
 }
 
+// SwitchShardLeader makes the QueryNode take over the shard leadership of the channel.
+func (c *Client) SwitchShardLeader(ctx context.Context, req *querypb.SwitchShardLeaderRequest, _ ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(c.nodeID),
+	)
+	return wrapGrpcCall(ctx, c, func(client querypb.QueryNodeClient) (*commonpb.Status, error) {
+		return client.SwitchShardLeader(ctx, req)
+	})
+}
+
 // HybridSearch performs replica hybrid search tasks in QueryNode.
 func (c *Client) HybridSearch(ctx context.Context, req *querypb.HybridSearchRequest, _ ...grpc.CallOption) (*querypb.HybridSearchResult, error) {
 	return wrapGrpcCall(ctx, c, func(client querypb.QueryNodeClient) (*querypb.HybridSearchResult, error) {
@@ -215,6 +215,7 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 			}),
 		)))
 	querypb.RegisterQueryNodeServer(s.grpcServer, s)
+	utils.RegisterHealthAndReflection(s.grpcServer, s, Params.ReflectionEnabled.GetAsBool())
 
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
@@ -351,6 +352,10 @@ func (s *Server) QueryStream(req *querypb.QueryRequest, srv querypb.QueryNode_Qu
 	return s.querynode.QueryStream(req, srv)
 }
 
+func (s *Server) SubscribeStandingQuery(req *querypb.SubscribeStandingQueryRequest, srv querypb.QueryNode_SubscribeStandingQueryServer) error {
+	return s.querynode.SubscribeStandingQuery(req, srv)
+}
+
 func (s *Server) QueryStreamSegments(req *querypb.QueryRequest, srv querypb.QueryNode_QueryStreamSegmentsServer) error {
 	return s.querynode.QueryStreamSegments(req, srv)
 }
@@ -388,6 +393,11 @@ func (s *Server) Delete(ctx context.Context, req *querypb.DeleteRequest) (*commo
 	return s.querynode.Delete(ctx, req)
 }
 
+// SwitchShardLeader makes the QueryNode take over the shard leadership of the channel.
+func (s *Server) SwitchShardLeader(ctx context.Context, req *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error) {
+	return s.querynode.SwitchShardLeader(ctx, req)
+}
+
 // HybridSearch performs hybrid search of streaming/historical replica on QueryNode.
 func (s *Server) HybridSearch(ctx context.Context, req *querypb.HybridSearchRequest) (*querypb.HybridSearchResult, error) {
 	return s.querynode.HybridSearch(ctx, req)
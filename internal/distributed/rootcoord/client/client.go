@@ -592,6 +592,127 @@ func (c *Client) RenameCollection(ctx context.Context, req *milvuspb.RenameColle
 	})
 }
 
+func (c *Client) AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.AddCollectionField(ctx, req)
+	})
+}
+
+func (c *Client) AlterDatabase(ctx context.Context, req *proxypb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.AlterDatabase(ctx, req)
+	})
+}
+
+func (c *Client) UndropCollection(ctx context.Context, req *proxypb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.UndropCollection(ctx, req)
+	})
+}
+
+func (c *Client) DropCollectionAsync(ctx context.Context, req *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*proxypb.DDLJobResponse, error) {
+		return client.DropCollectionAsync(ctx, req)
+	})
+}
+
+func (c *Client) AlterCollectionAsync(ctx context.Context, req *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*proxypb.DDLJobResponse, error) {
+		return client.AlterCollectionAsync(ctx, req)
+	})
+}
+
+func (c *Client) DescribeDDLJob(ctx context.Context, req *proxypb.DescribeDDLJobRequest, opts ...grpc.CallOption) (*proxypb.DescribeDDLJobResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*proxypb.DescribeDDLJobResponse, error) {
+		return client.DescribeDDLJob(ctx, req)
+	})
+}
+
+func (c *Client) CreateAPIKey(ctx context.Context, req *proxypb.CreateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*proxypb.APIKeyResponse, error) {
+		return client.CreateAPIKey(ctx, req)
+	})
+}
+
+func (c *Client) RotateAPIKey(ctx context.Context, req *proxypb.RotateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*proxypb.APIKeyResponse, error) {
+		return client.RotateAPIKey(ctx, req)
+	})
+}
+
+func (c *Client) RevokeAPIKey(ctx context.Context, req *proxypb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*commonpb.Status, error) {
+		return client.RevokeAPIKey(ctx, req)
+	})
+}
+
+func (c *Client) ListAPIKeys(ctx context.Context, req *proxypb.ListAPIKeysRequest, opts ...grpc.CallOption) (*proxypb.ListAPIKeysResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*proxypb.ListAPIKeysResponse, error) {
+		return client.ListAPIKeys(ctx, req)
+	})
+}
+
+func (c *Client) VerifyAPIKey(ctx context.Context, req *rootcoordpb.VerifyAPIKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.VerifyAPIKeyResponse, error) {
+	req = typeutil.Clone(req)
+	commonpbutil.UpdateMsgBase(
+		req.GetBase(),
+		commonpbutil.FillMsgBaseFromClient(paramtable.GetNodeID(), commonpbutil.WithTargetID(c.grpcClient.GetNodeID())),
+	)
+	return wrapGrpcCall(ctx, c, func(client rootcoordpb.RootCoordClient) (*rootcoordpb.VerifyAPIKeyResponse, error) {
+		return client.VerifyAPIKey(ctx, req)
+	})
+}
+
 func (c *Client) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	in = typeutil.Clone(in)
 	commonpbutil.UpdateMsgBase(
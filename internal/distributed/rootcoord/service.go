@@ -293,6 +293,7 @@ func (s *Server) startGrpcLoop(port int) {
 			}),
 		)))
 	rootcoordpb.RegisterRootCoordServer(s.grpcServer, s)
+	utils.RegisterHealthAndReflection(s.grpcServer, s, Params.ReflectionEnabled.GetAsBool())
 
 	go funcutil.CheckGrpcReady(ctx, s.grpcErrChan)
 	if err := s.grpcServer.Serve(lis); err != nil {
@@ -520,3 +521,47 @@ func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCol
 func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.RenameCollection(ctx, request)
 }
+
+func (s *Server) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddCollectionField(ctx, request)
+}
+
+func (s *Server) AlterDatabase(ctx context.Context, request *proxypb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterDatabase(ctx, request)
+}
+
+func (s *Server) UndropCollection(ctx context.Context, request *proxypb.UndropCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.UndropCollection(ctx, request)
+}
+
+func (s *Server) DropCollectionAsync(ctx context.Context, request *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	return s.rootCoord.DropCollectionAsync(ctx, request)
+}
+
+func (s *Server) AlterCollectionAsync(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	return s.rootCoord.AlterCollectionAsync(ctx, request)
+}
+
+func (s *Server) DescribeDDLJob(ctx context.Context, request *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error) {
+	return s.rootCoord.DescribeDDLJob(ctx, request)
+}
+
+func (s *Server) CreateAPIKey(ctx context.Context, request *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	return s.rootCoord.CreateAPIKey(ctx, request)
+}
+
+func (s *Server) RotateAPIKey(ctx context.Context, request *proxypb.RotateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	return s.rootCoord.RotateAPIKey(ctx, request)
+}
+
+func (s *Server) RevokeAPIKey(ctx context.Context, request *proxypb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RevokeAPIKey(ctx, request)
+}
+
+func (s *Server) ListAPIKeys(ctx context.Context, request *proxypb.ListAPIKeysRequest) (*proxypb.ListAPIKeysResponse, error) {
+	return s.rootCoord.ListAPIKeys(ctx, request)
+}
+
+func (s *Server) VerifyAPIKey(ctx context.Context, request *rootcoordpb.VerifyAPIKeyRequest) (*rootcoordpb.VerifyAPIKeyResponse, error) {
+	return s.rootCoord.VerifyAPIKey(ctx, request)
+}
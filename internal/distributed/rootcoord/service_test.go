@@ -31,6 +31,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/types"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
@@ -62,6 +64,50 @@ func (m *mockCore) RenameCollection(ctx context.Context, request *milvuspb.Renam
 	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
 }
 
+func (m *mockCore) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockCore) AlterDatabase(ctx context.Context, request *proxypb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockCore) UndropCollection(ctx context.Context, request *proxypb.UndropCollectionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockCore) DropCollectionAsync(ctx context.Context, request *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	return &proxypb.DDLJobResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (m *mockCore) AlterCollectionAsync(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	return &proxypb.DDLJobResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (m *mockCore) DescribeDDLJob(ctx context.Context, request *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error) {
+	return &proxypb.DescribeDDLJobResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (m *mockCore) CreateAPIKey(ctx context.Context, request *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	return &proxypb.APIKeyResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (m *mockCore) RotateAPIKey(ctx context.Context, request *proxypb.RotateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	return &proxypb.APIKeyResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (m *mockCore) RevokeAPIKey(ctx context.Context, request *proxypb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (m *mockCore) ListAPIKeys(ctx context.Context, request *proxypb.ListAPIKeysRequest) (*proxypb.ListAPIKeysResponse, error) {
+	return &proxypb.ListAPIKeysResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
+func (m *mockCore) VerifyAPIKey(ctx context.Context, request *rootcoordpb.VerifyAPIKeyRequest) (*rootcoordpb.VerifyAPIKeyResponse, error) {
+	return &rootcoordpb.VerifyAPIKeyResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
+}
+
 func (m *mockCore) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{
 		IsHealthy: true,
@@ -180,6 +226,61 @@ func TestRun(t *testing.T) {
 			assert.NoError(t, err)
 		})
 
+		t.Run("AddCollectionField", func(t *testing.T) {
+			_, err := svr.AddCollectionField(ctx, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("AlterDatabase", func(t *testing.T) {
+			_, err := svr.AlterDatabase(ctx, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("UndropCollection", func(t *testing.T) {
+			_, err := svr.UndropCollection(ctx, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("DropCollectionAsync", func(t *testing.T) {
+			_, err := svr.DropCollectionAsync(ctx, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("AlterCollectionAsync", func(t *testing.T) {
+			_, err := svr.AlterCollectionAsync(ctx, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("DescribeDDLJob", func(t *testing.T) {
+			_, err := svr.DescribeDDLJob(ctx, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("CreateAPIKey", func(t *testing.T) {
+			_, err := svr.CreateAPIKey(ctx, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("RotateAPIKey", func(t *testing.T) {
+			_, err := svr.RotateAPIKey(ctx, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("RevokeAPIKey", func(t *testing.T) {
+			_, err := svr.RevokeAPIKey(ctx, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("ListAPIKeys", func(t *testing.T) {
+			_, err := svr.ListAPIKeys(ctx, nil)
+			assert.NoError(t, err)
+		})
+
+		t.Run("VerifyAPIKey", func(t *testing.T) {
+			_, err := svr.VerifyAPIKey(ctx, nil)
+			assert.NoError(t, err)
+		})
+
 		t.Run("CreateDatabase", func(t *testing.T) {
 			ret, err := svr.CreateDatabase(ctx, nil)
 			assert.Nil(t, err)
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const defaultHealthWatchInterval = time.Second
+
+// ComponentStatesGetter is the component served by the grpc server.
+type ComponentStatesGetter interface {
+	GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)
+}
+
+// HealthServer implements the grpc health checking of a component,
+// the status is SERVING only if the component is healthy, which means ready to serve rather than only alive.
+// The services registered on the grpc server could be checked by name, or by "" for the whole server.
+type HealthServer struct {
+	grpc_health_v1.UnimplementedHealthServer
+
+	services      typeutil.Set[string]
+	component     ComponentStatesGetter
+	watchInterval time.Duration
+}
+
+func NewHealthServer(component ComponentStatesGetter, services ...string) *HealthServer {
+	return &HealthServer{
+		services:      typeutil.NewSet(services...),
+		component:     component,
+		watchInterval: defaultHealthWatchInterval,
+	}
+}
+
+func (s *HealthServer) servingStatus(ctx context.Context) grpc_health_v1.HealthCheckResponse_ServingStatus {
+	states, err := s.component.GetComponentStates(ctx, &milvuspb.GetComponentStatesRequest{})
+	if err := merr.CheckRPCCall(states, err); err != nil {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	if states.GetState().GetStateCode() != commonpb.StateCode_Healthy {
+		return grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	}
+	return grpc_health_v1.HealthCheckResponse_SERVING
+}
+
+// Check returns the serving status of the service, NotFound if the service is not served by the server.
+func (s *HealthServer) Check(ctx context.Context, req *grpc_health_v1.HealthCheckRequest) (*grpc_health_v1.HealthCheckResponse, error) {
+	if req.GetService() != "" && !s.services.Contain(req.GetService()) {
+		return nil, status.Errorf(codes.NotFound, "unknown service %s", req.GetService())
+	}
+	return &grpc_health_v1.HealthCheckResponse{Status: s.servingStatus(ctx)}, nil
+}
+
+// Watch sends the serving status of the service once it changes, until the stream closed.
+func (s *HealthServer) Watch(req *grpc_health_v1.HealthCheckRequest, stream grpc_health_v1.Health_WatchServer) error {
+	ctx := stream.Context()
+	if req.GetService() != "" && !s.services.Contain(req.GetService()) {
+		// the unknown service may be registered later as the spec, so keep watching
+		if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: grpc_health_v1.HealthCheckResponse_SERVICE_UNKNOWN}); err != nil {
+			return err
+		}
+		<-ctx.Done()
+		return status.FromContextError(ctx.Err()).Err()
+	}
+
+	ticker := time.NewTicker(s.watchInterval)
+	defer ticker.Stop()
+	last := grpc_health_v1.HealthCheckResponse_UNKNOWN
+	for {
+		if current := s.servingStatus(ctx); current != last {
+			if err := stream.Send(&grpc_health_v1.HealthCheckResponse{Status: current}); err != nil {
+				return err
+			}
+			last = current
+		}
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// RegisterHealthAndReflection registers the health server and the reflection service on the grpc server,
+// it shall be called after all the services registered, so that they could be checked by name.
+func RegisterHealthAndReflection(server *grpc.Server, component ComponentStatesGetter, enableReflection bool) {
+	services := make([]string, 0)
+	for name := range server.GetServiceInfo() {
+		services = append(services, name)
+	}
+	grpc_health_v1.RegisterHealthServer(server, NewHealthServer(component, services...))
+	if enableReflection {
+		reflection.Register(server)
+	}
+}
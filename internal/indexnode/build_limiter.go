@@ -0,0 +1,155 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+)
+
+// buildLimit is the resource limit of the concurrent builds of an index type,
+// non-positive means no limit.
+type buildLimit struct {
+	memory int64 // bytes
+	cpu    int   // cores
+}
+
+func (l buildLimit) unlimited() bool {
+	return l.memory <= 0 && l.cpu <= 0
+}
+
+// getBuildLimit returns the limit of the concurrent builds of the index type,
+// configured by the ratios of the memory and CPU cores of the index node.
+func getBuildLimit(indexType string) buildLimit {
+	if !Params.IndexNodeCfg.BuildLimitEnabled.GetAsBool() {
+		return buildLimit{}
+	}
+	limits := Params.IndexNodeCfg.BuildLimits.GetValue()
+	getRatio := func(name string) float64 {
+		v, ok := limits[strings.ToLower(indexType+"."+name)]
+		if !ok {
+			return 0
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0
+		}
+		return f
+	}
+	limit := buildLimit{}
+	if ratio := getRatio("maxMemoryRatio"); ratio > 0 {
+		limit.memory = int64(float64(hardware.GetMemoryCount()) * ratio)
+	}
+	if ratio := getRatio("maxCPURatio"); ratio > 0 {
+		limit.cpu = int(float64(hardware.GetCPUNum()) * ratio)
+		// a build takes one core at least
+		if limit.cpu < 1 {
+			limit.cpu = 1
+		}
+	}
+	return limit
+}
+
+// buildUsage is the resource reserved by the running builds of an index type.
+type buildUsage struct {
+	memory int64
+	cpu    int
+	builds int
+}
+
+// buildLimiter throttles the concurrent builds of each index type within its limit, so that
+// the builds of one index type can't evict the memory needed by the builds of the others.
+type buildLimiter struct {
+	mu    sync.Mutex
+	usage map[string]*buildUsage // index type -> usage
+	// closed and replaced once any reservation is released
+	released chan struct{}
+}
+
+func newBuildLimiter() *buildLimiter {
+	return &buildLimiter{
+		usage:    make(map[string]*buildUsage),
+		released: make(chan struct{}),
+	}
+}
+
+// acquire waits until the memory and CPU cores of the build fit in the limit of its index type,
+// and returns the function to release them. The build runs anyway if no other build of the index
+// type is running, so that the build larger than the limit won't wait forever.
+func (l *buildLimiter) acquire(ctx context.Context, indexType string, limit buildLimit, memory int64, cpu int) (func(), error) {
+	if limit.unlimited() {
+		return func() {}, nil
+	}
+	key := strings.ToLower(indexType)
+	for {
+		l.mu.Lock()
+		usage, ok := l.usage[key]
+		if !ok {
+			usage = &buildUsage{}
+			l.usage[key] = usage
+		}
+		if usage.builds == 0 ||
+			((limit.memory <= 0 || usage.memory+memory <= limit.memory) &&
+				(limit.cpu <= 0 || usage.cpu+cpu <= limit.cpu)) {
+			usage.memory += memory
+			usage.cpu += cpu
+			usage.builds++
+			l.mu.Unlock()
+
+			var once sync.Once
+			return func() {
+				once.Do(func() { l.release(key, memory, cpu) })
+			}, nil
+		}
+		released := l.released
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-released:
+		}
+	}
+}
+
+func (l *buildLimiter) release(key string, memory int64, cpu int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	usage := l.usage[key]
+	usage.memory -= memory
+	usage.cpu -= cpu
+	usage.builds--
+	if usage.builds == 0 {
+		delete(l.usage, key)
+	}
+	close(l.released)
+	l.released = make(chan struct{})
+}
+
+// getUsage returns the resource reserved by the running builds of the index type.
+func (l *buildLimiter) getUsage(indexType string) buildUsage {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if usage, ok := l.usage[strings.ToLower(indexType)]; ok {
+		return *usage
+	}
+	return buildUsage{}
+}
@@ -0,0 +1,122 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package indexnode
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestGetBuildLimit(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.SaveGroup(map[string]string{
+		Params.IndexNodeCfg.BuildLimits.KeyPrefix + "INVERTED.maxMemoryRatio": "0.5",
+		Params.IndexNodeCfg.BuildLimits.KeyPrefix + "INVERTED.maxCPURatio":    "0.000001",
+		Params.IndexNodeCfg.BuildLimits.KeyPrefix + "HNSW.maxMemoryRatio":     "invalid",
+	})
+	defer func() {
+		params.Reset(Params.IndexNodeCfg.BuildLimits.KeyPrefix + "INVERTED.maxMemoryRatio")
+		params.Reset(Params.IndexNodeCfg.BuildLimits.KeyPrefix + "INVERTED.maxCPURatio")
+		params.Reset(Params.IndexNodeCfg.BuildLimits.KeyPrefix + "HNSW.maxMemoryRatio")
+	}()
+
+	// disabled
+	assert.True(t, getBuildLimit("INVERTED").unlimited())
+
+	params.Save(Params.IndexNodeCfg.BuildLimitEnabled.Key, "true")
+	defer params.Reset(Params.IndexNodeCfg.BuildLimitEnabled.Key)
+	limit := getBuildLimit("INVERTED")
+	assert.Equal(t, int64(float64(hardware.GetMemoryCount())*0.5), limit.memory)
+	assert.Equal(t, 1, limit.cpu)
+	assert.Equal(t, limit, getBuildLimit("inverted"))
+	assert.True(t, getBuildLimit("HNSW").unlimited())
+	assert.True(t, getBuildLimit("DISKANN").unlimited())
+}
+
+func TestBuildLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unlimited", func(t *testing.T) {
+		l := newBuildLimiter()
+		release, err := l.acquire(ctx, "HNSW", buildLimit{}, 100, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, buildUsage{}, l.getUsage("HNSW"))
+		release()
+	})
+
+	t.Run("wait for release", func(t *testing.T) {
+		l := newBuildLimiter()
+		limit := buildLimit{memory: 100, cpu: 2}
+		release1, err := l.acquire(ctx, "INVERTED", limit, 60, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, buildUsage{memory: 60, cpu: 1, builds: 1}, l.getUsage("inverted"))
+
+		// other index types aren't affected
+		release2, err := l.acquire(ctx, "DISKANN", limit, 100, 2)
+		assert.NoError(t, err)
+
+		acquired := make(chan func())
+		go func() {
+			release, err := l.acquire(ctx, "INVERTED", limit, 60, 1)
+			assert.NoError(t, err)
+			acquired <- release
+		}()
+		select {
+		case <-acquired:
+			t.Fatal("build acquired beyond the memory limit")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		release1()
+		// released twice is fine
+		release1()
+		release3 := <-acquired
+		assert.Equal(t, buildUsage{memory: 60, cpu: 1, builds: 1}, l.getUsage("INVERTED"))
+		release3()
+		release2()
+		assert.Equal(t, buildUsage{}, l.getUsage("INVERTED"))
+		assert.Equal(t, buildUsage{}, l.getUsage("DISKANN"))
+	})
+
+	t.Run("cpu limit", func(t *testing.T) {
+		l := newBuildLimiter()
+		limit := buildLimit{cpu: 2}
+		release1, err := l.acquire(ctx, "INVERTED", limit, 0, 2)
+		assert.NoError(t, err)
+		defer release1()
+
+		ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err = l.acquire(ctx, "INVERTED", limit, 0, 1)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("larger than limit", func(t *testing.T) {
+		l := newBuildLimiter()
+		release, err := l.acquire(ctx, "INVERTED", buildLimit{memory: 100}, 200, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, buildUsage{memory: 200, cpu: 1, builds: 1}, l.getUsage("INVERTED"))
+		release()
+	})
+}
@@ -3,6 +3,7 @@ package indexnode
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -38,6 +39,14 @@ func (m *chunkMgrFactory) NewChunkManager(ctx context.Context, config *indexpb.S
 		storage.UseVirtualHost(config.GetUseVirtualHost()),
 		storage.RequestTimeout(config.GetRequestTimeoutMs()),
 		storage.Region(config.GetRegion()),
+		// the native client options aren't carried by the storage config, take them from the local ones
+		storage.UseNativeClient(Params.MinioCfg.UseNativeClient.GetAsBool()),
+		storage.MaxRetries(Params.MinioCfg.MaxRetries.GetAsInt()),
+		storage.MultipartThreshold(Params.MinioCfg.MultipartThresholdMB.GetAsInt64()*1024*1024),
+		storage.MultipartPartSize(Params.MinioCfg.MultipartPartSizeMB.GetAsInt64()*1024*1024),
+		storage.Encryption(Params.MinioCfg.EncryptionEnabled.GetAsBool(), Params.MinioCfg.EncryptionKMS.GetValue(), Params.MinioCfg.EncryptionMasterKeyID.GetValue()),
+		storage.DataKeyRotateInterval(time.Duration(Params.MinioCfg.EncryptionKeyRotationDays.GetAsInt64())*24*time.Hour),
+		storage.DataKeyRefreshInterval(Params.MinioCfg.EncryptionKeyRefreshSeconds.GetAsDuration(time.Second)),
 		storage.CreateBucket(true),
 	)
 	return chunkManagerFactory.NewPersistentStorageChunkManager(ctx)
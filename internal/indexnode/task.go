@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/indexcgowrapper"
+	"github.com/milvus-io/milvus/internal/util/initcore"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -138,6 +139,13 @@ func (it *indexBuildTaskV2) BuildIndex(ctx context.Context) error {
 		}
 	}
 
+	release, err := it.acquireBuildLimit(ctx, indexType)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to acquire the build limit", zap.String("index type", indexType), zap.Error(err))
+		return err
+	}
+	defer release()
+
 	var buildIndexInfo *indexcgowrapper.BuildIndexInfo
 	buildIndexInfo, err = indexcgowrapper.NewBuildIndexInfo(it.req.GetStorageConfig())
 	defer indexcgowrapper.DeleteBuildIndexInfo(buildIndexInfo)
@@ -301,6 +309,21 @@ func (it *indexBuildTask) Name() string {
 	return it.ident
 }
 
+// numRows is the number of rows of the segment to build index on.
+func (it *indexBuildTask) numRows() int64 {
+	return it.req.GetNumRows()
+}
+
+// preemptible returns true for the disk index, whose build lasts for hours on huge segments.
+func (it *indexBuildTask) preemptible() bool {
+	for _, kvPair := range it.req.GetIndexParams() {
+		if kvPair.GetKey() == common.IndexTypeKey {
+			return kvPair.GetValue() == indexparamcheck.IndexDISKANN
+		}
+	}
+	return false
+}
+
 func (it *indexBuildTask) SetState(state commonpb.IndexState, failReason string) {
 	it.node.storeTaskState(it.ClusterID, it.BuildID, state, failReason)
 }
@@ -482,6 +505,13 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 		}
 	}
 
+	release, err := it.acquireBuildLimit(ctx, indexType)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to acquire the build limit", zap.String("index type", indexType), zap.Error(err))
+		return err
+	}
+	defer release()
+
 	var buildIndexInfo *indexcgowrapper.BuildIndexInfo
 	buildIndexInfo, err = indexcgowrapper.NewBuildIndexInfo(it.req.GetStorageConfig())
 	defer indexcgowrapper.DeleteBuildIndexInfo(buildIndexInfo)
@@ -544,6 +574,11 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 		}
 	}
 
+	if err := initcore.SyncCollectionDataKeys(ctx, it.cm, it.collectionID); err != nil {
+		log.Ctx(ctx).Warn("failed to sync data keys of collection", zap.Error(err))
+		return err
+	}
+
 	it.index, err = indexcgowrapper.CreateIndex(ctx, buildIndexInfo)
 	if err != nil {
 		if it.index != nil && it.index.CleanLocalData() != nil {
@@ -562,6 +597,68 @@ func (it *indexBuildTask) BuildIndex(ctx context.Context) error {
 	return nil
 }
 
+// acquireBuildLimit waits until the memory and CPU cores of the build fit in the limit of its index type,
+// and returns the function to release them. The builds taking num_build_thread are told to take no more
+// cores and memory than the limit, the others run on the shared build thread pool and take one core each.
+func (it *indexBuildTask) acquireBuildLimit(ctx context.Context, indexType string) (func(), error) {
+	limit := getBuildLimit(indexType)
+	if limit.unlimited() {
+		return func() {}, nil
+	}
+	memory, err := it.estimateBuildMemory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cpu := 1
+	if v, ok := it.newIndexParams[indexparams.NumBuildThreadKey]; ok {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			cpu = n
+		}
+		if limit.cpu > 0 && cpu > limit.cpu {
+			cpu = limit.cpu
+			it.newIndexParams[indexparams.NumBuildThreadKey] = strconv.Itoa(cpu)
+		}
+	}
+	if v, ok := it.newIndexParams[indexparams.BuildDramBudgetKey]; ok && limit.memory > 0 {
+		budget, err := strconv.ParseFloat(v, 64)
+		if err != nil || budget*(1<<30) > float64(limit.memory) {
+			it.newIndexParams[indexparams.BuildDramBudgetKey] = fmt.Sprintf("%f", float64(limit.memory)/(1<<30))
+		}
+	}
+
+	tr := timerecord.NewTimeRecorder("acquireBuildLimit")
+	release, err := it.node.sched.buildLimiter.acquire(ctx, indexType, limit, memory, cpu)
+	if err != nil {
+		return nil, err
+	}
+	log.Ctx(ctx).Info("build limit acquired", zap.Int64("buildID", it.BuildID), zap.String("index type", indexType),
+		zap.Int64("memory", memory), zap.Int("cpu", cpu), zap.Int64("memoryLimit", limit.memory),
+		zap.Int("cpuLimit", limit.cpu), zap.Duration("wait", tr.ElapseSpan()))
+	return release, nil
+}
+
+// estimateBuildMemory estimates the memory to build index by the size of the field data,
+// the build is retried if it fails to stat the binlogs, e.g. the stat requests are shed.
+func (it *indexBuildTask) estimateBuildMemory(ctx context.Context) (int64, error) {
+	size, err := estimateFieldDataSize(it.statistic.Dim, it.req.GetNumRows(), it.fieldType)
+	if err == nil && size > 0 {
+		return int64(size), nil
+	}
+	// the size of the scalar and sparse field data is unknown until loaded, take the size of the binlogs instead,
+	// it's only an estimation, so the stat requests are shed first if the object storage is throttling
+	ctx = storage.WithRequestClass(ctx, storage.RequestClassStats)
+	var total int64
+	for _, path := range it.req.GetDataPaths() {
+		size, err := it.cm.Size(ctx, path)
+		if err != nil {
+			log.Ctx(ctx).Warn("failed to stat binlog to estimate build memory", zap.String("path", path), zap.Error(err))
+			return 0, err
+		}
+		total += size
+	}
+	return total, nil
+}
+
 func (it *indexBuildTask) SaveIndexFiles(ctx context.Context) error {
 	gcIndex := func() {
 		if err := it.index.Delete(); err != nil {
@@ -40,6 +40,8 @@ type TaskQueue interface {
 	utFull() bool
 	addUnissuedTask(t task) error
 	PopUnissuedTask() task
+	PopSmallUnissuedTask(maxRows int64) task
+	SmallUnissuedTaskNum(maxRows int64) int
 	AddActiveTask(t task)
 	PopActiveTask(tName string) task
 	Enqueue(t task) error
@@ -100,6 +102,34 @@ func (queue *IndexTaskQueue) PopUnissuedTask() task {
 	return ft.Value.(task)
 }
 
+// PopSmallUnissuedTask pops the first small task from tasks queue, see isSmallTask.
+func (queue *IndexTaskQueue) PopSmallUnissuedTask(maxRows int64) task {
+	queue.utLock.Lock()
+	defer queue.utLock.Unlock()
+
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		if isSmallTask(e.Value.(task), maxRows) {
+			queue.unissuedTasks.Remove(e)
+			return e.Value.(task)
+		}
+	}
+	return nil
+}
+
+// SmallUnissuedTaskNum returns the number of small tasks in tasks queue.
+func (queue *IndexTaskQueue) SmallUnissuedTaskNum(maxRows int64) int {
+	queue.utLock.Lock()
+	defer queue.utLock.Unlock()
+
+	num := 0
+	for e := queue.unissuedTasks.Front(); e != nil; e = e.Next() {
+		if isSmallTask(e.Value.(task), maxRows) {
+			num++
+		}
+	}
+	return num
+}
+
 // AddActiveTask adds a task to activeTasks.
 func (queue *IndexTaskQueue) AddActiveTask(t task) {
 	queue.atLock.Lock()
@@ -166,39 +196,133 @@ func NewIndexBuildTaskQueue(sched *TaskScheduler) *IndexTaskQueue {
 	}
 }
 
+// sizedTask is the task knows the number of rows to build index on.
+type sizedTask interface {
+	numRows() int64
+}
+
+// preemptibleTask is the task could run other tasks on its build slot between its stages.
+type preemptibleTask interface {
+	preemptible() bool
+}
+
+// isSmallTask returns whether the task is small enough to take the slots reserved for the small tasks,
+// the tasks of unknown size are never small.
+func isSmallTask(t task, maxRows int64) bool {
+	sized, ok := t.(sizedTask)
+	return ok && maxRows > 0 && sized.numRows() > 0 && sized.numRows() <= maxRows
+}
+
 // TaskScheduler is a scheduler of indexing tasks.
 type TaskScheduler struct {
 	IndexBuildQueue TaskQueue
 
 	buildParallel int
-	wg            sync.WaitGroup
-	ctx           context.Context
-	cancel        context.CancelFunc
+	// extra slots only for the small tasks, so that they aren't stuck behind the builds of huge segments
+	smallTaskParallel int
+
+	slotMu            sync.Mutex
+	runningTasks      int
+	runningSmallTasks int
+	slotReleased      chan struct{}
+
+	buildLimiter *buildLimiter
+
+	wg     sync.WaitGroup
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
 // NewTaskScheduler creates a new task scheduler of indexing tasks.
 func NewTaskScheduler(ctx context.Context) *TaskScheduler {
 	ctx1, cancel := context.WithCancel(ctx)
 	s := &TaskScheduler{
-		ctx:           ctx1,
-		cancel:        cancel,
-		buildParallel: Params.IndexNodeCfg.BuildParallel.GetAsInt(),
+		ctx:               ctx1,
+		cancel:            cancel,
+		buildParallel:     Params.IndexNodeCfg.BuildParallel.GetAsInt(),
+		smallTaskParallel: Params.IndexNodeCfg.SmallTaskParallel.GetAsInt(),
+		slotReleased:      make(chan struct{}, 1),
+		buildLimiter:      newBuildLimiter(),
 	}
 	s.IndexBuildQueue = NewIndexBuildTaskQueue(s)
 
 	return s
 }
 
-func (sched *TaskScheduler) scheduleIndexBuildTask() []task {
-	ret := make([]task, 0)
-	for i := 0; i < sched.buildParallel; i++ {
+// acquireSlot pops the next task to run if there is a free slot,
+// the reserved slots only run the small tasks once all the others are busy.
+func (sched *TaskScheduler) acquireSlot() (task, bool) {
+	sched.slotMu.Lock()
+	defer sched.slotMu.Unlock()
+
+	if sched.runningTasks < sched.buildParallel {
 		t := sched.IndexBuildQueue.PopUnissuedTask()
+		if t != nil {
+			sched.runningTasks++
+		}
+		return t, false
+	}
+	if sched.runningSmallTasks < sched.smallTaskParallel {
+		t := sched.IndexBuildQueue.PopSmallUnissuedTask(Params.IndexNodeCfg.SmallTaskMaxRows.GetAsInt64())
+		if t != nil {
+			sched.runningSmallTasks++
+			return t, true
+		}
+	}
+	return nil, false
+}
+
+func (sched *TaskScheduler) releaseSlot(small bool) {
+	sched.slotMu.Lock()
+	if small {
+		sched.runningSmallTasks--
+	} else {
+		sched.runningTasks--
+	}
+	sched.slotMu.Unlock()
+
+	select {
+	case sched.slotReleased <- struct{}{}:
+	default:
+	}
+}
+
+// scheduleIndexBuildTask runs the tasks in queue until all the slots are busy.
+func (sched *TaskScheduler) scheduleIndexBuildTask() {
+	for {
+		t, small := sched.acquireSlot()
 		if t == nil {
-			return ret
+			return
 		}
-		ret = append(ret, t)
+		sched.wg.Add(1)
+		go func() {
+			defer sched.wg.Done()
+			defer sched.releaseSlot(small)
+			sched.processTask(t, sched.IndexBuildQueue)
+		}()
+	}
+}
+
+// checkpoint runs the small tasks waiting in queue on the slot of the preemptible task between its stages,
+// the finished stages of the preempted task are kept, so no work is wasted. Only the small tasks waiting
+// at the checkpoint are run, the preempted task won't be starved by the newcomers.
+func (sched *TaskScheduler) checkpoint(t task, q TaskQueue) {
+	if !Params.IndexNodeCfg.EnablePreemption.GetAsBool() {
+		return
+	}
+	maxRows := Params.IndexNodeCfg.SmallTaskMaxRows.GetAsInt64()
+	if pt, ok := t.(preemptibleTask); !ok || !pt.preemptible() || isSmallTask(t, maxRows) {
+		return
+	}
+	for n := q.SmallUnissuedTaskNum(maxRows); n > 0; n-- {
+		small := q.PopSmallUnissuedTask(maxRows)
+		if small == nil {
+			return
+		}
+		log.Ctx(t.Ctx()).Info("index build task preempted by small task", zap.String("task", t.Name()),
+			zap.String("smallTask", small.Name()))
+		sched.processTask(small, q)
 	}
-	return ret
 }
 
 func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
@@ -219,7 +343,10 @@ func (sched *TaskScheduler) processTask(t task, q TaskQueue) {
 	defer sched.IndexBuildQueue.PopActiveTask(t.Name())
 	log.Ctx(t.Ctx()).Debug("process task", zap.String("task", t.Name()))
 	pipelines := []func(context.Context) error{t.Prepare, t.BuildIndex, t.SaveIndexFiles}
-	for _, fn := range pipelines {
+	for i, fn := range pipelines {
+		if i > 0 {
+			sched.checkpoint(t, q)
+		}
 		if err := wrap(fn); err != nil {
 			if errors.Is(err, errCancel) {
 				log.Ctx(t.Ctx()).Warn("index build task canceled, retry it", zap.String("task", t.Name()))
@@ -247,16 +374,9 @@ func (sched *TaskScheduler) indexBuildLoop() {
 		case <-sched.ctx.Done():
 			return
 		case <-sched.IndexBuildQueue.utChan():
-			tasks := sched.scheduleIndexBuildTask()
-			var wg sync.WaitGroup
-			for _, t := range tasks {
-				wg.Add(1)
-				go func(group *sync.WaitGroup, t task) {
-					defer group.Done()
-					sched.processTask(t, sched.IndexBuildQueue)
-				}(&wg, t)
-			}
-			wg.Wait()
+			sched.scheduleIndexBuildTask()
+		case <-sched.slotReleased:
+			sched.scheduleIndexBuildTask()
 		}
 	}
 }
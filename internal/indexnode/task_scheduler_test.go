@@ -10,6 +10,9 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -205,3 +208,149 @@ func TestIndexTaskScheduler(t *testing.T) {
 		assert.Equal(t, task.GetState(), commonpb.IndexState_Finished)
 	}
 }
+
+type sizedFakeTask struct {
+	*fakeTask
+	rows      int64
+	diskIndex bool
+	// the task holds its slot after prepared until closed
+	hold   chan struct{}
+	events chan string
+}
+
+func (t *sizedFakeTask) numRows() int64 {
+	return t.rows
+}
+
+func (t *sizedFakeTask) preemptible() bool {
+	return t.diskIndex
+}
+
+func (t *sizedFakeTask) Prepare(ctx context.Context) error {
+	t.events <- t.Name() + "/prepare"
+	if t.hold != nil {
+		<-t.hold
+	}
+	return t.fakeTask.Prepare(ctx)
+}
+
+func (t *sizedFakeTask) BuildIndex(ctx context.Context) error {
+	t.events <- t.Name() + "/build"
+	return t.fakeTask.BuildIndex(ctx)
+}
+
+func newSizedTask(rows int64, diskIndex bool, hold chan struct{}, events chan string) *sizedFakeTask {
+	return &sizedFakeTask{
+		fakeTask:  newTask(fakeTaskSavedIndexes, nil, commonpb.IndexState_Finished).(*fakeTask),
+		rows:      rows,
+		diskIndex: diskIndex,
+		hold:      hold,
+		events:    events,
+	}
+}
+
+func nextEvent(t *testing.T, events chan string) string {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("wait for task event timeout")
+		return ""
+	}
+}
+
+func TestIndexTaskScheduler_SmallTasks(t *testing.T) {
+	paramtable.Init()
+
+	assert.True(t, isSmallTask(newSizedTask(1000, false, nil, nil), 100000))
+	assert.False(t, isSmallTask(newSizedTask(1000, false, nil, nil), 0))
+	assert.False(t, isSmallTask(newSizedTask(0, false, nil, nil), 100000))
+	assert.False(t, isSmallTask(newTask(fakeTaskSavedIndexes, nil, commonpb.IndexState_Finished), 100000))
+
+	buildTask := &indexBuildTask{req: &indexpb.CreateJobRequest{
+		NumRows:     1000,
+		IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: indexparamcheck.IndexDISKANN}},
+	}}
+	assert.EqualValues(t, 1000, buildTask.numRows())
+	assert.True(t, buildTask.preemptible())
+	buildTask.req.IndexParams[0].Value = indexparamcheck.IndexHNSW
+	assert.False(t, buildTask.preemptible())
+
+	t.Run("reserved slot", func(t *testing.T) {
+		scheduler := NewTaskScheduler(context.TODO())
+		scheduler.buildParallel = 1
+		scheduler.smallTaskParallel = 1
+		scheduler.Start()
+		defer scheduler.Close()
+
+		events := make(chan string, 16)
+		hold := make(chan struct{})
+		huge := newSizedTask(1000000, false, hold, events)
+		small := newSizedTask(1000, false, nil, events)
+
+		assert.NoError(t, scheduler.IndexBuildQueue.Enqueue(huge))
+		assert.Equal(t, huge.Name()+"/prepare", nextEvent(t, events))
+		assert.NoError(t, scheduler.IndexBuildQueue.Enqueue(small))
+		// the small task isn't stuck behind the huge one
+		assert.Equal(t, small.Name()+"/prepare", nextEvent(t, events))
+		assert.Equal(t, small.Name()+"/build", nextEvent(t, events))
+
+		close(hold)
+		assert.Equal(t, huge.Name()+"/build", nextEvent(t, events))
+		_taskwg.Wait()
+		assert.Equal(t, commonpb.IndexState_Finished, huge.GetState())
+		assert.Equal(t, commonpb.IndexState_Finished, small.GetState())
+	})
+
+	t.Run("preemption", func(t *testing.T) {
+		scheduler := NewTaskScheduler(context.TODO())
+		scheduler.buildParallel = 1
+		scheduler.smallTaskParallel = 0
+		scheduler.Start()
+		defer scheduler.Close()
+
+		events := make(chan string, 16)
+		hold := make(chan struct{})
+		huge := newSizedTask(1000000, true, hold, events)
+		small := newSizedTask(1000, false, nil, events)
+
+		assert.NoError(t, scheduler.IndexBuildQueue.Enqueue(huge))
+		assert.Equal(t, huge.Name()+"/prepare", nextEvent(t, events))
+		assert.NoError(t, scheduler.IndexBuildQueue.Enqueue(small))
+
+		// the small task runs at the checkpoint before building the disk index
+		close(hold)
+		assert.Equal(t, small.Name()+"/prepare", nextEvent(t, events))
+		assert.Equal(t, small.Name()+"/build", nextEvent(t, events))
+		assert.Equal(t, huge.Name()+"/build", nextEvent(t, events))
+		_taskwg.Wait()
+		assert.Equal(t, commonpb.IndexState_Finished, huge.GetState())
+		assert.Equal(t, commonpb.IndexState_Finished, small.GetState())
+	})
+
+	t.Run("preemption disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.IndexNodeCfg.EnablePreemption.Key, "false")
+		defer paramtable.Get().Reset(Params.IndexNodeCfg.EnablePreemption.Key)
+
+		scheduler := NewTaskScheduler(context.TODO())
+		scheduler.buildParallel = 1
+		scheduler.smallTaskParallel = 0
+		scheduler.Start()
+		defer scheduler.Close()
+
+		events := make(chan string, 16)
+		hold := make(chan struct{})
+		huge := newSizedTask(1000000, true, hold, events)
+		small := newSizedTask(1000, false, nil, events)
+
+		assert.NoError(t, scheduler.IndexBuildQueue.Enqueue(huge))
+		assert.Equal(t, huge.Name()+"/prepare", nextEvent(t, events))
+		assert.NoError(t, scheduler.IndexBuildQueue.Enqueue(small))
+
+		close(hold)
+		assert.Equal(t, huge.Name()+"/build", nextEvent(t, events))
+		assert.Equal(t, small.Name()+"/prepare", nextEvent(t, events))
+		assert.Equal(t, small.Name()+"/build", nextEvent(t, events))
+		_taskwg.Wait()
+	})
+}
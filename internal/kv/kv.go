@@ -92,4 +92,5 @@ type SnapShotKV interface {
 	MultiSave(kvs map[string]string, ts typeutil.Timestamp) error
 	LoadWithPrefix(key string, ts typeutil.Timestamp) ([]string, []string, error)
 	MultiSaveAndRemoveWithPrefix(saves map[string]string, removals []string, ts typeutil.Timestamp) error
+	MultiSaveAndRemoveWithRaw(saves map[string]string, removals []string, rawSaves map[string]string, rawRemovals []string, ts typeutil.Timestamp) error
 }
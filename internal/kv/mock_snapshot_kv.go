@@ -11,6 +11,7 @@ type mockSnapshotKV struct {
 	MultiSaveFunc                    func(kvs map[string]string, ts typeutil.Timestamp) error
 	LoadWithPrefixFunc               func(key string, ts typeutil.Timestamp) ([]string, []string, error)
 	MultiSaveAndRemoveWithPrefixFunc func(saves map[string]string, removals []string, ts typeutil.Timestamp) error
+	MultiSaveAndRemoveWithRawFunc    func(saves map[string]string, removals []string, rawSaves map[string]string, rawRemovals []string, ts typeutil.Timestamp) error
 }
 
 func NewMockSnapshotKV() *mockSnapshotKV {
@@ -51,3 +52,10 @@ func (m mockSnapshotKV) MultiSaveAndRemoveWithPrefix(saves map[string]string, re
 	}
 	return nil
 }
+
+func (m mockSnapshotKV) MultiSaveAndRemoveWithRaw(saves map[string]string, removals []string, rawSaves map[string]string, rawRemovals []string, ts typeutil.Timestamp) error {
+	if m.MultiSaveAndRemoveWithRawFunc != nil {
+		return m.MultiSaveAndRemoveWithRawFunc(saves, removals, rawSaves, rawRemovals, ts)
+	}
+	return nil
+}
@@ -221,6 +221,52 @@ func (_c *SnapShotKV_MultiSaveAndRemoveWithPrefix_Call) RunAndReturn(run func(ma
 	return _c
 }
 
+// MultiSaveAndRemoveWithRaw provides a mock function with given fields: saves, removals, rawSaves, rawRemovals, ts
+func (_m *SnapShotKV) MultiSaveAndRemoveWithRaw(saves map[string]string, removals []string, rawSaves map[string]string, rawRemovals []string, ts uint64) error {
+	ret := _m.Called(saves, removals, rawSaves, rawRemovals, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]string, []string, map[string]string, []string, uint64) error); ok {
+		r0 = rf(saves, removals, rawSaves, rawRemovals, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SnapShotKV_MultiSaveAndRemoveWithRaw_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MultiSaveAndRemoveWithRaw'
+type SnapShotKV_MultiSaveAndRemoveWithRaw_Call struct {
+	*mock.Call
+}
+
+// MultiSaveAndRemoveWithRaw is a helper method to define mock.On call
+//   - saves map[string]string
+//   - removals []string
+//   - rawSaves map[string]string
+//   - rawRemovals []string
+//   - ts uint64
+func (_e *SnapShotKV_Expecter) MultiSaveAndRemoveWithRaw(saves interface{}, removals interface{}, rawSaves interface{}, rawRemovals interface{}, ts interface{}) *SnapShotKV_MultiSaveAndRemoveWithRaw_Call {
+	return &SnapShotKV_MultiSaveAndRemoveWithRaw_Call{Call: _e.mock.On("MultiSaveAndRemoveWithRaw", saves, removals, rawSaves, rawRemovals, ts)}
+}
+
+func (_c *SnapShotKV_MultiSaveAndRemoveWithRaw_Call) Run(run func(saves map[string]string, removals []string, rawSaves map[string]string, rawRemovals []string, ts uint64)) *SnapShotKV_MultiSaveAndRemoveWithRaw_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]string), args[1].([]string), args[2].(map[string]string), args[3].([]string), args[4].(uint64))
+	})
+	return _c
+}
+
+func (_c *SnapShotKV_MultiSaveAndRemoveWithRaw_Call) Return(_a0 error) *SnapShotKV_MultiSaveAndRemoveWithRaw_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *SnapShotKV_MultiSaveAndRemoveWithRaw_Call) RunAndReturn(run func(map[string]string, []string, map[string]string, []string, uint64) error) *SnapShotKV_MultiSaveAndRemoveWithRaw_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function with given fields: key, value, ts
 func (_m *SnapShotKV) Save(key string, value string, ts uint64) error {
 	ret := _m.Called(key, value, ts)
@@ -25,6 +25,8 @@ type RootCoordCatalog interface {
 	CollectionExists(ctx context.Context, dbID int64, collectionID typeutil.UniqueID, ts typeutil.Timestamp) bool
 	DropCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error
 	AlterCollection(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, alterType AlterType, ts typeutil.Timestamp) error
+	// RenameCollection moves the collection, its aliases and its grants to the new name and database of newColl in one transaction.
+	RenameCollection(ctx context.Context, oldDBName string, newDBName string, oldColl *model.Collection, newColl *model.Collection, aliases []*model.Alias, ts typeutil.Timestamp) error
 
 	CreatePartition(ctx context.Context, dbID int64, partition *model.Partition, ts typeutil.Timestamp) error
 	DropPartition(ctx context.Context, dbID int64, collectionID typeutil.UniqueID, partitionID typeutil.UniqueID, ts typeutil.Timestamp) error
@@ -80,6 +82,15 @@ type RootCoordCatalog interface {
 	// For example []string{"user1/role1"}
 	ListUserRole(ctx context.Context, tenant string) ([]string, error)
 
+	// SaveAPIKey creates or updates the api key.
+	SaveAPIKey(ctx context.Context, apiKey *model.APIKey) error
+	// GetAPIKey gets the api key by name, returns error if the key doesn't exist.
+	GetAPIKey(ctx context.Context, name string) (*model.APIKey, error)
+	// DropAPIKey removes the api key.
+	DropAPIKey(ctx context.Context, name string) error
+	// ListAPIKeys lists all the api keys.
+	ListAPIKeys(ctx context.Context) ([]*model.APIKey, error)
+
 	Close()
 }
 
@@ -147,6 +158,18 @@ type DataCoordCatalog interface {
 	ListImportTasks() ([]*datapb.ImportTaskV2, error)
 	DropImportTask(taskID int64) error
 
+	SaveSnapshot(ctx context.Context, snapshot *datapb.CollectionSnapshot) error
+	ListSnapshots(ctx context.Context) ([]*datapb.CollectionSnapshot, error)
+	DropSnapshot(ctx context.Context, collectionID typeutil.UniqueID, name string) error
+
+	SaveIdempotencyRecord(ctx context.Context, record *datapb.IdempotencyRecord) error
+	ListIdempotencyRecords(ctx context.Context) ([]*datapb.IdempotencyRecord, error)
+	DropIdempotencyRecord(ctx context.Context, collectionID typeutil.UniqueID, key string) error
+
+	SaveIngestionPause(ctx context.Context, collectionID typeutil.UniqueID) error
+	ListIngestionPauses(ctx context.Context) ([]typeutil.UniqueID, error)
+	DropIngestionPause(ctx context.Context, collectionID typeutil.UniqueID) error
+
 	GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool
 }
 
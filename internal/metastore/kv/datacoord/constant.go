@@ -27,6 +27,9 @@ const (
 	ImportJobPrefix           = MetaPrefix + "/import-job"
 	ImportTaskPrefix          = MetaPrefix + "/import-task"
 	PreImportTaskPrefix       = MetaPrefix + "/preimport-task"
+	SnapshotPrefix            = MetaPrefix + "/snapshot"
+	IdempotencyPrefix         = MetaPrefix + "/idempotency"
+	IngestionPausePrefix      = MetaPrefix + "/ingestion-pause"
 
 	NonRemoveFlagTomestone = "non-removed"
 	RemoveFlagTomestone    = "removed"
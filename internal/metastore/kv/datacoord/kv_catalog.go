@@ -799,6 +799,91 @@ func (kc *Catalog) DropImportTask(taskID int64) error {
 	return kc.MetaKv.Remove(key)
 }
 
+func (kc *Catalog) SaveSnapshot(ctx context.Context, snapshot *datapb.CollectionSnapshot) error {
+	key := buildSnapshotKey(snapshot.GetCollectionID(), snapshot.GetName())
+	value, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(key, string(value))
+}
+
+func (kc *Catalog) ListSnapshots(ctx context.Context) ([]*datapb.CollectionSnapshot, error) {
+	snapshots := make([]*datapb.CollectionSnapshot, 0)
+	_, values, err := kc.MetaKv.LoadWithPrefix(SnapshotPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		snapshot := &datapb.CollectionSnapshot{}
+		err = proto.Unmarshal([]byte(value), snapshot)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func (kc *Catalog) DropSnapshot(ctx context.Context, collectionID typeutil.UniqueID, name string) error {
+	key := buildSnapshotKey(collectionID, name)
+	return kc.MetaKv.Remove(key)
+}
+
+func (kc *Catalog) SaveIdempotencyRecord(ctx context.Context, record *datapb.IdempotencyRecord) error {
+	key := buildIdempotencyKey(record.GetCollectionID(), record.GetKey())
+	value, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(key, string(value))
+}
+
+func (kc *Catalog) ListIdempotencyRecords(ctx context.Context) ([]*datapb.IdempotencyRecord, error) {
+	records := make([]*datapb.IdempotencyRecord, 0)
+	_, values, err := kc.MetaKv.LoadWithPrefix(IdempotencyPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		record := &datapb.IdempotencyRecord{}
+		err = proto.Unmarshal([]byte(value), record)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func (kc *Catalog) DropIdempotencyRecord(ctx context.Context, collectionID typeutil.UniqueID, key string) error {
+	return kc.MetaKv.Remove(buildIdempotencyKey(collectionID, key))
+}
+
+func (kc *Catalog) SaveIngestionPause(ctx context.Context, collectionID typeutil.UniqueID) error {
+	return kc.MetaKv.Save(buildIngestionPauseKey(collectionID), strconv.FormatInt(collectionID, 10))
+}
+
+func (kc *Catalog) ListIngestionPauses(ctx context.Context) ([]typeutil.UniqueID, error) {
+	_, values, err := kc.MetaKv.LoadWithPrefix(IngestionPausePrefix)
+	if err != nil {
+		return nil, err
+	}
+	collectionIDs := make([]typeutil.UniqueID, 0, len(values))
+	for _, value := range values {
+		collectionID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		collectionIDs = append(collectionIDs, collectionID)
+	}
+	return collectionIDs, nil
+}
+
+func (kc *Catalog) DropIngestionPause(ctx context.Context, collectionID typeutil.UniqueID) error {
+	return kc.MetaKv.Remove(buildIngestionPauseKey(collectionID))
+}
+
 const allPartitionID = -1
 
 // GcConfirm returns true if related collection/partition is not found.
@@ -1433,3 +1433,103 @@ func TestCatalog_Import(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestCatalog_Snapshot(t *testing.T) {
+	kc := &Catalog{}
+	ctx := context.Background()
+	snapshot := &datapb.CollectionSnapshot{
+		Name:         "snapshot",
+		CollectionID: 100,
+	}
+
+	txn := mocks.NewMetaKv(t)
+	txn.EXPECT().Save(buildSnapshotKey(100, "snapshot"), mock.Anything).Return(nil)
+	kc.MetaKv = txn
+	assert.NoError(t, kc.SaveSnapshot(ctx, snapshot))
+
+	txn = mocks.NewMetaKv(t)
+	value, err := proto.Marshal(snapshot)
+	assert.NoError(t, err)
+	txn.EXPECT().LoadWithPrefix(SnapshotPrefix).Return(nil, []string{string(value)}, nil)
+	kc.MetaKv = txn
+	snapshots, err := kc.ListSnapshots(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 1)
+	assert.Equal(t, "snapshot", snapshots[0].GetName())
+
+	txn = mocks.NewMetaKv(t)
+	txn.EXPECT().LoadWithPrefix(SnapshotPrefix).Return(nil, []string{"@#%#^#"}, nil)
+	kc.MetaKv = txn
+	_, err = kc.ListSnapshots(ctx)
+	assert.Error(t, err)
+
+	txn = mocks.NewMetaKv(t)
+	txn.EXPECT().Remove(buildSnapshotKey(100, "snapshot")).Return(nil)
+	kc.MetaKv = txn
+	assert.NoError(t, kc.DropSnapshot(ctx, 100, "snapshot"))
+}
+
+func TestCatalog_IdempotencyRecord(t *testing.T) {
+	kc := &Catalog{}
+	ctx := context.Background()
+	record := &datapb.IdempotencyRecord{
+		Key:          "key",
+		CollectionID: 100,
+		Result:       []byte("1000"),
+	}
+
+	txn := mocks.NewMetaKv(t)
+	txn.EXPECT().Save(buildIdempotencyKey(100, "key"), mock.Anything).Return(nil)
+	kc.MetaKv = txn
+	assert.NoError(t, kc.SaveIdempotencyRecord(ctx, record))
+
+	txn = mocks.NewMetaKv(t)
+	value, err := proto.Marshal(record)
+	assert.NoError(t, err)
+	txn.EXPECT().LoadWithPrefix(IdempotencyPrefix).Return(nil, []string{string(value)}, nil)
+	kc.MetaKv = txn
+	records, err := kc.ListIdempotencyRecords(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "key", records[0].GetKey())
+	assert.Equal(t, []byte("1000"), records[0].GetResult())
+
+	txn = mocks.NewMetaKv(t)
+	txn.EXPECT().LoadWithPrefix(IdempotencyPrefix).Return(nil, nil, errors.New("mock error"))
+	kc.MetaKv = txn
+	_, err = kc.ListIdempotencyRecords(ctx)
+	assert.Error(t, err)
+
+	txn = mocks.NewMetaKv(t)
+	txn.EXPECT().Remove(buildIdempotencyKey(100, "key")).Return(nil)
+	kc.MetaKv = txn
+	assert.NoError(t, kc.DropIdempotencyRecord(ctx, 100, "key"))
+}
+
+func TestCatalog_IngestionPause(t *testing.T) {
+	kc := &Catalog{}
+	ctx := context.Background()
+
+	txn := mocks.NewMetaKv(t)
+	txn.EXPECT().Save(buildIngestionPauseKey(100), "100").Return(nil)
+	kc.MetaKv = txn
+	assert.NoError(t, kc.SaveIngestionPause(ctx, 100))
+
+	txn = mocks.NewMetaKv(t)
+	txn.EXPECT().LoadWithPrefix(IngestionPausePrefix).Return(nil, []string{"100", "101"}, nil)
+	kc.MetaKv = txn
+	collectionIDs, err := kc.ListIngestionPauses(ctx)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{100, 101}, collectionIDs)
+
+	txn = mocks.NewMetaKv(t)
+	txn.EXPECT().LoadWithPrefix(IngestionPausePrefix).Return(nil, []string{"@#%#^#"}, nil)
+	kc.MetaKv = txn
+	_, err = kc.ListIngestionPauses(ctx)
+	assert.Error(t, err)
+
+	txn = mocks.NewMetaKv(t)
+	txn.EXPECT().Remove(buildIngestionPauseKey(100)).Return(nil)
+	kc.MetaKv = txn
+	assert.NoError(t, kc.DropIngestionPause(ctx, 100))
+}
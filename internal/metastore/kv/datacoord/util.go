@@ -294,3 +294,15 @@ func buildImportTaskKey(taskID int64) string {
 func buildPreImportTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s/%d", PreImportTaskPrefix, taskID)
 }
+
+func buildSnapshotKey(collectionID typeutil.UniqueID, name string) string {
+	return fmt.Sprintf("%s/%d/%s", SnapshotPrefix, collectionID, name)
+}
+
+func buildIdempotencyKey(collectionID typeutil.UniqueID, key string) string {
+	return fmt.Sprintf("%s/%d/%s", IdempotencyPrefix, collectionID, key)
+}
+
+func buildIngestionPauseKey(collectionID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", IngestionPausePrefix, collectionID)
+}
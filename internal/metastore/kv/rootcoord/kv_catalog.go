@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/kv"
@@ -420,6 +423,49 @@ func (kc *Catalog) AlterAlias(ctx context.Context, alias *model.Alias, ts typeut
 	return kc.CreateAlias(ctx, alias, ts)
 }
 
+func (kc *Catalog) RenameCollection(ctx context.Context, oldDBName string, newDBName string, oldColl *model.Collection, newColl *model.Collection, aliases []*model.Alias, ts typeutil.Timestamp) error {
+	if oldColl.TenantID != newColl.TenantID || oldColl.CollectionID != newColl.CollectionID {
+		return fmt.Errorf("altering tenant id or collection id is forbidden")
+	}
+	oldCollClone := oldColl.Clone()
+	oldCollClone.DBID = newColl.DBID
+	oldCollClone.Name = newColl.Name
+
+	oldKey := BuildCollectionKey(oldColl.DBID, oldColl.CollectionID)
+	newKey := BuildCollectionKey(newColl.DBID, oldColl.CollectionID)
+	value, err := proto.Marshal(model.MarshalCollectionModel(oldCollClone))
+	if err != nil {
+		return err
+	}
+	saves := map[string]string{newKey: string(value)}
+	removals := make([]string, 0, len(aliases)+1)
+	if oldKey != newKey {
+		removals = append(removals, oldKey)
+	}
+
+	for _, alias := range aliases {
+		newAlias := alias.Clone()
+		newAlias.DbID = newColl.DBID
+		v, err := proto.Marshal(model.MarshalAliasModel(newAlias))
+		if err != nil {
+			return err
+		}
+		saves[BuildAliasKeyWithDB(newAlias.DbID, newAlias.Name)] = string(v)
+		if alias.DbID != newAlias.DbID {
+			removals = append(removals, BuildAliasKeyWithDB(alias.DbID, alias.Name))
+		}
+	}
+
+	// the grants are stored apart from the collection meta without snapshots,
+	// they are moved in the same transaction so that a failure never separates them
+	grantSaves, grantRemovals, err := kc.migrateGrants(util.DefaultTenant, commonpb.ObjectType_Collection.String(),
+		oldDBName, oldColl.Name, newDBName, newColl.Name)
+	if err != nil {
+		return err
+	}
+	return kc.Snapshot.MultiSaveAndRemoveWithRaw(saves, removals, grantSaves, grantRemovals, ts)
+}
+
 func (kc *Catalog) DropCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error {
 	collectionKeys := []string{BuildCollectionKey(collectionInfo.DBID, collectionInfo.CollectionID)}
 
@@ -481,7 +527,22 @@ func (kc *Catalog) alterModifyCollection(oldColl *model.Collection, newColl *mod
 		return err
 	}
 	saves := map[string]string{newKey: string(value)}
+	// save the fields added to newly path
+	oldFields := lo.SliceToMap(oldColl.Fields, func(field *model.Field) (int64, struct{}) { return field.FieldID, struct{}{} })
+	for _, field := range newColl.Fields {
+		if _, ok := oldFields[field.FieldID]; ok {
+			continue
+		}
+		v, err := proto.Marshal(model.MarshalFieldModel(field))
+		if err != nil {
+			return err
+		}
+		saves[BuildFieldKey(oldColl.CollectionID, field.FieldID)] = string(v)
+	}
 	if oldKey == newKey {
+		if len(saves) > 1 {
+			return kc.Snapshot.MultiSave(saves, ts)
+		}
 		return kc.Snapshot.Save(newKey, string(value), ts)
 	}
 	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(saves, []string{oldKey}, ts)
@@ -1174,6 +1235,45 @@ func (kc *Catalog) ListPolicy(ctx context.Context, tenant string) ([]string, err
 	return grantInfoStrs, nil
 }
 
+// migrateGrants builds the kvs which move the grants of all roles on the object to the new database and object name.
+func (kc *Catalog) migrateGrants(tenant string, object string, oldDBName string, oldObjectName string, newDBName string, newObjectName string) (map[string]string, []string, error) {
+	granteeKey := funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, "")
+	keys, values, err := kc.Txn.LoadWithPrefix(granteeKey)
+	if err != nil {
+		log.Error("fail to load all grant privilege entities", zap.String("key", granteeKey), zap.Error(err))
+		return nil, nil, err
+	}
+
+	oldName := funcutil.CombineObjectName(oldDBName, oldObjectName)
+	newName := funcutil.CombineObjectName(newDBName, newObjectName)
+	saves := make(map[string]string)
+	removals := make([]string, 0)
+	for i, key := range keys {
+		grantInfos := typeutil.AfterN(key, granteeKey+"/", "/")
+		if len(grantInfos) != 3 || grantInfos[1] != object {
+			continue
+		}
+		name := grantInfos[2]
+		// the grants without db belong to the default database
+		if oldDBName == util.DefaultDBName && !strings.Contains(name, ".") {
+			name = funcutil.CombineObjectName(util.DefaultDBName, name)
+		}
+		migratedName := newName
+		if name != oldName {
+			// the partition level grants of the object are moved together
+			partitionName, ok := strings.CutPrefix(name, oldName+util.PartitionSeparator)
+			if !ok {
+				continue
+			}
+			migratedName = newName + util.PartitionSeparator + partitionName
+		}
+		// the grantee id is kept, so the privileges of the grant are moved together
+		saves[funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, fmt.Sprintf("%s/%s/%s", grantInfos[0], object, migratedName))] = values[i]
+		removals = append(removals, funcutil.HandleTenantForEtcdKey(GranteePrefix, tenant, fmt.Sprintf("%s/%s/%s", grantInfos[0], object, grantInfos[2])))
+	}
+	return saves, removals, nil
+}
+
 func (kc *Catalog) ListUserRole(ctx context.Context, tenant string) ([]string, error) {
 	var userRoles []string
 	k := funcutil.HandleTenantForEtcdKey(RoleMappingPrefix, tenant, "")
@@ -1194,6 +1294,63 @@ func (kc *Catalog) ListUserRole(ctx context.Context, tenant string) ([]string, e
 	return userRoles, nil
 }
 
+func (kc *Catalog) SaveAPIKey(ctx context.Context, apiKey *model.APIKey) error {
+	k := fmt.Sprintf("%s/%s", APIKeyPrefix, apiKey.Name)
+	v, err := json.Marshal(apiKey)
+	if err != nil {
+		log.Error("save api key marshal fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	if err := kc.Txn.Save(k, string(v)); err != nil {
+		log.Error("save api key persist meta fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) GetAPIKey(ctx context.Context, name string) (*model.APIKey, error) {
+	k := fmt.Sprintf("%s/%s", APIKeyPrefix, name)
+	v, err := kc.Txn.Load(k)
+	if err != nil {
+		if errors.Is(err, merr.ErrIoKeyNotFound) {
+			return nil, merr.WrapErrAPIKeyNotFound(name)
+		}
+		log.Warn("get api key meta fail", zap.String("key", k), zap.Error(err))
+		return nil, err
+	}
+	apiKey := &model.APIKey{}
+	if err := json.Unmarshal([]byte(v), apiKey); err != nil {
+		return nil, fmt.Errorf("unmarshal api key err:%w", err)
+	}
+	return apiKey, nil
+}
+
+func (kc *Catalog) DropAPIKey(ctx context.Context, name string) error {
+	k := fmt.Sprintf("%s/%s", APIKeyPrefix, name)
+	if err := kc.Txn.Remove(k); err != nil {
+		log.Error("drop api key fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (kc *Catalog) ListAPIKeys(ctx context.Context) ([]*model.APIKey, error) {
+	_, values, err := kc.Txn.LoadWithPrefix(APIKeyPrefix + "/")
+	if err != nil {
+		log.Error("list api keys fail", zap.String("prefix", APIKeyPrefix), zap.Error(err))
+		return nil, err
+	}
+	apiKeys := make([]*model.APIKey, 0, len(values))
+	for _, v := range values {
+		apiKey := &model.APIKey{}
+		if err := json.Unmarshal([]byte(v), apiKey); err != nil {
+			return nil, fmt.Errorf("unmarshal api key err:%w", err)
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+	return apiKeys, nil
+}
+
 func (kc *Catalog) Close() {
 	// do nothing
 }
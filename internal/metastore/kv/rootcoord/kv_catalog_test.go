@@ -1027,6 +1027,23 @@ func TestCatalog_AlterCollection(t *testing.T) {
 		assert.Equal(t, pb.CollectionState_CollectionCreated, got.State)
 	})
 
+	t.Run("modify, fields added", func(t *testing.T) {
+		var collectionID int64 = 1
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.MultiSaveFunc = func(saves map[string]string, ts typeutil.Timestamp) error {
+			assert.Equal(t, 2, len(saves))
+			assert.Contains(t, maps.Keys(saves), BuildCollectionKey(0, collectionID))
+			assert.Contains(t, maps.Keys(saves), BuildFieldKey(collectionID, 101))
+			return nil
+		}
+		kc := &Catalog{Snapshot: snapshot}
+		ctx := context.Background()
+		oldC := &model.Collection{CollectionID: collectionID, Fields: []*model.Field{{FieldID: 100}}}
+		newC := &model.Collection{CollectionID: collectionID, Fields: []*model.Field{{FieldID: 100}, {FieldID: 101}}}
+		err := kc.AlterCollection(ctx, oldC, newC, metastore.MODIFY, 0)
+		assert.NoError(t, err)
+	})
+
 	t.Run("modify, tenant id changed", func(t *testing.T) {
 		kc := &Catalog{}
 		ctx := context.Background()
@@ -1056,6 +1073,95 @@ func TestCatalog_AlterCollection(t *testing.T) {
 	})
 }
 
+func TestCatalog_RenameCollection(t *testing.T) {
+	granteeKey := funcutil.HandleTenantForEtcdKey(GranteePrefix, util.DefaultTenant, "")
+
+	t.Run("collection id changed", func(t *testing.T) {
+		kc := &Catalog{}
+		oldC := &model.Collection{CollectionID: 1}
+		newC := &model.Collection{CollectionID: 2}
+		err := kc.RenameCollection(context.Background(), util.DefaultDBName, util.DefaultDBName, oldC, newC, nil, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("move to another db with aliases and grants", func(t *testing.T) {
+		var collectionID int64 = 1
+		txn := mocks.NewTxnKV(t)
+		txn.EXPECT().LoadWithPrefix(granteeKey).Return([]string{
+			granteeKey + "/role0/Global/old",
+			granteeKey + "/role0/Collection/other",
+			granteeKey + "/role0/Collection/" + funcutil.CombinePartitionObjectName("older", "p1"),
+			granteeKey + "/role0/Collection/" + funcutil.CombineObjectName("db2", "old"),
+			granteeKey + "/role1/Collection/old",
+			granteeKey + "/role2/Collection/" + funcutil.CombineObjectName(util.DefaultDBName, "old"),
+			granteeKey + "/role4/Collection/" + funcutil.CombinePartitionObjectName("old", "p1"),
+		}, []string{"id0", "id3", "id5", "id6", "id1", "id2", "id4"}, nil)
+
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.MultiSaveAndRemoveWithRawFunc = func(saves map[string]string, removals []string, rawSaves map[string]string, rawRemovals []string, ts typeutil.Timestamp) error {
+			assert.ElementsMatch(t, []string{BuildCollectionKey(0, collectionID), BuildAliasKeyWithDB(0, "alias")}, removals)
+			assert.ElementsMatch(t, []string{BuildCollectionKey(1, collectionID), BuildAliasKeyWithDB(1, "alias")}, maps.Keys(saves))
+
+			var aliasPb pb.AliasInfo
+			err := proto.Unmarshal([]byte(saves[BuildAliasKeyWithDB(1, "alias")]), &aliasPb)
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), aliasPb.GetDbId())
+			assert.Equal(t, collectionID, aliasPb.GetCollectionId())
+
+			newName := funcutil.CombineObjectName("db1", "new")
+			assert.Equal(t, map[string]string{
+				granteeKey + "/role1/Collection/" + newName:                                            "id1",
+				granteeKey + "/role2/Collection/" + newName:                                            "id2",
+				granteeKey + "/role4/Collection/" + funcutil.CombinePartitionObjectName(newName, "p1"): "id4",
+			}, rawSaves)
+			assert.ElementsMatch(t, []string{
+				granteeKey + "/role1/Collection/old",
+				granteeKey + "/role2/Collection/" + funcutil.CombineObjectName(util.DefaultDBName, "old"),
+				granteeKey + "/role4/Collection/" + funcutil.CombinePartitionObjectName("old", "p1"),
+			}, rawRemovals)
+			return nil
+		}
+
+		kc := &Catalog{Txn: txn, Snapshot: snapshot}
+		oldC := &model.Collection{DBID: 0, CollectionID: collectionID, Name: "old", State: pb.CollectionState_CollectionCreated}
+		newC := &model.Collection{DBID: 1, CollectionID: collectionID, Name: "new", State: pb.CollectionState_CollectionCreated}
+		aliases := []*model.Alias{{Name: "alias", CollectionID: collectionID, DbID: 0}}
+		err := kc.RenameCollection(context.Background(), util.DefaultDBName, "db1", oldC, newC, aliases, 0)
+		assert.NoError(t, err)
+	})
+
+	t.Run("rename in the same db", func(t *testing.T) {
+		var collectionID int64 = 1
+		txn := mocks.NewTxnKV(t)
+		txn.EXPECT().LoadWithPrefix(granteeKey).Return(nil, nil, nil)
+		snapshot := kv.NewMockSnapshotKV()
+		snapshot.MultiSaveAndRemoveWithRawFunc = func(saves map[string]string, removals []string, rawSaves map[string]string, rawRemovals []string, ts typeutil.Timestamp) error {
+			assert.Empty(t, removals)
+			assert.ElementsMatch(t, []string{BuildCollectionKey(1, collectionID)}, maps.Keys(saves))
+			assert.Empty(t, rawSaves)
+			assert.Empty(t, rawRemovals)
+			return errors.New("error mock MultiSaveAndRemoveWithRaw")
+		}
+
+		kc := &Catalog{Txn: txn, Snapshot: snapshot}
+		oldC := &model.Collection{DBID: 1, CollectionID: collectionID, Name: "old"}
+		newC := &model.Collection{DBID: 1, CollectionID: collectionID, Name: "new"}
+		err := kc.RenameCollection(context.Background(), "db1", "db1", oldC, newC, nil, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("load grants fail", func(t *testing.T) {
+		txn := mocks.NewTxnKV(t)
+		txn.EXPECT().LoadWithPrefix(granteeKey).Return(nil, nil, errors.New("mock load error"))
+
+		kc := &Catalog{Txn: txn, Snapshot: kv.NewMockSnapshotKV()}
+		oldC := &model.Collection{DBID: 1, CollectionID: 1, Name: "old"}
+		newC := &model.Collection{DBID: 1, CollectionID: 1, Name: "new"}
+		err := kc.RenameCollection(context.Background(), "db1", "db1", oldC, newC, nil, 0)
+		assert.Error(t, err)
+	})
+}
+
 func TestCatalog_AlterPartition(t *testing.T) {
 	t.Run("add", func(t *testing.T) {
 		kc := &Catalog{}
@@ -2551,3 +2657,75 @@ func TestCatalog_AlterDatabase(t *testing.T) {
 	err = c.AlterDatabase(ctx, newDB, typeutil.ZeroTimestamp)
 	assert.ErrorIs(t, err, mockErr)
 }
+
+func TestCatalog_APIKey(t *testing.T) {
+	ctx := context.TODO()
+	apiKey := &model.APIKey{
+		Name:       "key1",
+		SecretHash: "hash",
+		DBNames:    []string{"db1"},
+		Roles:      []string{"role1"},
+		CreatedAt:  100,
+	}
+	k := fmt.Sprintf("%s/%s", APIKeyPrefix, apiKey.Name)
+	v, err := json.Marshal(apiKey)
+	require.NoError(t, err)
+
+	t.Run("save", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := &Catalog{Txn: kvmock}
+		kvmock.EXPECT().Save(k, string(v)).Return(nil).Once()
+		assert.NoError(t, c.SaveAPIKey(ctx, apiKey))
+
+		kvmock.EXPECT().Save(k, string(v)).Return(errors.New("mock save error")).Once()
+		assert.Error(t, c.SaveAPIKey(ctx, apiKey))
+	})
+
+	t.Run("get", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := &Catalog{Txn: kvmock}
+		kvmock.EXPECT().Load(k).Return(string(v), nil).Once()
+		ret, err := c.GetAPIKey(ctx, apiKey.Name)
+		assert.NoError(t, err)
+		assert.Equal(t, apiKey, ret)
+
+		kvmock.EXPECT().Load(k).Return("", merr.WrapErrIoKeyNotFound(k)).Once()
+		_, err = c.GetAPIKey(ctx, apiKey.Name)
+		assert.ErrorIs(t, err, merr.ErrAPIKeyNotFound)
+
+		kvmock.EXPECT().Load(k).Return("", errors.New("mock load error")).Once()
+		_, err = c.GetAPIKey(ctx, apiKey.Name)
+		assert.Error(t, err)
+
+		kvmock.EXPECT().Load(k).Return("random", nil).Once()
+		_, err = c.GetAPIKey(ctx, apiKey.Name)
+		assert.Error(t, err)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := &Catalog{Txn: kvmock}
+		kvmock.EXPECT().Remove(k).Return(nil).Once()
+		assert.NoError(t, c.DropAPIKey(ctx, apiKey.Name))
+
+		kvmock.EXPECT().Remove(k).Return(errors.New("mock remove error")).Once()
+		assert.Error(t, c.DropAPIKey(ctx, apiKey.Name))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		kvmock := mocks.NewTxnKV(t)
+		c := &Catalog{Txn: kvmock}
+		kvmock.EXPECT().LoadWithPrefix(APIKeyPrefix+"/").Return([]string{k}, []string{string(v)}, nil).Once()
+		ret, err := c.ListAPIKeys(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.APIKey{apiKey}, ret)
+
+		kvmock.EXPECT().LoadWithPrefix(APIKeyPrefix+"/").Return([]string{k}, []string{"random"}, nil).Once()
+		_, err = c.ListAPIKeys(ctx)
+		assert.Error(t, err)
+
+		kvmock.EXPECT().LoadWithPrefix(APIKeyPrefix+"/").Return(nil, nil, errors.New("mock load error")).Once()
+		_, err = c.ListAPIKeys(ctx)
+		assert.Error(t, err)
+	})
+}
@@ -49,6 +49,9 @@ const (
 
 	// GranteeIDPrefix prefix for mapping among privilege and grantor
 	GranteeIDPrefix = ComponentPrefix + CommonCredentialPrefix + "/grantee-id"
+
+	// APIKeyPrefix prefix for api key
+	APIKeyPrefix = ComponentPrefix + CommonCredentialPrefix + "/apikeys"
 )
 
 func BuildDatabasePrefixWithDBID(dbID int64) string {
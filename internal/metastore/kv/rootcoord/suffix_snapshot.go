@@ -509,10 +509,40 @@ func (ss *SuffixSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string,
 	if ts == 0 {
 		return ss.MetaKv.MultiSaveAndRemoveWithPrefix(saves, removals)
 	}
+	return ss.MultiSaveAndRemoveWithRaw(saves, removals, nil, nil, ts)
+}
+
+// MultiSaveAndRemoveWithRaw works like MultiSaveAndRemoveWithPrefix, and saves and removes
+// the raw kvs without snapshots in the same transaction
+func (ss *SuffixSnapshot) MultiSaveAndRemoveWithRaw(saves map[string]string, removals []string, rawSaves map[string]string, rawRemovals []string, ts typeutil.Timestamp) error {
 	ss.Lock()
 	defer ss.Unlock()
 	var err error
 
+	// if ts == 0, act like MetaKv
+	if ts == 0 {
+		execute := make(map[string]string, len(saves)+len(rawSaves))
+		for key, value := range saves {
+			execute[key] = value
+		}
+		deletes := make([]string, 0, len(removals)+len(rawRemovals))
+		for _, removal := range removals {
+			keys, _, err := ss.MetaKv.LoadWithPrefix(removal)
+			if err != nil {
+				log.Warn("SuffixSnapshot MetaKv LoadwithPrefix failed", zap.String("key", removal), zap.Error(err))
+				return err
+			}
+			for _, key := range keys {
+				deletes = append(deletes, ss.hideRootPrefix(key))
+			}
+		}
+		for key, value := range rawSaves {
+			execute[key] = value
+		}
+		deletes = append(deletes, rawRemovals...)
+		return ss.MetaKv.MultiSaveAndRemove(execute, deletes)
+	}
+
 	// process each key, checks whether is the latest
 	execute, updateList, err := ss.generateSaveExecute(saves, ts)
 	if err != nil {
@@ -536,8 +566,17 @@ func (ss *SuffixSnapshot) MultiSaveAndRemoveWithPrefix(saves map[string]string,
 		}
 	}
 
+	// the raw kvs are written as they are
+	for key, value := range rawSaves {
+		execute[key] = value
+	}
+
 	// multi save execute map; if succeeds, update ts in the update list
-	err = ss.MetaKv.MultiSave(execute)
+	if len(rawRemovals) > 0 {
+		err = ss.MetaKv.MultiSaveAndRemove(execute, rawRemovals)
+	} else {
+		err = ss.MetaKv.MultiSave(execute)
+	}
 	if err == nil {
 		for _, key := range updateList {
 			ss.lastestTS[key] = ts
@@ -30,6 +30,7 @@ import (
 
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/kv/mocks"
+	"github.com/milvus-io/milvus/internal/kv/predicates"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
@@ -673,6 +674,57 @@ func Test_SuffixSnapshotMultiSaveAndRemoveWithPrefix(t *testing.T) {
 	ss.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{""}, 0)
 }
 
+func TestSuffixSnapshot_MultiSaveAndRemoveWithRaw(t *testing.T) {
+	sep := "_ts"
+	rootPath := "root/"
+
+	t.Run("with ts", func(t *testing.T) {
+		kv := mocks.NewMetaKv(t)
+		ss, err := NewSuffixSnapshot(kv, sep, rootPath, snapshotPrefix)
+		require.NoError(t, err)
+
+		kv.EXPECT().LoadWithPrefix(ss.composeSnapshotPrefix("k1")).Return(nil, nil, nil)
+		kv.EXPECT().LoadWithPrefix("k2").Return([]string{rootPath + "k2"}, []string{"v2"}, nil)
+		kv.EXPECT().MultiSaveAndRemove(mock.Anything, mock.Anything).Run(func(saves map[string]string, removals []string, preds ...predicates.Predicate) {
+			assert.Equal(t, map[string]string{
+				"k1":                       "v1",
+				ss.composeTSKey("k1", 100): "v1",
+				"k2":                       string(SuffixSnapshotTombstone),
+				ss.composeTSKey("k2", 100): string(SuffixSnapshotTombstone),
+				"raw1":                     "r1",
+			}, saves)
+			assert.Equal(t, []string{"raw2"}, removals)
+		}).Return(nil)
+
+		err = ss.MultiSaveAndRemoveWithRaw(map[string]string{"k1": "v1"}, []string{"k2"}, map[string]string{"raw1": "r1"}, []string{"raw2"}, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, typeutil.Timestamp(100), ss.lastestTS["k1"])
+		assert.Equal(t, typeutil.Timestamp(100), ss.lastestTS["k2"])
+	})
+
+	t.Run("without ts", func(t *testing.T) {
+		kv := mocks.NewMetaKv(t)
+		ss, err := NewSuffixSnapshot(kv, sep, rootPath, snapshotPrefix)
+		require.NoError(t, err)
+
+		kv.EXPECT().LoadWithPrefix("k2").Return([]string{rootPath + "k2"}, []string{"v2"}, nil)
+		kv.EXPECT().MultiSaveAndRemove(map[string]string{"k1": "v1", "raw1": "r1"}, []string{"k2", "raw2"}).Return(errors.New("mock"))
+
+		err = ss.MultiSaveAndRemoveWithRaw(map[string]string{"k1": "v1"}, []string{"k2"}, map[string]string{"raw1": "r1"}, []string{"raw2"}, 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("load removal fail", func(t *testing.T) {
+		kv := mocks.NewMetaKv(t)
+		ss, err := NewSuffixSnapshot(kv, sep, rootPath, snapshotPrefix)
+		require.NoError(t, err)
+
+		kv.EXPECT().LoadWithPrefix("k2").Return(nil, nil, errors.New("mock"))
+		err = ss.MultiSaveAndRemoveWithRaw(nil, []string{"k2"}, map[string]string{"raw1": "r1"}, nil, 100)
+		assert.Error(t, err)
+	})
+}
+
 func TestSuffixSnapshot_LoadWithPrefix(t *testing.T) {
 	rand.Seed(time.Now().UnixNano())
 	randVal := rand.Int()
@@ -430,6 +430,50 @@ func (_c *DataCoordCatalog_DropChannelCheckpoint_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// DropIdempotencyRecord provides a mock function with given fields: ctx, collectionID, key
+func (_m *DataCoordCatalog) DropIdempotencyRecord(ctx context.Context, collectionID int64, key string) error {
+	ret := _m.Called(ctx, collectionID, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, collectionID, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropIdempotencyRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropIdempotencyRecord'
+type DataCoordCatalog_DropIdempotencyRecord_Call struct {
+	*mock.Call
+}
+
+// DropIdempotencyRecord is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - key string
+func (_e *DataCoordCatalog_Expecter) DropIdempotencyRecord(ctx interface{}, collectionID interface{}, key interface{}) *DataCoordCatalog_DropIdempotencyRecord_Call {
+	return &DataCoordCatalog_DropIdempotencyRecord_Call{Call: _e.mock.On("DropIdempotencyRecord", ctx, collectionID, key)}
+}
+
+func (_c *DataCoordCatalog_DropIdempotencyRecord_Call) Run(run func(ctx context.Context, collectionID int64, key string)) *DataCoordCatalog_DropIdempotencyRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropIdempotencyRecord_Call) Return(_a0 error) *DataCoordCatalog_DropIdempotencyRecord_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropIdempotencyRecord_Call) RunAndReturn(run func(context.Context, int64, string) error) *DataCoordCatalog_DropIdempotencyRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropImportJob provides a mock function with given fields: jobID
 func (_m *DataCoordCatalog) DropImportJob(jobID int64) error {
 	ret := _m.Called(jobID)
@@ -558,6 +602,49 @@ func (_c *DataCoordCatalog_DropIndex_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// DropIngestionPause provides a mock function with given fields: ctx, collectionID
+func (_m *DataCoordCatalog) DropIngestionPause(ctx context.Context, collectionID int64) error {
+	ret := _m.Called(ctx, collectionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropIngestionPause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropIngestionPause'
+type DataCoordCatalog_DropIngestionPause_Call struct {
+	*mock.Call
+}
+
+// DropIngestionPause is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *DataCoordCatalog_Expecter) DropIngestionPause(ctx interface{}, collectionID interface{}) *DataCoordCatalog_DropIngestionPause_Call {
+	return &DataCoordCatalog_DropIngestionPause_Call{Call: _e.mock.On("DropIngestionPause", ctx, collectionID)}
+}
+
+func (_c *DataCoordCatalog_DropIngestionPause_Call) Run(run func(ctx context.Context, collectionID int64)) *DataCoordCatalog_DropIngestionPause_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropIngestionPause_Call) Return(_a0 error) *DataCoordCatalog_DropIngestionPause_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropIngestionPause_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_DropIngestionPause_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropPreImportTask provides a mock function with given fields: taskID
 func (_m *DataCoordCatalog) DropPreImportTask(taskID int64) error {
 	ret := _m.Called(taskID)
@@ -689,6 +776,50 @@ func (_c *DataCoordCatalog_DropSegmentIndex_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// DropSnapshot provides a mock function with given fields: ctx, collectionID, name
+func (_m *DataCoordCatalog) DropSnapshot(ctx context.Context, collectionID int64, name string) error {
+	ret := _m.Called(ctx, collectionID, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, collectionID, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type DataCoordCatalog_DropSnapshot_Call struct {
+	*mock.Call
+}
+
+// DropSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - name string
+func (_e *DataCoordCatalog_Expecter) DropSnapshot(ctx interface{}, collectionID interface{}, name interface{}) *DataCoordCatalog_DropSnapshot_Call {
+	return &DataCoordCatalog_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot", ctx, collectionID, name)}
+}
+
+func (_c *DataCoordCatalog_DropSnapshot_Call) Run(run func(ctx context.Context, collectionID int64, name string)) *DataCoordCatalog_DropSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropSnapshot_Call) Return(_a0 error) *DataCoordCatalog_DropSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_DropSnapshot_Call) RunAndReturn(run func(context.Context, int64, string) error) *DataCoordCatalog_DropSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GcConfirm provides a mock function with given fields: ctx, collectionID, partitionID
 func (_m *DataCoordCatalog) GcConfirm(ctx context.Context, collectionID int64, partitionID int64) bool {
 	ret := _m.Called(ctx, collectionID, partitionID)
@@ -787,6 +918,60 @@ func (_c *DataCoordCatalog_ListChannelCheckpoint_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// ListIdempotencyRecords provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListIdempotencyRecords(ctx context.Context) ([]*datapb.IdempotencyRecord, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*datapb.IdempotencyRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.IdempotencyRecord, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.IdempotencyRecord); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.IdempotencyRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListIdempotencyRecords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIdempotencyRecords'
+type DataCoordCatalog_ListIdempotencyRecords_Call struct {
+	*mock.Call
+}
+
+// ListIdempotencyRecords is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListIdempotencyRecords(ctx interface{}) *DataCoordCatalog_ListIdempotencyRecords_Call {
+	return &DataCoordCatalog_ListIdempotencyRecords_Call{Call: _e.mock.On("ListIdempotencyRecords", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListIdempotencyRecords_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListIdempotencyRecords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListIdempotencyRecords_Call) Return(_a0 []*datapb.IdempotencyRecord, _a1 error) *DataCoordCatalog_ListIdempotencyRecords_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListIdempotencyRecords_Call) RunAndReturn(run func(context.Context) ([]*datapb.IdempotencyRecord, error)) *DataCoordCatalog_ListIdempotencyRecords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListImportJobs provides a mock function with given fields:
 func (_m *DataCoordCatalog) ListImportJobs() ([]*datapb.ImportJob, error) {
 	ret := _m.Called()
@@ -947,6 +1132,60 @@ func (_c *DataCoordCatalog_ListIndexes_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// ListIngestionPauses provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListIngestionPauses(ctx context.Context) ([]int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []int64); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListIngestionPauses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIngestionPauses'
+type DataCoordCatalog_ListIngestionPauses_Call struct {
+	*mock.Call
+}
+
+// ListIngestionPauses is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListIngestionPauses(ctx interface{}) *DataCoordCatalog_ListIngestionPauses_Call {
+	return &DataCoordCatalog_ListIngestionPauses_Call{Call: _e.mock.On("ListIngestionPauses", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListIngestionPauses_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListIngestionPauses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListIngestionPauses_Call) Return(_a0 []int64, _a1 error) *DataCoordCatalog_ListIngestionPauses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListIngestionPauses_Call) RunAndReturn(run func(context.Context) ([]int64, error)) *DataCoordCatalog_ListIngestionPauses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListPreImportTasks provides a mock function with given fields:
 func (_m *DataCoordCatalog) ListPreImportTasks() ([]*datapb.PreImportTask, error) {
 	ret := _m.Called()
@@ -1108,6 +1347,60 @@ func (_c *DataCoordCatalog_ListSegments_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ListSnapshots provides a mock function with given fields: ctx
+func (_m *DataCoordCatalog) ListSnapshots(ctx context.Context) ([]*datapb.CollectionSnapshot, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*datapb.CollectionSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*datapb.CollectionSnapshot, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*datapb.CollectionSnapshot); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.CollectionSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataCoordCatalog_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type DataCoordCatalog_ListSnapshots_Call struct {
+	*mock.Call
+}
+
+// ListSnapshots is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *DataCoordCatalog_Expecter) ListSnapshots(ctx interface{}) *DataCoordCatalog_ListSnapshots_Call {
+	return &DataCoordCatalog_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots", ctx)}
+}
+
+func (_c *DataCoordCatalog_ListSnapshots_Call) Run(run func(ctx context.Context)) *DataCoordCatalog_ListSnapshots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListSnapshots_Call) Return(_a0 []*datapb.CollectionSnapshot, _a1 error) *DataCoordCatalog_ListSnapshots_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataCoordCatalog_ListSnapshots_Call) RunAndReturn(run func(context.Context) ([]*datapb.CollectionSnapshot, error)) *DataCoordCatalog_ListSnapshots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // MarkChannelAdded provides a mock function with given fields: ctx, channel
 func (_m *DataCoordCatalog) MarkChannelAdded(ctx context.Context, channel string) error {
 	ret := _m.Called(ctx, channel)
@@ -1324,6 +1617,49 @@ func (_c *DataCoordCatalog_SaveDroppedSegmentsInBatch_Call) RunAndReturn(run fun
 	return _c
 }
 
+// SaveIdempotencyRecord provides a mock function with given fields: ctx, record
+func (_m *DataCoordCatalog) SaveIdempotencyRecord(ctx context.Context, record *datapb.IdempotencyRecord) error {
+	ret := _m.Called(ctx, record)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.IdempotencyRecord) error); ok {
+		r0 = rf(ctx, record)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveIdempotencyRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIdempotencyRecord'
+type DataCoordCatalog_SaveIdempotencyRecord_Call struct {
+	*mock.Call
+}
+
+// SaveIdempotencyRecord is a helper method to define mock.On call
+//   - ctx context.Context
+//   - record *datapb.IdempotencyRecord
+func (_e *DataCoordCatalog_Expecter) SaveIdempotencyRecord(ctx interface{}, record interface{}) *DataCoordCatalog_SaveIdempotencyRecord_Call {
+	return &DataCoordCatalog_SaveIdempotencyRecord_Call{Call: _e.mock.On("SaveIdempotencyRecord", ctx, record)}
+}
+
+func (_c *DataCoordCatalog_SaveIdempotencyRecord_Call) Run(run func(ctx context.Context, record *datapb.IdempotencyRecord)) *DataCoordCatalog_SaveIdempotencyRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.IdempotencyRecord))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveIdempotencyRecord_Call) Return(_a0 error) *DataCoordCatalog_SaveIdempotencyRecord_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveIdempotencyRecord_Call) RunAndReturn(run func(context.Context, *datapb.IdempotencyRecord) error) *DataCoordCatalog_SaveIdempotencyRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveImportJob provides a mock function with given fields: job
 func (_m *DataCoordCatalog) SaveImportJob(job *datapb.ImportJob) error {
 	ret := _m.Called(job)
@@ -1408,6 +1744,49 @@ func (_c *DataCoordCatalog_SaveImportTask_Call) RunAndReturn(run func(*datapb.Im
 	return _c
 }
 
+// SaveIngestionPause provides a mock function with given fields: ctx, collectionID
+func (_m *DataCoordCatalog) SaveIngestionPause(ctx context.Context, collectionID int64) error {
+	ret := _m.Called(ctx, collectionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveIngestionPause_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIngestionPause'
+type DataCoordCatalog_SaveIngestionPause_Call struct {
+	*mock.Call
+}
+
+// SaveIngestionPause is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *DataCoordCatalog_Expecter) SaveIngestionPause(ctx interface{}, collectionID interface{}) *DataCoordCatalog_SaveIngestionPause_Call {
+	return &DataCoordCatalog_SaveIngestionPause_Call{Call: _e.mock.On("SaveIngestionPause", ctx, collectionID)}
+}
+
+func (_c *DataCoordCatalog_SaveIngestionPause_Call) Run(run func(ctx context.Context, collectionID int64)) *DataCoordCatalog_SaveIngestionPause_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveIngestionPause_Call) Return(_a0 error) *DataCoordCatalog_SaveIngestionPause_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveIngestionPause_Call) RunAndReturn(run func(context.Context, int64) error) *DataCoordCatalog_SaveIngestionPause_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SavePreImportTask provides a mock function with given fields: task
 func (_m *DataCoordCatalog) SavePreImportTask(task *datapb.PreImportTask) error {
 	ret := _m.Called(task)
@@ -1450,6 +1829,49 @@ func (_c *DataCoordCatalog_SavePreImportTask_Call) RunAndReturn(run func(*datapb
 	return _c
 }
 
+// SaveSnapshot provides a mock function with given fields: ctx, snapshot
+func (_m *DataCoordCatalog) SaveSnapshot(ctx context.Context, snapshot *datapb.CollectionSnapshot) error {
+	ret := _m.Called(ctx, snapshot)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CollectionSnapshot) error); ok {
+		r0 = rf(ctx, snapshot)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DataCoordCatalog_SaveSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveSnapshot'
+type DataCoordCatalog_SaveSnapshot_Call struct {
+	*mock.Call
+}
+
+// SaveSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - snapshot *datapb.CollectionSnapshot
+func (_e *DataCoordCatalog_Expecter) SaveSnapshot(ctx interface{}, snapshot interface{}) *DataCoordCatalog_SaveSnapshot_Call {
+	return &DataCoordCatalog_SaveSnapshot_Call{Call: _e.mock.On("SaveSnapshot", ctx, snapshot)}
+}
+
+func (_c *DataCoordCatalog_SaveSnapshot_Call) Run(run func(ctx context.Context, snapshot *datapb.CollectionSnapshot)) *DataCoordCatalog_SaveSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.CollectionSnapshot))
+	})
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveSnapshot_Call) Return(_a0 error) *DataCoordCatalog_SaveSnapshot_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *DataCoordCatalog_SaveSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.CollectionSnapshot) error) *DataCoordCatalog_SaveSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShouldDropChannel provides a mock function with given fields: ctx, channel
 func (_m *DataCoordCatalog) ShouldDropChannel(ctx context.Context, channel string) bool {
 	ret := _m.Called(ctx, channel)
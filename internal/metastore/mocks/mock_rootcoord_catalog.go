@@ -726,6 +726,49 @@ func (_c *RootCoordCatalog_DeleteGrant_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// DropAPIKey provides a mock function with given fields: ctx, name
+func (_m *RootCoordCatalog) DropAPIKey(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_DropAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropAPIKey'
+type RootCoordCatalog_DropAPIKey_Call struct {
+	*mock.Call
+}
+
+// DropAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *RootCoordCatalog_Expecter) DropAPIKey(ctx interface{}, name interface{}) *RootCoordCatalog_DropAPIKey_Call {
+	return &RootCoordCatalog_DropAPIKey_Call{Call: _e.mock.On("DropAPIKey", ctx, name)}
+}
+
+func (_c *RootCoordCatalog_DropAPIKey_Call) Run(run func(ctx context.Context, name string)) *RootCoordCatalog_DropAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropAPIKey_Call) Return(_a0 error) *RootCoordCatalog_DropAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_DropAPIKey_Call) RunAndReturn(run func(context.Context, string) error) *RootCoordCatalog_DropAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropAlias provides a mock function with given fields: ctx, dbID, alias, ts
 func (_m *RootCoordCatalog) DropAlias(ctx context.Context, dbID int64, alias string, ts uint64) error {
 	ret := _m.Called(ctx, dbID, alias, ts)
@@ -992,6 +1035,61 @@ func (_c *RootCoordCatalog_DropRole_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetAPIKey provides a mock function with given fields: ctx, name
+func (_m *RootCoordCatalog) GetAPIKey(ctx context.Context, name string) (*model.APIKey, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.APIKey, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.APIKey); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_GetAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKey'
+type RootCoordCatalog_GetAPIKey_Call struct {
+	*mock.Call
+}
+
+// GetAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *RootCoordCatalog_Expecter) GetAPIKey(ctx interface{}, name interface{}) *RootCoordCatalog_GetAPIKey_Call {
+	return &RootCoordCatalog_GetAPIKey_Call{Call: _e.mock.On("GetAPIKey", ctx, name)}
+}
+
+func (_c *RootCoordCatalog_GetAPIKey_Call) Run(run func(ctx context.Context, name string)) *RootCoordCatalog_GetAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_GetAPIKey_Call) Return(_a0 *model.APIKey, _a1 error) *RootCoordCatalog_GetAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_GetAPIKey_Call) RunAndReturn(run func(context.Context, string) (*model.APIKey, error)) *RootCoordCatalog_GetAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionByID provides a mock function with given fields: ctx, dbID, ts, collectionID
 func (_m *RootCoordCatalog) GetCollectionByID(ctx context.Context, dbID int64, ts uint64, collectionID int64) (*model.Collection, error) {
 	ret := _m.Called(ctx, dbID, ts, collectionID)
@@ -1161,6 +1259,60 @@ func (_c *RootCoordCatalog_GetCredential_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields: ctx
+func (_m *RootCoordCatalog) ListAPIKeys(ctx context.Context) ([]*model.APIKey, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.APIKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.APIKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type RootCoordCatalog_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RootCoordCatalog_Expecter) ListAPIKeys(ctx interface{}) *RootCoordCatalog_ListAPIKeys_Call {
+	return &RootCoordCatalog_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", ctx)}
+}
+
+func (_c *RootCoordCatalog_ListAPIKeys_Call) Run(run func(ctx context.Context)) *RootCoordCatalog_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListAPIKeys_Call) Return(_a0 []*model.APIKey, _a1 error) *RootCoordCatalog_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListAPIKeys_Call) RunAndReturn(run func(context.Context) ([]*model.APIKey, error)) *RootCoordCatalog_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAliases provides a mock function with given fields: ctx, dbID, ts
 func (_m *RootCoordCatalog) ListAliases(ctx context.Context, dbID int64, ts uint64) ([]*model.Alias, error) {
 	ret := _m.Called(ctx, dbID, ts)
@@ -1662,6 +1814,97 @@ func (_c *RootCoordCatalog_ListUserRole_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// RenameCollection provides a mock function with given fields: ctx, oldDBName, newDBName, oldColl, newColl, aliases, ts
+func (_m *RootCoordCatalog) RenameCollection(ctx context.Context, oldDBName string, newDBName string, oldColl *model.Collection, newColl *model.Collection, aliases []*model.Alias, ts uint64) error {
+	ret := _m.Called(ctx, oldDBName, newDBName, oldColl, newColl, aliases, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *model.Collection, *model.Collection, []*model.Alias, uint64) error); ok {
+		r0 = rf(ctx, oldDBName, newDBName, oldColl, newColl, aliases, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_RenameCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameCollection'
+type RootCoordCatalog_RenameCollection_Call struct {
+	*mock.Call
+}
+
+// RenameCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldDBName string
+//   - newDBName string
+//   - oldColl *model.Collection
+//   - newColl *model.Collection
+//   - aliases []*model.Alias
+//   - ts uint64
+func (_e *RootCoordCatalog_Expecter) RenameCollection(ctx interface{}, oldDBName interface{}, newDBName interface{}, oldColl interface{}, newColl interface{}, aliases interface{}, ts interface{}) *RootCoordCatalog_RenameCollection_Call {
+	return &RootCoordCatalog_RenameCollection_Call{Call: _e.mock.On("RenameCollection", ctx, oldDBName, newDBName, oldColl, newColl, aliases, ts)}
+}
+
+func (_c *RootCoordCatalog_RenameCollection_Call) Run(run func(ctx context.Context, oldDBName string, newDBName string, oldColl *model.Collection, newColl *model.Collection, aliases []*model.Alias, ts uint64)) *RootCoordCatalog_RenameCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*model.Collection), args[4].(*model.Collection), args[5].([]*model.Alias), args[6].(uint64))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_RenameCollection_Call) Return(_a0 error) *RootCoordCatalog_RenameCollection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_RenameCollection_Call) RunAndReturn(run func(context.Context, string, string, *model.Collection, *model.Collection, []*model.Alias, uint64) error) *RootCoordCatalog_RenameCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveAPIKey provides a mock function with given fields: ctx, apiKey
+func (_m *RootCoordCatalog) SaveAPIKey(ctx context.Context, apiKey *model.APIKey) error {
+	ret := _m.Called(ctx, apiKey)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.APIKey) error); ok {
+		r0 = rf(ctx, apiKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_SaveAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveAPIKey'
+type RootCoordCatalog_SaveAPIKey_Call struct {
+	*mock.Call
+}
+
+// SaveAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - apiKey *model.APIKey
+func (_e *RootCoordCatalog_Expecter) SaveAPIKey(ctx interface{}, apiKey interface{}) *RootCoordCatalog_SaveAPIKey_Call {
+	return &RootCoordCatalog_SaveAPIKey_Call{Call: _e.mock.On("SaveAPIKey", ctx, apiKey)}
+}
+
+func (_c *RootCoordCatalog_SaveAPIKey_Call) Run(run func(ctx context.Context, apiKey *model.APIKey)) *RootCoordCatalog_SaveAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.APIKey))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveAPIKey_Call) Return(_a0 error) *RootCoordCatalog_SaveAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_SaveAPIKey_Call) RunAndReturn(run func(context.Context, *model.APIKey) error) *RootCoordCatalog_SaveAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRootCoordCatalog creates a new instance of RootCoordCatalog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRootCoordCatalog(t interface {
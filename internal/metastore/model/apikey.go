@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+// APIKey is the api key authenticating the applications, only the hash of its secret is stored.
+type APIKey struct {
+	Name       string   `json:"name"`
+	SecretHash string   `json:"secret_hash"`
+	DBNames    []string `json:"db_names,omitempty"`
+	Roles      []string `json:"roles,omitempty"`
+	// unix time in seconds, the key never expires if ExpireAt is 0
+	ExpireAt  int64 `json:"expire_at,omitempty"`
+	CreatedAt int64 `json:"created_at"`
+	RotatedAt int64 `json:"rotated_at,omitempty"`
+}
+
+// IsExpired returns whether the key is expired at the time.
+func (k *APIKey) IsExpired(now time.Time) bool {
+	return k.ExpireAt != 0 && now.Unix() >= k.ExpireAt
+}
+
+// MarshalAPIKeyModel converts the api key to the api key info, the secret hash is never returned.
+func MarshalAPIKeyModel(k *APIKey) *proxypb.APIKeyInfo {
+	if k == nil {
+		return nil
+	}
+	return &proxypb.APIKeyInfo{
+		Name:      k.Name,
+		DbNames:   k.DBNames,
+		Roles:     k.Roles,
+		ExpireAt:  k.ExpireAt,
+		CreatedAt: k.CreatedAt,
+		RotatedAt: k.RotatedAt,
+	}
+}
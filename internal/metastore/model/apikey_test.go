@@ -0,0 +1,36 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+)
+
+func TestMarshalAPIKeyModel(t *testing.T) {
+	apiKey := &APIKey{
+		Name:       "key1",
+		SecretHash: "xxxx",
+		DBNames:    []string{"db1"},
+		Roles:      []string{"role1"},
+		ExpireAt:   200,
+		CreatedAt:  100,
+		RotatedAt:  150,
+	}
+	assert.Equal(t, &proxypb.APIKeyInfo{
+		Name:      "key1",
+		DbNames:   []string{"db1"},
+		Roles:     []string{"role1"},
+		ExpireAt:  200,
+		CreatedAt: 100,
+		RotatedAt: 150,
+	}, MarshalAPIKeyModel(apiKey))
+	assert.Nil(t, MarshalAPIKeyModel(nil))
+
+	assert.False(t, apiKey.IsExpired(time.Unix(199, 0)))
+	assert.True(t, apiKey.IsExpired(time.Unix(200, 0)))
+	apiKey.ExpireAt = 0
+	assert.False(t, apiKey.IsExpired(time.Unix(300, 0)))
+}
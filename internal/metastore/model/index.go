@@ -19,6 +19,8 @@ type Index struct {
 	IndexParams     []*commonpb.KeyValuePair
 	IsAutoIndex     bool
 	UserIndexParams []*commonpb.KeyValuePair
+	// ReplaceIndexID is the serving index replaced once this index built on all segments
+	ReplaceIndexID int64
 }
 
 func UnmarshalIndexModel(indexInfo *indexpb.FieldIndex) *Index {
@@ -37,6 +39,7 @@ func UnmarshalIndexModel(indexInfo *indexpb.FieldIndex) *Index {
 		IndexParams:     indexInfo.IndexInfo.GetIndexParams(),
 		IsAutoIndex:     indexInfo.IndexInfo.GetIsAutoIndex(),
 		UserIndexParams: indexInfo.IndexInfo.GetUserIndexParams(),
+		ReplaceIndexID:  indexInfo.GetReplaceIndexID(),
 	}
 }
 
@@ -56,8 +59,9 @@ func MarshalIndexModel(index *Index) *indexpb.FieldIndex {
 			IsAutoIndex:     index.IsAutoIndex,
 			UserIndexParams: index.UserIndexParams,
 		},
-		Deleted:    index.IsDeleted,
-		CreateTime: index.CreateTime,
+		Deleted:        index.IsDeleted,
+		CreateTime:     index.CreateTime,
+		ReplaceIndexID: index.ReplaceIndexID,
 	}
 }
 
@@ -124,6 +128,7 @@ func CloneIndex(index *Index) *Index {
 		IndexParams:     make([]*commonpb.KeyValuePair, len(index.IndexParams)),
 		IsAutoIndex:     index.IsAutoIndex,
 		UserIndexParams: make([]*commonpb.KeyValuePair, len(index.UserIndexParams)),
+		ReplaceIndexID:  index.ReplaceIndexID,
 	}
 	for i, param := range index.TypeParams {
 		clonedIndex.TypeParams[i] = proto.Clone(param).(*commonpb.KeyValuePair)
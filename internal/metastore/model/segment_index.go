@@ -25,6 +25,33 @@ type SegmentIndex struct {
 	WriteHandoff        bool
 	CurrentIndexVersion int32
 	IndexStoreVersion   int64
+	// Partitions are the parts of the index built across index nodes, in the order of the rows
+	Partitions []*IndexPartition
+	// CacheKey is the content hash of the source binlogs and the index params
+	CacheKey string
+	// ReusedFrom locates the index files reused from the index cache instead of building
+	ReusedFrom *IndexFilesLocation
+}
+
+// IndexPartition is the index of the contiguous rows of the segment built by a single index node.
+type IndexPartition struct {
+	BuildID       int64
+	NodeID        int64
+	IndexVersion  int64
+	DataIDs       []int64
+	NumRows       int64
+	IndexState    commonpb.IndexState
+	FailReason    string
+	IndexFileKeys []string
+	IndexSize     uint64
+}
+
+// IndexFilesLocation locates the index files of a build.
+type IndexFilesLocation struct {
+	BuildID      int64
+	IndexVersion int64
+	PartitionID  int64
+	SegmentID    int64
 }
 
 func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
@@ -49,6 +76,9 @@ func UnmarshalSegmentIndexModel(segIndex *indexpb.SegmentIndex) *SegmentIndex {
 		IndexSize:           segIndex.SerializeSize,
 		WriteHandoff:        segIndex.WriteHandoff,
 		CurrentIndexVersion: segIndex.GetCurrentIndexVersion(),
+		Partitions:          unmarshalIndexPartitions(segIndex.GetPartitions()),
+		CacheKey:            segIndex.GetCacheKey(),
+		ReusedFrom:          UnmarshalIndexFilesLocation(segIndex.GetReusedFrom()),
 	}
 }
 
@@ -74,6 +104,9 @@ func MarshalSegmentIndexModel(segIdx *SegmentIndex) *indexpb.SegmentIndex {
 		SerializeSize:       segIdx.IndexSize,
 		WriteHandoff:        segIdx.WriteHandoff,
 		CurrentIndexVersion: segIdx.CurrentIndexVersion,
+		Partitions:          marshalIndexPartitions(segIdx.Partitions),
+		CacheKey:            segIdx.CacheKey,
+		ReusedFrom:          MarshalIndexFilesLocation(segIdx.ReusedFrom),
 	}
 }
 
@@ -95,5 +128,97 @@ func CloneSegmentIndex(segIndex *SegmentIndex) *SegmentIndex {
 		IndexSize:           segIndex.IndexSize,
 		WriteHandoff:        segIndex.WriteHandoff,
 		CurrentIndexVersion: segIndex.CurrentIndexVersion,
+		Partitions:          CloneIndexPartitions(segIndex.Partitions),
+		CacheKey:            segIndex.CacheKey,
+		ReusedFrom:          cloneIndexFilesLocation(segIndex.ReusedFrom),
+	}
+}
+
+func unmarshalIndexPartitions(partitions []*indexpb.IndexPartition) []*IndexPartition {
+	if len(partitions) == 0 {
+		return nil
+	}
+	ret := make([]*IndexPartition, 0, len(partitions))
+	for _, partition := range partitions {
+		ret = append(ret, &IndexPartition{
+			BuildID:       partition.GetBuildID(),
+			NodeID:        partition.GetNodeID(),
+			IndexVersion:  partition.GetIndexVersion(),
+			DataIDs:       append([]int64(nil), partition.GetDataIds()...),
+			NumRows:       partition.GetNumRows(),
+			IndexState:    partition.GetState(),
+			FailReason:    partition.GetFailReason(),
+			IndexFileKeys: common.CloneStringList(partition.GetIndexFileKeys()),
+			IndexSize:     partition.GetSerializeSize(),
+		})
+	}
+	return ret
+}
+
+func marshalIndexPartitions(partitions []*IndexPartition) []*indexpb.IndexPartition {
+	if len(partitions) == 0 {
+		return nil
+	}
+	ret := make([]*indexpb.IndexPartition, 0, len(partitions))
+	for _, partition := range partitions {
+		ret = append(ret, &indexpb.IndexPartition{
+			BuildID:       partition.BuildID,
+			NodeID:        partition.NodeID,
+			IndexVersion:  partition.IndexVersion,
+			DataIds:       append([]int64(nil), partition.DataIDs...),
+			NumRows:       partition.NumRows,
+			State:         partition.IndexState,
+			FailReason:    partition.FailReason,
+			IndexFileKeys: common.CloneStringList(partition.IndexFileKeys),
+			SerializeSize: partition.IndexSize,
+		})
+	}
+	return ret
+}
+
+// CloneIndexPartitions returns the deep copy of the partitions.
+func CloneIndexPartitions(partitions []*IndexPartition) []*IndexPartition {
+	if len(partitions) == 0 {
+		return nil
+	}
+	ret := make([]*IndexPartition, 0, len(partitions))
+	for _, partition := range partitions {
+		cloned := *partition
+		cloned.DataIDs = append([]int64(nil), partition.DataIDs...)
+		cloned.IndexFileKeys = common.CloneStringList(partition.IndexFileKeys)
+		ret = append(ret, &cloned)
+	}
+	return ret
+}
+
+func UnmarshalIndexFilesLocation(location *indexpb.IndexFilesLocation) *IndexFilesLocation {
+	if location == nil {
+		return nil
+	}
+	return &IndexFilesLocation{
+		BuildID:      location.GetBuildID(),
+		IndexVersion: location.GetIndexVersion(),
+		PartitionID:  location.GetPartitionID(),
+		SegmentID:    location.GetSegmentID(),
+	}
+}
+
+func MarshalIndexFilesLocation(location *IndexFilesLocation) *indexpb.IndexFilesLocation {
+	if location == nil {
+		return nil
+	}
+	return &indexpb.IndexFilesLocation{
+		BuildID:      location.BuildID,
+		IndexVersion: location.IndexVersion,
+		PartitionID:  location.PartitionID,
+		SegmentID:    location.SegmentID,
+	}
+}
+
+func cloneIndexFilesLocation(location *IndexFilesLocation) *IndexFilesLocation {
+	if location == nil {
+		return nil
 	}
+	cloned := *location
+	return &cloned
 }
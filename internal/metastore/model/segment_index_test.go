@@ -53,3 +53,42 @@ func TestUnmarshalSegmentIndexModel(t *testing.T) {
 	assert.Equal(t, indexModel2.SegmentID, ret.SegmentID)
 	assert.Nil(t, UnmarshalSegmentIndexModel(nil))
 }
+
+func TestSegmentIndexPartitions(t *testing.T) {
+	segIdx := &SegmentIndex{
+		BuildID:    buildID,
+		IndexState: commonpb.IndexState_InProgress,
+		Partitions: []*IndexPartition{
+			{BuildID: 10, NodeID: 1, IndexVersion: 1, DataIDs: []int64{1, 2}, NumRows: 2000, IndexState: commonpb.IndexState_Finished, IndexFileKeys: []string{"a"}, IndexSize: 100},
+			{BuildID: 11, DataIDs: []int64{3}, NumRows: 1000, IndexState: commonpb.IndexState_Unissued, IndexFileKeys: []string{}},
+		},
+	}
+
+	ret := UnmarshalSegmentIndexModel(MarshalSegmentIndexModel(segIdx))
+	assert.Equal(t, segIdx.Partitions, ret.Partitions)
+
+	cloned := CloneSegmentIndex(segIdx)
+	assert.Equal(t, segIdx.Partitions, cloned.Partitions)
+	cloned.Partitions[0].DataIDs[0] = 100
+	cloned.Partitions[1].IndexState = commonpb.IndexState_InProgress
+	assert.EqualValues(t, 1, segIdx.Partitions[0].DataIDs[0])
+	assert.Equal(t, commonpb.IndexState_Unissued, segIdx.Partitions[1].IndexState)
+}
+
+func TestSegmentIndexReusedFrom(t *testing.T) {
+	segIdx := &SegmentIndex{
+		BuildID:    buildID,
+		IndexState: commonpb.IndexState_Finished,
+		CacheKey:   "key",
+		ReusedFrom: &IndexFilesLocation{BuildID: 10, IndexVersion: 1, PartitionID: 2, SegmentID: 3},
+	}
+
+	ret := UnmarshalSegmentIndexModel(MarshalSegmentIndexModel(segIdx))
+	assert.Equal(t, "key", ret.CacheKey)
+	assert.Equal(t, segIdx.ReusedFrom, ret.ReusedFrom)
+
+	cloned := CloneSegmentIndex(segIdx)
+	assert.Equal(t, segIdx.ReusedFrom, cloned.ReusedFrom)
+	cloned.ReusedFrom.BuildID = 11
+	assert.EqualValues(t, 10, segIdx.ReusedFrom.BuildID)
+}
@@ -146,6 +146,61 @@ func (_c *MockDataCoord_AssignSegmentID_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// AuditGarbage provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) AuditGarbage(_a0 context.Context, _a1 *datapb.AuditGarbageRequest) (*datapb.AuditGarbageResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.AuditGarbageResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AuditGarbageRequest) (*datapb.AuditGarbageResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.AuditGarbageRequest) *datapb.AuditGarbageResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.AuditGarbageResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.AuditGarbageRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_AuditGarbage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditGarbage'
+type MockDataCoord_AuditGarbage_Call struct {
+	*mock.Call
+}
+
+// AuditGarbage is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.AuditGarbageRequest
+func (_e *MockDataCoord_Expecter) AuditGarbage(_a0 interface{}, _a1 interface{}) *MockDataCoord_AuditGarbage_Call {
+	return &MockDataCoord_AuditGarbage_Call{Call: _e.mock.On("AuditGarbage", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_AuditGarbage_Call) Run(run func(_a0 context.Context, _a1 *datapb.AuditGarbageRequest)) *MockDataCoord_AuditGarbage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.AuditGarbageRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_AuditGarbage_Call) Return(_a0 *datapb.AuditGarbageResponse, _a1 error) *MockDataCoord_AuditGarbage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_AuditGarbage_Call) RunAndReturn(run func(context.Context, *datapb.AuditGarbageRequest) (*datapb.AuditGarbageResponse, error)) *MockDataCoord_AuditGarbage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // BroadcastAlteredCollection provides a mock function with given fields: _a0, _a1
 func (_m *MockDataCoord) BroadcastAlteredCollection(_a0 context.Context, _a1 *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -201,24 +256,24 @@ func (_c *MockDataCoord_BroadcastAlteredCollection_Call) RunAndReturn(run func(c
 	return _c
 }
 
-// CheckHealth provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
+// CancelCompaction provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CancelCompaction(_a0 context.Context, _a1 *datapb.CancelCompactionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.CheckHealthResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) *milvuspb.CheckHealthResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelCompactionRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.CheckHealthResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CheckHealthRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelCompactionRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -227,45 +282,45 @@ func (_m *MockDataCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHea
 	return r0, r1
 }
 
-// MockDataCoord_CheckHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckHealth'
-type MockDataCoord_CheckHealth_Call struct {
+// MockDataCoord_CancelCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelCompaction'
+type MockDataCoord_CancelCompaction_Call struct {
 	*mock.Call
 }
 
-// CheckHealth is a helper method to define mock.On call
+// CancelCompaction is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.CheckHealthRequest
-func (_e *MockDataCoord_Expecter) CheckHealth(_a0 interface{}, _a1 interface{}) *MockDataCoord_CheckHealth_Call {
-	return &MockDataCoord_CheckHealth_Call{Call: _e.mock.On("CheckHealth", _a0, _a1)}
+//   - _a1 *datapb.CancelCompactionRequest
+func (_e *MockDataCoord_Expecter) CancelCompaction(_a0 interface{}, _a1 interface{}) *MockDataCoord_CancelCompaction_Call {
+	return &MockDataCoord_CancelCompaction_Call{Call: _e.mock.On("CancelCompaction", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_CheckHealth_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest)) *MockDataCoord_CheckHealth_Call {
+func (_c *MockDataCoord_CancelCompaction_Call) Run(run func(_a0 context.Context, _a1 *datapb.CancelCompactionRequest)) *MockDataCoord_CancelCompaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.CheckHealthRequest))
+		run(args[0].(context.Context), args[1].(*datapb.CancelCompactionRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse, _a1 error) *MockDataCoord_CheckHealth_Call {
+func (_c *MockDataCoord_CancelCompaction_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CancelCompaction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_CheckHealth_Call) RunAndReturn(run func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)) *MockDataCoord_CheckHealth_Call {
+func (_c *MockDataCoord_CancelCompaction_Call) RunAndReturn(run func(context.Context, *datapb.CancelCompactionRequest) (*commonpb.Status, error)) *MockDataCoord_CancelCompaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateIndex provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) CreateIndex(_a0 context.Context, _a1 *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
+// CancelIndexTasks provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CancelIndexTasks(_a0 context.Context, _a1 *datapb.CancelIndexTasksRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.CreateIndexRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelIndexTasksRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.CreateIndexRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CancelIndexTasksRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -273,7 +328,7 @@ func (_m *MockDataCoord) CreateIndex(_a0 context.Context, _a1 *indexpb.CreateInd
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.CreateIndexRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CancelIndexTasksRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -282,53 +337,53 @@ func (_m *MockDataCoord) CreateIndex(_a0 context.Context, _a1 *indexpb.CreateInd
 	return r0, r1
 }
 
-// MockDataCoord_CreateIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIndex'
-type MockDataCoord_CreateIndex_Call struct {
+// MockDataCoord_CancelIndexTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelIndexTasks'
+type MockDataCoord_CancelIndexTasks_Call struct {
 	*mock.Call
 }
 
-// CreateIndex is a helper method to define mock.On call
+// CancelIndexTasks is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.CreateIndexRequest
-func (_e *MockDataCoord_Expecter) CreateIndex(_a0 interface{}, _a1 interface{}) *MockDataCoord_CreateIndex_Call {
-	return &MockDataCoord_CreateIndex_Call{Call: _e.mock.On("CreateIndex", _a0, _a1)}
+//   - _a1 *datapb.CancelIndexTasksRequest
+func (_e *MockDataCoord_Expecter) CancelIndexTasks(_a0 interface{}, _a1 interface{}) *MockDataCoord_CancelIndexTasks_Call {
+	return &MockDataCoord_CancelIndexTasks_Call{Call: _e.mock.On("CancelIndexTasks", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_CreateIndex_Call) Run(run func(_a0 context.Context, _a1 *indexpb.CreateIndexRequest)) *MockDataCoord_CreateIndex_Call {
+func (_c *MockDataCoord_CancelIndexTasks_Call) Run(run func(_a0 context.Context, _a1 *datapb.CancelIndexTasksRequest)) *MockDataCoord_CancelIndexTasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.CreateIndexRequest))
+		run(args[0].(context.Context), args[1].(*datapb.CancelIndexTasksRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_CreateIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CreateIndex_Call {
+func (_c *MockDataCoord_CancelIndexTasks_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CancelIndexTasks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_CreateIndex_Call) RunAndReturn(run func(context.Context, *indexpb.CreateIndexRequest) (*commonpb.Status, error)) *MockDataCoord_CreateIndex_Call {
+func (_c *MockDataCoord_CancelIndexTasks_Call) RunAndReturn(run func(context.Context, *datapb.CancelIndexTasksRequest) (*commonpb.Status, error)) *MockDataCoord_CancelIndexTasks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DescribeIndex provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) DescribeIndex(_a0 context.Context, _a1 *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
+// CheckHealth provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *indexpb.DescribeIndexResponse
+	var r0 *milvuspb.CheckHealthResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DescribeIndexRequest) *indexpb.DescribeIndexResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.CheckHealthRequest) *milvuspb.CheckHealthResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.DescribeIndexResponse)
+			r0 = ret.Get(0).(*milvuspb.CheckHealthResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.DescribeIndexRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.CheckHealthRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -337,45 +392,45 @@ func (_m *MockDataCoord) DescribeIndex(_a0 context.Context, _a1 *indexpb.Describ
 	return r0, r1
 }
 
-// MockDataCoord_DescribeIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeIndex'
-type MockDataCoord_DescribeIndex_Call struct {
+// MockDataCoord_CheckHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckHealth'
+type MockDataCoord_CheckHealth_Call struct {
 	*mock.Call
 }
 
-// DescribeIndex is a helper method to define mock.On call
+// CheckHealth is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.DescribeIndexRequest
-func (_e *MockDataCoord_Expecter) DescribeIndex(_a0 interface{}, _a1 interface{}) *MockDataCoord_DescribeIndex_Call {
-	return &MockDataCoord_DescribeIndex_Call{Call: _e.mock.On("DescribeIndex", _a0, _a1)}
+//   - _a1 *milvuspb.CheckHealthRequest
+func (_e *MockDataCoord_Expecter) CheckHealth(_a0 interface{}, _a1 interface{}) *MockDataCoord_CheckHealth_Call {
+	return &MockDataCoord_CheckHealth_Call{Call: _e.mock.On("CheckHealth", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_DescribeIndex_Call) Run(run func(_a0 context.Context, _a1 *indexpb.DescribeIndexRequest)) *MockDataCoord_DescribeIndex_Call {
+func (_c *MockDataCoord_CheckHealth_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest)) *MockDataCoord_CheckHealth_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.DescribeIndexRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.CheckHealthRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_DescribeIndex_Call) Return(_a0 *indexpb.DescribeIndexResponse, _a1 error) *MockDataCoord_DescribeIndex_Call {
+func (_c *MockDataCoord_CheckHealth_Call) Return(_a0 *milvuspb.CheckHealthResponse, _a1 error) *MockDataCoord_CheckHealth_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_DescribeIndex_Call) RunAndReturn(run func(context.Context, *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)) *MockDataCoord_DescribeIndex_Call {
+func (_c *MockDataCoord_CheckHealth_Call) RunAndReturn(run func(context.Context, *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error)) *MockDataCoord_CheckHealth_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DropIndex provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) DropIndex(_a0 context.Context, _a1 *indexpb.DropIndexRequest) (*commonpb.Status, error) {
+// CreateBackup provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CreateBackup(_a0 context.Context, _a1 *datapb.CreateBackupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropIndexRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateBackupRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropIndexRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateBackupRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -383,7 +438,7 @@ func (_m *MockDataCoord) DropIndex(_a0 context.Context, _a1 *indexpb.DropIndexRe
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.DropIndexRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateBackupRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -392,53 +447,53 @@ func (_m *MockDataCoord) DropIndex(_a0 context.Context, _a1 *indexpb.DropIndexRe
 	return r0, r1
 }
 
-// MockDataCoord_DropIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropIndex'
-type MockDataCoord_DropIndex_Call struct {
+// MockDataCoord_CreateBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateBackup'
+type MockDataCoord_CreateBackup_Call struct {
 	*mock.Call
 }
 
-// DropIndex is a helper method to define mock.On call
+// CreateBackup is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.DropIndexRequest
-func (_e *MockDataCoord_Expecter) DropIndex(_a0 interface{}, _a1 interface{}) *MockDataCoord_DropIndex_Call {
-	return &MockDataCoord_DropIndex_Call{Call: _e.mock.On("DropIndex", _a0, _a1)}
+//   - _a1 *datapb.CreateBackupRequest
+func (_e *MockDataCoord_Expecter) CreateBackup(_a0 interface{}, _a1 interface{}) *MockDataCoord_CreateBackup_Call {
+	return &MockDataCoord_CreateBackup_Call{Call: _e.mock.On("CreateBackup", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_DropIndex_Call) Run(run func(_a0 context.Context, _a1 *indexpb.DropIndexRequest)) *MockDataCoord_DropIndex_Call {
+func (_c *MockDataCoord_CreateBackup_Call) Run(run func(_a0 context.Context, _a1 *datapb.CreateBackupRequest)) *MockDataCoord_CreateBackup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.DropIndexRequest))
+		run(args[0].(context.Context), args[1].(*datapb.CreateBackupRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_DropIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_DropIndex_Call {
+func (_c *MockDataCoord_CreateBackup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CreateBackup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_DropIndex_Call) RunAndReturn(run func(context.Context, *indexpb.DropIndexRequest) (*commonpb.Status, error)) *MockDataCoord_DropIndex_Call {
+func (_c *MockDataCoord_CreateBackup_Call) RunAndReturn(run func(context.Context, *datapb.CreateBackupRequest) (*commonpb.Status, error)) *MockDataCoord_CreateBackup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DropVirtualChannel provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) DropVirtualChannel(_a0 context.Context, _a1 *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
+// CreateIndex provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CreateIndex(_a0 context.Context, _a1 *indexpb.CreateIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.DropVirtualChannelResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.CreateIndexRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropVirtualChannelRequest) *datapb.DropVirtualChannelResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.CreateIndexRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.DropVirtualChannelResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropVirtualChannelRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.CreateIndexRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -447,53 +502,53 @@ func (_m *MockDataCoord) DropVirtualChannel(_a0 context.Context, _a1 *datapb.Dro
 	return r0, r1
 }
 
-// MockDataCoord_DropVirtualChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropVirtualChannel'
-type MockDataCoord_DropVirtualChannel_Call struct {
+// MockDataCoord_CreateIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateIndex'
+type MockDataCoord_CreateIndex_Call struct {
 	*mock.Call
 }
 
-// DropVirtualChannel is a helper method to define mock.On call
+// CreateIndex is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.DropVirtualChannelRequest
-func (_e *MockDataCoord_Expecter) DropVirtualChannel(_a0 interface{}, _a1 interface{}) *MockDataCoord_DropVirtualChannel_Call {
-	return &MockDataCoord_DropVirtualChannel_Call{Call: _e.mock.On("DropVirtualChannel", _a0, _a1)}
+//   - _a1 *indexpb.CreateIndexRequest
+func (_e *MockDataCoord_Expecter) CreateIndex(_a0 interface{}, _a1 interface{}) *MockDataCoord_CreateIndex_Call {
+	return &MockDataCoord_CreateIndex_Call{Call: _e.mock.On("CreateIndex", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_DropVirtualChannel_Call) Run(run func(_a0 context.Context, _a1 *datapb.DropVirtualChannelRequest)) *MockDataCoord_DropVirtualChannel_Call {
+func (_c *MockDataCoord_CreateIndex_Call) Run(run func(_a0 context.Context, _a1 *indexpb.CreateIndexRequest)) *MockDataCoord_CreateIndex_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.DropVirtualChannelRequest))
+		run(args[0].(context.Context), args[1].(*indexpb.CreateIndexRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_DropVirtualChannel_Call) Return(_a0 *datapb.DropVirtualChannelResponse, _a1 error) *MockDataCoord_DropVirtualChannel_Call {
+func (_c *MockDataCoord_CreateIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CreateIndex_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_DropVirtualChannel_Call) RunAndReturn(run func(context.Context, *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error)) *MockDataCoord_DropVirtualChannel_Call {
+func (_c *MockDataCoord_CreateIndex_Call) RunAndReturn(run func(context.Context, *indexpb.CreateIndexRequest) (*commonpb.Status, error)) *MockDataCoord_CreateIndex_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Flush provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) Flush(_a0 context.Context, _a1 *datapb.FlushRequest) (*datapb.FlushResponse, error) {
+// CreateSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) CreateSnapshot(_a0 context.Context, _a1 *datapb.CreateSnapshotRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.FlushResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) *datapb.FlushResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.CreateSnapshotRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.FlushResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.CreateSnapshotRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -502,53 +557,53 @@ func (_m *MockDataCoord) Flush(_a0 context.Context, _a1 *datapb.FlushRequest) (*
 	return r0, r1
 }
 
-// MockDataCoord_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
-type MockDataCoord_Flush_Call struct {
+// MockDataCoord_CreateSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSnapshot'
+type MockDataCoord_CreateSnapshot_Call struct {
 	*mock.Call
 }
 
-// Flush is a helper method to define mock.On call
+// CreateSnapshot is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.FlushRequest
-func (_e *MockDataCoord_Expecter) Flush(_a0 interface{}, _a1 interface{}) *MockDataCoord_Flush_Call {
-	return &MockDataCoord_Flush_Call{Call: _e.mock.On("Flush", _a0, _a1)}
+//   - _a1 *datapb.CreateSnapshotRequest
+func (_e *MockDataCoord_Expecter) CreateSnapshot(_a0 interface{}, _a1 interface{}) *MockDataCoord_CreateSnapshot_Call {
+	return &MockDataCoord_CreateSnapshot_Call{Call: _e.mock.On("CreateSnapshot", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_Flush_Call) Run(run func(_a0 context.Context, _a1 *datapb.FlushRequest)) *MockDataCoord_Flush_Call {
+func (_c *MockDataCoord_CreateSnapshot_Call) Run(run func(_a0 context.Context, _a1 *datapb.CreateSnapshotRequest)) *MockDataCoord_CreateSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.FlushRequest))
+		run(args[0].(context.Context), args[1].(*datapb.CreateSnapshotRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_Flush_Call) Return(_a0 *datapb.FlushResponse, _a1 error) *MockDataCoord_Flush_Call {
+func (_c *MockDataCoord_CreateSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_CreateSnapshot_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_Flush_Call) RunAndReturn(run func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)) *MockDataCoord_Flush_Call {
+func (_c *MockDataCoord_CreateSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.CreateSnapshotRequest) (*commonpb.Status, error)) *MockDataCoord_CreateSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GcConfirm provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GcConfirm(_a0 context.Context, _a1 *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error) {
+// DescribeIndex provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) DescribeIndex(_a0 context.Context, _a1 *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GcConfirmResponse
+	var r0 *indexpb.DescribeIndexResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) *datapb.GcConfirmResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DescribeIndexRequest) *indexpb.DescribeIndexResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GcConfirmResponse)
+			r0 = ret.Get(0).(*indexpb.DescribeIndexResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcConfirmRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.DescribeIndexRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -557,45 +612,45 @@ func (_m *MockDataCoord) GcConfirm(_a0 context.Context, _a1 *datapb.GcConfirmReq
 	return r0, r1
 }
 
-// MockDataCoord_GcConfirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcConfirm'
-type MockDataCoord_GcConfirm_Call struct {
+// MockDataCoord_DescribeIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeIndex'
+type MockDataCoord_DescribeIndex_Call struct {
 	*mock.Call
 }
 
-// GcConfirm is a helper method to define mock.On call
+// DescribeIndex is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GcConfirmRequest
-func (_e *MockDataCoord_Expecter) GcConfirm(_a0 interface{}, _a1 interface{}) *MockDataCoord_GcConfirm_Call {
-	return &MockDataCoord_GcConfirm_Call{Call: _e.mock.On("GcConfirm", _a0, _a1)}
+//   - _a1 *indexpb.DescribeIndexRequest
+func (_e *MockDataCoord_Expecter) DescribeIndex(_a0 interface{}, _a1 interface{}) *MockDataCoord_DescribeIndex_Call {
+	return &MockDataCoord_DescribeIndex_Call{Call: _e.mock.On("DescribeIndex", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GcConfirm_Call) Run(run func(_a0 context.Context, _a1 *datapb.GcConfirmRequest)) *MockDataCoord_GcConfirm_Call {
+func (_c *MockDataCoord_DescribeIndex_Call) Run(run func(_a0 context.Context, _a1 *indexpb.DescribeIndexRequest)) *MockDataCoord_DescribeIndex_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GcConfirmRequest))
+		run(args[0].(context.Context), args[1].(*indexpb.DescribeIndexRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GcConfirm_Call) Return(_a0 *datapb.GcConfirmResponse, _a1 error) *MockDataCoord_GcConfirm_Call {
+func (_c *MockDataCoord_DescribeIndex_Call) Return(_a0 *indexpb.DescribeIndexResponse, _a1 error) *MockDataCoord_DescribeIndex_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GcConfirm_Call) RunAndReturn(run func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)) *MockDataCoord_GcConfirm_Call {
+func (_c *MockDataCoord_DescribeIndex_Call) RunAndReturn(run func(context.Context, *indexpb.DescribeIndexRequest) (*indexpb.DescribeIndexResponse, error)) *MockDataCoord_DescribeIndex_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GcControl provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GcControl(_a0 context.Context, _a1 *datapb.GcControlRequest) (*commonpb.Status, error) {
+// DropBackup provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) DropBackup(_a0 context.Context, _a1 *datapb.DropBackupRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropBackupRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropBackupRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -603,7 +658,7 @@ func (_m *MockDataCoord) GcControl(_a0 context.Context, _a1 *datapb.GcControlReq
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcControlRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropBackupRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -612,53 +667,53 @@ func (_m *MockDataCoord) GcControl(_a0 context.Context, _a1 *datapb.GcControlReq
 	return r0, r1
 }
 
-// MockDataCoord_GcControl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcControl'
-type MockDataCoord_GcControl_Call struct {
+// MockDataCoord_DropBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropBackup'
+type MockDataCoord_DropBackup_Call struct {
 	*mock.Call
 }
 
-// GcControl is a helper method to define mock.On call
+// DropBackup is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GcControlRequest
-func (_e *MockDataCoord_Expecter) GcControl(_a0 interface{}, _a1 interface{}) *MockDataCoord_GcControl_Call {
-	return &MockDataCoord_GcControl_Call{Call: _e.mock.On("GcControl", _a0, _a1)}
+//   - _a1 *datapb.DropBackupRequest
+func (_e *MockDataCoord_Expecter) DropBackup(_a0 interface{}, _a1 interface{}) *MockDataCoord_DropBackup_Call {
+	return &MockDataCoord_DropBackup_Call{Call: _e.mock.On("DropBackup", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GcControl_Call) Run(run func(_a0 context.Context, _a1 *datapb.GcControlRequest)) *MockDataCoord_GcControl_Call {
+func (_c *MockDataCoord_DropBackup_Call) Run(run func(_a0 context.Context, _a1 *datapb.DropBackupRequest)) *MockDataCoord_DropBackup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GcControlRequest))
+		run(args[0].(context.Context), args[1].(*datapb.DropBackupRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GcControl_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_GcControl_Call {
+func (_c *MockDataCoord_DropBackup_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_DropBackup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GcControl_Call) RunAndReturn(run func(context.Context, *datapb.GcControlRequest) (*commonpb.Status, error)) *MockDataCoord_GcControl_Call {
+func (_c *MockDataCoord_DropBackup_Call) RunAndReturn(run func(context.Context, *datapb.DropBackupRequest) (*commonpb.Status, error)) *MockDataCoord_DropBackup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCollectionStatistics provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetCollectionStatistics(_a0 context.Context, _a1 *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
+// DropIndex provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) DropIndex(_a0 context.Context, _a1 *indexpb.DropIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GetCollectionStatisticsResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropIndexRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) *datapb.GetCollectionStatisticsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.DropIndexRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetCollectionStatisticsResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCollectionStatisticsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.DropIndexRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -667,53 +722,53 @@ func (_m *MockDataCoord) GetCollectionStatistics(_a0 context.Context, _a1 *datap
 	return r0, r1
 }
 
-// MockDataCoord_GetCollectionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStatistics'
-type MockDataCoord_GetCollectionStatistics_Call struct {
+// MockDataCoord_DropIndex_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropIndex'
+type MockDataCoord_DropIndex_Call struct {
 	*mock.Call
 }
 
-// GetCollectionStatistics is a helper method to define mock.On call
+// DropIndex is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetCollectionStatisticsRequest
-func (_e *MockDataCoord_Expecter) GetCollectionStatistics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCollectionStatistics_Call {
-	return &MockDataCoord_GetCollectionStatistics_Call{Call: _e.mock.On("GetCollectionStatistics", _a0, _a1)}
+//   - _a1 *indexpb.DropIndexRequest
+func (_e *MockDataCoord_Expecter) DropIndex(_a0 interface{}, _a1 interface{}) *MockDataCoord_DropIndex_Call {
+	return &MockDataCoord_DropIndex_Call{Call: _e.mock.On("DropIndex", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetCollectionStatistics_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetCollectionStatisticsRequest)) *MockDataCoord_GetCollectionStatistics_Call {
+func (_c *MockDataCoord_DropIndex_Call) Run(run func(_a0 context.Context, _a1 *indexpb.DropIndexRequest)) *MockDataCoord_DropIndex_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetCollectionStatisticsRequest))
+		run(args[0].(context.Context), args[1].(*indexpb.DropIndexRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetCollectionStatistics_Call) Return(_a0 *datapb.GetCollectionStatisticsResponse, _a1 error) *MockDataCoord_GetCollectionStatistics_Call {
+func (_c *MockDataCoord_DropIndex_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_DropIndex_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetCollectionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)) *MockDataCoord_GetCollectionStatistics_Call {
+func (_c *MockDataCoord_DropIndex_Call) RunAndReturn(run func(context.Context, *indexpb.DropIndexRequest) (*commonpb.Status, error)) *MockDataCoord_DropIndex_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCompactionState provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetCompactionState(_a0 context.Context, _a1 *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+// DropSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) DropSnapshot(_a0 context.Context, _a1 *datapb.DropSnapshotRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.GetCompactionStateResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) *milvuspb.GetCompactionStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropSnapshotRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetCompactionStateResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropSnapshotRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -722,53 +777,53 @@ func (_m *MockDataCoord) GetCompactionState(_a0 context.Context, _a1 *milvuspb.G
 	return r0, r1
 }
 
-// MockDataCoord_GetCompactionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionState'
-type MockDataCoord_GetCompactionState_Call struct {
+// MockDataCoord_DropSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropSnapshot'
+type MockDataCoord_DropSnapshot_Call struct {
 	*mock.Call
 }
 
-// GetCompactionState is a helper method to define mock.On call
+// DropSnapshot is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetCompactionStateRequest
-func (_e *MockDataCoord_Expecter) GetCompactionState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCompactionState_Call {
-	return &MockDataCoord_GetCompactionState_Call{Call: _e.mock.On("GetCompactionState", _a0, _a1)}
+//   - _a1 *datapb.DropSnapshotRequest
+func (_e *MockDataCoord_Expecter) DropSnapshot(_a0 interface{}, _a1 interface{}) *MockDataCoord_DropSnapshot_Call {
+	return &MockDataCoord_DropSnapshot_Call{Call: _e.mock.On("DropSnapshot", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetCompactionState_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetCompactionStateRequest)) *MockDataCoord_GetCompactionState_Call {
+func (_c *MockDataCoord_DropSnapshot_Call) Run(run func(_a0 context.Context, _a1 *datapb.DropSnapshotRequest)) *MockDataCoord_DropSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionStateRequest))
+		run(args[0].(context.Context), args[1].(*datapb.DropSnapshotRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionState_Call) Return(_a0 *milvuspb.GetCompactionStateResponse, _a1 error) *MockDataCoord_GetCompactionState_Call {
+func (_c *MockDataCoord_DropSnapshot_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_DropSnapshot_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)) *MockDataCoord_GetCompactionState_Call {
+func (_c *MockDataCoord_DropSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.DropSnapshotRequest) (*commonpb.Status, error)) *MockDataCoord_DropSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetCompactionStateWithPlans provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetCompactionStateWithPlans(_a0 context.Context, _a1 *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
+// DropVirtualChannel provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) DropVirtualChannel(_a0 context.Context, _a1 *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.GetCompactionPlansResponse
+	var r0 *datapb.DropVirtualChannelResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) *milvuspb.GetCompactionPlansResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropVirtualChannelRequest) *datapb.DropVirtualChannelResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetCompactionPlansResponse)
+			r0 = ret.Get(0).(*datapb.DropVirtualChannelResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionPlansRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropVirtualChannelRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -777,53 +832,53 @@ func (_m *MockDataCoord) GetCompactionStateWithPlans(_a0 context.Context, _a1 *m
 	return r0, r1
 }
 
-// MockDataCoord_GetCompactionStateWithPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionStateWithPlans'
-type MockDataCoord_GetCompactionStateWithPlans_Call struct {
+// MockDataCoord_DropVirtualChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropVirtualChannel'
+type MockDataCoord_DropVirtualChannel_Call struct {
 	*mock.Call
 }
 
-// GetCompactionStateWithPlans is a helper method to define mock.On call
+// DropVirtualChannel is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetCompactionPlansRequest
-func (_e *MockDataCoord_Expecter) GetCompactionStateWithPlans(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCompactionStateWithPlans_Call {
-	return &MockDataCoord_GetCompactionStateWithPlans_Call{Call: _e.mock.On("GetCompactionStateWithPlans", _a0, _a1)}
+//   - _a1 *datapb.DropVirtualChannelRequest
+func (_e *MockDataCoord_Expecter) DropVirtualChannel(_a0 interface{}, _a1 interface{}) *MockDataCoord_DropVirtualChannel_Call {
+	return &MockDataCoord_DropVirtualChannel_Call{Call: _e.mock.On("DropVirtualChannel", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetCompactionPlansRequest)) *MockDataCoord_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoord_DropVirtualChannel_Call) Run(run func(_a0 context.Context, _a1 *datapb.DropVirtualChannelRequest)) *MockDataCoord_DropVirtualChannel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionPlansRequest))
+		run(args[0].(context.Context), args[1].(*datapb.DropVirtualChannelRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Return(_a0 *milvuspb.GetCompactionPlansResponse, _a1 error) *MockDataCoord_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoord_DropVirtualChannel_Call) Return(_a0 *datapb.DropVirtualChannelResponse, _a1 error) *MockDataCoord_DropVirtualChannel_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)) *MockDataCoord_GetCompactionStateWithPlans_Call {
+func (_c *MockDataCoord_DropVirtualChannel_Call) RunAndReturn(run func(context.Context, *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error)) *MockDataCoord_DropVirtualChannel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetComponentStates provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+// Flush provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) Flush(_a0 context.Context, _a1 *datapb.FlushRequest) (*datapb.FlushResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.ComponentStates
+	var r0 *datapb.FlushResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) *milvuspb.ComponentStates); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.FlushRequest) *datapb.FlushResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+			r0 = ret.Get(0).(*datapb.FlushResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.FlushRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -832,53 +887,53 @@ func (_m *MockDataCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.G
 	return r0, r1
 }
 
-// MockDataCoord_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
-type MockDataCoord_GetComponentStates_Call struct {
+// MockDataCoord_Flush_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Flush'
+type MockDataCoord_Flush_Call struct {
 	*mock.Call
 }
 
-// GetComponentStates is a helper method to define mock.On call
+// Flush is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetComponentStatesRequest
-func (_e *MockDataCoord_Expecter) GetComponentStates(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetComponentStates_Call {
-	return &MockDataCoord_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates", _a0, _a1)}
+//   - _a1 *datapb.FlushRequest
+func (_e *MockDataCoord_Expecter) Flush(_a0 interface{}, _a1 interface{}) *MockDataCoord_Flush_Call {
+	return &MockDataCoord_Flush_Call{Call: _e.mock.On("Flush", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetComponentStates_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest)) *MockDataCoord_GetComponentStates_Call {
+func (_c *MockDataCoord_Flush_Call) Run(run func(_a0 context.Context, _a1 *datapb.FlushRequest)) *MockDataCoord_Flush_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest))
+		run(args[0].(context.Context), args[1].(*datapb.FlushRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockDataCoord_GetComponentStates_Call {
+func (_c *MockDataCoord_Flush_Call) Return(_a0 *datapb.FlushResponse, _a1 error) *MockDataCoord_Flush_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)) *MockDataCoord_GetComponentStates_Call {
+func (_c *MockDataCoord_Flush_Call) RunAndReturn(run func(context.Context, *datapb.FlushRequest) (*datapb.FlushResponse, error)) *MockDataCoord_Flush_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushAllState provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetFlushAllState(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
+// GcConfirm provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GcConfirm(_a0 context.Context, _a1 *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.GetFlushAllStateResponse
+	var r0 *datapb.GcConfirmResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) *milvuspb.GetFlushAllStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcConfirmRequest) *datapb.GcConfirmResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetFlushAllStateResponse)
+			r0 = ret.Get(0).(*datapb.GcConfirmResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetFlushAllStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcConfirmRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -887,53 +942,53 @@ func (_m *MockDataCoord) GetFlushAllState(_a0 context.Context, _a1 *milvuspb.Get
 	return r0, r1
 }
 
-// MockDataCoord_GetFlushAllState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushAllState'
-type MockDataCoord_GetFlushAllState_Call struct {
+// MockDataCoord_GcConfirm_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcConfirm'
+type MockDataCoord_GcConfirm_Call struct {
 	*mock.Call
 }
 
-// GetFlushAllState is a helper method to define mock.On call
+// GcConfirm is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.GetFlushAllStateRequest
-func (_e *MockDataCoord_Expecter) GetFlushAllState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushAllState_Call {
-	return &MockDataCoord_GetFlushAllState_Call{Call: _e.mock.On("GetFlushAllState", _a0, _a1)}
+//   - _a1 *datapb.GcConfirmRequest
+func (_e *MockDataCoord_Expecter) GcConfirm(_a0 interface{}, _a1 interface{}) *MockDataCoord_GcConfirm_Call {
+	return &MockDataCoord_GcConfirm_Call{Call: _e.mock.On("GcConfirm", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetFlushAllState_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest)) *MockDataCoord_GetFlushAllState_Call {
+func (_c *MockDataCoord_GcConfirm_Call) Run(run func(_a0 context.Context, _a1 *datapb.GcConfirmRequest)) *MockDataCoord_GcConfirm_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetFlushAllStateRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GcConfirmRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushAllState_Call) Return(_a0 *milvuspb.GetFlushAllStateResponse, _a1 error) *MockDataCoord_GetFlushAllState_Call {
+func (_c *MockDataCoord_GcConfirm_Call) Return(_a0 *datapb.GcConfirmResponse, _a1 error) *MockDataCoord_GcConfirm_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushAllState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)) *MockDataCoord_GetFlushAllState_Call {
+func (_c *MockDataCoord_GcConfirm_Call) RunAndReturn(run func(context.Context, *datapb.GcConfirmRequest) (*datapb.GcConfirmResponse, error)) *MockDataCoord_GcConfirm_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushState provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetFlushState(_a0 context.Context, _a1 *datapb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+// GcControl provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GcControl(_a0 context.Context, _a1 *datapb.GcControlRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.GetFlushStateResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest) *milvuspb.GetFlushStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GcControlRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetFlushStateResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GcControlRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -942,53 +997,53 @@ func (_m *MockDataCoord) GetFlushState(_a0 context.Context, _a1 *datapb.GetFlush
 	return r0, r1
 }
 
-// MockDataCoord_GetFlushState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushState'
-type MockDataCoord_GetFlushState_Call struct {
+// MockDataCoord_GcControl_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GcControl'
+type MockDataCoord_GcControl_Call struct {
 	*mock.Call
 }
 
-// GetFlushState is a helper method to define mock.On call
+// GcControl is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetFlushStateRequest
-func (_e *MockDataCoord_Expecter) GetFlushState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushState_Call {
-	return &MockDataCoord_GetFlushState_Call{Call: _e.mock.On("GetFlushState", _a0, _a1)}
+//   - _a1 *datapb.GcControlRequest
+func (_e *MockDataCoord_Expecter) GcControl(_a0 interface{}, _a1 interface{}) *MockDataCoord_GcControl_Call {
+	return &MockDataCoord_GcControl_Call{Call: _e.mock.On("GcControl", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetFlushState_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetFlushStateRequest)) *MockDataCoord_GetFlushState_Call {
+func (_c *MockDataCoord_GcControl_Call) Run(run func(_a0 context.Context, _a1 *datapb.GcControlRequest)) *MockDataCoord_GcControl_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetFlushStateRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GcControlRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushState_Call) Return(_a0 *milvuspb.GetFlushStateResponse, _a1 error) *MockDataCoord_GetFlushState_Call {
+func (_c *MockDataCoord_GcControl_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_GcControl_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushState_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)) *MockDataCoord_GetFlushState_Call {
+func (_c *MockDataCoord_GcControl_Call) RunAndReturn(run func(context.Context, *datapb.GcControlRequest) (*commonpb.Status, error)) *MockDataCoord_GcControl_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetFlushedSegments provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetFlushedSegments(_a0 context.Context, _a1 *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+// GetCollectionStatistics provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetCollectionStatistics(_a0 context.Context, _a1 *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GetFlushedSegmentsResponse
+	var r0 *datapb.GetCollectionStatisticsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) *datapb.GetFlushedSegmentsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCollectionStatisticsRequest) *datapb.GetCollectionStatisticsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetFlushedSegmentsResponse)
+			r0 = ret.Get(0).(*datapb.GetCollectionStatisticsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushedSegmentsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCollectionStatisticsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -997,53 +1052,53 @@ func (_m *MockDataCoord) GetFlushedSegments(_a0 context.Context, _a1 *datapb.Get
 	return r0, r1
 }
 
-// MockDataCoord_GetFlushedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushedSegments'
-type MockDataCoord_GetFlushedSegments_Call struct {
+// MockDataCoord_GetCollectionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCollectionStatistics'
+type MockDataCoord_GetCollectionStatistics_Call struct {
 	*mock.Call
 }
 
-// GetFlushedSegments is a helper method to define mock.On call
+// GetCollectionStatistics is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetFlushedSegmentsRequest
-func (_e *MockDataCoord_Expecter) GetFlushedSegments(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushedSegments_Call {
-	return &MockDataCoord_GetFlushedSegments_Call{Call: _e.mock.On("GetFlushedSegments", _a0, _a1)}
+//   - _a1 *datapb.GetCollectionStatisticsRequest
+func (_e *MockDataCoord_Expecter) GetCollectionStatistics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCollectionStatistics_Call {
+	return &MockDataCoord_GetCollectionStatistics_Call{Call: _e.mock.On("GetCollectionStatistics", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetFlushedSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetFlushedSegmentsRequest)) *MockDataCoord_GetFlushedSegments_Call {
+func (_c *MockDataCoord_GetCollectionStatistics_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetCollectionStatisticsRequest)) *MockDataCoord_GetCollectionStatistics_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetFlushedSegmentsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetCollectionStatisticsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushedSegments_Call) Return(_a0 *datapb.GetFlushedSegmentsResponse, _a1 error) *MockDataCoord_GetFlushedSegments_Call {
+func (_c *MockDataCoord_GetCollectionStatistics_Call) Return(_a0 *datapb.GetCollectionStatisticsResponse, _a1 error) *MockDataCoord_GetCollectionStatistics_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetFlushedSegments_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)) *MockDataCoord_GetFlushedSegments_Call {
+func (_c *MockDataCoord_GetCollectionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetCollectionStatisticsRequest) (*datapb.GetCollectionStatisticsResponse, error)) *MockDataCoord_GetCollectionStatistics_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetImportProgress provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetImportProgress(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
+// GetCompactionProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetCompactionProgress(_a0 context.Context, _a1 *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *internalpb.GetImportProgressResponse
+	var r0 *datapb.GetCompactionProgressResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest) *internalpb.GetImportProgressResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetCompactionProgressRequest) *datapb.GetCompactionProgressResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.GetImportProgressResponse)
+			r0 = ret.Get(0).(*datapb.GetCompactionProgressResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetImportProgressRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetCompactionProgressRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1052,53 +1107,53 @@ func (_m *MockDataCoord) GetImportProgress(_a0 context.Context, _a1 *internalpb.
 	return r0, r1
 }
 
-// MockDataCoord_GetImportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportProgress'
-type MockDataCoord_GetImportProgress_Call struct {
+// MockDataCoord_GetCompactionProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionProgress'
+type MockDataCoord_GetCompactionProgress_Call struct {
 	*mock.Call
 }
 
-// GetImportProgress is a helper method to define mock.On call
+// GetCompactionProgress is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *internalpb.GetImportProgressRequest
-func (_e *MockDataCoord_Expecter) GetImportProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetImportProgress_Call {
-	return &MockDataCoord_GetImportProgress_Call{Call: _e.mock.On("GetImportProgress", _a0, _a1)}
+//   - _a1 *datapb.GetCompactionProgressRequest
+func (_e *MockDataCoord_Expecter) GetCompactionProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCompactionProgress_Call {
+	return &MockDataCoord_GetCompactionProgress_Call{Call: _e.mock.On("GetCompactionProgress", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetImportProgress_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest)) *MockDataCoord_GetImportProgress_Call {
+func (_c *MockDataCoord_GetCompactionProgress_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetCompactionProgressRequest)) *MockDataCoord_GetCompactionProgress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*internalpb.GetImportProgressRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetCompactionProgressRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetImportProgress_Call) Return(_a0 *internalpb.GetImportProgressResponse, _a1 error) *MockDataCoord_GetImportProgress_Call {
+func (_c *MockDataCoord_GetCompactionProgress_Call) Return(_a0 *datapb.GetCompactionProgressResponse, _a1 error) *MockDataCoord_GetCompactionProgress_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetImportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)) *MockDataCoord_GetImportProgress_Call {
+func (_c *MockDataCoord_GetCompactionProgress_Call) RunAndReturn(run func(context.Context, *datapb.GetCompactionProgressRequest) (*datapb.GetCompactionProgressResponse, error)) *MockDataCoord_GetCompactionProgress_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexBuildProgress provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetIndexBuildProgress(_a0 context.Context, _a1 *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+// GetCompactionState provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetCompactionState(_a0 context.Context, _a1 *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *indexpb.GetIndexBuildProgressResponse
+	var r0 *milvuspb.GetCompactionStateResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) *indexpb.GetIndexBuildProgressResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionStateRequest) *milvuspb.GetCompactionStateResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexBuildProgressResponse)
+			r0 = ret.Get(0).(*milvuspb.GetCompactionStateResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionStateRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1107,53 +1162,53 @@ func (_m *MockDataCoord) GetIndexBuildProgress(_a0 context.Context, _a1 *indexpb
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexBuildProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexBuildProgress'
-type MockDataCoord_GetIndexBuildProgress_Call struct {
+// MockDataCoord_GetCompactionState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionState'
+type MockDataCoord_GetCompactionState_Call struct {
 	*mock.Call
 }
 
-// GetIndexBuildProgress is a helper method to define mock.On call
+// GetCompactionState is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.GetIndexBuildProgressRequest
-func (_e *MockDataCoord_Expecter) GetIndexBuildProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexBuildProgress_Call {
-	return &MockDataCoord_GetIndexBuildProgress_Call{Call: _e.mock.On("GetIndexBuildProgress", _a0, _a1)}
+//   - _a1 *milvuspb.GetCompactionStateRequest
+func (_e *MockDataCoord_Expecter) GetCompactionState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCompactionState_Call {
+	return &MockDataCoord_GetCompactionState_Call{Call: _e.mock.On("GetCompactionState", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetIndexBuildProgress_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexBuildProgressRequest)) *MockDataCoord_GetIndexBuildProgress_Call {
+func (_c *MockDataCoord_GetCompactionState_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetCompactionStateRequest)) *MockDataCoord_GetCompactionState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexBuildProgressRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionStateRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexBuildProgress_Call) Return(_a0 *indexpb.GetIndexBuildProgressResponse, _a1 error) *MockDataCoord_GetIndexBuildProgress_Call {
+func (_c *MockDataCoord_GetCompactionState_Call) Return(_a0 *milvuspb.GetCompactionStateResponse, _a1 error) *MockDataCoord_GetCompactionState_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexBuildProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)) *MockDataCoord_GetIndexBuildProgress_Call {
+func (_c *MockDataCoord_GetCompactionState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)) *MockDataCoord_GetCompactionState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexInfos provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetIndexInfos(_a0 context.Context, _a1 *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error) {
+// GetCompactionStateWithPlans provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetCompactionStateWithPlans(_a0 context.Context, _a1 *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *indexpb.GetIndexInfoResponse
+	var r0 *milvuspb.GetCompactionPlansResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) *indexpb.GetIndexInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetCompactionPlansRequest) *milvuspb.GetCompactionPlansResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexInfoResponse)
+			r0 = ret.Get(0).(*milvuspb.GetCompactionPlansResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexInfoRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetCompactionPlansRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1162,53 +1217,1098 @@ func (_m *MockDataCoord) GetIndexInfos(_a0 context.Context, _a1 *indexpb.GetInde
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexInfos'
+// MockDataCoord_GetCompactionStateWithPlans_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCompactionStateWithPlans'
+type MockDataCoord_GetCompactionStateWithPlans_Call struct {
+	*mock.Call
+}
+
+// GetCompactionStateWithPlans is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.GetCompactionPlansRequest
+func (_e *MockDataCoord_Expecter) GetCompactionStateWithPlans(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetCompactionStateWithPlans_Call {
+	return &MockDataCoord_GetCompactionStateWithPlans_Call{Call: _e.mock.On("GetCompactionStateWithPlans", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetCompactionPlansRequest)) *MockDataCoord_GetCompactionStateWithPlans_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetCompactionPlansRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) Return(_a0 *milvuspb.GetCompactionPlansResponse, _a1 error) *MockDataCoord_GetCompactionStateWithPlans_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetCompactionStateWithPlans_Call) RunAndReturn(run func(context.Context, *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)) *MockDataCoord_GetCompactionStateWithPlans_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComponentStates provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.ComponentStates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest) *milvuspb.ComponentStates); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
+type MockDataCoord_GetComponentStates_Call struct {
+	*mock.Call
+}
+
+// GetComponentStates is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.GetComponentStatesRequest
+func (_e *MockDataCoord_Expecter) GetComponentStates(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetComponentStates_Call {
+	return &MockDataCoord_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetComponentStates_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest)) *MockDataCoord_GetComponentStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockDataCoord_GetComponentStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error)) *MockDataCoord_GetComponentStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlushAllState provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetFlushAllState(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.GetFlushAllStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetFlushAllStateRequest) *milvuspb.GetFlushAllStateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetFlushAllStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetFlushAllStateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetFlushAllState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushAllState'
+type MockDataCoord_GetFlushAllState_Call struct {
+	*mock.Call
+}
+
+// GetFlushAllState is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.GetFlushAllStateRequest
+func (_e *MockDataCoord_Expecter) GetFlushAllState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushAllState_Call {
+	return &MockDataCoord_GetFlushAllState_Call{Call: _e.mock.On("GetFlushAllState", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetFlushAllState_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetFlushAllStateRequest)) *MockDataCoord_GetFlushAllState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetFlushAllStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushAllState_Call) Return(_a0 *milvuspb.GetFlushAllStateResponse, _a1 error) *MockDataCoord_GetFlushAllState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushAllState_Call) RunAndReturn(run func(context.Context, *milvuspb.GetFlushAllStateRequest) (*milvuspb.GetFlushAllStateResponse, error)) *MockDataCoord_GetFlushAllState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlushState provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetFlushState(_a0 context.Context, _a1 *datapb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.GetFlushStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushStateRequest) *milvuspb.GetFlushStateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetFlushStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushStateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetFlushState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushState'
+type MockDataCoord_GetFlushState_Call struct {
+	*mock.Call
+}
+
+// GetFlushState is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetFlushStateRequest
+func (_e *MockDataCoord_Expecter) GetFlushState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushState_Call {
+	return &MockDataCoord_GetFlushState_Call{Call: _e.mock.On("GetFlushState", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetFlushState_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetFlushStateRequest)) *MockDataCoord_GetFlushState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetFlushStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushState_Call) Return(_a0 *milvuspb.GetFlushStateResponse, _a1 error) *MockDataCoord_GetFlushState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushState_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)) *MockDataCoord_GetFlushState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetFlushedSegments provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetFlushedSegments(_a0 context.Context, _a1 *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetFlushedSegmentsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetFlushedSegmentsRequest) *datapb.GetFlushedSegmentsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetFlushedSegmentsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetFlushedSegmentsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetFlushedSegments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFlushedSegments'
+type MockDataCoord_GetFlushedSegments_Call struct {
+	*mock.Call
+}
+
+// GetFlushedSegments is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetFlushedSegmentsRequest
+func (_e *MockDataCoord_Expecter) GetFlushedSegments(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetFlushedSegments_Call {
+	return &MockDataCoord_GetFlushedSegments_Call{Call: _e.mock.On("GetFlushedSegments", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetFlushedSegments_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetFlushedSegmentsRequest)) *MockDataCoord_GetFlushedSegments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetFlushedSegmentsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushedSegments_Call) Return(_a0 *datapb.GetFlushedSegmentsResponse, _a1 error) *MockDataCoord_GetFlushedSegments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetFlushedSegments_Call) RunAndReturn(run func(context.Context, *datapb.GetFlushedSegmentsRequest) (*datapb.GetFlushedSegmentsResponse, error)) *MockDataCoord_GetFlushedSegments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIdempotencyRecord provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetIdempotencyRecord(_a0 context.Context, _a1 *datapb.GetIdempotencyRecordRequest) (*datapb.GetIdempotencyRecordResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetIdempotencyRecordResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetIdempotencyRecordRequest) (*datapb.GetIdempotencyRecordResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetIdempotencyRecordRequest) *datapb.GetIdempotencyRecordResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetIdempotencyRecordResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetIdempotencyRecordRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIdempotencyRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIdempotencyRecord'
+type MockDataCoord_GetIdempotencyRecord_Call struct {
+	*mock.Call
+}
+
+// GetIdempotencyRecord is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetIdempotencyRecordRequest
+func (_e *MockDataCoord_Expecter) GetIdempotencyRecord(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIdempotencyRecord_Call {
+	return &MockDataCoord_GetIdempotencyRecord_Call{Call: _e.mock.On("GetIdempotencyRecord", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetIdempotencyRecord_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetIdempotencyRecordRequest)) *MockDataCoord_GetIdempotencyRecord_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetIdempotencyRecordRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIdempotencyRecord_Call) Return(_a0 *datapb.GetIdempotencyRecordResponse, _a1 error) *MockDataCoord_GetIdempotencyRecord_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIdempotencyRecord_Call) RunAndReturn(run func(context.Context, *datapb.GetIdempotencyRecordRequest) (*datapb.GetIdempotencyRecordResponse, error)) *MockDataCoord_GetIdempotencyRecord_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImportProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetImportProgress(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.GetImportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest) *internalpb.GetImportProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetImportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetImportProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetImportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportProgress'
+type MockDataCoord_GetImportProgress_Call struct {
+	*mock.Call
+}
+
+// GetImportProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetImportProgressRequest
+func (_e *MockDataCoord_Expecter) GetImportProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetImportProgress_Call {
+	return &MockDataCoord_GetImportProgress_Call{Call: _e.mock.On("GetImportProgress", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetImportProgress_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetImportProgressRequest)) *MockDataCoord_GetImportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetImportProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetImportProgress_Call) Return(_a0 *internalpb.GetImportProgressResponse, _a1 error) *MockDataCoord_GetImportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetImportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)) *MockDataCoord_GetImportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexBuildProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetIndexBuildProgress(_a0 context.Context, _a1 *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.GetIndexBuildProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) *indexpb.GetIndexBuildProgressResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexBuildProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexBuildProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexBuildProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexBuildProgress'
+type MockDataCoord_GetIndexBuildProgress_Call struct {
+	*mock.Call
+}
+
+// GetIndexBuildProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetIndexBuildProgressRequest
+func (_e *MockDataCoord_Expecter) GetIndexBuildProgress(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexBuildProgress_Call {
+	return &MockDataCoord_GetIndexBuildProgress_Call{Call: _e.mock.On("GetIndexBuildProgress", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetIndexBuildProgress_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexBuildProgressRequest)) *MockDataCoord_GetIndexBuildProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexBuildProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexBuildProgress_Call) Return(_a0 *indexpb.GetIndexBuildProgressResponse, _a1 error) *MockDataCoord_GetIndexBuildProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexBuildProgress_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexBuildProgressRequest) (*indexpb.GetIndexBuildProgressResponse, error)) *MockDataCoord_GetIndexBuildProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexInfos provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetIndexInfos(_a0 context.Context, _a1 *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.GetIndexInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexInfoRequest) *indexpb.GetIndexInfoResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexInfoRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexInfos'
 type MockDataCoord_GetIndexInfos_Call struct {
 	*mock.Call
 }
 
-// GetIndexInfos is a helper method to define mock.On call
+// GetIndexInfos is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetIndexInfoRequest
+func (_e *MockDataCoord_Expecter) GetIndexInfos(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexInfos_Call {
+	return &MockDataCoord_GetIndexInfos_Call{Call: _e.mock.On("GetIndexInfos", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetIndexInfos_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexInfoRequest)) *MockDataCoord_GetIndexInfos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexInfoRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexInfos_Call) Return(_a0 *indexpb.GetIndexInfoResponse, _a1 error) *MockDataCoord_GetIndexInfos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexInfos_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)) *MockDataCoord_GetIndexInfos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexState provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetIndexState(_a0 context.Context, _a1 *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.GetIndexStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) *indexpb.GetIndexStateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexState'
+type MockDataCoord_GetIndexState_Call struct {
+	*mock.Call
+}
+
+// GetIndexState is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetIndexStateRequest
+func (_e *MockDataCoord_Expecter) GetIndexState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexState_Call {
+	return &MockDataCoord_GetIndexState_Call{Call: _e.mock.On("GetIndexState", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetIndexState_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexStateRequest)) *MockDataCoord_GetIndexState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexState_Call) Return(_a0 *indexpb.GetIndexStateResponse, _a1 error) *MockDataCoord_GetIndexState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)) *MockDataCoord_GetIndexState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetIndexStatistics provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetIndexStatistics(_a0 context.Context, _a1 *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.GetIndexStatisticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) *indexpb.GetIndexStatisticsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetIndexStatisticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStatisticsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetIndexStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexStatistics'
+type MockDataCoord_GetIndexStatistics_Call struct {
+	*mock.Call
+}
+
+// GetIndexStatistics is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetIndexStatisticsRequest
+func (_e *MockDataCoord_Expecter) GetIndexStatistics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexStatistics_Call {
+	return &MockDataCoord_GetIndexStatistics_Call{Call: _e.mock.On("GetIndexStatistics", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetIndexStatistics_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexStatisticsRequest)) *MockDataCoord_GetIndexStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStatisticsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexStatistics_Call) Return(_a0 *indexpb.GetIndexStatisticsResponse, _a1 error) *MockDataCoord_GetIndexStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetIndexStatistics_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)) *MockDataCoord_GetIndexStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetInsertBinlogPaths provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetInsertBinlogPaths(_a0 context.Context, _a1 *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetInsertBinlogPathsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) *datapb.GetInsertBinlogPathsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetInsertBinlogPathsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetInsertBinlogPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInsertBinlogPaths'
+type MockDataCoord_GetInsertBinlogPaths_Call struct {
+	*mock.Call
+}
+
+// GetInsertBinlogPaths is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetInsertBinlogPathsRequest
+func (_e *MockDataCoord_Expecter) GetInsertBinlogPaths(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetInsertBinlogPaths_Call {
+	return &MockDataCoord_GetInsertBinlogPaths_Call{Call: _e.mock.On("GetInsertBinlogPaths", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetInsertBinlogPaths_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetInsertBinlogPathsRequest)) *MockDataCoord_GetInsertBinlogPaths_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetInsertBinlogPathsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetInsertBinlogPaths_Call) Return(_a0 *datapb.GetInsertBinlogPathsResponse, _a1 error) *MockDataCoord_GetInsertBinlogPaths_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetInsertBinlogPaths_Call) RunAndReturn(run func(context.Context, *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error)) *MockDataCoord_GetInsertBinlogPaths_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMetrics provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetMetrics(_a0 context.Context, _a1 *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.GetMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) *milvuspb.GetMetricsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
+type MockDataCoord_GetMetrics_Call struct {
+	*mock.Call
+}
+
+// GetMetrics is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.GetMetricsRequest
+func (_e *MockDataCoord_Expecter) GetMetrics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetMetrics_Call {
+	return &MockDataCoord_GetMetrics_Call{Call: _e.mock.On("GetMetrics", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetMetrics_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetMetricsRequest)) *MockDataCoord_GetMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockDataCoord_GetMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)) *MockDataCoord_GetMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPartitionStatistics provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetPartitionStatistics(_a0 context.Context, _a1 *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetPartitionStatisticsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest) *datapb.GetPartitionStatisticsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetPartitionStatisticsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetPartitionStatisticsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetPartitionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionStatistics'
+type MockDataCoord_GetPartitionStatistics_Call struct {
+	*mock.Call
+}
+
+// GetPartitionStatistics is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetPartitionStatisticsRequest
+func (_e *MockDataCoord_Expecter) GetPartitionStatistics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetPartitionStatistics_Call {
+	return &MockDataCoord_GetPartitionStatistics_Call{Call: _e.mock.On("GetPartitionStatistics", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetPartitionStatistics_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetPartitionStatisticsRequest)) *MockDataCoord_GetPartitionStatistics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetPartitionStatisticsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetPartitionStatistics_Call) Return(_a0 *datapb.GetPartitionStatisticsResponse, _a1 error) *MockDataCoord_GetPartitionStatistics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetPartitionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error)) *MockDataCoord_GetPartitionStatistics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecoveryInfo provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetRecoveryInfo(_a0 context.Context, _a1 *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetRecoveryInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest) *datapb.GetRecoveryInfoResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetRecoveryInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfo'
+type MockDataCoord_GetRecoveryInfo_Call struct {
+	*mock.Call
+}
+
+// GetRecoveryInfo is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetRecoveryInfoRequest
+func (_e *MockDataCoord_Expecter) GetRecoveryInfo(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetRecoveryInfo_Call {
+	return &MockDataCoord_GetRecoveryInfo_Call{Call: _e.mock.On("GetRecoveryInfo", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetRecoveryInfo_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetRecoveryInfoRequest)) *MockDataCoord_GetRecoveryInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetRecoveryInfo_Call) Return(_a0 *datapb.GetRecoveryInfoResponse, _a1 error) *MockDataCoord_GetRecoveryInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetRecoveryInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error)) *MockDataCoord_GetRecoveryInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRecoveryInfoV2 provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetRecoveryInfoV2(_a0 context.Context, _a1 *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetRecoveryInfoResponseV2
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) *datapb.GetRecoveryInfoResponseV2); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponseV2)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetRecoveryInfoV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfoV2'
+type MockDataCoord_GetRecoveryInfoV2_Call struct {
+	*mock.Call
+}
+
+// GetRecoveryInfoV2 is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetRecoveryInfoRequestV2
+func (_e *MockDataCoord_Expecter) GetRecoveryInfoV2(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetRecoveryInfoV2_Call {
+	return &MockDataCoord_GetRecoveryInfoV2_Call{Call: _e.mock.On("GetRecoveryInfoV2", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetRecoveryInfoV2_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetRecoveryInfoRequestV2)) *MockDataCoord_GetRecoveryInfoV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequestV2))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetRecoveryInfoV2_Call) Return(_a0 *datapb.GetRecoveryInfoResponseV2, _a1 error) *MockDataCoord_GetRecoveryInfoV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetRecoveryInfoV2_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error)) *MockDataCoord_GetRecoveryInfoV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetScalarIndexAdvice provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetScalarIndexAdvice(_a0 context.Context, _a1 *datapb.GetScalarIndexAdviceRequest) (*datapb.GetScalarIndexAdviceResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetScalarIndexAdviceResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetScalarIndexAdviceRequest) (*datapb.GetScalarIndexAdviceResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetScalarIndexAdviceRequest) *datapb.GetScalarIndexAdviceResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetScalarIndexAdviceResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetScalarIndexAdviceRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetScalarIndexAdvice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetScalarIndexAdvice'
+type MockDataCoord_GetScalarIndexAdvice_Call struct {
+	*mock.Call
+}
+
+// GetScalarIndexAdvice is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetScalarIndexAdviceRequest
+func (_e *MockDataCoord_Expecter) GetScalarIndexAdvice(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetScalarIndexAdvice_Call {
+	return &MockDataCoord_GetScalarIndexAdvice_Call{Call: _e.mock.On("GetScalarIndexAdvice", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetScalarIndexAdvice_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetScalarIndexAdviceRequest)) *MockDataCoord_GetScalarIndexAdvice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetScalarIndexAdviceRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetScalarIndexAdvice_Call) Return(_a0 *datapb.GetScalarIndexAdviceResponse, _a1 error) *MockDataCoord_GetScalarIndexAdvice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetScalarIndexAdvice_Call) RunAndReturn(run func(context.Context, *datapb.GetScalarIndexAdviceRequest) (*datapb.GetScalarIndexAdviceResponse, error)) *MockDataCoord_GetScalarIndexAdvice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentIndexState provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetSegmentIndexState(_a0 context.Context, _a1 *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.GetSegmentIndexStateResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) *indexpb.GetSegmentIndexStateResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.GetSegmentIndexStateResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetSegmentIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentIndexState'
+type MockDataCoord_GetSegmentIndexState_Call struct {
+	*mock.Call
+}
+
+// GetSegmentIndexState is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *indexpb.GetSegmentIndexStateRequest
+func (_e *MockDataCoord_Expecter) GetSegmentIndexState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetSegmentIndexState_Call {
+	return &MockDataCoord_GetSegmentIndexState_Call{Call: _e.mock.On("GetSegmentIndexState", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetSegmentIndexState_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetSegmentIndexStateRequest)) *MockDataCoord_GetSegmentIndexState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*indexpb.GetSegmentIndexStateRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentIndexState_Call) Return(_a0 *indexpb.GetSegmentIndexStateResponse, _a1 error) *MockDataCoord_GetSegmentIndexState_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)) *MockDataCoord_GetSegmentIndexState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentInfo provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetSegmentInfo(_a0 context.Context, _a1 *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *datapb.GetSegmentInfoResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest) *datapb.GetSegmentInfoResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.GetSegmentInfoResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentInfoRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetSegmentInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfo'
+type MockDataCoord_GetSegmentInfo_Call struct {
+	*mock.Call
+}
+
+// GetSegmentInfo is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.GetSegmentInfoRequest
+func (_e *MockDataCoord_Expecter) GetSegmentInfo(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetSegmentInfo_Call {
+	return &MockDataCoord_GetSegmentInfo_Call{Call: _e.mock.On("GetSegmentInfo", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetSegmentInfo_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetSegmentInfoRequest)) *MockDataCoord_GetSegmentInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.GetSegmentInfoRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentInfo_Call) Return(_a0 *datapb.GetSegmentInfoResponse, _a1 error) *MockDataCoord_GetSegmentInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetSegmentInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)) *MockDataCoord_GetSegmentInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSegmentInfoChannel provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetSegmentInfoChannel(_a0 context.Context, _a1 *datapb.GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoChannelRequest) *milvuspb.StringResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentInfoChannelRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetSegmentInfoChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfoChannel'
+type MockDataCoord_GetSegmentInfoChannel_Call struct {
+	*mock.Call
+}
+
+// GetSegmentInfoChannel is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.GetIndexInfoRequest
-func (_e *MockDataCoord_Expecter) GetIndexInfos(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexInfos_Call {
-	return &MockDataCoord_GetIndexInfos_Call{Call: _e.mock.On("GetIndexInfos", _a0, _a1)}
+//   - _a1 *datapb.GetSegmentInfoChannelRequest
+func (_e *MockDataCoord_Expecter) GetSegmentInfoChannel(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetSegmentInfoChannel_Call {
+	return &MockDataCoord_GetSegmentInfoChannel_Call{Call: _e.mock.On("GetSegmentInfoChannel", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetIndexInfos_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexInfoRequest)) *MockDataCoord_GetIndexInfos_Call {
+func (_c *MockDataCoord_GetSegmentInfoChannel_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetSegmentInfoChannelRequest)) *MockDataCoord_GetSegmentInfoChannel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexInfoRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetSegmentInfoChannelRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexInfos_Call) Return(_a0 *indexpb.GetIndexInfoResponse, _a1 error) *MockDataCoord_GetIndexInfos_Call {
+func (_c *MockDataCoord_GetSegmentInfoChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetSegmentInfoChannel_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexInfos_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexInfoRequest) (*indexpb.GetIndexInfoResponse, error)) *MockDataCoord_GetIndexInfos_Call {
+func (_c *MockDataCoord_GetSegmentInfoChannel_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error)) *MockDataCoord_GetSegmentInfoChannel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexState provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetIndexState(_a0 context.Context, _a1 *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error) {
+// GetSegmentStates provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetSegmentStates(_a0 context.Context, _a1 *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *indexpb.GetIndexStateResponse
+	var r0 *datapb.GetSegmentStatesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStateRequest) *indexpb.GetIndexStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest) *datapb.GetSegmentStatesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexStateResponse)
+			r0 = ret.Get(0).(*datapb.GetSegmentStatesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentStatesRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1217,53 +2317,53 @@ func (_m *MockDataCoord) GetIndexState(_a0 context.Context, _a1 *indexpb.GetInde
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexState'
-type MockDataCoord_GetIndexState_Call struct {
+// MockDataCoord_GetSegmentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentStates'
+type MockDataCoord_GetSegmentStates_Call struct {
 	*mock.Call
 }
 
-// GetIndexState is a helper method to define mock.On call
+// GetSegmentStates is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.GetIndexStateRequest
-func (_e *MockDataCoord_Expecter) GetIndexState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexState_Call {
-	return &MockDataCoord_GetIndexState_Call{Call: _e.mock.On("GetIndexState", _a0, _a1)}
+//   - _a1 *datapb.GetSegmentStatesRequest
+func (_e *MockDataCoord_Expecter) GetSegmentStates(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetSegmentStates_Call {
+	return &MockDataCoord_GetSegmentStates_Call{Call: _e.mock.On("GetSegmentStates", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetIndexState_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexStateRequest)) *MockDataCoord_GetIndexState_Call {
+func (_c *MockDataCoord_GetSegmentStates_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetSegmentStatesRequest)) *MockDataCoord_GetSegmentStates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStateRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetSegmentStatesRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexState_Call) Return(_a0 *indexpb.GetIndexStateResponse, _a1 error) *MockDataCoord_GetIndexState_Call {
+func (_c *MockDataCoord_GetSegmentStates_Call) Return(_a0 *datapb.GetSegmentStatesResponse, _a1 error) *MockDataCoord_GetSegmentStates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStateRequest) (*indexpb.GetIndexStateResponse, error)) *MockDataCoord_GetIndexState_Call {
+func (_c *MockDataCoord_GetSegmentStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)) *MockDataCoord_GetSegmentStates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetIndexStatistics provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetIndexStatistics(_a0 context.Context, _a1 *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error) {
+// GetSegmentsByStates provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetSegmentsByStates(_a0 context.Context, _a1 *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *indexpb.GetIndexStatisticsResponse
+	var r0 *datapb.GetSegmentsByStatesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetIndexStatisticsRequest) *indexpb.GetIndexStatisticsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest) *datapb.GetSegmentsByStatesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetIndexStatisticsResponse)
+			r0 = ret.Get(0).(*datapb.GetSegmentsByStatesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetIndexStatisticsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentsByStatesRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1272,53 +2372,53 @@ func (_m *MockDataCoord) GetIndexStatistics(_a0 context.Context, _a1 *indexpb.Ge
 	return r0, r1
 }
 
-// MockDataCoord_GetIndexStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetIndexStatistics'
-type MockDataCoord_GetIndexStatistics_Call struct {
+// MockDataCoord_GetSegmentsByStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentsByStates'
+type MockDataCoord_GetSegmentsByStates_Call struct {
 	*mock.Call
 }
 
-// GetIndexStatistics is a helper method to define mock.On call
+// GetSegmentsByStates is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.GetIndexStatisticsRequest
-func (_e *MockDataCoord_Expecter) GetIndexStatistics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetIndexStatistics_Call {
-	return &MockDataCoord_GetIndexStatistics_Call{Call: _e.mock.On("GetIndexStatistics", _a0, _a1)}
+//   - _a1 *datapb.GetSegmentsByStatesRequest
+func (_e *MockDataCoord_Expecter) GetSegmentsByStates(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetSegmentsByStates_Call {
+	return &MockDataCoord_GetSegmentsByStates_Call{Call: _e.mock.On("GetSegmentsByStates", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetIndexStatistics_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetIndexStatisticsRequest)) *MockDataCoord_GetIndexStatistics_Call {
+func (_c *MockDataCoord_GetSegmentsByStates_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetSegmentsByStatesRequest)) *MockDataCoord_GetSegmentsByStates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetIndexStatisticsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.GetSegmentsByStatesRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexStatistics_Call) Return(_a0 *indexpb.GetIndexStatisticsResponse, _a1 error) *MockDataCoord_GetIndexStatistics_Call {
+func (_c *MockDataCoord_GetSegmentsByStates_Call) Return(_a0 *datapb.GetSegmentsByStatesResponse, _a1 error) *MockDataCoord_GetSegmentsByStates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetIndexStatistics_Call) RunAndReturn(run func(context.Context, *indexpb.GetIndexStatisticsRequest) (*indexpb.GetIndexStatisticsResponse, error)) *MockDataCoord_GetIndexStatistics_Call {
+func (_c *MockDataCoord_GetSegmentsByStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error)) *MockDataCoord_GetSegmentsByStates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetInsertBinlogPaths provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetInsertBinlogPaths(_a0 context.Context, _a1 *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error) {
+// GetStatisticsChannel provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetStatisticsChannel(_a0 context.Context, _a1 *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GetInsertBinlogPathsResponse
+	var r0 *milvuspb.StringResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) *datapb.GetInsertBinlogPathsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest) *milvuspb.StringResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetInsertBinlogPathsResponse)
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetInsertBinlogPathsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1327,108 +2427,204 @@ func (_m *MockDataCoord) GetInsertBinlogPaths(_a0 context.Context, _a1 *datapb.G
 	return r0, r1
 }
 
-// MockDataCoord_GetInsertBinlogPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetInsertBinlogPaths'
-type MockDataCoord_GetInsertBinlogPaths_Call struct {
+// MockDataCoord_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
+type MockDataCoord_GetStatisticsChannel_Call struct {
 	*mock.Call
 }
 
-// GetInsertBinlogPaths is a helper method to define mock.On call
+// GetStatisticsChannel is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetInsertBinlogPathsRequest
-func (_e *MockDataCoord_Expecter) GetInsertBinlogPaths(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetInsertBinlogPaths_Call {
-	return &MockDataCoord_GetInsertBinlogPaths_Call{Call: _e.mock.On("GetInsertBinlogPaths", _a0, _a1)}
+//   - _a1 *internalpb.GetStatisticsChannelRequest
+func (_e *MockDataCoord_Expecter) GetStatisticsChannel(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetStatisticsChannel_Call {
+	return &MockDataCoord_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetInsertBinlogPaths_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetInsertBinlogPathsRequest)) *MockDataCoord_GetInsertBinlogPaths_Call {
+func (_c *MockDataCoord_GetStatisticsChannel_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetStatisticsChannelRequest)) *MockDataCoord_GetStatisticsChannel_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetInsertBinlogPathsRequest))
+		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetInsertBinlogPaths_Call) Return(_a0 *datapb.GetInsertBinlogPathsResponse, _a1 error) *MockDataCoord_GetInsertBinlogPaths_Call {
+func (_c *MockDataCoord_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetStatisticsChannel_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetInsertBinlogPaths_Call) RunAndReturn(run func(context.Context, *datapb.GetInsertBinlogPathsRequest) (*datapb.GetInsertBinlogPathsResponse, error)) *MockDataCoord_GetInsertBinlogPaths_Call {
+func (_c *MockDataCoord_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)) *MockDataCoord_GetStatisticsChannel_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMetrics provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetMetrics(_a0 context.Context, _a1 *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+// GetTimeTickChannel provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) GetTimeTickChannel(_a0 context.Context, _a1 *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.GetMetricsResponse
+	var r0 *milvuspb.StringResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest) *milvuspb.GetMetricsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest) *milvuspb.StringResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
+type MockDataCoord_GetTimeTickChannel_Call struct {
+	*mock.Call
+}
+
+// GetTimeTickChannel is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.GetTimeTickChannelRequest
+func (_e *MockDataCoord_Expecter) GetTimeTickChannel(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetTimeTickChannel_Call {
+	return &MockDataCoord_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_GetTimeTickChannel_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetTimeTickChannelRequest)) *MockDataCoord_GetTimeTickChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetTimeTickChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)) *MockDataCoord_GetTimeTickChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportV2 provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ImportV2(_a0 context.Context, _a1 *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.ImportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal) *internalpb.ImportResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ImportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ImportRequestInternal) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_ImportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportV2'
+type MockDataCoord_ImportV2_Call struct {
+	*mock.Call
+}
+
+// ImportV2 is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.ImportRequestInternal
+func (_e *MockDataCoord_Expecter) ImportV2(_a0 interface{}, _a1 interface{}) *MockDataCoord_ImportV2_Call {
+	return &MockDataCoord_ImportV2_Call{Call: _e.mock.On("ImportV2", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_ImportV2_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ImportRequestInternal)) *MockDataCoord_ImportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.ImportRequestInternal))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_ImportV2_Call) Return(_a0 *internalpb.ImportResponse, _a1 error) *MockDataCoord_ImportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_ImportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error)) *MockDataCoord_ImportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Init provides a mock function with given fields:
+func (_m *MockDataCoord) Init() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// MockDataCoord_GetMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMetrics'
-type MockDataCoord_GetMetrics_Call struct {
+// MockDataCoord_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
+type MockDataCoord_Init_Call struct {
 	*mock.Call
 }
 
-// GetMetrics is a helper method to define mock.On call
-//   - _a0 context.Context
-//   - _a1 *milvuspb.GetMetricsRequest
-func (_e *MockDataCoord_Expecter) GetMetrics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetMetrics_Call {
-	return &MockDataCoord_GetMetrics_Call{Call: _e.mock.On("GetMetrics", _a0, _a1)}
+// Init is a helper method to define mock.On call
+func (_e *MockDataCoord_Expecter) Init() *MockDataCoord_Init_Call {
+	return &MockDataCoord_Init_Call{Call: _e.mock.On("Init")}
 }
 
-func (_c *MockDataCoord_GetMetrics_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.GetMetricsRequest)) *MockDataCoord_GetMetrics_Call {
+func (_c *MockDataCoord_Init_Call) Run(run func()) *MockDataCoord_Init_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest))
+		run()
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockDataCoord_GetMetrics_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockDataCoord_Init_Call) Return(_a0 error) *MockDataCoord_Init_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDataCoord_GetMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)) *MockDataCoord_GetMetrics_Call {
+func (_c *MockDataCoord_Init_Call) RunAndReturn(run func() error) *MockDataCoord_Init_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetPartitionStatistics provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetPartitionStatistics(_a0 context.Context, _a1 *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error) {
+// ListBackups provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListBackups(_a0 context.Context, _a1 *datapb.ListBackupsRequest) (*datapb.ListBackupsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GetPartitionStatisticsResponse
+	var r0 *datapb.ListBackupsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListBackupsRequest) (*datapb.ListBackupsResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetPartitionStatisticsRequest) *datapb.GetPartitionStatisticsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListBackupsRequest) *datapb.ListBackupsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetPartitionStatisticsResponse)
+			r0 = ret.Get(0).(*datapb.ListBackupsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetPartitionStatisticsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListBackupsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1437,53 +2633,53 @@ func (_m *MockDataCoord) GetPartitionStatistics(_a0 context.Context, _a1 *datapb
 	return r0, r1
 }
 
-// MockDataCoord_GetPartitionStatistics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionStatistics'
-type MockDataCoord_GetPartitionStatistics_Call struct {
+// MockDataCoord_ListBackups_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBackups'
+type MockDataCoord_ListBackups_Call struct {
 	*mock.Call
 }
 
-// GetPartitionStatistics is a helper method to define mock.On call
+// ListBackups is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetPartitionStatisticsRequest
-func (_e *MockDataCoord_Expecter) GetPartitionStatistics(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetPartitionStatistics_Call {
-	return &MockDataCoord_GetPartitionStatistics_Call{Call: _e.mock.On("GetPartitionStatistics", _a0, _a1)}
+//   - _a1 *datapb.ListBackupsRequest
+func (_e *MockDataCoord_Expecter) ListBackups(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListBackups_Call {
+	return &MockDataCoord_ListBackups_Call{Call: _e.mock.On("ListBackups", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetPartitionStatistics_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetPartitionStatisticsRequest)) *MockDataCoord_GetPartitionStatistics_Call {
+func (_c *MockDataCoord_ListBackups_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListBackupsRequest)) *MockDataCoord_ListBackups_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetPartitionStatisticsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ListBackupsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetPartitionStatistics_Call) Return(_a0 *datapb.GetPartitionStatisticsResponse, _a1 error) *MockDataCoord_GetPartitionStatistics_Call {
+func (_c *MockDataCoord_ListBackups_Call) Return(_a0 *datapb.ListBackupsResponse, _a1 error) *MockDataCoord_ListBackups_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetPartitionStatistics_Call) RunAndReturn(run func(context.Context, *datapb.GetPartitionStatisticsRequest) (*datapb.GetPartitionStatisticsResponse, error)) *MockDataCoord_GetPartitionStatistics_Call {
+func (_c *MockDataCoord_ListBackups_Call) RunAndReturn(run func(context.Context, *datapb.ListBackupsRequest) (*datapb.ListBackupsResponse, error)) *MockDataCoord_ListBackups_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecoveryInfo provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetRecoveryInfo(_a0 context.Context, _a1 *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error) {
+// ListChannelCheckpoints provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListChannelCheckpoints(_a0 context.Context, _a1 *datapb.ListChannelCheckpointsRequest) (*datapb.ListChannelCheckpointsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GetRecoveryInfoResponse
+	var r0 *datapb.ListChannelCheckpointsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListChannelCheckpointsRequest) (*datapb.ListChannelCheckpointsResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequest) *datapb.GetRecoveryInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListChannelCheckpointsRequest) *datapb.ListChannelCheckpointsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponse)
+			r0 = ret.Get(0).(*datapb.ListChannelCheckpointsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListChannelCheckpointsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1492,53 +2688,53 @@ func (_m *MockDataCoord) GetRecoveryInfo(_a0 context.Context, _a1 *datapb.GetRec
 	return r0, r1
 }
 
-// MockDataCoord_GetRecoveryInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfo'
-type MockDataCoord_GetRecoveryInfo_Call struct {
+// MockDataCoord_ListChannelCheckpoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChannelCheckpoints'
+type MockDataCoord_ListChannelCheckpoints_Call struct {
 	*mock.Call
 }
 
-// GetRecoveryInfo is a helper method to define mock.On call
+// ListChannelCheckpoints is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetRecoveryInfoRequest
-func (_e *MockDataCoord_Expecter) GetRecoveryInfo(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetRecoveryInfo_Call {
-	return &MockDataCoord_GetRecoveryInfo_Call{Call: _e.mock.On("GetRecoveryInfo", _a0, _a1)}
+//   - _a1 *datapb.ListChannelCheckpointsRequest
+func (_e *MockDataCoord_Expecter) ListChannelCheckpoints(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListChannelCheckpoints_Call {
+	return &MockDataCoord_ListChannelCheckpoints_Call{Call: _e.mock.On("ListChannelCheckpoints", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetRecoveryInfo_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetRecoveryInfoRequest)) *MockDataCoord_GetRecoveryInfo_Call {
+func (_c *MockDataCoord_ListChannelCheckpoints_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListChannelCheckpointsRequest)) *MockDataCoord_ListChannelCheckpoints_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ListChannelCheckpointsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetRecoveryInfo_Call) Return(_a0 *datapb.GetRecoveryInfoResponse, _a1 error) *MockDataCoord_GetRecoveryInfo_Call {
+func (_c *MockDataCoord_ListChannelCheckpoints_Call) Return(_a0 *datapb.ListChannelCheckpointsResponse, _a1 error) *MockDataCoord_ListChannelCheckpoints_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetRecoveryInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequest) (*datapb.GetRecoveryInfoResponse, error)) *MockDataCoord_GetRecoveryInfo_Call {
+func (_c *MockDataCoord_ListChannelCheckpoints_Call) RunAndReturn(run func(context.Context, *datapb.ListChannelCheckpointsRequest) (*datapb.ListChannelCheckpointsResponse, error)) *MockDataCoord_ListChannelCheckpoints_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRecoveryInfoV2 provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetRecoveryInfoV2(_a0 context.Context, _a1 *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error) {
+// ListImports provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListImports(_a0 context.Context, _a1 *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GetRecoveryInfoResponseV2
+	var r0 *internalpb.ListImportsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) *datapb.GetRecoveryInfoResponseV2); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequestInternal) *internalpb.ListImportsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetRecoveryInfoResponseV2)
+			r0 = ret.Get(0).(*internalpb.ListImportsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetRecoveryInfoRequestV2) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListImportsRequestInternal) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1547,53 +2743,53 @@ func (_m *MockDataCoord) GetRecoveryInfoV2(_a0 context.Context, _a1 *datapb.GetR
 	return r0, r1
 }
 
-// MockDataCoord_GetRecoveryInfoV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRecoveryInfoV2'
-type MockDataCoord_GetRecoveryInfoV2_Call struct {
+// MockDataCoord_ListImports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImports'
+type MockDataCoord_ListImports_Call struct {
 	*mock.Call
 }
 
-// GetRecoveryInfoV2 is a helper method to define mock.On call
+// ListImports is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetRecoveryInfoRequestV2
-func (_e *MockDataCoord_Expecter) GetRecoveryInfoV2(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetRecoveryInfoV2_Call {
-	return &MockDataCoord_GetRecoveryInfoV2_Call{Call: _e.mock.On("GetRecoveryInfoV2", _a0, _a1)}
+//   - _a1 *internalpb.ListImportsRequestInternal
+func (_e *MockDataCoord_Expecter) ListImports(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListImports_Call {
+	return &MockDataCoord_ListImports_Call{Call: _e.mock.On("ListImports", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetRecoveryInfoV2_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetRecoveryInfoRequestV2)) *MockDataCoord_GetRecoveryInfoV2_Call {
+func (_c *MockDataCoord_ListImports_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListImportsRequestInternal)) *MockDataCoord_ListImports_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetRecoveryInfoRequestV2))
+		run(args[0].(context.Context), args[1].(*internalpb.ListImportsRequestInternal))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetRecoveryInfoV2_Call) Return(_a0 *datapb.GetRecoveryInfoResponseV2, _a1 error) *MockDataCoord_GetRecoveryInfoV2_Call {
+func (_c *MockDataCoord_ListImports_Call) Return(_a0 *internalpb.ListImportsResponse, _a1 error) *MockDataCoord_ListImports_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetRecoveryInfoV2_Call) RunAndReturn(run func(context.Context, *datapb.GetRecoveryInfoRequestV2) (*datapb.GetRecoveryInfoResponseV2, error)) *MockDataCoord_GetRecoveryInfoV2_Call {
+func (_c *MockDataCoord_ListImports_Call) RunAndReturn(run func(context.Context, *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error)) *MockDataCoord_ListImports_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentIndexState provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetSegmentIndexState(_a0 context.Context, _a1 *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error) {
+// ListIndexTasks provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListIndexTasks(_a0 context.Context, _a1 *datapb.ListIndexTasksRequest) (*datapb.ListIndexTasksResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *indexpb.GetSegmentIndexStateResponse
+	var r0 *datapb.ListIndexTasksResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListIndexTasksRequest) (*datapb.ListIndexTasksResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) *indexpb.GetSegmentIndexStateResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListIndexTasksRequest) *datapb.ListIndexTasksResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.GetSegmentIndexStateResponse)
+			r0 = ret.Get(0).(*datapb.ListIndexTasksResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.GetSegmentIndexStateRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListIndexTasksRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1602,53 +2798,53 @@ func (_m *MockDataCoord) GetSegmentIndexState(_a0 context.Context, _a1 *indexpb.
 	return r0, r1
 }
 
-// MockDataCoord_GetSegmentIndexState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentIndexState'
-type MockDataCoord_GetSegmentIndexState_Call struct {
+// MockDataCoord_ListIndexTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIndexTasks'
+type MockDataCoord_ListIndexTasks_Call struct {
 	*mock.Call
 }
 
-// GetSegmentIndexState is a helper method to define mock.On call
+// ListIndexTasks is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.GetSegmentIndexStateRequest
-func (_e *MockDataCoord_Expecter) GetSegmentIndexState(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetSegmentIndexState_Call {
-	return &MockDataCoord_GetSegmentIndexState_Call{Call: _e.mock.On("GetSegmentIndexState", _a0, _a1)}
+//   - _a1 *datapb.ListIndexTasksRequest
+func (_e *MockDataCoord_Expecter) ListIndexTasks(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListIndexTasks_Call {
+	return &MockDataCoord_ListIndexTasks_Call{Call: _e.mock.On("ListIndexTasks", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetSegmentIndexState_Call) Run(run func(_a0 context.Context, _a1 *indexpb.GetSegmentIndexStateRequest)) *MockDataCoord_GetSegmentIndexState_Call {
+func (_c *MockDataCoord_ListIndexTasks_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListIndexTasksRequest)) *MockDataCoord_ListIndexTasks_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.GetSegmentIndexStateRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ListIndexTasksRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentIndexState_Call) Return(_a0 *indexpb.GetSegmentIndexStateResponse, _a1 error) *MockDataCoord_GetSegmentIndexState_Call {
+func (_c *MockDataCoord_ListIndexTasks_Call) Return(_a0 *datapb.ListIndexTasksResponse, _a1 error) *MockDataCoord_ListIndexTasks_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentIndexState_Call) RunAndReturn(run func(context.Context, *indexpb.GetSegmentIndexStateRequest) (*indexpb.GetSegmentIndexStateResponse, error)) *MockDataCoord_GetSegmentIndexState_Call {
+func (_c *MockDataCoord_ListIndexTasks_Call) RunAndReturn(run func(context.Context, *datapb.ListIndexTasksRequest) (*datapb.ListIndexTasksResponse, error)) *MockDataCoord_ListIndexTasks_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentInfo provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetSegmentInfo(_a0 context.Context, _a1 *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error) {
+// ListIndexes provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListIndexes(_a0 context.Context, _a1 *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GetSegmentInfoResponse
+	var r0 *indexpb.ListIndexesResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoRequest) *datapb.GetSegmentInfoResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexesRequest) *indexpb.ListIndexesResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetSegmentInfoResponse)
+			r0 = ret.Get(0).(*indexpb.ListIndexesResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentInfoRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ListIndexesRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1657,53 +2853,53 @@ func (_m *MockDataCoord) GetSegmentInfo(_a0 context.Context, _a1 *datapb.GetSegm
 	return r0, r1
 }
 
-// MockDataCoord_GetSegmentInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfo'
-type MockDataCoord_GetSegmentInfo_Call struct {
+// MockDataCoord_ListIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIndexes'
+type MockDataCoord_ListIndexes_Call struct {
 	*mock.Call
 }
 
-// GetSegmentInfo is a helper method to define mock.On call
+// ListIndexes is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetSegmentInfoRequest
-func (_e *MockDataCoord_Expecter) GetSegmentInfo(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetSegmentInfo_Call {
-	return &MockDataCoord_GetSegmentInfo_Call{Call: _e.mock.On("GetSegmentInfo", _a0, _a1)}
+//   - _a1 *indexpb.ListIndexesRequest
+func (_e *MockDataCoord_Expecter) ListIndexes(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListIndexes_Call {
+	return &MockDataCoord_ListIndexes_Call{Call: _e.mock.On("ListIndexes", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetSegmentInfo_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetSegmentInfoRequest)) *MockDataCoord_GetSegmentInfo_Call {
+func (_c *MockDataCoord_ListIndexes_Call) Run(run func(_a0 context.Context, _a1 *indexpb.ListIndexesRequest)) *MockDataCoord_ListIndexes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetSegmentInfoRequest))
+		run(args[0].(context.Context), args[1].(*indexpb.ListIndexesRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentInfo_Call) Return(_a0 *datapb.GetSegmentInfoResponse, _a1 error) *MockDataCoord_GetSegmentInfo_Call {
+func (_c *MockDataCoord_ListIndexes_Call) Return(_a0 *indexpb.ListIndexesResponse, _a1 error) *MockDataCoord_ListIndexes_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentInfo_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentInfoRequest) (*datapb.GetSegmentInfoResponse, error)) *MockDataCoord_GetSegmentInfo_Call {
+func (_c *MockDataCoord_ListIndexes_Call) RunAndReturn(run func(context.Context, *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error)) *MockDataCoord_ListIndexes_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentInfoChannel provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetSegmentInfoChannel(_a0 context.Context, _a1 *datapb.GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error) {
+// ListReplicas provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListReplicas(_a0 context.Context, _a1 *datapb.ListReplicasRequest) (*datapb.ListReplicasResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *datapb.ListReplicasResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListReplicasRequest) (*datapb.ListReplicasResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentInfoChannelRequest) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListReplicasRequest) *datapb.ListReplicasResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*datapb.ListReplicasResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentInfoChannelRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListReplicasRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1712,53 +2908,53 @@ func (_m *MockDataCoord) GetSegmentInfoChannel(_a0 context.Context, _a1 *datapb.
 	return r0, r1
 }
 
-// MockDataCoord_GetSegmentInfoChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentInfoChannel'
-type MockDataCoord_GetSegmentInfoChannel_Call struct {
+// MockDataCoord_ListReplicas_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReplicas'
+type MockDataCoord_ListReplicas_Call struct {
 	*mock.Call
 }
 
-// GetSegmentInfoChannel is a helper method to define mock.On call
+// ListReplicas is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetSegmentInfoChannelRequest
-func (_e *MockDataCoord_Expecter) GetSegmentInfoChannel(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetSegmentInfoChannel_Call {
-	return &MockDataCoord_GetSegmentInfoChannel_Call{Call: _e.mock.On("GetSegmentInfoChannel", _a0, _a1)}
+//   - _a1 *datapb.ListReplicasRequest
+func (_e *MockDataCoord_Expecter) ListReplicas(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListReplicas_Call {
+	return &MockDataCoord_ListReplicas_Call{Call: _e.mock.On("ListReplicas", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetSegmentInfoChannel_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetSegmentInfoChannelRequest)) *MockDataCoord_GetSegmentInfoChannel_Call {
+func (_c *MockDataCoord_ListReplicas_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListReplicasRequest)) *MockDataCoord_ListReplicas_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetSegmentInfoChannelRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ListReplicasRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentInfoChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetSegmentInfoChannel_Call {
+func (_c *MockDataCoord_ListReplicas_Call) Return(_a0 *datapb.ListReplicasResponse, _a1 error) *MockDataCoord_ListReplicas_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentInfoChannel_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentInfoChannelRequest) (*milvuspb.StringResponse, error)) *MockDataCoord_GetSegmentInfoChannel_Call {
+func (_c *MockDataCoord_ListReplicas_Call) RunAndReturn(run func(context.Context, *datapb.ListReplicasRequest) (*datapb.ListReplicasResponse, error)) *MockDataCoord_ListReplicas_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentStates provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetSegmentStates(_a0 context.Context, _a1 *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error) {
+// ListSnapshots provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ListSnapshots(_a0 context.Context, _a1 *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GetSegmentStatesResponse
+	var r0 *datapb.ListSnapshotsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentStatesRequest) *datapb.GetSegmentStatesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ListSnapshotsRequest) *datapb.ListSnapshotsResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetSegmentStatesResponse)
+			r0 = ret.Get(0).(*datapb.ListSnapshotsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentStatesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ListSnapshotsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1767,53 +2963,53 @@ func (_m *MockDataCoord) GetSegmentStates(_a0 context.Context, _a1 *datapb.GetSe
 	return r0, r1
 }
 
-// MockDataCoord_GetSegmentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentStates'
-type MockDataCoord_GetSegmentStates_Call struct {
+// MockDataCoord_ListSnapshots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSnapshots'
+type MockDataCoord_ListSnapshots_Call struct {
 	*mock.Call
 }
 
-// GetSegmentStates is a helper method to define mock.On call
+// ListSnapshots is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetSegmentStatesRequest
-func (_e *MockDataCoord_Expecter) GetSegmentStates(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetSegmentStates_Call {
-	return &MockDataCoord_GetSegmentStates_Call{Call: _e.mock.On("GetSegmentStates", _a0, _a1)}
+//   - _a1 *datapb.ListSnapshotsRequest
+func (_e *MockDataCoord_Expecter) ListSnapshots(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListSnapshots_Call {
+	return &MockDataCoord_ListSnapshots_Call{Call: _e.mock.On("ListSnapshots", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetSegmentStates_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetSegmentStatesRequest)) *MockDataCoord_GetSegmentStates_Call {
+func (_c *MockDataCoord_ListSnapshots_Call) Run(run func(_a0 context.Context, _a1 *datapb.ListSnapshotsRequest)) *MockDataCoord_ListSnapshots_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetSegmentStatesRequest))
+		run(args[0].(context.Context), args[1].(*datapb.ListSnapshotsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentStates_Call) Return(_a0 *datapb.GetSegmentStatesResponse, _a1 error) *MockDataCoord_GetSegmentStates_Call {
+func (_c *MockDataCoord_ListSnapshots_Call) Return(_a0 *datapb.ListSnapshotsResponse, _a1 error) *MockDataCoord_ListSnapshots_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentStatesRequest) (*datapb.GetSegmentStatesResponse, error)) *MockDataCoord_GetSegmentStates_Call {
+func (_c *MockDataCoord_ListSnapshots_Call) RunAndReturn(run func(context.Context, *datapb.ListSnapshotsRequest) (*datapb.ListSnapshotsResponse, error)) *MockDataCoord_ListSnapshots_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetSegmentsByStates provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetSegmentsByStates(_a0 context.Context, _a1 *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error) {
+// ManualCompaction provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ManualCompaction(_a0 context.Context, _a1 *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *datapb.GetSegmentsByStatesResponse
+	var r0 *milvuspb.ManualCompactionResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.GetSegmentsByStatesRequest) *datapb.GetSegmentsByStatesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest) *milvuspb.ManualCompactionResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*datapb.GetSegmentsByStatesResponse)
+			r0 = ret.Get(0).(*milvuspb.ManualCompactionResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.GetSegmentsByStatesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ManualCompactionRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1822,53 +3018,53 @@ func (_m *MockDataCoord) GetSegmentsByStates(_a0 context.Context, _a1 *datapb.Ge
 	return r0, r1
 }
 
-// MockDataCoord_GetSegmentsByStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentsByStates'
-type MockDataCoord_GetSegmentsByStates_Call struct {
+// MockDataCoord_ManualCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManualCompaction'
+type MockDataCoord_ManualCompaction_Call struct {
 	*mock.Call
 }
 
-// GetSegmentsByStates is a helper method to define mock.On call
+// ManualCompaction is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.GetSegmentsByStatesRequest
-func (_e *MockDataCoord_Expecter) GetSegmentsByStates(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetSegmentsByStates_Call {
-	return &MockDataCoord_GetSegmentsByStates_Call{Call: _e.mock.On("GetSegmentsByStates", _a0, _a1)}
+//   - _a1 *milvuspb.ManualCompactionRequest
+func (_e *MockDataCoord_Expecter) ManualCompaction(_a0 interface{}, _a1 interface{}) *MockDataCoord_ManualCompaction_Call {
+	return &MockDataCoord_ManualCompaction_Call{Call: _e.mock.On("ManualCompaction", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetSegmentsByStates_Call) Run(run func(_a0 context.Context, _a1 *datapb.GetSegmentsByStatesRequest)) *MockDataCoord_GetSegmentsByStates_Call {
+func (_c *MockDataCoord_ManualCompaction_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.ManualCompactionRequest)) *MockDataCoord_ManualCompaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.GetSegmentsByStatesRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.ManualCompactionRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentsByStates_Call) Return(_a0 *datapb.GetSegmentsByStatesResponse, _a1 error) *MockDataCoord_GetSegmentsByStates_Call {
+func (_c *MockDataCoord_ManualCompaction_Call) Return(_a0 *milvuspb.ManualCompactionResponse, _a1 error) *MockDataCoord_ManualCompaction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetSegmentsByStates_Call) RunAndReturn(run func(context.Context, *datapb.GetSegmentsByStatesRequest) (*datapb.GetSegmentsByStatesResponse, error)) *MockDataCoord_GetSegmentsByStates_Call {
+func (_c *MockDataCoord_ManualCompaction_Call) RunAndReturn(run func(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)) *MockDataCoord_ManualCompaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStatisticsChannel provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetStatisticsChannel(_a0 context.Context, _a1 *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error) {
+// MarkSegmentsDropped provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) MarkSegmentsDropped(_a0 context.Context, _a1 *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1877,53 +3073,53 @@ func (_m *MockDataCoord) GetStatisticsChannel(_a0 context.Context, _a1 *internal
 	return r0, r1
 }
 
-// MockDataCoord_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
-type MockDataCoord_GetStatisticsChannel_Call struct {
+// MockDataCoord_MarkSegmentsDropped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSegmentsDropped'
+type MockDataCoord_MarkSegmentsDropped_Call struct {
 	*mock.Call
 }
 
-// GetStatisticsChannel is a helper method to define mock.On call
+// MarkSegmentsDropped is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *internalpb.GetStatisticsChannelRequest
-func (_e *MockDataCoord_Expecter) GetStatisticsChannel(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetStatisticsChannel_Call {
-	return &MockDataCoord_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel", _a0, _a1)}
+//   - _a1 *datapb.MarkSegmentsDroppedRequest
+func (_e *MockDataCoord_Expecter) MarkSegmentsDropped(_a0 interface{}, _a1 interface{}) *MockDataCoord_MarkSegmentsDropped_Call {
+	return &MockDataCoord_MarkSegmentsDropped_Call{Call: _e.mock.On("MarkSegmentsDropped", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetStatisticsChannel_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetStatisticsChannelRequest)) *MockDataCoord_GetStatisticsChannel_Call {
+func (_c *MockDataCoord_MarkSegmentsDropped_Call) Run(run func(_a0 context.Context, _a1 *datapb.MarkSegmentsDroppedRequest)) *MockDataCoord_MarkSegmentsDropped_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest))
+		run(args[0].(context.Context), args[1].(*datapb.MarkSegmentsDroppedRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetStatisticsChannel_Call {
+func (_c *MockDataCoord_MarkSegmentsDropped_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_MarkSegmentsDropped_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest) (*milvuspb.StringResponse, error)) *MockDataCoord_GetStatisticsChannel_Call {
+func (_c *MockDataCoord_MarkSegmentsDropped_Call) RunAndReturn(run func(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)) *MockDataCoord_MarkSegmentsDropped_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTimeTickChannel provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) GetTimeTickChannel(_a0 context.Context, _a1 *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error) {
+// PauseIngestion provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) PauseIngestion(_a0 context.Context, _a1 *datapb.PauseIngestionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PauseIngestionRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetTimeTickChannelRequest) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PauseIngestionRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetTimeTickChannelRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.PauseIngestionRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1932,53 +3128,53 @@ func (_m *MockDataCoord) GetTimeTickChannel(_a0 context.Context, _a1 *internalpb
 	return r0, r1
 }
 
-// MockDataCoord_GetTimeTickChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTimeTickChannel'
-type MockDataCoord_GetTimeTickChannel_Call struct {
+// MockDataCoord_PauseIngestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseIngestion'
+type MockDataCoord_PauseIngestion_Call struct {
 	*mock.Call
 }
 
-// GetTimeTickChannel is a helper method to define mock.On call
+// PauseIngestion is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *internalpb.GetTimeTickChannelRequest
-func (_e *MockDataCoord_Expecter) GetTimeTickChannel(_a0 interface{}, _a1 interface{}) *MockDataCoord_GetTimeTickChannel_Call {
-	return &MockDataCoord_GetTimeTickChannel_Call{Call: _e.mock.On("GetTimeTickChannel", _a0, _a1)}
+//   - _a1 *datapb.PauseIngestionRequest
+func (_e *MockDataCoord_Expecter) PauseIngestion(_a0 interface{}, _a1 interface{}) *MockDataCoord_PauseIngestion_Call {
+	return &MockDataCoord_PauseIngestion_Call{Call: _e.mock.On("PauseIngestion", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_GetTimeTickChannel_Call) Run(run func(_a0 context.Context, _a1 *internalpb.GetTimeTickChannelRequest)) *MockDataCoord_GetTimeTickChannel_Call {
+func (_c *MockDataCoord_PauseIngestion_Call) Run(run func(_a0 context.Context, _a1 *datapb.PauseIngestionRequest)) *MockDataCoord_PauseIngestion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*internalpb.GetTimeTickChannelRequest))
+		run(args[0].(context.Context), args[1].(*datapb.PauseIngestionRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_GetTimeTickChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockDataCoord_GetTimeTickChannel_Call {
+func (_c *MockDataCoord_PauseIngestion_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_PauseIngestion_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_GetTimeTickChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetTimeTickChannelRequest) (*milvuspb.StringResponse, error)) *MockDataCoord_GetTimeTickChannel_Call {
+func (_c *MockDataCoord_PauseIngestion_Call) RunAndReturn(run func(context.Context, *datapb.PauseIngestionRequest) (*commonpb.Status, error)) *MockDataCoord_PauseIngestion_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ImportV2 provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) ImportV2(_a0 context.Context, _a1 *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error) {
+// PromoteReplica provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) PromoteReplica(_a0 context.Context, _a1 *datapb.PromoteReplicaRequest) (*datapb.RestoreSnapshotResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *internalpb.ImportResponse
+	var r0 *datapb.RestoreSnapshotResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PromoteReplicaRequest) (*datapb.RestoreSnapshotResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequestInternal) *internalpb.ImportResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PromoteReplicaRequest) *datapb.RestoreSnapshotResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.ImportResponse)
+			r0 = ret.Get(0).(*datapb.RestoreSnapshotResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ImportRequestInternal) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.PromoteReplicaRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -1987,37 +3183,37 @@ func (_m *MockDataCoord) ImportV2(_a0 context.Context, _a1 *internalpb.ImportReq
 	return r0, r1
 }
 
-// MockDataCoord_ImportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportV2'
-type MockDataCoord_ImportV2_Call struct {
+// MockDataCoord_PromoteReplica_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteReplica'
+type MockDataCoord_PromoteReplica_Call struct {
 	*mock.Call
 }
 
-// ImportV2 is a helper method to define mock.On call
+// PromoteReplica is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *internalpb.ImportRequestInternal
-func (_e *MockDataCoord_Expecter) ImportV2(_a0 interface{}, _a1 interface{}) *MockDataCoord_ImportV2_Call {
-	return &MockDataCoord_ImportV2_Call{Call: _e.mock.On("ImportV2", _a0, _a1)}
+//   - _a1 *datapb.PromoteReplicaRequest
+func (_e *MockDataCoord_Expecter) PromoteReplica(_a0 interface{}, _a1 interface{}) *MockDataCoord_PromoteReplica_Call {
+	return &MockDataCoord_PromoteReplica_Call{Call: _e.mock.On("PromoteReplica", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_ImportV2_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ImportRequestInternal)) *MockDataCoord_ImportV2_Call {
+func (_c *MockDataCoord_PromoteReplica_Call) Run(run func(_a0 context.Context, _a1 *datapb.PromoteReplicaRequest)) *MockDataCoord_PromoteReplica_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*internalpb.ImportRequestInternal))
+		run(args[0].(context.Context), args[1].(*datapb.PromoteReplicaRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_ImportV2_Call) Return(_a0 *internalpb.ImportResponse, _a1 error) *MockDataCoord_ImportV2_Call {
+func (_c *MockDataCoord_PromoteReplica_Call) Return(_a0 *datapb.RestoreSnapshotResponse, _a1 error) *MockDataCoord_PromoteReplica_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_ImportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ImportRequestInternal) (*internalpb.ImportResponse, error)) *MockDataCoord_ImportV2_Call {
+func (_c *MockDataCoord_PromoteReplica_Call) RunAndReturn(run func(context.Context, *datapb.PromoteReplicaRequest) (*datapb.RestoreSnapshotResponse, error)) *MockDataCoord_PromoteReplica_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Init provides a mock function with given fields:
-func (_m *MockDataCoord) Init() error {
+// Register provides a mock function with given fields:
+func (_m *MockDataCoord) Register() error {
 	ret := _m.Called()
 
 	var r0 error
@@ -2030,51 +3226,51 @@ func (_m *MockDataCoord) Init() error {
 	return r0
 }
 
-// MockDataCoord_Init_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Init'
-type MockDataCoord_Init_Call struct {
+// MockDataCoord_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
+type MockDataCoord_Register_Call struct {
 	*mock.Call
 }
 
-// Init is a helper method to define mock.On call
-func (_e *MockDataCoord_Expecter) Init() *MockDataCoord_Init_Call {
-	return &MockDataCoord_Init_Call{Call: _e.mock.On("Init")}
+// Register is a helper method to define mock.On call
+func (_e *MockDataCoord_Expecter) Register() *MockDataCoord_Register_Call {
+	return &MockDataCoord_Register_Call{Call: _e.mock.On("Register")}
 }
 
-func (_c *MockDataCoord_Init_Call) Run(run func()) *MockDataCoord_Init_Call {
+func (_c *MockDataCoord_Register_Call) Run(run func()) *MockDataCoord_Register_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_Init_Call) Return(_a0 error) *MockDataCoord_Init_Call {
+func (_c *MockDataCoord_Register_Call) Return(_a0 error) *MockDataCoord_Register_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *MockDataCoord_Init_Call) RunAndReturn(run func() error) *MockDataCoord_Init_Call {
+func (_c *MockDataCoord_Register_Call) RunAndReturn(run func() error) *MockDataCoord_Register_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListImports provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) ListImports(_a0 context.Context, _a1 *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error) {
+// ReportDataNodeTtMsgs provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ReportDataNodeTtMsgs(_a0 context.Context, _a1 *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *internalpb.ListImportsResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequestInternal) *internalpb.ListImportsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.ListImportsResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListImportsRequestInternal) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -2083,53 +3279,53 @@ func (_m *MockDataCoord) ListImports(_a0 context.Context, _a1 *internalpb.ListIm
 	return r0, r1
 }
 
-// MockDataCoord_ListImports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImports'
-type MockDataCoord_ListImports_Call struct {
+// MockDataCoord_ReportDataNodeTtMsgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportDataNodeTtMsgs'
+type MockDataCoord_ReportDataNodeTtMsgs_Call struct {
 	*mock.Call
 }
 
-// ListImports is a helper method to define mock.On call
+// ReportDataNodeTtMsgs is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *internalpb.ListImportsRequestInternal
-func (_e *MockDataCoord_Expecter) ListImports(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListImports_Call {
-	return &MockDataCoord_ListImports_Call{Call: _e.mock.On("ListImports", _a0, _a1)}
+//   - _a1 *datapb.ReportDataNodeTtMsgsRequest
+func (_e *MockDataCoord_Expecter) ReportDataNodeTtMsgs(_a0 interface{}, _a1 interface{}) *MockDataCoord_ReportDataNodeTtMsgs_Call {
+	return &MockDataCoord_ReportDataNodeTtMsgs_Call{Call: _e.mock.On("ReportDataNodeTtMsgs", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_ListImports_Call) Run(run func(_a0 context.Context, _a1 *internalpb.ListImportsRequestInternal)) *MockDataCoord_ListImports_Call {
+func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) Run(run func(_a0 context.Context, _a1 *datapb.ReportDataNodeTtMsgsRequest)) *MockDataCoord_ReportDataNodeTtMsgs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*internalpb.ListImportsRequestInternal))
+		run(args[0].(context.Context), args[1].(*datapb.ReportDataNodeTtMsgsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_ListImports_Call) Return(_a0 *internalpb.ListImportsResponse, _a1 error) *MockDataCoord_ListImports_Call {
+func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ReportDataNodeTtMsgs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_ListImports_Call) RunAndReturn(run func(context.Context, *internalpb.ListImportsRequestInternal) (*internalpb.ListImportsResponse, error)) *MockDataCoord_ListImports_Call {
+func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)) *MockDataCoord_ReportDataNodeTtMsgs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListIndexes provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) ListIndexes(_a0 context.Context, _a1 *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error) {
+// RestoreBackup provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) RestoreBackup(_a0 context.Context, _a1 *datapb.RestoreBackupRequest) (*datapb.RestoreSnapshotResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *indexpb.ListIndexesResponse
+	var r0 *datapb.RestoreSnapshotResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreBackupRequest) (*datapb.RestoreSnapshotResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *indexpb.ListIndexesRequest) *indexpb.ListIndexesResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreBackupRequest) *datapb.RestoreSnapshotResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*indexpb.ListIndexesResponse)
+			r0 = ret.Get(0).(*datapb.RestoreSnapshotResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *indexpb.ListIndexesRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RestoreBackupRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -2138,53 +3334,53 @@ func (_m *MockDataCoord) ListIndexes(_a0 context.Context, _a1 *indexpb.ListIndex
 	return r0, r1
 }
 
-// MockDataCoord_ListIndexes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListIndexes'
-type MockDataCoord_ListIndexes_Call struct {
+// MockDataCoord_RestoreBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreBackup'
+type MockDataCoord_RestoreBackup_Call struct {
 	*mock.Call
 }
 
-// ListIndexes is a helper method to define mock.On call
+// RestoreBackup is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *indexpb.ListIndexesRequest
-func (_e *MockDataCoord_Expecter) ListIndexes(_a0 interface{}, _a1 interface{}) *MockDataCoord_ListIndexes_Call {
-	return &MockDataCoord_ListIndexes_Call{Call: _e.mock.On("ListIndexes", _a0, _a1)}
+//   - _a1 *datapb.RestoreBackupRequest
+func (_e *MockDataCoord_Expecter) RestoreBackup(_a0 interface{}, _a1 interface{}) *MockDataCoord_RestoreBackup_Call {
+	return &MockDataCoord_RestoreBackup_Call{Call: _e.mock.On("RestoreBackup", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_ListIndexes_Call) Run(run func(_a0 context.Context, _a1 *indexpb.ListIndexesRequest)) *MockDataCoord_ListIndexes_Call {
+func (_c *MockDataCoord_RestoreBackup_Call) Run(run func(_a0 context.Context, _a1 *datapb.RestoreBackupRequest)) *MockDataCoord_RestoreBackup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*indexpb.ListIndexesRequest))
+		run(args[0].(context.Context), args[1].(*datapb.RestoreBackupRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_ListIndexes_Call) Return(_a0 *indexpb.ListIndexesResponse, _a1 error) *MockDataCoord_ListIndexes_Call {
+func (_c *MockDataCoord_RestoreBackup_Call) Return(_a0 *datapb.RestoreSnapshotResponse, _a1 error) *MockDataCoord_RestoreBackup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_ListIndexes_Call) RunAndReturn(run func(context.Context, *indexpb.ListIndexesRequest) (*indexpb.ListIndexesResponse, error)) *MockDataCoord_ListIndexes_Call {
+func (_c *MockDataCoord_RestoreBackup_Call) RunAndReturn(run func(context.Context, *datapb.RestoreBackupRequest) (*datapb.RestoreSnapshotResponse, error)) *MockDataCoord_RestoreBackup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ManualCompaction provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) ManualCompaction(_a0 context.Context, _a1 *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+// RestoreSegment provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) RestoreSegment(_a0 context.Context, _a1 *datapb.RestoreSegmentRequest) (*datapb.RestoreSegmentResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.ManualCompactionResponse
+	var r0 *datapb.RestoreSegmentResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSegmentRequest) (*datapb.RestoreSegmentResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.ManualCompactionRequest) *milvuspb.ManualCompactionResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSegmentRequest) *datapb.RestoreSegmentResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ManualCompactionResponse)
+			r0 = ret.Get(0).(*datapb.RestoreSegmentResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.ManualCompactionRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RestoreSegmentRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -2193,53 +3389,53 @@ func (_m *MockDataCoord) ManualCompaction(_a0 context.Context, _a1 *milvuspb.Man
 	return r0, r1
 }
 
-// MockDataCoord_ManualCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ManualCompaction'
-type MockDataCoord_ManualCompaction_Call struct {
+// MockDataCoord_RestoreSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSegment'
+type MockDataCoord_RestoreSegment_Call struct {
 	*mock.Call
 }
 
-// ManualCompaction is a helper method to define mock.On call
+// RestoreSegment is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.ManualCompactionRequest
-func (_e *MockDataCoord_Expecter) ManualCompaction(_a0 interface{}, _a1 interface{}) *MockDataCoord_ManualCompaction_Call {
-	return &MockDataCoord_ManualCompaction_Call{Call: _e.mock.On("ManualCompaction", _a0, _a1)}
+//   - _a1 *datapb.RestoreSegmentRequest
+func (_e *MockDataCoord_Expecter) RestoreSegment(_a0 interface{}, _a1 interface{}) *MockDataCoord_RestoreSegment_Call {
+	return &MockDataCoord_RestoreSegment_Call{Call: _e.mock.On("RestoreSegment", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_ManualCompaction_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.ManualCompactionRequest)) *MockDataCoord_ManualCompaction_Call {
+func (_c *MockDataCoord_RestoreSegment_Call) Run(run func(_a0 context.Context, _a1 *datapb.RestoreSegmentRequest)) *MockDataCoord_RestoreSegment_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.ManualCompactionRequest))
+		run(args[0].(context.Context), args[1].(*datapb.RestoreSegmentRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_ManualCompaction_Call) Return(_a0 *milvuspb.ManualCompactionResponse, _a1 error) *MockDataCoord_ManualCompaction_Call {
+func (_c *MockDataCoord_RestoreSegment_Call) Return(_a0 *datapb.RestoreSegmentResponse, _a1 error) *MockDataCoord_RestoreSegment_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_ManualCompaction_Call) RunAndReturn(run func(context.Context, *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)) *MockDataCoord_ManualCompaction_Call {
+func (_c *MockDataCoord_RestoreSegment_Call) RunAndReturn(run func(context.Context, *datapb.RestoreSegmentRequest) (*datapb.RestoreSegmentResponse, error)) *MockDataCoord_RestoreSegment_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// MarkSegmentsDropped provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) MarkSegmentsDropped(_a0 context.Context, _a1 *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error) {
+// RestoreSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) RestoreSnapshot(_a0 context.Context, _a1 *datapb.RestoreSnapshotRequest) (*datapb.RestoreSnapshotResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *commonpb.Status
+	var r0 *datapb.RestoreSnapshotResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotRequest) (*datapb.RestoreSnapshotResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.RestoreSnapshotRequest) *datapb.RestoreSnapshotResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*datapb.RestoreSnapshotResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.MarkSegmentsDroppedRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.RestoreSnapshotRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -2248,86 +3444,100 @@ func (_m *MockDataCoord) MarkSegmentsDropped(_a0 context.Context, _a1 *datapb.Ma
 	return r0, r1
 }
 
-// MockDataCoord_MarkSegmentsDropped_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkSegmentsDropped'
-type MockDataCoord_MarkSegmentsDropped_Call struct {
+// MockDataCoord_RestoreSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreSnapshot'
+type MockDataCoord_RestoreSnapshot_Call struct {
 	*mock.Call
 }
 
-// MarkSegmentsDropped is a helper method to define mock.On call
+// RestoreSnapshot is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.MarkSegmentsDroppedRequest
-func (_e *MockDataCoord_Expecter) MarkSegmentsDropped(_a0 interface{}, _a1 interface{}) *MockDataCoord_MarkSegmentsDropped_Call {
-	return &MockDataCoord_MarkSegmentsDropped_Call{Call: _e.mock.On("MarkSegmentsDropped", _a0, _a1)}
+//   - _a1 *datapb.RestoreSnapshotRequest
+func (_e *MockDataCoord_Expecter) RestoreSnapshot(_a0 interface{}, _a1 interface{}) *MockDataCoord_RestoreSnapshot_Call {
+	return &MockDataCoord_RestoreSnapshot_Call{Call: _e.mock.On("RestoreSnapshot", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_MarkSegmentsDropped_Call) Run(run func(_a0 context.Context, _a1 *datapb.MarkSegmentsDroppedRequest)) *MockDataCoord_MarkSegmentsDropped_Call {
+func (_c *MockDataCoord_RestoreSnapshot_Call) Run(run func(_a0 context.Context, _a1 *datapb.RestoreSnapshotRequest)) *MockDataCoord_RestoreSnapshot_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.MarkSegmentsDroppedRequest))
+		run(args[0].(context.Context), args[1].(*datapb.RestoreSnapshotRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_MarkSegmentsDropped_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_MarkSegmentsDropped_Call {
+func (_c *MockDataCoord_RestoreSnapshot_Call) Return(_a0 *datapb.RestoreSnapshotResponse, _a1 error) *MockDataCoord_RestoreSnapshot_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_MarkSegmentsDropped_Call) RunAndReturn(run func(context.Context, *datapb.MarkSegmentsDroppedRequest) (*commonpb.Status, error)) *MockDataCoord_MarkSegmentsDropped_Call {
+func (_c *MockDataCoord_RestoreSnapshot_Call) RunAndReturn(run func(context.Context, *datapb.RestoreSnapshotRequest) (*datapb.RestoreSnapshotResponse, error)) *MockDataCoord_RestoreSnapshot_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Register provides a mock function with given fields:
-func (_m *MockDataCoord) Register() error {
-	ret := _m.Called()
+// ResumeIngestion provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) ResumeIngestion(_a0 context.Context, _a1 *datapb.ResumeIngestionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ResumeIngestionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ResumeIngestionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ResumeIngestionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// MockDataCoord_Register_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Register'
-type MockDataCoord_Register_Call struct {
+// MockDataCoord_ResumeIngestion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeIngestion'
+type MockDataCoord_ResumeIngestion_Call struct {
 	*mock.Call
 }
 
-// Register is a helper method to define mock.On call
-func (_e *MockDataCoord_Expecter) Register() *MockDataCoord_Register_Call {
-	return &MockDataCoord_Register_Call{Call: _e.mock.On("Register")}
+// ResumeIngestion is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.ResumeIngestionRequest
+func (_e *MockDataCoord_Expecter) ResumeIngestion(_a0 interface{}, _a1 interface{}) *MockDataCoord_ResumeIngestion_Call {
+	return &MockDataCoord_ResumeIngestion_Call{Call: _e.mock.On("ResumeIngestion", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_Register_Call) Run(run func()) *MockDataCoord_Register_Call {
+func (_c *MockDataCoord_ResumeIngestion_Call) Run(run func(_a0 context.Context, _a1 *datapb.ResumeIngestionRequest)) *MockDataCoord_ResumeIngestion_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		run(args[0].(context.Context), args[1].(*datapb.ResumeIngestionRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_Register_Call) Return(_a0 error) *MockDataCoord_Register_Call {
-	_c.Call.Return(_a0)
+func (_c *MockDataCoord_ResumeIngestion_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ResumeIngestion_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_Register_Call) RunAndReturn(run func() error) *MockDataCoord_Register_Call {
+func (_c *MockDataCoord_ResumeIngestion_Call) RunAndReturn(run func(context.Context, *datapb.ResumeIngestionRequest) (*commonpb.Status, error)) *MockDataCoord_ResumeIngestion_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ReportDataNodeTtMsgs provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) ReportDataNodeTtMsgs(_a0 context.Context, _a1 *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error) {
+// SaveBinlogPaths provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) SaveBinlogPaths(_a0 context.Context, _a1 *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SaveBinlogPathsRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -2335,7 +3545,7 @@ func (_m *MockDataCoord) ReportDataNodeTtMsgs(_a0 context.Context, _a1 *datapb.R
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.SaveBinlogPathsRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -2344,45 +3554,45 @@ func (_m *MockDataCoord) ReportDataNodeTtMsgs(_a0 context.Context, _a1 *datapb.R
 	return r0, r1
 }
 
-// MockDataCoord_ReportDataNodeTtMsgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReportDataNodeTtMsgs'
-type MockDataCoord_ReportDataNodeTtMsgs_Call struct {
+// MockDataCoord_SaveBinlogPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBinlogPaths'
+type MockDataCoord_SaveBinlogPaths_Call struct {
 	*mock.Call
 }
 
-// ReportDataNodeTtMsgs is a helper method to define mock.On call
+// SaveBinlogPaths is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.ReportDataNodeTtMsgsRequest
-func (_e *MockDataCoord_Expecter) ReportDataNodeTtMsgs(_a0 interface{}, _a1 interface{}) *MockDataCoord_ReportDataNodeTtMsgs_Call {
-	return &MockDataCoord_ReportDataNodeTtMsgs_Call{Call: _e.mock.On("ReportDataNodeTtMsgs", _a0, _a1)}
+//   - _a1 *datapb.SaveBinlogPathsRequest
+func (_e *MockDataCoord_Expecter) SaveBinlogPaths(_a0 interface{}, _a1 interface{}) *MockDataCoord_SaveBinlogPaths_Call {
+	return &MockDataCoord_SaveBinlogPaths_Call{Call: _e.mock.On("SaveBinlogPaths", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) Run(run func(_a0 context.Context, _a1 *datapb.ReportDataNodeTtMsgsRequest)) *MockDataCoord_ReportDataNodeTtMsgs_Call {
+func (_c *MockDataCoord_SaveBinlogPaths_Call) Run(run func(_a0 context.Context, _a1 *datapb.SaveBinlogPathsRequest)) *MockDataCoord_SaveBinlogPaths_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.ReportDataNodeTtMsgsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.SaveBinlogPathsRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_ReportDataNodeTtMsgs_Call {
+func (_c *MockDataCoord_SaveBinlogPaths_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_SaveBinlogPaths_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_ReportDataNodeTtMsgs_Call) RunAndReturn(run func(context.Context, *datapb.ReportDataNodeTtMsgsRequest) (*commonpb.Status, error)) *MockDataCoord_ReportDataNodeTtMsgs_Call {
+func (_c *MockDataCoord_SaveBinlogPaths_Call) RunAndReturn(run func(context.Context, *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error)) *MockDataCoord_SaveBinlogPaths_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveBinlogPaths provides a mock function with given fields: _a0, _a1
-func (_m *MockDataCoord) SaveBinlogPaths(_a0 context.Context, _a1 *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error) {
+// SaveIdempotencyRecord provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) SaveIdempotencyRecord(_a0 context.Context, _a1 *datapb.SaveIdempotencyRecordRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SaveIdempotencyRecordRequest) (*commonpb.Status, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SaveBinlogPathsRequest) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SaveIdempotencyRecordRequest) *commonpb.Status); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
@@ -2390,7 +3600,7 @@ func (_m *MockDataCoord) SaveBinlogPaths(_a0 context.Context, _a1 *datapb.SaveBi
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *datapb.SaveBinlogPathsRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.SaveIdempotencyRecordRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -2399,31 +3609,31 @@ func (_m *MockDataCoord) SaveBinlogPaths(_a0 context.Context, _a1 *datapb.SaveBi
 	return r0, r1
 }
 
-// MockDataCoord_SaveBinlogPaths_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveBinlogPaths'
-type MockDataCoord_SaveBinlogPaths_Call struct {
+// MockDataCoord_SaveIdempotencyRecord_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveIdempotencyRecord'
+type MockDataCoord_SaveIdempotencyRecord_Call struct {
 	*mock.Call
 }
 
-// SaveBinlogPaths is a helper method to define mock.On call
+// SaveIdempotencyRecord is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *datapb.SaveBinlogPathsRequest
-func (_e *MockDataCoord_Expecter) SaveBinlogPaths(_a0 interface{}, _a1 interface{}) *MockDataCoord_SaveBinlogPaths_Call {
-	return &MockDataCoord_SaveBinlogPaths_Call{Call: _e.mock.On("SaveBinlogPaths", _a0, _a1)}
+//   - _a1 *datapb.SaveIdempotencyRecordRequest
+func (_e *MockDataCoord_Expecter) SaveIdempotencyRecord(_a0 interface{}, _a1 interface{}) *MockDataCoord_SaveIdempotencyRecord_Call {
+	return &MockDataCoord_SaveIdempotencyRecord_Call{Call: _e.mock.On("SaveIdempotencyRecord", _a0, _a1)}
 }
 
-func (_c *MockDataCoord_SaveBinlogPaths_Call) Run(run func(_a0 context.Context, _a1 *datapb.SaveBinlogPathsRequest)) *MockDataCoord_SaveBinlogPaths_Call {
+func (_c *MockDataCoord_SaveIdempotencyRecord_Call) Run(run func(_a0 context.Context, _a1 *datapb.SaveIdempotencyRecordRequest)) *MockDataCoord_SaveIdempotencyRecord_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*datapb.SaveBinlogPathsRequest))
+		run(args[0].(context.Context), args[1].(*datapb.SaveIdempotencyRecordRequest))
 	})
 	return _c
 }
 
-func (_c *MockDataCoord_SaveBinlogPaths_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_SaveBinlogPaths_Call {
+func (_c *MockDataCoord_SaveIdempotencyRecord_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_SaveIdempotencyRecord_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockDataCoord_SaveBinlogPaths_Call) RunAndReturn(run func(context.Context, *datapb.SaveBinlogPathsRequest) (*commonpb.Status, error)) *MockDataCoord_SaveBinlogPaths_Call {
+func (_c *MockDataCoord_SaveIdempotencyRecord_Call) RunAndReturn(run func(context.Context, *datapb.SaveIdempotencyRecordRequest) (*commonpb.Status, error)) *MockDataCoord_SaveIdempotencyRecord_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2461,6 +3671,61 @@ func (_c *MockDataCoord_SetAddress_Call) RunAndReturn(run func(string)) *MockDat
 	return _c
 }
 
+// SetChannelParallelism provides a mock function with given fields: _a0, _a1
+func (_m *MockDataCoord) SetChannelParallelism(_a0 context.Context, _a1 *datapb.SetChannelParallelismRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SetChannelParallelismRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SetChannelParallelismRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.SetChannelParallelismRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataCoord_SetChannelParallelism_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetChannelParallelism'
+type MockDataCoord_SetChannelParallelism_Call struct {
+	*mock.Call
+}
+
+// SetChannelParallelism is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.SetChannelParallelismRequest
+func (_e *MockDataCoord_Expecter) SetChannelParallelism(_a0 interface{}, _a1 interface{}) *MockDataCoord_SetChannelParallelism_Call {
+	return &MockDataCoord_SetChannelParallelism_Call{Call: _e.mock.On("SetChannelParallelism", _a0, _a1)}
+}
+
+func (_c *MockDataCoord_SetChannelParallelism_Call) Run(run func(_a0 context.Context, _a1 *datapb.SetChannelParallelismRequest)) *MockDataCoord_SetChannelParallelism_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.SetChannelParallelismRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataCoord_SetChannelParallelism_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataCoord_SetChannelParallelism_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataCoord_SetChannelParallelism_Call) RunAndReturn(run func(context.Context, *datapb.SetChannelParallelismRequest) (*commonpb.Status, error)) *MockDataCoord_SetChannelParallelism_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetDataNodeCreator provides a mock function with given fields: _a0
 func (_m *MockDataCoord) SetDataNodeCreator(_a0 func(context.Context, string, int64) (types.DataNodeClient, error)) {
 	_m.Called(_a0)
@@ -801,6 +801,61 @@ func (_c *MockDataNode_NotifyChannelOperation_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// PauseChannels provides a mock function with given fields: _a0, _a1
+func (_m *MockDataNode) PauseChannels(_a0 context.Context, _a1 *datapb.PauseChannelsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PauseChannelsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PauseChannelsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.PauseChannelsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNode_PauseChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseChannels'
+type MockDataNode_PauseChannels_Call struct {
+	*mock.Call
+}
+
+// PauseChannels is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.PauseChannelsRequest
+func (_e *MockDataNode_Expecter) PauseChannels(_a0 interface{}, _a1 interface{}) *MockDataNode_PauseChannels_Call {
+	return &MockDataNode_PauseChannels_Call{Call: _e.mock.On("PauseChannels", _a0, _a1)}
+}
+
+func (_c *MockDataNode_PauseChannels_Call) Run(run func(_a0 context.Context, _a1 *datapb.PauseChannelsRequest)) *MockDataNode_PauseChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.PauseChannelsRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataNode_PauseChannels_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNode_PauseChannels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNode_PauseChannels_Call) RunAndReturn(run func(context.Context, *datapb.PauseChannelsRequest) (*commonpb.Status, error)) *MockDataNode_PauseChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PreImport provides a mock function with given fields: _a0, _a1
 func (_m *MockDataNode) PreImport(_a0 context.Context, _a1 *datapb.PreImportRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1095,6 +1150,61 @@ func (_c *MockDataNode_SetAddress_Call) RunAndReturn(run func(string)) *MockData
 	return _c
 }
 
+// SetChannelParallelism provides a mock function with given fields: _a0, _a1
+func (_m *MockDataNode) SetChannelParallelism(_a0 context.Context, _a1 *datapb.SetChannelParallelismRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SetChannelParallelismRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SetChannelParallelismRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.SetChannelParallelismRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNode_SetChannelParallelism_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetChannelParallelism'
+type MockDataNode_SetChannelParallelism_Call struct {
+	*mock.Call
+}
+
+// SetChannelParallelism is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.SetChannelParallelismRequest
+func (_e *MockDataNode_Expecter) SetChannelParallelism(_a0 interface{}, _a1 interface{}) *MockDataNode_SetChannelParallelism_Call {
+	return &MockDataNode_SetChannelParallelism_Call{Call: _e.mock.On("SetChannelParallelism", _a0, _a1)}
+}
+
+func (_c *MockDataNode_SetChannelParallelism_Call) Run(run func(_a0 context.Context, _a1 *datapb.SetChannelParallelismRequest)) *MockDataNode_SetChannelParallelism_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.SetChannelParallelismRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataNode_SetChannelParallelism_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNode_SetChannelParallelism_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNode_SetChannelParallelism_Call) RunAndReturn(run func(context.Context, *datapb.SetChannelParallelismRequest) (*commonpb.Status, error)) *MockDataNode_SetChannelParallelism_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SetDataCoordClient provides a mock function with given fields: dataCoord
 func (_m *MockDataNode) SetDataCoordClient(dataCoord types.DataCoordClient) error {
 	ret := _m.Called(dataCoord)
@@ -1349,6 +1459,61 @@ func (_c *MockDataNode_Stop_Call) RunAndReturn(run func() error) *MockDataNode_S
 	return _c
 }
 
+// StopCompaction provides a mock function with given fields: _a0, _a1
+func (_m *MockDataNode) StopCompaction(_a0 context.Context, _a1 *datapb.StopCompactionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.StopCompactionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.StopCompactionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.StopCompactionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNode_StopCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopCompaction'
+type MockDataNode_StopCompaction_Call struct {
+	*mock.Call
+}
+
+// StopCompaction is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *datapb.StopCompactionRequest
+func (_e *MockDataNode_Expecter) StopCompaction(_a0 interface{}, _a1 interface{}) *MockDataNode_StopCompaction_Call {
+	return &MockDataNode_StopCompaction_Call{Call: _e.mock.On("StopCompaction", _a0, _a1)}
+}
+
+func (_c *MockDataNode_StopCompaction_Call) Run(run func(_a0 context.Context, _a1 *datapb.StopCompactionRequest)) *MockDataNode_StopCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*datapb.StopCompactionRequest))
+	})
+	return _c
+}
+
+func (_c *MockDataNode_StopCompaction_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNode_StopCompaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNode_StopCompaction_Call) RunAndReturn(run func(context.Context, *datapb.StopCompactionRequest) (*commonpb.Status, error)) *MockDataNode_StopCompaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SyncSegments provides a mock function with given fields: _a0, _a1
 func (_m *MockDataNode) SyncSegments(_a0 context.Context, _a1 *datapb.SyncSegmentsRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
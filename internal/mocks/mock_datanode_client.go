@@ -842,6 +842,76 @@ func (_c *MockDataNodeClient_NotifyChannelOperation_Call) RunAndReturn(run func(
 	return _c
 }
 
+// PauseChannels provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataNodeClient) PauseChannels(ctx context.Context, in *datapb.PauseChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PauseChannelsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.PauseChannelsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.PauseChannelsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNodeClient_PauseChannels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseChannels'
+type MockDataNodeClient_PauseChannels_Call struct {
+	*mock.Call
+}
+
+// PauseChannels is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.PauseChannelsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataNodeClient_Expecter) PauseChannels(ctx interface{}, in interface{}, opts ...interface{}) *MockDataNodeClient_PauseChannels_Call {
+	return &MockDataNodeClient_PauseChannels_Call{Call: _e.mock.On("PauseChannels",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataNodeClient_PauseChannels_Call) Run(run func(ctx context.Context, in *datapb.PauseChannelsRequest, opts ...grpc.CallOption)) *MockDataNodeClient_PauseChannels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.PauseChannelsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataNodeClient_PauseChannels_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNodeClient_PauseChannels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNodeClient_PauseChannels_Call) RunAndReturn(run func(context.Context, *datapb.PauseChannelsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataNodeClient_PauseChannels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PreImport provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataNodeClient) PreImport(ctx context.Context, in *datapb.PreImportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1122,6 +1192,76 @@ func (_c *MockDataNodeClient_ResendSegmentStats_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// SetChannelParallelism provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataNodeClient) SetChannelParallelism(ctx context.Context, in *datapb.SetChannelParallelismRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SetChannelParallelismRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.SetChannelParallelismRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.SetChannelParallelismRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNodeClient_SetChannelParallelism_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetChannelParallelism'
+type MockDataNodeClient_SetChannelParallelism_Call struct {
+	*mock.Call
+}
+
+// SetChannelParallelism is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.SetChannelParallelismRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataNodeClient_Expecter) SetChannelParallelism(ctx interface{}, in interface{}, opts ...interface{}) *MockDataNodeClient_SetChannelParallelism_Call {
+	return &MockDataNodeClient_SetChannelParallelism_Call{Call: _e.mock.On("SetChannelParallelism",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataNodeClient_SetChannelParallelism_Call) Run(run func(ctx context.Context, in *datapb.SetChannelParallelismRequest, opts ...grpc.CallOption)) *MockDataNodeClient_SetChannelParallelism_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.SetChannelParallelismRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataNodeClient_SetChannelParallelism_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNodeClient_SetChannelParallelism_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNodeClient_SetChannelParallelism_Call) RunAndReturn(run func(context.Context, *datapb.SetChannelParallelismRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataNodeClient_SetChannelParallelism_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShowConfigurations provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataNodeClient) ShowConfigurations(ctx context.Context, in *internalpb.ShowConfigurationsRequest, opts ...grpc.CallOption) (*internalpb.ShowConfigurationsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -1192,6 +1332,76 @@ func (_c *MockDataNodeClient_ShowConfigurations_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// StopCompaction provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataNodeClient) StopCompaction(ctx context.Context, in *datapb.StopCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.StopCompactionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.StopCompactionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.StopCompactionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNodeClient_StopCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StopCompaction'
+type MockDataNodeClient_StopCompaction_Call struct {
+	*mock.Call
+}
+
+// StopCompaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.StopCompactionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataNodeClient_Expecter) StopCompaction(ctx interface{}, in interface{}, opts ...interface{}) *MockDataNodeClient_StopCompaction_Call {
+	return &MockDataNodeClient_StopCompaction_Call{Call: _e.mock.On("StopCompaction",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataNodeClient_StopCompaction_Call) Run(run func(ctx context.Context, in *datapb.StopCompactionRequest, opts ...grpc.CallOption)) *MockDataNodeClient_StopCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.StopCompactionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataNodeClient_StopCompaction_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNodeClient_StopCompaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNodeClient_StopCompaction_Call) RunAndReturn(run func(context.Context, *datapb.StopCompactionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataNodeClient_StopCompaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SyncSegments provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataNodeClient) SyncSegments(ctx context.Context, in *datapb.SyncSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
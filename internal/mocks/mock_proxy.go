@@ -10,6 +10,8 @@ import (
 
 	federpb "github.com/milvus-io/milvus-proto/go-api/v2/federpb"
 
+	indexpb "github.com/milvus-io/milvus/internal/proto/indexpb"
+
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -18,6 +20,8 @@ import (
 
 	proxypb "github.com/milvus-io/milvus/internal/proto/proxypb"
 
+	querypb "github.com/milvus-io/milvus/internal/proto/querypb"
+
 	types "github.com/milvus-io/milvus/internal/types"
 )
 
@@ -34,6 +38,61 @@ func (_m *MockProxy) EXPECT() *MockProxy_Expecter {
 	return &MockProxy_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AddCollectionField(_a0 context.Context, _a1 *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AddCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockProxy_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.AddCollectionFieldRequest
+func (_e *MockProxy_Expecter) AddCollectionField(_a0 interface{}, _a1 interface{}) *MockProxy_AddCollectionField_Call {
+	return &MockProxy_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", _a0, _a1)}
+}
+
+func (_c *MockProxy_AddCollectionField_Call) Run(run func(_a0 context.Context, _a1 *proxypb.AddCollectionFieldRequest)) *MockProxy_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.AddCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AddCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)) *MockProxy_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocTimestamp provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) AllocTimestamp(_a0 context.Context, _a1 *milvuspb.AllocTimestampRequest) (*milvuspb.AllocTimestampResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -199,6 +258,116 @@ func (_c *MockProxy_AlterCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AlterCollectionAsync provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AlterCollectionAsync(_a0 context.Context, _a1 *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.DDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterCollectionRequest) *proxypb.DDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.AlterCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AlterCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterCollectionAsync'
+type MockProxy_AlterCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// AlterCollectionAsync is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.AlterCollectionRequest
+func (_e *MockProxy_Expecter) AlterCollectionAsync(_a0 interface{}, _a1 interface{}) *MockProxy_AlterCollectionAsync_Call {
+	return &MockProxy_AlterCollectionAsync_Call{Call: _e.mock.On("AlterCollectionAsync", _a0, _a1)}
+}
+
+func (_c *MockProxy_AlterCollectionAsync_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.AlterCollectionRequest)) *MockProxy_AlterCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.AlterCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AlterCollectionAsync_Call) Return(_a0 *proxypb.DDLJobResponse, _a1 error) *MockProxy_AlterCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AlterCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error)) *MockProxy_AlterCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterDatabase provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AlterDatabase(_a0 context.Context, _a1 *proxypb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AlterDatabaseRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AlterDatabaseRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AlterDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type MockProxy_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.AlterDatabaseRequest
+func (_e *MockProxy_Expecter) AlterDatabase(_a0 interface{}, _a1 interface{}) *MockProxy_AlterDatabase_Call {
+	return &MockProxy_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", _a0, _a1)}
+}
+
+func (_c *MockProxy_AlterDatabase_Call) Run(run func(_a0 context.Context, _a1 *proxypb.AlterDatabaseRequest)) *MockProxy_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.AlterDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AlterDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AlterDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AlterDatabase_Call) RunAndReturn(run func(context.Context, *proxypb.AlterDatabaseRequest) (*commonpb.Status, error)) *MockProxy_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) AlterIndex(_a0 context.Context, _a1 *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -254,6 +423,61 @@ func (_c *MockProxy_AlterIndex_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// BatchSearch provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) BatchSearch(_a0 context.Context, _a1 *proxypb.BatchSearchRequest) (*proxypb.BatchSearchResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.BatchSearchResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.BatchSearchRequest) (*proxypb.BatchSearchResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.BatchSearchRequest) *proxypb.BatchSearchResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.BatchSearchResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.BatchSearchRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_BatchSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchSearch'
+type MockProxy_BatchSearch_Call struct {
+	*mock.Call
+}
+
+// BatchSearch is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.BatchSearchRequest
+func (_e *MockProxy_Expecter) BatchSearch(_a0 interface{}, _a1 interface{}) *MockProxy_BatchSearch_Call {
+	return &MockProxy_BatchSearch_Call{Call: _e.mock.On("BatchSearch", _a0, _a1)}
+}
+
+func (_c *MockProxy_BatchSearch_Call) Run(run func(_a0 context.Context, _a1 *proxypb.BatchSearchRequest)) *MockProxy_BatchSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.BatchSearchRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_BatchSearch_Call) Return(_a0 *proxypb.BatchSearchResponse, _a1 error) *MockProxy_BatchSearch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_BatchSearch_Call) RunAndReturn(run func(context.Context, *proxypb.BatchSearchRequest) (*proxypb.BatchSearchResponse, error)) *MockProxy_BatchSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CalcDistance provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CalcDistance(_a0 context.Context, _a1 *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	ret := _m.Called(_a0, _a1)
@@ -419,6 +643,61 @@ func (_c *MockProxy_Connect_Call) RunAndReturn(run func(context.Context, *milvus
 	return _c
 }
 
+// CreateAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreateAPIKey(_a0 context.Context, _a1 *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateAPIKeyRequest) *proxypb.APIKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.CreateAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type MockProxy_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.CreateAPIKeyRequest
+func (_e *MockProxy_Expecter) CreateAPIKey(_a0 interface{}, _a1 interface{}) *MockProxy_CreateAPIKey_Call {
+	return &MockProxy_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", _a0, _a1)}
+}
+
+func (_c *MockProxy_CreateAPIKey_Call) Run(run func(_a0 context.Context, _a1 *proxypb.CreateAPIKeyRequest)) *MockProxy_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.CreateAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CreateAPIKey_Call) Return(_a0 *proxypb.APIKeyResponse, _a1 error) *MockProxy_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CreateAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error)) *MockProxy_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAlias provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CreateAlias(_a0 context.Context, _a1 *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -529,6 +808,61 @@ func (_c *MockProxy_CreateCollection_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// CreateCollectionFromSnapshot provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) CreateCollectionFromSnapshot(_a0 context.Context, _a1 *proxypb.CreateCollectionFromSnapshotRequest) (*proxypb.RestoreCollectionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.RestoreCollectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateCollectionFromSnapshotRequest) (*proxypb.RestoreCollectionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateCollectionFromSnapshotRequest) *proxypb.RestoreCollectionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.RestoreCollectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.CreateCollectionFromSnapshotRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_CreateCollectionFromSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionFromSnapshot'
+type MockProxy_CreateCollectionFromSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionFromSnapshot is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.CreateCollectionFromSnapshotRequest
+func (_e *MockProxy_Expecter) CreateCollectionFromSnapshot(_a0 interface{}, _a1 interface{}) *MockProxy_CreateCollectionFromSnapshot_Call {
+	return &MockProxy_CreateCollectionFromSnapshot_Call{Call: _e.mock.On("CreateCollectionFromSnapshot", _a0, _a1)}
+}
+
+func (_c *MockProxy_CreateCollectionFromSnapshot_Call) Run(run func(_a0 context.Context, _a1 *proxypb.CreateCollectionFromSnapshotRequest)) *MockProxy_CreateCollectionFromSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.CreateCollectionFromSnapshotRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_CreateCollectionFromSnapshot_Call) Return(_a0 *proxypb.RestoreCollectionsResponse, _a1 error) *MockProxy_CreateCollectionFromSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_CreateCollectionFromSnapshot_Call) RunAndReturn(run func(context.Context, *proxypb.CreateCollectionFromSnapshotRequest) (*proxypb.RestoreCollectionsResponse, error)) *MockProxy_CreateCollectionFromSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCredential provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CreateCredential(_a0 context.Context, _a1 *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1055,26 +1389,81 @@ type MockProxy_DescribeCollection_Call struct {
 	*mock.Call
 }
 
-// DescribeCollection is a helper method to define mock.On call
+// DescribeCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DescribeCollectionRequest
+func (_e *MockProxy_Expecter) DescribeCollection(_a0 interface{}, _a1 interface{}) *MockProxy_DescribeCollection_Call {
+	return &MockProxy_DescribeCollection_Call{Call: _e.mock.On("DescribeCollection", _a0, _a1)}
+}
+
+func (_c *MockProxy_DescribeCollection_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DescribeCollectionRequest)) *MockProxy_DescribeCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DescribeCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DescribeCollection_Call) Return(_a0 *milvuspb.DescribeCollectionResponse, _a1 error) *MockProxy_DescribeCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DescribeCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)) *MockProxy_DescribeCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeDDLJob provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DescribeDDLJob(_a0 context.Context, _a1 *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.DescribeDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeDDLJobRequest) *proxypb.DescribeDDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DescribeDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DescribeDDLJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DescribeDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDDLJob'
+type MockProxy_DescribeDDLJob_Call struct {
+	*mock.Call
+}
+
+// DescribeDDLJob is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.DescribeCollectionRequest
-func (_e *MockProxy_Expecter) DescribeCollection(_a0 interface{}, _a1 interface{}) *MockProxy_DescribeCollection_Call {
-	return &MockProxy_DescribeCollection_Call{Call: _e.mock.On("DescribeCollection", _a0, _a1)}
+//   - _a1 *proxypb.DescribeDDLJobRequest
+func (_e *MockProxy_Expecter) DescribeDDLJob(_a0 interface{}, _a1 interface{}) *MockProxy_DescribeDDLJob_Call {
+	return &MockProxy_DescribeDDLJob_Call{Call: _e.mock.On("DescribeDDLJob", _a0, _a1)}
 }
 
-func (_c *MockProxy_DescribeCollection_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DescribeCollectionRequest)) *MockProxy_DescribeCollection_Call {
+func (_c *MockProxy_DescribeDDLJob_Call) Run(run func(_a0 context.Context, _a1 *proxypb.DescribeDDLJobRequest)) *MockProxy_DescribeDDLJob_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.DescribeCollectionRequest))
+		run(args[0].(context.Context), args[1].(*proxypb.DescribeDDLJobRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_DescribeCollection_Call) Return(_a0 *milvuspb.DescribeCollectionResponse, _a1 error) *MockProxy_DescribeCollection_Call {
+func (_c *MockProxy_DescribeDDLJob_Call) Return(_a0 *proxypb.DescribeDDLJobResponse, _a1 error) *MockProxy_DescribeDDLJob_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_DescribeCollection_Call) RunAndReturn(run func(context.Context, *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error)) *MockProxy_DescribeCollection_Call {
+func (_c *MockProxy_DescribeDDLJob_Call) RunAndReturn(run func(context.Context, *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error)) *MockProxy_DescribeDDLJob_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1134,6 +1523,61 @@ func (_c *MockProxy_DescribeIndex_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// DescribeIndexProgress provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DescribeIndexProgress(_a0 context.Context, _a1 *proxypb.DescribeIndexProgressRequest) (*indexpb.DescribeIndexResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *indexpb.DescribeIndexResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeIndexProgressRequest) (*indexpb.DescribeIndexResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeIndexProgressRequest) *indexpb.DescribeIndexResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.DescribeIndexResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DescribeIndexProgressRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DescribeIndexProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeIndexProgress'
+type MockProxy_DescribeIndexProgress_Call struct {
+	*mock.Call
+}
+
+// DescribeIndexProgress is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.DescribeIndexProgressRequest
+func (_e *MockProxy_Expecter) DescribeIndexProgress(_a0 interface{}, _a1 interface{}) *MockProxy_DescribeIndexProgress_Call {
+	return &MockProxy_DescribeIndexProgress_Call{Call: _e.mock.On("DescribeIndexProgress", _a0, _a1)}
+}
+
+func (_c *MockProxy_DescribeIndexProgress_Call) Run(run func(_a0 context.Context, _a1 *proxypb.DescribeIndexProgressRequest)) *MockProxy_DescribeIndexProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.DescribeIndexProgressRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DescribeIndexProgress_Call) Return(_a0 *indexpb.DescribeIndexResponse, _a1 error) *MockProxy_DescribeIndexProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DescribeIndexProgress_Call) RunAndReturn(run func(context.Context, *proxypb.DescribeIndexProgressRequest) (*indexpb.DescribeIndexResponse, error)) *MockProxy_DescribeIndexProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeResourceGroup provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DescribeResourceGroup(_a0 context.Context, _a1 *milvuspb.DescribeResourceGroupRequest) (*milvuspb.DescribeResourceGroupResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1354,6 +1798,61 @@ func (_c *MockProxy_DropCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DropCollectionAsync provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DropCollectionAsync(_a0 context.Context, _a1 *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.DDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest) *proxypb.DDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DropCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionAsync'
+type MockProxy_DropCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// DropCollectionAsync is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DropCollectionRequest
+func (_e *MockProxy_Expecter) DropCollectionAsync(_a0 interface{}, _a1 interface{}) *MockProxy_DropCollectionAsync_Call {
+	return &MockProxy_DropCollectionAsync_Call{Call: _e.mock.On("DropCollectionAsync", _a0, _a1)}
+}
+
+func (_c *MockProxy_DropCollectionAsync_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropCollectionRequest)) *MockProxy_DropCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DropCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DropCollectionAsync_Call) Return(_a0 *proxypb.DDLJobResponse, _a1 error) *MockProxy_DropCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DropCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error)) *MockProxy_DropCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DropDatabase(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2605,6 +3104,61 @@ func (_c *MockProxy_GetLoadingProgress_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// GetLoadingProgressDetail provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) GetLoadingProgressDetail(_a0 context.Context, _a1 *proxypb.GetLoadingProgressDetailRequest) (*querypb.GetLoadProgressDetailResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetLoadProgressDetailResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.GetLoadingProgressDetailRequest) (*querypb.GetLoadProgressDetailResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.GetLoadingProgressDetailRequest) *querypb.GetLoadProgressDetailResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetLoadProgressDetailResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.GetLoadingProgressDetailRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_GetLoadingProgressDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadingProgressDetail'
+type MockProxy_GetLoadingProgressDetail_Call struct {
+	*mock.Call
+}
+
+// GetLoadingProgressDetail is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.GetLoadingProgressDetailRequest
+func (_e *MockProxy_Expecter) GetLoadingProgressDetail(_a0 interface{}, _a1 interface{}) *MockProxy_GetLoadingProgressDetail_Call {
+	return &MockProxy_GetLoadingProgressDetail_Call{Call: _e.mock.On("GetLoadingProgressDetail", _a0, _a1)}
+}
+
+func (_c *MockProxy_GetLoadingProgressDetail_Call) Run(run func(_a0 context.Context, _a1 *proxypb.GetLoadingProgressDetailRequest)) *MockProxy_GetLoadingProgressDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.GetLoadingProgressDetailRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_GetLoadingProgressDetail_Call) Return(_a0 *querypb.GetLoadProgressDetailResponse, _a1 error) *MockProxy_GetLoadingProgressDetail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_GetLoadingProgressDetail_Call) RunAndReturn(run func(context.Context, *proxypb.GetLoadingProgressDetailRequest) (*querypb.GetLoadProgressDetailResponse, error)) *MockProxy_GetLoadingProgressDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMetrics provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) GetMetrics(_a0 context.Context, _a1 *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3579,6 +4133,61 @@ func (_c *MockProxy_InvalidateCredentialCache_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) ListAPIKeys(_a0 context.Context, _a1 *proxypb.ListAPIKeysRequest) (*proxypb.ListAPIKeysResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListAPIKeysRequest) (*proxypb.ListAPIKeysResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListAPIKeysRequest) *proxypb.ListAPIKeysResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ListAPIKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ListAPIKeysRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type MockProxy_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.ListAPIKeysRequest
+func (_e *MockProxy_Expecter) ListAPIKeys(_a0 interface{}, _a1 interface{}) *MockProxy_ListAPIKeys_Call {
+	return &MockProxy_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", _a0, _a1)}
+}
+
+func (_c *MockProxy_ListAPIKeys_Call) Run(run func(_a0 context.Context, _a1 *proxypb.ListAPIKeysRequest)) *MockProxy_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.ListAPIKeysRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_ListAPIKeys_Call) Return(_a0 *proxypb.ListAPIKeysResponse, _a1 error) *MockProxy_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *proxypb.ListAPIKeysRequest) (*proxypb.ListAPIKeysResponse, error)) *MockProxy_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAliases provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListAliases(_a0 context.Context, _a1 *milvuspb.ListAliasesRequest) (*milvuspb.ListAliasesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4325,48 +4934,158 @@ type MockProxy_OperateUserRole_Call struct {
 	*mock.Call
 }
 
-// OperateUserRole is a helper method to define mock.On call
+// OperateUserRole is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.OperateUserRoleRequest
+func (_e *MockProxy_Expecter) OperateUserRole(_a0 interface{}, _a1 interface{}) *MockProxy_OperateUserRole_Call {
+	return &MockProxy_OperateUserRole_Call{Call: _e.mock.On("OperateUserRole", _a0, _a1)}
+}
+
+func (_c *MockProxy_OperateUserRole_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.OperateUserRoleRequest)) *MockProxy_OperateUserRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.OperateUserRoleRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_OperateUserRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_OperateUserRole_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_OperateUserRole_Call) RunAndReturn(run func(context.Context, *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)) *MockProxy_OperateUserRole_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteReplica provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) PromoteReplica(_a0 context.Context, _a1 *proxypb.PromoteReplicaRequest) (*proxypb.RestoreCollectionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.RestoreCollectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.PromoteReplicaRequest) (*proxypb.RestoreCollectionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.PromoteReplicaRequest) *proxypb.RestoreCollectionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.RestoreCollectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.PromoteReplicaRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_PromoteReplica_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteReplica'
+type MockProxy_PromoteReplica_Call struct {
+	*mock.Call
+}
+
+// PromoteReplica is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.PromoteReplicaRequest
+func (_e *MockProxy_Expecter) PromoteReplica(_a0 interface{}, _a1 interface{}) *MockProxy_PromoteReplica_Call {
+	return &MockProxy_PromoteReplica_Call{Call: _e.mock.On("PromoteReplica", _a0, _a1)}
+}
+
+func (_c *MockProxy_PromoteReplica_Call) Run(run func(_a0 context.Context, _a1 *proxypb.PromoteReplicaRequest)) *MockProxy_PromoteReplica_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.PromoteReplicaRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_PromoteReplica_Call) Return(_a0 *proxypb.RestoreCollectionsResponse, _a1 error) *MockProxy_PromoteReplica_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_PromoteReplica_Call) RunAndReturn(run func(context.Context, *proxypb.PromoteReplicaRequest) (*proxypb.RestoreCollectionsResponse, error)) *MockProxy_PromoteReplica_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Query provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) Query(_a0 context.Context, _a1 *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *milvuspb.QueryResults
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.QueryRequest) *milvuspb.QueryResults); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.QueryResults)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.QueryRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
+type MockProxy_Query_Call struct {
+	*mock.Call
+}
+
+// Query is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.OperateUserRoleRequest
-func (_e *MockProxy_Expecter) OperateUserRole(_a0 interface{}, _a1 interface{}) *MockProxy_OperateUserRole_Call {
-	return &MockProxy_OperateUserRole_Call{Call: _e.mock.On("OperateUserRole", _a0, _a1)}
+//   - _a1 *milvuspb.QueryRequest
+func (_e *MockProxy_Expecter) Query(_a0 interface{}, _a1 interface{}) *MockProxy_Query_Call {
+	return &MockProxy_Query_Call{Call: _e.mock.On("Query", _a0, _a1)}
 }
 
-func (_c *MockProxy_OperateUserRole_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.OperateUserRoleRequest)) *MockProxy_OperateUserRole_Call {
+func (_c *MockProxy_Query_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.QueryRequest)) *MockProxy_Query_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.OperateUserRoleRequest))
+		run(args[0].(context.Context), args[1].(*milvuspb.QueryRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_OperateUserRole_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_OperateUserRole_Call {
+func (_c *MockProxy_Query_Call) Return(_a0 *milvuspb.QueryResults, _a1 error) *MockProxy_Query_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_OperateUserRole_Call) RunAndReturn(run func(context.Context, *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)) *MockProxy_OperateUserRole_Call {
+func (_c *MockProxy_Query_Call) RunAndReturn(run func(context.Context, *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)) *MockProxy_Query_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// Query provides a mock function with given fields: _a0, _a1
-func (_m *MockProxy) Query(_a0 context.Context, _a1 *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+// QueryIterator provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) QueryIterator(_a0 context.Context, _a1 *proxypb.QueryIteratorRequest) (*proxypb.QueryIteratorResponse, error) {
 	ret := _m.Called(_a0, _a1)
 
-	var r0 *milvuspb.QueryResults
+	var r0 *proxypb.QueryIteratorResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.QueryIteratorRequest) (*proxypb.QueryIteratorResponse, error)); ok {
 		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.QueryRequest) *milvuspb.QueryResults); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.QueryIteratorRequest) *proxypb.QueryIteratorResponse); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.QueryResults)
+			r0 = ret.Get(0).(*proxypb.QueryIteratorResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.QueryRequest) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.QueryIteratorRequest) error); ok {
 		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
@@ -4375,31 +5094,31 @@ func (_m *MockProxy) Query(_a0 context.Context, _a1 *milvuspb.QueryRequest) (*mi
 	return r0, r1
 }
 
-// MockProxy_Query_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Query'
-type MockProxy_Query_Call struct {
+// MockProxy_QueryIterator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryIterator'
+type MockProxy_QueryIterator_Call struct {
 	*mock.Call
 }
 
-// Query is a helper method to define mock.On call
+// QueryIterator is a helper method to define mock.On call
 //   - _a0 context.Context
-//   - _a1 *milvuspb.QueryRequest
-func (_e *MockProxy_Expecter) Query(_a0 interface{}, _a1 interface{}) *MockProxy_Query_Call {
-	return &MockProxy_Query_Call{Call: _e.mock.On("Query", _a0, _a1)}
+//   - _a1 *proxypb.QueryIteratorRequest
+func (_e *MockProxy_Expecter) QueryIterator(_a0 interface{}, _a1 interface{}) *MockProxy_QueryIterator_Call {
+	return &MockProxy_QueryIterator_Call{Call: _e.mock.On("QueryIterator", _a0, _a1)}
 }
 
-func (_c *MockProxy_Query_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.QueryRequest)) *MockProxy_Query_Call {
+func (_c *MockProxy_QueryIterator_Call) Run(run func(_a0 context.Context, _a1 *proxypb.QueryIteratorRequest)) *MockProxy_QueryIterator_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*milvuspb.QueryRequest))
+		run(args[0].(context.Context), args[1].(*proxypb.QueryIteratorRequest))
 	})
 	return _c
 }
 
-func (_c *MockProxy_Query_Call) Return(_a0 *milvuspb.QueryResults, _a1 error) *MockProxy_Query_Call {
+func (_c *MockProxy_QueryIterator_Call) Return(_a0 *proxypb.QueryIteratorResponse, _a1 error) *MockProxy_QueryIterator_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxy_Query_Call) RunAndReturn(run func(context.Context, *milvuspb.QueryRequest) (*milvuspb.QueryResults, error)) *MockProxy_Query_Call {
+func (_c *MockProxy_QueryIterator_Call) RunAndReturn(run func(context.Context, *proxypb.QueryIteratorRequest) (*proxypb.QueryIteratorResponse, error)) *MockProxy_QueryIterator_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -4775,6 +5494,171 @@ func (_c *MockProxy_ReplicateMessage_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// RestoreBackup provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RestoreBackup(_a0 context.Context, _a1 *proxypb.RestoreBackupRequest) (*proxypb.RestoreCollectionsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.RestoreCollectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RestoreBackupRequest) (*proxypb.RestoreCollectionsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RestoreBackupRequest) *proxypb.RestoreCollectionsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.RestoreCollectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RestoreBackupRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RestoreBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreBackup'
+type MockProxy_RestoreBackup_Call struct {
+	*mock.Call
+}
+
+// RestoreBackup is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.RestoreBackupRequest
+func (_e *MockProxy_Expecter) RestoreBackup(_a0 interface{}, _a1 interface{}) *MockProxy_RestoreBackup_Call {
+	return &MockProxy_RestoreBackup_Call{Call: _e.mock.On("RestoreBackup", _a0, _a1)}
+}
+
+func (_c *MockProxy_RestoreBackup_Call) Run(run func(_a0 context.Context, _a1 *proxypb.RestoreBackupRequest)) *MockProxy_RestoreBackup_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.RestoreBackupRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RestoreBackup_Call) Return(_a0 *proxypb.RestoreCollectionsResponse, _a1 error) *MockProxy_RestoreBackup_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RestoreBackup_Call) RunAndReturn(run func(context.Context, *proxypb.RestoreBackupRequest) (*proxypb.RestoreCollectionsResponse, error)) *MockProxy_RestoreBackup_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RevokeAPIKey(_a0 context.Context, _a1 *proxypb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RevokeAPIKeyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RevokeAPIKeyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RevokeAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type MockProxy_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.RevokeAPIKeyRequest
+func (_e *MockProxy_Expecter) RevokeAPIKey(_a0 interface{}, _a1 interface{}) *MockProxy_RevokeAPIKey_Call {
+	return &MockProxy_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", _a0, _a1)}
+}
+
+func (_c *MockProxy_RevokeAPIKey_Call) Run(run func(_a0 context.Context, _a1 *proxypb.RevokeAPIKeyRequest)) *MockProxy_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.RevokeAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RevokeAPIKey_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_RevokeAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.RevokeAPIKeyRequest) (*commonpb.Status, error)) *MockProxy_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) RotateAPIKey(_a0 context.Context, _a1 *proxypb.RotateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RotateAPIKeyRequest) (*proxypb.APIKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RotateAPIKeyRequest) *proxypb.APIKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RotateAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_RotateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateAPIKey'
+type MockProxy_RotateAPIKey_Call struct {
+	*mock.Call
+}
+
+// RotateAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.RotateAPIKeyRequest
+func (_e *MockProxy_Expecter) RotateAPIKey(_a0 interface{}, _a1 interface{}) *MockProxy_RotateAPIKey_Call {
+	return &MockProxy_RotateAPIKey_Call{Call: _e.mock.On("RotateAPIKey", _a0, _a1)}
+}
+
+func (_c *MockProxy_RotateAPIKey_Call) Run(run func(_a0 context.Context, _a1 *proxypb.RotateAPIKeyRequest)) *MockProxy_RotateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.RotateAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_RotateAPIKey_Call) Return(_a0 *proxypb.APIKeyResponse, _a1 error) *MockProxy_RotateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_RotateAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.RotateAPIKeyRequest) (*proxypb.APIKeyResponse, error)) *MockProxy_RotateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Search provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) Search(_a0 context.Context, _a1 *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4830,6 +5714,61 @@ func (_c *MockProxy_Search_Call) RunAndReturn(run func(context.Context, *milvusp
 	return _c
 }
 
+// SearchIterator provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) SearchIterator(_a0 context.Context, _a1 *proxypb.SearchIteratorRequest) (*proxypb.SearchIteratorResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.SearchIteratorResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SearchIteratorRequest) (*proxypb.SearchIteratorResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SearchIteratorRequest) *proxypb.SearchIteratorResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.SearchIteratorResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.SearchIteratorRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_SearchIterator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchIterator'
+type MockProxy_SearchIterator_Call struct {
+	*mock.Call
+}
+
+// SearchIterator is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.SearchIteratorRequest
+func (_e *MockProxy_Expecter) SearchIterator(_a0 interface{}, _a1 interface{}) *MockProxy_SearchIterator_Call {
+	return &MockProxy_SearchIterator_Call{Call: _e.mock.On("SearchIterator", _a0, _a1)}
+}
+
+func (_c *MockProxy_SearchIterator_Call) Run(run func(_a0 context.Context, _a1 *proxypb.SearchIteratorRequest)) *MockProxy_SearchIterator_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.SearchIteratorRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_SearchIterator_Call) Return(_a0 *proxypb.SearchIteratorResponse, _a1 error) *MockProxy_SearchIterator_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_SearchIterator_Call) RunAndReturn(run func(context.Context, *proxypb.SearchIteratorRequest) (*proxypb.SearchIteratorResponse, error)) *MockProxy_SearchIterator_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) SelectGrant(_a0 context.Context, _a1 *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5440,6 +6379,49 @@ func (_c *MockProxy_Stop_Call) RunAndReturn(run func() error) *MockProxy_Stop_Ca
 	return _c
 }
 
+// SubscribeStandingQuery provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) SubscribeStandingQuery(_a0 *proxypb.SubscribeStandingQueryRequest, _a1 proxypb.Proxy_SubscribeStandingQueryServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*proxypb.SubscribeStandingQueryRequest, proxypb.Proxy_SubscribeStandingQueryServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxy_SubscribeStandingQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeStandingQuery'
+type MockProxy_SubscribeStandingQuery_Call struct {
+	*mock.Call
+}
+
+// SubscribeStandingQuery is a helper method to define mock.On call
+//   - _a0 *proxypb.SubscribeStandingQueryRequest
+//   - _a1 proxypb.Proxy_SubscribeStandingQueryServer
+func (_e *MockProxy_Expecter) SubscribeStandingQuery(_a0 interface{}, _a1 interface{}) *MockProxy_SubscribeStandingQuery_Call {
+	return &MockProxy_SubscribeStandingQuery_Call{Call: _e.mock.On("SubscribeStandingQuery", _a0, _a1)}
+}
+
+func (_c *MockProxy_SubscribeStandingQuery_Call) Run(run func(_a0 *proxypb.SubscribeStandingQueryRequest, _a1 proxypb.Proxy_SubscribeStandingQueryServer)) *MockProxy_SubscribeStandingQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*proxypb.SubscribeStandingQueryRequest), args[1].(proxypb.Proxy_SubscribeStandingQueryServer))
+	})
+	return _c
+}
+
+func (_c *MockProxy_SubscribeStandingQuery_Call) Return(_a0 error) *MockProxy_SubscribeStandingQuery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxy_SubscribeStandingQuery_Call) RunAndReturn(run func(*proxypb.SubscribeStandingQueryRequest, proxypb.Proxy_SubscribeStandingQueryServer) error) *MockProxy_SubscribeStandingQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TransferNode provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) TransferNode(_a0 context.Context, _a1 *milvuspb.TransferNodeRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -5550,6 +6532,61 @@ func (_c *MockProxy_TransferReplica_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// UndropCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) UndropCollection(_a0 context.Context, _a1 *proxypb.UndropCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.UndropCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.UndropCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.UndropCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_UndropCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndropCollection'
+type MockProxy_UndropCollection_Call struct {
+	*mock.Call
+}
+
+// UndropCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.UndropCollectionRequest
+func (_e *MockProxy_Expecter) UndropCollection(_a0 interface{}, _a1 interface{}) *MockProxy_UndropCollection_Call {
+	return &MockProxy_UndropCollection_Call{Call: _e.mock.On("UndropCollection", _a0, _a1)}
+}
+
+func (_c *MockProxy_UndropCollection_Call) Run(run func(_a0 context.Context, _a1 *proxypb.UndropCollectionRequest)) *MockProxy_UndropCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.UndropCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_UndropCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_UndropCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_UndropCollection_Call) RunAndReturn(run func(context.Context, *proxypb.UndropCollectionRequest) (*commonpb.Status, error)) *MockProxy_UndropCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateCredential provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) UpdateCredential(_a0 context.Context, _a1 *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
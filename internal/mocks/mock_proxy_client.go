@@ -9,6 +9,8 @@ import (
 
 	grpc "google.golang.org/grpc"
 
+	indexpb "github.com/milvus-io/milvus/internal/proto/indexpb"
+
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
@@ -16,6 +18,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	proxypb "github.com/milvus-io/milvus/internal/proto/proxypb"
+
+	querypb "github.com/milvus-io/milvus/internal/proto/querypb"
 )
 
 // MockProxyClient is an autogenerated mock type for the ProxyClient type
@@ -31,6 +35,286 @@ func (_m *MockProxyClient) EXPECT() *MockProxyClient_Expecter {
 	return &MockProxyClient_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) AddCollectionField(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockProxyClient_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.AddCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) AddCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_AddCollectionField_Call {
+	return &MockProxyClient_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_AddCollectionField_Call) Run(run func(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption)) *MockProxyClient_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.AddCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_AddCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterCollectionAsync provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) AlterCollectionAsync(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.DDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterCollectionRequest, ...grpc.CallOption) (*proxypb.DDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterCollectionRequest, ...grpc.CallOption) *proxypb.DDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.AlterCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_AlterCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterCollectionAsync'
+type MockProxyClient_AlterCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// AlterCollectionAsync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.AlterCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) AlterCollectionAsync(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_AlterCollectionAsync_Call {
+	return &MockProxyClient_AlterCollectionAsync_Call{Call: _e.mock.On("AlterCollectionAsync",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_AlterCollectionAsync_Call) Run(run func(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption)) *MockProxyClient_AlterCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.AlterCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_AlterCollectionAsync_Call) Return(_a0 *proxypb.DDLJobResponse, _a1 error) *MockProxyClient_AlterCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_AlterCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.AlterCollectionRequest, ...grpc.CallOption) (*proxypb.DDLJobResponse, error)) *MockProxyClient_AlterCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterDatabase provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) AlterDatabase(ctx context.Context, in *proxypb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AlterDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AlterDatabaseRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AlterDatabaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type MockProxyClient_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.AlterDatabaseRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) AlterDatabase(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_AlterDatabase_Call {
+	return &MockProxyClient_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_AlterDatabase_Call) Run(run func(ctx context.Context, in *proxypb.AlterDatabaseRequest, opts ...grpc.CallOption)) *MockProxyClient_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.AlterDatabaseRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_AlterDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_AlterDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_AlterDatabase_Call) RunAndReturn(run func(context.Context, *proxypb.AlterDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BatchSearch provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) BatchSearch(ctx context.Context, in *proxypb.BatchSearchRequest, opts ...grpc.CallOption) (*proxypb.BatchSearchResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.BatchSearchResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.BatchSearchRequest, ...grpc.CallOption) (*proxypb.BatchSearchResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.BatchSearchRequest, ...grpc.CallOption) *proxypb.BatchSearchResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.BatchSearchResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.BatchSearchRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_BatchSearch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchSearch'
+type MockProxyClient_BatchSearch_Call struct {
+	*mock.Call
+}
+
+// BatchSearch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.BatchSearchRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) BatchSearch(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_BatchSearch_Call {
+	return &MockProxyClient_BatchSearch_Call{Call: _e.mock.On("BatchSearch",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_BatchSearch_Call) Run(run func(ctx context.Context, in *proxypb.BatchSearchRequest, opts ...grpc.CallOption)) *MockProxyClient_BatchSearch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.BatchSearchRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_BatchSearch_Call) Return(_a0 *proxypb.BatchSearchResponse, _a1 error) *MockProxyClient_BatchSearch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_BatchSearch_Call) RunAndReturn(run func(context.Context, *proxypb.BatchSearchRequest, ...grpc.CallOption) (*proxypb.BatchSearchResponse, error)) *MockProxyClient_BatchSearch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *MockProxyClient) Close() error {
 	ret := _m.Called()
@@ -72,8 +356,8 @@ func (_c *MockProxyClient_Close_Call) RunAndReturn(run func() error) *MockProxyC
 	return _c
 }
 
-// GetComponentStates provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+// CreateAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) CreateAPIKey(ctx context.Context, in *proxypb.CreateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -83,20 +367,1070 @@ func (_m *MockProxyClient) GetComponentStates(ctx context.Context, in *milvuspb.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.ComponentStates
+	var r0 *proxypb.APIKeyResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateAPIKeyRequest, ...grpc.CallOption) (*proxypb.APIKeyResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) *milvuspb.ComponentStates); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateAPIKeyRequest, ...grpc.CallOption) *proxypb.APIKeyResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+			r0 = ret.Get(0).(*proxypb.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.CreateAPIKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type MockProxyClient_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.CreateAPIKeyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) CreateAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_CreateAPIKey_Call {
+	return &MockProxyClient_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_CreateAPIKey_Call) Run(run func(ctx context.Context, in *proxypb.CreateAPIKeyRequest, opts ...grpc.CallOption)) *MockProxyClient_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.CreateAPIKeyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_CreateAPIKey_Call) Return(_a0 *proxypb.APIKeyResponse, _a1 error) *MockProxyClient_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_CreateAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.CreateAPIKeyRequest, ...grpc.CallOption) (*proxypb.APIKeyResponse, error)) *MockProxyClient_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCollectionFromSnapshot provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) CreateCollectionFromSnapshot(ctx context.Context, in *proxypb.CreateCollectionFromSnapshotRequest, opts ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.RestoreCollectionsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateCollectionFromSnapshotRequest, ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateCollectionFromSnapshotRequest, ...grpc.CallOption) *proxypb.RestoreCollectionsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.RestoreCollectionsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.CreateCollectionFromSnapshotRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_CreateCollectionFromSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCollectionFromSnapshot'
+type MockProxyClient_CreateCollectionFromSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateCollectionFromSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.CreateCollectionFromSnapshotRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) CreateCollectionFromSnapshot(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_CreateCollectionFromSnapshot_Call {
+	return &MockProxyClient_CreateCollectionFromSnapshot_Call{Call: _e.mock.On("CreateCollectionFromSnapshot",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_CreateCollectionFromSnapshot_Call) Run(run func(ctx context.Context, in *proxypb.CreateCollectionFromSnapshotRequest, opts ...grpc.CallOption)) *MockProxyClient_CreateCollectionFromSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.CreateCollectionFromSnapshotRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_CreateCollectionFromSnapshot_Call) Return(_a0 *proxypb.RestoreCollectionsResponse, _a1 error) *MockProxyClient_CreateCollectionFromSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_CreateCollectionFromSnapshot_Call) RunAndReturn(run func(context.Context, *proxypb.CreateCollectionFromSnapshotRequest, ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error)) *MockProxyClient_CreateCollectionFromSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeDDLJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) DescribeDDLJob(ctx context.Context, in *proxypb.DescribeDDLJobRequest, opts ...grpc.CallOption) (*proxypb.DescribeDDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.DescribeDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeDDLJobRequest, ...grpc.CallOption) (*proxypb.DescribeDDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeDDLJobRequest, ...grpc.CallOption) *proxypb.DescribeDDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DescribeDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DescribeDDLJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_DescribeDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDDLJob'
+type MockProxyClient_DescribeDDLJob_Call struct {
+	*mock.Call
+}
+
+// DescribeDDLJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.DescribeDDLJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) DescribeDDLJob(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_DescribeDDLJob_Call {
+	return &MockProxyClient_DescribeDDLJob_Call{Call: _e.mock.On("DescribeDDLJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_DescribeDDLJob_Call) Run(run func(ctx context.Context, in *proxypb.DescribeDDLJobRequest, opts ...grpc.CallOption)) *MockProxyClient_DescribeDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.DescribeDDLJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_DescribeDDLJob_Call) Return(_a0 *proxypb.DescribeDDLJobResponse, _a1 error) *MockProxyClient_DescribeDDLJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_DescribeDDLJob_Call) RunAndReturn(run func(context.Context, *proxypb.DescribeDDLJobRequest, ...grpc.CallOption) (*proxypb.DescribeDDLJobResponse, error)) *MockProxyClient_DescribeDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeIndexProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) DescribeIndexProgress(ctx context.Context, in *proxypb.DescribeIndexProgressRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *indexpb.DescribeIndexResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeIndexProgressRequest, ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeIndexProgressRequest, ...grpc.CallOption) *indexpb.DescribeIndexResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*indexpb.DescribeIndexResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DescribeIndexProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_DescribeIndexProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeIndexProgress'
+type MockProxyClient_DescribeIndexProgress_Call struct {
+	*mock.Call
+}
+
+// DescribeIndexProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.DescribeIndexProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) DescribeIndexProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_DescribeIndexProgress_Call {
+	return &MockProxyClient_DescribeIndexProgress_Call{Call: _e.mock.On("DescribeIndexProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_DescribeIndexProgress_Call) Run(run func(ctx context.Context, in *proxypb.DescribeIndexProgressRequest, opts ...grpc.CallOption)) *MockProxyClient_DescribeIndexProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.DescribeIndexProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_DescribeIndexProgress_Call) Return(_a0 *indexpb.DescribeIndexResponse, _a1 error) *MockProxyClient_DescribeIndexProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_DescribeIndexProgress_Call) RunAndReturn(run func(context.Context, *proxypb.DescribeIndexProgressRequest, ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error)) *MockProxyClient_DescribeIndexProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DropCollectionAsync provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) DropCollectionAsync(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.DDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*proxypb.DDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) *proxypb.DDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_DropCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionAsync'
+type MockProxyClient_DropCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// DropCollectionAsync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.DropCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) DropCollectionAsync(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_DropCollectionAsync_Call {
+	return &MockProxyClient_DropCollectionAsync_Call{Call: _e.mock.On("DropCollectionAsync",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_DropCollectionAsync_Call) Run(run func(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption)) *MockProxyClient_DropCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.DropCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_DropCollectionAsync_Call) Return(_a0 *proxypb.DDLJobResponse, _a1 error) *MockProxyClient_DropCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_DropCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*proxypb.DDLJobResponse, error)) *MockProxyClient_DropCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetComponentStates provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.ComponentStates
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) *milvuspb.ComponentStates); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.ComponentStates)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
+type MockProxyClient_GetComponentStates_Call struct {
+	*mock.Call
+}
+
+// GetComponentStates is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.GetComponentStatesRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) GetComponentStates(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetComponentStates_Call {
+	return &MockProxyClient_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_GetComponentStates_Call) Run(run func(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption)) *MockProxyClient_GetComponentStates_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockProxyClient_GetComponentStates_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)) *MockProxyClient_GetComponentStates_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDdChannel provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) GetDdChannel(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDdChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDdChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetDdChannelRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_GetDdChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDdChannel'
+type MockProxyClient_GetDdChannel_Call struct {
+	*mock.Call
+}
+
+// GetDdChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetDdChannelRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) GetDdChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetDdChannel_Call {
+	return &MockProxyClient_GetDdChannel_Call{Call: _e.mock.On("GetDdChannel",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_GetDdChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption)) *MockProxyClient_GetDdChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetDdChannelRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_GetDdChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockProxyClient_GetDdChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_GetDdChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetDdChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockProxyClient_GetDdChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetImportProgress provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.GetImportProgressResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) *internalpb.GetImportProgressResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.GetImportProgressResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_GetImportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportProgress'
+type MockProxyClient_GetImportProgress_Call struct {
+	*mock.Call
+}
+
+// GetImportProgress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetImportProgressRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) GetImportProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetImportProgress_Call {
+	return &MockProxyClient_GetImportProgress_Call{Call: _e.mock.On("GetImportProgress",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_GetImportProgress_Call) Run(run func(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption)) *MockProxyClient_GetImportProgress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetImportProgressRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_GetImportProgress_Call) Return(_a0 *internalpb.GetImportProgressResponse, _a1 error) *MockProxyClient_GetImportProgress_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_GetImportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)) *MockProxyClient_GetImportProgress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLoadingProgressDetail provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) GetLoadingProgressDetail(ctx context.Context, in *proxypb.GetLoadingProgressDetailRequest, opts ...grpc.CallOption) (*querypb.GetLoadProgressDetailResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetLoadProgressDetailResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.GetLoadingProgressDetailRequest, ...grpc.CallOption) (*querypb.GetLoadProgressDetailResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.GetLoadingProgressDetailRequest, ...grpc.CallOption) *querypb.GetLoadProgressDetailResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetLoadProgressDetailResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.GetLoadingProgressDetailRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_GetLoadingProgressDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadingProgressDetail'
+type MockProxyClient_GetLoadingProgressDetail_Call struct {
+	*mock.Call
+}
+
+// GetLoadingProgressDetail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.GetLoadingProgressDetailRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) GetLoadingProgressDetail(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetLoadingProgressDetail_Call {
+	return &MockProxyClient_GetLoadingProgressDetail_Call{Call: _e.mock.On("GetLoadingProgressDetail",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_GetLoadingProgressDetail_Call) Run(run func(ctx context.Context, in *proxypb.GetLoadingProgressDetailRequest, opts ...grpc.CallOption)) *MockProxyClient_GetLoadingProgressDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.GetLoadingProgressDetailRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_GetLoadingProgressDetail_Call) Return(_a0 *querypb.GetLoadProgressDetailResponse, _a1 error) *MockProxyClient_GetLoadingProgressDetail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_GetLoadingProgressDetail_Call) RunAndReturn(run func(context.Context, *proxypb.GetLoadingProgressDetailRequest, ...grpc.CallOption) (*querypb.GetLoadProgressDetailResponse, error)) *MockProxyClient_GetLoadingProgressDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProxyMetrics provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) GetProxyMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.GetMetricsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) *milvuspb.GetMetricsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_GetProxyMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProxyMetrics'
+type MockProxyClient_GetProxyMetrics_Call struct {
+	*mock.Call
+}
+
+// GetProxyMetrics is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.GetMetricsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) GetProxyMetrics(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetProxyMetrics_Call {
+	return &MockProxyClient_GetProxyMetrics_Call{Call: _e.mock.On("GetProxyMetrics",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_GetProxyMetrics_Call) Run(run func(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption)) *MockProxyClient_GetProxyMetrics_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_GetProxyMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockProxyClient_GetProxyMetrics_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_GetProxyMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)) *MockProxyClient_GetProxyMetrics_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatisticsChannel provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *milvuspb.StringResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*milvuspb.StringResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
+type MockProxyClient_GetStatisticsChannel_Call struct {
+	*mock.Call
+}
+
+// GetStatisticsChannel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.GetStatisticsChannelRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) GetStatisticsChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetStatisticsChannel_Call {
+	return &MockProxyClient_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_GetStatisticsChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption)) *MockProxyClient_GetStatisticsChannel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockProxyClient_GetStatisticsChannel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockProxyClient_GetStatisticsChannel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ImportV2 provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ImportV2(ctx context.Context, in *internalpb.ImportRequest, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.ImportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequest, ...grpc.CallOption) (*internalpb.ImportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequest, ...grpc.CallOption) *internalpb.ImportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.ImportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ImportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_ImportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportV2'
+type MockProxyClient_ImportV2_Call struct {
+	*mock.Call
+}
+
+// ImportV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.ImportRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) ImportV2(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ImportV2_Call {
+	return &MockProxyClient_ImportV2_Call{Call: _e.mock.On("ImportV2",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_ImportV2_Call) Run(run func(ctx context.Context, in *internalpb.ImportRequest, opts ...grpc.CallOption)) *MockProxyClient_ImportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.ImportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_ImportV2_Call) Return(_a0 *internalpb.ImportResponse, _a1 error) *MockProxyClient_ImportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_ImportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ImportRequest, ...grpc.CallOption) (*internalpb.ImportResponse, error)) *MockProxyClient_ImportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateCollectionMetaCache provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) InvalidateCollectionMetaCache(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_InvalidateCollectionMetaCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateCollectionMetaCache'
+type MockProxyClient_InvalidateCollectionMetaCache_Call struct {
+	*mock.Call
+}
+
+// InvalidateCollectionMetaCache is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.InvalidateCollMetaCacheRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) InvalidateCollectionMetaCache(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_InvalidateCollectionMetaCache_Call {
+	return &MockProxyClient_InvalidateCollectionMetaCache_Call{Call: _e.mock.On("InvalidateCollectionMetaCache",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_InvalidateCollectionMetaCache_Call) Run(run func(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption)) *MockProxyClient_InvalidateCollectionMetaCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.InvalidateCollMetaCacheRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_InvalidateCollectionMetaCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_InvalidateCollectionMetaCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_InvalidateCollectionMetaCache_Call) RunAndReturn(run func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_InvalidateCollectionMetaCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateCredentialCache provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) InvalidateCredentialCache(ctx context.Context, in *proxypb.InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCredCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCredCacheRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateCredCacheRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_InvalidateCredentialCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateCredentialCache'
+type MockProxyClient_InvalidateCredentialCache_Call struct {
+	*mock.Call
+}
+
+// InvalidateCredentialCache is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.InvalidateCredCacheRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) InvalidateCredentialCache(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_InvalidateCredentialCache_Call {
+	return &MockProxyClient_InvalidateCredentialCache_Call{Call: _e.mock.On("InvalidateCredentialCache",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_InvalidateCredentialCache_Call) Run(run func(ctx context.Context, in *proxypb.InvalidateCredCacheRequest, opts ...grpc.CallOption)) *MockProxyClient_InvalidateCredentialCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.InvalidateCredCacheRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_InvalidateCredentialCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_InvalidateCredentialCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_InvalidateCredentialCache_Call) RunAndReturn(run func(context.Context, *proxypb.InvalidateCredCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_InvalidateCredentialCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAPIKeys provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ListAPIKeys(ctx context.Context, in *proxypb.ListAPIKeysRequest, opts ...grpc.CallOption) (*proxypb.ListAPIKeysResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListAPIKeysRequest, ...grpc.CallOption) (*proxypb.ListAPIKeysResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListAPIKeysRequest, ...grpc.CallOption) *proxypb.ListAPIKeysResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ListAPIKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ListAPIKeysRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type MockProxyClient_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.ListAPIKeysRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) ListAPIKeys(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ListAPIKeys_Call {
+	return &MockProxyClient_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_ListAPIKeys_Call) Run(run func(ctx context.Context, in *proxypb.ListAPIKeysRequest, opts ...grpc.CallOption)) *MockProxyClient_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.ListAPIKeysRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_ListAPIKeys_Call) Return(_a0 *proxypb.ListAPIKeysResponse, _a1 error) *MockProxyClient_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *proxypb.ListAPIKeysRequest, ...grpc.CallOption) (*proxypb.ListAPIKeysResponse, error)) *MockProxyClient_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListClientInfos provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ListClientInfos(ctx context.Context, in *proxypb.ListClientInfosRequest, opts ...grpc.CallOption) (*proxypb.ListClientInfosResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.ListClientInfosResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListClientInfosRequest, ...grpc.CallOption) (*proxypb.ListClientInfosResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListClientInfosRequest, ...grpc.CallOption) *proxypb.ListClientInfosResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ListClientInfosResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ListClientInfosRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -105,21 +1439,21 @@ func (_m *MockProxyClient) GetComponentStates(ctx context.Context, in *milvuspb.
 	return r0, r1
 }
 
-// MockProxyClient_GetComponentStates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetComponentStates'
-type MockProxyClient_GetComponentStates_Call struct {
+// MockProxyClient_ListClientInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListClientInfos'
+type MockProxyClient_ListClientInfos_Call struct {
 	*mock.Call
 }
 
-// GetComponentStates is a helper method to define mock.On call
+// ListClientInfos is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetComponentStatesRequest
+//   - in *proxypb.ListClientInfosRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) GetComponentStates(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetComponentStates_Call {
-	return &MockProxyClient_GetComponentStates_Call{Call: _e.mock.On("GetComponentStates",
+func (_e *MockProxyClient_Expecter) ListClientInfos(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ListClientInfos_Call {
+	return &MockProxyClient_ListClientInfos_Call{Call: _e.mock.On("ListClientInfos",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_GetComponentStates_Call) Run(run func(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption)) *MockProxyClient_GetComponentStates_Call {
+func (_c *MockProxyClient_ListClientInfos_Call) Run(run func(ctx context.Context, in *proxypb.ListClientInfosRequest, opts ...grpc.CallOption)) *MockProxyClient_ListClientInfos_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -127,23 +1461,23 @@ func (_c *MockProxyClient_GetComponentStates_Call) Run(run func(ctx context.Cont
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetComponentStatesRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.ListClientInfosRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_GetComponentStates_Call) Return(_a0 *milvuspb.ComponentStates, _a1 error) *MockProxyClient_GetComponentStates_Call {
+func (_c *MockProxyClient_ListClientInfos_Call) Return(_a0 *proxypb.ListClientInfosResponse, _a1 error) *MockProxyClient_ListClientInfos_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_GetComponentStates_Call) RunAndReturn(run func(context.Context, *milvuspb.GetComponentStatesRequest, ...grpc.CallOption) (*milvuspb.ComponentStates, error)) *MockProxyClient_GetComponentStates_Call {
+func (_c *MockProxyClient_ListClientInfos_Call) RunAndReturn(run func(context.Context, *proxypb.ListClientInfosRequest, ...grpc.CallOption) (*proxypb.ListClientInfosResponse, error)) *MockProxyClient_ListClientInfos_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetDdChannel provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) GetDdChannel(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+// ListImports provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) ListImports(ctx context.Context, in *internalpb.ListImportsRequest, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -153,20 +1487,20 @@ func (_m *MockProxyClient) GetDdChannel(ctx context.Context, in *internalpb.GetD
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *internalpb.ListImportsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDdChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequest, ...grpc.CallOption) (*internalpb.ListImportsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetDdChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequest, ...grpc.CallOption) *internalpb.ListImportsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*internalpb.ListImportsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetDdChannelRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListImportsRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -175,21 +1509,21 @@ func (_m *MockProxyClient) GetDdChannel(ctx context.Context, in *internalpb.GetD
 	return r0, r1
 }
 
-// MockProxyClient_GetDdChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDdChannel'
-type MockProxyClient_GetDdChannel_Call struct {
+// MockProxyClient_ListImports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImports'
+type MockProxyClient_ListImports_Call struct {
 	*mock.Call
 }
 
-// GetDdChannel is a helper method to define mock.On call
+// ListImports is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetDdChannelRequest
+//   - in *internalpb.ListImportsRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) GetDdChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetDdChannel_Call {
-	return &MockProxyClient_GetDdChannel_Call{Call: _e.mock.On("GetDdChannel",
+func (_e *MockProxyClient_Expecter) ListImports(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ListImports_Call {
+	return &MockProxyClient_ListImports_Call{Call: _e.mock.On("ListImports",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_GetDdChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetDdChannelRequest, opts ...grpc.CallOption)) *MockProxyClient_GetDdChannel_Call {
+func (_c *MockProxyClient_ListImports_Call) Run(run func(ctx context.Context, in *internalpb.ListImportsRequest, opts ...grpc.CallOption)) *MockProxyClient_ListImports_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -197,23 +1531,23 @@ func (_c *MockProxyClient_GetDdChannel_Call) Run(run func(ctx context.Context, i
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetDdChannelRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*internalpb.ListImportsRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_GetDdChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockProxyClient_GetDdChannel_Call {
+func (_c *MockProxyClient_ListImports_Call) Return(_a0 *internalpb.ListImportsResponse, _a1 error) *MockProxyClient_ListImports_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_GetDdChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetDdChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockProxyClient_GetDdChannel_Call {
+func (_c *MockProxyClient_ListImports_Call) RunAndReturn(run func(context.Context, *internalpb.ListImportsRequest, ...grpc.CallOption) (*internalpb.ListImportsResponse, error)) *MockProxyClient_ListImports_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetImportProgress provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) GetImportProgress(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error) {
+// PromoteReplica provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) PromoteReplica(ctx context.Context, in *proxypb.PromoteReplicaRequest, opts ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -223,20 +1557,20 @@ func (_m *MockProxyClient) GetImportProgress(ctx context.Context, in *internalpb
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *internalpb.GetImportProgressResponse
+	var r0 *proxypb.RestoreCollectionsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.PromoteReplicaRequest, ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) *internalpb.GetImportProgressResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.PromoteReplicaRequest, ...grpc.CallOption) *proxypb.RestoreCollectionsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.GetImportProgressResponse)
+			r0 = ret.Get(0).(*proxypb.RestoreCollectionsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.PromoteReplicaRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -245,21 +1579,21 @@ func (_m *MockProxyClient) GetImportProgress(ctx context.Context, in *internalpb
 	return r0, r1
 }
 
-// MockProxyClient_GetImportProgress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetImportProgress'
-type MockProxyClient_GetImportProgress_Call struct {
+// MockProxyClient_PromoteReplica_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteReplica'
+type MockProxyClient_PromoteReplica_Call struct {
 	*mock.Call
 }
 
-// GetImportProgress is a helper method to define mock.On call
+// PromoteReplica is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetImportProgressRequest
+//   - in *proxypb.PromoteReplicaRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) GetImportProgress(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetImportProgress_Call {
-	return &MockProxyClient_GetImportProgress_Call{Call: _e.mock.On("GetImportProgress",
+func (_e *MockProxyClient_Expecter) PromoteReplica(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_PromoteReplica_Call {
+	return &MockProxyClient_PromoteReplica_Call{Call: _e.mock.On("PromoteReplica",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_GetImportProgress_Call) Run(run func(ctx context.Context, in *internalpb.GetImportProgressRequest, opts ...grpc.CallOption)) *MockProxyClient_GetImportProgress_Call {
+func (_c *MockProxyClient_PromoteReplica_Call) Run(run func(ctx context.Context, in *proxypb.PromoteReplicaRequest, opts ...grpc.CallOption)) *MockProxyClient_PromoteReplica_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -267,23 +1601,23 @@ func (_c *MockProxyClient_GetImportProgress_Call) Run(run func(ctx context.Conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetImportProgressRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.PromoteReplicaRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_GetImportProgress_Call) Return(_a0 *internalpb.GetImportProgressResponse, _a1 error) *MockProxyClient_GetImportProgress_Call {
+func (_c *MockProxyClient_PromoteReplica_Call) Return(_a0 *proxypb.RestoreCollectionsResponse, _a1 error) *MockProxyClient_PromoteReplica_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_GetImportProgress_Call) RunAndReturn(run func(context.Context, *internalpb.GetImportProgressRequest, ...grpc.CallOption) (*internalpb.GetImportProgressResponse, error)) *MockProxyClient_GetImportProgress_Call {
+func (_c *MockProxyClient_PromoteReplica_Call) RunAndReturn(run func(context.Context, *proxypb.PromoteReplicaRequest, ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error)) *MockProxyClient_PromoteReplica_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetProxyMetrics provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) GetProxyMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+// QueryIterator provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) QueryIterator(ctx context.Context, in *proxypb.QueryIteratorRequest, opts ...grpc.CallOption) (*proxypb.QueryIteratorResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -293,20 +1627,20 @@ func (_m *MockProxyClient) GetProxyMetrics(ctx context.Context, in *milvuspb.Get
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.GetMetricsResponse
+	var r0 *proxypb.QueryIteratorResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.QueryIteratorRequest, ...grpc.CallOption) (*proxypb.QueryIteratorResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) *milvuspb.GetMetricsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.QueryIteratorRequest, ...grpc.CallOption) *proxypb.QueryIteratorResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.GetMetricsResponse)
+			r0 = ret.Get(0).(*proxypb.QueryIteratorResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.QueryIteratorRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -315,21 +1649,21 @@ func (_m *MockProxyClient) GetProxyMetrics(ctx context.Context, in *milvuspb.Get
 	return r0, r1
 }
 
-// MockProxyClient_GetProxyMetrics_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProxyMetrics'
-type MockProxyClient_GetProxyMetrics_Call struct {
+// MockProxyClient_QueryIterator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryIterator'
+type MockProxyClient_QueryIterator_Call struct {
 	*mock.Call
 }
 
-// GetProxyMetrics is a helper method to define mock.On call
+// QueryIterator is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.GetMetricsRequest
+//   - in *proxypb.QueryIteratorRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) GetProxyMetrics(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetProxyMetrics_Call {
-	return &MockProxyClient_GetProxyMetrics_Call{Call: _e.mock.On("GetProxyMetrics",
+func (_e *MockProxyClient_Expecter) QueryIterator(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_QueryIterator_Call {
+	return &MockProxyClient_QueryIterator_Call{Call: _e.mock.On("QueryIterator",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_GetProxyMetrics_Call) Run(run func(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption)) *MockProxyClient_GetProxyMetrics_Call {
+func (_c *MockProxyClient_QueryIterator_Call) Run(run func(ctx context.Context, in *proxypb.QueryIteratorRequest, opts ...grpc.CallOption)) *MockProxyClient_QueryIterator_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -337,23 +1671,23 @@ func (_c *MockProxyClient_GetProxyMetrics_Call) Run(run func(ctx context.Context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.GetMetricsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.QueryIteratorRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_GetProxyMetrics_Call) Return(_a0 *milvuspb.GetMetricsResponse, _a1 error) *MockProxyClient_GetProxyMetrics_Call {
+func (_c *MockProxyClient_QueryIterator_Call) Return(_a0 *proxypb.QueryIteratorResponse, _a1 error) *MockProxyClient_QueryIterator_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_GetProxyMetrics_Call) RunAndReturn(run func(context.Context, *milvuspb.GetMetricsRequest, ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)) *MockProxyClient_GetProxyMetrics_Call {
+func (_c *MockProxyClient_QueryIterator_Call) RunAndReturn(run func(context.Context, *proxypb.QueryIteratorRequest, ...grpc.CallOption) (*proxypb.QueryIteratorResponse, error)) *MockProxyClient_QueryIterator_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetStatisticsChannel provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+// RefreshPolicyInfoCache provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) RefreshPolicyInfoCache(ctx context.Context, in *proxypb.RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -363,20 +1697,20 @@ func (_m *MockProxyClient) GetStatisticsChannel(ctx context.Context, in *interna
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *milvuspb.StringResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RefreshPolicyInfoCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) *milvuspb.StringResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RefreshPolicyInfoCacheRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*milvuspb.StringResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RefreshPolicyInfoCacheRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -385,21 +1719,21 @@ func (_m *MockProxyClient) GetStatisticsChannel(ctx context.Context, in *interna
 	return r0, r1
 }
 
-// MockProxyClient_GetStatisticsChannel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatisticsChannel'
-type MockProxyClient_GetStatisticsChannel_Call struct {
+// MockProxyClient_RefreshPolicyInfoCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshPolicyInfoCache'
+type MockProxyClient_RefreshPolicyInfoCache_Call struct {
 	*mock.Call
 }
 
-// GetStatisticsChannel is a helper method to define mock.On call
+// RefreshPolicyInfoCache is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.GetStatisticsChannelRequest
+//   - in *proxypb.RefreshPolicyInfoCacheRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) GetStatisticsChannel(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_GetStatisticsChannel_Call {
-	return &MockProxyClient_GetStatisticsChannel_Call{Call: _e.mock.On("GetStatisticsChannel",
+func (_e *MockProxyClient_Expecter) RefreshPolicyInfoCache(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_RefreshPolicyInfoCache_Call {
+	return &MockProxyClient_RefreshPolicyInfoCache_Call{Call: _e.mock.On("RefreshPolicyInfoCache",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_GetStatisticsChannel_Call) Run(run func(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption)) *MockProxyClient_GetStatisticsChannel_Call {
+func (_c *MockProxyClient_RefreshPolicyInfoCache_Call) Run(run func(ctx context.Context, in *proxypb.RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption)) *MockProxyClient_RefreshPolicyInfoCache_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -407,23 +1741,23 @@ func (_c *MockProxyClient_GetStatisticsChannel_Call) Run(run func(ctx context.Co
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.GetStatisticsChannelRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.RefreshPolicyInfoCacheRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_GetStatisticsChannel_Call) Return(_a0 *milvuspb.StringResponse, _a1 error) *MockProxyClient_GetStatisticsChannel_Call {
+func (_c *MockProxyClient_RefreshPolicyInfoCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_RefreshPolicyInfoCache_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_GetStatisticsChannel_Call) RunAndReturn(run func(context.Context, *internalpb.GetStatisticsChannelRequest, ...grpc.CallOption) (*milvuspb.StringResponse, error)) *MockProxyClient_GetStatisticsChannel_Call {
+func (_c *MockProxyClient_RefreshPolicyInfoCache_Call) RunAndReturn(run func(context.Context, *proxypb.RefreshPolicyInfoCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_RefreshPolicyInfoCache_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ImportV2 provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) ImportV2(ctx context.Context, in *internalpb.ImportRequest, opts ...grpc.CallOption) (*internalpb.ImportResponse, error) {
+// RestoreBackup provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) RestoreBackup(ctx context.Context, in *proxypb.RestoreBackupRequest, opts ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -433,20 +1767,20 @@ func (_m *MockProxyClient) ImportV2(ctx context.Context, in *internalpb.ImportRe
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *internalpb.ImportResponse
+	var r0 *proxypb.RestoreCollectionsResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequest, ...grpc.CallOption) (*internalpb.ImportResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RestoreBackupRequest, ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ImportRequest, ...grpc.CallOption) *internalpb.ImportResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RestoreBackupRequest, ...grpc.CallOption) *proxypb.RestoreCollectionsResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.ImportResponse)
+			r0 = ret.Get(0).(*proxypb.RestoreCollectionsResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ImportRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RestoreBackupRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -455,21 +1789,21 @@ func (_m *MockProxyClient) ImportV2(ctx context.Context, in *internalpb.ImportRe
 	return r0, r1
 }
 
-// MockProxyClient_ImportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportV2'
-type MockProxyClient_ImportV2_Call struct {
+// MockProxyClient_RestoreBackup_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreBackup'
+type MockProxyClient_RestoreBackup_Call struct {
 	*mock.Call
 }
 
-// ImportV2 is a helper method to define mock.On call
+// RestoreBackup is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.ImportRequest
+//   - in *proxypb.RestoreBackupRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) ImportV2(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ImportV2_Call {
-	return &MockProxyClient_ImportV2_Call{Call: _e.mock.On("ImportV2",
+func (_e *MockProxyClient_Expecter) RestoreBackup(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_RestoreBackup_Call {
+	return &MockProxyClient_RestoreBackup_Call{Call: _e.mock.On("RestoreBackup",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_ImportV2_Call) Run(run func(ctx context.Context, in *internalpb.ImportRequest, opts ...grpc.CallOption)) *MockProxyClient_ImportV2_Call {
+func (_c *MockProxyClient_RestoreBackup_Call) Run(run func(ctx context.Context, in *proxypb.RestoreBackupRequest, opts ...grpc.CallOption)) *MockProxyClient_RestoreBackup_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -477,23 +1811,23 @@ func (_c *MockProxyClient_ImportV2_Call) Run(run func(ctx context.Context, in *i
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.ImportRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.RestoreBackupRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_ImportV2_Call) Return(_a0 *internalpb.ImportResponse, _a1 error) *MockProxyClient_ImportV2_Call {
+func (_c *MockProxyClient_RestoreBackup_Call) Return(_a0 *proxypb.RestoreCollectionsResponse, _a1 error) *MockProxyClient_RestoreBackup_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_ImportV2_Call) RunAndReturn(run func(context.Context, *internalpb.ImportRequest, ...grpc.CallOption) (*internalpb.ImportResponse, error)) *MockProxyClient_ImportV2_Call {
+func (_c *MockProxyClient_RestoreBackup_Call) RunAndReturn(run func(context.Context, *proxypb.RestoreBackupRequest, ...grpc.CallOption) (*proxypb.RestoreCollectionsResponse, error)) *MockProxyClient_RestoreBackup_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InvalidateCollectionMetaCache provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) InvalidateCollectionMetaCache(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// RevokeAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) RevokeAPIKey(ctx context.Context, in *proxypb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -505,10 +1839,10 @@ func (_m *MockProxyClient) InvalidateCollectionMetaCache(ctx context.Context, in
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RevokeAPIKeyRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RevokeAPIKeyRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -516,7 +1850,7 @@ func (_m *MockProxyClient) InvalidateCollectionMetaCache(ctx context.Context, in
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RevokeAPIKeyRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -525,21 +1859,21 @@ func (_m *MockProxyClient) InvalidateCollectionMetaCache(ctx context.Context, in
 	return r0, r1
 }
 
-// MockProxyClient_InvalidateCollectionMetaCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateCollectionMetaCache'
-type MockProxyClient_InvalidateCollectionMetaCache_Call struct {
+// MockProxyClient_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type MockProxyClient_RevokeAPIKey_Call struct {
 	*mock.Call
 }
 
-// InvalidateCollectionMetaCache is a helper method to define mock.On call
+// RevokeAPIKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *proxypb.InvalidateCollMetaCacheRequest
+//   - in *proxypb.RevokeAPIKeyRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) InvalidateCollectionMetaCache(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_InvalidateCollectionMetaCache_Call {
-	return &MockProxyClient_InvalidateCollectionMetaCache_Call{Call: _e.mock.On("InvalidateCollectionMetaCache",
+func (_e *MockProxyClient_Expecter) RevokeAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_RevokeAPIKey_Call {
+	return &MockProxyClient_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_InvalidateCollectionMetaCache_Call) Run(run func(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption)) *MockProxyClient_InvalidateCollectionMetaCache_Call {
+func (_c *MockProxyClient_RevokeAPIKey_Call) Run(run func(ctx context.Context, in *proxypb.RevokeAPIKeyRequest, opts ...grpc.CallOption)) *MockProxyClient_RevokeAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -547,23 +1881,23 @@ func (_c *MockProxyClient_InvalidateCollectionMetaCache_Call) Run(run func(ctx c
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*proxypb.InvalidateCollMetaCacheRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.RevokeAPIKeyRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_InvalidateCollectionMetaCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_InvalidateCollectionMetaCache_Call {
+func (_c *MockProxyClient_RevokeAPIKey_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_RevokeAPIKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_InvalidateCollectionMetaCache_Call) RunAndReturn(run func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_InvalidateCollectionMetaCache_Call {
+func (_c *MockProxyClient_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.RevokeAPIKeyRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_RevokeAPIKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InvalidateCredentialCache provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) InvalidateCredentialCache(ctx context.Context, in *proxypb.InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// RotateAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) RotateAPIKey(ctx context.Context, in *proxypb.RotateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -573,20 +1907,20 @@ func (_m *MockProxyClient) InvalidateCredentialCache(ctx context.Context, in *pr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *proxypb.APIKeyResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCredCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RotateAPIKeyRequest, ...grpc.CallOption) (*proxypb.APIKeyResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCredCacheRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RotateAPIKeyRequest, ...grpc.CallOption) *proxypb.APIKeyResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*proxypb.APIKeyResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateCredCacheRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RotateAPIKeyRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -595,21 +1929,21 @@ func (_m *MockProxyClient) InvalidateCredentialCache(ctx context.Context, in *pr
 	return r0, r1
 }
 
-// MockProxyClient_InvalidateCredentialCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateCredentialCache'
-type MockProxyClient_InvalidateCredentialCache_Call struct {
+// MockProxyClient_RotateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateAPIKey'
+type MockProxyClient_RotateAPIKey_Call struct {
 	*mock.Call
 }
 
-// InvalidateCredentialCache is a helper method to define mock.On call
+// RotateAPIKey is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *proxypb.InvalidateCredCacheRequest
+//   - in *proxypb.RotateAPIKeyRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) InvalidateCredentialCache(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_InvalidateCredentialCache_Call {
-	return &MockProxyClient_InvalidateCredentialCache_Call{Call: _e.mock.On("InvalidateCredentialCache",
+func (_e *MockProxyClient_Expecter) RotateAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_RotateAPIKey_Call {
+	return &MockProxyClient_RotateAPIKey_Call{Call: _e.mock.On("RotateAPIKey",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_InvalidateCredentialCache_Call) Run(run func(ctx context.Context, in *proxypb.InvalidateCredCacheRequest, opts ...grpc.CallOption)) *MockProxyClient_InvalidateCredentialCache_Call {
+func (_c *MockProxyClient_RotateAPIKey_Call) Run(run func(ctx context.Context, in *proxypb.RotateAPIKeyRequest, opts ...grpc.CallOption)) *MockProxyClient_RotateAPIKey_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -617,23 +1951,23 @@ func (_c *MockProxyClient_InvalidateCredentialCache_Call) Run(run func(ctx conte
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*proxypb.InvalidateCredCacheRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.RotateAPIKeyRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_InvalidateCredentialCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_InvalidateCredentialCache_Call {
+func (_c *MockProxyClient_RotateAPIKey_Call) Return(_a0 *proxypb.APIKeyResponse, _a1 error) *MockProxyClient_RotateAPIKey_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_InvalidateCredentialCache_Call) RunAndReturn(run func(context.Context, *proxypb.InvalidateCredCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_InvalidateCredentialCache_Call {
+func (_c *MockProxyClient_RotateAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.RotateAPIKeyRequest, ...grpc.CallOption) (*proxypb.APIKeyResponse, error)) *MockProxyClient_RotateAPIKey_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListClientInfos provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) ListClientInfos(ctx context.Context, in *proxypb.ListClientInfosRequest, opts ...grpc.CallOption) (*proxypb.ListClientInfosResponse, error) {
+// SearchIterator provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) SearchIterator(ctx context.Context, in *proxypb.SearchIteratorRequest, opts ...grpc.CallOption) (*proxypb.SearchIteratorResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -643,20 +1977,20 @@ func (_m *MockProxyClient) ListClientInfos(ctx context.Context, in *proxypb.List
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *proxypb.ListClientInfosResponse
+	var r0 *proxypb.SearchIteratorResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListClientInfosRequest, ...grpc.CallOption) (*proxypb.ListClientInfosResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SearchIteratorRequest, ...grpc.CallOption) (*proxypb.SearchIteratorResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListClientInfosRequest, ...grpc.CallOption) *proxypb.ListClientInfosResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SearchIteratorRequest, ...grpc.CallOption) *proxypb.SearchIteratorResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*proxypb.ListClientInfosResponse)
+			r0 = ret.Get(0).(*proxypb.SearchIteratorResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ListClientInfosRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.SearchIteratorRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -665,21 +1999,21 @@ func (_m *MockProxyClient) ListClientInfos(ctx context.Context, in *proxypb.List
 	return r0, r1
 }
 
-// MockProxyClient_ListClientInfos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListClientInfos'
-type MockProxyClient_ListClientInfos_Call struct {
+// MockProxyClient_SearchIterator_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchIterator'
+type MockProxyClient_SearchIterator_Call struct {
 	*mock.Call
 }
 
-// ListClientInfos is a helper method to define mock.On call
+// SearchIterator is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *proxypb.ListClientInfosRequest
+//   - in *proxypb.SearchIteratorRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) ListClientInfos(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ListClientInfos_Call {
-	return &MockProxyClient_ListClientInfos_Call{Call: _e.mock.On("ListClientInfos",
+func (_e *MockProxyClient_Expecter) SearchIterator(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_SearchIterator_Call {
+	return &MockProxyClient_SearchIterator_Call{Call: _e.mock.On("SearchIterator",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_ListClientInfos_Call) Run(run func(ctx context.Context, in *proxypb.ListClientInfosRequest, opts ...grpc.CallOption)) *MockProxyClient_ListClientInfos_Call {
+func (_c *MockProxyClient_SearchIterator_Call) Run(run func(ctx context.Context, in *proxypb.SearchIteratorRequest, opts ...grpc.CallOption)) *MockProxyClient_SearchIterator_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -687,23 +2021,23 @@ func (_c *MockProxyClient_ListClientInfos_Call) Run(run func(ctx context.Context
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*proxypb.ListClientInfosRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.SearchIteratorRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_ListClientInfos_Call) Return(_a0 *proxypb.ListClientInfosResponse, _a1 error) *MockProxyClient_ListClientInfos_Call {
+func (_c *MockProxyClient_SearchIterator_Call) Return(_a0 *proxypb.SearchIteratorResponse, _a1 error) *MockProxyClient_SearchIterator_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_ListClientInfos_Call) RunAndReturn(run func(context.Context, *proxypb.ListClientInfosRequest, ...grpc.CallOption) (*proxypb.ListClientInfosResponse, error)) *MockProxyClient_ListClientInfos_Call {
+func (_c *MockProxyClient_SearchIterator_Call) RunAndReturn(run func(context.Context, *proxypb.SearchIteratorRequest, ...grpc.CallOption) (*proxypb.SearchIteratorResponse, error)) *MockProxyClient_SearchIterator_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListImports provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) ListImports(ctx context.Context, in *internalpb.ListImportsRequest, opts ...grpc.CallOption) (*internalpb.ListImportsResponse, error) {
+// SetRates provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) SetRates(ctx context.Context, in *proxypb.SetRatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -713,20 +2047,20 @@ func (_m *MockProxyClient) ListImports(ctx context.Context, in *internalpb.ListI
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *internalpb.ListImportsResponse
+	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequest, ...grpc.CallOption) (*internalpb.ListImportsResponse, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SetRatesRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.ListImportsRequest, ...grpc.CallOption) *internalpb.ListImportsResponse); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SetRatesRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*internalpb.ListImportsResponse)
+			r0 = ret.Get(0).(*commonpb.Status)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.ListImportsRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.SetRatesRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -735,21 +2069,21 @@ func (_m *MockProxyClient) ListImports(ctx context.Context, in *internalpb.ListI
 	return r0, r1
 }
 
-// MockProxyClient_ListImports_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListImports'
-type MockProxyClient_ListImports_Call struct {
+// MockProxyClient_SetRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRates'
+type MockProxyClient_SetRates_Call struct {
 	*mock.Call
 }
 
-// ListImports is a helper method to define mock.On call
+// SetRates is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *internalpb.ListImportsRequest
+//   - in *proxypb.SetRatesRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) ListImports(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_ListImports_Call {
-	return &MockProxyClient_ListImports_Call{Call: _e.mock.On("ListImports",
+func (_e *MockProxyClient_Expecter) SetRates(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_SetRates_Call {
+	return &MockProxyClient_SetRates_Call{Call: _e.mock.On("SetRates",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_ListImports_Call) Run(run func(ctx context.Context, in *internalpb.ListImportsRequest, opts ...grpc.CallOption)) *MockProxyClient_ListImports_Call {
+func (_c *MockProxyClient_SetRates_Call) Run(run func(ctx context.Context, in *proxypb.SetRatesRequest, opts ...grpc.CallOption)) *MockProxyClient_SetRates_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -757,23 +2091,23 @@ func (_c *MockProxyClient_ListImports_Call) Run(run func(ctx context.Context, in
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*internalpb.ListImportsRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.SetRatesRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_ListImports_Call) Return(_a0 *internalpb.ListImportsResponse, _a1 error) *MockProxyClient_ListImports_Call {
+func (_c *MockProxyClient_SetRates_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_SetRates_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_ListImports_Call) RunAndReturn(run func(context.Context, *internalpb.ListImportsRequest, ...grpc.CallOption) (*internalpb.ListImportsResponse, error)) *MockProxyClient_ListImports_Call {
+func (_c *MockProxyClient_SetRates_Call) RunAndReturn(run func(context.Context, *proxypb.SetRatesRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_SetRates_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// RefreshPolicyInfoCache provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) RefreshPolicyInfoCache(ctx context.Context, in *proxypb.RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// SubscribeStandingQuery provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) SubscribeStandingQuery(ctx context.Context, in *proxypb.SubscribeStandingQueryRequest, opts ...grpc.CallOption) (proxypb.Proxy_SubscribeStandingQueryClient, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -783,20 +2117,20 @@ func (_m *MockProxyClient) RefreshPolicyInfoCache(ctx context.Context, in *proxy
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 proxypb.Proxy_SubscribeStandingQueryClient
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RefreshPolicyInfoCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SubscribeStandingQueryRequest, ...grpc.CallOption) (proxypb.Proxy_SubscribeStandingQueryClient, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RefreshPolicyInfoCacheRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SubscribeStandingQueryRequest, ...grpc.CallOption) proxypb.Proxy_SubscribeStandingQueryClient); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(proxypb.Proxy_SubscribeStandingQueryClient)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RefreshPolicyInfoCacheRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.SubscribeStandingQueryRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -805,21 +2139,21 @@ func (_m *MockProxyClient) RefreshPolicyInfoCache(ctx context.Context, in *proxy
 	return r0, r1
 }
 
-// MockProxyClient_RefreshPolicyInfoCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshPolicyInfoCache'
-type MockProxyClient_RefreshPolicyInfoCache_Call struct {
+// MockProxyClient_SubscribeStandingQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeStandingQuery'
+type MockProxyClient_SubscribeStandingQuery_Call struct {
 	*mock.Call
 }
 
-// RefreshPolicyInfoCache is a helper method to define mock.On call
+// SubscribeStandingQuery is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *proxypb.RefreshPolicyInfoCacheRequest
+//   - in *proxypb.SubscribeStandingQueryRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) RefreshPolicyInfoCache(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_RefreshPolicyInfoCache_Call {
-	return &MockProxyClient_RefreshPolicyInfoCache_Call{Call: _e.mock.On("RefreshPolicyInfoCache",
+func (_e *MockProxyClient_Expecter) SubscribeStandingQuery(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_SubscribeStandingQuery_Call {
+	return &MockProxyClient_SubscribeStandingQuery_Call{Call: _e.mock.On("SubscribeStandingQuery",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_RefreshPolicyInfoCache_Call) Run(run func(ctx context.Context, in *proxypb.RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption)) *MockProxyClient_RefreshPolicyInfoCache_Call {
+func (_c *MockProxyClient_SubscribeStandingQuery_Call) Run(run func(ctx context.Context, in *proxypb.SubscribeStandingQueryRequest, opts ...grpc.CallOption)) *MockProxyClient_SubscribeStandingQuery_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -827,23 +2161,23 @@ func (_c *MockProxyClient_RefreshPolicyInfoCache_Call) Run(run func(ctx context.
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*proxypb.RefreshPolicyInfoCacheRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.SubscribeStandingQueryRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_RefreshPolicyInfoCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_RefreshPolicyInfoCache_Call {
+func (_c *MockProxyClient_SubscribeStandingQuery_Call) Return(_a0 proxypb.Proxy_SubscribeStandingQueryClient, _a1 error) *MockProxyClient_SubscribeStandingQuery_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_RefreshPolicyInfoCache_Call) RunAndReturn(run func(context.Context, *proxypb.RefreshPolicyInfoCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_RefreshPolicyInfoCache_Call {
+func (_c *MockProxyClient_SubscribeStandingQuery_Call) RunAndReturn(run func(context.Context, *proxypb.SubscribeStandingQueryRequest, ...grpc.CallOption) (proxypb.Proxy_SubscribeStandingQueryClient, error)) *MockProxyClient_SubscribeStandingQuery_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SetRates provides a mock function with given fields: ctx, in, opts
-func (_m *MockProxyClient) SetRates(ctx context.Context, in *proxypb.SetRatesRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// UndropCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) UndropCollection(ctx context.Context, in *proxypb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -855,10 +2189,10 @@ func (_m *MockProxyClient) SetRates(ctx context.Context, in *proxypb.SetRatesReq
 
 	var r0 *commonpb.Status
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SetRatesRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.UndropCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.SetRatesRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.UndropCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
@@ -866,7 +2200,7 @@ func (_m *MockProxyClient) SetRates(ctx context.Context, in *proxypb.SetRatesReq
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.SetRatesRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.UndropCollectionRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -875,21 +2209,21 @@ func (_m *MockProxyClient) SetRates(ctx context.Context, in *proxypb.SetRatesReq
 	return r0, r1
 }
 
-// MockProxyClient_SetRates_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetRates'
-type MockProxyClient_SetRates_Call struct {
+// MockProxyClient_UndropCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndropCollection'
+type MockProxyClient_UndropCollection_Call struct {
 	*mock.Call
 }
 
-// SetRates is a helper method to define mock.On call
+// UndropCollection is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *proxypb.SetRatesRequest
+//   - in *proxypb.UndropCollectionRequest
 //   - opts ...grpc.CallOption
-func (_e *MockProxyClient_Expecter) SetRates(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_SetRates_Call {
-	return &MockProxyClient_SetRates_Call{Call: _e.mock.On("SetRates",
+func (_e *MockProxyClient_Expecter) UndropCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_UndropCollection_Call {
+	return &MockProxyClient_UndropCollection_Call{Call: _e.mock.On("UndropCollection",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockProxyClient_SetRates_Call) Run(run func(ctx context.Context, in *proxypb.SetRatesRequest, opts ...grpc.CallOption)) *MockProxyClient_SetRates_Call {
+func (_c *MockProxyClient_UndropCollection_Call) Run(run func(ctx context.Context, in *proxypb.UndropCollectionRequest, opts ...grpc.CallOption)) *MockProxyClient_UndropCollection_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -897,17 +2231,17 @@ func (_c *MockProxyClient_SetRates_Call) Run(run func(ctx context.Context, in *p
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*proxypb.SetRatesRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.UndropCollectionRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockProxyClient_SetRates_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_SetRates_Call {
+func (_c *MockProxyClient_UndropCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_UndropCollection_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockProxyClient_SetRates_Call) RunAndReturn(run func(context.Context, *proxypb.SetRatesRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_SetRates_Call {
+func (_c *MockProxyClient_UndropCollection_Call) RunAndReturn(run func(context.Context, *proxypb.UndropCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_UndropCollection_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -419,6 +419,61 @@ func (_c *MockQueryCoord_DropResourceGroup_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// FreezeCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) FreezeCollection(_a0 context.Context, _a1 *querypb.FreezeCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.FreezeCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.FreezeCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.FreezeCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_FreezeCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FreezeCollection'
+type MockQueryCoord_FreezeCollection_Call struct {
+	*mock.Call
+}
+
+// FreezeCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.FreezeCollectionRequest
+func (_e *MockQueryCoord_Expecter) FreezeCollection(_a0 interface{}, _a1 interface{}) *MockQueryCoord_FreezeCollection_Call {
+	return &MockQueryCoord_FreezeCollection_Call{Call: _e.mock.On("FreezeCollection", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_FreezeCollection_Call) Run(run func(_a0 context.Context, _a1 *querypb.FreezeCollectionRequest)) *MockQueryCoord_FreezeCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.FreezeCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_FreezeCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_FreezeCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_FreezeCollection_Call) RunAndReturn(run func(context.Context, *querypb.FreezeCollectionRequest) (*commonpb.Status, error)) *MockQueryCoord_FreezeCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) GetComponentStates(_a0 context.Context, _a1 *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
 	ret := _m.Called(_a0, _a1)
@@ -474,6 +529,61 @@ func (_c *MockQueryCoord_GetComponentStates_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetLoadProgressDetail provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) GetLoadProgressDetail(_a0 context.Context, _a1 *querypb.GetLoadProgressDetailRequest) (*querypb.GetLoadProgressDetailResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *querypb.GetLoadProgressDetailResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadProgressDetailRequest) (*querypb.GetLoadProgressDetailResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadProgressDetailRequest) *querypb.GetLoadProgressDetailResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetLoadProgressDetailResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetLoadProgressDetailRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_GetLoadProgressDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadProgressDetail'
+type MockQueryCoord_GetLoadProgressDetail_Call struct {
+	*mock.Call
+}
+
+// GetLoadProgressDetail is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.GetLoadProgressDetailRequest
+func (_e *MockQueryCoord_Expecter) GetLoadProgressDetail(_a0 interface{}, _a1 interface{}) *MockQueryCoord_GetLoadProgressDetail_Call {
+	return &MockQueryCoord_GetLoadProgressDetail_Call{Call: _e.mock.On("GetLoadProgressDetail", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_GetLoadProgressDetail_Call) Run(run func(_a0 context.Context, _a1 *querypb.GetLoadProgressDetailRequest)) *MockQueryCoord_GetLoadProgressDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.GetLoadProgressDetailRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_GetLoadProgressDetail_Call) Return(_a0 *querypb.GetLoadProgressDetailResponse, _a1 error) *MockQueryCoord_GetLoadProgressDetail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_GetLoadProgressDetail_Call) RunAndReturn(run func(context.Context, *querypb.GetLoadProgressDetailRequest) (*querypb.GetLoadProgressDetailResponse, error)) *MockQueryCoord_GetLoadProgressDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMetrics provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryCoord) GetMetrics(_a0 context.Context, _a1 *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2394,6 +2504,61 @@ func (_c *MockQueryCoord_TransferSegment_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// UnfreezeCollection provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryCoord) UnfreezeCollection(_a0 context.Context, _a1 *querypb.UnfreezeCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UnfreezeCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UnfreezeCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.UnfreezeCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoord_UnfreezeCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfreezeCollection'
+type MockQueryCoord_UnfreezeCollection_Call struct {
+	*mock.Call
+}
+
+// UnfreezeCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.UnfreezeCollectionRequest
+func (_e *MockQueryCoord_Expecter) UnfreezeCollection(_a0 interface{}, _a1 interface{}) *MockQueryCoord_UnfreezeCollection_Call {
+	return &MockQueryCoord_UnfreezeCollection_Call{Call: _e.mock.On("UnfreezeCollection", _a0, _a1)}
+}
+
+func (_c *MockQueryCoord_UnfreezeCollection_Call) Run(run func(_a0 context.Context, _a1 *querypb.UnfreezeCollectionRequest)) *MockQueryCoord_UnfreezeCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.UnfreezeCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryCoord_UnfreezeCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoord_UnfreezeCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoord_UnfreezeCollection_Call) RunAndReturn(run func(context.Context, *querypb.UnfreezeCollectionRequest) (*commonpb.Status, error)) *MockQueryCoord_UnfreezeCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateStateCode provides a mock function with given fields: stateCode
 func (_m *MockQueryCoord) UpdateStateCode(stateCode commonpb.StateCode) {
 	_m.Called(stateCode)
@@ -562,6 +562,76 @@ func (_c *MockQueryCoordClient_DropResourceGroup_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// FreezeCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) FreezeCollection(ctx context.Context, in *querypb.FreezeCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.FreezeCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.FreezeCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.FreezeCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_FreezeCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FreezeCollection'
+type MockQueryCoordClient_FreezeCollection_Call struct {
+	*mock.Call
+}
+
+// FreezeCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.FreezeCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) FreezeCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_FreezeCollection_Call {
+	return &MockQueryCoordClient_FreezeCollection_Call{Call: _e.mock.On("FreezeCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_FreezeCollection_Call) Run(run func(ctx context.Context, in *querypb.FreezeCollectionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_FreezeCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.FreezeCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_FreezeCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_FreezeCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_FreezeCollection_Call) RunAndReturn(run func(context.Context, *querypb.FreezeCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_FreezeCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
 	_va := make([]interface{}, len(opts))
@@ -632,6 +702,76 @@ func (_c *MockQueryCoordClient_GetComponentStates_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// GetLoadProgressDetail provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) GetLoadProgressDetail(ctx context.Context, in *querypb.GetLoadProgressDetailRequest, opts ...grpc.CallOption) (*querypb.GetLoadProgressDetailResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *querypb.GetLoadProgressDetailResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadProgressDetailRequest, ...grpc.CallOption) (*querypb.GetLoadProgressDetailResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.GetLoadProgressDetailRequest, ...grpc.CallOption) *querypb.GetLoadProgressDetailResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*querypb.GetLoadProgressDetailResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.GetLoadProgressDetailRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_GetLoadProgressDetail_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLoadProgressDetail'
+type MockQueryCoordClient_GetLoadProgressDetail_Call struct {
+	*mock.Call
+}
+
+// GetLoadProgressDetail is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.GetLoadProgressDetailRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) GetLoadProgressDetail(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_GetLoadProgressDetail_Call {
+	return &MockQueryCoordClient_GetLoadProgressDetail_Call{Call: _e.mock.On("GetLoadProgressDetail",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_GetLoadProgressDetail_Call) Run(run func(ctx context.Context, in *querypb.GetLoadProgressDetailRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_GetLoadProgressDetail_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.GetLoadProgressDetailRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetLoadProgressDetail_Call) Return(_a0 *querypb.GetLoadProgressDetailResponse, _a1 error) *MockQueryCoordClient_GetLoadProgressDetail_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_GetLoadProgressDetail_Call) RunAndReturn(run func(context.Context, *querypb.GetLoadProgressDetailRequest, ...grpc.CallOption) (*querypb.GetLoadProgressDetailResponse, error)) *MockQueryCoordClient_GetLoadProgressDetail_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetMetrics provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -2592,6 +2732,76 @@ func (_c *MockQueryCoordClient_TransferSegment_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// UnfreezeCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryCoordClient) UnfreezeCollection(ctx context.Context, in *querypb.UnfreezeCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UnfreezeCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.UnfreezeCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.UnfreezeCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryCoordClient_UnfreezeCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnfreezeCollection'
+type MockQueryCoordClient_UnfreezeCollection_Call struct {
+	*mock.Call
+}
+
+// UnfreezeCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.UnfreezeCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryCoordClient_Expecter) UnfreezeCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryCoordClient_UnfreezeCollection_Call {
+	return &MockQueryCoordClient_UnfreezeCollection_Call{Call: _e.mock.On("UnfreezeCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryCoordClient_UnfreezeCollection_Call) Run(run func(ctx context.Context, in *querypb.UnfreezeCollectionRequest, opts ...grpc.CallOption)) *MockQueryCoordClient_UnfreezeCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.UnfreezeCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryCoordClient_UnfreezeCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryCoordClient_UnfreezeCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryCoordClient_UnfreezeCollection_Call) RunAndReturn(run func(context.Context, *querypb.UnfreezeCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryCoordClient_UnfreezeCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockQueryCoordClient creates a new instance of MockQueryCoordClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockQueryCoordClient(t interface {
@@ -1473,6 +1473,104 @@ func (_c *MockQueryNode_Stop_Call) RunAndReturn(run func() error) *MockQueryNode
 	return _c
 }
 
+// SubscribeStandingQuery provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryNode) SubscribeStandingQuery(_a0 *querypb.SubscribeStandingQueryRequest, _a1 querypb.QueryNode_SubscribeStandingQueryServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*querypb.SubscribeStandingQueryRequest, querypb.QueryNode_SubscribeStandingQueryServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQueryNode_SubscribeStandingQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeStandingQuery'
+type MockQueryNode_SubscribeStandingQuery_Call struct {
+	*mock.Call
+}
+
+// SubscribeStandingQuery is a helper method to define mock.On call
+//   - _a0 *querypb.SubscribeStandingQueryRequest
+//   - _a1 querypb.QueryNode_SubscribeStandingQueryServer
+func (_e *MockQueryNode_Expecter) SubscribeStandingQuery(_a0 interface{}, _a1 interface{}) *MockQueryNode_SubscribeStandingQuery_Call {
+	return &MockQueryNode_SubscribeStandingQuery_Call{Call: _e.mock.On("SubscribeStandingQuery", _a0, _a1)}
+}
+
+func (_c *MockQueryNode_SubscribeStandingQuery_Call) Run(run func(_a0 *querypb.SubscribeStandingQueryRequest, _a1 querypb.QueryNode_SubscribeStandingQueryServer)) *MockQueryNode_SubscribeStandingQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*querypb.SubscribeStandingQueryRequest), args[1].(querypb.QueryNode_SubscribeStandingQueryServer))
+	})
+	return _c
+}
+
+func (_c *MockQueryNode_SubscribeStandingQuery_Call) Return(_a0 error) *MockQueryNode_SubscribeStandingQuery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQueryNode_SubscribeStandingQuery_Call) RunAndReturn(run func(*querypb.SubscribeStandingQueryRequest, querypb.QueryNode_SubscribeStandingQueryServer) error) *MockQueryNode_SubscribeStandingQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SwitchShardLeader provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryNode) SwitchShardLeader(_a0 context.Context, _a1 *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SwitchShardLeaderRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SwitchShardLeaderRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNode_SwitchShardLeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwitchShardLeader'
+type MockQueryNode_SwitchShardLeader_Call struct {
+	*mock.Call
+}
+
+// SwitchShardLeader is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.SwitchShardLeaderRequest
+func (_e *MockQueryNode_Expecter) SwitchShardLeader(_a0 interface{}, _a1 interface{}) *MockQueryNode_SwitchShardLeader_Call {
+	return &MockQueryNode_SwitchShardLeader_Call{Call: _e.mock.On("SwitchShardLeader", _a0, _a1)}
+}
+
+func (_c *MockQueryNode_SwitchShardLeader_Call) Run(run func(_a0 context.Context, _a1 *querypb.SwitchShardLeaderRequest)) *MockQueryNode_SwitchShardLeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.SwitchShardLeaderRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryNode_SwitchShardLeader_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryNode_SwitchShardLeader_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryNode_SwitchShardLeader_Call) RunAndReturn(run func(context.Context, *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error)) *MockQueryNode_SwitchShardLeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SyncDistribution provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryNode) SyncDistribution(_a0 context.Context, _a1 *querypb.SyncDistributionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1542,6 +1542,146 @@ func (_c *MockQueryNodeClient_ShowConfigurations_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// SubscribeStandingQuery provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryNodeClient) SubscribeStandingQuery(ctx context.Context, in *querypb.SubscribeStandingQueryRequest, opts ...grpc.CallOption) (querypb.QueryNode_SubscribeStandingQueryClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 querypb.QueryNode_SubscribeStandingQueryClient
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SubscribeStandingQueryRequest, ...grpc.CallOption) (querypb.QueryNode_SubscribeStandingQueryClient, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SubscribeStandingQueryRequest, ...grpc.CallOption) querypb.QueryNode_SubscribeStandingQueryClient); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(querypb.QueryNode_SubscribeStandingQueryClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SubscribeStandingQueryRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNodeClient_SubscribeStandingQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeStandingQuery'
+type MockQueryNodeClient_SubscribeStandingQuery_Call struct {
+	*mock.Call
+}
+
+// SubscribeStandingQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.SubscribeStandingQueryRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryNodeClient_Expecter) SubscribeStandingQuery(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryNodeClient_SubscribeStandingQuery_Call {
+	return &MockQueryNodeClient_SubscribeStandingQuery_Call{Call: _e.mock.On("SubscribeStandingQuery",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryNodeClient_SubscribeStandingQuery_Call) Run(run func(ctx context.Context, in *querypb.SubscribeStandingQueryRequest, opts ...grpc.CallOption)) *MockQueryNodeClient_SubscribeStandingQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.SubscribeStandingQueryRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryNodeClient_SubscribeStandingQuery_Call) Return(_a0 querypb.QueryNode_SubscribeStandingQueryClient, _a1 error) *MockQueryNodeClient_SubscribeStandingQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryNodeClient_SubscribeStandingQuery_Call) RunAndReturn(run func(context.Context, *querypb.SubscribeStandingQueryRequest, ...grpc.CallOption) (querypb.QueryNode_SubscribeStandingQueryClient, error)) *MockQueryNodeClient_SubscribeStandingQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SwitchShardLeader provides a mock function with given fields: ctx, in, opts
+func (_m *MockQueryNodeClient) SwitchShardLeader(ctx context.Context, in *querypb.SwitchShardLeaderRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SwitchShardLeaderRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SwitchShardLeaderRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SwitchShardLeaderRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNodeClient_SwitchShardLeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwitchShardLeader'
+type MockQueryNodeClient_SwitchShardLeader_Call struct {
+	*mock.Call
+}
+
+// SwitchShardLeader is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *querypb.SwitchShardLeaderRequest
+//   - opts ...grpc.CallOption
+func (_e *MockQueryNodeClient_Expecter) SwitchShardLeader(ctx interface{}, in interface{}, opts ...interface{}) *MockQueryNodeClient_SwitchShardLeader_Call {
+	return &MockQueryNodeClient_SwitchShardLeader_Call{Call: _e.mock.On("SwitchShardLeader",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockQueryNodeClient_SwitchShardLeader_Call) Run(run func(ctx context.Context, in *querypb.SwitchShardLeaderRequest, opts ...grpc.CallOption)) *MockQueryNodeClient_SwitchShardLeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*querypb.SwitchShardLeaderRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockQueryNodeClient_SwitchShardLeader_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryNodeClient_SwitchShardLeader_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryNodeClient_SwitchShardLeader_Call) RunAndReturn(run func(context.Context, *querypb.SwitchShardLeaderRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockQueryNodeClient_SwitchShardLeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SyncDistribution provides a mock function with given fields: ctx, in, opts
 func (_m *MockQueryNodeClient) SyncDistribution(ctx context.Context, in *querypb.SyncDistributionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
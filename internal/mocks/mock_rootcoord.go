@@ -36,6 +36,61 @@ func (_m *RootCoord) EXPECT() *RootCoord_Expecter {
 	return &RootCoord_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) AddCollectionField(_a0 context.Context, _a1 *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AddCollectionFieldRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type RootCoord_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.AddCollectionFieldRequest
+func (_e *RootCoord_Expecter) AddCollectionField(_a0 interface{}, _a1 interface{}) *RootCoord_AddCollectionField_Call {
+	return &RootCoord_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField", _a0, _a1)}
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Run(run func(_a0 context.Context, _a1 *proxypb.AddCollectionFieldRequest)) *RootCoord_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.AddCollectionFieldRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_AddCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error)) *RootCoord_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) AllocID(_a0 context.Context, _a1 *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -256,6 +311,116 @@ func (_c *RootCoord_AlterCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AlterCollectionAsync provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) AlterCollectionAsync(_a0 context.Context, _a1 *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.DDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterCollectionRequest) *proxypb.DDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.AlterCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AlterCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterCollectionAsync'
+type RootCoord_AlterCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// AlterCollectionAsync is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.AlterCollectionRequest
+func (_e *RootCoord_Expecter) AlterCollectionAsync(_a0 interface{}, _a1 interface{}) *RootCoord_AlterCollectionAsync_Call {
+	return &RootCoord_AlterCollectionAsync_Call{Call: _e.mock.On("AlterCollectionAsync", _a0, _a1)}
+}
+
+func (_c *RootCoord_AlterCollectionAsync_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.AlterCollectionRequest)) *RootCoord_AlterCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.AlterCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AlterCollectionAsync_Call) Return(_a0 *proxypb.DDLJobResponse, _a1 error) *RootCoord_AlterCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_AlterCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error)) *RootCoord_AlterCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterDatabase provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) AlterDatabase(_a0 context.Context, _a1 *proxypb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AlterDatabaseRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AlterDatabaseRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AlterDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type RootCoord_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.AlterDatabaseRequest
+func (_e *RootCoord_Expecter) AlterDatabase(_a0 interface{}, _a1 interface{}) *RootCoord_AlterDatabase_Call {
+	return &RootCoord_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", _a0, _a1)}
+}
+
+func (_c *RootCoord_AlterDatabase_Call) Run(run func(_a0 context.Context, _a1 *proxypb.AlterDatabaseRequest)) *RootCoord_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.AlterDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AlterDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_AlterDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_AlterDatabase_Call) RunAndReturn(run func(context.Context, *proxypb.AlterDatabaseRequest) (*commonpb.Status, error)) *RootCoord_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -311,6 +476,61 @@ func (_c *RootCoord_CheckHealth_Call) RunAndReturn(run func(context.Context, *mi
 	return _c
 }
 
+// CreateAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) CreateAPIKey(_a0 context.Context, _a1 *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateAPIKeyRequest) *proxypb.APIKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.CreateAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type RootCoord_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.CreateAPIKeyRequest
+func (_e *RootCoord_Expecter) CreateAPIKey(_a0 interface{}, _a1 interface{}) *RootCoord_CreateAPIKey_Call {
+	return &RootCoord_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", _a0, _a1)}
+}
+
+func (_c *RootCoord_CreateAPIKey_Call) Run(run func(_a0 context.Context, _a1 *proxypb.CreateAPIKeyRequest)) *RootCoord_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.CreateAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_CreateAPIKey_Call) Return(_a0 *proxypb.APIKeyResponse, _a1 error) *RootCoord_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_CreateAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error)) *RootCoord_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAlias provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) CreateAlias(_a0 context.Context, _a1 *milvuspb.CreateAliasRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -861,6 +1081,61 @@ func (_c *RootCoord_DescribeCollectionInternal_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// DescribeDDLJob provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DescribeDDLJob(_a0 context.Context, _a1 *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.DescribeDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeDDLJobRequest) *proxypb.DescribeDDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DescribeDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DescribeDDLJobRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DescribeDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDDLJob'
+type RootCoord_DescribeDDLJob_Call struct {
+	*mock.Call
+}
+
+// DescribeDDLJob is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.DescribeDDLJobRequest
+func (_e *RootCoord_Expecter) DescribeDDLJob(_a0 interface{}, _a1 interface{}) *RootCoord_DescribeDDLJob_Call {
+	return &RootCoord_DescribeDDLJob_Call{Call: _e.mock.On("DescribeDDLJob", _a0, _a1)}
+}
+
+func (_c *RootCoord_DescribeDDLJob_Call) Run(run func(_a0 context.Context, _a1 *proxypb.DescribeDDLJobRequest)) *RootCoord_DescribeDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.DescribeDDLJobRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DescribeDDLJob_Call) Return(_a0 *proxypb.DescribeDDLJobResponse, _a1 error) *RootCoord_DescribeDDLJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DescribeDDLJob_Call) RunAndReturn(run func(context.Context, *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error)) *RootCoord_DescribeDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropAlias provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) DropAlias(_a0 context.Context, _a1 *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -971,6 +1246,61 @@ func (_c *RootCoord_DropCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DropCollectionAsync provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DropCollectionAsync(_a0 context.Context, _a1 *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.DDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest) *proxypb.DDLJobResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DropCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionAsync'
+type RootCoord_DropCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// DropCollectionAsync is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *milvuspb.DropCollectionRequest
+func (_e *RootCoord_Expecter) DropCollectionAsync(_a0 interface{}, _a1 interface{}) *RootCoord_DropCollectionAsync_Call {
+	return &RootCoord_DropCollectionAsync_Call{Call: _e.mock.On("DropCollectionAsync", _a0, _a1)}
+}
+
+func (_c *RootCoord_DropCollectionAsync_Call) Run(run func(_a0 context.Context, _a1 *milvuspb.DropCollectionRequest)) *RootCoord_DropCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*milvuspb.DropCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DropCollectionAsync_Call) Return(_a0 *proxypb.DDLJobResponse, _a1 error) *RootCoord_DropCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DropCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error)) *RootCoord_DropCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) DropDatabase(_a0 context.Context, _a1 *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1617,6 +1947,61 @@ func (_c *RootCoord_InvalidateCollectionMetaCache_Call) RunAndReturn(run func(co
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) ListAPIKeys(_a0 context.Context, _a1 *proxypb.ListAPIKeysRequest) (*proxypb.ListAPIKeysResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.ListAPIKeysResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListAPIKeysRequest) (*proxypb.ListAPIKeysResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListAPIKeysRequest) *proxypb.ListAPIKeysResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.ListAPIKeysResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ListAPIKeysRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type RootCoord_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.ListAPIKeysRequest
+func (_e *RootCoord_Expecter) ListAPIKeys(_a0 interface{}, _a1 interface{}) *RootCoord_ListAPIKeys_Call {
+	return &RootCoord_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys", _a0, _a1)}
+}
+
+func (_c *RootCoord_ListAPIKeys_Call) Run(run func(_a0 context.Context, _a1 *proxypb.ListAPIKeysRequest)) *RootCoord_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.ListAPIKeysRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_ListAPIKeys_Call) Return(_a0 *proxypb.ListAPIKeysResponse, _a1 error) *RootCoord_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *proxypb.ListAPIKeysRequest) (*proxypb.ListAPIKeysResponse, error)) *RootCoord_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAliases provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) ListAliases(_a0 context.Context, _a1 *milvuspb.ListAliasesRequest) (*milvuspb.ListAliasesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2043,6 +2428,116 @@ func (_c *RootCoord_RenameCollection_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// RevokeAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) RevokeAPIKey(_a0 context.Context, _a1 *proxypb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RevokeAPIKeyRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RevokeAPIKeyRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RevokeAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type RootCoord_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.RevokeAPIKeyRequest
+func (_e *RootCoord_Expecter) RevokeAPIKey(_a0 interface{}, _a1 interface{}) *RootCoord_RevokeAPIKey_Call {
+	return &RootCoord_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", _a0, _a1)}
+}
+
+func (_c *RootCoord_RevokeAPIKey_Call) Run(run func(_a0 context.Context, _a1 *proxypb.RevokeAPIKeyRequest)) *RootCoord_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.RevokeAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RevokeAPIKey_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_RevokeAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.RevokeAPIKeyRequest) (*commonpb.Status, error)) *RootCoord_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) RotateAPIKey(_a0 context.Context, _a1 *proxypb.RotateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *proxypb.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RotateAPIKeyRequest) (*proxypb.APIKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RotateAPIKeyRequest) *proxypb.APIKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RotateAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_RotateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateAPIKey'
+type RootCoord_RotateAPIKey_Call struct {
+	*mock.Call
+}
+
+// RotateAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.RotateAPIKeyRequest
+func (_e *RootCoord_Expecter) RotateAPIKey(_a0 interface{}, _a1 interface{}) *RootCoord_RotateAPIKey_Call {
+	return &RootCoord_RotateAPIKey_Call{Call: _e.mock.On("RotateAPIKey", _a0, _a1)}
+}
+
+func (_c *RootCoord_RotateAPIKey_Call) Run(run func(_a0 context.Context, _a1 *proxypb.RotateAPIKeyRequest)) *RootCoord_RotateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.RotateAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_RotateAPIKey_Call) Return(_a0 *proxypb.APIKeyResponse, _a1 error) *RootCoord_RotateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_RotateAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.RotateAPIKeyRequest) (*proxypb.APIKeyResponse, error)) *RootCoord_RotateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) SelectGrant(_a0 context.Context, _a1 *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2781,6 +3276,61 @@ func (_c *RootCoord_Stop_Call) RunAndReturn(run func() error) *RootCoord_Stop_Ca
 	return _c
 }
 
+// UndropCollection provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) UndropCollection(_a0 context.Context, _a1 *proxypb.UndropCollectionRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.UndropCollectionRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.UndropCollectionRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.UndropCollectionRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_UndropCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndropCollection'
+type RootCoord_UndropCollection_Call struct {
+	*mock.Call
+}
+
+// UndropCollection is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.UndropCollectionRequest
+func (_e *RootCoord_Expecter) UndropCollection(_a0 interface{}, _a1 interface{}) *RootCoord_UndropCollection_Call {
+	return &RootCoord_UndropCollection_Call{Call: _e.mock.On("UndropCollection", _a0, _a1)}
+}
+
+func (_c *RootCoord_UndropCollection_Call) Run(run func(_a0 context.Context, _a1 *proxypb.UndropCollectionRequest)) *RootCoord_UndropCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.UndropCollectionRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_UndropCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_UndropCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_UndropCollection_Call) RunAndReturn(run func(context.Context, *proxypb.UndropCollectionRequest) (*commonpb.Status, error)) *RootCoord_UndropCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelTimeTick provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) UpdateChannelTimeTick(_a0 context.Context, _a1 *internalpb.ChannelTimeTickMsg) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -2924,6 +3474,61 @@ func (_c *RootCoord_UpdateStateCode_Call) RunAndReturn(run func(commonpb.StateCo
 	return _c
 }
 
+// VerifyAPIKey provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) VerifyAPIKey(_a0 context.Context, _a1 *rootcoordpb.VerifyAPIKeyRequest) (*rootcoordpb.VerifyAPIKeyResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *rootcoordpb.VerifyAPIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.VerifyAPIKeyRequest) (*rootcoordpb.VerifyAPIKeyResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.VerifyAPIKeyRequest) *rootcoordpb.VerifyAPIKeyResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.VerifyAPIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.VerifyAPIKeyRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_VerifyAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyAPIKey'
+type RootCoord_VerifyAPIKey_Call struct {
+	*mock.Call
+}
+
+// VerifyAPIKey is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *rootcoordpb.VerifyAPIKeyRequest
+func (_e *RootCoord_Expecter) VerifyAPIKey(_a0 interface{}, _a1 interface{}) *RootCoord_VerifyAPIKey_Call {
+	return &RootCoord_VerifyAPIKey_Call{Call: _e.mock.On("VerifyAPIKey", _a0, _a1)}
+}
+
+func (_c *RootCoord_VerifyAPIKey_Call) Run(run func(_a0 context.Context, _a1 *rootcoordpb.VerifyAPIKeyRequest)) *RootCoord_VerifyAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*rootcoordpb.VerifyAPIKeyRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_VerifyAPIKey_Call) Return(_a0 *rootcoordpb.VerifyAPIKeyResponse, _a1 error) *RootCoord_VerifyAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_VerifyAPIKey_Call) RunAndReturn(run func(context.Context, *rootcoordpb.VerifyAPIKeyRequest) (*rootcoordpb.VerifyAPIKeyResponse, error)) *RootCoord_VerifyAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewRootCoord creates a new instance of RootCoord. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewRootCoord(t interface {
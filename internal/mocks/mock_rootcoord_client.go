@@ -33,6 +33,76 @@ func (_m *MockRootCoordClient) EXPECT() *MockRootCoordClient_Expecter {
 	return &MockRootCoordClient_Expecter{mock: &_m.Mock}
 }
 
+// AddCollectionField provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) AddCollectionField(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_AddCollectionField_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCollectionField'
+type MockRootCoordClient_AddCollectionField_Call struct {
+	*mock.Call
+}
+
+// AddCollectionField is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.AddCollectionFieldRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) AddCollectionField(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_AddCollectionField_Call {
+	return &MockRootCoordClient_AddCollectionField_Call{Call: _e.mock.On("AddCollectionField",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) Run(run func(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption)) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.AddCollectionFieldRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_AddCollectionField_Call) RunAndReturn(run func(context.Context, *proxypb.AddCollectionFieldRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_AddCollectionField_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AllocID provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) AllocID(ctx context.Context, in *rootcoordpb.AllocIDRequest, opts ...grpc.CallOption) (*rootcoordpb.AllocIDResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -313,6 +383,146 @@ func (_c *MockRootCoordClient_AlterCollection_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// AlterCollectionAsync provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) AlterCollectionAsync(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.DDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterCollectionRequest, ...grpc.CallOption) (*proxypb.DDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.AlterCollectionRequest, ...grpc.CallOption) *proxypb.DDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.AlterCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_AlterCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterCollectionAsync'
+type MockRootCoordClient_AlterCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// AlterCollectionAsync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.AlterCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) AlterCollectionAsync(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_AlterCollectionAsync_Call {
+	return &MockRootCoordClient_AlterCollectionAsync_Call{Call: _e.mock.On("AlterCollectionAsync",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_AlterCollectionAsync_Call) Run(run func(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_AlterCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.AlterCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_AlterCollectionAsync_Call) Return(_a0 *proxypb.DDLJobResponse, _a1 error) *MockRootCoordClient_AlterCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_AlterCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.AlterCollectionRequest, ...grpc.CallOption) (*proxypb.DDLJobResponse, error)) *MockRootCoordClient_AlterCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterDatabase provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) AlterDatabase(ctx context.Context, in *proxypb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AlterDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.AlterDatabaseRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.AlterDatabaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type MockRootCoordClient_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.AlterDatabaseRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) AlterDatabase(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_AlterDatabase_Call {
+	return &MockRootCoordClient_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_AlterDatabase_Call) Run(run func(ctx context.Context, in *proxypb.AlterDatabaseRequest, opts ...grpc.CallOption)) *MockRootCoordClient_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.AlterDatabaseRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_AlterDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_AlterDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_AlterDatabase_Call) RunAndReturn(run func(context.Context, *proxypb.AlterDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -424,6 +634,76 @@ func (_c *MockRootCoordClient_Close_Call) RunAndReturn(run func() error) *MockRo
 	return _c
 }
 
+// CreateAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) CreateAPIKey(ctx context.Context, in *proxypb.CreateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateAPIKeyRequest, ...grpc.CallOption) (*proxypb.APIKeyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.CreateAPIKeyRequest, ...grpc.CallOption) *proxypb.APIKeyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.CreateAPIKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type MockRootCoordClient_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.CreateAPIKeyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) CreateAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_CreateAPIKey_Call {
+	return &MockRootCoordClient_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_CreateAPIKey_Call) Run(run func(ctx context.Context, in *proxypb.CreateAPIKeyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.CreateAPIKeyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_CreateAPIKey_Call) Return(_a0 *proxypb.APIKeyResponse, _a1 error) *MockRootCoordClient_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_CreateAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.CreateAPIKeyRequest, ...grpc.CallOption) (*proxypb.APIKeyResponse, error)) *MockRootCoordClient_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAlias provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1124,6 +1404,76 @@ func (_c *MockRootCoordClient_DescribeCollectionInternal_Call) RunAndReturn(run
 	return _c
 }
 
+// DescribeDDLJob provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DescribeDDLJob(ctx context.Context, in *proxypb.DescribeDDLJobRequest, opts ...grpc.CallOption) (*proxypb.DescribeDDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.DescribeDDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeDDLJobRequest, ...grpc.CallOption) (*proxypb.DescribeDDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.DescribeDDLJobRequest, ...grpc.CallOption) *proxypb.DescribeDDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DescribeDDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.DescribeDDLJobRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DescribeDDLJob_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDDLJob'
+type MockRootCoordClient_DescribeDDLJob_Call struct {
+	*mock.Call
+}
+
+// DescribeDDLJob is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.DescribeDDLJobRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DescribeDDLJob(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DescribeDDLJob_Call {
+	return &MockRootCoordClient_DescribeDDLJob_Call{Call: _e.mock.On("DescribeDDLJob",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DescribeDDLJob_Call) Run(run func(ctx context.Context, in *proxypb.DescribeDDLJobRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DescribeDDLJob_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.DescribeDDLJobRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DescribeDDLJob_Call) Return(_a0 *proxypb.DescribeDDLJobResponse, _a1 error) *MockRootCoordClient_DescribeDDLJob_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DescribeDDLJob_Call) RunAndReturn(run func(context.Context, *proxypb.DescribeDDLJobRequest, ...grpc.CallOption) (*proxypb.DescribeDDLJobResponse, error)) *MockRootCoordClient_DescribeDDLJob_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropAlias provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1264,6 +1614,76 @@ func (_c *MockRootCoordClient_DropCollection_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// DropCollectionAsync provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) DropCollectionAsync(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.DDLJobResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*proxypb.DDLJobResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) *proxypb.DDLJobResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.DDLJobResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_DropCollectionAsync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropCollectionAsync'
+type MockRootCoordClient_DropCollectionAsync_Call struct {
+	*mock.Call
+}
+
+// DropCollectionAsync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.DropCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) DropCollectionAsync(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_DropCollectionAsync_Call {
+	return &MockRootCoordClient_DropCollectionAsync_Call{Call: _e.mock.On("DropCollectionAsync",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_DropCollectionAsync_Call) Run(run func(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_DropCollectionAsync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.DropCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropCollectionAsync_Call) Return(_a0 *proxypb.DDLJobResponse, _a1 error) *MockRootCoordClient_DropCollectionAsync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_DropCollectionAsync_Call) RunAndReturn(run func(context.Context, *milvuspb.DropCollectionRequest, ...grpc.CallOption) (*proxypb.DDLJobResponse, error)) *MockRootCoordClient_DropCollectionAsync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropDatabase provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1918,7 +2338,77 @@ func (_m *MockRootCoordClient) HasPartition(ctx context.Context, in *milvuspb.Ha
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_HasPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasPartition'
+type MockRootCoordClient_HasPartition_Call struct {
+	*mock.Call
+}
+
+// HasPartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *milvuspb.HasPartitionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) HasPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_HasPartition_Call {
+	return &MockRootCoordClient_HasPartition_Call{Call: _e.mock.On("HasPartition",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_HasPartition_Call) Run(run func(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_HasPartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*milvuspb.HasPartitionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_HasPartition_Call) Return(_a0 *milvuspb.BoolResponse, _a1 error) *MockRootCoordClient_HasPartition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_HasPartition_Call) RunAndReturn(run func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)) *MockRootCoordClient_HasPartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InvalidateCollectionMetaCache provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) InvalidateCollectionMetaCache(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1927,21 +2417,21 @@ func (_m *MockRootCoordClient) HasPartition(ctx context.Context, in *milvuspb.Ha
 	return r0, r1
 }
 
-// MockRootCoordClient_HasPartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'HasPartition'
-type MockRootCoordClient_HasPartition_Call struct {
+// MockRootCoordClient_InvalidateCollectionMetaCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateCollectionMetaCache'
+type MockRootCoordClient_InvalidateCollectionMetaCache_Call struct {
 	*mock.Call
 }
 
-// HasPartition is a helper method to define mock.On call
+// InvalidateCollectionMetaCache is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *milvuspb.HasPartitionRequest
+//   - in *proxypb.InvalidateCollMetaCacheRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) HasPartition(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_HasPartition_Call {
-	return &MockRootCoordClient_HasPartition_Call{Call: _e.mock.On("HasPartition",
+func (_e *MockRootCoordClient_Expecter) InvalidateCollectionMetaCache(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
+	return &MockRootCoordClient_InvalidateCollectionMetaCache_Call{Call: _e.mock.On("InvalidateCollectionMetaCache",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_HasPartition_Call) Run(run func(ctx context.Context, in *milvuspb.HasPartitionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_HasPartition_Call {
+func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) Run(run func(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption)) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -1949,23 +2439,23 @@ func (_c *MockRootCoordClient_HasPartition_Call) Run(run func(ctx context.Contex
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*milvuspb.HasPartitionRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.InvalidateCollMetaCacheRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_HasPartition_Call) Return(_a0 *milvuspb.BoolResponse, _a1 error) *MockRootCoordClient_HasPartition_Call {
+func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_HasPartition_Call) RunAndReturn(run func(context.Context, *milvuspb.HasPartitionRequest, ...grpc.CallOption) (*milvuspb.BoolResponse, error)) *MockRootCoordClient_HasPartition_Call {
+func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) RunAndReturn(run func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// InvalidateCollectionMetaCache provides a mock function with given fields: ctx, in, opts
-func (_m *MockRootCoordClient) InvalidateCollectionMetaCache(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+// ListAPIKeys provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) ListAPIKeys(ctx context.Context, in *proxypb.ListAPIKeysRequest, opts ...grpc.CallOption) (*proxypb.ListAPIKeysResponse, error) {
 	_va := make([]interface{}, len(opts))
 	for _i := range opts {
 		_va[_i] = opts[_i]
@@ -1975,20 +2465,20 @@ func (_m *MockRootCoordClient) InvalidateCollectionMetaCache(ctx context.Context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *commonpb.Status
+	var r0 *proxypb.ListAPIKeysResponse
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListAPIKeysRequest, ...grpc.CallOption) (*proxypb.ListAPIKeysResponse, error)); ok {
 		return rf(ctx, in, opts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) *commonpb.Status); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.ListAPIKeysRequest, ...grpc.CallOption) *proxypb.ListAPIKeysResponse); ok {
 		r0 = rf(ctx, in, opts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*commonpb.Status)
+			r0 = ret.Get(0).(*proxypb.ListAPIKeysResponse)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.ListAPIKeysRequest, ...grpc.CallOption) error); ok {
 		r1 = rf(ctx, in, opts...)
 	} else {
 		r1 = ret.Error(1)
@@ -1997,21 +2487,21 @@ func (_m *MockRootCoordClient) InvalidateCollectionMetaCache(ctx context.Context
 	return r0, r1
 }
 
-// MockRootCoordClient_InvalidateCollectionMetaCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateCollectionMetaCache'
-type MockRootCoordClient_InvalidateCollectionMetaCache_Call struct {
+// MockRootCoordClient_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type MockRootCoordClient_ListAPIKeys_Call struct {
 	*mock.Call
 }
 
-// InvalidateCollectionMetaCache is a helper method to define mock.On call
+// ListAPIKeys is a helper method to define mock.On call
 //   - ctx context.Context
-//   - in *proxypb.InvalidateCollMetaCacheRequest
+//   - in *proxypb.ListAPIKeysRequest
 //   - opts ...grpc.CallOption
-func (_e *MockRootCoordClient_Expecter) InvalidateCollectionMetaCache(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
-	return &MockRootCoordClient_InvalidateCollectionMetaCache_Call{Call: _e.mock.On("InvalidateCollectionMetaCache",
+func (_e *MockRootCoordClient_Expecter) ListAPIKeys(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_ListAPIKeys_Call {
+	return &MockRootCoordClient_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys",
 		append([]interface{}{ctx, in}, opts...)...)}
 }
 
-func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) Run(run func(ctx context.Context, in *proxypb.InvalidateCollMetaCacheRequest, opts ...grpc.CallOption)) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
+func (_c *MockRootCoordClient_ListAPIKeys_Call) Run(run func(ctx context.Context, in *proxypb.ListAPIKeysRequest, opts ...grpc.CallOption)) *MockRootCoordClient_ListAPIKeys_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		variadicArgs := make([]grpc.CallOption, len(args)-2)
 		for i, a := range args[2:] {
@@ -2019,17 +2509,17 @@ func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) Run(run func(c
 				variadicArgs[i] = a.(grpc.CallOption)
 			}
 		}
-		run(args[0].(context.Context), args[1].(*proxypb.InvalidateCollMetaCacheRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(*proxypb.ListAPIKeysRequest), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
+func (_c *MockRootCoordClient_ListAPIKeys_Call) Return(_a0 *proxypb.ListAPIKeysResponse, _a1 error) *MockRootCoordClient_ListAPIKeys_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *MockRootCoordClient_InvalidateCollectionMetaCache_Call) RunAndReturn(run func(context.Context, *proxypb.InvalidateCollMetaCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_InvalidateCollectionMetaCache_Call {
+func (_c *MockRootCoordClient_ListAPIKeys_Call) RunAndReturn(run func(context.Context, *proxypb.ListAPIKeysRequest, ...grpc.CallOption) (*proxypb.ListAPIKeysResponse, error)) *MockRootCoordClient_ListAPIKeys_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -2524,6 +3014,146 @@ func (_c *MockRootCoordClient_RenameCollection_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// RevokeAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) RevokeAPIKey(ctx context.Context, in *proxypb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RevokeAPIKeyRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RevokeAPIKeyRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RevokeAPIKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type MockRootCoordClient_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.RevokeAPIKeyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) RevokeAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_RevokeAPIKey_Call {
+	return &MockRootCoordClient_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_RevokeAPIKey_Call) Run(run func(ctx context.Context, in *proxypb.RevokeAPIKeyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.RevokeAPIKeyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_RevokeAPIKey_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_RevokeAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_RevokeAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.RevokeAPIKeyRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RotateAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) RotateAPIKey(ctx context.Context, in *proxypb.RotateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *proxypb.APIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RotateAPIKeyRequest, ...grpc.CallOption) (*proxypb.APIKeyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.RotateAPIKeyRequest, ...grpc.CallOption) *proxypb.APIKeyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.APIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.RotateAPIKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_RotateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RotateAPIKey'
+type MockRootCoordClient_RotateAPIKey_Call struct {
+	*mock.Call
+}
+
+// RotateAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.RotateAPIKeyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) RotateAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_RotateAPIKey_Call {
+	return &MockRootCoordClient_RotateAPIKey_Call{Call: _e.mock.On("RotateAPIKey",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_RotateAPIKey_Call) Run(run func(ctx context.Context, in *proxypb.RotateAPIKeyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_RotateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.RotateAPIKeyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_RotateAPIKey_Call) Return(_a0 *proxypb.APIKeyResponse, _a1 error) *MockRootCoordClient_RotateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_RotateAPIKey_Call) RunAndReturn(run func(context.Context, *proxypb.RotateAPIKeyRequest, ...grpc.CallOption) (*proxypb.APIKeyResponse, error)) *MockRootCoordClient_RotateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) SelectGrant(ctx context.Context, in *milvuspb.SelectGrantRequest, opts ...grpc.CallOption) (*milvuspb.SelectGrantResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -3084,6 +3714,76 @@ func (_c *MockRootCoordClient_ShowSegments_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// UndropCollection provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) UndropCollection(ctx context.Context, in *proxypb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.UndropCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.UndropCollectionRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.UndropCollectionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_UndropCollection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UndropCollection'
+type MockRootCoordClient_UndropCollection_Call struct {
+	*mock.Call
+}
+
+// UndropCollection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.UndropCollectionRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) UndropCollection(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_UndropCollection_Call {
+	return &MockRootCoordClient_UndropCollection_Call{Call: _e.mock.On("UndropCollection",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_UndropCollection_Call) Run(run func(ctx context.Context, in *proxypb.UndropCollectionRequest, opts ...grpc.CallOption)) *MockRootCoordClient_UndropCollection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.UndropCollectionRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_UndropCollection_Call) Return(_a0 *commonpb.Status, _a1 error) *MockRootCoordClient_UndropCollection_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_UndropCollection_Call) RunAndReturn(run func(context.Context, *proxypb.UndropCollectionRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockRootCoordClient_UndropCollection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateChannelTimeTick provides a mock function with given fields: ctx, in, opts
 func (_m *MockRootCoordClient) UpdateChannelTimeTick(ctx context.Context, in *internalpb.ChannelTimeTickMsg, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -3224,6 +3924,76 @@ func (_c *MockRootCoordClient_UpdateCredential_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// VerifyAPIKey provides a mock function with given fields: ctx, in, opts
+func (_m *MockRootCoordClient) VerifyAPIKey(ctx context.Context, in *rootcoordpb.VerifyAPIKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.VerifyAPIKeyResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *rootcoordpb.VerifyAPIKeyResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.VerifyAPIKeyRequest, ...grpc.CallOption) (*rootcoordpb.VerifyAPIKeyResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *rootcoordpb.VerifyAPIKeyRequest, ...grpc.CallOption) *rootcoordpb.VerifyAPIKeyResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rootcoordpb.VerifyAPIKeyResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *rootcoordpb.VerifyAPIKeyRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRootCoordClient_VerifyAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyAPIKey'
+type MockRootCoordClient_VerifyAPIKey_Call struct {
+	*mock.Call
+}
+
+// VerifyAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *rootcoordpb.VerifyAPIKeyRequest
+//   - opts ...grpc.CallOption
+func (_e *MockRootCoordClient_Expecter) VerifyAPIKey(ctx interface{}, in interface{}, opts ...interface{}) *MockRootCoordClient_VerifyAPIKey_Call {
+	return &MockRootCoordClient_VerifyAPIKey_Call{Call: _e.mock.On("VerifyAPIKey",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockRootCoordClient_VerifyAPIKey_Call) Run(run func(ctx context.Context, in *rootcoordpb.VerifyAPIKeyRequest, opts ...grpc.CallOption)) *MockRootCoordClient_VerifyAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*rootcoordpb.VerifyAPIKeyRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockRootCoordClient_VerifyAPIKey_Call) Return(_a0 *rootcoordpb.VerifyAPIKeyResponse, _a1 error) *MockRootCoordClient_VerifyAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRootCoordClient_VerifyAPIKey_Call) RunAndReturn(run func(context.Context, *rootcoordpb.VerifyAPIKeyRequest, ...grpc.CallOption) (*rootcoordpb.VerifyAPIKeyResponse, error)) *MockRootCoordClient_VerifyAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRootCoordClient creates a new instance of MockRootCoordClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRootCoordClient(t interface {
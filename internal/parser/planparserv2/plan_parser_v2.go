@@ -93,15 +93,18 @@ func CreateRetrievePlan(schema *typeutil.SchemaHelper, exprStr string) (*planpb.
 	if err != nil {
 		return nil, err
 	}
+	return CreateRetrievePlanByExpr(expr), nil
+}
 
-	planNode := &planpb.PlanNode{
+// CreateRetrievePlanByExpr creates the retrieve plan with the parsed expression, such as the bound prepared expression.
+func CreateRetrievePlanByExpr(expr *planpb.Expr) *planpb.PlanNode {
+	return &planpb.PlanNode{
 		Node: &planpb.PlanNode_Query{
 			Query: &planpb.QueryPlanNode{
 				Predicates: expr,
 			},
 		},
 	}
-	return planNode, nil
 }
 
 func CreateSearchPlan(schema *typeutil.SchemaHelper, exprStr string, vectorFieldName string, queryInfo *planpb.QueryInfo) (*planpb.PlanNode, error) {
@@ -117,6 +120,11 @@ func CreateSearchPlan(schema *typeutil.SchemaHelper, exprStr string, vectorField
 		log.Info("CreateSearchPlan failed", zap.Error(err))
 		return nil, err
 	}
+	return CreateSearchPlanByExpr(schema, expr, vectorFieldName, queryInfo)
+}
+
+// CreateSearchPlanByExpr creates the search plan with the parsed expression, the expr could be nil if no filter.
+func CreateSearchPlanByExpr(schema *typeutil.SchemaHelper, expr *planpb.Expr, vectorFieldName string, queryInfo *planpb.QueryInfo) (*planpb.PlanNode, error) {
 	vectorField, err := schema.GetFieldFromName(vectorFieldName)
 	if err != nil {
 		log.Info("CreateSearchPlan failed", zap.Error(err))
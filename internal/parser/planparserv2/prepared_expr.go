@@ -0,0 +1,497 @@
+package planparserv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// The placeholders of the prepared expression are parsed as the sentinel values first,
+// then located in the parsed expression and replaced by the values of each request.
+// The sentinel ints are exactly representable as float, so that they are still located once casted to float.
+const (
+	sentinelIntBase    int64 = 1 << 52
+	sentinelFloatBase  int64 = 1 << 40
+	sentinelStringBase       = "\x00expr_placeholder_"
+)
+
+var (
+	placeholderPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	likePattern        = regexp.MustCompile(`(?i)\blike\s*$`)
+)
+
+type exprParam struct {
+	isList bool
+	value  *planpb.GenericValue
+	values []*planpb.GenericValue
+}
+
+// kind returns the value type of the param, the elements type for the list.
+func (p *exprParam) kind() string {
+	if p.isList {
+		if len(p.values) == 0 {
+			return ""
+		}
+		return valueKind(p.values[0])
+	}
+	return valueKind(p.value)
+}
+
+// baked returns whether the param can't be represented by a sentinel value,
+// such params are parsed with their literal values and become a part of the signature.
+func (p *exprParam) baked() bool {
+	kind := p.kind()
+	return kind == "" || kind == "bool"
+}
+
+func (p *exprParam) signature() string {
+	var b strings.Builder
+	if p.isList {
+		b.WriteString("list:")
+	}
+	b.WriteString(p.kind())
+	if p.baked() {
+		b.WriteString("=")
+		b.WriteString(p.literal())
+	}
+	return b.String()
+}
+
+func (p *exprParam) literal() string {
+	if !p.isList {
+		return formatValue(p.value)
+	}
+	literals := make([]string, 0, len(p.values))
+	for _, value := range p.values {
+		literals = append(literals, formatValue(value))
+	}
+	return "[" + strings.Join(literals, ", ") + "]"
+}
+
+// ExprParams are the values bound to the placeholders of an expression template.
+type ExprParams map[string]*exprParam
+
+// ParseExprParams parses the params from a json object, the values could be bool, number, string or list of them.
+func ParseExprParams(data string) (ExprParams, error) {
+	decoder := json.NewDecoder(strings.NewReader(data))
+	decoder.UseNumber()
+	raw := make(map[string]interface{})
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid expression params: %s", err)
+	}
+	params := make(ExprParams, len(raw))
+	for name, value := range raw {
+		param, err := newExprParam(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of expression param %s: %s", name, err)
+		}
+		params[name] = param
+	}
+	return params, nil
+}
+
+func newExprParam(value interface{}) (*exprParam, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		v, err := toGenericValue(value)
+		if err != nil {
+			return nil, err
+		}
+		return &exprParam{value: v}, nil
+	}
+
+	values := make([]*planpb.GenericValue, 0, len(list))
+	kinds := typeutil.NewSet[string]()
+	for _, elem := range list {
+		v, err := toGenericValue(elem)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+		kinds.Insert(valueKind(v))
+	}
+	// the ints are promoted to floats in the list mixed by ints and floats
+	if kinds.Len() == 2 && kinds.Contain("int", "float") {
+		for i, v := range values {
+			if IsInteger(v) {
+				values[i] = NewFloat(float64(v.GetInt64Val()))
+			}
+		}
+	} else if kinds.Len() > 1 {
+		return nil, fmt.Errorf("the elements of list should be of the same type")
+	}
+	return &exprParam{isList: true, values: values}, nil
+}
+
+func toGenericValue(value interface{}) (*planpb.GenericValue, error) {
+	switch v := value.(type) {
+	case bool:
+		return NewBool(v), nil
+	case string:
+		return NewString(v), nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return NewInt(i), nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return NewFloat(f), nil
+	}
+	return nil, fmt.Errorf("unsupported value %v", value)
+}
+
+func valueKind(value *planpb.GenericValue) string {
+	switch value.GetVal().(type) {
+	case *planpb.GenericValue_BoolVal:
+		return "bool"
+	case *planpb.GenericValue_Int64Val:
+		return "int"
+	case *planpb.GenericValue_FloatVal:
+		return "float"
+	case *planpb.GenericValue_StringVal:
+		return "string"
+	}
+	return ""
+}
+
+func formatValue(value *planpb.GenericValue) string {
+	switch v := value.GetVal().(type) {
+	case *planpb.GenericValue_BoolVal:
+		return strconv.FormatBool(v.BoolVal)
+	case *planpb.GenericValue_Int64Val:
+		if v.Int64Val < 0 {
+			return "(" + strconv.FormatInt(v.Int64Val, 10) + ")"
+		}
+		return strconv.FormatInt(v.Int64Val, 10)
+	case *planpb.GenericValue_FloatVal:
+		literal := strconv.FormatFloat(v.FloatVal, 'g', -1, 64)
+		if !strings.ContainsAny(literal, ".eE") {
+			literal += ".0"
+		}
+		if v.FloatVal < 0 {
+			return "(" + literal + ")"
+		}
+		return literal
+	case *planpb.GenericValue_StringVal:
+		return strconv.Quote(v.StringVal)
+	}
+	return ""
+}
+
+// Signature identifies the types of the params, the expression prepared with the params
+// could only be bound to the params of the same signature.
+func (params ExprParams) Signature() string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	signatures := make([]string, 0, len(names))
+	for _, name := range names {
+		signatures = append(signatures, name+":"+params[name].signature())
+	}
+	return strings.Join(signatures, ";")
+}
+
+// splitExprTemplate splits the template into the literal fragments and the placeholder names between them,
+// the braces inside the string literals are not placeholders.
+func splitExprTemplate(template string) ([]string, []string, error) {
+	fragments := make([]string, 0)
+	names := make([]string, 0)
+	var quote byte
+	start := 0
+	for i := 0; i < len(template); i++ {
+		c := template[i]
+		switch {
+		case quote != 0:
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '{':
+			end := strings.IndexByte(template[i:], '}')
+			if end < 0 {
+				return nil, nil, fmt.Errorf("unclosed placeholder in expression: %s", template)
+			}
+			name := strings.TrimSpace(template[i+1 : i+end])
+			if !placeholderPattern.MatchString(name) {
+				return nil, nil, fmt.Errorf("invalid placeholder {%s} in expression: %s", name, template)
+			}
+			fragments = append(fragments, template[start:i])
+			names = append(names, name)
+			i += end
+			start = i + 1
+		}
+	}
+	fragments = append(fragments, template[start:])
+	return fragments, names, nil
+}
+
+// PreparedExpr is an expression template parsed once and bound to the params of each request,
+// the placeholders in the template are written as {name}.
+type PreparedExpr struct {
+	schema    *typeutil.SchemaHelper
+	fragments []string
+	// refs are the indexes in names of the placeholders in the template
+	refs     []int
+	names    []string
+	expected []int
+	// expr is parsed with the sentinel values, it's nil if some placeholders can't be located in it,
+	// e.g. the like patterns or the folded constants, then the template is parsed with the values every time.
+	expr      *planpb.Expr
+	signature string
+}
+
+// PrepareExpr parses the expression template with the types of the params,
+// the prepared expression could be bound to any params of the same signature.
+func PrepareExpr(schema *typeutil.SchemaHelper, template string, params ExprParams) (*PreparedExpr, error) {
+	fragments, refNames, err := splitExprTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	p := &PreparedExpr{
+		schema:    schema,
+		fragments: fragments,
+		signature: params.Signature(),
+	}
+	indexes := make(map[string]int)
+	literalOnly := false
+	for i, name := range refNames {
+		param, ok := params[name]
+		if !ok {
+			return nil, fmt.Errorf("the value of placeholder {%s} not provided", name)
+		}
+		idx, ok := indexes[name]
+		if !ok {
+			idx = len(p.names)
+			indexes[name] = idx
+			p.names = append(p.names, name)
+			p.expected = append(p.expected, 0)
+		}
+		p.refs = append(p.refs, idx)
+		if !param.baked() {
+			p.expected[idx]++
+		}
+		if likePattern.MatchString(fragments[i]) {
+			literalOnly = true
+		}
+	}
+	for name := range params {
+		if _, ok := indexes[name]; !ok {
+			return nil, fmt.Errorf("placeholder {%s} not found in expression: %s", name, template)
+		}
+	}
+
+	if !literalOnly {
+		expr, err := ParseExpr(schema, p.render(params, true))
+		if err == nil {
+			p.expr = expr
+			if _, err = p.Bind(params); err == nil {
+				return p, nil
+			}
+		}
+		p.expr = nil
+	}
+	if _, err := ParseExpr(schema, p.render(params, false)); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// render renders the template with the literal values, or the sentinel values if possible.
+func (p *PreparedExpr) render(params ExprParams, sentinel bool) string {
+	var b strings.Builder
+	for i, idx := range p.refs {
+		b.WriteString(p.fragments[i])
+		param := params[p.names[idx]]
+		switch {
+		case !sentinel || param.baked():
+			b.WriteString(param.literal())
+		case param.isList:
+			b.WriteString("[" + sentinelLiteral(idx, param.kind()) + "]")
+		default:
+			b.WriteString(sentinelLiteral(idx, param.kind()))
+		}
+	}
+	b.WriteString(p.fragments[len(p.fragments)-1])
+	return b.String()
+}
+
+func sentinelLiteral(idx int, kind string) string {
+	switch kind {
+	case "int":
+		return formatValue(NewInt(sentinelIntBase + int64(idx)))
+	case "float":
+		return formatValue(NewFloat(float64(sentinelFloatBase+int64(idx)) + 0.5))
+	default:
+		return formatValue(NewString(sentinelStringBase + strconv.Itoa(idx)))
+	}
+}
+
+// Bind returns the expression with the placeholders replaced by the params.
+func (p *PreparedExpr) Bind(params ExprParams) (*planpb.Expr, error) {
+	if params.Signature() != p.signature {
+		return nil, fmt.Errorf("the expression params mismatch with the prepared ones, expected: %s, actual: %s", p.signature, params.Signature())
+	}
+	if p.expr == nil {
+		return ParseExpr(p.schema, p.render(params, false))
+	}
+
+	binder := &exprBinder{
+		params: make([]*exprParam, len(p.names)),
+		counts: make([]int, len(p.names)),
+	}
+	for i, name := range p.names {
+		binder.params[i] = params[name]
+	}
+	expr := proto.Clone(p.expr).(*planpb.Expr)
+	binder.bindExpr(expr)
+	if binder.err != nil {
+		return nil, binder.err
+	}
+	for i, count := range binder.counts {
+		if count != p.expected[i] {
+			return nil, fmt.Errorf("placeholder {%s} can't be bound", p.names[i])
+		}
+	}
+	return expr, nil
+}
+
+// exprBinder replaces the sentinel values in the expression by the params.
+type exprBinder struct {
+	params []*exprParam
+	counts []int
+	err    error
+}
+
+func (b *exprBinder) bindExpr(expr *planpb.Expr) {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		e.TermExpr.Values = b.bindValues(e.TermExpr.GetValues())
+	case *planpb.Expr_UnaryExpr:
+		b.bindExpr(e.UnaryExpr.GetChild())
+	case *planpb.Expr_BinaryExpr:
+		b.bindExpr(e.BinaryExpr.GetLeft())
+		b.bindExpr(e.BinaryExpr.GetRight())
+	case *planpb.Expr_UnaryRangeExpr:
+		e.UnaryRangeExpr.Value = b.bindValue(e.UnaryRangeExpr.GetValue())
+	case *planpb.Expr_BinaryRangeExpr:
+		e.BinaryRangeExpr.LowerValue = b.bindValue(e.BinaryRangeExpr.GetLowerValue())
+		e.BinaryRangeExpr.UpperValue = b.bindValue(e.BinaryRangeExpr.GetUpperValue())
+	case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+		e.BinaryArithOpEvalRangeExpr.RightOperand = b.bindValue(e.BinaryArithOpEvalRangeExpr.GetRightOperand())
+		e.BinaryArithOpEvalRangeExpr.Value = b.bindValue(e.BinaryArithOpEvalRangeExpr.GetValue())
+		b.checkDivisor(e.BinaryArithOpEvalRangeExpr.GetArithOp(), e.BinaryArithOpEvalRangeExpr.GetRightOperand())
+	case *planpb.Expr_BinaryArithExpr:
+		b.bindExpr(e.BinaryArithExpr.GetLeft())
+		b.bindExpr(e.BinaryArithExpr.GetRight())
+	case *planpb.Expr_ValueExpr:
+		e.ValueExpr.Value = b.bindValue(e.ValueExpr.GetValue())
+	case *planpb.Expr_JsonContainsExpr:
+		e.JsonContainsExpr.Elements = b.bindValues(e.JsonContainsExpr.GetElements())
+	}
+}
+
+func (b *exprBinder) bindValue(value *planpb.GenericValue) *planpb.GenericValue {
+	if array := value.GetArrayVal(); array != nil {
+		array.Array = b.bindValues(array.GetArray())
+		return value
+	}
+	idx, ok := b.match(value)
+	if !ok {
+		return value
+	}
+	if b.params[idx].isList {
+		b.err = fmt.Errorf("list placeholder can't be used as a single value")
+		return value
+	}
+	b.counts[idx]++
+	return b.convert(value, b.params[idx].value)
+}
+
+// bindValues replaces the list with the single sentinel value by the list param.
+func (b *exprBinder) bindValues(values []*planpb.GenericValue) []*planpb.GenericValue {
+	if len(values) == 1 {
+		if idx, ok := b.match(values[0]); ok && b.params[idx].isList {
+			b.counts[idx]++
+			bound := make([]*planpb.GenericValue, 0, len(b.params[idx].values))
+			for _, value := range b.params[idx].values {
+				bound = append(bound, b.convert(values[0], value))
+			}
+			return bound
+		}
+	}
+	for i := range values {
+		values[i] = b.bindValue(values[i])
+	}
+	return values
+}
+
+// match returns the index of the placeholder if the value is a sentinel.
+func (b *exprBinder) match(value *planpb.GenericValue) (int, bool) {
+	var idx int64
+	var kind string
+	switch v := value.GetVal().(type) {
+	case *planpb.GenericValue_Int64Val:
+		idx, kind = v.Int64Val-sentinelIntBase, "int"
+	case *planpb.GenericValue_FloatVal:
+		if v.FloatVal == math.Trunc(v.FloatVal) {
+			idx, kind = int64(v.FloatVal)-sentinelIntBase, "int"
+		} else if v.FloatVal-0.5 == math.Trunc(v.FloatVal) {
+			idx, kind = int64(v.FloatVal)-sentinelFloatBase, "float"
+		} else {
+			return 0, false
+		}
+	case *planpb.GenericValue_StringVal:
+		suffix, ok := strings.CutPrefix(v.StringVal, sentinelStringBase)
+		if !ok {
+			return 0, false
+		}
+		i, err := strconv.Atoi(suffix)
+		if err != nil {
+			return 0, false
+		}
+		idx, kind = int64(i), "string"
+	default:
+		return 0, false
+	}
+	if idx < 0 || idx >= int64(len(b.params)) {
+		return 0, false
+	}
+	param := b.params[idx]
+	if param.baked() || param.kind() != kind {
+		return 0, false
+	}
+	return int(idx), true
+}
+
+// convert converts the param value to the type of the sentinel, as the parser casts the value to the field type.
+func (b *exprBinder) convert(sentinel *planpb.GenericValue, value *planpb.GenericValue) *planpb.GenericValue {
+	if IsFloating(sentinel) && IsInteger(value) {
+		return NewFloat(float64(value.GetInt64Val()))
+	}
+	return value
+}
+
+func (b *exprBinder) checkDivisor(op planpb.ArithOpType, operand *planpb.GenericValue) {
+	if op != planpb.ArithOpType_Div && op != planpb.ArithOpType_Mod {
+		return
+	}
+	if (IsInteger(operand) && operand.GetInt64Val() == 0) || (IsFloating(operand) && operand.GetFloatVal() == 0) {
+		b.err = fmt.Errorf("cannot divide or modulo by zero")
+	}
+}
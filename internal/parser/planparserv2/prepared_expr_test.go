@@ -0,0 +1,127 @@
+package planparserv2
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrepareExpr(t *testing.T) {
+	helper := newTestSchemaHelper(t)
+
+	cases := []struct {
+		template string
+		params   []string
+		exprs    []string
+		prepared bool
+	}{
+		{
+			template: `Int64Field > {a}`,
+			params:   []string{`{"a": 1}`, `{"a": -100}`},
+			exprs:    []string{`Int64Field > 1`, `Int64Field > -100`},
+			prepared: true,
+		},
+		{
+			template: `{low} <= DoubleField < {high} and VarCharField == {s}`,
+			params:   []string{`{"low": 1, "high": 2.5, "s": "a{b}"}`, `{"low": -3, "high": 1e3, "s": "\"quoted\""}`},
+			exprs:    []string{`1 <= DoubleField < 2.5 and VarCharField == "a{b}"`, `-3 <= DoubleField < 1000.0 and VarCharField == '"quoted"'`},
+			prepared: true,
+		},
+		{
+			template: `Int64Field in {ids} or FloatField not in {values}`,
+			params:   []string{`{"ids": [1, 2, 3], "values": [1, 2.5]}`, `{"ids": [4], "values": [0.5, 3]}`},
+			exprs:    []string{`Int64Field in [1, 2, 3] or FloatField not in [1.0, 2.5]`, `Int64Field in [4] or FloatField not in [0.5, 3.0]`},
+			prepared: true,
+		},
+		{
+			template: `Int64Field % {m} == {r} and BoolField == {b}`,
+			params:   []string{`{"m": 3, "r": 1, "b": true}`, `{"m": 5, "r": 2, "b": true}`},
+			exprs:    []string{`Int64Field % 3 == 1 and BoolField == true`, `Int64Field % 5 == 2 and BoolField == true`},
+			prepared: true,
+		},
+		{
+			template: `json_contains_any(JSONField["tags"], {tags}) and A == {a} and A != {a}`,
+			params:   []string{`{"tags": ["x", "y"], "a": 1}`, `{"tags": ["z"], "a": 2}`},
+			exprs:    []string{`json_contains_any(JSONField["tags"], ["x", "y"]) and A == 1 and A != 1`, `json_contains_any(JSONField["tags"], ["z"]) and A == 2 and A != 2`},
+			prepared: true,
+		},
+		{
+			// the like patterns are parsed with the values every time
+			template: `VarCharField like {p}`,
+			params:   []string{`{"p": "abc%"}`, `{"p": "%abc"}`},
+			exprs:    []string{`VarCharField like "abc%"`, `VarCharField like "%abc"`},
+			prepared: false,
+		},
+		{
+			// the folded constants can't be located in the parsed expression
+			template: `Int64Field > {a} + 1`,
+			params:   []string{`{"a": 1}`, `{"a": 5}`},
+			exprs:    []string{`Int64Field > 2`, `Int64Field > 6`},
+			prepared: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.template, func(t *testing.T) {
+			params, err := ParseExprParams(c.params[0])
+			require.NoError(t, err)
+			prepared, err := PrepareExpr(helper, c.template, params)
+			require.NoError(t, err)
+			assert.Equal(t, c.prepared, prepared.expr != nil)
+
+			for i, data := range c.params {
+				params, err := ParseExprParams(data)
+				require.NoError(t, err)
+				bound, err := prepared.Bind(params)
+				require.NoError(t, err)
+				expected, err := ParseExpr(helper, c.exprs[i])
+				require.NoError(t, err)
+				assert.True(t, proto.Equal(expected, bound), "expected: %s, actual: %s", expected, bound)
+			}
+		})
+	}
+}
+
+func TestPrepareExprFailed(t *testing.T) {
+	helper := newTestSchemaHelper(t)
+
+	prepare := func(template string, data string) (*PreparedExpr, error) {
+		params, err := ParseExprParams(data)
+		require.NoError(t, err)
+		return PrepareExpr(helper, template, params)
+	}
+
+	_, err := ParseExprParams(`[1, 2]`)
+	assert.Error(t, err)
+	_, err = ParseExprParams(`{"a": [1, "a"]}`)
+	assert.Error(t, err)
+	_, err = ParseExprParams(`{"a": {"b": 1}}`)
+	assert.Error(t, err)
+
+	_, err = prepare(`Int64Field > {a`, `{"a": 1}`)
+	assert.Error(t, err)
+	_, err = prepare(`Int64Field > {a.b}`, `{"a": 1}`)
+	assert.Error(t, err)
+	_, err = prepare(`Int64Field > {a}`, `{"b": 1}`)
+	assert.Error(t, err)
+	_, err = prepare(`Int64Field > {a}`, `{"a": 1, "b": 1}`)
+	assert.Error(t, err)
+	_, err = prepare(`Int64Field > {a}`, `{"a": "str"}`)
+	assert.Error(t, err)
+	_, err = prepare(`Int64Field in {a}`, `{"a": 1}`)
+	assert.Error(t, err)
+
+	prepared, err := prepare(`Int64Field % {m} == 0`, `{"m": 2}`)
+	require.NoError(t, err)
+	params, err := ParseExprParams(`{"m": 0}`)
+	require.NoError(t, err)
+	_, err = prepared.Bind(params)
+	assert.Error(t, err)
+	// the params of different types should be prepared again
+	params, err = ParseExprParams(`{"m": "2"}`)
+	require.NoError(t, err)
+	_, err = prepared.Bind(params)
+	assert.Error(t, err)
+}
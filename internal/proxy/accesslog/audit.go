@@ -0,0 +1,322 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	auditSinkFile  = "file"
+	auditSinkKafka = "kafka"
+	auditSinkOtlp  = "otlp"
+
+	// max number of the audit logs emitted to the sink at once
+	auditBatchSize = 256
+)
+
+var (
+	_globalA  *AuditLogger
+	auditOnce sync.Once
+)
+
+// AuditEntry is the structured audit log of a request.
+type AuditEntry struct {
+	Time        string `json:"time"`
+	User        string `json:"user,omitempty"`
+	Address     string `json:"address,omitempty"`
+	SdkVersion  string `json:"sdk_version,omitempty"`
+	Method      string `json:"method"`
+	Status      string `json:"status"`
+	ErrorCode   string `json:"error_code"`
+	ErrorMsg    string `json:"error_msg,omitempty"`
+	Database    string `json:"database,omitempty"`
+	Collection  string `json:"collection,omitempty"`
+	Partitions  string `json:"partitions,omitempty"`
+	ExprHash    string `json:"expr_hash,omitempty"`
+	ResultCount *int64 `json:"result_count,omitempty"`
+	LatencyMs   int64  `json:"latency_ms"`
+	TraceID     string `json:"trace_id,omitempty"`
+
+	timestamp time.Time
+	failed    bool
+}
+
+// auditSink emits the audit logs to the storage, the entries are written in order by a single goroutine.
+type auditSink interface {
+	Write(entries []*AuditEntry) error
+	Close()
+}
+
+func InitAuditLog(logCfg *paramtable.AuditLogConfig, kafkaCfg *paramtable.KafkaConfig) {
+	auditOnce.Do(func() {
+		if !logCfg.Enable.GetAsBool() {
+			return
+		}
+		sink, err := newAuditSink(logCfg, kafkaCfg)
+		if err != nil {
+			log.Fatal("initialize audit logger error", zap.Error(err))
+		}
+		_globalA = NewAuditLogger(logCfg, sink)
+		log.Info("Init audit log success", zap.String("sink", logCfg.Sink.GetValue()))
+	})
+}
+
+// CloseAuditLog flushes the pending audit logs and closes the sink.
+func CloseAuditLog() {
+	if _globalA != nil {
+		_globalA.Close()
+	}
+}
+
+func newAuditSink(logCfg *paramtable.AuditLogConfig, kafkaCfg *paramtable.KafkaConfig) (auditSink, error) {
+	switch logCfg.Sink.GetValue() {
+	case auditSinkFile:
+		return newFileAuditSink(logCfg), nil
+	case auditSinkKafka:
+		return newKafkaAuditSink(logCfg, kafkaCfg)
+	case auditSinkOtlp:
+		return newOtlpAuditSink(logCfg)
+	default:
+		return nil, merr.WrapErrParameterInvalid("file, kafka or otlp", logCfg.Sink.GetValue(), "invalid audit log sink")
+	}
+}
+
+// AuditLogger samples the requests and emits the audit logs to the sink asynchronously,
+// the requests are blocked or the audit logs are dropped and counted if the sink could not keep up with the requests.
+type AuditLogger struct {
+	cfg     *paramtable.AuditLogConfig
+	sink    auditSink
+	entries chan *AuditEntry
+
+	closeCh   chan struct{}
+	closeWg   sync.WaitGroup
+	closeOnce sync.Once
+}
+
+func NewAuditLogger(cfg *paramtable.AuditLogConfig, sink auditSink) *AuditLogger {
+	l := &AuditLogger{
+		cfg:     cfg,
+		sink:    sink,
+		entries: make(chan *AuditEntry, cfg.BufferSize.GetAsInt()),
+		closeCh: make(chan struct{}),
+	}
+	l.closeWg.Add(1)
+	go l.run()
+	return l
+}
+
+func (l *AuditLogger) run() {
+	defer l.closeWg.Done()
+	batch := make([]*AuditEntry, 0, auditBatchSize)
+	for {
+		select {
+		case <-l.closeCh:
+			// flush the pending entries before exit
+			for {
+				select {
+				case entry := <-l.entries:
+					batch = append(batch, entry)
+					if len(batch) >= auditBatchSize {
+						l.flush(batch)
+						batch = batch[:0]
+					}
+				default:
+					l.flush(batch)
+					return
+				}
+			}
+		case entry := <-l.entries:
+			batch = append(batch, entry)
+		drain:
+			for len(batch) < auditBatchSize {
+				select {
+				case entry := <-l.entries:
+					batch = append(batch, entry)
+				default:
+					break drain
+				}
+			}
+			l.flush(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+func (l *AuditLogger) flush(batch []*AuditEntry) {
+	if len(batch) == 0 {
+		return
+	}
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	if err := l.sink.Write(batch); err != nil {
+		log.RatedWarn(10, "failed to write audit logs", zap.Int("num", len(batch)), zap.Error(err))
+		metrics.ProxyAuditLogCount.WithLabelValues(nodeID, metrics.FailLabel).Add(float64(len(batch)))
+		return
+	}
+	metrics.ProxyAuditLogCount.WithLabelValues(nodeID, metrics.SuccessLabel).Add(float64(len(batch)))
+}
+
+func (l *AuditLogger) shouldAudit(method string, failed bool) bool {
+	if methods := l.cfg.Methods.GetValue(); methods != "" {
+		matched := false
+		for _, m := range strings.Split(methods, ",") {
+			if strings.TrimSpace(m) == method {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	// the failed requests are always audited
+	if failed {
+		return true
+	}
+	rate := l.cfg.SampleRate.GetAsFloat()
+	return rate >= 1 || (rate > 0 && rand.Float64() < rate)
+}
+
+// Audit emits the audit log of the finished request if sampled, returns whether the log is accepted.
+func (l *AuditLogger) Audit(i *GrpcAccessInfo) bool {
+	failed := getMethodStatus(i) != "Successful"
+	if !l.shouldAudit(getMethodName(i), failed) {
+		return false
+	}
+	entry := newAuditEntry(i)
+	entry.failed = failed
+	select {
+	case l.entries <- entry:
+		return true
+	default:
+	}
+
+	if l.cfg.BlockOnFull.GetAsBool() {
+		select {
+		case l.entries <- entry:
+			return true
+		case <-l.closeCh:
+		}
+	}
+	log.RatedWarn(10, "audit log buffer is full, drop the audit log", zap.String("method", entry.Method))
+	metrics.ProxyAuditLogCount.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.AbandonLabel).Inc()
+	return false
+}
+
+// Audit emits the audit log of the request if the audit log enabled.
+func (i *GrpcAccessInfo) Audit() bool {
+	if _globalA == nil {
+		return false
+	}
+	return _globalA.Audit(i)
+}
+
+func (l *AuditLogger) Close() {
+	l.closeOnce.Do(func() {
+		close(l.closeCh)
+		l.closeWg.Wait()
+		l.sink.Close()
+	})
+}
+
+func knownOrEmpty(value string) string {
+	if value == unknownString {
+		return ""
+	}
+	return value
+}
+
+// hashExpr hides the literals in the expression which may be sensitive, the same expressions have the same hash.
+func hashExpr(expr string) string {
+	if expr == "" || expr == unknownString {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(expr))
+	return hex.EncodeToString(sum[:])
+}
+
+func getResultCount(i *GrpcAccessInfo) (int64, bool) {
+	switch resp := i.resp.(type) {
+	case *milvuspb.SearchResults:
+		ids := resp.GetResults().GetIds()
+		if ids == nil {
+			return 0, true
+		}
+		return int64(typeutil.GetSizeOfIDs(ids)), true
+	case *milvuspb.QueryResults:
+		if len(resp.GetFieldsData()) == 0 {
+			return 0, true
+		}
+		rows, err := funcutil.GetNumRowOfFieldData(resp.GetFieldsData()[0])
+		if err != nil {
+			return 0, false
+		}
+		return int64(rows), true
+	case *milvuspb.MutationResult:
+		switch i.req.(type) {
+		case *milvuspb.DeleteRequest:
+			return resp.GetDeleteCnt(), true
+		case *milvuspb.UpsertRequest:
+			return resp.GetUpsertCnt(), true
+		default:
+			return resp.GetInsertCnt(), true
+		}
+	}
+	return 0, false
+}
+
+func newAuditEntry(i *GrpcAccessInfo) *AuditEntry {
+	entry := &AuditEntry{
+		Time:       getTimeStart(i),
+		User:       knownOrEmpty(getUserName(i)),
+		Address:    knownOrEmpty(getAddr(i)),
+		SdkVersion: knownOrEmpty(getSdkVersion(i)),
+		Method:     getMethodName(i),
+		Status:     getMethodStatus(i),
+		ErrorCode:  getErrorCode(i),
+		Database:   knownOrEmpty(getDbName(i)),
+		Collection: knownOrEmpty(getCollectionName(i)),
+		Partitions: knownOrEmpty(getPartitionName(i)),
+		ExprHash:   hashExpr(getExpr(i)),
+		LatencyMs:  i.end.Sub(i.start).Milliseconds(),
+		TraceID:    getTraceID(i),
+		timestamp:  i.start,
+	}
+	if entry.Status != "Successful" {
+		entry.ErrorMsg = knownOrEmpty(getErrorMsg(i))
+	}
+	if count, ok := getResultCount(i); ok {
+		entry.ResultCount = &count
+	}
+	return entry
+}
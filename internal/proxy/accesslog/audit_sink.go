@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	otlpcommonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/kafka"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	auditSinkTimeout = 10 * time.Second
+	auditScopeName   = "milvus.audit"
+)
+
+// fileAuditSink writes the audit logs as json lines to the rotated local files.
+type fileAuditSink struct {
+	logger *RotateLogger
+}
+
+func newFileAuditSink(logCfg *paramtable.AuditLogConfig) *fileAuditSink {
+	logger := &RotateLogger{
+		localPath:  logCfg.LocalPath.GetValue(),
+		fileName:   logCfg.Filename.GetValue(),
+		maxSize:    logCfg.MaxSize.GetAsInt(),
+		maxBackups: logCfg.MaxBackups.GetAsInt(),
+	}
+	log.Info("Audit log save to " + logger.dir())
+	logger.start()
+	return &fileAuditSink{logger: logger}
+}
+
+func (s *fileAuditSink) Write(entries []*AuditEntry) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+	_, err := s.logger.Write(buf.Bytes())
+	return err
+}
+
+func (s *fileAuditSink) Close() {
+	s.logger.Close()
+}
+
+// kafkaAuditSink produces the audit logs to the kafka topic, one message per audit log.
+type kafkaAuditSink struct {
+	producer mqwrapper.Producer
+}
+
+func newKafkaAuditSink(logCfg *paramtable.AuditLogConfig, kafkaCfg *paramtable.KafkaConfig) (*kafkaAuditSink, error) {
+	if kafkaCfg.Address.GetValue() == "" {
+		return nil, fmt.Errorf("kafka.brokerList is required by the kafka audit log sink")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), auditSinkTimeout)
+	defer cancel()
+	client, err := kafka.NewKafkaClientInstanceWithConfig(ctx, kafkaCfg)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := client.CreateProducer(mqwrapper.ProducerOptions{Topic: logCfg.KafkaTopic.GetValue()})
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaAuditSink{producer: producer}, nil
+}
+
+func (s *kafkaAuditSink) Write(entries []*AuditEntry) error {
+	ctx, cancel := context.WithTimeout(context.Background(), auditSinkTimeout)
+	defer cancel()
+	for _, entry := range entries {
+		payload, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		if _, err := s.producer.Send(ctx, &mqwrapper.ProducerMessage{Payload: payload}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *kafkaAuditSink) Close() {
+	s.producer.Close()
+}
+
+// otlpAuditSink exports the audit logs to the OTLP logs collector over grpc.
+type otlpAuditSink struct {
+	conn     *grpc.ClientConn
+	client   collogspb.LogsServiceClient
+	resource *resourcepb.Resource
+}
+
+func newOtlpAuditSink(logCfg *paramtable.AuditLogConfig) (*otlpAuditSink, error) {
+	creds := insecure.NewCredentials()
+	if logCfg.OtlpSecure.GetAsBool() {
+		creds = credentials.NewTLS(&tls.Config{})
+	}
+	// the connection is established lazily, so the proxy is able to start before the collector
+	conn, err := grpc.Dial(logCfg.OtlpEndpoint.GetValue(), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return &otlpAuditSink{
+		conn:   conn,
+		client: collogspb.NewLogsServiceClient(conn),
+		resource: &resourcepb.Resource{
+			Attributes: []*otlpcommonpb.KeyValue{
+				otlpStringAttr("service.name", typeutil.ProxyRole),
+				otlpStringAttr("service.instance.id", fmt.Sprint(paramtable.GetNodeID())),
+			},
+		},
+	}, nil
+}
+
+func otlpStringAttr(key, value string) *otlpcommonpb.KeyValue {
+	return &otlpcommonpb.KeyValue{
+		Key:   key,
+		Value: &otlpcommonpb.AnyValue{Value: &otlpcommonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func (s *otlpAuditSink) Write(entries []*AuditEntry) error {
+	records := make([]*logspb.LogRecord, 0, len(entries))
+	for _, entry := range entries {
+		body, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		severity := logspb.SeverityNumber_SEVERITY_NUMBER_INFO
+		if entry.failed {
+			severity = logspb.SeverityNumber_SEVERITY_NUMBER_WARN
+		}
+		records = append(records, &logspb.LogRecord{
+			TimeUnixNano:         uint64(entry.timestamp.UnixNano()),
+			ObservedTimeUnixNano: uint64(time.Now().UnixNano()),
+			SeverityNumber:       severity,
+			SeverityText:         severity.String(),
+			Body:                 &otlpcommonpb.AnyValue{Value: &otlpcommonpb.AnyValue_StringValue{StringValue: string(body)}},
+			Attributes: []*otlpcommonpb.KeyValue{
+				otlpStringAttr("method", entry.Method),
+				otlpStringAttr("user", entry.User),
+				otlpStringAttr("status", entry.Status),
+			},
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), auditSinkTimeout)
+	defer cancel()
+	_, err := s.client.Export(ctx, &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: s.resource,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &otlpcommonpb.InstrumentationScope{Name: auditScopeName},
+				LogRecords: records,
+			}},
+		}},
+	})
+	return err
+}
+
+func (s *otlpAuditSink) Close() {
+	s.conn.Close()
+}
@@ -0,0 +1,288 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type mockAuditSink struct {
+	mu      sync.Mutex
+	entries []*AuditEntry
+	closed  bool
+}
+
+func (s *mockAuditSink) Write(entries []*AuditEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entries...)
+	return nil
+}
+
+func (s *mockAuditSink) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+}
+
+// blockingAuditSink blocks the writes until released.
+type blockingAuditSink struct {
+	mu      sync.Mutex
+	count   int
+	writing chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingAuditSink) Write(entries []*AuditEntry) error {
+	select {
+	case s.writing <- struct{}{}:
+	default:
+	}
+	<-s.release
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.count += len(entries)
+	return nil
+}
+
+func (s *blockingAuditSink) Close() {}
+
+type mockLogsServer struct {
+	collogspb.UnimplementedLogsServiceServer
+	requests chan *collogspb.ExportLogsServiceRequest
+}
+
+func (s *mockLogsServer) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	s.requests <- req
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+type AuditLogSuite struct {
+	suite.Suite
+
+	cfg *paramtable.AuditLogConfig
+}
+
+func (s *AuditLogSuite) SetupSuite() {
+	paramtable.Init()
+	s.cfg = &paramtable.Get().ProxyCfg.AuditLog
+}
+
+func (s *AuditLogSuite) TearDownTest() {
+	paramtable.Get().Reset(s.cfg.SampleRate.Key)
+	paramtable.Get().Reset(s.cfg.Methods.Key)
+	paramtable.Get().Reset(s.cfg.BlockOnFull.Key)
+}
+
+func (s *AuditLogSuite) newAccessInfo(method string, req, resp any, err error) *GrpcAccessInfo {
+	md := metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("mockUser:mockPass"))
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	info := NewGrpcAccessInfo(ctx, &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/" + method}, req)
+	info.SetResult(resp, err)
+	return info
+}
+
+func (s *AuditLogSuite) TestAuditEntry() {
+	req := &milvuspb.QueryRequest{DbName: "db", CollectionName: "coll", Expr: "id in [1, 2]"}
+	resp := &milvuspb.QueryResults{
+		Status: merr.Success(),
+		FieldsData: []*schemapb.FieldData{{
+			Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2}}},
+			}},
+		}},
+	}
+	entry := newAuditEntry(s.newAccessInfo("Query", req, resp, nil))
+	s.Equal("mockUser", entry.User)
+	s.Equal("Query", entry.Method)
+	s.Equal("Successful", entry.Status)
+	s.Equal("db", entry.Database)
+	s.Equal("coll", entry.Collection)
+	s.Equal(hashExpr("id in [1, 2]"), entry.ExprHash)
+	s.NotContains(entry.ExprHash, "id in")
+	s.EqualValues(2, *entry.ResultCount)
+	s.Empty(entry.ErrorMsg)
+
+	entry = newAuditEntry(s.newAccessInfo("Delete", &milvuspb.DeleteRequest{}, &milvuspb.MutationResult{
+		Status:    merr.Status(merr.ErrCollectionNotFound),
+		DeleteCnt: 0,
+	}, nil))
+	s.Equal("Failed", entry.Status)
+	s.NotEmpty(entry.ErrorMsg)
+	s.EqualValues(0, *entry.ResultCount)
+
+	entry = newAuditEntry(s.newAccessInfo("DescribeCollection", &milvuspb.DescribeCollectionRequest{}, nil, merr.ErrServiceNotReady))
+	s.Nil(entry.ResultCount)
+	s.Empty(entry.ExprHash)
+}
+
+func (s *AuditLogSuite) TestSampling() {
+	sink := &mockAuditSink{}
+	logger := NewAuditLogger(s.cfg, sink)
+
+	paramtable.Get().Save(s.cfg.SampleRate.Key, "0")
+	s.False(logger.Audit(s.newAccessInfo("Search", &milvuspb.SearchRequest{}, &milvuspb.SearchResults{Status: merr.Success()}, nil)))
+	// the failed requests are always audited
+	s.True(logger.Audit(s.newAccessInfo("Search", &milvuspb.SearchRequest{}, nil, merr.ErrServiceNotReady)))
+
+	paramtable.Get().Save(s.cfg.SampleRate.Key, "1")
+	paramtable.Get().Save(s.cfg.Methods.Key, "Insert, Delete")
+	s.False(logger.Audit(s.newAccessInfo("Search", &milvuspb.SearchRequest{}, &milvuspb.SearchResults{Status: merr.Success()}, nil)))
+	s.True(logger.Audit(s.newAccessInfo("Insert", &milvuspb.InsertRequest{}, &milvuspb.MutationResult{Status: merr.Success(), InsertCnt: 10}, nil)))
+
+	logger.Close()
+	s.True(sink.closed)
+	s.Len(sink.entries, 2)
+	s.Equal("Search", sink.entries[0].Method)
+	s.Equal("Insert", sink.entries[1].Method)
+	s.EqualValues(10, *sink.entries[1].ResultCount)
+}
+
+func (s *AuditLogSuite) TestBufferFull() {
+	paramtable.Get().Save(s.cfg.BufferSize.Key, "1")
+	defer paramtable.Get().Reset(s.cfg.BufferSize.Key)
+	sink := &blockingAuditSink{writing: make(chan struct{}, 1), release: make(chan struct{})}
+	logger := NewAuditLogger(s.cfg, sink)
+	newInfo := func() *GrpcAccessInfo {
+		return s.newAccessInfo("Insert", &milvuspb.InsertRequest{}, &milvuspb.MutationResult{Status: merr.Success()}, nil)
+	}
+
+	s.True(logger.Audit(newInfo()))
+	// the first log is being written, the second one is buffered and the third one is dropped
+	<-sink.writing
+	s.True(logger.Audit(newInfo()))
+	s.False(logger.Audit(newInfo()))
+
+	// the request is blocked until the log is buffered
+	paramtable.Get().Save(s.cfg.BlockOnFull.Key, "true")
+	done := make(chan bool, 1)
+	go func() {
+		done <- logger.Audit(newInfo())
+	}()
+	select {
+	case <-done:
+		s.Fail("audit should be blocked while the buffer is full")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(sink.release)
+	s.True(<-done)
+
+	logger.Close()
+	s.Equal(3, sink.count)
+}
+
+func (s *AuditLogSuite) TestRestfulAccessInfo() {
+	md := metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("mockUser:mockPass"))
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	info := NewRestfulAccessInfo(ctx, "127.0.0.1:19530", &milvuspb.SearchRequest{CollectionName: "coll"})
+	info.SetResult(&milvuspb.SearchResults{Status: merr.Success()}, nil)
+	entry := newAuditEntry(info)
+	s.Equal("Search", entry.Method)
+	s.Equal("tcp-127.0.0.1:19530", entry.Address)
+	s.Equal("mockUser", entry.User)
+	s.Equal("coll", entry.Collection)
+	s.Equal("Successful", entry.Status)
+
+	info = NewRestfulAccessInfo(context.Background(), "invalid", nil)
+	s.Equal(unknownString, getMethodName(info))
+	s.Equal(unknownString, getAddr(info))
+}
+
+func (s *AuditLogSuite) TestFileSink() {
+	dir := s.T().TempDir()
+	paramtable.Get().Save(s.cfg.LocalPath.Key, dir)
+	defer paramtable.Get().Reset(s.cfg.LocalPath.Key)
+
+	sink := newFileAuditSink(s.cfg)
+	err := sink.Write([]*AuditEntry{{Method: "Insert"}, {Method: "Delete"}})
+	s.NoError(err)
+	sink.Close()
+
+	file, err := os.Open(path.Join(dir, s.cfg.Filename.GetValue()))
+	s.Require().NoError(err)
+	defer file.Close()
+	methods := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entry := &AuditEntry{}
+		s.NoError(json.Unmarshal(scanner.Bytes(), entry))
+		methods = append(methods, entry.Method)
+	}
+	s.Equal([]string{"Insert", "Delete"}, methods)
+}
+
+func (s *AuditLogSuite) TestOtlpSink() {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	server := grpc.NewServer()
+	logsServer := &mockLogsServer{requests: make(chan *collogspb.ExportLogsServiceRequest, 1)}
+	collogspb.RegisterLogsServiceServer(server, logsServer)
+	go server.Serve(lis)
+	defer server.Stop()
+
+	paramtable.Get().Save(s.cfg.OtlpEndpoint.Key, lis.Addr().String())
+	defer paramtable.Get().Reset(s.cfg.OtlpEndpoint.Key)
+	sink, err := newOtlpAuditSink(s.cfg)
+	s.Require().NoError(err)
+	defer sink.Close()
+
+	err = sink.Write([]*AuditEntry{{Method: "Insert", timestamp: time.Now()}, {Method: "Delete", failed: true}})
+	s.NoError(err)
+	req := <-logsServer.requests
+	records := req.GetResourceLogs()[0].GetScopeLogs()[0].GetLogRecords()
+	s.Len(records, 2)
+	entry := &AuditEntry{}
+	s.NoError(json.Unmarshal([]byte(records[1].GetBody().GetStringValue()), entry))
+	s.Equal("Delete", entry.Method)
+	s.Equal("SEVERITY_NUMBER_WARN", records[1].GetSeverityText())
+}
+
+func (s *AuditLogSuite) TestInvalidSink() {
+	paramtable.Get().Save(s.cfg.Sink.Key, "unknown")
+	defer paramtable.Get().Reset(s.cfg.Sink.Key)
+	_, err := newAuditSink(s.cfg, &paramtable.Get().KafkaCfg)
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+
+	paramtable.Get().Save(s.cfg.Sink.Key, auditSinkKafka)
+	_, err = newAuditSink(s.cfg, &paramtable.Get().KafkaCfg)
+	s.Error(err)
+}
+
+func TestAuditLog(t *testing.T) {
+	suite.Run(t, new(AuditLogSuite))
+}
@@ -27,6 +27,9 @@ const (
 	unknownString = "Unknown"
 	fomaterkey    = "format"
 	methodKey     = "methods"
+
+	// the pseudo service name of the grpc calls issued by the restful requests
+	restfulServiceName = "milvus.restful"
 )
 
 type getMetricFunc func(i *GrpcAccessInfo) string
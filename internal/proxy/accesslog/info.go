@@ -19,7 +19,11 @@ package accesslog
 import (
 	"context"
 	"fmt"
+	"net"
+	"net/netip"
 	"path"
+	"reflect"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
@@ -65,6 +69,22 @@ func NewGrpcAccessInfo(ctx context.Context, grpcInfo *grpc.UnaryServerInfo, req
 	return accessInfo
 }
 
+// NewRestfulAccessInfo creates the access info of the grpc call issued by the restful request from the remote address,
+// the method is named after the grpc request, e.g. Search for the SearchRequest.
+func NewRestfulAccessInfo(ctx context.Context, remoteAddr string, req interface{}) *GrpcAccessInfo {
+	if addrPort, err := netip.ParseAddrPort(remoteAddr); err == nil {
+		ctx = peer.NewContext(ctx, &peer.Peer{Addr: net.TCPAddrFromAddrPort(addrPort)})
+	}
+	method := unknownString
+	if t := reflect.TypeOf(req); t != nil {
+		if t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		method = strings.TrimSuffix(t.Name(), "Request")
+	}
+	return NewGrpcAccessInfo(ctx, &grpc.UnaryServerInfo{FullMethod: path.Join("/", restfulServiceName, method)}, req)
+}
+
 // update context for more info
 func (i *GrpcAccessInfo) UpdateCtx(ctx context.Context) {
 	i.ctx = ctx
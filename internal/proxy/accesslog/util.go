@@ -38,6 +38,7 @@ func UnaryAccessLogInterceptor(ctx context.Context, req any, info *grpc.UnarySer
 	resp, err := handler(newCtx, req)
 	accessInfo.SetResult(resp, err)
 	accessInfo.Write()
+	accessInfo.Audit()
 	return resp, err
 }
 
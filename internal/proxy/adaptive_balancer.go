@@ -0,0 +1,406 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const (
+	// adaptiveLatencyWeight is the weight of the newest latency in the moving average
+	adaptiveLatencyWeight = 0.3
+	// adaptiveMinSamples is the number of latencies required before a query node could be ejected
+	adaptiveMinSamples = 10
+	// adaptiveMaxEjectionBackoff caps the ejection duration to 2^adaptiveMaxEjectionBackoff times the base duration
+	adaptiveMaxEjectionBackoff = 6
+	// adaptiveMaxFailures is the number of consecutive failed requests the query node is ejected after
+	adaptiveMaxFailures = 3
+	// adaptiveStatsTTL is the duration the stats of the query node not selected nor checked are kept
+	adaptiveStatsTTL = 10 * time.Minute
+)
+
+type adaptiveNodeStats struct {
+	// number of requests sent but not finished yet
+	inflight int64
+	samples  int64
+	// moving average of the response time reported by the query node, in ms
+	latency float64
+
+	// the node is ejected if ejectedUntil is set, it's probed with one request after ejectedUntil
+	ejectedUntil time.Time
+	ejections    int
+	probing      bool
+	probed       bool
+
+	// number of consecutive failed requests, which penalizes the score
+	failures int
+	// the node is skipped if unreachable by the health check
+	unreachable         bool
+	healthCheckFailures int64
+	// the last time the node is available to select, fed back or checked, the stats are pruned if inactive for long
+	lastActive time.Time
+	// the last time the node is fed back or checked, the node is checked for health if no recent feedback
+	lastFeedback time.Time
+}
+
+func (s *adaptiveNodeStats) ejected() bool {
+	return !s.ejectedUntil.IsZero()
+}
+
+// AdaptiveBalancer selects the query node by the in-flight requests, the recent latency fed back by the query nodes
+// and the failed requests. The query nodes much slower than the others or failing consecutively are ejected,
+// and probed with one request for recovery once the ejection expired. The query nodes without recent feedback are
+// checked for health, which are skipped if unreachable, and their stats are dropped once not shard leaders any more.
+type AdaptiveBalancer struct {
+	clientMgr shardClientMgr
+
+	mu    sync.Mutex
+	nodes map[int64]*adaptiveNodeStats
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func NewAdaptiveBalancer(clientMgr shardClientMgr) *AdaptiveBalancer {
+	return &AdaptiveBalancer{
+		clientMgr: clientMgr,
+		nodes:     make(map[int64]*adaptiveNodeStats),
+		closeCh:   make(chan struct{}),
+	}
+}
+
+func (b *AdaptiveBalancer) Start(ctx context.Context) {
+	b.wg.Add(1)
+	go b.checkQueryNodeHealthLoop(ctx)
+}
+
+func (b *AdaptiveBalancer) Close() {
+	b.closeOnce.Do(func() {
+		close(b.closeCh)
+		b.wg.Wait()
+	})
+}
+
+func (b *AdaptiveBalancer) getOrCreate(node int64) *adaptiveNodeStats {
+	stats, ok := b.nodes[node]
+	if !ok {
+		stats = &adaptiveNodeStats{}
+		b.nodes[node] = stats
+	}
+	stats.lastActive = time.Now()
+	return stats
+}
+
+// medianLatency returns the median latency of the available nodes with latency except the given one.
+func (b *AdaptiveBalancer) medianLatency(except int64) (float64, bool) {
+	latencies := make([]float64, 0, len(b.nodes))
+	for node, stats := range b.nodes {
+		if node != except && stats.samples > 0 && !stats.ejected() {
+			latencies = append(latencies, stats.latency)
+		}
+	}
+	if len(latencies) == 0 {
+		return 0, false
+	}
+	sort.Float64s(latencies)
+	mid := len(latencies) / 2
+	if len(latencies)%2 == 0 {
+		return (latencies[mid-1] + latencies[mid]) / 2, true
+	}
+	return latencies[mid], true
+}
+
+func (b *AdaptiveBalancer) isSlow(node int64, stats *adaptiveNodeStats) bool {
+	median, ok := b.medianLatency(node)
+	if !ok {
+		return false
+	}
+	ratio := Params.ProxyCfg.EjectionLatencyRatio.GetAsFloat()
+	minLatency := float64(Params.ProxyCfg.EjectionMinLatency.GetAsDuration(time.Millisecond).Milliseconds())
+	return stats.latency > median*ratio && stats.latency-median > minLatency
+}
+
+func (b *AdaptiveBalancer) eject(node int64, stats *adaptiveNodeStats) {
+	backoff := math.Pow(2, math.Min(float64(stats.ejections), adaptiveMaxEjectionBackoff))
+	duration := time.Duration(float64(Params.ProxyCfg.EjectionDuration.GetAsDuration(time.Millisecond)) * backoff)
+	stats.ejectedUntil = time.Now().Add(duration)
+	stats.ejections++
+	log.Info("query node responds slowly, eject it from replica selection",
+		zap.Int64("nodeID", node),
+		zap.Float64("latency", stats.latency),
+		zap.Int("ejections", stats.ejections),
+		zap.Duration("duration", duration))
+}
+
+// canEject returns whether one more node could be ejected without exceeding the max ejection ratio.
+func (b *AdaptiveBalancer) canEject() bool {
+	ejected := 0
+	for _, s := range b.nodes {
+		if s.ejected() {
+			ejected++
+		}
+	}
+	return float64(ejected+1) <= Params.ProxyCfg.MaxEjectionRatio.GetAsFloat()*float64(len(b.nodes))
+}
+
+func (b *AdaptiveBalancer) tryEject(node int64, stats *adaptiveNodeStats) {
+	if stats.ejected() || stats.samples < adaptiveMinSamples || !b.isSlow(node, stats) || !b.canEject() {
+		return
+	}
+	b.eject(node, stats)
+}
+
+func (b *AdaptiveBalancer) SelectNode(ctx context.Context, availableNodes []int64, cost int64) (int64, error) {
+	if len(availableNodes) == 0 {
+		return -1, merr.ErrNodeNotAvailable
+	}
+	rand.Shuffle(len(availableNodes), func(i, j int) {
+		availableNodes[i], availableNodes[j] = availableNodes[j], availableNodes[i]
+	})
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	// the latency of the node without feedback is regarded as the median
+	defaultLatency, _ := b.medianLatency(-1)
+	targetNode, ejectedNode := int64(-1), int64(-1)
+	targetScore, ejectedScore := math.MaxFloat64, math.MaxFloat64
+	for _, node := range availableNodes {
+		stats := b.getOrCreate(node)
+		if stats.unreachable {
+			log.Ctx(ctx).WithRateGroup("proxy.AdaptiveBalancer", 1, 60).
+				RatedWarn(5, "query node is unreachable, skip it", zap.Int64("nodeID", node))
+			continue
+		}
+		latency := defaultLatency
+		if stats.samples > 0 {
+			latency = stats.latency
+		}
+		score := (latency + 1) * float64(stats.inflight+1) * float64(stats.failures+1)
+
+		if stats.ejected() {
+			// probe the ejected node with one request once the ejection expired
+			if !stats.probing && now.After(stats.ejectedUntil) {
+				stats.probing = true
+				stats.probed = false
+				stats.inflight++
+				log.Ctx(ctx).Info("probe the ejected query node", zap.Int64("nodeID", node))
+				return node, nil
+			}
+			if score < ejectedScore {
+				ejectedNode, ejectedScore = node, score
+			}
+			continue
+		}
+		metrics.ProxyWorkLoadScore.WithLabelValues(strconv.FormatInt(node, 10)).Set(score)
+		if score < targetScore {
+			targetNode, targetScore = node, score
+		}
+	}
+
+	// all the reachable nodes are ejected, serve the request anyway
+	if targetNode == -1 {
+		targetNode = ejectedNode
+	}
+	if targetNode == -1 {
+		return -1, merr.WrapErrServiceUnavailable("all available nodes are unreachable")
+	}
+	b.nodes[targetNode].inflight++
+	return targetNode, nil
+}
+
+// CancelWorkload is called once the request finished, the probing of the ejected node is concluded here.
+func (b *AdaptiveBalancer) CancelWorkload(node int64, nq int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats, ok := b.nodes[node]
+	if !ok {
+		return
+	}
+	if stats.inflight > 0 {
+		stats.inflight--
+	}
+	if !stats.probing {
+		return
+	}
+
+	stats.probing = false
+	if stats.probed && !b.isSlow(node, stats) {
+		stats.ejectedUntil = time.Time{}
+		stats.ejections = 0
+		log.Info("ejected query node recovered", zap.Int64("nodeID", node), zap.Float64("latency", stats.latency))
+		return
+	}
+	b.eject(node, stats)
+}
+
+// UpdateCostMetrics updates the latency of the query node by the response time it reported.
+func (b *AdaptiveBalancer) UpdateCostMetrics(node int64, cost *internalpb.CostAggregation) {
+	if cost == nil {
+		return
+	}
+	latency := float64(cost.GetResponseTime())
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats := b.getOrCreate(node)
+	stats.failures = 0
+	stats.lastFeedback = time.Now()
+	if stats.samples == 0 || stats.probing {
+		// the latency before ejected is out of date
+		stats.latency = latency
+	} else {
+		stats.latency = adaptiveLatencyWeight*latency + (1-adaptiveLatencyWeight)*stats.latency
+	}
+	stats.samples++
+	if stats.probing {
+		stats.probed = true
+		return
+	}
+	b.tryEject(node, stats)
+}
+
+// ReportFailure penalizes the query node failed the request, it's ejected once failed consecutively.
+func (b *AdaptiveBalancer) ReportFailure(node int64, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	stats := b.getOrCreate(node)
+	stats.failures++
+	if stats.probing {
+		// the probe is concluded failed once the workload canceled
+		stats.probed = false
+		return
+	}
+	if stats.ejected() || stats.failures < adaptiveMaxFailures || !b.canEject() {
+		return
+	}
+	log.Warn("query node failed the requests consecutively", zap.Int64("nodeID", node), zap.Int("failures", stats.failures), zap.Error(err))
+	b.eject(node, stats)
+}
+
+func (b *AdaptiveBalancer) checkQueryNodeHealthLoop(ctx context.Context) {
+	log := log.Ctx(ctx).WithRateGroup("proxy.AdaptiveBalancer", 1, 60)
+	defer b.wg.Done()
+
+	checkQueryNodeHealthInterval := Params.ProxyCfg.CheckQueryNodeHealthInterval.GetAsDuration(time.Millisecond)
+	ticker := time.NewTicker(checkQueryNodeHealthInterval)
+	defer ticker.Stop()
+	log.Info("Start check query node health loop")
+	pool := conc.NewDefaultPool[any]()
+	for {
+		select {
+		case <-b.closeCh:
+			log.Info("check query node health loop exit")
+			return
+
+		case <-ticker.C:
+			var futures []*conc.Future[any]
+			for _, node := range b.nodesToCheck(checkQueryNodeHealthInterval) {
+				node := node
+				futures = append(futures, pool.Submit(func() (any, error) {
+					b.checkQueryNodeHealth(node)
+					return struct{}{}, nil
+				}))
+			}
+			conc.AwaitAll(futures...)
+		}
+	}
+}
+
+// nodesToCheck prunes the stats of the nodes inactive for long, and returns the nodes without recent feedback.
+func (b *AdaptiveBalancer) nodesToCheck(interval time.Duration) []int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	nodes := make([]int64, 0)
+	for node, stats := range b.nodes {
+		inactive := now.Sub(stats.lastActive)
+		if stats.inflight == 0 && inactive > adaptiveStatsTTL {
+			delete(b.nodes, node)
+			continue
+		}
+		if now.Sub(stats.lastFeedback) > interval || stats.unreachable {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+func (b *AdaptiveBalancer) checkQueryNodeHealth(node int64) {
+	ctx, cancel := context.WithTimeout(context.Background(), Params.ProxyCfg.HealthCheckTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+
+	qn, err := b.clientMgr.GetClient(ctx, node)
+	if err != nil {
+		// the node isn't a shard leader any more, drop its stats
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if stats, ok := b.nodes[node]; ok && stats.inflight == 0 {
+			delete(b.nodes, node)
+		}
+		return
+	}
+
+	resp, err := qn.GetComponentStates(ctx, &milvuspb.GetComponentStatesRequest{})
+	if err == nil && resp.GetState().GetStateCode() != commonpb.StateCode_Healthy {
+		err = merr.ErrServiceUnavailable
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	stats, ok := b.nodes[node]
+	if !ok {
+		return
+	}
+	stats.lastActive = time.Now()
+	stats.lastFeedback = stats.lastActive
+	if err != nil {
+		stats.healthCheckFailures++
+		if !stats.unreachable && stats.healthCheckFailures >= Params.ProxyCfg.RetryTimesOnHealthCheck.GetAsInt64() {
+			stats.unreachable = true
+			log.Warn("query node health check failed, set node unreachable", zap.Int64("nodeID", node), zap.Error(err))
+		}
+		return
+	}
+	stats.healthCheckFailures = 0
+	if stats.unreachable {
+		stats.unreachable = false
+		log.Info("query node recuperated, set node reachable", zap.Int64("nodeID", node))
+	}
+}
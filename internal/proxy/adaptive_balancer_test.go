@@ -0,0 +1,298 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type AdaptiveBalancerSuite struct {
+	suite.Suite
+
+	clientMgr *MockShardClientManager
+	balancer  *AdaptiveBalancer
+}
+
+func (suite *AdaptiveBalancerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *AdaptiveBalancerSuite) SetupTest() {
+	paramtable.Get().Save(Params.ProxyCfg.EjectionMinLatency.Key, "10")
+	paramtable.Get().Save(Params.ProxyCfg.EjectionDuration.Key, "50")
+	suite.clientMgr = NewMockShardClientManager(suite.T())
+	// the health check loop is started by the test checking it only
+	suite.balancer = NewAdaptiveBalancer(suite.clientMgr)
+}
+
+func (suite *AdaptiveBalancerSuite) TearDownTest() {
+	paramtable.Get().Reset(Params.ProxyCfg.EjectionMinLatency.Key)
+	paramtable.Get().Reset(Params.ProxyCfg.EjectionDuration.Key)
+}
+
+// serve simulates one request finished by the node with the given response time.
+func (suite *AdaptiveBalancerSuite) serve(node int64, latency int64) {
+	suite.balancer.UpdateCostMetrics(node, &internalpb.CostAggregation{ResponseTime: latency})
+	suite.balancer.CancelWorkload(node, 1)
+}
+
+func (suite *AdaptiveBalancerSuite) TestNoAvailableNode() {
+	_, err := suite.balancer.SelectNode(context.Background(), []int64{}, 1)
+	suite.ErrorIs(err, merr.ErrNodeNotAvailable)
+}
+
+func (suite *AdaptiveBalancerSuite) TestSelectByLatency() {
+	for i := 0; i < 3; i++ {
+		suite.serve(1, 5)
+		suite.serve(2, 20)
+	}
+
+	for i := 0; i < 10; i++ {
+		node, err := suite.balancer.SelectNode(context.Background(), []int64{1, 2}, 1)
+		suite.NoError(err)
+		suite.EqualValues(1, node)
+		suite.balancer.CancelWorkload(node, 1)
+	}
+}
+
+func (suite *AdaptiveBalancerSuite) TestSelectByInflight() {
+	ctx := context.Background()
+	suite.serve(1, 5)
+	suite.serve(2, 5)
+
+	node1, err := suite.balancer.SelectNode(ctx, []int64{1, 2}, 1)
+	suite.NoError(err)
+	node2, err := suite.balancer.SelectNode(ctx, []int64{1, 2}, 1)
+	suite.NoError(err)
+	suite.NotEqual(node1, node2)
+
+	// the node without feedback is regarded as the median
+	node3, err := suite.balancer.SelectNode(ctx, []int64{1, 2, 3}, 1)
+	suite.NoError(err)
+	suite.EqualValues(3, node3)
+}
+
+func (suite *AdaptiveBalancerSuite) TestEjectAndRecover() {
+	ctx := context.Background()
+	for i := 0; i < adaptiveMinSamples; i++ {
+		suite.serve(1, 5)
+		suite.serve(2, 5)
+		suite.serve(3, 100)
+	}
+	suite.True(suite.balancer.nodes[3].ejected())
+
+	// the ejected node is not selected even if idle
+	for i := 0; i < 10; i++ {
+		node, err := suite.balancer.SelectNode(ctx, []int64{1, 3}, 1)
+		suite.NoError(err)
+		suite.EqualValues(1, node)
+	}
+
+	// probe the node once the ejection expired, it stays ejected if still slow
+	suite.Eventually(func() bool {
+		node, err := suite.balancer.SelectNode(ctx, []int64{3}, 1)
+		return err == nil && node == 3 && suite.balancer.nodes[3].probing
+	}, time.Second, 10*time.Millisecond)
+	suite.serve(3, 100)
+	suite.True(suite.balancer.nodes[3].ejected())
+	suite.Equal(2, suite.balancer.nodes[3].ejections)
+
+	// the node recovers if the probe responds in time
+	suite.Eventually(func() bool {
+		node, err := suite.balancer.SelectNode(ctx, []int64{3}, 1)
+		return err == nil && node == 3 && suite.balancer.nodes[3].probing
+	}, time.Second, 10*time.Millisecond)
+	suite.serve(3, 5)
+	suite.False(suite.balancer.nodes[3].ejected())
+	suite.Equal(0, suite.balancer.nodes[3].ejections)
+}
+
+func (suite *AdaptiveBalancerSuite) TestProbeFailed() {
+	ctx := context.Background()
+	for i := 0; i < adaptiveMinSamples; i++ {
+		suite.serve(1, 5)
+		suite.serve(2, 100)
+	}
+	suite.True(suite.balancer.nodes[2].ejected())
+
+	suite.Eventually(func() bool {
+		node, err := suite.balancer.SelectNode(ctx, []int64{2}, 1)
+		return err == nil && node == 2 && suite.balancer.nodes[2].probing
+	}, time.Second, 10*time.Millisecond)
+	// the probe request failed without feedback
+	suite.balancer.CancelWorkload(2, 1)
+	suite.True(suite.balancer.nodes[2].ejected())
+	suite.False(suite.balancer.nodes[2].probing)
+}
+
+func (suite *AdaptiveBalancerSuite) TestMaxEjectionRatio() {
+	paramtable.Get().Save(Params.ProxyCfg.MaxEjectionRatio.Key, "0.3")
+	defer paramtable.Get().Reset(Params.ProxyCfg.MaxEjectionRatio.Key)
+	for i := 0; i < adaptiveMinSamples; i++ {
+		suite.serve(1, 5)
+		suite.serve(2, 5)
+		suite.serve(3, 100)
+		suite.serve(4, 100)
+	}
+	// only one of the four nodes could be ejected
+	ejected := 0
+	for _, stats := range suite.balancer.nodes {
+		if stats.ejected() {
+			ejected++
+		}
+	}
+	suite.Equal(1, ejected)
+}
+
+func (suite *AdaptiveBalancerSuite) TestAllEjected() {
+	paramtable.Get().Save(Params.ProxyCfg.EjectionDuration.Key, "60000")
+	for i := 0; i < adaptiveMinSamples; i++ {
+		suite.serve(1, 5)
+		suite.serve(2, 100)
+	}
+	suite.True(suite.balancer.nodes[2].ejected())
+
+	// serve the request by the ejected node anyway
+	node, err := suite.balancer.SelectNode(context.Background(), []int64{2}, 1)
+	suite.NoError(err)
+	suite.EqualValues(2, node)
+}
+
+func (suite *AdaptiveBalancerSuite) TestReportFailure() {
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		suite.serve(1, 5)
+		suite.serve(2, 5)
+		suite.serve(3, 5)
+	}
+
+	// the failed node is penalized
+	suite.balancer.ReportFailure(2, errors.New("mock"))
+	for i := 0; i < 10; i++ {
+		node, err := suite.balancer.SelectNode(ctx, []int64{1, 2}, 1)
+		suite.NoError(err)
+		suite.EqualValues(1, node)
+		suite.balancer.CancelWorkload(node, 1)
+	}
+	// and recovers once responded
+	suite.serve(2, 5)
+	suite.Equal(0, suite.balancer.nodes[2].failures)
+
+	// ejected once failed consecutively
+	for i := 0; i < adaptiveMaxFailures; i++ {
+		suite.False(suite.balancer.nodes[3].ejected())
+		suite.balancer.ReportFailure(3, errors.New("mock"))
+	}
+	suite.True(suite.balancer.nodes[3].ejected())
+
+	// the probe failed
+	suite.Eventually(func() bool {
+		node, err := suite.balancer.SelectNode(ctx, []int64{3}, 1)
+		return err == nil && node == 3 && suite.balancer.nodes[3].probing
+	}, time.Second, 10*time.Millisecond)
+	suite.balancer.UpdateCostMetrics(3, &internalpb.CostAggregation{ResponseTime: 5})
+	suite.balancer.ReportFailure(3, errors.New("mock"))
+	suite.balancer.CancelWorkload(3, 1)
+	suite.True(suite.balancer.nodes[3].ejected())
+	suite.Equal(2, suite.balancer.nodes[3].ejections)
+}
+
+func (suite *AdaptiveBalancerSuite) TestCheckHealth() {
+	paramtable.Get().Save(Params.ProxyCfg.RetryTimesOnHealthCheck.Key, "2")
+	defer paramtable.Get().Reset(Params.ProxyCfg.RetryTimesOnHealthCheck.Key)
+	ctx := context.Background()
+	states := func(code commonpb.StateCode) *milvuspb.ComponentStates {
+		return &milvuspb.ComponentStates{State: &milvuspb.ComponentInfo{StateCode: code}}
+	}
+	qn1 := mocks.NewMockQueryNodeClient(suite.T())
+	suite.clientMgr.EXPECT().GetClient(mock.Anything, int64(1)).Return(qn1, nil)
+	qn1.EXPECT().GetComponentStates(mock.Anything, mock.Anything).Return(states(commonpb.StateCode_Abnormal), nil).Once()
+	qn1.EXPECT().GetComponentStates(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+	qn1.EXPECT().GetComponentStates(mock.Anything, mock.Anything).Return(states(commonpb.StateCode_Healthy), nil).Once()
+	suite.clientMgr.EXPECT().GetClient(mock.Anything, int64(2)).Return(nil, errors.New("shard client not found"))
+
+	suite.serve(1, 5)
+	suite.serve(2, 5)
+	suite.balancer.checkQueryNodeHealth(1)
+	suite.False(suite.balancer.nodes[1].unreachable)
+	suite.balancer.checkQueryNodeHealth(1)
+	suite.True(suite.balancer.nodes[1].unreachable)
+
+	// the unreachable node is skipped
+	node, err := suite.balancer.SelectNode(ctx, []int64{1, 2}, 1)
+	suite.NoError(err)
+	suite.EqualValues(2, node)
+	suite.balancer.CancelWorkload(node, 1)
+	_, err = suite.balancer.SelectNode(ctx, []int64{1}, 1)
+	suite.ErrorIs(err, merr.ErrServiceUnavailable)
+
+	suite.balancer.checkQueryNodeHealth(1)
+	suite.False(suite.balancer.nodes[1].unreachable)
+
+	// the stats are dropped once the node isn't a shard leader
+	suite.balancer.checkQueryNodeHealth(2)
+	suite.NotContains(suite.balancer.nodes, int64(2))
+}
+
+func (suite *AdaptiveBalancerSuite) TestPruneStats() {
+	suite.serve(1, 5)
+	suite.serve(2, 5)
+	suite.serve(3, 5)
+	suite.Empty(suite.balancer.nodesToCheck(time.Minute))
+
+	// no recent feedback
+	suite.balancer.nodes[2].lastFeedback = time.Now().Add(-2 * time.Minute)
+	// inactive for long
+	suite.balancer.nodes[3].lastActive = time.Now().Add(-2 * adaptiveStatsTTL)
+	suite.balancer.nodes[3].lastFeedback = suite.balancer.nodes[3].lastActive
+	suite.ElementsMatch([]int64{2}, suite.balancer.nodesToCheck(time.Minute))
+	suite.NotContains(suite.balancer.nodes, int64(3))
+}
+
+func (suite *AdaptiveBalancerSuite) TestCheckHealthLoop() {
+	paramtable.Get().Save(Params.ProxyCfg.CheckQueryNodeHealthInterval.Key, "100")
+	defer paramtable.Get().Reset(Params.ProxyCfg.CheckQueryNodeHealthInterval.Key)
+	qn := mocks.NewMockQueryNodeClient(suite.T())
+	suite.clientMgr.EXPECT().GetClient(mock.Anything, int64(1)).Return(qn, nil)
+	qn.EXPECT().GetComponentStates(mock.Anything, mock.Anything).Return(nil, errors.New("mock"))
+
+	suite.balancer.Start(context.Background())
+	defer suite.balancer.Close()
+	suite.serve(1, 5)
+	suite.Eventually(func() bool {
+		suite.balancer.mu.Lock()
+		defer suite.balancer.mu.Unlock()
+		return suite.balancer.nodes[1].unreachable
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func TestAdaptiveBalancerSuite(t *testing.T) {
+	suite.Run(t, new(AdaptiveBalancerSuite))
+}
@@ -0,0 +1,35 @@
+package proxy
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/aggregateutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// aggReducer merges the partial aggregation results of the shards,
+// the groups are paginated by the offset and limit of the query.
+type aggReducer struct {
+	params         *queryParams
+	req            *internalpb.RetrieveRequest
+	schema         *schemapb.CollectionSchema
+	collectionName string
+}
+
+func (r *aggReducer) Reduce(results []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
+	agg, err := aggregateutil.NewAggregator(r.schema, r.req.GetGroupByFieldID(), r.req.GetAggregates())
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range results {
+		if err := agg.AddPartial(res.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	return &milvuspb.QueryResults{
+		Status:         merr.Success(),
+		FieldsData:     agg.Finalize(r.params.offset, r.params.limit),
+		CollectionName: r.collectionName,
+	}, nil
+}
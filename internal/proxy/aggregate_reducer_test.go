@@ -0,0 +1,64 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func Test_aggReducer_Reduce(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "tag", DataType: schemapb.DataType_Bool},
+		},
+	}
+	req := &internalpb.RetrieveRequest{
+		GroupByFieldID: 101,
+		Aggregates:     []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Count}, {Op: internalpb.AggregateOp_Max, FieldID: 100}},
+	}
+	partial := func(tags []bool, counts []int64, maxes []int64) *internalpb.RetrieveResults {
+		column := func(name string, dataType schemapb.DataType, scalars *schemapb.ScalarField) *schemapb.FieldData {
+			return &schemapb.FieldData{FieldName: name, Type: dataType, Field: &schemapb.FieldData_Scalars{Scalars: scalars}}
+		}
+		return &internalpb.RetrieveResults{FieldsData: []*schemapb.FieldData{
+			column("tag", schemapb.DataType_Bool, &schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: tags}}}),
+			column("count(*)", schemapb.DataType_Int64, &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: counts}}}),
+			column("count(*)", schemapb.DataType_Int64, &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: make([]int64, len(tags))}}}),
+			column("max(pk)", schemapb.DataType_Int64, &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: maxes}}}),
+		}}
+	}
+	results := []*internalpb.RetrieveResults{
+		partial([]bool{false, true}, []int64{2, 3}, []int64{10, 20}),
+		partial([]bool{true}, []int64{4}, []int64{30}),
+	}
+
+	t.Run("normal case", func(t *testing.T) {
+		r := &aggReducer{params: &queryParams{limit: typeutil.Unlimited}, req: req, schema: schema, collectionName: "test"}
+		res, err := r.Reduce(results)
+		require.NoError(t, err)
+		assert.Equal(t, "test", res.GetCollectionName())
+		require.Len(t, res.GetFieldsData(), 3)
+		assert.Equal(t, []bool{false, true}, res.GetFieldsData()[0].GetScalars().GetBoolData().GetData())
+		assert.Equal(t, []int64{2, 7}, res.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{10, 30}, res.GetFieldsData()[2].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("paginated groups", func(t *testing.T) {
+		r := &aggReducer{params: &queryParams{offset: 1, limit: 1}, req: req, schema: schema}
+		res, err := r.Reduce(results)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{true}, res.GetFieldsData()[0].GetScalars().GetBoolData().GetData())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		r := &aggReducer{params: &queryParams{limit: typeutil.Unlimited}, req: req, schema: schema}
+		_, err := r.Reduce([]*internalpb.RetrieveResults{{FieldsData: []*schemapb.FieldData{nil}}})
+		assert.Error(t, err)
+	})
+}
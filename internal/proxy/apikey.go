@@ -0,0 +1,118 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// isAPIKey checks whether the raw token is the api key managed by rootcoord, rather than the one verified by the hook.
+func isAPIKey(rawToken string) bool {
+	return strings.HasPrefix(rawToken, util.APIKeyPrefix)
+}
+
+// getAPIKeyName returns the name of the api key if the user is authenticated by the api key.
+func getAPIKeyName(username string) (string, bool) {
+	if !strings.HasPrefix(username, util.APIKeyUserPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(username, util.APIKeyUserPrefix), true
+}
+
+// verifyAPIKey verifies the api key, and returns the user the key authenticates as.
+func verifyAPIKey(ctx context.Context, rawToken string) (string, error) {
+	info, err := globalMetaCache.VerifyAPIKey(ctx, rawToken)
+	if err != nil {
+		return "", err
+	}
+	return util.APIKeyUserPrefix + info.GetName(), nil
+}
+
+// VerifyToken verifies the token carrying no password, which is either the api key managed by rootcoord or
+// the one verified by the hook, and returns the user the token authenticates as.
+func VerifyToken(ctx context.Context, rawToken string) (string, error) {
+	if !isAPIKey(rawToken) {
+		return VerifyAPIKey(rawToken)
+	}
+	if globalMetaCache == nil {
+		return "", merr.WrapErrServiceUnavailable("internal: Milvus Proxy is not ready yet. please wait")
+	}
+	return verifyAPIKey(ctx, rawToken)
+}
+
+// checkAPIKeyManager checks the current user is allowed to manage the api keys, which are root and the users of the admin role.
+// The api keys are not allowed to manage the keys, otherwise a leaked key could outlive its revocation by creating the others.
+// It returns the current user with the roles the user holds.
+func checkAPIKeyManager(ctx context.Context) (string, []string, error) {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return "", nil, merr.WrapErrPrivilegeNotPermitted("the api keys could only be managed with the authorization enabled")
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	if username == util.UserRoot {
+		return username, nil, nil
+	}
+	if _, ok := getAPIKeyName(username); ok {
+		return "", nil, merr.WrapErrPrivilegeNotPermitted("the api key %s is not allowed to manage the api keys", username)
+	}
+	roles, err := GetRole(ctx, username)
+	if err != nil {
+		return "", nil, err
+	}
+	if !lo.Contains(roles, util.RoleAdmin) {
+		return "", nil, merr.WrapErrPrivilegeNotPermitted("the api keys could only be managed by root or the users of the admin role")
+	}
+	return username, roles, nil
+}
+
+// checkAPIKeyRoles checks the roles granted to the api key are held by the user, root holds all the roles.
+func checkAPIKeyRoles(username string, userRoles []string, roles []string) error {
+	if username == util.UserRoot {
+		return nil
+	}
+	for _, role := range roles {
+		if !lo.Contains(userRoles, role) {
+			return merr.WrapErrPrivilegeNotPermitted("the role %s granted to the api key is not held by %s", role, username)
+		}
+	}
+	return nil
+}
+
+// checkAPIKeyDatabase checks the database is in the scope of the api key if the user is authenticated by the api key,
+// the key is valid for all the databases if it's not scoped to any.
+func checkAPIKeyDatabase(username string, dbName string) error {
+	name, ok := getAPIKeyName(username)
+	if !ok {
+		return nil
+	}
+	info, ok := globalMetaCache.GetAPIKeyInfo(name)
+	if !ok {
+		return merr.WrapErrPrivilegeNotAuthenticated("the api key %s is rotated or revoked", name)
+	}
+	if len(info.GetDbNames()) > 0 && !lo.Contains(info.GetDbNames(), dbName) {
+		return merr.WrapErrPrivilegeNotPermitted("the database %s is out of the scope of the api key %s", dbName, name)
+	}
+	return nil
+}
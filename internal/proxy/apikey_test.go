@@ -0,0 +1,293 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestMetaCache_APIKey(t *testing.T) {
+	ctx := context.Background()
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	cache, err := NewMetaCache(rootCoord, nil, nil)
+	require.NoError(t, err)
+
+	t.Run("verify", func(t *testing.T) {
+		rootCoord.EXPECT().VerifyAPIKey(mock.Anything, mock.Anything).Return(&rootcoordpb.VerifyAPIKeyResponse{
+			Status: merr.Success(),
+			Info:   &proxypb.APIKeyInfo{Name: "key1", Roles: []string{"role1"}},
+		}, nil).Once()
+		info, err := cache.VerifyAPIKey(ctx, "mk-key1-secret")
+		assert.NoError(t, err)
+		assert.Equal(t, "key1", info.GetName())
+
+		// the verified key is cached
+		info, err = cache.VerifyAPIKey(ctx, "mk-key1-secret")
+		assert.NoError(t, err)
+		assert.Equal(t, "key1", info.GetName())
+		assert.ElementsMatch(t, []string{"role1"}, cache.GetUserRole("apikey/key1"))
+		assert.Empty(t, cache.GetUserRole("apikey/key2"))
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		cache.RemoveCredential("apikey/key1")
+		_, ok := cache.GetAPIKeyInfo("key1")
+		assert.False(t, ok)
+
+		rootCoord.EXPECT().VerifyAPIKey(mock.Anything, mock.Anything).Return(&rootcoordpb.VerifyAPIKeyResponse{
+			Status: merr.Status(merr.WrapErrPrivilegeNotAuthenticated("invalid or expired api key")),
+		}, nil).Once()
+		_, err := cache.VerifyAPIKey(ctx, "mk-key1-secret")
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotAuthenticated)
+
+		rootCoord.EXPECT().VerifyAPIKey(mock.Anything, mock.Anything).Return(nil, errors.New("mock error")).Once()
+		_, err = cache.VerifyAPIKey(ctx, "mk-key1-secret")
+		assert.Error(t, err)
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		rootCoord.EXPECT().VerifyAPIKey(mock.Anything, mock.Anything).Return(&rootcoordpb.VerifyAPIKeyResponse{
+			Status: merr.Success(),
+			Info:   &proxypb.APIKeyInfo{Name: "key2", ExpireAt: time.Now().Add(time.Second).Unix()},
+		}, nil).Once()
+		_, err := cache.VerifyAPIKey(ctx, "mk-key2-secret")
+		assert.NoError(t, err)
+
+		info, ok := cache.GetAPIKeyInfo("key2")
+		assert.True(t, ok)
+		info.ExpireAt = time.Now().Add(-time.Second).Unix()
+		_, err = cache.VerifyAPIKey(ctx, "mk-key2-secret")
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotAuthenticated)
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		ttl := apiKeyCacheTTL
+		apiKeyCacheTTL = 0
+		defer func() { apiKeyCacheTTL = ttl }()
+
+		rootCoord.EXPECT().VerifyAPIKey(mock.Anything, mock.Anything).Return(&rootcoordpb.VerifyAPIKeyResponse{
+			Status: merr.Success(),
+			Info:   &proxypb.APIKeyInfo{Name: "key3"},
+		}, nil).Once()
+		_, err := cache.VerifyAPIKey(ctx, "mk-key3-secret")
+		assert.NoError(t, err)
+
+		// the stale key is verified again, the one revoked without the invalidation is rejected
+		_, ok := cache.GetAPIKeyInfo("key3")
+		assert.False(t, ok)
+		rootCoord.EXPECT().VerifyAPIKey(mock.Anything, mock.Anything).Return(&rootcoordpb.VerifyAPIKeyResponse{
+			Status: merr.Status(merr.WrapErrPrivilegeNotAuthenticated("invalid or expired api key")),
+		}, nil).Once()
+		_, err = cache.VerifyAPIKey(ctx, "mk-key3-secret")
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotAuthenticated)
+	})
+}
+
+func TestAPIKeyAuthentication(t *testing.T) {
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	cache, err := NewMetaCache(rootCoord, nil, nil)
+	require.NoError(t, err)
+	globalMetaCache = cache
+
+	rootCoord.EXPECT().VerifyAPIKey(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *rootcoordpb.VerifyAPIKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.VerifyAPIKeyResponse, error) {
+		if req.GetKey() != "mk-key1-secret" {
+			return &rootcoordpb.VerifyAPIKeyResponse{
+				Status: merr.Status(merr.WrapErrPrivilegeNotAuthenticated("invalid or expired api key")),
+			}, nil
+		}
+		return &rootcoordpb.VerifyAPIKeyResponse{
+			Status: merr.Success(),
+			Info:   &proxypb.APIKeyInfo{Name: "key1", DbNames: []string{"db1"}, Roles: []string{"role1"}},
+		}, nil
+	})
+
+	t.Run("authenticate", func(t *testing.T) {
+		assert.True(t, isAPIKey("mk-key1-secret"))
+		assert.False(t, isAPIKey("mockapikey"))
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("mk-key1-secret")))
+		authCtx, err := AuthenticationInterceptor(ctx)
+		assert.NoError(t, err)
+		username, err := GetCurUserFromContext(authCtx)
+		assert.NoError(t, err)
+		assert.Equal(t, "apikey/key1", username)
+		roles, err := GetRole(authCtx, username)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"role1"}, roles)
+
+		ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("mk-key1-invalid")))
+		_, err = AuthenticationInterceptor(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("verify token", func(t *testing.T) {
+		SetMockAPIHook("foo", nil)
+		defer SetMockAPIHook("", nil)
+
+		username, err := VerifyToken(context.Background(), "mk-key1-secret")
+		assert.NoError(t, err)
+		assert.Equal(t, "apikey/key1", username)
+
+		// the api keys managed by rootcoord are never verified by the hook
+		_, err = VerifyToken(context.Background(), "mk-key1-invalid")
+		assert.Error(t, err)
+
+		username, err = VerifyToken(context.Background(), "mockapikey")
+		assert.NoError(t, err)
+		assert.Equal(t, "foo", username)
+	})
+
+	t.Run("database scope", func(t *testing.T) {
+		assert.NoError(t, checkAPIKeyDatabase("apikey/key1", "db1"))
+		assert.ErrorIs(t, checkAPIKeyDatabase("apikey/key1", "db2"), merr.ErrPrivilegeNotPermitted)
+		assert.ErrorIs(t, checkAPIKeyDatabase("apikey/key2", "db1"), merr.ErrPrivilegeNotAuthenticated)
+		assert.NoError(t, checkAPIKeyDatabase("alice", "db2"))
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+			util.HeaderAuthorize, crypto.Base64Encode("apikey/key1:___"),
+			strings.ToLower(util.HeaderDBName), "db2",
+		))
+		_, err := PrivilegeInterceptor(ctx, &milvuspb.LoadCollectionRequest{CollectionName: "col1"})
+		assert.Error(t, err)
+	})
+}
+
+func TestProxy_APIKey(t *testing.T) {
+	paramtable.Init()
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	node := &Proxy{rootCoord: rootCoord}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	cache := NewMockCache(t)
+	cache.EXPECT().GetUserRole("alice").Return([]string{util.RoleAdmin, "role1"}).Maybe()
+	cache.EXPECT().GetUserRole("bob").Return([]string{"role1"}).Maybe()
+	globalMetaCache = cache
+
+	newCtx := func(username string) context.Context {
+		return NewContextWithMetadata(context.Background(), username, util.DefaultDBName)
+	}
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		resp, err := node.CreateAPIKey(newCtx(util.UserRoot), &proxypb.CreateAPIKeyRequest{Name: "key1"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrPrivilegeNotPermitted)
+	})
+
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	t.Run("create", func(t *testing.T) {
+		rootCoord.EXPECT().CreateAPIKey(mock.Anything, mock.Anything).Return(&proxypb.APIKeyResponse{
+			Status: merr.Success(),
+			Key:    "mk-key1-secret",
+		}, nil).Twice()
+		resp, err := node.CreateAPIKey(newCtx("alice"), &proxypb.CreateAPIKeyRequest{Name: "key1", Roles: []string{"role1"}})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, "mk-key1-secret", resp.GetKey())
+
+		// root is allowed to grant any role
+		resp, err = node.CreateAPIKey(newCtx(util.UserRoot), &proxypb.CreateAPIKeyRequest{Name: "key1", Roles: []string{"role2"}})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+
+		// the role is not held by the caller
+		resp, err = node.CreateAPIKey(newCtx("alice"), &proxypb.CreateAPIKeyRequest{Name: "key1", Roles: []string{"role2"}})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrPrivilegeNotPermitted)
+
+		// neither root nor admin
+		resp, err = node.CreateAPIKey(newCtx("bob"), &proxypb.CreateAPIKeyRequest{Name: "key1", Roles: []string{"role1"}})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrPrivilegeNotPermitted)
+
+		// the api key is not allowed to create the others
+		resp, err = node.CreateAPIKey(newCtx("apikey/key1"), &proxypb.CreateAPIKeyRequest{Name: "key2"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrPrivilegeNotPermitted)
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		rootCoord.EXPECT().ListAPIKeys(mock.Anything, mock.Anything).Return(&proxypb.ListAPIKeysResponse{
+			Status: merr.Success(),
+			Infos: []*proxypb.APIKeyInfo{
+				{Name: "key1", Roles: []string{"role1"}},
+				{Name: "key2", Roles: []string{"role2"}},
+			},
+		}, nil)
+		rootCoord.EXPECT().RotateAPIKey(mock.Anything, mock.Anything).Return(&proxypb.APIKeyResponse{
+			Status: merr.Success(),
+			Key:    "mk-key1-secret2",
+		}, nil).Once()
+		resp, err := node.RotateAPIKey(newCtx("alice"), &proxypb.RotateAPIKeyRequest{Name: "key1"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+
+		resp, err = node.RotateAPIKey(newCtx("alice"), &proxypb.RotateAPIKeyRequest{Name: "key2"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrPrivilegeNotPermitted)
+
+		resp, err = node.RotateAPIKey(newCtx("alice"), &proxypb.RotateAPIKeyRequest{Name: "key3"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrAPIKeyNotFound)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		rootCoord.EXPECT().RevokeAPIKey(mock.Anything, mock.Anything).Return(merr.Success(), nil).Once()
+		status, err := node.RevokeAPIKey(newCtx("alice"), &proxypb.RevokeAPIKeyRequest{Name: "key1"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+
+		status, err = node.RevokeAPIKey(newCtx("bob"), &proxypb.RevokeAPIKeyRequest{Name: "key1"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrPrivilegeNotPermitted)
+	})
+
+	t.Run("list", func(t *testing.T) {
+		resp, err := node.ListAPIKeys(newCtx("alice"), &proxypb.ListAPIKeysRequest{})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetInfos(), 2)
+
+		resp, err = node.ListAPIKeys(newCtx("bob"), &proxypb.ListAPIKeysRequest{})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrPrivilegeNotPermitted)
+	})
+}
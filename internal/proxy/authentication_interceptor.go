@@ -74,7 +74,29 @@ func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
 				return nil, status.Error(codes.Unauthenticated, "invalid token format")
 			}
 
-			if !strings.Contains(rawToken, util.CredentialSeperator) {
+			if IsOIDCToken(rawToken) {
+				// the oidc id token issued by the sso provider
+				user, roles, err := VerifyOIDCToken(ctx, rawToken)
+				if err != nil {
+					log.Warn("fail to verify oidc token", zap.Error(err))
+					return nil, status.Error(codes.Unauthenticated, "auth check failure, please check oidc token is valid")
+				}
+				metrics.UserRPCCounter.WithLabelValues(user).Inc()
+				userToken := fmt.Sprintf("%s%s%s", user, util.CredentialSeperator, "___")
+				md[strings.ToLower(util.HeaderAuthorize)] = []string{crypto.Base64Encode(userToken)}
+				ctx = WithOIDCRoles(metadata.NewIncomingContext(ctx, md), roles)
+			} else if isAPIKey(rawToken) {
+				// the api key managed by rootcoord
+				user, err := verifyAPIKey(ctx, rawToken)
+				if err != nil {
+					log.Warn("fail to verify api key", zap.Error(err))
+					return nil, status.Error(codes.Unauthenticated, "auth check failure, please check api key is valid")
+				}
+				metrics.UserRPCCounter.WithLabelValues(user).Inc()
+				userToken := fmt.Sprintf("%s%s%s", user, util.CredentialSeperator, "___")
+				md[strings.ToLower(util.HeaderAuthorize)] = []string{crypto.Base64Encode(userToken)}
+				ctx = metadata.NewIncomingContext(ctx, md)
+			} else if !strings.Contains(rawToken, util.CredentialSeperator) {
 				user, err := VerifyAPIKey(rawToken)
 				if err != nil {
 					log.Warn("fail to verify apikey", zap.Error(err))
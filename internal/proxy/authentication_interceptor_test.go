@@ -141,5 +141,15 @@ func TestAuthenticationInterceptor(t *testing.T) {
 		user, _ := parseMD(rawToken)
 		assert.Equal(t, "mockUser", user)
 	}
+
+	{
+		// the invalid oidc token
+		paramtable.Get().Save(Params.CommonCfg.OIDCEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.OIDCEnabled.Key)
+		md = metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("eyJhbGciOiJSUzI1NiJ9.eyJzdWIiOiJhbGljZSJ9.invalid"))
+		ctx = metadata.NewIncomingContext(ctx, md)
+		_, err = AuthenticationInterceptor(ctx)
+		assert.Error(t, err)
+	}
 	hoo = hookutil.DefaultHook{}
 }
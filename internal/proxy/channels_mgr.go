@@ -21,15 +21,18 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -48,6 +51,8 @@ type channelInfos struct {
 	// It seems that there is no need to maintain relationships between vchans & pchans.
 	vchans []vChan
 	pchans []pChan
+	// the options of the producers set in the collection properties, nil if not set
+	producerOptions *mqwrapper.ProducerOptions
 }
 
 type streamInfos struct {
@@ -106,7 +111,26 @@ func getDmlChannelsFunc(ctx context.Context, rc types.RootCoordClient) getChanne
 			return channelInfos{}, merr.Error(resp.GetStatus())
 		}
 
-		return newChannels(resp.GetVirtualChannelNames(), resp.GetPhysicalChannelNames())
+		infos, err := newChannels(resp.GetVirtualChannelNames(), resp.GetPhysicalChannelNames())
+		if err != nil {
+			return channelInfos{}, err
+		}
+		infos.producerOptions = getProducerOptions(resp.GetProperties()...)
+		return infos, nil
+	}
+}
+
+// getProducerOptions returns the options of the producers set in the collection properties, nil if not set.
+func getProducerOptions(kvs ...*commonpb.KeyValuePair) *mqwrapper.ProducerOptions {
+	settings, ok := common.GetCollectionMsgStream(kvs...)
+	if !ok {
+		return nil
+	}
+	return &mqwrapper.ProducerOptions{
+		EnableCompression:       true,
+		CompressionType:         settings.Compression,
+		BatchingMaxMessages:     settings.BatchSize,
+		BatchingMaxPublishDelay: time.Duration(settings.LingerMs) * time.Millisecond,
 	}
 }
 
@@ -172,7 +196,7 @@ func (mgr *singleTypeChannelsMgr) streamExistPrivate(collectionID UniqueID) bool
 	return ok && streamInfos.stream != nil
 }
 
-func createStream(factory msgstream.Factory, pchans []pChan, repack repackFuncType) (msgstream.MsgStream, error) {
+func createStream(factory msgstream.Factory, infos channelInfos, repack repackFuncType) (msgstream.MsgStream, error) {
 	var stream msgstream.MsgStream
 	var err error
 
@@ -181,7 +205,10 @@ func createStream(factory msgstream.Factory, pchans []pChan, repack repackFuncTy
 		return nil, err
 	}
 
-	stream.AsProducer(pchans)
+	if configurable, ok := stream.(msgstream.ProducerConfigurable); ok && infos.producerOptions != nil {
+		configurable.SetProducerOptions(*infos.producerOptions)
+	}
+	stream.AsProducer(infos.pchans)
 	if repack != nil {
 		stream.SetRepackFunc(repack)
 	}
@@ -219,7 +246,7 @@ func (mgr *singleTypeChannelsMgr) createMsgStream(collectionID UniqueID) (msgstr
 		return nil, err
 	}
 
-	stream, err := createStream(mgr.msgStreamFactory, channelInfos.pchans, mgr.repackFunc)
+	stream, err := createStream(mgr.msgStreamFactory, channelInfos, mgr.repackFunc)
 	if err != nil {
 		// What if stream created by other goroutines?
 		log.Error("failed to create message stream", zap.Error(err), zap.Int64("collection", collectionID))
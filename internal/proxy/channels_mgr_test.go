@@ -20,6 +20,7 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
@@ -27,7 +28,9 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -49,6 +52,33 @@ func Test_newChannels(t *testing.T) {
 		assert.ElementsMatch(t, []string{"111", "222"}, got.vchans)
 		// assert.ElementsMatch(t, []string{"111"}, got.pchans)
 		assert.ElementsMatch(t, []string{"111", "111"}, got.pchans)
+		assert.Nil(t, got.producerOptions)
+	})
+
+	t.Run("producer options", func(t *testing.T) {
+		ctx := context.Background()
+		rc := newMockRootCoord()
+		rc.DescribeCollectionFunc = func(ctx context.Context, request *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error) {
+			return &milvuspb.DescribeCollectionResponse{
+				VirtualChannelNames:  []string{"111"},
+				PhysicalChannelNames: []string{"111"},
+				Properties: []*commonpb.KeyValuePair{
+					{Key: common.CollectionMsgStreamCompressionKey, Value: "lz4"},
+					{Key: common.CollectionMsgStreamBatchSizeKey, Value: "100"},
+					{Key: common.CollectionMsgStreamLingerMsKey, Value: "5"},
+				},
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			}, nil
+		}
+		f := getDmlChannelsFunc(ctx, rc)
+		got, err := f(100)
+		assert.NoError(t, err)
+		assert.Equal(t, &mqwrapper.ProducerOptions{
+			EnableCompression:       true,
+			CompressionType:         "lz4",
+			BatchingMaxMessages:     100,
+			BatchingMaxPublishDelay: 5 * time.Millisecond,
+		}, got.producerOptions)
 	})
 }
 
@@ -214,7 +244,7 @@ func Test_createStream(t *testing.T) {
 		factory.fQStream = func(ctx context.Context) (msgstream.MsgStream, error) {
 			return nil, errors.New("mock")
 		}
-		_, err := createStream(factory, nil, nil)
+		_, err := createStream(factory, channelInfos{}, nil)
 		assert.Error(t, err)
 	})
 
@@ -223,7 +253,7 @@ func Test_createStream(t *testing.T) {
 		factory.f = func(ctx context.Context) (msgstream.MsgStream, error) {
 			return nil, errors.New("mock")
 		}
-		_, err := createStream(factory, nil, nil)
+		_, err := createStream(factory, channelInfos{}, nil)
 		assert.Error(t, err)
 	})
 
@@ -232,7 +262,7 @@ func Test_createStream(t *testing.T) {
 		factory.f = func(ctx context.Context) (msgstream.MsgStream, error) {
 			return newMockMsgStream(), nil
 		}
-		_, err := createStream(factory, []string{"111"}, func(tsMsgs []msgstream.TsMsg, hashKeys [][]int32) (map[int32]*msgstream.MsgPack, error) {
+		_, err := createStream(factory, channelInfos{pchans: []string{"111"}}, func(tsMsgs []msgstream.TsMsg, hashKeys [][]int32) (map[int32]*msgstream.MsgPack, error) {
 			return nil, nil
 		})
 		assert.NoError(t, err)
@@ -0,0 +1,364 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// this file contains the helpers creating the collections restored from the snapshots, the backups and the replicas
+
+func (node *Proxy) createCollectionFromSnapshot(ctx context.Context, dbName, collectionName, snapshotName, targetName string) (*datapb.RestoreSnapshotResponse, error) {
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, err
+	}
+	source, err := node.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection)),
+		DbName:       dbName,
+		CollectionID: collectionID,
+	})
+	if err := merr.CheckRPCCall(source, err); err != nil {
+		return nil, err
+	}
+	sourcePartitions, err := node.rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_ShowPartitions)),
+		DbName:       dbName,
+		CollectionID: collectionID,
+	})
+	if err := merr.CheckRPCCall(sourcePartitions, err); err != nil {
+		return nil, err
+	}
+
+	return node.cloneCollection(ctx, dbName, targetName, source, sourcePartitions,
+		func(targetCollectionID int64, partitionMapping map[int64]int64) (*datapb.RestoreSnapshotResponse, error) {
+			resp, err := node.dataCoord.RestoreSnapshot(ctx, &datapb.RestoreSnapshotRequest{
+				Base:               commonpbutil.NewMsgBase(),
+				CollectionID:       collectionID,
+				Name:               snapshotName,
+				TargetCollectionID: targetCollectionID,
+				PartitionMapping:   partitionMapping,
+			})
+			return resp, merr.CheckRPCCall(resp, err)
+		})
+}
+
+// cloneCollection creates the target collection with the schema, shards and partitions of the source collection,
+// and restores the segments into it by restore, the target collection is dropped if the restore fails.
+func (node *Proxy) cloneCollection(ctx context.Context, dbName, targetName string,
+	source *milvuspb.DescribeCollectionResponse, sourcePartitions *milvuspb.ShowPartitionsResponse,
+	restore func(targetCollectionID int64, partitionMapping map[int64]int64) (*datapb.RestoreSnapshotResponse, error),
+) (*datapb.RestoreSnapshotResponse, error) {
+	// the system fields and the dynamic field are appended by rootcoord
+	schema := proto.Clone(source.GetSchema()).(*schemapb.CollectionSchema)
+	schema.Name = targetName
+	schema.Fields = lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return !common.IsSystemField(field.GetFieldID()) && !field.GetIsDynamic()
+	})
+	schemaBytes, err := proto.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	createReq := &milvuspb.CreateCollectionRequest{
+		Base:             commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection)),
+		DbName:           dbName,
+		CollectionName:   targetName,
+		Schema:           schemaBytes,
+		ShardsNum:        source.GetShardsNum(),
+		ConsistencyLevel: source.GetConsistencyLevel(),
+		Properties:       source.GetProperties(),
+	}
+	hasPartitionKey := typeutil.HasPartitionKey(schema)
+	if hasPartitionKey {
+		createReq.NumPartitions = int64(len(sourcePartitions.GetPartitionNames()))
+	}
+	if err := merr.CheckRPCCall(node.rootCoord.CreateCollection(ctx, createReq)); err != nil {
+		return nil, err
+	}
+
+	resp, err := node.restoreSnapshotInto(ctx, dbName, targetName, source, sourcePartitions, hasPartitionKey, restore)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to restore, drop the target collection",
+			zap.String("collection", source.GetCollectionName()),
+			zap.String("target", targetName),
+			zap.Error(err))
+		status, dropErr := node.rootCoord.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+			Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropCollection)),
+			DbName:         dbName,
+			CollectionName: targetName,
+		})
+		if err := merr.CheckRPCCall(status, dropErr); err != nil {
+			log.Ctx(ctx).Warn("failed to drop the target collection", zap.String("target", targetName), zap.Error(err))
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// restoreSnapshotInto creates the partitions of the source collection in the target collection,
+// and restores the segments with the partitions mapped by name.
+func (node *Proxy) restoreSnapshotInto(ctx context.Context, dbName, targetName string,
+	source *milvuspb.DescribeCollectionResponse, sourcePartitions *milvuspb.ShowPartitionsResponse, hasPartitionKey bool,
+	restore func(targetCollectionID int64, partitionMapping map[int64]int64) (*datapb.RestoreSnapshotResponse, error),
+) (*datapb.RestoreSnapshotResponse, error) {
+	target, err := node.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DescribeCollection)),
+		DbName:         dbName,
+		CollectionName: targetName,
+	})
+	if err := merr.CheckRPCCall(target, err); err != nil {
+		return nil, err
+	}
+	// the binlogs are keyed by field id, which shall be the same
+	sourceFields := lo.SliceToMap(source.GetSchema().GetFields(), func(field *schemapb.FieldSchema) (string, int64) {
+		return field.GetName(), field.GetFieldID()
+	})
+	for _, field := range target.GetSchema().GetFields() {
+		if sourceFields[field.GetName()] != field.GetFieldID() {
+			return nil, merr.WrapErrParameterInvalidMsg("field %s id mismatched, source %d, target %d",
+				field.GetName(), sourceFields[field.GetName()], field.GetFieldID())
+		}
+	}
+
+	showTargetPartitions := func() (*milvuspb.ShowPartitionsResponse, error) {
+		resp, err := node.rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+			Base:         commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_ShowPartitions)),
+			DbName:       dbName,
+			CollectionID: target.GetCollectionID(),
+		})
+		return resp, merr.CheckRPCCall(resp, err)
+	}
+	targetPartitions, err := showTargetPartitions()
+	if err != nil {
+		return nil, err
+	}
+	if !hasPartitionKey {
+		for _, name := range sourcePartitions.GetPartitionNames() {
+			if lo.Contains(targetPartitions.GetPartitionNames(), name) {
+				continue
+			}
+			status, err := node.rootCoord.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+				Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreatePartition)),
+				DbName:         dbName,
+				CollectionName: targetName,
+				PartitionName:  name,
+			})
+			if err := merr.CheckRPCCall(status, err); err != nil {
+				return nil, err
+			}
+		}
+		targetPartitions, err = showTargetPartitions()
+		if err != nil {
+			return nil, err
+		}
+	}
+	targetPartitionIDs := make(map[string]int64)
+	for i, name := range targetPartitions.GetPartitionNames() {
+		targetPartitionIDs[name] = targetPartitions.GetPartitionIDs()[i]
+	}
+	partitionMapping := make(map[int64]int64)
+	for i, name := range sourcePartitions.GetPartitionNames() {
+		if partitionID, ok := targetPartitionIDs[name]; ok {
+			partitionMapping[sourcePartitions.GetPartitionIDs()[i]] = partitionID
+		}
+	}
+
+	return restore(target.GetCollectionID(), partitionMapping)
+}
+
+// restoreCollection creates the collection of the manifest in the database, which is created if not exists,
+// and then restores the segments into it by restore. The indexes of the manifest are created before the
+// segments restored, so that the index files of the segments are reused rather than rebuilt.
+func (node *Proxy) restoreCollection(ctx context.Context, dbName string, info *datapb.ReplicaInfo,
+	restore func(targetCollectionID int64, partitionMapping map[int64]int64) (*datapb.RestoreSnapshotResponse, error),
+) (*datapb.RestoreSnapshotResponse, error) {
+	source := info.GetCollection()
+	if len(dbName) == 0 {
+		dbName = util.DefaultDBName
+	}
+	dbs, err := node.rootCoord.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_ListDatabases)),
+	})
+	if err := merr.CheckRPCCall(dbs, err); err != nil {
+		return nil, err
+	}
+	if !lo.Contains(dbs.GetDbNames(), dbName) {
+		status, err := node.rootCoord.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base:   commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_CreateDatabase)),
+			DbName: dbName,
+		})
+		if err := merr.CheckRPCCall(status, err); err != nil {
+			return nil, err
+		}
+	}
+
+	sourcePartitions := &milvuspb.ShowPartitionsResponse{
+		PartitionNames: info.GetPartitionNames(),
+		PartitionIDs:   info.GetPartitionIDs(),
+	}
+	var targetCollectionID int64
+	resp, err := node.cloneCollection(ctx, dbName, source.GetCollectionName(), source, sourcePartitions,
+		func(collectionID int64, partitionMapping map[int64]int64) (*datapb.RestoreSnapshotResponse, error) {
+			targetCollectionID = collectionID
+			// the field ids of the target collection are the same as the source one
+			for _, index := range info.GetIndexes() {
+				status, err := node.dataCoord.CreateIndex(ctx, &indexpb.CreateIndexRequest{
+					CollectionID:    collectionID,
+					FieldID:         index.GetFieldID(),
+					IndexName:       index.GetIndexName(),
+					TypeParams:      index.GetTypeParams(),
+					IndexParams:     index.GetIndexParams(),
+					IsAutoIndex:     index.GetIsAutoIndex(),
+					UserIndexParams: index.GetUserIndexParams(),
+				})
+				if err := merr.CheckRPCCall(status, err); err != nil {
+					return nil, err
+				}
+			}
+			return restore(collectionID, partitionMapping)
+		})
+	if err != nil {
+		return nil, err
+	}
+	log.Ctx(ctx).Info("collection restored",
+		zap.String("db", dbName),
+		zap.String("collection", source.GetCollectionName()),
+		zap.Int64("collectionID", targetCollectionID),
+		zap.Int("segmentNum", len(resp.GetSegmentIDs())))
+	return resp, nil
+}
+
+// restoreCollections restores the collections into the target databases by restore, the privileges of all the
+// collections are checked before any of them restored, restoring into a database not exists requires the privilege
+// of creating database.
+func (node *Proxy) restoreCollections(ctx context.Context, collections []*datapb.ReplicaInfo, targetDbName func(info *datapb.ReplicaInfo) string,
+	restore func(info *datapb.ReplicaInfo, targetCollectionID int64, partitionMapping map[int64]int64) (*datapb.RestoreSnapshotResponse, error),
+) ([]*proxypb.RestoredCollection, error) {
+	dbNames := make([]string, len(collections))
+	for i, info := range collections {
+		dbNames[i] = targetDbName(info)
+		if len(dbNames[i]) == 0 {
+			dbNames[i] = util.DefaultDBName
+		}
+	}
+
+	dbs, err := node.rootCoord.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+		Base: commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_ListDatabases)),
+	})
+	if err := merr.CheckRPCCall(dbs, err); err != nil {
+		return nil, err
+	}
+	for i, info := range collections {
+		dbCtx := withDatabase(ctx, dbNames[i])
+		if !lo.Contains(dbs.GetDbNames(), dbNames[i]) {
+			if _, err := PrivilegeInterceptor(dbCtx, &milvuspb.CreateDatabaseRequest{DbName: dbNames[i]}); err != nil {
+				return nil, err
+			}
+		}
+		if _, err := PrivilegeInterceptor(dbCtx, &milvuspb.CreateCollectionRequest{
+			DbName:         dbNames[i],
+			CollectionName: info.GetCollection().GetCollectionName(),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	restored := make([]*proxypb.RestoredCollection, 0, len(collections))
+	for i, info := range collections {
+		info := info
+		resp, err := node.restoreCollection(ctx, dbNames[i], info,
+			func(targetCollectionID int64, partitionMapping map[int64]int64) (*datapb.RestoreSnapshotResponse, error) {
+				return restore(info, targetCollectionID, partitionMapping)
+			})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to restore collection %s", info.GetCollection().GetCollectionName())
+		}
+		restored = append(restored, &proxypb.RestoredCollection{
+			DbName:         dbNames[i],
+			CollectionName: info.GetCollection().GetCollectionName(),
+			SegmentIDs:     resp.GetSegmentIDs(),
+			NumRows:        resp.GetNumRows(),
+		})
+	}
+	return restored, nil
+}
+
+// getReplicas returns the replicas of the collection, all the replicas if the collection name not specified.
+func (node *Proxy) getReplicas(ctx context.Context, dbName, collectionName string) ([]*datapb.ReplicaInfo, error) {
+	resp, err := node.dataCoord.ListReplicas(ctx, &datapb.ListReplicasRequest{
+		Base: commonpbutil.NewMsgBase(),
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	if len(dbName) == 0 {
+		dbName = util.DefaultDBName
+	}
+	replicas := lo.Filter(resp.GetReplicas(), func(replica *datapb.ReplicaInfo, _ int) bool {
+		return len(collectionName) == 0 ||
+			(replica.GetCollection().GetCollectionName() == collectionName && replica.GetCollection().GetDbName() == dbName)
+	})
+	if len(collectionName) > 0 && len(replicas) == 0 {
+		return nil, merr.WrapErrCollectionNotFound(collectionName, "no replica")
+	}
+	return replicas, nil
+}
+
+// getBackupCollections returns the collections of the backup selected by the database and the names,
+// all the collections of the backup are selected if neither specified.
+func (node *Proxy) getBackupCollections(ctx context.Context, backupName, dbName string, collectionNames []string) ([]*datapb.ReplicaInfo, error) {
+	resp, err := node.dataCoord.ListBackups(ctx, &datapb.ListBackupsRequest{
+		Base: commonpbutil.NewMsgBase(),
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	backup, ok := lo.Find(resp.GetBackups(), func(backup *datapb.BackupInfo) bool {
+		return backup.GetName() == backupName
+	})
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("backup %s not found", backupName)
+	}
+	collections := lo.Filter(backup.GetCollections(), func(info *datapb.ReplicaInfo, _ int) bool {
+		if len(dbName) > 0 && info.GetCollection().GetDbName() != dbName {
+			return false
+		}
+		return len(collectionNames) == 0 || lo.Contains(collectionNames, info.GetCollection().GetCollectionName())
+	})
+	if len(collections) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("no collection selected in backup %s", backupName)
+	}
+	return collections, nil
+}
@@ -0,0 +1,488 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type CollectionRestoreSuite struct {
+	suite.Suite
+
+	ctx       context.Context
+	datacoord *mocks.MockDataCoordClient
+	rootcoord *mocks.MockRootCoordClient
+	proxy     *Proxy
+}
+
+func (s *CollectionRestoreSuite) SetupSuite() {
+	paramtable.Init()
+	s.ctx = NewContextWithMetadata(context.Background(), util.UserRoot, util.DefaultDBName)
+}
+
+func (s *CollectionRestoreSuite) SetupTest() {
+	s.datacoord = mocks.NewMockDataCoordClient(s.T())
+	s.rootcoord = mocks.NewMockRootCoordClient(s.T())
+
+	s.proxy = &Proxy{
+		dataCoord: s.datacoord,
+		rootCoord: s.rootcoord,
+	}
+	s.proxy.UpdateStateCode(commonpb.StateCode_Healthy)
+}
+
+func (s *CollectionRestoreSuite) TearDownTest() {
+	s.datacoord.AssertExpectations(s.T())
+}
+
+func (s *CollectionRestoreSuite) TestCreateCollectionFromSnapshot() {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	schema := &schemapb.CollectionSchema{
+		Name: "test_collection",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vector", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	setupSource := func() {
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil)
+		globalMetaCache = metaCache
+
+		s.rootcoord.EXPECT().DescribeCollection(mock.Anything, mock.MatchedBy(func(req *milvuspb.DescribeCollectionRequest) bool {
+			return req.GetCollectionID() == 1
+		})).Return(&milvuspb.DescribeCollectionResponse{
+			Status:       merr.Success(),
+			CollectionID: 1,
+			Schema:       schema,
+			ShardsNum:    2,
+		}, nil)
+		s.rootcoord.EXPECT().ShowPartitions(mock.Anything, mock.MatchedBy(func(req *milvuspb.ShowPartitionsRequest) bool {
+			return req.GetCollectionID() == 1
+		})).Return(&milvuspb.ShowPartitionsResponse{
+			Status:         merr.Success(),
+			PartitionNames: []string{"_default", "p1"},
+			PartitionIDs:   []int64{10, 11},
+		}, nil)
+		s.rootcoord.EXPECT().CreateCollection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("target", req.GetCollectionName())
+			s.EqualValues(2, req.GetShardsNum())
+			target := &schemapb.CollectionSchema{}
+			s.NoError(proto.Unmarshal(req.GetSchema(), target))
+			s.Equal("target", target.GetName())
+			s.Len(target.GetFields(), 2)
+			return merr.Success(), nil
+		})
+		s.rootcoord.EXPECT().DescribeCollection(mock.Anything, mock.MatchedBy(func(req *milvuspb.DescribeCollectionRequest) bool {
+			return req.GetCollectionName() == "target"
+		})).Return(&milvuspb.DescribeCollectionResponse{
+			Status:       merr.Success(),
+			CollectionID: 2,
+			Schema:       schema,
+			ShardsNum:    2,
+		}, nil)
+		s.rootcoord.EXPECT().ShowPartitions(mock.Anything, mock.MatchedBy(func(req *milvuspb.ShowPartitionsRequest) bool {
+			return req.GetCollectionID() == 2
+		})).Return(&milvuspb.ShowPartitionsResponse{
+			Status:         merr.Success(),
+			PartitionNames: []string{"_default"},
+			PartitionIDs:   []int64{20},
+		}, nil).Once()
+		s.rootcoord.EXPECT().CreatePartition(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.CreatePartitionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("p1", req.GetPartitionName())
+			return merr.Success(), nil
+		})
+		s.rootcoord.EXPECT().ShowPartitions(mock.Anything, mock.MatchedBy(func(req *milvuspb.ShowPartitionsRequest) bool {
+			return req.GetCollectionID() == 2
+		})).Return(&milvuspb.ShowPartitionsResponse{
+			Status:         merr.Success(),
+			PartitionNames: []string{"_default", "p1"},
+			PartitionIDs:   []int64{20, 21},
+		}, nil).Once()
+	}
+
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		setupSource()
+
+		s.datacoord.EXPECT().RestoreSnapshot(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.RestoreSnapshotRequest, opts ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			s.EqualValues(2, req.GetTargetCollectionID())
+			s.Equal("snapshot", req.GetName())
+			s.Equal(map[int64]int64{10: 20, 11: 21}, req.GetPartitionMapping())
+			return &datapb.RestoreSnapshotResponse{Status: merr.Success(), SegmentIDs: []int64{1000}, NumRows: 100}, nil
+		})
+		resp, err := s.proxy.CreateCollectionFromSnapshot(s.ctx, &proxypb.CreateCollectionFromSnapshotRequest{
+			CollectionName:       "test_collection",
+			SnapshotName:         "snapshot",
+			TargetCollectionName: "target",
+		})
+		s.NoError(err)
+		s.NoError(merr.Error(resp.GetStatus()))
+		s.Equal([]*proxypb.RestoredCollection{{
+			DbName:         "default",
+			CollectionName: "target",
+			SegmentIDs:     []int64{1000},
+			NumRows:        100,
+		}}, resp.GetCollections())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		resp, err := s.proxy.CreateCollectionFromSnapshot(s.ctx, &proxypb.CreateCollectionFromSnapshotRequest{
+			CollectionName: "test_collection",
+			SnapshotName:   "snapshot",
+		})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+
+		// target collection dropped once restore failed
+		setupSource()
+		s.datacoord.EXPECT().RestoreSnapshot(mock.Anything, mock.Anything).Return(&datapb.RestoreSnapshotResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("snapshot not found")),
+		}, nil)
+		s.rootcoord.EXPECT().DropCollection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("target", req.GetCollectionName())
+			return merr.Success(), nil
+		})
+		resp, err = s.proxy.CreateCollectionFromSnapshot(s.ctx, &proxypb.CreateCollectionFromSnapshotRequest{
+			CollectionName:       "test_collection",
+			SnapshotName:         "snapshot",
+			TargetCollectionName: "target",
+		})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	s.Run("unauthenticated", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		resp, err := s.proxy.CreateCollectionFromSnapshot(context.Background(), &proxypb.CreateCollectionFromSnapshotRequest{
+			CollectionName:       "test_collection",
+			SnapshotName:         "snapshot",
+			TargetCollectionName: "target",
+		})
+		s.NoError(err)
+		s.Error(merr.Error(resp.GetStatus()))
+	})
+}
+
+func (s *CollectionRestoreSuite) TestRestoreBackup() {
+	schema := &schemapb.CollectionSchema{
+		Name: "test_collection",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vector", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	backup := &datapb.BackupInfo{
+		Name: "backup",
+		Collections: []*datapb.ReplicaInfo{{
+			Collection: &milvuspb.DescribeCollectionResponse{
+				CollectionID:   1,
+				CollectionName: "test_collection",
+				DbName:         "default",
+				Schema:         schema,
+			},
+			PartitionNames: []string{"_default"},
+			PartitionIDs:   []int64{10},
+			Indexes:        []*indexpb.IndexInfo{{FieldID: 101, IndexName: "vector_index"}},
+		}},
+	}
+
+	s.Run("restore", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().ListBackups(mock.Anything, mock.Anything).Return(&datapb.ListBackupsResponse{
+			Status:  merr.Success(),
+			Backups: []*datapb.BackupInfo{backup},
+		}, nil)
+		s.rootcoord.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(&milvuspb.ListDatabasesResponse{
+			Status:  merr.Success(),
+			DbNames: []string{"default"},
+		}, nil)
+		s.rootcoord.EXPECT().CreateDatabase(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("restored", req.GetDbName())
+			return merr.Success(), nil
+		})
+		s.rootcoord.EXPECT().CreateCollection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("restored", req.GetDbName())
+			s.Equal("test_collection", req.GetCollectionName())
+			return merr.Success(), nil
+		})
+		s.rootcoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+			Status:       merr.Success(),
+			CollectionID: 2,
+			Schema:       schema,
+		}, nil)
+		s.rootcoord.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+			Status:         merr.Success(),
+			PartitionNames: []string{"_default"},
+			PartitionIDs:   []int64{20},
+		}, nil)
+		// the indexes are created before the segments restored to reuse the index files of the backup
+		indexCreated := false
+		s.datacoord.EXPECT().CreateIndex(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *indexpb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.EqualValues(2, req.GetCollectionID())
+			s.EqualValues(101, req.GetFieldID())
+			s.Equal("vector_index", req.GetIndexName())
+			indexCreated = true
+			return merr.Success(), nil
+		})
+		s.datacoord.EXPECT().RestoreBackup(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.RestoreBackupRequest, opts ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error) {
+			s.True(indexCreated)
+			s.Equal("backup", req.GetName())
+			s.EqualValues(1, req.GetCollectionID())
+			s.EqualValues(2, req.GetTargetCollectionID())
+			s.Equal(map[int64]int64{10: 20}, req.GetPartitionMapping())
+			return &datapb.RestoreSnapshotResponse{Status: merr.Success(), SegmentIDs: []int64{1000}, NumRows: 100}, nil
+		})
+
+		resp, err := s.proxy.RestoreBackup(s.ctx, &proxypb.RestoreBackupRequest{
+			BackupName:      "backup",
+			CollectionNames: []string{"test_collection"},
+			TargetDbName:    "restored",
+		})
+		s.NoError(err)
+		s.NoError(merr.Error(resp.GetStatus()))
+		s.Equal([]*proxypb.RestoredCollection{{
+			DbName:         "restored",
+			CollectionName: "test_collection",
+			SegmentIDs:     []int64{1000},
+			NumRows:        100,
+		}}, resp.GetCollections())
+	})
+
+	s.Run("invalid", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().ListBackups(mock.Anything, mock.Anything).Return(&datapb.ListBackupsResponse{
+			Status:  merr.Success(),
+			Backups: []*datapb.BackupInfo{backup},
+		}, nil)
+		for _, req := range []*proxypb.RestoreBackupRequest{
+			{BackupName: "unknown"},
+			{BackupName: "backup", CollectionNames: []string{"other"}},
+			{BackupName: "backup", DbName: "other"},
+		} {
+			resp, err := s.proxy.RestoreBackup(s.ctx, req)
+			s.NoError(err)
+			s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+		}
+	})
+
+	s.Run("unauthenticated", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		// nothing is restored without the privileges
+		s.datacoord.EXPECT().ListBackups(mock.Anything, mock.Anything).Return(&datapb.ListBackupsResponse{
+			Status:  merr.Success(),
+			Backups: []*datapb.BackupInfo{backup},
+		}, nil)
+		s.rootcoord.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(&milvuspb.ListDatabasesResponse{
+			Status:  merr.Success(),
+			DbNames: []string{"default"},
+		}, nil)
+		resp, err := s.proxy.RestoreBackup(context.Background(), &proxypb.RestoreBackupRequest{
+			BackupName:   "backup",
+			TargetDbName: "restored",
+		})
+		s.NoError(err)
+		s.Error(merr.Error(resp.GetStatus()))
+	})
+}
+
+func (s *CollectionRestoreSuite) TestPromoteReplica() {
+	schema := &schemapb.CollectionSchema{
+		Name: "test_collection",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vector", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	replica := &datapb.ReplicaInfo{
+		Collection: &milvuspb.DescribeCollectionResponse{
+			CollectionID:   1,
+			CollectionName: "test_collection",
+			DbName:         "db",
+			Schema:         schema,
+			ShardsNum:      2,
+		},
+		PartitionNames: []string{"_default"},
+		PartitionIDs:   []int64{10},
+		Indexes:        []*indexpb.IndexInfo{{FieldID: 101, IndexName: "vector_index"}},
+	}
+
+	s.Run("promote", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().ListReplicas(mock.Anything, mock.Anything).Return(&datapb.ListReplicasResponse{
+			Status:   merr.Success(),
+			Replicas: []*datapb.ReplicaInfo{replica},
+		}, nil)
+		s.rootcoord.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(&milvuspb.ListDatabasesResponse{
+			Status:  merr.Success(),
+			DbNames: []string{"default"},
+		}, nil)
+		s.rootcoord.EXPECT().CreateDatabase(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("db", req.GetDbName())
+			return merr.Success(), nil
+		})
+		s.rootcoord.EXPECT().CreateCollection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.CreateCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("db", req.GetDbName())
+			s.Equal("test_collection", req.GetCollectionName())
+			return merr.Success(), nil
+		})
+		s.rootcoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+			Status:       merr.Success(),
+			CollectionID: 2,
+			Schema:       schema,
+			ShardsNum:    2,
+		}, nil)
+		s.rootcoord.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+			Status:         merr.Success(),
+			PartitionNames: []string{"_default"},
+			PartitionIDs:   []int64{20},
+		}, nil)
+		s.datacoord.EXPECT().CreateIndex(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *indexpb.CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.EqualValues(2, req.GetCollectionID())
+			s.EqualValues(101, req.GetFieldID())
+			s.Equal("vector_index", req.GetIndexName())
+			return merr.Success(), nil
+		})
+		s.datacoord.EXPECT().PromoteReplica(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.PromoteReplicaRequest, opts ...grpc.CallOption) (*datapb.RestoreSnapshotResponse, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			s.EqualValues(2, req.GetTargetCollectionID())
+			s.Equal(map[int64]int64{10: 20}, req.GetPartitionMapping())
+			return &datapb.RestoreSnapshotResponse{Status: merr.Success(), SegmentIDs: []int64{1000}, NumRows: 100}, nil
+		})
+
+		resp, err := s.proxy.PromoteReplica(s.ctx, &proxypb.PromoteReplicaRequest{
+			DbName:         "db",
+			CollectionName: "test_collection",
+		})
+		s.NoError(err)
+		s.NoError(merr.Error(resp.GetStatus()))
+		s.Equal([]*proxypb.RestoredCollection{{
+			DbName:         "db",
+			CollectionName: "test_collection",
+			SegmentIDs:     []int64{1000},
+			NumRows:        100,
+		}}, resp.GetCollections())
+
+		// no replica of the collection
+		resp, err = s.proxy.PromoteReplica(s.ctx, &proxypb.PromoteReplicaRequest{CollectionName: "unknown"})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotFound)
+	})
+
+	s.Run("promote_failed", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().ListReplicas(mock.Anything, mock.Anything).Return(&datapb.ListReplicasResponse{
+			Status:   merr.Success(),
+			Replicas: []*datapb.ReplicaInfo{replica},
+		}, nil)
+		s.rootcoord.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(&milvuspb.ListDatabasesResponse{
+			Status:  merr.Success(),
+			DbNames: []string{"default", "db"},
+		}, nil)
+		s.rootcoord.EXPECT().CreateCollection(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		s.rootcoord.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+			Status:       merr.Success(),
+			CollectionID: 2,
+			Schema:       schema,
+		}, nil)
+		s.rootcoord.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+			Status:         merr.Success(),
+			PartitionNames: []string{"_default"},
+			PartitionIDs:   []int64{20},
+		}, nil)
+		s.datacoord.EXPECT().CreateIndex(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		s.datacoord.EXPECT().PromoteReplica(mock.Anything, mock.Anything).Return(&datapb.RestoreSnapshotResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("replication storage not configured")),
+		}, nil)
+		// the collection created is dropped if failed to promote
+		s.rootcoord.EXPECT().DropCollection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("test_collection", req.GetCollectionName())
+			return merr.Success(), nil
+		})
+
+		resp, err := s.proxy.PromoteReplica(s.ctx, &proxypb.PromoteReplicaRequest{})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceUnavailable)
+	})
+
+	s.Run("unauthenticated", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		// nothing is promoted without the privileges
+		s.datacoord.EXPECT().ListReplicas(mock.Anything, mock.Anything).Return(&datapb.ListReplicasResponse{
+			Status:   merr.Success(),
+			Replicas: []*datapb.ReplicaInfo{replica},
+		}, nil)
+		s.rootcoord.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(&milvuspb.ListDatabasesResponse{
+			Status:  merr.Success(),
+			DbNames: []string{"default", "db"},
+		}, nil)
+		resp, err := s.proxy.PromoteReplica(context.Background(), &proxypb.PromoteReplicaRequest{})
+		s.NoError(err)
+		s.Error(merr.Error(resp.GetStatus()))
+	})
+}
+
+func TestCollectionRestore(t *testing.T) {
+	suite.Run(t, new(CollectionRestoreSuite))
+}
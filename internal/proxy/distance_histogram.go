@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const maxDistanceHistogramBuckets = 1000
+
+// distanceHistogram buckets the distances of the range search results evenly between the radius and the range filter,
+// which is returned instead of the results.
+type distanceHistogram struct {
+	buckets int
+	lower   float64
+	upper   float64
+}
+
+// DistanceHistogramResult is the histogram returned in the extra info of status,
+// Bounds are the buckets bounds in ascending order, Counts are the counts of the buckets of each query.
+type DistanceHistogramResult struct {
+	Bounds []float64 `json:"bounds"`
+	Counts [][]int64 `json:"counts"`
+}
+
+// parseDistanceHistogram returns the histogram requested by the range search, nil if not requested.
+func parseDistanceHistogram(params []*commonpb.KeyValuePair) (*distanceHistogram, error) {
+	bucketsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(DistanceHistogramKey, params)
+	if err != nil {
+		return nil, nil
+	}
+	buckets, err := strconv.Atoi(bucketsStr)
+	if err != nil || buckets <= 0 || buckets > maxDistanceHistogramBuckets {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %s, shall be an integer in range [1, %d]", DistanceHistogramKey, bucketsStr, maxDistanceHistogramBuckets)
+	}
+
+	searchParamStr, _ := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, params)
+	var searchParams map[string]interface{}
+	if err := json.Unmarshal([]byte(searchParamStr), &searchParams); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("distance histogram is only supported by range search with radius and range_filter")
+	}
+	radius, ok1 := searchParams[radiusKey].(float64)
+	rangeFilter, ok2 := searchParams[rangeFilterKey].(float64)
+	if !ok1 || !ok2 {
+		return nil, merr.WrapErrParameterInvalidMsg("distance histogram is only supported by range search with radius and range_filter")
+	}
+	return &distanceHistogram{
+		buckets: buckets,
+		lower:   math.Min(radius, rangeFilter),
+		upper:   math.Max(radius, rangeFilter),
+	}, nil
+}
+
+func (h *distanceHistogram) compute(results *schemapb.SearchResultData) *DistanceHistogramResult {
+	width := (h.upper - h.lower) / float64(h.buckets)
+	bounds := make([]float64, h.buckets+1)
+	for i := range bounds {
+		bounds[i] = h.lower + width*float64(i)
+	}
+	bounds[h.buckets] = h.upper
+
+	counts := make([][]int64, results.GetNumQueries())
+	offset := int64(0)
+	for i := range counts {
+		counts[i] = make([]int64, h.buckets)
+		var topk int64
+		if i < len(results.GetTopks()) {
+			topk = results.GetTopks()[i]
+		}
+		for _, score := range results.GetScores()[offset : offset+topk] {
+			bucket := int((float64(score) - h.lower) / width)
+			if bucket < 0 {
+				bucket = 0
+			} else if bucket >= h.buckets {
+				bucket = h.buckets - 1
+			}
+			counts[i][bucket]++
+		}
+		offset += topk
+	}
+	return &DistanceHistogramResult{Bounds: bounds, Counts: counts}
+}
+
+// apply replaces the search results with the histogram of the distances in the extra info of status.
+func (h *distanceHistogram) apply(result *milvuspb.SearchResults) error {
+	histogram := h.compute(result.GetResults())
+	bs, err := json.Marshal(histogram)
+	if err != nil {
+		return err
+	}
+	if result.Status == nil {
+		result.Status = merr.Success()
+	}
+	if result.Status.ExtraInfo == nil {
+		result.Status.ExtraInfo = make(map[string]string)
+	}
+	result.Status.ExtraInfo[DistanceHistogramKey] = string(bs)
+
+	numQueries := result.GetResults().GetNumQueries()
+	result.Results = &schemapb.SearchResultData{
+		NumQueries: numQueries,
+		Topks:      make([]int64, numQueries),
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestParseDistanceHistogram(t *testing.T) {
+	params := func(buckets string, searchParams string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{
+			{Key: DistanceHistogramKey, Value: buckets},
+			{Key: SearchParamsKey, Value: searchParams},
+		}
+	}
+
+	h, err := parseDistanceHistogram(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, h)
+
+	h, err = parseDistanceHistogram(params("4", `{"radius": 1.0, "range_filter": 0.2}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &distanceHistogram{buckets: 4, lower: 0.2, upper: 1.0}, h)
+
+	for _, c := range [][]string{
+		{"0", `{"radius": 1.0, "range_filter": 0.2}`},
+		{"1001", `{"radius": 1.0, "range_filter": 0.2}`},
+		{"a", `{"radius": 1.0, "range_filter": 0.2}`},
+		{"4", `{"radius": 1.0}`},
+		{"4", `{"nprobe": 10}`},
+		{"4", ``},
+	} {
+		_, err = parseDistanceHistogram(params(c[0], c[1]))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid, c)
+	}
+}
+
+func TestDistanceHistogramApply(t *testing.T) {
+	h := &distanceHistogram{buckets: 4, lower: 0, upper: 1}
+	result := &milvuspb.SearchResults{
+		Status: merr.Success(),
+		Results: &schemapb.SearchResultData{
+			NumQueries: 2,
+			TopK:       3,
+			Topks:      []int64{3, 2},
+			Scores:     []float32{0.1, 0.3, 1.0, 0.6, 0.7},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4, 5}}}},
+		},
+	}
+	require.NoError(t, h.apply(result))
+
+	histogram := &DistanceHistogramResult{}
+	require.NoError(t, json.Unmarshal([]byte(result.GetStatus().GetExtraInfo()[DistanceHistogramKey]), histogram))
+	assert.Equal(t, []float64{0, 0.25, 0.5, 0.75, 1}, histogram.Bounds)
+	assert.Equal(t, [][]int64{{1, 1, 0, 1}, {0, 0, 2, 0}}, histogram.Counts)
+
+	// the results are not returned
+	assert.Equal(t, int64(2), result.GetResults().GetNumQueries())
+	assert.Equal(t, []int64{0, 0}, result.GetResults().GetTopks())
+	assert.Nil(t, result.GetResults().GetIds())
+	assert.Empty(t, result.GetResults().GetScores())
+}
@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/exprutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// getMaskedFields returns the ids of the fields masked for any role of the current user,
+// nothing is masked if the authorization is disabled or for the root user.
+func getMaskedFields(ctx context.Context, schema *schemaInfo, masks map[string][]string) (typeutil.UniqueSet, error) {
+	if len(masks) == 0 {
+		return nil, nil
+	}
+	roleNames, err := getRestrictedRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	masked := typeutil.NewUniqueSet()
+	for _, roleName := range roleNames {
+		for _, name := range masks[roleName] {
+			// the fields dropped from the schema are ignored
+			if field, err := schema.schemaHelper.GetFieldFromName(name); err == nil {
+				masked.Insert(field.GetFieldID())
+			}
+		}
+	}
+	return masked, nil
+}
+
+// maskFields removes the masked fields from the fields data and the output fields of the results.
+func maskFields(schema *schemaInfo, masked typeutil.UniqueSet, fieldsData []*schemapb.FieldData, outputFields []string) ([]*schemapb.FieldData, []string) {
+	if len(masked) == 0 {
+		return fieldsData, outputFields
+	}
+	retFieldsData := make([]*schemapb.FieldData, 0, len(fieldsData))
+	for _, fieldData := range fieldsData {
+		if !masked.Contain(fieldData.GetFieldId()) {
+			retFieldsData = append(retFieldsData, fieldData)
+		}
+	}
+	retOutputFields := make([]string, 0, len(outputFields))
+	for _, name := range outputFields {
+		if fieldID, ok := schema.fieldMap.Get(name); ok && masked.Contain(fieldID) {
+			continue
+		}
+		retOutputFields = append(retOutputFields, name)
+	}
+	return retFieldsData, retOutputFields
+}
+
+// checkMaskedFields refuses to filter, group, order or aggregate by the masked fields, whose values would be leaked
+// by the results although the fields themselves are removed from them.
+func checkMaskedFields(schema *schemaInfo, masked typeutil.UniqueSet, plan *planpb.PlanNode, fieldIDs ...int64) error {
+	if len(masked) == 0 {
+		return nil
+	}
+	used := typeutil.NewUniqueSet(fieldIDs...)
+	if plan != nil {
+		if expr, err := exprutil.ParseExprFromPlan(plan); err == nil {
+			collectColumns(expr, used)
+		}
+		if groupByFieldID := plan.GetVectorAnns().GetQueryInfo().GetGroupByFieldId(); groupByFieldID > 0 {
+			used.Insert(groupByFieldID)
+		}
+	}
+	for fieldID := range used {
+		if !masked.Contain(fieldID) {
+			continue
+		}
+		name := strconv.FormatInt(fieldID, 10)
+		if field, err := schema.schemaHelper.GetFieldFromID(fieldID); err == nil {
+			name = field.GetName()
+		}
+		return merr.WrapErrPrivilegeNotPermitted("field %s is masked for the user", name)
+	}
+	return nil
+}
+
+// collectColumns inserts the fields referred by the expression into the ids.
+func collectColumns(expr *planpb.Expr, ids typeutil.UniqueSet) {
+	insert := func(info *planpb.ColumnInfo) {
+		if info != nil {
+			ids.Insert(info.GetFieldId())
+		}
+	}
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_TermExpr:
+		insert(e.TermExpr.GetColumnInfo())
+	case *planpb.Expr_UnaryExpr:
+		collectColumns(e.UnaryExpr.GetChild(), ids)
+	case *planpb.Expr_BinaryExpr:
+		collectColumns(e.BinaryExpr.GetLeft(), ids)
+		collectColumns(e.BinaryExpr.GetRight(), ids)
+	case *planpb.Expr_CompareExpr:
+		insert(e.CompareExpr.GetLeftColumnInfo())
+		insert(e.CompareExpr.GetRightColumnInfo())
+	case *planpb.Expr_UnaryRangeExpr:
+		insert(e.UnaryRangeExpr.GetColumnInfo())
+	case *planpb.Expr_BinaryRangeExpr:
+		insert(e.BinaryRangeExpr.GetColumnInfo())
+	case *planpb.Expr_BinaryArithOpEvalRangeExpr:
+		insert(e.BinaryArithOpEvalRangeExpr.GetColumnInfo())
+	case *planpb.Expr_BinaryArithExpr:
+		collectColumns(e.BinaryArithExpr.GetLeft(), ids)
+		collectColumns(e.BinaryArithExpr.GetRight(), ids)
+	case *planpb.Expr_ColumnExpr:
+		insert(e.ColumnExpr.GetInfo())
+	case *planpb.Expr_ExistsExpr:
+		insert(e.ExistsExpr.GetInfo())
+	case *planpb.Expr_JsonContainsExpr:
+		insert(e.JsonContainsExpr.GetColumnInfo())
+	}
+}
@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestFieldMask(t *testing.T) {
+	paramtable.Init()
+	schema := newSchemaInfo(constructCollectionSchema(testInt64Field, testFloatVecField, 8, "coll"))
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "false")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+		masked, err := getMaskedFields(context.Background(), schema, map[string][]string{"public": {testFloatVecField}})
+		assert.NoError(t, err)
+		assert.Empty(t, masked)
+	})
+
+	t.Run("root", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+		ctx := GetContext(context.Background(), "root:123456")
+		masked, err := getMaskedFields(ctx, schema, map[string][]string{"public": {testFloatVecField}})
+		assert.NoError(t, err)
+		assert.Empty(t, masked)
+
+		_, err = getMaskedFields(context.Background(), schema, map[string][]string{"public": {testFloatVecField}})
+		assert.Error(t, err)
+	})
+
+	t.Run("mask fields", func(t *testing.T) {
+		fieldsData := []*schemapb.FieldData{
+			{FieldName: testInt64Field, FieldId: 100},
+			{FieldName: testFloatVecField, FieldId: 101},
+		}
+		retFieldsData, retOutputFields := maskFields(schema, nil, fieldsData, []string{testInt64Field, testFloatVecField})
+		assert.Equal(t, fieldsData, retFieldsData)
+		assert.Equal(t, []string{testInt64Field, testFloatVecField}, retOutputFields)
+
+		retFieldsData, retOutputFields = maskFields(schema, typeutil.NewUniqueSet(101), fieldsData, []string{testInt64Field, testFloatVecField})
+		assert.Len(t, retFieldsData, 1)
+		assert.Equal(t, int64(100), retFieldsData[0].GetFieldId())
+		assert.Equal(t, []string{testInt64Field}, retOutputFields)
+	})
+
+	t.Run("check masked fields", func(t *testing.T) {
+		expr, err := planparserv2.ParseExpr(schema.schemaHelper, testInt64Field+" > 10")
+		assert.NoError(t, err)
+		plan := planparserv2.CreateRetrievePlanByExpr(&planpb.Expr{
+			Expr: &planpb.Expr_UnaryExpr{UnaryExpr: &planpb.UnaryExpr{Op: planpb.UnaryExpr_Not, Child: expr}},
+		})
+
+		assert.NoError(t, checkMaskedFields(schema, nil, plan, 100))
+		assert.NoError(t, checkMaskedFields(schema, typeutil.NewUniqueSet(101), plan))
+		// filtered by the masked field
+		err = checkMaskedFields(schema, typeutil.NewUniqueSet(100), plan)
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+		// aggregated or grouped by the masked field
+		err = checkMaskedFields(schema, typeutil.NewUniqueSet(101), nil, 0, 101)
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+
+		searchPlan := &planpb.PlanNode{Node: &planpb.PlanNode_VectorAnns{VectorAnns: &planpb.VectorANNS{
+			QueryInfo: &planpb.QueryInfo{GroupByFieldId: 100},
+		}}}
+		err = checkMaskedFields(schema, typeutil.NewUniqueSet(100), searchPlan)
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+	})
+}
@@ -0,0 +1,117 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// idempotencyKey is the idempotency key carried by the mutation request, the results of which are recorded by
+// DataCoord within the deduplication window, so that the client retries after timeouts don't mutate twice.
+type idempotencyKey struct {
+	collectionID int64
+	key          string
+}
+
+func (k *idempotencyKey) String() string {
+	return fmt.Sprintf("%d/%s", k.collectionID, k.key)
+}
+
+// idempotencyFinalizeTimeout is the timeout to record the result, which is detached from the request context.
+const idempotencyFinalizeTimeout = 10 * time.Second
+
+// acquireIdempotencyKey acquires the idempotency key carried by the request, the key is recorded pending by DataCoord
+// until finalized, so that the retries sent to any proxy meanwhile are rejected. It returns the result recorded instead
+// if the request with the key completed, or nil for both if the request carries no key.
+func (node *Proxy) acquireIdempotencyKey(ctx context.Context, dbName, collectionName string) (*idempotencyKey, *milvuspb.MutationResult, error) {
+	key := GetIdempotencyKeyFromContext(ctx)
+	if key == "" {
+		return nil, nil, nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := node.dataCoord.GetIdempotencyRecord(ctx, &datapb.GetIdempotencyRecordRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		CollectionID: collectionID,
+		Key:          key,
+		Acquire:      true,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return nil, nil, err
+	}
+	if resp.GetAcquired() {
+		return &idempotencyKey{collectionID: collectionID, key: key}, nil, nil
+	}
+	if resp.GetRecord().GetState() != datapb.IdempotencyRecordState_IdempotencyCompleted {
+		return nil, nil, merr.WrapErrServiceUnavailable("request with the same idempotency key is in progress")
+	}
+	result := &milvuspb.MutationResult{}
+	if err := proto.Unmarshal(resp.GetRecord().GetResult(), result); err != nil {
+		return nil, nil, err
+	}
+	return nil, result, nil
+}
+
+// finalizeIdempotencyKey records the result of the succeeded request, or the key aborted if the request failed,
+// so that the retries are executed again. It's done even if the request canceled, otherwise the retries are
+// rejected until the record expired. The failure to record is logged only.
+func (node *Proxy) finalizeIdempotencyKey(ctx context.Context, k *idempotencyKey, result *milvuspb.MutationResult) {
+	if k == nil {
+		return
+	}
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", k.collectionID), zap.String("idempotencyKey", k.key))
+	record := &datapb.IdempotencyRecord{
+		Key:          k.key,
+		CollectionID: k.collectionID,
+		CreateTime:   time.Now().UnixMilli(),
+		State:        datapb.IdempotencyRecordState_IdempotencyAborted,
+	}
+	if result.GetStatus().GetErrorCode() == commonpb.ErrorCode_Success {
+		value, err := proto.Marshal(result)
+		if err != nil {
+			log.Warn("failed to marshal mutation result", zap.Error(err))
+		} else {
+			record.Result = value
+			record.State = datapb.IdempotencyRecordState_IdempotencyCompleted
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), idempotencyFinalizeTimeout)
+	defer cancel()
+	status, err := node.dataCoord.SaveIdempotencyRecord(ctx, &datapb.SaveIdempotencyRecordRequest{
+		Base:   commonpbutil.NewMsgBase(commonpbutil.WithSourceID(paramtable.GetNodeID())),
+		Record: record,
+	})
+	if err = merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("failed to save idempotency record", zap.String("state", record.GetState().String()), zap.Error(err))
+	}
+}
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type IdempotencySuite struct {
+	suite.Suite
+
+	datacoord *mocks.MockDataCoordClient
+	proxy     *Proxy
+	cache     Cache
+}
+
+func (s *IdempotencySuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *IdempotencySuite) SetupTest() {
+	s.datacoord = mocks.NewMockDataCoordClient(s.T())
+	s.proxy = &Proxy{
+		dataCoord: s.datacoord,
+	}
+	s.proxy.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	s.cache = globalMetaCache
+	metaCache := NewMockCache(s.T())
+	metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil).Maybe()
+	globalMetaCache = metaCache
+}
+
+func (s *IdempotencySuite) TearDownTest() {
+	globalMetaCache = s.cache
+}
+
+func (s *IdempotencySuite) withKey(key string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(util.HeaderIdempotencyKey, key))
+}
+
+func (s *IdempotencySuite) TestNoKey() {
+	k, result, err := s.proxy.acquireIdempotencyKey(context.Background(), "", "test_collection")
+	s.NoError(err)
+	s.Nil(k)
+	s.Nil(result)
+	// no-op without key
+	s.proxy.finalizeIdempotencyKey(context.Background(), k, &milvuspb.MutationResult{Status: merr.Success()})
+}
+
+func (s *IdempotencySuite) TestAcquireAndFinalize() {
+	ctx := s.withKey("key")
+	s.datacoord.EXPECT().GetIdempotencyRecord(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.GetIdempotencyRecordRequest, opts ...grpc.CallOption) (*datapb.GetIdempotencyRecordResponse, error) {
+		s.EqualValues(1, req.GetCollectionID())
+		s.Equal("key", req.GetKey())
+		s.True(req.GetAcquire())
+		return &datapb.GetIdempotencyRecordResponse{Status: merr.Success(), Acquired: true}, nil
+	}).Once()
+	k, result, err := s.proxy.acquireIdempotencyKey(ctx, "", "test_collection")
+	s.Require().NoError(err)
+	s.Require().NotNil(k)
+	s.Nil(result)
+	s.EqualValues(1, k.collectionID)
+	s.Equal("key", k.key)
+
+	var saved *datapb.IdempotencyRecord
+	s.datacoord.EXPECT().SaveIdempotencyRecord(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.SaveIdempotencyRecordRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+		saved = req.GetRecord()
+		return merr.Success(), nil
+	}).Twice()
+	// failed results are recorded aborted, so that the retries are executed again
+	s.proxy.finalizeIdempotencyKey(ctx, k, &milvuspb.MutationResult{Status: merr.Status(errors.New("mock"))})
+	s.Equal(datapb.IdempotencyRecordState_IdempotencyAborted, saved.GetState())
+	s.Empty(saved.GetResult())
+
+	// finalized even if the request canceled
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	s.proxy.finalizeIdempotencyKey(canceled, k, &milvuspb.MutationResult{Status: merr.Success(), InsertCnt: 10})
+	s.EqualValues(1, saved.GetCollectionID())
+	s.Equal("key", saved.GetKey())
+	s.Equal(datapb.IdempotencyRecordState_IdempotencyCompleted, saved.GetState())
+
+	// completed
+	s.datacoord.EXPECT().GetIdempotencyRecord(mock.Anything, mock.Anything).Return(&datapb.GetIdempotencyRecordResponse{
+		Status: merr.Success(),
+		Record: saved,
+	}, nil).Once()
+	k, result, err = s.proxy.acquireIdempotencyKey(ctx, "", "test_collection")
+	s.NoError(err)
+	s.Nil(k)
+	s.EqualValues(10, result.GetInsertCnt())
+
+	// in progress
+	s.datacoord.EXPECT().GetIdempotencyRecord(mock.Anything, mock.Anything).Return(&datapb.GetIdempotencyRecordResponse{
+		Status: merr.Success(),
+		Record: &datapb.IdempotencyRecord{Key: "key", CollectionID: 1, State: datapb.IdempotencyRecordState_IdempotencyPending},
+	}, nil).Once()
+	_, _, err = s.proxy.acquireIdempotencyKey(ctx, "", "test_collection")
+	s.ErrorIs(err, merr.ErrServiceUnavailable)
+
+	s.datacoord.EXPECT().GetIdempotencyRecord(mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+	_, _, err = s.proxy.acquireIdempotencyKey(ctx, "", "test_collection")
+	s.Error(err)
+}
+
+func (s *IdempotencySuite) TestInsertDeduplicated() {
+	value, err := proto.Marshal(&milvuspb.MutationResult{Status: merr.Success(), InsertCnt: 10})
+	s.Require().NoError(err)
+	s.datacoord.EXPECT().GetIdempotencyRecord(mock.Anything, mock.Anything).Return(&datapb.GetIdempotencyRecordResponse{
+		Status: merr.Success(),
+		Record: &datapb.IdempotencyRecord{Key: "key", CollectionID: 1, Result: value},
+	}, nil).Once()
+
+	resp, err := s.proxy.Insert(s.withKey("key"), &milvuspb.InsertRequest{CollectionName: "test_collection", NumRows: 10})
+	s.NoError(err)
+	s.NoError(merr.Error(resp.GetStatus()))
+	s.EqualValues(10, resp.GetInsertCnt())
+}
+
+func TestIdempotency(t *testing.T) {
+	suite.Run(t, new(IdempotencySuite))
+}
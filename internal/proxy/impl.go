@@ -20,8 +20,10 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -31,6 +33,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/federpb"
@@ -38,10 +41,12 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proxy/connection"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
 	"github.com/milvus-io/milvus/internal/util/importutilv2"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -64,6 +69,9 @@ import (
 
 const moduleName = "Proxy"
 
+// batchSearchMaxRequests is the max number of the searches in one BatchSearch request
+const batchSearchMaxRequests = 64
+
 // GetComponentStates gets the state of Proxy.
 func (node *Proxy) GetComponentStates(ctx context.Context, req *milvuspb.GetComponentStatesRequest) (*milvuspb.ComponentStates, error) {
 	stats := &milvuspb.ComponentStates{
@@ -128,6 +136,15 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 				aliasName = globalMetaCache.RemoveCollectionsByID(ctx, collectionID)
 			}
 			log.Info("complete to invalidate collection meta cache with collection name", zap.String("collectionName", collectionName))
+		case commonpb.MsgType_AlterCollection:
+			// the schema of the collection changed, e.g. a field added
+			if collectionName != "" {
+				globalMetaCache.RemoveCollection(ctx, request.GetDbName(), collectionName)
+			}
+			if request.CollectionID != UniqueID(0) {
+				aliasName = globalMetaCache.RemoveCollectionsByID(ctx, collectionID)
+			}
+			log.Info("complete to invalidate collection meta cache of altered collection")
 		case commonpb.MsgType_DropPartition:
 			if globalMetaCache != nil {
 				if collectionName != "" && request.GetPartitionName() != "" {
@@ -1092,7 +1109,7 @@ func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterC
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName),
-		zap.Any("props", request.Properties))
+		zap.Any("props", common.RedactProperties(request.Properties...)))
 
 	log.Info(
 		rpcReceived(method))
@@ -2360,6 +2377,18 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		metrics.InsertLabel, request.GetCollectionName()).Add(float64(proto.Size(request)))
 	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
 
+	idempotency, result, err := node.acquireIdempotencyKey(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		log.Warn("failed to acquire idempotency key", zap.Error(err))
+		return &milvuspb.MutationResult{
+			Status: merr.Status(err),
+		}, nil
+	}
+	if result != nil {
+		log.Info("insert request deduplicated by idempotency key")
+		return result, nil
+	}
+
 	it := &insertTask{
 		ctx:       ctx,
 		Condition: NewTaskCondition(ctx),
@@ -2405,7 +2434,9 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		log.Warn("Failed to enqueue insert task: " + err.Error())
 		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 			metrics.AbandonLabel, request.GetDbName(), request.GetCollectionName()).Inc()
-		return constructFailedResponse(err), nil
+		result = constructFailedResponse(err)
+		node.finalizeIdempotencyKey(ctx, idempotency, result)
+		return result, nil
 	}
 
 	log.Debug("Detail of insert request in Proxy")
@@ -2414,7 +2445,9 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		log.Warn("Failed to execute insert task in task scheduler: " + err.Error())
 		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
 			metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
-		return constructFailedResponse(err), nil
+		result = constructFailedResponse(err)
+		node.finalizeIdempotencyKey(ctx, idempotency, result)
+		return result, nil
 	}
 
 	if it.result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
@@ -2457,6 +2490,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 	metrics.ProxyCollectionMutationLatency.
 		WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.InsertLabel, request.CollectionName).
 		Observe(float64(tr.ElapseSpan().Milliseconds()))
+	node.finalizeIdempotencyKey(ctx, idempotency, it.result)
 	return it.result, nil
 }
 
@@ -2493,7 +2527,7 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	dr := &deleteRunner{
 		req:             request,
 		idAllocator:     node.rowIDAllocator,
-		tsoAllocatorIns: node.tsoAllocator,
+		tsoAllocatorIns: node.tsoAllocator.batched(),
 		chMgr:           node.chMgr,
 		chTicker:        node.chTicker,
 		queue:           node.sched.dmQueue,
@@ -2684,12 +2718,16 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 
 // Search searches the most similar records of requests.
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	if field := node.getMultiVectorSearchField(ctx, request); field != nil {
+		return node.searchMultiVector(ctx, request, field)
+	}
+
 	var err error
 	rsp := &milvuspb.SearchResults{
 		Status: merr.Success(),
 	}
 	err2 := retry.Handle(ctx, func() (bool, error) {
-		rsp, err = node.search(ctx, request)
+		rsp, err = node.search(ctx, node.newSearchTask(ctx, request))
 		if errors.Is(merr.Error(rsp.GetStatus()), merr.ErrInconsistentRequery) {
 			return true, merr.Error(rsp.GetStatus())
 		}
@@ -2701,7 +2739,28 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 	return rsp, err
 }
 
-func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+func (node *Proxy) newSearchTask(ctx context.Context, request *milvuspb.SearchRequest) *searchTask {
+	return &searchTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		SearchRequest: &internalpb.SearchRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Search),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request:                request,
+		tr:                     timerecord.NewTimeRecorder("search"),
+		qc:                     node.queryCoord,
+		node:                   node,
+		lb:                     node.lbPolicy,
+		enableMaterializedView: node.enableMaterializedView,
+	}
+}
+
+func (node *Proxy) search(ctx context.Context, qt *searchTask) (*milvuspb.SearchResults, error) {
+	request := qt.request
 	receiveSize := proto.Size(request)
 	metrics.ProxyReceiveBytes.WithLabelValues(
 		strconv.FormatInt(paramtable.GetNodeID(), 10),
@@ -2735,6 +2794,7 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest)
 
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-Search")
 	defer sp.End()
+	qt.ctx = ctx
 
 	if request.SearchByPrimaryKeys {
 		placeholderGroupBytes, err := node.getVectorPlaceholderGroupForSearchByPks(ctx, request)
@@ -2747,24 +2807,6 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest)
 		request.PlaceholderGroup = placeholderGroupBytes
 	}
 
-	qt := &searchTask{
-		ctx:       ctx,
-		Condition: NewTaskCondition(ctx),
-		SearchRequest: &internalpb.SearchRequest{
-			Base: commonpbutil.NewMsgBase(
-				commonpbutil.WithMsgType(commonpb.MsgType_Search),
-				commonpbutil.WithSourceID(paramtable.GetNodeID()),
-			),
-			ReqID: paramtable.GetNodeID(),
-		},
-		request:                request,
-		tr:                     timerecord.NewTimeRecorder("search"),
-		qc:                     node.queryCoord,
-		node:                   node,
-		lb:                     node.lbPolicy,
-		enableMaterializedView: node.enableMaterializedView,
-	}
-
 	guaranteeTs := request.GuaranteeTimestamp
 
 	log := log.Ctx(ctx).With(
@@ -2883,6 +2925,8 @@ func (node *Proxy) search(ctx context.Context, request *milvuspb.SearchRequest)
 			hookutil.DimensionKey:  qt.dimension,
 		})
 		SetReportValue(qt.result.GetStatus(), v)
+		SetServedTimestamp(qt.result.GetStatus(), qt.queryChannelsTs)
+		SetSearchCoverage(qt.result.GetStatus(), qt.partial)
 		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
 		rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
 	}
@@ -3065,6 +3109,7 @@ func (node *Proxy) hybridSearch(ctx context.Context, request *milvuspb.HybridSea
 			hookutil.DimensionKey:  qt.dimension,
 		})
 		SetReportValue(qt.result.GetStatus(), v)
+		SetServedTimestamp(qt.result.GetStatus(), qt.queryChannelsTs)
 		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
 		rateCol.Add(metricsinfo.ReadResultThroughput, float64(sentSize))
 	}
@@ -3364,6 +3409,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 			hookutil.DimensionKey:  qt.dimension,
 		})
 		SetReportValue(res.Status, v)
+		SetServedTimestamp(res.Status, qt.queryChannelsTs)
 	}
 	return res, err
 }
@@ -5854,6 +5900,7 @@ func (node *Proxy) ImportV2(ctx context.Context, req *internalpb.ImportRequest)
 		}
 	}
 	importRequest := &internalpb.ImportRequestInternal{
+		DbName:         req.GetDbName(),
 		CollectionID:   collectionID,
 		CollectionName: req.GetCollectionName(),
 		PartitionIDs:   partitionIDs,
@@ -5942,3 +5989,1219 @@ func (node *Proxy) ListImports(ctx context.Context, req *internalpb.ListImportsR
 	metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
 	return resp, nil
 }
+
+// QueryIterator returns a page of the query results in the order of primary key,
+// along with the cursor to fetch the next page.
+func (node *Proxy) QueryIterator(ctx context.Context, req *proxypb.QueryIteratorRequest) (*proxypb.QueryIteratorResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.QueryIteratorResponse{Status: merr.Status(err)}, nil
+	}
+	request := req.GetRequest()
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.Int64("batchSize", req.GetBatchSize()),
+	)
+	method := "QueryIterator"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Debug(rpcReceived(method))
+
+	resp := &proxypb.QueryIteratorResponse{
+		Status: merr.Success(),
+	}
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	defer func() {
+		if resp.GetStatus().GetCode() != 0 {
+			log.Warn("query iterator failed", zap.String("reason", resp.GetStatus().GetReason()))
+			metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		} else {
+			metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		}
+		metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	}()
+
+	if req.GetBatchSize() <= 0 {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("batch size must be positive, but got %d", req.GetBatchSize()))
+		return resp, nil
+	}
+	if matchCountRule(request.GetOutputFields()) {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("count(*) is not supported by query iterator"))
+		return resp, nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	pkField, err := schema.GetPkField()
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	cursor, err := decodeIteratorCursor(req.GetCursor(), collectionID)
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	pageRequest := proto.Clone(request).(*milvuspb.QueryRequest)
+	pageRequest.Expr = iteratorQueryExpr(request.GetExpr(), pkField.GetName(), cursor)
+	pageRequest.QueryParams = iteratorQueryParams(request.GetQueryParams(), req.GetBatchSize())
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: pageRequest,
+		qc:      node.queryCoord,
+		lb:      node.lbPolicy,
+	}
+	if cursor != nil {
+		qt.channelsMvcc = cursor.ChannelsMvcc
+	}
+	result, err := node.query(ctx, qt)
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	resp.Status = result.GetStatus()
+	if !merr.Ok(result.GetStatus()) {
+		return resp, nil
+	}
+	resp.Results = result
+
+	var pkData *schemapb.FieldData
+	if len(result.GetFieldsData()) > 0 {
+		pkData, err = typeutil.GetPrimaryFieldData(result.GetFieldsData(), pkField)
+		if err != nil {
+			resp.Status = merr.Status(err)
+			return resp, nil
+		}
+	}
+	// all the results are returned
+	rows := typeutil.GetPKSize(pkData)
+	if int64(rows) < req.GetBatchSize() {
+		return resp, nil
+	}
+	next := &iteratorCursor{
+		CollectionID: collectionID,
+		ChannelsMvcc: qt.queryChannelsTs,
+	}
+	if cursor != nil {
+		next.ChannelsMvcc = cursor.ChannelsMvcc
+	}
+	next.appendPK(typeutil.GetData(pkData, rows-1))
+	resp.NextCursor, err = encodeIteratorCursor(next)
+	if err != nil {
+		resp.Status = merr.Status(err)
+	}
+	return resp, nil
+}
+
+// SearchIterator returns a page of the search results in the order of distance,
+// along with the cursor to fetch the next page.
+func (node *Proxy) SearchIterator(ctx context.Context, req *proxypb.SearchIteratorRequest) (*proxypb.SearchIteratorResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.SearchIteratorResponse{Status: merr.Status(err)}, nil
+	}
+	request := req.GetRequest()
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.Int64("batchSize", req.GetBatchSize()),
+	)
+	method := "SearchIterator"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Debug(rpcReceived(method))
+
+	resp := &proxypb.SearchIteratorResponse{
+		Status: merr.Success(),
+	}
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	defer func() {
+		if resp.GetStatus().GetCode() != 0 {
+			log.Warn("search iterator failed", zap.String("reason", resp.GetStatus().GetReason()))
+			metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		} else {
+			metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		}
+		metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	}()
+
+	if req.GetBatchSize() <= 0 {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("batch size must be positive, but got %d", req.GetBatchSize()))
+		return resp, nil
+	}
+	if request.GetNq() > 1 {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("search iterator supports only one vector, but got nq %d", request.GetNq()))
+		return resp, nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	pkField, err := schema.GetPkField()
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	cursor, err := decodeIteratorCursor(req.GetCursor(), collectionID)
+	if err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	var (
+		qt     *searchTask
+		result *milvuspb.SearchResults
+		width  float64
+	)
+	if cursor != nil {
+		width = float64(cursor.Width)
+	}
+	// expand the distance range until enough results found
+	for expansion := 0; ; expansion++ {
+		if expansion >= searchIteratorMaxExpansion {
+			width = 0
+		}
+		params, exhausted, err := iteratorSearchParams(request.GetSearchParams(), req.GetBatchSize(), cursor, width)
+		if err != nil {
+			resp.Status = merr.Status(err)
+			return resp, nil
+		}
+		pageRequest := proto.Clone(request).(*milvuspb.SearchRequest)
+		pageRequest.SearchParams = params
+		pageRequest.Dsl = iteratorSearchExpr(request.GetDsl(), pkField.GetName(), cursor)
+		qt = node.newSearchTask(ctx, pageRequest)
+		if cursor != nil {
+			qt.channelsMvcc = cursor.ChannelsMvcc
+		}
+		result, err = node.search(ctx, qt)
+		if err != nil {
+			resp.Status = merr.Status(err)
+			return resp, nil
+		}
+		if !merr.Ok(result.GetStatus()) {
+			resp.Status = result.GetStatus()
+			return resp, nil
+		}
+		if exhausted || int64(len(result.GetResults().GetScores())) >= req.GetBatchSize() {
+			break
+		}
+		width *= 2
+	}
+	resp.Results = result
+
+	// all the results are returned
+	if int64(len(result.GetResults().GetScores())) < req.GetBatchSize() {
+		return resp, nil
+	}
+	channelsMvcc := qt.queryChannelsTs
+	if cursor != nil {
+		channelsMvcc = cursor.ChannelsMvcc
+	}
+	next := nextSearchIteratorCursor(cursor, collectionID, channelsMvcc, qt.SearchRequest.GetMetricType(), result.GetResults())
+	resp.NextCursor, err = encodeIteratorCursor(next)
+	if err != nil {
+		resp.Status = merr.Status(err)
+	}
+	return resp, nil
+}
+
+// BatchSearch executes the searches in parallel, the searches may target different collections.
+// The results are returned in the order of the requests, each with its own status.
+func (node *Proxy) BatchSearch(ctx context.Context, req *proxypb.BatchSearchRequest) (*proxypb.BatchSearchResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.BatchSearchResponse{Status: merr.Status(err)}, nil
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int("num", len(req.GetRequests())),
+	)
+	method := "BatchSearch"
+	tr := timerecord.NewTimeRecorder(method)
+	log.Debug(rpcReceived(method))
+
+	resp := &proxypb.BatchSearchResponse{
+		Status: merr.Success(),
+	}
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, "", "").Inc()
+	defer func() {
+		if resp.GetStatus().GetCode() != 0 {
+			log.Warn("batch search failed", zap.String("reason", resp.GetStatus().GetReason()))
+			metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, "", "").Inc()
+		} else {
+			metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, "", "").Inc()
+		}
+		metrics.ProxyReqLatency.WithLabelValues(nodeID, method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	}()
+
+	requests := req.GetRequests()
+	if len(requests) == 0 {
+		resp.Status = merr.Status(merr.WrapErrParameterMissing("requests", "no search in batch search"))
+		return resp, nil
+	}
+	if len(requests) > batchSearchMaxRequests {
+		resp.Status = merr.Status(merr.WrapErrParameterInvalidMsg("batch search supports at most %d searches, but got %d", batchSearchMaxRequests, len(requests)))
+		return resp, nil
+	}
+	// the privilege interceptor is not aware of the searches in the batch, check them one by one
+	for _, request := range requests {
+		if _, err := PrivilegeInterceptor(ctx, request); err != nil {
+			resp.Status = merr.Status(err)
+			return resp, nil
+		}
+	}
+
+	resp.Results = make([]*milvuspb.SearchResults, len(requests))
+	wg := sync.WaitGroup{}
+	for i, request := range requests {
+		wg.Add(1)
+		go func(i int, request *milvuspb.SearchRequest) {
+			defer wg.Done()
+			result, err := node.Search(ctx, request)
+			if err != nil {
+				result = &milvuspb.SearchResults{Status: merr.Status(err)}
+			}
+			resp.Results[i] = result
+		}(i, request)
+	}
+	wg.Wait()
+	return resp, nil
+}
+
+// CreateAPIKey creates the api key scoped to the databases and the roles, the key is only returned once.
+// The roles granted to the key are limited to the ones held by the caller.
+func (node *Proxy) CreateAPIKey(ctx context.Context, req *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CreateAPIKey")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("name", req.GetName()),
+		zap.Strings("dbNames", req.GetDbNames()),
+		zap.Strings("roles", req.GetRoles()))
+
+	log.Info("CreateAPIKey")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	username, userRoles, err := checkAPIKeyManager(ctx)
+	if err == nil {
+		err = checkAPIKeyRoles(username, userRoles, req.GetRoles())
+	}
+	if err != nil {
+		log.Warn("permission deny to create api key", zap.Error(err))
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+
+	resp, err := node.rootCoord.CreateAPIKey(ctx, &proxypb.CreateAPIKeyRequest{
+		Base:     commonpbutil.NewMsgBase(),
+		Name:     req.GetName(),
+		DbNames:  req.GetDbNames(),
+		Roles:    req.GetRoles(),
+		ExpireAt: req.GetExpireAt(),
+	})
+	if err != nil {
+		log.Warn("create api key fail", zap.Error(err))
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	return resp, nil
+}
+
+// RotateAPIKey replaces the api key with a new one, the caller must hold all the roles of the key.
+func (node *Proxy) RotateAPIKey(ctx context.Context, req *proxypb.RotateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-RotateAPIKey")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("name", req.GetName()))
+
+	log.Info("RotateAPIKey")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	username, userRoles, err := checkAPIKeyManager(ctx)
+	if err != nil {
+		log.Warn("permission deny to rotate api key", zap.Error(err))
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	if username != util.UserRoot {
+		// the rotated key is returned to the caller, which must not gain the roles it doesn't hold
+		info, err := node.describeAPIKey(ctx, req.GetName())
+		if err == nil {
+			err = checkAPIKeyRoles(username, userRoles, info.GetRoles())
+		}
+		if err != nil {
+			log.Warn("permission deny to rotate api key", zap.Error(err))
+			return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+		}
+	}
+
+	resp, err := node.rootCoord.RotateAPIKey(ctx, &proxypb.RotateAPIKeyRequest{
+		Base:     commonpbutil.NewMsgBase(),
+		Name:     req.GetName(),
+		ExpireAt: req.GetExpireAt(),
+	})
+	if err != nil {
+		log.Warn("rotate api key fail", zap.Error(err))
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	return resp, nil
+}
+
+// RevokeAPIKey revokes the api key, the key is rejected by all the proxies once revoked.
+func (node *Proxy) RevokeAPIKey(ctx context.Context, req *proxypb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-RevokeAPIKey")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("name", req.GetName()))
+
+	log.Info("RevokeAPIKey")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if _, _, err := checkAPIKeyManager(ctx); err != nil {
+		log.Warn("permission deny to revoke api key", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	resp, err := node.rootCoord.RevokeAPIKey(ctx, &proxypb.RevokeAPIKeyRequest{
+		Base: commonpbutil.NewMsgBase(),
+		Name: req.GetName(),
+	})
+	if err != nil {
+		log.Warn("revoke api key fail", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return resp, nil
+}
+
+// ListAPIKeys lists the api keys without their secrets.
+func (node *Proxy) ListAPIKeys(ctx context.Context, req *proxypb.ListAPIKeysRequest) (*proxypb.ListAPIKeysResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-ListAPIKeys")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole))
+
+	log.Debug("ListAPIKeys")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+	if _, _, err := checkAPIKeyManager(ctx); err != nil {
+		log.Warn("permission deny to list api keys", zap.Error(err))
+		return &proxypb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+
+	resp, err := node.rootCoord.ListAPIKeys(ctx, &proxypb.ListAPIKeysRequest{
+		Base: commonpbutil.NewMsgBase(),
+	})
+	if err != nil {
+		log.Warn("list api keys fail", zap.Error(err))
+		return &proxypb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+	return resp, nil
+}
+
+func (node *Proxy) describeAPIKey(ctx context.Context, name string) (*proxypb.APIKeyInfo, error) {
+	resp, err := node.rootCoord.ListAPIKeys(ctx, &proxypb.ListAPIKeysRequest{
+		Base: commonpbutil.NewMsgBase(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	info, ok := lo.Find(resp.GetInfos(), func(info *proxypb.APIKeyInfo) bool {
+		return info.GetName() == name
+	})
+	if !ok {
+		return nil, merr.WrapErrAPIKeyNotFound(name)
+	}
+	return info, nil
+}
+
+// DropCollectionAsync drops the collection without waiting, the returned job id is used to describe the progress.
+func (node *Proxy) DropCollectionAsync(ctx context.Context, request *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.DDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DropCollectionAsync")
+	defer sp.End()
+	method := "DropCollectionAsync"
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+	)
+	log.Info(rpcReceived(method))
+
+	if err := validateCollectionName(request.GetCollectionName()); err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return &proxypb.DDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	resp, err := node.rootCoord.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{
+		Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_DropCollection)),
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("drop collection async fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return &proxypb.DDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	log.Info(rpcDone(method), zap.Int64("jobID", resp.GetJobID()))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	return resp, nil
+}
+
+// AlterCollectionAsync alters the properties of the collection without waiting,
+// the returned job id is used to describe the progress.
+func (node *Proxy) AlterCollectionAsync(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.DDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AlterCollectionAsync")
+	defer sp.End()
+	method := "AlterCollectionAsync"
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.Any("props", common.RedactProperties(request.GetProperties()...)),
+	)
+	log.Info(rpcReceived(method))
+
+	if err := validateCollectionName(request.GetCollectionName()); err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return &proxypb.DDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	resp, err := node.rootCoord.AlterCollectionAsync(ctx, &milvuspb.AlterCollectionRequest{
+		Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_AlterCollection)),
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+		Properties:     request.GetProperties(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("alter collection async fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return &proxypb.DDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	log.Info(rpcDone(method), zap.Int64("jobID", resp.GetJobID()))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	return resp, nil
+}
+
+// DescribeDDLJob returns the progress of the ddl job, the job is only visible to the users
+// who are able to describe the collection it runs on.
+func (node *Proxy) DescribeDDLJob(ctx context.Context, request *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.DescribeDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DescribeDDLJob")
+	defer sp.End()
+	method := "DescribeDDLJob"
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("jobID", request.GetJobID()),
+	)
+	log.Debug(rpcReceived(method))
+
+	resp, err := node.rootCoord.DescribeDDLJob(ctx, &proxypb.DescribeDDLJobRequest{
+		Base:  commonpbutil.NewMsgBase(),
+		JobID: request.GetJobID(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("describe ddl job fail", zap.Error(err))
+		return &proxypb.DescribeDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	// the privilege interceptor is not aware of the collection of the job, check it after the job is found
+	dbName := resp.GetDbName()
+	if dbName == "" {
+		dbName = util.DefaultDBName
+	}
+	if dbName != GetCurDBNameFromContextOrDefault(ctx) {
+		err = merr.WrapErrPrivilegeNotPermitted("the ddl job %d is not in the current database", request.GetJobID())
+	} else {
+		_, err = PrivilegeInterceptor(ctx, &milvuspb.DescribeCollectionRequest{
+			DbName:         dbName,
+			CollectionName: resp.GetCollectionName(),
+		})
+	}
+	if err != nil {
+		log.Warn("permission deny to describe ddl job", zap.Error(err))
+		return &proxypb.DescribeDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+	return resp, nil
+}
+
+// AddCollectionField adds a scalar field with default value to the existing collection,
+// the rows inserted before read the default value of the field.
+func (node *Proxy) AddCollectionField(ctx context.Context, request *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AddCollectionField")
+	defer sp.End()
+	method := "AddCollectionField"
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("field", request.GetField().GetName()),
+	)
+	log.Info(rpcReceived(method))
+
+	// the privilege interceptor is not aware of the request, adding field requires the privilege of altering the collection
+	if _, err := PrivilegeInterceptor(ctx, &milvuspb.AlterCollectionRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+	}); err != nil {
+		log.Warn("permission deny to add collection field", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+	if err := validateAddedField(request.GetCollectionName(), request.GetField()); err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	resp, err := node.rootCoord.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{
+		Base:           commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_AlterCollection)),
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+		Field:          request.GetField(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("add collection field fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	return merr.Success(), nil
+}
+
+// AlterDatabase updates the properties of the database, e.g. the database quotas,
+// the property with empty value is removed.
+func (node *Proxy) AlterDatabase(ctx context.Context, request *proxypb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AlterDatabase")
+	defer sp.End()
+	method := "AlterDatabase"
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), "").Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("dbName", request.GetDbName()),
+		zap.Any("props", common.RedactProperties(request.GetProperties()...)),
+	)
+	log.Info(rpcReceived(method))
+
+	// the privilege interceptor is not aware of the request, altering database requires the privilege of creating database
+	if _, err := PrivilegeInterceptor(ctx, &milvuspb.CreateDatabaseRequest{DbName: request.GetDbName()}); err != nil {
+		log.Warn("permission deny to alter database", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), "").Inc()
+		return merr.Status(err), nil
+	}
+	if err := checkStorageProperties(ctx, request.GetProperties()); err != nil {
+		log.Warn("permission deny to alter database storage", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), "").Inc()
+		return merr.Status(err), nil
+	}
+	if err := ValidateDatabaseName(request.GetDbName()); err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), "").Inc()
+		return merr.Status(err), nil
+	}
+	if len(request.GetProperties()) == 0 {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), "").Inc()
+		return merr.Status(merr.WrapErrParameterInvalidMsg("no properties to alter")), nil
+	}
+
+	resp, err := node.rootCoord.AlterDatabase(ctx, &proxypb.AlterDatabaseRequest{
+		Base:       commonpbutil.NewMsgBase(),
+		DbName:     request.GetDbName(),
+		Properties: request.GetProperties(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("alter database fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), "").Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), "").Inc()
+	return merr.Success(), nil
+}
+
+// SubscribeStandingQuery streams the inserts matching the filter and the deletes of the collection,
+// which are subscribed from the delegators of all the shards, until the client cancels or any of them closed.
+// The stream isn't covered by the unary interceptors, so the request is authenticated and authorized here.
+func (node *Proxy) SubscribeStandingQuery(request *proxypb.SubscribeStandingQueryRequest, srv proxypb.Proxy_SubscribeStandingQueryServer) error {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return srv.Send(&querypb.StandingQueryEvent{Status: merr.Status(err)})
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(srv.Context(), "Proxy-SubscribeStandingQuery")
+	defer sp.End()
+	method := "SubscribeStandingQuery"
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("expr", request.GetExpr()),
+	)
+	log.Info(rpcReceived(method))
+
+	// the subscriptions of all the shards are canceled once any of them ended,
+	// as the events of the collection are incomplete since then
+	g, gctx := errgroup.WithContext(ctx)
+	streams, err := node.subscribeStandingQuery(gctx, request)
+	if err != nil {
+		log.Warn("subscribe standing query fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return srv.Send(&querypb.StandingQueryEvent{Status: merr.Status(err)})
+	}
+
+	// the events of the shards are sent by one stream, which is not safe to send concurrently
+	var mu sync.Mutex
+	send := func(event *querypb.StandingQueryEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return srv.Send(event)
+	}
+	for _, stream := range streams {
+		stream := stream
+		g.Go(func() error {
+			for {
+				event, err := stream.Recv()
+				if err == io.EOF {
+					return merr.WrapErrServiceInternal("standing query of shard closed")
+				}
+				if err != nil {
+					return err
+				}
+				if err := merr.Error(event.GetStatus()); err != nil {
+					return err
+				}
+				if err := send(event); err != nil {
+					return err
+				}
+			}
+		})
+	}
+	err = g.Wait()
+	if ctx.Err() != nil {
+		// canceled by the client
+		log.Info(rpcDone(method))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return nil
+	}
+	log.Warn("standing query closed", zap.Error(err))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	return send(&querypb.StandingQueryEvent{Status: merr.Status(err)})
+}
+
+// subscribeStandingQuery checks the privilege of querying the collection, applies the row filter of the user,
+// and subscribes the standing query on the delegators of all the shards.
+func (node *Proxy) subscribeStandingQuery(ctx context.Context, request *proxypb.SubscribeStandingQueryRequest) ([]querypb.QueryNode_SubscribeStandingQueryClient, error) {
+	ctx, err := AuthenticationInterceptor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.GetDbName() == "" {
+		request.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	// the privilege is checked against the database of the context, which must be the one subscribed
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(strings.ToLower(util.HeaderDBName), request.GetDbName())
+	ctx = metadata.NewIncomingContext(ctx, md)
+	if _, err := PrivilegeInterceptor(ctx, &milvuspb.QueryRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+	}); err != nil {
+		return nil, err
+	}
+	if err := validateCollectionName(request.GetCollectionName()); err != nil {
+		return nil, err
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, request.GetDbName(), request.GetCollectionName(), collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := createRetrievePlan(schema, request.GetExpr(), nil)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err)
+	}
+	rowFilter, err := getRowFilter(ctx, collectionInfo.rowFilters)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyRowFilter(schema.schemaHelper, plan, rowFilter); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("failed to apply row filter: %v", err)
+	}
+	outputFields, _, err := translateOutputFields(request.GetOutputFields(), schema, true)
+	if err != nil {
+		return nil, err
+	}
+	outputFieldIDs, err := translateToOutputFieldIDs(outputFields, schema.CollectionSchema)
+	if err != nil {
+		return nil, err
+	}
+	masked, err := getMaskedFields(ctx, schema, collectionInfo.fieldMasks)
+	if err != nil {
+		return nil, err
+	}
+	plan.OutputFieldIds = lo.Filter(outputFieldIDs, func(fieldID int64, _ int) bool {
+		return !masked.Contain(fieldID)
+	})
+	serializedPlan, err := proto.Marshal(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	shards, err := globalMetaCache.GetShards(ctx, true, request.GetDbName(), request.GetCollectionName(), collectionID)
+	if err != nil {
+		return nil, err
+	}
+	streams := make([]querypb.QueryNode_SubscribeStandingQueryClient, 0, len(shards))
+	for channel, leaders := range shards {
+		var stream querypb.QueryNode_SubscribeStandingQueryClient
+		err := merr.WrapErrChannelNotAvailable(channel, "no shard leader available")
+		for _, leader := range leaders {
+			var qn types.QueryNodeClient
+			qn, err = node.shardMgr.GetClient(ctx, leader.nodeID)
+			if err != nil {
+				continue
+			}
+			stream, err = qn.SubscribeStandingQuery(ctx, &querypb.SubscribeStandingQueryRequest{
+				Base: commonpbutil.NewMsgBase(
+					commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+					commonpbutil.WithSourceID(paramtable.GetNodeID()),
+					commonpbutil.WithTargetID(leader.nodeID),
+				),
+				CollectionID:       collectionID,
+				Channel:            channel,
+				SerializedExprPlan: serializedPlan,
+				OutputFieldsId:     plan.GetOutputFieldIds(),
+			})
+			if err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		streams = append(streams, stream)
+	}
+	return streams, nil
+}
+
+// CreateCollectionFromSnapshot creates a collection with the schema, shards and partitions of the source collection,
+// and restores the snapshot of the source collection into it, the binlogs are shared rather than copied.
+func (node *Proxy) CreateCollectionFromSnapshot(ctx context.Context, request *proxypb.CreateCollectionFromSnapshotRequest) (*proxypb.RestoreCollectionsResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.RestoreCollectionsResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-CreateCollectionFromSnapshot")
+	defer sp.End()
+	method := "CreateCollectionFromSnapshot"
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	if request.GetDbName() == "" {
+		request.DbName = GetCurDBNameFromContextOrDefault(ctx)
+	}
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+		zap.String("snapshot", request.GetSnapshotName()),
+		zap.String("target", request.GetTargetCollectionName()),
+	)
+	log.Info(rpcReceived(method))
+
+	fail := func(err error) (*proxypb.RestoreCollectionsResponse, error) {
+		log.Warn("create collection from snapshot fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return &proxypb.RestoreCollectionsResponse{Status: merr.Status(err)}, nil
+	}
+	// the privilege interceptor is not aware of the request, reading the snapshot requires the privilege of querying the source collection,
+	// and creating the target collection requires the privilege of creating collection
+	ctx = withDatabase(ctx, request.GetDbName())
+	if _, err := PrivilegeInterceptor(ctx, &milvuspb.QueryRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+	}); err != nil {
+		return fail(err)
+	}
+	if _, err := PrivilegeInterceptor(ctx, &milvuspb.CreateCollectionRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetTargetCollectionName(),
+	}); err != nil {
+		return fail(err)
+	}
+	if err := validateCollectionName(request.GetTargetCollectionName()); err != nil {
+		return fail(err)
+	}
+
+	resp, err := node.createCollectionFromSnapshot(ctx, request.GetDbName(), request.GetCollectionName(),
+		request.GetSnapshotName(), request.GetTargetCollectionName())
+	if err != nil {
+		return fail(err)
+	}
+
+	log.Info(rpcDone(method), zap.Int("segmentNum", len(resp.GetSegmentIDs())))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	return &proxypb.RestoreCollectionsResponse{
+		Status: merr.Success(),
+		Collections: []*proxypb.RestoredCollection{{
+			DbName:         request.GetDbName(),
+			CollectionName: request.GetTargetCollectionName(),
+			SegmentIDs:     resp.GetSegmentIDs(),
+			NumRows:        resp.GetNumRows(),
+		}},
+	}, nil
+}
+
+// RestoreBackup creates the collections of the backup with the same names, partitions and indexes,
+// the binlogs and the index files of the backup are shared rather than copied.
+func (node *Proxy) RestoreBackup(ctx context.Context, request *proxypb.RestoreBackupRequest) (*proxypb.RestoreCollectionsResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.RestoreCollectionsResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-RestoreBackup")
+	defer sp.End()
+	method := "RestoreBackup"
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetTargetDbName(), "").Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("backup", request.GetBackupName()),
+		zap.String("db", request.GetDbName()),
+		zap.Strings("collections", request.GetCollectionNames()),
+		zap.String("targetDb", request.GetTargetDbName()),
+	)
+	log.Info(rpcReceived(method))
+
+	fail := func(err error) (*proxypb.RestoreCollectionsResponse, error) {
+		log.Warn("restore backup fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetTargetDbName(), "").Inc()
+		return &proxypb.RestoreCollectionsResponse{Status: merr.Status(err)}, nil
+	}
+	collections, err := node.getBackupCollections(ctx, request.GetBackupName(), request.GetDbName(), request.GetCollectionNames())
+	if err != nil {
+		return fail(err)
+	}
+	targetDbName := func(info *datapb.ReplicaInfo) string {
+		if len(request.GetTargetDbName()) > 0 {
+			return request.GetTargetDbName()
+		}
+		if len(info.GetCollection().GetDbName()) > 0 {
+			return info.GetCollection().GetDbName()
+		}
+		return util.DefaultDBName
+	}
+
+	restored, err := node.restoreCollections(ctx, collections, targetDbName,
+		func(info *datapb.ReplicaInfo, targetCollectionID int64, partitionMapping map[int64]int64) (*datapb.RestoreSnapshotResponse, error) {
+			resp, err := node.dataCoord.RestoreBackup(ctx, &datapb.RestoreBackupRequest{
+				Base:               commonpbutil.NewMsgBase(),
+				Name:               request.GetBackupName(),
+				CollectionID:       info.GetCollection().GetCollectionID(),
+				TargetCollectionID: targetCollectionID,
+				PartitionMapping:   partitionMapping,
+			})
+			return resp, merr.CheckRPCCall(resp, err)
+		})
+	if err != nil {
+		return fail(err)
+	}
+
+	log.Info(rpcDone(method), zap.Int("collectionNum", len(restored)))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetTargetDbName(), "").Inc()
+	return &proxypb.RestoreCollectionsResponse{Status: merr.Success(), Collections: restored}, nil
+}
+
+// PromoteReplica creates the collections replicated from the primary cluster with the same names, partitions and indexes,
+// all the replicas are promoted if the collection name not specified.
+func (node *Proxy) PromoteReplica(ctx context.Context, request *proxypb.PromoteReplicaRequest) (*proxypb.RestoreCollectionsResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &proxypb.RestoreCollectionsResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-PromoteReplica")
+	defer sp.End()
+	method := "PromoteReplica"
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.GetDbName()),
+		zap.String("collection", request.GetCollectionName()),
+	)
+	log.Info(rpcReceived(method))
+
+	fail := func(err error) (*proxypb.RestoreCollectionsResponse, error) {
+		log.Warn("promote replica fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return &proxypb.RestoreCollectionsResponse{Status: merr.Status(err)}, nil
+	}
+	replicas, err := node.getReplicas(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return fail(err)
+	}
+	restored, err := node.restoreCollections(ctx, replicas,
+		func(info *datapb.ReplicaInfo) string {
+			return info.GetCollection().GetDbName()
+		},
+		func(info *datapb.ReplicaInfo, targetCollectionID int64, partitionMapping map[int64]int64) (*datapb.RestoreSnapshotResponse, error) {
+			resp, err := node.dataCoord.PromoteReplica(ctx, &datapb.PromoteReplicaRequest{
+				Base:               commonpbutil.NewMsgBase(),
+				CollectionID:       info.GetCollection().GetCollectionID(),
+				TargetCollectionID: targetCollectionID,
+				PartitionMapping:   partitionMapping,
+			})
+			return resp, merr.CheckRPCCall(resp, err)
+		})
+	if err != nil {
+		return fail(err)
+	}
+
+	log.Info(rpcDone(method), zap.Int("collectionNum", len(restored)))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	return &proxypb.RestoreCollectionsResponse{Status: merr.Success(), Collections: restored}, nil
+}
+
+// UndropCollection restores the latest dropped collection of the name within the gc restore window,
+// the indexes of the collection have to be created again.
+func (node *Proxy) UndropCollection(ctx context.Context, request *proxypb.UndropCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-UndropCollection")
+	defer sp.End()
+	method := "UndropCollection"
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("dbName", request.GetDbName()),
+		zap.String("collectionName", request.GetCollectionName()),
+	)
+	log.Info(rpcReceived(method))
+
+	// the privilege interceptor is not aware of the request, undropping collection requires the privilege of creating collection
+	if _, err := PrivilegeInterceptor(ctx, &milvuspb.CreateCollectionRequest{DbName: request.GetDbName(), CollectionName: request.GetCollectionName()}); err != nil {
+		log.Warn("permission deny to undrop collection", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+	if err := validateCollectionName(request.GetCollectionName()); err != nil {
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	resp, err := node.rootCoord.UndropCollection(ctx, &proxypb.UndropCollectionRequest{
+		Base:           commonpbutil.NewMsgBase(),
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("undrop collection fail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	return merr.Success(), nil
+}
+
+// GetLoadingProgressDetail returns the per stage and per node load progress of the collection or partitions,
+// which requires the same privilege as GetLoadingProgress.
+func (node *Proxy) GetLoadingProgressDetail(ctx context.Context, request *proxypb.GetLoadingProgressDetailRequest) (*querypb.GetLoadProgressDetailResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &querypb.GetLoadProgressDetailResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-GetLoadingProgressDetail")
+	defer sp.End()
+	method := "GetLoadingProgressDetail"
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("dbName", request.GetDbName()),
+		zap.String("collectionName", request.GetCollectionName()),
+		zap.Strings("partitionNames", request.GetPartitionNames()),
+	)
+	log.Debug(rpcReceived(method))
+
+	getErrResponse := func(err error) *querypb.GetLoadProgressDetailResponse {
+		log.Warn("fail to get loading progress detail", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return &querypb.GetLoadProgressDetailResponse{Status: merr.Status(err)}
+	}
+
+	// the privilege interceptor is not aware of the request, it requires the privilege of getting loading progress
+	if _, err := PrivilegeInterceptor(ctx, &milvuspb.GetLoadingProgressRequest{DbName: request.GetDbName(), CollectionName: request.GetCollectionName()}); err != nil {
+		return getErrResponse(err), nil
+	}
+	if err := validateCollectionName(request.GetCollectionName()); err != nil {
+		return getErrResponse(err), nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return getErrResponse(err), nil
+	}
+	partitionIDs := make([]int64, 0, len(request.GetPartitionNames()))
+	for _, partitionName := range request.GetPartitionNames() {
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, request.GetDbName(), request.GetCollectionName(), partitionName)
+		if err != nil {
+			return getErrResponse(err), nil
+		}
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+
+	resp, err := node.queryCoord.GetLoadProgressDetail(ctx, &querypb.GetLoadProgressDetailRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_SystemInfo),
+			commonpbutil.WithSourceID(paramtable.GetNodeID()),
+		),
+		CollectionID: collectionID,
+		PartitionIDs: partitionIDs,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return getErrResponse(err), nil
+	}
+
+	log.Debug(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	return resp, nil
+}
+
+// DescribeIndexProgress describes the indexes of the collection with the build progress of each segment
+// and the estimated remaining time, which requires the same privilege as DescribeIndex.
+func (node *Proxy) DescribeIndexProgress(ctx context.Context, request *proxypb.DescribeIndexProgressRequest) (*indexpb.DescribeIndexResponse, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &indexpb.DescribeIndexResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DescribeIndexProgress")
+	defer sp.End()
+	method := "DescribeIndexProgress"
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.TotalLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("dbName", request.GetDbName()),
+		zap.String("collectionName", request.GetCollectionName()),
+		zap.String("indexName", request.GetIndexName()),
+	)
+	log.Debug(rpcReceived(method))
+
+	getErrResponse := func(err error) *indexpb.DescribeIndexResponse {
+		log.Warn("fail to describe index progress", zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.FailLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+		return &indexpb.DescribeIndexResponse{Status: merr.Status(err)}
+	}
+
+	// the privilege interceptor is not aware of the request, it requires the privilege of describing index
+	if _, err := PrivilegeInterceptor(ctx, &milvuspb.DescribeIndexRequest{
+		DbName:         request.GetDbName(),
+		CollectionName: request.GetCollectionName(),
+		IndexName:      request.GetIndexName(),
+	}); err != nil {
+		return getErrResponse(err), nil
+	}
+	if err := validateCollectionName(request.GetCollectionName()); err != nil {
+		return getErrResponse(err), nil
+	}
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return getErrResponse(err), nil
+	}
+
+	resp, err := node.dataCoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
+		CollectionID:        collectionID,
+		IndexName:           request.GetIndexName(),
+		WithSegmentProgress: true,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return getErrResponse(err), nil
+	}
+
+	log.Debug(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(nodeID, method, metrics.SuccessLabel, request.GetDbName(), request.GetCollectionName()).Inc()
+	return resp, nil
+}
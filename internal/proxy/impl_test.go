@@ -24,8 +24,10 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -37,16 +39,20 @@ import (
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -1622,3 +1628,530 @@ func TestProxy_ImportV2(t *testing.T) {
 		assert.Equal(t, int32(0), rsp.GetStatus().GetCode())
 	})
 }
+
+func TestProxy_BatchSearch(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	// server is not healthy
+	node := &Proxy{}
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	rsp, err := node.BatchSearch(ctx, &proxypb.BatchSearchRequest{})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(rsp.GetStatus()), merr.ErrServiceNotReady)
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	// no search
+	rsp, err = node.BatchSearch(ctx, &proxypb.BatchSearchRequest{})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(rsp.GetStatus()), merr.ErrParameterMissing)
+
+	// too many searches
+	requests := make([]*milvuspb.SearchRequest, batchSearchMaxRequests+1)
+	for i := range requests {
+		requests[i] = &milvuspb.SearchRequest{CollectionName: "col"}
+	}
+	rsp, err = node.BatchSearch(ctx, &proxypb.BatchSearchRequest{Requests: requests})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(rsp.GetStatus()), merr.ErrParameterInvalid)
+
+	// the searches are checked by the privilege interceptor
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+	rsp, err = node.BatchSearch(ctx, &proxypb.BatchSearchRequest{Requests: requests[:2]})
+	assert.NoError(t, err)
+	assert.NotEqual(t, int32(0), rsp.GetStatus().GetCode())
+	assert.Empty(t, rsp.GetResults())
+}
+
+func TestProxy_DDLJob(t *testing.T) {
+	paramtable.Init()
+	ctx := NewContextWithMetadata(context.Background(), util.UserRoot, util.DefaultDBName)
+
+	// server is not healthy
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	node := &Proxy{rootCoord: rootCoord}
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	jobResp, err := node.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{CollectionName: "col"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(jobResp.GetStatus()), merr.ErrServiceNotReady)
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	t.Run("drop async", func(t *testing.T) {
+		rootCoord.EXPECT().DropCollectionAsync(mock.Anything, mock.Anything).Return(&proxypb.DDLJobResponse{
+			Status: merr.Success(),
+			JobID:  100,
+		}, nil).Once()
+		resp, err := node.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{DbName: util.DefaultDBName, CollectionName: "col"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, int64(100), resp.GetJobID())
+
+		resp, err = node.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{CollectionName: ""})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrParameterInvalid)
+	})
+
+	t.Run("alter async", func(t *testing.T) {
+		rootCoord.EXPECT().AlterCollectionAsync(mock.Anything, mock.Anything).Return(nil, merr.ErrServiceNotReady).Once()
+		resp, err := node.AlterCollectionAsync(ctx, &milvuspb.AlterCollectionRequest{
+			CollectionName: "col",
+			Properties:     []*commonpb.KeyValuePair{{Key: common.MmapEnabledKey, Value: "true"}},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		rootCoord.EXPECT().DescribeDDLJob(mock.Anything, mock.Anything).Return(&proxypb.DescribeDDLJobResponse{
+			Status:         merr.Success(),
+			JobID:          100,
+			State:          proxypb.DDLJobState_DDLJobCompleted,
+			DbName:         "db1",
+			CollectionName: "col",
+		}, nil).Twice()
+		resp, err := node.DescribeDDLJob(NewContextWithMetadata(context.Background(), util.UserRoot, "db1"), &proxypb.DescribeDDLJobRequest{JobID: 100})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, proxypb.DDLJobState_DDLJobCompleted, resp.GetState())
+
+		// the job is not in the database of the request
+		resp, err = node.DescribeDDLJob(ctx, &proxypb.DescribeDDLJobRequest{JobID: 100})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrPrivilegeNotPermitted)
+	})
+}
+
+func TestProxy_AddCollectionField(t *testing.T) {
+	paramtable.Init()
+	ctx := NewContextWithMetadata(context.Background(), util.UserRoot, util.DefaultDBName)
+	field := &schemapb.FieldSchema{
+		Name:         "age",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 18}},
+	}
+
+	// server is not healthy
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	node := &Proxy{rootCoord: rootCoord}
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	status, err := node.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{CollectionName: "col", Field: field})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	t.Run("add field", func(t *testing.T) {
+		rootCoord.EXPECT().AddCollectionField(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			assert.Equal(t, "col", req.GetCollectionName())
+			assert.Equal(t, commonpb.MsgType_AlterCollection, req.GetBase().GetMsgType())
+			assert.Equal(t, field, req.GetField())
+			return merr.Success(), nil
+		}).Once()
+		status, err := node.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{CollectionName: "col", Field: field})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+
+		rootCoord.EXPECT().AddCollectionField(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrCollectionNotFound("col")), nil).Once()
+		status, err = node.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{CollectionName: "col", Field: field})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("invalid field", func(t *testing.T) {
+		for _, req := range []*proxypb.AddCollectionFieldRequest{
+			{Field: field},
+			{CollectionName: "col"},
+			{CollectionName: "col", Field: &schemapb.FieldSchema{Name: "age", DataType: schemapb.DataType_Int64}},
+			{CollectionName: "col", Field: &schemapb.FieldSchema{
+				Name:         "name",
+				DataType:     schemapb.DataType_VarChar,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "abc"}},
+			}},
+		} {
+			status, err := node.AddCollectionField(ctx, req)
+			assert.NoError(t, err)
+			assert.Error(t, merr.Error(status))
+		}
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		status, err := node.AddCollectionField(context.Background(), &proxypb.AddCollectionFieldRequest{CollectionName: "col", Field: field})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+	})
+}
+
+func TestProxy_AlterDatabase(t *testing.T) {
+	paramtable.Init()
+	ctx := NewContextWithMetadata(context.Background(), util.UserRoot, util.DefaultDBName)
+	properties := []*commonpb.KeyValuePair{{Key: common.DatabaseMaxCollectionsKey, Value: "10"}}
+
+	// server is not healthy
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	node := &Proxy{rootCoord: rootCoord}
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	status, err := node.AlterDatabase(ctx, &proxypb.AlterDatabaseRequest{DbName: "db1", Properties: properties})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	t.Run("alter", func(t *testing.T) {
+		rootCoord.EXPECT().AlterDatabase(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			assert.Equal(t, "db1", req.GetDbName())
+			assert.Equal(t, properties, req.GetProperties())
+			return merr.Success(), nil
+		}).Once()
+		status, err := node.AlterDatabase(ctx, &proxypb.AlterDatabaseRequest{DbName: "db1", Properties: properties})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+
+		rootCoord.EXPECT().AlterDatabase(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrDatabaseNotFound("db1")), nil).Once()
+		status, err = node.AlterDatabase(ctx, &proxypb.AlterDatabaseRequest{DbName: "db1", Properties: properties})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrDatabaseNotFound)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		status, err := node.AlterDatabase(ctx, &proxypb.AlterDatabaseRequest{Properties: properties})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrDatabaseInvalidName)
+
+		status, err = node.AlterDatabase(ctx, &proxypb.AlterDatabaseRequest{DbName: "db1"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrParameterInvalid)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		status, err := node.AlterDatabase(context.Background(), &proxypb.AlterDatabaseRequest{DbName: "db1", Properties: properties})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+	})
+}
+
+func TestProxy_UndropCollection(t *testing.T) {
+	paramtable.Init()
+	ctx := NewContextWithMetadata(context.Background(), util.UserRoot, util.DefaultDBName)
+
+	// server is not healthy
+	rootCoord := mocks.NewMockRootCoordClient(t)
+	node := &Proxy{rootCoord: rootCoord}
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	status, err := node.UndropCollection(ctx, &proxypb.UndropCollectionRequest{CollectionName: "col"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(status), merr.ErrServiceNotReady)
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	t.Run("undrop", func(t *testing.T) {
+		rootCoord.EXPECT().UndropCollection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			assert.Equal(t, "db1", req.GetDbName())
+			assert.Equal(t, "col", req.GetCollectionName())
+			return merr.Success(), nil
+		}).Once()
+		status, err := node.UndropCollection(ctx, &proxypb.UndropCollectionRequest{DbName: "db1", CollectionName: "col"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(status))
+
+		rootCoord.EXPECT().UndropCollection(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrCollectionNotFound("col")), nil).Once()
+		status, err = node.UndropCollection(ctx, &proxypb.UndropCollectionRequest{DbName: "db1", CollectionName: "col"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		status, err := node.UndropCollection(ctx, &proxypb.UndropCollectionRequest{DbName: "db1"})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		status, err := node.UndropCollection(context.Background(), &proxypb.UndropCollectionRequest{CollectionName: "col"})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(status))
+	})
+}
+
+func TestProxy_GetLoadingProgressDetail(t *testing.T) {
+	paramtable.Init()
+	cacheBak := globalMetaCache
+	defer func() { globalMetaCache = cacheBak }()
+	ctx := NewContextWithMetadata(context.Background(), util.UserRoot, util.DefaultDBName)
+
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(1, nil).Maybe()
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "unknown").Return(0, merr.WrapErrCollectionNotFound("unknown")).Maybe()
+	cache.EXPECT().GetPartitionID(mock.Anything, mock.Anything, "col", "p1").Return(2, nil).Maybe()
+	globalMetaCache = cache
+
+	// server is not healthy
+	queryCoord := mocks.NewMockQueryCoordClient(t)
+	node := &Proxy{queryCoord: queryCoord}
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := node.GetLoadingProgressDetail(ctx, &proxypb.GetLoadingProgressDetailRequest{CollectionName: "col"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	t.Run("get progress", func(t *testing.T) {
+		queryCoord.EXPECT().GetLoadProgressDetail(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *querypb.GetLoadProgressDetailRequest, opts ...grpc.CallOption) (*querypb.GetLoadProgressDetailResponse, error) {
+			assert.EqualValues(t, 1, req.GetCollectionID())
+			assert.Equal(t, []int64{2}, req.GetPartitionIDs())
+			return &querypb.GetLoadProgressDetailResponse{Status: merr.Success(), CollectionID: 1, Progress: 50}, nil
+		}).Once()
+		resp, err := node.GetLoadingProgressDetail(ctx, &proxypb.GetLoadingProgressDetailRequest{CollectionName: "col", PartitionNames: []string{"p1"}})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.EqualValues(t, 50, resp.GetProgress())
+
+		queryCoord.EXPECT().GetLoadProgressDetail(mock.Anything, mock.Anything).Return(&querypb.GetLoadProgressDetailResponse{
+			Status: merr.Status(merr.WrapErrCollectionNotLoaded("col")),
+		}, nil).Once()
+		resp, err = node.GetLoadingProgressDetail(ctx, &proxypb.GetLoadingProgressDetailRequest{CollectionName: "col"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		resp, err := node.GetLoadingProgressDetail(ctx, &proxypb.GetLoadingProgressDetailRequest{CollectionName: "unknown"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		resp, err := node.GetLoadingProgressDetail(context.Background(), &proxypb.GetLoadingProgressDetailRequest{CollectionName: "col"})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+}
+
+func TestProxy_DescribeIndexProgress(t *testing.T) {
+	paramtable.Init()
+	cacheBak := globalMetaCache
+	defer func() { globalMetaCache = cacheBak }()
+	ctx := NewContextWithMetadata(context.Background(), util.UserRoot, util.DefaultDBName)
+
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "col").Return(1, nil).Maybe()
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "unknown").Return(0, merr.WrapErrCollectionNotFound("unknown")).Maybe()
+	globalMetaCache = cache
+
+	// server is not healthy
+	dataCoord := mocks.NewMockDataCoordClient(t)
+	node := &Proxy{dataCoord: dataCoord}
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := node.DescribeIndexProgress(ctx, &proxypb.DescribeIndexProgressRequest{CollectionName: "col"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	t.Run("describe progress", func(t *testing.T) {
+		dataCoord.EXPECT().DescribeIndex(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *indexpb.DescribeIndexRequest, opts ...grpc.CallOption) (*indexpb.DescribeIndexResponse, error) {
+			assert.EqualValues(t, 1, req.GetCollectionID())
+			assert.Equal(t, "idx", req.GetIndexName())
+			assert.True(t, req.GetWithSegmentProgress())
+			return &indexpb.DescribeIndexResponse{
+				Status: merr.Success(),
+				IndexInfos: []*indexpb.IndexInfo{{
+					IndexName:                 "idx",
+					EstimatedRemainingSeconds: 10,
+					SegmentProgresses: []*indexpb.SegmentIndexProgress{
+						{SegmentID: 100, NumRows: 1000, IndexedRows: 500, EstimatedRemainingSeconds: 10},
+					},
+				}},
+			}, nil
+		}).Once()
+		resp, err := node.DescribeIndexProgress(ctx, &proxypb.DescribeIndexProgressRequest{CollectionName: "col", IndexName: "idx"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Len(t, resp.GetIndexInfos(), 1)
+		assert.Len(t, resp.GetIndexInfos()[0].GetSegmentProgresses(), 1)
+		assert.EqualValues(t, 10, resp.GetIndexInfos()[0].GetEstimatedRemainingSeconds())
+
+		dataCoord.EXPECT().DescribeIndex(mock.Anything, mock.Anything).Return(&indexpb.DescribeIndexResponse{
+			Status: merr.Status(merr.WrapErrIndexNotFound("idx")),
+		}, nil).Once()
+		resp, err = node.DescribeIndexProgress(ctx, &proxypb.DescribeIndexProgressRequest{CollectionName: "col", IndexName: "idx"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrIndexNotFound)
+	})
+
+	t.Run("collection not found", func(t *testing.T) {
+		resp, err := node.DescribeIndexProgress(ctx, &proxypb.DescribeIndexProgressRequest{CollectionName: "unknown"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrCollectionNotFound)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		resp, err := node.DescribeIndexProgress(context.Background(), &proxypb.DescribeIndexProgressRequest{CollectionName: "col"})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+}
+
+func TestProxy_SubscribeStandingQuery(t *testing.T) {
+	paramtable.Init()
+	cacheBak := globalMetaCache
+	defer func() { globalMetaCache = cacheBak }()
+	ctx := NewContextWithMetadata(context.Background(), util.UserRoot, util.DefaultDBName)
+
+	schema := &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.DimKey, Value: "8"},
+			}},
+		},
+	}
+	cache := NewMockCache(t)
+	cache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Maybe()
+	cache.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, mock.Anything).Return(newSchemaInfo(schema), nil).Maybe()
+	cache.EXPECT().GetCollectionInfo(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&collectionBasicInfo{}, nil).Maybe()
+	cache.EXPECT().GetShards(mock.Anything, true, mock.Anything, mock.Anything, mock.Anything).Return(map[string][]nodeInfo{
+		"dml_0": {{nodeID: 1}},
+		"dml_1": {{nodeID: 2}},
+	}, nil).Maybe()
+	globalMetaCache = cache
+
+	qn := mocks.NewMockQueryNodeClient(t)
+	mgr := NewMockShardClientManager(t)
+	mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(qn, nil).Maybe()
+	node := &Proxy{shardMgr: mgr}
+
+	subscribe := func(ctx context.Context, request *proxypb.SubscribeStandingQueryRequest) (*streamrpc.InMemoryStreamer[*querypb.StandingQueryEvent], error) {
+		srv := streamrpc.NewInMemoryStreamer[*querypb.StandingQueryEvent](ctx, 16)
+		err := node.SubscribeStandingQuery(request, srv)
+		return srv, err
+	}
+
+	// server is not healthy
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+	srv, err := subscribe(ctx, &proxypb.SubscribeStandingQueryRequest{CollectionName: "coll"})
+	assert.NoError(t, err)
+	event, err := srv.Recv()
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(event.GetStatus()), merr.ErrServiceNotReady)
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+
+	// the delete is streamed from dml_0 and then its standing query closed, while dml_1 streams nothing
+	mockShards := func(closeEvent *querypb.StandingQueryEvent) {
+		qn.EXPECT().SubscribeStandingQuery(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *querypb.SubscribeStandingQueryRequest, opts ...grpc.CallOption) (querypb.QueryNode_SubscribeStandingQueryClient, error) {
+			assert.EqualValues(t, 1, req.GetCollectionID())
+			assert.ElementsMatch(t, []int64{100, 101}, req.GetOutputFieldsId())
+			plan := &planpb.PlanNode{}
+			assert.NoError(t, proto.Unmarshal(req.GetSerializedExprPlan(), plan))
+			assert.NotNil(t, plan.GetQuery().GetPredicates().GetUnaryRangeExpr())
+
+			stream := streamrpc.NewInMemoryStreamer[*querypb.StandingQueryEvent](ctx, 16)
+			if req.GetChannel() == "dml_0" {
+				stream.Send(&querypb.StandingQueryEvent{Status: merr.Success(), Type: querypb.StandingQueryEventType_StandingQueryDelete})
+				if closeEvent != nil {
+					stream.Send(closeEvent)
+				}
+				stream.Close()
+			}
+			return stream, nil
+		}).Times(2)
+	}
+
+	t.Run("subscribe", func(t *testing.T) {
+		mockShards(nil)
+		srv, err := subscribe(ctx, &proxypb.SubscribeStandingQueryRequest{
+			CollectionName: "coll",
+			Expr:           "age > 20",
+			OutputFields:   []string{"age"},
+		})
+		assert.NoError(t, err)
+		event, err := srv.Recv()
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(event.GetStatus()))
+		assert.Equal(t, querypb.StandingQueryEventType_StandingQueryDelete, event.GetType())
+		event, err = srv.Recv()
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(event.GetStatus()), merr.ErrServiceInternal)
+	})
+
+	t.Run("shard failed", func(t *testing.T) {
+		mockShards(&querypb.StandingQueryEvent{Status: merr.Status(merr.WrapErrServiceQuotaExceeded("too many standing queries"))})
+		srv, err := subscribe(ctx, &proxypb.SubscribeStandingQueryRequest{
+			CollectionName: "coll",
+			Expr:           "age > 20",
+			OutputFields:   []string{"age"},
+		})
+		assert.NoError(t, err)
+		event, err := srv.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, querypb.StandingQueryEventType_StandingQueryDelete, event.GetType())
+		event, err = srv.Recv()
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(event.GetStatus()), merr.ErrServiceQuotaExceeded)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		srv, err := subscribe(ctx, &proxypb.SubscribeStandingQueryRequest{CollectionName: "coll", Expr: "unknown > 20"})
+		assert.NoError(t, err)
+		event, err := srv.Recv()
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(event.GetStatus()), merr.ErrParameterInvalid)
+
+		srv, err = subscribe(ctx, &proxypb.SubscribeStandingQueryRequest{CollectionName: "coll", OutputFields: []string{"unknown"}})
+		assert.NoError(t, err)
+		event, err = srv.Recv()
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(event.GetStatus()))
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		srv, err := subscribe(metadata.NewIncomingContext(context.Background(), metadata.MD{}), &proxypb.SubscribeStandingQueryRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		event, err := srv.Recv()
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(event.GetStatus()))
+	})
+}
+
+func TestProxy_WaitDmlTasksDone(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	node := &Proxy{ctx: ctx}
+	var err error
+	node.sched, err = newTaskScheduler(ctx, newMockTsoAllocator(), dependency.NewDefaultFactory(true))
+	require.NoError(t, err)
+
+	assert.NoError(t, node.waitDmlTasksDone(time.Second))
+
+	// the writes in flight are waited
+	node.sched.dmQueue.statsLock.Lock()
+	node.sched.dmQueue.pChanStatisticsInfos["ch1"] = &pChanStatInfo{}
+	node.sched.dmQueue.statsLock.Unlock()
+	assert.ErrorIs(t, node.waitDmlTasksDone(200*time.Millisecond), context.DeadlineExceeded)
+
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		node.sched.dmQueue.statsLock.Lock()
+		delete(node.sched.dmQueue.pChanStatisticsInfos, "ch1")
+		node.sched.dmQueue.statsLock.Unlock()
+	}()
+	assert.NoError(t, node.waitDmlTasksDone(5*time.Second))
+}
@@ -0,0 +1,232 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// searchIteratorMinWidth is the min width of the distance range searched for a page
+	searchIteratorMinWidth = 1e-3
+	// searchIteratorMaxExpansion is the max times to double the range if not enough results found,
+	// the range is unbounded after that.
+	searchIteratorMaxExpansion = 16
+)
+
+// iteratorCursor is the state of an iterator carried by the cursor token between the pages,
+// so that the proxy keeps nothing for the iterators.
+type iteratorCursor struct {
+	CollectionID int64 `json:"collection_id"`
+	// ChannelsMvcc pins all the pages to the snapshot read by the first page
+	ChannelsMvcc map[string]uint64 `json:"channels_mvcc,omitempty"`
+	// IntPKs or StrPKs are the last primary key returned by the query iterator,
+	// or the primary keys returned at the last distance by the search iterator.
+	IntPKs []int64  `json:"int_pks,omitempty"`
+	StrPKs []string `json:"str_pks,omitempty"`
+
+	// Distance is the last distance returned by the search iterator
+	Distance float32 `json:"distance,omitempty"`
+	// Width is the width of the distance range to search for the next page
+	Width      float32 `json:"width,omitempty"`
+	MetricType string  `json:"metric_type,omitempty"`
+}
+
+func encodeIteratorCursor(cursor *iteratorCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeIteratorCursor decodes the cursor token, returns nil if the token is empty, which is the first page.
+func decodeIteratorCursor(token string, collectionID int64) (*iteratorCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid iterator cursor: %s", err.Error())
+	}
+	cursor := &iteratorCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid iterator cursor: %s", err.Error())
+	}
+	if cursor.CollectionID != collectionID {
+		return nil, merr.WrapErrParameterInvalidMsg("iterator cursor of collection %d used for collection %d", cursor.CollectionID, collectionID)
+	}
+	return cursor, nil
+}
+
+func (c *iteratorCursor) appendPK(pk any) {
+	switch pk := pk.(type) {
+	case int64:
+		c.IntPKs = append(c.IntPKs, pk)
+	case string:
+		c.StrPKs = append(c.StrPKs, pk)
+	}
+}
+
+func (c *iteratorCursor) pkLiterals() []string {
+	if len(c.StrPKs) > 0 {
+		return lo.Map(c.StrPKs, func(pk string, _ int) string { return strconv.Quote(pk) })
+	}
+	return lo.Map(c.IntPKs, func(pk int64, _ int) string { return strconv.FormatInt(pk, 10) })
+}
+
+func andExpr(expr string, cond string) string {
+	if strings.TrimSpace(expr) == "" {
+		return cond
+	}
+	return fmt.Sprintf("(%s) && %s", expr, cond)
+}
+
+// iteratorQueryExpr returns the expression of the next page of the query iterator,
+// the rows are returned in the order of primary key, so the next page starts after the last primary key.
+func iteratorQueryExpr(expr string, pkName string, cursor *iteratorCursor) string {
+	if cursor == nil {
+		return expr
+	}
+	literals := cursor.pkLiterals()
+	if len(literals) == 0 {
+		return expr
+	}
+	return andExpr(expr, fmt.Sprintf("%s > %s", pkName, literals[len(literals)-1]))
+}
+
+// iteratorQueryParams returns the query params of a page of the query iterator.
+func iteratorQueryParams(params []*commonpb.KeyValuePair, batchSize int64) []*commonpb.KeyValuePair {
+	ret := lo.Filter(params, func(kv *commonpb.KeyValuePair, _ int) bool {
+		key := kv.GetKey()
+		// stop for best may return less rows than the batch size, which means the end of iteration
+		return key != LimitKey && key != OffsetKey && key != ReduceStopForBestKey
+	})
+	return append(ret, &commonpb.KeyValuePair{Key: LimitKey, Value: strconv.FormatInt(batchSize, 10)})
+}
+
+// iteratorSearchExpr returns the expression of the next page of the search iterator,
+// which excludes the results at the last distance returned.
+func iteratorSearchExpr(expr string, pkName string, cursor *iteratorCursor) string {
+	if cursor == nil {
+		return expr
+	}
+	literals := cursor.pkLiterals()
+	if len(literals) == 0 {
+		return expr
+	}
+	return andExpr(expr, fmt.Sprintf("%s not in [%s]", pkName, strings.Join(literals, ", ")))
+}
+
+// iteratorSearchParams returns the search params of the next page of the search iterator,
+// which range searches the results within the width after the last distance, the range is unbounded if width <= 0.
+// exhausted is true if the range reaches the radius specified or it's the first page,
+// otherwise the range shall be expanded if not enough results found.
+func iteratorSearchParams(params []*commonpb.KeyValuePair, batchSize int64, cursor *iteratorCursor, width float64) ([]*commonpb.KeyValuePair, bool, error) {
+	ret := lo.Filter(params, func(kv *commonpb.KeyValuePair, _ int) bool {
+		key := kv.GetKey()
+		return key != TopKKey && key != OffsetKey && key != IteratorField && key != SearchParamsKey
+	})
+	ret = append(ret,
+		&commonpb.KeyValuePair{Key: TopKKey, Value: strconv.FormatInt(batchSize, 10)},
+		&commonpb.KeyValuePair{Key: IteratorField, Value: "True"},
+	)
+
+	searchParams := make(map[string]any)
+	if str, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, params); err == nil && str != "" {
+		if err := json.Unmarshal([]byte(str), &searchParams); err != nil {
+			return nil, false, merr.WrapErrParameterInvalidMsg("invalid search params: %s", err.Error())
+		}
+	}
+
+	exhausted := true
+	if cursor != nil {
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, params); err != nil && cursor.MetricType != "" {
+			ret = append(ret, &commonpb.KeyValuePair{Key: MetricTypeKey, Value: cursor.MetricType})
+		}
+
+		// the larger the better for IP/COSINE, the range is (radius, range_filter],
+		// otherwise the range is [range_filter, radius)
+		positive := metric.PositivelyRelated(cursor.MetricType)
+		last := float64(cursor.Distance)
+		radius := last + width
+		if positive {
+			radius = last - width
+		}
+		exhausted = width <= 0
+		if exhausted {
+			radius = math.MaxFloat32
+			if positive {
+				radius = -math.MaxFloat32
+			}
+		}
+		if userRadius, ok := searchParams[radiusKey].(float64); ok {
+			if (positive && radius <= userRadius) || (!positive && radius >= userRadius) {
+				radius = userRadius
+				exhausted = true
+			}
+		}
+		searchParams[radiusKey] = radius
+		searchParams[rangeFilterKey] = last
+	}
+
+	data, err := json.Marshal(searchParams)
+	if err != nil {
+		return nil, false, err
+	}
+	ret = append(ret, &commonpb.KeyValuePair{Key: SearchParamsKey, Value: string(data)})
+	return ret, exhausted, nil
+}
+
+// nextSearchIteratorCursor returns the cursor after the results of a page of the search iterator.
+func nextSearchIteratorCursor(prev *iteratorCursor, collectionID int64, channelsMvcc map[string]uint64, metricType string, results *schemapb.SearchResultData) *iteratorCursor {
+	scores := results.GetScores()
+	first, last := scores[0], scores[len(scores)-1]
+	next := &iteratorCursor{
+		CollectionID: collectionID,
+		ChannelsMvcc: channelsMvcc,
+		Distance:     last,
+		Width:        float32(math.Abs(float64(last - first))),
+		MetricType:   metricType,
+	}
+	if next.Width < searchIteratorMinWidth {
+		next.Width = searchIteratorMinWidth
+	}
+	// the results at the last distance shall be excluded from the next page
+	if prev != nil && prev.Distance == last {
+		next.IntPKs = append(next.IntPKs, prev.IntPKs...)
+		next.StrPKs = append(next.StrPKs, prev.StrPKs...)
+	}
+	for i := len(scores) - 1; i >= 0 && scores[i] == last; i-- {
+		next.appendPK(typeutil.GetPK(results.GetIds(), int64(i)))
+	}
+	return next
+}
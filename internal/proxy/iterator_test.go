@@ -0,0 +1,175 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+)
+
+func TestIteratorCursor(t *testing.T) {
+	cursor, err := decodeIteratorCursor("", 100)
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	token, err := encodeIteratorCursor(&iteratorCursor{
+		CollectionID: 100,
+		ChannelsMvcc: map[string]uint64{"dml_0": 1000},
+		IntPKs:       []int64{10},
+		Distance:     0.5,
+		MetricType:   metric.L2,
+	})
+	assert.NoError(t, err)
+
+	cursor, err = decodeIteratorCursor(token, 100)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1000, cursor.ChannelsMvcc["dml_0"])
+	assert.Equal(t, []int64{10}, cursor.IntPKs)
+	assert.EqualValues(t, 0.5, cursor.Distance)
+	assert.Equal(t, metric.L2, cursor.MetricType)
+
+	// used for another collection
+	_, err = decodeIteratorCursor(token, 101)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, err = decodeIteratorCursor("invalid!", 100)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestIteratorQueryExpr(t *testing.T) {
+	assert.Equal(t, "a > 1", iteratorQueryExpr("a > 1", "pk", nil))
+	assert.Equal(t, "(a > 1) && pk > 10", iteratorQueryExpr("a > 1", "pk", &iteratorCursor{IntPKs: []int64{10}}))
+	assert.Equal(t, "pk > \"a\\\"b\"", iteratorQueryExpr("", "pk", &iteratorCursor{StrPKs: []string{"a\"b"}}))
+
+	params := iteratorQueryParams([]*commonpb.KeyValuePair{
+		{Key: LimitKey, Value: "10"},
+		{Key: OffsetKey, Value: "10"},
+		{Key: ReduceStopForBestKey, Value: "true"},
+		{Key: IgnoreGrowingKey, Value: "true"},
+	}, 100)
+	assert.Len(t, params, 2)
+	limit, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, params)
+	assert.NoError(t, err)
+	assert.Equal(t, "100", limit)
+}
+
+func TestIteratorSearchExpr(t *testing.T) {
+	assert.Equal(t, "a > 1", iteratorSearchExpr("a > 1", "pk", nil))
+	assert.Equal(t, "a > 1", iteratorSearchExpr("a > 1", "pk", &iteratorCursor{Distance: 1}))
+	assert.Equal(t, "(a > 1) && pk not in [1, 2]", iteratorSearchExpr("a > 1", "pk", &iteratorCursor{IntPKs: []int64{1, 2}}))
+	assert.Equal(t, "pk not in [\"a\"]", iteratorSearchExpr("", "pk", &iteratorCursor{StrPKs: []string{"a"}}))
+}
+
+func TestIteratorSearchParams(t *testing.T) {
+	getParams := func(kvs []*commonpb.KeyValuePair) map[string]any {
+		str, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, kvs)
+		assert.NoError(t, err)
+		params := make(map[string]any)
+		assert.NoError(t, json.Unmarshal([]byte(str), &params))
+		return params
+	}
+	request := []*commonpb.KeyValuePair{
+		{Key: TopKKey, Value: "10"},
+		{Key: OffsetKey, Value: "5"},
+		{Key: SearchParamsKey, Value: `{"nprobe": 16}`},
+	}
+
+	// first page
+	kvs, exhausted, err := iteratorSearchParams(request, 100, nil, 0)
+	assert.NoError(t, err)
+	assert.True(t, exhausted)
+	topk, _ := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, kvs)
+	assert.Equal(t, "100", topk)
+	_, err = funcutil.GetAttrByKeyFromRepeatedKV(OffsetKey, kvs)
+	assert.Error(t, err)
+	iterator, _ := funcutil.GetAttrByKeyFromRepeatedKV(IteratorField, kvs)
+	assert.Equal(t, "True", iterator)
+	params := getParams(kvs)
+	assert.EqualValues(t, 16, params["nprobe"])
+	assert.NotContains(t, params, radiusKey)
+
+	// L2, smaller is better
+	cursor := &iteratorCursor{Distance: 1, MetricType: metric.L2}
+	kvs, exhausted, err = iteratorSearchParams(request, 100, cursor, 0.5)
+	assert.NoError(t, err)
+	assert.False(t, exhausted)
+	metricType, _ := funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, kvs)
+	assert.Equal(t, metric.L2, metricType)
+	params = getParams(kvs)
+	assert.EqualValues(t, 1.5, params[radiusKey])
+	assert.EqualValues(t, 1, params[rangeFilterKey])
+
+	// unbounded
+	kvs, exhausted, err = iteratorSearchParams(request, 100, cursor, 0)
+	assert.NoError(t, err)
+	assert.True(t, exhausted)
+	assert.EqualValues(t, math.MaxFloat32, getParams(kvs)[radiusKey])
+
+	// IP, larger is better
+	cursor = &iteratorCursor{Distance: 1, MetricType: metric.IP}
+	kvs, exhausted, err = iteratorSearchParams(request, 100, cursor, 0.5)
+	assert.NoError(t, err)
+	assert.False(t, exhausted)
+	params = getParams(kvs)
+	assert.EqualValues(t, 0.5, params[radiusKey])
+	assert.EqualValues(t, 1, params[rangeFilterKey])
+
+	// bounded by the radius specified
+	request[2].Value = `{"radius": 0.8}`
+	kvs, exhausted, err = iteratorSearchParams(request, 100, cursor, 0.5)
+	assert.NoError(t, err)
+	assert.True(t, exhausted)
+	assert.EqualValues(t, 0.8, getParams(kvs)[radiusKey])
+
+	request[2].Value = `invalid`
+	_, _, err = iteratorSearchParams(request, 100, cursor, 0.5)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestNextSearchIteratorCursor(t *testing.T) {
+	results := &schemapb.SearchResultData{
+		Scores: []float32{0.1, 0.2, 0.3, 0.3},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4}}},
+		},
+	}
+	cursor := nextSearchIteratorCursor(nil, 100, map[string]uint64{"dml_0": 1000}, metric.L2, results)
+	assert.EqualValues(t, 100, cursor.CollectionID)
+	assert.EqualValues(t, 0.3, cursor.Distance)
+	assert.InDelta(t, 0.2, cursor.Width, 1e-6)
+	assert.ElementsMatch(t, []int64{3, 4}, cursor.IntPKs)
+
+	// the last distance is the same as the previous page
+	results = &schemapb.SearchResultData{
+		Scores: []float32{0.3, 0.3},
+		Ids: &schemapb.IDs{
+			IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{5, 6}}},
+		},
+	}
+	cursor = nextSearchIteratorCursor(cursor, 100, cursor.ChannelsMvcc, metric.L2, results)
+	assert.EqualValues(t, searchIteratorMinWidth, cursor.Width)
+	assert.ElementsMatch(t, []int64{3, 4, 5, 6}, cursor.IntPKs)
+}
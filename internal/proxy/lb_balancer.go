@@ -29,3 +29,8 @@ type LBBalancer interface {
 	Start(ctx context.Context)
 	Close()
 }
+
+// failureReporter is implemented by the balancers penalizing the query nodes failed the requests.
+type failureReporter interface {
+	ReportFailure(node int64, err error)
+}
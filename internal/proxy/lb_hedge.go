@@ -0,0 +1,276 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// hedgeLatencyWindow is the number of the recent latencies the hedging threshold computed from
+	hedgeLatencyWindow = 1000
+	// hedgeMinLatencySamples is the number of latencies required before hedging, the threshold is refreshed per these samples
+	hedgeMinLatencySamples = 100
+	// hedgeMaxBudget caps the hedged requests could be issued in a burst
+	hedgeMaxBudget = 10
+)
+
+// requestHedger decides when to issue the hedged request by the percentile of the recent latencies,
+// and caps the hedged requests by the budget earned from the requests.
+type requestHedger struct {
+	mu        sync.Mutex
+	latencies []time.Duration
+	next      int
+	recorded  int
+	threshold time.Duration
+	budget    float64
+}
+
+func newRequestHedger() *requestHedger {
+	return &requestHedger{
+		latencies: make([]time.Duration, 0, hedgeLatencyWindow),
+	}
+}
+
+// record adds the latency of a succeeded request.
+func (h *requestHedger) record(latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.latencies) < hedgeLatencyWindow {
+		h.latencies = append(h.latencies, latency)
+	} else {
+		h.latencies[h.next] = latency
+		h.next = (h.next + 1) % hedgeLatencyWindow
+	}
+	h.recorded++
+	if len(h.latencies) < hedgeMinLatencySamples || (h.threshold > 0 && h.recorded < hedgeMinLatencySamples) {
+		return
+	}
+
+	sorted := make([]time.Duration, len(h.latencies))
+	copy(sorted, h.latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	percentile := math.Max(0, math.Min(1, Params.ProxyCfg.HedgedReadPercentile.GetAsFloat()))
+	h.threshold = sorted[int(percentile*float64(len(sorted)-1))]
+	h.recorded = 0
+}
+
+// delay returns how long to wait before issuing the hedged request, false if not enough latencies recorded.
+func (h *requestHedger) delay() (time.Duration, bool) {
+	h.mu.Lock()
+	threshold := h.threshold
+	h.mu.Unlock()
+
+	if threshold <= 0 {
+		return 0, false
+	}
+	minDelay := Params.ProxyCfg.HedgedReadMinDelay.GetAsDuration(time.Millisecond)
+	if threshold < minDelay {
+		return minDelay, true
+	}
+	return threshold, true
+}
+
+// earn adds the budget of one request, each request allows the budget ratio of hedged requests.
+func (h *requestHedger) earn() {
+	ratio := Params.ProxyCfg.HedgedReadBudget.GetAsFloat()
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.budget = math.Min(h.budget+ratio, hedgeMaxBudget)
+}
+
+// tryAcquire consumes the budget of one hedged request, false if the budget runs out.
+func (h *requestHedger) tryAcquire() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.budget < 1 {
+		return false
+	}
+	h.budget--
+	return true
+}
+
+// hedgedQueryNodeClient issues the search/query to another replica of the channel if the selected delegator
+// doesn't respond in time, the first succeeded response is taken, other requests go to the delegator directly.
+type hedgedQueryNodeClient struct {
+	types.QueryNodeClient
+	lb           *LBPolicyImpl
+	workload     ChannelWorkload
+	nodeID       int64
+	excludeNodes typeutil.UniqueSet
+}
+
+type hedgedResult[T any] struct {
+	nodeID int64
+	resp   T
+	err    error
+}
+
+func (r hedgedResult[T]) succeed() bool {
+	if r.err != nil {
+		return false
+	}
+	if status, ok := any(r.resp).(interface{ GetStatus() *commonpb.Status }); ok {
+		return merr.Ok(status.GetStatus())
+	}
+	return true
+}
+
+// selectHedgedNode selects another replica for the hedged request, returns -1 if there is no other replica.
+func (c *hedgedQueryNodeClient) selectHedgedNode(ctx context.Context) (int64, types.QueryNodeClient) {
+	candidates := lo.Filter(c.workload.shardLeaders, func(node int64, _ int) bool {
+		return node != c.nodeID && !c.excludeNodes.Contain(node)
+	})
+	if len(candidates) == 0 || !c.lb.hedger.tryAcquire() {
+		return -1, nil
+	}
+
+	node, err := c.lb.balancer.SelectNode(ctx, candidates, c.workload.nq)
+	if err != nil {
+		log.Ctx(ctx).Debug("failed to select node for hedged request", zap.String("channel", c.workload.channel), zap.Error(err))
+		return -1, nil
+	}
+	client, err := c.lb.clientMgr.GetClient(ctx, node)
+	if err != nil {
+		log.Ctx(ctx).Debug("failed to get client for hedged request", zap.Int64("nodeID", node), zap.Error(err))
+		c.lb.balancer.CancelWorkload(node, c.workload.nq)
+		return -1, nil
+	}
+	return node, client
+}
+
+func hedgedCall[T any](ctx context.Context, c *hedgedQueryNodeClient, call func(ctx context.Context, nodeID int64, client types.QueryNodeClient) (T, error)) (T, int64, error) {
+	hedger := c.lb.hedger
+	hedger.earn()
+
+	start := time.Now()
+	delay, ok := hedger.delay()
+	if !ok {
+		r := hedgedResult[T]{nodeID: c.nodeID}
+		r.resp, r.err = call(ctx, c.nodeID, c.QueryNodeClient)
+		if r.succeed() {
+			hedger.record(time.Since(start))
+		}
+		return r.resp, r.nodeID, r.err
+	}
+
+	// the request which doesn't respond first is canceled
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	resultCh := make(chan hedgedResult[T], 2)
+	send := func(nodeID int64, client types.QueryNodeClient) {
+		r := hedgedResult[T]{nodeID: nodeID}
+		r.resp, r.err = call(ctx, nodeID, client)
+		resultCh <- r
+	}
+	go send(c.nodeID, c.QueryNodeClient)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	pending := 1
+	nodeIDLabel := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	for {
+		select {
+		case <-timer.C:
+			node, client := c.selectHedgedNode(ctx)
+			if client == nil {
+				continue
+			}
+			log.Ctx(ctx).Debug("delegator responds slowly, issue hedged request",
+				zap.String("channel", c.workload.channel),
+				zap.Int64("nodeID", c.nodeID),
+				zap.Int64("hedgedNodeID", node),
+				zap.Duration("delay", delay))
+			metrics.ProxyHedgedReadCount.WithLabelValues(nodeIDLabel, metrics.TotalLabel).Inc()
+			pending++
+			go func() {
+				defer c.lb.balancer.CancelWorkload(node, c.workload.nq)
+				send(node, client)
+			}()
+		case r := <-resultCh:
+			pending--
+			succeed := r.succeed()
+			if succeed && r.nodeID == c.nodeID {
+				hedger.record(time.Since(start))
+			}
+			if succeed && r.nodeID != c.nodeID {
+				metrics.ProxyHedgedReadCount.WithLabelValues(nodeIDLabel, metrics.SuccessLabel).Inc()
+			}
+			// wait for the other request if failed
+			if succeed || pending == 0 {
+				return r.resp, r.nodeID, r.err
+			}
+		}
+	}
+}
+
+func (c *hedgedQueryNodeClient) Search(ctx context.Context, req *querypb.SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error) {
+	resp, nodeID, err := hedgedCall(ctx, c, func(ctx context.Context, nodeID int64, client types.QueryNodeClient) (*internalpb.SearchResults, error) {
+		if nodeID == c.nodeID {
+			return client.Search(ctx, req, opts...)
+		}
+		hedgedReq := typeutil.Clone(req)
+		if base := hedgedReq.GetReq().GetBase(); base != nil {
+			base.TargetID = nodeID
+		}
+		return client.Search(ctx, hedgedReq, opts...)
+	})
+	if err == nil && nodeID != c.nodeID {
+		// the cost belongs to the hedged node rather than the one the caller selected
+		c.lb.UpdateCostMetrics(nodeID, resp.GetCostAggregation())
+		resp.CostAggregation = nil
+	}
+	return resp, err
+}
+
+func (c *hedgedQueryNodeClient) Query(ctx context.Context, req *querypb.QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error) {
+	resp, nodeID, err := hedgedCall(ctx, c, func(ctx context.Context, nodeID int64, client types.QueryNodeClient) (*internalpb.RetrieveResults, error) {
+		if nodeID == c.nodeID {
+			return client.Query(ctx, req, opts...)
+		}
+		hedgedReq := typeutil.Clone(req)
+		if base := hedgedReq.GetReq().GetBase(); base != nil {
+			base.TargetID = nodeID
+		}
+		return client.Query(ctx, hedgedReq, opts...)
+	})
+	if err == nil && nodeID != c.nodeID {
+		c.lb.UpdateCostMetrics(nodeID, resp.GetCostAggregation())
+		resp.CostAggregation = nil
+	}
+	return resp, err
+}
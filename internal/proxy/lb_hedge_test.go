@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestRequestHedger(t *testing.T) {
+	paramtable.Init()
+	h := newRequestHedger()
+
+	for i := 1; i < hedgeMinLatencySamples; i++ {
+		h.record(time.Duration(i) * time.Millisecond)
+	}
+	_, ok := h.delay()
+	assert.False(t, ok)
+
+	h.record(hedgeMinLatencySamples * time.Millisecond)
+	delay, ok := h.delay()
+	assert.True(t, ok)
+	assert.Equal(t, 95*time.Millisecond, delay)
+
+	paramtable.Get().Save(Params.ProxyCfg.HedgedReadMinDelay.Key, "200")
+	defer paramtable.Get().Reset(Params.ProxyCfg.HedgedReadMinDelay.Key)
+	delay, ok = h.delay()
+	assert.True(t, ok)
+	assert.Equal(t, 200*time.Millisecond, delay)
+
+	paramtable.Get().Save(Params.ProxyCfg.HedgedReadBudget.Key, "0.5")
+	defer paramtable.Get().Reset(Params.ProxyCfg.HedgedReadBudget.Key)
+	assert.False(t, h.tryAcquire())
+	h.earn()
+	assert.False(t, h.tryAcquire())
+	h.earn()
+	assert.True(t, h.tryAcquire())
+	assert.False(t, h.tryAcquire())
+
+	// the budget is capped
+	for i := 0; i < 100; i++ {
+		h.earn()
+	}
+	for i := 0; i < hedgeMaxBudget; i++ {
+		assert.True(t, h.tryAcquire())
+	}
+	assert.False(t, h.tryAcquire())
+}
+
+func TestHedgedQueryNodeClient(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+
+	newClient := func(t *testing.T, budget float64) (*hedgedQueryNodeClient, *mocks.MockQueryNodeClient, *MockLBBalancer, *MockShardClientManager) {
+		primary := mocks.NewMockQueryNodeClient(t)
+		balancer := NewMockLBBalancer(t)
+		mgr := NewMockShardClientManager(t)
+		lb := &LBPolicyImpl{
+			balancer:  balancer,
+			clientMgr: mgr,
+			hedger:    newRequestHedger(),
+		}
+		lb.hedger.threshold = 10 * time.Millisecond
+		lb.hedger.budget = budget
+		return &hedgedQueryNodeClient{
+			QueryNodeClient: primary,
+			lb:              lb,
+			workload: ChannelWorkload{
+				channel:      "channel1",
+				shardLeaders: []int64{1, 2},
+				nq:           1,
+			},
+			nodeID:       1,
+			excludeNodes: typeutil.NewUniqueSet(),
+		}, primary, balancer, mgr
+	}
+	slowSearch := func(ctx context.Context, req *querypb.SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(100 * time.Millisecond):
+			return &internalpb.SearchResults{Status: merr.Success()}, nil
+		}
+	}
+
+	t.Run("hedged node responds first", func(t *testing.T) {
+		c, primary, balancer, mgr := newClient(t, 1)
+		primary.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(slowSearch)
+
+		hedged := mocks.NewMockQueryNodeClient(t)
+		cost := &internalpb.CostAggregation{ResponseTime: 1}
+		hedged.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *querypb.SearchRequest, opts ...grpc.CallOption) (*internalpb.SearchResults, error) {
+				assert.EqualValues(t, 2, req.GetReq().GetBase().GetTargetID())
+				return &internalpb.SearchResults{Status: merr.Success(), CostAggregation: cost}, nil
+			})
+		balancer.EXPECT().SelectNode(mock.Anything, []int64{2}, int64(1)).Return(2, nil)
+		balancer.EXPECT().CancelWorkload(int64(2), int64(1))
+		balancer.EXPECT().UpdateCostMetrics(int64(2), cost)
+		mgr.EXPECT().GetClient(mock.Anything, int64(2)).Return(hedged, nil)
+
+		req := &querypb.SearchRequest{Req: &internalpb.SearchRequest{Base: &commonpb.MsgBase{TargetID: 1}}}
+		resp, err := c.Search(ctx, req)
+		assert.NoError(t, err)
+		assert.Nil(t, resp.GetCostAggregation())
+		// the request of the caller is not modified
+		assert.EqualValues(t, 1, req.GetReq().GetBase().GetTargetID())
+	})
+
+	t.Run("budget runs out", func(t *testing.T) {
+		c, primary, _, _ := newClient(t, 0)
+		primary.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(slowSearch)
+
+		resp, err := c.Search(ctx, &querypb.SearchRequest{})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.GetStatus()))
+	})
+
+	t.Run("hedged node fails", func(t *testing.T) {
+		c, primary, balancer, mgr := newClient(t, 1)
+		primary.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *querypb.QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error) {
+				time.Sleep(50 * time.Millisecond)
+				return &internalpb.RetrieveResults{Status: merr.Success()}, nil
+			})
+
+		hedged := mocks.NewMockQueryNodeClient(t)
+		hedged.EXPECT().Query(mock.Anything, mock.Anything).Return(&internalpb.RetrieveResults{Status: merr.Status(merr.ErrServiceOverloaded)}, nil)
+		balancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(2, nil)
+		balancer.EXPECT().CancelWorkload(int64(2), int64(1))
+		mgr.EXPECT().GetClient(mock.Anything, int64(2)).Return(hedged, nil)
+
+		resp, err := c.Query(ctx, &querypb.QueryRequest{})
+		assert.NoError(t, err)
+		assert.True(t, merr.Ok(resp.GetStatus()))
+	})
+
+	t.Run("no other replica", func(t *testing.T) {
+		c, primary, _, _ := newClient(t, 1)
+		c.workload.shardLeaders = []int64{1}
+		primary.EXPECT().Query(mock.Anything, mock.Anything).Return(nil, merr.ErrServiceUnavailable).Once()
+
+		_, err := c.Query(ctx, &querypb.QueryRequest{})
+		assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+	})
+}
@@ -17,6 +17,8 @@ package proxy
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -51,6 +53,39 @@ type CollectionWorkLoad struct {
 	collectionID   int64
 	nq             int64
 	exec           executeFunc
+	// partial tolerates the failed channels and records them if not nil
+	partial *partialResult
+}
+
+// partialResult records the channels failed in a workload which returns the results of the other channels.
+type partialResult struct {
+	mu      sync.Mutex
+	total   int
+	failed  []string
+	lastErr error
+}
+
+func (p *partialResult) fail(channel string, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failed = append(p.failed, channel)
+	p.lastErr = err
+}
+
+func (p *partialResult) failedChannels() []string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.failed
+}
+
+// coverage returns the fraction of the channels that succeeded.
+func (p *partialResult) coverage() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.total == 0 {
+		return 1
+	}
+	return float64(p.total-len(p.failed)) / float64(p.total)
 }
 
 type LBPolicy interface {
@@ -64,6 +99,8 @@ type LBPolicy interface {
 type LBPolicyImpl struct {
 	balancer  LBBalancer
 	clientMgr shardClientMgr
+	// hedger is nil if the hedged read is unused
+	hedger *requestHedger
 }
 
 func NewLBPolicyImpl(clientMgr shardClientMgr) *LBPolicyImpl {
@@ -74,14 +111,18 @@ func NewLBPolicyImpl(clientMgr shardClientMgr) *LBPolicyImpl {
 	case "round_robin":
 		log.Info("use round_robin policy on replica selection")
 		balancer = NewRoundRobinBalancer()
-	default:
+	case "look_aside":
 		log.Info("use look_aside policy on replica selection")
 		balancer = NewLookAsideBalancer(clientMgr)
+	default:
+		log.Info("use adaptive policy on replica selection")
+		balancer = NewAdaptiveBalancer(clientMgr)
 	}
 
 	return &LBPolicyImpl{
 		balancer:  balancer,
 		clientMgr: clientMgr,
+		hedger:    newRequestHedger(),
 	}
 }
 
@@ -170,6 +211,7 @@ func (lb *LBPolicyImpl) ExecuteWithRetry(ctx context.Context, workload ChannelWo
 				zap.Int64("nodeID", targetNode),
 				zap.Error(err))
 			excludeNodes.Insert(targetNode)
+			lb.reportFailure(targetNode, err)
 
 			// cancel work load which assign to the target node
 			lb.balancer.CancelWorkload(targetNode, workload.nq)
@@ -177,12 +219,29 @@ func (lb *LBPolicyImpl) ExecuteWithRetry(ctx context.Context, workload ChannelWo
 			return lastErr
 		}
 
+		if lb.hedger != nil && Params.ProxyCfg.HedgedReadEnabled.GetAsBool() {
+			client = &hedgedQueryNodeClient{
+				QueryNodeClient: client,
+				lb:              lb,
+				workload:        workload,
+				nodeID:          targetNode,
+				excludeNodes:    excludeNodes,
+			}
+		}
+
 		err = workload.exec(ctx, targetNode, client, workload.channel)
 		if err != nil {
-			log.Warn("search/query channel failed",
-				zap.Int64("nodeID", targetNode),
-				zap.Error(err))
+			if errors.Is(err, merr.ErrServiceOverloaded) {
+				log.Warn("search/query channel rejected as query node overloaded, retry on another replica",
+					zap.Int64("nodeID", targetNode),
+					zap.Error(err))
+			} else {
+				log.Warn("search/query channel failed",
+					zap.Int64("nodeID", targetNode),
+					zap.Error(err))
+			}
 			excludeNodes.Insert(targetNode)
+			lb.reportFailure(targetNode, err)
 			lb.balancer.CancelWorkload(targetNode, workload.nq)
 
 			lastErr = errors.Wrapf(err, "failed to search/query delegator %d for channel %s", targetNode, workload.channel)
@@ -204,13 +263,25 @@ func (lb *LBPolicyImpl) Execute(ctx context.Context, workload CollectionWorkLoad
 		return err
 	}
 
+	partial := workload.partial
+	if partial != nil {
+		partial.total = len(dml2leaders)
+		// leave the rest of the request timeout to reduce the results of the channels responded in time
+		if deadline, ok := ctx.Deadline(); ok {
+			var cancel context.CancelFunc
+			timeout := time.Duration(float64(time.Until(deadline)) * Params.ProxyCfg.PartialResultTimeoutRatio.GetAsFloat())
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+	}
+
 	wg, ctx := errgroup.WithContext(ctx)
 	for channel, nodes := range dml2leaders {
 		channel := channel
 		nodes := lo.Map(nodes, func(node nodeInfo, _ int) int64 { return node.nodeID })
 		retryOnReplica := Params.ProxyCfg.RetryTimesOnReplica.GetAsInt()
 		wg.Go(func() error {
-			return lb.ExecuteWithRetry(ctx, ChannelWorkload{
+			err := lb.ExecuteWithRetry(ctx, ChannelWorkload{
 				db:             workload.db,
 				collectionName: workload.collectionName,
 				collectionID:   workload.collectionID,
@@ -220,10 +291,31 @@ func (lb *LBPolicyImpl) Execute(ctx context.Context, workload CollectionWorkLoad
 				exec:           workload.exec,
 				retryTimes:     uint(len(nodes) * retryOnReplica),
 			})
+			if err != nil && partial != nil {
+				log.Ctx(ctx).Warn("channel failed, skip it for partial result",
+					zap.String("channel", channel), zap.Error(err))
+				partial.fail(channel, err)
+				return nil
+			}
+			return err
 		})
 	}
 
-	return wg.Wait()
+	if err := wg.Wait(); err != nil {
+		return err
+	}
+	// fail the workload if none of the channels succeeded
+	if partial != nil && partial.total > 0 && len(partial.failedChannels()) == partial.total {
+		return partial.lastErr
+	}
+	return nil
+}
+
+// reportFailure reports the failed request to the balancer if it penalizes the failed query nodes.
+func (lb *LBPolicyImpl) reportFailure(node int64, err error) {
+	if reporter, ok := lb.balancer.(failureReporter); ok {
+		reporter.ReportFailure(node, err)
+	}
 }
 
 func (lb *LBPolicyImpl) UpdateCostMetrics(node int64, cost *internalpb.CostAggregation) {
@@ -19,6 +19,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
@@ -335,6 +336,27 @@ func (s *LBPolicySuite) TestExecuteWithRetry() {
 	})
 	s.NoError(err)
 
+	// test exec rejected as query node overloaded, then retry success
+	counter = 0
+	err = s.lbPolicy.ExecuteWithRetry(ctx, ChannelWorkload{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		channel:        s.channels[0],
+		shardLeaders:   s.nodes,
+		nq:             1,
+		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+			counter++
+			if counter == 1 {
+				return merr.Error(merr.Status(merr.WrapErrServiceOverloaded("memory", 95, 90)))
+			}
+			return nil
+		},
+		retryTimes: 2,
+	})
+	s.NoError(err)
+	s.Equal(2, counter)
+
 	// test exec timeout
 	s.mgr.ExpectedCalls = nil
 	s.mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(s.qn, nil)
@@ -411,6 +433,73 @@ func (s *LBPolicySuite) TestExecute() {
 	s.ErrorIs(err, mockErr)
 }
 
+func (s *LBPolicySuite) TestExecutePartialResult() {
+	mockErr := errors.New("mock error")
+	s.mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(s.qn, nil)
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(1, nil)
+	s.lbBalancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything)
+
+	s.Run("skip failed channel", func() {
+		partial := &partialResult{}
+		err := s.lbPolicy.Execute(context.Background(), CollectionWorkLoad{
+			db:             dbName,
+			collectionName: s.collectionName,
+			collectionID:   s.collectionID,
+			nq:             1,
+			exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+				if channel == s.channels[0] {
+					return mockErr
+				}
+				return nil
+			},
+			partial: partial,
+		})
+		s.NoError(err)
+		s.Equal([]string{s.channels[0]}, partial.failedChannels())
+		s.Equal(0.5, partial.coverage())
+	})
+
+	s.Run("skip timeout channel", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		partial := &partialResult{}
+		err := s.lbPolicy.Execute(ctx, CollectionWorkLoad{
+			db:             dbName,
+			collectionName: s.collectionName,
+			collectionID:   s.collectionID,
+			nq:             1,
+			exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+				if channel == s.channels[0] {
+					<-ctx.Done()
+					return ctx.Err()
+				}
+				return nil
+			},
+			partial: partial,
+		})
+		s.NoError(err)
+		// the channels are waited for part of the request timeout
+		s.NoError(ctx.Err())
+		s.Equal(0.5, partial.coverage())
+	})
+
+	s.Run("all channels failed", func() {
+		partial := &partialResult{}
+		err := s.lbPolicy.Execute(context.Background(), CollectionWorkLoad{
+			db:             dbName,
+			collectionName: s.collectionName,
+			collectionID:   s.collectionID,
+			nq:             1,
+			exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+				return mockErr
+			},
+			partial: partial,
+		})
+		s.ErrorIs(err, mockErr)
+		s.Equal(float64(0), partial.coverage())
+	})
+}
+
 func (s *LBPolicySuite) TestUpdateCostMetrics() {
 	s.lbBalancer.EXPECT().UpdateCostMetrics(mock.Anything, mock.Anything)
 	s.lbPolicy.UpdateCostMetrics(1, &internalpb.CostAggregation{})
@@ -418,7 +507,7 @@ func (s *LBPolicySuite) TestUpdateCostMetrics() {
 
 func (s *LBPolicySuite) TestNewLBPolicy() {
 	policy := NewLBPolicyImpl(s.mgr)
-	s.Equal(reflect.TypeOf(policy.balancer).String(), "*proxy.LookAsideBalancer")
+	s.Equal(reflect.TypeOf(policy.balancer).String(), "*proxy.AdaptiveBalancer")
 	policy.Close()
 
 	Params.Save(Params.ProxyCfg.ReplicaSelectionPolicy.Key, "round_robin")
@@ -430,6 +519,12 @@ func (s *LBPolicySuite) TestNewLBPolicy() {
 	policy = NewLBPolicyImpl(s.mgr)
 	s.Equal(reflect.TypeOf(policy.balancer).String(), "*proxy.LookAsideBalancer")
 	policy.Close()
+
+	Params.Save(Params.ProxyCfg.ReplicaSelectionPolicy.Key, "adaptive")
+	defer Params.Reset(Params.ProxyCfg.ReplicaSelectionPolicy.Key)
+	policy = NewLBPolicyImpl(s.mgr)
+	s.Equal(reflect.TypeOf(policy.balancer).String(), "*proxy.AdaptiveBalancer")
+	policy.Close()
 }
 
 func TestLBPolicySuite(t *testing.T) {
@@ -17,13 +17,16 @@
 package proxy
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	management "github.com/milvus-io/milvus/internal/http"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -36,6 +39,33 @@ import (
 const (
 	mgrRouteGcPause  = `/management/datacoord/garbage_collection/pause`
 	mgrRouteGcResume = `/management/datacoord/garbage_collection/resume`
+	mgrRouteGcAudit  = `/management/datacoord/garbage_collection/audit`
+
+	mgrRestoreSegment = `/management/datacoord/segment/restore`
+
+	mgrListChannelCheckpoints = `/management/datacoord/channel/checkpoints`
+
+	mgrCreateSnapshot = `/management/datacoord/snapshot/create`
+	mgrDropSnapshot   = `/management/datacoord/snapshot/drop`
+	mgrListSnapshots  = `/management/datacoord/snapshot/list`
+
+	mgrListReplicas = `/management/datacoord/replication/list`
+
+	mgrCreateBackup = `/management/datacoord/backup/create`
+	mgrListBackups  = `/management/datacoord/backup/list`
+	mgrDropBackup   = `/management/datacoord/backup/drop`
+
+	mgrGetCompactionProgress = `/management/datacoord/compaction/progress`
+	mgrCancelCompaction      = `/management/datacoord/compaction/cancel`
+
+	mgrListIndexTasks   = `/management/datacoord/index/tasks`
+	mgrCancelIndexTasks = `/management/datacoord/index/tasks/cancel`
+	mgrGetIndexAdvice   = `/management/datacoord/index/advice`
+
+	mgrPauseIngestion  = `/management/datacoord/ingestion/pause`
+	mgrResumeIngestion = `/management/datacoord/ingestion/resume`
+
+	mgrSetChannelParallelism = `/management/datacoord/channel/parallelism`
 
 	mgrSuspendQueryCoordBalance = `/management/querycoord/balance/suspend`
 	mgrResumeQueryCoordBalance  = `/management/querycoord/balance/resume`
@@ -47,96 +77,912 @@ const (
 	mgrListQueryNode              = `/management/querycoord/node/list`
 	mgrGetQueryNodeDistribution   = `/management/querycoord/distribution/get`
 	mgrCheckQueryNodeDistribution = `/management/querycoord/distribution/check`
+
+	mgrGetLoadProgressDetail = `/management/querycoord/load/progress`
+
+	mgrFreezeCollection   = `/management/querycoord/collection/freeze`
+	mgrUnfreezeCollection = `/management/querycoord/collection/unfreeze`
 )
 
-var mgrRouteRegisterOnce sync.Once
+var mgrRouteRegisterOnce sync.Once
+
+func RegisterMgrRoute(proxy *Proxy) {
+	mgrRouteRegisterOnce.Do(func() {
+		management.Register(&management.Handler{
+			Path:        mgrRouteGcPause,
+			HandlerFunc: proxy.PauseDatacoordGC,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrRouteGcResume,
+			HandlerFunc: proxy.ResumeDatacoordGC,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrRouteGcAudit,
+			HandlerFunc: proxy.AuditDatacoordGC,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrRestoreSegment,
+			HandlerFunc: proxy.RestoreSegment,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrListChannelCheckpoints,
+			HandlerFunc: proxy.ListChannelCheckpoints,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrCreateSnapshot,
+			HandlerFunc: proxy.CreateSnapshot,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrDropSnapshot,
+			HandlerFunc: proxy.DropSnapshot,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrListSnapshots,
+			HandlerFunc: proxy.ListSnapshots,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrListReplicas,
+			HandlerFunc: proxy.ListReplicas,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrCreateBackup,
+			HandlerFunc: proxy.CreateBackup,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrListBackups,
+			HandlerFunc: proxy.ListBackups,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrDropBackup,
+			HandlerFunc: proxy.DropBackup,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrGetCompactionProgress,
+			HandlerFunc: proxy.GetCompactionProgress,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrCancelCompaction,
+			HandlerFunc: proxy.CancelCompaction,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrListIndexTasks,
+			HandlerFunc: proxy.ListIndexTasks,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrCancelIndexTasks,
+			HandlerFunc: proxy.CancelIndexTasks,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrGetIndexAdvice,
+			HandlerFunc: proxy.GetScalarIndexAdvice,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrPauseIngestion,
+			HandlerFunc: proxy.PauseIngestion,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrResumeIngestion,
+			HandlerFunc: proxy.ResumeIngestion,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrSetChannelParallelism,
+			HandlerFunc: proxy.SetChannelParallelism,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrListQueryNode,
+			HandlerFunc: proxy.ListQueryNode,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrGetQueryNodeDistribution,
+			HandlerFunc: proxy.GetQueryNodeDistribution,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrSuspendQueryCoordBalance,
+			HandlerFunc: proxy.SuspendQueryCoordBalance,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrResumeQueryCoordBalance,
+			HandlerFunc: proxy.ResumeQueryCoordBalance,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrSuspendQueryNode,
+			HandlerFunc: proxy.SuspendQueryNode,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrResumeQueryNode,
+			HandlerFunc: proxy.ResumeQueryNode,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrTransferSegment,
+			HandlerFunc: proxy.TransferSegment,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrTransferChannel,
+			HandlerFunc: proxy.TransferChannel,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrCheckQueryNodeDistribution,
+			HandlerFunc: proxy.CheckQueryNodeDistribution,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrGetLoadProgressDetail,
+			HandlerFunc: proxy.GetLoadProgressDetail,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrFreezeCollection,
+			HandlerFunc: proxy.FreezeCollection,
+		})
+		management.Register(&management.Handler{
+			Path:        mgrUnfreezeCollection,
+			HandlerFunc: proxy.UnfreezeCollection,
+		})
+	})
+}
+
+func (node *Proxy) PauseDatacoordGC(w http.ResponseWriter, req *http.Request) {
+	pauseSeconds := req.URL.Query().Get("pause_seconds")
+
+	resp, err := node.dataCoord.GcControl(req.Context(), &datapb.GcControlRequest{
+		Base:    commonpbutil.NewMsgBase(),
+		Command: datapb.GcCommand_Pause,
+		Params: []*commonpb.KeyValuePair{
+			{Key: "duration", Value: pauseSeconds},
+		},
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to pause garbage collection, %s"}`, err.Error())))
+		return
+	}
+	if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to pause garbage collection, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) ResumeDatacoordGC(w http.ResponseWriter, req *http.Request) {
+	resp, err := node.dataCoord.GcControl(req.Context(), &datapb.GcControlRequest{
+		Base:    commonpbutil.NewMsgBase(),
+		Command: datapb.GcCommand_Resume,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to pause garbage collection, %s"}`, err.Error())))
+		return
+	}
+	if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to pause garbage collection, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// AuditDatacoordGC reports the garbage found by a dry-run garbage collection,
+// the number of files listed could be limited by `limit`
+func (node *Proxy) AuditDatacoordGC(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to audit garbage collection, %s"}`, err.Error())))
+		return
+	}
+
+	var limit int64
+	if value := req.FormValue("limit"); len(value) > 0 {
+		limit, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to audit garbage collection, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	resp, err := node.dataCoord.AuditGarbage(req.Context(), &datapb.AuditGarbageRequest{
+		Base:  commonpbutil.NewMsgBase(),
+		Limit: limit,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to audit garbage collection, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to audit garbage collection, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to audit garbage collection, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// ListChannelCheckpoints lists the checkpoints of the channels with their lags,
+// the channels could be limited to the collection of `collection_id`
+func (node *Proxy) ListChannelCheckpoints(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list channel checkpoints, %s"}`, err.Error())))
+		return
+	}
+
+	var collectionID int64
+	if value := req.FormValue("collection_id"); len(value) > 0 {
+		collectionID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list channel checkpoints, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	resp, err := node.dataCoord.ListChannelCheckpoints(req.Context(), &datapb.ListChannelCheckpointsRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list channel checkpoints, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list channel checkpoints, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list channel checkpoints, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// RestoreSegment restores the dropped segments of collection within the gc restore window,
+// segments could be specified by comma separated `segment_ids`, all the restorable ones are restored otherwise
+func (node *Proxy) RestoreSegment(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore segment, %s"}`, err.Error())))
+		return
+	}
+
+	dbName := req.FormValue("db_name")
+	collectionName := req.FormValue("collection_name")
+	if err := validateCollectionName(collectionName); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore segment, %s"}`, err.Error())))
+		return
+	}
+
+	segmentIDs := make([]int64, 0)
+	if ids := req.FormValue("segment_ids"); len(ids) > 0 {
+		for _, id := range strings.Split(ids, ",") {
+			segmentID, err := strconv.ParseInt(id, 10, 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore segment, %s"}`, err.Error())))
+				return
+			}
+			segmentIDs = append(segmentIDs, segmentID)
+		}
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(req.Context(), dbName, collectionName)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore segment, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.RestoreSegment(req.Context(), &datapb.RestoreSegmentRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		SegmentIDs:   segmentIDs,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore segment, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore segment, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to restore segment, %s"}`, err.Error())))
+		return
+	}
+	w.Write(bytes)
+}
+
+func (node *Proxy) CreateSnapshot(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, err.Error())))
+		return
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(req.Context(), req.FormValue("db_name"), req.FormValue("collection_name"))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.CreateSnapshot(req.Context(), &datapb.CreateSnapshotRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		Name:         req.FormValue("snapshot_name"),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) DropSnapshot(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop snapshot, %s"}`, err.Error())))
+		return
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(req.Context(), req.FormValue("db_name"), req.FormValue("collection_name"))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop snapshot, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.DropSnapshot(req.Context(), &datapb.DropSnapshotRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		Name:         req.FormValue("snapshot_name"),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop snapshot, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop snapshot, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) ListSnapshots(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, err.Error())))
+		return
+	}
+
+	// list the snapshots of all collections if collection name not specified
+	var collectionID int64
+	if collectionName := req.FormValue("collection_name"); len(collectionName) > 0 {
+		collectionID, err = globalMetaCache.GetCollectionID(req.Context(), req.FormValue("db_name"), collectionName)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	resp, err := node.dataCoord.ListSnapshots(req.Context(), &datapb.ListSnapshotsRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+func (node *Proxy) ListReplicas(w http.ResponseWriter, req *http.Request) {
+	resp, err := node.dataCoord.ListReplicas(req.Context(), &datapb.ListReplicasRequest{
+		Base: commonpbutil.NewMsgBase(),
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list replicas, %s"}`, err.Error())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list replicas, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// CreateBackup backs up the collections specified, or the collections of the database, or all the collections.
+func (node *Proxy) CreateBackup(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create backup, %s"}`, err.Error())))
+		return
+	}
+
+	copyFiles := false
+	if value := req.FormValue("copy_files"); len(value) > 0 {
+		copyFiles, err = strconv.ParseBool(value)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create backup, %s"}`, err.Error())))
+			return
+		}
+	}
+	collectionIDs, err := node.getBackupCollectionIDs(req.Context(), req.FormValue("db_name"), req.FormValue("collection_names"))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create backup, %s"}`, err.Error())))
+		return
+	}
+
+	status, err := node.dataCoord.CreateBackup(req.Context(), &datapb.CreateBackupRequest{
+		Base:          commonpbutil.NewMsgBase(),
+		Name:          req.FormValue("backup_name"),
+		CollectionIDs: collectionIDs,
+		CopyFiles:     copyFiles,
+	})
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create backup, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// getBackupCollectionIDs returns the ids of the collections named, or the ones of the database,
+// nil is returned for all the collections if neither specified.
+func (node *Proxy) getBackupCollectionIDs(ctx context.Context, dbName, collectionNames string) ([]int64, error) {
+	if len(collectionNames) > 0 {
+		collectionIDs := make([]int64, 0)
+		for _, collectionName := range strings.Split(collectionNames, ",") {
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, strings.TrimSpace(collectionName))
+			if err != nil {
+				return nil, err
+			}
+			collectionIDs = append(collectionIDs, collectionID)
+		}
+		return collectionIDs, nil
+	}
+	if len(dbName) > 0 {
+		resp, err := node.rootCoord.ShowCollections(ctx, &milvuspb.ShowCollectionsRequest{
+			Base:   commonpbutil.NewMsgBase(commonpbutil.WithMsgType(commonpb.MsgType_ShowCollections)),
+			DbName: dbName,
+		})
+		if err := merr.CheckRPCCall(resp, err); err != nil {
+			return nil, err
+		}
+		if len(resp.GetCollectionIds()) == 0 {
+			return nil, merr.WrapErrParameterInvalidMsg("no collection in database %s", dbName)
+		}
+		return resp.GetCollectionIds(), nil
+	}
+	return nil, nil
+}
+
+func (node *Proxy) ListBackups(w http.ResponseWriter, req *http.Request) {
+	resp, err := node.dataCoord.ListBackups(req.Context(), &datapb.ListBackupsRequest{
+		Base: commonpbutil.NewMsgBase(),
+	})
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list backups, %s"}`, err.Error())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list backups, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+func (node *Proxy) DropBackup(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop backup, %s"}`, err.Error())))
+		return
+	}
+
+	status, err := node.dataCoord.DropBackup(req.Context(), &datapb.DropBackupRequest{
+		Base: commonpbutil.NewMsgBase(),
+		Name: req.FormValue("backup_name"),
+	})
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop backup, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) GetCompactionProgress(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get compaction progress, %s"}`, err.Error())))
+		return
+	}
+
+	compactionID, err := strconv.ParseInt(req.FormValue("compaction_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get compaction progress, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.GetCompactionProgress(req.Context(), &datapb.GetCompactionProgressRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CompactionID: compactionID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get compaction progress, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get compaction progress, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get compaction progress, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+func (node *Proxy) CancelCompaction(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel compaction, %s"}`, err.Error())))
+		return
+	}
+
+	compactionID, err := strconv.ParseInt(req.FormValue("compaction_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel compaction, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.CancelCompaction(req.Context(), &datapb.CancelCompactionRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CompactionID: compactionID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel compaction, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel compaction, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// ListIndexTasks lists the unfinished index tasks with their progress and estimated remaining time,
+// of the collection and the index if specified.
+func (node *Proxy) ListIndexTasks(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list index tasks, %s"}`, err.Error())))
+		return
+	}
+
+	var collectionID int64
+	if value := req.FormValue("collection_id"); len(value) > 0 {
+		collectionID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list index tasks, %s"}`, err.Error())))
+			return
+		}
+	}
+
+	resp, err := node.dataCoord.ListIndexTasks(req.Context(), &datapb.ListIndexTasksRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		IndexName:    req.FormValue("index_name"),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list index tasks, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list index tasks, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list index tasks, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// CancelIndexTasks cancels the index tasks by build_ids,
+// or all the unfinished index tasks of the collection and the index if no build_ids given.
+func (node *Proxy) CancelIndexTasks(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel index tasks, %s"}`, err.Error())))
+		return
+	}
+
+	buildIDs := make([]int64, 0)
+	if ids := req.FormValue("build_ids"); len(ids) > 0 {
+		for _, id := range strings.Split(ids, ",") {
+			buildID, err := strconv.ParseInt(id, 10, 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel index tasks, %s"}`, err.Error())))
+				return
+			}
+			buildIDs = append(buildIDs, buildID)
+		}
+	}
 
-func RegisterMgrRoute(proxy *Proxy) {
-	mgrRouteRegisterOnce.Do(func() {
-		management.Register(&management.Handler{
-			Path:        mgrRouteGcPause,
-			HandlerFunc: proxy.PauseDatacoordGC,
-		})
-		management.Register(&management.Handler{
-			Path:        mgrRouteGcResume,
-			HandlerFunc: proxy.ResumeDatacoordGC,
-		})
-		management.Register(&management.Handler{
-			Path:        mgrListQueryNode,
-			HandlerFunc: proxy.ListQueryNode,
-		})
-		management.Register(&management.Handler{
-			Path:        mgrGetQueryNodeDistribution,
-			HandlerFunc: proxy.GetQueryNodeDistribution,
-		})
-		management.Register(&management.Handler{
-			Path:        mgrSuspendQueryCoordBalance,
-			HandlerFunc: proxy.SuspendQueryCoordBalance,
-		})
-		management.Register(&management.Handler{
-			Path:        mgrResumeQueryCoordBalance,
-			HandlerFunc: proxy.ResumeQueryCoordBalance,
-		})
-		management.Register(&management.Handler{
-			Path:        mgrSuspendQueryNode,
-			HandlerFunc: proxy.SuspendQueryNode,
-		})
-		management.Register(&management.Handler{
-			Path:        mgrResumeQueryNode,
-			HandlerFunc: proxy.ResumeQueryNode,
-		})
-		management.Register(&management.Handler{
-			Path:        mgrTransferSegment,
-			HandlerFunc: proxy.TransferSegment,
-		})
-		management.Register(&management.Handler{
-			Path:        mgrTransferChannel,
-			HandlerFunc: proxy.TransferChannel,
-		})
-		management.Register(&management.Handler{
-			Path:        mgrCheckQueryNodeDistribution,
-			HandlerFunc: proxy.CheckQueryNodeDistribution,
-		})
+	var collectionID int64
+	if value := req.FormValue("collection_id"); len(value) > 0 {
+		collectionID, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel index tasks, %s"}`, err.Error())))
+			return
+		}
+	}
+	if len(buildIDs) == 0 && collectionID == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"msg": "failed to cancel index tasks, build_ids or collection_id is required"}`))
+		return
+	}
+
+	resp, err := node.dataCoord.CancelIndexTasks(req.Context(), &datapb.CancelIndexTasksRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		IndexName:    req.FormValue("index_name"),
+		BuildIDs:     buildIDs,
 	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel index tasks, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel index tasks, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
 }
 
-func (node *Proxy) PauseDatacoordGC(w http.ResponseWriter, req *http.Request) {
-	pauseSeconds := req.URL.Query().Get("pause_seconds")
+// GetScalarIndexAdvice advises the scalar index types of the fields of the collection by their cardinality.
+func (node *Proxy) GetScalarIndexAdvice(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get scalar index advice, %s"}`, err.Error())))
+		return
+	}
 
-	resp, err := node.dataCoord.GcControl(req.Context(), &datapb.GcControlRequest{
-		Base:    commonpbutil.NewMsgBase(),
-		Command: datapb.GcCommand_Pause,
-		Params: []*commonpb.KeyValuePair{
-			{Key: "duration", Value: pauseSeconds},
-		},
+	collectionID, err := strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get scalar index advice, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.GetScalarIndexAdvice(req.Context(), &datapb.GetScalarIndexAdviceRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
 	})
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to pause garbage collection, %s"}`, err.Error())))
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get scalar index advice, %s"}`, err.Error())))
 		return
 	}
-	if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+
+	if !merr.Ok(resp.GetStatus()) {
 		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to pause garbage collection, %s"}`, resp.GetReason())))
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get scalar index advice, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get scalar index advice, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// PauseIngestion stops the datanodes consuming the insert and delete messages of the collection,
+// the messages are consumed after the ingestion resumed.
+func (node *Proxy) PauseIngestion(w http.ResponseWriter, req *http.Request) {
+	node.setIngestionPaused(w, req, true)
+}
+
+func (node *Proxy) ResumeIngestion(w http.ResponseWriter, req *http.Request) {
+	node.setIngestionPaused(w, req, false)
+}
+
+func (node *Proxy) setIngestionPaused(w http.ResponseWriter, req *http.Request, paused bool) {
+	action := "resume"
+	if paused {
+		action = "pause"
+	}
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s ingestion, %s"}`, action, err.Error())))
+		return
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(req.Context(), req.FormValue("db_name"), req.FormValue("collection_name"))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s ingestion, %s"}`, action, err.Error())))
+		return
+	}
+
+	var resp *commonpb.Status
+	if paused {
+		resp, err = node.dataCoord.PauseIngestion(req.Context(), &datapb.PauseIngestionRequest{
+			Base:         commonpbutil.NewMsgBase(),
+			CollectionID: collectionID,
+		})
+	} else {
+		resp, err = node.dataCoord.ResumeIngestion(req.Context(), &datapb.ResumeIngestionRequest{
+			Base:         commonpbutil.NewMsgBase(),
+			CollectionID: collectionID,
+		})
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s ingestion, %s"}`, action, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to %s ingestion, %s"}`, action, resp.GetReason())))
 		return
 	}
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(`{"msg": "OK"}`))
 }
 
-func (node *Proxy) ResumeDatacoordGC(w http.ResponseWriter, req *http.Request) {
-	resp, err := node.dataCoord.GcControl(req.Context(), &datapb.GcControlRequest{
-		Base:    commonpbutil.NewMsgBase(),
-		Command: datapb.GcCommand_Resume,
+// SetChannelParallelism updates the number of stages the datanode flowgraph of the channel runs in.
+func (node *Proxy) SetChannelParallelism(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to set channel parallelism, %s"}`, err.Error())))
+		return
+	}
+
+	parallelism, err := strconv.ParseInt(req.FormValue("parallelism"), 10, 32)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to set channel parallelism, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.dataCoord.SetChannelParallelism(req.Context(), &datapb.SetChannelParallelismRequest{
+		Base:        commonpbutil.NewMsgBase(),
+		Channel:     req.FormValue("channel"),
+		Parallelism: int32(parallelism),
 	})
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to pause garbage collection, %s"}`, err.Error())))
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to set channel parallelism, %s"}`, err.Error())))
 		return
 	}
-	if resp.GetErrorCode() != commonpb.ErrorCode_Success {
+
+	if !merr.Ok(resp) {
 		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to pause garbage collection, %s"}`, resp.GetReason())))
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to set channel parallelism, %s"}`, resp.GetReason())))
 		return
 	}
 	w.WriteHeader(http.StatusOK)
@@ -501,3 +1347,153 @@ func (node *Proxy) CheckQueryNodeDistribution(w http.ResponseWriter, req *http.R
 	w.WriteHeader(http.StatusOK)
 	w.Write([]byte(`{"msg": "OK"}`))
 }
+
+// GetLoadProgressDetail returns the per stage load progress of collection,
+// partitions could be specified by comma separated `partition_names`
+func (node *Proxy) GetLoadProgressDetail(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get load progress detail, %s"}`, err.Error())))
+		return
+	}
+
+	dbName := req.FormValue("db_name")
+	collectionName := req.FormValue("collection_name")
+	if err := validateCollectionName(collectionName); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get load progress detail, %s"}`, err.Error())))
+		return
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(req.Context(), dbName, collectionName)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get load progress detail, %s"}`, err.Error())))
+		return
+	}
+
+	partitionIDs := make([]int64, 0)
+	if partitionNames := req.FormValue("partition_names"); len(partitionNames) > 0 {
+		for _, partitionName := range strings.Split(partitionNames, ",") {
+			partitionID, err := globalMetaCache.GetPartitionID(req.Context(), dbName, collectionName, partitionName)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get load progress detail, %s"}`, err.Error())))
+				return
+			}
+			partitionIDs = append(partitionIDs, partitionID)
+		}
+	}
+
+	resp, err := node.queryCoord.GetLoadProgressDetail(req.Context(), &querypb.GetLoadProgressDetailRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+		PartitionIDs: partitionIDs,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get load progress detail, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp.GetStatus()) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get load progress detail, %s"}`, resp.GetStatus().GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	// skip marshal status to output
+	resp.Status = nil
+	bytes, err := json.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get load progress detail, %s"}`, err.Error())))
+		return
+	}
+	w.Write(bytes)
+}
+
+func (node *Proxy) FreezeCollection(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to freeze collection, %s"}`, err.Error())))
+		return
+	}
+
+	dbName := req.FormValue("db_name")
+	collectionName := req.FormValue("collection_name")
+	if err := validateCollectionName(collectionName); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to freeze collection, %s"}`, err.Error())))
+		return
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(req.Context(), dbName, collectionName)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to freeze collection, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.queryCoord.FreezeCollection(req.Context(), &querypb.FreezeCollectionRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to freeze collection, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to freeze collection, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (node *Proxy) UnfreezeCollection(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to unfreeze collection, %s"}`, err.Error())))
+		return
+	}
+
+	dbName := req.FormValue("db_name")
+	collectionName := req.FormValue("collection_name")
+	if err := validateCollectionName(collectionName); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to unfreeze collection, %s"}`, err.Error())))
+		return
+	}
+
+	collectionID, err := globalMetaCache.GetCollectionID(req.Context(), dbName, collectionName)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to unfreeze collection, %s"}`, err.Error())))
+		return
+	}
+
+	resp, err := node.queryCoord.UnfreezeCollection(req.Context(), &querypb.UnfreezeCollectionRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		CollectionID: collectionID,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to unfreeze collection, %s"}`, err.Error())))
+		return
+	}
+
+	if !merr.Ok(resp) {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to unfreeze collection, %s"}`, resp.GetReason())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
@@ -29,8 +29,10 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
@@ -40,16 +42,19 @@ type ProxyManagementSuite struct {
 
 	querycoord *mocks.MockQueryCoordClient
 	datacoord  *mocks.MockDataCoordClient
+	rootcoord  *mocks.MockRootCoordClient
 	proxy      *Proxy
 }
 
 func (s *ProxyManagementSuite) SetupTest() {
 	s.datacoord = mocks.NewMockDataCoordClient(s.T())
 	s.querycoord = mocks.NewMockQueryCoordClient(s.T())
+	s.rootcoord = mocks.NewMockRootCoordClient(s.T())
 
 	s.proxy = &Proxy{
 		dataCoord:  s.datacoord,
 		queryCoord: s.querycoord,
+		rootCoord:  s.rootcoord,
 	}
 }
 
@@ -165,6 +170,656 @@ func (s *ProxyManagementSuite) TestResumeDatacoordGC() {
 	})
 }
 
+func (s *ProxyManagementSuite) TestRestoreSegment() {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil)
+		globalMetaCache = metaCache
+
+		s.datacoord.EXPECT().RestoreSegment(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.RestoreSegmentRequest, opts ...grpc.CallOption) (*datapb.RestoreSegmentResponse, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			s.Equal([]int64{100, 101}, req.GetSegmentIDs())
+			return &datapb.RestoreSegmentResponse{Status: merr.Success(), RestoredSegmentIDs: []int64{100, 101}}, nil
+		})
+		req, err := http.NewRequest(http.MethodPost, mgrRestoreSegment, strings.NewReader("collection_name=test_collection&segment_ids=100,101"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.RestoreSegment(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"restored_segmentIDs":[100,101]}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodPost, mgrRestoreSegment, strings.NewReader("collection_name=test_collection&segment_ids=a"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.RestoreSegment(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil)
+		globalMetaCache = metaCache
+
+		s.datacoord.EXPECT().RestoreSegment(mock.Anything, mock.Anything).Return(&datapb.RestoreSegmentResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("segment restore disabled")),
+		}, nil).Once()
+		req, err = http.NewRequest(http.MethodPost, mgrRestoreSegment, strings.NewReader("collection_name=test_collection"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.RestoreSegment(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestAuditDatacoordGC() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().AuditGarbage(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.AuditGarbageRequest, opts ...grpc.CallOption) (*datapb.AuditGarbageResponse, error) {
+			s.EqualValues(10, req.GetLimit())
+			return &datapb.AuditGarbageResponse{
+				Status:           merr.Success(),
+				OrphanFileNum:    1,
+				OrphanBytes:      100,
+				ReclaimableBytes: 100,
+			}, nil
+		})
+		req, err := http.NewRequest(http.MethodGet, mgrRouteGcAudit+"?limit=10", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.AuditDatacoordGC(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"orphan_file_num":1,"orphan_bytes":100,"reclaimable_bytes":100}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodGet, mgrRouteGcAudit+"?limit=a", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.AuditDatacoordGC(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		s.datacoord.EXPECT().AuditGarbage(mock.Anything, mock.Anything).Return(&datapb.AuditGarbageResponse{
+			Status: merr.Status(merr.WrapErrServiceUnavailable("garbage collector not available")),
+		}, nil).Once()
+		req, err = http.NewRequest(http.MethodGet, mgrRouteGcAudit, nil)
+		s.Require().NoError(err)
+		recorder = httptest.NewRecorder()
+		s.proxy.AuditDatacoordGC(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestListChannelCheckpoints() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().ListChannelCheckpoints(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.ListChannelCheckpointsRequest, opts ...grpc.CallOption) (*datapb.ListChannelCheckpointsResponse, error) {
+			s.EqualValues(100, req.GetCollectionID())
+			return &datapb.ListChannelCheckpointsResponse{
+				Status: merr.Success(),
+				Checkpoints: []*datapb.ChannelCheckpointLag{
+					{Channel: "ch-1", CollectionID: 100, LagMs: 1000, Lagging: true},
+				},
+			}, nil
+		})
+		req, err := http.NewRequest(http.MethodGet, mgrListChannelCheckpoints+"?collection_id=100", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.ListChannelCheckpoints(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"checkpoints":[{"channel":"ch-1","collectionID":100,"lag_ms":1000,"lagging":true}]}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodGet, mgrListChannelCheckpoints+"?collection_id=a", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.ListChannelCheckpoints(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		s.datacoord.EXPECT().ListChannelCheckpoints(mock.Anything, mock.Anything).Return(&datapb.ListChannelCheckpointsResponse{
+			Status: merr.Status(merr.WrapErrServiceNotReady("test", 0, "initializing")),
+		}, nil).Once()
+		req, err = http.NewRequest(http.MethodGet, mgrListChannelCheckpoints, nil)
+		s.Require().NoError(err)
+		recorder = httptest.NewRecorder()
+		s.proxy.ListChannelCheckpoints(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetCompactionProgress() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().GetCompactionProgress(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.GetCompactionProgressRequest, opts ...grpc.CallOption) (*datapb.GetCompactionProgressResponse, error) {
+			s.EqualValues(1000, req.GetCompactionID())
+			return &datapb.GetCompactionProgressResponse{
+				Status:            merr.Success(),
+				TotalPlans:        2,
+				CompletedPlans:    1,
+				TotalSegments:     4,
+				RewrittenSegments: 2,
+			}, nil
+		})
+		req, err := http.NewRequest(http.MethodGet, mgrGetCompactionProgress+"?compaction_id=1000", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.GetCompactionProgress(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"total_plans":2,"completed_plans":1,"total_segments":4,"rewritten_segments":2}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodGet, mgrGetCompactionProgress+"?compaction_id=a", nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.GetCompactionProgress(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		s.datacoord.EXPECT().GetCompactionProgress(mock.Anything, mock.Anything).Return(&datapb.GetCompactionProgressResponse{
+			Status: merr.Status(merr.WrapErrParameterInvalidMsg("compaction not found")),
+		}, nil).Once()
+		req, err = http.NewRequest(http.MethodGet, mgrGetCompactionProgress+"?compaction_id=1000", nil)
+		s.Require().NoError(err)
+		recorder = httptest.NewRecorder()
+		s.proxy.GetCompactionProgress(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestCancelCompaction() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().CancelCompaction(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.CancelCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.EqualValues(1000, req.GetCompactionID())
+			return merr.Success(), nil
+		})
+		req, err := http.NewRequest(http.MethodPost, mgrCancelCompaction, strings.NewReader("compaction_id=1000"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CancelCompaction(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().CancelCompaction(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err := http.NewRequest(http.MethodPost, mgrCancelCompaction, strings.NewReader("compaction_id=1000"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CancelCompaction(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestListIndexTasks() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().ListIndexTasks(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.ListIndexTasksRequest, opts ...grpc.CallOption) (*datapb.ListIndexTasksResponse, error) {
+			s.EqualValues(1000, req.GetCollectionID())
+			s.Equal("vec_index", req.GetIndexName())
+			return &datapb.ListIndexTasksResponse{
+				Status: merr.Success(),
+				Tasks: []*indexpb.SegmentIndexProgress{
+					{CollectionID: 1000, SegmentID: 1, BuildID: 10, State: commonpb.IndexState_InProgress, EstimatedRemainingSeconds: 5},
+				},
+				EstimatedRemainingSeconds: 5,
+			}, nil
+		})
+		req, err := http.NewRequest(http.MethodPost, mgrListIndexTasks, strings.NewReader("collection_id=1000&index_name=vec_index"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.ListIndexTasks(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"buildID":10`)
+		s.Contains(recorder.Body.String(), `"estimated_remaining_seconds":5`)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().ListIndexTasks(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err := http.NewRequest(http.MethodPost, mgrListIndexTasks, nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.ListIndexTasks(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestCancelIndexTasks() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().CancelIndexTasks(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.CancelIndexTasksRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal([]int64{10, 11}, req.GetBuildIDs())
+			return merr.Success(), nil
+		})
+		req, err := http.NewRequest(http.MethodPost, mgrCancelIndexTasks, strings.NewReader("build_ids=10,11"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CancelIndexTasks(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+	})
+
+	s.Run("missing_params", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodPost, mgrCancelIndexTasks, strings.NewReader("index_name=vec_index"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CancelIndexTasks(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().CancelIndexTasks(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrParameterInvalidMsg("no unfinished index task to cancel")), nil)
+		req, err := http.NewRequest(http.MethodPost, mgrCancelIndexTasks, strings.NewReader("collection_id=1000"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CancelIndexTasks(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestGetScalarIndexAdvice() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().GetScalarIndexAdvice(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.GetScalarIndexAdviceRequest, opts ...grpc.CallOption) (*datapb.GetScalarIndexAdviceResponse, error) {
+			s.EqualValues(1000, req.GetCollectionID())
+			return &datapb.GetScalarIndexAdviceResponse{
+				Status: merr.Success(),
+				Advices: []*datapb.ScalarIndexAdvice{
+					{FieldID: 101, FieldName: "age", Cardinality: 100, NumRows: 10000, IndexType: "INVERTED"},
+				},
+			}, nil
+		})
+		req, err := http.NewRequest(http.MethodPost, mgrGetIndexAdvice, strings.NewReader("collection_id=1000"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.GetScalarIndexAdvice(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Contains(recorder.Body.String(), `"field_name":"age"`)
+		s.Contains(recorder.Body.String(), `"index_type":"INVERTED"`)
+	})
+
+	s.Run("missing_params", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodPost, mgrGetIndexAdvice, nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.GetScalarIndexAdvice(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+	})
+
+	s.Run("return_failure", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().GetScalarIndexAdvice(mock.Anything, mock.Anything).Return(&datapb.GetScalarIndexAdviceResponse{
+			Status: merr.Status(merr.WrapErrCollectionNotFound(1000)),
+		}, nil)
+		req, err := http.NewRequest(http.MethodPost, mgrGetIndexAdvice, strings.NewReader("collection_id=1000"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.GetScalarIndexAdvice(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestIngestion() {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil)
+		globalMetaCache = metaCache
+
+		s.datacoord.EXPECT().PauseIngestion(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.PauseIngestionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			return merr.Success(), nil
+		})
+		req, err := http.NewRequest(http.MethodPost, mgrPauseIngestion, strings.NewReader("collection_name=test_collection"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.PauseIngestion(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+
+		s.datacoord.EXPECT().ResumeIngestion(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.ResumeIngestionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			return merr.Success(), nil
+		})
+		req, err = http.NewRequest(http.MethodPost, mgrResumeIngestion, strings.NewReader("collection_name=test_collection"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.ResumeIngestion(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil)
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "unknown").Return(0, merr.WrapErrCollectionNotFound("unknown"))
+		globalMetaCache = metaCache
+
+		req, err := http.NewRequest(http.MethodPost, mgrPauseIngestion, strings.NewReader("collection_name=unknown"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.PauseIngestion(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+
+		s.datacoord.EXPECT().PauseIngestion(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrServiceNotReady("datacoord", 1, "initializing")), nil)
+		req, err = http.NewRequest(http.MethodPost, mgrPauseIngestion, strings.NewReader("collection_name=test_collection"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.PauseIngestion(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+
+		s.datacoord.EXPECT().ResumeIngestion(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err = http.NewRequest(http.MethodPost, mgrResumeIngestion, strings.NewReader("collection_name=test_collection"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.ResumeIngestion(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestSetChannelParallelism() {
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().SetChannelParallelism(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.SetChannelParallelismRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("ch-1", req.GetChannel())
+			s.EqualValues(4, req.GetParallelism())
+			return merr.Success(), nil
+		})
+		req, err := http.NewRequest(http.MethodPost, mgrSetChannelParallelism, strings.NewReader("channel=ch-1&parallelism=4"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.SetChannelParallelism(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodPost, mgrSetChannelParallelism, strings.NewReader("channel=ch-1&parallelism=a"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.SetChannelParallelism(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		s.datacoord.EXPECT().SetChannelParallelism(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrChannelNotFound("ch-1")), nil).Once()
+		req, err = http.NewRequest(http.MethodPost, mgrSetChannelParallelism, strings.NewReader("channel=ch-1&parallelism=4"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.SetChannelParallelism(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+
+		s.datacoord.EXPECT().SetChannelParallelism(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error")).Once()
+		req, err = http.NewRequest(http.MethodPost, mgrSetChannelParallelism, strings.NewReader("channel=ch-1&parallelism=4"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.SetChannelParallelism(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestSnapshot() {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil)
+		globalMetaCache = metaCache
+
+		s.datacoord.EXPECT().CreateSnapshot(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.CreateSnapshotRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			s.Equal("snapshot", req.GetName())
+			return merr.Success(), nil
+		})
+		req, err := http.NewRequest(http.MethodPost, mgrCreateSnapshot, strings.NewReader("collection_name=test_collection&snapshot_name=snapshot"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CreateSnapshot(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+
+		s.datacoord.EXPECT().ListSnapshots(mock.Anything, mock.Anything).Return(&datapb.ListSnapshotsResponse{
+			Status:    merr.Success(),
+			Snapshots: []*datapb.SnapshotInfo{{Name: "snapshot", CollectionID: 1, NumSegments: 2, NumRows: 100}},
+		}, nil)
+		req, err = http.NewRequest(http.MethodGet, mgrListSnapshots+"?collection_name=test_collection", nil)
+		s.Require().NoError(err)
+		recorder = httptest.NewRecorder()
+		s.proxy.ListSnapshots(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"snapshots":[{"name":"snapshot","collectionID":1,"num_segments":2,"num_rows":100}]}`, recorder.Body.String())
+
+		s.datacoord.EXPECT().DropSnapshot(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		req, err = http.NewRequest(http.MethodPost, mgrDropSnapshot, strings.NewReader("collection_name=test_collection&snapshot_name=snapshot"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.DropSnapshot(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil)
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "unknown").Return(0, merr.WrapErrCollectionNotFound("unknown"))
+		globalMetaCache = metaCache
+
+		req, err := http.NewRequest(http.MethodPost, mgrCreateSnapshot, strings.NewReader("collection_name=unknown&snapshot_name=snapshot"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CreateSnapshot(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+
+		s.datacoord.EXPECT().CreateSnapshot(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrParameterInvalidMsg("snapshot already exists")), nil)
+		req, err = http.NewRequest(http.MethodPost, mgrCreateSnapshot, strings.NewReader("collection_name=test_collection&snapshot_name=snapshot"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.CreateSnapshot(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+
+		s.datacoord.EXPECT().DropSnapshot(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err = http.NewRequest(http.MethodPost, mgrDropSnapshot, strings.NewReader("collection_name=test_collection&snapshot_name=snapshot"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.DropSnapshot(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+
+		s.datacoord.EXPECT().ListSnapshots(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error"))
+		req, err = http.NewRequest(http.MethodGet, mgrListSnapshots, nil)
+		s.Require().NoError(err)
+		recorder = httptest.NewRecorder()
+		s.proxy.ListSnapshots(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestReplication() {
+	replica := &datapb.ReplicaInfo{
+		Snapshot: &datapb.SnapshotInfo{Name: "replica", CollectionID: 1, NumSegments: 1, NumRows: 100},
+	}
+
+	s.Run("list", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		s.datacoord.EXPECT().ListReplicas(mock.Anything, mock.Anything).Return(&datapb.ListReplicasResponse{
+			Status:   merr.Success(),
+			Replicas: []*datapb.ReplicaInfo{{Snapshot: replica.GetSnapshot()}},
+		}, nil).Once()
+		req, err := http.NewRequest(http.MethodGet, mgrListReplicas, nil)
+		s.Require().NoError(err)
+		recorder := httptest.NewRecorder()
+		s.proxy.ListReplicas(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"replicas":[{"snapshot":{"name":"replica","collectionID":1,"num_segments":1,"num_rows":100}}]}`, recorder.Body.String())
+
+		s.datacoord.EXPECT().ListReplicas(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error")).Once()
+		recorder = httptest.NewRecorder()
+		s.proxy.ListReplicas(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestBackup() {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	s.Run("create_list_drop", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, "default", "test_collection").Return(1, nil)
+		globalMetaCache = metaCache
+
+		s.datacoord.EXPECT().CreateBackup(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.CreateBackupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal("backup", req.GetName())
+			s.Equal([]int64{1}, req.GetCollectionIDs())
+			s.True(req.GetCopyFiles())
+			return merr.Success(), nil
+		}).Once()
+		req, err := http.NewRequest(http.MethodPost, mgrCreateBackup,
+			strings.NewReader("backup_name=backup&db_name=default&collection_names=test_collection&copy_files=true"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.CreateBackup(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+
+		// all the collections of the database
+		s.rootcoord.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(&milvuspb.ShowCollectionsResponse{
+			Status:        merr.Success(),
+			CollectionIds: []int64{1, 2},
+		}, nil)
+		s.datacoord.EXPECT().CreateBackup(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *datapb.CreateBackupRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.Equal([]int64{1, 2}, req.GetCollectionIDs())
+			return merr.Success(), nil
+		}).Once()
+		req, err = http.NewRequest(http.MethodPost, mgrCreateBackup, strings.NewReader("backup_name=backup2&db_name=default"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.CreateBackup(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+
+		req, err = http.NewRequest(http.MethodPost, mgrCreateBackup, strings.NewReader("backup_name=backup&copy_files=maybe"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.CreateBackup(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		s.datacoord.EXPECT().ListBackups(mock.Anything, mock.Anything).Return(&datapb.ListBackupsResponse{
+			Status:  merr.Success(),
+			Backups: []*datapb.BackupInfo{{Name: "backup"}},
+		}, nil)
+		req, err = http.NewRequest(http.MethodGet, mgrListBackups, nil)
+		s.Require().NoError(err)
+		recorder = httptest.NewRecorder()
+		s.proxy.ListBackups(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"backups":[{"name":"backup"}]}`, recorder.Body.String())
+
+		s.datacoord.EXPECT().DropBackup(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrParameterInvalidMsg("backup not found")), nil)
+		req, err = http.NewRequest(http.MethodPost, mgrDropBackup, strings.NewReader("backup_name=unknown"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.DropBackup(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
 func (s *ProxyManagementSuite) TestListQueryNode() {
 	s.Run("normal", func() {
 		s.SetupTest()
@@ -686,6 +1341,146 @@ func (s *ProxyManagementSuite) TestCheckQueryNodeDistribution() {
 	})
 }
 
+func (s *ProxyManagementSuite) TestGetLoadProgressDetail() {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil)
+		metaCache.EXPECT().GetPartitionID(mock.Anything, mock.Anything, "test_collection", "p1").Return(10, nil)
+		globalMetaCache = metaCache
+
+		s.querycoord.EXPECT().GetLoadProgressDetail(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *querypb.GetLoadProgressDetailRequest, opts ...grpc.CallOption) (*querypb.GetLoadProgressDetailResponse, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			s.Equal([]int64{10}, req.GetPartitionIDs())
+			return &querypb.GetLoadProgressDetailResponse{
+				Status:       merr.Success(),
+				CollectionID: 1,
+				Progress:     50,
+				Stages: []*querypb.LoadStageProgress{
+					{Stage: querypb.LoadStage_TargetBuilt, Finished: 1, Total: 1, Progress: 100},
+				},
+			}, nil
+		})
+
+		req, err := http.NewRequest(http.MethodPost, mgrGetLoadProgressDetail, strings.NewReader("collection_name=test_collection&partition_names=p1"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		recorder := httptest.NewRecorder()
+		s.proxy.GetLoadProgressDetail(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"collectionID":1,"progress":50,"stages":[{"finished":1,"total":1,"progress":100}]}`, recorder.Body.String())
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		// test invalid collection name
+		req, err := http.NewRequest(http.MethodPost, mgrGetLoadProgressDetail, strings.NewReader(""))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.GetLoadProgressDetail(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil)
+		globalMetaCache = metaCache
+
+		// test rpc return error
+		s.querycoord.EXPECT().GetLoadProgressDetail(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error")).Once()
+		req, err = http.NewRequest(http.MethodPost, mgrGetLoadProgressDetail, strings.NewReader("collection_name=test_collection"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.GetLoadProgressDetail(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+
+		// test rpc return failure
+		s.querycoord.EXPECT().GetLoadProgressDetail(mock.Anything, mock.Anything).Return(&querypb.GetLoadProgressDetailResponse{
+			Status: merr.Status(merr.WrapErrCollectionNotLoaded(1)),
+		}, nil).Once()
+		req, err = http.NewRequest(http.MethodPost, mgrGetLoadProgressDetail, strings.NewReader("collection_name=test_collection"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.GetLoadProgressDetail(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func (s *ProxyManagementSuite) TestFreezeCollection() {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+
+	s.Run("normal", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil)
+		globalMetaCache = metaCache
+
+		s.querycoord.EXPECT().FreezeCollection(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *querypb.FreezeCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+			s.EqualValues(1, req.GetCollectionID())
+			return merr.Success(), nil
+		})
+		req, err := http.NewRequest(http.MethodPost, mgrFreezeCollection, strings.NewReader("collection_name=test_collection"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.FreezeCollection(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+		s.Equal(`{"msg": "OK"}`, recorder.Body.String())
+
+		s.querycoord.EXPECT().UnfreezeCollection(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		req, err = http.NewRequest(http.MethodPost, mgrUnfreezeCollection, strings.NewReader("collection_name=test_collection"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.UnfreezeCollection(recorder, req)
+		s.Equal(http.StatusOK, recorder.Code)
+	})
+
+	s.Run("return_error", func() {
+		s.SetupTest()
+		defer s.TearDownTest()
+
+		req, err := http.NewRequest(http.MethodPost, mgrFreezeCollection, strings.NewReader(""))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder := httptest.NewRecorder()
+		s.proxy.FreezeCollection(recorder, req)
+		s.Equal(http.StatusBadRequest, recorder.Code)
+
+		metaCache := NewMockCache(s.T())
+		metaCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, "test_collection").Return(1, nil)
+		globalMetaCache = metaCache
+
+		s.querycoord.EXPECT().FreezeCollection(mock.Anything, mock.Anything).Return(nil, errors.New("mocked error")).Once()
+		req, err = http.NewRequest(http.MethodPost, mgrFreezeCollection, strings.NewReader("collection_name=test_collection"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.FreezeCollection(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+
+		s.querycoord.EXPECT().UnfreezeCollection(mock.Anything, mock.Anything).Return(merr.Status(merr.WrapErrCollectionNotLoaded(1)), nil).Once()
+		req, err = http.NewRequest(http.MethodPost, mgrUnfreezeCollection, strings.NewReader("collection_name=test_collection"))
+		s.Require().NoError(err)
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		recorder = httptest.NewRecorder()
+		s.proxy.UnfreezeCollection(recorder, req)
+		s.Equal(http.StatusInternalServerError, recorder.Code)
+	})
+}
+
 func TestProxyManagement(t *testing.T) {
 	suite.Run(t, new(ProxyManagementSuite))
 }
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -33,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/types"
@@ -42,6 +44,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/conc"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -73,6 +76,8 @@ type Cache interface {
 	GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemaInfo, error)
 	GetShards(ctx context.Context, withCache bool, database, collectionName string, collectionID int64) (map[string][]nodeInfo, error)
 	DeprecateShardCache(database, collectionName string)
+	// DeprecateShardCacheByID clear the shard leader cache of a collection by id
+	DeprecateShardCacheByID(collectionID UniqueID)
 	RemoveCollection(ctx context.Context, database, collectionName string)
 	RemoveCollectionsByID(ctx context.Context, collectionID UniqueID) []string
 	RemovePartition(ctx context.Context, database, collectionName string, partitionName string)
@@ -81,6 +86,10 @@ type Cache interface {
 	GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error)
 	RemoveCredential(username string)
 	UpdateCredential(credInfo *internalpb.CredentialInfo)
+	// VerifyAPIKey verifies the api key by the cached verified keys, or by rootcoord for the uncached ones
+	VerifyAPIKey(ctx context.Context, key string) (*proxypb.APIKeyInfo, error)
+	// GetAPIKeyInfo get the info of the verified api key by name
+	GetAPIKeyInfo(name string) (*proxypb.APIKeyInfo, bool)
 
 	GetPrivilegeInfo(ctx context.Context) []string
 	GetUserRole(username string) []string
@@ -94,10 +103,23 @@ type Cache interface {
 }
 
 type collectionBasicInfo struct {
-	collID              typeutil.UniqueID
-	createdTimestamp    uint64
-	createdUtcTimestamp uint64
-	consistencyLevel    commonpb.ConsistencyLevel
+	collID                typeutil.UniqueID
+	createdTimestamp      uint64
+	createdUtcTimestamp   uint64
+	consistencyLevel      commonpb.ConsistencyLevel
+	partitionKeyBucketNum int64
+	// replicaNumber and resourceGroups are used when loading the collection without specifying them
+	replicaNumber  int32
+	resourceGroups []string
+	// rankStrategy and rankParams are used by the hybrid search without specifying them
+	rankStrategy string
+	rankParams   string
+	// searchProfiles are the named search params referred by the search requests
+	searchProfiles map[string]*searchProfile
+	// fieldMasks are the fields never output to the roles
+	fieldMasks map[string][]string
+	// rowFilters are the filters AND-ed into the requests of the roles
+	rowFilters map[string]string
 }
 
 type collectionInfo struct {
@@ -107,6 +129,15 @@ type collectionInfo struct {
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
 	consistencyLevel    commonpb.ConsistencyLevel
+	// partitionKeyBucketNum is the number of buckets of the partition key values in each partition
+	partitionKeyBucketNum int64
+	replicaNumber         int32
+	resourceGroups        []string
+	rankStrategy          string
+	rankParams            string
+	searchProfiles        map[string]*searchProfile
+	fieldMasks            map[string][]string
+	rowFilters            map[string]string
 }
 
 // schemaInfo is a helper function wraps *schemapb.CollectionSchema
@@ -117,6 +148,7 @@ type schemaInfo struct {
 	hasPartitionKeyField bool
 	pkField              *schemapb.FieldSchema
 	schemaHelper         *typeutil.SchemaHelper
+	preparedExprs        *preparedExprCache
 }
 
 func newSchemaInfo(schema *schemapb.CollectionSchema) *schemaInfo {
@@ -140,6 +172,7 @@ func newSchemaInfo(schema *schemapb.CollectionSchema) *schemaInfo {
 		hasPartitionKeyField: hasPartitionkey,
 		pkField:              pkField,
 		schemaHelper:         schemaHelper,
+		preparedExprs:        newPreparedExprCache(),
 	}
 }
 
@@ -182,6 +215,15 @@ func (info *collectionInfo) getBasicInfo() *collectionBasicInfo {
 		createdTimestamp:    info.createdTimestamp,
 		createdUtcTimestamp: info.createdUtcTimestamp,
 		consistencyLevel:    info.consistencyLevel,
+
+		partitionKeyBucketNum: info.partitionKeyBucketNum,
+		replicaNumber:         info.replicaNumber,
+		resourceGroups:        append([]string(nil), info.resourceGroups...),
+		rankStrategy:          info.rankStrategy,
+		rankParams:            info.rankParams,
+		searchProfiles:        info.searchProfiles,
+		fieldMasks:            info.fieldMasks,
+		rowFilters:            info.rowFilters,
 	}
 
 	return basicInfo
@@ -193,8 +235,9 @@ func (info *collectionInfo) isCollectionCached() bool {
 
 // shardLeaders wraps shard leader mapping for iteration.
 type shardLeaders struct {
-	idx        *atomic.Int64
-	deprecated *atomic.Bool
+	idx          *atomic.Int64
+	deprecated   *atomic.Bool
+	collectionID int64
 
 	shardLeaders map[string][]nodeInfo
 }
@@ -250,6 +293,10 @@ type MetaCache struct {
 	credMap        map[string]*internalpb.CredentialInfo   // cache for credential, lazy load
 	privilegeInfos map[string]struct{}                     // privileges cache
 	userToRoles    map[string]map[string]struct{}          // user to role cache
+	apiKeyNames    map[string]string                       // hash of the verified api key -> api key name
+	apiKeyInfos    map[string]*proxypb.APIKeyInfo          // api key name -> api key info
+	apiKeyExpiry   map[string]time.Time                    // api key name -> the time the cached key info is stale
+	apiKeyVersion  int64                                   // bumped once any api key is invalidated, guarded by credMut
 	mu             sync.RWMutex
 	credMut        sync.RWMutex
 	leaderMut      sync.RWMutex
@@ -265,6 +312,9 @@ type MetaCache struct {
 // globalMetaCache is singleton instance of Cache
 var globalMetaCache Cache
 
+// apiKeyCacheTTL bounds how long the verified api key is cached in proxy.
+var apiKeyCacheTTL = time.Minute
+
 // InitMetaCache initializes globalMetaCache
 func InitMetaCache(ctx context.Context, rootCoord types.RootCoordClient, queryCoord types.QueryCoordClient, shardMgr shardClientMgr) error {
 	var err error
@@ -296,6 +346,9 @@ func NewMetaCache(rootCoord types.RootCoordClient, queryCoord types.QueryCoordCl
 		shardMgr:       shardMgr,
 		privilegeInfos: map[string]struct{}{},
 		userToRoles:    map[string]map[string]struct{}{},
+		apiKeyNames:    map[string]string{},
+		apiKeyInfos:    map[string]*proxypb.APIKeyInfo{},
+		apiKeyExpiry:   map[string]time.Time{},
 	}, nil
 }
 
@@ -375,6 +428,13 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 	}
 
 	schemaInfo := newSchemaInfo(collection.Schema)
+	bucketNum, ok := common.GetCollectionPartitionKeyBucketNum(collection.GetProperties()...)
+	if !ok || !schemaInfo.hasPartitionKeyField {
+		bucketNum = 1
+	}
+	replicaNumber, _ := common.GetCollectionReplicaNumber(collection.GetProperties()...)
+	resourceGroups, _ := common.GetCollectionResourceGroups(collection.GetProperties()...)
+	rankStrategy, rankParams, _ := common.GetCollectionRankStrategy(collection.GetProperties()...)
 	m.collInfo[database][collectionName] = &collectionInfo{
 		collID:              collection.CollectionID,
 		schema:              schemaInfo,
@@ -382,6 +442,15 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 		createdTimestamp:    collection.CreatedTimestamp,
 		createdUtcTimestamp: collection.CreatedUtcTimestamp,
 		consistencyLevel:    collection.ConsistencyLevel,
+
+		partitionKeyBucketNum: bucketNum,
+		replicaNumber:         replicaNumber,
+		resourceGroups:        resourceGroups,
+		rankStrategy:          rankStrategy,
+		rankParams:            rankParams,
+		searchProfiles:        parseSearchProfiles(collection.GetProperties()),
+		fieldMasks:            common.GetCollectionFieldMasks(collection.GetProperties()...),
+		rowFilters:            common.GetCollectionRowFilters(collection.GetProperties()...),
 	}
 
 	log.Info("meta update success", zap.String("database", database), zap.String("collectionName", collectionName), zap.Int64("collectionID", collection.CollectionID))
@@ -703,6 +772,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, database, collection
 		CreatedUtcTimestamp:  coll.CreatedUtcTimestamp,
 		ConsistencyLevel:     coll.ConsistencyLevel,
 		DbName:               coll.GetDbName(),
+		Properties:           coll.GetProperties(),
 	}
 	for _, field := range coll.Schema.Fields {
 		if field.FieldID >= common.StartOfUserFieldID {
@@ -865,6 +935,18 @@ func (m *MetaCache) RemoveCredential(username string) {
 	defer m.credMut.Unlock()
 	// delete pair in credMap
 	delete(m.credMap, username)
+
+	// the api key is invalidated as the user it authenticates as once it's rotated or revoked
+	if name, ok := getAPIKeyName(username); ok {
+		delete(m.apiKeyInfos, name)
+		delete(m.apiKeyExpiry, name)
+		for keyHash, keyName := range m.apiKeyNames {
+			if keyName == name {
+				delete(m.apiKeyNames, keyHash)
+			}
+		}
+		m.apiKeyVersion++
+	}
 }
 
 func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
@@ -881,6 +963,56 @@ func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
 	m.credMap[username].Sha256Password = credInfo.Sha256Password
 }
 
+func (m *MetaCache) VerifyAPIKey(ctx context.Context, key string) (*proxypb.APIKeyInfo, error) {
+	// only the hash of the key is kept in memory
+	keyHash := crypto.SHA256(key, "")
+	m.credMut.RLock()
+	info, ok := m.getAPIKeyInfo(m.apiKeyNames[keyHash])
+	version := m.apiKeyVersion
+	m.credMut.RUnlock()
+
+	if !ok {
+		resp, err := m.rootCoord.VerifyAPIKey(ctx, &rootcoordpb.VerifyAPIKeyRequest{
+			Base: commonpbutil.NewMsgBase(),
+			Key:  key,
+		})
+		if err := merr.CheckRPCCall(resp, err); err != nil {
+			return nil, err
+		}
+		info = resp.GetInfo()
+
+		m.credMut.Lock()
+		// skip caching the key if it may be invalidated during the verification
+		if version == m.apiKeyVersion {
+			m.apiKeyNames[keyHash] = info.GetName()
+			m.apiKeyInfos[info.GetName()] = info
+			m.apiKeyExpiry[info.GetName()] = time.Now().Add(apiKeyCacheTTL)
+		}
+		m.credMut.Unlock()
+	}
+
+	if info.GetExpireAt() != 0 && time.Now().Unix() >= info.GetExpireAt() {
+		return nil, merr.WrapErrPrivilegeNotAuthenticated("the api key %s is expired", info.GetName())
+	}
+	return info, nil
+}
+
+func (m *MetaCache) GetAPIKeyInfo(name string) (*proxypb.APIKeyInfo, bool) {
+	m.credMut.RLock()
+	defer m.credMut.RUnlock()
+	return m.getAPIKeyInfo(name)
+}
+
+// getAPIKeyInfo returns the cached api key info, the stale one is verified by rootcoord again,
+// so that the rotated or revoked key is rejected even if the proxy misses the invalidation. credMut must be held.
+func (m *MetaCache) getAPIKeyInfo(name string) (*proxypb.APIKeyInfo, bool) {
+	info, ok := m.apiKeyInfos[name]
+	if !ok || !time.Now().Before(m.apiKeyExpiry[name]) {
+		return nil, false
+	}
+	return info, true
+}
+
 // GetShards update cache if withCache == false
 func (m *MetaCache) GetShards(ctx context.Context, withCache bool, database, collectionName string, collectionID int64) (map[string][]nodeInfo, error) {
 	method := "GetShards"
@@ -923,6 +1055,7 @@ func (m *MetaCache) GetShards(ctx context.Context, withCache bool, database, col
 
 	shards := parseShardLeaderList2QueryNode(resp.GetShards())
 	newShardLeaders := &shardLeaders{
+		collectionID: info.collID,
 		shardLeaders: shards,
 		deprecated:   atomic.NewBool(false),
 		idx:          atomic.NewInt64(0),
@@ -976,6 +1109,20 @@ func (m *MetaCache) DeprecateShardCache(database, collectionName string) {
 	}
 }
 
+// DeprecateShardCacheByID clear the shard leader cache of a collection by id
+func (m *MetaCache) DeprecateShardCacheByID(collectionID UniqueID) {
+	m.leaderMut.RLock()
+	defer m.leaderMut.RUnlock()
+	for _, db := range m.collLeader {
+		for collectionName, shards := range db {
+			if shards.collectionID == collectionID {
+				log.Info("clearing shard cache for collection", zap.String("collectionName", collectionName), zap.Int64("collectionID", collectionID))
+				shards.deprecated.Store(true)
+			}
+		}
+	}
+}
+
 func (m *MetaCache) InitPolicyInfo(info []string, userRoles []string) {
 	defer func() {
 		err := getEnforcer().LoadPolicy()
@@ -1011,6 +1158,12 @@ func (m *MetaCache) GetPrivilegeInfo(ctx context.Context) []string {
 }
 
 func (m *MetaCache) GetUserRole(user string) []string {
+	// the user authenticated by the api key has the roles the key is scoped to
+	if name, ok := getAPIKeyName(user); ok {
+		info, _ := m.GetAPIKeyInfo(name)
+		return append([]string{}, info.GetRoles()...)
+	}
+
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
@@ -600,6 +600,48 @@ func TestMetaCache_ClearShards(t *testing.T) {
 	})
 }
 
+func TestMetaCache_ClearShardsByID(t *testing.T) {
+	var (
+		ctx            = context.TODO()
+		collectionName = "collection1"
+		collectionID   = int64(1)
+	)
+
+	rootCoord := &MockRootCoordClientInterface{}
+	qc := getQueryCoordClient()
+	mgr := newShardClientMgr()
+	err := InitMetaCache(ctx, rootCoord, qc, mgr)
+	require.Nil(t, err)
+
+	qc.EXPECT().GetShardLeaders(mock.Anything, mock.Anything).Return(&querypb.GetShardLeadersResponse{
+		Status: merr.Success(),
+		Shards: []*querypb.ShardLeadersList{
+			{
+				ChannelName: "channel-1",
+				NodeIds:     []int64{1, 2, 3},
+				NodeAddrs:   []string{"localhost:9000", "localhost:9001", "localhost:9002"},
+			},
+		},
+	}, nil).Times(2)
+	qc.EXPECT().ShowCollections(mock.Anything, mock.Anything).Return(&querypb.ShowCollectionsResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+	}, nil)
+	_, err = globalMetaCache.GetShards(ctx, true, dbName, collectionName, collectionID)
+	require.NoError(t, err)
+
+	// the other collection is not affected
+	globalMetaCache.DeprecateShardCacheByID(collectionID + 1)
+	_, err = globalMetaCache.GetShards(ctx, true, dbName, collectionName, collectionID)
+	require.NoError(t, err)
+	qc.AssertNumberOfCalls(t, "GetShardLeaders", 1)
+
+	// the shard leaders are fetched again once deprecated
+	globalMetaCache.DeprecateShardCacheByID(collectionID)
+	_, err = globalMetaCache.GetShards(ctx, true, dbName, collectionName, collectionID)
+	require.NoError(t, err)
+	qc.AssertNumberOfCalls(t, "GetShardLeaders", 2)
+}
+
 func TestMetaCache_PolicyInfo(t *testing.T) {
 	client := &MockRootCoordClientInterface{}
 	qc := &mocks.MockQueryCoordClient{}
@@ -8,6 +8,8 @@ import (
 	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
 	mock "github.com/stretchr/testify/mock"
 
+	proxypb "github.com/milvus-io/milvus/internal/proto/proxypb"
+
 	typeutil "github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -110,6 +112,93 @@ func (_c *MockCache_DeprecateShardCache_Call) RunAndReturn(run func(string, stri
 	return _c
 }
 
+// DeprecateShardCacheByID provides a mock function with given fields: collectionID
+func (_m *MockCache) DeprecateShardCacheByID(collectionID int64) {
+	_m.Called(collectionID)
+}
+
+// MockCache_DeprecateShardCacheByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeprecateShardCacheByID'
+type MockCache_DeprecateShardCacheByID_Call struct {
+	*mock.Call
+}
+
+// DeprecateShardCacheByID is a helper method to define mock.On call
+//   - collectionID int64
+func (_e *MockCache_Expecter) DeprecateShardCacheByID(collectionID interface{}) *MockCache_DeprecateShardCacheByID_Call {
+	return &MockCache_DeprecateShardCacheByID_Call{Call: _e.mock.On("DeprecateShardCacheByID", collectionID)}
+}
+
+func (_c *MockCache_DeprecateShardCacheByID_Call) Run(run func(collectionID int64)) *MockCache_DeprecateShardCacheByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCache_DeprecateShardCacheByID_Call) Return() *MockCache_DeprecateShardCacheByID_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCache_DeprecateShardCacheByID_Call) RunAndReturn(run func(int64)) *MockCache_DeprecateShardCacheByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAPIKeyInfo provides a mock function with given fields: name
+func (_m *MockCache) GetAPIKeyInfo(name string) (*proxypb.APIKeyInfo, bool) {
+	ret := _m.Called(name)
+
+	var r0 *proxypb.APIKeyInfo
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (*proxypb.APIKeyInfo, bool)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *proxypb.APIKeyInfo); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.APIKeyInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockCache_GetAPIKeyInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKeyInfo'
+type MockCache_GetAPIKeyInfo_Call struct {
+	*mock.Call
+}
+
+// GetAPIKeyInfo is a helper method to define mock.On call
+//   - name string
+func (_e *MockCache_Expecter) GetAPIKeyInfo(name interface{}) *MockCache_GetAPIKeyInfo_Call {
+	return &MockCache_GetAPIKeyInfo_Call{Call: _e.mock.On("GetAPIKeyInfo", name)}
+}
+
+func (_c *MockCache_GetAPIKeyInfo_Call) Run(run func(name string)) *MockCache_GetAPIKeyInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_GetAPIKeyInfo_Call) Return(_a0 *proxypb.APIKeyInfo, _a1 bool) *MockCache_GetAPIKeyInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCache_GetAPIKeyInfo_Call) RunAndReturn(run func(string) (*proxypb.APIKeyInfo, bool)) *MockCache_GetAPIKeyInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionID provides a mock function with given fields: ctx, database, collectionName
 func (_m *MockCache) GetCollectionID(ctx context.Context, database string, collectionName string) (int64, error) {
 	ret := _m.Called(ctx, database, collectionName)
@@ -1155,6 +1244,61 @@ func (_c *MockCache_UpdateCredential_Call) RunAndReturn(run func(*internalpb.Cre
 	return _c
 }
 
+// VerifyAPIKey provides a mock function with given fields: ctx, key
+func (_m *MockCache) VerifyAPIKey(ctx context.Context, key string) (*proxypb.APIKeyInfo, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 *proxypb.APIKeyInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*proxypb.APIKeyInfo, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *proxypb.APIKeyInfo); ok {
+		r0 = rf(ctx, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*proxypb.APIKeyInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCache_VerifyAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyAPIKey'
+type MockCache_VerifyAPIKey_Call struct {
+	*mock.Call
+}
+
+// VerifyAPIKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *MockCache_Expecter) VerifyAPIKey(ctx interface{}, key interface{}) *MockCache_VerifyAPIKey_Call {
+	return &MockCache_VerifyAPIKey_Call{Call: _e.mock.On("VerifyAPIKey", ctx, key)}
+}
+
+func (_c *MockCache_VerifyAPIKey_Call) Run(run func(ctx context.Context, key string)) *MockCache_VerifyAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_VerifyAPIKey_Call) Return(_a0 *proxypb.APIKeyInfo, _a1 error) *MockCache_VerifyAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCache_VerifyAPIKey_Call) RunAndReturn(run func(context.Context, string) (*proxypb.APIKeyInfo, error)) *MockCache_VerifyAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockCache creates a new instance of MockCache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockCache(t interface {
@@ -0,0 +1,243 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	ModelEndpointKey = "endpoint"
+	ModelQueryKey    = "query"
+	ModelFieldKey    = "field"
+	ModelTimeoutKey  = "timeout"
+
+	defaultModelRankTimeout = 3 * time.Second
+)
+
+type modelRankRequest struct {
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+}
+
+type modelRankResponse struct {
+	Results []struct {
+		Index int     `json:"index"`
+		Score float32 `json:"score"`
+	} `json:"results"`
+}
+
+// modelReRanker re-orders the fused results by the relevance scores from an external model endpoint,
+// the documents sent to the model are the values of a varchar output field.
+type modelReRanker struct {
+	endpoint string
+	query    string
+	field    string
+	timeout  time.Duration
+	client   *http.Client
+}
+
+// newModelReRanker fuses the ann search results by rrf, then re-ranks the fused results by the model.
+func newModelReRanker(reqs []*milvuspb.SearchRequest, params map[string]interface{}) ([]reScorer, reRanker, error) {
+	getString := func(key string) (string, error) {
+		value, ok := params[key].(string)
+		if !ok || value == "" {
+			return "", merr.WrapErrParameterInvalidMsg("%s of the model ranker should be a non-empty string", key)
+		}
+		return value, nil
+	}
+	endpoint, err := getString(ModelEndpointKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, nil, merr.WrapErrParameterInvalidMsg("invalid model ranker endpoint %s", endpoint)
+	}
+	// the proxy requests the endpoint on behalf of the user, which is restricted to the ones configured by the admin,
+	// otherwise the user could reach any address from the proxy with the documents of the results
+	if !isModelRankerEndpointAllowed(endpoint) {
+		return nil, nil, merr.WrapErrParameterInvalidMsg("model ranker endpoint %s is not allowed, see %s",
+			endpoint, paramtable.Get().ProxyCfg.ModelRankerEndpoints.Key)
+	}
+	query, err := getString(ModelQueryKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	field, err := getString(ModelFieldKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	timeout := defaultModelRankTimeout
+	if value, ok := params[ModelTimeoutKey]; ok {
+		ms, ok := value.(float64)
+		if !ok || ms <= 0 {
+			return nil, nil, merr.WrapErrParameterInvalidMsg("%s of the model ranker should be a positive number in milliseconds", ModelTimeoutKey)
+		}
+		timeout = time.Duration(ms * float64(time.Millisecond))
+	}
+
+	scorers := make([]reScorer, len(reqs))
+	if _, ok := params[RRFParamsKey]; ok {
+		scorers, _, err = newRRFScorers(reqs, params)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		for i := range reqs {
+			scorers[i] = newRRFScorer(float32(defaultRRFParamsValue))
+		}
+	}
+	return scorers, &modelReRanker{
+		endpoint: endpoint,
+		query:    query,
+		field:    field,
+		timeout:  timeout,
+		client:   &http.Client{},
+	}, nil
+}
+
+func isModelRankerEndpointAllowed(endpoint string) bool {
+	for _, allowed := range paramtable.Get().ProxyCfg.ModelRankerEndpoints.GetAsStrings() {
+		if allowed = strings.TrimSpace(allowed); allowed != "" && allowed == endpoint {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *modelReRanker) name() string {
+	return "model"
+}
+
+func (r *modelReRanker) requiredFields() []string {
+	return []string{r.field}
+}
+
+func (r *modelReRanker) getDocuments(result *schemapb.SearchResultData) ([]string, error) {
+	for _, fieldData := range result.GetFieldsData() {
+		if fieldData.GetFieldName() != r.field {
+			continue
+		}
+		if fieldData.GetType() != schemapb.DataType_VarChar && fieldData.GetType() != schemapb.DataType_String {
+			return nil, merr.WrapErrParameterInvalidMsg("the field %s used by the model ranker should be varchar, but got %s", r.field, fieldData.GetType())
+		}
+		return fieldData.GetScalars().GetStringData().GetData(), nil
+	}
+	return nil, merr.WrapErrFieldNotFound(r.field, "the field used by the model ranker not found in the results")
+}
+
+func (r *modelReRanker) score(ctx context.Context, documents []string) ([]float32, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+	body, err := json.Marshal(&modelRankRequest{Query: r.query, Documents: documents})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, merr.WrapErrServiceUnavailable(err.Error(), "failed to request the model ranker")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, merr.WrapErrServiceUnavailable(fmt.Sprintf("status %d: %s", resp.StatusCode, msg), "the model ranker failed")
+	}
+	rankResp := &modelRankResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(rankResp); err != nil {
+		return nil, merr.WrapErrServiceInternal(fmt.Sprintf("invalid response of the model ranker: %s", err.Error()))
+	}
+
+	// every document must be scored exactly once
+	scores := make([]float32, len(documents))
+	scored := make([]bool, len(documents))
+	for _, item := range rankResp.Results {
+		if item.Index < 0 || item.Index >= len(documents) || scored[item.Index] {
+			return nil, merr.WrapErrServiceInternal(fmt.Sprintf("invalid document index %d returned by the model ranker", item.Index))
+		}
+		scores[item.Index] = item.Score
+		scored[item.Index] = true
+	}
+	if len(rankResp.Results) != len(documents) {
+		return nil, merr.WrapErrServiceInternal(fmt.Sprintf("the model ranker scored %d of %d documents", len(rankResp.Results), len(documents)))
+	}
+	return scores, nil
+}
+
+// reRank re-orders the results by the model scores in descending order,
+// only one query is supported as the hybrid search.
+func (r *modelReRanker) reRank(ctx context.Context, result *milvuspb.SearchResults) error {
+	data := result.GetResults()
+	size := len(data.GetScores())
+	if size == 0 {
+		return nil
+	}
+	documents, err := r.getDocuments(data)
+	if err != nil {
+		return err
+	}
+	if len(documents) != size || typeutil.GetSizeOfIDs(data.GetIds()) != size {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("the number of documents %d mismatches with the results %d", len(documents), size))
+	}
+	scores, err := r.score(ctx, documents)
+	if err != nil {
+		log.Ctx(ctx).Warn("model re-rank failed", zap.String("endpoint", r.endpoint), zap.Error(err))
+		return err
+	}
+
+	order := make([]int, size)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return scores[order[i]] > scores[order[j]]
+	})
+	ids := &schemapb.IDs{}
+	newScores := make([]float32, 0, size)
+	fieldsData := typeutil.PrepareResultFieldData(data.GetFieldsData(), int64(size))
+	for _, idx := range order {
+		typeutil.AppendPKs(ids, typeutil.GetPK(data.GetIds(), int64(idx)))
+		newScores = append(newScores, scores[idx])
+		typeutil.AppendFieldData(fieldsData, data.GetFieldsData(), int64(idx))
+	}
+	data.Ids = ids
+	data.Scores = newScores
+	data.FieldsData = fieldsData
+	return nil
+}
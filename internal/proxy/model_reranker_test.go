@@ -0,0 +1,148 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type ModelReRankerSuite struct {
+	suite.Suite
+
+	server  *httptest.Server
+	handler http.HandlerFunc
+}
+
+func (s *ModelReRankerSuite) SetupTest() {
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.handler(w, r)
+	}))
+}
+
+func (s *ModelReRankerSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *ModelReRankerSuite) newReRanker() *modelReRanker {
+	return &modelReRanker{
+		endpoint: s.server.URL,
+		query:    "what is milvus",
+		field:    "doc",
+		timeout:  time.Second,
+		client:   &http.Client{},
+	}
+}
+
+func (s *ModelReRankerSuite) newResult() *milvuspb.SearchResults {
+	return &milvuspb.SearchResults{
+		Status: merr.Success(),
+		Results: &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       3,
+			Topks:      []int64{3},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
+			Scores:     []float32{0.3, 0.2, 0.1},
+			FieldsData: []*schemapb.FieldData{{
+				Type:      schemapb.DataType_VarChar,
+				FieldName: "doc",
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b", "c"}}},
+				}},
+			}},
+		},
+	}
+}
+
+func (s *ModelReRankerSuite) TestReRank() {
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		req := &modelRankRequest{}
+		s.NoError(json.NewDecoder(r.Body).Decode(req))
+		s.Equal("what is milvus", req.Query)
+		s.Equal([]string{"a", "b", "c"}, req.Documents)
+		w.Write([]byte(`{"results": [{"index": 2, "score": 0.9}, {"index": 0, "score": 0.5}, {"index": 1, "score": 0.7}]}`))
+	}
+	result := s.newResult()
+	err := s.newReRanker().reRank(context.Background(), result)
+	s.NoError(err)
+	s.Equal([]int64{3, 2, 1}, result.GetResults().GetIds().GetIntId().GetData())
+	s.Equal([]float32{0.9, 0.7, 0.5}, result.GetResults().GetScores())
+	s.Equal([]string{"c", "b", "a"}, result.GetResults().GetFieldsData()[0].GetScalars().GetStringData().GetData())
+}
+
+func (s *ModelReRankerSuite) TestEmptyResult() {
+	s.handler = func(w http.ResponseWriter, r *http.Request) {
+		s.Fail("the model should not be requested")
+	}
+	result := &milvuspb.SearchResults{Results: &schemapb.SearchResultData{}}
+	s.NoError(s.newReRanker().reRank(context.Background(), result))
+}
+
+func (s *ModelReRankerSuite) TestFailure() {
+	s.Run("field not found", func() {
+		result := s.newResult()
+		result.Results.FieldsData[0].FieldName = "other"
+		err := s.newReRanker().reRank(context.Background(), result)
+		s.ErrorIs(err, merr.ErrFieldNotFound)
+	})
+
+	s.Run("model error", func() {
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		err := s.newReRanker().reRank(context.Background(), s.newResult())
+		s.ErrorIs(err, merr.ErrServiceUnavailable)
+	})
+
+	s.Run("missing scores", func() {
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"results": [{"index": 2, "score": 0.9}]}`))
+		}
+		result := s.newResult()
+		err := s.newReRanker().reRank(context.Background(), result)
+		s.ErrorIs(err, merr.ErrServiceInternal)
+		// the results are untouched
+		s.Equal([]int64{1, 2, 3}, result.GetResults().GetIds().GetIntId().GetData())
+	})
+
+	s.Run("timeout", func() {
+		s.handler = func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(200 * time.Millisecond):
+			}
+		}
+		reranker := s.newReRanker()
+		reranker.timeout = 10 * time.Millisecond
+		err := reranker.reRank(context.Background(), s.newResult())
+		s.Error(err)
+	})
+}
+
+func TestModelReRanker(t *testing.T) {
+	suite.Run(t, new(ModelReRankerSuite))
+}
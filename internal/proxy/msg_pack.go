@@ -108,6 +108,7 @@ func repackInsertDataByPartition(ctx context.Context,
 	partitionName string,
 	rowOffsets []int,
 	channelName string,
+	bucketID int64,
 	insertMsg *msgstream.InsertMsg,
 	segIDAssigner *segIDAssigner,
 ) ([]msgstream.TsMsg, error) {
@@ -126,7 +127,7 @@ func repackInsertDataByPartition(ctx context.Context,
 		return nil, err
 	}
 	beforeAssign := time.Now()
-	assignedSegmentInfos, err := segIDAssigner.GetSegmentID(insertMsg.CollectionID, partitionID, channelName, uint32(len(rowOffsets)), maxTs)
+	assignedSegmentInfos, err := segIDAssigner.GetSegmentIDInBucket(insertMsg.CollectionID, partitionID, channelName, bucketID, uint32(len(rowOffsets)), maxTs)
 	metrics.ProxyAssignSegmentIDLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Observe(float64(time.Since(beforeAssign).Milliseconds()))
 	if err != nil {
 		log.Error("allocate segmentID for insert data failed",
@@ -193,7 +194,7 @@ func repackInsertData(ctx context.Context,
 	channel2RowOffsets := assignChannelsByPK(result.IDs, channelNames, insertMsg)
 	for channel, rowOffsets := range channel2RowOffsets {
 		partitionName := insertMsg.PartitionName
-		msgs, err := repackInsertDataByPartition(ctx, partitionName, rowOffsets, channel, insertMsg, segIDAssigner)
+		msgs, err := repackInsertDataByPartition(ctx, partitionName, rowOffsets, channel, 0, insertMsg, segIDAssigner)
 		if err != nil {
 			log.Warn("repack insert data to msg pack failed",
 				zap.String("collectionName", insertMsg.CollectionName),
@@ -245,29 +246,52 @@ func repackInsertDataWithPartitionKey(ctx context.Context,
 			zap.Error(err))
 		return nil, err
 	}
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, insertMsg.GetDbName(), insertMsg.CollectionName, insertMsg.CollectionID)
+	if err != nil {
+		log.Warn("get collection info failed in partition key mode",
+			zap.String("collectionName", insertMsg.CollectionName),
+			zap.Error(err))
+		return nil, err
+	}
+	// rows of the same partition are further hashed into buckets,
+	// so that the partition key values in a segment are clustered
+	var bucketValues []uint32
+	if collInfo.partitionKeyBucketNum > 1 {
+		bucketValues, err = typeutil.HashKey2Buckets(partitionKeys, len(partitionNames), collInfo.partitionKeyBucketNum)
+		if err != nil {
+			log.Warn("hash partition keys to buckets failed",
+				zap.String("collectionName", insertMsg.CollectionName),
+				zap.Error(err))
+			return nil, err
+		}
+	}
 
+	type partitionBucket struct {
+		partitionName string
+		bucketID      int64
+	}
 	for channel, rowOffsets := range channel2RowOffsets {
-		partition2RowOffsets := make(map[string][]int)
+		bucket2RowOffsets := make(map[partitionBucket][]int)
 		for _, idx := range rowOffsets {
-			partitionName := partitionNames[hashValues[idx]]
-			if _, ok := partition2RowOffsets[partitionName]; !ok {
-				partition2RowOffsets[partitionName] = []int{}
+			bucket := partitionBucket{partitionName: partitionNames[hashValues[idx]]}
+			if bucketValues != nil {
+				bucket.bucketID = int64(bucketValues[idx])
 			}
-			partition2RowOffsets[partitionName] = append(partition2RowOffsets[partitionName], idx)
+			bucket2RowOffsets[bucket] = append(bucket2RowOffsets[bucket], idx)
 		}
 
 		errGroup, _ := errgroup.WithContext(ctx)
-		partition2Msgs := typeutil.NewConcurrentMap[string, []msgstream.TsMsg]()
-		for partitionName, offsets := range partition2RowOffsets {
-			partitionName := partitionName
+		bucket2Msgs := typeutil.NewConcurrentMap[partitionBucket, []msgstream.TsMsg]()
+		for bucket, offsets := range bucket2RowOffsets {
+			bucket := bucket
 			offsets := offsets
 			errGroup.Go(func() error {
-				msgs, err := repackInsertDataByPartition(ctx, partitionName, offsets, channel, insertMsg, segIDAssigner)
+				msgs, err := repackInsertDataByPartition(ctx, bucket.partitionName, offsets, channel, bucket.bucketID, insertMsg, segIDAssigner)
 				if err != nil {
 					return err
 				}
 
-				partition2Msgs.Insert(partitionName, msgs)
+				bucket2Msgs.Insert(bucket, msgs)
 				return nil
 			})
 		}
@@ -281,7 +305,7 @@ func repackInsertDataWithPartitionKey(ctx context.Context,
 			return nil, err
 		}
 
-		partition2Msgs.Range(func(name string, msgs []msgstream.TsMsg) bool {
+		bucket2Msgs.Range(func(_ partitionBucket, msgs []msgstream.TsMsg) bool {
 			msgPack.Msgs = append(msgPack.Msgs, msgs...)
 			return true
 		})
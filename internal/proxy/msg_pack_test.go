@@ -228,6 +228,10 @@ func TestRepackInsertDataWithPartitionKey(t *testing.T) {
 		IDs: ids,
 	}
 
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	assert.NoError(t, err)
+	insertMsg.CollectionID = collectionID
+
 	t.Run("repack insert data success", func(t *testing.T) {
 		partitionKeys := generateFieldData(schemapb.DataType_VarChar, testVarCharField, nb)
 		_, err = repackInsertDataWithPartitionKey(ctx, []string{"test_dml_channel"}, partitionKeys,
@@ -0,0 +1,35 @@
+package proxy
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/multivectorutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// mvReducer merges the topk rows of the shards scored by the max-sim of the multi-vector field,
+// the rows are paginated by the offset and limit and followed by the column of the scores.
+type mvReducer struct {
+	params         *queryParams
+	req            *internalpb.RetrieveRequest
+	schema         *schemapb.CollectionSchema
+	collectionName string
+}
+
+func (r *mvReducer) Reduce(results []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
+	searcher, err := multivectorutil.NewSearcher(r.schema, r.req.GetMultiVectorSearch())
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range results {
+		if err := searcher.AddPartial(res.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	return &milvuspb.QueryResults{
+		Status:         merr.Success(),
+		FieldsData:     searcher.Finalize(r.params.offset, r.params.limit),
+		CollectionName: r.collectionName,
+	}, nil
+}
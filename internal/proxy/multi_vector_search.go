@@ -0,0 +1,212 @@
+package proxy
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/multivectorutil"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// getMultiVectorSearchField returns the multi-vector field searched by the request, nil if the anns field
+// is not a multi-vector field. The errors are left to the search task to report.
+func (node *Proxy) getMultiVectorSearchField(ctx context.Context, request *milvuspb.SearchRequest) *schemapb.FieldSchema {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return nil
+	}
+	annsField, err := funcutil.GetAttrByKeyFromRepeatedKV(AnnsFieldKey, request.GetSearchParams())
+	if err != nil || annsField == "" {
+		return nil
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return nil
+	}
+	field := typeutil.GetFieldByName(schema.CollectionSchema, annsField)
+	if field == nil || !multivectorutil.IsMultiVectorField(field) {
+		return nil
+	}
+	return field
+}
+
+// parseMultiVectorSearch parses the query vectors of the search on the multi-vector field,
+// all the vectors of the single query are scored together by the max-sim.
+func parseMultiVectorSearch(request *milvuspb.SearchRequest, schema *schemapb.CollectionSchema, field *schemapb.FieldSchema) (*internalpb.MultiVectorSearch, int64, error) {
+	queryInfo, offset, err := parseSearchInfo(request.GetSearchParams(), schema)
+	if err != nil {
+		return nil, 0, err
+	}
+	if queryInfo.GetGroupByFieldId() > 0 {
+		return nil, 0, merr.WrapErrParameterInvalidMsg("search group by is not supported by multi-vector field %s", field.GetName())
+	}
+	metricType := queryInfo.GetMetricType()
+	if metricType == "" {
+		metricType = metric.IP
+	}
+	if err := multivectorutil.CheckMetricType(metricType); err != nil {
+		return nil, 0, err
+	}
+	dim, err := multivectorutil.GetDim(field)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	placeholderGroup := &commonpb.PlaceholderGroup{}
+	if err := proto.Unmarshal(request.GetPlaceholderGroup(), placeholderGroup); err != nil {
+		return nil, 0, err
+	}
+	if len(placeholderGroup.GetPlaceholders()) != 1 || placeholderGroup.GetPlaceholders()[0].GetType() != commonpb.PlaceholderType_FloatVector {
+		return nil, 0, merr.WrapErrParameterInvalidMsg("multi-vector search requires the float vectors of a single query")
+	}
+	values := placeholderGroup.GetPlaceholders()[0].GetValues()
+	if len(values) == 0 {
+		return nil, 0, merr.WrapErrParameterInvalidMsg("multi-vector search requires at least one query vector")
+	}
+	vectors := make([]float32, 0, int64(len(values))*dim)
+	for _, value := range values {
+		if int64(len(value)) != dim*4 {
+			return nil, 0, merr.WrapErrParameterInvalidMsg("the dim of the query vector is %d, expected %d", len(value)/4, dim)
+		}
+		for i := 0; i < len(value); i += 4 {
+			vectors = append(vectors, typeutil.BytesToFloat32(value[i:i+4]))
+		}
+	}
+	if err := typeutil.VerifyFloats32(vectors); err != nil {
+		return nil, 0, err
+	}
+
+	return &internalpb.MultiVectorSearch{
+		FieldID:    field.GetFieldID(),
+		Vectors:    vectors,
+		MetricType: metricType,
+		Topk:       queryInfo.GetTopk(),
+	}, offset, nil
+}
+
+// searchMultiVector serves the search on the multi-vector field by a query task,
+// the filtered rows are scored by the max-sim on query nodes and the topk rows are returned as the hits.
+func (node *Proxy) searchMultiVector(ctx context.Context, request *milvuspb.SearchRequest, field *schemapb.FieldSchema) (*milvuspb.SearchResults, error) {
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.GetDbName(), request.GetCollectionName())
+	if err != nil {
+		return &milvuspb.SearchResults{Status: merr.Status(err)}, nil
+	}
+	search, offset, err := parseMultiVectorSearch(request, schema.CollectionSchema, field)
+	if err != nil {
+		return &milvuspb.SearchResults{Status: merr.Status(err)}, nil
+	}
+
+	queryParams := []*commonpb.KeyValuePair{
+		{Key: LimitKey, Value: strconv.FormatInt(search.GetTopk()-offset, 10)},
+		{Key: OffsetKey, Value: strconv.FormatInt(offset, 10)},
+	}
+	for _, kv := range request.GetSearchParams() {
+		if kv.GetKey() == IgnoreGrowingKey || kv.GetKey() == MaxStalenessKey {
+			queryParams = append(queryParams, kv)
+		}
+	}
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: &milvuspb.QueryRequest{
+			Base:                  request.GetBase(),
+			DbName:                request.GetDbName(),
+			CollectionName:        request.GetCollectionName(),
+			Expr:                  request.GetDsl(),
+			OutputFields:          request.GetOutputFields(),
+			PartitionNames:        request.GetPartitionNames(),
+			TravelTimestamp:       request.GetTravelTimestamp(),
+			GuaranteeTimestamp:    request.GetGuaranteeTimestamp(),
+			QueryParams:           queryParams,
+			NotReturnAllMeta:      request.GetNotReturnAllMeta(),
+			ConsistencyLevel:      request.GetConsistencyLevel(),
+			UseDefaultConsistency: request.GetUseDefaultConsistency(),
+		},
+		qc:                node.queryCoord,
+		lb:                node.lbPolicy,
+		multiVectorSearch: search,
+	}
+	res, err := node.query(ctx, qt)
+	if err != nil || !merr.Ok(res.GetStatus()) {
+		return &milvuspb.SearchResults{Status: res.GetStatus()}, err
+	}
+
+	results, err := multiVectorSearchResults(res, schema.CollectionSchema, qt.request.GetOutputFields(), qt.userOutputFields, search.GetTopk()-offset)
+	if err != nil {
+		return &milvuspb.SearchResults{Status: merr.Status(err)}, nil
+	}
+	return &milvuspb.SearchResults{
+		Status:         merr.Success(),
+		Results:        results,
+		CollectionName: request.GetCollectionName(),
+	}, nil
+}
+
+// multiVectorSearchResults converts the scored rows into the hits of the single query,
+// only the requested fields are returned.
+func multiVectorSearchResults(res *milvuspb.QueryResults, schema *schemapb.CollectionSchema, outputFields []string, userOutputFields []string, topk int64) (*schemapb.SearchResultData, error) {
+	results := &schemapb.SearchResultData{
+		NumQueries:   1,
+		TopK:         topk,
+		Topks:        []int64{0},
+		Ids:          &schemapb.IDs{},
+		Scores:       make([]float32, 0),
+		FieldsData:   make([]*schemapb.FieldData, 0),
+		OutputFields: userOutputFields,
+	}
+	fieldsData := res.GetFieldsData()
+	if len(fieldsData) == 0 {
+		return results, nil
+	}
+	scores := fieldsData[len(fieldsData)-1]
+	if scores.GetFieldName() != multivectorutil.ScoreFieldName {
+		return nil, merr.WrapErrServiceInternal("scores not found in multi-vector search results")
+	}
+	results.Scores = scores.GetScalars().GetFloatData().GetData()
+	results.Topks[0] = int64(len(results.Scores))
+
+	for _, fieldData := range fieldsData[:len(fieldsData)-1] {
+		field := typeutil.GetField(schema, fieldData.GetFieldId())
+		if field == nil {
+			return nil, merr.WrapErrFieldNotFound(fieldData.GetFieldId())
+		}
+		if field.GetIsPrimaryKey() {
+			switch field.GetDataType() {
+			case schemapb.DataType_Int64:
+				results.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: fieldData.GetScalars().GetLongData().GetData()}}
+			case schemapb.DataType_VarChar:
+				results.Ids.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: fieldData.GetScalars().GetStringData().GetData()}}
+			default:
+				return nil, errors.New("unsupported primary key type")
+			}
+		}
+		if lo.Contains(outputFields, field.GetName()) {
+			fieldData.FieldName = field.GetName()
+			fieldData.IsDynamic = field.GetIsDynamic()
+			results.FieldsData = append(results.FieldsData, fieldData)
+		}
+	}
+	if results.GetIds().GetIdField() == nil {
+		return nil, merr.WrapErrServiceInternal("primary keys not found in multi-vector search results")
+	}
+	return results, nil
+}
@@ -0,0 +1,164 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/multivectorutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func multiVectorTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "title", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID:     102,
+				Name:        "passages",
+				DataType:    schemapb.DataType_Array,
+				ElementType: schemapb.DataType_Float,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.DimKey, Value: "2"},
+					{Key: common.MaxCapacityKey, Value: "8"},
+					{Key: common.MultiVectorKey, Value: "true"},
+				},
+			},
+		},
+	}
+}
+
+func multiVectorTestRows(pks []int64, titles []string, vectors [][]float32) []*schemapb.FieldData {
+	arrays := make([]*schemapb.ScalarField, len(vectors))
+	for i, v := range vectors {
+		arrays[i] = &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: v}}}
+	}
+	return []*schemapb.FieldData{
+		{
+			FieldId: 100,
+			Type:    schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+			}},
+		},
+		{
+			FieldId: 101,
+			Type:    schemapb.DataType_VarChar,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: titles}},
+			}},
+		},
+		{
+			FieldId: 102,
+			Type:    schemapb.DataType_Array,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_ArrayData{ArrayData: &schemapb.ArrayArray{Data: arrays, ElementType: schemapb.DataType_Float}},
+			}},
+		},
+	}
+}
+
+func multiVectorSearchRequest(t *testing.T, vectors [][]float32, params map[string]string) *milvuspb.SearchRequest {
+	values := make([][]byte, 0, len(vectors))
+	for _, v := range vectors {
+		value := make([]byte, 0, len(v)*4)
+		for _, f := range v {
+			value = append(value, typeutil.Float32ToBytes(f)...)
+		}
+		values = append(values, value)
+	}
+	placeholderGroup, err := proto.Marshal(&commonpb.PlaceholderGroup{
+		Placeholders: []*commonpb.PlaceholderValue{{Tag: "$0", Type: commonpb.PlaceholderType_FloatVector, Values: values}},
+	})
+	require.NoError(t, err)
+	searchParams := []*commonpb.KeyValuePair{{Key: AnnsFieldKey, Value: "passages"}}
+	for k, v := range params {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: k, Value: v})
+	}
+	return &milvuspb.SearchRequest{PlaceholderGroup: placeholderGroup, SearchParams: searchParams}
+}
+
+func TestParseMultiVectorSearch(t *testing.T) {
+	schema := multiVectorTestSchema()
+	field := schema.GetFields()[2]
+
+	search, offset, err := parseMultiVectorSearch(multiVectorSearchRequest(t, [][]float32{{1, 0}, {0, 1}}, map[string]string{TopKKey: "10", OffsetKey: "5"}), schema, field)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), offset)
+	assert.Equal(t, int64(15), search.GetTopk())
+	assert.Equal(t, int64(102), search.GetFieldID())
+	assert.Equal(t, metric.IP, search.GetMetricType())
+	assert.Equal(t, []float32{1, 0, 0, 1}, search.GetVectors())
+
+	search, _, err = parseMultiVectorSearch(multiVectorSearchRequest(t, [][]float32{{1, 0}}, map[string]string{TopKKey: "10", common.MetricTypeKey: metric.COSINE}), schema, field)
+	require.NoError(t, err)
+	assert.Equal(t, metric.COSINE, search.GetMetricType())
+
+	_, _, err = parseMultiVectorSearch(multiVectorSearchRequest(t, [][]float32{{1, 0}}, map[string]string{TopKKey: "10", common.MetricTypeKey: metric.L2}), schema, field)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, _, err = parseMultiVectorSearch(multiVectorSearchRequest(t, [][]float32{{1, 0, 1}}, map[string]string{TopKKey: "10"}), schema, field)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, _, err = parseMultiVectorSearch(multiVectorSearchRequest(t, nil, map[string]string{TopKKey: "10"}), schema, field)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, _, err = parseMultiVectorSearch(multiVectorSearchRequest(t, [][]float32{{1, 0}}, map[string]string{TopKKey: "10", GroupByFieldKey: "title"}), schema, field)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, _, err = parseMultiVectorSearch(multiVectorSearchRequest(t, [][]float32{{1, 0}}, nil), schema, field)
+	assert.Error(t, err)
+}
+
+func Test_mvReducer_Reduce(t *testing.T) {
+	schema := multiVectorTestSchema()
+	req := &internalpb.RetrieveRequest{
+		MultiVectorSearch: &internalpb.MultiVectorSearch{FieldID: 102, Vectors: []float32{1, 0}, MetricType: metric.IP, Topk: 3},
+	}
+	partial := func(pks []int64, titles []string, vectors [][]float32) *internalpb.RetrieveResults {
+		searcher, err := multivectorutil.NewSearcher(schema, req.GetMultiVectorSearch())
+		require.NoError(t, err)
+		require.NoError(t, searcher.AddRows(multiVectorTestRows(pks, titles, vectors)))
+		return &internalpb.RetrieveResults{FieldsData: searcher.Partial()}
+	}
+	results := []*internalpb.RetrieveResults{
+		partial([]int64{1, 2}, []string{"a", "b"}, [][]float32{{0.2, 0, 0.6, 0}, {0.9, 0}}),
+		partial([]int64{3}, []string{"c"}, [][]float32{{0.4, 0}}),
+		{},
+	}
+
+	r := &mvReducer{params: &queryParams{offset: 1, limit: 2}, req: req, schema: schema, collectionName: "test"}
+	res, err := r.Reduce(results)
+	require.NoError(t, err)
+	assert.Equal(t, "test", res.GetCollectionName())
+
+	hits, err := multiVectorSearchResults(res, schema, []string{"title"}, []string{"title"}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, hits.GetTopks())
+	assert.Equal(t, []int64{1, 3}, hits.GetIds().GetIntId().GetData())
+	assert.InDeltaSlice(t, []float32{0.6, 0.4}, hits.GetScores(), 1e-6)
+	require.Len(t, hits.GetFieldsData(), 1)
+	assert.Equal(t, "title", hits.GetFieldsData()[0].GetFieldName())
+	assert.Equal(t, []string{"a", "c"}, hits.GetFieldsData()[0].GetScalars().GetStringData().GetData())
+
+	// no rows matched
+	hits, err = multiVectorSearchResults(&milvuspb.QueryResults{}, schema, nil, nil, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{0}, hits.GetTopks())
+
+	_, err = multiVectorSearchResults(&milvuspb.QueryResults{FieldsData: multiVectorTestRows([]int64{1}, []string{"a"}, [][]float32{{1, 0}})}, schema, nil, nil, 2)
+	assert.Error(t, err)
+
+	_, err = r.Reduce([]*internalpb.RetrieveResults{{FieldsData: multiVectorTestRows([]int64{1}, []string{"a"}, [][]float32{{1, 0}})}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,311 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang-jwt/jwt/v4"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+	// the jwks is refreshed at most once in the interval for the tokens signed by the unknown keys
+	oidcMinRefreshInterval = 10 * time.Second
+	oidcHTTPTimeout        = 10 * time.Second
+)
+
+var oidcSigningMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}
+
+var (
+	oidc         *oidcVerifier
+	oidcInitOnce sync.Once
+)
+
+func getOIDCVerifier() *oidcVerifier {
+	oidcInitOnce.Do(func() {
+		oidc = newOIDCVerifier(Params.CommonCfg.OIDCIssuer.GetValue(), &http.Client{Timeout: oidcHTTPTimeout})
+	})
+	return oidc
+}
+
+// oidcRolesKey is the key of the roles mapped from the claims of the verified token in the request context.
+type oidcRolesKey struct{}
+
+// WithOIDCRoles carries the roles of the verified token in the context of the request, the roles are never shared with
+// the other requests, even the ones of the same subject.
+func WithOIDCRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, oidcRolesKey{}, roles)
+}
+
+// getOIDCRoles returns the roles of the token the request is authenticated by.
+func getOIDCRoles(ctx context.Context) []string {
+	roles, _ := ctx.Value(oidcRolesKey{}).([]string)
+	return roles
+}
+
+// oidcVerifier verifies the OIDC id tokens by the jwks discovered from the issuer.
+type oidcVerifier struct {
+	issuer string
+	client *http.Client
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	refreshedAt time.Time
+}
+
+func newOIDCVerifier(issuer string, client *http.Client) *oidcVerifier {
+	return &oidcVerifier{
+		issuer: strings.TrimSuffix(issuer, "/"),
+		client: client,
+		keys:   make(map[string]crypto.PublicKey),
+	}
+}
+
+// isJWT checks whether the raw token is shaped like a jwt, which is three base64url segments.
+func isJWT(rawToken string) bool {
+	segments := strings.Split(rawToken, ".")
+	return len(segments) == 3 && strings.HasPrefix(rawToken, "eyJ")
+}
+
+// IsOIDCToken checks whether the raw token is the oidc id token to verify, which is only accepted with the oidc enabled.
+func IsOIDCToken(rawToken string) bool {
+	return Params.CommonCfg.OIDCEnabled.GetAsBool() && isJWT(rawToken)
+}
+
+// VerifyOIDCToken verifies the oidc id token by the issuer configured,
+// and returns the user the token authenticates as with the roles mapped from the claims.
+func VerifyOIDCToken(ctx context.Context, rawToken string) (string, []string, error) {
+	return getOIDCVerifier().Verify(ctx, rawToken)
+}
+
+// Verify verifies the token and returns the user the token authenticates as with the roles mapped from the claims,
+// the user is namespaced by the prefix, so it's never confused with the local user of the same name.
+func (v *oidcVerifier) Verify(ctx context.Context, rawToken string) (string, []string, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return v.getKey(ctx, kid)
+	}, jwt.WithValidMethods(oidcSigningMethods))
+	if err != nil {
+		return "", nil, err
+	}
+	// the expiration is only verified by the parser if it's present, the token never expires is rejected
+	if _, ok := claims["exp"]; !ok {
+		return "", nil, errors.New("missing exp claim")
+	}
+	if !claims.VerifyIssuer(v.issuer, true) {
+		return "", nil, fmt.Errorf("invalid issuer, expected %s", v.issuer)
+	}
+	if audience := Params.CommonCfg.OIDCAudience.GetValue(); audience != "" && !claims.VerifyAudience(audience, true) {
+		return "", nil, fmt.Errorf("invalid audience, expected %s", audience)
+	}
+
+	username, _ := claims[Params.CommonCfg.OIDCUsernameClaim.GetValue()].(string)
+	if username == "" {
+		return "", nil, fmt.Errorf("missing username claim %s", Params.CommonCfg.OIDCUsernameClaim.GetValue())
+	}
+	// the root user and the users of the api keys are never authenticated by the tokens
+	if username == util.UserRoot || strings.HasPrefix(username, util.APIKeyUserPrefix) {
+		return "", nil, fmt.Errorf("the user %s is not allowed to authenticate by the oidc token", username)
+	}
+
+	roles := mapClaimsToRoles(claims[Params.CommonCfg.OIDCRolesClaim.GetValue()], Params.CommonCfg.OIDCRoleMapping.GetAsJSONMap())
+	return util.OIDCUserPrefix + username, roles, nil
+}
+
+// mapClaimsToRoles maps the groups in the claim to the roles, the claim may be a string or a list of strings.
+func mapClaimsToRoles(claim interface{}, mapping map[string]string) []string {
+	var groups []string
+	switch claim := claim.(type) {
+	case string:
+		groups = []string{claim}
+	case []interface{}:
+		for _, group := range claim {
+			if group, ok := group.(string); ok {
+				groups = append(groups, group)
+			}
+		}
+	}
+	roles := typeutil.NewSet[string]()
+	for _, group := range groups {
+		if role, ok := mapping[group]; ok && role != "" {
+			roles.Insert(role)
+		}
+	}
+	return roles.Collect()
+}
+
+func (v *oidcVerifier) getKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	refreshInterval := Params.CommonCfg.OIDCJWKSRefreshInterval.GetAsDuration(time.Second)
+	key, ok := v.keys[kid]
+	sinceRefresh := time.Since(v.refreshedAt)
+	if ok && sinceRefresh < refreshInterval {
+		return key, nil
+	}
+	// the keys may be rotated by the issuer, refresh them for the unknown ones
+	if ok || sinceRefresh >= oidcMinRefreshInterval {
+		if err := v.refreshKeys(ctx); err != nil {
+			log.Warn("failed to refresh the oidc jwks", zap.String("issuer", v.issuer), zap.Error(err))
+			// the cached key is used until the jwks is available again
+			if ok {
+				return key, nil
+			}
+			return nil, err
+		}
+		key, ok = v.keys[kid]
+	}
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %s", kid)
+	}
+	return key, nil
+}
+
+type oidcDiscovery struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (v *oidcVerifier) refreshKeys(ctx context.Context) error {
+	if v.issuer == "" {
+		return errors.New("the oidc issuer is not configured")
+	}
+	discovery := &oidcDiscovery{}
+	if err := v.getJSON(ctx, v.issuer+oidcDiscoveryPath, discovery); err != nil {
+		return err
+	}
+	if strings.TrimSuffix(discovery.Issuer, "/") != v.issuer {
+		return fmt.Errorf("issuer mismatched, expected %s, got %s", v.issuer, discovery.Issuer)
+	}
+	jwks := &struct {
+		Keys []*jsonWebKey `json:"keys"`
+	}{}
+	if err := v.getJSON(ctx, discovery.JWKSURI, jwks); err != nil {
+		return err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			log.Warn("skip the invalid jwk", zap.String("kid", jwk.Kid), zap.Error(err))
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	v.keys = keys
+	v.refreshedAt = time.Now()
+	log.Info("oidc jwks refreshed", zap.String("issuer", v.issuer), zap.Int("keys", len(keys)))
+	return nil
+}
+
+func (v *oidcVerifier) getJSON(ctx context.Context, url string, obj interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get %s, status: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(obj)
+}
+
+func (jwk *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := decodeBigInt(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", jwk.Crv)
+		}
+		x, err := decodeBigInt(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", jwk.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
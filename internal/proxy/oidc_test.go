@@ -0,0 +1,185 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type mockOIDCProvider struct {
+	server   *httptest.Server
+	key      *rsa.PrivateKey
+	kid      string
+	jwksHits int
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	p := &mockOIDCProvider{key: key, kid: "key1"}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(&oidcDiscovery{Issuer: p.server.URL, JWKSURI: p.server.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		p.jwksHits++
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []*jsonWebKey{{
+				Kid: p.kid,
+				Kty: "RSA",
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(p.key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(p.key.E)).Bytes()),
+			}},
+		})
+	})
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+func (p *mockOIDCProvider) sign(t *testing.T, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(p.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestOIDCVerifier(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(Params.CommonCfg.OIDCAudience.Key, "milvus")
+	params.Save(Params.CommonCfg.OIDCRoleMapping.Key, `{"milvus-admins": "admin", "readers": "reader"}`)
+	defer params.Reset(Params.CommonCfg.OIDCAudience.Key)
+	defer params.Reset(Params.CommonCfg.OIDCRoleMapping.Key)
+
+	ctx := context.Background()
+	p := newMockOIDCProvider(t)
+	v := newOIDCVerifier(p.server.URL, p.server.Client())
+	claims := func(sub string, aud string, exp time.Time) jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":    p.server.URL,
+			"aud":    aud,
+			"sub":    sub,
+			"exp":    exp.Unix(),
+			"groups": []string{"milvus-admins", "others"},
+		}
+	}
+
+	t.Run("verify", func(t *testing.T) {
+		token := p.sign(t, p.kid, claims("alice", "milvus", time.Now().Add(time.Minute)))
+		assert.True(t, isJWT(token))
+		username, roles, err := v.Verify(ctx, token)
+		assert.NoError(t, err)
+		assert.Equal(t, "oidc/alice", username)
+		assert.ElementsMatch(t, []string{"admin"}, roles)
+
+		// the jwks is cached
+		_, _, err = v.Verify(ctx, token)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, p.jwksHits)
+
+		// the roles of the tokens of the same subject are never shared
+		readerClaims := claims("alice", "milvus", time.Now().Add(time.Minute))
+		readerClaims["groups"] = []string{"readers"}
+		_, roles, err = v.Verify(ctx, p.sign(t, p.kid, readerClaims))
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"reader"}, roles)
+	})
+
+	t.Run("invalid claims", func(t *testing.T) {
+		_, _, err := v.Verify(ctx, p.sign(t, p.kid, claims("alice", "other", time.Now().Add(time.Minute))))
+		assert.Error(t, err)
+		_, _, err = v.Verify(ctx, p.sign(t, p.kid, claims("alice", "milvus", time.Now().Add(-time.Minute))))
+		assert.Error(t, err)
+		_, _, err = v.Verify(ctx, p.sign(t, p.kid, claims("", "milvus", time.Now().Add(time.Minute))))
+		assert.Error(t, err)
+		_, _, err = v.Verify(ctx, p.sign(t, p.kid, claims("root", "milvus", time.Now().Add(time.Minute))))
+		assert.Error(t, err)
+		_, _, err = v.Verify(ctx, p.sign(t, p.kid, claims("apikey/key1", "milvus", time.Now().Add(time.Minute))))
+		assert.Error(t, err)
+
+		invalidIssuer := claims("alice", "milvus", time.Now().Add(time.Minute))
+		invalidIssuer["iss"] = "http://other"
+		_, _, err = v.Verify(ctx, p.sign(t, p.kid, invalidIssuer))
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		hits := p.jwksHits
+		_, _, err := v.Verify(ctx, p.sign(t, "key2", claims("alice", "milvus", time.Now().Add(time.Minute))))
+		assert.Error(t, err)
+		// the jwks is not refreshed too frequently
+		assert.Equal(t, hits, p.jwksHits)
+
+		// the rotated key is fetched
+		v.refreshedAt = time.Time{}
+		p.kid = "key2"
+		_, _, err = v.Verify(ctx, p.sign(t, "key2", claims("alice", "milvus", time.Now().Add(time.Minute))))
+		assert.NoError(t, err)
+		assert.Equal(t, hits+1, p.jwksHits)
+	})
+
+	t.Run("no expiration", func(t *testing.T) {
+		noExp := claims("alice", "milvus", time.Now())
+		delete(noExp, "exp")
+		_, _, err := v.Verify(ctx, p.sign(t, p.kid, noExp))
+		assert.Error(t, err)
+	})
+
+	t.Run("issuer unavailable", func(t *testing.T) {
+		v := newOIDCVerifier("", http.DefaultClient)
+		_, _, err := v.Verify(ctx, p.sign(t, p.kid, claims("alice", "milvus", time.Now().Add(time.Minute))))
+		assert.Error(t, err)
+	})
+}
+
+func TestMapClaimsToRoles(t *testing.T) {
+	mapping := map[string]string{"g1": "r1", "g2": "r2", "g3": "r1"}
+	assert.ElementsMatch(t, []string{"r1"}, mapClaimsToRoles("g1", mapping))
+	assert.ElementsMatch(t, []string{"r1", "r2"}, mapClaimsToRoles([]interface{}{"g1", "g2", "g3", "g4", 1}, mapping))
+	assert.Empty(t, mapClaimsToRoles(nil, mapping))
+	assert.False(t, isJWT("alice:123456"))
+}
+
+func TestIsOIDCToken(t *testing.T) {
+	paramtable.Init()
+	token := "eyJhbGciOiJSUzI1NiJ9.eyJzdWIiOiJhbGljZSJ9.signature"
+	assert.False(t, IsOIDCToken(token))
+
+	paramtable.Get().Save(Params.CommonCfg.OIDCEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.OIDCEnabled.Key)
+	assert.True(t, IsOIDCToken(token))
+	assert.False(t, IsOIDCToken("mk-key1-secret"))
+}
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// preparedExprCache caches the prepared expression templates of a collection in lru,
+// it's dropped together with the schema info once the collection meta changed.
+type preparedExprCache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type preparedExprEntry struct {
+	key      string
+	prepared *planparserv2.PreparedExpr
+}
+
+func newPreparedExprCache() *preparedExprCache {
+	return &preparedExprCache{
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+func (c *preparedExprCache) Get(key string) (*planparserv2.PreparedExpr, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ele, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.lru.MoveToFront(ele)
+	return ele.Value.(*preparedExprEntry).prepared, true
+}
+
+func (c *preparedExprCache) Put(key string, prepared *planparserv2.PreparedExpr, capacity int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ele, ok := c.entries[key]; ok {
+		ele.Value.(*preparedExprEntry).prepared = prepared
+		c.lru.MoveToFront(ele)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(&preparedExprEntry{key: key, prepared: prepared})
+	for c.lru.Len() > capacity {
+		ele := c.lru.Back()
+		c.lru.Remove(ele)
+		delete(c.entries, ele.Value.(*preparedExprEntry).key)
+	}
+}
+
+func (c *preparedExprCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// bindExpr parses the expression template with the params bound,
+// the template is prepared once for the params of the same types and reused by the following requests.
+func (s *schemaInfo) bindExpr(template string, data string) (*planpb.Expr, error) {
+	params, err := planparserv2.ParseExprParams(data)
+	if err != nil {
+		return nil, err
+	}
+	key := template + "\x00" + params.Signature()
+	prepared, ok := s.preparedExprs.Get(key)
+	if !ok {
+		prepared, err = planparserv2.PrepareExpr(s.schemaHelper, template, params)
+		if err != nil {
+			return nil, err
+		}
+		if capacity := paramtable.Get().ProxyCfg.PreparedExprCacheSize.GetAsInt(); capacity > 0 {
+			s.preparedExprs.Put(key, prepared, capacity)
+		}
+	}
+	return prepared.Bind(params)
+}
+
+func hasExprParams(params []*commonpb.KeyValuePair) bool {
+	_, err := funcutil.GetAttrByKeyFromRepeatedKV(ExprParamsKey, params)
+	return err == nil
+}
+
+// createRetrievePlan creates the retrieve plan, the expression is treated as a template if the expr params provided.
+func createRetrievePlan(schema *schemaInfo, exprStr string, params []*commonpb.KeyValuePair) (*planpb.PlanNode, error) {
+	data, err := funcutil.GetAttrByKeyFromRepeatedKV(ExprParamsKey, params)
+	if err != nil {
+		return planparserv2.CreateRetrievePlan(schema.schemaHelper, exprStr)
+	}
+	expr, err := schema.bindExpr(exprStr, data)
+	if err != nil {
+		return nil, err
+	}
+	return planparserv2.CreateRetrievePlanByExpr(expr), nil
+}
+
+// createSearchPlan creates the search plan, the expression is treated as a template if the expr params provided.
+func createSearchPlan(schema *schemaInfo, exprStr string, annsFieldName string, queryInfo *planpb.QueryInfo, params []*commonpb.KeyValuePair) (*planpb.PlanNode, error) {
+	data, err := funcutil.GetAttrByKeyFromRepeatedKV(ExprParamsKey, params)
+	if err != nil || exprStr == "" {
+		return planparserv2.CreateSearchPlan(schema.schemaHelper, exprStr, annsFieldName, queryInfo)
+	}
+	expr, err := schema.bindExpr(exprStr, data)
+	if err != nil {
+		return nil, err
+	}
+	return planparserv2.CreateSearchPlanByExpr(schema.schemaHelper, expr, annsFieldName, queryInfo)
+}
@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestPreparedExpr(t *testing.T) {
+	paramtable.Init()
+	schema := newSchemaInfo(constructCollectionSchema(testInt64Field, testFloatVecField, 8, "coll"))
+
+	params := func(data string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: ExprParamsKey, Value: data}}
+	}
+
+	t.Run("retrieve", func(t *testing.T) {
+		plan, err := createRetrievePlan(schema, "int64 in {ids}", params(`{"ids": [1, 2]}`))
+		assert.NoError(t, err)
+		expected, err := planparserv2.CreateRetrievePlan(schema.schemaHelper, "int64 in [1, 2]")
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(expected, plan))
+
+		// the prepared template is reused by the params of the same types
+		plan, err = createRetrievePlan(schema, "int64 in {ids}", params(`{"ids": [3]}`))
+		assert.NoError(t, err)
+		expected, err = planparserv2.CreateRetrievePlan(schema.schemaHelper, "int64 in [3]")
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(expected, plan))
+		assert.Equal(t, 1, schema.preparedExprs.Len())
+
+		_, err = createRetrievePlan(schema, "int64 in {ids}", params(`{"ids": ["a"]}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		plan, err := createSearchPlan(schema, "int64 > {a}", testFloatVecField, nil, params(`{"a": 10}`))
+		assert.NoError(t, err)
+		expected, err := planparserv2.CreateSearchPlan(schema.schemaHelper, "int64 > 10", testFloatVecField, nil)
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(expected, plan))
+
+		_, err = createSearchPlan(schema, "int64 > {a}", testFloatVecField, nil, params(`{"b": 10}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("cache capacity", func(t *testing.T) {
+		paramtable.Get().Save(paramtable.Get().ProxyCfg.PreparedExprCacheSize.Key, "1")
+		defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.PreparedExprCacheSize.Key)
+		_, err := schema.bindExpr("int64 > {a}", `{"a": 1}`)
+		assert.NoError(t, err)
+		_, err = schema.bindExpr("int64 < {a}", `{"a": 1}`)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, schema.preparedExprs.Len())
+	})
+}
@@ -102,7 +102,11 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 	if username == util.UserRoot {
 		return ctx, nil
 	}
-	roleNames, err := GetRole(username)
+	if err := checkAPIKeyDatabase(username, GetCurDBNameFromContextOrDefault(ctx)); err != nil {
+		log.Info("permission deny", zap.String("username", username), zap.Error(err))
+		return ctx, status.Error(codes.PermissionDenied, err.Error())
+	}
+	roleNames, err := GetRole(ctx, username)
 	if err != nil {
 		log.Warn("GetRole fail", zap.String("username", username), zap.Error(err))
 		return ctx, err
@@ -173,10 +177,61 @@ func PrivilegeInterceptor(ctx context.Context, req interface{}) (context.Context
 		}
 	}
 
+	if objectType == commonpb.ObjectType_Collection.String() && objectNameIndex != 0 {
+		permitPartitions, err := permitPartitionObjects(e, roleNames, dbName, objectName, objectPrivilege, getPartitionNames(req))
+		if err != nil {
+			log.Warn("fail to permit the partitions", zap.Error(err))
+			return ctx, err
+		}
+		if permitPartitions {
+			return ctx, nil
+		}
+	}
+
 	log.Info("permission deny", zap.Strings("roles", roleNames))
 	return ctx, status.Error(codes.PermissionDenied, fmt.Sprintf("%s: permission deny to %s", objectPrivilege, username))
 }
 
+// getPartitionNames returns the partitions the request refers to, empty if refers to all the partitions.
+func getPartitionNames(req interface{}) []string {
+	switch req := req.(type) {
+	case interface{ GetPartitionNames() []string }:
+		return req.GetPartitionNames()
+	case interface{ GetPartitionName() string }:
+		if req.GetPartitionName() != "" {
+			return []string{req.GetPartitionName()}
+		}
+	}
+	return nil
+}
+
+// permitPartitionObjects checks the partition level grants of the collection,
+// which permits the request only if every partition it refers to is granted to any of the roles.
+func permitPartitionObjects(e *casbin.SyncedEnforcer, roleNames []string, dbName string, collectionName string, privilege string, partitionNames []string) (bool, error) {
+	if len(partitionNames) == 0 {
+		return false, nil
+	}
+	object := commonpb.ObjectType_Collection.String()
+	for _, partitionName := range partitionNames {
+		resource := funcutil.PolicyForResource(dbName, object, funcutil.CombinePartitionObjectName(collectionName, partitionName))
+		permitted := false
+		for _, roleName := range roleNames {
+			isPermit, err := e.Enforce(roleName, resource, privilege)
+			if err != nil {
+				return false, err
+			}
+			if isPermit {
+				permitted = true
+				break
+			}
+		}
+		if !permitted {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // isCurUserObject Determine whether it is an Object of type User that operates on its own user information,
 // like updating password or viewing your own role information.
 // make users operate their own user information when the related privileges are not granted.
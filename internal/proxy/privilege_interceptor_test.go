@@ -221,3 +221,44 @@ func TestResourceGroupPrivilege(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestPartitionPrivilege(t *testing.T) {
+	paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+	defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+	ctx := GetContext(context.Background(), "alice:123456")
+	client := &MockRootCoordClientInterface{}
+	queryCoord := &mocks.MockQueryCoordClient{}
+	mgr := newShardClientMgr()
+
+	client.listPolicy = func(ctx context.Context, in *internalpb.ListPolicyRequest) (*internalpb.ListPolicyResponse, error) {
+		return &internalpb.ListPolicyResponse{
+			Status: merr.Success(),
+			PolicyInfos: []string{
+				funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), funcutil.CombinePartitionObjectName("col1", "p1"), commonpb.ObjectPrivilege_PrivilegeSearch.String(), "default"),
+				funcutil.PolicyForPrivilege("role1", commonpb.ObjectType_Collection.String(), funcutil.CombinePartitionObjectName("col2", "*"), commonpb.ObjectPrivilege_PrivilegeQuery.String(), "default"),
+			},
+			UserRoles: []string{
+				funcutil.EncodeUserRoleCache("alice", "role1"),
+			},
+		}, nil
+	}
+	err := InitMetaCache(ctx, client, queryCoord, mgr)
+	assert.NoError(t, err)
+
+	// the whole collection is not granted
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.SearchRequest{CollectionName: "col1"})
+	assert.Error(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.SearchRequest{CollectionName: "col1", PartitionNames: []string{"p1"}})
+	assert.NoError(t, err)
+	// every partition should be granted
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.SearchRequest{CollectionName: "col1", PartitionNames: []string{"p1", "p2"}})
+	assert.Error(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.QueryRequest{CollectionName: "col1", PartitionNames: []string{"p1"}})
+	assert.Error(t, err)
+
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.QueryRequest{CollectionName: "col2", PartitionNames: []string{"p1", "p2"}})
+	assert.NoError(t, err)
+	_, err = PrivilegeInterceptor(ctx, &milvuspb.QueryRequest{CollectionName: "col2"})
+	assert.Error(t, err)
+}
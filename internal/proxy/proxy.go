@@ -40,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/internal/util/hookutil"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -51,6 +52,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/resource"
+	"github.com/milvus-io/milvus/pkg/util/retry"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -64,6 +66,12 @@ type Timestamp = typeutil.Timestamp
 // const sendTimeTickMsgInterval = 200 * time.Millisecond
 // const channelMgrTickerInterval = 100 * time.Millisecond
 
+const (
+	// the writes in flight are waited at most fenceDrainTimeout before re-registering once fenced
+	fenceDrainTimeout  = time.Minute
+	fenceCheckInterval = 100 * time.Millisecond
+)
+
 // make sure Proxy implements types.Proxy
 var _ types.Proxy = (*Proxy)(nil)
 
@@ -129,6 +137,9 @@ type Proxy struct {
 
 	// materialized view
 	enableMaterializedView bool
+
+	// metaEventWatcher receives the meta changes pushed by the coordinators, nil if the meta cache watch disabled
+	metaEventWatcher *proxyutil.MetaEventWatcher
 }
 
 // NewProxy returns a Proxy struct.
@@ -225,6 +236,8 @@ func (node *Proxy) Init() error {
 	accesslog.InitAccessLog(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
 	log.Debug("init access log for Proxy done")
 
+	accesslog.InitAuditLog(&Params.ProxyCfg.AuditLog, &Params.KafkaCfg)
+
 	err := node.initRateCollector()
 	if err != nil {
 		return err
@@ -278,7 +291,7 @@ func (node *Proxy) Init() error {
 	node.replicateMsgStream.EnableProduce(true)
 	node.replicateMsgStream.AsProducer([]string{replicateMsgChannel})
 
-	node.sched, err = newTaskScheduler(node.ctx, node.tsoAllocator, node.factory)
+	node.sched, err = newTaskScheduler(node.ctx, node.tsoAllocator, node.factory, withBatchedTsoAllocator(node.tsoAllocator.batched()))
 	if err != nil {
 		log.Warn("failed to create task scheduler", zap.String("role", typeutil.ProxyRole), zap.Error(err))
 		return err
@@ -286,7 +299,7 @@ func (node *Proxy) Init() error {
 	log.Debug("create task scheduler done", zap.String("role", typeutil.ProxyRole))
 
 	syncTimeTickInterval := Params.ProxyCfg.TimeTickInterval.GetAsDuration(time.Millisecond) / 2
-	node.chTicker = newChannelsTimeTicker(node.ctx, Params.ProxyCfg.TimeTickInterval.GetAsDuration(time.Millisecond)/2, []string{}, node.sched.getPChanStatistics, tsoAllocator)
+	node.chTicker = newChannelsTimeTicker(node.ctx, Params.ProxyCfg.TimeTickInterval.GetAsDuration(time.Millisecond)/2, []string{}, node.sched.getPChanStatistics, tsoAllocator.batched())
 	log.Debug("create channels time ticker done", zap.String("role", typeutil.ProxyRole), zap.Duration("syncTimeTickInterval", syncTimeTickInterval))
 
 	node.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
@@ -387,6 +400,47 @@ func (node *Proxy) sendChannelsTimeTickLoop() {
 	}()
 }
 
+// handleFence stops serving once fenced by rootcoord since the time tick of the proxy stalled,
+// and re-registers by removing the fence after the writes in flight are done.
+func (node *Proxy) handleFence(serverID int64, reason string) {
+	log := log.With(zap.Int64("serverID", serverID))
+	log.Warn("proxy fenced by rootcoord, stop serving", zap.String("reason", reason))
+	node.UpdateStateCode(commonpb.StateCode_Abnormal)
+
+	// the time ticks of the writes in flight are denied while fenced
+	if err := node.waitDmlTasksDone(fenceDrainTimeout); err != nil {
+		log.Warn("the writes in flight are not done before re-registering", zap.Error(err))
+	}
+	err := retry.Do(node.ctx, func() error {
+		return node.session.UnfenceSession(node.ctx, serverID)
+	})
+	if err != nil {
+		log.Warn("fail to remove the fence, keep the proxy out of service", zap.Error(err))
+		return
+	}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+	log.Info("proxy re-registered after fenced")
+}
+
+// waitDmlTasksDone waits until there are no dml tasks in flight.
+func (node *Proxy) waitDmlTasksDone(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(node.ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(fenceCheckInterval)
+	defer ticker.Stop()
+	for {
+		stats, err := node.sched.dmQueue.getPChanStatsInfo()
+		if err == nil && len(stats) == 0 {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 // Start starts a proxy node.
 func (node *Proxy) Start() error {
 	if err := node.sched.Start(); err != nil {
@@ -428,6 +482,20 @@ func (node *Proxy) Start() error {
 	log.Debug("update state code", zap.String("role", typeutil.ProxyRole), zap.String("State", commonpb.StateCode_Healthy.String()))
 	node.UpdateStateCode(commonpb.StateCode_Healthy)
 
+	if node.session != nil {
+		// the proxy is fenced by rootcoord if its time tick stalled
+		go node.session.WatchFence(node.ctx, node.session.ServerID, node.handleFence, nil)
+	}
+
+	if Params.CommonCfg.MetaCacheWatchEnabled.GetAsBool() {
+		node.metaEventWatcher = proxyutil.NewMetaEventWatcher(node.etcdCli, node.handleMetaEvent)
+		if err := node.metaEventWatcher.Start(node.ctx); err != nil {
+			log.Warn("failed to watch meta events", zap.String("role", typeutil.ProxyRole), zap.Error(err))
+			return err
+		}
+		log.Debug("start meta event watcher done", zap.String("role", typeutil.ProxyRole))
+	}
+
 	// register devops api
 	RegisterMgrRoute(node)
 
@@ -463,6 +531,8 @@ func (node *Proxy) Stop() error {
 		cb()
 	}
 
+	accesslog.CloseAuditLog()
+
 	if node.session != nil {
 		node.session.Stop()
 	}
@@ -483,6 +553,10 @@ func (node *Proxy) Stop() error {
 		node.resourceManager.Close()
 	}
 
+	if node.metaEventWatcher != nil {
+		node.metaEventWatcher.Stop()
+	}
+
 	node.cancel()
 	node.wg.Wait()
 
@@ -493,6 +567,16 @@ func (node *Proxy) Stop() error {
 	return nil
 }
 
+// handleMetaEvent deprecates the shard leader cache as the change pushed by querycoord.
+func (node *Proxy) handleMetaEvent(ctx context.Context, event *proxyutil.MetaEvent) {
+	switch event.Type {
+	case proxyutil.MetaEventShardLeader:
+		globalMetaCache.DeprecateShardCacheByID(event.CollectionID)
+	default:
+		log.Warn("unknown meta event", zap.String("type", event.Type))
+	}
+}
+
 // AddStartCallback adds a callback in the startServer phase.
 func (node *Proxy) AddStartCallback(callbacks ...func()) {
 	node.startCallbacks = append(node.startCallbacks, callbacks...)
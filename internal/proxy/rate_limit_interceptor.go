@@ -27,6 +27,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -74,6 +75,15 @@ func getRequestInfo(req interface{}) ([]int64, internalpb.RateType, int, error)
 	case *milvuspb.SearchRequest:
 		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
 		return []int64{collectionID}, internalpb.RateType_DQLSearch, int(r.GetNq()), nil
+	case *proxypb.BatchSearchRequest:
+		collectionIDs := make([]int64, 0, len(r.GetRequests()))
+		nq := 0
+		for _, subReq := range r.GetRequests() {
+			collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), subReq.GetDbName(), subReq.GetCollectionName())
+			collectionIDs = append(collectionIDs, collectionID)
+			nq += int(subReq.GetNq())
+		}
+		return collectionIDs, internalpb.RateType_DQLSearch, nq, nil
 	case *milvuspb.QueryRequest:
 		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
 		return []int64{collectionID}, internalpb.RateType_DQLQuery, 1, nil // think of the query request's nq as 1
@@ -145,6 +155,10 @@ func getFailedResponse(req any, err error) any {
 		return &milvuspb.SearchResults{
 			Status: merr.Status(err),
 		}
+	case *proxypb.BatchSearchRequest:
+		return &proxypb.BatchSearchResponse{
+			Status: merr.Status(err),
+		}
 	case *milvuspb.QueryRequest:
 		return &milvuspb.QueryResults{
 			Status: merr.Status(err),
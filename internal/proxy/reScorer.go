@@ -1,6 +1,7 @@
 package proxy
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -22,21 +23,40 @@ const (
 	rrfRankType                      // rrfRankType = 1
 	weightedRankType                 // weightedRankType = 2
 	udfExprRankType                  // udfExprRankType = 3
+	modelRankType                    // modelRankType = 4
 )
 
-var rankTypeMap = map[string]rankType{
-	"invalid":  invalidRankType,
-	"rrf":      rrfRankType,
-	"weighted": weightedRankType,
-	"expr":     udfExprRankType,
-}
-
 type reScorer interface {
 	name() string
 	scorerType() rankType
 	reScore(input *milvuspb.SearchResults)
 }
 
+// reRanker re-orders the fused results of the hybrid search, e.g. by an external model.
+type reRanker interface {
+	name() string
+	// requiredFields returns the output fields used to re-rank the results
+	requiredFields() []string
+	reRank(ctx context.Context, result *milvuspb.SearchResults) error
+}
+
+// rankerFactory creates the scorers of the ann searches from the rank params,
+// and optionally the re-ranker of the fused results.
+type rankerFactory func(reqs []*milvuspb.SearchRequest, params map[string]interface{}) ([]reScorer, reRanker, error)
+
+var rankerFactories = make(map[string]rankerFactory)
+
+// registerRanker makes the rank strategy available to the hybrid search.
+func registerRanker(strategy string, factory rankerFactory) {
+	rankerFactories[strategy] = factory
+}
+
+func init() {
+	registerRanker("rrf", newRRFScorers)
+	registerRanker("weighted", newWeightedScorers)
+	registerRanker("model", newModelReRanker)
+}
+
 type baseScorer struct {
 	scorerName string
 }
@@ -75,101 +95,123 @@ func (ws *weightedScorer) scorerType() rankType {
 	return weightedRankType
 }
 
-func NewReScorer(reqs []*milvuspb.SearchRequest, rankParams []*commonpb.KeyValuePair) ([]reScorer, error) {
+func newRRFScorer(k float32) *rrfScorer {
+	return &rrfScorer{
+		baseScorer: baseScorer{
+			scorerName: "rrf",
+		},
+		k: k,
+	}
+}
+
+func newRRFScorers(reqs []*milvuspb.SearchRequest, params map[string]interface{}) ([]reScorer, reRanker, error) {
+	_, ok := params[RRFParamsKey]
+	if !ok {
+		return nil, nil, errors.New(RRFParamsKey + " not found in rank_params")
+	}
+	var k float64
+	if reflect.ValueOf(params[RRFParamsKey]).CanFloat() {
+		k = reflect.ValueOf(params[RRFParamsKey]).Float()
+	} else {
+		return nil, nil, errors.New("The type of rank param k should be float")
+	}
+	if k <= 0 || k >= maxRRFParamsValue {
+		return nil, nil, errors.New(fmt.Sprintf("The rank params k should be in range (0, %d)", maxRRFParamsValue))
+	}
+	log.Debug("rrf params", zap.Float64("k", k))
 	res := make([]reScorer, len(reqs))
+	for i := range reqs {
+		res[i] = newRRFScorer(float32(k))
+	}
+	return res, nil, nil
+}
+
+func newWeightedScorers(reqs []*milvuspb.SearchRequest, params map[string]interface{}) ([]reScorer, reRanker, error) {
+	if _, ok := params[WeightsParamsKey]; !ok {
+		return nil, nil, errors.New(WeightsParamsKey + " not found in rank_params")
+	}
+	weights := make([]float32, 0)
+	switch reflect.TypeOf(params[WeightsParamsKey]).Kind() {
+	case reflect.Slice:
+		rs := reflect.ValueOf(params[WeightsParamsKey])
+		for i := 0; i < rs.Len(); i++ {
+			v := rs.Index(i).Elem()
+			if v.CanFloat() {
+				weight := v.Float()
+				if weight < 0 || weight > 1 {
+					return nil, nil, errors.New("rank param weight should be in range [0, 1]")
+				}
+				weights = append(weights, float32(weight))
+			} else {
+				return nil, nil, errors.New("The type of rank param weight should be float")
+			}
+		}
+	default:
+		return nil, nil, errors.New("The weights param should be an array")
+	}
+
+	log.Debug("weights params", zap.Any("weights", weights))
+	if len(reqs) != len(weights) {
+		return nil, nil, merr.WrapErrParameterInvalid(fmt.Sprint(len(reqs)), fmt.Sprint(len(weights)), "the length of weights param mismatch with ann search requests")
+	}
+	res := make([]reScorer, len(reqs))
+	for i := range reqs {
+		res[i] = &weightedScorer{
+			baseScorer: baseScorer{
+				scorerName: "weighted",
+			},
+			weight: weights[i],
+		}
+	}
+	return res, nil, nil
+}
+
+// NewReScorer creates the scorers of the ann searches and the optional re-ranker by the rank strategy,
+// rrf is used if the strategy not specified.
+func NewReScorer(reqs []*milvuspb.SearchRequest, rankParams []*commonpb.KeyValuePair) ([]reScorer, reRanker, error) {
 	rankTypeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RankTypeKey, rankParams)
-	if err != nil {
+	if err != nil || rankTypeStr == "" {
 		log.Info("rank strategy not specified, use rrf instead")
 		// if not set rank strategy, use rrf rank as default
+		res := make([]reScorer, len(reqs))
 		for i := range reqs {
-			res[i] = &rrfScorer{
-				baseScorer: baseScorer{
-					scorerName: "rrf",
-				},
-				k: float32(defaultRRFParamsValue),
-			}
+			res[i] = newRRFScorer(float32(defaultRRFParamsValue))
 		}
-		return res, nil
+		return res, nil, nil
 	}
 
-	if _, ok := rankTypeMap[rankTypeStr]; !ok {
-		return nil, errors.Errorf("unsupported rank type %s", rankTypeStr)
+	factory, ok := rankerFactories[rankTypeStr]
+	if !ok {
+		return nil, nil, errors.Errorf("unsupported rank type %s", rankTypeStr)
 	}
 
 	paramStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RankParamsKey, rankParams)
 	if err != nil {
-		return nil, errors.New(RankParamsKey + " not found in rank_params")
+		return nil, nil, errors.New(RankParamsKey + " not found in rank_params")
 	}
 
 	var params map[string]interface{}
 	err = json.Unmarshal([]byte(paramStr), &params)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	return factory(reqs, params)
+}
 
-	switch rankTypeMap[rankTypeStr] {
-	case rrfRankType:
-		_, ok := params[RRFParamsKey]
-		if !ok {
-			return nil, errors.New(RRFParamsKey + " not found in rank_params")
-		}
-		var k float64
-		if reflect.ValueOf(params[RRFParamsKey]).CanFloat() {
-			k = reflect.ValueOf(params[RRFParamsKey]).Float()
-		} else {
-			return nil, errors.New("The type of rank param k should be float")
-		}
-		if k <= 0 || k >= maxRRFParamsValue {
-			return nil, errors.New(fmt.Sprintf("The rank params k should be in range (0, %d)", maxRRFParamsValue))
-		}
-		log.Debug("rrf params", zap.Float64("k", k))
-		for i := range reqs {
-			res[i] = &rrfScorer{
-				baseScorer: baseScorer{
-					scorerName: "rrf",
-				},
-				k: float32(k),
-			}
-		}
-	case weightedRankType:
-		if _, ok := params[WeightsParamsKey]; !ok {
-			return nil, errors.New(WeightsParamsKey + " not found in rank_params")
-		}
-		weights := make([]float32, 0)
-		switch reflect.TypeOf(params[WeightsParamsKey]).Kind() {
-		case reflect.Slice:
-			rs := reflect.ValueOf(params[WeightsParamsKey])
-			for i := 0; i < rs.Len(); i++ {
-				v := rs.Index(i).Elem()
-				if v.CanFloat() {
-					weight := v.Float()
-					if weight < 0 || weight > 1 {
-						return nil, errors.New("rank param weight should be in range [0, 1]")
-					}
-					weights = append(weights, float32(weight))
-				} else {
-					return nil, errors.New("The type of rank param weight should be float")
-				}
-			}
-		default:
-			return nil, errors.New("The weights param should be an array")
-		}
-
-		log.Debug("weights params", zap.Any("weights", weights))
-		if len(reqs) != len(weights) {
-			return nil, merr.WrapErrParameterInvalid(fmt.Sprint(len(reqs)), fmt.Sprint(len(weights)), "the length of weights param mismatch with ann search requests")
-		}
-		for i := range reqs {
-			res[i] = &weightedScorer{
-				baseScorer: baseScorer{
-					scorerName: "weighted",
-				},
-				weight: weights[i],
-			}
+// withCollectionRankParams fills the rank strategy configured in the collection properties
+// if the request doesn't specify one.
+func withCollectionRankParams(rankParams []*commonpb.KeyValuePair, info *collectionBasicInfo) []*commonpb.KeyValuePair {
+	if strategy, _ := funcutil.GetAttrByKeyFromRepeatedKV(RankTypeKey, rankParams); strategy != "" || info.rankStrategy == "" {
+		return rankParams
+	}
+	ret := make([]*commonpb.KeyValuePair, 0, len(rankParams)+2)
+	for _, kv := range rankParams {
+		if kv.GetKey() != RankTypeKey && kv.GetKey() != RankParamsKey {
+			ret = append(ret, kv)
 		}
-	default:
-		return nil, errors.Errorf("unsupported rank type %s", rankTypeStr)
 	}
-
-	return res, nil
+	return append(ret,
+		&commonpb.KeyValuePair{Key: RankTypeKey, Value: info.rankStrategy},
+		&commonpb.KeyValuePair{Key: RankParamsKey, Value: info.rankParams},
+	)
 }
@@ -3,16 +3,20 @@ package proxy
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func TestRescorer(t *testing.T) {
 	t.Run("default scorer", func(t *testing.T) {
-		rescorers, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, nil)
+		rescorers, _, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(rescorers))
 		assert.Equal(t, rrfRankType, rescorers[0].scorerType())
@@ -27,7 +31,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		_, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+		_, _, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "k not found in rank_params")
 	})
@@ -42,7 +46,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		_, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+		_, _, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
 		assert.Error(t, err)
 
 		params[RRFParamsKey] = maxRRFParamsValue + 1
@@ -53,7 +57,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		_, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+		_, _, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
 		assert.Error(t, err)
 	})
 
@@ -67,7 +71,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		rescorers, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+		rescorers, _, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(rescorers))
 		assert.Equal(t, rrfRankType, rescorers[0].scorerType())
@@ -83,7 +87,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		_, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+		_, _, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not found in rank_params")
 	})
@@ -99,7 +103,7 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		_, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+		_, _, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "rank param weight should be in range [0, 1]")
 	})
@@ -115,10 +119,103 @@ func TestRescorer(t *testing.T) {
 			{Key: RankParamsKey, Value: string(b)},
 		}
 
-		rescorers, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+		rescorers, _, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
 		assert.NoError(t, err)
 		assert.Equal(t, 2, len(rescorers))
 		assert.Equal(t, weightedRankType, rescorers[0].scorerType())
 		assert.Equal(t, float32(weights[0]), rescorers[0].(*weightedScorer).weight)
 	})
 }
+
+func TestModelRankParams(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().ProxyCfg.ModelRankerEndpoints.Key, "http://localhost:8080/rerank, http://localhost:8081/rerank")
+	defer paramtable.Get().Reset(paramtable.Get().ProxyCfg.ModelRankerEndpoints.Key)
+	newRankParams := func(params map[string]interface{}) []*commonpb.KeyValuePair {
+		b, err := json.Marshal(params)
+		assert.NoError(t, err)
+		return []*commonpb.KeyValuePair{
+			{Key: RankTypeKey, Value: "model"},
+			{Key: RankParamsKey, Value: string(b)},
+		}
+	}
+
+	t.Run("invalid params", func(t *testing.T) {
+		_, _, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, newRankParams(map[string]interface{}{
+			ModelQueryKey: "query",
+			ModelFieldKey: "doc",
+		}))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		_, _, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, newRankParams(map[string]interface{}{
+			ModelEndpointKey: "localhost:8080",
+			ModelQueryKey:    "query",
+			ModelFieldKey:    "doc",
+		}))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		_, _, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, newRankParams(map[string]interface{}{
+			ModelEndpointKey: "http://localhost:8080/rerank",
+			ModelQueryKey:    "query",
+			ModelFieldKey:    "doc",
+			ModelTimeoutKey:  -1,
+		}))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		// the endpoints not configured are not allowed
+		_, _, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, newRankParams(map[string]interface{}{
+			ModelEndpointKey: "http://169.254.169.254/latest/meta-data",
+			ModelQueryKey:    "query",
+			ModelFieldKey:    "doc",
+		}))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("model", func(t *testing.T) {
+		rescorers, reranker, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, newRankParams(map[string]interface{}{
+			ModelEndpointKey: "http://localhost:8081/rerank",
+			ModelQueryKey:    "query",
+			ModelFieldKey:    "doc",
+			ModelTimeoutKey:  500,
+			RRFParamsKey:     10,
+		}))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(rescorers))
+		assert.Equal(t, float32(10), rescorers[0].(*rrfScorer).k)
+		assert.Equal(t, []string{"doc"}, reranker.requiredFields())
+		assert.Equal(t, 500*time.Millisecond, reranker.(*modelReRanker).timeout)
+	})
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		_, _, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, []*commonpb.KeyValuePair{
+			{Key: RankTypeKey, Value: "unknown"},
+			{Key: RankParamsKey, Value: "{}"},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestWithCollectionRankParams(t *testing.T) {
+	info := &collectionBasicInfo{rankStrategy: "weighted", rankParams: `{"weights": [0.3, 0.7]}`}
+	rankParams := withCollectionRankParams([]*commonpb.KeyValuePair{
+		{Key: LimitKey, Value: "10"},
+		{Key: RankTypeKey, Value: ""},
+		{Key: RankParamsKey, Value: "{}"},
+	}, info)
+	rescorers, _, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+	assert.NoError(t, err)
+	assert.Equal(t, weightedRankType, rescorers[0].scorerType())
+	assert.Equal(t, float32(0.7), rescorers[1].(*weightedScorer).weight)
+	limit, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, rankParams)
+	assert.NoError(t, err)
+	assert.Equal(t, "10", limit)
+
+	// the strategy of the request takes precedence
+	rankParams = withCollectionRankParams([]*commonpb.KeyValuePair{
+		{Key: RankTypeKey, Value: "rrf"},
+		{Key: RankParamsKey, Value: `{"k": 20}`},
+	}, info)
+	rescorers, _, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+	assert.NoError(t, err)
+	assert.Equal(t, rrfRankType, rescorers[0].scorerType())
+}
@@ -19,5 +19,21 @@ func createMilvusReducer(ctx context.Context, params *queryParams, req *internal
 			collectionName: collectionName,
 		}
 	}
+	if len(req.GetAggregates()) > 0 {
+		return &aggReducer{
+			params:         params,
+			req:            req,
+			schema:         schema,
+			collectionName: collectionName,
+		}
+	}
+	if req.GetMultiVectorSearch() != nil {
+		return &mvReducer{
+			params:         params,
+			req:            req,
+			schema:         schema,
+			collectionName: collectionName,
+		}
+	}
 	return newDefaultLimitReducer(ctx, params, req, schema, collectionName)
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 )
 
@@ -28,4 +29,10 @@ func Test_createMilvusReducer(t *testing.T) {
 	r = createMilvusReducer(ctx, nil, nil, nil, n, "")
 	_, ok = r.(*cntReducer)
 	assert.True(t, ok)
+
+	n.Node.(*planpb.PlanNode_Query).Query.IsCount = false
+	req := &internalpb.RetrieveRequest{Aggregates: []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Count}}}
+	r = createMilvusReducer(ctx, nil, req, nil, n, "")
+	_, ok = r.(*aggReducer)
+	assert.True(t, ok)
 }
@@ -1111,6 +1111,50 @@ func (coord *RootCoordMock) RenameCollection(ctx context.Context, req *milvuspb.
 	return &commonpb.Status{}, nil
 }
 
+func (coord *RootCoordMock) AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) AlterDatabase(ctx context.Context, req *proxypb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) UndropCollection(ctx context.Context, req *proxypb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (coord *RootCoordMock) DropCollectionAsync(ctx context.Context, req *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	return &proxypb.DDLJobResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) AlterCollectionAsync(ctx context.Context, req *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	return &proxypb.DDLJobResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) DescribeDDLJob(ctx context.Context, req *proxypb.DescribeDDLJobRequest, opts ...grpc.CallOption) (*proxypb.DescribeDDLJobResponse, error) {
+	return &proxypb.DescribeDDLJobResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) CreateAPIKey(ctx context.Context, req *proxypb.CreateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	return &proxypb.APIKeyResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) RotateAPIKey(ctx context.Context, req *proxypb.RotateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	return &proxypb.APIKeyResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) RevokeAPIKey(ctx context.Context, req *proxypb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *RootCoordMock) ListAPIKeys(ctx context.Context, req *proxypb.ListAPIKeysRequest, opts ...grpc.CallOption) (*proxypb.ListAPIKeysResponse, error) {
+	return &proxypb.ListAPIKeysResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) VerifyAPIKey(ctx context.Context, req *rootcoordpb.VerifyAPIKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.VerifyAPIKeyResponse, error) {
+	return &rootcoordpb.VerifyAPIKeyResponse{Status: merr.Success()}, nil
+}
+
 type DescribeCollectionFunc func(ctx context.Context, request *milvuspb.DescribeCollectionRequest, opts ...grpc.CallOption) (*milvuspb.DescribeCollectionResponse, error)
 
 type ShowPartitionsFunc func(ctx context.Context, request *milvuspb.ShowPartitionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowPartitionsResponse, error)
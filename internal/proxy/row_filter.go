@@ -0,0 +1,106 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// checkAccessControlProperties checks the current user is permitted to set the row filters and the field masks
+// in the collection properties, which are restricted to the admins, otherwise the restricted users could lift them.
+func checkAccessControlProperties(ctx context.Context, props []*commonpb.KeyValuePair) error {
+	for _, prop := range props {
+		if strings.HasPrefix(prop.GetKey(), common.CollectionRowFilterPrefix) ||
+			strings.HasPrefix(prop.GetKey(), common.CollectionFieldMaskPrefix) {
+			if err := checkAdmin(ctx); err != nil {
+				return errors.Wrapf(err, "failed to set property %s", prop.GetKey())
+			}
+		}
+	}
+	return nil
+}
+
+// getRowFilter returns the row filter of the current user, which permits the rows permitted by any of its roles with the filters.
+// The user is unrestricted if none of its roles has a filter.
+func getRowFilter(ctx context.Context, filters map[string]string) (string, error) {
+	if len(filters) == 0 {
+		return "", nil
+	}
+	roleNames, err := getRestrictedRoles(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	roleFilterSet := typeutil.NewSet[string]()
+	for _, roleName := range roleNames {
+		if filter, ok := filters[roleName]; ok {
+			roleFilterSet.Insert(fmt.Sprintf("(%s)", filter))
+		}
+	}
+	// keep the filter stable, so the prepared expressions are reused
+	roleFilters := roleFilterSet.Collect()
+	sort.Strings(roleFilters)
+	return strings.Join(roleFilters, " or "), nil
+}
+
+// applyRowFilter ANDs the row filter into the predicates of the plan. The filter is parsed apart from the expression of
+// the request, so that the expression could never escape from the filter by the unbalanced parentheses.
+func applyRowFilter(schema *typeutil.SchemaHelper, plan *planpb.PlanNode, rowFilter string) error {
+	if rowFilter == "" {
+		return nil
+	}
+	filter, err := planparserv2.ParseExpr(schema, rowFilter)
+	if err != nil {
+		return err
+	}
+	and := func(predicates *planpb.Expr) *planpb.Expr {
+		if predicates == nil {
+			return filter
+		}
+		return &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{
+					Op:    planpb.BinaryExpr_LogicalAnd,
+					Left:  predicates,
+					Right: filter,
+				},
+			},
+		}
+	}
+	switch node := plan.GetNode().(type) {
+	case *planpb.PlanNode_Query:
+		node.Query.Predicates = and(node.Query.GetPredicates())
+	case *planpb.PlanNode_VectorAnns:
+		node.VectorAnns.Predicates = and(node.VectorAnns.GetPredicates())
+	case *planpb.PlanNode_Predicates:
+		node.Predicates = and(node.Predicates)
+	default:
+		return fmt.Errorf("unexpected plan node %T", node)
+	}
+	return nil
+}
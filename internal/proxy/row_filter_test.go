@@ -0,0 +1,184 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestRowFilter(t *testing.T) {
+	paramtable.Init()
+	filters := map[string]string{
+		"analyst": "region == 'eu'",
+		"auditor": "year > 2020",
+		"public":  "published == true",
+	}
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "false")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+		filter, err := getRowFilter(context.Background(), filters)
+		assert.NoError(t, err)
+		assert.Empty(t, filter)
+	})
+
+	t.Run("authorization enabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		cache := NewMockCache(t)
+		cache.EXPECT().GetUserRole("alice").Return([]string{"analyst", "auditor"})
+		cache.EXPECT().GetUserRole("bob").Return(nil)
+		globalMetaCache = cache
+		defer func() { globalMetaCache = nil }()
+
+		filter, err := getRowFilter(GetContext(context.Background(), "root:123456"), filters)
+		assert.NoError(t, err)
+		assert.Empty(t, filter)
+
+		filter, err = getRowFilter(GetContext(context.Background(), "alice:123456"), filters)
+		assert.NoError(t, err)
+		assert.Equal(t, "(published == true) or (region == 'eu') or (year > 2020)", filter)
+
+		filter, err = getRowFilter(GetContext(context.Background(), "bob:123456"), map[string]string{"analyst": "region == 'eu'"})
+		assert.NoError(t, err)
+		assert.Empty(t, filter)
+
+		_, err = getRowFilter(context.Background(), filters)
+		assert.Error(t, err)
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		schema, err := typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{
+			Name: "test",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "region", DataType: schemapb.DataType_VarChar},
+				{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "8"}}},
+			},
+		})
+		require.NoError(t, err)
+		filter, err := planparserv2.ParseExpr(schema, "region == 'eu'")
+		require.NoError(t, err)
+
+		plan, err := planparserv2.CreateRetrievePlan(schema, "id > 0")
+		require.NoError(t, err)
+		expr := plan.GetQuery().GetPredicates()
+		assert.NoError(t, applyRowFilter(schema, plan, ""))
+		assert.Equal(t, expr, plan.GetQuery().GetPredicates())
+
+		assert.NoError(t, applyRowFilter(schema, plan, "region == 'eu'"))
+		and := plan.GetQuery().GetPredicates().GetBinaryExpr()
+		assert.Equal(t, planpb.BinaryExpr_LogicalAnd, and.GetOp())
+		assert.True(t, proto.Equal(expr, and.GetLeft()))
+		assert.True(t, proto.Equal(filter, and.GetRight()))
+
+		// the count plan and the search plan without the expression are filtered
+		plan, err = createCntPlan("", schema)
+		require.NoError(t, err)
+		assert.NoError(t, applyRowFilter(schema, plan, "region == 'eu'"))
+		assert.True(t, proto.Equal(filter, plan.GetQuery().GetPredicates()))
+		plan, err = planparserv2.CreateSearchPlan(schema, "", "vec", &planpb.QueryInfo{Topk: 10})
+		require.NoError(t, err)
+		assert.NoError(t, applyRowFilter(schema, plan, "region == 'eu'"))
+		assert.True(t, proto.Equal(filter, plan.GetVectorAnns().GetPredicates()))
+
+		assert.Error(t, applyRowFilter(schema, plan, "region =="))
+		assert.Error(t, applyRowFilter(schema, &planpb.PlanNode{}, "region == 'eu'"))
+	})
+
+	t.Run("injection", func(t *testing.T) {
+		schema, err := typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{
+			Name: "test",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "region", DataType: schemapb.DataType_VarChar},
+			},
+		})
+		require.NoError(t, err)
+
+		// the expression is parsed alone, so the unbalanced parentheses could not close the one around the filter
+		_, err = planparserv2.CreateRetrievePlan(schema, "id >= 0) or (id < 0")
+		assert.Error(t, err)
+
+		// the filter is always AND-ed at the root of the predicates
+		plan, err := planparserv2.CreateRetrievePlan(schema, "id >= 0 or id < 0")
+		require.NoError(t, err)
+		assert.NoError(t, applyRowFilter(schema, plan, "region == 'eu'"))
+		and := plan.GetQuery().GetPredicates().GetBinaryExpr()
+		assert.Equal(t, planpb.BinaryExpr_LogicalAnd, and.GetOp())
+		assert.Equal(t, planpb.BinaryExpr_LogicalOr, and.GetLeft().GetBinaryExpr().GetOp())
+		assert.Equal(t, int64(101), and.GetRight().GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+	})
+
+	t.Run("access control properties", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		cache := NewMockCache(t)
+		cache.EXPECT().GetUserRole("alice").Return([]string{"analyst"})
+		cache.EXPECT().GetUserRole("carol").Return([]string{util.RoleAdmin})
+		globalMetaCache = cache
+		defer func() { globalMetaCache = nil }()
+
+		alice := GetContext(context.Background(), "alice:123456")
+		for _, key := range []string{common.CollectionRowFilterPrefix + "analyst", common.CollectionFieldMaskPrefix + "analyst"} {
+			props := []*commonpb.KeyValuePair{{Key: key, Value: "region"}}
+			assert.NoError(t, checkAccessControlProperties(GetContext(context.Background(), "root:123456"), props))
+			assert.NoError(t, checkAccessControlProperties(GetContext(context.Background(), "carol:123456"), props))
+			// the restricted users could not lift the restrictions
+			assert.ErrorIs(t, checkAccessControlProperties(alice, props), merr.ErrPrivilegeNotPermitted)
+		}
+		assert.NoError(t, checkAccessControlProperties(alice, []*commonpb.KeyValuePair{{Key: common.MmapEnabledKey, Value: "true"}}))
+	})
+
+	t.Run("upsert", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		cache := NewMockCache(t)
+		cache.EXPECT().GetUserRole("alice").Return([]string{"analyst"})
+		cache.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, "test").
+			Return(newSchemaInfo(constructCollectionSchema(testInt64Field, testFloatVecField, 8, "test")), nil)
+		cache.EXPECT().GetCollectionInfo(mock.Anything, mock.Anything, "test", int64(0)).
+			Return(&collectionBasicInfo{rowFilters: filters}, nil)
+		globalMetaCache = cache
+		defer func() { globalMetaCache = nil }()
+
+		// the rows replaced by the upsert are not filtered
+		task := &upsertTask{req: &milvuspb.UpsertRequest{CollectionName: "test"}}
+		err := task.PreExecute(GetContext(context.Background(), "alice:123456"))
+		assert.ErrorIs(t, err, merr.ErrPrivilegeNotPermitted)
+	})
+}
@@ -0,0 +1,178 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// groupOrder orders the hits inside each group of search group by with a scalar field,
+// the groups themselves are still ordered by their highest scores.
+type groupOrder struct {
+	field *schemapb.FieldSchema
+	desc  bool
+	// appended is true if the field is not requested by the output fields,
+	// which is fetched for ordering only and removed from the results then
+	appended bool
+}
+
+// parseGroupOrder returns the group order requested by the search, nil if not requested.
+func parseGroupOrder(params []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema) (*groupOrder, error) {
+	orderBy, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupOrderByKey, params)
+	if err != nil || strings.TrimSpace(orderBy) == "" {
+		return nil, nil
+	}
+	groupBy, _ := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, params)
+	if groupBy == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("%s is only supported by search group by", GroupOrderByKey)
+	}
+
+	order := &groupOrder{}
+	tokens := strings.Fields(orderBy)
+	if len(tokens) > 2 {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %s, should be in format of \"<field> [asc|desc]\"", GroupOrderByKey, orderBy)
+	}
+	if len(tokens) == 2 {
+		switch strings.ToLower(tokens[1]) {
+		case "asc":
+		case "desc":
+			order.desc = true
+		default:
+			return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %s, should be in format of \"<field> [asc|desc]\"", GroupOrderByKey, orderBy)
+		}
+	}
+
+	field := typeutil.GetFieldByName(schema, tokens[0])
+	if field == nil {
+		return nil, merr.WrapErrFieldNotFound(tokens[0], "group order field not found in schema")
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_VarChar:
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported data type %s of group order field %s", field.GetDataType().String(), field.GetName())
+	}
+	order.field = field
+	return order, nil
+}
+
+// fillOutputFields adds the order field to the output fields to fetch its values.
+func (o *groupOrder) fillOutputFields(outputFields []string) []string {
+	if lo.Contains(outputFields, o.field.GetName()) {
+		return outputFields
+	}
+	o.appended = true
+	return append(outputFields, o.field.GetName())
+}
+
+// apply reorders the hits inside each group by the values of the order field,
+// the hits of the same group are placed together by the reduce.
+func (o *groupOrder) apply(result *schemapb.SearchResultData) error {
+	orderIdx := -1
+	for i, fieldData := range result.GetFieldsData() {
+		if fieldData.GetFieldId() == o.field.GetFieldID() {
+			orderIdx = i
+			break
+		}
+	}
+	if orderIdx == -1 {
+		if len(result.GetScores()) == 0 {
+			return nil
+		}
+		return merr.WrapErrServiceInternal("group order field not found in search results", o.field.GetName())
+	}
+	orderData := result.GetFieldsData()[orderIdx]
+
+	permutation := make([]int, 0, len(result.GetScores()))
+	var offset int
+	for _, topk := range result.GetTopks() {
+		end := offset + int(topk)
+		for start := offset; start < end; {
+			groupByVal := typeutil.GetData(result.GetGroupByFieldValue(), start)
+			groupEnd := start + 1
+			for groupEnd < end && typeutil.GetData(result.GetGroupByFieldValue(), groupEnd) == groupByVal {
+				groupEnd++
+			}
+			group := lo.Range(groupEnd - start)
+			for i := range group {
+				group[i] += start
+			}
+			sort.SliceStable(group, func(i, j int) bool {
+				cmp := compareGroupOrderValue(typeutil.GetData(orderData, group[i]), typeutil.GetData(orderData, group[j]))
+				if o.desc {
+					return cmp > 0
+				}
+				return cmp < 0
+			})
+			permutation = append(permutation, group...)
+			start = groupEnd
+		}
+		offset = end
+	}
+
+	ids := &schemapb.IDs{}
+	scores := make([]float32, 0, len(permutation))
+	fieldsData := typeutil.PrepareResultFieldData(result.GetFieldsData(), int64(len(permutation)))
+	for _, idx := range permutation {
+		typeutil.AppendPKs(ids, typeutil.GetPK(result.GetIds(), int64(idx)))
+		scores = append(scores, result.GetScores()[idx])
+		typeutil.AppendFieldData(fieldsData, result.GetFieldsData(), int64(idx))
+	}
+	result.Ids = ids
+	result.Scores = scores
+	result.FieldsData = fieldsData
+	if o.appended {
+		result.FieldsData = append(result.FieldsData[:orderIdx], result.FieldsData[orderIdx+1:]...)
+	}
+	return nil
+}
+
+func compareGroupOrderValue(left, right interface{}) int {
+	switch l := left.(type) {
+	case int32:
+		return compareOrdered(l, right.(int32))
+	case int64:
+		return compareOrdered(l, right.(int64))
+	case float32:
+		return compareOrdered(l, right.(float32))
+	case float64:
+		return compareOrdered(l, right.(float64))
+	case string:
+		return compareOrdered(l, right.(string))
+	default:
+		return 0
+	}
+}
+
+func compareOrdered[T int32 | int64 | float32 | float64 | string](left, right T) int {
+	if left < right {
+		return -1
+	}
+	if left > right {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,133 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func groupOrderTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "doc_id", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "chunk", DataType: schemapb.DataType_Int32},
+			{FieldID: 103, Name: "meta", DataType: schemapb.DataType_JSON},
+		},
+	}
+}
+
+func TestParseGroupOrder(t *testing.T) {
+	schema := groupOrderTestSchema()
+	params := func(orderBy string, groupBy string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{
+			{Key: GroupOrderByKey, Value: orderBy},
+			{Key: GroupByFieldKey, Value: groupBy},
+		}
+	}
+
+	order, err := parseGroupOrder(nil, schema)
+	assert.NoError(t, err)
+	assert.Nil(t, order)
+
+	order, err = parseGroupOrder(params("chunk", "doc_id"), schema)
+	require.NoError(t, err)
+	assert.Equal(t, int64(102), order.field.GetFieldID())
+	assert.False(t, order.desc)
+
+	order, err = parseGroupOrder(params(" chunk  DESC ", "doc_id"), schema)
+	require.NoError(t, err)
+	assert.True(t, order.desc)
+
+	for _, c := range []struct {
+		orderBy string
+		groupBy string
+	}{
+		{"chunk", ""},
+		{"chunk desc extra", "doc_id"},
+		{"chunk down", "doc_id"},
+		{"meta", "doc_id"},
+	} {
+		_, err = parseGroupOrder(params(c.orderBy, c.groupBy), schema)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid, c.orderBy)
+	}
+	_, err = parseGroupOrder(params("unknown", "doc_id"), schema)
+	assert.ErrorIs(t, err, merr.ErrFieldNotFound)
+
+	order, err = parseGroupOrder(params("chunk", "doc_id"), schema)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"chunk"}, order.fillOutputFields([]string{"chunk"}))
+	assert.False(t, order.appended)
+	assert.Equal(t, []string{"doc_id", "chunk"}, order.fillOutputFields([]string{"doc_id"}))
+	assert.True(t, order.appended)
+}
+
+func TestGroupOrderApply(t *testing.T) {
+	result := func() *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: 2,
+			TopK:       2,
+			Topks:      []int64{4, 2},
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4, 5, 6}}}},
+			Scores:     []float32{0.9, 0.8, 0.7, 0.6, 0.5, 0.4},
+			GroupByFieldValue: &schemapb.FieldData{
+				Type: schemapb.DataType_VarChar,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "a", "b", "b", "a", "a"}}},
+				}},
+			},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:    schemapb.DataType_Int32,
+					FieldId: 102,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{3, 1, 2, 5, 7, 4}}},
+					}},
+				},
+			},
+		}
+	}
+
+	schema := groupOrderTestSchema()
+	order := &groupOrder{field: schema.GetFields()[2]}
+	res := result()
+	require.NoError(t, order.apply(res))
+	assert.Equal(t, []int64{2, 1, 3, 4, 6, 5}, res.GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{0.8, 0.9, 0.7, 0.6, 0.4, 0.5}, res.GetScores())
+	assert.Equal(t, []int32{1, 3, 2, 5, 4, 7}, res.GetFieldsData()[0].GetScalars().GetIntData().GetData())
+
+	// the order field not requested is removed
+	order = &groupOrder{field: schema.GetFields()[2], desc: true, appended: true}
+	res = result()
+	require.NoError(t, order.apply(res))
+	assert.Equal(t, []int64{1, 2, 4, 3, 5, 6}, res.GetIds().GetIntId().GetData())
+	assert.Empty(t, res.GetFieldsData())
+
+	// empty results
+	assert.NoError(t, order.apply(&schemapb.SearchResultData{NumQueries: 1, Topks: []int64{0}}))
+
+	res = result()
+	res.FieldsData = nil
+	assert.Error(t, order.apply(res))
+}
@@ -0,0 +1,189 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const searchProfileConsistencyKey = "consistency_level"
+
+var (
+	// searchProfileIntParams are the search params of the profile which must be positive integers
+	searchProfileIntParams = map[string]struct{}{
+		"ef":           {},
+		"nprobe":       {},
+		"reorder_k":    {},
+		"search_list":  {},
+		"itopk_size":   {},
+		"search_width": {},
+	}
+	searchProfileFloatParams = map[string]struct{}{
+		"radius":            {},
+		"range_filter":      {},
+		"drop_ratio_search": {},
+	}
+)
+
+// searchProfile is a named set of search params stored in the collection properties,
+// the search request referring to it by name gets the params which are not specified by itself.
+type searchProfile struct {
+	params           map[string]interface{}
+	consistencyLevel commonpb.ConsistencyLevel
+	hasConsistency   bool
+}
+
+func parseSearchProfile(name string, value string) (*searchProfile, error) {
+	if name == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("search profile name should not be empty")
+	}
+	values := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewBufferString(value))
+	decoder.UseNumber()
+	if err := decoder.Decode(&values); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid search profile %s: %s", name, err.Error())
+	}
+
+	profile := &searchProfile{params: make(map[string]interface{})}
+	for key, v := range values {
+		if key == searchProfileConsistencyKey {
+			level, ok := v.(string)
+			if !ok {
+				return nil, merr.WrapErrParameterInvalidMsg("invalid consistency level of search profile %s: %v", name, v)
+			}
+			code, ok := commonpb.ConsistencyLevel_value[level]
+			if !ok {
+				return nil, merr.WrapErrParameterInvalidMsg("invalid consistency level of search profile %s: %s", name, level)
+			}
+			profile.consistencyLevel = commonpb.ConsistencyLevel(code)
+			profile.hasConsistency = true
+			continue
+		}
+
+		number, ok := v.(json.Number)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalidMsg("search param %s of search profile %s should be a number", key, name)
+		}
+		if _, ok := searchProfileIntParams[key]; ok {
+			i, err := number.Int64()
+			if err != nil || i <= 0 {
+				return nil, merr.WrapErrParameterInvalidMsg("search param %s of search profile %s should be a positive integer", key, name)
+			}
+			profile.params[key] = i
+		} else if _, ok := searchProfileFloatParams[key]; ok {
+			f, err := number.Float64()
+			if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+				return nil, merr.WrapErrParameterInvalidMsg("search param %s of search profile %s should be a float", key, name)
+			}
+			profile.params[key] = f
+		} else {
+			return nil, merr.WrapErrParameterInvalidMsg("unsupported search param %s of search profile %s", key, name)
+		}
+	}
+	return profile, nil
+}
+
+// validateSearchProfiles checks the search profiles in the collection properties.
+func validateSearchProfiles(props []*commonpb.KeyValuePair) error {
+	for name, value := range common.GetCollectionSearchProfiles(props...) {
+		if _, err := parseSearchProfile(name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseSearchProfiles parses the search profiles in the collection properties,
+// the invalid profiles are skipped, which may be stored before the validation introduced.
+func parseSearchProfiles(props []*commonpb.KeyValuePair) map[string]*searchProfile {
+	values := common.GetCollectionSearchProfiles(props...)
+	if len(values) == 0 {
+		return nil
+	}
+	profiles := make(map[string]*searchProfile, len(values))
+	for name, value := range values {
+		profile, err := parseSearchProfile(name, value)
+		if err != nil {
+			log.Warn("skip invalid search profile", zap.String("profile", name), zap.Error(err))
+			continue
+		}
+		profiles[name] = profile
+	}
+	return profiles
+}
+
+// applySearchProfile fills the search request with the params of the profile it refers to,
+// the params specified by the request take precedence over the profile.
+func applySearchProfile(req *milvuspb.SearchRequest, profiles map[string]*searchProfile) error {
+	name, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchProfileKey, req.GetSearchParams())
+	if err != nil || name == "" {
+		return nil
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("search profile %s not found in collection %s", name, req.GetCollectionName())
+	}
+
+	if len(profile.params) > 0 {
+		params := make(map[string]interface{})
+		index := -1
+		for i, kv := range req.GetSearchParams() {
+			if kv.GetKey() != SearchParamsKey {
+				continue
+			}
+			index = i
+			if kv.GetValue() != "" {
+				decoder := json.NewDecoder(bytes.NewBufferString(kv.GetValue()))
+				decoder.UseNumber()
+				if err := decoder.Decode(&params); err != nil {
+					return merr.WrapErrParameterInvalidMsg("invalid search params: %s", err.Error())
+				}
+			}
+		}
+		for key, value := range profile.params {
+			if _, ok := params[key]; !ok {
+				params[key] = value
+			}
+		}
+		bs, err := json.Marshal(params)
+		if err != nil {
+			return err
+		}
+		if index >= 0 {
+			req.SearchParams[index].Value = string(bs)
+		} else {
+			req.SearchParams = append(req.SearchParams, &commonpb.KeyValuePair{Key: SearchParamsKey, Value: string(bs)})
+		}
+	}
+
+	if profile.hasConsistency && req.GetUseDefaultConsistency() {
+		req.ConsistencyLevel = profile.consistencyLevel
+		req.UseDefaultConsistency = false
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestValidateSearchProfiles(t *testing.T) {
+	profile := func(name, value string) []*commonpb.KeyValuePair {
+		return []*commonpb.KeyValuePair{{Key: common.CollectionSearchProfilePrefix + name, Value: value}}
+	}
+
+	assert.NoError(t, validateSearchProfiles(nil))
+	assert.NoError(t, validateSearchProfiles(profile("fast", `{"ef": 32, "radius": 0.5, "consistency_level": "Eventually"}`)))
+
+	invalids := []string{
+		`not json`,
+		`{"ef": 0}`,
+		`{"nprobe": 1.5}`,
+		`{"radius": "0.5"}`,
+		`{"consistency_level": "Unknown"}`,
+		`{"consistency_level": 1}`,
+		`{"unknown": 1}`,
+	}
+	for _, value := range invalids {
+		err := validateSearchProfiles(profile("fast", value))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid, value)
+	}
+	assert.Error(t, validateSearchProfiles(profile("", `{"ef": 32}`)))
+
+	// the invalid profiles are skipped when parsing
+	profiles := parseSearchProfiles(append(profile("fast", `{"ef": 32}`), profile("bad", `{"ef": -1}`)...))
+	assert.Len(t, profiles, 1)
+	assert.Contains(t, profiles, "fast")
+}
+
+func TestApplySearchProfile(t *testing.T) {
+	profiles := parseSearchProfiles([]*commonpb.KeyValuePair{
+		{Key: common.CollectionSearchProfilePrefix + "fast", Value: `{"ef": 32, "reorder_k": 100, "consistency_level": "Eventually"}`},
+		{Key: common.CollectionSearchProfilePrefix + "accurate", Value: `{"ef": 256}`},
+	})
+	require.Len(t, profiles, 2)
+
+	t.Run("no profile", func(t *testing.T) {
+		req := &milvuspb.SearchRequest{SearchParams: []*commonpb.KeyValuePair{{Key: SearchParamsKey, Value: `{"ef": 10}`}}}
+		assert.NoError(t, applySearchProfile(req, profiles))
+		assert.Equal(t, `{"ef": 10}`, req.GetSearchParams()[0].GetValue())
+	})
+
+	t.Run("request params take precedence", func(t *testing.T) {
+		req := &milvuspb.SearchRequest{
+			SearchParams: []*commonpb.KeyValuePair{
+				{Key: SearchParamsKey, Value: `{"ef": 10}`},
+				{Key: SearchProfileKey, Value: "fast"},
+			},
+			UseDefaultConsistency: true,
+		}
+		assert.NoError(t, applySearchProfile(req, profiles))
+		params, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, req.GetSearchParams())
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"ef": 10, "reorder_k": 100}`, params)
+		assert.Equal(t, commonpb.ConsistencyLevel_Eventually, req.GetConsistencyLevel())
+		assert.False(t, req.GetUseDefaultConsistency())
+	})
+
+	t.Run("request consistency takes precedence", func(t *testing.T) {
+		req := &milvuspb.SearchRequest{
+			SearchParams:     []*commonpb.KeyValuePair{{Key: SearchProfileKey, Value: "fast"}},
+			ConsistencyLevel: commonpb.ConsistencyLevel_Strong,
+		}
+		assert.NoError(t, applySearchProfile(req, profiles))
+		params, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, req.GetSearchParams())
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"ef": 32, "reorder_k": 100}`, params)
+		assert.Equal(t, commonpb.ConsistencyLevel_Strong, req.GetConsistencyLevel())
+	})
+
+	t.Run("profile not found", func(t *testing.T) {
+		req := &milvuspb.SearchRequest{SearchParams: []*commonpb.KeyValuePair{{Key: SearchProfileKey, Value: "unknown"}}}
+		assert.ErrorIs(t, applySearchProfile(req, profiles), merr.ErrParameterInvalid)
+	})
+
+	t.Run("invalid request params", func(t *testing.T) {
+		req := &milvuspb.SearchRequest{SearchParams: []*commonpb.KeyValuePair{
+			{Key: SearchParamsKey, Value: `{"ef":`},
+			{Key: SearchProfileKey, Value: "accurate"},
+		}}
+		assert.ErrorIs(t, applySearchProfile(req, profiles), merr.ErrParameterInvalid)
+	})
+}
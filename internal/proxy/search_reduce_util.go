@@ -56,7 +56,8 @@ func reduceSearchResult(ctx context.Context, reduceInfo *reduceSearchResultInfo)
 			reduceInfo.topK,
 			reduceInfo.metricType,
 			reduceInfo.pkType,
-			reduceInfo.offset)
+			reduceInfo.offset,
+			reduceInfo.queryInfo.GetGroupSize())
 	}
 	return reduceSearchResultDataNoGroupBy(ctx,
 		reduceInfo.subSearchResultData,
@@ -67,7 +68,16 @@ func reduceSearchResult(ctx context.Context, reduceInfo *reduceSearchResultInfo)
 		reduceInfo.offset)
 }
 
-func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64) (*milvuspb.SearchResults, error) {
+type groupReduceInfo struct {
+	subSearchIdx int
+	resultIdx    int64
+	score        float32
+	id           interface{}
+}
+
+// reduceSearchResultDataWithGroupBy keeps topk groups of the highest scores, each of which has at most groupSize hits,
+// the hits of the same group are placed together in the results.
+func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, pkType schemapb.DataType, offset int64, groupSize int64) (*milvuspb.SearchResults, error) {
 	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
 	defer func() {
 		tr.CtxElapse(ctx, "done")
@@ -79,14 +89,18 @@ func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData
 		zap.Int64("nq", nq),
 		zap.Int64("offset", offset),
 		zap.Int64("limit", limit),
+		zap.Int64("groupSize", groupSize),
 		zap.String("metricType", metricType))
+	if groupSize <= 0 {
+		groupSize = 1
+	}
 
 	ret := &milvuspb.SearchResults{
 		Status: merr.Success(),
 		Results: &schemapb.SearchResultData{
 			NumQueries: nq,
 			TopK:       topk,
-			FieldsData: typeutil.PrepareResultFieldData(subSearchResultData[0].GetFieldsData(), limit),
+			FieldsData: typeutil.PrepareResultFieldData(subSearchResultData[0].GetFieldsData(), limit*groupSize),
 			Scores:     []float32{},
 			Ids:        &schemapb.IDs{},
 			Topks:      []int64{},
@@ -149,18 +163,20 @@ func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData
 	for i := int64(0); i < nq; i++ {
 		var (
 			// cursor of current data of each subSearch for merging the j-th data of TopK.
-			// sum(cursors) == j
 			cursors = make([]int64, subSearchNum)
 
 			j             int64
 			idSet         = make(map[interface{}]struct{})
-			groupByValSet = make(map[interface{}]struct{})
+			groupByValMap = make(map[interface{}][]*groupReduceInfo)
+			// groups in order of their highest scores
+			groupByValList = make([]interface{}, 0, topk)
+			fullGroupNum   int64
 		)
 
-		// keep limit results
-		for j = 0; j < limit; {
+		// keep topk groups, each of which has at most groupSize hits
+		for fullGroupNum < topk {
 			// From all the sub-query result sets of the i-th query vector,
-			//   find the sub-query result set index of the score j-th data,
+			//   find the sub-query result set index of the highest score data,
 			//   and the index of the data in schemapb.SearchResultData
 			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
 			if subSearchIdx == -1 {
@@ -178,25 +194,26 @@ func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData
 
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
-				_, groupByValExist := groupByValSet[groupByVal]
-				if !groupByValExist {
-					groupByValSet[groupByVal] = struct{}{}
-					if int64(len(groupByValSet)) <= offset {
-						continue
-						// skip offset groups
+				hits, groupByValExist := groupByValMap[groupByVal]
+				if (groupByValExist && int64(len(hits)) >= groupSize) ||
+					(!groupByValExist && int64(len(groupByValList)) >= topk) {
+					// skip entity of the full group or out of the topk groups
+					skipDupCnt++
+				} else {
+					if !groupByValExist {
+						groupByValList = append(groupByValList, groupByVal)
 					}
-					retSize += typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[subSearchIdx].FieldsData, resultDataIdx)
-					typeutil.AppendPKs(ret.Results.Ids, id)
-					ret.Results.Scores = append(ret.Results.Scores, score)
+					hits = append(hits, &groupReduceInfo{
+						subSearchIdx: subSearchIdx,
+						resultIdx:    resultDataIdx,
+						score:        score,
+						id:           id,
+					})
+					groupByValMap[groupByVal] = hits
 					idSet[id] = struct{}{}
-					if err := typeutil.AppendGroupByValue(ret.Results, groupByVal, subSearchRes.GetGroupByFieldValue().GetType()); err != nil {
-						log.Ctx(ctx).Error("failed to append groupByValues", zap.Error(err))
-						return ret, err
+					if int64(len(hits)) == groupSize {
+						fullGroupNum++
 					}
-					j++
-				} else {
-					// skip entity with same groupby
-					skipDupCnt++
 				}
 			} else {
 				// skip entity with same id
@@ -204,6 +221,22 @@ func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData
 			}
 			cursors[subSearchIdx]++
 		}
+
+		// skip offset groups
+		if int64(len(groupByValList)) > offset {
+			for _, groupByVal := range groupByValList[offset:] {
+				for _, hit := range groupByValMap[groupByVal] {
+					retSize += typeutil.AppendFieldData(ret.Results.FieldsData, subSearchResultData[hit.subSearchIdx].FieldsData, hit.resultIdx)
+					typeutil.AppendPKs(ret.Results.Ids, hit.id)
+					ret.Results.Scores = append(ret.Results.Scores, hit.score)
+					if err := typeutil.AppendGroupByValue(ret.Results, groupByVal, subSearchResultData[hit.subSearchIdx].GetGroupByFieldValue().GetType()); err != nil {
+						log.Ctx(ctx).Error("failed to append groupByValues", zap.Error(err))
+						return ret, err
+					}
+					j++
+				}
+			}
+		}
 		if realTopK != -1 && realTopK != j {
 			log.Ctx(ctx).Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
 			// return nil, errors.New("the length (topk) between all result of query is different")
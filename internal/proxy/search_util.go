@@ -12,7 +12,6 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
-	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/util/exprutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -41,6 +40,12 @@ func initSearchRequest(ctx context.Context, t *searchTask, isHybrid bool) error
 	}
 	t.SearchRequest.IgnoreGrowing = ignoreGrowing
 
+	priority, err := parseReadPriority(t.request.GetSearchParams())
+	if err != nil {
+		return err
+	}
+	t.SearchRequest.Priority = priority
+
 	// Manually update nq if not set.
 	nq, err := getNq(t.request)
 	if err != nil {
@@ -90,7 +95,7 @@ func initSearchRequest(ctx context.Context, t *searchTask, isHybrid bool) error
 		}
 		t.offset = offset
 
-		plan, err := planparserv2.CreateSearchPlan(t.schema.schemaHelper, t.request.Dsl, annsFieldName, queryInfo)
+		plan, err := createSearchPlan(t.schema, t.request.Dsl, annsFieldName, queryInfo, t.request.GetSearchParams())
 		if err != nil {
 			log.Warn("failed to create query plan", zap.Error(err),
 				zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
@@ -100,6 +105,12 @@ func initSearchRequest(ctx context.Context, t *searchTask, isHybrid bool) error
 		log.Debug("create query plan",
 			zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
 			zap.String("anns field", annsFieldName), zap.Any("query info", queryInfo))
+		if err := checkMaskedFields(t.schema, t.maskedFields, plan); err != nil {
+			return err
+		}
+		if err := applyRowFilter(t.schema.schemaHelper, plan, t.rowFilter); err != nil {
+			return merr.WrapErrParameterInvalidMsg("failed to apply row filter: %v", err)
+		}
 
 		if t.partitionKeyMode {
 			expr, err := exprutil.ParseExprFromPlan(plan)
@@ -133,6 +144,7 @@ func initSearchRequest(ctx context.Context, t *searchTask, isHybrid bool) error
 		plan.OutputFieldIds = outputFieldIDs
 
 		t.SearchRequest.Topk = queryInfo.GetTopk()
+		t.SearchRequest.GroupSize = queryInfo.GetGroupSize()
 		t.SearchRequest.MetricType = queryInfo.GetMetricType()
 		t.queryInfo = queryInfo
 		t.SearchRequest.DslType = commonpb.DslType_BoolExprV1
@@ -52,6 +52,7 @@ type segRequest struct {
 	partitionID UniqueID
 	segInfo     map[UniqueID]uint32
 	channelName string
+	bucketID    int64
 	timestamp   Timestamp
 }
 
@@ -65,6 +66,7 @@ type assignInfo struct {
 	collID         UniqueID
 	partitionID    UniqueID
 	channelName    string
+	bucketID       int64
 	segInfos       *list.List
 	lastInsertTime time.Time
 }
@@ -195,33 +197,34 @@ func (sa *segIDAssigner) pickCanDoFunc() {
 	if sa.ToDoReqs == nil {
 		return
 	}
-	records := make(map[UniqueID]map[UniqueID]map[string]uint32)
+	records := make(map[UniqueID]map[UniqueID]map[string]map[int64]uint32)
 	var newTodoReqs []allocator.Request
 	for _, req := range sa.ToDoReqs {
 		segRequest := req.(*segRequest)
 		collID := segRequest.collID
 		partitionID := segRequest.partitionID
 		channelName := segRequest.channelName
+		bucketID := segRequest.bucketID
 
 		if _, ok := records[collID]; !ok {
-			records[collID] = make(map[UniqueID]map[string]uint32)
+			records[collID] = make(map[UniqueID]map[string]map[int64]uint32)
 		}
 		if _, ok := records[collID][partitionID]; !ok {
-			records[collID][partitionID] = make(map[string]uint32)
+			records[collID][partitionID] = make(map[string]map[int64]uint32)
 		}
-
 		if _, ok := records[collID][partitionID][channelName]; !ok {
-			records[collID][partitionID][channelName] = 0
+			records[collID][partitionID][channelName] = make(map[int64]uint32)
 		}
 
-		records[collID][partitionID][channelName] += segRequest.count
-		assign, err := sa.getAssign(segRequest.collID, segRequest.partitionID, segRequest.channelName)
-		if err != nil || assign.Capacity(segRequest.timestamp) < records[collID][partitionID][channelName] {
+		records[collID][partitionID][channelName][bucketID] += segRequest.count
+		assign, err := sa.getAssign(segRequest.collID, segRequest.partitionID, segRequest.channelName, segRequest.bucketID)
+		if err != nil || assign.Capacity(segRequest.timestamp) < records[collID][partitionID][channelName][bucketID] {
 			sa.segReqs = append(sa.segReqs, &datapb.SegmentIDRequest{
 				ChannelName:  channelName,
 				Count:        segRequest.count,
 				CollectionID: collID,
 				PartitionID:  partitionID,
+				BucketID:     bucketID,
 			})
 			newTodoReqs = append(newTodoReqs, req)
 		} else {
@@ -234,7 +237,7 @@ func (sa *segIDAssigner) pickCanDoFunc() {
 	sa.ToDoReqs = newTodoReqs
 }
 
-func (sa *segIDAssigner) getAssign(collID UniqueID, partitionID UniqueID, channelName string) (*assignInfo, error) {
+func (sa *segIDAssigner) getAssign(collID UniqueID, partitionID UniqueID, channelName string, bucketID int64) (*assignInfo, error) {
 	assignInfos, ok := sa.assignInfos[collID]
 	if !ok {
 		return nil, fmt.Errorf("can not find collection %d", collID)
@@ -242,13 +245,13 @@ func (sa *segIDAssigner) getAssign(collID UniqueID, partitionID UniqueID, channe
 
 	for e := assignInfos.Front(); e != nil; e = e.Next() {
 		info := e.Value.(*assignInfo)
-		if info.partitionID != partitionID || info.channelName != channelName {
+		if info.partitionID != partitionID || info.channelName != channelName || info.bucketID != bucketID {
 			continue
 		}
 		return info, nil
 	}
-	return nil, fmt.Errorf("can not find assign info with collID %d, partitionID %d, channelName %s",
-		collID, partitionID, channelName)
+	return nil, fmt.Errorf("can not find assign info with collID %d, partitionID %d, channelName %s, bucketID %d",
+		collID, partitionID, channelName, bucketID)
 }
 
 func (sa *segIDAssigner) checkSyncFunc(timeout bool) bool {
@@ -264,7 +267,8 @@ func (sa *segIDAssigner) checkSegReqEqual(req1, req2 *datapb.SegmentIDRequest) b
 	if req1 == req2 {
 		return true
 	}
-	return req1.CollectionID == req2.CollectionID && req1.PartitionID == req2.PartitionID && req1.ChannelName == req2.ChannelName
+	return req1.CollectionID == req2.CollectionID && req1.PartitionID == req2.PartitionID && req1.ChannelName == req2.ChannelName &&
+		req1.BucketID == req2.BucketID
 }
 
 func (sa *segIDAssigner) reduceSegReqs() {
@@ -339,7 +343,7 @@ func (sa *segIDAssigner) syncSegments() (bool, error) {
 			success = false
 			continue
 		}
-		assign, err := sa.getAssign(segAssign.CollectionID, segAssign.PartitionID, segAssign.ChannelName)
+		assign, err := sa.getAssign(segAssign.CollectionID, segAssign.PartitionID, segAssign.ChannelName, segAssign.GetBucketID())
 		segInfo2 := &segInfo{
 			segID:      segAssign.SegID,
 			count:      segAssign.Count,
@@ -357,6 +361,7 @@ func (sa *segIDAssigner) syncSegments() (bool, error) {
 				collID:      segAssign.CollectionID,
 				partitionID: segAssign.PartitionID,
 				channelName: segAssign.ChannelName,
+				bucketID:    segAssign.GetBucketID(),
 				segInfos:    segInfos,
 			}
 			colInfos.PushBack(assign)
@@ -374,7 +379,7 @@ func (sa *segIDAssigner) syncSegments() (bool, error) {
 
 func (sa *segIDAssigner) processFunc(req allocator.Request) error {
 	segRequest := req.(*segRequest)
-	assign, err := sa.getAssign(segRequest.collID, segRequest.partitionID, segRequest.channelName)
+	assign, err := sa.getAssign(segRequest.collID, segRequest.partitionID, segRequest.channelName, segRequest.bucketID)
 	if err != nil {
 		return err
 	}
@@ -384,11 +389,17 @@ func (sa *segIDAssigner) processFunc(req allocator.Request) error {
 }
 
 func (sa *segIDAssigner) GetSegmentID(collID UniqueID, partitionID UniqueID, channelName string, count uint32, ts Timestamp) (map[UniqueID]uint32, error) {
+	return sa.GetSegmentIDInBucket(collID, partitionID, channelName, 0, count, ts)
+}
+
+// GetSegmentIDInBucket assigns the rows to the segments holding the partition key bucket only.
+func (sa *segIDAssigner) GetSegmentIDInBucket(collID UniqueID, partitionID UniqueID, channelName string, bucketID int64, count uint32, ts Timestamp) (map[UniqueID]uint32, error) {
 	req := &segRequest{
 		BaseRequest: allocator.BaseRequest{Done: make(chan error), Valid: false},
 		collID:      collID,
 		partitionID: partitionID,
 		channelName: channelName,
+		bucketID:    bucketID,
 		count:       count,
 		timestamp:   ts,
 	}
@@ -54,6 +54,7 @@ func (mockD *mockDataCoord) AssignSegmentID(ctx context.Context, req *datapb.Ass
 				CollectionID: r.CollectionID,
 				PartitionID:  r.PartitionID,
 				ExpireTime:   mockD.expireTime,
+				BucketID:     r.BucketID,
 
 				Status: merr.Success(),
 			}
@@ -305,3 +306,47 @@ func TestSegmentAllocator6(t *testing.T) {
 	wg.Wait()
 	assert.True(t, success)
 }
+
+type mockBucketDataCoord struct {
+	expireTime Timestamp
+}
+
+func (mockD *mockBucketDataCoord) AssignSegmentID(ctx context.Context, req *datapb.AssignSegmentIDRequest, opts ...grpc.CallOption) (*datapb.AssignSegmentIDResponse, error) {
+	assigns := make([]*datapb.SegmentIDAssignment, 0, len(req.SegmentIDRequests))
+	for _, r := range req.SegmentIDRequests {
+		assigns = append(assigns, &datapb.SegmentIDAssignment{
+			SegID:        100 + r.BucketID,
+			ChannelName:  r.ChannelName,
+			Count:        r.Count,
+			CollectionID: r.CollectionID,
+			PartitionID:  r.PartitionID,
+			ExpireTime:   mockD.expireTime,
+			BucketID:     r.BucketID,
+			Status:       merr.Success(),
+		})
+	}
+	return &datapb.AssignSegmentIDResponse{
+		Status:           merr.Success(),
+		SegIDAssignments: assigns,
+	}, nil
+}
+
+func TestSegmentAllocatorInBucket(t *testing.T) {
+	ctx := context.Background()
+	segAllocator, err := newSegIDAssigner(ctx, &mockBucketDataCoord{expireTime: Timestamp(1000)}, getLastTick1)
+	assert.NoError(t, err)
+	segAllocator.Start()
+	defer segAllocator.Close()
+
+	ret, err := segAllocator.GetSegmentIDInBucket(1, 1, "abc", 2, 10, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID]uint32{102: 10}, ret)
+
+	ret, err = segAllocator.GetSegmentIDInBucket(1, 1, "abc", 3, 10, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID]uint32{103: 10}, ret)
+
+	ret, err = segAllocator.GetSegmentID(1, 1, "abc", 10, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, map[UniqueID]uint32{100: 10}, ret)
+}
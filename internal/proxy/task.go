@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/multivectorutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
@@ -54,6 +55,33 @@ const (
 	RoundDecimalKey      = "round_decimal"
 	OffsetKey            = "offset"
 	LimitKey             = "limit"
+	// GroupSizeKey is the max number of hits returned for each group of search group by, 1 by default
+	GroupSizeKey = "group_size"
+	// GroupOrderByKey orders the hits inside each group by a scalar field instead of the scores,
+	// in format of "<field> [asc|desc]"
+	GroupOrderByKey = "group_order_by"
+	// ReadPriorityKey hints the scheduling lane of search/query on query node, interactive or batch
+	ReadPriorityKey = "priority"
+	// MaxStalenessKey overrides the max staleness in milliseconds of bounded consistency, graceful time by default
+	MaxStalenessKey = "max_staleness"
+	// ServedTimestampKey is the key of the timestamp served by the search/query in the extra info of status
+	ServedTimestampKey = "served_ts"
+	// PartialResultKey allows the search to return the results of the available channels if some channels failed or timed out
+	PartialResultKey = "partial_result"
+	// SearchCoverageKey is the key of the fraction of the channels searched in the extra info of status
+	SearchCoverageKey = "search_coverage"
+	// ExprParamsKey carries the json values of the placeholders in the expression template,
+	// the template is prepared once by proxy and bound to the values of each request
+	ExprParamsKey = "expr_params"
+	// DistanceHistogramKey requests the range search to return the histogram of the distances in the buckets number
+	// instead of the results, the histogram is returned by the same key in the extra info of status
+	DistanceHistogramKey = "distance_histogram"
+	// SearchProfileKey refers to the named search profile in the collection properties,
+	// whose params are used by the search without specifying them
+	SearchProfileKey = "search_profile"
+	// InSegmentFusionKey fuses the sub searches of the weighted hybrid search inside each segment on query nodes,
+	// instead of ranking the topk results of each sub search on proxy
+	InSegmentFusionKey = "in_segment_fusion"
 
 	InsertTaskName                = "InsertTask"
 	CreateCollectionTaskName      = "CreateCollectionTask"
@@ -344,6 +372,11 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 			if err = validateMaxCapacityPerRow(t.schema.Name, field); err != nil {
 				return err
 			}
+			if multivectorutil.IsMultiVectorField(field) {
+				if err := multivectorutil.CheckField(field); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -351,6 +384,16 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if err := validateSearchProfiles(t.GetProperties()); err != nil {
+		return err
+	}
+	if err := checkAccessControlProperties(ctx, t.GetProperties()); err != nil {
+		return err
+	}
+	if err := checkStorageProperties(ctx, t.GetProperties()); err != nil {
+		return err
+	}
+
 	t.CreateCollectionRequest.Schema, err = proto.Marshal(t.schema)
 	if err != nil {
 		return err
@@ -619,7 +662,7 @@ func (t *describeCollectionTask) Execute(ctx context.Context) error {
 	t.result.ShardsNum = result.ShardsNum
 	t.result.ConsistencyLevel = result.ConsistencyLevel
 	t.result.Aliases = result.Aliases
-	t.result.Properties = result.Properties
+	t.result.Properties = common.RedactProperties(result.Properties...)
 	t.result.DbName = result.GetDbName()
 	t.result.NumPartitions = result.NumPartitions
 	for _, field := range result.Schema.Fields {
@@ -878,6 +921,24 @@ func hasLazyLoadProp(props ...*commonpb.KeyValuePair) bool {
 	return false
 }
 
+func hasTieringProp(props ...*commonpb.KeyValuePair) bool {
+	for _, p := range props {
+		if p.GetKey() == common.TieringEnableKey || p.GetKey() == common.TieringIdleTimeoutKey {
+			return true
+		}
+	}
+	return false
+}
+
+func hasPartitionKeyBucketProp(props ...*commonpb.KeyValuePair) bool {
+	for _, p := range props {
+		if p.GetKey() == common.CollectionPartitionKeyBucketNumKey {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 	t.Base.MsgType = commonpb.MsgType_AlterCollection
 	t.Base.SourceID = paramtable.GetNodeID()
@@ -888,7 +949,20 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 	}
 
 	t.CollectionID = collectionID
-	if hasMmapProp(t.Properties...) || hasLazyLoadProp(t.Properties...) {
+	if err := validateSearchProfiles(t.GetProperties()); err != nil {
+		return err
+	}
+	if err := checkAccessControlProperties(ctx, t.GetProperties()); err != nil {
+		return err
+	}
+	if err := checkStorageProperties(ctx, t.GetProperties()); err != nil {
+		return err
+	}
+	// the segments written are bucketed by the bucket num, the delegator prunes them by it
+	if hasPartitionKeyBucketProp(t.Properties...) {
+		return merr.WrapErrParameterInvalidMsg("the partition key bucket num can not be altered, set it on creating the collection")
+	}
+	if hasMmapProp(t.Properties...) || hasLazyLoadProp(t.Properties...) || hasTieringProp(t.Properties...) {
 		loaded, err := isCollectionLoaded(ctx, t.queryCoord, t.CollectionID)
 		if err != nil {
 			return err
@@ -1519,11 +1593,6 @@ func (t *loadCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	// To compat with LoadCollcetion before Milvus@2.1
-	if t.ReplicaNumber == 0 {
-		t.ReplicaNumber = 1
-	}
-
 	return nil
 }
 
@@ -1544,6 +1613,14 @@ func (t *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	t.ReplicaNumber, t.ResourceGroups, err = getLoadConfig(ctx, t.GetDbName(), t.CollectionName, collID, t.ReplicaNumber, t.ResourceGroups)
+	if err != nil {
+		return err
+	}
+	// To compat with LoadCollcetion before Milvus@2.1
+	if t.ReplicaNumber == 0 {
+		t.ReplicaNumber = 1
+	}
 	// check index
 	indexResponse, err := t.datacoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
 		CollectionID: collID,
@@ -1602,6 +1679,25 @@ func (t *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	return nil
 }
 
+// getLoadConfig returns the replica number and resource groups to load the collection with,
+// the ones in the collection properties are used if not specified by the request.
+func getLoadConfig(ctx context.Context, dbName string, collectionName string, collectionID int64, replicaNumber int32, resourceGroups []string) (int32, []string, error) {
+	if replicaNumber > 0 && len(resourceGroups) > 0 {
+		return replicaNumber, resourceGroups, nil
+	}
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName, collectionID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if replicaNumber <= 0 && collInfo.replicaNumber > 0 {
+		replicaNumber = collInfo.replicaNumber
+	}
+	if len(resourceGroups) == 0 {
+		resourceGroups = collInfo.resourceGroups
+	}
+	return replicaNumber, resourceGroups, nil
+}
+
 func (t *loadCollectionTask) PostExecute(ctx context.Context) error {
 	collID, err := globalMetaCache.GetCollectionID(ctx, t.GetDbName(), t.CollectionName)
 	log.Ctx(ctx).Debug("loadCollectionTask PostExecute",
@@ -1790,6 +1886,10 @@ func (t *loadPartitionsTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	t.ReplicaNumber, t.ResourceGroups, err = getLoadConfig(ctx, t.GetDbName(), t.CollectionName, collID, t.ReplicaNumber, t.ResourceGroups)
+	if err != nil {
+		return err
+	}
 	// check index
 	indexResponse, err := t.datacoord.DescribeIndex(ctx, &indexpb.DescribeIndexRequest{
 		CollectionID: collID,
@@ -237,6 +237,7 @@ type deleteRunner struct {
 	collectionID     UniqueID
 	partitionID      UniqueID
 	partitionKeyMode bool
+	rowFilter        string
 
 	// for query
 	msgID int64
@@ -269,6 +270,16 @@ func (dr *deleteRunner) Init(ctx context.Context) error {
 		return ErrWithLog(log, "Failed to get collection schema", err)
 	}
 
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, dr.req.GetDbName(), collName, dr.collectionID)
+	if err != nil {
+		return ErrWithLog(log, "Failed to get collection info", err)
+	}
+	// only the rows permitted by the row filter are deleted
+	dr.rowFilter, err = getRowFilter(ctx, collectionInfo.rowFilters)
+	if err != nil {
+		return ErrWithLog(log, "Failed to get row filter", err)
+	}
+
 	dr.partitionKeyMode = dr.schema.IsPartitionKeyCollection()
 	// get partitionIDs of delete
 	dr.partitionID = common.AllPartitionsID
@@ -309,6 +320,9 @@ func (dr *deleteRunner) Run(ctx context.Context) error {
 	if err != nil {
 		return merr.WrapErrParameterInvalidMsg("failed to create delete plan: %v", err)
 	}
+	if err := applyRowFilter(dr.schema.schemaHelper, plan, dr.rowFilter); err != nil {
+		return merr.WrapErrParameterInvalidMsg("failed to apply row filter: %v", err)
+	}
 
 	isSimple, pk, numRow := getPrimaryKeysFromPlan(dr.schema.CollectionSchema, plan)
 	if isSimple {
@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -48,6 +50,8 @@ type hybridSearchTask struct {
 	partitionKeyMode bool
 
 	userOutputFields []string
+	// maskedFields are the fields masked for the roles of the user, which are removed from the results
+	maskedFields typeutil.UniqueSet
 
 	qc   types.QueryCoordClient
 	node types.ProxyComponent
@@ -58,6 +62,7 @@ type hybridSearchTask struct {
 	partitionIDsSet       *typeutil.ConcurrentSet[UniqueID]
 
 	reScorers       []reScorer
+	reRanker        reRanker
 	queryChannelsTs map[string]Timestamp
 	rankParams      *rankParams
 }
@@ -147,6 +152,16 @@ func (t *hybridSearchTask) PreExecute(ctx context.Context) error {
 			zap.String("collectionName", collectionName), zap.Int64("collectionID", t.CollectionID), zap.Error(err2))
 		return err2
 	}
+	t.maskedFields, err = getMaskedFields(ctx, t.schema, collectionInfo.fieldMasks)
+	if err != nil {
+		log.Warn("get masked fields failed", zap.Error(err))
+		return err
+	}
+	rowFilter, err := getRowFilter(ctx, collectionInfo.rowFilters)
+	if err != nil {
+		log.Warn("get row filter failed", zap.Error(err))
+		return err
+	}
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
 	var consistencyLevel commonpb.ConsistencyLevel
 	useDefaultConsistency := t.request.GetUseDefaultConsistency()
@@ -163,12 +178,36 @@ func (t *hybridSearchTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+	if consistencyLevel == commonpb.ConsistencyLevel_Bounded {
+		maxStaleness, err := parseMaxStaleness(t.request.GetRankParams())
+		if err != nil {
+			return err
+		}
+		// the delegator resolves the bounded staleness against its serviceable timestamp
+		guaranteeTs = t.BeginTs()
+		t.HybridSearchRequest.MaxStaleness = maxStaleness
+	}
+	t.HybridSearchRequest.ConsistencyLevel = consistencyLevel
 
-	t.reScorers, err = NewReScorer(t.request.GetRequests(), t.request.GetRankParams())
+	rankParams := withCollectionRankParams(t.request.GetRankParams(), collectionInfo)
+	t.reScorers, t.reRanker, err = NewReScorer(t.request.GetRequests(), rankParams)
 	if err != nil {
-		log.Info("generate reScorer failed", zap.Any("rank params", t.request.GetRankParams()), zap.Error(err))
+		log.Info("generate reScorer failed", zap.Any("rank params", rankParams), zap.Error(err))
 		return err
 	}
+	if t.reRanker != nil {
+		for _, field := range t.reRanker.requiredFields() {
+			if !lo.Contains(t.request.GetOutputFields(), field) {
+				return merr.WrapErrParameterInvalidMsg("the field %s used by the %s ranker should be in the output fields", field, t.reRanker.name())
+			}
+			// the results are re-ranked before masked
+			if fieldID, ok := t.schema.fieldMap.Get(field); ok {
+				if err := checkMaskedFields(t.schema, t.maskedFields, nil, fieldID); err != nil {
+					return err
+				}
+			}
+		}
+	}
 	t.HybridSearchRequest.GuaranteeTimestamp = guaranteeTs
 	t.searchTasks = make([]*searchTask, len(t.request.GetRequests()))
 	for index := range t.request.Requests {
@@ -209,6 +248,8 @@ func (t *hybridSearchTask) PreExecute(ctx context.Context) error {
 			lb:      t.lb,
 
 			partitionKeyMode: t.partitionKeyMode,
+			maskedFields:     t.maskedFields,
+			rowFilter:        rowFilter,
 			resultBuf:        typeutil.NewConcurrentSet[*internalpb.SearchResults](),
 		}
 		err := initSearchRequest(ctx, t.searchTasks[index], true)
@@ -221,6 +262,18 @@ func (t *hybridSearchTask) PreExecute(ctx context.Context) error {
 		}
 	}
 
+	fusion, err := parseInSegmentFusion(rankParams)
+	if err != nil {
+		return err
+	}
+	if fusion {
+		t.HybridSearchRequest.Fusion, err = newSearchFusion(t.searchTasks, t.reScorers, rankParams)
+		if err != nil {
+			log.Info("in-segment fusion not applicable", zap.Error(err))
+			return err
+		}
+	}
+
 	log.Debug("hybrid search preExecute done.",
 		zap.Uint64("guarantee_ts", guaranteeTs),
 		zap.Bool("use_default_consistency", t.request.GetUseDefaultConsistency()),
@@ -307,6 +360,36 @@ type rankParams struct {
 }
 
 // parseRankParams get limit and offset from rankParams, both are optional.
+// newSearchFusion checks the hybrid search could be fused inside the segments,
+// which requires the weighted ranker over the sub searches of positively related metrics.
+func newSearchFusion(searchTasks []*searchTask, reScorers []reScorer, rankParamsPair []*commonpb.KeyValuePair) (*internalpb.SearchFusion, error) {
+	weights := make([]float32, 0, len(reScorers))
+	for _, scorer := range reScorers {
+		ws, ok := scorer.(*weightedScorer)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalidMsg("%s requires the weighted ranker, got %s", InSegmentFusionKey, scorer.name())
+		}
+		weights = append(weights, ws.weight)
+	}
+	for _, task := range searchTasks {
+		metricType := task.SearchRequest.GetMetricType()
+		if metricType != "" && !metric.PositivelyRelated(metricType) {
+			return nil, merr.WrapErrParameterInvalidMsg("%s requires the larger score to be the better one, got metric type %s", InSegmentFusionKey, metricType)
+		}
+		if task.SearchRequest.GetIgnoreGrowing() != searchTasks[0].SearchRequest.GetIgnoreGrowing() {
+			return nil, merr.WrapErrParameterInvalidMsg("%s requires the same %s of the sub searches", InSegmentFusionKey, IgnoreGrowingKey)
+		}
+	}
+	params, err := parseRankParams(rankParamsPair)
+	if err != nil {
+		return nil, err
+	}
+	return &internalpb.SearchFusion{
+		Weights: weights,
+		Topk:    params.limit + params.offset,
+	}, nil
+}
+
 func parseRankParams(rankParamsPair []*commonpb.KeyValuePair) (*rankParams, error) {
 	var (
 		limit        int64
@@ -365,6 +448,9 @@ func (t *hybridSearchTask) collectHybridSearchResults(ctx context.Context) error
 		return fmt.Errorf("hybrid search task wait to finish timeout, msgID=%d", t.ID())
 	default:
 		log.Ctx(ctx).Debug("all hybrid searches are finished or canceled")
+		if t.GetFusion() != nil {
+			return t.collectFusedSearchResults(ctx)
+		}
 		t.resultBuf.Range(func(res *querypb.HybridSearchResult) bool {
 			for index, searchResult := range res.GetResults() {
 				t.searchTasks[index].resultBuf.Insert(searchResult)
@@ -388,6 +474,36 @@ func (t *hybridSearchTask) collectHybridSearchResults(ctx context.Context) error
 	}
 }
 
+// collectFusedSearchResults reduces the fused results of the shards, whose scores are weighted already.
+func (t *hybridSearchTask) collectFusedSearchResults(ctx context.Context) error {
+	results := make([]*internalpb.SearchResults, 0)
+	t.resultBuf.Range(func(res *querypb.HybridSearchResult) bool {
+		results = append(results, res.GetResults()...)
+		return true
+	})
+	resultData, err := decodeSearchResults(ctx, results)
+	if err != nil {
+		return err
+	}
+
+	t.multipleRecallResults = typeutil.NewConcurrentSet[*milvuspb.SearchResults]()
+	// no hits at all, nothing to rank
+	if len(resultData) == 0 {
+		return nil
+	}
+	primaryFieldSchema, err := t.schema.GetPkField()
+	if err != nil {
+		return err
+	}
+	result, err := reduceSearchResult(ctx, NewReduceSearchResultInfo(resultData, 1, t.GetFusion().GetTopk(), metric.IP,
+		primaryFieldSchema.GetDataType(), 0, &planpb.QueryInfo{GroupByFieldId: -1}))
+	if err != nil {
+		return err
+	}
+	t.multipleRecallResults.Insert(result)
+	return nil
+}
+
 func (t *hybridSearchTask) PostExecute(ctx context.Context) error {
 	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-HybridSearch-PostExecute")
 	defer sp.End()
@@ -444,7 +560,14 @@ func (t *hybridSearchTask) PostExecute(ctx context.Context) error {
 			return err
 		}
 	}
+	if t.reRanker != nil {
+		if err := t.reRanker.reRank(ctx, t.result); err != nil {
+			return err
+		}
+	}
 	t.result.Results.OutputFields = t.userOutputFields
+	t.result.Results.FieldsData, t.result.Results.OutputFields = maskFields(t.schema, t.maskedFields,
+		t.result.Results.FieldsData, t.result.Results.OutputFields)
 
 	log.Debug("hybrid search post execute done")
 	return nil
@@ -14,6 +14,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -23,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -361,3 +363,88 @@ func TestHybridSearchTask_PostExecute(t *testing.T) {
 		assert.Equal(t, qt.result.GetStatus().GetErrorCode(), commonpb.ErrorCode_Success)
 	})
 }
+
+func TestHybridSearchTask_Fusion(t *testing.T) {
+	rankParams := []*commonpb.KeyValuePair{
+		{Key: LimitKey, Value: "3"},
+		{Key: OffsetKey, Value: "1"},
+	}
+	newTask := func(metricType string, ignoreGrowing bool) *searchTask {
+		return &searchTask{SearchRequest: &internalpb.SearchRequest{MetricType: metricType, IgnoreGrowing: ignoreGrowing}}
+	}
+	weighted := []reScorer{
+		&weightedScorer{baseScorer: baseScorer{scorerName: "weighted"}, weight: 0.7},
+		&weightedScorer{baseScorer: baseScorer{scorerName: "weighted"}, weight: 0.3},
+	}
+
+	t.Run("new fusion", func(t *testing.T) {
+		fusion, err := newSearchFusion([]*searchTask{newTask(metric.IP, false), newTask("", false)}, weighted, rankParams)
+		assert.NoError(t, err)
+		assert.Equal(t, []float32{0.7, 0.3}, fusion.GetWeights())
+		assert.Equal(t, int64(4), fusion.GetTopk())
+
+		_, err = newSearchFusion([]*searchTask{newTask(metric.IP, false)}, []reScorer{newRRFScorer(60)}, rankParams)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		_, err = newSearchFusion([]*searchTask{newTask(metric.IP, false), newTask(metric.L2, false)}, weighted, rankParams)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		_, err = newSearchFusion([]*searchTask{newTask(metric.IP, false), newTask(metric.IP, true)}, weighted, rankParams)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		_, err = newSearchFusion([]*searchTask{newTask(metric.IP, false), newTask(metric.IP, false)}, weighted, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("collect fused results", func(t *testing.T) {
+		schema := newSchemaInfo(&schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			},
+		})
+		shardResult := func(ids []int64, scores []float32) *querypb.HybridSearchResult {
+			result, err := proto.Marshal(&schemapb.SearchResultData{
+				NumQueries: 1,
+				TopK:       4,
+				Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+				Scores:     scores,
+				Topks:      []int64{int64(len(ids))},
+			})
+			require.NoError(t, err)
+			return &querypb.HybridSearchResult{
+				Status:  merr.Success(),
+				Results: []*internalpb.SearchResults{{MetricType: metric.IP, SlicedBlob: result}},
+			}
+		}
+
+		qt := &hybridSearchTask{
+			ctx:    context.Background(),
+			schema: schema,
+			HybridSearchRequest: &internalpb.HybridSearchRequest{
+				Base:   commonpbutil.NewMsgBase(),
+				Fusion: &internalpb.SearchFusion{Weights: []float32{0.7, 0.3}, Topk: 4},
+			},
+			request: &milvuspb.HybridSearchRequest{
+				RankParams: rankParams,
+			},
+			resultBuf: typeutil.NewConcurrentSet[*querypb.HybridSearchResult](),
+		}
+		qt.resultBuf.Insert(shardResult([]int64{1, 3}, []float32{0.9, 0.5}))
+		qt.resultBuf.Insert(shardResult([]int64{2, 4}, []float32{0.8, 0.1}))
+
+		err := qt.PostExecute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 3, 4}, qt.result.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{0.8, 0.5, 0.1}, qt.result.GetResults().GetScores())
+
+		// no hits of any shard
+		qt.resultBuf = typeutil.NewConcurrentSet[*querypb.HybridSearchResult]()
+		qt.resultBuf.Insert(&querypb.HybridSearchResult{
+			Status:  merr.Success(),
+			Results: []*internalpb.SearchResults{{MetricType: metric.IP}},
+		})
+		err = qt.PostExecute(context.Background())
+		assert.NoError(t, err)
+		assert.Empty(t, qt.result.GetResults().GetScores())
+	})
+}
@@ -477,9 +477,14 @@ func (t *alterIndexTask) PreExecute(ctx context.Context) error {
 	t.req.Base.MsgType = commonpb.MsgType_AlterIndex
 	t.req.Base.SourceID = paramtable.GetNodeID()
 
-	for _, param := range t.req.GetExtraParams() {
-		if !indexparams.IsConfigableIndexParam(param.GetKey()) {
-			return merr.WrapErrParameterInvalidMsg("%s is not configable index param", param.GetKey())
+	// altering the index type rebuilds the index in background, the build params are
+	// validated by DataCoord, and the loaded segments switch to the rebuilt index online.
+	_, reindex := funcutil.KeyValuePair2Map(t.req.GetExtraParams())[common.IndexTypeKey]
+	if !reindex {
+		for _, param := range t.req.GetExtraParams() {
+			if !indexparams.IsConfigableIndexParam(param.GetKey()) {
+				return merr.WrapErrParameterInvalidMsg("%s is not configable index param", param.GetKey())
+			}
 		}
 	}
 
@@ -499,6 +504,9 @@ func (t *alterIndexTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if reindex {
+		return nil
+	}
 	loaded, err := isCollectionLoaded(ctx, t.querycoord, collection)
 	if err != nil {
 		return err
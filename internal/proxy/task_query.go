@@ -19,6 +19,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/aggregateutil"
 	"github.com/milvus-io/milvus/internal/util/exprutil"
 	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -57,6 +58,8 @@ type queryTask struct {
 	dimension      int64
 
 	userOutputFields []string
+	// maskedFields are the fields masked for the roles of the user, which are removed from the results
+	maskedFields typeutil.UniqueSet
 
 	resultBuf *typeutil.ConcurrentSet[*internalpb.RetrieveResults]
 
@@ -65,9 +68,14 @@ type queryTask struct {
 	lb               LBPolicy
 	channelsMvcc     map[string]Timestamp
 	fastSkip         bool
+	// queryChannelsTs records the mvcc timestamps used by the query nodes
+	queryChannelsTs map[string]Timestamp
 
 	reQuery     bool
 	allQueryCnt int64
+
+	// multiVectorSearch is set if the task serves the search on the multi-vector field
+	multiVectorSearch *internalpb.MultiVectorSearch
 }
 
 type queryParams struct {
@@ -185,6 +193,67 @@ func matchCountRule(outputs []string) bool {
 	return len(outputs) == 1 && strings.ToLower(strings.TrimSpace(outputs[0])) == "count(*)"
 }
 
+// parseAggregates parses the aggregates such as "sum(price)" in the output fields,
+// the other output fields must be the group by field, which are returned in the names.
+func parseAggregates(outputs []string, queryParams []*commonpb.KeyValuePair, schema *schemaInfo) ([]*internalpb.Aggregate, *schemapb.FieldSchema, []string, error) {
+	groupByName, _ := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, queryParams)
+	aggregates := make([]*internalpb.Aggregate, 0)
+	names := make([]string, 0, len(outputs))
+	others := make([]string, 0)
+	for _, output := range outputs {
+		op, fieldName, ok, err := aggregateutil.ParseAggregate(output)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if !ok {
+			others = append(others, strings.TrimSpace(output))
+			continue
+		}
+		name := aggregateutil.AggregateName(op, fieldName)
+		if lo.Contains(names, name) {
+			continue
+		}
+		aggregate := &internalpb.Aggregate{Op: op}
+		if op != internalpb.AggregateOp_Count {
+			field, err := schema.schemaHelper.GetFieldFromName(fieldName)
+			if err != nil {
+				return nil, nil, nil, merr.WrapErrFieldNotFound(fieldName)
+			}
+			if err := aggregateutil.CheckAggregatedField(field); err != nil {
+				return nil, nil, nil, err
+			}
+			aggregate.FieldID = field.GetFieldID()
+		}
+		aggregates = append(aggregates, aggregate)
+		names = append(names, name)
+	}
+	if len(aggregates) == 0 {
+		if groupByName != "" {
+			return nil, nil, nil, merr.WrapErrParameterInvalidMsg("group by field %s should be used with aggregates", groupByName)
+		}
+		return nil, nil, nil, nil
+	}
+
+	var groupByField *schemapb.FieldSchema
+	if groupByName != "" {
+		field, err := schema.schemaHelper.GetFieldFromName(groupByName)
+		if err != nil {
+			return nil, nil, nil, merr.WrapErrFieldNotFound(groupByName)
+		}
+		if err := aggregateutil.CheckGroupByField(field); err != nil {
+			return nil, nil, nil, err
+		}
+		groupByField = field
+		names = append([]string{groupByName}, names...)
+	}
+	for _, other := range others {
+		if other != groupByName {
+			return nil, nil, nil, merr.WrapErrParameterInvalidMsg("output field %s should be aggregated or grouped by", other)
+		}
+	}
+	return aggregates, groupByField, names, nil
+}
+
 func createCntPlan(expr string, schemaHelper *typeutil.SchemaHelper) (*planpb.PlanNode, error) {
 	if expr == "" {
 		return &planpb.PlanNode{
@@ -210,17 +279,35 @@ func createCntPlan(expr string, schemaHelper *typeutil.SchemaHelper) (*planpb.Pl
 func (t *queryTask) createPlan(ctx context.Context) error {
 	schema := t.schema
 
+	if t.multiVectorSearch != nil {
+		return t.createMultiVectorSearchPlan()
+	}
+
+	aggregates, groupByField, names, err := parseAggregates(t.request.GetOutputFields(), t.request.GetQueryParams(), schema)
+	if err != nil {
+		return err
+	}
 	cntMatch := matchCountRule(t.request.GetOutputFields())
-	if cntMatch {
-		var err error
-		t.plan, err = createCntPlan(t.request.GetExpr(), schema.schemaHelper)
+	if cntMatch && groupByField == nil {
+		if hasExprParams(t.request.GetQueryParams()) && t.request.GetExpr() != "" {
+			t.plan, err = createRetrievePlan(schema, t.request.GetExpr(), t.request.GetQueryParams())
+			if err != nil {
+				return merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err)
+			}
+			t.plan.GetQuery().IsCount = true
+		} else {
+			t.plan, err = createCntPlan(t.request.GetExpr(), schema.schemaHelper)
+		}
 		t.userOutputFields = []string{"count(*)"}
 		return err
 	}
 
-	var err error
+	if len(aggregates) > 0 {
+		return t.createAggregatePlan(aggregates, groupByField, names)
+	}
+
 	if t.plan == nil {
-		t.plan, err = planparserv2.CreateRetrievePlan(schema.schemaHelper, t.request.Expr)
+		t.plan, err = createRetrievePlan(schema, t.request.Expr, t.request.GetQueryParams())
 		if err != nil {
 			return merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err)
 		}
@@ -245,6 +332,67 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 	return nil
 }
 
+// createAggregatePlan creates the plan retrieving the grouped and aggregated fields,
+// which are aggregated by query nodes, so the groups rather than the rows are limited.
+func (t *queryTask) createAggregatePlan(aggregates []*internalpb.Aggregate, groupByField *schemapb.FieldSchema, names []string) error {
+	var err error
+	t.plan, err = createRetrievePlan(t.schema, t.request.GetExpr(), t.request.GetQueryParams())
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err)
+	}
+
+	outputFieldIDs := make([]int64, 0, len(aggregates)+1)
+	if groupByField != nil {
+		outputFieldIDs = append(outputFieldIDs, groupByField.GetFieldID())
+		t.RetrieveRequest.GroupByFieldID = groupByField.GetFieldID()
+	}
+	for _, aggregate := range aggregates {
+		if aggregate.GetFieldID() != 0 && !lo.Contains(outputFieldIDs, aggregate.GetFieldID()) {
+			outputFieldIDs = append(outputFieldIDs, aggregate.GetFieldID())
+		}
+	}
+	t.RetrieveRequest.Aggregates = aggregates
+	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
+	t.RetrieveRequest.Limit = typeutil.Unlimited
+	t.plan.OutputFieldIds = outputFieldIDs
+	t.userOutputFields = names
+	return nil
+}
+
+// createMultiVectorSearchPlan creates the plan retrieving the filtered rows with the multi-vector field,
+// which are scored by query nodes, so the topk rows rather than the filtered rows are limited.
+func (t *queryTask) createMultiVectorSearchPlan() error {
+	var err error
+	t.plan, err = createRetrievePlan(t.schema, t.request.GetExpr(), t.request.GetQueryParams())
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err)
+	}
+
+	t.request.OutputFields, t.userOutputFields, err = translateOutputFields(t.request.GetOutputFields(), t.schema, false)
+	if err != nil {
+		return err
+	}
+	pkField, err := t.schema.schemaHelper.GetPrimaryKeyField()
+	if err != nil {
+		return err
+	}
+	outputFieldIDs := []int64{pkField.GetFieldID(), t.multiVectorSearch.GetFieldID()}
+	for _, name := range t.request.GetOutputFields() {
+		field := typeutil.GetFieldByName(t.schema.CollectionSchema, name)
+		if field == nil {
+			return merr.WrapErrFieldNotFound(name)
+		}
+		if !lo.Contains(outputFieldIDs, field.GetFieldID()) {
+			outputFieldIDs = append(outputFieldIDs, field.GetFieldID())
+		}
+	}
+	t.RetrieveRequest.MultiVectorSearch = t.multiVectorSearch
+	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
+	t.RetrieveRequest.Limit = typeutil.Unlimited
+	t.plan.OutputFieldIds = outputFieldIDs
+	return nil
+}
+
 func (t *queryTask) CanSkipAllocTimestamp() bool {
 	var consistencyLevel commonpb.ConsistencyLevel
 	useDefaultConsistency := t.request.GetUseDefaultConsistency()
@@ -326,6 +474,12 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 	t.RetrieveRequest.IgnoreGrowing = ignoreGrowing
 
+	priority, err := parseReadPriority(t.request.GetQueryParams())
+	if err != nil {
+		return err
+	}
+	t.RetrieveRequest.Priority = priority
+
 	queryParams, err := parseQueryParams(t.request.GetQueryParams())
 	if err != nil {
 		return err
@@ -357,12 +511,48 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		t.request.Expr = IDs2Expr(pkField, t.ids)
 	}
 
+	collectionInfo, err2 := globalMetaCache.GetCollectionInfo(ctx, t.request.GetDbName(), collectionName, t.CollectionID)
+	if err2 != nil {
+		log.Warn("Proxy::queryTask::PreExecute failed to GetCollectionInfo from cache",
+			zap.String("collectionName", collectionName), zap.Int64("collectionID", t.CollectionID),
+			zap.Error(err2))
+		return err2
+	}
+
+	// the results of the requery are masked by the search
+	if !t.reQuery {
+		t.maskedFields, err = getMaskedFields(ctx, t.schema, collectionInfo.fieldMasks)
+		if err != nil {
+			log.Warn("get masked fields failed", zap.Error(err))
+			return err
+		}
+	}
+
 	if err := t.createPlan(ctx); err != nil {
 		return err
 	}
+	aggregatedFieldIDs := lo.Map(t.RetrieveRequest.GetAggregates(), func(aggregate *internalpb.Aggregate, _ int) int64 {
+		return aggregate.GetFieldID()
+	})
+	if err := checkMaskedFields(t.schema, t.maskedFields, t.plan, append(aggregatedFieldIDs, t.RetrieveRequest.GetGroupByFieldID())...); err != nil {
+		return err
+	}
+	// the results of the requery are filtered by the search
+	if !t.reQuery {
+		rowFilter, err := getRowFilter(ctx, collectionInfo.rowFilters)
+		if err != nil {
+			log.Warn("get row filter failed", zap.Error(err))
+			return err
+		}
+		if err := applyRowFilter(schema.schemaHelper, t.plan, rowFilter); err != nil {
+			return merr.WrapErrParameterInvalidMsg("failed to apply row filter: %v", err)
+		}
+	}
 	t.plan.Node.(*planpb.PlanNode_Query).Query.Limit = t.RetrieveRequest.Limit
 
-	if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited {
+	// the aggregation is limited by the groups and the multi-vector search by the topk rather than the rows
+	if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited &&
+		len(t.RetrieveRequest.GetAggregates()) == 0 && t.RetrieveRequest.GetMultiVectorSearch() == nil {
 		return fmt.Errorf("empty expression should be used with limit")
 	}
 
@@ -404,14 +594,6 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		t.RetrieveRequest.Username = username
 	}
 
-	collectionInfo, err2 := globalMetaCache.GetCollectionInfo(ctx, t.request.GetDbName(), collectionName, t.CollectionID)
-	if err2 != nil {
-		log.Warn("Proxy::queryTask::PreExecute failed to GetCollectionInfo from cache",
-			zap.String("collectionName", collectionName), zap.Int64("collectionID", t.CollectionID),
-			zap.Error(err2))
-		return err2
-	}
-
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
 	var consistencyLevel commonpb.ConsistencyLevel
 	useDefaultConsistency := t.request.GetUseDefaultConsistency()
@@ -428,6 +610,16 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+	if consistencyLevel == commonpb.ConsistencyLevel_Bounded {
+		maxStaleness, err := parseMaxStaleness(t.request.GetQueryParams())
+		if err != nil {
+			return err
+		}
+		// the delegator resolves the bounded staleness against its serviceable timestamp
+		guaranteeTs = t.BeginTs()
+		t.RetrieveRequest.MaxStaleness = maxStaleness
+	}
+	t.RetrieveRequest.ConsistencyLevel = consistencyLevel
 	t.GuaranteeTimestamp = guaranteeTs
 
 	deadline, ok := t.TraceCtx().Deadline()
@@ -487,9 +679,13 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		return nil
 	default:
 		log.Debug("all queries are finished or canceled")
+		t.queryChannelsTs = make(map[string]Timestamp)
 		t.resultBuf.Range(func(res *internalpb.RetrieveResults) bool {
 			toReduceResults = append(toReduceResults, res)
 			t.allQueryCnt += res.GetAllRetrieveCount()
+			for ch, ts := range res.GetChannelsMvcc() {
+				t.queryChannelsTs[ch] = ts
+			}
 			log.Debug("proxy receives one query result", zap.Int64("sourceID", res.GetBase().GetSourceID()))
 			return true
 		})
@@ -506,6 +702,7 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 	t.result.OutputFields = t.userOutputFields
+	t.result.FieldsData, t.result.OutputFields = maskFields(t.schema, t.maskedFields, t.result.FieldsData, t.result.OutputFields)
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
 	log.Debug("Query PostExecute done")
@@ -900,6 +900,72 @@ func Test_queryTask_createPlan(t *testing.T) {
 		err := tsk.createPlan(context.TODO())
 		assert.Error(t, err)
 	})
+
+	t.Run("aggregates", func(t *testing.T) {
+		schema := newSchemaInfo(collSchema)
+		tsk := &queryTask{
+			schema:          schema,
+			RetrieveRequest: &internalpb.RetrieveRequest{Limit: 10},
+			request: &milvuspb.QueryRequest{
+				OutputFields: []string{"VarCharField", "count(*)", "SUM(Int64Field)", "avg(DoubleField)", "sum(Int64Field)"},
+				Expr:         "Int64Field > 2",
+				QueryParams:  []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "VarCharField"}},
+			},
+		}
+		err := tsk.createPlan(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"VarCharField", "count(*)", "sum(Int64Field)", "avg(DoubleField)"}, tsk.userOutputFields)
+		assert.Equal(t, int64(121), tsk.RetrieveRequest.GetGroupByFieldID())
+		assert.Len(t, tsk.RetrieveRequest.GetAggregates(), 3)
+		assert.Equal(t, []int64{121, 105, 111}, tsk.RetrieveRequest.GetOutputFieldsId())
+		assert.Equal(t, typeutil.Unlimited, tsk.RetrieveRequest.GetLimit())
+		assert.False(t, tsk.plan.GetQuery().GetIsCount())
+
+		// count(*) grouped by the field is aggregated rather than counted
+		tsk = &queryTask{
+			schema:          schema,
+			RetrieveRequest: &internalpb.RetrieveRequest{},
+			request: &milvuspb.QueryRequest{
+				OutputFields: []string{"count(*)"},
+				QueryParams:  []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "Int64Field"}},
+			},
+		}
+		err = tsk.createPlan(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Int64Field", "count(*)"}, tsk.userOutputFields)
+		assert.False(t, tsk.plan.GetQuery().GetIsCount())
+	})
+
+	t.Run("invalid aggregates", func(t *testing.T) {
+		schema := newSchemaInfo(collSchema)
+		cases := []struct {
+			outputs []string
+			groupBy string
+		}{
+			{outputs: []string{"count(Int64Field)"}},
+			{outputs: []string{"sum(VarCharField)"}},
+			{outputs: []string{"sum(unknown)"}},
+			{outputs: []string{"Int64Field", "sum(DoubleField)"}},
+			{outputs: []string{"Int64Field", "sum(DoubleField)"}, groupBy: "VarCharField"},
+			{outputs: []string{"sum(DoubleField)"}, groupBy: "FloatVectorField"},
+			{outputs: []string{"Int64Field"}, groupBy: "Int64Field"},
+		}
+		for _, c := range cases {
+			tsk := &queryTask{
+				schema:          schema,
+				RetrieveRequest: &internalpb.RetrieveRequest{},
+				request: &milvuspb.QueryRequest{
+					OutputFields: c.outputs,
+					Expr:         "Int64Field > 2",
+				},
+			}
+			if c.groupBy != "" {
+				tsk.request.QueryParams = []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: c.groupBy}}
+			}
+			err := tsk.createPlan(context.TODO())
+			assert.Error(t, err, c.outputs)
+		}
+	})
 }
 
 func TestQueryTask_IDs2Expr(t *testing.T) {
@@ -398,6 +398,17 @@ type taskScheduler struct {
 
 type schedOpt func(*taskScheduler)
 
+// withBatchedTsoAllocator sets the timestamp allocator of the ddl, dml and data coord tasks, which tolerate the batched timestamps.
+// The query tasks allocating the timestamps are the strong reads, which always allocate by the allocator of scheduler,
+// the non-strong reads take the local time without allocating.
+func withBatchedTsoAllocator(tsoAllocatorIns tsoAllocator) schedOpt {
+	return func(s *taskScheduler) {
+		s.ddQueue.tsoAllocatorIns = tsoAllocatorIns
+		s.dmQueue.tsoAllocatorIns = tsoAllocatorIns
+		s.dcQueue.tsoAllocatorIns = tsoAllocatorIns
+	}
+}
+
 func newTaskScheduler(ctx context.Context,
 	tsoAllocatorIns tsoAllocator,
 	factory msgstream.Factory,
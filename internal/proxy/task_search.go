@@ -64,6 +64,10 @@ type searchTask struct {
 	enableMaterializedView bool
 
 	userOutputFields []string
+	// maskedFields are the fields masked for the roles of the user, which are removed from the results
+	maskedFields typeutil.UniqueSet
+	// rowFilter is the row filter of the roles of the user, which is AND-ed into the plan
+	rowFilter string
 
 	offset    int64
 	dimension int64
@@ -74,6 +78,14 @@ type searchTask struct {
 	lb              LBPolicy
 	queryChannelsTs map[string]Timestamp
 	queryInfo       *planpb.QueryInfo
+	// channelsMvcc specifies the mvcc timestamp of each channel to search on
+	channelsMvcc map[string]Timestamp
+	// partial records the skipped channels if the partial result is allowed
+	partial *partialResult
+	// histogram is returned instead of the results if requested by the range search
+	histogram *distanceHistogram
+	// groupOrder orders the hits inside each group of search group by
+	groupOrder *groupOrder
 }
 
 func getPartitionIDs(ctx context.Context, dbName string, collectionName string, partitionNames []string) (partitionIDs []UniqueID, err error) {
@@ -209,7 +221,23 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair, schema *schemapb
 		}
 	}
 
-	// 6. parse iterator tag, prevent trying to groupBy when doing iteration or doing range-search
+	// 6. parse group size, the max number of hits returned for each group
+	var groupSize int64 = 1
+	groupSizeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupSizeKey, searchParamsPair)
+	if err == nil {
+		if groupByFieldId == -1 {
+			return nil, 0, merr.WrapErrParameterInvalidMsg("%s is only supported by search group by", GroupSizeKey)
+		}
+		groupSize, err = strconv.ParseInt(groupSizeStr, 0, 64)
+		if err != nil || groupSize <= 0 {
+			return nil, 0, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid, should be a positive integer", GroupSizeKey, groupSizeStr)
+		}
+		if err := validateTopKLimit(queryTopK * groupSize); err != nil {
+			return nil, 0, fmt.Errorf("(%s+%s)*%s [%d] is invalid, %w", OffsetKey, TopKKey, GroupSizeKey, queryTopK*groupSize, err)
+		}
+	}
+
+	// 7. parse iterator tag, prevent trying to groupBy when doing iteration or doing range-search
 	isIterator, _ := funcutil.GetAttrByKeyFromRepeatedKV(IteratorField, searchParamsPair)
 	if isIterator == "True" && groupByFieldId > 0 {
 		return nil, 0, merr.WrapErrParameterInvalid("", "",
@@ -226,6 +254,7 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair, schema *schemapb
 		SearchParams:   searchParamStr,
 		RoundDecimal:   roundDecimal,
 		GroupByFieldId: groupByFieldId,
+		GroupSize:      groupSize,
 	}, offset, nil
 }
 
@@ -337,18 +366,47 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	log.Debug("translate output fields",
 		zap.Strings("output fields", t.request.GetOutputFields()))
 
-	err = initSearchRequest(ctx, t, false)
-	if err != nil {
-		log.Debug("init search request failed", zap.Error(err))
-		return err
-	}
-
 	collectionInfo, err2 := globalMetaCache.GetCollectionInfo(ctx, t.request.GetDbName(), collectionName, t.CollectionID)
 	if err2 != nil {
 		log.Warn("Proxy::searchTask::PreExecute failed to GetCollectionInfo from cache",
 			zap.String("collectionName", collectionName), zap.Int64("collectionID", t.CollectionID), zap.Error(err2))
 		return err2
 	}
+
+	t.maskedFields, err = getMaskedFields(ctx, t.schema, collectionInfo.fieldMasks)
+	if err != nil {
+		log.Warn("get masked fields failed", zap.Error(err))
+		return err
+	}
+
+	if err := applySearchProfile(t.request, collectionInfo.searchProfiles); err != nil {
+		log.Warn("apply search profile failed", zap.Error(err))
+		return err
+	}
+
+	t.rowFilter, err = getRowFilter(ctx, collectionInfo.rowFilters)
+	if err != nil {
+		log.Warn("get row filter failed", zap.Error(err))
+		return err
+	}
+
+	t.groupOrder, err = parseGroupOrder(t.request.GetSearchParams(), t.schema.CollectionSchema)
+	if err != nil {
+		log.Warn("parse group order failed", zap.Error(err))
+		return err
+	}
+	if t.groupOrder != nil {
+		if err := checkMaskedFields(t.schema, t.maskedFields, nil, t.groupOrder.field.GetFieldID()); err != nil {
+			return err
+		}
+		t.request.OutputFields = t.groupOrder.fillOutputFields(t.request.GetOutputFields())
+	}
+
+	err = initSearchRequest(ctx, t, false)
+	if err != nil {
+		log.Debug("init search request failed", zap.Error(err))
+		return err
+	}
 	guaranteeTs := t.request.GetGuaranteeTimestamp()
 	var consistencyLevel commonpb.ConsistencyLevel
 	useDefaultConsistency := t.request.GetUseDefaultConsistency()
@@ -365,8 +423,34 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+	if consistencyLevel == commonpb.ConsistencyLevel_Bounded {
+		maxStaleness, err := parseMaxStaleness(t.request.GetSearchParams())
+		if err != nil {
+			return err
+		}
+		// the delegator resolves the bounded staleness against its serviceable timestamp
+		guaranteeTs = t.BeginTs()
+		t.SearchRequest.MaxStaleness = maxStaleness
+	}
+	t.SearchRequest.ConsistencyLevel = consistencyLevel
 	t.SearchRequest.GuaranteeTimestamp = guaranteeTs
 
+	allowPartial, err := parsePartialResult(t.request.GetSearchParams())
+	if err != nil {
+		return err
+	}
+	if allowPartial {
+		t.partial = &partialResult{}
+	}
+
+	t.histogram, err = parseDistanceHistogram(t.request.GetSearchParams())
+	if err != nil {
+		return err
+	}
+	if t.histogram != nil && len(t.userOutputFields) > 0 {
+		return merr.WrapErrParameterInvalidMsg("output fields are not returned with the distance histogram")
+	}
+
 	log.Debug("search PreExecute done.",
 		zap.Uint64("guarantee_ts", guaranteeTs),
 		zap.Bool("use_default_consistency", useDefaultConsistency),
@@ -391,11 +475,17 @@ func (t *searchTask) Execute(ctx context.Context) error {
 		collectionName: t.collectionName,
 		nq:             t.Nq,
 		exec:           t.searchShard,
+		partial:        t.partial,
 	})
 	if err != nil {
 		log.Warn("search execute failed", zap.Error(err))
 		return errors.Wrap(err, "failed to search")
 	}
+	if t.partial != nil && len(t.partial.failedChannels()) > 0 {
+		log.Warn("search returns partial result",
+			zap.Strings("skippedChannels", t.partial.failedChannels()),
+			zap.Float64("coverage", t.partial.coverage()))
+	}
 
 	log.Debug("Search Execute done.",
 		zap.Int64("collection", t.GetCollectionID()),
@@ -433,6 +523,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 
 	if len(toReduceResults) >= 1 {
 		MetricType = toReduceResults[0].GetMetricType()
+		t.SearchRequest.MetricType = MetricType
 	}
 
 	// Decode all search results
@@ -447,6 +538,9 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 
 	if len(validSearchResults) <= 0 {
 		t.fillInEmptyResult(Nq)
+		if t.histogram != nil {
+			return t.histogram.apply(t.result)
+		}
 		return nil
 	}
 
@@ -472,6 +566,9 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
 	t.result.CollectionName = t.collectionName
+	if t.histogram != nil {
+		return t.histogram.apply(t.result)
+	}
 	t.fillInFieldInfo()
 
 	if t.requery {
@@ -481,7 +578,15 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 			return err
 		}
 	}
+	if t.groupOrder != nil {
+		if err := t.groupOrder.apply(t.result.GetResults()); err != nil {
+			log.Warn("failed to order the hits inside groups", zap.Error(err))
+			return err
+		}
+	}
 	t.result.Results.OutputFields = t.userOutputFields
+	t.result.Results.FieldsData, t.result.Results.OutputFields = maskFields(t.schema, t.maskedFields,
+		t.result.Results.FieldsData, t.result.Results.OutputFields)
 
 	log.Debug("Search post execute done",
 		zap.Int64("collection", t.GetCollectionID()),
@@ -492,6 +597,9 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channel string) error {
 	searchReq := typeutil.Clone(t.SearchRequest)
 	searchReq.GetBase().TargetID = nodeID
+	if mvccTs, ok := t.channelsMvcc[channel]; ok && mvccTs > 0 {
+		searchReq.MvccTimestamp = mvccTs
+	}
 	req := &querypb.SearchRequest{
 		Req:             searchReq,
 		DmlChannels:     []string{channel},
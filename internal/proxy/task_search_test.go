@@ -1795,6 +1795,60 @@ func TestTaskSearch_reduceGroupBySearchResultDataWithOffset(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestTaskSearch_reduceGroupBySearchResultDataWithGroupSize(t *testing.T) {
+	var (
+		nq     int64 = 1
+		limit  int64 = 2
+		offset int64 = 1
+	)
+	ids := [][]int64{
+		{1, 3, 5, 7, 9},
+		{2, 4, 6, 8, 10},
+	}
+	scores := [][]float32{
+		{10, 8, 6, 4, 2},
+		{9, 7, 5, 3, 1},
+	}
+	groupByValuesArr := [][]int64{
+		{1, 2, 1, 3, 3},
+		{1, 2, 2, 3, 4},
+	}
+
+	var results []*schemapb.SearchResultData
+	for j := range ids {
+		result := getSearchResultData(nq, limit+offset)
+		result.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids[j]}}
+		result.Scores = scores[j]
+		result.Topks = []int64{5}
+		result.GroupByFieldValue = &schemapb.FieldData{
+			Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{
+							Data: groupByValuesArr[j],
+						},
+					},
+				},
+			},
+		}
+		results = append(results, result)
+	}
+
+	queryInfo := &planpb.QueryInfo{
+		GroupByFieldId: 1,
+		GroupSize:      2,
+	}
+	reduced, err := reduceSearchResult(context.TODO(), NewReduceSearchResultInfo(results, nq, limit+offset, metric.L2,
+		schemapb.DataType_Int64, offset, queryInfo))
+	assert.NoError(t, err)
+	// group 1 is skipped by offset, the third hit of group 2 is dropped, the hits of each group are placed together
+	assert.EqualValues(t, []int64{3, 4, 7, 8}, reduced.GetResults().GetIds().GetIntId().GetData())
+	assert.EqualValues(t, []float32{-8, -7, -4, -3}, reduced.GetResults().GetScores())
+	assert.EqualValues(t, []int64{2, 2, 3, 3}, reduced.GetResults().GetGroupByFieldValue().GetScalars().GetLongData().GetData())
+	assert.EqualValues(t, []int64{4}, reduced.GetResults().GetTopks())
+}
+
 func TestSearchTask_ErrExecute(t *testing.T) {
 	var (
 		err error
@@ -2217,6 +2271,39 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 		assert.Nil(t, info)
 		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
 	})
+	t.Run("check group size", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: int64(101), Name: "string_field"},
+			},
+		}
+		normalParam := getValidSearchParams()
+		normalParam = append(normalParam, &commonpb.KeyValuePair{
+			Key:   GroupByFieldKey,
+			Value: "string_field",
+		}, &commonpb.KeyValuePair{
+			Key:   GroupSizeKey,
+			Value: "3",
+		})
+		info, _, err := parseSearchInfo(normalParam, schema)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), info.GetGroupSize())
+
+		resetSearchParamsValue(normalParam, GroupSizeKey, "0")
+		_, _, err = parseSearchInfo(normalParam, schema)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		resetSearchParamsValue(normalParam, GroupSizeKey, "100000")
+		_, _, err = parseSearchInfo(normalParam, schema)
+		assert.Error(t, err)
+
+		noGroupByParam := append(getValidSearchParams(), &commonpb.KeyValuePair{
+			Key:   GroupSizeKey,
+			Value: "3",
+		})
+		_, _, err = parseSearchInfo(noGroupByParam, schema)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
 	t.Run("check range-search and groupBy", func(t *testing.T) {
 		normalParam := getValidSearchParams()
 		resetSearchParamsValue(normalParam, SearchParamsKey, `{"nprobe": 10, "radius":0.2}`)
@@ -2541,6 +2541,36 @@ func Test_loadCollectionTask_Execute(t *testing.T) {
 	})
 }
 
+func Test_getLoadConfig(t *testing.T) {
+	cache := globalMetaCache
+	defer func() { globalMetaCache = cache }()
+	ctx := context.Background()
+
+	mockCache := NewMockCache(t)
+	mockCache.EXPECT().GetCollectionInfo(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&collectionBasicInfo{
+		collID:         1,
+		replicaNumber:  2,
+		resourceGroups: []string{"rg1"},
+	}, nil).Once()
+	globalMetaCache = mockCache
+
+	// specified by the request
+	replicaNumber, rgs, err := getLoadConfig(ctx, "db", "coll", 1, 3, []string{"rg2"})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, replicaNumber)
+	assert.Equal(t, []string{"rg2"}, rgs)
+
+	// use the load config of the collection
+	replicaNumber, rgs, err = getLoadConfig(ctx, "db", "coll", 1, 0, nil)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, replicaNumber)
+	assert.Equal(t, []string{"rg1"}, rgs)
+
+	mockCache.EXPECT().GetCollectionInfo(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("mock")).Once()
+	_, _, err = getLoadConfig(ctx, "db", "coll", 1, 0, nil)
+	assert.Error(t, err)
+}
+
 func Test_loadPartitionTask_Execute(t *testing.T) {
 	rc := newMockRootCoord()
 	dc := NewDataCoordMock()
@@ -3637,4 +3667,8 @@ func TestAlterCollectionCheckLoaded(t *testing.T) {
 	}
 	err = task.PreExecute(context.Background())
 	assert.Equal(t, merr.Code(merr.ErrCollectionLoaded), merr.Code(err))
+
+	task.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionPartitionKeyBucketNumKey, Value: "4"}}
+	err = task.PreExecute(context.Background())
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
 }
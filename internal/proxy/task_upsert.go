@@ -293,6 +293,22 @@ func (it *upsertTask) PreExecute(ctx context.Context) error {
 	}
 	it.schema = schema
 
+	// the rows replaced are deleted by the primary keys regardless of the row filter,
+	// so the users restricted by the row filters are not permitted to upsert
+	collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, it.req.GetDbName(), collectionName, 0)
+	if err != nil {
+		log.Warn("Failed to get collection info", zap.Error(err))
+		return err
+	}
+	rowFilter, err := getRowFilter(ctx, collectionInfo.rowFilters)
+	if err != nil {
+		log.Warn("get row filter failed", zap.Error(err))
+		return err
+	}
+	if rowFilter != "" {
+		return merr.WrapErrPrivilegeNotPermitted("upsert is not permitted for the users restricted by the row filters, insert and delete instead")
+	}
+
 	it.partitionKeyMode, err = isPartitionKeyMode(ctx, it.req.GetDbName(), collectionName)
 	if err != nil {
 		log.Warn("check partition key mode failed",
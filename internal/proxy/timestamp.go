@@ -20,20 +20,33 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"sync"
 	"time"
 
+	"go.uber.org/zap"
+
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
 
-// timestampAllocator implements tsoAllocator.
+// timestampAllocator implements tsoAllocator, allocates the timestamps from rootcoord.
+// If the tso batching enabled, the allocator returned by batched serves the timestamps from the batch
+// allocated from rootcoord in advance within a bounded window, which reduces the TSO requests of rootcoord.
 type timestampAllocator struct {
 	tso    timestampAllocatorInterface
 	peerID UniqueID
+
+	// the batched timestamps in [batchNext, batchEnd) are available until the window after batchTime passed
+	mu        sync.Mutex
+	batchNext Timestamp
+	batchEnd  Timestamp
+	batchTime time.Time
 }
 
 // newTimestampAllocator creates a new timestampAllocator
@@ -45,7 +58,8 @@ func newTimestampAllocator(tso timestampAllocatorInterface, peerID UniqueID) (*t
 	return a, nil
 }
 
-func (ta *timestampAllocator) alloc(ctx context.Context, count uint32) ([]Timestamp, error) {
+// allocFromRootCoord allocates count timestamps from rootcoord, returns the first timestamp and the count.
+func (ta *timestampAllocator) allocFromRootCoord(ctx context.Context, count uint32) (Timestamp, uint32, error) {
 	tr := timerecord.NewTimeRecorder("applyTimestamp")
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
@@ -63,24 +77,69 @@ func (ta *timestampAllocator) alloc(ctx context.Context, count uint32) ([]Timest
 	}()
 
 	if err != nil {
-		return nil, fmt.Errorf("syncTimestamp Failed:%w", err)
+		return 0, 0, fmt.Errorf("syncTimestamp Failed:%w", err)
 	}
 	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
-		return nil, fmt.Errorf("syncTimeStamp Failed:%s", resp.GetStatus().GetReason())
+		return 0, 0, fmt.Errorf("syncTimeStamp Failed:%s", resp.GetStatus().GetReason())
 	}
 	if resp == nil {
-		return nil, fmt.Errorf("empty AllocTimestampResponse")
+		return 0, 0, fmt.Errorf("empty AllocTimestampResponse")
+	}
+	return resp.GetTimestamp(), resp.GetCount(), nil
+}
+
+func (ta *timestampAllocator) alloc(ctx context.Context, count uint32) ([]Timestamp, error) {
+	start, cnt, err := ta.allocFromRootCoord(ctx, count)
+	if err != nil {
+		return nil, err
 	}
-	start, cnt := resp.GetTimestamp(), resp.GetCount()
+
 	ret := make([]Timestamp, cnt)
 	for i := uint32(0); i < cnt; i++ {
 		ret[i] = start + uint64(i)
 	}
-
 	return ret, nil
 }
 
-// AllocOne allocates a timestamp.
+// allocFromBatch serves the timestamp from the batch, a new batch is allocated from rootcoord
+// if the batch is used up or expired. The lock is held during allocating from rootcoord,
+// so that the timestamps served are increasing, which the DML and the time ticks of the proxy rely on.
+func (ta *timestampAllocator) allocFromBatch(ctx context.Context) (Timestamp, error) {
+	window := Params.ProxyCfg.TSOBatchWindow.GetAsDuration(time.Millisecond)
+	ta.mu.Lock()
+	defer ta.mu.Unlock()
+	if ta.batchNext < ta.batchEnd && time.Since(ta.batchTime) <= window {
+		ts := ta.batchNext
+		ta.batchNext++
+		return ts, nil
+	}
+
+	batchTime := time.Now()
+	start, cnt, err := ta.allocFromRootCoord(ctx, Params.ProxyCfg.TSOBatchSize.GetAsUint32())
+	if err != nil {
+		return 0, err
+	}
+	if cnt == 0 {
+		return 0, fmt.Errorf("syncTimeStamp Failed:no timestamp allocated")
+	}
+
+	// the physical time of timestamps must be in the duration of the request,
+	// the batch is not served if the clock skews too much.
+	maxClockSkew := Params.ProxyCfg.TSOBatchMaxClockSkew.GetAsDuration(time.Millisecond)
+	physical := tsoutil.PhysicalTime(start)
+	if physical.Before(batchTime.Add(-maxClockSkew)) || physical.After(time.Now().Add(maxClockSkew)) {
+		log.RatedWarn(10, "clock of proxy skews from rootcoord, skip batching the timestamps",
+			zap.Time("physicalTime", physical),
+			zap.Time("localTime", batchTime),
+			zap.Duration("maxClockSkew", maxClockSkew))
+		return start, nil
+	}
+
+	ta.batchNext, ta.batchEnd, ta.batchTime = start+1, start+uint64(cnt), batchTime
+	return start, nil
+}
+
+// AllocOne allocates a timestamp from rootcoord, which is newer than all the timestamps allocated before.
 func (ta *timestampAllocator) AllocOne(ctx context.Context) (Timestamp, error) {
 	ret, err := ta.alloc(ctx, 1)
 	if err != nil {
@@ -88,3 +147,23 @@ func (ta *timestampAllocator) AllocOne(ctx context.Context) (Timestamp, error) {
 	}
 	return ret[0], nil
 }
+
+// batched returns the allocator serving the timestamps from the batch if the tso batching enabled.
+// The batched timestamps are increasing, but could be older than the ones allocated from rootcoord
+// within the batch window, so it serves the DDL, the DML and the time ticks of the proxy,
+// while the strong reads and the timestamps returned to the clients are allocated from rootcoord.
+func (ta *timestampAllocator) batched() tsoAllocator {
+	return &batchedTimestampAllocator{timestampAllocator: ta}
+}
+
+type batchedTimestampAllocator struct {
+	*timestampAllocator
+}
+
+// AllocOne allocates a timestamp from the batch, or from rootcoord if the tso batching disabled.
+func (ta *batchedTimestampAllocator) AllocOne(ctx context.Context) (Timestamp, error) {
+	if !Params.ProxyCfg.TSOBatchEnabled.GetAsBool() {
+		return ta.timestampAllocator.AllocOne(ctx)
+	}
+	return ta.allocFromBatch(ctx)
+}
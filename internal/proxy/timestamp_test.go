@@ -19,10 +19,20 @@ package proxy
 import (
 	"context"
 	"math/rand"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
 
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/util/uniquegenerator"
 )
 
@@ -62,3 +72,160 @@ func TestTimestampAllocator_AllocOne(t *testing.T) {
 	_, err = tsAllocator.AllocOne(ctx)
 	assert.NoError(t, err)
 }
+
+func TestTimestampAllocator_Batch(t *testing.T) {
+	ctx := context.Background()
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.ProxyCfg.TSOBatchEnabled.Key, "true")
+	defer params.Reset(params.ProxyCfg.TSOBatchEnabled.Key)
+	params.Save(params.ProxyCfg.TSOBatchSize.Key, "10")
+	defer params.Reset(params.ProxyCfg.TSOBatchSize.Key)
+
+	newAllocator := func(physical func() time.Time) (*timestampAllocator, *int) {
+		rpcCount := 0
+		tso := newMockTimestampAllocator(t)
+		tso.EXPECT().AllocTimestamp(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *rootcoordpb.AllocTimestampRequest, opts ...grpc.CallOption) (*rootcoordpb.AllocTimestampResponse, error) {
+				rpcCount++
+				return &rootcoordpb.AllocTimestampResponse{
+					Status:    merr.Success(),
+					Timestamp: tsoutil.ComposeTSByTime(physical(), int64(rpcCount*100)),
+					Count:     req.GetCount(),
+				}, nil
+			}).Maybe()
+		tsAllocator, err := newTimestampAllocator(tso, 1)
+		assert.NoError(t, err)
+		return tsAllocator, &rpcCount
+	}
+
+	t.Run("served from batch", func(t *testing.T) {
+		tsAllocator, rpcCount := newAllocator(time.Now)
+		batched := tsAllocator.batched()
+		var last Timestamp
+		for i := 0; i < 10; i++ {
+			ts, err := batched.AllocOne(ctx)
+			assert.NoError(t, err)
+			assert.Greater(t, ts, last)
+			last = ts
+		}
+		assert.Equal(t, 1, *rpcCount)
+
+		// batch used up
+		ts, err := batched.AllocOne(ctx)
+		assert.NoError(t, err)
+		assert.Greater(t, ts, last)
+		assert.Equal(t, 2, *rpcCount)
+
+		// the timestamps not batched are always allocated from rootcoord, newer than the batched ones
+		ret, err := tsAllocator.alloc(ctx, 2)
+		assert.NoError(t, err)
+		assert.Len(t, ret, 2)
+		assert.Greater(t, ret[0], ts)
+		assert.Equal(t, 3, *rpcCount)
+		ts, err = tsAllocator.AllocOne(ctx)
+		assert.NoError(t, err)
+		assert.Greater(t, ts, ret[1])
+		assert.Equal(t, 4, *rpcCount)
+	})
+
+	t.Run("batch disabled", func(t *testing.T) {
+		params.Save(params.ProxyCfg.TSOBatchEnabled.Key, "false")
+		defer params.Save(params.ProxyCfg.TSOBatchEnabled.Key, "true")
+
+		tsAllocator, rpcCount := newAllocator(time.Now)
+		batched := tsAllocator.batched()
+		_, err := batched.AllocOne(ctx)
+		assert.NoError(t, err)
+		_, err = batched.AllocOne(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, *rpcCount)
+	})
+
+	t.Run("batch expired", func(t *testing.T) {
+		params.Save(params.ProxyCfg.TSOBatchWindow.Key, "0")
+		defer params.Reset(params.ProxyCfg.TSOBatchWindow.Key)
+
+		tsAllocator, rpcCount := newAllocator(time.Now)
+		batched := tsAllocator.batched()
+		_, err := batched.AllocOne(ctx)
+		assert.NoError(t, err)
+		time.Sleep(time.Millisecond)
+		_, err = batched.AllocOne(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, *rpcCount)
+	})
+
+	t.Run("clock skewed", func(t *testing.T) {
+		tsAllocator, rpcCount := newAllocator(func() time.Time {
+			return time.Now().Add(time.Hour)
+		})
+		batched := tsAllocator.batched()
+		_, err := batched.AllocOne(ctx)
+		assert.NoError(t, err)
+		_, err = batched.AllocOne(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, *rpcCount)
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		var rpcCount atomic.Int64
+		tso := newMockTimestampAllocator(t)
+		tso.EXPECT().AllocTimestamp(mock.Anything, mock.Anything).RunAndReturn(
+			func(ctx context.Context, req *rootcoordpb.AllocTimestampRequest, opts ...grpc.CallOption) (*rootcoordpb.AllocTimestampResponse, error) {
+				return &rootcoordpb.AllocTimestampResponse{
+					Status:    merr.Success(),
+					Timestamp: tsoutil.ComposeTSByTime(time.Now(), rpcCount.Inc()*100),
+					Count:     req.GetCount(),
+				}, nil
+			})
+		tsAllocator, err := newTimestampAllocator(tso, 1)
+		assert.NoError(t, err)
+		batched := tsAllocator.batched()
+
+		var mu sync.Mutex
+		allocated := typeutil.NewSet[Timestamp]()
+		wg := sync.WaitGroup{}
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				var last Timestamp
+				for j := 0; j < 20; j++ {
+					ts, err := batched.AllocOne(ctx)
+					assert.NoError(t, err)
+					// the timestamps served are increasing
+					assert.Greater(t, ts, last)
+					last = ts
+					mu.Lock()
+					assert.False(t, allocated.Contain(ts))
+					allocated.Insert(ts)
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, 160, allocated.Len())
+	})
+
+	t.Run("strong reads not batched", func(t *testing.T) {
+		tsAllocator, _ := newAllocator(time.Now)
+		sched, err := newTaskScheduler(ctx, tsAllocator, nil, withBatchedTsoAllocator(tsAllocator.batched()))
+		assert.NoError(t, err)
+		assert.IsType(t, &batchedTimestampAllocator{}, sched.ddQueue.tsoAllocatorIns)
+		assert.IsType(t, &batchedTimestampAllocator{}, sched.dmQueue.tsoAllocatorIns)
+		assert.IsType(t, &batchedTimestampAllocator{}, sched.dcQueue.tsoAllocatorIns)
+		assert.Equal(t, tsAllocator, sched.dqQueue.tsoAllocatorIns)
+	})
+
+	t.Run("alloc failed", func(t *testing.T) {
+		tso := newMockTimestampAllocator(t)
+		tso.EXPECT().AllocTimestamp(mock.Anything, mock.Anything).Return(&rootcoordpb.AllocTimestampResponse{
+			Status: merr.Status(merr.ErrServiceNotReady),
+		}, nil)
+		tsAllocator, err := newTimestampAllocator(tso, 1)
+		assert.NoError(t, err)
+		_, err = tsAllocator.AllocOne(ctx)
+		assert.Error(t, err)
+	})
+}
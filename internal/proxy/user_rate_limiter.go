@@ -0,0 +1,285 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy/connection"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// idle clientLimiters are cleaned up per clientLimiterCleanInterval
+	clientLimiterCleanInterval = time.Minute
+	clientLimiterIdleTimeout   = 10 * time.Minute
+
+	userLimitLabel       = "User"
+	connectionLimitLabel = "Connection"
+)
+
+// clientLimit is the limit of a user or a connection, non-positive value means no limit.
+type clientLimit struct {
+	qps         float64
+	concurrency int64
+	// result bytes per second
+	resultRate float64
+}
+
+// clientLimiter limits the requests of a user or a connection.
+type clientLimiter struct {
+	requests   *ratelimitutil.Limiter
+	results    *ratelimitutil.Limiter
+	inflight   atomic.Int64
+	lastActive atomic.Int64
+}
+
+func newClientLimiter() *clientLimiter {
+	return &clientLimiter{
+		requests: ratelimitutil.NewLimiter(ratelimitutil.Inf, 0),
+		results:  ratelimitutil.NewLimiter(ratelimitutil.Inf, 0),
+	}
+}
+
+func setClientLimit(limiter *ratelimitutil.Limiter, rate float64) {
+	limit := ratelimitutil.Inf
+	if rate > 0 {
+		limit = ratelimitutil.Limit(rate)
+	}
+	if limiter.Limit() != limit {
+		limiter.SetLimit(limit)
+	}
+}
+
+// acquire checks the limit before the request, release must be called once the request finished if no error returned.
+func (c *clientLimiter) acquire(limit clientLimit) error {
+	now := time.Now()
+	c.lastActive.Store(now.UnixNano())
+	setClientLimit(c.requests, limit.qps)
+	setClientLimit(c.results, limit.resultRate)
+
+	inflight := c.inflight.Inc()
+	if limit.concurrency > 0 && inflight > limit.concurrency {
+		c.inflight.Dec()
+		return merr.WrapErrServiceRequestLimitExceeded(int32(limit.concurrency), "too many concurrent requests")
+	}
+	// the result size is charged after the request finished, reject the requests until the debt is paid off
+	if !c.results.AllowN(now, 0) {
+		c.inflight.Dec()
+		return merr.WrapErrServiceRateLimit(limit.resultRate, "result rate exceeded")
+	}
+	if !c.requests.AllowN(now, 1) {
+		c.inflight.Dec()
+		return merr.WrapErrServiceRateLimit(limit.qps, "request rate exceeded")
+	}
+	return nil
+}
+
+func (c *clientLimiter) release(resultSize int) {
+	c.inflight.Dec()
+	if resultSize > 0 {
+		c.results.AllowN(time.Now(), resultSize)
+	}
+}
+
+// cancel reverts the acquired request if rejected by another limiter.
+func (c *clientLimiter) cancel() {
+	c.inflight.Dec()
+	c.requests.Cancel(1)
+}
+
+func (c *clientLimiter) idle(now time.Time) bool {
+	return c.inflight.Load() == 0 && now.Sub(time.Unix(0, c.lastActive.Load())) > clientLimiterIdleTimeout
+}
+
+// UserRateLimiter limits the requests per user and per connection in proxy. The limits of a user are the most
+// permissive ones among the limits of the roles granted to the user, and the default user limits if no role limit set.
+type UserRateLimiter struct {
+	users     *typeutil.ConcurrentMap[string, *clientLimiter]
+	conns     *typeutil.ConcurrentMap[int64, *clientLimiter]
+	lastClean atomic.Int64
+}
+
+func NewUserRateLimiter() *UserRateLimiter {
+	l := &UserRateLimiter{
+		users: typeutil.NewConcurrentMap[string, *clientLimiter](),
+		conns: typeutil.NewConcurrentMap[int64, *clientLimiter](),
+	}
+	l.lastClean.Store(time.Now().UnixNano())
+	return l
+}
+
+func getRoleLimit(roleLimits map[string]string, roles []string, name string, defaultValue float64) float64 {
+	value, found := 0.0, false
+	for _, role := range roles {
+		v, ok := roleLimits[strings.ToLower(role+"."+name)]
+		if !ok {
+			continue
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			continue
+		}
+		// non-positive value means no limit, which is the most permissive one
+		if !found || (value > 0 && (f <= 0 || f > value)) {
+			value = f
+		}
+		found = true
+	}
+	if !found {
+		return defaultValue
+	}
+	return value
+}
+
+func getUserLimit(ctx context.Context, username string) clientLimit {
+	cfg := &Params.QuotaConfig
+	limit := clientLimit{
+		qps:         cfg.UserMaxQPS.GetAsFloat(),
+		concurrency: cfg.UserMaxConcurrency.GetAsInt64(),
+		resultRate:  cfg.UserMaxResultRate.GetAsFloat() * paramtable.MBSize,
+	}
+	roleLimits := cfg.UserRoleLimits.GetValue()
+	if len(roleLimits) == 0 {
+		return limit
+	}
+	roles, err := GetRole(ctx, username)
+	if err != nil || len(roles) == 0 {
+		return limit
+	}
+	limit.qps = getRoleLimit(roleLimits, roles, "maxQPS", limit.qps)
+	limit.concurrency = int64(getRoleLimit(roleLimits, roles, "maxConcurrency", float64(limit.concurrency)))
+	limit.resultRate = getRoleLimit(roleLimits, roles, "maxResultRate", limit.resultRate/paramtable.MBSize) * paramtable.MBSize
+	return limit
+}
+
+func getConnectionLimit() clientLimit {
+	cfg := &Params.QuotaConfig
+	return clientLimit{
+		qps:         cfg.ConnectionMaxQPS.GetAsFloat(),
+		concurrency: cfg.ConnectionMaxConcurrency.GetAsInt64(),
+		resultRate:  cfg.ConnectionMaxResultRate.GetAsFloat() * paramtable.MBSize,
+	}
+}
+
+func (l *UserRateLimiter) clean(now time.Time) {
+	last := l.lastClean.Load()
+	if now.Sub(time.Unix(0, last)) < clientLimiterCleanInterval || !l.lastClean.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+	l.users.Range(func(user string, limiter *clientLimiter) bool {
+		if limiter.idle(now) {
+			l.users.Remove(user)
+		}
+		return true
+	})
+	l.conns.Range(func(conn int64, limiter *clientLimiter) bool {
+		if limiter.idle(now) {
+			l.conns.Remove(conn)
+		}
+		return true
+	})
+}
+
+// acquire checks the limits of the user and the connection, the returned limiters must be released once the request finished.
+func (l *UserRateLimiter) acquire(ctx context.Context) ([]*clientLimiter, error) {
+	l.clean(time.Now())
+
+	acquired := make([]*clientLimiter, 0, 2)
+	rollback := func() {
+		for _, limiter := range acquired {
+			limiter.cancel()
+		}
+	}
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+
+	if username, err := GetCurUserFromContext(ctx); err == nil && username != "" {
+		limiter, ok := l.users.Get(username)
+		if !ok {
+			limiter, _ = l.users.GetOrInsert(username, newClientLimiter())
+		}
+		if err := limiter.acquire(getUserLimit(ctx, username)); err != nil {
+			metrics.ProxyRateLimitReqCount.WithLabelValues(nodeID, userLimitLabel, metrics.FailLabel).Inc()
+			return nil, err
+		}
+		acquired = append(acquired, limiter)
+	}
+
+	if identifier, err := connection.GetIdentifierFromContext(ctx); err == nil {
+		limiter, ok := l.conns.Get(identifier)
+		if !ok {
+			limiter, _ = l.conns.GetOrInsert(identifier, newClientLimiter())
+		}
+		if err := limiter.acquire(getConnectionLimit()); err != nil {
+			metrics.ProxyRateLimitReqCount.WithLabelValues(nodeID, connectionLimitLabel, metrics.FailLabel).Inc()
+			rollback()
+			return nil, err
+		}
+		acquired = append(acquired, limiter)
+	}
+	return acquired, nil
+}
+
+func releaseClientLimiters(limiters []*clientLimiter, resp any) {
+	resultSize := 0
+	switch r := resp.(type) {
+	case *milvuspb.SearchResults:
+		resultSize = proto.Size(r)
+	case *milvuspb.QueryResults:
+		resultSize = proto.Size(r)
+	}
+	for _, limiter := range limiters {
+		limiter.release(resultSize)
+	}
+}
+
+// UserRateLimitInterceptor returns a new unary server interceptor that limits the requests per user and per connection.
+func UserRateLimitInterceptor(limiter *UserRateLimiter) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !Params.QuotaConfig.UserLimitEnabled.GetAsBool() {
+			return handler(ctx, req)
+		}
+		switch req.(type) {
+		// the client is always able to connect and check the health
+		case *milvuspb.ConnectRequest, *milvuspb.CheckHealthRequest, *milvuspb.GetVersionRequest:
+			return handler(ctx, req)
+		}
+
+		limiters, err := limiter.acquire(ctx)
+		if err != nil {
+			if rsp := getFailedResponse(req, err); rsp != nil {
+				return rsp, nil
+			}
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		releaseClientLimiters(limiters, resp)
+		return resp, err
+	}
+}
@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/contextutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func Test_getRoleLimit(t *testing.T) {
+	roleLimits := map[string]string{
+		"admin.maxqps":        "100",
+		"reader.maxqps":       "10",
+		"unlimited.maxqps":    "-1",
+		"invalid.maxqps":      "abc",
+		"admin.maxresultrate": "1",
+	}
+
+	assert.Equal(t, float64(5), getRoleLimit(roleLimits, nil, "maxQPS", 5))
+	assert.Equal(t, float64(5), getRoleLimit(roleLimits, []string{"public", "invalid"}, "maxQPS", 5))
+	assert.Equal(t, float64(10), getRoleLimit(roleLimits, []string{"reader"}, "maxQPS", 5))
+	assert.Equal(t, float64(100), getRoleLimit(roleLimits, []string{"reader", "admin"}, "maxQPS", 5))
+	assert.Equal(t, float64(-1), getRoleLimit(roleLimits, []string{"admin", "unlimited", "reader"}, "maxQPS", 5))
+	assert.Equal(t, float64(-1), getRoleLimit(roleLimits, []string{"reader"}, "maxConcurrency", -1))
+}
+
+func TestUserRateLimiter(t *testing.T) {
+	paramtable.Init()
+	cfg := &Params.QuotaConfig
+	ctx := NewContextWithMetadata(context.Background(), "user1", "default")
+	ctx = contextutil.AppendToIncomingContext(ctx, util.IdentifierKey, "1")
+
+	t.Run("concurrency", func(t *testing.T) {
+		paramtable.Get().Save(cfg.UserMaxConcurrency.Key, "1")
+		defer paramtable.Get().Reset(cfg.UserMaxConcurrency.Key)
+
+		limiter := NewUserRateLimiter()
+		limiters, err := limiter.acquire(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, limiters, 2)
+
+		_, err = limiter.acquire(ctx)
+		assert.ErrorIs(t, err, merr.ErrServiceRequestLimitExceeded)
+		// the connection of another user is not limited
+		_, err = limiter.acquire(NewContextWithMetadata(context.Background(), "user2", "default"))
+		assert.NoError(t, err)
+
+		releaseClientLimiters(limiters, nil)
+		_, err = limiter.acquire(ctx)
+		assert.NoError(t, err)
+	})
+
+	t.Run("connection qps", func(t *testing.T) {
+		paramtable.Get().Save(cfg.ConnectionMaxQPS.Key, "1")
+		defer paramtable.Get().Reset(cfg.ConnectionMaxQPS.Key)
+
+		limiter := NewUserRateLimiter()
+		var err error
+		for i := 0; i < 3 && err == nil; i++ {
+			_, err = limiter.acquire(ctx)
+		}
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		// the rejected request is not counted by the user limiter
+		user, _ := limiter.users.Get("user1")
+		assert.EqualValues(t, 2, user.inflight.Load())
+	})
+
+	t.Run("result rate", func(t *testing.T) {
+		paramtable.Get().Save(cfg.UserMaxResultRate.Key, "0.001")
+		defer paramtable.Get().Reset(cfg.UserMaxResultRate.Key)
+
+		limiter := NewUserRateLimiter()
+		limiters, err := limiter.acquire(ctx)
+		assert.NoError(t, err)
+		releaseClientLimiters(limiters, &milvuspb.QueryResults{
+			Status:         merr.Success(),
+			CollectionName: string(make([]byte, 10000)),
+		})
+		_, err = limiter.acquire(ctx)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+	})
+
+	t.Run("role limits", func(t *testing.T) {
+		cache := NewMockCache(t)
+		cache.EXPECT().GetUserRole(mock.Anything).Return([]string{"admin"})
+		cacheBak := globalMetaCache
+		globalMetaCache = cache
+		defer func() { globalMetaCache = cacheBak }()
+		cfg.UserRoleLimits.GetFunc = func() map[string]string {
+			return map[string]string{"admin.maxconcurrency": "2"}
+		}
+		defer func() { cfg.UserRoleLimits.GetFunc = nil }()
+		paramtable.Get().Save(cfg.UserMaxConcurrency.Key, "1")
+		defer paramtable.Get().Reset(cfg.UserMaxConcurrency.Key)
+
+		limit := getUserLimit(context.Background(), "user1")
+		assert.EqualValues(t, 2, limit.concurrency)
+		assert.Equal(t, float64(-1), limit.qps)
+	})
+
+	t.Run("clean idle limiters", func(t *testing.T) {
+		limiter := NewUserRateLimiter()
+		limiters, err := limiter.acquire(ctx)
+		assert.NoError(t, err)
+		releaseClientLimiters(limiters, nil)
+
+		limiter.clean(time.Now().Add(clientLimiterIdleTimeout + time.Minute))
+		assert.Equal(t, 0, limiter.users.Len())
+		assert.Equal(t, 0, limiter.conns.Len())
+	})
+}
+
+func TestUserRateLimitInterceptor(t *testing.T) {
+	paramtable.Init()
+	cfg := &Params.QuotaConfig
+	ctx := NewContextWithMetadata(context.Background(), "user1", "default")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &milvuspb.QueryResults{Status: merr.Success()}, nil
+	}
+	interceptor := UserRateLimitInterceptor(NewUserRateLimiter())
+
+	paramtable.Get().Save(cfg.UserMaxConcurrency.Key, "0")
+	defer paramtable.Get().Reset(cfg.UserMaxConcurrency.Key)
+	paramtable.Get().Save(cfg.UserMaxQPS.Key, "1")
+	defer paramtable.Get().Reset(cfg.UserMaxQPS.Key)
+
+	// not limited if disabled
+	for i := 0; i < 5; i++ {
+		_, err := interceptor(ctx, &milvuspb.QueryRequest{}, nil, handler)
+		assert.NoError(t, err)
+	}
+
+	paramtable.Get().Save(cfg.UserLimitEnabled.Key, "true")
+	defer paramtable.Get().Reset(cfg.UserLimitEnabled.Key)
+	var resp interface{}
+	var err error
+	for i := 0; i < 3; i++ {
+		resp, err = interceptor(ctx, &milvuspb.QueryRequest{}, nil, handler)
+		assert.NoError(t, err)
+	}
+	assert.ErrorIs(t, merr.Error(resp.(*milvuspb.QueryResults).GetStatus()), merr.ErrServiceRateLimit)
+
+	_, err = interceptor(ctx, &milvuspb.DescribeCollectionRequest{}, nil, handler)
+	assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+
+	// connect is never limited
+	_, err = interceptor(ctx, &milvuspb.ConnectRequest{}, nil, handler)
+	assert.NoError(t, err)
+}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/grpc/metadata"
@@ -33,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
@@ -44,6 +46,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/contextutil"
 	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metric"
@@ -354,6 +357,27 @@ func validateMaxLengthPerRow(collectionName string, field *schemapb.FieldSchema)
 	return nil
 }
 
+// validateAddedField checks the field added to the existing collection,
+// the data type and the default value of the field are checked by rootcoord.
+func validateAddedField(collectionName string, field *schemapb.FieldSchema) error {
+	if err := validateCollectionName(collectionName); err != nil {
+		return err
+	}
+	if field == nil {
+		return merr.WrapErrParameterMissing("field", "the field added is missing")
+	}
+	if err := validateFieldName(field.GetName()); err != nil {
+		return err
+	}
+	if field.GetDefaultValue() == nil {
+		return merr.WrapErrParameterInvalidMsg("the field added must be with default value")
+	}
+	if field.GetDataType() == schemapb.DataType_VarChar {
+		return validateMaxLengthPerRow(collectionName, field)
+	}
+	return nil
+}
+
 func validateMaxCapacityPerRow(collectionName string, field *schemapb.FieldSchema) error {
 	exist := false
 	for _, param := range field.TypeParams {
@@ -859,6 +883,16 @@ func ValidateObjectName(entity string) error {
 	if util.IsAnyWord(entity) {
 		return nil
 	}
+	// the partition level grants, like col1:p1 or col1:*
+	if collectionName, partitionName, ok := funcutil.SplitPartitionObjectName(entity); ok {
+		if err := validateName(collectionName, "collection name"); err != nil {
+			return err
+		}
+		if util.IsAnyWord(partitionName) {
+			return nil
+		}
+		return validateName(partitionName, "partition name")
+	}
 	return validateName(entity, "role name")
 }
 
@@ -902,6 +936,28 @@ func GetCurDBNameFromContextOrDefault(ctx context.Context) string {
 	return dbNameData[0]
 }
 
+// withDatabase returns the context with the database of the request,
+// the privileges of the request are checked against the database of the context.
+func withDatabase(ctx context.Context, dbName string) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(strings.ToLower(util.HeaderDBName), dbName)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// GetIdempotencyKeyFromContext returns the idempotency key carried by the request metadata, empty if not carried.
+func GetIdempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	keys := md[util.HeaderIdempotencyKey]
+	if len(keys) < 1 {
+		return ""
+	}
+	return keys[0]
+}
+
 func NewContextWithMetadata(ctx context.Context, username string, dbName string) context.Context {
 	dbKey := strings.ToLower(util.HeaderDBName)
 	if username == "" {
@@ -913,11 +969,61 @@ func NewContextWithMetadata(ctx context.Context, username string, dbName string)
 	return contextutil.AppendToIncomingContext(ctx, authKey, authValue, dbKey, dbName)
 }
 
-func GetRole(username string) ([]string, error) {
+func GetRole(ctx context.Context, username string) ([]string, error) {
 	if globalMetaCache == nil {
 		return []string{}, merr.WrapErrServiceUnavailable("internal: Milvus Proxy is not ready yet. please wait")
 	}
-	return globalMetaCache.GetUserRole(username), nil
+	roles := globalMetaCache.GetUserRole(username)
+	if strings.HasPrefix(username, util.OIDCUserPrefix) {
+		// the roles mapped from the claims of the oidc token the request is authenticated by
+		roles = append(roles, getOIDCRoles(ctx)...)
+	}
+	return roles, nil
+}
+
+// getRestrictedRoles returns the roles of the current user the restrictions like the field masks apply to,
+// nothing is restricted if the authorization is disabled or for the root user.
+func getRestrictedRoles(ctx context.Context) ([]string, error) {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return nil, nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if username == util.UserRoot {
+		return nil, nil
+	}
+	roleNames, err := GetRole(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	return append(roleNames, util.RolePublic), nil
+}
+
+// checkAdmin checks the current user is root or of the admin role, nothing is checked if the authorization is disabled.
+func checkAdmin(ctx context.Context) error {
+	roleNames, err := getRestrictedRoles(ctx)
+	if err != nil {
+		return err
+	}
+	if roleNames != nil && !lo.Contains(roleNames, util.RoleAdmin) {
+		return merr.WrapErrPrivilegeNotPermitted("only root or the users of the admin role are permitted")
+	}
+	return nil
+}
+
+// checkStorageProperties checks the current user is permitted to set the storage properties, which are restricted to
+// the admins, otherwise the users could place the collections in any storage reachable from the cluster.
+func checkStorageProperties(ctx context.Context, props []*commonpb.KeyValuePair) error {
+	for _, prop := range props {
+		if common.IsStorageProperty(prop.GetKey()) {
+			if err := checkAdmin(ctx); err != nil {
+				return errors.Wrapf(err, "failed to set property %s", prop.GetKey())
+			}
+		}
+	}
+	return nil
 }
 
 func PasswordVerify(ctx context.Context, username, rawPwd string) bool {
@@ -1634,3 +1740,92 @@ func SetReportValue(status *commonpb.Status, value int) {
 	}
 	status.ExtraInfo["report_value"] = strconv.Itoa(value)
 }
+
+// SetServedTimestamp sets the timestamp served by all the channels into the status,
+// which the client could pass as the guarantee timestamp of the following session consistency reads,
+// so that they don't read staler data even served by the other replicas.
+func SetServedTimestamp(status *commonpb.Status, channelsTs map[string]Timestamp) {
+	if !merr.Ok(status) || len(channelsTs) == 0 {
+		return
+	}
+	servedTs := lo.Min(lo.Values(channelsTs))
+	if status.ExtraInfo == nil {
+		status.ExtraInfo = make(map[string]string)
+	}
+	status.ExtraInfo[ServedTimestampKey] = strconv.FormatUint(servedTs, 10)
+}
+
+// parseMaxStaleness returns the max staleness in milliseconds of the bounded consistency read,
+// graceful time if not specified by the request params.
+func parseMaxStaleness(params []*commonpb.KeyValuePair) (int64, error) {
+	for _, kv := range params {
+		if kv.GetKey() != MaxStalenessKey {
+			continue
+		}
+		maxStaleness, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+		if err != nil || maxStaleness < 0 {
+			return 0, merr.WrapErrParameterInvalidMsg("invalid %s: %s, shall be a non-negative integer in milliseconds", MaxStalenessKey, kv.GetValue())
+		}
+		return maxStaleness, nil
+	}
+	return Params.CommonCfg.GracefulTime.GetAsInt64(), nil
+}
+
+// parsePartialResult returns whether the search allows the partial result, false if not specified.
+func parsePartialResult(params []*commonpb.KeyValuePair) (bool, error) {
+	for _, kv := range params {
+		if kv.GetKey() != PartialResultKey {
+			continue
+		}
+		allowed, err := strconv.ParseBool(kv.GetValue())
+		if err != nil {
+			return false, merr.WrapErrParameterInvalidMsg("invalid %s: %s, shall be true or false", PartialResultKey, kv.GetValue())
+		}
+		return allowed, nil
+	}
+	return false, nil
+}
+
+// parseInSegmentFusion returns whether the hybrid search fuses the sub searches inside the segments, false if not specified.
+func parseInSegmentFusion(params []*commonpb.KeyValuePair) (bool, error) {
+	for _, kv := range params {
+		if kv.GetKey() != InSegmentFusionKey {
+			continue
+		}
+		enabled, err := strconv.ParseBool(kv.GetValue())
+		if err != nil {
+			return false, merr.WrapErrParameterInvalidMsg("invalid %s: %s, shall be true or false", InSegmentFusionKey, kv.GetValue())
+		}
+		return enabled, nil
+	}
+	return false, nil
+}
+
+// SetSearchCoverage sets the fraction of the channels searched into the status of the search allowing partial result.
+func SetSearchCoverage(status *commonpb.Status, partial *partialResult) {
+	if !merr.Ok(status) || partial == nil {
+		return
+	}
+	if status.ExtraInfo == nil {
+		status.ExtraInfo = make(map[string]string)
+	}
+	status.ExtraInfo[SearchCoverageKey] = strconv.FormatFloat(partial.coverage(), 'f', -1, 64)
+}
+
+// parseReadPriority returns the read priority hinted by the request params, interactive if not specified.
+func parseReadPriority(params []*commonpb.KeyValuePair) (internalpb.ReadPriority, error) {
+	for _, kv := range params {
+		if kv.GetKey() != ReadPriorityKey {
+			continue
+		}
+		switch strings.ToLower(kv.GetValue()) {
+		case "interactive":
+			return internalpb.ReadPriority_Interactive, nil
+		case "batch":
+			return internalpb.ReadPriority_Batch, nil
+		default:
+			return internalpb.ReadPriority_Interactive, merr.WrapErrParameterInvalidMsg("invalid %s: %s, shall be interactive or batch", ReadPriorityKey, kv.GetValue())
+		}
+	}
+	return internalpb.ReadPriority_Interactive, nil
+}
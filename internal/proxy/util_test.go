@@ -830,6 +830,10 @@ func TestValidateName(t *testing.T) {
 	assert.NotNil(t, ValidateObjectName(" "))
 	assert.NotNil(t, ValidateObjectName(string(longName)))
 	assert.Nil(t, ValidateObjectName("*"))
+	assert.Nil(t, ValidateObjectName("col1:p1"))
+	assert.Nil(t, ValidateObjectName("col1:*"))
+	assert.NotNil(t, ValidateObjectName(":p1"))
+	assert.NotNil(t, ValidateObjectName("col1: "))
 }
 
 func TestIsDefaultRole(t *testing.T) {
@@ -896,8 +900,9 @@ func TestGetCurDBNameFromContext(t *testing.T) {
 }
 
 func TestGetRole(t *testing.T) {
+	ctx := context.Background()
 	globalMetaCache = nil
-	_, err := GetRole("foo")
+	_, err := GetRole(ctx, "foo")
 	assert.Error(t, err)
 	mockCache := NewMockCache(t)
 	mockCache.On("GetUserRole",
@@ -909,13 +914,25 @@ func TestGetRole(t *testing.T) {
 		return []string{"role1"}
 	})
 	globalMetaCache = mockCache
-	roles, err := GetRole("root")
+	roles, err := GetRole(ctx, "root")
 	assert.NoError(t, err)
 	assert.Equal(t, 3, len(roles))
 
-	roles, err = GetRole("foo")
+	roles, err = GetRole(ctx, "foo")
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(roles))
+
+	// the roles of the oidc token are only granted to the request authenticated by the token
+	oidcCtx := WithOIDCRoles(ctx, []string{"admin"})
+	roles, err = GetRole(oidcCtx, "oidc/foo")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"role1", "admin"}, roles)
+	roles, err = GetRole(oidcCtx, "foo")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"role1"}, roles)
+	roles, err = GetRole(ctx, "oidc/foo")
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"role1"}, roles)
 }
 
 func TestPasswordVerify(t *testing.T) {
@@ -2107,3 +2124,119 @@ func TestSendReplicateMessagePack(t *testing.T) {
 		SendReplicateMessagePack(ctx, mockStream, &milvuspb.ReleasePartitionsRequest{})
 	})
 }
+
+func TestParseReadPriority(t *testing.T) {
+	priority, err := parseReadPriority(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.ReadPriority_Interactive, priority)
+
+	priority, err = parseReadPriority([]*commonpb.KeyValuePair{{Key: ReadPriorityKey, Value: "Batch"}})
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.ReadPriority_Batch, priority)
+
+	priority, err = parseReadPriority([]*commonpb.KeyValuePair{{Key: ReadPriorityKey, Value: "interactive"}})
+	assert.NoError(t, err)
+	assert.Equal(t, internalpb.ReadPriority_Interactive, priority)
+
+	_, err = parseReadPriority([]*commonpb.KeyValuePair{{Key: ReadPriorityKey, Value: "urgent"}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestParseMaxStaleness(t *testing.T) {
+	paramtable.Init()
+	maxStaleness, err := parseMaxStaleness(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, Params.CommonCfg.GracefulTime.GetAsInt64(), maxStaleness)
+
+	maxStaleness, err = parseMaxStaleness([]*commonpb.KeyValuePair{{Key: MaxStalenessKey, Value: "100"}})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 100, maxStaleness)
+
+	_, err = parseMaxStaleness([]*commonpb.KeyValuePair{{Key: MaxStalenessKey, Value: "-1"}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, err = parseMaxStaleness([]*commonpb.KeyValuePair{{Key: MaxStalenessKey, Value: "1s"}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestSetServedTimestamp(t *testing.T) {
+	status := merr.Success()
+	SetServedTimestamp(status, nil)
+	assert.Empty(t, status.GetExtraInfo())
+
+	SetServedTimestamp(status, map[string]Timestamp{"dml_0": 200, "dml_1": 100})
+	assert.Equal(t, "100", status.GetExtraInfo()[ServedTimestampKey])
+
+	status = merr.Status(merr.ErrServiceNotReady)
+	SetServedTimestamp(status, map[string]Timestamp{"dml_0": 200})
+	assert.Empty(t, status.GetExtraInfo())
+}
+
+func TestParsePartialResult(t *testing.T) {
+	allowed, err := parsePartialResult(nil)
+	assert.NoError(t, err)
+	assert.False(t, allowed)
+
+	allowed, err = parsePartialResult([]*commonpb.KeyValuePair{{Key: PartialResultKey, Value: "true"}})
+	assert.NoError(t, err)
+	assert.True(t, allowed)
+
+	_, err = parsePartialResult([]*commonpb.KeyValuePair{{Key: PartialResultKey, Value: "yes"}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestParseInSegmentFusion(t *testing.T) {
+	enabled, err := parseInSegmentFusion(nil)
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	enabled, err = parseInSegmentFusion([]*commonpb.KeyValuePair{{Key: InSegmentFusionKey, Value: "true"}})
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	_, err = parseInSegmentFusion([]*commonpb.KeyValuePair{{Key: InSegmentFusionKey, Value: "yes"}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestSetSearchCoverage(t *testing.T) {
+	status := merr.Success()
+	SetSearchCoverage(status, nil)
+	assert.Empty(t, status.GetExtraInfo())
+
+	SetSearchCoverage(status, &partialResult{total: 4, failed: []string{"dml_0"}})
+	assert.Equal(t, "0.75", status.GetExtraInfo()[SearchCoverageKey])
+
+	status = merr.Status(merr.ErrServiceNotReady)
+	SetSearchCoverage(status, &partialResult{total: 4})
+	assert.Empty(t, status.GetExtraInfo())
+}
+
+func TestCheckStorageProperties(t *testing.T) {
+	paramtable.Init()
+	props := []*commonpb.KeyValuePair{{Key: common.CollectionStorageBucketKey, Value: "tenant-bucket"}}
+	dbProps := []*commonpb.KeyValuePair{{Key: common.DatabaseDefaultStorageAddressKey, Value: "localhost:9000"}}
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "false")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+		assert.NoError(t, checkStorageProperties(context.Background(), props))
+	})
+
+	t.Run("authorization enabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.CommonCfg.AuthorizationEnabled.Key, "true")
+		defer paramtable.Get().Reset(Params.CommonCfg.AuthorizationEnabled.Key)
+
+		cache := NewMockCache(t)
+		cache.EXPECT().GetUserRole("alice").Return([]string{"analyst"})
+		cache.EXPECT().GetUserRole("carol").Return([]string{util.RoleAdmin})
+		globalMetaCache = cache
+		defer func() { globalMetaCache = nil }()
+
+		alice := GetContext(context.Background(), "alice:123456")
+		assert.NoError(t, checkStorageProperties(GetContext(context.Background(), "root:123456"), props))
+		assert.NoError(t, checkStorageProperties(GetContext(context.Background(), "carol:123456"), dbProps))
+		assert.ErrorIs(t, checkStorageProperties(alice, props), merr.ErrPrivilegeNotPermitted)
+		assert.ErrorIs(t, checkStorageProperties(alice, dbProps), merr.ErrPrivilegeNotPermitted)
+		assert.NoError(t, checkStorageProperties(alice, []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "10"}}))
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/multivectorutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -544,6 +545,11 @@ func (v *validateUtil) checkArrayFieldData(field *schemapb.FieldData, fieldSchem
 			}
 		}
 	}
+	if multivectorutil.IsMultiVectorField(fieldSchema) {
+		if err := multivectorutil.CheckFieldData(fieldSchema, field); err != nil {
+			return err
+		}
+	}
 	return v.checkArrayElement(data, fieldSchema)
 }
 
@@ -3621,3 +3621,17 @@ func Test_validateUtil_checkJSONData(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func Test_validateUtil_checkMultiVectorFieldData(t *testing.T) {
+	v := newValidateUtil(withMaxCapCheck())
+	f := multiVectorTestSchema().GetFields()[2]
+
+	data := multiVectorTestRows([]int64{1, 2}, []string{"a", "b"}, [][]float32{{1, 0}, {1, 0, 0, 1}})[2]
+	assert.NoError(t, v.checkArrayFieldData(data, f))
+
+	data = multiVectorTestRows([]int64{1}, []string{"a"}, [][]float32{{1, 0, 1}})[2]
+	assert.Error(t, v.checkArrayFieldData(data, f))
+
+	data = multiVectorTestRows([]int64{1}, []string{"a"}, [][]float32{{}})[2]
+	assert.Error(t, v.checkArrayFieldData(data, f))
+}
@@ -75,17 +75,19 @@ func (b *BalanceChecker) Description() string {
 func (b *BalanceChecker) readyToCheck(collectionID int64) bool {
 	metaExist := (b.meta.GetCollection(collectionID) != nil)
 	targetExist := b.targetMgr.IsNextTargetExist(collectionID) || b.targetMgr.IsCurrentTargetExist(collectionID)
+	// checks of frozen collection are suspended
+	frozen := b.meta.IsFrozen(collectionID)
 
-	return metaExist && targetExist
+	return metaExist && targetExist && !frozen
 }
 
 func (b *BalanceChecker) replicasToBalance() []int64 {
 	ids := b.meta.GetAll()
 
-	// all replicas belonging to loading collection will be skipped
+	// all replicas belonging to loading or frozen collection will be skipped
 	loadedCollections := lo.Filter(ids, func(cid int64, _ int) bool {
 		collection := b.meta.GetCollection(cid)
-		return collection != nil && collection.GetStatus() == querypb.LoadStatus_Loaded
+		return collection != nil && collection.GetStatus() == querypb.LoadStatus_Loaded && !collection.GetFrozen()
 	})
 	sort.Slice(loadedCollections, func(i, j int) bool {
 		return loadedCollections[i] < loadedCollections[j]
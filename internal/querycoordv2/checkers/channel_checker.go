@@ -72,8 +72,10 @@ func (c *ChannelChecker) Description() string {
 func (c *ChannelChecker) readyToCheck(collectionID int64) bool {
 	metaExist := (c.meta.GetCollection(collectionID) != nil)
 	targetExist := c.targetMgr.IsNextTargetExist(collectionID) || c.targetMgr.IsCurrentTargetExist(collectionID)
+	// checks of frozen collection are suspended
+	frozen := c.meta.IsFrozen(collectionID)
 
-	return metaExist && targetExist
+	return metaExist && targetExist && !frozen
 }
 
 func (c *ChannelChecker) Check(ctx context.Context) []task.Task {
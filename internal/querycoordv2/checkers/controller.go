@@ -68,6 +68,8 @@ func NewCheckerController(
 		utils.BalanceChecker: NewBalanceChecker(meta, targetMgr, balancer, nodeMgr, scheduler),
 		utils.IndexChecker:   NewIndexChecker(meta, dist, broker, nodeMgr),
 		utils.LeaderChecker:  NewLeaderChecker(meta, dist, targetMgr, nodeMgr),
+
+		utils.LeaderBalanceChecker: NewLeaderBalanceChecker(meta, dist, targetMgr, nodeMgr, scheduler),
 	}
 
 	manualCheckChs := map[utils.CheckerType]chan struct{}{
@@ -108,6 +110,8 @@ func getCheckerInterval(checker utils.CheckerType) time.Duration {
 		return Params.QueryCoordCfg.IndexCheckInterval.GetAsDuration(time.Millisecond)
 	case utils.LeaderChecker:
 		return Params.QueryCoordCfg.LeaderViewUpdateInterval.GetAsDuration(time.Second)
+	case utils.LeaderBalanceChecker:
+		return Params.QueryCoordCfg.LeaderBalanceCheckInterval.GetAsDuration(time.Millisecond)
 	default:
 		return Params.QueryCoordCfg.CheckInterval.GetAsDuration(time.Millisecond)
 	}
@@ -76,6 +76,10 @@ func (c *IndexChecker) Check(ctx context.Context) []task.Task {
 	var tasks []task.Task
 
 	for _, collectionID := range collectionIDs {
+		// checks of frozen collection are suspended
+		if c.meta.IsFrozen(collectionID) {
+			continue
+		}
 		indexInfos, err := c.broker.ListIndexes(ctx, collectionID)
 		if err != nil {
 			log.Warn("failed to list indexes", zap.Int64("collection", collectionID), zap.Error(err))
@@ -0,0 +1,251 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/balance"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+var _ Checker = (*LeaderBalanceChecker)(nil)
+
+// LeaderBalanceChecker checks the shard leader load of each replica,
+// and transfers the leadership from the overloaded nodes to the followers which already hold the channel's data.
+type LeaderBalanceChecker struct {
+	*checkerActivation
+	meta      *meta.Meta
+	dist      *meta.DistributionManager
+	target    *meta.TargetManager
+	nodeMgr   *session.NodeManager
+	scheduler task.Scheduler
+}
+
+func NewLeaderBalanceChecker(
+	meta *meta.Meta,
+	dist *meta.DistributionManager,
+	target *meta.TargetManager,
+	nodeMgr *session.NodeManager,
+	scheduler task.Scheduler,
+) *LeaderBalanceChecker {
+	return &LeaderBalanceChecker{
+		checkerActivation: newCheckerActivation(),
+		meta:              meta,
+		dist:              dist,
+		target:            target,
+		nodeMgr:           nodeMgr,
+		scheduler:         scheduler,
+	}
+}
+
+func (c *LeaderBalanceChecker) ID() utils.CheckerType {
+	return utils.LeaderBalanceChecker
+}
+
+func (c *LeaderBalanceChecker) Description() string {
+	return "LeaderBalanceChecker checks the shard leader count and qps of nodes, and moves the leaders out of the overloaded nodes"
+}
+
+func (c *LeaderBalanceChecker) readyToCheck(collectionID int64) bool {
+	collection := c.meta.GetCollection(collectionID)
+	// only the leaders of fully loaded collection could be switched
+	loaded := collection != nil && collection.GetStatus() == querypb.LoadStatus_Loaded
+	targetExist := c.target.IsCurrentTargetExist(collectionID)
+	// checks of frozen collection are suspended
+	frozen := c.meta.IsFrozen(collectionID)
+
+	return loaded && targetExist && !frozen
+}
+
+func (c *LeaderBalanceChecker) Check(ctx context.Context) []task.Task {
+	if !c.IsActive() || !Params.QueryCoordCfg.EnableLeaderBalance.GetAsBool() {
+		return nil
+	}
+	// switch one leader at a time, wait for the previous channel tasks done
+	if c.scheduler.GetChannelTaskNum() != 0 {
+		return nil
+	}
+
+	collectionIDs := c.meta.CollectionManager.GetAll()
+	sort.Slice(collectionIDs, func(i, j int) bool {
+		return collectionIDs[i] < collectionIDs[j]
+	})
+
+	plans := make([]balance.ChannelAssignPlan, 0)
+	for _, collectionID := range collectionIDs {
+		if !c.readyToCheck(collectionID) {
+			continue
+		}
+		for _, replica := range c.meta.ReplicaManager.GetByCollection(collectionID) {
+			if plan := c.balanceReplica(replica); plan != nil {
+				plans = append(plans, *plan)
+			}
+		}
+	}
+
+	tasks := balance.CreateChannelTasksFromPlans(ctx, c.ID(), Params.QueryCoordCfg.ChannelTaskTimeout.GetAsDuration(time.Millisecond), plans)
+	task.SetPriority(task.TaskPriorityLow, tasks...)
+	task.SetReason("shard leader overloaded", tasks...)
+	return tasks
+}
+
+type leaderLoad struct {
+	count int
+	qps   float64
+}
+
+// balanceReplica returns a plan moving one shard leader out of the most overloaded node of the replica,
+// returns nil if the replica is balanced or no follower could take over.
+func (c *LeaderBalanceChecker) balanceReplica(replica *meta.Replica) *balance.ChannelAssignPlan {
+	nodes := lo.Filter(replica.GetNodes(), func(node int64, _ int) bool {
+		stopping, err := c.nodeMgr.IsStoppingNode(node)
+		return err == nil && !stopping
+	})
+	if len(nodes) < 2 {
+		return nil
+	}
+
+	// the load of node counts all the leaders on it, no matter which collection they belong to
+	loads := make(map[int64]*leaderLoad)
+	minCount := -1
+	totalQPS := 0.0
+	for _, node := range nodes {
+		load := &leaderLoad{}
+		for _, view := range c.dist.LeaderViewManager.GetLeaderView(node) {
+			load.count++
+			load.qps += view.QPS
+		}
+		loads[node] = load
+		if minCount == -1 || load.count < minCount {
+			minCount = load.count
+		}
+		totalQPS += load.qps
+	}
+	avgQPS := totalQPS / float64(len(nodes))
+	countThreshold := Params.QueryCoordCfg.LeaderBalanceCountThreshold.GetAsInt()
+	qpsFactor := Params.QueryCoordCfg.LeaderBalanceQPSFactor.GetAsFloat()
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if loads[nodes[i]].count != loads[nodes[j]].count {
+			return loads[nodes[i]].count > loads[nodes[j]].count
+		}
+		return loads[nodes[i]].qps > loads[nodes[j]].qps
+	})
+
+	leaders := c.dist.ChannelDistManager.GetShardLeadersByReplica(replica)
+	for _, source := range nodes {
+		load := loads[source]
+		countOverloaded := load.count-minCount >= countThreshold
+		qpsOverloaded := avgQPS > 0 && load.qps > avgQPS*qpsFactor
+		if !countOverloaded && !qpsOverloaded {
+			continue
+		}
+
+		// try the hottest leader first
+		views := make([]*meta.LeaderView, 0)
+		for channel, leader := range leaders {
+			if leader != source {
+				continue
+			}
+			if view := c.dist.LeaderViewManager.GetLeaderShardView(source, channel); view != nil {
+				views = append(views, view)
+			}
+		}
+		sort.Slice(views, func(i, j int) bool {
+			if views[i].QPS != views[j].QPS {
+				return views[i].QPS > views[j].QPS
+			}
+			return views[i].Channel < views[j].Channel
+		})
+
+		for _, view := range views {
+			target := c.selectFollower(replica, view, source, nodes, loads, countOverloaded)
+			if target == -1 {
+				continue
+			}
+			channels := c.dist.ChannelDistManager.GetByFilter(meta.WithChannelName2Channel(view.Channel), meta.WithNodeID2Channel(source))
+			if len(channels) == 0 {
+				continue
+			}
+			log.Info("shard leader overloaded, move it to follower",
+				zap.Int64("collectionID", replica.GetCollectionID()),
+				zap.Int64("replicaID", replica.GetID()),
+				zap.String("channel", view.Channel),
+				zap.Int64("from", source),
+				zap.Int64("to", target),
+				zap.Int("leaderCount", load.count),
+				zap.Float64("leaderQPS", load.qps))
+			return &balance.ChannelAssignPlan{
+				Channel: channels[0],
+				Replica: replica,
+				From:    source,
+				To:      target,
+			}
+		}
+	}
+	return nil
+}
+
+// selectFollower returns the follower holding most sealed segments of the channel, which is less loaded than the source,
+// returns -1 if not found.
+func (c *LeaderBalanceChecker) selectFollower(replica *meta.Replica, view *meta.LeaderView, source int64,
+	nodes []int64, loads map[int64]*leaderLoad, byCount bool,
+) int64 {
+	hasSealed := len(c.dist.SegmentDistManager.GetByFilter(meta.WithChannel(view.Channel), meta.WithReplica(replica))) > 0
+
+	target := int64(-1)
+	targetSegmentNum := 0
+	for _, node := range nodes {
+		if node == source {
+			continue
+		}
+		load := loads[node]
+		if byCount && load.count+1 >= loads[source].count {
+			continue
+		}
+		if !byCount && load.qps+view.QPS >= loads[source].qps {
+			continue
+		}
+
+		segmentNum := len(c.dist.SegmentDistManager.GetByFilter(
+			meta.WithChannel(view.Channel),
+			meta.WithCollectionID(replica.GetCollectionID()),
+			meta.WithNodeID(node)))
+		// the new leader shall already hold the channel's data
+		if hasSealed && segmentNum == 0 {
+			continue
+		}
+		if target == -1 || segmentNum > targetSegmentNum ||
+			(segmentNum == targetSegmentNum && load.count < loads[target].count) {
+			target = node
+			targetSegmentNum = segmentNum
+		}
+	}
+	return target
+}
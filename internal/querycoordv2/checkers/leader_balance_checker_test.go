@@ -0,0 +1,213 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checkers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type LeaderBalanceCheckerTestSuite struct {
+	suite.Suite
+	checker *LeaderBalanceChecker
+	kv      kv.MetaKv
+
+	meta      *meta.Meta
+	broker    *meta.MockBroker
+	nodeMgr   *session.NodeManager
+	scheduler *task.MockScheduler
+}
+
+func (suite *LeaderBalanceCheckerTestSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *LeaderBalanceCheckerTestSuite) SetupTest() {
+	var err error
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	// meta
+	store := querycoord.NewCatalog(suite.kv)
+	idAllocator := RandomIncrementIDAllocator()
+	suite.nodeMgr = session.NewNodeManager()
+	suite.meta = meta.NewMeta(idAllocator, store, suite.nodeMgr)
+	suite.broker = meta.NewMockBroker(suite.T())
+	suite.scheduler = task.NewMockScheduler(suite.T())
+
+	distManager := meta.NewDistributionManager()
+	targetManager := meta.NewTargetManager(suite.broker, suite.meta)
+	suite.checker = NewLeaderBalanceChecker(suite.meta, distManager, targetManager, suite.nodeMgr, suite.scheduler)
+
+	paramtable.Get().Save(Params.QueryCoordCfg.EnableLeaderBalance.Key, "true")
+}
+
+func (suite *LeaderBalanceCheckerTestSuite) TearDownTest() {
+	paramtable.Get().Reset(Params.QueryCoordCfg.EnableLeaderBalance.Key)
+	suite.kv.Close()
+}
+
+// prepare loads collection 1 with one replica on node 1, 2, 3,
+// all the 3 channels are led by node 1, the sealed segments of channel-1 are on node 2 and 3
+func (suite *LeaderBalanceCheckerTestSuite) prepare() {
+	checker := suite.checker
+	collection := utils.CreateTestCollection(1, 1)
+	collection.Status = querypb.LoadStatus_Loaded
+	checker.meta.CollectionManager.PutCollection(collection)
+	checker.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2, 3}))
+	for _, node := range []int64{1, 2, 3} {
+		suite.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+			NodeID:   node,
+			Address:  "localhost",
+			Hostname: "localhost",
+		}))
+	}
+
+	channels := []*datapb.VchannelInfo{
+		{CollectionID: 1, ChannelName: "channel-1"},
+		{CollectionID: 1, ChannelName: "channel-2"},
+		{CollectionID: 1, ChannelName: "channel-3"},
+	}
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, PartitionID: 1, InsertChannel: "channel-1"},
+		{ID: 2, PartitionID: 1, InsertChannel: "channel-1"},
+		{ID: 3, PartitionID: 1, InsertChannel: "channel-1"},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(channels, segments, nil)
+	checker.target.UpdateCollectionNextTarget(1)
+	checker.target.UpdateCollectionCurrentTarget(1)
+
+	checker.dist.SegmentDistManager.Update(2, utils.CreateTestSegment(1, 1, 1, 2, 1, "channel-1"))
+	checker.dist.SegmentDistManager.Update(3,
+		utils.CreateTestSegment(1, 1, 2, 3, 1, "channel-1"),
+		utils.CreateTestSegment(1, 1, 3, 3, 1, "channel-1"))
+
+	views := make([]*meta.LeaderView, 0)
+	dmChannels := make([]*meta.DmChannel, 0)
+	for i, channel := range channels {
+		view := utils.CreateTestLeaderView(1, 1, channel.GetChannelName(), map[int64]int64{}, map[int64]*meta.Segment{})
+		view.QPS = float64(10 * (len(channels) - i))
+		views = append(views, view)
+		dmChannels = append(dmChannels, utils.CreateTestChannel(1, 1, 1, channel.GetChannelName()))
+	}
+	checker.dist.LeaderViewManager.Update(1, views...)
+	checker.dist.ChannelDistManager.Update(1, dmChannels...)
+}
+
+func (suite *LeaderBalanceCheckerTestSuite) TestMoveLeader() {
+	suite.prepare()
+	suite.scheduler.EXPECT().GetChannelTaskNum().Return(0)
+
+	tasks := suite.checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.Equal(utils.LeaderBalanceChecker, tasks[0].Source())
+	suite.Equal(task.TaskPriorityLow, tasks[0].Priority())
+	suite.Equal("channel-1", tasks[0].(*task.ChannelTask).Channel())
+	suite.Len(tasks[0].Actions(), 2)
+	// node 3 holds most data of channel-1
+	suite.Equal(task.ActionTypeGrow, tasks[0].Actions()[0].Type())
+	suite.Equal(int64(3), tasks[0].Actions()[0].Node())
+	suite.Equal(task.ActionTypeReduce, tasks[0].Actions()[1].Type())
+	suite.Equal(int64(1), tasks[0].Actions()[1].Node())
+}
+
+func (suite *LeaderBalanceCheckerTestSuite) TestFollowerWithoutData() {
+	suite.prepare()
+	suite.scheduler.EXPECT().GetChannelTaskNum().Return(0)
+
+	// no follower holds the data of channel-1, channel-2 has no sealed segment and could be moved
+	suite.checker.dist.SegmentDistManager.Update(2)
+	suite.checker.dist.SegmentDistManager.Update(3)
+	suite.checker.dist.SegmentDistManager.Update(1, utils.CreateTestSegment(1, 1, 1, 1, 1, "channel-1"))
+
+	tasks := suite.checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.Equal("channel-2", tasks[0].(*task.ChannelTask).Channel())
+}
+
+func (suite *LeaderBalanceCheckerTestSuite) TestQPSOverloaded() {
+	suite.prepare()
+	suite.scheduler.EXPECT().GetChannelTaskNum().Return(0)
+	paramtable.Get().Save(Params.QueryCoordCfg.LeaderBalanceCountThreshold.Key, "10")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.LeaderBalanceCountThreshold.Key)
+
+	tasks := suite.checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.Equal("channel-1", tasks[0].(*task.ChannelTask).Channel())
+
+	// no qps, the leaders are balanced
+	for _, view := range suite.checker.dist.LeaderViewManager.GetLeaderView(1) {
+		view.QPS = 0
+	}
+	tasks = suite.checker.Check(context.TODO())
+	suite.Len(tasks, 0)
+}
+
+func (suite *LeaderBalanceCheckerTestSuite) TestSkipCheck() {
+	suite.prepare()
+
+	// scheduler busy
+	suite.scheduler.EXPECT().GetChannelTaskNum().Return(1).Once()
+	suite.Len(suite.checker.Check(context.TODO()), 0)
+
+	// frozen collection
+	suite.scheduler.EXPECT().GetChannelTaskNum().Return(0)
+	suite.NoError(suite.checker.meta.SetFrozen(1, true))
+	suite.Len(suite.checker.Check(context.TODO()), 0)
+	suite.NoError(suite.checker.meta.SetFrozen(1, false))
+
+	// disabled
+	paramtable.Get().Save(Params.QueryCoordCfg.EnableLeaderBalance.Key, "false")
+	suite.Len(suite.checker.Check(context.TODO()), 0)
+	paramtable.Get().Save(Params.QueryCoordCfg.EnableLeaderBalance.Key, "true")
+
+	// deactivated
+	suite.checker.Deactivate()
+	suite.Len(suite.checker.Check(context.TODO()), 0)
+	suite.checker.Activate()
+	suite.Len(suite.checker.Check(context.TODO()), 1)
+}
+
+func TestLeaderBalanceCheckerSuite(t *testing.T) {
+	suite.Run(t, new(LeaderBalanceCheckerTestSuite))
+}
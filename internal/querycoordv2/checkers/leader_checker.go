@@ -70,8 +70,10 @@ func (c *LeaderChecker) Description() string {
 func (c *LeaderChecker) readyToCheck(collectionID int64) bool {
 	metaExist := (c.meta.GetCollection(collectionID) != nil)
 	targetExist := c.target.IsNextTargetExist(collectionID) || c.target.IsCurrentTargetExist(collectionID)
+	// checks of frozen collection are suspended
+	frozen := c.meta.IsFrozen(collectionID)
 
-	return metaExist && targetExist
+	return metaExist && targetExist && !frozen
 }
 
 func (c *LeaderChecker) Check(ctx context.Context) []task.Task {
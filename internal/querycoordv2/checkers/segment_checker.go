@@ -75,8 +75,10 @@ func (c *SegmentChecker) Description() string {
 func (c *SegmentChecker) readyToCheck(collectionID int64) bool {
 	metaExist := (c.meta.GetCollection(collectionID) != nil)
 	targetExist := c.targetMgr.IsNextTargetExist(collectionID) || c.targetMgr.IsCurrentTargetExist(collectionID)
+	// checks of frozen collection are suspended
+	frozen := c.meta.IsFrozen(collectionID)
 
-	return metaExist && targetExist
+	return metaExist && targetExist && !frozen
 }
 
 func (c *SegmentChecker) Check(ctx context.Context) []task.Task {
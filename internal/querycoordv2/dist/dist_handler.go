@@ -102,7 +102,10 @@ func (dh *distHandler) handleDistResp(resp *querypb.GetDataDistributionResponse)
 	dh.updateChannelsDistribution(resp)
 	dh.updateLeaderView(resp)
 
-	dh.scheduler.Dispatch(dh.nodeID)
+	// scheduler is nil for the dist handler of a standby QueryCoord
+	if dh.scheduler != nil {
+		dh.scheduler.Dispatch(dh.nodeID)
+	}
 }
 
 func (dh *distHandler) updateSegmentsDistribution(resp *querypb.GetDataDistributionResponse) {
@@ -200,6 +203,7 @@ func (dh *distHandler) updateLeaderView(resp *querypb.GetDataDistributionRespons
 			GrowingSegments:  segments,
 			TargetVersion:    lview.TargetVersion,
 			NumOfGrowingRows: lview.GetNumOfGrowingRows(),
+			QPS:              lview.GetQps(),
 		}
 		updates = append(updates, view)
 	}
@@ -269,6 +269,10 @@ func (s *Server) getSystemInfoMetrics(
 	metricsinfo.FillDeployMetricsWithEnv(&clusterTopology.Self.SystemInfo)
 	nodesMetrics := s.tryGetNodesMetrics(ctx, req, s.nodeMgr.GetAll()...)
 	s.fillMetricsWithNodes(&clusterTopology, nodesMetrics)
+	clusterTopology.CollectionSearchStats = metricsinfo.AggregateSegmentSearchStats(
+		lo.FlatMap(clusterTopology.ConnectedNodes, func(node metricsinfo.QueryNodeInfos, _ int) []metricsinfo.SegmentSearchStats {
+			return node.SegmentSearchStats
+		}))
 
 	coordTopology := metricsinfo.QueryCoordTopology{
 		Cluster: clusterTopology,
@@ -88,6 +88,11 @@ func (job *LoadCollectionJob) PreExecute() error {
 		req.ReplicaNumber = 1
 	}
 
+	if _, err := typeutil.GetLoadFieldIDs(req.GetSchema()); err != nil {
+		log.Warn("invalid load fields", zap.Error(err))
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+
 	collection := job.meta.GetCollection(req.GetCollectionID())
 	if collection == nil {
 		return nil
@@ -270,6 +275,11 @@ func (job *LoadPartitionJob) PreExecute() error {
 		req.ReplicaNumber = 1
 	}
 
+	if _, err := typeutil.GetLoadFieldIDs(req.GetSchema()); err != nil {
+		log.Warn("invalid load fields", zap.Error(err))
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+
 	collection := job.meta.GetCollection(req.GetCollectionID())
 	if collection == nil {
 		return nil
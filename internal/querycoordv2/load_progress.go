@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// loadProgressCollector breaks the load progress of a collection into stages,
+// the finished/total of each stage is counted by (replica, segment) or (replica, channel) pairs
+type loadProgressCollector struct {
+	s            *Server
+	collectionID int64
+	partitionSet typeutil.UniqueSet
+
+	stages map[querypb.LoadStage]*querypb.LoadStageProgress
+	nodes  map[int64]*querypb.NodeLoadProgress
+}
+
+func newLoadProgressCollector(s *Server, collectionID int64, partitionIDs []int64) *loadProgressCollector {
+	stages := make(map[querypb.LoadStage]*querypb.LoadStageProgress)
+	for stage := range querypb.LoadStage_name {
+		stages[querypb.LoadStage(stage)] = &querypb.LoadStageProgress{Stage: querypb.LoadStage(stage)}
+	}
+	return &loadProgressCollector{
+		s:            s,
+		collectionID: collectionID,
+		partitionSet: typeutil.NewUniqueSet(partitionIDs...),
+		stages:       stages,
+		nodes:        make(map[int64]*querypb.NodeLoadProgress),
+	}
+}
+
+func (c *loadProgressCollector) node(nodeID int64) *querypb.NodeLoadProgress {
+	node, ok := c.nodes[nodeID]
+	if !ok {
+		node = &querypb.NodeLoadProgress{NodeID: nodeID}
+		c.nodes[nodeID] = node
+	}
+	return node
+}
+
+func (c *loadProgressCollector) Collect() ([]*querypb.LoadStageProgress, []*querypb.NodeLoadProgress) {
+	replicaNum := int64(len(c.s.meta.ReplicaManager.GetByCollection(c.collectionID)))
+
+	scope := meta.NextTarget
+	if !c.s.targetMgr.IsNextTargetExist(c.collectionID) {
+		scope = meta.CurrentTarget
+	}
+	targetBuilt := c.stages[querypb.LoadStage_TargetBuilt]
+	targetBuilt.Total = 1
+	if c.s.targetMgr.IsNextTargetExist(c.collectionID) || c.s.targetMgr.IsCurrentTargetExist(c.collectionID) {
+		targetBuilt.Finished = 1
+	}
+
+	segments := lo.PickBy(c.s.targetMgr.GetSealedSegmentsByCollection(c.collectionID, scope), func(_ int64, segment *datapb.SegmentInfo) bool {
+		return c.partitionSet.Len() == 0 || c.partitionSet.Contain(segment.GetPartitionID())
+	})
+	c.collectSegments(segments, replicaNum)
+	c.collectChannels(segments, c.s.targetMgr.GetDmChannelsByCollection(c.collectionID, scope), replicaNum)
+
+	stages := lo.Values(c.stages)
+	for _, stage := range stages {
+		stage.Progress = 100
+		if stage.GetTotal() > 0 {
+			stage.Progress = stage.GetFinished() * 100 / stage.GetTotal()
+		}
+	}
+	sort.Slice(stages, func(i, j int) bool { return stages[i].GetStage() < stages[j].GetStage() })
+	nodes := lo.Values(c.nodes)
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].GetNodeID() < nodes[j].GetNodeID() })
+	return stages, nodes
+}
+
+func (c *loadProgressCollector) collectSegments(segments map[int64]*datapb.SegmentInfo, replicaNum int64) {
+	taskDist := c.s.taskScheduler.GetSegmentTaskDist(c.collectionID)
+	segmentDist := lo.GroupBy(c.s.dist.SegmentDistManager.GetByFilter(meta.WithCollectionID(c.collectionID)), func(segment *meta.Segment) int64 {
+		return segment.GetID()
+	})
+	fieldIndexes := c.s.meta.CollectionManager.GetFieldIndex(c.collectionID)
+	indexReady := func(segment *meta.Segment) bool {
+		for fieldID := range fieldIndexes {
+			if _, ok := segment.IndexInfo[fieldID]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+
+	dispatched := c.stages[querypb.LoadStage_SegmentsDispatched]
+	loaded := c.stages[querypb.LoadStage_SegmentsLoaded]
+	warmup := c.stages[querypb.LoadStage_IndexWarmup]
+	for segmentID := range segments {
+		dispatched.Total += replicaNum
+		loaded.Total += replicaNum
+		warmup.Total += replicaNum
+
+		loadedNodes := lo.Map(segmentDist[segmentID], func(segment *meta.Segment, _ int) int64 { return segment.Node })
+		readyNodes := lo.FilterMap(segmentDist[segmentID], func(segment *meta.Segment, _ int) (int64, bool) {
+			return segment.Node, indexReady(segment)
+		})
+		dispatchedNodes := lo.Union(taskDist[segmentID], loadedNodes)
+
+		dispatched.Finished += int64(len(utils.GroupNodesByReplica(c.s.meta.ReplicaManager, c.collectionID, dispatchedNodes)))
+		loaded.Finished += int64(len(utils.GroupNodesByReplica(c.s.meta.ReplicaManager, c.collectionID, loadedNodes)))
+		warmup.Finished += int64(len(utils.GroupNodesByReplica(c.s.meta.ReplicaManager, c.collectionID, readyNodes)))
+
+		for _, nodeID := range dispatchedNodes {
+			c.node(nodeID).DispatchedSegmentNum++
+		}
+		for _, nodeID := range loadedNodes {
+			c.node(nodeID).LoadedSegmentNum++
+		}
+		for _, nodeID := range readyNodes {
+			c.node(nodeID).IndexReadySegmentNum++
+		}
+	}
+}
+
+// collectChannels counts the delegators which could serve the target segments of its channel
+func (c *loadProgressCollector) collectChannels(segments map[int64]*datapb.SegmentInfo, channels map[string]*meta.DmChannel, replicaNum int64) {
+	serviceable := c.stages[querypb.LoadStage_DelegatorServiceable]
+	serviceable.Total = int64(len(channels)) * replicaNum
+
+	channelSegments := lo.GroupBy(lo.Values(segments), func(segment *datapb.SegmentInfo) string {
+		return segment.GetInsertChannel()
+	})
+	for _, replica := range c.s.meta.ReplicaManager.GetByCollection(c.collectionID) {
+		for channel := range channels {
+			view := c.s.dist.LeaderViewManager.GetLatestLeadersByReplicaShard(replica, channel)
+			if view == nil {
+				continue
+			}
+			node := c.node(view.ID)
+			node.ChannelNum++
+			ready := lo.EveryBy(channelSegments[channel], func(segment *datapb.SegmentInfo) bool {
+				_, ok := view.Segments[segment.GetID()]
+				return ok
+			})
+			if ready {
+				serviceable.Finished++
+				node.ServiceableChannelNum++
+			}
+		}
+	}
+}
@@ -524,6 +524,33 @@ func (m *CollectionManager) UpdateLoadPercent(partitionID int64, loadPercent int
 	return collectionPercent, m.putCollection(saveCollection, newCollection)
 }
 
+// SetFrozen freezes or unfreezes the collection, and persists the state,
+// the target, checkers and balance of a frozen collection are suspended
+func (m *CollectionManager) SetFrozen(collectionID typeutil.UniqueID, frozen bool) error {
+	m.rwmutex.Lock()
+	defer m.rwmutex.Unlock()
+
+	oldCollection, ok := m.collections[collectionID]
+	if !ok {
+		return merr.WrapErrCollectionNotLoaded(collectionID)
+	}
+	if oldCollection.GetFrozen() == frozen {
+		return nil
+	}
+
+	newCollection := oldCollection.Clone()
+	newCollection.Frozen = frozen
+	return m.putCollection(true, newCollection)
+}
+
+func (m *CollectionManager) IsFrozen(collectionID typeutil.UniqueID) bool {
+	m.rwmutex.RLock()
+	defer m.rwmutex.RUnlock()
+
+	collection, ok := m.collections[collectionID]
+	return ok && collection.GetFrozen()
+}
+
 // RemoveCollection removes collection and its partitions.
 func (m *CollectionManager) RemoveCollection(collectionID typeutil.UniqueID) error {
 	m.rwmutex.Lock()
@@ -42,6 +42,7 @@ import (
 type Broker interface {
 	DescribeCollection(ctx context.Context, collectionID UniqueID) (*milvuspb.DescribeCollectionResponse, error)
 	GetPartitions(ctx context.Context, collectionID UniqueID) ([]UniqueID, error)
+	GetPartitionKeyBuckets(ctx context.Context, collectionID UniqueID) ([]UniqueID, error)
 	GetRecoveryInfo(ctx context.Context, collectionID UniqueID, partitionID UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentBinlogs, error)
 	ListIndexes(ctx context.Context, collectionID UniqueID) ([]*indexpb.IndexInfo, error)
 	GetSegmentInfo(ctx context.Context, segmentID ...UniqueID) (*datapb.GetSegmentInfoResponse, error)
@@ -103,6 +104,35 @@ func (broker *CoordinatorBroker) GetPartitions(ctx context.Context, collectionID
 	return resp.PartitionIDs, nil
 }
 
+// GetPartitionKeyBuckets returns the partition IDs in the order of the hash buckets of the partition key.
+func (broker *CoordinatorBroker) GetPartitionKeyBuckets(ctx context.Context, collectionID UniqueID) ([]UniqueID, error) {
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	req := &milvuspb.ShowPartitionsRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_ShowPartitions),
+		),
+		CollectionID: collectionID,
+	}
+	resp, err := broker.rootCoord.ShowPartitions(ctx, req)
+	if err := merr.CheckRPCCall(resp, err); err != nil {
+		log.Warn("failed to get partitions", zap.Error(err))
+		return nil, err
+	}
+
+	partitions := make(map[string]int64, len(resp.GetPartitionIDs()))
+	for i, name := range resp.GetPartitionNames() {
+		partitions[name] = resp.GetPartitionIDs()[i]
+	}
+	_, buckets, err := RearrangePartitionsForPartitionKey(partitions)
+	if err != nil {
+		log.Warn("failed to arrange the partitions of partition key", zap.Error(err))
+		return nil, err
+	}
+	return buckets, nil
+}
+
 func (broker *CoordinatorBroker) GetRecoveryInfo(ctx context.Context, collectionID UniqueID, partitionID UniqueID) ([]*datapb.VchannelInfo, []*datapb.SegmentBinlogs, error) {
 	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
 	defer cancel()
@@ -129,6 +129,47 @@ func (s *CoordinatorBrokerRootCoordSuite) TestGetPartitions() {
 	})
 }
 
+func (s *CoordinatorBrokerRootCoordSuite) TestGetPartitionKeyBuckets() {
+	ctx := context.Background()
+	collection := int64(100)
+
+	s.Run("normal_case", func() {
+		// the partition IDs are not in the order of the buckets
+		s.rootcoord.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+			Status:         merr.Status(nil),
+			PartitionNames: []string{"_default_1", "_default_0", "_default_2"},
+			PartitionIDs:   []int64{10, 12, 11},
+		}, nil)
+
+		buckets, err := s.broker.GetPartitionKeyBuckets(ctx, collection)
+		s.NoError(err)
+		s.Equal([]int64{12, 10, 11}, buckets)
+		s.resetMock()
+	})
+
+	s.Run("bad_partition_name", func() {
+		s.rootcoord.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+			Status:         merr.Status(nil),
+			PartitionNames: []string{"_default_0", "p1"},
+			PartitionIDs:   []int64{10, 11},
+		}, nil)
+
+		_, err := s.broker.GetPartitionKeyBuckets(ctx, collection)
+		s.Error(err)
+		s.resetMock()
+	})
+
+	s.Run("collection_not_exist", func() {
+		s.rootcoord.EXPECT().ShowPartitions(mock.Anything, mock.Anything).Return(&milvuspb.ShowPartitionsResponse{
+			Status: merr.Status(merr.WrapErrCollectionNotFound("mock")),
+		}, nil)
+
+		_, err := s.broker.GetPartitionKeyBuckets(ctx, collection)
+		s.ErrorIs(err, merr.ErrCollectionNotFound)
+		s.resetMock()
+	})
+}
+
 type CoordinatorBrokerDataCoordSuite struct {
 	suite.Suite
 
@@ -33,6 +33,7 @@ type LeaderView struct {
 	GrowingSegments  map[int64]*Segment
 	TargetVersion    int64
 	NumOfGrowingRows int64
+	QPS              float64
 }
 
 func (view *LeaderView) Clone() *LeaderView {
@@ -55,6 +56,7 @@ func (view *LeaderView) Clone() *LeaderView {
 		GrowingSegments:  growings,
 		TargetVersion:    view.TargetVersion,
 		NumOfGrowingRows: view.NumOfGrowingRows,
+		QPS:              view.QPS,
 	}
 }
 
@@ -63,6 +65,9 @@ type channelViews map[string]*LeaderView
 type LeaderViewManager struct {
 	rwmutex sync.RWMutex
 	views   map[int64]channelViews // LeaderID -> Views (one per shard)
+
+	// notifyFunc is called with the collections whose shard leaders changed
+	notifyFunc func(collectionIDs ...int64)
 }
 
 func NewLeaderViewManager() *LeaderViewManager {
@@ -93,13 +98,42 @@ func (mgr *LeaderViewManager) GetSegmentByNode(nodeID int64) []int64 {
 	return segments
 }
 
+// SetNotifyFunc sets the function to notify the collections whose shard leaders changed,
+// the shard leaders of a collection change once a leader starts or stops serving a channel of it.
+func (mgr *LeaderViewManager) SetNotifyFunc(notifyFunc func(collectionIDs ...int64)) {
+	mgr.rwmutex.Lock()
+	defer mgr.rwmutex.Unlock()
+	mgr.notifyFunc = notifyFunc
+}
+
 // Update updates the leader's views, all views have to be with the same leader ID
 func (mgr *LeaderViewManager) Update(leaderID int64, views ...*LeaderView) {
 	mgr.rwmutex.Lock()
-	defer mgr.rwmutex.Unlock()
-	mgr.views[leaderID] = make(channelViews, len(views))
+	oldViews := mgr.views[leaderID]
+	newViews := make(channelViews, len(views))
 	for _, view := range views {
-		mgr.views[leaderID][view.Channel] = view
+		newViews[view.Channel] = view
+	}
+	mgr.views[leaderID] = newViews
+	notifyFunc := mgr.notifyFunc
+	mgr.rwmutex.Unlock()
+
+	if notifyFunc == nil {
+		return
+	}
+	changed := make(map[int64]struct{})
+	for channel, view := range oldViews {
+		if _, ok := newViews[channel]; !ok {
+			changed[view.CollectionID] = struct{}{}
+		}
+	}
+	for channel, view := range newViews {
+		if _, ok := oldViews[channel]; !ok {
+			changed[view.CollectionID] = struct{}{}
+		}
+	}
+	if len(changed) > 0 {
+		notifyFunc(lo.Keys(changed)...)
 	}
 }
 
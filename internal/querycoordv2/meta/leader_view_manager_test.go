@@ -166,6 +166,27 @@ func (suite *LeaderViewManagerSuite) TestGetLeader() {
 	suite.Len(leaders, 1)
 }
 
+func (suite *LeaderViewManagerSuite) TestNotifyLeaderChanged() {
+	mgr := suite.mgr
+	var notified []int64
+	mgr.SetNotifyFunc(func(collectionIDs ...int64) {
+		notified = append(notified, collectionIDs...)
+	})
+
+	// the views updated without the channels changed
+	mgr.Update(1, lo.Values(suite.leaders[1])...)
+	suite.Empty(notified)
+
+	// leader 3 starts to serve a channel
+	mgr.Update(3, &LeaderView{ID: 3, CollectionID: 100, Channel: "100-dmc0"})
+	suite.ElementsMatch([]int64{100}, notified)
+
+	// leader 3 goes offline
+	notified = nil
+	mgr.Update(3)
+	suite.ElementsMatch([]int64{100}, notified)
+}
+
 func (suite *LeaderViewManagerSuite) AssertSegmentDist(segment int64, nodes []int64) bool {
 	nodeSet := typeutil.NewUniqueSet(nodes...)
 	for leader, views := range suite.leaders {
@@ -139,6 +139,61 @@ func (_c *MockBroker_GetIndexInfo_Call) RunAndReturn(run func(context.Context, i
 	return _c
 }
 
+// GetPartitionKeyBuckets provides a mock function with given fields: ctx, collectionID
+func (_m *MockBroker) GetPartitionKeyBuckets(ctx context.Context, collectionID int64) ([]int64, error) {
+	ret := _m.Called(ctx, collectionID)
+
+	var r0 []int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]int64, error)); ok {
+		return rf(ctx, collectionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []int64); ok {
+		r0 = rf(ctx, collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, collectionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockBroker_GetPartitionKeyBuckets_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartitionKeyBuckets'
+type MockBroker_GetPartitionKeyBuckets_Call struct {
+	*mock.Call
+}
+
+// GetPartitionKeyBuckets is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+func (_e *MockBroker_Expecter) GetPartitionKeyBuckets(ctx interface{}, collectionID interface{}) *MockBroker_GetPartitionKeyBuckets_Call {
+	return &MockBroker_GetPartitionKeyBuckets_Call{Call: _e.mock.On("GetPartitionKeyBuckets", ctx, collectionID)}
+}
+
+func (_c *MockBroker_GetPartitionKeyBuckets_Call) Run(run func(ctx context.Context, collectionID int64)) *MockBroker_GetPartitionKeyBuckets_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *MockBroker_GetPartitionKeyBuckets_Call) Return(_a0 []int64, _a1 error) *MockBroker_GetPartitionKeyBuckets_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockBroker_GetPartitionKeyBuckets_Call) RunAndReturn(run func(context.Context, int64) ([]int64, error)) *MockBroker_GetPartitionKeyBuckets_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPartitions provides a mock function with given fields: ctx, collectionID
 func (_m *MockBroker) GetPartitions(ctx context.Context, collectionID int64) ([]int64, error) {
 	ret := _m.Called(ctx, collectionID)
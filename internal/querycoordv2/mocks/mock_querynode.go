@@ -1160,6 +1160,104 @@ func (_c *MockQueryNodeServer_ShowConfigurations_Call) RunAndReturn(run func(con
 	return _c
 }
 
+// SubscribeStandingQuery provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryNodeServer) SubscribeStandingQuery(_a0 *querypb.SubscribeStandingQueryRequest, _a1 querypb.QueryNode_SubscribeStandingQueryServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*querypb.SubscribeStandingQueryRequest, querypb.QueryNode_SubscribeStandingQueryServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockQueryNodeServer_SubscribeStandingQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeStandingQuery'
+type MockQueryNodeServer_SubscribeStandingQuery_Call struct {
+	*mock.Call
+}
+
+// SubscribeStandingQuery is a helper method to define mock.On call
+//   - _a0 *querypb.SubscribeStandingQueryRequest
+//   - _a1 querypb.QueryNode_SubscribeStandingQueryServer
+func (_e *MockQueryNodeServer_Expecter) SubscribeStandingQuery(_a0 interface{}, _a1 interface{}) *MockQueryNodeServer_SubscribeStandingQuery_Call {
+	return &MockQueryNodeServer_SubscribeStandingQuery_Call{Call: _e.mock.On("SubscribeStandingQuery", _a0, _a1)}
+}
+
+func (_c *MockQueryNodeServer_SubscribeStandingQuery_Call) Run(run func(_a0 *querypb.SubscribeStandingQueryRequest, _a1 querypb.QueryNode_SubscribeStandingQueryServer)) *MockQueryNodeServer_SubscribeStandingQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*querypb.SubscribeStandingQueryRequest), args[1].(querypb.QueryNode_SubscribeStandingQueryServer))
+	})
+	return _c
+}
+
+func (_c *MockQueryNodeServer_SubscribeStandingQuery_Call) Return(_a0 error) *MockQueryNodeServer_SubscribeStandingQuery_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockQueryNodeServer_SubscribeStandingQuery_Call) RunAndReturn(run func(*querypb.SubscribeStandingQueryRequest, querypb.QueryNode_SubscribeStandingQueryServer) error) *MockQueryNodeServer_SubscribeStandingQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SwitchShardLeader provides a mock function with given fields: _a0, _a1
+func (_m *MockQueryNodeServer) SwitchShardLeader(_a0 context.Context, _a1 *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SwitchShardLeaderRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SwitchShardLeaderRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockQueryNodeServer_SwitchShardLeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwitchShardLeader'
+type MockQueryNodeServer_SwitchShardLeader_Call struct {
+	*mock.Call
+}
+
+// SwitchShardLeader is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *querypb.SwitchShardLeaderRequest
+func (_e *MockQueryNodeServer_Expecter) SwitchShardLeader(_a0 interface{}, _a1 interface{}) *MockQueryNodeServer_SwitchShardLeader_Call {
+	return &MockQueryNodeServer_SwitchShardLeader_Call{Call: _e.mock.On("SwitchShardLeader", _a0, _a1)}
+}
+
+func (_c *MockQueryNodeServer_SwitchShardLeader_Call) Run(run func(_a0 context.Context, _a1 *querypb.SwitchShardLeaderRequest)) *MockQueryNodeServer_SwitchShardLeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.SwitchShardLeaderRequest))
+	})
+	return _c
+}
+
+func (_c *MockQueryNodeServer_SwitchShardLeader_Call) Return(_a0 *commonpb.Status, _a1 error) *MockQueryNodeServer_SwitchShardLeader_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockQueryNodeServer_SwitchShardLeader_Call) RunAndReturn(run func(context.Context, *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error)) *MockQueryNodeServer_SwitchShardLeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SyncDistribution provides a mock function with given fields: _a0, _a1
 func (_m *MockQueryNodeServer) SyncDistribution(_a0 context.Context, _a1 *querypb.SyncDistributionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/lock"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -151,9 +152,14 @@ func (ob *TargetObserver) schedule(ctx context.Context) {
 		case req := <-ob.updateChan:
 			log := log.With(zap.Int64("collectionID", req.CollectionID))
 			log.Info("manually trigger update next target")
-			ob.keylocks.Lock(req.CollectionID)
-			err := ob.updateNextTarget(req.CollectionID)
-			ob.keylocks.Unlock(req.CollectionID)
+			var err error
+			if ob.meta.IsFrozen(req.CollectionID) {
+				err = merr.WrapErrCollectionFrozen(req.CollectionID)
+			} else {
+				ob.keylocks.Lock(req.CollectionID)
+				err = ob.updateNextTarget(req.CollectionID)
+				ob.keylocks.Unlock(req.CollectionID)
+			}
 			if err != nil {
 				log.Warn("failed to manually update next target", zap.Error(err))
 				close(req.ReadyNotifier)
@@ -189,6 +195,11 @@ func (ob *TargetObserver) check(ctx context.Context, collectionID int64) {
 		return
 	}
 
+	// the targets of frozen collection are pinned
+	if ob.meta.IsFrozen(collectionID) {
+		return
+	}
+
 	ob.keylocks.Lock(collectionID)
 	defer ob.keylocks.Unlock(collectionID)
 
@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -213,6 +214,22 @@ func (suite *TargetObserverSuite) TestTriggerUpdateTarget() {
 	}, 7*time.Second, 1*time.Second)
 }
 
+func (suite *TargetObserverSuite) TestFrozenCollection() {
+	suite.Eventually(func() bool {
+		return len(suite.targetMgr.GetSealedSegmentsByCollection(suite.collectionID, meta.NextTarget)) == 2 &&
+			len(suite.targetMgr.GetDmChannelsByCollection(suite.collectionID, meta.NextTarget)) == 2
+	}, 5*time.Second, 1*time.Second)
+
+	// target of frozen collection couldn't be updated
+	suite.NoError(suite.meta.CollectionManager.SetFrozen(suite.collectionID, true))
+	_, err := suite.observer.UpdateNextTarget(suite.collectionID)
+	suite.ErrorIs(err, merr.ErrCollectionFrozen)
+
+	suite.NoError(suite.meta.CollectionManager.SetFrozen(suite.collectionID, false))
+	_, err = suite.observer.UpdateNextTarget(suite.collectionID)
+	suite.NoError(err)
+}
+
 func (suite *TargetObserverSuite) TearDownTest() {
 	suite.kv.Close()
 	suite.observer.Stop()
@@ -174,7 +174,7 @@ func (suite *OpsServiceSuite) TestActiveCheckers() {
 	resp, err = suite.server.ListCheckers(ctx, &querypb.ListCheckersRequest{})
 	suite.NoError(err)
 	suite.True(merr.Ok(resp.Status))
-	suite.Len(resp.GetCheckerInfos(), 5)
+	suite.Len(resp.GetCheckerInfos(), 6)
 
 	resp4, err := suite.server.DeactivateChecker(ctx, &querypb.DeactivateCheckerRequest{
 		CheckerID: int32(utils.ChannelChecker),
@@ -883,6 +883,62 @@ func (suite *OpsServiceSuite) TestTransferChannel() {
 	suite.Len(nodeSet.Collect(), 3)
 }
 
+func (suite *OpsServiceSuite) TestFreezeAndUnfreezeCollection() {
+	ctx := context.Background()
+	collectionID := int64(1)
+
+	// test server unhealthy
+	suite.server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err := suite.server.FreezeCollection(ctx, &querypb.FreezeCollectionRequest{CollectionID: collectionID})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp))
+	resp, err = suite.server.UnfreezeCollection(ctx, &querypb.UnfreezeCollectionRequest{CollectionID: collectionID})
+	suite.NoError(err)
+	suite.False(merr.Ok(resp))
+
+	// test collection not loaded
+	suite.server.UpdateStateCode(commonpb.StateCode_Healthy)
+	resp, err = suite.server.FreezeCollection(ctx, &querypb.FreezeCollectionRequest{CollectionID: collectionID})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrCollectionNotLoaded)
+
+	// test no current target
+	suite.meta.PutCollection(utils.CreateTestCollection(collectionID, 1), utils.CreateTestPartition(collectionID, 1))
+	resp, err = suite.server.FreezeCollection(ctx, &querypb.FreezeCollectionRequest{CollectionID: collectionID})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrCollectionNotFullyLoaded)
+
+	// test freeze success
+	channels := []*datapb.VchannelInfo{{CollectionID: collectionID, ChannelName: "channel-1"}}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, collectionID).Return(channels, nil, nil)
+	suite.targetMgr.UpdateCollectionNextTarget(collectionID)
+	suite.targetMgr.UpdateCollectionCurrentTarget(collectionID)
+	resp, err = suite.server.FreezeCollection(ctx, &querypb.FreezeCollectionRequest{CollectionID: collectionID})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	suite.True(suite.meta.IsFrozen(collectionID))
+
+	// frozen state should be persisted
+	collections, err := suite.store.GetCollections()
+	suite.NoError(err)
+	suite.Len(collections, 1)
+	suite.True(collections[0].GetFrozen())
+
+	// partitions of frozen collection couldn't be released
+	resp, err = suite.server.ReleasePartitions(ctx, &querypb.ReleasePartitionsRequest{
+		CollectionID: collectionID,
+		PartitionIDs: []int64{1},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrCollectionFrozen)
+
+	// test unfreeze success
+	resp, err = suite.server.UnfreezeCollection(ctx, &querypb.UnfreezeCollectionRequest{CollectionID: collectionID})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+	suite.False(suite.meta.IsFrozen(collectionID))
+}
+
 func TestOpsService(t *testing.T) {
 	suite.Run(t, new(OpsServiceSuite))
 }
@@ -462,3 +462,54 @@ func (s *Server) CheckQueryNodeDistribution(ctx context.Context, req *querypb.Ch
 
 	return merr.Success(), nil
 }
+
+// freeze collection, pin its current target and suspend target update, checkers and balance for it
+func (s *Server) FreezeCollection(ctx context.Context, req *querypb.FreezeCollectionRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("FreezeCollection request received")
+
+	errMsg := "failed to freeze collection"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if !s.meta.CollectionManager.Exist(req.GetCollectionID()) {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if !s.targetMgr.IsCurrentTargetExist(req.GetCollectionID()) {
+		err := merr.WrapErrCollectionNotFullyLoaded(req.GetCollectionID(), "no current target to pin")
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := s.meta.CollectionManager.SetFrozen(req.GetCollectionID(), true); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	return merr.Success(), nil
+}
+
+// unfreeze collection, resume target update, checkers and balance for it
+func (s *Server) UnfreezeCollection(ctx context.Context, req *querypb.UnfreezeCollectionRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
+	log.Info("UnfreezeCollection request received")
+
+	errMsg := "failed to unfreeze collection"
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	if err := s.meta.CollectionManager.SetFrozen(req.GetCollectionID(), false); err != nil {
+		log.Warn(errMsg, zap.Error(err))
+		return merr.Status(err), nil
+	}
+	s.checkerController.Check()
+
+	return merr.Success(), nil
+}
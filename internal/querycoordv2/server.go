@@ -52,6 +52,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/task"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -120,6 +121,7 @@ type Server struct {
 	// Active-standby
 	enableActiveStandBy bool
 	activateFunc        func() error
+	standbyWarmer       *standbyWarmer
 
 	nodeUpEventChan chan int64
 	notifyNodeUp    chan struct{}
@@ -179,10 +181,16 @@ func (s *Server) Init() error {
 	if s.enableActiveStandBy {
 		s.activateFunc = func() error {
 			log.Info("QueryCoord switch from standby to active, activating")
+			if s.standbyWarmer != nil {
+				s.standbyWarmer.Stop()
+			}
 			if err := s.initQueryCoord(); err != nil {
 				log.Error("QueryCoord init failed", zap.Error(err))
 				return err
 			}
+			if s.standbyWarmer != nil {
+				s.standbyWarmer.StopMirror()
+			}
 			if err := s.startQueryCoord(); err != nil {
 				log.Error("QueryCoord init failed", zap.Error(err))
 				return err
@@ -190,6 +198,17 @@ func (s *Server) Init() error {
 			log.Info("QueryCoord startup success")
 			return nil
 		}
+		if Params.QueryCoordCfg.EnableStandbyWarmup.GetAsBool() &&
+			Params.MetaStoreCfg.MetaStoreType.GetValue() == util.MetaStoreTypeEtcd {
+			warmer := newStandbyWarmer(s)
+			if err := warmer.Start(); err != nil {
+				// warmup is an optimization, the standby could still take over with full recovery
+				log.Warn("failed to start standby warmup", zap.Error(err))
+				s.kv, s.nodeMgr, s.cluster, s.dist = nil, nil, nil, nil
+			} else {
+				s.standbyWarmer = warmer
+			}
+		}
 		s.UpdateStateCode(commonpb.StateCode_StandBy)
 		log.Info("QueryCoord enter standby mode successfully")
 		return nil
@@ -210,8 +229,11 @@ func (s *Server) initQueryCoord() error {
 			tikv.WithRequestTimeout(paramtable.Get().ServiceParam.TiKVCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
 		idAllocatorKV = tsoutil.NewTSOTiKVBase(s.tikvCli, Params.TiKVCfg.KvRootPath.GetValue(), "querycoord-id-allocator")
 	} else if metaType == util.MetaStoreTypeEtcd {
-		s.kv = etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
-			etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+		// kv may be warmed up while standby
+		if s.kv == nil {
+			s.kv = etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
+				etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+		}
 		idAllocatorKV = tsoutil.NewTSOKVBase(s.etcdCli, Params.EtcdCfg.KvRootPath.GetValue(), "querycoord-id-allocator")
 	} else {
 		return fmt.Errorf("not supported meta store: %s", metaType)
@@ -233,14 +255,18 @@ func (s *Server) initQueryCoord() error {
 	s.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 
 	// Init meta
-	s.nodeMgr = session.NewNodeManager()
+	if s.nodeMgr == nil {
+		s.nodeMgr = session.NewNodeManager()
+	}
 	err = s.initMeta()
 	if err != nil {
 		return err
 	}
 	// Init session
 	log.Info("init session")
-	s.cluster = session.NewCluster(s.nodeMgr, s.queryNodeCreator)
+	if s.cluster == nil {
+		s.cluster = session.NewCluster(s.nodeMgr, s.queryNodeCreator)
+	}
 
 	// Init schedulers
 	log.Info("init schedulers")
@@ -343,10 +369,23 @@ func (s *Server) initMeta() error {
 		return err
 	}
 
-	s.dist = &meta.DistributionManager{
-		SegmentDistManager: meta.NewSegmentDistManager(),
-		ChannelDistManager: meta.NewChannelDistManager(),
-		LeaderViewManager:  meta.NewLeaderViewManager(),
+	if s.dist == nil {
+		s.dist = &meta.DistributionManager{
+			SegmentDistManager: meta.NewSegmentDistManager(),
+			ChannelDistManager: meta.NewChannelDistManager(),
+			LeaderViewManager:  meta.NewLeaderViewManager(),
+		}
+	}
+	if Params.CommonCfg.MetaCacheWatchEnabled.GetAsBool() {
+		publisher := proxyutil.NewMetaEventPublisher(s.etcdCli)
+		s.dist.LeaderViewManager.SetNotifyFunc(func(collectionIDs ...int64) {
+			// don't block the distribution update
+			go func() {
+				if err := publisher.PublishShardLeaderChanged(s.ctx, collectionIDs...); err != nil {
+					log.Warn("failed to push the shard leader changes to proxies", zap.Int64s("collectionIDs", collectionIDs), zap.Error(err))
+				}
+			}()
+		})
 	}
 	s.targetMgr = meta.NewTargetManager(s.broker, s.meta)
 	err = s.targetMgr.Recover(s.store)
@@ -463,6 +502,12 @@ func (s *Server) Stop() error {
 	// job scheduler -> checker controller -> task scheduler -> dist controller -> cluster -> session
 	// observers -> dist controller
 
+	if s.standbyWarmer != nil {
+		log.Info("stop standby warmer...")
+		s.standbyWarmer.Stop()
+		s.standbyWarmer.StopMirror()
+	}
+
 	if s.jobScheduler != nil {
 		log.Info("stop job scheduler...")
 		s.jobScheduler.Stop()
@@ -187,6 +187,59 @@ func (s *Server) ShowPartitions(ctx context.Context, req *querypb.ShowPartitions
 	}, nil
 }
 
+// GetLoadProgressDetail returns the load progress of each loading stage and the per node counts,
+// to help locating where a slow loading is stuck
+func (s *Server) GetLoadProgressDetail(ctx context.Context, req *querypb.GetLoadProgressDetailRequest) (*querypb.GetLoadProgressDetailResponse, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64s("partitionIDs", req.GetPartitionIDs()),
+	)
+
+	log.Info("get load progress detail request received")
+
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		msg := "failed to get load progress detail"
+		log.Warn(msg, zap.Error(err))
+		return &querypb.GetLoadProgressDetailResponse{
+			Status: merr.Status(errors.Wrap(err, msg)),
+		}, nil
+	}
+
+	var progress int32
+	if len(req.GetPartitionIDs()) == 0 {
+		progress = s.meta.CollectionManager.CalculateLoadPercentage(req.GetCollectionID())
+	} else {
+		for _, partitionID := range req.GetPartitionIDs() {
+			percentage := s.meta.GetPartitionLoadPercentage(partitionID)
+			if percentage < 0 {
+				err := merr.WrapErrPartitionNotLoaded(partitionID)
+				log.Warn("get load progress detail failed", zap.Error(err))
+				return &querypb.GetLoadProgressDetailResponse{
+					Status: merr.Status(err),
+				}, nil
+			}
+			progress += percentage
+		}
+		progress /= int32(len(req.GetPartitionIDs()))
+	}
+	if progress < 0 {
+		err := merr.WrapErrCollectionNotLoaded(req.GetCollectionID())
+		log.Warn("get load progress detail failed", zap.Error(err))
+		return &querypb.GetLoadProgressDetailResponse{
+			Status: merr.Status(err),
+		}, nil
+	}
+
+	stages, nodes := newLoadProgressCollector(s, req.GetCollectionID(), req.GetPartitionIDs()).Collect()
+	return &querypb.GetLoadProgressDetailResponse{
+		Status:       merr.Success(),
+		CollectionID: req.GetCollectionID(),
+		Progress:     int64(progress),
+		Stages:       stages,
+		Nodes:        nodes,
+	}, nil
+}
+
 func (s *Server) LoadCollection(ctx context.Context, req *querypb.LoadCollectionRequest) (*commonpb.Status, error) {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", req.GetCollectionID()),
@@ -386,6 +439,13 @@ func (s *Server) ReleasePartitions(ctx context.Context, req *querypb.ReleasePart
 		return merr.Status(err), nil
 	}
 
+	if s.meta.CollectionManager.IsFrozen(req.GetCollectionID()) {
+		err := merr.WrapErrCollectionFrozen(req.GetCollectionID())
+		log.Warn("failed to release partitions", zap.Error(err))
+		metrics.QueryCoordReleaseCount.WithLabelValues(metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
 	tr := timerecord.NewTimeRecorder("release-partitions")
 	releaseJob := job.NewReleasePartitionJob(ctx,
 		req,
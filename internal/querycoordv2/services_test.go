@@ -250,6 +250,49 @@ func (suite *ServiceSuite) TestShowCollections() {
 	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
 }
 
+func (suite *ServiceSuite) TestGetLoadProgressDetail() {
+	suite.loadAll()
+	ctx := context.Background()
+	server := suite.server
+
+	for _, collection := range suite.collections {
+		suite.taskScheduler.EXPECT().GetSegmentTaskDist(collection).Return(nil).Maybe()
+		suite.updateChannelDist(collection)
+		req := &querypb.GetLoadProgressDetailRequest{
+			CollectionID: collection,
+		}
+		resp, err := server.GetLoadProgressDetail(ctx, req)
+		suite.NoError(err)
+		suite.True(merr.Ok(resp.GetStatus()))
+		suite.Len(resp.GetStages(), len(querypb.LoadStage_name))
+		for _, stage := range resp.GetStages() {
+			switch stage.GetStage() {
+			case querypb.LoadStage_TargetBuilt:
+				suite.EqualValues(100, stage.GetProgress())
+			case querypb.LoadStage_SegmentsLoaded:
+				suite.EqualValues(0, stage.GetFinished())
+			}
+		}
+
+		// Test partition not loaded
+		req.PartitionIDs = []int64{-1}
+		resp, err = server.GetLoadProgressDetail(ctx, req)
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrPartitionNotLoaded)
+	}
+
+	// Test collection not loaded
+	resp, err := server.GetLoadProgressDetail(ctx, &querypb.GetLoadProgressDetailRequest{CollectionID: -1})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrCollectionNotLoaded)
+
+	// Test when server is not healthy
+	server.UpdateStateCode(commonpb.StateCode_Initializing)
+	resp, err = server.GetLoadProgressDetail(ctx, &querypb.GetLoadProgressDetailRequest{CollectionID: suite.collections[0]})
+	suite.NoError(err)
+	suite.Equal(resp.GetStatus().GetCode(), merr.Code(merr.ErrServiceNotReady))
+}
+
 func (suite *ServiceSuite) TestShowPartitions() {
 	suite.loadAll()
 	ctx := context.Background()
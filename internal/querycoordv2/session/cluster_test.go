@@ -152,6 +152,10 @@ func (suite *ClusterTestSuite) createDefaultMockServer() querypb.QueryNodeServer
 		mock.Anything,
 		mock.AnythingOfType("*querypb.SyncDistributionRequest"),
 	).Maybe().Return(succStatus, nil)
+	svr.EXPECT().SwitchShardLeader(
+		mock.Anything,
+		mock.AnythingOfType("*querypb.SwitchShardLeaderRequest"),
+	).Maybe().Return(succStatus, nil)
 	svr.EXPECT().GetComponentStates(
 		mock.Anything,
 		mock.AnythingOfType("*milvuspb.GetComponentStatesRequest"),
@@ -205,6 +209,10 @@ func (suite *ClusterTestSuite) createFailedMockServer() querypb.QueryNodeServer
 		mock.Anything,
 		mock.AnythingOfType("*querypb.SyncDistributionRequest"),
 	).Maybe().Return(failStatus, nil)
+	svr.EXPECT().SwitchShardLeader(
+		mock.Anything,
+		mock.AnythingOfType("*querypb.SwitchShardLeaderRequest"),
+	).Maybe().Return(failStatus, nil)
 	svr.EXPECT().GetComponentStates(
 		mock.Anything,
 		mock.AnythingOfType("*milvuspb.GetComponentStatesRequest"),
@@ -380,6 +388,24 @@ func (suite *ClusterTestSuite) TestSyncDistribution() {
 	}, status)
 }
 
+func (suite *ClusterTestSuite) TestSwitchShardLeader() {
+	ctx := context.TODO()
+	status, err := suite.cluster.SwitchShardLeader(ctx, 0, &querypb.SwitchShardLeaderRequest{
+		Base: &commonpb.MsgBase{},
+	})
+	suite.NoError(err)
+	suite.Equal(merr.Success(), status)
+
+	status, err = suite.cluster.SwitchShardLeader(ctx, 1, &querypb.SwitchShardLeaderRequest{
+		Base: &commonpb.MsgBase{},
+	})
+	suite.NoError(err)
+	suite.Equal(&commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		Reason:    "unexpected error",
+	}, status)
+}
+
 func (suite *ClusterTestSuite) TestGetComponentStates() {
 	ctx := context.TODO()
 	status, err := suite.cluster.GetComponentStates(ctx, 0)
@@ -482,6 +482,62 @@ func (_c *MockCluster_Stop_Call) RunAndReturn(run func()) *MockCluster_Stop_Call
 	return _c
 }
 
+// SwitchShardLeader provides a mock function with given fields: ctx, nodeID, req
+func (_m *MockCluster) SwitchShardLeader(ctx context.Context, nodeID int64, req *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error) {
+	ret := _m.Called(ctx, nodeID, req)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error)); ok {
+		return rf(ctx, nodeID, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *querypb.SwitchShardLeaderRequest) *commonpb.Status); ok {
+		r0 = rf(ctx, nodeID, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *querypb.SwitchShardLeaderRequest) error); ok {
+		r1 = rf(ctx, nodeID, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCluster_SwitchShardLeader_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwitchShardLeader'
+type MockCluster_SwitchShardLeader_Call struct {
+	*mock.Call
+}
+
+// SwitchShardLeader is a helper method to define mock.On call
+//   - ctx context.Context
+//   - nodeID int64
+//   - req *querypb.SwitchShardLeaderRequest
+func (_e *MockCluster_Expecter) SwitchShardLeader(ctx interface{}, nodeID interface{}, req interface{}) *MockCluster_SwitchShardLeader_Call {
+	return &MockCluster_SwitchShardLeader_Call{Call: _e.mock.On("SwitchShardLeader", ctx, nodeID, req)}
+}
+
+func (_c *MockCluster_SwitchShardLeader_Call) Run(run func(ctx context.Context, nodeID int64, req *querypb.SwitchShardLeaderRequest)) *MockCluster_SwitchShardLeader_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(*querypb.SwitchShardLeaderRequest))
+	})
+	return _c
+}
+
+func (_c *MockCluster_SwitchShardLeader_Call) Return(_a0 *commonpb.Status, _a1 error) *MockCluster_SwitchShardLeader_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCluster_SwitchShardLeader_Call) RunAndReturn(run func(context.Context, int64, *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error)) *MockCluster_SwitchShardLeader_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SyncDistribution provides a mock function with given fields: ctx, nodeID, req
 func (_m *MockCluster) SyncDistribution(ctx context.Context, nodeID int64, req *querypb.SyncDistributionRequest) (*commonpb.Status, error) {
 	ret := _m.Called(ctx, nodeID, req)
@@ -0,0 +1,161 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/querycoordv2/dist"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// standbyWarmer keeps the state of a standby QueryCoord warm:
+// the meta and target in metastore are mirrored by mirrorKV,
+// the QueryNodes are connected and their data distribution is pulled as the active one does,
+// so the standby could take over without full recovery after it's activated.
+type standbyWarmer struct {
+	s              *Server
+	kv             *mirrorKV
+	distController dist.Controller
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newStandbyWarmer(s *Server) *standbyWarmer {
+	return &standbyWarmer{s: s}
+}
+
+// Start prepares the kv, node manager, cluster and distribution for the server,
+// which will be reused by initQueryCoord after activated.
+func (w *standbyWarmer) Start() error {
+	s := w.s
+	ctx, cancel := context.WithCancel(s.ctx)
+	w.cancel = cancel
+
+	metaKV := etcdkv.NewEtcdKV(s.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
+		etcdkv.WithRequestTimeout(paramtable.Get().ServiceParam.EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond)))
+	w.kv = newMirrorKV(metaKV, s.etcdCli, Params.EtcdCfg.MetaRootPath.GetValue(),
+		querycoord.CollectionLoadInfoPrefix,
+		querycoord.PartitionLoadInfoPrefix,
+		querycoord.ReplicaPrefix,
+		querycoord.ResourceGroupPrefix,
+		querycoord.CollectionTargetPrefix,
+	)
+	if err := w.kv.StartMirror(ctx); err != nil {
+		cancel()
+		return err
+	}
+
+	s.kv = w.kv
+	s.nodeMgr = session.NewNodeManager()
+	s.cluster = session.NewCluster(s.nodeMgr, s.queryNodeCreator)
+	s.dist = &meta.DistributionManager{
+		SegmentDistManager: meta.NewSegmentDistManager(),
+		ChannelDistManager: meta.NewChannelDistManager(),
+		LeaderViewManager:  meta.NewLeaderViewManager(),
+	}
+	// standby has no target, the segments in distribution will be completed by the active dist handler
+	w.distController = dist.NewDistController(s.cluster, s.nodeMgr, s.dist, meta.NewTargetManager(nil, nil), nil)
+
+	sessions, revision, err := s.session.GetSessions(typeutil.QueryNodeRole)
+	if err != nil {
+		cancel()
+		w.kv.StopMirror()
+		return err
+	}
+	for _, node := range sessions {
+		w.addNode(ctx, node)
+	}
+
+	w.wg.Add(1)
+	go w.watchNodes(ctx, revision)
+	log.Info("QueryCoord standby warmup started", zap.Int("nodeNum", len(sessions)))
+	return nil
+}
+
+// Stop stops the watching, the warmed state is kept for the server
+func (w *standbyWarmer) Stop() {
+	if w.cancel != nil {
+		w.cancel()
+	}
+	w.wg.Wait()
+	if w.distController != nil {
+		w.distController.Stop()
+	}
+}
+
+// StopMirror makes the kv read from metastore directly, should be called after meta recovered
+func (w *standbyWarmer) StopMirror() {
+	if w.kv != nil {
+		w.kv.StopMirror()
+	}
+}
+
+func (w *standbyWarmer) addNode(ctx context.Context, node *sessionutil.Session) {
+	w.s.nodeMgr.Add(session.NewNodeInfo(session.ImmutableNodeInfo{
+		NodeID:   node.ServerID,
+		Address:  node.Address,
+		Hostname: node.HostName,
+		Version:  node.Version,
+	}))
+	w.distController.StartDistInstance(ctx, node.ServerID)
+}
+
+func (w *standbyWarmer) watchNodes(ctx context.Context, revision int64) {
+	defer w.wg.Done()
+
+	eventChan := w.s.session.WatchServices(typeutil.QueryNodeRole, revision+1, nil)
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("stop watching nodes, QueryCoord standby warmup stopped")
+			return
+
+		case event, ok := <-eventChan:
+			if !ok {
+				log.Warn("standby session watcher channel closed")
+				return
+			}
+
+			nodeID := event.Session.ServerID
+			switch event.EventType {
+			case sessionutil.SessionAddEvent:
+				log.Info("standby add node", zap.Int64("nodeID", nodeID))
+				w.addNode(ctx, event.Session)
+			case sessionutil.SessionDelEvent:
+				log.Info("standby remove node", zap.Int64("nodeID", nodeID))
+				w.distController.Remove(nodeID)
+				w.s.nodeMgr.Remove(nodeID)
+				w.s.dist.LeaderViewManager.Update(nodeID)
+				w.s.dist.ChannelDistManager.Update(nodeID)
+				w.s.dist.SegmentDistManager.Update(nodeID)
+			}
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// mirrorKV wraps the meta kv of QueryCoord, while mirroring it keeps an in-memory copy
+// of the given prefixes up to date by watching etcd, and serves Load/LoadWithPrefix from memory.
+// So a standby QueryCoord could recover its meta without scanning the metastore again.
+type mirrorKV struct {
+	kv.MetaKv
+
+	cli      *clientv3.Client
+	rootPath string
+	prefixes []string
+
+	mut       sync.RWMutex
+	data      map[string]string // full key -> value
+	mirroring atomic.Bool
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newMirrorKV(metaKV kv.MetaKv, cli *clientv3.Client, rootPath string, prefixes ...string) *mirrorKV {
+	return &mirrorKV{
+		MetaKv:   metaKV,
+		cli:      cli,
+		rootPath: rootPath,
+		prefixes: prefixes,
+		data:     make(map[string]string),
+	}
+}
+
+// StartMirror loads all the mirrored prefixes, then keeps watching them in background
+func (m *mirrorKV) StartMirror(ctx context.Context) error {
+	revisions := make([]int64, 0, len(m.prefixes))
+	for _, prefix := range m.prefixes {
+		revision, err := m.reload(ctx, path.Join(m.rootPath, prefix))
+		if err != nil {
+			return err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.mirroring.Store(true)
+	for i, prefix := range m.prefixes {
+		m.wg.Add(1)
+		go m.mirrorLoop(ctx, path.Join(m.rootPath, prefix), revisions[i])
+	}
+	return nil
+}
+
+// StopMirror stops watching, and all the following reads go to the metastore directly
+func (m *mirrorKV) StopMirror() {
+	if !m.mirroring.CompareAndSwap(true, false) {
+		return
+	}
+	m.cancel()
+	m.wg.Wait()
+
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	m.data = make(map[string]string)
+}
+
+func (m *mirrorKV) mirrorLoop(ctx context.Context, prefix string, revision int64) {
+	defer m.wg.Done()
+	log := log.With(zap.String("prefix", prefix))
+
+	for {
+		watchChan := m.cli.Watch(clientv3.WithRequireLeader(ctx), prefix, clientv3.WithPrefix(), clientv3.WithRev(revision+1))
+		for resp := range watchChan {
+			if err := resp.Err(); err != nil {
+				log.Warn("mirror watch failed, reload the prefix", zap.Error(err))
+				break
+			}
+			m.mut.Lock()
+			for _, event := range resp.Events {
+				switch event.Type {
+				case clientv3.EventTypePut:
+					m.data[string(event.Kv.Key)] = string(event.Kv.Value)
+				case clientv3.EventTypeDelete:
+					delete(m.data, string(event.Kv.Key))
+				}
+			}
+			m.mut.Unlock()
+		}
+
+		for {
+			if ctx.Err() != nil {
+				log.Info("mirror loop stopped")
+				return
+			}
+			var err error
+			revision, err = m.reload(ctx, prefix)
+			if err == nil {
+				break
+			}
+			log.Warn("failed to reload mirrored prefix, retry later", zap.Error(err))
+			select {
+			case <-ctx.Done():
+			case <-time.After(time.Second):
+			}
+		}
+	}
+}
+
+// reload replaces the mirrored data under the prefix with the latest snapshot, returns the snapshot revision
+func (m *mirrorKV) reload(ctx context.Context, prefix string) (int64, error) {
+	resp, err := m.cli.Get(ctx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return 0, err
+	}
+
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	for key := range m.data {
+		if strings.HasPrefix(key, prefix) {
+			delete(m.data, key)
+		}
+	}
+	for _, kv := range resp.Kvs {
+		m.data[string(kv.Key)] = string(kv.Value)
+	}
+	return resp.Header.Revision, nil
+}
+
+// serveFromMirror returns whether the read of the key could be served by the mirrored data
+func (m *mirrorKV) serveFromMirror(key string) bool {
+	if !m.mirroring.Load() {
+		return false
+	}
+	for _, prefix := range m.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *mirrorKV) Load(key string) (string, error) {
+	if !m.serveFromMirror(key) {
+		return m.MetaKv.Load(key)
+	}
+
+	key = path.Join(m.rootPath, key)
+	m.mut.RLock()
+	defer m.mut.RUnlock()
+	value, ok := m.data[key]
+	if !ok {
+		return "", merr.WrapErrIoKeyNotFound(key)
+	}
+	return value, nil
+}
+
+func (m *mirrorKV) LoadWithPrefix(key string) ([]string, []string, error) {
+	if !m.serveFromMirror(key) {
+		return m.MetaKv.LoadWithPrefix(key)
+	}
+
+	key = path.Join(m.rootPath, key)
+	m.mut.RLock()
+	defer m.mut.RUnlock()
+	keys := make([]string, 0)
+	for k := range m.data {
+		if strings.HasPrefix(k, key) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	values := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, m.data[k])
+	}
+	return keys, values, nil
+}
@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querycoordv2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type MirrorKVSuite struct {
+	suite.Suite
+
+	rootPath string
+	cli      *clientv3.Client
+	metaKV   kv.MetaKv
+	kv       *mirrorKV
+}
+
+func (suite *MirrorKVSuite) SetupTest() {
+	var err error
+	suite.cli, err = etcd.GetEtcdClient(
+		Params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		Params.EtcdCfg.EtcdUseSSL.GetAsBool(),
+		Params.EtcdCfg.Endpoints.GetAsStrings(),
+		Params.EtcdCfg.EtcdTLSCert.GetValue(),
+		Params.EtcdCfg.EtcdTLSKey.GetValue(),
+		Params.EtcdCfg.EtcdTLSCACert.GetValue(),
+		Params.EtcdCfg.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+
+	suite.rootPath = "/test/querycoord/mirror-kv"
+	suite.metaKV = etcdkv.NewEtcdKV(suite.cli, suite.rootPath)
+	suite.Require().NoError(suite.metaKV.RemoveWithPrefix(""))
+	suite.kv = newMirrorKV(suite.metaKV, suite.cli, suite.rootPath, "mirrored")
+}
+
+func (suite *MirrorKVSuite) TearDownTest() {
+	suite.kv.StopMirror()
+	suite.metaKV.RemoveWithPrefix("")
+	suite.cli.Close()
+}
+
+func (suite *MirrorKVSuite) TestMirror() {
+	suite.NoError(suite.metaKV.Save("mirrored/1", "v1"))
+	suite.NoError(suite.kv.StartMirror(context.Background()))
+
+	value, err := suite.kv.Load("mirrored/1")
+	suite.NoError(err)
+	suite.Equal("v1", value)
+
+	// changes are applied by watching
+	suite.NoError(suite.metaKV.Save("mirrored/2", "v2"))
+	suite.NoError(suite.metaKV.Remove("mirrored/1"))
+	suite.Eventually(func() bool {
+		keys, values, err := suite.kv.LoadWithPrefix("mirrored")
+		return err == nil && len(keys) == 1 && values[0] == "v2"
+	}, 5*time.Second, 10*time.Millisecond)
+	_, err = suite.kv.Load("mirrored/1")
+	suite.ErrorIs(err, merr.ErrIoKeyNotFound)
+
+	// reads out of the mirrored prefixes go to metastore
+	suite.NoError(suite.metaKV.Save("others/1", "v1"))
+	value, err = suite.kv.Load("others/1")
+	suite.NoError(err)
+	suite.Equal("v1", value)
+
+	// reads go to metastore after mirror stopped
+	suite.kv.StopMirror()
+	suite.NoError(suite.metaKV.Save("mirrored/3", "v3"))
+	keys, _, err := suite.kv.LoadWithPrefix("mirrored")
+	suite.NoError(err)
+	suite.Len(keys, 2)
+}
+
+func TestMirrorKV(t *testing.T) {
+	suite.Run(t, new(MirrorKVSuite))
+}
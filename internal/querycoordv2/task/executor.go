@@ -29,12 +29,14 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/indexpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
 	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -333,6 +335,12 @@ func (ex *Executor) subscribeChannel(task *ChannelTask, step int) error {
 		task.ResourceGroup(),
 		partitions...,
 	)
+	loadMeta.PartitionKeyBuckets, err = ex.getPartitionKeyBuckets(ctx, collectionInfo)
+	loadMeta.PartitionKeyBucketNum = getPartitionKeyBucketNum(collectionInfo)
+	if err != nil {
+		log.Warn("failed to get the partition key buckets of collection")
+		return err
+	}
 
 	dmChannel := ex.targetMgr.GetDmChannel(task.CollectionID(), action.ChannelName(), meta.NextTarget)
 	if dmChannel == nil {
@@ -340,10 +348,12 @@ func (ex *Executor) subscribeChannel(task *ChannelTask, step int) error {
 		log.Warn(msg, zap.String("channelName", action.ChannelName()))
 		return merr.WrapErrChannelReduplicate(action.ChannelName())
 	}
+	schema := collectionInfo.GetSchema()
+	schema.Properties = mergeCollectonProps(schema.Properties, collectionInfo.GetProperties())
 	req := packSubChannelRequest(
 		task,
 		action,
-		collectionInfo.GetSchema(),
+		schema,
 		loadMeta,
 		dmChannel,
 		indexInfo,
@@ -370,11 +380,84 @@ func (ex *Executor) subscribeChannel(task *ChannelTask, step int) error {
 		log.Warn("failed to subscribe channel", zap.Error(err))
 		return err
 	}
+	if task.Source() == utils.LeaderBalanceChecker {
+		ex.switchShardLeader(task, action, collectionInfo.GetSchema(), loadMeta, dmChannel, indexInfo)
+	}
 	elapsed := time.Since(startTs)
 	log.Info("subscribe channel done", zap.Int64("taskID", task.ID()), zap.Duration("time taken", elapsed))
 	return nil
 }
 
+// switchShardLeader syncs the sealed segment distribution of the old leader to the new one,
+// so the new leader could serve at once without waiting for the leader checker.
+// The failure is tolerated, the leader checker will complete the leader view later.
+func (ex *Executor) switchShardLeader(task *ChannelTask,
+	action *ChannelAction,
+	schema *schemapb.CollectionSchema,
+	loadMeta *querypb.LoadMetaInfo,
+	channel *meta.DmChannel,
+	indexInfo []*indexpb.IndexInfo,
+) {
+	ctx := task.Context()
+	reduce, ok := lo.Find(task.Actions(), func(a Action) bool {
+		return a.Type() == ActionTypeReduce
+	})
+	if !ok {
+		return
+	}
+	log := log.Ctx(ctx).With(
+		zap.Int64("taskID", task.ID()),
+		zap.Int64("collectionID", task.CollectionID()),
+		zap.String("channel", task.Channel()),
+		zap.Int64("from", reduce.Node()),
+		zap.Int64("to", action.Node()),
+	)
+
+	view := ex.dist.LeaderViewManager.GetLeaderShardView(reduce.Node(), task.Channel())
+	if view == nil {
+		log.Warn("leader view of the old leader not found, skip switching shard leader")
+		return
+	}
+
+	actions := make([]*querypb.SyncAction, 0, len(view.Segments))
+	for segmentID, dist := range view.Segments {
+		loadInfo, _, err := ex.getLoadInfo(ctx, task.CollectionID(), segmentID, channel)
+		if err != nil {
+			log.Warn("failed to get load info, skip switching shard leader", zap.Int64("segmentID", segmentID), zap.Error(err))
+			return
+		}
+		actions = append(actions, &querypb.SyncAction{
+			Type:        querypb.SyncType_Set,
+			PartitionID: loadInfo.GetPartitionID(),
+			SegmentID:   segmentID,
+			NodeID:      dist.GetNodeID(),
+			Info:        loadInfo,
+			Version:     dist.GetVersion(),
+		})
+	}
+
+	req := &querypb.SwitchShardLeaderRequest{
+		Base: commonpbutil.NewMsgBase(
+			commonpbutil.WithMsgType(commonpb.MsgType_SyncDistribution),
+			commonpbutil.WithMsgID(task.ID()),
+		),
+		CollectionID:  task.CollectionID(),
+		Channel:       task.Channel(),
+		ReplicaID:     task.ReplicaID(),
+		SourceNodeID:  reduce.Node(),
+		Actions:       actions,
+		Schema:        schema,
+		LoadMeta:      loadMeta,
+		IndexInfoList: indexInfo,
+	}
+	status, err := ex.cluster.SwitchShardLeader(ctx, action.Node(), req)
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("failed to switch shard leader", zap.Error(err))
+		return
+	}
+	log.Info("switch shard leader done", zap.Int("segmentNum", len(actions)))
+}
+
 func (ex *Executor) shouldIncludeFlushedSegmentInfo(nodeID int64) bool {
 	node := ex.nodeMgr.Get(nodeID)
 	if node == nil {
@@ -579,6 +662,12 @@ func (ex *Executor) getMetaInfo(ctx context.Context, task Task) (*milvuspb.Descr
 		task.ResourceGroup(),
 		partitions...,
 	)
+	loadMeta.PartitionKeyBuckets, err = ex.getPartitionKeyBuckets(ctx, collectionInfo)
+	loadMeta.PartitionKeyBucketNum = getPartitionKeyBucketNum(collectionInfo)
+	if err != nil {
+		log.Warn("failed to get the partition key buckets of collection", zap.Error(err))
+		return nil, nil, nil, err
+	}
 
 	// get channel first, in case of target updated after segment info fetched
 	channel := ex.targetMgr.GetDmChannel(collectionID, shard, meta.NextTargetFirst)
@@ -589,6 +678,25 @@ func (ex *Executor) getMetaInfo(ctx context.Context, task Task) (*milvuspb.Descr
 	return collectionInfo, loadMeta, channel, nil
 }
 
+// getPartitionKeyBuckets returns the partition IDs in the order of the hash buckets,
+// which the delegator prunes the partitions by, nil if the collection is not in partition key mode.
+func (ex *Executor) getPartitionKeyBuckets(ctx context.Context, collectionInfo *milvuspb.DescribeCollectionResponse) ([]int64, error) {
+	if !typeutil.HasPartitionKey(collectionInfo.GetSchema()) {
+		return nil, nil
+	}
+	return ex.broker.GetPartitionKeyBuckets(ctx, collectionInfo.GetCollectionID())
+}
+
+// getPartitionKeyBucketNum returns the number of buckets the segments of each partition bucketed by,
+// which the delegator prunes the segments by, 0 if the collection is not bucketed.
+func getPartitionKeyBucketNum(collectionInfo *milvuspb.DescribeCollectionResponse) int64 {
+	if !typeutil.HasPartitionKey(collectionInfo.GetSchema()) {
+		return 0
+	}
+	num, _ := common.GetCollectionPartitionKeyBucketNum(collectionInfo.GetProperties()...)
+	return num
+}
+
 func (ex *Executor) getLoadInfo(ctx context.Context, collectionID, segmentID int64, channel *meta.DmChannel) (*querypb.SegmentLoadInfo, []*indexpb.IndexInfo, error) {
 	log := log.Ctx(ctx)
 	resp, err := ex.broker.GetSegmentInfo(ctx, segmentID)
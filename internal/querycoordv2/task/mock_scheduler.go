@@ -250,6 +250,50 @@ func (_c *MockScheduler_GetNodeSegmentDelta_Call) RunAndReturn(run func(int64) i
 	return _c
 }
 
+// GetSegmentTaskDist provides a mock function with given fields: collectionID
+func (_m *MockScheduler) GetSegmentTaskDist(collectionID int64) map[int64][]int64 {
+	ret := _m.Called(collectionID)
+
+	var r0 map[int64][]int64
+	if rf, ok := ret.Get(0).(func(int64) map[int64][]int64); ok {
+		r0 = rf(collectionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64][]int64)
+		}
+	}
+
+	return r0
+}
+
+// MockScheduler_GetSegmentTaskDist_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegmentTaskDist'
+type MockScheduler_GetSegmentTaskDist_Call struct {
+	*mock.Call
+}
+
+// GetSegmentTaskDist is a helper method to define mock.On call
+//   - collectionID int64
+func (_e *MockScheduler_Expecter) GetSegmentTaskDist(collectionID interface{}) *MockScheduler_GetSegmentTaskDist_Call {
+	return &MockScheduler_GetSegmentTaskDist_Call{Call: _e.mock.On("GetSegmentTaskDist", collectionID)}
+}
+
+func (_c *MockScheduler_GetSegmentTaskDist_Call) Run(run func(collectionID int64)) *MockScheduler_GetSegmentTaskDist_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockScheduler_GetSegmentTaskDist_Call) Return(_a0 map[int64][]int64) *MockScheduler_GetSegmentTaskDist_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockScheduler_GetSegmentTaskDist_Call) RunAndReturn(run func(int64) map[int64][]int64) *MockScheduler_GetSegmentTaskDist_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetSegmentTaskNum provides a mock function with given fields:
 func (_m *MockScheduler) GetSegmentTaskNum() int {
 	ret := _m.Called()
@@ -145,6 +145,7 @@ type Scheduler interface {
 	GetNodeChannelDelta(nodeID int64) int
 	GetChannelTaskNum() int
 	GetSegmentTaskNum() int
+	GetSegmentTaskDist(collectionID int64) map[int64][]int64
 }
 
 type taskScheduler struct {
@@ -497,6 +498,26 @@ func (scheduler *taskScheduler) GetSegmentTaskNum() int {
 	return len(scheduler.segmentTasks)
 }
 
+// GetSegmentTaskDist returns segmentID -> nodes which sealed segment load tasks
+// of the given collection are dispatching to
+func (scheduler *taskScheduler) GetSegmentTaskDist(collectionID int64) map[int64][]int64 {
+	scheduler.rwmutex.RLock()
+	defer scheduler.rwmutex.RUnlock()
+
+	dist := make(map[int64][]int64)
+	for index, task := range scheduler.segmentTasks {
+		if index.IsGrowing || task.CollectionID() != collectionID {
+			continue
+		}
+		for _, action := range task.Actions() {
+			if action.Type() == ActionTypeGrow {
+				dist[index.SegmentID] = append(dist[index.SegmentID], action.Node())
+			}
+		}
+	}
+	return dist
+}
+
 func calculateNodeDelta[K comparable, T ~map[K]Task](nodeID int64, tasks T) int {
 	delta := 0
 	for _, task := range tasks {
@@ -1359,6 +1359,70 @@ func (suite *TaskSuite) TestLeaderTaskSet() {
 	}
 }
 
+func (suite *TaskSuite) TestSwitchShardLeader() {
+	ctx := context.Background()
+	timeout := 10 * time.Second
+	sourceNode := int64(1)
+	targetNode := int64(3)
+	partition := int64(100)
+	channel := "move-4"
+
+	for _, segment := range suite.moveSegments {
+		suite.broker.EXPECT().GetSegmentInfo(mock.Anything, segment).Return(&datapb.GetSegmentInfoResponse{
+			Infos: []*datapb.SegmentInfo{
+				{
+					ID:            segment,
+					CollectionID:  suite.collection,
+					PartitionID:   partition,
+					InsertChannel: channel,
+				},
+			},
+		}, nil)
+		suite.broker.EXPECT().GetIndexInfo(mock.Anything, suite.collection, segment).Return(nil, nil)
+	}
+	suite.broker.EXPECT().ListIndexes(mock.Anything, suite.collection).Return(nil, nil)
+
+	view := &meta.LeaderView{
+		ID:           sourceNode,
+		CollectionID: suite.collection,
+		Channel:      channel,
+		Segments: map[int64]*querypb.SegmentDist{
+			suite.moveSegments[0]: {NodeID: sourceNode, Version: 1},
+			suite.moveSegments[1]: {NodeID: 2, Version: 2},
+		},
+	}
+	suite.dist.LeaderViewManager.Update(sourceNode, view)
+
+	task, err := NewChannelTask(ctx, timeout, utils.LeaderBalanceChecker, suite.collection, suite.replica,
+		NewChannelAction(targetNode, ActionTypeGrow, channel),
+		NewChannelAction(sourceNode, ActionTypeReduce, channel),
+	)
+	suite.NoError(err)
+
+	var req *querypb.SwitchShardLeaderRequest
+	suite.cluster.EXPECT().SwitchShardLeader(mock.Anything, targetNode, mock.Anything).
+		RunAndReturn(func(ctx context.Context, nodeID int64, r *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error) {
+			req = r
+			return merr.Success(), nil
+		})
+	executor := NewExecutor(suite.meta, suite.dist, suite.broker, suite.target, suite.cluster, suite.nodeMgr)
+	executor.switchShardLeader(task, task.Actions()[0].(*ChannelAction), &schemapb.CollectionSchema{}, &querypb.LoadMetaInfo{}, meta.DmChannelFromVChannel(&datapb.VchannelInfo{
+		CollectionID: suite.collection,
+		ChannelName:  channel,
+	}), nil)
+
+	suite.NotNil(req)
+	suite.Equal(sourceNode, req.GetSourceNodeID())
+	suite.Equal(channel, req.GetChannel())
+	suite.Len(req.GetActions(), 2)
+	for _, action := range req.GetActions() {
+		suite.Equal(querypb.SyncType_Set, action.GetType())
+		suite.Equal(view.Segments[action.GetSegmentID()].GetNodeID(), action.GetNodeID())
+		suite.Equal(view.Segments[action.GetSegmentID()].GetVersion(), action.GetVersion())
+		suite.Equal(partition, action.GetPartitionID())
+	}
+}
+
 func (suite *TaskSuite) TestCreateTaskBehavior() {
 	chanelTask, err := NewChannelTask(context.TODO(), 5*time.Second, WrapIDSource(0), 0, meta.NilReplica)
 	suite.ErrorIs(err, merr.ErrParameterInvalid)
@@ -28,6 +28,8 @@ const (
 	IndexCheckerName   = "index_checker"
 	LeaderCheckerName  = "leader_checker"
 	ManualBalanceName  = "manual_balance"
+
+	LeaderBalanceCheckerName = "leader_balance_checker"
 )
 
 type CheckerType int32
@@ -39,6 +41,7 @@ const (
 	IndexChecker
 	LeaderChecker
 	ManualBalance
+	LeaderBalanceChecker
 )
 
 var checkerNames = map[CheckerType]string{
@@ -48,6 +51,8 @@ var checkerNames = map[CheckerType]string{
 	IndexChecker:   IndexCheckerName,
 	LeaderChecker:  LeaderCheckerName,
 	ManualBalance:  ManualBalanceName,
+
+	LeaderBalanceChecker: LeaderBalanceCheckerName,
 }
 
 func (s CheckerType) String() string {
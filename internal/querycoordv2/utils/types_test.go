@@ -65,6 +65,13 @@ func Test_packLoadSegmentRequest(t *testing.T) {
 		assert.Equal(t, t2, req.GetDeltaPosition().Timestamp)
 	})
 
+	t.Run("test bucket of partition key", func(t *testing.T) {
+		segmentInfo := proto.Clone(segmentInfo).(*datapb.SegmentInfo)
+		segmentInfo.BucketID = 2
+		req := PackSegmentLoadInfo(segmentInfo, channel.GetSeekPosition(), nil)
+		assert.EqualValues(t, 2, req.GetBucketID())
+	})
+
 	t.Run("test channel cp after segment dml position", func(t *testing.T) {
 		channel := proto.Clone(channel).(*datapb.VchannelInfo)
 		channel.SeekPosition.Timestamp = t3
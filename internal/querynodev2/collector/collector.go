@@ -57,6 +57,11 @@ func ConstructLabel(subs ...string) string {
 	return label
 }
 
+// ChannelQPSLabel returns the rate label of the requests served by the shard delegator of the channel
+func ChannelQPSLabel(channel string) string {
+	return ConstructLabel("ChannelQPS", channel)
+}
+
 func init() {
 	var err error
 	Rate, err = ratelimitutil.NewRateCollector(ratelimitutil.DefaultWindow, ratelimitutil.DefaultGranularity)
@@ -53,6 +53,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/lifetime"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
+	"github.com/milvus-io/milvus/pkg/util/metric"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
@@ -70,6 +71,7 @@ type ShardDelegator interface {
 	Query(ctx context.Context, req *querypb.QueryRequest) ([]*internalpb.RetrieveResults, error)
 	QueryStream(ctx context.Context, req *querypb.QueryRequest, srv streamrpc.QueryStreamServer) error
 	GetStatistics(ctx context.Context, req *querypb.GetStatisticsRequest) ([]*internalpb.GetStatisticsResponse, error)
+	SubscribeStandingQuery(ctx context.Context, req *querypb.SubscribeStandingQueryRequest) (*StandingQuery, error)
 
 	// data
 	ProcessInsert(insertRecords map[int64]*InsertData)
@@ -121,7 +123,15 @@ type shardDelegator struct {
 	// queryHook
 	queryHook      optimizers.QueryHook
 	partitionStats map[UniqueID]*storage.PartitionStatsSnapshot
-	chunkManager   storage.ChunkManager
+	// scalarStats holds the scalar field stats of the sealed segments, segmentID -> stats
+	scalarStats  *typeutil.ConcurrentMap[UniqueID, []*storage.FieldStats]
+	chunkManager storage.ChunkManager
+	// resultCache caches the search results for repeated searches
+	resultCache *resultCache
+	// standingQueries streams the inserts/deletes to the subscribers
+	standingQueries *standingQueryManager
+	// deleteBatcher batches the deletes forwarded to remote workers, nil if disabled
+	deleteBatcher *deleteBatcher
 }
 
 // getLogger returns the zap logger with pre-defined shard attributes.
@@ -192,6 +202,92 @@ func (sd *shardDelegator) modifyQueryRequest(req *querypb.QueryRequest, scope qu
 }
 
 // Search preforms search operation on shard.
+// fusedSearch runs all the sub searches of the hybrid search in one pass over the segments,
+// the workers fuse the scores of the sub searches by the weights before the topk selection.
+func (sd *shardDelegator) fusedSearch(ctx context.Context, req *querypb.HybridSearchRequest, tSafe uint64, sealed []SnapshotItem, growing []SegmentEntry) (*querypb.HybridSearchResult, error) {
+	log := sd.getLogger(ctx)
+	subReqs := req.GetReq().GetReqs()
+	if len(subReqs) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("no sub search to fuse")
+	}
+
+	sealedNum := lo.SumBy(sealed, func(item SnapshotItem) int { return len(item.Segments) })
+	for i, request := range subReqs {
+		request.GuaranteeTimestamp = req.GetReq().GetGuaranteeTimestamp()
+		request.TimeoutTimestamp = req.GetReq().GetTimeoutTimestamp()
+		if request.GetMvccTimestamp() == 0 {
+			request.MvccTimestamp = tSafe
+		}
+		optimized, err := optimizers.OptimizeSearchParams(ctx, &querypb.SearchRequest{Req: request}, sd.queryHook, sealedNum)
+		if err != nil {
+			log.Warn("failed to optimize search params", zap.Error(err))
+			return nil, err
+		}
+		subReqs[i] = optimized.GetReq()
+	}
+	// the segments are not pruned, as the pruning relies on the single vector field searched
+	if subReqs[0].GetIgnoreGrowing() {
+		growing = []SegmentEntry{}
+	}
+
+	fusion := req.GetReq().GetFusion()
+	searchReq := &querypb.SearchRequest{
+		Req: &internalpb.SearchRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Search,
+				MsgID:    subReqs[0].GetBase().GetMsgID(),
+				SourceID: subReqs[0].GetBase().GetSourceID(),
+			},
+			ReqID:              req.GetReq().GetReqID(),
+			DbID:               req.GetReq().GetDbID(),
+			CollectionID:       req.GetReq().GetCollectionID(),
+			PartitionIDs:       req.GetReq().GetPartitionIDs(),
+			Nq:                 subReqs[0].GetNq(),
+			Topk:               fusion.GetTopk(),
+			MetricType:         metric.IP,
+			MvccTimestamp:      req.GetReq().GetMvccTimestamp(),
+			GuaranteeTimestamp: req.GetReq().GetGuaranteeTimestamp(),
+			TimeoutTimestamp:   req.GetReq().GetTimeoutTimestamp(),
+			Username:           subReqs[0].GetUsername(),
+			Priority:           subReqs[0].GetPriority(),
+			ConsistencyLevel:   req.GetReq().GetConsistencyLevel(),
+			Fusion: &internalpb.SearchFusion{
+				Weights: fusion.GetWeights(),
+				Topk:    fusion.GetTopk(),
+				Reqs:    subReqs,
+			},
+		},
+		DmlChannels:     req.GetDmlChannels(),
+		TotalChannelNum: req.GetTotalChannelNum(),
+		FromShardLeader: true,
+	}
+	tasks, err := organizeSubTask(ctx, searchReq, sealed, growing, sd, sd.modifySearchRequest)
+	if err != nil {
+		log.Warn("fused search organizeSubTask failed", zap.Error(err))
+		return nil, err
+	}
+	results, err := executeSubTasks(ctx, tasks, func(ctx context.Context, req *querypb.SearchRequest, worker cluster.Worker) (*internalpb.SearchResults, error) {
+		return worker.SearchSegments(ctx, req)
+	}, "FusedSearch", log)
+	if err != nil {
+		log.Warn("Delegator fused search failed", zap.Error(err))
+		return nil, err
+	}
+
+	result, err := segments.ReduceSearchResults(ctx, results, searchReq.Req.GetNq(), fusion.GetTopk(), 1, metric.IP)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("Delegator fused search done")
+
+	return &querypb.HybridSearchResult{
+		Status:       merr.Success(),
+		Results:      []*internalpb.SearchResults{result},
+		ChannelsMvcc: result.GetChannelsMvcc(),
+	}, nil
+}
+
 func (sd *shardDelegator) search(ctx context.Context, req *querypb.SearchRequest, sealed []SnapshotItem, growing []SegmentEntry) ([]*internalpb.SearchResults, error) {
 	log := sd.getLogger(ctx)
 	if req.Req.IgnoreGrowing {
@@ -213,6 +309,9 @@ func (sd *shardDelegator) search(ctx context.Context, req *querypb.SearchRequest
 		PruneSegments(ctx, sd.partitionStats, req.GetReq(), nil, sd.collection.Schema(), sealed,
 			PruneInfo{filterRatio: paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
 	}
+	if paramtable.Get().QueryNodeCfg.EnableScalarStatsPrune.GetAsBool() {
+		PruneSegmentsByScalarStats(ctx, sd.scalarStats.Get, req.GetReq().GetSerializedExprPlan(), sealed)
+	}
 
 	tasks, err := organizeSubTask(ctx, req, sealed, growing, sd, sd.modifySearchRequest)
 	if err != nil {
@@ -252,9 +351,13 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 		return nil, merr.WrapErrPartitionNotLoaded(partitions)
 	}
 
+	// the searches on the specified mvcc timestamp are not cached, as the cached results may be newer than it
+	cacheable := paramtable.Get().QueryNodeCfg.ResultCacheEnabled.GetAsBool() && req.GetReq().GetMvccTimestamp() == 0
+	generation := sd.resultCache.Generation()
+
 	// wait tsafe
 	waitTr := timerecord.NewTimeRecorder("wait tSafe")
-	tSafe, err := sd.waitTSafe(ctx, req.Req.GuaranteeTimestamp)
+	tSafe, err := sd.waitTSafe(ctx, readGuaranteeTs(req.GetReq()))
 	if err != nil {
 		log.Warn("delegator search failed to wait tsafe", zap.Error(err))
 		return nil, err
@@ -276,8 +379,32 @@ func (sd *shardDelegator) Search(ctx context.Context, req *querypb.SearchRequest
 	growing = lo.Filter(growing, func(segment SegmentEntry, _ int) bool {
 		return funcutil.SliceContain(existPartitions, segment.PartitionID)
 	})
+	sealed, growing = sd.pruneByPartitionKey(ctx, req.GetReq().GetSerializedExprPlan(), metrics.SearchLabel, sealed, growing)
+
+	if !cacheable {
+		return sd.search(ctx, req, sealed, growing)
+	}
 
-	return sd.search(ctx, req, sealed, growing)
+	cacheKey, err := resultCacheKey(req, sd.distribution.getTargetVersion(), sealed, growing)
+	if err != nil {
+		log.Warn("failed to get result cache key, skip result cache", zap.Error(err))
+		return sd.search(ctx, req, sealed, growing)
+	}
+	if results, ok := sd.resultCache.Get(cacheKey); ok {
+		metrics.QueryNodeResultCacheAccessTotal.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.CacheHitLabel).Inc()
+		for _, result := range results {
+			result.ReqID = req.GetReq().GetReqID()
+		}
+		return results, nil
+	}
+	metrics.QueryNodeResultCacheAccessTotal.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.CacheMissLabel).Inc()
+
+	results, err := sd.search(ctx, req, sealed, growing)
+	if err != nil {
+		return nil, err
+	}
+	sd.resultCache.Put(cacheKey, generation, results)
+	return results, nil
 }
 
 // HybridSearch preforms hybrid search operation on shard.
@@ -302,7 +429,7 @@ func (sd *shardDelegator) HybridSearch(ctx context.Context, req *querypb.HybridS
 
 	// wait tsafe
 	waitTr := timerecord.NewTimeRecorder("wait tSafe")
-	tSafe, err := sd.waitTSafe(ctx, req.Req.GuaranteeTimestamp)
+	tSafe, err := sd.waitTSafe(ctx, readGuaranteeTs(req.GetReq()))
 	if err != nil {
 		log.Warn("delegator hybrid search failed to wait tsafe", zap.Error(err))
 		return nil, err
@@ -325,6 +452,10 @@ func (sd *shardDelegator) HybridSearch(ctx context.Context, req *querypb.HybridS
 		return funcutil.SliceContain(existPartitions, segment.PartitionID)
 	})
 
+	if req.GetReq().GetFusion() != nil {
+		return sd.fusedSearch(ctx, req, tSafe, sealed, growing)
+	}
+
 	futures := make([]*conc.Future[*internalpb.SearchResults], len(req.GetReq().GetReqs()))
 	for index := range req.GetReq().GetReqs() {
 		request := req.GetReq().Reqs[index]
@@ -350,6 +481,7 @@ func (sd *shardDelegator) HybridSearch(ctx context.Context, req *querypb.HybridS
 				results,
 				searchReq.Req.GetNq(),
 				searchReq.Req.GetTopk(),
+				searchReq.Req.GetGroupSize(),
 				searchReq.Req.GetMetricType())
 		})
 		futures[index] = future
@@ -406,7 +538,7 @@ func (sd *shardDelegator) QueryStream(ctx context.Context, req *querypb.QueryReq
 
 	// wait tsafe
 	waitTr := timerecord.NewTimeRecorder("wait tSafe")
-	tSafe, err := sd.waitTSafe(ctx, req.Req.GuaranteeTimestamp)
+	tSafe, err := sd.waitTSafe(ctx, readGuaranteeTs(req.GetReq()))
 	if err != nil {
 		log.Warn("delegator query failed to wait tsafe", zap.Error(err))
 		return err
@@ -431,6 +563,7 @@ func (sd *shardDelegator) QueryStream(ctx context.Context, req *querypb.QueryReq
 	if req.Req.IgnoreGrowing {
 		growing = []SegmentEntry{}
 	}
+	sealed, growing = sd.pruneByPartitionKey(ctx, req.GetReq().GetSerializedExprPlan(), metrics.QueryLabel, sealed, growing)
 
 	log.Info("query stream segments...",
 		zap.Int("sealedNum", len(sealed)),
@@ -477,7 +610,7 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 
 	// wait tsafe
 	waitTr := timerecord.NewTimeRecorder("wait tSafe")
-	tSafe, err := sd.waitTSafe(ctx, req.Req.GuaranteeTimestamp)
+	tSafe, err := sd.waitTSafe(ctx, readGuaranteeTs(req.GetReq()))
 	if err != nil {
 		log.Warn("delegator query failed to wait tsafe", zap.Error(err))
 		return nil, err
@@ -503,10 +636,14 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 			return funcutil.SliceContain(existPartitions, segment.PartitionID)
 		})
 	}
+	sealed, growing = sd.pruneByPartitionKey(ctx, req.GetReq().GetSerializedExprPlan(), metrics.QueryLabel, sealed, growing)
 
 	if paramtable.Get().QueryNodeCfg.EnableSegmentPrune.GetAsBool() {
 		PruneSegments(ctx, sd.partitionStats, nil, req.GetReq(), sd.collection.Schema(), sealed, PruneInfo{paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
 	}
+	if paramtable.Get().QueryNodeCfg.EnableScalarStatsPrune.GetAsBool() {
+		PruneSegmentsByScalarStats(ctx, sd.scalarStats.Get, req.GetReq().GetSerializedExprPlan(), sealed)
+	}
 
 	sealedNum := lo.SumBy(sealed, func(item SnapshotItem) int { return len(item.Segments) })
 	log.Debug("query segments...",
@@ -689,10 +826,44 @@ func executeSubTasks[T any, R interface {
 	return results, nil
 }
 
-// waitTSafe returns when tsafe listener notifies a timestamp which meet the guarantee ts.
+type consistentReadRequest interface {
+	GetGuaranteeTimestamp() uint64
+	GetConsistencyLevel() commonpb.ConsistencyLevel
+	GetMaxStaleness() int64
+}
+
+// readGuaranteeTs returns the timestamp which the tsafe shall reach before serving the read request.
+// The bounded consistency reads accept the data staler than the guarantee ts within the max staleness,
+// so they are served immediately unless the delegator lags behind more than that;
+// the session consistency reads wait for the guarantee ts, which is the last write or read ts of the client session.
+func readGuaranteeTs(req consistentReadRequest) uint64 {
+	ts := req.GetGuaranteeTimestamp()
+	if req.GetConsistencyLevel() != commonpb.ConsistencyLevel_Bounded || req.GetMaxStaleness() <= 0 {
+		return ts
+	}
+	staleness := time.Duration(req.GetMaxStaleness()) * time.Millisecond
+	if physical, _ := tsoutil.ParseTS(ts); physical.UnixMilli() <= staleness.Milliseconds() {
+		return 0
+	}
+	return tsoutil.AddPhysicalDurationOnTs(ts, -staleness)
+}
+
+// waitTSafe returns when tsafe listener notifies a timestamp which meet the guarantee ts,
+// and the batched deletes before it are applied on workers.
 func (sd *shardDelegator) waitTSafe(ctx context.Context, ts uint64) (uint64, error) {
 	ctx, sp := otel.Tracer(typeutil.QueryNodeRole).Start(ctx, "Delegator-waitTSafe")
 	defer sp.End()
+	tSafe, err := sd.awaitTSafe(ctx, ts)
+	if err != nil {
+		return 0, err
+	}
+	if sd.deleteBatcher != nil {
+		sd.deleteBatcher.Flush()
+	}
+	return tSafe, nil
+}
+
+func (sd *shardDelegator) awaitTSafe(ctx context.Context, ts uint64) (uint64, error) {
 	log := sd.getLogger(ctx)
 	// already safe to search
 	latestTSafe := sd.latestTsafe.Load()
@@ -787,6 +958,35 @@ func (sd *shardDelegator) Close() {
 	// broadcast to all waitTsafe goroutine to quit
 	sd.tsCond.Broadcast()
 	sd.lifetime.Wait()
+	if sd.deleteBatcher != nil {
+		sd.deleteBatcher.Close()
+	}
+	sd.deleteBuffer.Close()
+	sd.standingQueries.close(merr.WrapErrChannelNotAvailable(sd.vchannelName, "delegator closed"))
+}
+
+// newDeleteBuffer creates the delete buffer of delegator,
+// which spills to local disk if enabled, otherwise keeps all the deletes in memory.
+func newDeleteBuffer(channel string, startTs uint64, sizePerBlock int64) (deletebuffer.DeleteBuffer[*deletebuffer.Item], error) {
+	params := paramtable.Get()
+	if !params.QueryNodeCfg.DeleteBufferSpillEnabled.GetAsBool() {
+		log.Info("Init delete cache with list delete buffer", zap.String("channel", channel), zap.Int64("sizePerBlock", sizePerBlock), zap.Time("startTime", tsoutil.PhysicalTime(startTs)))
+		return deletebuffer.NewListDeleteBuffer[*deletebuffer.Item](startTs, sizePerBlock), nil
+	}
+
+	config := deletebuffer.SpillConfig{
+		Dir:              path.Join(params.LocalStorageCfg.Path.GetValue(), "delete_buffer", fmt.Sprint(paramtable.GetNodeID()), channel),
+		MemoryLimit:      params.QueryNodeCfg.DeleteBufferMemoryLimit.GetAsInt64(),
+		Format:           params.QueryNodeCfg.DeleteBufferSpillFormat.GetValue(),
+		CompactThreshold: params.QueryNodeCfg.DeleteBufferSpillCompactThreshold.GetAsInt(),
+	}
+	log.Info("Init delete cache with spill delete buffer", zap.String("channel", channel),
+		zap.Int64("sizePerBlock", sizePerBlock),
+		zap.Time("startTime", tsoutil.PhysicalTime(startTs)),
+		zap.String("dir", config.Dir),
+		zap.Int64("memoryLimit", config.MemoryLimit),
+		zap.String("format", config.Format))
+	return deletebuffer.NewSpillDeleteBuffer(startTs, sizePerBlock, config)
 }
 
 // As partition stats is an optimization for search/query which is not mandatory for milvus instance,
@@ -869,7 +1069,11 @@ func NewShardDelegator(ctx context.Context, collectionID UniqueID, replicaID Uni
 	}
 
 	sizePerBlock := paramtable.Get().QueryNodeCfg.DeleteBufferBlockSize.GetAsInt64()
-	log.Info("Init delete cache with list delete buffer", zap.Int64("sizePerBlock", sizePerBlock), zap.Time("startTime", tsoutil.PhysicalTime(startTs)))
+	deleteBuffer, err := newDeleteBuffer(channel, startTs, sizePerBlock)
+	if err != nil {
+		log.Warn("failed to init delete buffer", zap.Error(err))
+		return nil, err
+	}
 
 	sd := &shardDelegator{
 		collectionID:    collectionID,
@@ -882,7 +1086,7 @@ func NewShardDelegator(ctx context.Context, collectionID UniqueID, replicaID Uni
 		lifetime:        lifetime.NewLifetime(lifetime.Initializing),
 		distribution:    NewDistribution(),
 		level0Deletions: make(map[int64]*storage.DeleteData),
-		deleteBuffer:    deletebuffer.NewListDeleteBuffer[*deletebuffer.Item](startTs, sizePerBlock),
+		deleteBuffer:    deleteBuffer,
 		pkOracle:        pkoracle.NewPkOracle(),
 		tsafeManager:    tsafeManager,
 		latestTsafe:     atomic.NewUint64(startTs),
@@ -891,6 +1095,15 @@ func NewShardDelegator(ctx context.Context, collectionID UniqueID, replicaID Uni
 		queryHook:       queryHook,
 		chunkManager:    chunkManager,
 		partitionStats:  make(map[UniqueID]*storage.PartitionStatsSnapshot),
+		scalarStats:     typeutil.NewConcurrentMap[UniqueID, []*storage.FieldStats](),
+		resultCache: newResultCache(paramtable.Get().QueryNodeCfg.ResultCacheTTL.GetAsDuration(time.Second),
+			paramtable.Get().QueryNodeCfg.ResultCacheCapacity.GetAsInt()),
+		standingQueries: newStandingQueryManager(),
+	}
+	if paramtable.Get().QueryNodeCfg.ForwardDeleteBatchEnabled.GetAsBool() {
+		sd.deleteBatcher = newDeleteBatcher(paramtable.Get().QueryNodeCfg.ForwardDeleteFlushInterval.GetAsDuration(time.Millisecond),
+			sd.applyDeleteBatch)
+		sd.deleteBatcher.Start()
 	}
 	m := sync.Mutex{}
 	sd.tsCond = sync.NewCond(&m)
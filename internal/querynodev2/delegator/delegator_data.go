@@ -18,11 +18,13 @@ package delegator
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"sort"
 	"time"
 
+	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
@@ -83,7 +85,10 @@ func (d *DeleteData) Append(ad DeleteData) {
 func (sd *shardDelegator) ProcessInsert(insertRecords map[int64]*InsertData) {
 	method := "ProcessInsert"
 	tr := timerecord.NewTimeRecorder(method)
+	// invalidate after the data applied, so the results searched before are not cached
+	defer sd.resultCache.Invalidate()
 	log := sd.getLogger(context.Background())
+	growings := make(map[int64]segments.Segment, len(insertRecords))
 	for segmentID, insertData := range insertRecords {
 		growing := sd.segmentManager.GetGrowing(segmentID)
 		if growing == nil {
@@ -135,6 +140,7 @@ func (sd *shardDelegator) ProcessInsert(insertRecords map[int64]*InsertData) {
 			"0",
 		).Add(float64(len(insertData.RowIDs)))
 		growing.UpdateBloomFilter(insertData.PrimaryKeys)
+		growings[segmentID] = growing
 
 		if !sd.pkOracle.Exists(growing, paramtable.GetNodeID()) {
 			// register created growing segment after insert, avoid to add empty growing to delegator
@@ -156,6 +162,7 @@ func (sd *shardDelegator) ProcessInsert(insertRecords map[int64]*InsertData) {
 			zap.Uint64("maxTimestamp", insertData.Timestamps[len(insertData.Timestamps)-1]),
 		)
 	}
+	sd.standingQueries.publishInserts(sd.collection, growings, insertRecords)
 	metrics.QueryNodeProcessCost.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.InsertLabel).
 		Observe(float64(tr.ElapseSpan().Milliseconds()))
 }
@@ -166,6 +173,7 @@ func (sd *shardDelegator) ProcessInsert(insertRecords map[int64]*InsertData) {
 func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 	method := "ProcessDelete"
 	tr := timerecord.NewTimeRecorder(method)
+	defer sd.resultCache.Invalidate()
 	// block load segment handle delete buffer
 	sd.deleteMut.Lock()
 	defer sd.deleteMut.Unlock()
@@ -190,6 +198,7 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 		Ts:   ts,
 		Data: cacheItems,
 	})
+	sd.standingQueries.publishDeletes(deleteData)
 
 	// segment => delete data
 	delRecords := make(map[int64]DeleteData)
@@ -217,6 +226,10 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 	eg, ctx := errgroup.WithContext(context.Background())
 	for _, entry := range sealed {
 		entry := entry
+		if sd.deleteBatcher != nil && entry.NodeID != paramtable.GetNodeID() {
+			sd.deleteBatcher.Add(entry.NodeID, sd.buildDeleteRequests(delRecords, entry.Segments, querypb.DataScope_Historical)...)
+			continue
+		}
 		eg.Go(func() error {
 			worker, err := sd.workerManager.GetWorker(ctx, entry.NodeID)
 			if err != nil {
@@ -310,6 +323,73 @@ func (sd *shardDelegator) applyDelete(ctx context.Context, nodeID int64, worker
 	return offlineSegments
 }
 
+// buildDeleteRequests builds the delete requests forwarded to the worker for the segments.
+func (sd *shardDelegator) buildDeleteRequests(delRecords map[int64]DeleteData, entries []SegmentEntry, scope querypb.DataScope) []*querypb.DeleteRequest {
+	reqs := make([]*querypb.DeleteRequest, 0, len(entries))
+	for _, segmentEntry := range entries {
+		delRecord, ok := delRecords[segmentEntry.SegmentID]
+		if !ok {
+			continue
+		}
+		reqs = append(reqs, &querypb.DeleteRequest{
+			CollectionId: sd.collectionID,
+			PartitionId:  segmentEntry.PartitionID,
+			VchannelName: sd.vchannelName,
+			SegmentId:    segmentEntry.SegmentID,
+			PrimaryKeys:  storage.ParsePrimaryKeys2IDs(delRecord.PrimaryKeys),
+			Timestamps:   delRecord.Timestamps,
+			Scope:        scope,
+		})
+	}
+	return reqs
+}
+
+// applyDeleteBatch forwards the batched deletes to the worker in one request,
+// the segments are marked offline if failed.
+func (sd *shardDelegator) applyDeleteBatch(nodeID int64, reqs []*querypb.DeleteRequest) {
+	ctx := context.Background()
+	log := sd.getLogger(ctx).With(
+		zap.Int64("workerID", nodeID),
+		zap.Int("batchSize", len(reqs)),
+	)
+
+	req, err := packDeleteBatch(&querypb.DeleteRequest{
+		Base:         commonpbutil.NewMsgBase(commonpbutil.WithTargetID(nodeID)),
+		CollectionId: sd.collectionID,
+		VchannelName: sd.vchannelName,
+		Scope:        querypb.DataScope_Historical,
+	}, reqs, paramtable.Get().QueryNodeCfg.ForwardDeleteCompression.GetAsBool())
+	if err == nil {
+		err = retry.Handle(ctx, func() (bool, error) {
+			if sd.Stopped() {
+				return false, merr.WrapErrChannelNotAvailable(sd.vchannelName, "channel is unsubscribing")
+			}
+
+			worker, err := sd.workerManager.GetWorker(ctx, nodeID)
+			if err != nil {
+				// skip if node down
+				// delete will be processed after loaded again
+				log.Warn("failed to get worker", zap.Error(err))
+				return false, nil
+			}
+			err = worker.Delete(ctx, req)
+			if errors.Is(err, merr.ErrNodeNotFound) {
+				log.Warn("try to delete data on non-exist node")
+				return false, err
+			} else if err != nil {
+				log.Warn("worker failed to delete batch", zap.Error(err))
+				return true, err
+			}
+			return false, nil
+		}, retry.Attempts(10))
+	}
+	if err != nil {
+		segmentIDs := batchedSegments(reqs)
+		log.Warn("apply delete batch failed, mark segment offline", zap.Int64s("offlineSegments", segmentIDs), zap.Error(err))
+		sd.markSegmentOffline(segmentIDs...)
+	}
+}
+
 // markSegmentOffline makes segment go offline and waits for QueryCoord to fix.
 func (sd *shardDelegator) markSegmentOffline(segmentIDs ...int64) {
 	sd.distribution.AddOfflines(segmentIDs...)
@@ -327,6 +407,7 @@ func (sd *shardDelegator) addGrowing(entries ...SegmentEntry) {
 // LoadGrowing load growing segments locally.
 func (sd *shardDelegator) LoadGrowing(ctx context.Context, infos []*querypb.SegmentLoadInfo, version int64) error {
 	log := sd.getLogger(ctx)
+	defer sd.resultCache.Invalidate()
 
 	segmentIDs := lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) int64 { return info.GetSegmentID() })
 	log.Info("loading growing segments...", zap.Int64s("segmentIDs", segmentIDs))
@@ -385,6 +466,7 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 	}
 
 	log := sd.getLogger(ctx)
+	defer sd.resultCache.Invalidate()
 
 	targetNodeID := req.GetDstNodeID()
 	// add common log fields
@@ -460,6 +542,7 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 			PartitionID: info.GetPartitionID(),
 			NodeID:      req.GetDstNodeID(),
 			Version:     req.GetVersion(),
+			BucketID:    info.GetBucketID(),
 		}
 	})
 	if req.GetInfos()[0].GetLevel() == datapb.SegmentLevel_L0 {
@@ -475,6 +558,7 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 
 	// alter distribution
 	sd.distribution.AddDistributions(entries...)
+	sd.loadScalarStats(ctx, req.GetInfos())
 
 	partStatsToReload := make([]UniqueID, 0)
 	lo.ForEach(req.GetInfos(), func(info *querypb.SegmentLoadInfo, _ int) {
@@ -485,6 +569,67 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 	return nil
 }
 
+// loadScalarStats decodes and keeps the scalar field stats of the loaded segments for segment pruning,
+// the segment without valid stats is never pruned.
+// The stats of the field with null rows are skipped, as the rows read the default value not covered by the stats.
+func (sd *shardDelegator) loadScalarStats(ctx context.Context, infos []*querypb.SegmentLoadInfo) {
+	for _, info := range infos {
+		if len(info.GetScalarStats()) == 0 {
+			continue
+		}
+		stats := make([]*storage.FieldStats, 0, len(info.GetScalarStats()))
+		for _, fieldStats := range info.GetScalarStats() {
+			if fieldStats.GetNullCount() > 0 {
+				continue
+			}
+			fs := &storage.FieldStats{}
+			if err := json.Unmarshal(fieldStats.GetStats(), fs); err != nil {
+				log.Warn("failed to decode scalar stats, skip it",
+					zap.Int64("segmentID", info.GetSegmentID()),
+					zap.Int64("fieldID", fieldStats.GetFieldID()),
+					zap.Error(err))
+				continue
+			}
+			fs.BF = sd.loadScalarBloomFilter(ctx, info, fieldStats.GetFieldID())
+			stats = append(stats, fs)
+		}
+		sd.scalarStats.Insert(info.GetSegmentID(), stats)
+	}
+}
+
+// loadScalarBloomFilter reads the bloom filter of the scalar field from the artifact logs of the segment,
+// nil if the bloom filters don't cover all the rows of the segment or failed to read.
+func (sd *shardDelegator) loadScalarBloomFilter(ctx context.Context, info *querypb.SegmentLoadInfo, fieldID int64) *bloom.BloomFilter {
+	logs, ok := lo.Find(info.GetArtifactlogs(), func(logs *datapb.FieldArtifactLogs) bool {
+		return logs.GetFieldID() == fieldID && logs.GetName() == storage.ScalarBloomFilterArtifact
+	})
+	if !ok || sd.chunkManager == nil {
+		return nil
+	}
+	rows := lo.SumBy(logs.GetBinlogs(), func(binlog *datapb.Binlog) int64 { return binlog.GetEntriesNum() })
+	if rows != info.GetNumOfRows() {
+		return nil
+	}
+	paths := lo.Map(logs.GetBinlogs(), func(binlog *datapb.Binlog, _ int) string { return binlog.GetLogPath() })
+	values, err := sd.chunkManager.MultiRead(ctx, paths)
+	if err != nil {
+		log.Warn("failed to read scalar bloom filters, skip them",
+			zap.Int64("segmentID", info.GetSegmentID()),
+			zap.Int64("fieldID", fieldID),
+			zap.Error(err))
+		return nil
+	}
+	bf, err := storage.MergeScalarBloomFilters(values)
+	if err != nil {
+		log.Warn("failed to decode scalar bloom filters, skip them",
+			zap.Int64("segmentID", info.GetSegmentID()),
+			zap.Int64("fieldID", fieldID),
+			zap.Error(err))
+		return nil
+	}
+	return bf
+}
+
 func (sd *shardDelegator) GetLevel0Deletions(partitionID int64) ([]storage.PrimaryKey, []storage.Timestamp) {
 	sd.level0Mut.RLock()
 	deleteData, ok1 := sd.level0Deletions[partitionID]
@@ -775,6 +920,7 @@ func (sd *shardDelegator) readDeleteFromMsgstream(ctx context.Context, position
 // ReleaseSegments releases segments local or remotely depending on the target node.
 func (sd *shardDelegator) ReleaseSegments(ctx context.Context, req *querypb.ReleaseSegmentsRequest, force bool) error {
 	log := sd.getLogger(ctx)
+	defer sd.resultCache.Invalidate()
 
 	targetNodeID := req.GetNodeID()
 	level0Segments := typeutil.NewSet(lo.Map(sd.segmentManager.GetBy(segments.WithLevel(datapb.SegmentLevel_L0), segments.WithChannel(sd.vchannelName)), func(segment segments.Segment, _ int) int64 {
@@ -828,6 +974,9 @@ func (sd *shardDelegator) ReleaseSegments(ctx context.Context, req *querypb.Rele
 			pkoracle.WithSegmentType(commonpb.SegmentState_Sealed),
 			pkoracle.WithWorkerID(targetNodeID),
 		)
+		for _, entry := range sealed {
+			sd.scalarStats.Remove(entry.SegmentID)
+		}
 	}
 	if len(growing) > 0 {
 		sd.pkOracle.Remove(
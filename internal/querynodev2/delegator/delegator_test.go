@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/querynodev2/cluster"
+	"github.com/milvus-io/milvus/internal/querynodev2/delegator/deletebuffer"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/querynodev2/tsafe"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -49,6 +50,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metric"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 )
 
 type DelegatorSuite struct {
@@ -308,6 +310,44 @@ func (s *DelegatorSuite) TestSearch() {
 		s.Equal(3, len(results))
 	})
 
+	s.Run("result_cache", func() {
+		paramtable.Get().Save(paramtable.Get().QueryNodeCfg.ResultCacheEnabled.Key, "true")
+		defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.ResultCacheEnabled.Key)
+		defer func() {
+			s.workerManager.ExpectedCalls = nil
+		}()
+		worker := &cluster.MockWorker{}
+		worker.EXPECT().SearchSegments(mock.Anything, mock.AnythingOfType("*querypb.SearchRequest")).
+			Return(&internalpb.SearchResults{}, nil)
+		s.workerManager.EXPECT().GetWorker(mock.Anything, mock.AnythingOfType("int64")).Return(worker, nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		search := func(reqID int64) []*internalpb.SearchResults {
+			results, err := s.delegator.Search(ctx, &querypb.SearchRequest{
+				Req:         &internalpb.SearchRequest{Base: commonpbutil.NewMsgBase(), ReqID: reqID, Nq: 1, Topk: 10},
+				DmlChannels: []string{s.vchannelName},
+			})
+			s.NoError(err)
+			s.Equal(3, len(results))
+			return results
+		}
+
+		search(1)
+		worker.AssertNumberOfCalls(s.T(), "SearchSegments", 3)
+		// identical search hits the cache
+		results := search(2)
+		worker.AssertNumberOfCalls(s.T(), "SearchSegments", 3)
+		s.EqualValues(2, results[0].GetReqID())
+
+		// data changed
+		sd, ok := s.delegator.(*shardDelegator)
+		s.Require().True(ok)
+		sd.resultCache.Invalidate()
+		search(3)
+		worker.AssertNumberOfCalls(s.T(), "SearchSegments", 6)
+	})
+
 	s.Run("partition_not_loaded", func() {
 		defer func() {
 			s.workerManager.ExpectedCalls = nil
@@ -1376,6 +1416,9 @@ func TestDelegatorWatchTsafe(t *testing.T) {
 		vchannelName: channelName,
 		lifetime:     lifetime.NewLifetime(lifetime.Initializing),
 		latestTsafe:  atomic.NewUint64(0),
+		deleteBuffer: deletebuffer.NewListDeleteBuffer[*deletebuffer.Item](0, 1024),
+
+		standingQueries: newStandingQueryManager(),
 	}
 	defer sd.Close()
 
@@ -1403,6 +1446,9 @@ func TestDelegatorTSafeListenerClosed(t *testing.T) {
 		vchannelName: channelName,
 		lifetime:     lifetime.NewLifetime(lifetime.Initializing),
 		latestTsafe:  atomic.NewUint64(0),
+		deleteBuffer: deletebuffer.NewListDeleteBuffer[*deletebuffer.Item](0, 1024),
+
+		standingQueries: newStandingQueryManager(),
 	}
 	defer sd.Close()
 
@@ -1434,3 +1480,35 @@ func TestDelegatorTSafeListenerClosed(t *testing.T) {
 	assert.Equal(t, sd.Serviceable(), false)
 	assert.Equal(t, sd.Stopped(), true)
 }
+
+func TestReadGuaranteeTs(t *testing.T) {
+	now := time.Now()
+	guaranteeTs := tsoutil.ComposeTSByTime(now, 0)
+
+	// strong and session reads wait for the guarantee ts
+	assert.Equal(t, guaranteeTs, readGuaranteeTs(&internalpb.SearchRequest{
+		GuaranteeTimestamp: guaranteeTs,
+		ConsistencyLevel:   commonpb.ConsistencyLevel_Strong,
+		MaxStaleness:       1000,
+	}))
+	assert.Equal(t, guaranteeTs, readGuaranteeTs(&internalpb.RetrieveRequest{
+		GuaranteeTimestamp: guaranteeTs,
+		ConsistencyLevel:   commonpb.ConsistencyLevel_Session,
+	}))
+
+	// bounded reads accept the data within max staleness
+	assert.Equal(t, tsoutil.ComposeTSByTime(now.Add(-time.Second), 0), readGuaranteeTs(&internalpb.SearchRequest{
+		GuaranteeTimestamp: guaranteeTs,
+		ConsistencyLevel:   commonpb.ConsistencyLevel_Bounded,
+		MaxStaleness:       1000,
+	}))
+	assert.Equal(t, guaranteeTs, readGuaranteeTs(&internalpb.HybridSearchRequest{
+		GuaranteeTimestamp: guaranteeTs,
+		ConsistencyLevel:   commonpb.ConsistencyLevel_Bounded,
+	}))
+	assert.EqualValues(t, 0, readGuaranteeTs(&internalpb.RetrieveRequest{
+		GuaranteeTimestamp: 1,
+		ConsistencyLevel:   commonpb.ConsistencyLevel_Bounded,
+		MaxStaleness:       1000,
+	}))
+}
@@ -0,0 +1,171 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/compressor"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// deleteBatcher accumulates the deletes forwarded to the remote workers,
+// and flushes them to each worker in one request
+// once the batch is full or the flush interval elapsed.
+type deleteBatcher struct {
+	mut     sync.Mutex
+	pending map[int64][]*querypb.DeleteRequest // nodeID => forwarded deletes
+	rows    int
+
+	// flushMut keeps the deletes of the same segment applied in order
+	flushMut sync.Mutex
+	apply    func(nodeID int64, reqs []*querypb.DeleteRequest)
+
+	interval  time.Duration
+	notify    chan struct{}
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func newDeleteBatcher(interval time.Duration, apply func(nodeID int64, reqs []*querypb.DeleteRequest)) *deleteBatcher {
+	return &deleteBatcher{
+		pending:  make(map[int64][]*querypb.DeleteRequest),
+		apply:    apply,
+		interval: interval,
+		notify:   make(chan struct{}, 1),
+		closeCh:  make(chan struct{}),
+	}
+}
+
+func (b *deleteBatcher) Start() {
+	b.wg.Add(1)
+	go b.loop()
+}
+
+func (b *deleteBatcher) loop() {
+	defer b.wg.Done()
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.closeCh:
+			return
+		case <-ticker.C:
+		case <-b.notify:
+		}
+		b.Flush()
+	}
+}
+
+// Add puts the deletes forwarded to the given worker into batch.
+func (b *deleteBatcher) Add(nodeID int64, reqs ...*querypb.DeleteRequest) {
+	if len(reqs) == 0 {
+		return
+	}
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	b.pending[nodeID] = append(b.pending[nodeID], reqs...)
+	for _, req := range reqs {
+		b.rows += len(req.GetTimestamps())
+	}
+	if b.rows >= paramtable.Get().QueryNodeCfg.ForwardDeleteFlushSize.GetAsInt() {
+		select {
+		case b.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Flush applies all the pending deletes, returns after they are applied.
+func (b *deleteBatcher) Flush() {
+	b.flushMut.Lock()
+	defer b.flushMut.Unlock()
+
+	b.mut.Lock()
+	pending := b.pending
+	b.pending = make(map[int64][]*querypb.DeleteRequest)
+	b.rows = 0
+	b.mut.Unlock()
+
+	wg := sync.WaitGroup{}
+	for nodeID, reqs := range pending {
+		nodeID, reqs := nodeID, reqs
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			b.apply(nodeID, reqs)
+		}()
+	}
+	wg.Wait()
+}
+
+// Close stops the background flush, the pending deletes are dropped.
+func (b *deleteBatcher) Close() {
+	b.closeOnce.Do(func() {
+		close(b.closeCh)
+		b.wg.Wait()
+	})
+}
+
+// packDeleteBatch packs the deletes forwarded to the same worker into one request.
+func packDeleteBatch(base *querypb.DeleteRequest, reqs []*querypb.DeleteRequest, compress bool) (*querypb.DeleteRequest, error) {
+	batch := &querypb.DeleteBatch{Requests: reqs}
+	if !compress {
+		base.Batch = batch
+		return base, nil
+	}
+	bs, err := proto.Marshal(batch)
+	if err != nil {
+		return nil, err
+	}
+	base.CompressedBatch = compressor.ZstdCompressBytes(bs, nil)
+	return base, nil
+}
+
+// UnpackDeleteBatch returns the deletes batched in the forwarded delete request,
+// or the request itself if not batched.
+func UnpackDeleteBatch(req *querypb.DeleteRequest) ([]*querypb.DeleteRequest, error) {
+	if len(req.GetCompressedBatch()) > 0 {
+		bs, err := compressor.ZstdDecompressBytes(req.GetCompressedBatch(), nil)
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("failed to decompress delete batch: %s", err.Error())
+		}
+		batch := &querypb.DeleteBatch{}
+		if err := proto.Unmarshal(bs, batch); err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("failed to unmarshal delete batch: %s", err.Error())
+		}
+		return batch.GetRequests(), nil
+	}
+	if req.GetBatch() != nil {
+		return req.GetBatch().GetRequests(), nil
+	}
+	return []*querypb.DeleteRequest{req}, nil
+}
+
+// batchedSegments returns the segments of the batched deletes.
+func batchedSegments(reqs []*querypb.DeleteRequest) []int64 {
+	return lo.Uniq(lo.Map(reqs, func(req *querypb.DeleteRequest, _ int) int64 {
+		return req.GetSegmentId()
+	}))
+}
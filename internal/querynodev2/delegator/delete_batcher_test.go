@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type DeleteBatcherSuite struct {
+	suite.Suite
+
+	mut     sync.Mutex
+	applied map[int64][]*querypb.DeleteRequest
+	batcher *deleteBatcher
+}
+
+func (s *DeleteBatcherSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *DeleteBatcherSuite) SetupTest() {
+	s.applied = make(map[int64][]*querypb.DeleteRequest)
+	s.batcher = newDeleteBatcher(time.Hour, func(nodeID int64, reqs []*querypb.DeleteRequest) {
+		s.mut.Lock()
+		defer s.mut.Unlock()
+		s.applied[nodeID] = append(s.applied[nodeID], reqs...)
+	})
+	s.batcher.Start()
+}
+
+func (s *DeleteBatcherSuite) TearDownTest() {
+	s.batcher.Close()
+}
+
+func (s *DeleteBatcherSuite) appliedCount(nodeID int64) int {
+	s.mut.Lock()
+	defer s.mut.Unlock()
+	return len(s.applied[nodeID])
+}
+
+func (s *DeleteBatcherSuite) deleteRequest(segmentID int64, pks ...int64) *querypb.DeleteRequest {
+	tss := make([]uint64, len(pks))
+	for i := range pks {
+		tss[i] = uint64(pks[i])
+	}
+	return &querypb.DeleteRequest{
+		SegmentId:   segmentID,
+		PrimaryKeys: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		Timestamps:  tss,
+		Scope:       querypb.DataScope_Historical,
+	}
+}
+
+func (s *DeleteBatcherSuite) TestFlush() {
+	s.batcher.Add(1, s.deleteRequest(100, 1, 2), s.deleteRequest(101, 3))
+	s.batcher.Add(2, s.deleteRequest(200, 4))
+	s.batcher.Add(1, s.deleteRequest(100, 5))
+	s.Equal(0, s.appliedCount(1))
+
+	s.batcher.Flush()
+	s.Equal(3, s.appliedCount(1))
+	s.Equal(1, s.appliedCount(2))
+	s.Equal([]int64{100, 101}, batchedSegments(s.applied[1]))
+	// applied in order
+	s.Equal([]int64{5}, s.applied[1][2].GetPrimaryKeys().GetIntId().GetData())
+
+	// nothing pending
+	s.batcher.Flush()
+	s.Equal(3, s.appliedCount(1))
+}
+
+func (s *DeleteBatcherSuite) TestFlushOnSize() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.ForwardDeleteFlushSize.Key, "3")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.ForwardDeleteFlushSize.Key)
+
+	s.batcher.Add(1, s.deleteRequest(100, 1, 2))
+	s.Never(func() bool { return s.appliedCount(1) > 0 }, 100*time.Millisecond, 10*time.Millisecond)
+	s.batcher.Add(1, s.deleteRequest(100, 3))
+	s.Eventually(func() bool { return s.appliedCount(1) == 2 }, time.Second, 10*time.Millisecond)
+}
+
+func (s *DeleteBatcherSuite) TestFlushOnInterval() {
+	s.batcher.Close()
+	s.batcher = newDeleteBatcher(10*time.Millisecond, s.batcher.apply)
+	s.batcher.Start()
+
+	s.batcher.Add(1, s.deleteRequest(100, 1))
+	s.Eventually(func() bool { return s.appliedCount(1) == 1 }, time.Second, 10*time.Millisecond)
+}
+
+func (s *DeleteBatcherSuite) TestPackAndUnpack() {
+	reqs := []*querypb.DeleteRequest{s.deleteRequest(100, 1, 2), s.deleteRequest(101, 3)}
+	for _, compress := range []bool{true, false} {
+		req, err := packDeleteBatch(&querypb.DeleteRequest{CollectionId: 10}, reqs, compress)
+		s.Require().NoError(err)
+		s.Equal(compress, len(req.GetCompressedBatch()) > 0)
+
+		unpacked, err := UnpackDeleteBatch(req)
+		s.Require().NoError(err)
+		s.Require().Len(unpacked, 2)
+		s.EqualValues(100, unpacked[0].GetSegmentId())
+		s.Equal([]int64{1, 2}, unpacked[0].GetPrimaryKeys().GetIntId().GetData())
+		s.Equal([]uint64{3}, unpacked[1].GetTimestamps())
+	}
+
+	// not batched
+	req := s.deleteRequest(100, 1)
+	unpacked, err := UnpackDeleteBatch(req)
+	s.NoError(err)
+	s.Equal([]*querypb.DeleteRequest{req}, unpacked)
+
+	_, err = UnpackDeleteBatch(&querypb.DeleteRequest{CompressedBatch: []byte("invalid")})
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+}
+
+func TestDeleteBatcher(t *testing.T) {
+	suite.Run(t, new(DeleteBatcherSuite))
+}
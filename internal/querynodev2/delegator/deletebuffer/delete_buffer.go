@@ -36,6 +36,7 @@ type DeleteBuffer[T timed] interface {
 	ListAfter(uint64) []T
 	SafeTs() uint64
 	TryDiscard(uint64)
+	Close()
 }
 
 func NewDoubleCacheDeleteBuffer[T timed](startTs uint64, maxSize int64) DeleteBuffer[T] {
@@ -61,6 +62,9 @@ func (c *doubleCacheBuffer[T]) SafeTs() uint64 {
 func (c *doubleCacheBuffer[T]) TryDiscard(_ uint64) {
 }
 
+func (c *doubleCacheBuffer[T]) Close() {
+}
+
 // Put implements DeleteBuffer.
 func (c *doubleCacheBuffer[T]) Put(entry T) {
 	c.mut.Lock()
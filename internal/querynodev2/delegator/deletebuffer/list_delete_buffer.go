@@ -92,3 +92,6 @@ func (b *listDeleteBuffer[T]) TryDiscard(ts uint64) {
 		b.list = b.list[nextHead:]
 	}
 }
+
+func (b *listDeleteBuffer[T]) Close() {
+}
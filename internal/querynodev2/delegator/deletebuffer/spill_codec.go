@@ -0,0 +1,223 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletebuffer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const (
+	// SpillFormatBinary encodes the spilled items with a compact little-endian layout.
+	SpillFormatBinary = "binary"
+	// SpillFormatJSON encodes the spilled items as json, which is larger but readable for debugging.
+	SpillFormatJSON = "json"
+)
+
+// spillCodec serializes the delete items of a spilled block,
+// the order of items and rows shall be kept, so the deletes are applied in the same order as in memory.
+type spillCodec interface {
+	Encode(items []*Item) ([]byte, error)
+	Decode(data []byte) ([]*Item, error)
+}
+
+func newSpillCodec(format string) (spillCodec, error) {
+	switch format {
+	case SpillFormatBinary:
+		return binaryCodec{}, nil
+	case SpillFormatJSON:
+		return jsonCodec{}, nil
+	default:
+		return nil, merr.WrapErrParameterInvalid("binary or json", format, "unknown delete buffer spill format")
+	}
+}
+
+// binaryCodec layout, all the numbers are little-endian:
+// item:       ts(uint64) | dataNum(uint32) | data...
+// data:       partitionID(int64) | rowNum(uint32) | pkType(int32) | pks... | tss(uint64 * rowNum)
+// int64 pk:   value(int64)
+// varchar pk: length(uint32) | bytes
+type binaryCodec struct{}
+
+func (binaryCodec) Encode(items []*Item) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	write := func(v any) {
+		// writing to bytes.Buffer never fails
+		_ = binary.Write(buf, binary.LittleEndian, v)
+	}
+	for _, item := range items {
+		write(item.Ts)
+		write(uint32(len(item.Data)))
+		for _, data := range item.Data {
+			pks := data.DeleteData.Pks
+			pkType := schemapb.DataType_Int64
+			if len(pks) > 0 {
+				pkType = pks[0].Type()
+			}
+			write(data.PartitionID)
+			write(uint32(len(pks)))
+			write(int32(pkType))
+			for _, pk := range pks {
+				switch pkType {
+				case schemapb.DataType_Int64:
+					write(pk.GetValue().(int64))
+				case schemapb.DataType_VarChar:
+					value := pk.GetValue().(string)
+					write(uint32(len(value)))
+					buf.WriteString(value)
+				default:
+					return nil, merr.WrapErrParameterInvalid("int64 or varchar", pkType.String(), "unsupported primary key type")
+				}
+			}
+			write(data.DeleteData.Tss)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (binaryCodec) Decode(data []byte) ([]*Item, error) {
+	reader := bufio.NewReader(bytes.NewReader(data))
+	read := func(v any) error {
+		return binary.Read(reader, binary.LittleEndian, v)
+	}
+
+	items := make([]*Item, 0)
+	for {
+		item := &Item{}
+		if err := read(&item.Ts); err != nil {
+			if errors.Is(err, io.EOF) {
+				return items, nil
+			}
+			return nil, err
+		}
+		var dataNum uint32
+		if err := read(&dataNum); err != nil {
+			return nil, err
+		}
+		item.Data = make([]BufferItem, 0, dataNum)
+		for i := uint32(0); i < dataNum; i++ {
+			var (
+				partitionID int64
+				rowNum      uint32
+				pkType      int32
+			)
+			if err := read(&partitionID); err != nil {
+				return nil, err
+			}
+			if err := read(&rowNum); err != nil {
+				return nil, err
+			}
+			if err := read(&pkType); err != nil {
+				return nil, err
+			}
+			pks := make([]storage.PrimaryKey, 0, rowNum)
+			for j := uint32(0); j < rowNum; j++ {
+				switch schemapb.DataType(pkType) {
+				case schemapb.DataType_Int64:
+					var value int64
+					if err := read(&value); err != nil {
+						return nil, err
+					}
+					pks = append(pks, storage.NewInt64PrimaryKey(value))
+				case schemapb.DataType_VarChar:
+					var length uint32
+					if err := read(&length); err != nil {
+						return nil, err
+					}
+					value := make([]byte, length)
+					if _, err := io.ReadFull(reader, value); err != nil {
+						return nil, err
+					}
+					pks = append(pks, storage.NewVarCharPrimaryKey(string(value)))
+				default:
+					return nil, merr.WrapErrParameterInvalid("int64 or varchar", schemapb.DataType(pkType).String(), "unsupported primary key type")
+				}
+			}
+			tss := make([]uint64, rowNum)
+			if err := read(tss); err != nil {
+				return nil, err
+			}
+			item.Data = append(item.Data, BufferItem{
+				PartitionID: partitionID,
+				DeleteData:  *storage.NewDeleteData(pks, tss),
+			})
+		}
+		items = append(items, item)
+	}
+}
+
+type jsonCodec struct{}
+
+type jsonItem struct {
+	Ts   uint64           `json:"ts"`
+	Data []jsonBufferItem `json:"data"`
+}
+
+type jsonBufferItem struct {
+	PartitionID int64                `json:"partitionID"`
+	Logs        []*storage.DeleteLog `json:"logs"`
+}
+
+func (jsonCodec) Encode(items []*Item) ([]byte, error) {
+	encoded := make([]jsonItem, 0, len(items))
+	for _, item := range items {
+		ji := jsonItem{Ts: item.Ts, Data: make([]jsonBufferItem, 0, len(item.Data))}
+		for _, data := range item.Data {
+			logs := make([]*storage.DeleteLog, 0, len(data.DeleteData.Pks))
+			for i, pk := range data.DeleteData.Pks {
+				logs = append(logs, storage.NewDeleteLog(pk, data.DeleteData.Tss[i]))
+			}
+			ji.Data = append(ji.Data, jsonBufferItem{PartitionID: data.PartitionID, Logs: logs})
+		}
+		encoded = append(encoded, ji)
+	}
+	return json.Marshal(encoded)
+}
+
+func (jsonCodec) Decode(data []byte) ([]*Item, error) {
+	var decoded []jsonItem
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	items := make([]*Item, 0, len(decoded))
+	for _, ji := range decoded {
+		item := &Item{Ts: ji.Ts, Data: make([]BufferItem, 0, len(ji.Data))}
+		for _, data := range ji.Data {
+			pks := make([]storage.PrimaryKey, 0, len(data.Logs))
+			tss := make([]uint64, 0, len(data.Logs))
+			for _, log := range data.Logs {
+				pks = append(pks, log.Pk)
+				tss = append(tss, log.Ts)
+			}
+			item.Data = append(item.Data, BufferItem{
+				PartitionID: data.PartitionID,
+				DeleteData:  *storage.NewDeleteData(pks, tss),
+			})
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,89 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletebuffer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func TestSpillCodec(t *testing.T) {
+	items := []*Item{
+		{
+			Ts: 10,
+			Data: []BufferItem{
+				{
+					PartitionID: 100,
+					DeleteData: *storage.NewDeleteData(
+						[]storage.PrimaryKey{storage.NewInt64PrimaryKey(1), storage.NewInt64PrimaryKey(2)},
+						[]uint64{9, 10}),
+				},
+				{
+					PartitionID: 101,
+					DeleteData:  *storage.NewDeleteData(nil, nil),
+				},
+			},
+		},
+		{
+			Ts: 11,
+			Data: []BufferItem{
+				{
+					PartitionID: 100,
+					DeleteData: *storage.NewDeleteData(
+						[]storage.PrimaryKey{storage.NewVarCharPrimaryKey("b"), storage.NewVarCharPrimaryKey("a")},
+						[]uint64{11, 11}),
+				},
+			},
+		},
+	}
+
+	for _, format := range []string{SpillFormatBinary, SpillFormatJSON} {
+		t.Run(format, func(t *testing.T) {
+			codec, err := newSpillCodec(format)
+			assert.NoError(t, err)
+
+			data, err := codec.Encode(items)
+			assert.NoError(t, err)
+			decoded, err := codec.Decode(data)
+			assert.NoError(t, err)
+
+			assert.Len(t, decoded, len(items))
+			for i, item := range items {
+				assert.Equal(t, item.Ts, decoded[i].Ts)
+				assert.Len(t, decoded[i].Data, len(item.Data))
+				for j, data := range item.Data {
+					assert.Equal(t, data.PartitionID, decoded[i].Data[j].PartitionID)
+					assert.Equal(t, data.DeleteData.RowCount, decoded[i].Data[j].DeleteData.RowCount)
+					assert.Equal(t, data.DeleteData.Size(), decoded[i].Data[j].DeleteData.Size())
+					for k, pk := range data.DeleteData.Pks {
+						assert.True(t, pk.EQ(decoded[i].Data[j].DeleteData.Pks[k]))
+						assert.Equal(t, data.DeleteData.Tss[k], decoded[i].Data[j].DeleteData.Tss[k])
+					}
+				}
+			}
+		})
+	}
+
+	_, err := newSpillCodec("unknown")
+	assert.Error(t, err)
+
+	_, err = binaryCodec{}.Decode([]byte{1, 2, 3})
+	assert.Error(t, err)
+}
@@ -0,0 +1,326 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletebuffer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/util/diskquota"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// SpillConfig is the config of the spillable delete buffer.
+type SpillConfig struct {
+	// Dir is the directory to keep the spilled blocks, it's cleaned up when the buffer created and closed
+	Dir string
+	// MemoryLimit is the max size of the blocks kept in memory
+	MemoryLimit int64
+	// Format is the encoding format of the spilled blocks, see SpillFormatBinary and SpillFormatJSON
+	Format string
+	// CompactThreshold is the max number of spilled files, the adjacent spilled blocks are merged if exceeded
+	CompactThreshold int
+}
+
+// NewSpillDeleteBuffer returns a DeleteBuffer which spills the earliest blocks to disk
+// when the size of blocks in memory exceeds the memory limit.
+func NewSpillDeleteBuffer(startTs uint64, sizePerBlock int64, config SpillConfig) (DeleteBuffer[*Item], error) {
+	codec, err := newSpillCodec(config.Format)
+	if err != nil {
+		return nil, err
+	}
+	// clean up the spilled files left by the previous run
+	if err := os.RemoveAll(config.Dir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(config.Dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &spillDeleteBuffer{
+		safeTs:       startTs,
+		sizePerBlock: sizePerBlock,
+		config:       config,
+		codec:        codec,
+		list:         []*spillBlock{newSpillBlock(startTs, sizePerBlock)},
+	}, nil
+}
+
+// spillBlock is a cacheBlock which could be spilled to disk.
+type spillBlock struct {
+	headTs uint64
+	size   int64
+	// mem is nil after the block spilled
+	mem  *cacheBlock[*Item]
+	path string
+	// diskSize is the size of the spilled file reserved from the local disk quota
+	diskSize int64
+}
+
+func newSpillBlock(ts uint64, sizePerBlock int64, elements ...*Item) *spillBlock {
+	block := &spillBlock{
+		headTs: ts,
+		mem:    newCacheBlock[*Item](ts, sizePerBlock, elements...),
+	}
+	for _, element := range elements {
+		block.size += element.Size()
+	}
+	return block
+}
+
+func (b *spillBlock) put(entry *Item) error {
+	if err := b.mem.Put(entry); err != nil {
+		return err
+	}
+	b.size += entry.Size()
+	return nil
+}
+
+func (b *spillBlock) spilled() bool {
+	return b.mem == nil
+}
+
+// spillDeleteBuffer implements DeleteBuffer with a list of blocks like listDeleteBuffer,
+// the earliest blocks except the tail are spilled to disk to bound the memory usage,
+// and read back in order when listing, so the forwarded deletes keep the same apply order.
+type spillDeleteBuffer struct {
+	mut sync.RWMutex
+
+	list []*spillBlock
+
+	safeTs       uint64
+	sizePerBlock int64
+	memorySize   int64
+	config       SpillConfig
+	codec        spillCodec
+	nextFileID   int64
+}
+
+func (b *spillDeleteBuffer) Put(entry *Item) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+
+	tail := b.list[len(b.list)-1]
+	err := tail.put(entry)
+	if errors.Is(err, errBufferFull) {
+		b.list = append(b.list, newSpillBlock(entry.Timestamp(), b.sizePerBlock, entry))
+	}
+	b.memorySize += entry.Size()
+
+	if b.memorySize > b.config.MemoryLimit {
+		if err := b.spill(); err != nil {
+			// keep the blocks in memory, try spilling again at next put
+			log.RatedWarn(60, "failed to spill delete buffer", zap.String("dir", b.config.Dir), zap.Error(err))
+			return
+		}
+		if err := b.compact(); err != nil {
+			log.Warn("failed to compact spilled delete buffer", zap.String("dir", b.config.Dir), zap.Error(err))
+		}
+	}
+}
+
+// spill writes the earliest blocks in memory to disk until the memory size is under limit,
+// the tail block is always kept in memory as it's being written.
+func (b *spillDeleteBuffer) spill() error {
+	for _, block := range b.list[:len(b.list)-1] {
+		if b.memorySize <= b.config.MemoryLimit {
+			return nil
+		}
+		// nothing to spill for the empty head block
+		if block.spilled() || block.size == 0 {
+			continue
+		}
+		path, diskSize, err := b.writeFile(block.mem.data)
+		if err != nil {
+			return err
+		}
+		block.path = path
+		block.diskSize = diskSize
+		block.mem = nil
+		b.memorySize -= block.size
+	}
+	return nil
+}
+
+// compact merges the adjacent spilled blocks until the number of spilled files is under threshold,
+// the merged block takes the earlier head ts, so it would be discarded no earlier than before.
+func (b *spillDeleteBuffer) compact() error {
+	for {
+		spilledNum := 0
+		for _, block := range b.list {
+			if block.spilled() {
+				spilledNum++
+			}
+		}
+		if spilledNum <= b.config.CompactThreshold {
+			return nil
+		}
+
+		// merge the smallest adjacent pair
+		target := -1
+		for idx := 0; idx+1 < len(b.list); idx++ {
+			if !b.list[idx].spilled() || !b.list[idx+1].spilled() {
+				continue
+			}
+			if target == -1 || b.list[idx].size+b.list[idx+1].size < b.list[target].size+b.list[target+1].size {
+				target = idx
+			}
+		}
+		if target == -1 {
+			return nil
+		}
+
+		first, second := b.list[target], b.list[target+1]
+		items, err := b.readFile(first.path)
+		if err != nil {
+			return err
+		}
+		secondItems, err := b.readFile(second.path)
+		if err != nil {
+			return err
+		}
+		path, diskSize, err := b.writeFile(append(items, secondItems...))
+		if err != nil {
+			return err
+		}
+		b.removeFile(first)
+		b.removeFile(second)
+
+		merged := &spillBlock{
+			headTs:   first.headTs,
+			size:     first.size + second.size,
+			path:     path,
+			diskSize: diskSize,
+		}
+		b.list = append(b.list[:target], append([]*spillBlock{merged}, b.list[target+2:]...)...)
+	}
+}
+
+func (b *spillDeleteBuffer) ListAfter(ts uint64) []*Item {
+	b.mut.RLock()
+	defer b.mut.RUnlock()
+
+	var result []*Item
+	for _, block := range b.list {
+		if !block.spilled() {
+			result = append(result, block.mem.ListAfter(ts)...)
+			continue
+		}
+		items, err := b.readFile(block.path)
+		if err != nil {
+			// the deletes must not be skipped silently, which breaks the consistency
+			log.Panic("failed to read spilled delete buffer", zap.String("path", block.path), zap.Error(err))
+		}
+		idx := sort.Search(len(items), func(idx int) bool {
+			return items[idx].Timestamp() >= ts
+		})
+		result = append(result, items[idx:]...)
+	}
+	return result
+}
+
+func (b *spillDeleteBuffer) SafeTs() uint64 {
+	b.mut.RLock()
+	defer b.mut.RUnlock()
+	return b.safeTs
+}
+
+func (b *spillDeleteBuffer) TryDiscard(ts uint64) {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	if len(b.list) == 1 {
+		return
+	}
+	var nextHead int
+	for idx := len(b.list) - 1; idx >= 0; idx-- {
+		block := b.list[idx]
+		if block.headTs <= ts {
+			nextHead = idx
+			break
+		}
+	}
+
+	if nextHead > 0 {
+		for idx := 0; idx < nextHead; idx++ {
+			b.release(b.list[idx])
+			b.list[idx] = nil
+		}
+		b.list = b.list[nextHead:]
+	}
+}
+
+// Close removes all the spilled files.
+func (b *spillDeleteBuffer) Close() {
+	b.mut.Lock()
+	defer b.mut.Unlock()
+	for _, block := range b.list {
+		b.release(block)
+	}
+	b.list = []*spillBlock{newSpillBlock(b.safeTs, b.sizePerBlock)}
+	if err := os.RemoveAll(b.config.Dir); err != nil {
+		log.Warn("failed to remove delete buffer spill dir", zap.String("dir", b.config.Dir), zap.Error(err))
+	}
+}
+
+func (b *spillDeleteBuffer) release(block *spillBlock) {
+	if block.spilled() {
+		b.removeFile(block)
+	} else {
+		b.memorySize -= block.size
+	}
+}
+
+// writeFile writes the items into a new spilled file, returns the path and size of the file,
+// it fails if the local disk quota exceeded.
+func (b *spillDeleteBuffer) writeFile(items []*Item) (string, int64, error) {
+	data, err := b.codec.Encode(items)
+	if err != nil {
+		return "", 0, err
+	}
+	size := int64(len(data))
+	if err := diskquota.Reserve(diskquota.ConsumerDeleteBuffer, size); err != nil {
+		return "", 0, err
+	}
+	path := filepath.Join(b.config.Dir, fmt.Sprintf("%d.%s", b.nextFileID, b.config.Format))
+	b.nextFileID++
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		diskquota.Release(diskquota.ConsumerDeleteBuffer, size)
+		return "", 0, err
+	}
+	return path, size, nil
+}
+
+func (b *spillDeleteBuffer) readFile(path string) ([]*Item, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return b.codec.Decode(data)
+}
+
+func (b *spillDeleteBuffer) removeFile(block *spillBlock) {
+	if err := os.Remove(block.path); err != nil && !os.IsNotExist(err) {
+		log.Warn("failed to remove spilled delete buffer file", zap.String("path", block.path), zap.Error(err))
+	}
+	diskquota.Release(diskquota.ConsumerDeleteBuffer, block.diskSize)
+}
@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletebuffer
+
+import (
+	"os"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/diskquota"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type SpillDeleteBufferSuite struct {
+	suite.Suite
+
+	config SpillConfig
+}
+
+func (s *SpillDeleteBufferSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *SpillDeleteBufferSuite) SetupTest() {
+	s.config = SpillConfig{
+		Dir:              s.T().TempDir(),
+		MemoryLimit:      1,
+		Format:           SpillFormatBinary,
+		CompactThreshold: 16,
+	}
+}
+
+func (s *SpillDeleteBufferSuite) newItem(ts uint64, pks ...storage.PrimaryKey) *Item {
+	return &Item{
+		Ts: ts,
+		Data: []BufferItem{
+			{
+				PartitionID: 200,
+				DeleteData:  *storage.NewDeleteData(pks, lo.RepeatBy(len(pks), func(int) uint64 { return ts })),
+			},
+		},
+	}
+}
+
+func (s *SpillDeleteBufferSuite) spilledFiles() []os.DirEntry {
+	entries, err := os.ReadDir(s.config.Dir)
+	s.Require().NoError(err)
+	return entries
+}
+
+func (s *SpillDeleteBufferSuite) TestNewBuffer() {
+	buffer, err := NewSpillDeleteBuffer(10, 1000, s.config)
+	s.Require().NoError(err)
+	s.EqualValues(10, buffer.SafeTs())
+
+	s.config.Format = "unknown"
+	_, err = NewSpillDeleteBuffer(10, 1000, s.config)
+	s.Error(err)
+}
+
+func (s *SpillDeleteBufferSuite) TestSpill() {
+	for _, format := range []string{SpillFormatBinary, SpillFormatJSON} {
+		s.Run(format, func() {
+			s.config.Dir = s.T().TempDir()
+			s.config.Format = format
+			buffer, err := NewSpillDeleteBuffer(10, 1, s.config)
+			s.Require().NoError(err)
+			defer buffer.Close()
+
+			buffer.Put(s.newItem(11, storage.NewInt64PrimaryKey(1), storage.NewInt64PrimaryKey(2)))
+			buffer.Put(s.newItem(12, storage.NewVarCharPrimaryKey("a")))
+			buffer.Put(s.newItem(13, storage.NewInt64PrimaryKey(3)))
+
+			// all the blocks except the tail are spilled
+			s.Len(s.spilledFiles(), 2)
+			sdb := buffer.(*spillDeleteBuffer)
+			s.Len(sdb.list, 4)
+			s.EqualValues(sdb.list[3].size, sdb.memorySize)
+
+			// items are listed in order
+			items := buffer.ListAfter(11)
+			s.Len(items, 3)
+			s.EqualValues([]uint64{11, 12, 13}, lo.Map(items, func(item *Item, _ int) uint64 { return item.Ts }))
+			s.Equal(storage.NewInt64PrimaryKey(2), items[0].Data[0].DeleteData.Pks[1])
+			s.Equal(storage.NewVarCharPrimaryKey("a"), items[1].Data[0].DeleteData.Pks[0])
+			s.EqualValues(200, items[1].Data[0].PartitionID)
+			s.EqualValues([]uint64{12}, items[1].Data[0].DeleteData.Tss)
+
+			s.Len(buffer.ListAfter(13), 1)
+		})
+	}
+}
+
+func (s *SpillDeleteBufferSuite) TestMemoryLimit() {
+	s.config.MemoryLimit = 1024
+	buffer, err := NewSpillDeleteBuffer(10, 1, s.config)
+	s.Require().NoError(err)
+	defer buffer.Close()
+
+	buffer.Put(s.newItem(11, storage.NewInt64PrimaryKey(1)))
+	buffer.Put(s.newItem(12, storage.NewInt64PrimaryKey(2)))
+	s.Len(s.spilledFiles(), 0)
+	s.Len(buffer.ListAfter(11), 2)
+}
+
+func (s *SpillDeleteBufferSuite) TestDiskQuotaExceeded() {
+	params := paramtable.Get()
+	params.Save(params.LocalStorageCfg.DiskCapacityLimit.Key, "0")
+	defer params.Reset(params.LocalStorageCfg.DiskCapacityLimit.Key)
+
+	buffer, err := NewSpillDeleteBuffer(10, 1, s.config)
+	s.Require().NoError(err)
+	defer buffer.Close()
+
+	// the blocks are kept in memory
+	buffer.Put(s.newItem(11, storage.NewInt64PrimaryKey(1)))
+	buffer.Put(s.newItem(12, storage.NewInt64PrimaryKey(2)))
+	s.Len(s.spilledFiles(), 0)
+	s.Zero(diskquota.Usage(diskquota.ConsumerDeleteBuffer))
+	s.Len(buffer.ListAfter(11), 2)
+}
+
+func (s *SpillDeleteBufferSuite) TestCompact() {
+	s.config.CompactThreshold = 2
+	buffer, err := NewSpillDeleteBuffer(10, 1, s.config)
+	s.Require().NoError(err)
+	defer buffer.Close()
+
+	for ts := uint64(11); ts <= 16; ts++ {
+		buffer.Put(s.newItem(ts, storage.NewInt64PrimaryKey(int64(ts))))
+	}
+	s.LessOrEqual(len(s.spilledFiles()), 2)
+
+	items := buffer.ListAfter(0)
+	s.EqualValues([]uint64{11, 12, 13, 14, 15, 16}, lo.Map(items, func(item *Item, _ int) uint64 { return item.Ts }))
+}
+
+func (s *SpillDeleteBufferSuite) TestTryDiscard() {
+	buffer, err := NewSpillDeleteBuffer(10, 1, s.config)
+	s.Require().NoError(err)
+
+	buffer.Put(s.newItem(10, storage.NewInt64PrimaryKey(1)))
+	buffer.Put(s.newItem(20, storage.NewInt64PrimaryKey(2)))
+	buffer.Put(s.newItem(30, storage.NewInt64PrimaryKey(3)))
+	s.Len(s.spilledFiles(), 2)
+	s.Positive(diskquota.Usage(diskquota.ConsumerDeleteBuffer))
+
+	buffer.TryDiscard(20)
+	s.Len(buffer.ListAfter(0), 2)
+	s.Len(s.spilledFiles(), 1)
+
+	buffer.TryDiscard(30)
+	s.Len(buffer.ListAfter(0), 1)
+	s.Len(s.spilledFiles(), 0)
+	s.Zero(diskquota.Usage(diskquota.ConsumerDeleteBuffer))
+
+	buffer.Close()
+	_, err = os.Stat(s.config.Dir)
+	s.True(os.IsNotExist(err))
+}
+
+func TestSpillDeleteBuffer(t *testing.T) {
+	suite.Run(t, new(SpillDeleteBufferSuite))
+}
@@ -84,6 +84,8 @@ type SegmentEntry struct {
 	PartitionID   UniqueID
 	Version       int64
 	TargetVersion int64
+	// BucketID is the bucket of the partition key values in the partition, 0 if not bucketed
+	BucketID int64
 }
 
 // NewDistribution creates a new distribution instance with all field initialized.
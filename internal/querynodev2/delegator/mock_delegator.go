@@ -733,6 +733,61 @@ func (_c *MockShardDelegator_Start_Call) RunAndReturn(run func()) *MockShardDele
 	return _c
 }
 
+// SubscribeStandingQuery provides a mock function with given fields: ctx, req
+func (_m *MockShardDelegator) SubscribeStandingQuery(ctx context.Context, req *querypb.SubscribeStandingQueryRequest) (*StandingQuery, error) {
+	ret := _m.Called(ctx, req)
+
+	var r0 *StandingQuery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SubscribeStandingQueryRequest) (*StandingQuery, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *querypb.SubscribeStandingQueryRequest) *StandingQuery); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*StandingQuery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *querypb.SubscribeStandingQueryRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShardDelegator_SubscribeStandingQuery_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubscribeStandingQuery'
+type MockShardDelegator_SubscribeStandingQuery_Call struct {
+	*mock.Call
+}
+
+// SubscribeStandingQuery is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *querypb.SubscribeStandingQueryRequest
+func (_e *MockShardDelegator_Expecter) SubscribeStandingQuery(ctx interface{}, req interface{}) *MockShardDelegator_SubscribeStandingQuery_Call {
+	return &MockShardDelegator_SubscribeStandingQuery_Call{Call: _e.mock.On("SubscribeStandingQuery", ctx, req)}
+}
+
+func (_c *MockShardDelegator_SubscribeStandingQuery_Call) Run(run func(ctx context.Context, req *querypb.SubscribeStandingQueryRequest)) *MockShardDelegator_SubscribeStandingQuery_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*querypb.SubscribeStandingQueryRequest))
+	})
+	return _c
+}
+
+func (_c *MockShardDelegator_SubscribeStandingQuery_Call) Return(_a0 *StandingQuery, _a1 error) *MockShardDelegator_SubscribeStandingQuery_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShardDelegator_SubscribeStandingQuery_Call) RunAndReturn(run func(context.Context, *querypb.SubscribeStandingQueryRequest) (*StandingQuery, error)) *MockShardDelegator_SubscribeStandingQuery_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SyncDistribution provides a mock function with given fields: ctx, entries
 func (_m *MockShardDelegator) SyncDistribution(ctx context.Context, entries ...SegmentEntry) {
 	_va := make([]interface{}, len(entries))
@@ -0,0 +1,140 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/util/exprutil"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// pruneByPartitionKey removes the segments of the partitions and the buckets which cannot hold the partition keys
+// in the filter expr of the request, so that no sub-task is dispatched for them.
+func (sd *shardDelegator) pruneByPartitionKey(ctx context.Context, serializedPlan []byte, queryType string,
+	sealed []SnapshotItem, growing []SegmentEntry,
+) ([]SnapshotItem, []SegmentEntry) {
+	if !paramtable.Get().QueryNodeCfg.EnablePartitionKeyPrune.GetAsBool() {
+		return sealed, growing
+	}
+	matched, ok := matchPartitionKeys(sd.collection.Schema(), sd.collection.GetPartitionKeyBuckets(),
+		sd.collection.GetPartitionKeyBucketNum(), serializedPlan)
+	if !ok {
+		return sealed, growing
+	}
+
+	sealed, growing, pruned := filterSegmentsByPartitionKeys(sealed, growing, matched)
+	if pruned > 0 {
+		metrics.QueryNodePartitionKeyPrunedSegmentNum.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), queryType).Add(float64(pruned))
+		log.Ctx(ctx).Debug("pruned segments by partition key",
+			zap.Int64s("partitions", lo.Keys(matched)),
+			zap.Int("prunedNum", pruned),
+		)
+	}
+	return sealed, growing
+}
+
+// partitionKeyMatch is the partitions which may hold the partition keys, with the buckets of each partition
+// which may hold them, the buckets are nil if the segments of the collection are not bucketed.
+type partitionKeyMatch map[int64]typeutil.Set[int64]
+
+// contain returns whether the segment may hold the partition keys,
+// the segment not bucketed, e.g. the growing segment, is kept once its partition matched.
+func (m partitionKeyMatch) contain(segment SegmentEntry) bool {
+	buckets, ok := m[segment.PartitionID]
+	if !ok {
+		return false
+	}
+	return buckets == nil || segment.BucketID == 0 || buckets.Contain(segment.BucketID)
+}
+
+// matchPartitionKeys returns the partitions and the buckets which may hold the partition keys in the filter expr of the plan,
+// ok is false if the partitions can't be pruned, e.g. no partition key mode or the expr is not an equality on the key.
+// buckets are the partition IDs in the order of the hash buckets, i.e. the index in the partition names,
+// and bucketNum is the number of buckets in each partition, which are provided by QueryCoord on loading.
+func matchPartitionKeys(schema *schemapb.CollectionSchema, buckets []int64, bucketNum int64, serializedPlan []byte) (partitionKeyMatch, bool) {
+	if len(buckets) == 0 || len(serializedPlan) == 0 || !typeutil.HasPartitionKey(schema) {
+		return nil, false
+	}
+	keyField, err := typeutil.GetPartitionKeyFieldSchema(schema)
+	if err != nil {
+		return nil, false
+	}
+
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		return nil, false
+	}
+	expr, err := exprutil.ParseExprFromPlan(plan)
+	if err != nil {
+		return nil, false
+	}
+	keys := exprutil.ParseKeys(expr, exprutil.PartitionKey)
+	if len(keys) == 0 {
+		return nil, false
+	}
+
+	matched := make(partitionKeyMatch)
+	for _, key := range keys {
+		value, err := typeutil2.HashPartitionKey(keyField, key)
+		if err != nil {
+			return nil, false
+		}
+		partitionID := buckets[value%uint32(len(buckets))]
+		if bucketNum <= 1 {
+			matched[partitionID] = nil
+			continue
+		}
+		if _, ok := matched[partitionID]; !ok {
+			matched[partitionID] = typeutil.NewSet[int64]()
+		}
+		matched[partitionID].Insert(int64(typeutil.HashValue2BucketID(value, len(buckets), bucketNum)))
+	}
+	return matched, true
+}
+
+// filterSegmentsByPartitionKeys keeps the segments which may hold the partition keys only, returns the number of segments removed.
+func filterSegmentsByPartitionKeys(sealed []SnapshotItem, growing []SegmentEntry, matched partitionKeyMatch) ([]SnapshotItem, []SegmentEntry, int) {
+	pruned := 0
+	mayHoldKeys := func(segment SegmentEntry, _ int) bool {
+		if matched.contain(segment) {
+			return true
+		}
+		pruned++
+		return false
+	}
+
+	sealed = lo.Map(sealed, func(item SnapshotItem, _ int) SnapshotItem {
+		return SnapshotItem{
+			NodeID:   item.NodeID,
+			Segments: lo.Filter(item.Segments, mayHoldKeys),
+		}
+	})
+	growing = lo.Filter(growing, mayHoldKeys)
+	return sealed, growing, pruned
+}
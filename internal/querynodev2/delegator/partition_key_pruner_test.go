@@ -0,0 +1,162 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func partitionKeyPlan(t *testing.T, keys ...int64) []byte {
+	values := make([]*planpb.GenericValue, 0, len(keys))
+	for _, key := range keys {
+		values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: key}})
+	}
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_Query{
+			Query: &planpb.QueryPlanNode{
+				Predicates: &planpb.Expr{
+					Expr: &planpb.Expr_TermExpr{
+						TermExpr: &planpb.TermExpr{
+							ColumnInfo: &planpb.ColumnInfo{
+								FieldId:        101,
+								DataType:       schemapb.DataType_Int64,
+								IsPartitionKey: true,
+							},
+							Values: values,
+						},
+					},
+				},
+			},
+		},
+	}
+	bs, err := proto.Marshal(plan)
+	assert.NoError(t, err)
+	return bs
+}
+
+func TestMatchPartitionKeys(t *testing.T) {
+	keyField := &schemapb.FieldSchema{FieldID: 101, Name: "key", DataType: schemapb.DataType_Int64, IsPartitionKey: true}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			keyField,
+		},
+	}
+	// the partition IDs are not monotonic in the order of the buckets
+	buckets := []int64{1003, 1001, 1002, 1000}
+
+	for key := int64(0); key < 16; key++ {
+		hashed, err := typeutil2.HashKey2Partitions(keyField, []*planpb.GenericValue{{Val: &planpb.GenericValue_Int64Val{Int64Val: key}}}, []string{"_default_0", "_default_1", "_default_2", "_default_3"})
+		assert.NoError(t, err)
+		assert.Len(t, hashed, 1)
+		var index int
+		_, err = fmt.Sscanf(hashed[0], "_default_%d", &index)
+		assert.NoError(t, err)
+
+		matched, ok := matchPartitionKeys(schema, buckets, 0, partitionKeyPlan(t, key))
+		assert.True(t, ok)
+		assert.Equal(t, partitionKeyMatch{buckets[index]: nil}, matched)
+
+		// the segments are bucketed in the partition by the proxy
+		value, _ := typeutil.Hash32Int64(key)
+		bucketID := typeutil.HashValue2BucketID(value, len(buckets), 4)
+		matched, ok = matchPartitionKeys(schema, buckets, 4, partitionKeyPlan(t, key))
+		assert.True(t, ok)
+		assert.Equal(t, partitionKeyMatch{buckets[index]: typeutil.NewSet[int64](int64(bucketID))}, matched)
+	}
+
+	// no partition key in expr
+	_, ok := matchPartitionKeys(schema, buckets, 0, partitionKeyPlan(t))
+	assert.False(t, ok)
+
+	// not partition key mode
+	_, ok = matchPartitionKeys(&schemapb.CollectionSchema{Fields: schema.Fields[:1]}, buckets, 0, partitionKeyPlan(t, 7))
+	assert.False(t, ok)
+
+	// no plan
+	_, ok = matchPartitionKeys(schema, buckets, 0, nil)
+	assert.False(t, ok)
+
+	// no buckets provided
+	_, ok = matchPartitionKeys(schema, nil, 0, partitionKeyPlan(t, 7))
+	assert.False(t, ok)
+}
+
+func TestFilterSegmentsByPartitionKeys(t *testing.T) {
+	sealed := []SnapshotItem{
+		{
+			NodeID: 1,
+			Segments: []SegmentEntry{
+				{SegmentID: 1, PartitionID: 1000},
+				{SegmentID: 2, PartitionID: 1001},
+			},
+		},
+		{
+			NodeID: 2,
+			Segments: []SegmentEntry{
+				{SegmentID: 3, PartitionID: 1001},
+			},
+		},
+	}
+	growing := []SegmentEntry{
+		{SegmentID: 4, PartitionID: 1000},
+		{SegmentID: 5, PartitionID: 1002},
+	}
+
+	filteredSealed, filteredGrowing, pruned := filterSegmentsByPartitionKeys(sealed, growing, partitionKeyMatch{1000: nil})
+	assert.Equal(t, 3, pruned)
+	assert.Len(t, filteredSealed, 2)
+	assert.Equal(t, []SegmentEntry{{SegmentID: 1, PartitionID: 1000}}, filteredSealed[0].Segments)
+	assert.Empty(t, filteredSealed[1].Segments)
+	assert.Equal(t, []SegmentEntry{{SegmentID: 4, PartitionID: 1000}}, filteredGrowing)
+	// the pinned segments are untouched
+	assert.Len(t, sealed[0].Segments, 2)
+
+	t.Run("bucketed", func(t *testing.T) {
+		sealed := []SnapshotItem{
+			{
+				NodeID: 1,
+				Segments: []SegmentEntry{
+					{SegmentID: 1, PartitionID: 1000, BucketID: 1},
+					{SegmentID: 2, PartitionID: 1000, BucketID: 2},
+					// written before bucketed
+					{SegmentID: 3, PartitionID: 1000},
+					{SegmentID: 4, PartitionID: 1001, BucketID: 1},
+				},
+			},
+		}
+		growing := []SegmentEntry{
+			{SegmentID: 5, PartitionID: 1000},
+		}
+
+		filteredSealed, filteredGrowing, pruned := filterSegmentsByPartitionKeys(sealed, growing,
+			partitionKeyMatch{1000: typeutil.NewSet[int64](2)})
+		assert.Equal(t, 2, pruned)
+		assert.ElementsMatch(t, []int64{2, 3}, lo.Map(filteredSealed[0].Segments, func(segment SegmentEntry, _ int) int64 { return segment.SegmentID }))
+		assert.Equal(t, growing, filteredGrowing)
+	})
+}
@@ -0,0 +1,171 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+// resultCache caches the search results of the delegator for the repeated searches,
+// the entries are keyed by the normalized request, the target version and the searched segments,
+// all the entries are invalidated once the data of the shard changes.
+type resultCache struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	capacity int
+	// generation increases on every invalidation,
+	// the results searched before the data changed are not put into cache.
+	generation int64
+	entries    map[string]*list.Element
+	lru        *list.List
+}
+
+type resultCacheEntry struct {
+	key      string
+	results  []*internalpb.SearchResults
+	expireAt time.Time
+}
+
+func newResultCache(ttl time.Duration, capacity int) *resultCache {
+	return &resultCache{
+		ttl:      ttl,
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// Generation returns the current generation, which shall be got before searching and passed to Put.
+func (c *resultCache) Generation() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.generation
+}
+
+// Get returns a copy of the cached results if not expired.
+func (c *resultCache) Get(key string) ([]*internalpb.SearchResults, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ele, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := ele.Value.(*resultCacheEntry)
+	if time.Now().After(entry.expireAt) {
+		c.remove(ele)
+		return nil, false
+	}
+	c.lru.MoveToFront(ele)
+	return cloneSearchResults(entry.results), true
+}
+
+// Put caches a copy of the results, unless the data changed since the generation got.
+func (c *resultCache) Put(key string, generation int64, results []*internalpb.SearchResults) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if generation != c.generation || c.capacity <= 0 {
+		return
+	}
+	entry := &resultCacheEntry{
+		key:      key,
+		results:  cloneSearchResults(results),
+		expireAt: time.Now().Add(c.ttl),
+	}
+	if ele, ok := c.entries[key]; ok {
+		ele.Value = entry
+		c.lru.MoveToFront(ele)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.capacity {
+		c.remove(c.lru.Back())
+	}
+}
+
+// Invalidate removes all the cached results.
+func (c *resultCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+func (c *resultCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+func (c *resultCache) remove(ele *list.Element) {
+	c.lru.Remove(ele)
+	delete(c.entries, ele.Value.(*resultCacheEntry).key)
+}
+
+func cloneSearchResults(results []*internalpb.SearchResults) []*internalpb.SearchResults {
+	return lo.Map(results, func(result *internalpb.SearchResults, _ int) *internalpb.SearchResults {
+		return proto.Clone(result).(*internalpb.SearchResults)
+	})
+}
+
+// resultCacheKey returns the cache key of the search request,
+// the fields which differ between the identical searches are ignored.
+func resultCacheKey(req *querypb.SearchRequest, targetVersion int64, sealed []SnapshotItem, growing []SegmentEntry) (string, error) {
+	normalized := proto.Clone(req).(*querypb.SearchRequest)
+	normalized.Req.Base = nil
+	normalized.Req.ReqID = 0
+	normalized.Req.MvccTimestamp = 0
+	normalized.Req.GuaranteeTimestamp = 0
+	normalized.Req.TimeoutTimestamp = 0
+	normalized.Req.Username = ""
+	data, err := proto.Marshal(normalized)
+	if err != nil {
+		return "", err
+	}
+
+	segments := make([]string, 0, len(sealed)+1)
+	for _, item := range sealed {
+		segmentIDs := lo.Map(item.Segments, func(entry SegmentEntry, _ int) int64 { return entry.SegmentID })
+		sort.Slice(segmentIDs, func(i, j int) bool { return segmentIDs[i] < segmentIDs[j] })
+		segments = append(segments, fmt.Sprintf("%d:%v", item.NodeID, segmentIDs))
+	}
+	sort.Strings(segments)
+	growingIDs := lo.Map(growing, func(entry SegmentEntry, _ int) int64 { return entry.SegmentID })
+	sort.Slice(growingIDs, func(i, j int) bool { return growingIDs[i] < growingIDs[j] })
+	segments = append(segments, fmt.Sprintf("growing:%v", growingIDs))
+
+	hash := sha256.New()
+	hash.Write(data)
+	hash.Write([]byte(strings.Join(segments, ";")))
+	return fmt.Sprintf("%d-%s", targetVersion, hex.EncodeToString(hash.Sum(nil))), nil
+}
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+)
+
+type ResultCacheSuite struct {
+	suite.Suite
+}
+
+func (s *ResultCacheSuite) results(reqID int64) []*internalpb.SearchResults {
+	return []*internalpb.SearchResults{{ReqID: reqID, NumQueries: 1, TopK: 10}}
+}
+
+func (s *ResultCacheSuite) TestGetPut() {
+	cache := newResultCache(time.Minute, 10)
+	_, ok := cache.Get("key")
+	s.False(ok)
+
+	cache.Put("key", cache.Generation(), s.results(1))
+	results, ok := cache.Get("key")
+	s.True(ok)
+	s.Len(results, 1)
+	s.EqualValues(1, results[0].GetReqID())
+
+	// the cached results are not affected by the caller
+	results[0].ReqID = 2
+	results, _ = cache.Get("key")
+	s.EqualValues(1, results[0].GetReqID())
+}
+
+func (s *ResultCacheSuite) TestExpire() {
+	cache := newResultCache(-time.Second, 10)
+	cache.Put("key", cache.Generation(), s.results(1))
+	_, ok := cache.Get("key")
+	s.False(ok)
+	s.Equal(0, cache.Len())
+}
+
+func (s *ResultCacheSuite) TestCapacity() {
+	cache := newResultCache(time.Minute, 2)
+	cache.Put("key1", cache.Generation(), s.results(1))
+	cache.Put("key2", cache.Generation(), s.results(2))
+	// key1 is the most recently used
+	_, ok := cache.Get("key1")
+	s.True(ok)
+	cache.Put("key3", cache.Generation(), s.results(3))
+
+	s.Equal(2, cache.Len())
+	_, ok = cache.Get("key2")
+	s.False(ok)
+	_, ok = cache.Get("key1")
+	s.True(ok)
+	_, ok = cache.Get("key3")
+	s.True(ok)
+
+	disabled := newResultCache(time.Minute, 0)
+	disabled.Put("key", disabled.Generation(), s.results(1))
+	s.Equal(0, disabled.Len())
+}
+
+func (s *ResultCacheSuite) TestInvalidate() {
+	cache := newResultCache(time.Minute, 10)
+	generation := cache.Generation()
+	cache.Put("key1", generation, s.results(1))
+
+	cache.Invalidate()
+	s.Equal(0, cache.Len())
+	// results searched before data changed are dropped
+	cache.Put("key2", generation, s.results(2))
+	s.Equal(0, cache.Len())
+
+	cache.Put("key2", cache.Generation(), s.results(2))
+	s.Equal(1, cache.Len())
+}
+
+func (s *ResultCacheSuite) TestKey() {
+	newReq := func(reqID int64, placeholder []byte) *querypb.SearchRequest {
+		return &querypb.SearchRequest{
+			Req: &internalpb.SearchRequest{
+				Base:               &commonpb.MsgBase{MsgID: reqID},
+				ReqID:              reqID,
+				PlaceholderGroup:   placeholder,
+				Nq:                 1,
+				Topk:               10,
+				GuaranteeTimestamp: uint64(reqID),
+				TimeoutTimestamp:   uint64(reqID),
+			},
+			DmlChannels: []string{"dml"},
+		}
+	}
+	sealed := []SnapshotItem{
+		{NodeID: 1, Segments: []SegmentEntry{{SegmentID: 2}, {SegmentID: 1}}},
+		{NodeID: 2, Segments: []SegmentEntry{{SegmentID: 3}}},
+	}
+	growing := []SegmentEntry{{SegmentID: 5}, {SegmentID: 4}}
+
+	key, err := resultCacheKey(newReq(1, []byte("vector")), 100, sealed, growing)
+	s.Require().NoError(err)
+
+	// request id, timestamps and segment order are ignored
+	other, err := resultCacheKey(newReq(2, []byte("vector")), 100,
+		[]SnapshotItem{
+			{NodeID: 2, Segments: []SegmentEntry{{SegmentID: 3}}},
+			{NodeID: 1, Segments: []SegmentEntry{{SegmentID: 1}, {SegmentID: 2}}},
+		},
+		[]SegmentEntry{{SegmentID: 4}, {SegmentID: 5}})
+	s.Require().NoError(err)
+	s.Equal(key, other)
+
+	other, err = resultCacheKey(newReq(1, []byte("other")), 100, sealed, growing)
+	s.Require().NoError(err)
+	s.NotEqual(key, other)
+
+	other, err = resultCacheKey(newReq(1, []byte("vector")), 101, sealed, growing)
+	s.Require().NoError(err)
+	s.NotEqual(key, other)
+
+	other, err = resultCacheKey(newReq(1, []byte("vector")), 100, sealed[:1], growing)
+	s.Require().NoError(err)
+	s.NotEqual(key, other)
+
+	other, err = resultCacheKey(newReq(1, []byte("vector")), 100, sealed, growing[:1])
+	s.Require().NoError(err)
+	s.NotEqual(key, other)
+}
+
+func TestResultCache(t *testing.T) {
+	suite.Run(t, new(ResultCacheSuite))
+}
@@ -227,3 +227,134 @@ func FilterSegmentsOnScalarField(partitionStats *storage.PartitionStatsSnapshot,
 		}
 	}
 }
+
+// PruneSegmentsByScalarStats removes the sealed segments whose scalar field min/max
+// don't overlap the ranges of the filter expr from the sealed segment list,
+// or whose bloom filters don't contain any value of the equal and term exprs.
+func PruneSegmentsByScalarStats(ctx context.Context,
+	getStats func(segmentID UniqueID) ([]*storage.FieldStats, bool),
+	serializedExprPlan []byte,
+	sealedSegments []SnapshotItem,
+) {
+	if len(serializedExprPlan) == 0 {
+		return
+	}
+	plan := planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedExprPlan, &plan); err != nil {
+		return
+	}
+	expr, err := exprutil.ParseExprFromPlan(&plan)
+	if err != nil || expr == nil {
+		return
+	}
+
+	// fieldID -> ranges parsed from the expr, nil if the field is not restricted
+	fieldRanges := make(map[int64][]*exprutil.PlanRange)
+	getRanges := func(fieldID int64) []*exprutil.PlanRange {
+		ranges, ok := fieldRanges[fieldID]
+		if !ok {
+			var matchALL bool
+			ranges, matchALL = exprutil.ParseFieldRanges(expr, fieldID)
+			if matchALL {
+				ranges = nil
+			}
+			fieldRanges[fieldID] = ranges
+		}
+		return ranges
+	}
+
+	filteredNum, totalSegNum := 0, 0
+	for idx, item := range sealedSegments {
+		newSegments := make([]SegmentEntry, 0, len(item.Segments))
+		totalSegNum += len(item.Segments)
+		for _, segment := range item.Segments {
+			if stats, ok := getStats(segment.SegmentID); ok && !scalarStatsOverlap(stats, getRanges) {
+				filteredNum++
+				continue
+			}
+			newSegments = append(newSegments, segment)
+		}
+		item.Segments = newSegments
+		sealedSegments[idx] = item
+	}
+	if filteredNum > 0 {
+		log.Ctx(ctx).RatedInfo(30, "Pruned segment by scalar stats for search/query",
+			zap.Int("filtered_segment_num[excluded]", filteredNum),
+			zap.Int("total_segment_num", totalSegNum),
+		)
+	}
+}
+
+// scalarStatsOverlap returns false only if any field of the segment has no value in the target ranges.
+func scalarStatsOverlap(stats []*storage.FieldStats, getRanges func(fieldID int64) []*exprutil.PlanRange) bool {
+	for _, fieldStats := range stats {
+		if fieldStats.Min == nil || fieldStats.Max == nil {
+			continue
+		}
+		targetRanges := getRanges(fieldStats.FieldID)
+		if len(targetRanges) == 0 {
+			continue
+		}
+		overlap := false
+		for _, tRange := range targetRanges {
+			if scalarRangeOverlap(fieldStats, tRange) {
+				overlap = true
+				break
+			}
+		}
+		if !overlap {
+			return false
+		}
+	}
+	return true
+}
+
+func scalarRangeOverlap(fieldStats *storage.FieldStats, tRange *exprutil.PlanRange) bool {
+	rangeType := exprutil.GetCommonDataType(tRange, tRange)
+	switch fieldStats.Type {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		minVal, ok1 := scalarFieldIntValue(fieldStats.Min)
+		maxVal, ok2 := scalarFieldIntValue(fieldStats.Max)
+		if rangeType != schemapb.DataType_Int64 || !ok1 || !ok2 {
+			return true
+		}
+		if !exprutil.IntRangeOverlap(tRange.ToIntRange(), exprutil.NewIntRange(minVal, maxVal, true, true)) {
+			return false
+		}
+		// the single value of the equal or term expr is tested by the bloom filter if any
+		if value, ok := tRange.PointValue(); ok {
+			return fieldStats.TestInt64(value.GetInt64Val())
+		}
+		return true
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		minVal, ok1 := fieldStats.Min.GetValue().(string)
+		maxVal, ok2 := fieldStats.Max.GetValue().(string)
+		if rangeType != schemapb.DataType_VarChar || !ok1 || !ok2 {
+			return true
+		}
+		// the unbounded upper of the target range is represented by empty string, which is
+		// only treated as unbounded as the second range
+		if !exprutil.StrRangeOverlap(exprutil.NewStrRange(minVal, maxVal, true, true), tRange.ToStrRange()) {
+			return false
+		}
+		if value, ok := tRange.PointValue(); ok {
+			return fieldStats.TestString(value.GetStringVal())
+		}
+		return true
+	}
+	return true
+}
+
+func scalarFieldIntValue(value storage.ScalarFieldValue) (int64, bool) {
+	switch v := value.GetValue().(type) {
+	case int8:
+		return int64(v), true
+	case int16:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	}
+	return 0, false
+}
@@ -362,6 +362,70 @@ func (sps *SegmentPrunerSuite) TestPruneSegmentsByScalarStrField() {
 	}
 }
 
+func (sps *SegmentPrunerSuite) TestPruneSegmentsByScalarStats() {
+	sps.SetupForClustering("info", schemapb.DataType_VarChar)
+	fieldIDs := make(map[string]int64)
+	for _, field := range sps.schema.GetFields() {
+		fieldIDs[field.GetName()] = field.GetFieldID()
+	}
+	newStats := func(minAge, maxAge int32, minInfo, maxInfo string) []*storage.FieldStats {
+		age := storage.NewScalarFieldStats(fieldIDs["age"], schemapb.DataType_Int32, false)
+		age.UpdateByMsgs(&storage.Int32FieldData{Data: []int32{minAge, maxAge}})
+		info := storage.NewScalarFieldStats(fieldIDs["info"], schemapb.DataType_VarChar, false)
+		info.UpdateByMsgs(&storage.StringFieldData{Data: []string{minInfo, maxInfo}})
+		return []*storage.FieldStats{age, info}
+	}
+	// segment 4 has no scalar stats
+	scalarStats := typeutil.NewConcurrentMap[UniqueID, []*storage.FieldStats]()
+	scalarStats.Insert(1, newStats(10, 20, "a", "c"))
+	scalarStats.Insert(2, newStats(30, 40, "d", "f"))
+	scalarStats.Insert(3, newStats(50, 60, "x", "z"))
+
+	schemaHelper, err := typeutil.CreateSchemaHelper(sps.schema)
+	sps.Require().NoError(err)
+	prune := func(exprStr string) []SnapshotItem {
+		testSegments := make([]SnapshotItem, len(sps.sealedSegments))
+		copy(testSegments, sps.sealedSegments)
+		planNode, err := planparserv2.CreateRetrievePlan(schemaHelper, exprStr)
+		sps.Require().NoError(err)
+		serializedPlan, _ := proto.Marshal(planNode)
+		PruneSegmentsByScalarStats(context.TODO(), scalarStats.Get, serializedPlan, testSegments)
+		return testSegments
+	}
+
+	testSegments := prune(`age > 35 and info >= "e"`)
+	sps.Equal(1, len(testSegments[0].Segments))
+	sps.Equal(2, len(testSegments[1].Segments))
+
+	testSegments = prune(`info in ["b"]`)
+	sps.Equal(1, len(testSegments[0].Segments))
+	sps.Equal(1, len(testSegments[1].Segments))
+
+	// no range could be parsed from the or expr
+	testSegments = prune(`age > 35 or info == "b"`)
+	sps.Equal(2, len(testSegments[0].Segments))
+	sps.Equal(2, len(testSegments[1].Segments))
+
+	// the values of the equal and term exprs are tested by the bloom filters
+	age := storage.NewScalarFieldStats(fieldIDs["age"], schemapb.DataType_Int32, true)
+	age.UpdateByMsgs(&storage.Int32FieldData{Data: []int32{10, 20}})
+	info := storage.NewScalarFieldStats(fieldIDs["info"], schemapb.DataType_VarChar, true)
+	info.UpdateByMsgs(&storage.StringFieldData{Data: []string{"a", "c"}})
+	scalarStats.Insert(1, []*storage.FieldStats{age, info})
+
+	testSegments = prune(`info in ["b"]`)
+	sps.Equal(0, len(testSegments[0].Segments))
+	sps.Equal(1, len(testSegments[1].Segments))
+
+	testSegments = prune(`age == 15`)
+	sps.Equal(0, len(testSegments[0].Segments))
+	sps.Equal(1, len(testSegments[1].Segments))
+
+	testSegments = prune(`age == 20 and info in ["b", "c"]`)
+	sps.Equal(1, len(testSegments[0].Segments))
+	sps.Equal(1, len(testSegments[1].Segments))
+}
+
 func vector2Placeholder(vectors [][]float32) *commonpb.PlaceholderValue {
 	ph := &commonpb.PlaceholderValue{
 		Tag:    "$0",
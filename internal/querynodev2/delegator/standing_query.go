@@ -0,0 +1,422 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/lifetime"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// StandingQuery is a filter registered on the delegator, the inserts matching it
+// and the deletes are streamed to the subscriber as the data flows through the delegator.
+// The filter is evaluated by segcore on the rows just inserted into the growing segments.
+// The deleted rows are not available to evaluate the filter, so if the standing query has one,
+// only the deletes of the rows streamed by it before are streamed.
+// The subscription is closed if the subscriber lags behind more than the buffer size,
+// so that the delegator is never blocked by the slow subscribers.
+type StandingQuery struct {
+	id      int64
+	plan    *planpb.PlanNode
+	pkField *schemapb.FieldSchema
+	manager *standingQueryManager
+
+	// published holds the primary keys streamed and not deleted yet, nil if the standing query has no filter
+	publishedMu sync.Mutex
+	published   typeutil.Set[any]
+
+	events    chan *querypb.StandingQueryEvent
+	done      chan struct{}
+	closeOnce sync.Once
+	err       error
+}
+
+// Events returns the channel of the events.
+func (q *StandingQuery) Events() <-chan *querypb.StandingQueryEvent {
+	return q.events
+}
+
+// Done returns the channel closed once the standing query is closed.
+func (q *StandingQuery) Done() <-chan struct{} {
+	return q.done
+}
+
+// Err returns the reason why the standing query is closed, nil if closed by the subscriber.
+func (q *StandingQuery) Err() error {
+	<-q.done
+	return q.err
+}
+
+// Close unregisters the standing query from the delegator.
+func (q *StandingQuery) Close() {
+	q.close(nil)
+}
+
+func (q *StandingQuery) close(err error) {
+	q.closeOnce.Do(func() {
+		q.err = err
+		close(q.done)
+		q.manager.unregister(q.id)
+	})
+}
+
+// publish sends the event without blocking, closes the standing query if its buffer is full.
+func (q *StandingQuery) publish(event *querypb.StandingQueryEvent) {
+	select {
+	case <-q.done:
+	case q.events <- event:
+	default:
+		log.Warn("standing query lags behind, close it", zap.Int64("standingQueryID", q.id))
+		q.close(merr.WrapErrServiceInternal("standing query lags behind, subscribe again"))
+	}
+}
+
+// retrievePlan returns the serialized plan retrieving the rows of the primary keys matching the filter.
+func (q *StandingQuery) retrievePlan(pks []storage.PrimaryKey) ([]byte, error) {
+	values := make([]*planpb.GenericValue, 0, len(pks))
+	for _, pk := range pks {
+		switch pk.Type() {
+		case schemapb.DataType_Int64:
+			values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: pk.GetValue().(int64)}})
+		case schemapb.DataType_VarChar:
+			values = append(values, &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: pk.GetValue().(string)}})
+		default:
+			return nil, merr.WrapErrParameterInvalidMsg("unsupported primary key type %s", pk.Type().String())
+		}
+	}
+	predicates := &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+		ColumnInfo: &planpb.ColumnInfo{
+			FieldId:      q.pkField.GetFieldID(),
+			DataType:     q.pkField.GetDataType(),
+			IsPrimaryKey: true,
+		},
+		Values: values,
+	}}}
+
+	plan := proto.Clone(q.plan).(*planpb.PlanNode)
+	query := plan.GetQuery()
+	if query.GetPredicates() != nil {
+		predicates = &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{
+			Op:    planpb.BinaryExpr_LogicalAnd,
+			Left:  query.GetPredicates(),
+			Right: predicates,
+		}}}
+	}
+	query.Predicates = predicates
+	query.Limit = int64(len(pks))
+	return proto.Marshal(plan)
+}
+
+// matchInserts retrieves the rows just inserted into the growing segment matching the filter,
+// returns nil if no row matches.
+func (q *StandingQuery) matchInserts(ctx context.Context, collection *segments.Collection, segment segments.Segment, data *InsertData) (*querypb.StandingQueryEvent, error) {
+	expr, err := q.retrievePlan(data.PrimaryKeys)
+	if err != nil {
+		return nil, err
+	}
+	// the rows inserted later or deleted are invisible at the max timestamp of the inserts
+	plan, err := segments.NewRetrievePlan(ctx, collection, expr, lo.Max(data.Timestamps), 0)
+	if err != nil {
+		return nil, err
+	}
+	defer plan.Delete()
+	result, err := segment.Retrieve(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+	size := typeutil.GetSizeOfIDs(result.GetIds())
+	if size == 0 {
+		return nil, nil
+	}
+
+	var rowTimestamps []int64
+	fieldsData := make([]*schemapb.FieldData, 0, len(result.GetFieldsData()))
+	for _, fieldData := range result.GetFieldsData() {
+		if fieldData.GetFieldId() == common.TimeStampField {
+			rowTimestamps = fieldData.GetScalars().GetLongData().GetData()
+			continue
+		}
+		fieldsData = append(fieldsData, fieldData)
+	}
+	if len(rowTimestamps) != size {
+		return nil, merr.WrapErrServiceInternal("timestamps of the retrieved rows missing")
+	}
+
+	inserted := typeutil.NewSet[any]()
+	for _, pk := range data.PrimaryKeys {
+		inserted.Insert(pk.GetValue())
+	}
+	// the rows of the same primary key inserted before are retrieved as well, keep the latest one
+	latest := make(map[any]int, size)
+	order := make([]any, 0, size)
+	for i := 0; i < size; i++ {
+		pk := typeutil.GetPK(result.GetIds(), int64(i))
+		if !inserted.Contain(pk) {
+			continue
+		}
+		j, ok := latest[pk]
+		if !ok {
+			order = append(order, pk)
+		}
+		if !ok || rowTimestamps[i] > rowTimestamps[j] {
+			latest[pk] = i
+		}
+	}
+	if len(order) == 0 {
+		return nil, nil
+	}
+
+	event := &querypb.StandingQueryEvent{
+		Status:      merr.Success(),
+		Type:        querypb.StandingQueryEventType_StandingQueryInsert,
+		PartitionID: data.PartitionID,
+		Ids:         &schemapb.IDs{},
+		Timestamps:  make([]uint64, 0, len(order)),
+		FieldsData:  typeutil.PrepareResultFieldData(fieldsData, int64(len(order))),
+	}
+	for _, pk := range order {
+		i := latest[pk]
+		typeutil.AppendIDs(event.Ids, result.GetIds(), i)
+		event.Timestamps = append(event.Timestamps, uint64(rowTimestamps[i]))
+		typeutil.AppendFieldData(event.FieldsData, fieldsData, int64(i))
+	}
+	q.track(order...)
+	return event, nil
+}
+
+// track records the primary keys streamed, if the standing query has a filter.
+func (q *StandingQuery) track(pks ...any) {
+	if q.published == nil {
+		return
+	}
+	q.publishedMu.Lock()
+	defer q.publishedMu.Unlock()
+	q.published.Insert(pks...)
+}
+
+// matchDeletes returns the delete event of the rows visible to the standing query, nil if none of them is.
+func (q *StandingQuery) matchDeletes(data *DeleteData) *querypb.StandingQueryEvent {
+	event := &querypb.StandingQueryEvent{
+		Status:      merr.Success(),
+		Type:        querypb.StandingQueryEventType_StandingQueryDelete,
+		PartitionID: data.PartitionID,
+	}
+	if q.published == nil {
+		event.Ids = storage.ParsePrimaryKeys2IDs(data.PrimaryKeys)
+		event.Timestamps = data.Timestamps
+		return event
+	}
+
+	q.publishedMu.Lock()
+	defer q.publishedMu.Unlock()
+	pks := make([]storage.PrimaryKey, 0)
+	for i, pk := range data.PrimaryKeys {
+		if !q.published.Contain(pk.GetValue()) {
+			continue
+		}
+		q.published.Remove(pk.GetValue())
+		pks = append(pks, pk)
+		event.Timestamps = append(event.Timestamps, data.Timestamps[i])
+	}
+	if len(pks) == 0 {
+		return nil
+	}
+	event.Ids = storage.ParsePrimaryKeys2IDs(pks)
+	return event
+}
+
+// SubscribeStandingQuery registers the standing query with the filter of request on the delegator.
+func (sd *shardDelegator) SubscribeStandingQuery(ctx context.Context, req *querypb.SubscribeStandingQueryRequest) (*StandingQuery, error) {
+	if err := sd.lifetime.Add(lifetime.IsWorking); err != nil {
+		return nil, err
+	}
+	defer sd.lifetime.Done()
+
+	plan := &planpb.PlanNode{Node: &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{}}}
+	if len(req.GetSerializedExprPlan()) > 0 {
+		plan = &planpb.PlanNode{}
+		if err := proto.Unmarshal(req.GetSerializedExprPlan(), plan); err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid filter of standing query: %s", err.Error())
+		}
+		if plan.GetQuery() == nil {
+			return nil, merr.WrapErrParameterInvalidMsg("filter of standing query must be a query plan")
+		}
+	}
+	schema := sd.collection.Schema()
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	plan.OutputFieldIds = req.GetOutputFieldsId()
+	if len(plan.OutputFieldIds) == 0 {
+		for _, field := range schema.GetFields() {
+			if field.GetFieldID() >= common.StartOfUserFieldID {
+				plan.OutputFieldIds = append(plan.OutputFieldIds, field.GetFieldID())
+			}
+		}
+	}
+
+	params := paramtable.Get()
+	query, err := sd.standingQueries.register(plan, pkField,
+		params.QueryNodeCfg.StandingQueryMaxNum.GetAsInt(),
+		params.QueryNodeCfg.StandingQueryBufferSize.GetAsInt())
+	if err != nil {
+		return nil, err
+	}
+	// let segcore check the filter once, rather than failing on the inserts
+	expr, err := query.retrievePlan(nil)
+	if err == nil {
+		var retrievePlan *segments.RetrievePlan
+		retrievePlan, err = segments.NewRetrievePlan(ctx, sd.collection, expr, 0, req.GetBase().GetMsgID())
+		if err == nil {
+			retrievePlan.Delete()
+		}
+	}
+	if err != nil {
+		query.Close()
+		return nil, merr.WrapErrParameterInvalidMsg("filter of standing query not supported: %s", err.Error())
+	}
+	sd.getLogger(ctx).Info("standing query subscribed", zap.Int64("standingQueryID", query.id))
+	return query, nil
+}
+
+// standingQueryManager manages the standing queries registered on the delegator.
+type standingQueryManager struct {
+	mu      sync.RWMutex
+	queries map[int64]*StandingQuery
+	nextID  int64
+	closed  bool
+}
+
+func newStandingQueryManager() *standingQueryManager {
+	return &standingQueryManager{
+		queries: make(map[int64]*StandingQuery),
+	}
+}
+
+// register registers a standing query retrieving the inserts by the plan.
+func (m *standingQueryManager) register(plan *planpb.PlanNode, pkField *schemapb.FieldSchema, maxNum int, bufferSize int) (*StandingQuery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil, merr.WrapErrServiceUnavailable("delegator closed")
+	}
+	if len(m.queries) >= maxNum {
+		return nil, merr.WrapErrServiceQuotaExceeded("too many standing queries on the delegator")
+	}
+	m.nextID++
+	// the timestamps of the rows are retrieved to tell the latest version of the primary keys
+	plan.OutputFieldIds = append(plan.OutputFieldIds, common.TimeStampField)
+	query := &StandingQuery{
+		id:      m.nextID,
+		plan:    plan,
+		pkField: pkField,
+		manager: m,
+		events:  make(chan *querypb.StandingQueryEvent, bufferSize),
+		done:    make(chan struct{}),
+	}
+	if plan.GetQuery().GetPredicates() != nil {
+		query.published = typeutil.NewSet[any]()
+	}
+	m.queries[query.id] = query
+	return query, nil
+}
+
+func (m *standingQueryManager) unregister(id int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.queries, id)
+}
+
+func (m *standingQueryManager) list() []*StandingQuery {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	queries := make([]*StandingQuery, 0, len(m.queries))
+	for _, query := range m.queries {
+		queries = append(queries, query)
+	}
+	return queries
+}
+
+// publishInserts streams the matched inserts to the standing queries,
+// growings are the growing segments the inserts applied to.
+func (m *standingQueryManager) publishInserts(collection *segments.Collection, growings map[int64]segments.Segment, insertRecords map[int64]*InsertData) {
+	queries := m.list()
+	if len(queries) == 0 {
+		return
+	}
+	for segmentID, data := range insertRecords {
+		growing, ok := growings[segmentID]
+		if !ok || len(data.PrimaryKeys) == 0 {
+			continue
+		}
+		for _, query := range queries {
+			event, err := query.matchInserts(context.Background(), collection, growing, data)
+			if err != nil {
+				log.Warn("failed to evaluate standing query, close it", zap.Int64("standingQueryID", query.id), zap.Error(err))
+				query.close(err)
+				continue
+			}
+			if event != nil {
+				query.publish(event)
+			}
+		}
+	}
+}
+
+// publishDeletes streams the deletes to the standing queries.
+func (m *standingQueryManager) publishDeletes(deleteData []*DeleteData) {
+	queries := m.list()
+	if len(queries) == 0 {
+		return
+	}
+	for _, data := range deleteData {
+		if len(data.PrimaryKeys) == 0 {
+			continue
+		}
+		for _, query := range queries {
+			if event := query.matchDeletes(data); event != nil {
+				query.publish(event)
+			}
+		}
+	}
+}
+
+// close closes all the standing queries with the error, no more could be registered.
+func (m *standingQueryManager) close(err error) {
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+	for _, query := range m.list() {
+		query.close(err)
+	}
+}
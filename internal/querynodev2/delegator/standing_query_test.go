@@ -0,0 +1,259 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type StandingQuerySuite struct {
+	suite.Suite
+
+	collectionID int64
+	manager      *standingQueryManager
+	segments     *segments.Manager
+	growing      *segments.MockSegment
+}
+
+func (s *StandingQuerySuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *StandingQuerySuite) SetupTest() {
+	s.collectionID = 1000
+	s.manager = newStandingQueryManager()
+	s.segments = segments.NewManager()
+	s.segments.Collection.PutOrRef(s.collectionID, &schemapb.CollectionSchema{
+		Name: "TestCollection",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "vector", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.DimKey, Value: "8"},
+			}},
+		},
+	}, nil, &querypb.LoadMetaInfo{LoadType: querypb.LoadType_LoadCollection})
+	s.growing = segments.NewMockSegment(s.T())
+}
+
+func (s *StandingQuerySuite) TearDownTest() {
+	s.segments.Collection.Unref(s.collectionID, 1)
+}
+
+// ageGreaterThan returns the plan of age > value
+func (s *StandingQuerySuite) ageGreaterThan(value int64) *planpb.PlanNode {
+	return &planpb.PlanNode{
+		Node: &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{
+			Predicates: &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: &planpb.ColumnInfo{FieldId: 101, DataType: schemapb.DataType_Int64},
+				Op:         planpb.OpType_GreaterThan,
+				Value:      &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: value}},
+			}}},
+		}},
+		OutputFieldIds: []int64{101},
+	}
+}
+
+func (s *StandingQuerySuite) pkField() *schemapb.FieldSchema {
+	return &schemapb.FieldSchema{FieldID: 100, Name: "id", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+}
+
+func (s *StandingQuerySuite) insertData(pks ...int64) map[int64]*InsertData {
+	primaryKeys := make([]storage.PrimaryKey, 0, len(pks))
+	timestamps := make([]uint64, 0, len(pks))
+	for _, pk := range pks {
+		primaryKeys = append(primaryKeys, storage.NewInt64PrimaryKey(pk))
+		timestamps = append(timestamps, uint64(pk))
+	}
+	return map[int64]*InsertData{
+		1: {
+			PrimaryKeys: primaryKeys,
+			Timestamps:  timestamps,
+			PartitionID: 10,
+		},
+	}
+}
+
+// retrieved returns the retrieve results of the rows with the ages, inserted at the timestamps
+func (s *StandingQuerySuite) retrieved(pks []int64, ages []int64, timestamps []int64) *segcorepb.RetrieveResults {
+	return &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		FieldsData: []*schemapb.FieldData{
+			{FieldId: 101, Type: schemapb.DataType_Int64, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: ages}},
+			}}},
+			{FieldId: common.TimeStampField, Type: schemapb.DataType_Int64, Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: timestamps}},
+			}}},
+		},
+	}
+}
+
+func (s *StandingQuerySuite) deleteData(pks ...int64) []*DeleteData {
+	primaryKeys := make([]storage.PrimaryKey, 0, len(pks))
+	timestamps := make([]uint64, 0, len(pks))
+	for _, pk := range pks {
+		primaryKeys = append(primaryKeys, storage.NewInt64PrimaryKey(pk))
+		timestamps = append(timestamps, uint64(pk+100))
+	}
+	return []*DeleteData{{
+		PartitionID: 10,
+		PrimaryKeys: primaryKeys,
+		Timestamps:  timestamps,
+		RowCount:    int64(len(pks)),
+	}}
+}
+
+func (s *StandingQuerySuite) TestRetrievePlan() {
+	query, err := s.manager.register(s.ageGreaterThan(20), s.pkField(), 16, 16)
+	s.Require().NoError(err)
+
+	expr, err := query.retrievePlan([]storage.PrimaryKey{storage.NewInt64PrimaryKey(1), storage.NewInt64PrimaryKey(2)})
+	s.Require().NoError(err)
+	plan := &planpb.PlanNode{}
+	s.Require().NoError(proto.Unmarshal(expr, plan))
+	s.EqualValues(2, plan.GetQuery().GetLimit())
+	s.Equal([]int64{101, common.TimeStampField}, plan.GetOutputFieldIds())
+	and := plan.GetQuery().GetPredicates().GetBinaryExpr()
+	s.Require().NotNil(and)
+	s.Equal(planpb.BinaryExpr_LogicalAnd, and.GetOp())
+	s.NotNil(and.GetLeft().GetUnaryRangeExpr())
+	term := and.GetRight().GetTermExpr()
+	s.Require().NotNil(term)
+	s.EqualValues(100, term.GetColumnInfo().GetFieldId())
+	s.Len(term.GetValues(), 2)
+	// the registered plan is not changed
+	s.Nil(query.plan.GetQuery().GetPredicates().GetBinaryExpr())
+}
+
+func (s *StandingQuerySuite) TestInsertAndDelete() {
+	query, err := s.manager.register(s.ageGreaterThan(20), s.pkField(), 16, 16)
+	s.Require().NoError(err)
+	collection := s.segments.Collection.Get(s.collectionID)
+
+	// the older version of pk 2 is retrieved as well
+	s.growing.EXPECT().Retrieve(mock.Anything, mock.Anything).Return(s.retrieved([]int64{2, 3, 2}, []int64{30, 40, 50}, []int64{2, 3, 0}), nil).Once()
+	s.manager.publishInserts(collection, map[int64]segments.Segment{1: s.growing}, s.insertData(1, 2, 3))
+	event := <-query.Events()
+	s.Equal(querypb.StandingQueryEventType_StandingQueryInsert, event.GetType())
+	s.EqualValues(10, event.GetPartitionID())
+	s.Equal([]int64{2, 3}, event.GetIds().GetIntId().GetData())
+	s.Equal([]uint64{2, 3}, event.GetTimestamps())
+	s.Require().Len(event.GetFieldsData(), 1)
+	s.Equal([]int64{30, 40}, event.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	// pk 4 inserted twice in the same batch, the latest version is streamed
+	data := s.insertData(4, 4)
+	data[1].Timestamps = []uint64{4, 5}
+	s.growing.EXPECT().Retrieve(mock.Anything, mock.Anything).Return(s.retrieved([]int64{4, 4}, []int64{30, 60}, []int64{4, 5}), nil).Once()
+	s.manager.publishInserts(collection, map[int64]segments.Segment{1: s.growing}, data)
+	event = <-query.Events()
+	s.Equal([]int64{4}, event.GetIds().GetIntId().GetData())
+	s.Equal([]uint64{5}, event.GetTimestamps())
+	s.Equal([]int64{60}, event.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+	// no row matched
+	s.growing.EXPECT().Retrieve(mock.Anything, mock.Anything).Return(s.retrieved(nil, nil, nil), nil).Once()
+	s.manager.publishInserts(collection, map[int64]segments.Segment{1: s.growing}, s.insertData(6))
+	s.Len(query.Events(), 0)
+
+	// the inserts not applied to growing segment
+	s.manager.publishInserts(collection, map[int64]segments.Segment{}, s.insertData(7))
+	s.Len(query.Events(), 0)
+
+	// only the deletes of the rows streamed before
+	s.manager.publishDeletes(s.deleteData(1, 2, 6))
+	event = <-query.Events()
+	s.Equal(querypb.StandingQueryEventType_StandingQueryDelete, event.GetType())
+	s.Equal([]int64{2}, event.GetIds().GetIntId().GetData())
+	s.Equal([]uint64{102}, event.GetTimestamps())
+	s.manager.publishDeletes(s.deleteData(2))
+	s.Len(query.Events(), 0)
+
+	query.Close()
+	s.NoError(query.Err())
+	s.Empty(s.manager.list())
+}
+
+func (s *StandingQuerySuite) TestDeleteWithoutFilter() {
+	plan := s.ageGreaterThan(20)
+	plan.GetQuery().Predicates = nil
+	query, err := s.manager.register(plan, s.pkField(), 16, 16)
+	s.Require().NoError(err)
+
+	s.manager.publishDeletes(s.deleteData(1))
+	event := <-query.Events()
+	s.Equal(querypb.StandingQueryEventType_StandingQueryDelete, event.GetType())
+	s.Equal([]int64{1}, event.GetIds().GetIntId().GetData())
+	s.Equal([]uint64{101}, event.GetTimestamps())
+	query.Close()
+}
+
+func (s *StandingQuerySuite) TestRetrieveFailed() {
+	query, err := s.manager.register(s.ageGreaterThan(20), s.pkField(), 16, 16)
+	s.Require().NoError(err)
+
+	s.growing.EXPECT().Retrieve(mock.Anything, mock.Anything).Return(nil, merr.WrapErrSegmentNotLoaded(1)).Once()
+	s.manager.publishInserts(s.segments.Collection.Get(s.collectionID), map[int64]segments.Segment{1: s.growing}, s.insertData(1))
+	s.ErrorIs(query.Err(), merr.ErrSegmentNotLoaded)
+	s.Empty(s.manager.list())
+}
+
+func (s *StandingQuerySuite) TestLagBehind() {
+	query, err := s.manager.register(s.ageGreaterThan(20), s.pkField(), 16, 1)
+	s.Require().NoError(err)
+	collection := s.segments.Collection.Get(s.collectionID)
+
+	s.growing.EXPECT().Retrieve(mock.Anything, mock.Anything).Return(s.retrieved([]int64{1}, []int64{30}, []int64{1}), nil).Once()
+	s.manager.publishInserts(collection, map[int64]segments.Segment{1: s.growing}, s.insertData(1))
+	s.growing.EXPECT().Retrieve(mock.Anything, mock.Anything).Return(s.retrieved([]int64{2}, []int64{30}, []int64{2}), nil).Once()
+	s.manager.publishInserts(collection, map[int64]segments.Segment{1: s.growing}, s.insertData(2))
+	<-query.Done()
+	s.ErrorIs(query.Err(), merr.ErrServiceInternal)
+	s.Empty(s.manager.list())
+}
+
+func (s *StandingQuerySuite) TestRegisterFailed() {
+	_, err := s.manager.register(s.ageGreaterThan(20), s.pkField(), 1, 16)
+	s.NoError(err)
+	_, err = s.manager.register(s.ageGreaterThan(20), s.pkField(), 1, 16)
+	s.ErrorIs(err, merr.ErrServiceQuotaExceeded)
+
+	s.manager.close(merr.WrapErrChannelNotAvailable("dml_0"))
+	s.Empty(s.manager.list())
+	_, err = s.manager.register(s.ageGreaterThan(20), s.pkField(), 16, 16)
+	s.ErrorIs(err, merr.ErrServiceUnavailable)
+}
+
+func TestStandingQuery(t *testing.T) {
+	suite.Run(t, new(StandingQuerySuite))
+}
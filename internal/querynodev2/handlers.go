@@ -29,6 +29,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querynodev2/collector"
 	"github.com/milvus-io/milvus/internal/querynodev2/delegator"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/querynodev2/tasks"
@@ -209,6 +210,7 @@ func (node *QueryNode) queryChannel(ctx context.Context, req *querypb.QueryReque
 		log.Warn("Query failed, failed to get shard delegator for query", zap.Error(err))
 		return nil, err
 	}
+	collector.Rate.Add(collector.ChannelQPSLabel(channel), 1)
 
 	// do query
 	results, err := sd.Query(queryCtx, req)
@@ -238,6 +240,9 @@ func (node *QueryNode) queryChannel(ctx context.Context, req *querypb.QueryReque
 	if err != nil {
 		return nil, err
 	}
+	// the delegator fills the mvcc timestamp if not specified,
+	// report it so that the following requests could read the same snapshot
+	resp.ChannelsMvcc = map[string]uint64{channel: req.GetReq().GetMvccTimestamp()}
 
 	tr.CtxElapse(ctx, fmt.Sprintf("do query with channel done , vChannel = %s, segmentIDs = %v",
 		channel,
@@ -312,6 +317,10 @@ func (node *QueryNode) queryStreamSegments(ctx context.Context, req *querypb.Que
 	if collection == nil {
 		return merr.WrapErrCollectionNotFound(req.Req.GetCollectionID())
 	}
+	if err := segments.LoadRequestedFields(ctx, node.manager, collection, req.GetSegmentIDs(), req.GetReq().GetOutputFieldsId(), req.GetReq().GetSerializedExprPlan()); err != nil {
+		log.Warn("failed to load requested fields", zap.Error(err))
+		return err
+	}
 
 	// Send task to scheduler and wait until it finished.
 	task := tasks.NewQueryStreamTask(ctx, collection, node.manager, req, srv)
@@ -368,6 +377,7 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 		log.Warn("Query failed, failed to get shard delegator for search", zap.Error(err))
 		return nil, err
 	}
+	collector.Rate.Add(collector.ChannelQPSLabel(channel), 1)
 	// do search
 	results, err := sd.Search(searchCtx, req)
 	if err != nil {
@@ -383,7 +393,7 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 		req.GetSegmentIDs(),
 	))
 
-	resp, err := segments.ReduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	resp, err := segments.ReduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetGroupSize(), req.Req.GetMetricType())
 	if err != nil {
 		return nil, err
 	}
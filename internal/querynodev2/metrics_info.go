@@ -208,8 +208,9 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 		SystemConfigurations: metricsinfo.QueryNodeConfiguration{
 			SimdType: paramtable.Get().CommonCfg.SimdType.GetValue(),
 		},
-		QuotaMetrics:      quotaMetrics,
-		CollectionMetrics: collectionMetrics,
+		QuotaMetrics:       quotaMetrics,
+		CollectionMetrics:  collectionMetrics,
+		SegmentSearchStats: node.manager.SegmentStats.Stats(),
 	}
 	metricsinfo.FillDeployMetricsWithEnv(&nodeInfos.SystemInfo)
 
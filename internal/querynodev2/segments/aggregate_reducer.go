@@ -0,0 +1,109 @@
+package segments
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/aggregateutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func isAggregate(req *querypb.QueryRequest) bool {
+	return len(req.GetReq().GetAggregates()) > 0
+}
+
+// aggregateSegment aggregates the rows retrieved from a segment into the partial result right away,
+// so that only the groups rather than the rows of all the segments are held by the query node.
+func aggregateSegment(req *querypb.QueryRequest, schema *schemapb.CollectionSchema, result *segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
+	agg, err := aggregateutil.NewAggregator(schema, req.GetReq().GetGroupByFieldID(), req.GetReq().GetAggregates())
+	if err != nil {
+		return nil, err
+	}
+	if err := agg.AddRows(result.GetFieldsData()); err != nil {
+		return nil, err
+	}
+	partial := agg.Partial()
+	if err := checkPartialSize(partial); err != nil {
+		return nil, err
+	}
+	return &segcorepb.RetrieveResults{
+		FieldsData:       partial,
+		AllRetrieveCount: result.GetAllRetrieveCount(),
+	}, nil
+}
+
+// checkPartialSize checks the size of the partial result against the max output size,
+// the rows are not limited by aggregation but the groups are.
+func checkPartialSize(partial []*schemapb.FieldData) error {
+	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	size := 0
+	for _, fieldData := range partial {
+		size += proto.Size(fieldData)
+	}
+	if int64(size) > maxOutputSize {
+		return merr.WrapErrParameterInvalidMsg("aggregation results exceed the max output size %d, too many groups", maxOutputSize)
+	}
+	return nil
+}
+
+// aggReducer merges the partial aggregation results of the workers.
+type aggReducer struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (r *aggReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
+	agg, err := aggregateutil.NewAggregator(r.schema, r.req.GetReq().GetGroupByFieldID(), r.req.GetReq().GetAggregates())
+	if err != nil {
+		return nil, err
+	}
+	allRetrieveCount := int64(0)
+	for _, res := range results {
+		allRetrieveCount += res.GetAllRetrieveCount()
+		if err := agg.AddPartial(res.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	partial := agg.Partial()
+	if err := checkPartialSize(partial); err != nil {
+		return nil, err
+	}
+	return &internalpb.RetrieveResults{
+		FieldsData:       partial,
+		AllRetrieveCount: allRetrieveCount,
+	}, nil
+}
+
+// aggReducerSegCore merges the partial results aggregated on the segments.
+type aggReducerSegCore struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (r *aggReducerSegCore) Reduce(ctx context.Context, results []*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
+	agg, err := aggregateutil.NewAggregator(r.schema, r.req.GetReq().GetGroupByFieldID(), r.req.GetReq().GetAggregates())
+	if err != nil {
+		return nil, err
+	}
+	allRetrieveCount := int64(0)
+	for _, res := range results {
+		allRetrieveCount += res.GetAllRetrieveCount()
+		if err := agg.AddPartial(res.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	partial := agg.Partial()
+	if err := checkPartialSize(partial); err != nil {
+		return nil, err
+	}
+	return &segcorepb.RetrieveResults{
+		FieldsData:       partial,
+		AllRetrieveCount: allRetrieveCount,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package segments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type AggReducerSuite struct {
+	suite.Suite
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (suite *AggReducerSuite) SetupTest() {
+	suite.req = &querypb.QueryRequest{
+		Req: &internalpb.RetrieveRequest{
+			GroupByFieldID: 101,
+			Aggregates: []*internalpb.Aggregate{
+				{Op: internalpb.AggregateOp_Count},
+				{Op: internalpb.AggregateOp_Sum, FieldID: 102},
+			},
+		},
+	}
+	suite.schema = &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "tag", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "value", DataType: schemapb.DataType_Int64},
+		},
+	}
+}
+
+func (suite *AggReducerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func TestAggReducerSuite(t *testing.T) {
+	suite.Run(t, new(AggReducerSuite))
+}
+
+func (suite *AggReducerSuite) rows(tags []int64, values []int64) []*schemapb.FieldData {
+	return []*schemapb.FieldData{
+		{
+			FieldId: 101,
+			Type:    schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: tags}},
+			}},
+		},
+		{
+			FieldId: 102,
+			Type:    schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+			}},
+		},
+	}
+}
+
+func (suite *AggReducerSuite) aggregateSegment(tags []int64, values []int64) *segcorepb.RetrieveResults {
+	partial, err := aggregateSegment(suite.req, suite.schema, &segcorepb.RetrieveResults{
+		FieldsData:       suite.rows(tags, values),
+		AllRetrieveCount: int64(len(tags)),
+	})
+	suite.Require().NoError(err)
+	return partial
+}
+
+func (suite *AggReducerSuite) TestNormalCase() {
+	// the rows are aggregated into the groups per segment
+	segment1 := suite.aggregateSegment([]int64{1, 2, 1}, []int64{10, 20, 30})
+	suite.Len(segment1.GetFieldsData(), 4)
+	suite.Equal([]int64{1, 2}, segment1.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	suite.Equal(int64(3), segment1.GetAllRetrieveCount())
+
+	segcoreReducer := &aggReducerSegCore{req: suite.req, schema: suite.schema}
+	partial1, err := segcoreReducer.Reduce(context.TODO(), []*segcorepb.RetrieveResults{
+		segment1,
+		suite.aggregateSegment([]int64{3}, []int64{40}),
+	})
+	suite.NoError(err)
+	suite.Equal(int64(4), partial1.GetAllRetrieveCount())
+	partial2, err := segcoreReducer.Reduce(context.TODO(), []*segcorepb.RetrieveResults{
+		suite.aggregateSegment([]int64{2}, []int64{50}),
+	})
+	suite.NoError(err)
+
+	reducer := &aggReducer{req: suite.req, schema: suite.schema}
+	res, err := reducer.Reduce(context.TODO(), []*internalpb.RetrieveResults{
+		{FieldsData: partial1.GetFieldsData(), AllRetrieveCount: partial1.GetAllRetrieveCount()},
+		{FieldsData: partial2.GetFieldsData(), AllRetrieveCount: partial2.GetAllRetrieveCount()},
+	})
+	suite.NoError(err)
+	suite.Equal(int64(5), res.GetAllRetrieveCount())
+	suite.Len(res.GetFieldsData(), 4)
+	suite.Equal([]int64{1, 2, 3}, res.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	suite.Equal([]int64{2, 2, 1}, res.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+	suite.Equal([]int64{40, 70, 40}, res.GetFieldsData()[3].GetScalars().GetLongData().GetData())
+}
+
+func (suite *AggReducerSuite) TestTooManyGroups() {
+	paramtable.Get().Save(paramtable.Get().QuotaConfig.MaxOutputSize.Key, "16")
+	defer paramtable.Get().Reset(paramtable.Get().QuotaConfig.MaxOutputSize.Key)
+
+	_, err := aggregateSegment(suite.req, suite.schema, &segcorepb.RetrieveResults{
+		FieldsData: suite.rows([]int64{1, 2, 3, 4}, []int64{10, 20, 30, 40}),
+	})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+}
+
+func (suite *AggReducerSuite) TestInvalid() {
+	_, err := aggregateSegment(suite.req, suite.schema, &segcorepb.RetrieveResults{
+		FieldsData: suite.rows([]int64{1}, []int64{10})[:1],
+	})
+	suite.Error(err)
+
+	segcoreReducer := &aggReducerSegCore{req: suite.req, schema: suite.schema}
+	_, err = segcoreReducer.Reduce(context.TODO(), []*segcorepb.RetrieveResults{
+		{FieldsData: suite.rows([]int64{1}, []int64{10})},
+	})
+	suite.Error(err)
+
+	reducer := &aggReducer{req: suite.req, schema: suite.schema}
+	_, err = reducer.Reduce(context.TODO(), []*internalpb.RetrieveResults{
+		{FieldsData: suite.rows([]int64{1}, []int64{10})},
+	})
+	suite.Error(err)
+}
@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	budgetResourceMemory = "memory"
+	budgetResourceCPU    = "cpu"
+
+	// searchResultEntrySize is the estimated size of a search result entry in segcore,
+	// which consists of the primary key, the distance and the segment offset.
+	searchResultEntrySize = 20
+)
+
+type requestBudgetKey struct{}
+
+// RequestBudget limits the resources consumed by a search/query request in segcore,
+// the request is cancelled once any of the resources exceeds the budget.
+type RequestBudget struct {
+	label       string
+	memoryLimit int64
+	cpuLimit    time.Duration
+
+	memoryUsed atomic.Int64
+	cpuUsed    atomic.Int64
+
+	cancel   context.CancelFunc
+	mu       sync.Mutex
+	exceeded error
+}
+
+// WithRequestBudget returns the context carrying the budget of the request,
+// the budget is taken from the query node config, label is the query type for metrics.
+func WithRequestBudget(ctx context.Context, label string) (context.Context, *RequestBudget) {
+	params := paramtable.Get()
+	ctx, cancel := context.WithCancel(ctx)
+	budget := &RequestBudget{
+		label:       label,
+		memoryLimit: params.QueryNodeCfg.RequestMaxMemorySize.GetAsInt64() * 1024 * 1024,
+		cpuLimit:    params.QueryNodeCfg.RequestMaxCPUTime.GetAsDuration(time.Millisecond),
+		cancel:      cancel,
+	}
+	return context.WithValue(ctx, requestBudgetKey{}, budget), budget
+}
+
+// GetRequestBudget returns the budget of the request, nil if no budget.
+func GetRequestBudget(ctx context.Context) *RequestBudget {
+	budget, _ := ctx.Value(requestBudgetKey{}).(*RequestBudget)
+	return budget
+}
+
+// Check returns the error if the budget has been exceeded.
+func (b *RequestBudget) Check() error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.exceeded
+}
+
+// ConsumeCPU charges the cpu time spent in segcore.
+func (b *RequestBudget) ConsumeCPU(d time.Duration) error {
+	if b == nil {
+		return nil
+	}
+	used := time.Duration(b.cpuUsed.Add(int64(d)))
+	if b.cpuLimit > 0 && used > b.cpuLimit {
+		b.exceed(budgetResourceCPU, used.Milliseconds(), b.cpuLimit.Milliseconds())
+	}
+	return b.Check()
+}
+
+// ConsumeMemory charges the memory held by the results.
+func (b *RequestBudget) ConsumeMemory(size int64) error {
+	if b == nil {
+		return nil
+	}
+	used := b.memoryUsed.Add(size)
+	if b.memoryLimit > 0 && used > b.memoryLimit {
+		b.exceed(budgetResourceMemory, used, b.memoryLimit)
+	}
+	return b.Check()
+}
+
+// Release releases the context of the request.
+func (b *RequestBudget) Release() {
+	if b == nil {
+		return
+	}
+	b.cancel()
+}
+
+// exceed records the first exceeded resource and cancels the request,
+// so that the segments not yet searched are skipped.
+func (b *RequestBudget) exceed(resource string, used, limit int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.exceeded != nil {
+		return
+	}
+	b.exceeded = merr.WrapErrServiceResourceExhausted(resource, used, limit, "request exceeds the budget of query node")
+	metrics.QueryNodeRequestBudgetExceededTotal.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), b.label, resource).Inc()
+	b.cancel()
+}
@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type RequestBudgetSuite struct {
+	suite.Suite
+}
+
+func (suite *RequestBudgetSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *RequestBudgetSuite) TearDownTest() {
+	paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.RequestMaxMemorySize.Key)
+	paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.RequestMaxCPUTime.Key)
+}
+
+func (suite *RequestBudgetSuite) TestUnlimited() {
+	ctx, budget := WithRequestBudget(context.Background(), metrics.SearchLabel)
+	defer budget.Release()
+
+	suite.Equal(budget, GetRequestBudget(ctx))
+	suite.NoError(budget.ConsumeCPU(time.Hour))
+	suite.NoError(budget.ConsumeMemory(1 << 40))
+	suite.NoError(ctx.Err())
+}
+
+func (suite *RequestBudgetSuite) TestMemoryExceeded() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.RequestMaxMemorySize.Key, "1")
+	ctx, budget := WithRequestBudget(context.Background(), metrics.SearchLabel)
+	defer budget.Release()
+
+	suite.NoError(budget.ConsumeMemory(512 * 1024))
+	suite.NoError(ctx.Err())
+
+	err := budget.ConsumeMemory(1024 * 1024)
+	suite.ErrorIs(err, merr.ErrServiceResourceExhausted)
+	suite.ErrorIs(budget.Check(), merr.ErrServiceResourceExhausted)
+	suite.ErrorIs(ctx.Err(), context.Canceled)
+
+	// the first exceeded resource is kept
+	suite.Equal(err, budget.ConsumeCPU(time.Hour))
+}
+
+func (suite *RequestBudgetSuite) TestCPUExceeded() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.RequestMaxCPUTime.Key, "100")
+	ctx, budget := WithRequestBudget(context.Background(), metrics.QueryLabel)
+	defer budget.Release()
+
+	suite.NoError(budget.ConsumeCPU(50 * time.Millisecond))
+	suite.ErrorIs(budget.ConsumeCPU(60*time.Millisecond), merr.ErrServiceResourceExhausted)
+	suite.ErrorIs(ctx.Err(), context.Canceled)
+}
+
+func (suite *RequestBudgetSuite) TestNoBudget() {
+	budget := GetRequestBudget(context.Background())
+	suite.Nil(budget)
+	suite.NoError(budget.Check())
+	suite.NoError(budget.ConsumeCPU(time.Hour))
+	suite.NoError(budget.ConsumeMemory(1 << 40))
+	budget.Release()
+}
+
+func TestRequestBudget(t *testing.T) {
+	suite.Run(t, new(RequestBudgetSuite))
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -86,6 +87,8 @@ func (m *collectionManager) PutOrRef(collectionID int64, schema *schemapb.Collec
 	m.mut.Lock()
 	defer m.mut.Unlock()
 
+	// the collection may bring its own bucket, the properties are carried by the schema
+	storage.RegisterCollectionStorage(collectionID, schema.GetProperties())
 	if collection, ok := m.collections[collectionID]; ok {
 		// the schema may be changed even the collection is loaded
 		collection.schema.Store(schema)
@@ -154,6 +157,10 @@ type Collection struct {
 	metricType atomic.String // deprecated
 	schema     atomic.Pointer[schemapb.CollectionSchema]
 	isGpuIndex bool
+	// the partition IDs in the order of the hash buckets, only in partition key mode
+	partitionKeyBuckets []int64
+	// the number of buckets of the partition key values in each partition, 0 or 1 if not bucketed
+	partitionKeyBucketNum int64
 
 	refCount *atomic.Uint32
 }
@@ -178,11 +185,33 @@ func (c *Collection) Schema() *schemapb.CollectionSchema {
 	return c.schema.Load()
 }
 
+// LoadFields returns the IDs of the fields loaded, nil if all the fields are loaded.
+func (c *Collection) LoadFields() typeutil.Set[int64] {
+	// the load fields have been validated by QueryCoord before loading
+	fields, err := typeutil.GetLoadFieldIDs(c.Schema())
+	if err != nil {
+		return nil
+	}
+	return fields
+}
+
 // IsGpuIndex returns a boolean value indicating whether the collection is using a GPU index.
 func (c *Collection) IsGpuIndex() bool {
 	return c.isGpuIndex
 }
 
+// GetPartitionKeyBuckets returns the partition IDs in the order of the hash buckets of the partition key,
+// empty if not in partition key mode.
+func (c *Collection) GetPartitionKeyBuckets() []int64 {
+	return c.partitionKeyBuckets
+}
+
+// GetPartitionKeyBucketNum returns the number of buckets the segments of each partition bucketed by,
+// 0 or 1 if not bucketed.
+func (c *Collection) GetPartitionKeyBucketNum() int64 {
+	return c.partitionKeyBucketNum
+}
+
 // getPartitionIDs return partitionIDs of collection
 func (c *Collection) GetPartitions() []int64 {
 	return c.partitions.Collect()
@@ -271,6 +300,9 @@ func NewCollection(collectionID int64, schema *schemapb.CollectionSchema, indexM
 		resourceGroup: loadMetaInfo.GetResourceGroup(),
 		refCount:      atomic.NewUint32(0),
 		isGpuIndex:    isGpuIndex,
+
+		partitionKeyBuckets:   loadMetaInfo.GetPartitionKeyBuckets(),
+		partitionKeyBucketNum: loadMetaInfo.GetPartitionKeyBucketNum(),
 	}
 	for _, partitionID := range loadMetaInfo.GetPartitionIDs() {
 		coll.partitions.Insert(partitionID)
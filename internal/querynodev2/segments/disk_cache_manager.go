@@ -0,0 +1,396 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/util/diskquota"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	DiskCacheCategoryMmap       = diskquota.ConsumerMmap
+	DiskCacheCategoryChunkCache = diskquota.ConsumerChunkCache
+	DiskCacheCategoryLazyLoad   = diskquota.ConsumerLazyLoad
+
+	chunkCacheDir = "chunk_cache"
+)
+
+var diskCacheCategories = []string{DiskCacheCategoryMmap, DiskCacheCategoryChunkCache, DiskCacheCategoryLazyLoad}
+
+// diskCacheFile is a file of the local disk cache. The checksum is computed incrementally by the verify rounds,
+// the first pass after the file found or changed records the checksum, and the later passes compare with it.
+type diskCacheFile struct {
+	category  string
+	segmentID int64
+	size      int64
+	modTime   time.Time
+	checksum  uint32
+	// checksummed is whether the checksum of the file recorded
+	checksummed bool
+	// offset and partial are the progress of the current pass
+	offset  int64
+	partial uint32
+}
+
+// DiskCacheManager governs the local disk cache of query node, which consists of
+// the mmap files of the sealed segments, the chunk cache and the data of the lazy load segments,
+// all of them are under the mmap dir and share the local disk quota with the other consumers, see diskquota.
+// The lazy load segments take the room left by the others, and are evicted first if the quota exceeded.
+// The files are verified by checksum in turn, at most the verify batch size of bytes read per round,
+// the corrupted lazy load segments are evicted so that they are cached again on access.
+type DiskCacheManager struct {
+	manager *Manager
+	root    string
+
+	mu    sync.RWMutex
+	files map[string]*diskCacheFile
+	usage map[string]int64
+	// cursor is the path of the file the next verify round starts from
+	cursor string
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func NewDiskCacheManager(manager *Manager) *DiskCacheManager {
+	return &DiskCacheManager{
+		manager: manager,
+		files:   make(map[string]*diskCacheFile),
+		usage:   make(map[string]int64),
+		closeCh: make(chan struct{}),
+	}
+}
+
+func (m *DiskCacheManager) Start() {
+	m.root = paramtable.Get().QueryNodeCfg.MmapDirPath.GetValue()
+	if m.root == "" {
+		return
+	}
+	m.wg.Add(1)
+	go m.schedule()
+}
+
+func (m *DiskCacheManager) Stop() {
+	m.closeOnce.Do(func() {
+		close(m.closeCh)
+		m.wg.Wait()
+	})
+}
+
+// Usage returns the disk usage in bytes of the category.
+func (m *DiskCacheManager) Usage(category string) int64 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.usage[category]
+}
+
+// LazyLoadCapacity returns the capacity of the lazy load segments,
+// which is the room of the local disk quota left by the other consumers, at most limit.
+func (m *DiskCacheManager) LazyLoadCapacity(limit int64) int64 {
+	available := diskquota.Available() + diskquota.Usage(DiskCacheCategoryLazyLoad)
+	if available < 0 {
+		available = 0
+	}
+	if available < limit {
+		return available
+	}
+	return limit
+}
+
+// Admit checks whether the room of size could be taken by the segments to load, besides the committed size
+// of the segments being loaded. The lazy load segments make room for them, as they're evicted first.
+func (m *DiskCacheManager) Admit(size, committed int64) error {
+	available := diskquota.Available() + diskquota.Usage(DiskCacheCategoryLazyLoad) - committed
+	if size > available {
+		return merr.WrapErrServiceDiskLimitExceeded(float32(diskquota.Total()+committed+size), float32(diskquota.Limit()), "local disk quota exceeded")
+	}
+	return nil
+}
+
+func (m *DiskCacheManager) schedule() {
+	defer m.wg.Done()
+
+	params := paramtable.Get()
+	refreshTicker := time.NewTicker(params.QueryNodeCfg.DiskCacheRefreshInterval.GetAsDuration(time.Second))
+	defer refreshTicker.Stop()
+	var verifyCh <-chan time.Time
+	if interval := params.QueryNodeCfg.DiskCacheVerifyInterval.GetAsDuration(time.Second); interval > 0 {
+		verifyTicker := time.NewTicker(interval)
+		defer verifyTicker.Stop()
+		verifyCh = verifyTicker.C
+	}
+
+	m.refresh()
+	for {
+		select {
+		case <-m.closeCh:
+			log.Info("disk cache manager stopped")
+			return
+		case <-refreshTicker.C:
+			m.refresh()
+		case <-verifyCh:
+			m.verify(params.QueryNodeCfg.DiskCacheVerifyBatchSize.GetAsInt64())
+		}
+	}
+}
+
+// refresh walks the local disk cache to update the files and the usage of each category,
+// the lazy load segments are evicted if the local disk quota exceeded.
+// Only the size and modification time are checked, the new and changed files are checksummed by the later verify rounds.
+func (m *DiskCacheManager) refresh() {
+	m.mu.RLock()
+	previous := m.files
+	m.mu.RUnlock()
+
+	files := make(map[string]*diskCacheFile, len(previous))
+	usage := make(map[string]int64, len(diskCacheCategories))
+	err := filepath.WalkDir(m.root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		file, ok := previous[path]
+		if !ok || file.size != info.Size() || !file.modTime.Equal(info.ModTime()) {
+			file = &diskCacheFile{
+				size:    info.Size(),
+				modTime: info.ModTime(),
+			}
+		}
+		// the category of segment files changes as the segment is evicted or loaded again
+		file.category, file.segmentID = m.categorize(path)
+		files[path] = file
+		usage[file.category] += file.size
+		return nil
+	})
+	if err != nil {
+		log.Warn("failed to refresh disk cache", zap.String("root", m.root), zap.Error(err))
+		return
+	}
+
+	m.mu.Lock()
+	m.files = files
+	m.usage = usage
+	m.mu.Unlock()
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	for _, category := range diskCacheCategories {
+		diskquota.Set(category, usage[category])
+		metrics.QueryNodeDiskCacheUsage.WithLabelValues(nodeID, category).Set(float64(usage[category]))
+	}
+	if total, limit := diskquota.Total(), diskquota.Limit(); total > limit {
+		log.Warn("local disk quota exceeded, evict the lazy load segments",
+			zap.Int64("usage", total),
+			zap.Int64("quota", limit),
+			zap.Int64("mmap", usage[DiskCacheCategoryMmap]),
+			zap.Int64("chunkCache", usage[DiskCacheCategoryChunkCache]),
+			zap.Int64("lazyLoad", usage[DiskCacheCategoryLazyLoad]),
+		)
+		m.evictLazyLoad(files, total-limit)
+	}
+}
+
+// evictLazyLoad evicts the lazy load segments, the largest first, until the size of them evicted reaches the given size.
+func (m *DiskCacheManager) evictLazyLoad(files map[string]*diskCacheFile, size int64) {
+	segmentSizes := make(map[int64]int64)
+	for _, file := range files {
+		if file.category == DiskCacheCategoryLazyLoad {
+			segmentSizes[file.segmentID] += file.size
+		}
+	}
+	segmentIDs := lo.Keys(segmentSizes)
+	sort.Slice(segmentIDs, func(i, j int) bool {
+		return segmentSizes[segmentIDs[i]] > segmentSizes[segmentIDs[j]]
+	})
+	for _, segmentID := range segmentIDs {
+		if size <= 0 {
+			return
+		}
+		// the pinned segments are being used, evicted later
+		if m.manager.DiskCache.Expire(segmentID) {
+			size -= segmentSizes[segmentID]
+			log.Info("evict lazy load segment for the local disk quota", zap.Int64("segmentID", segmentID), zap.Int64("size", segmentSizes[segmentID]))
+		}
+	}
+}
+
+// categorize returns the category of the file and the segment it belongs to,
+// the files are laid out as {root}/chunk_cache/... and {root}/{segmentID}/{fieldID}.
+func (m *DiskCacheManager) categorize(path string) (string, int64) {
+	rel, err := filepath.Rel(m.root, path)
+	if err != nil {
+		return DiskCacheCategoryMmap, 0
+	}
+	first := strings.SplitN(filepath.ToSlash(rel), "/", 2)[0]
+	if first == chunkCacheDir {
+		return DiskCacheCategoryChunkCache, 0
+	}
+	segmentID, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return DiskCacheCategoryMmap, 0
+	}
+	if segment := m.manager.Segment.GetSealed(segmentID); segment != nil && segment.IsLazyLoad() {
+		return DiskCacheCategoryLazyLoad, segmentID
+	}
+	return DiskCacheCategoryMmap, segmentID
+}
+
+// verify checksums the files in turn from where the last round stopped, at most batchSize bytes read,
+// the checksum of a large file is computed across rounds. Returns the number of corrupted files found.
+// The corrupted lazy load segments are evicted from the disk cache, and cached again on the next access.
+func (m *DiskCacheManager) verify(batchSize int64) int {
+	m.mu.RLock()
+	files := make(map[string]*diskCacheFile, len(m.files))
+	for path, file := range m.files {
+		files[path] = file
+	}
+	m.mu.RUnlock()
+	if len(files) == 0 {
+		return 0
+	}
+
+	paths := lo.Keys(files)
+	sort.Strings(paths)
+	start := sort.SearchStrings(paths, m.cursor)
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	corrupted := 0
+	for i := 0; i < len(paths) && batchSize > 0; i++ {
+		select {
+		case <-m.closeCh:
+			return corrupted
+		default:
+		}
+
+		path := paths[(start+i)%len(paths)]
+		file := files[path]
+		m.cursor = paths[(start+i+1)%len(paths)]
+		read, err := checksumFile(path, file, batchSize)
+		batchSize -= read
+		if err != nil {
+			// removed or rewritten, leave it to the next refresh
+			file.offset, file.partial = 0, 0
+			continue
+		}
+		if file.offset < file.size {
+			// the rest is checksummed by the next round
+			m.cursor = path
+			return corrupted
+		}
+
+		checksum := file.partial
+		file.offset, file.partial = 0, 0
+		if !file.checksummed {
+			file.checksum, file.checksummed = checksum, true
+			continue
+		}
+		if checksum == file.checksum {
+			continue
+		}
+
+		corrupted++
+		metrics.QueryNodeDiskCacheCorruptedTotal.WithLabelValues(nodeID, file.category).Inc()
+		log := log.With(
+			zap.String("path", path),
+			zap.String("category", file.category),
+			zap.Int64("segmentID", file.segmentID),
+		)
+		if file.category != DiskCacheCategoryLazyLoad {
+			log.Warn("disk cache file corrupted")
+			continue
+		}
+		evicted := m.manager.DiskCache.Expire(file.segmentID)
+		log.Warn("disk cache file corrupted, evict the lazy load segment", zap.Bool("evicted", evicted))
+		if evicted {
+			m.mu.Lock()
+			delete(m.files, path)
+			m.mu.Unlock()
+		}
+	}
+	return corrupted
+}
+
+// checksumFile continues the checksum of the file from its offset, at most limit bytes read,
+// returns the number of bytes read. It fails if the file is removed or changed since refreshed.
+func checksumFile(path string, file *diskCacheFile, limit int64) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	if info.Size() != file.size || !info.ModTime().Equal(file.modTime) {
+		return 0, fmt.Errorf("file %s changed since refreshed", path)
+	}
+
+	size := file.size - file.offset
+	if size > limit {
+		size = limit
+	}
+	hash := crc32Writer{crc: file.partial}
+	read, err := io.Copy(&hash, io.NewSectionReader(f, file.offset, size))
+	if err != nil {
+		return read, err
+	}
+	file.offset += read
+	file.partial = hash.crc
+	return read, nil
+}
+
+// crc32Writer updates the IEEE crc32 checksum with the bytes written, so that the checksum could be resumed.
+type crc32Writer struct {
+	crc uint32
+}
+
+func (w *crc32Writer) Write(p []byte) (int, error) {
+	w.crc = crc32.Update(w.crc, crc32.IEEETable, p)
+	return len(p), nil
+}
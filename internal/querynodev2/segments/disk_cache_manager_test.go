@@ -0,0 +1,126 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/util/diskquota"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type DiskCacheManagerSuite struct {
+	suite.Suite
+
+	root    string
+	manager *DiskCacheManager
+}
+
+func (suite *DiskCacheManagerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *DiskCacheManagerSuite) SetupTest() {
+	suite.root = suite.T().TempDir()
+	suite.Require().NoError(os.MkdirAll(filepath.Join(suite.root, chunkCacheDir), 0o755))
+	suite.Require().NoError(os.MkdirAll(filepath.Join(suite.root, "100"), 0o755))
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.root, chunkCacheDir, "a"), make([]byte, 1000), 0o600))
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.root, "100", "101"), make([]byte, 2000), 0o600))
+
+	suite.manager = NewDiskCacheManager(NewManager())
+	suite.manager.root = suite.root
+}
+
+func (suite *DiskCacheManagerSuite) TearDownTest() {
+	paramtable.Get().Reset(paramtable.Get().LocalStorageCfg.DiskCapacityLimit.Key)
+	for _, category := range diskCacheCategories {
+		diskquota.Set(category, 0)
+	}
+}
+
+func (suite *DiskCacheManagerSuite) TestRefresh() {
+	suite.manager.refresh()
+	suite.EqualValues(1000, suite.manager.Usage(DiskCacheCategoryChunkCache))
+	suite.EqualValues(2000, suite.manager.Usage(DiskCacheCategoryMmap))
+	suite.EqualValues(0, suite.manager.Usage(DiskCacheCategoryLazyLoad))
+
+	suite.Require().NoError(os.Remove(filepath.Join(suite.root, "100", "101")))
+	suite.manager.refresh()
+	suite.EqualValues(0, suite.manager.Usage(DiskCacheCategoryMmap))
+}
+
+func (suite *DiskCacheManagerSuite) TestLazyLoadCapacity() {
+	// 10GB * 0.9
+	paramtable.Get().Save(paramtable.Get().LocalStorageCfg.DiskCapacityLimit.Key, "10")
+	quota := diskquota.Limit()
+	suite.EqualValues(9*1024*1024*1024, quota)
+
+	suite.manager.refresh()
+	suite.EqualValues(1000, diskquota.Usage(DiskCacheCategoryChunkCache))
+	suite.EqualValues(2000, diskquota.Usage(DiskCacheCategoryMmap))
+	suite.EqualValues(quota-3000, suite.manager.LazyLoadCapacity(quota))
+	suite.EqualValues(100, suite.manager.LazyLoadCapacity(100))
+	suite.NoError(suite.manager.Admit(quota-3000, 0))
+	suite.ErrorIs(suite.manager.Admit(quota-3000, 1), merr.ErrServiceDiskLimitExceeded)
+
+	// the other consumers share the quota
+	suite.Require().NoError(diskquota.Reserve(diskquota.ConsumerCompactionSort, 1000))
+	defer diskquota.Release(diskquota.ConsumerCompactionSort, 1000)
+	suite.EqualValues(quota-4000, suite.manager.LazyLoadCapacity(quota))
+	suite.ErrorIs(suite.manager.Admit(quota-3000, 0), merr.ErrServiceDiskLimitExceeded)
+}
+
+func (suite *DiskCacheManagerSuite) TestVerify() {
+	path := filepath.Join(suite.root, "100", "101")
+	suite.manager.refresh()
+	// the checksums are recorded by the first pass, the file of 2000 bytes is checksummed across two rounds
+	suite.Equal(0, suite.manager.verify(1000))
+	suite.EqualValues(1000, suite.manager.files[path].offset)
+	suite.False(suite.manager.files[path].checksummed)
+	suite.Equal(0, suite.manager.verify(1000))
+	suite.True(suite.manager.files[path].checksummed)
+	suite.Equal(0, suite.manager.verify(1000))
+	suite.True(suite.manager.files[filepath.Join(suite.root, chunkCacheDir, "a")].checksummed)
+
+	// corrupt the file without changing its size and modification time
+	info, err := os.Stat(path)
+	suite.Require().NoError(err)
+	data := make([]byte, 2000)
+	data[1500] = 1
+	suite.Require().NoError(os.WriteFile(path, data, 0o600))
+	suite.Require().NoError(os.Chtimes(path, info.ModTime(), info.ModTime()))
+	suite.Equal(0, suite.manager.verify(1000))
+	suite.Equal(1, suite.manager.verify(1000))
+
+	// rewritten files are skipped until refreshed, and checksummed again
+	suite.Require().NoError(os.WriteFile(path, make([]byte, 3000), 0o600))
+	suite.Equal(0, suite.manager.verify(10000))
+	suite.manager.refresh()
+	suite.False(suite.manager.files[path].checksummed)
+	suite.Equal(0, suite.manager.verify(10000))
+	suite.True(suite.manager.files[path].checksummed)
+	suite.Equal(0, suite.manager.verify(10000))
+}
+
+func TestDiskCacheManager(t *testing.T) {
+	suite.Run(t, new(DiskCacheManagerSuite))
+}
@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// FuseSearchResultData fuses the results of the sub searches into one result,
+// the score of each hit is the weighted sum of its scores in the sub searches,
+// hits missing in a sub search contribute nothing for it.
+// Only the ids, scores and topks are filled, all the results must have the same nq.
+func FuseSearchResultData(results []*schemapb.SearchResultData, weights []float32, nq int64, topk int64) (*schemapb.SearchResultData, error) {
+	if len(results) != len(weights) {
+		return nil, merr.WrapErrParameterInvalidMsg("%d weights for %d sub searches", len(weights), len(results))
+	}
+	fused := &schemapb.SearchResultData{
+		NumQueries: nq,
+		TopK:       topk,
+		Ids:        &schemapb.IDs{},
+		Scores:     make([]float32, 0),
+		Topks:      make([]int64, 0, nq),
+	}
+
+	offsets := make([]int64, len(results))
+	for i, result := range results {
+		if int64(len(result.GetTopks())) != nq {
+			return nil, merr.WrapErrServiceInternal(fmt.Sprintf("sub search %d returns %d queries, expected %d", i, len(result.GetTopks()), nq))
+		}
+	}
+	for q := int64(0); q < nq; q++ {
+		scores := make(map[interface{}]float32)
+		pks := make([]interface{}, 0)
+		for i, result := range results {
+			for j := offsets[i]; j < offsets[i]+result.GetTopks()[q]; j++ {
+				pk := typeutil.GetPK(result.GetIds(), j)
+				if _, ok := scores[pk]; !ok {
+					pks = append(pks, pk)
+				}
+				scores[pk] += weights[i] * result.GetScores()[j]
+			}
+			offsets[i] += result.GetTopks()[q]
+		}
+
+		sort.Slice(pks, func(i, j int) bool {
+			if scores[pks[i]] != scores[pks[j]] {
+				return scores[pks[i]] > scores[pks[j]]
+			}
+			return lessPK(pks[i], pks[j])
+		})
+		if topk > 0 && int64(len(pks)) > topk {
+			pks = pks[:topk]
+		}
+		for _, pk := range pks {
+			typeutil.AppendPKs(fused.Ids, pk)
+			fused.Scores = append(fused.Scores, scores[pk])
+		}
+		fused.Topks = append(fused.Topks, int64(len(pks)))
+	}
+	return fused, nil
+}
+
+func lessPK(left, right interface{}) bool {
+	switch l := left.(type) {
+	case int64:
+		return l < right.(int64)
+	case string:
+		return l < right.(string)
+	default:
+		return false
+	}
+}
+
+// decodeSearchResult converts the result of one sub search on one segment into the search result data.
+func decodeSearchResult(ctx context.Context, req *SearchRequest, result *SearchResult, topk int64) (*schemapb.SearchResultData, error) {
+	blobs, err := ReduceSearchResultsAndFillData(ctx, req.Plan(), []*SearchResult{result}, 1, []int64{req.getNumOfQuery()}, []int64{topk})
+	if err != nil {
+		return nil, err
+	}
+	defer DeleteSearchResultDataBlobs(blobs)
+	blob, err := GetSearchResultDataBlob(ctx, blobs, 0)
+	if err != nil {
+		return nil, err
+	}
+	data := &schemapb.SearchResultData{}
+	if err := proto.Unmarshal(blob, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// fusedSearchSegments runs all the sub searches on each segment and fuses their scores,
+// the fused result of each segment is returned.
+func fusedSearchSegments(ctx context.Context, mgr *Manager, segments []Segment, reqs []*SearchRequest, topks []int64, weights []float32, topk int64) ([]*schemapb.SearchResultData, error) {
+	var (
+		results = make([]*schemapb.SearchResultData, len(segments))
+		errs    = make([]error, len(segments))
+		wg      sync.WaitGroup
+	)
+
+	searcher := func(i int) func(Segment) error {
+		return func(s Segment) error {
+			subResults := make([]*schemapb.SearchResultData, 0, len(reqs))
+			for j, req := range reqs {
+				result, err := s.Search(ctx, req)
+				if err != nil {
+					DeleteSearchResults([]*SearchResult{result})
+					return err
+				}
+				data, err := decodeSearchResult(ctx, req, result, topks[j])
+				DeleteSearchResults([]*SearchResult{result})
+				if err != nil {
+					return err
+				}
+				subResults = append(subResults, data)
+			}
+			fused, err := FuseSearchResultData(subResults, weights, reqs[0].getNumOfQuery(), topk)
+			if err != nil {
+				return err
+			}
+			results[i] = fused
+			return nil
+		}
+	}
+
+	for i, segment := range segments {
+		wg.Add(1)
+		go func(seg Segment, i int) {
+			defer wg.Done()
+			errs[i] = doOnSegment(mgr, seg, searcher(i))
+		}(segment, i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// FusedSearchHistorical runs the fused search on the historical segments, see SearchHistorical.
+func FusedSearchHistorical(ctx context.Context, manager *Manager, reqs []*SearchRequest, topks []int64, weights []float32, topk int64,
+	collID int64, partIDs []int64, segIDs []int64,
+) ([]*schemapb.SearchResultData, []Segment, error) {
+	segments, err := validateOnHistorical(ctx, manager, collID, partIDs, segIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := fusedSearchSegments(ctx, manager, segments, reqs, topks, weights, topk)
+	return results, segments, err
+}
+
+// FusedSearchStreaming runs the fused search on the streaming segments, see SearchStreaming.
+func FusedSearchStreaming(ctx context.Context, manager *Manager, reqs []*SearchRequest, topks []int64, weights []float32, topk int64,
+	collID int64, partIDs []int64, segIDs []int64,
+) ([]*schemapb.SearchResultData, []Segment, error) {
+	segments, err := validateOnStream(ctx, manager, collID, partIDs, segIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := fusedSearchSegments(ctx, manager, segments, reqs, topks, weights, topk)
+	return results, segments, err
+}
@@ -0,0 +1,77 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestFuseSearchResultData(t *testing.T) {
+	dense := &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       3,
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4}}}},
+		Scores:     []float32{0.9, 0.8, 0.2, 0.5},
+		Topks:      []int64{3, 1},
+	}
+	sparse := &schemapb.SearchResultData{
+		NumQueries: 2,
+		TopK:       3,
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{3, 5, 6}}}},
+		Scores:     []float32{4, 2, 1},
+		Topks:      []int64{1, 2},
+	}
+
+	fused, err := FuseSearchResultData([]*schemapb.SearchResultData{dense, sparse}, []float32{1, 0.2}, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2, 2}, fused.GetTopks())
+	// query 0: 1 -> 0.9, 2 -> 0.8, 3 -> 0.2+0.8
+	// query 1: 4 -> 0.5, 5 -> 0.4, 6 -> 0.2
+	assert.Equal(t, []int64{3, 1, 4, 5}, fused.GetIds().GetIntId().GetData())
+	assert.InDeltaSlice(t, []float32{1, 0.9, 0.5, 0.4}, fused.GetScores(), 1e-6)
+
+	t.Run("string pks", func(t *testing.T) {
+		left := &schemapb.SearchResultData{
+			Ids:    &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"b", "a"}}}},
+			Scores: []float32{1, 0.5},
+			Topks:  []int64{2},
+		}
+		right := &schemapb.SearchResultData{
+			Ids:    &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a"}}}},
+			Scores: []float32{0.5},
+			Topks:  []int64{1},
+		}
+		fused, err := FuseSearchResultData([]*schemapb.SearchResultData{left, right}, []float32{1, 1}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, fused.GetIds().GetStrId().GetData())
+		assert.Equal(t, []int64{2}, fused.GetTopks())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := FuseSearchResultData([]*schemapb.SearchResultData{dense}, []float32{1, 1}, 2, 2)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		_, err = FuseSearchResultData([]*schemapb.SearchResultData{dense}, []float32{1}, 1, 2)
+		assert.ErrorIs(t, err, merr.ErrServiceInternal)
+	})
+}
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// InterimIndexBuilder builds the interim index of the large growing segments in background,
+// so that the insertions are not blocked by the index building,
+// and the searches on them stop falling back to brute force scan once built.
+// It takes effect only if the interim index is enabled and built asynchronously in segcore.
+type InterimIndexBuilder struct {
+	manager *Manager
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func NewInterimIndexBuilder(manager *Manager) *InterimIndexBuilder {
+	return &InterimIndexBuilder{
+		manager: manager,
+		closeCh: make(chan struct{}),
+	}
+}
+
+func (b *InterimIndexBuilder) Start() {
+	params := paramtable.Get()
+	if !params.QueryNodeCfg.EnableTempSegmentIndex.GetAsBool() || !params.QueryNodeCfg.InterimIndexAsyncBuild.GetAsBool() {
+		return
+	}
+	b.wg.Add(1)
+	go b.schedule()
+}
+
+func (b *InterimIndexBuilder) Stop() {
+	b.closeOnce.Do(func() {
+		close(b.closeCh)
+		b.wg.Wait()
+	})
+}
+
+func (b *InterimIndexBuilder) schedule() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(paramtable.Get().QueryNodeCfg.InterimIndexAsyncBuildInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-b.closeCh:
+			log.Info("interim index builder stopped")
+			return
+		case <-ticker.C:
+			b.buildGrowingSegments()
+		}
+	}
+}
+
+// buildGrowingSegments builds the interim index of the growing segments exceeding the row threshold.
+func (b *InterimIndexBuilder) buildGrowingSegments() {
+	minRows := paramtable.Get().QueryNodeCfg.InterimIndexAsyncBuildMinRows.GetAsInt64()
+	for _, segment := range b.manager.Segment.GetBy(WithType(SegmentTypeGrowing)) {
+		select {
+		case <-b.closeCh:
+			return
+		default:
+		}
+
+		local, ok := segment.(*LocalSegment)
+		if !ok || local.InsertCount() < minRows {
+			continue
+		}
+		if err := local.BuildInterimIndex(context.Background()); err != nil {
+			log.Warn("failed to build interim index of growing segment",
+				zap.Int64("collectionID", segment.Collection()),
+				zap.Int64("segmentID", segment.ID()),
+				zap.Error(err),
+			)
+		}
+	}
+}
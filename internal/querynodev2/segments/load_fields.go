@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// splitLoadInfo splits the load info into the one only with the binlogs and indexes of the loaded fields,
+// and the binlogs and indexes of the fields skipped, which are loaded on demand.
+func splitLoadInfo(loadFields typeutil.Set[int64], info *querypb.SegmentLoadInfo) (*querypb.SegmentLoadInfo, map[int64]*IndexedFieldInfo) {
+	loaded := func(fieldID int64) bool {
+		return common.IsSystemField(fieldID) || loadFields.Contain(fieldID)
+	}
+
+	skipped := make(map[int64]*IndexedFieldInfo)
+	for _, binlog := range info.GetBinlogPaths() {
+		if !loaded(binlog.GetFieldID()) {
+			skipped[binlog.GetFieldID()] = &IndexedFieldInfo{FieldBinlog: binlog}
+		}
+	}
+	for _, index := range info.GetIndexInfos() {
+		if field, ok := skipped[index.GetFieldID()]; ok && len(index.GetIndexFilePaths()) > 0 {
+			field.IndexInfo = index
+		}
+	}
+
+	pruned := proto.Clone(info).(*querypb.SegmentLoadInfo)
+	pruned.BinlogPaths = lo.Filter(pruned.GetBinlogPaths(), func(binlog *datapb.FieldBinlog, _ int) bool {
+		return loaded(binlog.GetFieldID())
+	})
+	pruned.IndexInfos = lo.Filter(pruned.GetIndexInfos(), func(index *querypb.FieldIndexInfo, _ int) bool {
+		return loaded(index.GetFieldID())
+	})
+	return pruned, skipped
+}
+
+// LoadRequestedFields loads the fields used by the request into the sealed segments on demand,
+// which are skipped at loading since not listed in the load fields of the collection.
+// The fields used are the output fields and the fields referenced by the serialized plan.
+func LoadRequestedFields(ctx context.Context, manager *Manager, collection *Collection, segmentIDs []int64, outputFieldIDs []int64, serializedPlan []byte) error {
+	if collection.LoadFields() == nil || len(segmentIDs) == 0 {
+		return nil
+	}
+
+	fieldIDs := typeutil.NewSet(outputFieldIDs...)
+	if len(serializedPlan) > 0 {
+		plan := &planpb.PlanNode{}
+		if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+			return merr.WrapErrParameterInvalidMsg("failed to unmarshal plan: %s", err.Error())
+		}
+		fieldIDs.Insert(plan.GetOutputFieldIds()...)
+		collectPlanFields(proto.MessageReflect(plan), fieldIDs)
+	}
+
+	segments, err := manager.Segment.GetAndPin(segmentIDs, WithType(SegmentTypeSealed))
+	if err != nil {
+		return err
+	}
+	defer manager.Segment.Unpin(segments)
+
+	for _, segment := range segments {
+		local, ok := segment.(*LocalSegment)
+		if !ok {
+			continue
+		}
+		if err := local.loadSkippedFields(ctx, fieldIDs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadSkippedFields loads the fields skipped at loading if they are not loaded yet.
+func (s *LocalSegment) loadSkippedFields(ctx context.Context, fieldIDs typeutil.Set[int64]) error {
+	if len(s.skippedFields) == 0 {
+		return nil
+	}
+
+	s.demandMu.Lock()
+	defer s.demandMu.Unlock()
+	if s.demandedFields == nil {
+		s.demandedFields = typeutil.NewSet[int64]()
+	}
+	for fieldID := range fieldIDs {
+		info, ok := s.skippedFields[fieldID]
+		if !ok || s.demandedFields.Contain(fieldID) {
+			continue
+		}
+		if err := s.loadSkippedField(ctx, fieldID, info); err != nil {
+			log.Ctx(ctx).Warn("failed to load field on demand",
+				zap.Int64("collectionID", s.Collection()),
+				zap.Int64("segmentID", s.ID()),
+				zap.Int64("fieldID", fieldID),
+				zap.Error(err))
+			return err
+		}
+		s.demandedFields.Insert(fieldID)
+	}
+	return nil
+}
+
+func (s *LocalSegment) loadSkippedField(ctx context.Context, fieldID int64, info *IndexedFieldInfo) error {
+	rowCount := s.LoadInfo().GetNumOfRows()
+	if err := s.AddFieldDataInfo(ctx, rowCount, []*datapb.FieldBinlog{info.FieldBinlog}); err != nil {
+		return err
+	}
+	if info.IndexInfo != nil {
+		field := typeutil.GetField(s.collection.Schema(), fieldID)
+		if field == nil {
+			return merr.WrapErrFieldNotFound(fieldID)
+		}
+		indexInfo := proto.Clone(info.IndexInfo).(*querypb.FieldIndexInfo)
+		indexInfo.IndexFilePaths = filterIndexFilePaths(indexInfo.GetIndexFilePaths())
+		if err := s.LoadIndex(ctx, indexInfo, field.GetDataType()); err != nil {
+			return err
+		}
+		if typeutil.IsVectorType(field.GetDataType()) || s.HasRawData(fieldID) {
+			return nil
+		}
+	}
+	return loadSealedSegmentFields(ctx, s.collection, s, []*datapb.FieldBinlog{info.FieldBinlog}, rowCount)
+}
+
+var (
+	columnInfoName = proto.MessageReflect(&planpb.ColumnInfo{}).Descriptor().FullName()
+	vectorANNSName = proto.MessageReflect(&planpb.VectorANNS{}).Descriptor().FullName()
+)
+
+// collectPlanFields walks through the plan and collects the fields referenced by the columns and the vector search.
+func collectPlanFields(msg protoreflect.Message, fieldIDs typeutil.Set[int64]) {
+	switch msg.Descriptor().FullName() {
+	case columnInfoName, vectorANNSName:
+		fieldIDs.Insert(msg.Get(msg.Descriptor().Fields().ByName("field_id")).Int())
+	}
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind && fd.Kind() != protoreflect.GroupKind {
+			return true
+		}
+		switch {
+		case fd.IsList():
+			list := value.List()
+			for i := 0; i < list.Len(); i++ {
+				collectPlanFields(list.Get(i).Message(), fieldIDs)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+					collectPlanFields(v.Message(), fieldIDs)
+					return true
+				})
+			}
+		default:
+			collectPlanFields(value.Message(), fieldIDs)
+		}
+		return true
+	})
+}
@@ -0,0 +1,99 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestSplitLoadInfo(t *testing.T) {
+	info := &querypb.SegmentLoadInfo{
+		SegmentID: 1,
+		BinlogPaths: []*datapb.FieldBinlog{
+			{FieldID: common.RowIDField},
+			{FieldID: 100},
+			{FieldID: 101},
+			{FieldID: 102},
+			{FieldID: 103},
+		},
+		IndexInfos: []*querypb.FieldIndexInfo{
+			{FieldID: 101, IndexFilePaths: []string{"index/101"}},
+			{FieldID: 102, IndexFilePaths: []string{"index/102"}},
+		},
+	}
+
+	pruned, skipped := splitLoadInfo(typeutil.NewSet[int64](100, 101), info)
+	assert.ElementsMatch(t, []int64{common.RowIDField, 100, 101}, lo.Map(pruned.GetBinlogPaths(), func(binlog *datapb.FieldBinlog, _ int) int64 {
+		return binlog.GetFieldID()
+	}))
+	assert.Len(t, pruned.GetIndexInfos(), 1)
+	assert.EqualValues(t, 101, pruned.GetIndexInfos()[0].GetFieldID())
+	// the original info is untouched
+	assert.Len(t, info.GetBinlogPaths(), 5)
+
+	// the skipped fields are kept to load on demand
+	assert.ElementsMatch(t, []int64{102, 103}, lo.Keys(skipped))
+	assert.EqualValues(t, 102, skipped[102].FieldBinlog.GetFieldID())
+	assert.EqualValues(t, 102, skipped[102].IndexInfo.GetFieldID())
+	assert.EqualValues(t, 103, skipped[103].FieldBinlog.GetFieldID())
+	assert.Nil(t, skipped[103].IndexInfo)
+}
+
+func TestCollectPlanFields(t *testing.T) {
+	plan := &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{
+				FieldId: 102,
+				Predicates: &planpb.Expr{
+					Expr: &planpb.Expr_BinaryExpr{
+						BinaryExpr: &planpb.BinaryExpr{
+							Op: planpb.BinaryExpr_LogicalAnd,
+							Left: &planpb.Expr{
+								Expr: &planpb.Expr_UnaryRangeExpr{
+									UnaryRangeExpr: &planpb.UnaryRangeExpr{
+										ColumnInfo: &planpb.ColumnInfo{FieldId: 100},
+									},
+								},
+							},
+							Right: &planpb.Expr{
+								Expr: &planpb.Expr_TermExpr{
+									TermExpr: &planpb.TermExpr{
+										ColumnInfo: &planpb.ColumnInfo{FieldId: 103},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	fieldIDs := typeutil.NewSet[int64]()
+	collectPlanFields(proto.MessageReflect(plan), fieldIDs)
+	assert.ElementsMatch(t, []int64{100, 102, 103}, fieldIDs.Collect())
+}
@@ -28,6 +28,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/singleflight"
@@ -41,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/cache"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -159,10 +161,18 @@ type Manager struct {
 	Segment    SegmentManager
 	DiskCache  cache.Cache[int64, Segment]
 	Loader     Loader
+	Tiering    *Tiering
+	// InterimIndex builds the interim index of growing segments in background
+	InterimIndex *InterimIndexBuilder
+	// DiskCacheManager governs the local disk cache under the disk quota
+	DiskCacheManager *DiskCacheManager
+	// SegmentStats collects the search statistics of segments
+	SegmentStats *SegmentStatsCollector
 }
 
 func NewManager() *Manager {
 	diskCap := paramtable.Get().QueryNodeCfg.DiskCapacityLimit.GetAsInt64()
+	cacheCap := int64(float64(diskCap) * paramtable.Get().QueryNodeCfg.LazyLoadCacheCapacityRatio.GetAsFloat())
 
 	segMgr := NewSegmentManager()
 	sf := singleflight.Group{}
@@ -170,10 +180,32 @@ func NewManager() *Manager {
 		Collection: NewCollectionManager(),
 		Segment:    segMgr,
 	}
-
-	manager.DiskCache = cache.NewCacheBuilder[int64, Segment]().WithLazyScavenger(func(key int64) int64 {
-		return int64(segMgr.sealedSegments[key].ResourceUsageEstimate().DiskSize)
-	}, diskCap).WithLoader(func(key int64) (Segment, bool) {
+	manager.DiskCacheManager = NewDiskCacheManager(manager)
+	manager.SegmentStats = NewSegmentStatsCollector(manager)
+
+	// weights records the estimated size of the cached segments,
+	// the size must keep the same until the segment evicted, even the segment has been released.
+	weights := typeutil.NewConcurrentMap[int64, int64]()
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+
+	// the lazy load segments share the disk quota with the other files of disk cache
+	manager.DiskCache = cache.NewCacheBuilder[int64, Segment]().WithDynamicLazyScavenger(func(key int64) int64 {
+		if weight, ok := weights.Get(key); ok {
+			return weight
+		}
+		segMgr.mu.RLock()
+		segment, ok := segMgr.sealedSegments[key]
+		segMgr.mu.RUnlock()
+		if !ok {
+			return 0
+		}
+		// all the data of lazy load segment is mapped to disk
+		usage := segment.ResourceUsageEstimate()
+		weight, _ := weights.GetOrInsert(key, int64(usage.MemorySize+usage.DiskSize))
+		return weight
+	}, func() int64 {
+		return manager.DiskCacheManager.LazyLoadCapacity(cacheCap)
+	}).WithLoader(func(key int64) (Segment, bool) {
 		log.Debug("cache missed segment", zap.Int64("segmentID", key))
 		segMgr.mu.RLock()
 		defer segMgr.mu.RUnlock()
@@ -184,7 +216,9 @@ func NewManager() *Manager {
 			return nil, false
 		}
 
+		manager.SegmentStats.RecordCacheMiss(segment)
 		info := segment.LoadInfo()
+		tr := timerecord.NewTimeRecorder("loadDiskCache")
 		_, err, _ := sf.Do(fmt.Sprint(segment.ID()), func() (interface{}, error) {
 			collection := manager.Collection.Get(segment.Collection())
 			if collection == nil {
@@ -195,14 +229,24 @@ func NewManager() *Manager {
 		})
 		if err != nil {
 			log.Warn("cache sealed segment failed", zap.Error(err))
+			metrics.QueryNodeDiskCacheLoadTotal.WithLabelValues(nodeID, metrics.FailLabel).Inc()
 			return nil, false
 		}
+		usage := segment.ResourceUsageEstimate()
+		metrics.QueryNodeDiskCacheLoadTotal.WithLabelValues(nodeID, metrics.SuccessLabel).Inc()
+		metrics.QueryNodeDiskCacheLoadBytes.WithLabelValues(nodeID).Add(float64(usage.MemorySize + usage.DiskSize))
+		metrics.QueryNodeDiskCacheLoadDuration.WithLabelValues(nodeID).Observe(float64(tr.ElapseSpan().Milliseconds()))
 		return segment, true
 	}).WithFinalizer(func(key int64, segment Segment) error {
 		log.Debug("evict segment from cache", zap.Int64("segmentID", key))
+		weight, _ := weights.GetAndRemove(key)
+		metrics.QueryNodeDiskCacheEvictTotal.WithLabelValues(nodeID).Inc()
+		metrics.QueryNodeDiskCacheEvictBytes.WithLabelValues(nodeID).Add(float64(weight))
 		segment.Release(WithReleaseScope(ReleaseScopeData))
 		return nil
 	}).Build()
+	manager.Tiering = NewTiering(manager)
+	manager.InterimIndex = NewInterimIndexBuilder(manager)
 	return manager
 }
 
@@ -210,6 +254,17 @@ func (mgr *Manager) SetLoader(loader Loader) {
 	mgr.Loader = loader
 }
 
+// doOnSegment executes doer on the segment, the data of lazy load segment is cached before executing,
+// it waits for the evictable room at most the lazy load wait timeout if the disk cache is full.
+func doOnSegment(mgr *Manager, segment Segment, doer func(Segment) error) error {
+	mgr.Tiering.Touch(segment.ID())
+	if !segment.IsLazyLoad() {
+		return doer(segment)
+	}
+	timeout := paramtable.Get().QueryNodeCfg.LazyLoadWaitTimeout.GetAsDuration(time.Millisecond)
+	return mgr.DiskCache.DoWait(segment.ID(), timeout, doer)
+}
+
 type SegmentManager interface {
 	// Put puts the given segments in,
 	// and increases the ref count of the corresponding collection,
@@ -11,6 +11,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/cache"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -162,6 +163,32 @@ func (s *ManagerSuite) TestIncreaseVersion() {
 	segment.AssertExpectations(s.T())
 }
 
+func (s *ManagerSuite) TestDoOnSegment() {
+	mgr := NewManager()
+
+	segment := NewMockSegment(s.T())
+	segment.EXPECT().IsLazyLoad().Return(false)
+	called := false
+	err := doOnSegment(mgr, segment, func(Segment) error {
+		called = true
+		return nil
+	})
+	s.NoError(err)
+	s.True(called)
+
+	// lazy load segment released
+	segment = NewMockSegment(s.T())
+	segment.EXPECT().IsLazyLoad().Return(true)
+	segment.EXPECT().ID().Return(100)
+	called = false
+	err = doOnSegment(mgr, segment, func(Segment) error {
+		called = true
+		return nil
+	})
+	s.ErrorIs(err, cache.ErrNoSuchItem)
+	s.False(called)
+}
+
 func TestManager(t *testing.T) {
 	suite.Run(t, new(ManagerSuite))
 }
@@ -0,0 +1,64 @@
+package segments
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/internal/util/multivectorutil"
+)
+
+func isMultiVectorSearch(req *querypb.QueryRequest) bool {
+	return req.GetReq().GetMultiVectorSearch() != nil
+}
+
+// mvReducer merges the topk rows scored by the workers with the max-sim of the multi-vector field.
+type mvReducer struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (r *mvReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
+	searcher, err := multivectorutil.NewSearcher(r.schema, r.req.GetReq().GetMultiVectorSearch())
+	if err != nil {
+		return nil, err
+	}
+	allRetrieveCount := int64(0)
+	for _, res := range results {
+		allRetrieveCount += res.GetAllRetrieveCount()
+		if err := searcher.AddPartial(res.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	return &internalpb.RetrieveResults{
+		FieldsData:       searcher.Partial(),
+		AllRetrieveCount: allRetrieveCount,
+	}, nil
+}
+
+// mvReducerSegCore scores the rows retrieved from the segments with the max-sim of the multi-vector field,
+// and keeps the topk rows as the partial result.
+type mvReducerSegCore struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (r *mvReducerSegCore) Reduce(ctx context.Context, results []*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
+	searcher, err := multivectorutil.NewSearcher(r.schema, r.req.GetReq().GetMultiVectorSearch())
+	if err != nil {
+		return nil, err
+	}
+	allRetrieveCount := int64(0)
+	for _, res := range results {
+		allRetrieveCount += res.GetAllRetrieveCount()
+		if err := searcher.AddRows(res.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	return &segcorepb.RetrieveResults{
+		FieldsData:       searcher.Partial(),
+		AllRetrieveCount: allRetrieveCount,
+	}, nil
+}
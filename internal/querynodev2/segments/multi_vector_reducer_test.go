@@ -0,0 +1,117 @@
+package segments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+)
+
+type MultiVectorReducerSuite struct {
+	suite.Suite
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (suite *MultiVectorReducerSuite) SetupTest() {
+	suite.req = &querypb.QueryRequest{
+		Req: &internalpb.RetrieveRequest{
+			MultiVectorSearch: &internalpb.MultiVectorSearch{
+				FieldID:    101,
+				Vectors:    []float32{1, 0},
+				MetricType: metric.IP,
+				Topk:       2,
+			},
+		},
+	}
+	suite.schema = &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{
+				FieldID:     101,
+				Name:        "passages",
+				DataType:    schemapb.DataType_Array,
+				ElementType: schemapb.DataType_Float,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.DimKey, Value: "2"},
+					{Key: common.MultiVectorKey, Value: "true"},
+				},
+			},
+		},
+	}
+}
+
+func TestMultiVectorReducerSuite(t *testing.T) {
+	suite.Run(t, new(MultiVectorReducerSuite))
+}
+
+func (suite *MultiVectorReducerSuite) rows(pks []int64, vectors [][]float32) []*schemapb.FieldData {
+	arrays := make([]*schemapb.ScalarField, len(vectors))
+	for i, v := range vectors {
+		arrays[i] = &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: v}}}
+	}
+	return []*schemapb.FieldData{
+		{
+			FieldId: 100,
+			Type:    schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+			}},
+		},
+		{
+			FieldId: 101,
+			Type:    schemapb.DataType_Array,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_ArrayData{ArrayData: &schemapb.ArrayArray{Data: arrays, ElementType: schemapb.DataType_Float}},
+			}},
+		},
+	}
+}
+
+func (suite *MultiVectorReducerSuite) TestNormalCase() {
+	segcoreReducer := &mvReducerSegCore{req: suite.req, schema: suite.schema}
+	partial1, err := segcoreReducer.Reduce(context.TODO(), []*segcorepb.RetrieveResults{
+		{FieldsData: suite.rows([]int64{1, 2}, [][]float32{{0.1, 0, 0.2, 0}, {0.9, 0}}), AllRetrieveCount: 2},
+		{FieldsData: suite.rows([]int64{3}, [][]float32{{0.5, 0}}), AllRetrieveCount: 1},
+	})
+	suite.NoError(err)
+	suite.Equal(int64(3), partial1.GetAllRetrieveCount())
+	suite.Equal([]int64{2, 3}, partial1.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	partial2, err := segcoreReducer.Reduce(context.TODO(), []*segcorepb.RetrieveResults{
+		{FieldsData: suite.rows([]int64{4}, [][]float32{{0, 1, 0.7, 0}}), AllRetrieveCount: 1},
+	})
+	suite.NoError(err)
+
+	reducer := &mvReducer{req: suite.req, schema: suite.schema}
+	res, err := reducer.Reduce(context.TODO(), []*internalpb.RetrieveResults{
+		{FieldsData: partial1.GetFieldsData(), AllRetrieveCount: partial1.GetAllRetrieveCount()},
+		{FieldsData: partial2.GetFieldsData(), AllRetrieveCount: partial2.GetAllRetrieveCount()},
+	})
+	suite.NoError(err)
+	suite.Equal(int64(4), res.GetAllRetrieveCount())
+	suite.Len(res.GetFieldsData(), 3)
+	suite.Equal([]int64{2, 4}, res.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	suite.InDeltaSlice([]float32{0.9, 0.7}, res.GetFieldsData()[2].GetScalars().GetFloatData().GetData(), 1e-6)
+}
+
+func (suite *MultiVectorReducerSuite) TestInvalid() {
+	segcoreReducer := &mvReducerSegCore{req: suite.req, schema: suite.schema}
+	_, err := segcoreReducer.Reduce(context.TODO(), []*segcorepb.RetrieveResults{
+		{FieldsData: suite.rows([]int64{1}, [][]float32{{1, 0}})[:1]},
+	})
+	suite.Error(err)
+
+	reducer := &mvReducer{req: suite.req, schema: suite.schema}
+	_, err = reducer.Reduce(context.TODO(), []*internalpb.RetrieveResults{
+		{FieldsData: suite.rows([]int64{1}, [][]float32{{1, 0}})},
+	})
+	suite.Error(err)
+}
@@ -17,6 +17,12 @@ func CreateInternalReducer(req *querypb.QueryRequest, schema *schemapb.Collectio
 	if req.GetReq().GetIsCount() {
 		return &cntReducer{}
 	}
+	if isAggregate(req) {
+		return &aggReducer{req: req, schema: schema}
+	}
+	if isMultiVectorSearch(req) {
+		return &mvReducer{req: req, schema: schema}
+	}
 	return newDefaultLimitReducer(req, schema)
 }
 
@@ -28,5 +34,11 @@ func CreateSegCoreReducer(req *querypb.QueryRequest, schema *schemapb.Collection
 	if req.GetReq().GetIsCount() {
 		return &cntReducerSegCore{}
 	}
+	if isAggregate(req) {
+		return &aggReducerSegCore{req: req, schema: schema}
+	}
+	if isMultiVectorSearch(req) {
+		return &mvReducerSegCore{req: req, schema: schema}
+	}
 	return newDefaultLimitReducerSegcore(req, schema)
 }
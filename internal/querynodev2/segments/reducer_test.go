@@ -40,6 +40,18 @@ func (suite *ReducerFactorySuite) TestCreateInternalReducer() {
 	suite.ir = CreateInternalReducer(req, nil)
 	_, suite.ok = suite.ir.(*cntReducer)
 	suite.True(suite.ok)
+
+	req.Req.IsCount = false
+	req.Req.Aggregates = []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Count}}
+	suite.ir = CreateInternalReducer(req, nil)
+	_, suite.ok = suite.ir.(*aggReducer)
+	suite.True(suite.ok)
+
+	req.Req.Aggregates = nil
+	req.Req.MultiVectorSearch = &internalpb.MultiVectorSearch{FieldID: 101}
+	suite.ir = CreateInternalReducer(req, nil)
+	_, suite.ok = suite.ir.(*mvReducer)
+	suite.True(suite.ok)
 }
 
 func (suite *ReducerFactorySuite) TestCreateSegCoreReducer() {
@@ -57,4 +69,16 @@ func (suite *ReducerFactorySuite) TestCreateSegCoreReducer() {
 	suite.sr = CreateSegCoreReducer(req, nil)
 	_, suite.ok = suite.sr.(*cntReducerSegCore)
 	suite.True(suite.ok)
+
+	req.Req.IsCount = false
+	req.Req.Aggregates = []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Count}}
+	suite.sr = CreateSegCoreReducer(req, nil)
+	_, suite.ok = suite.sr.(*aggReducerSegCore)
+	suite.True(suite.ok)
+
+	req.Req.Aggregates = nil
+	req.Req.MultiVectorSearch = &internalpb.MultiVectorSearch{FieldID: 101}
+	suite.sr = CreateSegCoreReducer(req, nil)
+	_, suite.ok = suite.sr.(*mvReducerSegCore)
+	suite.True(suite.ok)
 }
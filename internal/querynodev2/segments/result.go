@@ -40,7 +40,9 @@ var _ typeutil.ResultWithID = &internalpb.RetrieveResults{}
 
 var _ typeutil.ResultWithID = &segcorepb.RetrieveResults{}
 
-func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string) (*internalpb.SearchResults, error) {
+// ReduceSearchResults merges the search results of segments or workers,
+// groupSize is the max number of hits kept for each group when grouping search.
+func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, groupSize int64, metricType string) (*internalpb.SearchResults, error) {
 	results = lo.Filter(results, func(result *internalpb.SearchResults, _ int) bool {
 		return result != nil && result.GetSlicedBlob() != nil
 	})
@@ -75,7 +77,7 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 			zap.Int64("topk", sData.TopK))
 	}
 
-	reducedResultData, err := ReduceSearchResultData(ctx, searchResultData, nq, topk)
+	reducedResultData, err := ReduceSearchResultData(ctx, searchResultData, nq, topk, groupSize)
 	if err != nil {
 		log.Warn("shard leader reduce errors", zap.Error(err))
 		return nil, err
@@ -102,8 +104,11 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 	return searchResults, nil
 }
 
-func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64) (*schemapb.SearchResultData, error) {
+func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64, groupSize int64) (*schemapb.SearchResultData, error) {
 	log := log.Ctx(ctx)
+	if groupSize <= 0 {
+		groupSize = 1
+	}
 
 	if len(searchResultData) == 0 {
 		return &schemapb.SearchResultData{
@@ -133,6 +138,10 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		ret.AllSearchCount += searchResultData[i].GetAllSearchCount()
 	}
 
+	groupBy := lo.ContainsBy(searchResultData, func(data *schemapb.SearchResultData) bool {
+		return data.GetGroupByFieldValue() != nil
+	})
+
 	var skipDupCnt int64
 	var retSize int64
 	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
@@ -140,9 +149,13 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		offsets := make([]int64, len(searchResultData))
 
 		idSet := make(map[interface{}]struct{})
-		groupByValueSet := make(map[interface{}]struct{})
-		var j int64
-		for j = 0; j < topk; {
+		// topk is the number of groups for grouping search, each group keeps at most groupSize hits
+		groupByValueCount := make(map[interface{}]int64)
+		var (
+			j         int64
+			fullGroup int64
+		)
+		for (groupBy && fullGroup < topk) || (!groupBy && j < topk) {
 			sel := SelectSearchResultData(searchResultData, resultOffsets, offsets, i)
 			if sel == -1 {
 				break
@@ -155,16 +168,24 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
-				groupByValExist := false
+				skipForGroupBy := false
 				if groupByVal != nil {
-					_, groupByValExist = groupByValueSet[groupByVal]
+					cnt, groupByValExist := groupByValueCount[groupByVal]
+					if groupByValExist {
+						skipForGroupBy = cnt >= groupSize
+					} else {
+						skipForGroupBy = int64(len(groupByValueCount)) >= topk
+					}
 				}
-				if !groupByValExist {
+				if !skipForGroupBy {
 					retSize += typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
 					typeutil.AppendPKs(ret.Ids, id)
 					ret.Scores = append(ret.Scores, score)
 					if groupByVal != nil {
-						groupByValueSet[groupByVal] = struct{}{}
+						groupByValueCount[groupByVal]++
+						if groupByValueCount[groupByVal] == groupSize {
+							fullGroup++
+						}
 						if err := typeutil.AppendGroupByValue(ret, groupByVal, searchResultData[sel].GetGroupByFieldValue().GetType()); err != nil {
 							log.Error("Failed to append groupByValues", zap.Error(err))
 							return ret, err
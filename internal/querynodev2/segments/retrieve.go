@@ -36,8 +36,11 @@ import (
 )
 
 // retrieveOnSegments performs retrieve on listed segments
-// all segment ids are validated before calling this function
-func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, segType SegmentType, plan *RetrievePlan) ([]*segcorepb.RetrieveResults, error) {
+// all segment ids are validated before calling this function,
+// the result of each segment is reduced right away by reduceSegment if not nil.
+func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, segType SegmentType, plan *RetrievePlan,
+	reduceSegment func(*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error),
+) ([]*segcorepb.RetrieveResults, error) {
 	var (
 		resultCh = make(chan *segcorepb.RetrieveResults, len(segments))
 		errs     = make([]error, len(segments))
@@ -52,6 +55,9 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 	retriever := func(s Segment) error {
 		tr := timerecord.NewTimeRecorder("retrieveOnSegments")
 		result, err := s.Retrieve(ctx, plan)
+		if err == nil && reduceSegment != nil {
+			result, err = reduceSegment(result)
+		}
 		resultCh <- result
 		if err != nil {
 			return err
@@ -65,14 +71,7 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 		wg.Add(1)
 		go func(seg Segment, i int) {
 			defer wg.Done()
-
-			var err error
-			if seg.IsLazyLoad() {
-				err = mgr.DiskCache.Do(seg.ID(), retriever)
-			} else {
-				err = retriever(seg)
-			}
-			if err != nil {
+			if err := doOnSegment(mgr, seg, retriever); err != nil {
 				errs[i] = err
 			}
 		}(segment, i)
@@ -94,7 +93,7 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 	return retrieveResults, nil
 }
 
-func retrieveOnSegmentsWithStream(ctx context.Context, segments []Segment, segType SegmentType, plan *RetrievePlan, svr streamrpc.QueryStreamServer) error {
+func retrieveOnSegmentsWithStream(ctx context.Context, mgr *Manager, segments []Segment, segType SegmentType, plan *RetrievePlan, svr streamrpc.QueryStreamServer) error {
 	var (
 		errs = make([]error, len(segments))
 		wg   sync.WaitGroup
@@ -109,27 +108,28 @@ func retrieveOnSegmentsWithStream(ctx context.Context, segments []Segment, segTy
 		wg.Add(1)
 		go func(segment Segment, i int) {
 			defer wg.Done()
-			tr := timerecord.NewTimeRecorder("retrieveOnSegmentsWithStream")
-			result, err := segment.Retrieve(ctx, plan)
-			if err != nil {
-				errs[i] = err
-				return
-			}
+			errs[i] = doOnSegment(mgr, segment, func(segment Segment) error {
+				tr := timerecord.NewTimeRecorder("retrieveOnSegmentsWithStream")
+				result, err := segment.Retrieve(ctx, plan)
+				if err != nil {
+					return err
+				}
 
-			if len(result.GetOffset()) != 0 {
-				if err = svr.Send(&internalpb.RetrieveResults{
-					Status:           merr.Success(),
-					Ids:              result.GetIds(),
-					FieldsData:       result.GetFieldsData(),
-					AllRetrieveCount: result.GetAllRetrieveCount(),
-				}); err != nil {
-					errs[i] = err
+				if len(result.GetOffset()) != 0 {
+					if err = svr.Send(&internalpb.RetrieveResults{
+						Status:           merr.Success(),
+						Ids:              result.GetIds(),
+						FieldsData:       result.GetFieldsData(),
+						AllRetrieveCount: result.GetAllRetrieveCount(),
+					}); err != nil {
+						return err
+					}
 				}
-			}
 
-			errs[i] = nil
-			metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-				metrics.QueryLabel, label).Observe(float64(tr.ElapseSpan().Milliseconds()))
+				metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+					metrics.QueryLabel, label).Observe(float64(tr.ElapseSpan().Milliseconds()))
+				return nil
+			})
 		}(segment, i)
 	}
 	wg.Wait()
@@ -159,7 +159,20 @@ func Retrieve(ctx context.Context, manager *Manager, plan *RetrievePlan, req *qu
 		return retrieveResults, retrieveSegments, err
 	}
 
-	retrieveResults, err = retrieveOnSegments(ctx, manager, retrieveSegments, SegType, plan)
+	var reduceSegment func(*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error)
+	if isAggregate(req) {
+		// the rows are aggregated per segment, so they are limited by the request budget rather than the max output size
+		collection := manager.Collection.Get(collID)
+		if collection == nil {
+			return retrieveResults, retrieveSegments, merr.WrapErrCollectionNotFound(collID)
+		}
+		schema := collection.Schema()
+		plan.aggregated = true
+		reduceSegment = func(result *segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
+			return aggregateSegment(req, schema, result)
+		}
+	}
+	retrieveResults, err = retrieveOnSegments(ctx, manager, retrieveSegments, SegType, plan, reduceSegment)
 	return retrieveResults, retrieveSegments, err
 }
 
@@ -184,6 +197,6 @@ func RetrieveStream(ctx context.Context, manager *Manager, plan *RetrievePlan, r
 		return retrieveSegments, err
 	}
 
-	err = retrieveOnSegmentsWithStream(ctx, retrieveSegments, SegType, plan, srv)
+	err = retrieveOnSegmentsWithStream(ctx, manager, retrieveSegments, SegType, plan, srv)
 	return retrieveSegments, err
 }
@@ -58,6 +58,7 @@ func searchSegments(ctx context.Context, mgr *Manager, segments []Segment, segTy
 			return err
 		}
 		// update metrics
+		mgr.SegmentStats.RecordSearch(s, tr.ElapseSpan())
 		elapsed := tr.ElapseSpan().Milliseconds()
 		metrics.QueryNodeSQSegmentLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
 			metrics.SearchLabel, searchLabel).Observe(float64(elapsed))
@@ -76,13 +77,7 @@ func searchSegments(ctx context.Context, mgr *Manager, segments []Segment, segTy
 				segmentsWithoutIndex = append(segmentsWithoutIndex, seg.ID())
 				mu.Unlock()
 			}
-			var err error
-			if seg.IsLazyLoad() {
-				err = mgr.DiskCache.Do(seg.ID(), searcher)
-			} else {
-				err = searcher(seg)
-			}
-			if err != nil {
+			if err := doOnSegment(mgr, seg, searcher); err != nil {
 				errs[i] = err
 			}
 		}(segment, i)
@@ -29,9 +29,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/apache/arrow/go/v12/arrow/array"
@@ -51,6 +53,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/querynodev2/pkoracle"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/diskquota"
 	typeutil_internal "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -89,7 +92,7 @@ type baseSegment struct {
 	segmentType    SegmentType
 	bloomFilterSet *pkoracle.BloomFilterSet
 	loadInfo       *querypb.SegmentLoadInfo
-	isLazyLoad     bool
+	isLazyLoad     *atomic.Bool
 
 	resourceUsageCache *atomic.Pointer[ResourceUsage]
 }
@@ -102,6 +105,7 @@ func newBaseSegment(collection *Collection, segmentType SegmentType, version int
 		loadStatus:     atomic.NewString(string(LoadStatusMeta)),
 		segmentType:    segmentType,
 		bloomFilterSet: pkoracle.NewBloomFilterSet(loadInfo.GetSegmentID(), loadInfo.GetPartitionID(), segmentType),
+		isLazyLoad:     atomic.NewBool(false),
 
 		resourceUsageCache: atomic.NewPointer[ResourceUsage](nil),
 	}
@@ -201,7 +205,7 @@ func (s *baseSegment) ResourceUsageEstimate() ResourceUsage {
 	return *usage
 }
 
-func (s *baseSegment) IsLazyLoad() bool { return s.isLazyLoad }
+func (s *baseSegment) IsLazyLoad() bool { return s.isLazyLoad.Load() }
 
 type FieldInfo struct {
 	datapb.FieldBinlog
@@ -225,6 +229,12 @@ type LocalSegment struct {
 	fields             *typeutil.ConcurrentMap[int64, *FieldInfo]
 	fieldIndexes       *typeutil.ConcurrentMap[int64, *IndexedFieldInfo]
 	space              *milvus_storage.Space
+
+	// the fields skipped at loading since not listed in the load fields, set before the segment is put into the manager
+	skippedFields map[int64]*IndexedFieldInfo
+	// the skipped fields loaded on demand
+	demandMu       sync.Mutex
+	demandedFields typeutil.Set[int64]
 }
 
 func NewSegment(ctx context.Context,
@@ -495,8 +505,14 @@ func (s *LocalSegment) Search(ctx context.Context, searchReq *SearchRequest) (*S
 	log = log.With(zap.Bool("withIndex", hasIndex))
 	log.Debug("search segment...")
 
+	budget := GetRequestBudget(ctx)
+	if err := budget.Check(); err != nil {
+		return nil, err
+	}
+
 	var searchResult SearchResult
 	var status C.CStatus
+	var cost time.Duration
 	GetSQPool().Submit(func() (any, error) {
 		tr := timerecord.NewTimeRecorder("cgoSearch")
 		status = C.Search(traceCtx,
@@ -506,7 +522,8 @@ func (s *LocalSegment) Search(ctx context.Context, searchReq *SearchRequest) (*S
 			C.uint64_t(searchReq.mvccTimestamp),
 			&searchResult.cSearchResult,
 		)
-		metrics.QueryNodeSQSegmentLatencyInCore.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.SearchLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		cost = tr.ElapseSpan()
+		metrics.QueryNodeSQSegmentLatencyInCore.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.SearchLabel).Observe(float64(cost.Milliseconds()))
 		return nil, nil
 	}).Await()
 	if err := HandleCStatus(ctx, &status, "Search failed",
@@ -515,6 +532,14 @@ func (s *LocalSegment) Search(ctx context.Context, searchReq *SearchRequest) (*S
 		zap.String("segmentType", s.segmentType.String())); err != nil {
 		return nil, err
 	}
+	if err := budget.ConsumeCPU(cost); err != nil {
+		DeleteSearchResults([]*SearchResult{&searchResult})
+		return nil, err
+	}
+	if err := budget.ConsumeMemory(searchReq.getNumOfQuery() * searchReq.plan.getTopK() * searchResultEntrySize); err != nil {
+		DeleteSearchResults([]*SearchResult{&searchResult})
+		return nil, err
+	}
 	log.Debug("search segment done")
 	return &searchResult, nil
 }
@@ -537,9 +562,19 @@ func (s *LocalSegment) Retrieve(ctx context.Context, plan *RetrievePlan) (*segco
 
 	traceCtx := ParseCTraceContext(ctx)
 
+	budget := GetRequestBudget(ctx)
+	if err := budget.Check(); err != nil {
+		return nil, err
+	}
+
 	maxLimitSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	if plan.aggregated {
+		// only the aggregated groups are output, the rows are bounded by the request budget
+		maxLimitSize = math.MaxInt64
+	}
 	var retrieveResult RetrieveResult
 	var status C.CStatus
+	var cost time.Duration
 	GetSQPool().Submit(func() (any, error) {
 		ts := C.uint64_t(plan.Timestamp)
 		tr := timerecord.NewTimeRecorder("cgoRetrieve")
@@ -550,8 +585,9 @@ func (s *LocalSegment) Retrieve(ctx context.Context, plan *RetrievePlan) (*segco
 			&retrieveResult.cRetrieveResult,
 			C.int64_t(maxLimitSize))
 
+		cost = tr.ElapseSpan()
 		metrics.QueryNodeSQSegmentLatencyInCore.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-			metrics.QueryLabel).Observe(float64(tr.ElapseSpan().Milliseconds()))
+			metrics.QueryLabel).Observe(float64(cost.Milliseconds()))
 		log.Debug("cgo retrieve done", zap.Duration("timeTaken", tr.ElapseSpan()))
 		return nil, nil
 	}).Await()
@@ -569,6 +605,12 @@ func (s *LocalSegment) Retrieve(ctx context.Context, plan *RetrievePlan) (*segco
 	if err := HandleCProto(&retrieveResult.cRetrieveResult, result); err != nil {
 		return nil, err
 	}
+	if err := budget.ConsumeCPU(cost); err != nil {
+		return nil, err
+	}
+	if err := budget.ConsumeMemory(int64(proto.Size(result))); err != nil {
+		return nil, err
+	}
 
 	log.Debug("retrieve segment done",
 		zap.Int("resultNum", len(result.Offset)),
@@ -663,6 +705,32 @@ func (s *LocalSegment) Insert(ctx context.Context, rowIDs []int64, timestamps []
 	return nil
 }
 
+// BuildInterimIndex builds the interim index of the growing segment deferred by the insertions,
+// it's a no-op if the index has been built or the rows are not enough to build.
+func (s *LocalSegment) BuildInterimIndex(ctx context.Context) error {
+	if s.Type() != SegmentTypeGrowing {
+		return fmt.Errorf("unexpected segmentType when building interim index, segmentType = %s", s.segmentType.String())
+	}
+
+	s.ptrLock.RLock()
+	defer s.ptrLock.RUnlock()
+
+	if s.ptr == nil {
+		return merr.WrapErrSegmentNotLoaded(s.ID(), "segment released")
+	}
+
+	var status C.CStatus
+	GetLoadPool().Submit(func() (any, error) {
+		status = C.BuildInterimIndex(s.ptr)
+		return nil, nil
+	}).Await()
+	if err := HandleCStatus(ctx, &status, "BuildInterimIndex failed"); err != nil {
+		return err
+	}
+	s.memSize.Store(-1)
+	return nil
+}
+
 func (s *LocalSegment) Delete(ctx context.Context, primaryKeys []storage.PrimaryKey, timestamps []typeutil.Timestamp) error {
 	/*
 		CStatus
@@ -921,6 +989,52 @@ func (s *LocalSegment) LoadFieldData(ctx context.Context, fieldID int64, rowCoun
 	return nil
 }
 
+// LoadFieldDefaultData loads the field with all rows of the default value,
+// used for the segments written before the field added to the collection.
+func (s *LocalSegment) LoadFieldDefaultData(ctx context.Context, field *schemapb.FieldSchema, rowCount int64) error {
+	s.ptrLock.RLock()
+	defer s.ptrLock.RUnlock()
+
+	if s.ptr == nil {
+		return merr.WrapErrSegmentNotLoaded(s.ID(), "segment released")
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", s.Collection()),
+		zap.Int64("partitionID", s.Partition()),
+		zap.Int64("segmentID", s.ID()),
+		zap.Int64("fieldID", field.GetFieldID()),
+		zap.Int64("rowCount", rowCount),
+	)
+
+	defaultValueBlob, err := proto.Marshal(field.GetDefaultValue())
+	if err != nil {
+		return err
+	}
+	if len(defaultValueBlob) == 0 {
+		return merr.WrapErrParameterInvalidMsg("field %s has no default value", field.GetName())
+	}
+
+	var status C.CStatus
+	GetLoadPool().Submit(func() (any, error) {
+		status = C.LoadFieldDefaultData(s.ptr,
+			C.int64_t(field.GetFieldID()),
+			unsafe.Pointer(&defaultValueBlob[0]),
+			C.int64_t(len(defaultValueBlob)),
+			C.int64_t(rowCount))
+		return nil, nil
+	}).Await()
+	if err := HandleCStatus(ctx, &status, "LoadFieldDefaultData failed",
+		zap.Int64("collectionID", s.Collection()),
+		zap.Int64("segmentID", s.ID()),
+		zap.Int64("fieldID", field.GetFieldID())); err != nil {
+		return err
+	}
+
+	log.Info("load field default data done")
+	return nil
+}
+
 func (s *LocalSegment) LoadDeltaData2(ctx context.Context, schema *schemapb.CollectionSchema) error {
 	deleteReader, err := s.space.ScanDelete()
 	if err != nil {
@@ -1273,6 +1387,12 @@ func (s *LocalSegment) WarmupChunkCache(ctx context.Context, fieldID int64) {
 	var status C.CStatus
 
 	warmingUp := strings.ToLower(paramtable.Get().QueryNodeCfg.ChunkCacheWarmingUp.GetValue())
+	// the chunk cache shares the local disk quota, the chunks are cached on demand instead if exceeded
+	if (warmingUp == "sync" || warmingUp == "async") && diskquota.Available() <= 0 {
+		log.Warn("skip warming up chunk cache as the local disk quota exceeded",
+			zap.Int64("usage", diskquota.Total()), zap.Int64("quota", diskquota.Limit()))
+		return
+	}
 	switch warmingUp {
 	case "sync":
 		GetLoadPool().Submit(func() (any, error) {
@@ -1366,23 +1486,31 @@ func (s *LocalSegment) Release(opts ...releaseOption) {
 
 	// wait all read ops finished
 	s.ptrLock.Lock()
-	ptr = s.ptr
-	s.ptr = nil
 	if options.Scope == ReleaseScopeData {
+		// keep the segment, so the data could be loaded again
+		if s.ptr != nil {
+			C.ClearSegmentData(s.ptr)
+		}
 		s.loadStatus.Store(string(LoadStatusMeta))
+		s.ptrLock.Unlock()
+		return
 	}
+	ptr = s.ptr
+	s.ptr = nil
 	s.ptrLock.Unlock()
 
 	if ptr == nil {
 		return
 	}
-	if options.Scope == ReleaseScopeData {
-		C.ClearSegmentData(ptr)
-		return
-	}
 
 	C.DeleteSegment(ptr)
 
+	if s.segmentType == SegmentTypeSealed {
+		for _, consumer := range storage.GetStatsConsumers() {
+			consumer.Release(s.ID())
+		}
+	}
+
 	metrics.QueryNodeNumEntities.WithLabelValues(
 		s.DatabaseName(),
 		fmt.Sprint(paramtable.GetNodeID()),
@@ -1406,3 +1534,20 @@ func (s *LocalSegment) Release(opts ...releaseOption) {
 		zap.Int64("insertCount", s.InsertCount()),
 	)
 }
+
+// evictToDiskCache releases the in memory data of the sealed segment and turns it into lazy load,
+// so the data would be loaded into the disk cache on next access.
+// Returns false if the segment is not in memory, or some of its fields are skipped at loading.
+func (s *LocalSegment) evictToDiskCache() bool {
+	// wait all read ops finished, and block the new ones until the segment turns into lazy load
+	s.ptrLock.Lock()
+	defer s.ptrLock.Unlock()
+	if s.ptr == nil || s.LoadStatus() != LoadStatusInMemory || len(s.skippedFields) > 0 {
+		return false
+	}
+
+	C.ClearSegmentData(s.ptr)
+	s.loadStatus.Store(string(LoadStatusMeta))
+	s.isLazyLoad.Store(true)
+	return true
+}
@@ -49,6 +49,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querynodev2/pkoracle"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/initcore"
 	typeutil_internal "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -224,6 +225,9 @@ func (loader *segmentLoaderV2) Load(ctx context.Context,
 			)
 			return err
 		}
+		if loadInfo.GetLevel() != datapb.SegmentLevel_L0 {
+			warmupSegment(ctx, segment, loadInfo)
+		}
 		loader.manager.Segment.Put(segmentType, segment)
 		newSegments.GetAndRemove(segmentID)
 		loaded.Insert(segmentID, segment)
@@ -581,8 +585,54 @@ func (loader *segmentLoader) Load(ctx context.Context,
 	// continue to wait other task done
 	log.Info("start loading...", zap.Int("segmentNum", len(segments)), zap.Int("afterFilter", len(infos)))
 
+	loadStatus := LoadStatusInMemory
+	collection := loader.manager.Collection.Get(collectionID)
+	if collection == nil {
+		err := merr.WrapErrCollectionNotFound(collectionID)
+		log.Warn("failed to get collection", zap.Error(err))
+		return nil, err
+	}
+
+	if err := initcore.SyncCollectionStorage(paramtable.Get(), collectionID); err != nil {
+		log.Warn("failed to sync storage of collection", zap.Error(err))
+		return nil, err
+	}
+	if err := initcore.SyncCollectionDataKeys(ctx, loader.cm, collectionID); err != nil {
+		log.Warn("failed to sync data keys of collection", zap.Error(err))
+		return nil, err
+	}
+
+	if isLazyLoadEnabled(collection) {
+		loadStatus = LoadStatusMeta
+	}
+
+	// Only the fields listed in load fields are loaded into the sealed segments, the others are loaded on demand.
+	// The lazy load segments register all the fields, which are loaded into the disk cache on access
+	skippedFields := make(map[int64]map[int64]*IndexedFieldInfo)
+	if loadFields := collection.LoadFields(); loadFields != nil && segmentType == SegmentTypeSealed && loadStatus != LoadStatusMeta {
+		infos = lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) *querypb.SegmentLoadInfo {
+			loaded, skipped := splitLoadInfo(loadFields, info)
+			skippedFields[info.GetSegmentID()] = skipped
+			return loaded
+		})
+	}
+
 	// Check memory & storage limit
-	resource, concurrencyLevel, err := loader.requestResource(ctx, infos...)
+	requestInfos := infos
+	if loadStatus == LoadStatusMeta && segmentType == SegmentTypeSealed {
+		// the data of lazy load segments is loaded into disk cache on access, which has its own capacity,
+		// only the delta data is resident after loaded
+		requestInfos = lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) *querypb.SegmentLoadInfo {
+			return &querypb.SegmentLoadInfo{
+				SegmentID:    info.GetSegmentID(),
+				PartitionID:  info.GetPartitionID(),
+				CollectionID: info.GetCollectionID(),
+				NumOfRows:    info.GetNumOfRows(),
+				Deltalogs:    info.GetDeltalogs(),
+			}
+		})
+	}
+	resource, concurrencyLevel, err := loader.requestResource(ctx, requestInfos...)
 	if err != nil {
 		log.Warn("request resource failed", zap.Error(err))
 		return nil, err
@@ -603,19 +653,6 @@ func (loader *segmentLoader) Load(ctx context.Context,
 		debug.FreeOSMemory()
 	}()
 
-	loadStatus := LoadStatusInMemory
-	collection := loader.manager.Collection.Get(collectionID)
-	if collection == nil {
-		err := merr.WrapErrCollectionNotFound(collectionID)
-		log.Warn("failed to get collection", zap.Error(err))
-		return nil, err
-	}
-
-	if common.IsCollectionLazyLoadEnabled(collection.Schema().Properties...) ||
-		(!common.HasLazyload(collection.Schema().Properties) && params.Params.QueryNodeCfg.LazyLoadEnabled.GetAsBool()) {
-		loadStatus = LoadStatusMeta
-	}
-
 	for _, info := range infos {
 		loadInfo := info
 
@@ -634,6 +671,9 @@ func (loader *segmentLoader) Load(ctx context.Context,
 			)
 			return nil, err
 		}
+		if local, ok := segment.(*LocalSegment); ok {
+			local.skippedFields = skippedFields[loadInfo.GetSegmentID()]
+		}
 
 		newSegments.Insert(loadInfo.GetSegmentID(), segment)
 	}
@@ -663,6 +703,9 @@ func (loader *segmentLoader) Load(ctx context.Context,
 			)
 			return err
 		}
+		if loadStatus == LoadStatusInMemory && loadInfo.GetLevel() != datapb.SegmentLevel_L0 {
+			warmupSegment(ctx, segment, loadInfo)
+		}
 		loader.manager.Segment.Put(segmentType, segment)
 		newSegments.GetAndRemove(segmentID)
 		loaded.Insert(segmentID, segment)
@@ -702,6 +745,13 @@ func (loader *segmentLoader) Load(ctx context.Context,
 	return result, nil
 }
 
+// isLazyLoadEnabled returns whether the sealed segments of the collection register the meta only when loading,
+// the collection property takes precedence over the node config.
+func isLazyLoadEnabled(collection *Collection) bool {
+	return common.IsCollectionLazyLoadEnabled(collection.Schema().Properties...) ||
+		(!common.HasLazyload(collection.Schema().Properties) && params.Params.QueryNodeCfg.LazyLoadEnabled.GetAsBool())
+}
+
 func (loader *segmentLoader) prepare(ctx context.Context, segmentType SegmentType, segments ...*querypb.SegmentLoadInfo) []*querypb.SegmentLoadInfo {
 	log := log.Ctx(ctx).With(
 		zap.Stringer("segmentType", segmentType),
@@ -791,6 +841,13 @@ func (loader *segmentLoader) requestResource(ctx context.Context, infos ...*quer
 		log.Warn("no sufficient resource to load segments", zap.Error(err))
 		return resource, 0, err
 	}
+	// the mmap files and the disk index share the local disk quota with the other consumers
+	if loader.manager.DiskCacheManager != nil {
+		if err := loader.manager.DiskCacheManager.Admit(int64(du), int64(loader.committedResource.DiskSize)); err != nil {
+			log.Warn("no sufficient local disk quota to load segments", zap.Error(err))
+			return resource, 0, err
+		}
+	}
 
 	resource.MemorySize += mu
 	resource.DiskSize += du
@@ -996,6 +1053,11 @@ func (loader *segmentLoader) loadSealedSegment(ctx context.Context, loadInfo *qu
 	if err := loadSealedSegmentFields(ctx, collection, segment, fieldBinlogs, loadInfo.GetNumOfRows(), WithLoadStatus(loadStatus)); err != nil {
 		return err
 	}
+	if loadStatus != LoadStatusMeta {
+		if err := loadAddedFieldsDefaultData(ctx, collection, segment, loadInfo); err != nil {
+			return err
+		}
+	}
 	// https://github.com/milvus-io/milvus/23654
 	// legacy entry num = 0
 	if err := loader.patchEntryNumber(ctx, segment, loadInfo); err != nil {
@@ -1004,6 +1066,29 @@ func (loader *segmentLoader) loadSealedSegment(ctx context.Context, loadInfo *qu
 	return nil
 }
 
+// loadAddedFieldsDefaultData loads the default value for the fields added
+// to the collection after the segment written, which have no binlog in the segment.
+func loadAddedFieldsDefaultData(ctx context.Context, collection *Collection, segment *LocalSegment, loadInfo *querypb.SegmentLoadInfo) error {
+	loadedFields := typeutil.NewSet[int64]()
+	for _, fieldBinlog := range loadInfo.GetBinlogPaths() {
+		loadedFields.Insert(fieldBinlog.GetFieldID())
+	}
+	for _, field := range collection.Schema().GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || loadedFields.Contain(field.GetFieldID()) ||
+			field.GetDefaultValue() == nil {
+			continue
+		}
+		if err := segment.LoadFieldDefaultData(ctx, field, loadInfo.GetNumOfRows()); err != nil {
+			log.Ctx(ctx).Warn("load default data of added field failed",
+				zap.Int64("segmentID", segment.ID()),
+				zap.Int64("fieldID", field.GetFieldID()),
+				zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
 func (loader *segmentLoader) LoadSegment(ctx context.Context,
 	segment *LocalSegment,
 	loadInfo *querypb.SegmentLoadInfo,
@@ -1033,12 +1118,15 @@ func (loader *segmentLoader) LoadSegment(ctx context.Context,
 
 	if segment.Type() == SegmentTypeSealed {
 		if loadStatus == LoadStatusMeta {
-			segment.baseSegment.isLazyLoad = true
+			segment.baseSegment.isLazyLoad.Store(true)
 			segment.baseSegment.loadInfo = loadInfo
 		}
 		if err := loader.loadSealedSegment(ctx, loadInfo, segment, collection, loadStatus); err != nil {
 			return err
 		}
+		if err := loader.loadArtifacts(ctx, segment.ID(), loadInfo.GetArtifactlogs()); err != nil {
+			return err
+		}
 	} else {
 		if err := segment.LoadMultiFieldData(ctx, loadInfo.GetNumOfRows(), loadInfo.BinlogPaths); err != nil {
 			return err
@@ -1068,6 +1156,36 @@ func (loader *segmentLoader) LoadSegment(ctx context.Context,
 	return loader.LoadDeltaLogs(ctx, segment, loadInfo.Deltalogs)
 }
 
+// loadArtifacts passes the artifact logs of the sealed segment to the stats consumers registered,
+// the artifacts without any consumer are skipped.
+func (loader *segmentLoader) loadArtifacts(ctx context.Context, segmentID int64, artifactLogs []*datapb.FieldArtifactLogs) error {
+	for _, logs := range artifactLogs {
+		consumer, ok := storage.GetStatsConsumer(logs.GetName())
+		if !ok {
+			continue
+		}
+		paths := lo.Map(logs.GetBinlogs(), func(binlog *datapb.Binlog, _ int) string { return binlog.GetLogPath() })
+		values, err := loader.cm.MultiRead(ctx, paths)
+		if err != nil {
+			log.Warn("failed to read artifact logs",
+				zap.Int64("segmentID", segmentID),
+				zap.Int64("fieldID", logs.GetFieldID()),
+				zap.String("name", logs.GetName()),
+				zap.Error(err))
+			return err
+		}
+		if err := consumer.Load(segmentID, logs.GetFieldID(), values); err != nil {
+			log.Warn("failed to load artifacts",
+				zap.Int64("segmentID", segmentID),
+				zap.Int64("fieldID", logs.GetFieldID()),
+				zap.String("name", logs.GetName()),
+				zap.Error(err))
+			return err
+		}
+	}
+	return nil
+}
+
 func (loader *segmentLoader) filterPKStatsBinlogs(fieldBinlogs []*datapb.FieldBinlog, pkFieldID int64) ([]string, storage.StatsLogType) {
 	result := make([]string, 0)
 	for _, fieldBinlog := range fieldBinlogs {
@@ -1170,15 +1288,7 @@ func (loader *segmentLoader) loadFieldsIndex(ctx context.Context,
 }
 
 func (loader *segmentLoader) loadFieldIndex(ctx context.Context, segment *LocalSegment, indexInfo *querypb.FieldIndexInfo, opts ...loadOption) error {
-	filteredPaths := make([]string, 0, len(indexInfo.IndexFilePaths))
-
-	for _, indexPath := range indexInfo.IndexFilePaths {
-		if path.Base(indexPath) != storage.IndexParamsKey {
-			filteredPaths = append(filteredPaths, indexPath)
-		}
-	}
-
-	indexInfo.IndexFilePaths = filteredPaths
+	indexInfo.IndexFilePaths = filterIndexFilePaths(indexInfo.IndexFilePaths)
 	fieldType, err := loader.getFieldType(segment.Collection(), indexInfo.FieldID)
 	if err != nil {
 		return err
@@ -1192,6 +1302,17 @@ func (loader *segmentLoader) loadFieldIndex(ctx context.Context, segment *LocalS
 	return segment.LoadIndex(ctx, indexInfo, fieldType, opts...)
 }
 
+// filterIndexFilePaths filters out the index params file, which is not loaded by segcore.
+func filterIndexFilePaths(paths []string) []string {
+	filteredPaths := make([]string, 0, len(paths))
+	for _, indexPath := range paths {
+		if path.Base(indexPath) != storage.IndexParamsKey {
+			filteredPaths = append(filteredPaths, indexPath)
+		}
+	}
+	return filteredPaths
+}
+
 func (loader *segmentLoader) loadBloomFilter(ctx context.Context, segmentID int64, bfs *pkoracle.BloomFilterSet,
 	binlogPaths []string, logType storage.StatsLogType,
 ) error {
@@ -1334,28 +1455,14 @@ func (loader *segmentLoader) patchEntryNumber(ctx context.Context, segment *Loca
 	counts := make([]int64, 0, len(rowIDField.GetBinlogs()))
 	for _, binlog := range rowIDField.GetBinlogs() {
 		// binlog.LogPath has already been filled
-		bs, err := loader.cm.Read(ctx, binlog.LogPath)
+		// get binlog entry num from the page index of rowID field binlog,
+		// only the descriptor and the parquet metadata are read for the version 2 binlogs,
+		// the binlogs of the other versions are read entirely since the header does not store entry num
+		reader, err := storage.NewBinlogPageReader(ctx, loader.cm, binlog.LogPath)
 		if err != nil {
 			return err
 		}
-
-		// get binlog entry num from rowID field
-		// since header does not store entry numb, we have to read all data here
-
-		reader, err := storage.NewBinlogReader(bs)
-		if err != nil {
-			return err
-		}
-		er, err := reader.NextEventReader()
-		if err != nil {
-			return err
-		}
-
-		rowIDs, err := er.GetInt64FromPayload()
-		if err != nil {
-			return err
-		}
-		counts = append(counts, int64(len(rowIDs)))
+		counts = append(counts, reader.PageIndex().RowNum())
 	}
 
 	var err error
@@ -1580,6 +1687,14 @@ func (loader *segmentLoader) LoadIndex(ctx context.Context, segment *LocalSegmen
 		info.Statslogs = nil
 		return info
 	})
+	if err := initcore.SyncCollectionStorage(paramtable.Get(), segment.Collection()); err != nil {
+		log.Warn("failed to sync storage of collection", zap.Error(err))
+		return err
+	}
+	if err := initcore.SyncCollectionDataKeys(ctx, loader.cm, segment.Collection()); err != nil {
+		log.Warn("failed to sync data keys of collection", zap.Error(err))
+		return err
+	}
 	resource, _, err := loader.requestResource(ctx, indexInfo...)
 	if err != nil {
 		return err
@@ -512,60 +512,111 @@ func (suite *SegmentLoaderSuite) TestLoadWithMmap() {
 	suite.NoError(err)
 }
 
-func (suite *SegmentLoaderSuite) TestPatchEntryNum() {
+func (suite *SegmentLoaderSuite) TestLoadFieldsOnDemand() {
 	ctx := context.Background()
 
-	msgLength := 100
-	segmentID := suite.segmentID
+	collection := suite.manager.Collection.Get(suite.collectionID)
+	collection.Schema().Properties = append(collection.Schema().Properties, &commonpb.KeyValuePair{
+		Key:   common.LoadFieldsKey,
+		Value: "floatVectorField",
+	})
+	boolField := collection.Schema().GetFields()[0]
+
+	msgLength := 4
 	binlogs, statsLogs, err := SaveBinLog(ctx,
 		suite.collectionID,
 		suite.partitionID,
-		segmentID,
+		suite.segmentID,
 		msgLength,
 		suite.schema,
 		suite.chunkManager,
 	)
 	suite.NoError(err)
 
-	vecFields := funcutil.GetVecFieldIDs(suite.schema)
-	indexInfo, err := GenAndSaveIndex(
-		suite.collectionID,
-		suite.partitionID,
-		segmentID,
-		vecFields[0],
-		msgLength,
-		IndexFaissIVFFlat,
-		metric.L2,
-		suite.chunkManager,
-	)
-	suite.NoError(err)
-	loadInfo := &querypb.SegmentLoadInfo{
-		SegmentID:    segmentID,
+	segments, err := suite.loader.Load(ctx, suite.collectionID, SegmentTypeSealed, 0, &querypb.SegmentLoadInfo{
+		SegmentID:    suite.segmentID,
 		PartitionID:  suite.partitionID,
 		CollectionID: suite.collectionID,
 		BinlogPaths:  binlogs,
 		Statslogs:    statsLogs,
-		IndexInfos:   []*querypb.FieldIndexInfo{indexInfo},
 		NumOfRows:    int64(msgLength),
-	}
+	})
+	suite.NoError(err)
+	suite.Len(segments, 1)
+	segment := segments[0].(*LocalSegment)
+	suite.Contains(segment.skippedFields, boolField.GetFieldID())
+
+	// the skipped field is loaded once requested
+	err = LoadRequestedFields(ctx, suite.manager, collection, []int64{suite.segmentID}, []int64{boolField.GetFieldID()}, nil)
+	suite.NoError(err)
+	suite.True(segment.demandedFields.Contain(boolField.GetFieldID()))
+	_, ok := segment.fields.Get(boolField.GetFieldID())
+	suite.True(ok)
+	// the segment with the fields loaded on demand is not evicted
+	suite.False(segment.evictToDiskCache())
+}
+
+func (suite *SegmentLoaderSuite) TestPatchEntryNum() {
+	ctx := context.Background()
+	params := paramtable.Get()
+	defer params.Reset(params.DataNodeCfg.BinlogFormatVersion.Key)
+	defer params.Reset(params.DataNodeCfg.BinlogPageRows.Key)
+	params.Save(params.DataNodeCfg.BinlogPageRows.Key, "30")
 
-	// mock legacy binlog entry num is zero case
-	for _, fieldLog := range binlogs {
-		for _, binlog := range fieldLog.GetBinlogs() {
-			binlog.EntriesNum = 0
+	msgLength := 100
+	for i, version := range []string{"1", "2"} {
+		params.Save(params.DataNodeCfg.BinlogFormatVersion.Key, version)
+		segmentID := suite.segmentID + int64(i)
+		binlogs, statsLogs, err := SaveBinLog(ctx,
+			suite.collectionID,
+			suite.partitionID,
+			segmentID,
+			msgLength,
+			suite.schema,
+			suite.chunkManager,
+		)
+		suite.NoError(err)
+
+		vecFields := funcutil.GetVecFieldIDs(suite.schema)
+		indexInfo, err := GenAndSaveIndex(
+			suite.collectionID,
+			suite.partitionID,
+			segmentID,
+			vecFields[0],
+			msgLength,
+			IndexFaissIVFFlat,
+			metric.L2,
+			suite.chunkManager,
+		)
+		suite.NoError(err)
+		loadInfo := &querypb.SegmentLoadInfo{
+			SegmentID:    segmentID,
+			PartitionID:  suite.partitionID,
+			CollectionID: suite.collectionID,
+			BinlogPaths:  binlogs,
+			Statslogs:    statsLogs,
+			IndexInfos:   []*querypb.FieldIndexInfo{indexInfo},
+			NumOfRows:    int64(msgLength),
 		}
-	}
 
-	segments, err := suite.loader.Load(ctx, suite.collectionID, SegmentTypeSealed, 0, loadInfo)
-	suite.Require().NoError(err)
-	suite.Require().Equal(1, len(segments))
+		// mock legacy binlog entry num is zero case
+		for _, fieldLog := range binlogs {
+			for _, binlog := range fieldLog.GetBinlogs() {
+				binlog.EntriesNum = 0
+			}
+		}
 
-	segment := segments[0]
-	info := segment.GetIndex(vecFields[0])
-	suite.Require().NotNil(info)
+		segments, err := suite.loader.Load(ctx, suite.collectionID, SegmentTypeSealed, 0, loadInfo)
+		suite.Require().NoError(err)
+		suite.Require().Equal(1, len(segments))
+
+		segment := segments[0]
+		info := segment.GetIndex(vecFields[0])
+		suite.Require().NotNil(info)
 
-	for _, binlog := range info.FieldBinlog.GetBinlogs() {
-		suite.Greater(binlog.EntriesNum, int64(0))
+		for _, binlog := range info.FieldBinlog.GetBinlogs() {
+			suite.EqualValues(msgLength, binlog.EntriesNum)
+		}
 	}
 }
 
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type segmentSearchStats struct {
+	collectionID int64
+	hits         atomic.Int64
+	latency      atomic.Int64
+	rowsScanned  atomic.Int64
+	cacheMisses  atomic.Int64
+}
+
+// SegmentStatsCollector collects the search statistics of segments,
+// the statistics of a segment are dropped once it's released.
+type SegmentStatsCollector struct {
+	manager *Manager
+	stats   *typeutil.ConcurrentMap[int64, *segmentSearchStats]
+}
+
+func NewSegmentStatsCollector(manager *Manager) *SegmentStatsCollector {
+	return &SegmentStatsCollector{
+		manager: manager,
+		stats:   typeutil.NewConcurrentMap[int64, *segmentSearchStats](),
+	}
+}
+
+func (c *SegmentStatsCollector) get(segmentID, collectionID int64) *segmentSearchStats {
+	if stats, ok := c.stats.Get(segmentID); ok {
+		return stats
+	}
+	stats, _ := c.stats.GetOrInsert(segmentID, &segmentSearchStats{collectionID: collectionID})
+	return stats
+}
+
+// RecordSearch records a search on the segment.
+func (c *SegmentStatsCollector) RecordSearch(segment Segment, latency time.Duration) {
+	stats := c.get(segment.ID(), segment.Collection())
+	stats.hits.Inc()
+	stats.latency.Add(int64(latency))
+	stats.rowsScanned.Add(segment.InsertCount())
+}
+
+// RecordCacheMiss records the segment is loaded into the disk cache before searching.
+func (c *SegmentStatsCollector) RecordCacheMiss(segment Segment) {
+	c.get(segment.ID(), segment.Collection()).cacheMisses.Inc()
+}
+
+// Stats returns the search statistics of the segments on this node.
+func (c *SegmentStatsCollector) Stats() []metricsinfo.SegmentSearchStats {
+	nodeID := paramtable.GetNodeID()
+	ret := make([]metricsinfo.SegmentSearchStats, 0, c.stats.Len())
+	c.stats.Range(func(segmentID int64, stats *segmentSearchStats) bool {
+		if c.manager.Segment.Get(segmentID) == nil {
+			c.stats.Remove(segmentID)
+			return true
+		}
+		hits := stats.hits.Load()
+		stat := metricsinfo.SegmentSearchStats{
+			SegmentID:     segmentID,
+			CollectionID:  stats.collectionID,
+			NodeID:        nodeID,
+			Hits:          hits,
+			RowsScanned:   stats.rowsScanned.Load(),
+			CacheHitRatio: 1,
+		}
+		if hits > 0 {
+			stat.AvgLatencyMs = float64(stats.latency.Load()) / float64(hits) / float64(time.Millisecond)
+			misses := stats.cacheMisses.Load()
+			if misses > hits {
+				misses = hits
+			}
+			stat.CacheHitRatio = float64(hits-misses) / float64(hits)
+		}
+		ret = append(ret, stat)
+		return true
+	})
+	return ret
+}
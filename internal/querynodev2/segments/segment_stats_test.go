@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type SegmentStatsCollectorSuite struct {
+	suite.Suite
+
+	segmentManager *MockSegmentManager
+	collector      *SegmentStatsCollector
+}
+
+func (suite *SegmentStatsCollectorSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *SegmentStatsCollectorSuite) SetupTest() {
+	suite.segmentManager = NewMockSegmentManager(suite.T())
+	suite.collector = NewSegmentStatsCollector(&Manager{Segment: suite.segmentManager})
+}
+
+func (suite *SegmentStatsCollectorSuite) newSegment(segmentID int64, rows int64) *MockSegment {
+	segment := NewMockSegment(suite.T())
+	segment.EXPECT().ID().Return(segmentID).Maybe()
+	segment.EXPECT().Collection().Return(100).Maybe()
+	segment.EXPECT().InsertCount().Return(rows).Maybe()
+	return segment
+}
+
+func (suite *SegmentStatsCollectorSuite) TestStats() {
+	segment := suite.newSegment(1, 1000)
+	suite.collector.RecordCacheMiss(segment)
+	suite.collector.RecordSearch(segment, 10*time.Millisecond)
+	suite.collector.RecordSearch(segment, 20*time.Millisecond)
+	suite.collector.RecordSearch(segment, 30*time.Millisecond)
+	suite.collector.RecordSearch(segment, 40*time.Millisecond)
+
+	suite.segmentManager.EXPECT().Get(int64(1)).Return(segment).Once()
+	stats := suite.collector.Stats()
+	suite.Require().Len(stats, 1)
+	suite.EqualValues(1, stats[0].SegmentID)
+	suite.EqualValues(100, stats[0].CollectionID)
+	suite.EqualValues(paramtable.GetNodeID(), stats[0].NodeID)
+	suite.EqualValues(4, stats[0].Hits)
+	suite.EqualValues(4000, stats[0].RowsScanned)
+	suite.InDelta(25, stats[0].AvgLatencyMs, 1e-9)
+	suite.InDelta(0.75, stats[0].CacheHitRatio, 1e-9)
+
+	// the stats of released segment are dropped
+	suite.segmentManager.EXPECT().Get(int64(1)).Return(nil).Once()
+	suite.Empty(suite.collector.Stats())
+	suite.Equal(0, suite.collector.stats.Len())
+}
+
+func (suite *SegmentStatsCollectorSuite) TestNoSearch() {
+	segment := suite.newSegment(2, 1000)
+	suite.collector.RecordCacheMiss(segment)
+
+	suite.segmentManager.EXPECT().Get(int64(2)).Return(segment).Once()
+	stats := suite.collector.Stats()
+	suite.Require().Len(stats, 1)
+	suite.EqualValues(0, stats[0].Hits)
+	suite.Zero(stats[0].AvgLatencyMs)
+	suite.EqualValues(1, stats[0].CacheHitRatio)
+}
+
+func TestSegmentStatsCollector(t *testing.T) {
+	suite.Run(t, new(SegmentStatsCollectorSuite))
+}
@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// Tiering evicts the in memory sealed segments which are not accessed for a while (hot -> warm),
+// the evicted segments turn into lazy load segments,
+// whose data are loaded into the local disk cache (mmap) again on access (warm -> hot).
+type Tiering struct {
+	manager *Manager
+	// lastAccess records the last access time of the sealed segments
+	lastAccess *typeutil.ConcurrentMap[int64, time.Time]
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func NewTiering(manager *Manager) *Tiering {
+	return &Tiering{
+		manager:    manager,
+		lastAccess: typeutil.NewConcurrentMap[int64, time.Time](),
+		closeCh:    make(chan struct{}),
+	}
+}
+
+func (t *Tiering) Start() {
+	t.wg.Add(1)
+	go t.schedule()
+}
+
+func (t *Tiering) Stop() {
+	t.closeOnce.Do(func() {
+		close(t.closeCh)
+		t.wg.Wait()
+	})
+}
+
+// Touch records the access of the segment.
+func (t *Tiering) Touch(segmentID int64) {
+	t.lastAccess.Insert(segmentID, time.Now())
+}
+
+func (t *Tiering) schedule() {
+	defer t.wg.Done()
+
+	ticker := time.NewTicker(paramtable.Get().QueryNodeCfg.TieringCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.closeCh:
+			log.Info("tiering stopped")
+			return
+		case <-ticker.C:
+			t.evictIdleSegments()
+		}
+	}
+}
+
+// evictIdleSegments evicts the in memory sealed segments which are idle longer than the timeout of their collection.
+func (t *Tiering) evictIdleSegments() {
+	now := time.Now()
+	loaded := typeutil.NewSet[int64]()
+	for _, segment := range t.manager.Segment.GetBy(WithType(SegmentTypeSealed)) {
+		loaded.Insert(segment.ID())
+		// the idle time of segment starts from it's found
+		lastAccess, _ := t.lastAccess.GetOrInsert(segment.ID(), now)
+		if segment.Level() == datapb.SegmentLevel_L0 || segment.IsLazyLoad() || segment.LoadStatus() != LoadStatusInMemory {
+			continue
+		}
+
+		enabled, idleTimeout := t.policy(segment.Collection())
+		if !enabled || now.Sub(lastAccess) < idleTimeout {
+			continue
+		}
+		t.evict(segment)
+	}
+
+	// clean up the released segments
+	t.lastAccess.Range(func(segmentID int64, _ time.Time) bool {
+		if !loaded.Contain(segmentID) {
+			t.lastAccess.Remove(segmentID)
+		}
+		return true
+	})
+}
+
+// policy returns whether tiering is enabled and the idle timeout for the collection,
+// the collection properties take precedence over the node config.
+func (t *Tiering) policy(collectionID int64) (bool, time.Duration) {
+	params := paramtable.Get()
+	enabled := params.QueryNodeCfg.TieringEnabled.GetAsBool()
+	idleTimeout := params.QueryNodeCfg.TieringIdleTimeout.GetAsDuration(time.Second)
+
+	collection := t.manager.Collection.Get(collectionID)
+	if collection == nil {
+		return false, idleTimeout
+	}
+	props := collection.Schema().GetProperties()
+	if value, ok := common.GetCollectionTieringEnabled(props...); ok {
+		enabled = value
+	}
+	if value, ok := common.GetCollectionTieringIdleTimeout(props...); ok {
+		idleTimeout = time.Duration(value) * time.Second
+	}
+	return enabled, idleTimeout
+}
+
+func (t *Tiering) evict(segment Segment) {
+	local, ok := segment.(*LocalSegment)
+	if !ok {
+		return
+	}
+
+	usage := local.ResourceUsageEstimate()
+	if !local.evictToDiskCache() {
+		return
+	}
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	collectionID := fmt.Sprint(segment.Collection())
+	metrics.QueryNodeTieringEvictTotal.WithLabelValues(nodeID, collectionID).Inc()
+	metrics.QueryNodeTieringEvictBytes.WithLabelValues(nodeID, collectionID).Add(float64(usage.MemorySize))
+	log.Info("evict idle segment to disk cache",
+		zap.Int64("collectionID", segment.Collection()),
+		zap.Int64("segmentID", segment.ID()),
+		zap.Uint64("memorySize", usage.MemorySize),
+	)
+}
@@ -0,0 +1,169 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type TieringSuite struct {
+	suite.Suite
+
+	collectionID int64
+	segmentID    int64
+	manager      *Manager
+	tiering      *Tiering
+}
+
+func (suite *TieringSuite) SetupSuite() {
+	paramtable.Init()
+	suite.collectionID = 100
+	suite.segmentID = 1
+}
+
+func (suite *TieringSuite) SetupTest() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.TieringEnabled.Key, "true")
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.TieringIdleTimeout.Key, "60")
+
+	suite.manager = NewManager()
+	suite.tiering = suite.manager.Tiering
+	suite.putSegment()
+}
+
+func (suite *TieringSuite) TearDownTest() {
+	paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.TieringEnabled.Key)
+	paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.TieringIdleTimeout.Key)
+	suite.manager.Segment.Clear()
+}
+
+func (suite *TieringSuite) putSegment(props ...*commonpb.KeyValuePair) {
+	schema := GenTestCollectionSchema("test-tiering", schemapb.DataType_Int64, true)
+	schema.Properties = props
+	suite.manager.Collection.PutOrRef(suite.collectionID,
+		schema,
+		GenTestIndexMeta(suite.collectionID, schema),
+		&querypb.LoadMetaInfo{
+			LoadType:     querypb.LoadType_LoadCollection,
+			CollectionID: suite.collectionID,
+		},
+	)
+
+	segment, err := NewSegment(context.Background(),
+		suite.manager.Collection.Get(suite.collectionID),
+		SegmentTypeSealed,
+		0,
+		&querypb.SegmentLoadInfo{
+			CollectionID:  suite.collectionID,
+			SegmentID:     suite.segmentID,
+			InsertChannel: "dml",
+			Level:         datapb.SegmentLevel_Legacy,
+		},
+	)
+	suite.Require().NoError(err)
+	suite.manager.Segment.Put(SegmentTypeSealed, segment)
+}
+
+func (suite *TieringSuite) idle(duration time.Duration) {
+	suite.tiering.lastAccess.Insert(suite.segmentID, time.Now().Add(-duration))
+}
+
+func (suite *TieringSuite) TestEvictIdleSegments() {
+	segment := suite.manager.Segment.GetSealed(suite.segmentID)
+
+	// the idle time starts from the segment found
+	suite.tiering.evictIdleSegments()
+	suite.False(segment.IsLazyLoad())
+	suite.Equal(LoadStatusInMemory, segment.LoadStatus())
+
+	suite.idle(time.Minute + time.Second)
+	suite.tiering.Touch(suite.segmentID)
+	suite.tiering.evictIdleSegments()
+	suite.False(segment.IsLazyLoad())
+
+	suite.idle(time.Minute + time.Second)
+	suite.tiering.evictIdleSegments()
+	suite.True(segment.IsLazyLoad())
+	suite.Equal(LoadStatusMeta, segment.LoadStatus())
+}
+
+func (suite *TieringSuite) TestDisabled() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.TieringEnabled.Key, "false")
+	segment := suite.manager.Segment.GetSealed(suite.segmentID)
+
+	suite.idle(time.Hour)
+	suite.tiering.evictIdleSegments()
+	suite.False(segment.IsLazyLoad())
+}
+
+func (suite *TieringSuite) TestCollectionPolicy() {
+	suite.manager.Segment.Clear()
+	suite.manager.Collection.Unref(suite.collectionID, 1)
+	suite.putSegment(
+		&commonpb.KeyValuePair{Key: common.TieringEnableKey, Value: "false"},
+	)
+	enabled, _ := suite.tiering.policy(suite.collectionID)
+	suite.False(enabled)
+
+	suite.manager.Segment.Clear()
+	suite.manager.Collection.Unref(suite.collectionID, 1)
+	suite.putSegment(
+		&commonpb.KeyValuePair{Key: common.TieringIdleTimeoutKey, Value: "3600"},
+	)
+	enabled, idleTimeout := suite.tiering.policy(suite.collectionID)
+	suite.True(enabled)
+	suite.Equal(time.Hour, idleTimeout)
+
+	segment := suite.manager.Segment.GetSealed(suite.segmentID)
+	suite.idle(time.Minute + time.Second)
+	suite.tiering.evictIdleSegments()
+	suite.False(segment.IsLazyLoad())
+
+	// collection not found
+	enabled, _ = suite.tiering.policy(suite.collectionID + 1)
+	suite.False(enabled)
+}
+
+func (suite *TieringSuite) TestCleanReleasedSegments() {
+	suite.tiering.evictIdleSegments()
+	suite.True(suite.tiering.lastAccess.Contain(suite.segmentID))
+
+	suite.manager.Segment.Remove(suite.segmentID, querypb.DataScope_All)
+	suite.tiering.evictIdleSegments()
+	suite.False(suite.tiering.lastAccess.Contain(suite.segmentID))
+}
+
+func (suite *TieringSuite) TestStartStop() {
+	suite.tiering.Start()
+	suite.tiering.Stop()
+	// stop twice
+	suite.tiering.Stop()
+}
+
+func TestTiering(t *testing.T) {
+	suite.Run(t, new(TieringSuite))
+}
@@ -0,0 +1,143 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const warmupBlockSize = 1024 * 1024
+
+// warmupSegment touches the local files of the loaded segment before it turns serviceable,
+// which are the disk index files and the mmap files of field data,
+// so that the pages are in the page cache and the first search/query doesn't suffer from the disk reads.
+// The warmup is best effort, failures are logged only.
+func warmupSegment(ctx context.Context, segment Segment, loadInfo *querypb.SegmentLoadInfo) {
+	params := paramtable.Get()
+	if !params.QueryNodeCfg.SegmentWarmupEnabled.GetAsBool() {
+		return
+	}
+	ratio := params.QueryNodeCfg.SegmentWarmupRatio.GetAsFloat() / 100
+	if ratio <= 0 {
+		return
+	}
+	if ratio > 1 {
+		ratio = 1
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", segment.Collection()),
+		zap.Int64("segmentID", segment.ID()),
+		zap.Float64("ratio", ratio),
+	)
+	tr := timerecord.NewTimeRecorder("warmupSegment")
+
+	dirs := make([]string, 0, len(loadInfo.GetIndexInfos())+1)
+	localIndexRoot := filepath.Join(params.LocalStorageCfg.Path.GetValue(), typeutil.QueryNodeRole, common.SegmentIndexPath)
+	for _, indexInfo := range loadInfo.GetIndexInfos() {
+		dirs = append(dirs, filepath.Join(localIndexRoot, fmt.Sprint(indexInfo.GetBuildID()), fmt.Sprint(indexInfo.GetIndexVersion())))
+	}
+	if mmapDir := params.QueryNodeCfg.MmapDirPath.GetValue(); mmapDir != "" {
+		dirs = append(dirs, filepath.Join(mmapDir, fmt.Sprint(segment.ID())))
+	}
+
+	var touched int64
+	for _, dir := range dirs {
+		n, err := warmupDir(ctx, dir, ratio)
+		touched += n
+		if err != nil {
+			log.Warn("failed to warm up segment files", zap.String("dir", dir), zap.Error(err))
+			return
+		}
+	}
+
+	metrics.QueryNodeSegmentWarmupLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	log.Info("warm up segment done", zap.Int64("touchedBytes", touched), zap.Duration("duration", tr.ElapseSpan()))
+}
+
+// warmupDir reads the leading ratio of all the files under the dir, returns the bytes read,
+// the dir not existing is ignored.
+func warmupDir(ctx context.Context, dir string, ratio float64) (int64, error) {
+	var touched int64
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		n, err := warmupFile(ctx, path, ratio)
+		touched += n
+		return err
+	})
+	return touched, err
+}
+
+func warmupFile(ctx context.Context, path string, ratio float64) (int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	limit := int64(float64(info.Size()) * ratio)
+	buf := make([]byte, warmupBlockSize)
+	var touched int64
+	for touched < limit {
+		if err := ctx.Err(); err != nil {
+			return touched, err
+		}
+		size := int64(len(buf))
+		if limit-touched < size {
+			size = limit - touched
+		}
+		n, err := file.Read(buf[:size])
+		touched += int64(n)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return touched, err
+		}
+	}
+	return touched, nil
+}
@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WarmupSuite struct {
+	suite.Suite
+
+	dir string
+}
+
+func (suite *WarmupSuite) SetupTest() {
+	suite.dir = suite.T().TempDir()
+	suite.Require().NoError(os.MkdirAll(filepath.Join(suite.dir, "sub"), 0o755))
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.dir, "a"), make([]byte, 3*warmupBlockSize), 0o600))
+	suite.Require().NoError(os.WriteFile(filepath.Join(suite.dir, "sub", "b"), make([]byte, 1000), 0o600))
+}
+
+func (suite *WarmupSuite) TestWarmupDir() {
+	touched, err := warmupDir(context.Background(), suite.dir, 1)
+	suite.NoError(err)
+	suite.EqualValues(3*warmupBlockSize+1000, touched)
+
+	touched, err = warmupDir(context.Background(), suite.dir, 0.5)
+	suite.NoError(err)
+	suite.EqualValues(3*warmupBlockSize/2+500, touched)
+}
+
+func (suite *WarmupSuite) TestNotExist() {
+	touched, err := warmupDir(context.Background(), filepath.Join(suite.dir, "not_exist"), 1)
+	suite.NoError(err)
+	suite.EqualValues(0, touched)
+}
+
+func (suite *WarmupSuite) TestCanceled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := warmupDir(ctx, suite.dir, 1)
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func TestWarmup(t *testing.T) {
+	suite.Run(t, new(WarmupSuite))
+}
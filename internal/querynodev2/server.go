@@ -42,6 +42,7 @@ import (
 
 	"github.com/samber/lo"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -90,6 +91,9 @@ type QueryNode struct {
 	cancel context.CancelFunc
 
 	lifetime lifetime.Lifetime[commonpb.StateCode]
+	// the cancel functions of the in-flight requests, which are canceled once draining the requests timed out
+	requestCancels *typeutil.ConcurrentMap[int64, context.CancelFunc]
+	requestSeq     atomic.Int64
 
 	// call once
 	initOnce  sync.Once
@@ -140,6 +144,8 @@ func NewQueryNode(ctx context.Context, factory dependency.Factory) *QueryNode {
 		cancel:   cancel,
 		factory:  factory,
 		lifetime: lifetime.NewLifetime(commonpb.StateCode_Abnormal),
+
+		requestCancels: typeutil.NewConcurrentMap[int64, context.CancelFunc](),
 	}
 
 	node.tSafeManager = tsafe.NewTSafeReplica()
@@ -189,6 +195,12 @@ func (node *QueryNode) InitSegcore() error {
 	enableGrowingIndex := C.bool(paramtable.Get().QueryNodeCfg.EnableTempSegmentIndex.GetAsBool())
 	C.SegcoreSetEnableTempSegmentIndex(enableGrowingIndex)
 
+	asyncBuildGrowingIndex := C.bool(paramtable.Get().QueryNodeCfg.InterimIndexAsyncBuild.GetAsBool())
+	C.SegcoreSetEnableInterimIndexAsyncBuild(asyncBuildGrowingIndex)
+
+	exprResCacheSize := C.int64_t(paramtable.Get().QueryNodeCfg.ExprResCacheSize.GetAsInt64())
+	C.SegcoreSetExprResCacheSize(exprResCacheSize)
+
 	nlist := C.int64_t(paramtable.Get().QueryNodeCfg.InterimIndexNlist.GetAsInt64())
 	C.SegcoreSetNlist(nlist)
 
@@ -237,6 +249,10 @@ func (node *QueryNode) InitSegcore() error {
 	if err != nil {
 		return err
 	}
+	err = initcore.InitReadCache(paramtable.Get())
+	if err != nil {
+		return err
+	}
 
 	mmapDirPath := paramtable.Get().QueryNodeCfg.MmapDirPath.GetValue()
 	if len(mmapDirPath) == 0 {
@@ -390,6 +406,9 @@ func (node *QueryNode) Init() error {
 func (node *QueryNode) Start() error {
 	node.startOnce.Do(func() {
 		node.scheduler.Start()
+		node.manager.Tiering.Start()
+		node.manager.InterimIndex.Start()
+		node.manager.DiskCacheManager.Start()
 
 		paramtable.SetCreateTime(time.Now())
 		paramtable.SetUpdateTime(time.Now())
@@ -468,8 +487,9 @@ func (node *QueryNode) Stop() error {
 			metrics.StoppingBalanceChannelNum.WithLabelValues(fmt.Sprint(node.GetNodeID())).Set(0)
 		}
 
+		// the segments and channels have been handed over, reject the new requests and drain the in-flight ones
 		node.UpdateStateCode(commonpb.StateCode_Abnormal)
-		node.lifetime.Wait()
+		node.drainRequests()
 		if node.scheduler != nil {
 			node.scheduler.Stop()
 		}
@@ -484,6 +504,9 @@ func (node *QueryNode) Stop() error {
 			node.dispClient.Close()
 		}
 		if node.manager != nil {
+			node.manager.Tiering.Stop()
+			node.manager.InterimIndex.Stop()
+			node.manager.DiskCacheManager.Stop()
 			node.manager.Segment.Clear()
 		}
 
@@ -495,6 +518,46 @@ func (node *QueryNode) Stop() error {
 	return nil
 }
 
+// drainRequests waits for the in-flight requests done. The requests are canceled once the drain timeout exceeded,
+// and still waited for, so that the segments are never released while they are accessed by the requests.
+func (node *QueryNode) drainRequests() {
+	timeout := paramtable.Get().QueryNodeCfg.QueryDrainTimeout.GetAsDuration(time.Second)
+	if timeout <= 0 {
+		node.lifetime.Wait()
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		node.lifetime.Wait()
+	}()
+	select {
+	case <-done:
+		log.Info("query node drained in-flight requests")
+	case <-time.After(timeout):
+		log.Warn("drain in-flight requests timed out, cancel them", zap.Duration("timeout", timeout))
+		node.requestCancels.Range(func(_ int64, cancel context.CancelFunc) bool {
+			cancel()
+			return true
+		})
+		<-done
+		log.Info("query node drained canceled in-flight requests")
+	}
+}
+
+// trackRequest derives the context of the in-flight request, which is canceled once draining the requests timed out.
+// The returned function must be called once the request is done.
+func (node *QueryNode) trackRequest(ctx context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	id := node.requestSeq.Inc()
+	node.requestCancels.Insert(id, cancel)
+	return ctx, func() {
+		node.requestCancels.Remove(id)
+		cancel()
+	}
+}
+
 // UpdateStateCode updata the state of query node, which can be initializing, healthy, and abnormal
 func (node *QueryNode) UpdateStateCode(code commonpb.StateCode) {
 	node.lifetime.SetState(code)
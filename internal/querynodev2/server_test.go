@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -242,6 +243,41 @@ func (suite *QueryNodeSuite) TestStop() {
 	suite.True(suite.node.manager.Segment.Empty())
 }
 
+func (suite *QueryNodeSuite) TestStopDrainRequests() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.GracefulStopTimeout.Key, "2")
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.QueryDrainTimeout.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.QueryDrainTimeout.Key)
+
+	suite.node.manager = segments.NewManager()
+	suite.node.UpdateStateCode(commonpb.StateCode_Healthy)
+	// an in-flight request never done until canceled
+	suite.NoError(suite.node.lifetime.Add(merr.IsHealthy))
+	ctx, untrack := suite.node.trackRequest(context.Background())
+	returned := make(chan struct{})
+	go func() {
+		defer suite.node.lifetime.Done()
+		defer untrack()
+		<-ctx.Done()
+		// the request takes a while to return after canceled
+		time.Sleep(100 * time.Millisecond)
+		close(returned)
+	}()
+
+	start := time.Now()
+	suite.NoError(suite.node.Stop())
+	suite.Less(time.Since(start), 10*time.Second)
+	// the request is canceled, and the node stopped after the request returned
+	suite.ErrorIs(ctx.Err(), context.Canceled)
+	select {
+	case <-returned:
+	default:
+		suite.Fail("the node stopped before the in-flight request returned")
+	}
+	suite.Equal(0, suite.node.requestCancels.Len())
+	// new requests are rejected
+	suite.Error(suite.node.lifetime.Add(merr.IsHealthy))
+}
+
 func TestQueryNode(t *testing.T) {
 	suite.Run(t, new(QueryNodeSuite))
 }
@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"sync"
 
+	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/trace"
@@ -51,6 +52,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -112,6 +114,8 @@ func (node *QueryNode) GetStatistics(ctx context.Context, req *querypb.GetStatis
 		}, nil
 	}
 	defer node.lifetime.Done()
+	ctx, untrack := node.trackRequest(ctx)
+	defer untrack()
 
 	failRet := &internalpb.GetStatisticsResponse{
 		Status: merr.Success(),
@@ -261,9 +265,11 @@ func (node *QueryNode) WatchDmChannels(ctx context.Context, req *querypb.WatchDm
 		return merr.Status(err), nil
 	}
 	node.delegators.Insert(channel.GetChannelName(), delegator)
+	collector.Rate.Register(collector.ChannelQPSLabel(channel.GetChannelName()))
 	defer func() {
 		if err != nil {
 			node.delegators.GetAndRemove(channel.GetChannelName())
+			collector.Rate.Deregister(collector.ChannelQPSLabel(channel.GetChannelName()))
 		}
 	}()
 
@@ -366,6 +372,7 @@ func (node *QueryNode) UnsubDmChannel(ctx context.Context, req *querypb.UnsubDmC
 	if ok {
 		// close the delegator first to block all coming query/search requests
 		delegator.Close()
+		collector.Rate.Deregister(collector.ChannelQPSLabel(req.GetChannelName()))
 
 		node.pipelineManager.Remove(req.GetChannelName())
 		node.manager.Segment.RemoveBy(segments.WithChannel(req.GetChannelName()), segments.WithType(segments.SegmentTypeGrowing))
@@ -656,6 +663,8 @@ func (node *QueryNode) SearchSegments(ctx context.Context, req *querypb.SearchRe
 		return resp, nil
 	}
 	defer node.lifetime.Done()
+	ctx, untrack := node.trackRequest(ctx)
+	defer untrack()
 
 	metrics.QueryNodeSQCount.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.SearchLabel, metrics.TotalLabel, metrics.FromLeader).Inc()
 	defer func() {
@@ -680,6 +689,11 @@ func (node *QueryNode) SearchSegments(ctx context.Context, req *querypb.SearchRe
 		resp.Status = merr.Status(err)
 		return resp, nil
 	}
+	if err := segments.LoadRequestedFields(searchCtx, node.manager, collection, req.GetSegmentIDs(), req.GetReq().GetOutputFieldsId(), req.GetReq().GetSerializedExprPlan()); err != nil {
+		log.Warn("failed to load requested fields", zap.Error(err))
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
 
 	task := tasks.NewSearchTask(searchCtx, collection, node.manager, req, node.serverID)
 	if err := node.scheduler.Add(task); err != nil {
@@ -737,6 +751,8 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 		}, nil
 	}
 	defer node.lifetime.Done()
+	ctx, untrack := node.trackRequest(ctx)
+	defer untrack()
 
 	resp := &internalpb.SearchResults{
 		Status: merr.Success(),
@@ -780,7 +796,7 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 	}
 
 	tr.RecordSpan()
-	result, err := segments.ReduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	result, err := segments.ReduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetGroupSize(), req.Req.GetMetricType())
 	if err != nil {
 		log.Warn("failed to reduce search results", zap.Error(err))
 		resp.Status = merr.Status(err)
@@ -822,6 +838,8 @@ func (node *QueryNode) HybridSearch(ctx context.Context, req *querypb.HybridSear
 		}, nil
 	}
 	defer node.lifetime.Done()
+	ctx, untrack := node.trackRequest(ctx)
+	defer untrack()
 
 	resp := &querypb.HybridSearchResult{
 		Base: &commonpb.MsgBase{
@@ -871,7 +889,7 @@ func (node *QueryNode) HybridSearch(ctx context.Context, req *querypb.HybridSear
 		for index, hs := range MultipleResults {
 			toReduceResults[index] = hs.Results[i]
 		}
-		result, err := segments.ReduceSearchResults(ctx, toReduceResults, searchReq.GetNq(), searchReq.GetTopk(), searchReq.GetMetricType())
+		result, err := segments.ReduceSearchResults(ctx, toReduceResults, searchReq.GetNq(), searchReq.GetTopk(), searchReq.GetGroupSize(), searchReq.GetMetricType())
 		if err != nil {
 			log.Warn("failed to reduce search results", zap.Error(err))
 			resp.Status = merr.Status(err)
@@ -918,6 +936,8 @@ func (node *QueryNode) QuerySegments(ctx context.Context, req *querypb.QueryRequ
 		return resp, nil
 	}
 	defer node.lifetime.Done()
+	ctx, untrack := node.trackRequest(ctx)
+	defer untrack()
 
 	metrics.QueryNodeSQCount.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.QueryLabel, metrics.TotalLabel, metrics.FromLeader).Inc()
 	defer func() {
@@ -940,6 +960,11 @@ func (node *QueryNode) QuerySegments(ctx context.Context, req *querypb.QueryRequ
 		resp.Status = merr.Status(merr.WrapErrCollectionNotLoaded(req.Req.GetCollectionID()))
 		return resp, nil
 	}
+	if err := segments.LoadRequestedFields(queryCtx, node.manager, collection, req.GetSegmentIDs(), req.GetReq().GetOutputFieldsId(), req.GetReq().GetSerializedExprPlan()); err != nil {
+		log.Warn("failed to load requested fields", zap.Error(err))
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
 
 	// Send task to scheduler and wait until it finished.
 	task := tasks.NewQueryTask(queryCtx, collection, node.manager, req)
@@ -999,6 +1024,8 @@ func (node *QueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*i
 		}, nil
 	}
 	defer node.lifetime.Done()
+	ctx, untrack := node.trackRequest(ctx)
+	defer untrack()
 
 	toMergeResults := make([]*internalpb.RetrieveResults, len(req.GetDmlChannels()))
 	runningGp, runningCtx := errgroup.WithContext(ctx)
@@ -1040,6 +1067,13 @@ func (node *QueryNode) Query(ctx context.Context, req *querypb.QueryRequest) (*i
 			Status: merr.Status(err),
 		}, nil
 	}
+	channelsMvcc := make(map[string]uint64)
+	for _, result := range toMergeResults {
+		for ch, ts := range result.GetChannelsMvcc() {
+			channelsMvcc[ch] = ts
+		}
+	}
+	ret.ChannelsMvcc = channelsMvcc
 	reduceLatency := tr.RecordSpan()
 	metrics.QueryNodeReduceLatency.WithLabelValues(fmt.Sprint(node.GetNodeID()), metrics.QueryLabel, metrics.ReduceShards).
 		Observe(float64(reduceLatency.Milliseconds()))
@@ -1076,6 +1110,8 @@ func (node *QueryNode) QueryStream(req *querypb.QueryRequest, srv querypb.QueryN
 		return nil
 	}
 	defer node.lifetime.Done()
+	ctx, untrack := node.trackRequest(ctx)
+	defer untrack()
 
 	runningGp, runningCtx := errgroup.WithContext(ctx)
 
@@ -1138,6 +1174,8 @@ func (node *QueryNode) QueryStreamSegments(req *querypb.QueryRequest, srv queryp
 		return nil
 	}
 	defer node.lifetime.Done()
+	ctx, untrack := node.trackRequest(ctx)
+	defer untrack()
 
 	log.Debug("start do query with channel",
 		zap.Bool("fromShardLeader", req.GetFromShardLeader()),
@@ -1167,6 +1205,51 @@ func (node *QueryNode) QueryStreamSegments(req *querypb.QueryRequest, srv queryp
 	return nil
 }
 
+// SubscribeStandingQuery streams the inserts matching the filter and the deletes of the channel,
+// until the client cancels, or the standing query is closed by the delegator.
+func (node *QueryNode) SubscribeStandingQuery(req *querypb.SubscribeStandingQueryRequest, srv querypb.QueryNode_SubscribeStandingQueryServer) error {
+	ctx := srv.Context()
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("channel", req.GetChannel()),
+	)
+
+	// the subscription is long-lived, don't hold the lifetime of node to block the graceful stop
+	if err := node.lifetime.Add(merr.IsHealthy); err != nil {
+		return srv.Send(&querypb.StandingQueryEvent{Status: merr.Status(err)})
+	}
+	shardDelegator, ok := node.delegators.Get(req.GetChannel())
+	node.lifetime.Done()
+	if !ok {
+		log.Warn("failed to subscribe standing query, the channel is not subscribed")
+		return srv.Send(&querypb.StandingQueryEvent{Status: merr.Status(merr.WrapErrChannelNotFound(req.GetChannel()))})
+	}
+
+	query, err := shardDelegator.SubscribeStandingQuery(ctx, req)
+	if err != nil {
+		log.Warn("failed to subscribe standing query", zap.Error(err))
+		return srv.Send(&querypb.StandingQueryEvent{Status: merr.Status(err)})
+	}
+	defer query.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-query.Done():
+			if err := query.Err(); err != nil {
+				return srv.Send(&querypb.StandingQueryEvent{Status: merr.Status(err)})
+			}
+			return nil
+		case event := <-query.Events():
+			if err := srv.Send(event); err != nil {
+				log.Warn("failed to send standing query event", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
 // SyncReplicaSegments syncs replica node & segments states
 func (node *QueryNode) SyncReplicaSegments(ctx context.Context, req *querypb.SyncReplicaSegmentsRequest) (*commonpb.Status, error) {
 	return merr.Success(), nil
@@ -1328,6 +1411,10 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 			numOfGrowingRows += segment.InsertCount()
 		}
 
+		qps, err := collector.Rate.Rate(collector.ChannelQPSLabel(key), ratelimitutil.DefaultAvgDuration)
+		if err != nil {
+			qps = 0
+		}
 		leaderViews = append(leaderViews, &querypb.LeaderView{
 			Collection:       delegator.Collection(),
 			Channel:          key,
@@ -1335,6 +1422,7 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 			GrowingSegments:  growingSegments,
 			TargetVersion:    delegator.GetTargetVersion(),
 			NumOfGrowingRows: numOfGrowingRows,
+			Qps:              qps,
 		})
 		return true
 	})
@@ -1438,6 +1526,73 @@ func (node *QueryNode) SyncDistribution(ctx context.Context, req *querypb.SyncDi
 	return merr.Success(), nil
 }
 
+// SwitchShardLeader takes over the shard leadership of the channel from the source node,
+// the sealed segment distribution of the old leader is synced into the delegator of this node,
+// QueryCoord shall route the requests to this node only after it succeeds.
+func (node *QueryNode) SwitchShardLeader(ctx context.Context, req *querypb.SwitchShardLeaderRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.String("channel", req.GetChannel()),
+		zap.Int64("sourceNodeID", req.GetSourceNodeID()),
+		zap.Int64("currentNodeID", node.GetNodeID()),
+	)
+	log.Info("received switch shard leader request", zap.Int("actionNum", len(req.GetActions())))
+
+	// check node healthy
+	if err := node.lifetime.Add(merr.IsHealthy); err != nil {
+		return merr.Status(err), nil
+	}
+	defer node.lifetime.Done()
+
+	shardDelegator, ok := node.delegators.Get(req.GetChannel())
+	if !ok {
+		err := merr.WrapErrChannelNotFound(req.GetChannel())
+		log.Warn("failed to switch shard leader, the channel is not subscribed")
+		return merr.Status(err), nil
+	}
+
+	for _, action := range req.GetActions() {
+		if action.GetType() != querypb.SyncType_Set {
+			err := merr.WrapErrParameterInvalid(querypb.SyncType_Set.String(), action.GetType().String(), "only set action is allowed")
+			return merr.Status(err), nil
+		}
+	}
+
+	status, err := node.SyncDistribution(ctx, &querypb.SyncDistributionRequest{
+		Base:          req.GetBase(),
+		CollectionID:  req.GetCollectionID(),
+		Channel:       req.GetChannel(),
+		ReplicaID:     req.GetReplicaID(),
+		Actions:       req.GetActions(),
+		Schema:        req.GetSchema(),
+		LoadMeta:      req.GetLoadMeta(),
+		IndexInfoList: req.GetIndexInfoList(),
+	})
+	if err := merr.CheckRPCCall(status, err); err != nil {
+		log.Warn("failed to sync distribution from the old leader", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	// make sure all the sealed segments are served before taking over
+	sealed, _ := shardDelegator.GetSegmentInfo(false)
+	served := typeutil.NewUniqueSet()
+	for _, item := range sealed {
+		for _, segment := range item.Segments {
+			served.Insert(segment.SegmentID)
+		}
+	}
+	for _, action := range req.GetActions() {
+		if action.GetInfo() != nil && !served.Contain(action.GetSegmentID()) {
+			err := merr.WrapErrSegmentLack(action.GetSegmentID(), "segment not served after switching shard leader")
+			log.Warn("failed to switch shard leader", zap.Error(err))
+			return merr.Status(err), nil
+		}
+	}
+
+	log.Info("switch shard leader done")
+	return merr.Success(), nil
+}
+
 // Delete is used to forward delete message between delegator and workers.
 func (node *QueryNode) Delete(ctx context.Context, req *querypb.DeleteRequest) (*commonpb.Status, error) {
 	log := log.Ctx(ctx).With(
@@ -1453,9 +1608,53 @@ func (node *QueryNode) Delete(ctx context.Context, req *querypb.DeleteRequest) (
 	}
 	defer node.lifetime.Done()
 
+	if req.GetBatch() != nil || len(req.GetCompressedBatch()) > 0 {
+		return node.deleteBatch(ctx, req), nil
+	}
+
 	log.Info("QueryNode received worker delete request")
 	log.Debug("Worker delete detail", zap.Stringer("info", &deleteRequestStringer{DeleteRequest: req}))
 
+	if err := node.delete(ctx, req); err != nil {
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
+
+// deleteBatch applies the deletes batched by delegator,
+// the deletes of the released segments are skipped.
+func (node *QueryNode) deleteBatch(ctx context.Context, req *querypb.DeleteRequest) *commonpb.Status {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionId()),
+		zap.String("channel", req.GetVchannelName()),
+	)
+
+	reqs, err := delegator.UnpackDeleteBatch(req)
+	if err != nil {
+		log.Warn("failed to unpack delete batch", zap.Error(err))
+		return merr.Status(err)
+	}
+	log.Info("QueryNode received worker delete batch", zap.Int("batchSize", len(reqs)))
+
+	for _, req := range reqs {
+		err := node.delete(ctx, req)
+		if errors.Is(err, merr.ErrSegmentNotFound) {
+			continue
+		} else if err != nil {
+			return merr.Status(err)
+		}
+	}
+	return merr.Success()
+}
+
+func (node *QueryNode) delete(ctx context.Context, req *querypb.DeleteRequest) error {
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", req.GetCollectionId()),
+		zap.String("channel", req.GetVchannelName()),
+		zap.Int64("segmentID", req.GetSegmentId()),
+		zap.String("scope", req.GetScope().String()),
+	)
+
 	filters := []segments.SegmentFilter{
 		segments.WithID(req.GetSegmentId()),
 	}
@@ -1470,9 +1669,8 @@ func (node *QueryNode) Delete(ctx context.Context, req *querypb.DeleteRequest) (
 
 	segments := node.manager.Segment.GetBy(filters...)
 	if len(segments) == 0 {
-		err := merr.WrapErrSegmentNotFound(req.GetSegmentId())
 		log.Warn("segment not found for delete")
-		return merr.Status(err), nil
+		return merr.WrapErrSegmentNotFound(req.GetSegmentId())
 	}
 
 	pks := storage.ParseIDs2PrimaryKeys(req.GetPrimaryKeys())
@@ -1480,11 +1678,10 @@ func (node *QueryNode) Delete(ctx context.Context, req *querypb.DeleteRequest) (
 		err := segment.Delete(ctx, pks, req.GetTimestamps())
 		if err != nil {
 			log.Warn("segment delete failed", zap.Error(err))
-			return merr.Status(err), nil
+			return err
 		}
 	}
-
-	return merr.Success(), nil
+	return nil
 }
 
 type deleteRequestStringer struct {
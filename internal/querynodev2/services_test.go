@@ -1438,6 +1438,7 @@ func (suite *ServiceSuite) TestQuery_Normal() {
 	rsp, err := suite.node.Query(ctx, req)
 	suite.NoError(err)
 	suite.Equal(commonpb.ErrorCode_Success, rsp.GetStatus().GetErrorCode())
+	suite.Contains(rsp.GetChannelsMvcc(), suite.vchannel)
 }
 
 func (suite *ServiceSuite) TestQuery_Failed() {
@@ -1961,6 +1962,77 @@ func (suite *ServiceSuite) TestSyncDistribution_Failed() {
 	suite.Equal(commonpb.ErrorCode_NotReadyServe, status.GetErrorCode())
 }
 
+func (suite *ServiceSuite) TestSwitchShardLeader() {
+	ctx := context.Background()
+	testChannel := "test_switch_shard_leader"
+	segmentID := suite.validSegmentIDs[0]
+	req := &querypb.SwitchShardLeaderRequest{
+		Base: &commonpb.MsgBase{
+			MsgID:    rand.Int63(),
+			TargetID: suite.node.session.ServerID,
+		},
+		CollectionID: suite.collectionID,
+		Channel:      testChannel,
+		SourceNodeID: 100,
+		Actions: []*querypb.SyncAction{
+			{
+				Type:        querypb.SyncType_Set,
+				SegmentID:   segmentID,
+				NodeID:      100,
+				PartitionID: suite.partitionIDs[0],
+				Info:        &querypb.SegmentLoadInfo{},
+				Version:     1,
+			},
+		},
+	}
+
+	suite.Run("channel_not_found", func() {
+		status, err := suite.node.SwitchShardLeader(ctx, req)
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(status), merr.ErrChannelNotFound)
+	})
+
+	mockDelegator := delegator.NewMockShardDelegator(suite.T())
+	suite.node.delegators.Insert(testChannel, mockDelegator)
+	defer suite.node.delegators.GetAndRemove(testChannel)
+
+	suite.Run("segment_lack", func() {
+		mockDelegator.EXPECT().LoadSegments(mock.Anything, mock.Anything).Return(nil).Once()
+		mockDelegator.EXPECT().GetSegmentInfo(false).Return([]delegator.SnapshotItem{}, nil).Once()
+
+		status, err := suite.node.SwitchShardLeader(ctx, req)
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(status), merr.ErrSegmentLack)
+	})
+
+	suite.Run("normal", func() {
+		mockDelegator.EXPECT().LoadSegments(mock.Anything, mock.Anything).Return(nil).Once()
+		mockDelegator.EXPECT().GetSegmentInfo(false).Return([]delegator.SnapshotItem{
+			{NodeID: 100, Segments: []delegator.SegmentEntry{{SegmentID: segmentID, NodeID: 100}}},
+		}, nil).Once()
+
+		status, err := suite.node.SwitchShardLeader(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_Success, status.GetErrorCode())
+	})
+
+	suite.Run("invalid_action", func() {
+		req := typeutil.Clone(req)
+		req.Actions[0].Type = querypb.SyncType_Remove
+		status, err := suite.node.SwitchShardLeader(ctx, req)
+		suite.NoError(err)
+		suite.ErrorIs(merr.Error(status), merr.ErrParameterInvalid)
+	})
+
+	suite.Run("node_not_healthy", func() {
+		suite.node.UpdateStateCode(commonpb.StateCode_Abnormal)
+		defer suite.node.UpdateStateCode(commonpb.StateCode_Healthy)
+		status, err := suite.node.SwitchShardLeader(ctx, req)
+		suite.NoError(err)
+		suite.Equal(commonpb.ErrorCode_NotReadyServe, status.GetErrorCode())
+	})
+}
+
 func (suite *ServiceSuite) TestDelete_Int64() {
 	ctx := context.Background()
 	// prepare
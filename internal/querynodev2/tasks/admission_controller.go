@@ -0,0 +1,117 @@
+package tasks
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const admissionCheckInterval = 100 * time.Millisecond
+
+// memoryUsageFunc returns the memory in use and the total memory in bytes.
+type memoryUsageFunc func() (used uint64, total uint64)
+
+// getMemoryUsage returns the memory in use including the working set of mmap page cache,
+// which is excluded from the used memory of process but still competes for the physical memory.
+func getMemoryUsage() (uint64, uint64) {
+	return hardware.GetUsedMemoryCount() + hardware.GetMappedMemoryCount(), hardware.GetMemoryCount()
+}
+
+// admissionController admits the read tasks by the memory headroom of query node.
+// Once the high watermark reached, the new tasks are queued until the memory usage drops below the low watermark,
+// and rejected with ErrServiceOverloaded if not admitted in the queue timeout, so that they could be retried on another replica.
+type admissionController struct {
+	usage    memoryUsageFunc
+	interval time.Duration
+
+	mu         sync.Mutex
+	sampledAt  time.Time
+	used       uint64
+	total      uint64
+	overloaded bool
+}
+
+func newAdmissionController(usage memoryUsageFunc) *admissionController {
+	return &admissionController{
+		usage:    usage,
+		interval: admissionCheckInterval,
+	}
+}
+
+// sample returns whether the node is overloaded, the memory usage is sampled at most once per check interval.
+func (c *admissionController) sample() (bool, uint64, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if time.Since(c.sampledAt) < c.interval {
+		return c.overloaded, c.used, c.total
+	}
+	c.used, c.total = c.usage()
+	c.sampledAt = time.Now()
+	if c.total == 0 {
+		return c.overloaded, c.used, c.total
+	}
+
+	params := paramtable.Get()
+	ratio := float64(c.used) / float64(c.total)
+	metrics.QueryNodeAdmissionMemoryRatio.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(ratio)
+	if ratio >= params.QueryNodeCfg.AdmissionHighWatermark.GetAsFloat() {
+		if !c.overloaded {
+			log.Warn("memory high watermark reached, queue the new read tasks", zap.Float64("memoryRatio", ratio))
+		}
+		c.overloaded = true
+	} else if c.overloaded && ratio < params.QueryNodeCfg.AdmissionLowWatermark.GetAsFloat() {
+		log.Info("memory usage dropped below low watermark, admit the read tasks", zap.Float64("memoryRatio", ratio))
+		c.overloaded = false
+	}
+	return c.overloaded, c.used, c.total
+}
+
+// Admit blocks until the task is admitted, returns error if the task is canceled
+// or the memory headroom isn't recovered in the queue timeout.
+func (c *admissionController) Admit(task Task) error {
+	params := paramtable.Get()
+	if !params.QueryNodeCfg.AdmissionEnabled.GetAsBool() {
+		return nil
+	}
+	overloaded, used, total := c.sample()
+	if !overloaded {
+		return nil
+	}
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	if timeout := params.QueryNodeCfg.AdmissionQueueTimeout.GetAsDuration(time.Millisecond); timeout > 0 {
+		metrics.QueryNodeAdmissionQueuedTotal.WithLabelValues(nodeID).Inc()
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+	wait:
+		for {
+			select {
+			case <-timer.C:
+				break wait
+			case <-ticker.C:
+				if err := task.Canceled(); err != nil {
+					return err
+				}
+				overloaded, used, total = c.sample()
+				if !overloaded {
+					return nil
+				}
+			}
+		}
+	}
+
+	metrics.QueryNodeAdmissionRejectedTotal.WithLabelValues(nodeID).Inc()
+	limit := int64(float64(total) * params.QueryNodeCfg.AdmissionHighWatermark.GetAsFloat())
+	return merr.WrapErrServiceOverloaded("memory", int64(used), limit, "read task rejected by admission control")
+}
@@ -0,0 +1,102 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type AdmissionControllerSuite struct {
+	suite.Suite
+
+	used       atomic.Uint64
+	controller *admissionController
+}
+
+func (s *AdmissionControllerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *AdmissionControllerSuite) SetupTest() {
+	params := paramtable.Get()
+	params.Save(params.QueryNodeCfg.AdmissionEnabled.Key, "true")
+	params.Save(params.QueryNodeCfg.AdmissionQueueTimeout.Key, "100")
+
+	s.used.Store(0)
+	s.controller = newAdmissionController(func() (uint64, uint64) {
+		return s.used.Load(), 100
+	})
+	s.controller.interval = time.Millisecond
+}
+
+func (s *AdmissionControllerSuite) TearDownTest() {
+	params := paramtable.Get()
+	params.Reset(params.QueryNodeCfg.AdmissionEnabled.Key)
+	params.Reset(params.QueryNodeCfg.AdmissionQueueTimeout.Key)
+}
+
+// setUsed sets the memory in use, which is sampled on next admission
+func (s *AdmissionControllerSuite) setUsed(used uint64) {
+	s.used.Store(used)
+	s.controller.mu.Lock()
+	s.controller.sampledAt = time.Time{}
+	s.controller.mu.Unlock()
+}
+
+func (s *AdmissionControllerSuite) newTask(ctx context.Context) Task {
+	return newMockTask(mockTaskConfig{ctx: ctx})
+}
+
+func (s *AdmissionControllerSuite) TestAdmit() {
+	s.setUsed(50)
+	s.NoError(s.controller.Admit(s.newTask(context.Background())))
+
+	// rejected after queue timeout
+	s.setUsed(95)
+	err := s.controller.Admit(s.newTask(context.Background()))
+	s.ErrorIs(err, merr.ErrServiceOverloaded)
+
+	// still overloaded above low watermark
+	s.setUsed(88)
+	err = s.controller.Admit(s.newTask(context.Background()))
+	s.ErrorIs(err, merr.ErrServiceOverloaded)
+
+	// admitted once dropped below low watermark during queueing
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		s.setUsed(80)
+	}()
+	s.NoError(s.controller.Admit(s.newTask(context.Background())))
+}
+
+func (s *AdmissionControllerSuite) TestRejectImmediately() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.AdmissionQueueTimeout.Key, "0")
+	s.setUsed(95)
+	err := s.controller.Admit(s.newTask(context.Background()))
+	s.ErrorIs(err, merr.ErrServiceOverloaded)
+}
+
+func (s *AdmissionControllerSuite) TestCanceled() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.AdmissionQueueTimeout.Key, "10000")
+	s.setUsed(95)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := s.controller.Admit(s.newTask(ctx))
+	s.ErrorIs(err, context.Canceled)
+}
+
+func (s *AdmissionControllerSuite) TestDisabled() {
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.AdmissionEnabled.Key, "false")
+	s.setUsed(100)
+	s.NoError(s.controller.Admit(s.newTask(context.Background())))
+}
+
+func TestAdmissionController(t *testing.T) {
+	suite.Run(t, new(AdmissionControllerSuite))
+}
@@ -33,6 +33,7 @@ func newScheduler(policy schedulePolicy) Scheduler {
 		gpuPool:          conc.NewPool[any](paramtable.Get().QueryNodeCfg.MaxGpuReadConcurrency.GetAsInt(), conc.WithPreAlloc(true)),
 		schedulerCounter: schedulerCounter{},
 		lifetime:         lifetime.NewLifetime(lifetime.Initializing),
+		admission:        newAdmissionController(getMemoryUsage),
 	}
 }
 
@@ -53,6 +54,8 @@ type scheduler struct {
 	wg sync.WaitGroup
 	// lifetime controls scheduler State & make sure all requests accepted will be processed
 	lifetime lifetime.Lifetime[lifetime.State]
+	// admission queues or rejects the new tasks if the memory headroom is insufficient
+	admission *admissionController
 
 	schedulerCounter
 }
@@ -65,6 +68,10 @@ func (s *scheduler) Add(task Task) (err error) {
 	}
 	defer s.lifetime.Done()
 
+	if err := s.admission.Admit(task); err != nil {
+		return err
+	}
+
 	errCh := make(chan error, 1)
 
 	// TODO: add operation should be fast, is UnsolveLen metric unnesscery?
@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 )
 
@@ -18,6 +19,7 @@ type mockTaskConfig struct {
 	mergeAble   bool
 	nq          int64
 	username    string
+	priority    internalpb.ReadPriority
 	executeCost time.Duration
 	execution   func(ctx context.Context) error
 }
@@ -39,6 +41,7 @@ func newMockTask(c mockTaskConfig) Task {
 		mergeAble:   c.mergeAble,
 		nq:          c.nq,
 		username:    c.username,
+		priority:    c.priority,
 		execution:   c.execution,
 		tr:          timerecord.NewTimeRecorderWithTrace(c.ctx, "searchTask"),
 	}
@@ -51,6 +54,7 @@ type MockTask struct {
 	mergeAble   bool
 	nq          int64
 	username    string
+	priority    internalpb.ReadPriority
 	execution   func(ctx context.Context) error
 	tr          *timerecord.TimeRecorder
 }
@@ -68,6 +72,10 @@ func (t *MockTask) IsGpuIndex() bool {
 	return false
 }
 
+func (t *MockTask) Priority() internalpb.ReadPriority {
+	return t.priority
+}
+
 func (t *MockTask) TimeRecorder() *timerecord.TimeRecorder {
 	return t.tr
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -20,6 +21,48 @@ func TestFIFOPolicy(t *testing.T) {
 	testCommonPolicyOperation(t, newFIFOPolicy())
 }
 
+func TestPriorityLanePolicy(t *testing.T) {
+	paramtable.Init()
+	testCommonPolicyOperation(t, newPriorityLanePolicy(newFIFOPolicy))
+	testPriorityLane(t, newPriorityLanePolicy(newFIFOPolicy))
+}
+
+func testPriorityLane(t *testing.T, policy schedulePolicy) {
+	weight := paramtable.Get().QueryNodeCfg.PriorityLaneInteractiveWeight.GetAsInt()
+	for i := 0; i < weight; i++ {
+		policy.Push(newMockTask(mockTaskConfig{priority: internalpb.ReadPriority_Batch}))
+	}
+	for i := 0; i < weight*2; i++ {
+		policy.Push(newMockTask(mockTaskConfig{priority: internalpb.ReadPriority_Interactive}))
+	}
+	assert.Equal(t, weight*3, policy.Len())
+
+	// a batch task is scheduled after every weight interactive tasks
+	for round := 0; round < 2; round++ {
+		for i := 0; i < weight; i++ {
+			assert.Equal(t, internalpb.ReadPriority_Interactive, policy.Pop().Priority())
+		}
+		assert.Equal(t, internalpb.ReadPriority_Batch, policy.Pop().Priority())
+	}
+	// batch tasks are scheduled once no interactive task pending
+	for i := 0; i < weight-2; i++ {
+		assert.Equal(t, internalpb.ReadPriority_Batch, policy.Pop().Priority())
+	}
+	assert.Nil(t, policy.Pop())
+
+	// strict priority
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.PriorityLaneInteractiveWeight.Key, "0")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.PriorityLaneInteractiveWeight.Key)
+	policy.Push(newMockTask(mockTaskConfig{priority: internalpb.ReadPriority_Batch}))
+	for i := 0; i < weight*2; i++ {
+		policy.Push(newMockTask(mockTaskConfig{priority: internalpb.ReadPriority_Interactive}))
+	}
+	for i := 0; i < weight*2; i++ {
+		assert.Equal(t, internalpb.ReadPriority_Interactive, policy.Pop().Priority())
+	}
+	assert.Equal(t, internalpb.ReadPriority_Batch, policy.Pop().Priority())
+}
+
 func testCrossUserMerge(t *testing.T, policy schedulePolicy) {
 	userN := 10
 	maxNQ := paramtable.Get().QueryNodeCfg.MaxGroupNQ.GetAsInt64()
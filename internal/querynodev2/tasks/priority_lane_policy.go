@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+var _ schedulePolicy = &priorityLanePolicy{}
+
+// newPriorityLanePolicy create a new priority lane schedule policy,
+// each lane is scheduled by the policy created by newLane.
+func newPriorityLanePolicy(newLane func() schedulePolicy) *priorityLanePolicy {
+	return &priorityLanePolicy{
+		interactive: newLane(),
+		batch:       newLane(),
+	}
+}
+
+// priorityLanePolicy schedules the tasks in the interactive lane and the batch lane,
+// so that the bulk offline scans can't starve the low latency online requests.
+// The interactive lane is preferred, the batch lane gets a chance after every
+// interactiveWeight interactive tasks scheduled, so that it's not starved either.
+// Tasks are merged within the same lane only.
+type priorityLanePolicy struct {
+	interactive schedulePolicy
+	batch       schedulePolicy
+
+	// interactivePopped is the number of the interactive tasks popped since the last batch task
+	interactivePopped int
+}
+
+// Push add a new task into the lane of its priority.
+func (p *priorityLanePolicy) Push(task Task) (int, error) {
+	if task.Priority() == internalpb.ReadPriority_Batch {
+		return p.batch.Push(task)
+	}
+	return p.interactive.Push(task)
+}
+
+// Pop get the task next ready to run.
+func (p *priorityLanePolicy) Pop() Task {
+	weight := paramtable.Get().QueryNodeCfg.PriorityLaneInteractiveWeight.GetAsInt()
+	if weight > 0 && p.interactivePopped >= weight {
+		if task := p.batch.Pop(); task != nil {
+			p.interactivePopped = 0
+			return task
+		}
+	}
+	if task := p.interactive.Pop(); task != nil {
+		p.interactivePopped++
+		return task
+	}
+	p.interactivePopped = 0
+	return p.batch.Pop()
+}
+
+// Len get ready task counts.
+func (p *priorityLanePolicy) Len() int {
+	return p.interactive.Len() + p.batch.Len()
+}
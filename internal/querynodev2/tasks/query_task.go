@@ -62,6 +62,10 @@ func (t *QueryTask) IsGpuIndex() bool {
 	return false
 }
 
+func (t *QueryTask) Priority() internalpb.ReadPriority {
+	return t.req.GetReq().GetPriority()
+}
+
 // PreExecute the task, only call once.
 func (t *QueryTask) PreExecute() error {
 	// Update task wait time metric before execute
@@ -104,7 +108,11 @@ func (t *QueryTask) Execute() error {
 		return err
 	}
 	defer retrievePlan.Delete()
-	results, querySegments, err := segments.Retrieve(t.ctx, t.segmentManager, retrievePlan, t.req)
+
+	// the query is cancelled once exceeds the resource budget
+	ctx, budget := segments.WithRequestBudget(t.ctx, metrics.QueryLabel)
+	defer budget.Release()
+	results, querySegments, err := segments.Retrieve(ctx, t.segmentManager, retrievePlan, t.req)
 	defer t.segmentManager.Segment.Unpin(querySegments)
 	if err != nil {
 		return err
@@ -14,6 +14,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querynodev2/collector"
@@ -22,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
@@ -94,6 +96,10 @@ func (t *SearchTask) IsGpuIndex() bool {
 	return t.collection.IsGpuIndex()
 }
 
+func (t *SearchTask) Priority() internalpb.ReadPriority {
+	return t.req.GetReq().GetPriority()
+}
+
 func (t *SearchTask) PreExecute() error {
 	// Update task wait time metric before execute
 	nodeID := strconv.FormatInt(t.GetNodeID(), 10)
@@ -137,6 +143,9 @@ func (t *SearchTask) Execute() error {
 	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "SearchTask")
 
 	req := t.req
+	if req.GetReq().GetFusion() != nil {
+		return t.executeFused()
+	}
 	t.combinePlaceHolderGroups()
 	searchReq, err := segments.NewSearchRequest(t.ctx, t.collection, req, t.placeholderGroup)
 	if err != nil {
@@ -144,13 +153,17 @@ func (t *SearchTask) Execute() error {
 	}
 	defer searchReq.Delete()
 
+	// the search is cancelled once exceeds the resource budget
+	ctx, budget := segments.WithRequestBudget(t.ctx, metrics.SearchLabel)
+	defer budget.Release()
+
 	var (
 		results          []*segments.SearchResult
 		searchedSegments []segments.Segment
 	)
 	if req.GetScope() == querypb.DataScope_Historical {
 		results, searchedSegments, err = segments.SearchHistorical(
-			t.ctx,
+			ctx,
 			t.segmentManager,
 			searchReq,
 			req.GetReq().GetCollectionID(),
@@ -159,7 +172,7 @@ func (t *SearchTask) Execute() error {
 		)
 	} else if req.GetScope() == querypb.DataScope_Streaming {
 		results, searchedSegments, err = segments.SearchStreaming(
-			t.ctx,
+			ctx,
 			t.segmentManager,
 			searchReq,
 			req.GetReq().GetCollectionID(),
@@ -259,6 +272,107 @@ func (t *SearchTask) Execute() error {
 	return nil
 }
 
+// executeFused runs the sub searches of the fusion on each segment,
+// and reduces the fused results of the segments into the topk hits.
+func (t *SearchTask) executeFused() error {
+	if t.scheduleSpan != nil {
+		t.scheduleSpan.End()
+	}
+	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "SearchTask")
+
+	req := t.req
+	fusion := req.GetReq().GetFusion()
+	if len(fusion.GetReqs()) == 0 {
+		return merr.WrapErrParameterInvalidMsg("no sub search to fuse")
+	}
+	nq := fusion.GetReqs()[0].GetNq()
+	searchReqs := make([]*segments.SearchRequest, 0, len(fusion.GetReqs()))
+	defer func() {
+		for _, searchReq := range searchReqs {
+			searchReq.Delete()
+		}
+	}()
+	topks := make([]int64, 0, len(fusion.GetReqs()))
+	for _, sub := range fusion.GetReqs() {
+		if sub.GetNq() != nq {
+			return merr.WrapErrParameterInvalidMsg("sub searches of the fusion must have the same nq")
+		}
+		searchReq, err := segments.NewSearchRequest(t.ctx, t.collection, &querypb.SearchRequest{
+			Req:         sub,
+			DmlChannels: req.GetDmlChannels(),
+			SegmentIDs:  req.GetSegmentIDs(),
+			Scope:       req.GetScope(),
+		}, sub.GetPlaceholderGroup())
+		if err != nil {
+			return err
+		}
+		searchReqs = append(searchReqs, searchReq)
+		// the scores are summed up, which requires the larger score to be the better one
+		if !metric.PositivelyRelated(searchReq.Plan().GetMetricType()) {
+			return merr.WrapErrParameterInvalidMsg("metric type %s is not supported by the fusion", searchReq.Plan().GetMetricType())
+		}
+		topks = append(topks, sub.GetTopk())
+	}
+
+	// the search is cancelled once exceeds the resource budget
+	ctx, budget := segments.WithRequestBudget(t.ctx, metrics.SearchLabel)
+	defer budget.Release()
+
+	var (
+		results          []*schemapb.SearchResultData
+		searchedSegments []segments.Segment
+		err              error
+	)
+	if req.GetScope() == querypb.DataScope_Historical {
+		results, searchedSegments, err = segments.FusedSearchHistorical(
+			ctx,
+			t.segmentManager,
+			searchReqs,
+			topks,
+			fusion.GetWeights(),
+			fusion.GetTopk(),
+			req.GetReq().GetCollectionID(),
+			nil,
+			req.GetSegmentIDs(),
+		)
+	} else if req.GetScope() == querypb.DataScope_Streaming {
+		results, searchedSegments, err = segments.FusedSearchStreaming(
+			ctx,
+			t.segmentManager,
+			searchReqs,
+			topks,
+			fusion.GetWeights(),
+			fusion.GetTopk(),
+			req.GetReq().GetCollectionID(),
+			nil,
+			req.GetSegmentIDs(),
+		)
+	}
+	defer t.segmentManager.Segment.Unpin(searchedSegments)
+	if err != nil {
+		return err
+	}
+
+	fused, err := segments.ReduceSearchResultData(t.ctx, results, nq, fusion.GetTopk(), 1)
+	if err != nil {
+		return err
+	}
+	result, err := segments.EncodeSearchResultData(fused, nq, fusion.GetTopk(), metric.IP)
+	if err != nil {
+		return err
+	}
+	result.Base = &commonpb.MsgBase{
+		SourceID: t.GetNodeID(),
+	}
+	result.SlicedOffset = 1
+	result.SlicedNumCount = 1
+	result.CostAggregation = &internalpb.CostAggregation{
+		ServiceTime: tr.ElapseSpan().Milliseconds(),
+	}
+	t.result = result
+	return nil
+}
+
 func (t *SearchTask) Merge(other *SearchTask) bool {
 	var (
 		nq        = t.nq
@@ -274,7 +388,8 @@ func (t *SearchTask) Merge(other *SearchTask) bool {
 	ratio := float64(after) / float64(pre)
 
 	// Check mergeable
-	if t.req.GetReq().GetDbID() != other.req.GetReq().GetDbID() ||
+	if t.req.GetReq().GetFusion() != nil || other.req.GetReq().GetFusion() != nil ||
+		t.req.GetReq().GetDbID() != other.req.GetReq().GetDbID() ||
 		t.req.GetReq().GetCollectionID() != other.req.GetReq().GetCollectionID() ||
 		t.req.GetReq().GetMvccTimestamp() != other.req.GetReq().GetMvccTimestamp() ||
 		t.req.GetReq().GetDslType() != other.req.GetReq().GetDslType() ||
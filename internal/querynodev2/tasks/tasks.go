@@ -1,23 +1,40 @@
 package tasks
 
+import (
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
 const (
 	schedulePolicyNameFIFO            = "fifo"
 	schedulePolicyNameUserTaskPolling = "user-task-polling"
 )
 
-// NewScheduler create a scheduler by policyName.
+// NewScheduler create a scheduler by policyName,
+// the tasks are scheduled in priority lanes if enabled, each lane follows the policy.
 func NewScheduler(policyName string) Scheduler {
+	newPolicy := func() schedulePolicy {
+		return newSchedulePolicy(policyName)
+	}
+	if paramtable.Get().QueryNodeCfg.PriorityLaneEnabled.GetAsBool() {
+		return newScheduler(
+			newPriorityLanePolicy(newPolicy),
+		)
+	}
+	return newScheduler(
+		newPolicy(),
+	)
+}
+
+// newSchedulePolicy create a schedule policy by policyName.
+func newSchedulePolicy(policyName string) schedulePolicy {
 	switch policyName {
 	case "":
 		fallthrough
 	case schedulePolicyNameFIFO:
-		return newScheduler(
-			newFIFOPolicy(),
-		)
+		return newFIFOPolicy()
 	case schedulePolicyNameUserTaskPolling:
-		return newScheduler(
-			newUserTaskPollingPolicy(),
-		)
+		return newUserTaskPollingPolicy()
 	default:
 		panic("invalid schedule task policy")
 	}
@@ -85,6 +102,9 @@ type Task interface {
 	// Return whether the task would be running on GPU.
 	IsGpuIndex() bool
 
+	// Return the priority lane which task is scheduled in.
+	Priority() internalpb.ReadPriority
+
 	// PreExecute the task, only call once.
 	PreExecute() error
 
@@ -0,0 +1,129 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// addCollectionFieldTask adds a scalar field to the existing collection,
+// the rows inserted before the field added read the default value of the field.
+type addCollectionFieldTask struct {
+	baseTask
+	Req *proxypb.AddCollectionFieldRequest
+}
+
+func (t *addCollectionFieldTask) Prepare(ctx context.Context) error {
+	if t.Req.GetCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("collection name is empty")
+	}
+	field := t.Req.GetField()
+	if field == nil || field.GetName() == "" {
+		return merr.WrapErrParameterInvalidMsg("field name is empty")
+	}
+	if field.GetIsPrimaryKey() || field.GetAutoID() || field.GetIsPartitionKey() || field.GetIsDynamic() {
+		return merr.WrapErrParameterInvalidMsg("the field added could not be primary key, auto id, partition key or dynamic field")
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_VarChar:
+	default:
+		return merr.WrapErrParameterInvalidMsg("field of type %s could not be added", field.GetDataType().String())
+	}
+	// the default value is read by the rows inserted before the field added
+	if field.GetDefaultValue() == nil {
+		return merr.WrapErrParameterInvalidMsg("the field added must be with default value")
+	}
+	return checkDefaultValue(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+}
+
+func (t *addCollectionFieldTask) Execute(ctx context.Context) error {
+	log := log.Ctx(ctx).With(zap.String("collection", t.Req.GetCollectionName()),
+		zap.String("field", t.Req.GetField().GetName()))
+
+	oldColl, err := t.core.meta.GetCollectionByName(ctx, t.Req.GetDbName(), t.Req.GetCollectionName(), t.GetTs())
+	if err != nil {
+		log.Warn("get collection failed during adding field", zap.Error(err))
+		return err
+	}
+	if lo.ContainsBy(oldColl.Fields, func(field *model.Field) bool { return field.Name == t.Req.GetField().GetName() }) {
+		return merr.WrapErrParameterInvalidMsg("field %s already exists", t.Req.GetField().GetName())
+	}
+
+	field := proto.Clone(t.Req.GetField()).(*schemapb.FieldSchema)
+	field.FieldID = nextFieldID(oldColl)
+	newColl := oldColl.Clone()
+	newColl.Fields = append(newColl.Fields, model.UnmarshalFieldModel(field))
+
+	redoTask := newBaseRedoTask(t.core.stepExecutor)
+	redoTask.AddSyncStep(&AlterCollectionStep{
+		baseStep: baseStep{core: t.core},
+		oldColl:  oldColl,
+		newColl:  newColl,
+		ts:       t.GetTs(),
+	})
+	aliases := t.core.meta.ListAliasesByID(oldColl.CollectionID)
+	redoTask.AddSyncStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
+		collectionNames: append(aliases, oldColl.Name),
+		collectionID:    oldColl.CollectionID,
+		ts:              t.GetTs(),
+		opts:            []proxyutil.ExpireCacheOpt{proxyutil.SetMsgType(commonpb.MsgType_AlterCollection)},
+	})
+	// DataCoord seals the growing segments of the collection once the schema changed,
+	// so the rows with the field are written into new segments.
+	redoTask.AddSyncStep(&BroadcastAlteredCollectionStep{
+		baseStep: baseStep{core: t.core},
+		req: &milvuspb.AlterCollectionRequest{
+			DbName:         t.Req.GetDbName(),
+			CollectionName: oldColl.Name,
+			CollectionID:   oldColl.CollectionID,
+			Properties:     oldColl.Properties,
+		},
+		core: t.core,
+	})
+
+	log.Info("add collection field", zap.Int64("collectionID", oldColl.CollectionID), zap.Int64("fieldID", field.GetFieldID()))
+	return redoTask.Execute(ctx)
+}
+
+// nextFieldID returns the id for the field added to the collection.
+func nextFieldID(coll *model.Collection) typeutil.UniqueID {
+	fieldID := int64(common.StartOfUserFieldID)
+	for _, field := range coll.Fields {
+		if field.FieldID >= fieldID {
+			fieldID = field.FieldID + 1
+		}
+	}
+	return fieldID
+}
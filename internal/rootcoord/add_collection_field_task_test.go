@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func newAddedField(name string) *schemapb.FieldSchema {
+	return &schemapb.FieldSchema{
+		Name:         name,
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}},
+	}
+}
+
+func Test_addCollectionFieldTask_Prepare(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		reqs := []*proxypb.AddCollectionFieldRequest{
+			{Field: newAddedField("f")},
+			{CollectionName: "cn"},
+			{CollectionName: "cn", Field: &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64, IsPrimaryKey: true}},
+			{CollectionName: "cn", Field: &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_FloatVector}},
+			{CollectionName: "cn", Field: &schemapb.FieldSchema{Name: "f", DataType: schemapb.DataType_Int64}},
+			{CollectionName: "cn", Field: &schemapb.FieldSchema{
+				Name:         "f",
+				DataType:     schemapb.DataType_Int64,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}},
+			}},
+		}
+		for _, req := range reqs {
+			task := &addCollectionFieldTask{Req: req}
+			err := task.Prepare(context.Background())
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &addCollectionFieldTask{
+			Req: &proxypb.AddCollectionFieldRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+				CollectionName: "cn",
+				Field:          newAddedField("f"),
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_addCollectionFieldTask_Execute(t *testing.T) {
+	newColl := func() *model.Collection {
+		return &model.Collection{
+			CollectionID: 1,
+			Name:         "cn",
+			Fields: []*model.Field{
+				{FieldID: common.RowIDField, Name: common.RowIDFieldName},
+				{FieldID: common.TimeStampField, Name: common.TimeStampFieldName},
+				{FieldID: common.StartOfUserFieldID, Name: "pk", IsPrimaryKey: true},
+				{FieldID: common.StartOfUserFieldID + 1, Name: "vec"},
+			},
+		}
+	}
+
+	t.Run("failed to get collection", func(t *testing.T) {
+		core := newTestCore(withInvalidMeta())
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &proxypb.AddCollectionFieldRequest{CollectionName: "cn", Field: newAddedField("f")},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("field already exists", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+		core := newTestCore(withMeta(meta))
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &proxypb.AddCollectionFieldRequest{CollectionName: "cn", Field: newAddedField("pk")},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("alter step failed", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return(nil)
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("err"))
+		core := newTestCore(withMeta(meta))
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &proxypb.AddCollectionFieldRequest{CollectionName: "cn", Field: newAddedField("f")},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("add successfully", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(newColl(), nil)
+		meta.EXPECT().ListAliasesByID(mock.Anything).Return([]string{"alias"})
+		meta.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, oldColl *model.Collection, newColl *model.Collection, ts uint64) error {
+				assert.Equal(t, 4, len(oldColl.Fields))
+				assert.Equal(t, 5, len(newColl.Fields))
+				added := newColl.Fields[4]
+				assert.Equal(t, "f", added.Name)
+				assert.EqualValues(t, common.StartOfUserFieldID+2, added.FieldID)
+				assert.EqualValues(t, 1, added.DefaultValue.GetLongData())
+				return nil
+			})
+
+		broker := newMockBroker()
+		broker.BroadcastAlteredCollectionFunc = func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error {
+			assert.EqualValues(t, 1, req.GetCollectionID())
+			return nil
+		}
+
+		core := newTestCore(withMeta(meta), withBroker(broker), withValidProxyManager())
+		task := &addCollectionFieldTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &proxypb.AddCollectionFieldRequest{CollectionName: "cn", Field: newAddedField("f")},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_nextFieldID(t *testing.T) {
+	assert.EqualValues(t, common.StartOfUserFieldID, nextFieldID(&model.Collection{}))
+	assert.EqualValues(t, 105, nextFieldID(&model.Collection{Fields: []*model.Field{
+		{FieldID: common.RowIDField},
+		{FieldID: 100},
+		{FieldID: 104},
+		{FieldID: 101},
+	}}))
+}
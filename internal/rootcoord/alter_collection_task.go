@@ -26,7 +26,9 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 type alterCollectionTask struct {
@@ -57,6 +59,9 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 
 	newColl := oldColl.Clone()
 	updateCollectionProperties(newColl, a.Req.GetProperties())
+	if err := checkCollectionStorageUnchanged(oldColl.Properties, newColl.Properties); err != nil {
+		return err
+	}
 
 	ts := a.GetTs()
 	redoTask := newBaseRedoTask(a.core.stepExecutor)
@@ -77,6 +82,19 @@ func (a *alterCollectionTask) Execute(ctx context.Context) error {
 	return redoTask.Execute(ctx)
 }
 
+// checkCollectionStorageUnchanged checks the files of the collection stay where they are,
+// only the credentials of the storage could be altered.
+func checkCollectionStorageUnchanged(oldProps, newProps []*commonpb.KeyValuePair) error {
+	oldStorage, _ := common.GetCollectionStorage(oldProps...)
+	newStorage, _ := common.GetCollectionStorage(newProps...)
+	if oldStorage.Address != newStorage.Address ||
+		oldStorage.BucketName != newStorage.BucketName ||
+		oldStorage.RootPath != newStorage.RootPath {
+		return merr.WrapErrParameterInvalidMsg("the bucket, root path and address of the collection storage can't be altered")
+	}
+	return nil
+}
+
 func updateCollectionProperties(coll *model.Collection, updatedProps []*commonpb.KeyValuePair) {
 	props := make(map[string]string)
 	for _, prop := range coll.Properties {
@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// alterDatabaseTask updates the properties of the database, the quotas take effect at once.
+type alterDatabaseTask struct {
+	baseTask
+	Req *proxypb.AlterDatabaseRequest
+}
+
+func (t *alterDatabaseTask) Prepare(ctx context.Context) error {
+	if len(t.Req.GetProperties()) == 0 {
+		return merr.WrapErrParameterInvalidMsg("no properties to alter")
+	}
+	return validateDatabaseProperties(t.Req.GetProperties())
+}
+
+func (t *alterDatabaseTask) Execute(ctx context.Context) error {
+	dbName := normalizeDBName(t.Req.GetDbName())
+	oldDB, err := t.core.meta.GetDatabaseByName(ctx, dbName, t.GetTs())
+	if err != nil {
+		log.Ctx(ctx).Warn("get database failed during altering database", zap.String("dbName", dbName), zap.Error(err))
+		return err
+	}
+
+	newDB := oldDB.Clone()
+	newDB.Properties = mergeDatabaseProperties(oldDB.Properties, t.Req.GetProperties())
+	if err := t.core.meta.AlterDatabase(ctx, oldDB, newDB, t.GetTs()); err != nil {
+		return err
+	}
+	t.core.ddlLimiter.Update(dbName, newDB.Properties)
+	log.Ctx(ctx).Info("alter database properties", zap.String("dbName", dbName), zap.Any("properties", common.RedactProperties(newDB.Properties...)))
+	return nil
+}
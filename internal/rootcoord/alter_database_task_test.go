@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+)
+
+func Test_alterDatabaseTask_Prepare(t *testing.T) {
+	t.Run("invalid request", func(t *testing.T) {
+		reqs := []*proxypb.AlterDatabaseRequest{
+			{DbName: "db"},
+			{DbName: "db", Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseMaxCollectionsKey, Value: "-1"}}},
+		}
+		for _, req := range reqs {
+			task := &alterDatabaseTask{Req: req}
+			err := task.Prepare(context.Background())
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &alterDatabaseTask{
+			Req: &proxypb.AlterDatabaseRequest{
+				DbName:     "db",
+				Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseMaxCollectionsKey, Value: "10"}},
+			},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_alterDatabaseTask_Execute(t *testing.T) {
+	t.Run("failed to get database", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db", mock.Anything).Return(nil, errors.New("err"))
+		core := newTestCore(withMeta(meta))
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &proxypb.AlterDatabaseRequest{DbName: "db"},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to alter database", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db", mock.Anything).Return(model.NewDatabase(1, "db", 0), nil)
+		meta.EXPECT().AlterDatabase(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("err"))
+		core := newTestCore(withMeta(meta))
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &proxypb.AlterDatabaseRequest{
+				DbName:     "db",
+				Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseDDLRateMaxKey, Value: "0"}},
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+		assert.NoError(t, core.ddlLimiter.Check("db"))
+	})
+
+	t.Run("alter successfully", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, util.DefaultDBName, mock.Anything).Return(model.NewDefaultDatabase(), nil)
+		meta.EXPECT().AlterDatabase(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts uint64) error {
+				assert.Empty(t, oldDB.Properties)
+				assert.Equal(t, []*commonpb.KeyValuePair{{Key: common.DatabaseDDLRateMaxKey, Value: "0"}}, newDB.Properties)
+				return nil
+			})
+		core := newTestCore(withMeta(meta))
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &proxypb.AlterDatabaseRequest{
+				Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseDDLRateMaxKey, Value: "0"}},
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Error(t, core.ddlLimiter.Check(util.DefaultDBName))
+	})
+}
@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/crypto"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	apiKeySecretLen     = 32
+	apiKeyNameMaxLength = 128
+)
+
+// generateAPIKey generates the key formatted as <prefix><name>-<secret>, and returns the key with the hash of its secret.
+func generateAPIKey(name string) (string, string, error) {
+	b := make([]byte, apiKeySecretLen)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	secret := hex.EncodeToString(b)
+	return fmt.Sprintf("%s%s-%s", util.APIKeyPrefix, name, secret), crypto.SHA256(secret, name), nil
+}
+
+// parseAPIKey parses the name and the secret of the key, the name may contain the hyphens but the secret never does.
+func parseAPIKey(key string) (string, string, bool) {
+	if !strings.HasPrefix(key, util.APIKeyPrefix) {
+		return "", "", false
+	}
+	key = strings.TrimPrefix(key, util.APIKeyPrefix)
+	idx := strings.LastIndex(key, "-")
+	if idx <= 0 || idx == len(key)-1 {
+		return "", "", false
+	}
+	return key[:idx], key[idx+1:], true
+}
+
+// verifyAPIKeySecret checks the secret against the stored hash in constant time.
+func verifyAPIKeySecret(apiKey *model.APIKey, secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(crypto.SHA256(secret, apiKey.Name)), []byte(apiKey.SecretHash)) == 1
+}
+
+// validateAPIKeyName checks the name, which is a part of the key and the user the key authenticates as.
+func validateAPIKeyName(name string) error {
+	if name == "" {
+		return merr.WrapErrParameterInvalidMsg("the api key name is empty")
+	}
+	if len(name) > apiKeyNameMaxLength {
+		return merr.WrapErrParameterInvalidMsg("the length of the api key name %s exceeds %d", name, apiKeyNameMaxLength)
+	}
+	for _, c := range name {
+		if !(c >= 'a' && c <= 'z') && !(c >= 'A' && c <= 'Z') && !(c >= '0' && c <= '9') && c != '_' && c != '-' {
+			return merr.WrapErrParameterInvalidMsg("the api key name %s can only contain letters, numbers, underscores and hyphens", name)
+		}
+	}
+	return nil
+}
+
+func validateAPIKeyExpireAt(expireAt int64) error {
+	if expireAt < 0 || (expireAt != 0 && expireAt <= time.Now().Unix()) {
+		return merr.WrapErrParameterInvalidMsg("the expiry of the api key %d is in the past", expireAt)
+	}
+	return nil
+}
+
+// checkAPIKeyScope checks the databases and the roles the api key is scoped to exist.
+func (c *Core) checkAPIKeyScope(ctx context.Context, dbNames []string, roles []string) error {
+	for _, dbName := range dbNames {
+		if _, err := c.meta.GetDatabaseByName(ctx, dbName, typeutil.MaxTimestamp); err != nil {
+			return err
+		}
+	}
+	for _, role := range roles {
+		if _, err := c.meta.SelectRole(util.DefaultTenant, &milvuspb.RoleEntity{Name: role}, false); err != nil {
+			return merr.WrapErrParameterInvalidMsg("role %s not found: %s", role, err.Error())
+		}
+	}
+	return nil
+}
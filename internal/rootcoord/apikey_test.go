@@ -0,0 +1,66 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/util"
+)
+
+func TestAPIKeyGenerateAndParse(t *testing.T) {
+	key, secretHash, err := generateAPIKey("my-key_1")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, util.APIKeyPrefix))
+	assert.NotContains(t, key, secretHash)
+
+	name, secret, ok := parseAPIKey(key)
+	assert.True(t, ok)
+	assert.Equal(t, "my-key_1", name)
+	assert.True(t, verifyAPIKeySecret(&model.APIKey{Name: name, SecretHash: secretHash}, secret))
+	assert.False(t, verifyAPIKeySecret(&model.APIKey{Name: name, SecretHash: secretHash}, secret+"0"))
+	// the secret is salted by the name
+	assert.False(t, verifyAPIKeySecret(&model.APIKey{Name: "other", SecretHash: secretHash}, secret))
+
+	another, _, err := generateAPIKey("my-key_1")
+	require.NoError(t, err)
+	assert.NotEqual(t, key, another)
+
+	for _, invalid := range []string{"", "my-key-secret", util.APIKeyPrefix + "name", util.APIKeyPrefix + "-secret", util.APIKeyPrefix + "name-"} {
+		_, _, ok = parseAPIKey(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
+
+func TestValidateAPIKey(t *testing.T) {
+	assert.NoError(t, validateAPIKeyName("key_1-a"))
+	assert.Error(t, validateAPIKeyName(""))
+	assert.Error(t, validateAPIKeyName("key/1"))
+	assert.Error(t, validateAPIKeyName("key:1"))
+	assert.Error(t, validateAPIKeyName(strings.Repeat("a", apiKeyNameMaxLength+1)))
+
+	assert.NoError(t, validateAPIKeyExpireAt(0))
+	assert.NoError(t, validateAPIKeyExpireAt(time.Now().Add(time.Hour).Unix()))
+	assert.Error(t, validateAPIKeyExpireAt(time.Now().Add(-time.Hour).Unix()))
+	assert.Error(t, validateAPIKeyExpireAt(-1))
+}
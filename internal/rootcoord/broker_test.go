@@ -327,3 +327,26 @@ func TestServerBroker_GcConfirm(t *testing.T) {
 		assert.True(t, broker.GcConfirm(context.Background(), 100, 10000))
 	})
 }
+
+func TestServerBroker_RestoreDroppedSegments(t *testing.T) {
+	t.Run("failed to restore", func(t *testing.T) {
+		dc := mocks.NewMockDataCoordClient(t)
+		dc.EXPECT().RestoreSegment(mock.Anything, mock.Anything).Return(
+			&datapb.RestoreSegmentResponse{Status: merr.Status(merr.ErrParameterInvalid)}, nil)
+		c := newTestCore(withDataCoord(dc))
+		broker := newServerBroker(c)
+		err := broker.RestoreDroppedSegments(context.Background(), 100)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		dc := mocks.NewMockDataCoordClient(t)
+		dc.EXPECT().RestoreSegment(mock.Anything, mock.MatchedBy(func(req *datapb.RestoreSegmentRequest) bool {
+			// all the restorable dropped segments of the collection are restored
+			return req.GetCollectionID() == 100 && len(req.GetSegmentIDs()) == 0
+		})).Return(&datapb.RestoreSegmentResponse{Status: merr.Success(), RestoredSegmentIDs: []int64{1, 2}}, nil)
+		c := newTestCore(withDataCoord(dc))
+		broker := newServerBroker(c)
+		assert.NoError(t, broker.RestoreDroppedSegments(context.Background(), 100))
+	})
+}
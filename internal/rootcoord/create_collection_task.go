@@ -55,6 +55,7 @@ type createCollectionTask struct {
 	partIDs        []UniqueID
 	channels       collectionChannels
 	dbID           UniqueID
+	dbProperties   []*commonpb.KeyValuePair
 	partitionNames []string
 }
 
@@ -94,6 +95,11 @@ func (t *createCollectionTask) validate() error {
 		return merr.WrapErrCollectionNumLimitExceeded(maxColNumPerDB, "max number of collection has reached the limit in DB")
 	}
 
+	if dbMaxCollections, ok := getDatabaseQuota(t.dbProperties, common.DatabaseMaxCollectionsKey); ok && len(collIDs) >= int(dbMaxCollections) {
+		log.Warn("unable to create collection because the number of collection has reached the quota of DB", zap.Int("dbMaxCollections", int(dbMaxCollections)))
+		return merr.WrapErrDatabaseQuotaExceeded(t.Req.GetDbName(), common.DatabaseMaxCollectionsKey, int(dbMaxCollections))
+	}
+
 	// 3. check total collection number
 	totalCollections := 0
 	for _, collIDs := range db2CollIDs {
@@ -345,6 +351,16 @@ func (t *createCollectionTask) Prepare(ctx context.Context) error {
 		return err
 	}
 	t.dbID = db.ID
+	t.dbProperties = db.Properties
+
+	// the collection inherits the defaults of the database unless overridden,
+	// the consistency level is regarded as unspecified if it's the zero value.
+	t.Req.Properties = inheritDatabaseDefaults(db.Properties, t.Req.GetProperties())
+	if t.Req.GetConsistencyLevel() == commonpb.ConsistencyLevel_Strong {
+		if level, ok := getDatabaseDefaultConsistencyLevel(db.Properties); ok {
+			t.Req.ConsistencyLevel = level
+		}
+	}
 
 	if err := t.validate(); err != nil {
 		return err
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util"
@@ -693,6 +694,70 @@ func Test_createCollectionTask_Prepare(t *testing.T) {
 	})
 }
 
+func Test_createCollectionTask_PrepareWithDatabaseDefaults(t *testing.T) {
+	paramtable.Init()
+	db := model.NewDatabase(1, "db1", pb.DatabaseState_DatabaseCreated)
+	db.Properties = []*commonpb.KeyValuePair{
+		{Key: common.DatabaseDefaultCollectionTTLKey, Value: "3600"},
+		{Key: common.DatabaseDefaultResourceGroupsKey, Value: "rg1"},
+		{Key: common.DatabaseDefaultConsistencyLevelKey, Value: "Bounded"},
+	}
+	meta := mockrootcoord.NewIMetaTable(t)
+	meta.EXPECT().GetDatabaseByName(mock.Anything, mock.Anything, mock.Anything).Return(db, nil)
+	meta.EXPECT().ListAllAvailCollections(mock.Anything).Return(map[int64][]int64{}).Maybe()
+
+	collectionName := funcutil.GenRandomStr()
+	schema := &schemapb.CollectionSchema{
+		Name: collectionName,
+		Fields: []*schemapb.FieldSchema{
+			{Name: funcutil.GenRandomStr()},
+		},
+	}
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+
+	t.Run("inherit", func(t *testing.T) {
+		core := newTestCore(withInvalidIDAllocator(), withMeta(meta))
+		task := createCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				DbName:         "db1",
+				CollectionName: collectionName,
+				Schema:         marshaledSchema,
+			},
+		}
+		// failed to assign id after the defaults inherited
+		_ = task.Prepare(context.Background())
+		assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+			{Key: common.CollectionTTLConfigKey, Value: "3600"},
+			{Key: common.CollectionResourceGroupsKey, Value: "rg1"},
+		}, task.Req.GetProperties())
+		assert.Equal(t, commonpb.ConsistencyLevel_Bounded, task.Req.GetConsistencyLevel())
+	})
+
+	t.Run("overridden", func(t *testing.T) {
+		core := newTestCore(withInvalidIDAllocator(), withMeta(meta))
+		task := createCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.CreateCollectionRequest{
+				Base:             &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				DbName:           "db1",
+				CollectionName:   collectionName,
+				Schema:           marshaledSchema,
+				Properties:       []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "60"}},
+				ConsistencyLevel: commonpb.ConsistencyLevel_Session,
+			},
+		}
+		_ = task.Prepare(context.Background())
+		assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+			{Key: common.CollectionTTLConfigKey, Value: "60"},
+			{Key: common.CollectionResourceGroupsKey, Value: "rg1"},
+		}, task.Req.GetProperties())
+		assert.Equal(t, commonpb.ConsistencyLevel_Session, task.Req.GetConsistencyLevel())
+	})
+}
+
 func Test_createCollectionTask_Execute(t *testing.T) {
 	t.Run("add same collection with different parameters", func(t *testing.T) {
 		defer cleanTestEnv()
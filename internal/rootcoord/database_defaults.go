@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// databaseDefaultCollectionKeys maps the database default properties to the collection properties they provide.
+var databaseDefaultCollectionKeys = map[string]string{
+	common.DatabaseDefaultCollectionTTLKey:  common.CollectionTTLConfigKey,
+	common.DatabaseDefaultMmapEnabledKey:    common.MmapEnabledKey,
+	common.DatabaseDefaultReplicaNumberKey:  common.CollectionReplicaNumberKey,
+	common.DatabaseDefaultResourceGroupsKey: common.CollectionResourceGroupsKey,
+
+	common.DatabaseDefaultStorageAddressKey:         common.CollectionStorageAddressKey,
+	common.DatabaseDefaultStorageBucketKey:          common.CollectionStorageBucketKey,
+	common.DatabaseDefaultStorageRootPathKey:        common.CollectionStorageRootPathKey,
+	common.DatabaseDefaultStorageAccessKeyIDKey:     common.CollectionStorageAccessKeyIDKey,
+	common.DatabaseDefaultStorageSecretAccessKeyKey: common.CollectionStorageSecretAccessKeyKey,
+}
+
+// validateDatabaseDefault checks the value of the database default property.
+func validateDatabaseDefault(key, value string) error {
+	invalid := func(expected string) error {
+		return merr.WrapErrParameterInvalidMsg("invalid value %s of database property %s, expected %s", value, key, expected)
+	}
+
+	switch key {
+	case common.DatabaseDefaultCollectionTTLKey:
+		if v, err := strconv.ParseInt(value, 10, 64); err != nil || v < 0 {
+			return invalid("non-negative integer")
+		}
+	case common.DatabaseDefaultMmapEnabledKey:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return invalid("bool")
+		}
+	case common.DatabaseDefaultReplicaNumberKey:
+		if _, ok := common.GetCollectionReplicaNumber(&commonpb.KeyValuePair{Key: common.CollectionReplicaNumberKey, Value: value}); !ok {
+			return invalid("positive integer")
+		}
+	case common.DatabaseDefaultResourceGroupsKey:
+		if _, ok := common.GetCollectionResourceGroups(&commonpb.KeyValuePair{Key: common.CollectionResourceGroupsKey, Value: value}); !ok {
+			return invalid("comma separated resource groups")
+		}
+	case common.DatabaseDefaultConsistencyLevelKey:
+		if _, ok := getDatabaseDefaultConsistencyLevel([]*commonpb.KeyValuePair{{Key: key, Value: value}}); !ok {
+			return invalid("consistency level")
+		}
+	case common.DatabaseDefaultStorageAddressKey,
+		common.DatabaseDefaultStorageBucketKey,
+		common.DatabaseDefaultStorageRootPathKey,
+		common.DatabaseDefaultStorageAccessKeyIDKey,
+		common.DatabaseDefaultStorageSecretAccessKeyKey:
+		if strings.TrimSpace(value) != value {
+			return invalid("no leading or trailing spaces")
+		}
+	default:
+		return merr.WrapErrParameterInvalidMsg("unknown database property %s", key)
+	}
+	return nil
+}
+
+// inheritDatabaseDefaults returns the collection properties filled with the database defaults,
+// the properties specified by the collection are kept. The storage is inherited as a whole,
+// none of the storage defaults is inherited if the collection specifies any storage property.
+func inheritDatabaseDefaults(dbProperties []*commonpb.KeyValuePair, collProperties []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	specified := make(map[string]struct{}, len(collProperties))
+	for _, kv := range collProperties {
+		specified[kv.GetKey()] = struct{}{}
+	}
+	for _, key := range common.CollectionStorageKeys {
+		if _, ok := specified[key]; ok {
+			for _, key := range common.CollectionStorageKeys {
+				specified[key] = struct{}{}
+			}
+			break
+		}
+	}
+
+	var inherited []*commonpb.KeyValuePair
+	for _, kv := range dbProperties {
+		collKey, ok := databaseDefaultCollectionKeys[kv.GetKey()]
+		if !ok || kv.GetValue() == "" {
+			continue
+		}
+		if _, ok := specified[collKey]; ok {
+			continue
+		}
+		inherited = append(inherited, &commonpb.KeyValuePair{Key: collKey, Value: kv.GetValue()})
+	}
+	if len(inherited) == 0 {
+		return collProperties
+	}
+	return append(common.CloneKeyValuePairs(collProperties), inherited...)
+}
+
+// getDatabaseDefaultConsistencyLevel returns the default consistency level of the database, by name like "Bounded".
+func getDatabaseDefaultConsistencyLevel(dbProperties []*commonpb.KeyValuePair) (commonpb.ConsistencyLevel, bool) {
+	for _, kv := range dbProperties {
+		if kv.GetKey() != common.DatabaseDefaultConsistencyLevelKey {
+			continue
+		}
+		for name, level := range commonpb.ConsistencyLevel_value {
+			if strings.EqualFold(name, kv.GetValue()) {
+				return commonpb.ConsistencyLevel(level), true
+			}
+		}
+		return 0, false
+	}
+	return 0, false
+}
@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func Test_validateDatabaseDefault(t *testing.T) {
+	cases := []struct {
+		key   string
+		value string
+		valid bool
+	}{
+		{common.DatabaseDefaultCollectionTTLKey, "3600", true},
+		{common.DatabaseDefaultCollectionTTLKey, "-1", false},
+		{common.DatabaseDefaultMmapEnabledKey, "true", true},
+		{common.DatabaseDefaultMmapEnabledKey, "yes", false},
+		{common.DatabaseDefaultReplicaNumberKey, "2", true},
+		{common.DatabaseDefaultReplicaNumberKey, "0", false},
+		{common.DatabaseDefaultResourceGroupsKey, "rg1,rg2", true},
+		{common.DatabaseDefaultResourceGroupsKey, " , ", false},
+		{common.DatabaseDefaultConsistencyLevelKey, "bounded", true},
+		{common.DatabaseDefaultConsistencyLevelKey, "unknown", false},
+		{common.DatabaseDefaultStorageBucketKey, "tenant-bucket", true},
+		{common.DatabaseDefaultStorageRootPathKey, " tenant", false},
+		{common.DatabaseDefaultPropertyPrefix + "unknown", "1", false},
+	}
+	for _, c := range cases {
+		err := validateDatabaseDefault(c.key, c.value)
+		assert.Equal(t, c.valid, err == nil, "key: %s, value: %s", c.key, c.value)
+	}
+
+	// database defaults are validated together with the quotas
+	err := validateDatabaseProperties([]*commonpb.KeyValuePair{{Key: common.DatabaseDefaultReplicaNumberKey, Value: "abc"}})
+	assert.Error(t, err)
+	err = validateDatabaseProperties([]*commonpb.KeyValuePair{{Key: common.DatabaseDefaultReplicaNumberKey, Value: ""}})
+	assert.NoError(t, err)
+}
+
+func Test_inheritDatabaseDefaults(t *testing.T) {
+	dbProperties := []*commonpb.KeyValuePair{
+		{Key: common.DatabaseDefaultCollectionTTLKey, Value: "3600"},
+		{Key: common.DatabaseDefaultMmapEnabledKey, Value: "true"},
+		{Key: common.DatabaseDefaultReplicaNumberKey, Value: "2"},
+		{Key: common.DatabaseMaxCollectionsKey, Value: "10"},
+	}
+
+	t.Run("no defaults", func(t *testing.T) {
+		collProperties := []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "60"}}
+		got := inheritDatabaseDefaults(nil, collProperties)
+		assert.Equal(t, collProperties, got)
+	})
+
+	t.Run("overridden by collection", func(t *testing.T) {
+		collProperties := []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "60"}}
+		got := inheritDatabaseDefaults(dbProperties, collProperties)
+		assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+			{Key: common.CollectionTTLConfigKey, Value: "60"},
+			{Key: common.MmapEnabledKey, Value: "true"},
+			{Key: common.CollectionReplicaNumberKey, Value: "2"},
+		}, got)
+		// the properties of the request are not modified
+		assert.Equal(t, 1, len(collProperties))
+	})
+
+	t.Run("storage", func(t *testing.T) {
+		dbProperties := []*commonpb.KeyValuePair{
+			{Key: common.DatabaseDefaultStorageBucketKey, Value: "tenant-bucket"},
+			{Key: common.DatabaseDefaultStorageAccessKeyIDKey, Value: "ak"},
+			{Key: common.DatabaseDefaultStorageSecretAccessKeyKey, Value: "sk"},
+		}
+		got := inheritDatabaseDefaults(dbProperties, nil)
+		assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+			{Key: common.CollectionStorageBucketKey, Value: "tenant-bucket"},
+			{Key: common.CollectionStorageAccessKeyIDKey, Value: "ak"},
+			{Key: common.CollectionStorageSecretAccessKeyKey, Value: "sk"},
+		}, got)
+
+		// the credentials of the database aren't mixed into the bucket of the collection
+		collProperties := []*commonpb.KeyValuePair{{Key: common.CollectionStorageBucketKey, Value: "other-bucket"}}
+		got = inheritDatabaseDefaults(dbProperties, collProperties)
+		assert.Equal(t, collProperties, got)
+	})
+
+	t.Run("consistency level", func(t *testing.T) {
+		_, ok := getDatabaseDefaultConsistencyLevel(dbProperties)
+		assert.False(t, ok)
+
+		level, ok := getDatabaseDefaultConsistencyLevel([]*commonpb.KeyValuePair{{Key: common.DatabaseDefaultConsistencyLevelKey, Value: "Eventually"}})
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.ConsistencyLevel_Eventually, level)
+	})
+}
@@ -0,0 +1,162 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/ratelimitutil"
+)
+
+const databasePropertyPrefix = "database."
+
+var databaseQuotaKeys = []string{
+	common.DatabaseMaxCollectionsKey,
+	common.DatabaseLoadedSizeQuotaKey,
+	common.DatabaseDDLRateMaxKey,
+}
+
+// validateDatabaseProperties checks the quotas in the database properties are non-negative numbers
+// and the collection defaults are valid, the empty value means removing the property.
+func validateDatabaseProperties(properties []*commonpb.KeyValuePair) error {
+	for _, kv := range properties {
+		if !strings.HasPrefix(kv.GetKey(), databasePropertyPrefix) || kv.GetValue() == "" {
+			continue
+		}
+		if strings.HasPrefix(kv.GetKey(), common.DatabaseDefaultPropertyPrefix) {
+			if err := validateDatabaseDefault(kv.GetKey(), kv.GetValue()); err != nil {
+				return err
+			}
+			continue
+		}
+		known := false
+		for _, key := range databaseQuotaKeys {
+			if kv.GetKey() == key {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return merr.WrapErrParameterInvalidMsg("unknown database property %s", kv.GetKey())
+		}
+		v, err := strconv.ParseFloat(kv.GetValue(), 64)
+		if err != nil || v < 0 {
+			return merr.WrapErrParameterInvalidMsg("invalid value %s of database property %s, expected non-negative number", kv.GetValue(), kv.GetKey())
+		}
+	}
+	return nil
+}
+
+// getDatabaseQuota returns the quota of the database, false if it's not set or invalid.
+func getDatabaseQuota(properties []*commonpb.KeyValuePair, key string) (float64, bool) {
+	for _, kv := range properties {
+		if kv.GetKey() != key {
+			continue
+		}
+		v, err := strconv.ParseFloat(kv.GetValue(), 64)
+		if err != nil || v < 0 {
+			return 0, false
+		}
+		return v, true
+	}
+	return 0, false
+}
+
+// mergeDatabaseProperties updates the properties by the altered ones, the property with empty value is removed.
+func mergeDatabaseProperties(properties []*commonpb.KeyValuePair, altered []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	merged := common.CloneKeyValuePairs(properties)
+	for _, kv := range altered {
+		idx := -1
+		for i, p := range merged {
+			if p.GetKey() == kv.GetKey() {
+				idx = i
+				break
+			}
+		}
+		switch {
+		case idx >= 0 && kv.GetValue() == "":
+			merged = append(merged[:idx], merged[idx+1:]...)
+		case idx >= 0:
+			merged[idx] = &commonpb.KeyValuePair{Key: kv.GetKey(), Value: kv.GetValue()}
+		case kv.GetValue() != "":
+			merged = append(merged, &commonpb.KeyValuePair{Key: kv.GetKey(), Value: kv.GetValue()})
+		}
+	}
+	return merged
+}
+
+// databaseDDLLimiter limits the rate of DDL requests per database,
+// the databases without the DDL rate quota are not limited.
+type databaseDDLLimiter struct {
+	mu       sync.Mutex
+	limiters map[string]*ratelimitutil.Limiter
+}
+
+// Update resets the limiter of the database by its properties.
+func (l *databaseDDLLimiter) Update(dbName string, properties []*commonpb.KeyValuePair) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	dbName = normalizeDBName(dbName)
+	rate, ok := getDatabaseQuota(properties, common.DatabaseDDLRateMaxKey)
+	if !ok {
+		delete(l.limiters, dbName)
+		return
+	}
+	if l.limiters == nil {
+		l.limiters = make(map[string]*ratelimitutil.Limiter)
+	}
+	if limiter, ok := l.limiters[dbName]; ok {
+		limiter.SetLimit(ratelimitutil.Limit(rate))
+		return
+	}
+	l.limiters[dbName] = ratelimitutil.NewLimiter(ratelimitutil.Limit(rate), rate)
+}
+
+func (l *databaseDDLLimiter) Remove(dbName string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.limiters, normalizeDBName(dbName))
+}
+
+// Check returns the rate limit error if the DDL rate quota of the database exceeded.
+func (l *databaseDDLLimiter) Check(dbName string) error {
+	l.mu.Lock()
+	limiter, ok := l.limiters[normalizeDBName(dbName)]
+	l.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	if !limiter.AllowN(time.Now(), 1) {
+		return merr.WrapErrServiceRateLimit(float64(limiter.Limit()), "DDL rate of database "+normalizeDBName(dbName)+" exceeded")
+	}
+	return nil
+}
+
+func normalizeDBName(dbName string) string {
+	if dbName == "" {
+		return util.DefaultDBName
+	}
+	return dbName
+}
@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_validateDatabaseProperties(t *testing.T) {
+	assert.NoError(t, validateDatabaseProperties([]*commonpb.KeyValuePair{
+		{Key: common.DatabaseMaxCollectionsKey, Value: "10"},
+		{Key: common.DatabaseLoadedSizeQuotaKey, Value: "1024.5"},
+		{Key: common.DatabaseDDLRateMaxKey, Value: ""},
+		{Key: "other", Value: "abc"},
+	}))
+
+	for _, kv := range []*commonpb.KeyValuePair{
+		{Key: "database.unknown", Value: "10"},
+		{Key: common.DatabaseMaxCollectionsKey, Value: "abc"},
+		{Key: common.DatabaseDDLRateMaxKey, Value: "-1"},
+	} {
+		err := validateDatabaseProperties([]*commonpb.KeyValuePair{kv})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	}
+}
+
+func Test_mergeDatabaseProperties(t *testing.T) {
+	properties := []*commonpb.KeyValuePair{
+		{Key: common.DatabaseMaxCollectionsKey, Value: "10"},
+		{Key: common.DatabaseDDLRateMaxKey, Value: "1"},
+	}
+	merged := mergeDatabaseProperties(properties, []*commonpb.KeyValuePair{
+		{Key: common.DatabaseMaxCollectionsKey, Value: "20"},
+		{Key: common.DatabaseDDLRateMaxKey, Value: ""},
+		{Key: common.DatabaseLoadedSizeQuotaKey, Value: "100"},
+	})
+	assert.Equal(t, []*commonpb.KeyValuePair{
+		{Key: common.DatabaseMaxCollectionsKey, Value: "20"},
+		{Key: common.DatabaseLoadedSizeQuotaKey, Value: "100"},
+	}, merged)
+	// the origin properties are not changed
+	assert.Equal(t, "10", properties[0].GetValue())
+	assert.Len(t, properties, 2)
+
+	quota, ok := getDatabaseQuota(merged, common.DatabaseMaxCollectionsKey)
+	assert.True(t, ok)
+	assert.Equal(t, float64(20), quota)
+	_, ok = getDatabaseQuota(merged, common.DatabaseDDLRateMaxKey)
+	assert.False(t, ok)
+}
+
+func Test_databaseDDLLimiter(t *testing.T) {
+	var limiter databaseDDLLimiter
+	assert.NoError(t, limiter.Check("db1"))
+
+	limiter.Update("db1", []*commonpb.KeyValuePair{{Key: common.DatabaseDDLRateMaxKey, Value: "1"}})
+	assert.NoError(t, limiter.Check("db1"))
+	assert.NoError(t, limiter.Check("db1"))
+	err := limiter.Check("db1")
+	assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+	assert.NoError(t, limiter.Check("db2"))
+
+	// empty db name is the default database
+	limiter.Update("", []*commonpb.KeyValuePair{{Key: common.DatabaseDDLRateMaxKey, Value: "0"}})
+	assert.Error(t, limiter.Check("default"))
+
+	limiter.Update("db1", nil)
+	assert.NoError(t, limiter.Check("db1"))
+
+	limiter.Remove("default")
+	assert.NoError(t, limiter.Check(""))
+}
@@ -0,0 +1,123 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// ddlJobRetention is how long the finished ddl jobs are kept for describing.
+const ddlJobRetention = time.Hour
+
+// ddlJob traces the progress of the ddl task executed asynchronously.
+type ddlJob struct {
+	jobID          int64
+	jobType        string
+	dbName         string
+	collectionName string
+	state          proxypb.DDLJobState
+	failReason     string
+	startTime      time.Time
+	endTime        time.Time
+}
+
+// ddlJobManager keeps the asynchronous ddl jobs in memory, the jobs are lost once rootcoord restarted.
+type ddlJobManager struct {
+	mu   sync.RWMutex
+	jobs map[int64]*ddlJob
+}
+
+// submit enqueues the task into the scheduler and returns the job id without waiting for the task,
+// onDone is called once the task finished.
+func (m *ddlJobManager) submit(scheduler IScheduler, t task, jobType, dbName, collectionName string, onDone func(err error)) (int64, error) {
+	if err := scheduler.AddTask(t); err != nil {
+		return 0, err
+	}
+	job := &ddlJob{
+		jobID:          t.GetID(),
+		jobType:        jobType,
+		dbName:         dbName,
+		collectionName: collectionName,
+		state:          proxypb.DDLJobState_DDLJobInProgress,
+		startTime:      time.Now(),
+	}
+
+	m.mu.Lock()
+	if m.jobs == nil {
+		m.jobs = make(map[int64]*ddlJob)
+	}
+	for id, j := range m.jobs {
+		if j.state != proxypb.DDLJobState_DDLJobInProgress && time.Since(j.endTime) > ddlJobRetention {
+			delete(m.jobs, id)
+		}
+	}
+	m.jobs[job.jobID] = job
+	m.mu.Unlock()
+
+	go func() {
+		err := t.WaitToFinish()
+		m.mu.Lock()
+		job.endTime = time.Now()
+		if err != nil {
+			job.state = proxypb.DDLJobState_DDLJobFailed
+			job.failReason = err.Error()
+		} else {
+			job.state = proxypb.DDLJobState_DDLJobCompleted
+		}
+		m.mu.Unlock()
+		if onDone != nil {
+			onDone(err)
+		}
+	}()
+	return job.jobID, nil
+}
+
+func (m *ddlJobManager) describe(jobID int64) (*proxypb.DescribeDDLJobResponse, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	job, ok := m.jobs[jobID]
+	if !ok {
+		return nil, merr.WrapErrDDLJobNotFound(jobID)
+	}
+	resp := &proxypb.DescribeDDLJobResponse{
+		Status:         merr.Success(),
+		JobID:          job.jobID,
+		JobType:        job.jobType,
+		State:          job.state,
+		FailReason:     job.failReason,
+		DbName:         job.dbName,
+		CollectionName: job.collectionName,
+		StartTime:      job.startTime.UnixMilli(),
+	}
+	if !job.endTime.IsZero() {
+		resp.EndTime = job.endTime.UnixMilli()
+	}
+	return resp, nil
+}
+
+// detachCtx returns the context for the task outlives the request, only the trace of the request is kept.
+func detachCtx(ctx context.Context) context.Context {
+	return trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func Test_ddlJobManager(t *testing.T) {
+	t.Run("enqueue failed", func(t *testing.T) {
+		var m ddlJobManager
+		sched := newMockScheduler()
+		sched.AddTaskFunc = func(t task) error {
+			return errors.New("error mock AddTask")
+		}
+		bt := newBaseTask(context.Background(), nil)
+		_, err := m.submit(sched, &bt, "test", "db", "coll", nil)
+		assert.Error(t, err)
+		assert.Empty(t, m.jobs)
+	})
+
+	t.Run("job completed", func(t *testing.T) {
+		var m ddlJobManager
+		sched := newMockScheduler()
+		tasks := make(chan task, 1)
+		sched.AddTaskFunc = func(t task) error {
+			t.SetID(100)
+			tasks <- t
+			return nil
+		}
+		done := make(chan error, 1)
+		bt := newBaseTask(context.Background(), nil)
+		jobID, err := m.submit(sched, &bt, "test", "db", "coll", func(err error) {
+			done <- err
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(100), jobID)
+
+		resp, err := m.describe(jobID)
+		assert.NoError(t, err)
+		assert.Equal(t, proxypb.DDLJobState_DDLJobInProgress, resp.GetState())
+		assert.Equal(t, "test", resp.GetJobType())
+		assert.Equal(t, "db", resp.GetDbName())
+		assert.Equal(t, "coll", resp.GetCollectionName())
+		assert.Zero(t, resp.GetEndTime())
+
+		(<-tasks).NotifyDone(nil)
+		assert.NoError(t, <-done)
+		resp, err = m.describe(jobID)
+		assert.NoError(t, err)
+		assert.Equal(t, proxypb.DDLJobState_DDLJobCompleted, resp.GetState())
+		assert.NotZero(t, resp.GetEndTime())
+	})
+
+	t.Run("job failed", func(t *testing.T) {
+		var m ddlJobManager
+		sched := newMockScheduler()
+		sched.AddTaskFunc = func(t task) error {
+			t.SetID(100)
+			t.NotifyDone(errors.New("error mock task fail"))
+			return nil
+		}
+		done := make(chan error, 1)
+		bt := newBaseTask(context.Background(), nil)
+		jobID, err := m.submit(sched, &bt, "test", "db", "coll", func(err error) {
+			done <- err
+		})
+		assert.NoError(t, err)
+		assert.Error(t, <-done)
+
+		resp, err := m.describe(jobID)
+		assert.NoError(t, err)
+		assert.Equal(t, proxypb.DDLJobState_DDLJobFailed, resp.GetState())
+		assert.Contains(t, resp.GetFailReason(), "error mock task fail")
+	})
+
+	t.Run("job not found", func(t *testing.T) {
+		var m ddlJobManager
+		_, err := m.describe(100)
+		assert.ErrorIs(t, err, merr.ErrDDLJobNotFound)
+	})
+
+	t.Run("expired jobs cleaned", func(t *testing.T) {
+		m := ddlJobManager{jobs: map[int64]*ddlJob{
+			1: {jobID: 1, state: proxypb.DDLJobState_DDLJobCompleted, endTime: time.Now().Add(-2 * ddlJobRetention)},
+			2: {jobID: 2, state: proxypb.DDLJobState_DDLJobFailed, endTime: time.Now()},
+			3: {jobID: 3, state: proxypb.DDLJobState_DDLJobInProgress},
+		}}
+		sched := newMockScheduler()
+		sched.AddTaskFunc = func(t task) error {
+			t.SetID(4)
+			t.NotifyDone(nil)
+			return nil
+		}
+		bt := newBaseTask(context.Background(), nil)
+		_, err := m.submit(sched, &bt, "test", "db", "coll", nil)
+		assert.NoError(t, err)
+
+		_, err = m.describe(1)
+		assert.ErrorIs(t, err, merr.ErrDDLJobNotFound)
+		for _, id := range []int64{2, 3, 4} {
+			_, err = m.describe(id)
+			assert.NoError(t, err)
+		}
+	})
+}
@@ -67,6 +67,11 @@ func (t *dropCollectionTask) Execute(ctx context.Context) error {
 		return err
 	}
 
+	// the former drop of the undropped collection is still being abandoned.
+	if t.core.undroppedCollections.Contain(collMeta.CollectionID) {
+		return fmt.Errorf("collection %s is being undropped, retry later", t.Req.GetCollectionName())
+	}
+
 	// meta cache of all aliases should also be cleaned.
 	aliases := t.core.meta.ListAliasesByID(collMeta.CollectionID)
 
@@ -32,5 +32,9 @@ func (t *dropDatabaseTask) Prepare(ctx context.Context) error {
 }
 
 func (t *dropDatabaseTask) Execute(ctx context.Context) error {
-	return t.core.meta.DropDatabase(ctx, t.Req.GetDbName(), t.GetTs())
+	if err := t.core.meta.DropDatabase(ctx, t.Req.GetDbName(), t.GetTs()); err != nil {
+		return err
+	}
+	t.core.ddlLimiter.Remove(t.Req.GetDbName())
+	return nil
 }
@@ -17,9 +17,11 @@
 package rootcoord
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
@@ -36,3 +38,18 @@ func Test_expireCacheConfig_apply(t *testing.T) {
 	c.Apply(req)
 	assert.Equal(t, commonpb.MsgType_DropCollection, req.GetBase().GetMsgType())
 }
+
+func TestCore_ExpireMetaCache(t *testing.T) {
+	pcm := proxyutil.NewMockProxyClientManager(t)
+	pcm.EXPECT().InvalidateCollectionMetaCache(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, req *proxypb.InvalidateCollMetaCacheRequest, opts ...proxyutil.ExpireCacheOpt) error {
+			assert.Equal(t, "coll", req.GetCollectionName())
+			assert.Len(t, opts, 1)
+			return nil
+		}).Once()
+	// the invalidation is always broadcast by rpc, which returns once all the proxies invalidated their cache
+	c := newTestCore(withHealthyCode())
+	c.proxyClientManager = pcm
+	err := c.ExpireMetaCache(context.Background(), "db", []string{"coll"}, 1, "", 0, proxyutil.SetMsgType(commonpb.MsgType_DropCollection))
+	assert.NoError(t, err)
+}
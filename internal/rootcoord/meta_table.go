@@ -47,6 +47,7 @@ type IMetaTable interface {
 	GetDatabaseByID(ctx context.Context, dbID int64, ts Timestamp) (*model.Database, error)
 	GetDatabaseByName(ctx context.Context, dbName string, ts Timestamp) (*model.Database, error)
 	CreateDatabase(ctx context.Context, db *model.Database, ts typeutil.Timestamp) error
+	AlterDatabase(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts typeutil.Timestamp) error
 	DropDatabase(ctx context.Context, dbName string, ts typeutil.Timestamp) error
 	ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error)
 
@@ -92,6 +93,13 @@ type IMetaTable interface {
 	DropGrant(tenant string, role *milvuspb.RoleEntity) error
 	ListPolicy(tenant string) ([]string, error)
 	ListUserRole(tenant string) ([]string, error)
+
+	// TODO: better to accept ctx.
+	AddAPIKey(apiKey *model.APIKey) error
+	AlterAPIKey(apiKey *model.APIKey) error
+	GetAPIKey(name string) (*model.APIKey, error)
+	DropAPIKey(name string) error
+	ListAPIKeys() ([]*model.APIKey, error)
 }
 
 type MetaTable struct {
@@ -424,6 +432,10 @@ func (mt *MetaTable) ChangeCollectionState(ctx context.Context, collectionID Uni
 
 	switch state {
 	case pb.CollectionState_CollectionCreated:
+		if coll.State == pb.CollectionState_CollectionDropping {
+			// the undropped collection takes the name back.
+			mt.names.insert(db.Name, coll.Name, collectionID)
+		}
 		metrics.RootCoordNumOfCollections.WithLabelValues(db.Name).Inc()
 		metrics.RootCoordNumOfPartitions.WithLabelValues().Add(float64(coll.GetPartitionNum(true)))
 	default:
@@ -783,25 +795,69 @@ func (mt *MetaTable) RenameCollection(ctx context.Context, dbName string, oldNam
 		return err
 	}
 
-	// unsupported rename collection while the collection has aliases
-	aliases := mt.listAliasesByID(oldColl.CollectionID)
-	if len(aliases) > 0 && oldColl.DBID != targetDB.ID {
-		return fmt.Errorf("fail to rename db name, must drop all aliases of this collection before rename")
+	// the aliases are moved to the target db together with the collection
+	aliasNames := mt.listAliasesByID(oldColl.CollectionID)
+	aliases := make([]*model.Alias, 0, len(aliasNames))
+	for _, alias := range aliasNames {
+		if oldColl.DBID != targetDB.ID {
+			if _, ok := mt.names.get(newDBName, alias); ok {
+				return merr.WrapErrAliasCollectionNameConflict(newDBName, alias)
+			}
+			if _, ok := mt.aliases.get(newDBName, alias); ok {
+				return merr.WrapErrAliasAlreadyExist(newDBName, alias)
+			}
+		}
+		aliases = append(aliases, &model.Alias{
+			Name:         alias,
+			CollectionID: oldColl.CollectionID,
+			CreatedTime:  ts,
+			State:        pb.AliasState_AliasCreated,
+			DbID:         oldColl.DBID,
+		})
+	}
+
+	if oldColl.DBID != targetDB.ID {
+		if err := mt.checkCollectionQuotaOfDB(targetDB); err != nil {
+			return err
+		}
 	}
 
 	newColl = oldColl.Clone()
 	newColl.Name = newName
 	newColl.DBID = targetDB.ID
-	if err := mt.catalog.AlterCollection(ctx, oldColl, newColl, metastore.MODIFY, ts); err != nil {
+	if err := mt.catalog.RenameCollection(ctx, dbName, newDBName, oldColl, newColl, aliases, ts); err != nil {
 		return err
 	}
 
 	mt.names.insert(newDBName, newName, oldColl.CollectionID)
 	mt.names.remove(dbName, oldName)
+	for _, alias := range aliasNames {
+		mt.aliases.remove(dbName, alias)
+		mt.aliases.insert(newDBName, alias, oldColl.CollectionID)
+	}
 
 	mt.collID2Meta[oldColl.CollectionID] = newColl
 
-	log.Info("rename collection finished")
+	log.Info("rename collection finished", zap.Strings("aliases", aliasNames))
+	return nil
+}
+
+// checkCollectionQuotaOfDB checks one more collection could be put into the database.
+func (mt *MetaTable) checkCollectionQuotaOfDB(db *model.Database) error {
+	collectionNum := 0
+	for _, coll := range mt.collID2Meta {
+		if coll.DBID == db.ID && coll.Available() {
+			collectionNum++
+		}
+	}
+
+	maxColNumPerDB := Params.QuotaConfig.MaxCollectionNumPerDB.GetAsInt()
+	if collectionNum >= maxColNumPerDB {
+		return merr.WrapErrCollectionNumLimitExceeded(maxColNumPerDB, "max number of collection has reached the limit in DB")
+	}
+	if dbMaxCollections, ok := getDatabaseQuota(db.Properties, common.DatabaseMaxCollectionsKey); ok && collectionNum >= int(dbMaxCollections) {
+		return merr.WrapErrDatabaseQuotaExceeded(db.Name, common.DatabaseMaxCollectionsKey, int(dbMaxCollections))
+	}
 	return nil
 }
 
@@ -1390,3 +1446,57 @@ func (mt *MetaTable) ListUserRole(tenant string) ([]string, error) {
 
 	return mt.catalog.ListUserRole(mt.ctx, tenant)
 }
+
+// AddAPIKey add api key
+func (mt *MetaTable) AddAPIKey(apiKey *model.APIKey) error {
+	if funcutil.IsEmptyString(apiKey.Name) {
+		return fmt.Errorf("the api key name is empty")
+	}
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	if _, err := mt.catalog.GetAPIKey(mt.ctx, apiKey.Name); err == nil {
+		return merr.WrapErrAPIKeyAlreadyExist(apiKey.Name)
+	} else if !errors.Is(err, merr.ErrAPIKeyNotFound) {
+		return err
+	}
+	return mt.catalog.SaveAPIKey(mt.ctx, apiKey)
+}
+
+// AlterAPIKey update the existing api key
+func (mt *MetaTable) AlterAPIKey(apiKey *model.APIKey) error {
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	if _, err := mt.catalog.GetAPIKey(mt.ctx, apiKey.Name); err != nil {
+		return err
+	}
+	return mt.catalog.SaveAPIKey(mt.ctx, apiKey)
+}
+
+// GetAPIKey get api key by name
+func (mt *MetaTable) GetAPIKey(name string) (*model.APIKey, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	return mt.catalog.GetAPIKey(mt.ctx, name)
+}
+
+// DropAPIKey drop the existing api key
+func (mt *MetaTable) DropAPIKey(name string) error {
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	if _, err := mt.catalog.GetAPIKey(mt.ctx, name); err != nil {
+		return err
+	}
+	return mt.catalog.DropAPIKey(mt.ctx, name)
+}
+
+// ListAPIKeys list all the api keys
+func (mt *MetaTable) ListAPIKeys() ([]*model.APIKey, error) {
+	mt.permissionLock.RLock()
+	defer mt.permissionLock.RUnlock()
+
+	return mt.catalog.ListAPIKeys(mt.ctx)
+}
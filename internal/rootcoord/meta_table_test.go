@@ -448,6 +448,42 @@ func TestRbacListPolicy(t *testing.T) {
 	assert.Equal(t, 0, len(userRoles))
 }
 
+func TestRbacAPIKey(t *testing.T) {
+	mt := generateMetaTable(t)
+
+	apiKey := &model.APIKey{Name: "key1", SecretHash: "hash1", Roles: []string{"role1"}}
+	err := mt.AddAPIKey(apiKey)
+	require.NoError(t, err)
+
+	err = mt.AddAPIKey(&model.APIKey{Name: ""})
+	assert.Error(t, err)
+	err = mt.AddAPIKey(&model.APIKey{Name: "key1"})
+	assert.ErrorIs(t, err, merr.ErrAPIKeyAlreadyExist)
+
+	ret, err := mt.GetAPIKey("key1")
+	assert.NoError(t, err)
+	assert.Equal(t, apiKey, ret)
+
+	err = mt.AlterAPIKey(&model.APIKey{Name: "key1", SecretHash: "hash2", Roles: []string{"role1"}})
+	assert.NoError(t, err)
+	ret, err = mt.GetAPIKey("key1")
+	assert.NoError(t, err)
+	assert.Equal(t, "hash2", ret.SecretHash)
+	err = mt.AlterAPIKey(&model.APIKey{Name: "key2"})
+	assert.ErrorIs(t, err, merr.ErrAPIKeyNotFound)
+
+	apiKeys, err := mt.ListAPIKeys()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(apiKeys))
+
+	err = mt.DropAPIKey("key1")
+	assert.NoError(t, err)
+	err = mt.DropAPIKey("key1")
+	assert.ErrorIs(t, err, merr.ErrAPIKeyNotFound)
+	_, err = mt.GetAPIKey("key1")
+	assert.ErrorIs(t, err, merr.ErrAPIKeyNotFound)
+}
+
 func TestMetaTable_getCollectionByIDInternal(t *testing.T) {
 	t.Run("failed to get from catalog", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
@@ -1377,6 +1413,28 @@ func TestMetaTable_ChangeCollectionState(t *testing.T) {
 		err = meta.ChangeCollectionState(context.TODO(), 100, pb.CollectionState_CollectionDropping, 1000)
 		assert.NoError(t, err)
 	})
+
+	t.Run("undrop collection", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().AlterCollection(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		meta := &MetaTable{
+			catalog: catalog,
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: {Name: util.DefaultDBName, ID: util.DefaultDBID},
+			},
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				100: {Name: "test", CollectionID: 100, DBID: util.DefaultDBID, State: pb.CollectionState_CollectionDropping},
+			},
+			names: newNameDb(),
+		}
+		// the name was taken by another collection which is dropped as well
+		meta.names.insert(util.DefaultDBName, "test", 101)
+		err := meta.ChangeCollectionState(context.TODO(), 100, pb.CollectionState_CollectionCreated, 1000)
+		assert.NoError(t, err)
+		collectionID, ok := meta.names.get(util.DefaultDBName, "test")
+		assert.True(t, ok)
+		assert.EqualValues(t, 100, collectionID)
+	})
 }
 
 func TestMetaTable_AddPartition(t *testing.T) {
@@ -1519,13 +1577,8 @@ func TestMetaTable_RenameCollection(t *testing.T) {
 
 	t.Run("alter collection fail", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
-		catalog.On("AlterCollection",
-			mock.Anything,
-			mock.Anything,
-			mock.Anything,
-			mock.Anything,
-			mock.Anything,
-		).Return(errors.New("fail"))
+		catalog.EXPECT().RenameCollection(mock.Anything, util.DefaultDBName, util.DefaultDBName, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(errors.New("fail"))
 		catalog.On("GetCollectionByName",
 			mock.Anything,
 			mock.Anything,
@@ -1552,7 +1605,7 @@ func TestMetaTable_RenameCollection(t *testing.T) {
 		assert.Error(t, err)
 	})
 
-	t.Run("rename db name fails if aliases exists", func(t *testing.T) {
+	t.Run("rename db name moves aliases", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
 		catalog.On("GetCollectionByName",
 			mock.Anything,
@@ -1560,6 +1613,13 @@ func TestMetaTable_RenameCollection(t *testing.T) {
 			mock.Anything,
 			mock.Anything,
 		).Return(nil, merr.WrapErrCollectionNotFound("error"))
+		catalog.EXPECT().RenameCollection(mock.Anything, util.DefaultDBName, "db1", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, oldDBName string, newDBName string, oldColl *model.Collection, newColl *model.Collection, aliases []*model.Alias, ts uint64) error {
+				assert.Equal(t, int64(2), newColl.DBID)
+				assert.Equal(t, 1, len(aliases))
+				assert.Equal(t, "alias", aliases[0].Name)
+				return nil
+			})
 		meta := &MetaTable{
 			dbName2Meta: map[string]*model.Database{
 				util.DefaultDBName: model.NewDefaultDatabase(),
@@ -1579,18 +1639,95 @@ func TestMetaTable_RenameCollection(t *testing.T) {
 		meta.aliases.insert(util.DefaultDBName, "alias", 1)
 
 		err := meta.RenameCollection(context.TODO(), util.DefaultDBName, "old", "db1", "new", 1000)
-		assert.Error(t, err)
+		assert.NoError(t, err)
+
+		_, ok := meta.aliases.get(util.DefaultDBName, "alias")
+		assert.False(t, ok)
+		id, ok := meta.aliases.get("db1", "alias")
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), id)
+		id, ok = meta.names.get("db1", "new")
+		assert.True(t, ok)
+		assert.Equal(t, int64(1), id)
 	})
 
-	t.Run("alter collection ok", func(t *testing.T) {
+	t.Run("rename db name fails if alias conflicts", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
-		catalog.On("AlterCollection",
+		catalog.On("GetCollectionByName",
+			mock.Anything,
+			mock.Anything,
 			mock.Anything,
 			mock.Anything,
+		).Return(nil, merr.WrapErrCollectionNotFound("error"))
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+				"db1":              model.NewDatabase(2, "db1", pb.DatabaseState_DatabaseCreated),
+			},
+			catalog: catalog,
+			names:   newNameDb(),
+			aliases: newNameDb(),
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				1: {
+					CollectionID: 1,
+					Name:         "old",
+				},
+			},
+		}
+		meta.names.insert(util.DefaultDBName, "old", 1)
+		meta.aliases.insert(util.DefaultDBName, "alias", 1)
+		meta.aliases.insert("db1", "alias", 3)
+
+		err := meta.RenameCollection(context.TODO(), util.DefaultDBName, "old", "db1", "new", 1000)
+		assert.ErrorIs(t, err, merr.ErrAliasAlreadyExist)
+
+		meta.aliases.remove("db1", "alias")
+		meta.names.insert("db1", "alias", 3)
+		err = meta.RenameCollection(context.TODO(), util.DefaultDBName, "old", "db1", "new", 1000)
+		assert.ErrorIs(t, err, merr.ErrAliasCollectionNameConfilct)
+	})
+
+	t.Run("rename db name fails if quota of target db exceeded", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("GetCollectionByName",
+			mock.Anything,
 			mock.Anything,
 			mock.Anything,
 			mock.Anything,
-		).Return(nil)
+		).Return(nil, merr.WrapErrCollectionNotFound("error"))
+		db1 := model.NewDatabase(2, "db1", pb.DatabaseState_DatabaseCreated)
+		db1.Properties = []*commonpb.KeyValuePair{{Key: common.DatabaseMaxCollectionsKey, Value: "1"}}
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{
+				util.DefaultDBName: model.NewDefaultDatabase(),
+				"db1":              db1,
+			},
+			catalog: catalog,
+			names:   newNameDb(),
+			aliases: newNameDb(),
+			collID2Meta: map[typeutil.UniqueID]*model.Collection{
+				1: {
+					CollectionID: 1,
+					Name:         "old",
+				},
+				2: {
+					CollectionID: 2,
+					DBID:         2,
+					Name:         "other",
+					State:        pb.CollectionState_CollectionCreated,
+				},
+			},
+		}
+		meta.names.insert(util.DefaultDBName, "old", 1)
+		meta.names.insert("db1", "other", 2)
+
+		err := meta.RenameCollection(context.TODO(), util.DefaultDBName, "old", "db1", "new", 1000)
+		assert.ErrorIs(t, err, merr.ErrDatabaseQuotaExceeded)
+	})
+
+	t.Run("alter collection ok", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.EXPECT().RenameCollection(mock.Anything, util.DefaultDBName, util.DefaultDBName, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 		catalog.On("GetCollectionByName",
 			mock.Anything,
 			mock.Anything,
@@ -391,6 +391,12 @@ func withValidProxyManager() Opt {
 		p.InvalidateCollectionMetaCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCollMetaCacheRequest) (*commonpb.Status, error) {
 			return merr.Success(), nil
 		}
+		p.RefreshPolicyInfoCacheFunc = func(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+			return merr.Success(), nil
+		}
+		p.InvalidateCredentialCacheFunc = func(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+			return merr.Success(), nil
+		}
 		p.GetComponentStatesFunc = func(ctx context.Context) (*milvuspb.ComponentStates, error) {
 			return &milvuspb.ComponentStates{
 				State:  &milvuspb.ComponentInfo{StateCode: commonpb.StateCode_Healthy},
@@ -896,7 +902,8 @@ type mockBroker struct {
 
 	BroadcastAlteredCollectionFunc func(ctx context.Context, req *milvuspb.AlterCollectionRequest) error
 
-	GCConfirmFunc func(ctx context.Context, collectionID, partitionID UniqueID) bool
+	GCConfirmFunc              func(ctx context.Context, collectionID, partitionID UniqueID) bool
+	RestoreDroppedSegmentsFunc func(ctx context.Context, collectionID UniqueID) error
 }
 
 func newMockBroker() *mockBroker {
@@ -943,6 +950,10 @@ func (b mockBroker) GcConfirm(ctx context.Context, collectionID, partitionID Uni
 	return b.GCConfirmFunc(ctx, collectionID, partitionID)
 }
 
+func (b mockBroker) RestoreDroppedSegments(ctx context.Context, collectionID UniqueID) error {
+	return b.RestoreDroppedSegmentsFunc(ctx, collectionID)
+}
+
 func withBroker(b Broker) Opt {
 	return func(c *Core) {
 		c.broker = b
@@ -28,6 +28,48 @@ func (_m *IMetaTable) EXPECT() *IMetaTable_Expecter {
 	return &IMetaTable_Expecter{mock: &_m.Mock}
 }
 
+// AddAPIKey provides a mock function with given fields: apiKey
+func (_m *IMetaTable) AddAPIKey(apiKey *model.APIKey) error {
+	ret := _m.Called(apiKey)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.APIKey) error); ok {
+		r0 = rf(apiKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AddAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAPIKey'
+type IMetaTable_AddAPIKey_Call struct {
+	*mock.Call
+}
+
+// AddAPIKey is a helper method to define mock.On call
+//   - apiKey *model.APIKey
+func (_e *IMetaTable_Expecter) AddAPIKey(apiKey interface{}) *IMetaTable_AddAPIKey_Call {
+	return &IMetaTable_AddAPIKey_Call{Call: _e.mock.On("AddAPIKey", apiKey)}
+}
+
+func (_c *IMetaTable_AddAPIKey_Call) Run(run func(apiKey *model.APIKey)) *IMetaTable_AddAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.APIKey))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AddAPIKey_Call) Return(_a0 error) *IMetaTable_AddAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AddAPIKey_Call) RunAndReturn(run func(*model.APIKey) error) *IMetaTable_AddAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddCollection provides a mock function with given fields: ctx, coll
 func (_m *IMetaTable) AddCollection(ctx context.Context, coll *model.Collection) error {
 	ret := _m.Called(ctx, coll)
@@ -156,6 +198,48 @@ func (_c *IMetaTable_AddPartition_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// AlterAPIKey provides a mock function with given fields: apiKey
+func (_m *IMetaTable) AlterAPIKey(apiKey *model.APIKey) error {
+	ret := _m.Called(apiKey)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.APIKey) error); ok {
+		r0 = rf(apiKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AlterAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterAPIKey'
+type IMetaTable_AlterAPIKey_Call struct {
+	*mock.Call
+}
+
+// AlterAPIKey is a helper method to define mock.On call
+//   - apiKey *model.APIKey
+func (_e *IMetaTable_Expecter) AlterAPIKey(apiKey interface{}) *IMetaTable_AlterAPIKey_Call {
+	return &IMetaTable_AlterAPIKey_Call{Call: _e.mock.On("AlterAPIKey", apiKey)}
+}
+
+func (_c *IMetaTable_AlterAPIKey_Call) Run(run func(apiKey *model.APIKey)) *IMetaTable_AlterAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.APIKey))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AlterAPIKey_Call) Return(_a0 error) *IMetaTable_AlterAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AlterAPIKey_Call) RunAndReturn(run func(*model.APIKey) error) *IMetaTable_AlterAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterAlias provides a mock function with given fields: ctx, dbName, alias, collectionName, ts
 func (_m *IMetaTable) AlterAlias(ctx context.Context, dbName string, alias string, collectionName string, ts uint64) error {
 	ret := _m.Called(ctx, dbName, alias, collectionName, ts)
@@ -289,6 +373,51 @@ func (_c *IMetaTable_AlterCredential_Call) RunAndReturn(run func(*internalpb.Cre
 	return _c
 }
 
+// AlterDatabase provides a mock function with given fields: ctx, oldDB, newDB, ts
+func (_m *IMetaTable) AlterDatabase(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts uint64) error {
+	ret := _m.Called(ctx, oldDB, newDB, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Database, *model.Database, uint64) error); ok {
+		r0 = rf(ctx, oldDB, newDB, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type IMetaTable_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldDB *model.Database
+//   - newDB *model.Database
+//   - ts uint64
+func (_e *IMetaTable_Expecter) AlterDatabase(ctx interface{}, oldDB interface{}, newDB interface{}, ts interface{}) *IMetaTable_AlterDatabase_Call {
+	return &IMetaTable_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", ctx, oldDB, newDB, ts)}
+}
+
+func (_c *IMetaTable_AlterDatabase_Call) Run(run func(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts uint64)) *IMetaTable_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Database), args[2].(*model.Database), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AlterDatabase_Call) Return(_a0 error) *IMetaTable_AlterDatabase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AlterDatabase_Call) RunAndReturn(run func(context.Context, *model.Database, *model.Database, uint64) error) *IMetaTable_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ChangeCollectionState provides a mock function with given fields: ctx, collectionID, state, ts
 func (_m *IMetaTable) ChangeCollectionState(ctx context.Context, collectionID int64, state etcdpb.CollectionState, ts uint64) error {
 	ret := _m.Called(ctx, collectionID, state, ts)
@@ -610,6 +739,48 @@ func (_c *IMetaTable_DescribeAlias_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// DropAPIKey provides a mock function with given fields: name
+func (_m *IMetaTable) DropAPIKey(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_DropAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropAPIKey'
+type IMetaTable_DropAPIKey_Call struct {
+	*mock.Call
+}
+
+// DropAPIKey is a helper method to define mock.On call
+//   - name string
+func (_e *IMetaTable_Expecter) DropAPIKey(name interface{}) *IMetaTable_DropAPIKey_Call {
+	return &IMetaTable_DropAPIKey_Call{Call: _e.mock.On("DropAPIKey", name)}
+}
+
+func (_c *IMetaTable_DropAPIKey_Call) Run(run func(name string)) *IMetaTable_DropAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_DropAPIKey_Call) Return(_a0 error) *IMetaTable_DropAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_DropAPIKey_Call) RunAndReturn(run func(string) error) *IMetaTable_DropAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropAlias provides a mock function with given fields: ctx, dbName, alias, ts
 func (_m *IMetaTable) DropAlias(ctx context.Context, dbName string, alias string, ts uint64) error {
 	ret := _m.Called(ctx, dbName, alias, ts)
@@ -785,6 +956,60 @@ func (_c *IMetaTable_DropRole_Call) RunAndReturn(run func(string, string) error)
 	return _c
 }
 
+// GetAPIKey provides a mock function with given fields: name
+func (_m *IMetaTable) GetAPIKey(name string) (*model.APIKey, error) {
+	ret := _m.Called(name)
+
+	var r0 *model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.APIKey, error)); ok {
+		return rf(name)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.APIKey); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_GetAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAPIKey'
+type IMetaTable_GetAPIKey_Call struct {
+	*mock.Call
+}
+
+// GetAPIKey is a helper method to define mock.On call
+//   - name string
+func (_e *IMetaTable_Expecter) GetAPIKey(name interface{}) *IMetaTable_GetAPIKey_Call {
+	return &IMetaTable_GetAPIKey_Call{Call: _e.mock.On("GetAPIKey", name)}
+}
+
+func (_c *IMetaTable_GetAPIKey_Call) Run(run func(name string)) *IMetaTable_GetAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_GetAPIKey_Call) Return(_a0 *model.APIKey, _a1 error) *IMetaTable_GetAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_GetAPIKey_Call) RunAndReturn(run func(string) (*model.APIKey, error)) *IMetaTable_GetAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetCollectionByID provides a mock function with given fields: ctx, dbName, collectionID, ts, allowUnavailable
 func (_m *IMetaTable) GetCollectionByID(ctx context.Context, dbName string, collectionID int64, ts uint64, allowUnavailable bool) (*model.Collection, error) {
 	ret := _m.Called(ctx, dbName, collectionID, ts, allowUnavailable)
@@ -1153,6 +1378,59 @@ func (_c *IMetaTable_IsAlias_Call) RunAndReturn(run func(string, string) bool) *
 	return _c
 }
 
+// ListAPIKeys provides a mock function with given fields:
+func (_m *IMetaTable) ListAPIKeys() ([]*model.APIKey, error) {
+	ret := _m.Called()
+
+	var r0 []*model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*model.APIKey, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.APIKey); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAPIKeys'
+type IMetaTable_ListAPIKeys_Call struct {
+	*mock.Call
+}
+
+// ListAPIKeys is a helper method to define mock.On call
+func (_e *IMetaTable_Expecter) ListAPIKeys() *IMetaTable_ListAPIKeys_Call {
+	return &IMetaTable_ListAPIKeys_Call{Call: _e.mock.On("ListAPIKeys")}
+}
+
+func (_c *IMetaTable_ListAPIKeys_Call) Run(run func()) *IMetaTable_ListAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListAPIKeys_Call) Return(_a0 []*model.APIKey, _a1 error) *IMetaTable_ListAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListAPIKeys_Call) RunAndReturn(run func() ([]*model.APIKey, error)) *IMetaTable_ListAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAliases provides a mock function with given fields: ctx, dbName, collectionName, ts
 func (_m *IMetaTable) ListAliases(ctx context.Context, dbName string, collectionName string, ts uint64) ([]string, error) {
 	ret := _m.Called(ctx, dbName, collectionName, ts)
@@ -85,6 +85,7 @@ type collectionStates = map[milvuspb.QuotaState]commonpb.ErrorCode
 //  5. DQL queue latency protection ->  dqlRate = curDQLRate * CoolOffSpeed
 //  6. Search result protection ->	 	searchRate = curSearchRate * CoolOffSpeed
 //  7. GrowingSegsSize protection ->    dmlRate = maxDMLRate * (high - cur) / (high - low)
+//  8. Database loaded size quota ->    force deny writing the database if exceeded
 //
 // If necessary, user can also manually force to deny RW requests.
 type QuotaCenter struct {
@@ -515,6 +516,7 @@ func (q *QuotaCenter) calculateWriteRates() error {
 	}
 
 	q.checkDiskQuota()
+	q.checkDatabaseLoadedSizeQuota()
 
 	ts, err := q.tsoAllocator.GenerateTSO(1)
 	if err != nil {
@@ -879,6 +881,53 @@ func (q *QuotaCenter) checkDiskQuota() {
 	q.totalBinlogSize = total
 }
 
+// checkDatabaseLoadedSizeQuota checks if the loaded size quota of database exceeded,
+// writing into all the collections of the database are denied if so.
+func (q *QuotaCenter) checkDatabaseLoadedSizeQuota() {
+	q.diskMu.Lock()
+	defer q.diskMu.Unlock()
+	if q.dataCoordMetrics == nil {
+		return
+	}
+	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
+
+	db2LoadedSize := make(map[int64]int64)
+	db2Collections := make(map[int64][]int64)
+	for _, collection := range q.readableCollections {
+		// dbName can be ignored if ts is max timestamps
+		collectionInfo, err := q.meta.GetCollectionByID(context.TODO(), "", collection, typeutil.MaxTimestamp, false)
+		if err != nil {
+			continue
+		}
+		db2LoadedSize[collectionInfo.DBID] += q.dataCoordMetrics.CollectionBinlogSize[collection]
+	}
+	for _, collection := range q.writableCollections {
+		collectionInfo, err := q.meta.GetCollectionByID(context.TODO(), "", collection, typeutil.MaxTimestamp, false)
+		if err != nil {
+			continue
+		}
+		db2Collections[collectionInfo.DBID] = append(db2Collections[collectionInfo.DBID], collection)
+	}
+
+	for dbID, loadedSize := range db2LoadedSize {
+		db, err := q.meta.GetDatabaseByID(context.TODO(), dbID, typeutil.MaxTimestamp)
+		if err != nil {
+			continue
+		}
+		quota, ok := getDatabaseQuota(db.Properties, common.DatabaseLoadedSizeQuotaKey)
+		if !ok || float64(loadedSize) < quota*1024*1024 {
+			continue
+		}
+		log.RatedWarn(10, "database loaded size quota exceeded",
+			zap.String("db", db.Name),
+			zap.Int64("loaded size", loadedSize),
+			zap.Float64("loaded size quota(MB)", quota))
+		if len(db2Collections[dbID]) > 0 {
+			q.forceDenyWriting(commonpb.ErrorCode_MemoryQuotaExhausted, db2Collections[dbID]...)
+		}
+	}
+}
+
 // setRates notifies Proxies to set rates for different rate types.
 func (q *QuotaCenter) setRates() error {
 	ctx, cancel := context.WithTimeout(q.ctx, SetRatesTimeout)
@@ -570,6 +570,47 @@ func TestQuotaCenter(t *testing.T) {
 		paramtable.Get().Save(Params.QuotaConfig.DiskQuotaPerCollection.Key, colQuotaBackup)
 	})
 
+	t.Run("test checkDatabaseLoadedSizeQuota", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoordClient(t)
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, dbName string, collectionID int64, ts uint64, allowUnavailable bool) (*model.Collection, error) {
+				if collectionID == 3 {
+					return &model.Collection{CollectionID: collectionID, DBID: 2}, nil
+				}
+				return &model.Collection{CollectionID: collectionID, DBID: 1}, nil
+			}).Maybe()
+		meta.EXPECT().GetDatabaseByID(mock.Anything, int64(1), mock.Anything).Return(&model.Database{
+			ID:         1,
+			Name:       "db1",
+			Properties: []*commonpb.KeyValuePair{{Key: common.DatabaseLoadedSizeQuotaKey, Value: "100"}},
+		}, nil).Maybe()
+		meta.EXPECT().GetDatabaseByID(mock.Anything, int64(2), mock.Anything).Return(&model.Database{ID: 2, Name: "db2"}, nil).Maybe()
+		quotaCenter := NewQuotaCenter(pcm, qc, dc, core.tsoAllocator, meta)
+		quotaCenter.checkDatabaseLoadedSizeQuota()
+
+		quotaCenter.readableCollections = []int64{1, 2, 3}
+		quotaCenter.writableCollections = []int64{1, 2, 3}
+		quotaCenter.dataCoordMetrics = &metricsinfo.DataCoordQuotaMetrics{CollectionBinlogSize: map[int64]int64{
+			1: 20 * 1024 * 1024, 2: 30 * 1024 * 1024, 3: 200 * 1024 * 1024,
+		}}
+		quotaCenter.resetAllCurrentRates()
+		quotaCenter.checkDatabaseLoadedSizeQuota()
+		for _, collection := range []int64{1, 2, 3} {
+			assert.NotEqual(t, Limit(0), quotaCenter.currentRates[collection][internalpb.RateType_DMLInsert])
+		}
+
+		quotaCenter.dataCoordMetrics = &metricsinfo.DataCoordQuotaMetrics{CollectionBinlogSize: map[int64]int64{
+			1: 60 * 1024 * 1024, 2: 50 * 1024 * 1024, 3: 200 * 1024 * 1024,
+		}}
+		quotaCenter.resetAllCurrentRates()
+		quotaCenter.checkDatabaseLoadedSizeQuota()
+		assert.Equal(t, Limit(0), quotaCenter.currentRates[1][internalpb.RateType_DMLInsert])
+		assert.Equal(t, Limit(0), quotaCenter.currentRates[2][internalpb.RateType_DMLUpsert])
+		assert.Equal(t, commonpb.ErrorCode_MemoryQuotaExhausted, quotaCenter.quotaStates[2][milvuspb.QuotaState_DenyToWrite])
+		assert.NotEqual(t, Limit(0), quotaCenter.currentRates[3][internalpb.RateType_DMLInsert])
+	})
+
 	t.Run("test setRates", func(t *testing.T) {
 		qc := mocks.NewMockQueryCoordClient(t)
 		pcm.EXPECT().GetProxyCount().Return(1)
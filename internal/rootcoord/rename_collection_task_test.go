@@ -25,6 +25,8 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func Test_renameCollectionTask_Prepare(t *testing.T) {
@@ -54,8 +56,30 @@ func Test_renameCollectionTask_Prepare(t *testing.T) {
 }
 
 func Test_renameCollectionTask_Execute(t *testing.T) {
+	t.Run("collection not found", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return nil, merr.WrapErrCollectionNotFound(collectionName)
+		}
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.RenameCollectionRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_RenameCollection,
+				},
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+	})
+
 	t.Run("failed to expire cache", func(t *testing.T) {
-		core := newTestCore(withInvalidProxyManager())
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 1}, nil
+		}
+		core := newTestCore(withInvalidProxyManager(), withMeta(meta))
 		task := &renameCollectionTask{
 			baseTask: newBaseTask(context.Background(), core),
 			Req: &milvuspb.RenameCollectionRequest{
@@ -70,6 +94,9 @@ func Test_renameCollectionTask_Execute(t *testing.T) {
 
 	t.Run("failed to rename collection", func(t *testing.T) {
 		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 1}, nil
+		}
 		meta.RenameCollectionFunc = func(ctx context.Context, oldName string, newName string, ts Timestamp) error {
 			return errors.New("fail")
 		}
@@ -86,4 +113,27 @@ func Test_renameCollectionTask_Execute(t *testing.T) {
 		err := task.Execute(context.Background())
 		assert.Error(t, err)
 	})
+
+	t.Run("ok", func(t *testing.T) {
+		meta := newMockMetaTable()
+		meta.GetCollectionByNameFunc = func(ctx context.Context, collectionName string, ts Timestamp) (*model.Collection, error) {
+			return &model.Collection{CollectionID: 1}, nil
+		}
+		meta.RenameCollectionFunc = func(ctx context.Context, oldName string, newName string, ts Timestamp) error {
+			return nil
+		}
+
+		core := newTestCore(withValidProxyManager(), withMeta(meta))
+		task := &renameCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &milvuspb.RenameCollectionRequest{
+				Base: &commonpb.MsgBase{
+					MsgType: commonpb.MsgType_RenameCollection,
+				},
+				NewDBName: "db1",
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+	})
 }
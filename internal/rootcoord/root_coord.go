@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"sort"
 	"sync"
 	"time"
 
@@ -116,6 +117,13 @@ type Core struct {
 	queryCoord types.QueryCoordClient
 
 	quotaCenter *QuotaCenter
+	ddlLimiter  databaseDDLLimiter
+	ddlJobs     ddlJobManager
+
+	// the dropped collections waiting for the gc to finish, which could be undropped,
+	// and the undropped collections whose pending drop should be abandoned
+	gcPendingCollections typeutil.ConcurrentSet[UniqueID]
+	undroppedCollections typeutil.ConcurrentSet[UniqueID]
 
 	stateCode atomic.Int32
 	initOnce  sync.Once
@@ -435,6 +443,10 @@ func (c *Core) initInternal() error {
 		return err
 	}
 
+	if err := c.initDatabaseDDLLimiter(); err != nil {
+		return err
+	}
+
 	c.scheduler = newScheduler(c.ctx, c.idAllocator, c.tsoAllocator)
 
 	c.factory.Init(Params)
@@ -456,6 +468,13 @@ func (c *Core) initInternal() error {
 	)
 	c.proxyWatcher.AddSessionFunc(c.chanTimeTick.addSession, c.proxyClientManager.AddProxyClient)
 	c.proxyWatcher.DelSessionFunc(c.chanTimeTick.delSession, c.proxyClientManager.DelProxyClient)
+	if Params.RootCoordCfg.TimeTickMonitorEnabled.GetAsBool() && Params.RootCoordCfg.TimeTickAutoFence.GetAsBool() {
+		// the proxies stalled are fenced through etcd, the fences of the proxies gone are useless
+		c.chanTimeTick.fenceSession = func(serverID UniqueID, reason string) error {
+			return c.session.FenceSession(c.ctx, serverID, reason)
+		}
+		c.proxyWatcher.DelSessionFunc(c.removeSessionFence)
+	}
 	log.Info("init proxy manager done")
 
 	c.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
@@ -701,6 +720,25 @@ func (c *Core) startServerLoop() {
 	go c.startTimeTickLoop()
 	go c.tsLoop()
 	go c.chanTimeTick.startWatch(&c.wg)
+	if Params.RootCoordCfg.TimeTickMonitorEnabled.GetAsBool() {
+		c.wg.Add(1)
+		go c.chanTimeTick.startMonitor(&c.wg)
+	}
+	if c.chanTimeTick.fenceSession != nil {
+		// the proxy fenced re-registers by removing its fence
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			c.session.WatchFences(c.ctx, nil, c.chanTimeTick.unfenceSession)
+		}()
+	}
+}
+
+// removeSessionFence removes the fence of the proxy gone.
+func (c *Core) removeSessionFence(sess *sessionutil.Session) {
+	if err := c.session.UnfenceSession(c.ctx, sess.ServerID); err != nil {
+		log.Warn("fail to remove the fence of the proxy", zap.Int64("serverID", sess.ServerID), zap.Error(err))
+	}
 }
 
 // Start starts RootCoord.
@@ -946,6 +984,9 @@ func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollecti
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	if err := c.ddlLimiter.Check(in.GetDbName()); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("CreateCollection", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("CreateCollection")
@@ -997,6 +1038,9 @@ func (c *Core) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRe
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	if err := c.ddlLimiter.Check(in.GetDbName()); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("DropCollection", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("DropCollection")
@@ -1235,6 +1279,9 @@ func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollection
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	if err := c.ddlLimiter.Check(in.GetDbName()); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterCollection", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("AlterCollection")
@@ -1242,7 +1289,7 @@ func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollection
 	log.Ctx(ctx).Info("received request to alter collection",
 		zap.String("role", typeutil.RootCoordRole),
 		zap.String("name", in.GetCollectionName()),
-		zap.Any("props", in.Properties))
+		zap.Any("props", common.RedactProperties(in.Properties...)))
 
 	t := &alterCollectionTask{
 		baseTask: newBaseTask(ctx, c),
@@ -1286,6 +1333,9 @@ func (c *Core) CreatePartition(ctx context.Context, in *milvuspb.CreatePartition
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	if err := c.ddlLimiter.Check(in.GetDbName()); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("CreatePartition", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("CreatePartition")
@@ -1340,6 +1390,9 @@ func (c *Core) DropPartition(ctx context.Context, in *milvuspb.DropPartitionRequ
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	if err := c.ddlLimiter.Check(in.GetDbName()); err != nil {
+		return merr.Status(err), nil
+	}
 
 	metrics.RootCoordDDLReqCounter.WithLabelValues("DropPartition", metrics.TotalLabel).Inc()
 	tr := timerecord.NewTimeRecorder("DropPartition")
@@ -2630,6 +2683,15 @@ func (c *Core) RenameCollection(ctx context.Context, req *milvuspb.RenameCollect
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
 	}
+	if err := c.ddlLimiter.Check(req.GetDbName()); err != nil {
+		return merr.Status(err), nil
+	}
+	// moving the collection into another database counts as a DDL of the target database as well
+	if req.GetNewDBName() != "" && normalizeDBName(req.GetNewDBName()) != normalizeDBName(req.GetDbName()) {
+		if err := c.ddlLimiter.Check(req.GetNewDBName()); err != nil {
+			return merr.Status(err), nil
+		}
+	}
 
 	log := log.Ctx(ctx).With(zap.String("oldCollectionName", req.GetOldName()), zap.String("newCollectionName", req.GetNewName()))
 	log.Info("received request to rename collection")
@@ -2660,6 +2722,426 @@ func (c *Core) RenameCollection(ctx context.Context, req *milvuspb.RenameCollect
 	return merr.Success(), nil
 }
 
+// AddCollectionField adds a scalar field with default value to the existing collection.
+func (c *Core) AddCollectionField(ctx context.Context, req *proxypb.AddCollectionFieldRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := c.ddlLimiter.Check(req.GetDbName()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("collectionName", req.GetCollectionName()), zap.String("fieldName", req.GetField().GetName()))
+	log.Info("received request to add collection field")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("AddCollectionField")
+	t := &addCollectionFieldTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to add collection field", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to add collection field", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AddCollectionField", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AddCollectionField").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to add collection field", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// AlterDatabase updates the properties of the database, e.g. the database quotas.
+func (c *Core) AlterDatabase(ctx context.Context, req *proxypb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()))
+	log.Info("received request to alter database", zap.Any("properties", common.RedactProperties(req.GetProperties()...)))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterDatabase", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("AlterDatabase")
+	t := &alterDatabaseTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to alter database", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterDatabase", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to alter database", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("AlterDatabase", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("AlterDatabase", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("AlterDatabase").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to alter database", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// UndropCollection restores the dropped collection whose data is not garbage collected yet.
+func (c *Core) UndropCollection(ctx context.Context, req *proxypb.UndropCollectionRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	log := log.Ctx(ctx).With(zap.String("dbName", req.GetDbName()), zap.String("collectionName", req.GetCollectionName()))
+	log.Info("received request to undrop collection")
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("UndropCollection", metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder("UndropCollection")
+	t := &undropCollectionTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      req,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to undrop collection", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("UndropCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to undrop collection", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues("UndropCollection", metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues("UndropCollection", metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues("UndropCollection").Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	log.Info("done to undrop collection", zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+// DropCollectionAsync drops the collection asynchronously, returns the ddl job id once the request enqueued.
+func (c *Core) DropCollectionAsync(ctx context.Context, in *milvuspb.DropCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &proxypb.DDLJobResponse{Status: merr.Status(err)}, nil
+	}
+	if err := c.ddlLimiter.Check(in.GetDbName()); err != nil {
+		return &proxypb.DDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	log.Ctx(ctx).Info("received request to drop collection asynchronously",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()),
+		zap.String("name", in.GetCollectionName()))
+
+	t := &dropCollectionTask{
+		baseTask: newBaseTask(detachCtx(ctx), c),
+		Req:      in,
+	}
+	return c.submitDDLJob("DropCollectionAsync", in.GetDbName(), in.GetCollectionName(), t), nil
+}
+
+// AlterCollectionAsync alters the collection asynchronously, returns the ddl job id once the request enqueued.
+func (c *Core) AlterCollectionAsync(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*proxypb.DDLJobResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &proxypb.DDLJobResponse{Status: merr.Status(err)}, nil
+	}
+	if err := c.ddlLimiter.Check(in.GetDbName()); err != nil {
+		return &proxypb.DDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	log.Ctx(ctx).Info("received request to alter collection asynchronously",
+		zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()),
+		zap.String("name", in.GetCollectionName()),
+		zap.Any("props", common.RedactProperties(in.GetProperties()...)))
+
+	t := &alterCollectionTask{
+		baseTask: newBaseTask(detachCtx(ctx), c),
+		Req:      in,
+	}
+	return c.submitDDLJob("AlterCollectionAsync", in.GetDbName(), in.GetCollectionName(), t), nil
+}
+
+// submitDDLJob enqueues the task without waiting for it, the result of the task is traced by the ddl job.
+func (c *Core) submitDDLJob(jobType string, dbName string, collectionName string, t task) *proxypb.DDLJobResponse {
+	log := log.With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("jobType", jobType),
+		zap.String("dbName", dbName),
+		zap.String("name", collectionName))
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(jobType, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(jobType)
+	jobID, err := c.ddlJobs.submit(c.scheduler, t, jobType, dbName, collectionName, func(err error) {
+		if err != nil {
+			log.Warn("ddl job failed", zap.Int64("jobID", t.GetID()), zap.Uint64("ts", t.GetTs()), zap.Error(err))
+			metrics.RootCoordDDLReqCounter.WithLabelValues(jobType, metrics.FailLabel).Inc()
+			return
+		}
+		metrics.RootCoordDDLReqCounter.WithLabelValues(jobType, metrics.SuccessLabel).Inc()
+		metrics.RootCoordDDLReqLatency.WithLabelValues(jobType).Observe(float64(tr.ElapseSpan().Milliseconds()))
+		log.Info("ddl job done", zap.Int64("jobID", t.GetID()), zap.Uint64("ts", t.GetTs()))
+	})
+	if err != nil {
+		log.Warn("failed to enqueue ddl job", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(jobType, metrics.FailLabel).Inc()
+		return &proxypb.DDLJobResponse{Status: merr.Status(err)}
+	}
+
+	log.Info("ddl job submitted", zap.Int64("jobID", jobID))
+	return &proxypb.DDLJobResponse{
+		Status: merr.Success(),
+		JobID:  jobID,
+	}
+}
+
+// DescribeDDLJob returns the progress of the asynchronous ddl job.
+func (c *Core) DescribeDDLJob(ctx context.Context, in *proxypb.DescribeDDLJobRequest) (*proxypb.DescribeDDLJobResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &proxypb.DescribeDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+
+	resp, err := c.ddlJobs.describe(in.GetJobID())
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to describe ddl job", zap.Int64("jobID", in.GetJobID()), zap.Error(err))
+		return &proxypb.DescribeDDLJobResponse{Status: merr.Status(err)}, nil
+	}
+	return resp, nil
+}
+
+// CreateAPIKey creates the api key scoped to the databases and the roles, the key is only returned once.
+func (c *Core) CreateAPIKey(ctx context.Context, in *proxypb.CreateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	method := "CreateAPIKey"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("name", in.GetName()),
+		zap.Strings("dbNames", in.GetDbNames()), zap.Strings("roles", in.GetRoles()), zap.Int64("expireAt", in.GetExpireAt()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+
+	err := validateAPIKeyName(in.GetName())
+	if err == nil {
+		err = validateAPIKeyExpireAt(in.GetExpireAt())
+	}
+	if err == nil {
+		err = c.checkAPIKeyScope(ctx, in.GetDbNames(), in.GetRoles())
+	}
+	if err != nil {
+		ctxLog.Warn("CreateAPIKey invalid request", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+
+	key, secretHash, err := generateAPIKey(in.GetName())
+	if err != nil {
+		ctxLog.Warn("CreateAPIKey generate key failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	apiKey := &model.APIKey{
+		Name:       in.GetName(),
+		SecretHash: secretHash,
+		DBNames:    in.GetDbNames(),
+		Roles:      in.GetRoles(),
+		ExpireAt:   in.GetExpireAt(),
+		CreatedAt:  time.Now().Unix(),
+	}
+	if err := c.meta.AddAPIKey(apiKey); err != nil {
+		ctxLog.Warn("CreateAPIKey save api key failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctxLog.Info("CreateAPIKey success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &proxypb.APIKeyResponse{
+		Status: merr.Success(),
+		Key:    key,
+		Info:   model.MarshalAPIKeyModel(apiKey),
+	}, nil
+}
+
+// RotateAPIKey replaces the secret of the api key, the previous key is rejected once the proxies' caches are expired.
+func (c *Core) RotateAPIKey(ctx context.Context, in *proxypb.RotateAPIKeyRequest) (*proxypb.APIKeyResponse, error) {
+	method := "RotateAPIKey"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("name", in.GetName()), zap.Int64("expireAt", in.GetExpireAt()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+
+	if err := validateAPIKeyExpireAt(in.GetExpireAt()); err != nil {
+		ctxLog.Warn("RotateAPIKey invalid request", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	apiKey, err := c.meta.GetAPIKey(in.GetName())
+	if err != nil {
+		ctxLog.Warn("RotateAPIKey get api key failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	key, secretHash, err := generateAPIKey(apiKey.Name)
+	if err != nil {
+		ctxLog.Warn("RotateAPIKey generate key failed", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	apiKey.SecretHash = secretHash
+	apiKey.ExpireAt = in.GetExpireAt()
+	apiKey.RotatedAt = time.Now().Unix()
+
+	redoTask := newBaseRedoTask(c.stepExecutor)
+	redoTask.AddSyncStep(NewSimpleStep("alter api key meta data", func(ctx context.Context) ([]nestedStep, error) {
+		err := c.meta.AlterAPIKey(apiKey)
+		if err != nil {
+			ctxLog.Warn("RotateAPIKey save api key failed", zap.Error(err))
+		}
+		return nil, err
+	}))
+	// the previous key is cached by the proxies as the user it authenticates as,
+	// the step is retried until all the proxies expire it
+	redoTask.AddAsyncStep(NewSimpleStep("expire api key cache", func(ctx context.Context) ([]nestedStep, error) {
+		err := c.ExpireCredCache(ctx, util.APIKeyUserPrefix+apiKey.Name)
+		if err != nil {
+			ctxLog.Warn("RotateAPIKey expire cache failed", zap.Error(err))
+		}
+		return nil, err
+	}))
+	if err := redoTask.Execute(ctx); err != nil {
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &proxypb.APIKeyResponse{Status: merr.Status(err)}, nil
+	}
+
+	ctxLog.Info("RotateAPIKey success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &proxypb.APIKeyResponse{
+		Status: merr.Success(),
+		Key:    key,
+		Info:   model.MarshalAPIKeyModel(apiKey),
+	}, nil
+}
+
+// RevokeAPIKey drops the api key.
+func (c *Core) RevokeAPIKey(ctx context.Context, in *proxypb.RevokeAPIKeyRequest) (*commonpb.Status, error) {
+	method := "RevokeAPIKey"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole), zap.String("name", in.GetName()))
+	ctxLog.Debug(method)
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	redoTask := newBaseRedoTask(c.stepExecutor)
+	redoTask.AddSyncStep(NewSimpleStep("drop api key meta data", func(ctx context.Context) ([]nestedStep, error) {
+		err := c.meta.DropAPIKey(in.GetName())
+		if err != nil {
+			ctxLog.Warn("RevokeAPIKey drop api key failed", zap.Error(err))
+		}
+		return nil, err
+	}))
+	redoTask.AddAsyncStep(NewSimpleStep("expire api key cache", func(ctx context.Context) ([]nestedStep, error) {
+		err := c.ExpireCredCache(ctx, util.APIKeyUserPrefix+in.GetName())
+		if err != nil {
+			ctxLog.Warn("RevokeAPIKey expire cache failed", zap.Error(err))
+		}
+		return nil, err
+	}))
+	if err := redoTask.Execute(ctx); err != nil {
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	ctxLog.Info("RevokeAPIKey success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// ListAPIKeys lists the api keys without their secrets.
+func (c *Core) ListAPIKeys(ctx context.Context, in *proxypb.ListAPIKeysRequest) (*proxypb.ListAPIKeysResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &proxypb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+
+	apiKeys, err := c.meta.ListAPIKeys()
+	if err != nil {
+		log.Ctx(ctx).Warn("fail to list api keys", zap.Error(err))
+		return &proxypb.ListAPIKeysResponse{Status: merr.Status(err)}, nil
+	}
+	infos := lo.Map(apiKeys, func(apiKey *model.APIKey, _ int) *proxypb.APIKeyInfo {
+		return model.MarshalAPIKeyModel(apiKey)
+	})
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].GetName() < infos[j].GetName()
+	})
+	return &proxypb.ListAPIKeysResponse{
+		Status: merr.Success(),
+		Infos:  infos,
+	}, nil
+}
+
+// VerifyAPIKey verifies the key presented by the client, and returns the scope of the key.
+func (c *Core) VerifyAPIKey(ctx context.Context, in *rootcoordpb.VerifyAPIKeyRequest) (*rootcoordpb.VerifyAPIKeyResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &rootcoordpb.VerifyAPIKeyResponse{Status: merr.Status(err)}, nil
+	}
+
+	// never tell the clients whether the key exists
+	notAuthenticated := &rootcoordpb.VerifyAPIKeyResponse{
+		Status: merr.Status(merr.WrapErrPrivilegeNotAuthenticated("invalid or expired api key")),
+	}
+	name, secret, ok := parseAPIKey(in.GetKey())
+	if !ok {
+		return notAuthenticated, nil
+	}
+	apiKey, err := c.meta.GetAPIKey(name)
+	if err != nil {
+		if errors.Is(err, merr.ErrAPIKeyNotFound) {
+			return notAuthenticated, nil
+		}
+		log.Ctx(ctx).Warn("fail to get api key", zap.String("name", name), zap.Error(err))
+		return &rootcoordpb.VerifyAPIKeyResponse{Status: merr.Status(err)}, nil
+	}
+	if !verifyAPIKeySecret(apiKey, secret) || apiKey.IsExpired(time.Now()) {
+		log.Ctx(ctx).Info("api key verification failed", zap.String("name", name))
+		return notAuthenticated, nil
+	}
+	return &rootcoordpb.VerifyAPIKeyResponse{
+		Status: merr.Success(),
+		Info:   model.MarshalAPIKeyModel(apiKey),
+	}, nil
+}
+
+// initDatabaseDDLLimiter loads the DDL rate quotas of the databases.
+func (c *Core) initDatabaseDDLLimiter() error {
+	dbs, err := c.meta.ListDatabases(c.ctx, typeutil.MaxTimestamp)
+	if err != nil {
+		return err
+	}
+	for _, db := range dbs {
+		c.ddlLimiter.Update(db.Name, db.Properties)
+	}
+	return nil
+}
+
 func (c *Core) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return &milvuspb.CheckHealthResponse{
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -40,6 +41,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	kvfactory "github.com/milvus-io/milvus/internal/util/dependency/kv"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -986,6 +988,358 @@ func TestRootCoord_RenameCollection(t *testing.T) {
 	})
 }
 
+func TestRootCoord_AddCollectionField(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AddCollectionField(ctx, &proxypb.AddCollectionFieldRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_AlterDatabase(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.AlterDatabase(ctx, &proxypb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AlterDatabase(ctx, &proxypb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AlterDatabase(ctx, &proxypb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AlterDatabase(ctx, &proxypb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_DDLJob(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		ctx := context.Background()
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		resp, err = c.AlterCollectionAsync(ctx, &milvuspb.AlterCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		describeResp, err := c.DescribeDDLJob(ctx, &proxypb.DescribeDDLJobRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, describeResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("add task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("execute task failed", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AlterCollectionAsync(ctx, &milvuspb.AlterCollectionRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		assert.Eventually(t, func() bool {
+			describeResp, err := c.DescribeDDLJob(ctx, &proxypb.DescribeDDLJobRequest{JobID: resp.GetJobID()})
+			return err == nil && describeResp.GetState() == proxypb.DDLJobState_DDLJobFailed
+		}, time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("run ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DropCollectionAsync(ctx, &milvuspb.DropCollectionRequest{CollectionName: "coll"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		assert.Eventually(t, func() bool {
+			describeResp, err := c.DescribeDDLJob(ctx, &proxypb.DescribeDDLJobRequest{JobID: resp.GetJobID()})
+			return err == nil && describeResp.GetState() == proxypb.DDLJobState_DDLJobCompleted &&
+				describeResp.GetCollectionName() == "coll"
+		}, time.Second, 10*time.Millisecond)
+
+		describeResp, err := c.DescribeDDLJob(ctx, &proxypb.DescribeDDLJobRequest{JobID: resp.GetJobID() + 1})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(describeResp.GetStatus()), merr.ErrDDLJobNotFound)
+	})
+}
+
+func TestRootCoord_APIKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.CreateAPIKey(ctx, &proxypb.CreateAPIKeyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		resp, err = c.RotateAPIKey(ctx, &proxypb.RotateAPIKeyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		status, err := c.RevokeAPIKey(ctx, &proxypb.RevokeAPIKeyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		listResp, err := c.ListAPIKeys(ctx, &proxypb.ListAPIKeysRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+
+		verifyResp, err := c.VerifyAPIKey(ctx, &rootcoordpb.VerifyAPIKeyRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, verifyResp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("invalid create request", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db2", mock.Anything).Return(nil, merr.WrapErrDatabaseNotFound("db2"))
+		meta.EXPECT().SelectRole(mock.Anything, mock.Anything, false).Return(nil, merr.WrapErrIoKeyNotFound("role2"))
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(&model.Database{Name: "db1"}, nil)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+
+		for _, req := range []*proxypb.CreateAPIKeyRequest{
+			{Name: ""},
+			{Name: "key:1"},
+			{Name: "key1", ExpireAt: time.Now().Add(-time.Hour).Unix()},
+			{Name: "key1", DbNames: []string{"db2"}},
+			{Name: "key1", DbNames: []string{"db1"}, Roles: []string{"role2"}},
+		} {
+			resp, err := c.CreateAPIKey(ctx, req)
+			assert.NoError(t, err)
+			assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		}
+	})
+
+	t.Run("create, rotate, verify and revoke", func(t *testing.T) {
+		var saved *model.APIKey
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(&model.Database{Name: "db1"}, nil)
+		meta.EXPECT().SelectRole(mock.Anything, mock.Anything, false).Return([]*milvuspb.RoleResult{{Role: &milvuspb.RoleEntity{Name: "role1"}}}, nil)
+		meta.EXPECT().AddAPIKey(mock.Anything).RunAndReturn(func(apiKey *model.APIKey) error {
+			saved = apiKey
+			return nil
+		})
+		meta.EXPECT().AlterAPIKey(mock.Anything).RunAndReturn(func(apiKey *model.APIKey) error {
+			saved = apiKey
+			return nil
+		})
+		meta.EXPECT().GetAPIKey("my-key").RunAndReturn(func(name string) (*model.APIKey, error) {
+			if saved == nil {
+				return nil, merr.WrapErrAPIKeyNotFound(name)
+			}
+			apiKey := *saved
+			return &apiKey, nil
+		})
+		meta.EXPECT().GetAPIKey(mock.Anything).Return(nil, merr.WrapErrAPIKeyNotFound("other"))
+		meta.EXPECT().ListAPIKeys().RunAndReturn(func() ([]*model.APIKey, error) {
+			return []*model.APIKey{saved}, nil
+		})
+		meta.EXPECT().DropAPIKey("my-key").RunAndReturn(func(name string) error {
+			saved = nil
+			return nil
+		})
+		c := newTestCore(withHealthyCode(), withMeta(meta), withValidProxyManager())
+
+		resp, err := c.CreateAPIKey(ctx, &proxypb.CreateAPIKeyRequest{
+			Name:    "my-key",
+			DbNames: []string{"db1"},
+			Roles:   []string{"role1"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.True(t, strings.HasPrefix(resp.GetKey(), util.APIKeyPrefix+"my-key-"))
+		assert.Equal(t, []string{"db1"}, resp.GetInfo().GetDbNames())
+		key := resp.GetKey()
+
+		verifyResp, err := c.VerifyAPIKey(ctx, &rootcoordpb.VerifyAPIKeyRequest{Key: key})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, verifyResp.GetStatus().GetErrorCode())
+		assert.Equal(t, []string{"role1"}, verifyResp.GetInfo().GetRoles())
+
+		for _, invalidKey := range []string{"", "my-key", key + "0", util.APIKeyPrefix + "other-0123"} {
+			verifyResp, err = c.VerifyAPIKey(ctx, &rootcoordpb.VerifyAPIKeyRequest{Key: invalidKey})
+			assert.NoError(t, err)
+			assert.ErrorIs(t, merr.Error(verifyResp.GetStatus()), merr.ErrPrivilegeNotAuthenticated)
+		}
+
+		listResp, err := c.ListAPIKeys(ctx, &proxypb.ListAPIKeysRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+		assert.Equal(t, 1, len(listResp.GetInfos()))
+
+		expireAt := time.Now().Add(time.Hour).Unix()
+		resp, err = c.RotateAPIKey(ctx, &proxypb.RotateAPIKeyRequest{Name: "my-key", ExpireAt: expireAt})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		assert.NotEqual(t, key, resp.GetKey())
+		assert.Equal(t, expireAt, resp.GetInfo().GetExpireAt())
+
+		// the previous key is rejected after rotation
+		verifyResp, err = c.VerifyAPIKey(ctx, &rootcoordpb.VerifyAPIKeyRequest{Key: key})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(verifyResp.GetStatus()), merr.ErrPrivilegeNotAuthenticated)
+		verifyResp, err = c.VerifyAPIKey(ctx, &rootcoordpb.VerifyAPIKeyRequest{Key: resp.GetKey()})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, verifyResp.GetStatus().GetErrorCode())
+
+		// the expired key is rejected
+		saved.ExpireAt = time.Now().Add(-time.Second).Unix()
+		verifyResp, err = c.VerifyAPIKey(ctx, &rootcoordpb.VerifyAPIKeyRequest{Key: resp.GetKey()})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(verifyResp.GetStatus()), merr.ErrPrivilegeNotAuthenticated)
+
+		status, err := c.RevokeAPIKey(ctx, &proxypb.RevokeAPIKeyRequest{Name: "my-key"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+
+		resp, err = c.RotateAPIKey(ctx, &proxypb.RotateAPIKeyRequest{Name: "my-key"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrAPIKeyNotFound)
+	})
+
+	t.Run("expire cache", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetAPIKey("key1").Return(&model.APIKey{Name: "key1"}, nil)
+		meta.EXPECT().AlterAPIKey(mock.Anything).Return(nil)
+		meta.EXPECT().DropAPIKey("key1").Return(nil)
+		stepsCh := make(chan *stepStack, 2)
+		executor := newMockStepExecutor()
+		executor.AddStepsFunc = func(s *stepStack) {
+			stepsCh <- s
+		}
+		c := newTestCore(withHealthyCode(), withMeta(meta), withStepExecutor(executor))
+
+		// the cache expiry is left to the step executor, which retries it until it succeeds
+		resp, err := c.RotateAPIKey(ctx, &proxypb.RotateAPIKeyRequest{Name: "key1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		status, err := c.RevokeAPIKey(ctx, &proxypb.RevokeAPIKeyRequest{Name: "key1"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		for i := 0; i < 2; i++ {
+			s := <-stepsCh
+			assert.Equal(t, 1, len(s.steps))
+			assert.Equal(t, "expire api key cache", s.steps[0].Desc())
+		}
+	})
+
+	t.Run("meta failed", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().AddAPIKey(mock.Anything).Return(merr.WrapErrAPIKeyAlreadyExist("key1"))
+		meta.EXPECT().DropAPIKey(mock.Anything).Return(merr.WrapErrAPIKeyNotFound("key1"))
+		meta.EXPECT().ListAPIKeys().Return(nil, errors.New("mock error"))
+		meta.EXPECT().GetAPIKey(mock.Anything).Return(nil, errors.New("mock error"))
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+
+		resp, err := c.CreateAPIKey(ctx, &proxypb.CreateAPIKeyRequest{Name: "key1"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp.GetStatus()), merr.ErrAPIKeyAlreadyExist)
+
+		status, err := c.RevokeAPIKey(ctx, &proxypb.RevokeAPIKeyRequest{Name: "key1"})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(status), merr.ErrAPIKeyNotFound)
+
+		listResp, err := c.ListAPIKeys(ctx, &proxypb.ListAPIKeysRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, listResp.GetStatus().GetErrorCode())
+
+		resp, err = c.RotateAPIKey(ctx, &proxypb.RotateAPIKeyRequest{Name: "key1"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		verifyResp, err := c.VerifyAPIKey(ctx, &rootcoordpb.VerifyAPIKeyRequest{Key: util.APIKeyPrefix + "key1-0123"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, verifyResp.GetStatus().GetErrorCode())
+		assert.NotErrorIs(t, merr.Error(verifyResp.GetStatus()), merr.ErrPrivilegeNotAuthenticated)
+	})
+}
+
+func TestRootCoord_DatabaseDDLRateLimit(t *testing.T) {
+	c := newTestCore(withHealthyCode(),
+		withValidScheduler())
+	c.ddlLimiter.Update("db", []*commonpb.KeyValuePair{{Key: common.DatabaseDDLRateMaxKey, Value: "0"}})
+
+	ctx := context.Background()
+	resp, err := c.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{DbName: "db"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceRateLimit)
+
+	resp, err = c.DropCollection(ctx, &milvuspb.DropCollectionRequest{DbName: "db"})
+	assert.NoError(t, err)
+	assert.ErrorIs(t, merr.Error(resp), merr.ErrServiceRateLimit)
+
+	resp, err = c.DropCollection(ctx, &milvuspb.DropCollectionRequest{DbName: "other"})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+}
+
 func TestRootCoord_ShowConfigurations(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		ctx := context.Background()
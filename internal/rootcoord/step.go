@@ -75,6 +75,10 @@ type deleteCollectionMetaStep struct {
 }
 
 func (s *deleteCollectionMetaStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	// the drop is abandoned since the collection is undropped.
+	if s.core.undroppedCollections.TryRemove(s.collectionID) {
+		return nil, nil
+	}
 	err := s.core.meta.RemoveCollection(ctx, s.collectionID, s.ts)
 	return nil, err
 }
@@ -454,6 +458,7 @@ type confirmGCStep struct {
 	collectionID      UniqueID
 	partitionID       UniqueID
 	lastScheduledTime time.Time
+	pending           bool
 }
 
 func newConfirmGCStep(core *Core, collectionID, partitionID UniqueID) *confirmGCStep {
@@ -466,12 +471,27 @@ func newConfirmGCStep(core *Core, collectionID, partitionID UniqueID) *confirmGC
 }
 
 func (b *confirmGCStep) Execute(ctx context.Context) ([]nestedStep, error) {
+	if b.partitionID == allPartition {
+		// the dropped collection could be undropped until the gc finished.
+		if b.core.undroppedCollections.Contain(b.collectionID) {
+			return nil, nil
+		}
+		if !b.pending {
+			b.core.gcPendingCollections.Insert(b.collectionID)
+			b.pending = true
+		}
+	}
+
 	if time.Since(b.lastScheduledTime) < confirmGCInterval {
 		return nil, fmt.Errorf("wait for reschedule to confirm GC, collection: %d, partition: %d, last scheduled time: %s, now: %s",
 			b.collectionID, b.partitionID, b.lastScheduledTime.String(), time.Now().String())
 	}
 
 	finished := b.core.broker.GcConfirm(ctx, b.collectionID, b.partitionID)
+	if finished && b.partitionID == allPartition && !b.core.gcPendingCollections.TryRemove(b.collectionID) {
+		// the collection is being undropped, check again after it's done.
+		finished = false
+	}
 	if finished {
 		return nil, nil
 	}
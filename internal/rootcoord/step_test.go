@@ -22,6 +22,9 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 )
 
 func Test_waitForTsSyncedStep_Execute(t *testing.T) {
@@ -94,6 +97,60 @@ func Test_confirmGCStep_Execute(t *testing.T) {
 		_, err := s.Execute(context.TODO())
 		assert.NoError(t, err)
 	})
+
+	t.Run("dropped collection being undropped", func(t *testing.T) {
+		broker := newMockBroker()
+		broker.GCConfirmFunc = func(ctx context.Context, collectionID, partitionID UniqueID) bool {
+			return true
+		}
+
+		core := newTestCore(withBroker(broker))
+
+		confirmGCInterval = time.Millisecond
+		defer restoreConfirmGCInterval()
+
+		s := newConfirmGCStep(core, 100, allPartition)
+		time.Sleep(confirmGCInterval)
+		_, err := s.Execute(context.TODO())
+		assert.NoError(t, err)
+		assert.False(t, core.gcPendingCollections.Contain(100))
+
+		// the undropping task takes the pending collection
+		confirmGCInterval = time.Hour
+		s = newConfirmGCStep(core, 100, allPartition)
+		_, err = s.Execute(context.TODO())
+		assert.Error(t, err)
+		assert.True(t, core.gcPendingCollections.TryRemove(100))
+		confirmGCInterval = time.Millisecond
+		time.Sleep(confirmGCInterval)
+		_, err = s.Execute(context.TODO())
+		assert.Error(t, err)
+
+		// the drop is abandoned once undropped
+		core.undroppedCollections.Insert(100)
+		_, err = s.Execute(context.TODO())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_deleteCollectionMetaStep_Execute(t *testing.T) {
+	t.Run("undropped collection", func(t *testing.T) {
+		core := newTestCore()
+		core.undroppedCollections.Insert(100)
+		s := &deleteCollectionMetaStep{baseStep: baseStep{core: core}, collectionID: 100}
+		_, err := s.Execute(context.TODO())
+		assert.NoError(t, err)
+		assert.False(t, core.undroppedCollections.Contain(100))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().RemoveCollection(mock.Anything, int64(100), mock.Anything).Return(nil)
+		core := newTestCore(withMeta(meta))
+		s := &deleteCollectionMetaStep{baseStep: baseStep{core: core}, collectionID: 100}
+		_, err := s.Execute(context.TODO())
+		assert.NoError(t, err)
+	})
 }
 
 func TestSkip(t *testing.T) {
@@ -0,0 +1,214 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ttProgress is the latest time tick of a session or a channel and when it advanced.
+type ttProgress struct {
+	ts         Timestamp
+	advancedAt time.Time
+	// stalled is set once the stall reported, until the time tick advances
+	stalled bool
+}
+
+// updateProgress records the time tick of the session,
+// lock is needed by the invoker
+func (t *timetickSync) updateProgress(sourceID UniqueID, ts Timestamp) {
+	progress, ok := t.sessProgress[sourceID]
+	if !ok {
+		progress = &ttProgress{}
+		t.sessProgress[sourceID] = progress
+	}
+	if ts <= progress.ts {
+		return
+	}
+	if progress.stalled {
+		log.Info("time tick of the session resumed", zap.Int64("serverID", sourceID),
+			zap.Duration("stalled", time.Since(progress.advancedAt)))
+	}
+	progress.ts, progress.advancedAt, progress.stalled = ts, time.Now(), false
+}
+
+// removeProgress removes the progress of the session gone,
+// lock is needed by the invoker
+func (t *timetickSync) removeProgress(sourceID UniqueID) {
+	delete(t.sessProgress, sourceID)
+	t.fenced.Remove(sourceID)
+	label := strconv.FormatInt(sourceID, 10)
+	metrics.RootCoordProxyTimeTickLag.DeleteLabelValues(label)
+	metrics.RootCoordProxyTimeTickSkew.DeleteLabelValues(label)
+}
+
+// startMonitor checks the time tick progress of the sessions and channels periodically.
+func (t *timetickSync) startMonitor(wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	ticker := time.NewTicker(Params.RootCoordCfg.TimeTickMonitorCheckInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.ctx.Done():
+			log.Info("time tick monitor exit")
+			return
+		case <-ticker.C:
+			now := time.Now()
+			t.fenceSessions(t.checkSessions(now))
+			t.checkChannels(now)
+		}
+	}
+}
+
+// checkSessions reports the lags, skews and stalls of the time ticks of the proxies,
+// the proxies stalled are fenced if enabled so that they don't hold the time ticks of all the channels,
+// it returns the proxies fenced.
+func (t *timetickSync) checkSessions(now time.Time) []UniqueID {
+	stallThreshold := Params.RootCoordCfg.TimeTickStallThreshold.GetAsDuration(time.Second)
+	skewThreshold := Params.RootCoordCfg.TimeTickSkewThreshold.GetAsDuration(time.Second)
+	autoFence := Params.RootCoordCfg.TimeTickAutoFence.GetAsBool()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	var latest Timestamp
+	for id := range t.sess2ChanTsMap {
+		if progress, ok := t.sessProgress[id]; ok && progress.ts > latest {
+			latest = progress.ts
+		}
+	}
+	latestTime := tsoutil.PhysicalTime(latest)
+
+	var fenced []UniqueID
+	for id := range t.sess2ChanTsMap {
+		if id == ddlSourceID {
+			continue
+		}
+		progress, ok := t.sessProgress[id]
+		if !ok {
+			progress = &ttProgress{advancedAt: now}
+			t.sessProgress[id] = progress
+		}
+		label := strconv.FormatInt(id, 10)
+		if progress.ts != 0 {
+			physical := tsoutil.PhysicalTime(progress.ts)
+			metrics.RootCoordProxyTimeTickLag.WithLabelValues(label).Set(float64(now.Sub(physical).Milliseconds()))
+			skew := latestTime.Sub(physical)
+			metrics.RootCoordProxyTimeTickSkew.WithLabelValues(label).Set(float64(skew.Milliseconds()))
+			if skew > skewThreshold {
+				log.Warn("time tick of the proxy skewed", zap.Int64("serverID", id), zap.Duration("skew", skew))
+				metrics.RootCoordTimeTickAnomalyCounter.WithLabelValues(label, metrics.TimeTickSkewLabel).Inc()
+			}
+		}
+
+		stalled := now.Sub(progress.advancedAt)
+		if stalled <= stallThreshold {
+			continue
+		}
+		if !progress.stalled {
+			progress.stalled = true
+			metrics.RootCoordTimeTickAnomalyCounter.WithLabelValues(label, metrics.TimeTickStallLabel).Inc()
+		}
+		log.Warn("time tick of the proxy stalled", zap.Int64("serverID", id), zap.Duration("stalled", stalled),
+			zap.Time("lastTime", tsoutil.PhysicalTime(progress.ts)))
+		if autoFence {
+			delete(t.sess2ChanTsMap, id)
+			delete(t.sessProgress, id)
+			t.fenced.Insert(id)
+			metrics.RootCoordTimeTickAnomalyCounter.WithLabelValues(label, metrics.TimeTickFenceLabel).Inc()
+			log.Warn("fence the proxy whose time tick stalled", zap.Int64("serverID", id))
+			fenced = append(fenced, id)
+		}
+	}
+	if len(fenced) > 0 {
+		t.sendToChannel()
+	}
+	return fenced
+}
+
+// fenceSessions writes the fences of the proxies, the proxies watching their fences stop serving,
+// and unfence themselves to re-register once the writes in flight are done.
+// The proxy is checked again if its fence isn't written, otherwise it would be denied forever.
+func (t *timetickSync) fenceSessions(serverIDs []UniqueID) {
+	if t.fenceSession == nil {
+		return
+	}
+	for _, id := range serverIDs {
+		if err := t.fenceSession(id, "the time tick of the proxy stalled"); err != nil {
+			log.Warn("fail to fence the proxy", zap.Int64("serverID", id), zap.Error(err))
+			t.unfenceSession(id, "")
+		}
+	}
+}
+
+// unfenceSession re-registers the proxy fenced once the proxy removes its fence.
+func (t *timetickSync) unfenceSession(serverID UniqueID, _ string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if !t.fenced.Contain(serverID) {
+		return
+	}
+	t.fenced.Remove(serverID)
+	t.sess2ChanTsMap[serverID] = nil
+	t.sessProgress[serverID] = &ttProgress{advancedAt: time.Now()}
+	log.Info("the proxy fenced re-registered", zap.Int64("serverID", serverID))
+}
+
+// checkChannels reports the lags and stalls of the time ticks synced to the dml channels.
+func (t *timetickSync) checkChannels(now time.Time) {
+	stallThreshold := Params.RootCoordCfg.TimeTickStallThreshold.GetAsDuration(time.Second)
+
+	channels := t.listDmlChannels()
+	inUse := typeutil.NewSet(channels...)
+	for channel := range t.chanProgress {
+		if !inUse.Contain(channel) {
+			delete(t.chanProgress, channel)
+		}
+	}
+
+	for _, channel := range channels {
+		ts := t.syncedTtHistogram.get(channel)
+		if ts != typeutil.ZeroTimestamp {
+			metrics.RootCoordInsertChannelTimeTick.WithLabelValues(channel).Set(float64(now.Sub(tsoutil.PhysicalTime(ts)).Milliseconds()))
+		}
+		progress, ok := t.chanProgress[channel]
+		if !ok || ts > progress.ts {
+			t.chanProgress[channel] = &ttProgress{ts: ts, advancedAt: now}
+			continue
+		}
+		stalled := now.Sub(progress.advancedAt)
+		if stalled <= stallThreshold {
+			continue
+		}
+		if !progress.stalled {
+			progress.stalled = true
+			metrics.RootCoordTimeTickAnomalyCounter.WithLabelValues(channel, metrics.TimeTickStallLabel).Inc()
+		}
+		log.Warn("time tick of the channel stalled", zap.String("channel", channel), zap.Duration("stalled", stalled),
+			zap.Time("syncedTime", tsoutil.PhysicalTime(ts)))
+	}
+}
@@ -0,0 +1,151 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func newTimeTickMsg(sourceID UniqueID, ts Timestamp) *internalpb.ChannelTimeTickMsg {
+	return &internalpb.ChannelTimeTickMsg{
+		Base:             &commonpb.MsgBase{MsgType: commonpb.MsgType_TimeTick, SourceID: sourceID},
+		DefaultTimestamp: ts,
+	}
+}
+
+func TestTimeTickMonitor_Sessions(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(Params.RootCoordCfg.TimeTickAutoFence.Key, "true")
+	defer params.Reset(Params.RootCoordCfg.TimeTickAutoFence.Key)
+
+	ttSync := newTickerWithMockNormalStream()
+	ttSync.initSessions([]*sessionutil.Session{
+		{SessionRaw: sessionutil.SessionRaw{ServerID: 1}},
+		{SessionRaw: sessionutil.SessionRaw{ServerID: 2}},
+	})
+	now := time.Now()
+	require.NoError(t, ttSync.updateTimeTick(newTimeTickMsg(1, tsoutil.ComposeTSByTime(now, 0)), "test"))
+	require.NoError(t, ttSync.updateTimeTick(newTimeTickMsg(2, tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0)), "test"))
+
+	// the proxy behind the latest time tick is skewed
+	skewed := testutil.ToFloat64(metrics.RootCoordTimeTickAnomalyCounter.WithLabelValues("2", metrics.TimeTickSkewLabel))
+	ttSync.checkSessions(now)
+	assert.Equal(t, skewed+1, testutil.ToFloat64(metrics.RootCoordTimeTickAnomalyCounter.WithLabelValues("2", metrics.TimeTickSkewLabel)))
+	assert.Equal(t, float64(time.Minute.Milliseconds()), testutil.ToFloat64(metrics.RootCoordProxyTimeTickSkew.WithLabelValues("2")))
+	assert.Contains(t, ttSync.sess2ChanTsMap, UniqueID(2))
+
+	// the proxy stalled is fenced
+	ttSync.sessProgress[2].advancedAt = now.Add(-2 * time.Minute)
+	ttSync.checkSessions(now)
+	assert.Contains(t, ttSync.sess2ChanTsMap, UniqueID(1))
+	assert.NotContains(t, ttSync.sess2ChanTsMap, UniqueID(2))
+	err := ttSync.updateTimeTick(newTimeTickMsg(2, tsoutil.ComposeTSByTime(now, 0)), "test")
+	assert.ErrorIs(t, err, merr.ErrNodeStateUnexpected)
+
+	// the fenced proxy is not recovered by the sessions re-initialized
+	ttSync.initSessions([]*sessionutil.Session{
+		{SessionRaw: sessionutil.SessionRaw{ServerID: 1}},
+		{SessionRaw: sessionutil.SessionRaw{ServerID: 2}},
+	})
+	assert.NotContains(t, ttSync.sess2ChanTsMap, UniqueID(2))
+
+	// the fence is cleared once the session gone
+	ttSync.delSession(&sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 2}})
+	assert.False(t, ttSync.fenced.Contain(2))
+}
+
+func TestTimeTickMonitor_Fence(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(Params.RootCoordCfg.TimeTickAutoFence.Key, "true")
+	defer params.Reset(Params.RootCoordCfg.TimeTickAutoFence.Key)
+
+	ttSync := newTickerWithMockNormalStream()
+	ttSync.initSessions([]*sessionutil.Session{
+		{SessionRaw: sessionutil.SessionRaw{ServerID: 1}},
+	})
+	now := time.Now()
+	ttSync.sessProgress[1].advancedAt = now.Add(-2 * time.Minute)
+	fenced := ttSync.checkSessions(now)
+	assert.Equal(t, []UniqueID{1}, fenced)
+
+	// the proxy is checked again if its fence isn't written
+	ttSync.fenceSession = func(serverID UniqueID, reason string) error {
+		return errors.New("mock")
+	}
+	ttSync.fenceSessions(fenced)
+	assert.False(t, ttSync.fenced.Contain(1))
+	assert.Contains(t, ttSync.sess2ChanTsMap, UniqueID(1))
+
+	var written []UniqueID
+	ttSync.fenceSession = func(serverID UniqueID, reason string) error {
+		written = append(written, serverID)
+		return nil
+	}
+	ttSync.sessProgress[1].advancedAt = now.Add(-2 * time.Minute)
+	ttSync.fenceSessions(ttSync.checkSessions(now))
+	assert.Equal(t, []UniqueID{1}, written)
+	assert.True(t, ttSync.fenced.Contain(1))
+
+	// the proxy re-registers by removing its fence
+	ttSync.unfenceSession(1, "")
+	assert.False(t, ttSync.fenced.Contain(1))
+	assert.Contains(t, ttSync.sess2ChanTsMap, UniqueID(1))
+	assert.NoError(t, ttSync.updateTimeTick(newTimeTickMsg(1, tsoutil.ComposeTSByTime(now, 0)), "test"))
+
+	// the unfence of the proxy not fenced is ignored
+	ttSync.delSession(&sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}})
+	ttSync.unfenceSession(1, "")
+	assert.NotContains(t, ttSync.sess2ChanTsMap, UniqueID(1))
+}
+
+func TestTimeTickMonitor_Channels(t *testing.T) {
+	ttSync := newTickerWithMockNormalStream()
+	channel := ttSync.getDmlChannelNames(1)[0]
+	ttSync.addDmlChannels(channel)
+	defer ttSync.removeDmlChannels(channel)
+
+	now := time.Now()
+	ttSync.syncedTtHistogram.update(channel, tsoutil.ComposeTSByTime(now, 0))
+	ttSync.checkChannels(now)
+	stalled := testutil.ToFloat64(metrics.RootCoordTimeTickAnomalyCounter.WithLabelValues(channel, metrics.TimeTickStallLabel))
+
+	// reported once until the time tick advances
+	ttSync.checkChannels(now.Add(2 * time.Minute))
+	ttSync.checkChannels(now.Add(3 * time.Minute))
+	assert.Equal(t, stalled+1, testutil.ToFloat64(metrics.RootCoordTimeTickAnomalyCounter.WithLabelValues(channel, metrics.TimeTickStallLabel)))
+	assert.True(t, ttSync.chanProgress[channel].stalled)
+
+	ttSync.syncedTtHistogram.update(channel, tsoutil.ComposeTSByTime(now.Add(3*time.Minute), 0))
+	ttSync.checkChannels(now.Add(3 * time.Minute))
+	assert.False(t, ttSync.chanProgress[channel].stalled)
+}
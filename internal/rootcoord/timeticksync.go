@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -85,6 +86,14 @@ type timetickSync struct {
 	sendChan       chan map[typeutil.UniqueID]*chanTsMsg
 
 	syncedTtHistogram *ttHistogram
+
+	// the progress of the time ticks of the sessions and channels to detect the stalls and skews,
+	// the proxies fenced are removed from the sessions and their time ticks are denied.
+	sessProgress map[typeutil.UniqueID]*ttProgress
+	chanProgress map[string]*ttProgress
+	fenced       typeutil.UniqueSet
+	// fenceSession writes the fence the proxy watches, nil if the proxies are only fenced in memory
+	fenceSession func(serverID typeutil.UniqueID, reason string) error
 }
 
 type chanTsMsg struct {
@@ -139,6 +148,10 @@ func newTimeTickSync(ctx context.Context, sourceID int64, factory msgstream.Fact
 		sendChan: make(chan map[typeutil.UniqueID]*chanTsMsg, 1),
 
 		syncedTtHistogram: newTtHistogram(),
+
+		sessProgress: make(map[typeutil.UniqueID]*ttProgress),
+		chanProgress: make(map[string]*ttProgress),
+		fenced:       typeutil.NewUniqueSet(),
 	}
 }
 
@@ -202,6 +215,9 @@ func (t *timetickSync) updateTimeTick(in *internalpb.ChannelTimeTickMsg, reason
 		return fmt.Errorf("invalid TimeTickMsg, timestamp and channelname size mismatch")
 	}
 
+	if t.fenced.Contain(in.Base.SourceID) {
+		return merr.WrapErrNodeStateUnexpected(in.Base.SourceID, "fenced", "the time tick of the proxy stalled")
+	}
 	prev, ok := t.sess2ChanTsMap[in.Base.SourceID]
 	if !ok {
 		return fmt.Errorf("skip ChannelTimeTickMsg from un-recognized session %d", in.Base.SourceID)
@@ -222,6 +238,7 @@ func (t *timetickSync) updateTimeTick(in *internalpb.ChannelTimeTickMsg, reason
 	} else {
 		t.sess2ChanTsMap[in.Base.SourceID] = newChanTsMsg(in, prev.cnt+1)
 	}
+	t.updateProgress(in.Base.SourceID, in.DefaultTimestamp)
 	t.sendToChannel()
 	return nil
 }
@@ -230,12 +247,14 @@ func (t *timetickSync) addSession(sess *sessionutil.Session) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 	t.sess2ChanTsMap[sess.ServerID] = nil
+	t.sessProgress[sess.ServerID] = &ttProgress{advancedAt: time.Now()}
 	log.Info("Add session for timeticksync", zap.Int64("serverID", sess.ServerID))
 }
 
 func (t *timetickSync) delSession(sess *sessionutil.Session) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
+	t.removeProgress(sess.ServerID)
 	if _, ok := t.sess2ChanTsMap[sess.ServerID]; ok {
 		delete(t.sess2ChanTsMap, sess.ServerID)
 		log.Info("Remove session from timeticksync", zap.Int64("serverID", sess.ServerID))
@@ -247,10 +266,15 @@ func (t *timetickSync) initSessions(sess []*sessionutil.Session) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
 	t.sess2ChanTsMap = make(map[typeutil.UniqueID]*chanTsMsg)
+	t.sessProgress = make(map[typeutil.UniqueID]*ttProgress)
 	// Init DDL source
 	t.sess2ChanTsMap[ddlSourceID] = nil
 	for _, s := range sess {
+		if t.fenced.Contain(s.ServerID) {
+			continue
+		}
 		t.sess2ChanTsMap[s.ServerID] = nil
+		t.sessProgress[s.ServerID] = &ttProgress{advancedAt: time.Now()}
 		log.Info("Init proxy sessions for timeticksync", zap.Int64("serverID", s.ServerID))
 	}
 }
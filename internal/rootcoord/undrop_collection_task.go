@@ -0,0 +1,210 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
+	"github.com/milvus-io/milvus/pkg/log"
+	ms "github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// undropCollectionTask restores the latest dropped collection of the name,
+// which is possible only before the dropped segments of it are garbage collected by datacoord.
+// The indexes of the collection are dropped along with it and have to be created again,
+// and the undropped collection has to be loaded again as well.
+type undropCollectionTask struct {
+	baseTask
+	Req *proxypb.UndropCollectionRequest
+}
+
+func (t *undropCollectionTask) Prepare(ctx context.Context) error {
+	if Params.DataCoordCfg.GCRestoreWindow.GetAsInt64() <= 0 {
+		return merr.WrapErrParameterInvalidMsg("undropping collection disabled, %s not set", Params.DataCoordCfg.GCRestoreWindow.Key)
+	}
+	if t.Req.GetCollectionName() == "" {
+		return merr.WrapErrParameterInvalidMsg("collection name not specified")
+	}
+	return nil
+}
+
+// getDroppedCollection returns the latest dropped collection of the name.
+func (t *undropCollectionTask) getDroppedCollection(ctx context.Context) (*model.Collection, error) {
+	dbName, collectionName := t.Req.GetDbName(), t.Req.GetCollectionName()
+	_, err := t.core.meta.GetCollectionByName(ctx, dbName, collectionName, typeutil.MaxTimestamp)
+	if err == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("collection %s already exists", collectionName)
+	}
+	if !errors.Is(err, merr.ErrCollectionNotFound) {
+		return nil, err
+	}
+
+	colls, err := t.core.meta.ListCollections(ctx, dbName, typeutil.MaxTimestamp, false)
+	if err != nil {
+		return nil, err
+	}
+	var dropped *model.Collection
+	for _, coll := range colls {
+		if coll.Name != collectionName || coll.State != pb.CollectionState_CollectionDropping {
+			continue
+		}
+		if dropped == nil || coll.CreateTime > dropped.CreateTime {
+			dropped = coll
+		}
+	}
+	if dropped == nil {
+		return nil, merr.WrapErrCollectionNotFoundWithDB(dbName, collectionName)
+	}
+	return dropped, nil
+}
+
+func (t *undropCollectionTask) genUndropCollectionMsg(ctx context.Context, coll *model.Collection, schema *schemapb.CollectionSchema, ts Timestamp) *ms.MsgPack {
+	// error won't happen here.
+	marshaledSchema, _ := proto.Marshal(schema)
+	msg := &ms.CreateCollectionMsg{
+		BaseMsg: ms.BaseMsg{
+			Ctx:            ctx,
+			BeginTimestamp: ts,
+			EndTimestamp:   ts,
+			HashValues:     []uint32{0},
+		},
+		CreateCollectionRequest: msgpb.CreateCollectionRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_CreateCollection),
+				commonpbutil.WithTimeStamp(ts),
+			),
+			CollectionID:         coll.CollectionID,
+			PartitionIDs:         lo.Map(coll.Partitions, func(p *model.Partition, _ int) int64 { return p.PartitionID }),
+			Schema:               marshaledSchema,
+			VirtualChannelNames:  coll.VirtualChannelNames,
+			PhysicalChannelNames: coll.PhysicalChannelNames,
+		},
+	}
+	return &ms.MsgPack{Msgs: []ms.TsMsg{msg}}
+}
+
+func (t *undropCollectionTask) Execute(ctx context.Context) error {
+	coll, err := t.getDroppedCollection(ctx)
+	if err != nil {
+		return err
+	}
+	log := log.Ctx(ctx).With(zap.String("collection", coll.Name), zap.Int64("collectionID", coll.CollectionID))
+
+	// the collection is pending for gc once the data of it is dropped,
+	// the confirmation of gc waits until the undropping done.
+	if !t.core.gcPendingCollections.TryRemove(coll.CollectionID) {
+		return fmt.Errorf("collection %s is still being dropped or undropped, retry later", coll.Name)
+	}
+	if t.core.broker.GcConfirm(ctx, coll.CollectionID, allPartition) {
+		t.core.gcPendingCollections.Insert(coll.CollectionID)
+		return merr.WrapErrParameterInvalidMsg("the data of collection %s has been garbage collected", coll.Name)
+	}
+
+	ts := t.GetTs()
+	schema := &schemapb.CollectionSchema{
+		Name:               coll.Name,
+		Description:        coll.Description,
+		AutoID:             coll.AutoID,
+		Fields:             model.MarshalFieldModels(coll.Fields),
+		EnableDynamicField: coll.EnableDynamicField,
+	}
+	t.core.chanTimeTick.addDmlChannels(coll.PhysicalChannelNames...)
+	startPositions, err := t.core.chanTimeTick.broadcastMarkDmlChannels(coll.PhysicalChannelNames, t.genUndropCollectionMsg(ctx, coll, schema, ts))
+	if err != nil {
+		t.core.chanTimeTick.removeDmlChannels(coll.PhysicalChannelNames...)
+		t.core.gcPendingCollections.Insert(coll.CollectionID)
+		return err
+	}
+
+	undoTask := newBaseUndoTask(t.core.stepExecutor)
+	undoTask.AddStep(&nullStep{}, NewSimpleStep("wait for gc again", func(ctx context.Context) ([]nestedStep, error) {
+		t.core.gcPendingCollections.Insert(coll.CollectionID)
+		return nil, nil
+	}))
+	undoTask.AddStep(&nullStep{}, &removeDmlChannelsStep{
+		baseStep:  baseStep{core: t.core},
+		pChannels: coll.PhysicalChannelNames,
+	})
+	undoTask.AddStep(&watchChannelsStep{
+		baseStep: baseStep{core: t.core},
+		info: &watchInfo{
+			ts:             ts,
+			collectionID:   coll.CollectionID,
+			vChannels:      coll.VirtualChannelNames,
+			startPositions: toKeyDataPairs(startPositions),
+			schema:         schema,
+		},
+	}, &unwatchChannelsStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: coll.CollectionID,
+		channels: collectionChannels{
+			virtualChannels:  coll.VirtualChannelNames,
+			physicalChannels: coll.PhysicalChannelNames,
+		},
+		isSkip: !Params.CommonCfg.TTMsgEnabled.GetAsBool(),
+	})
+	undoTask.AddStep(&changeCollectionStateStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: coll.CollectionID,
+		state:        pb.CollectionState_CollectionCreated,
+		ts:           ts,
+	}, &changeCollectionStateStep{
+		baseStep:     baseStep{core: t.core},
+		collectionID: coll.CollectionID,
+		state:        pb.CollectionState_CollectionDropping,
+		ts:           ts,
+	})
+	// the channels must be watched before the segments restored by datacoord.
+	undoTask.AddStep(NewSimpleStep("restore dropped segments", func(ctx context.Context) ([]nestedStep, error) {
+		return nil, t.core.broker.RestoreDroppedSegments(ctx, coll.CollectionID)
+	}), &nullStep{})
+	undoTask.AddStep(&expireCacheStep{
+		baseStep:        baseStep{core: t.core},
+		dbName:          t.Req.GetDbName(),
+		collectionNames: []string{coll.Name},
+		collectionID:    coll.CollectionID,
+		ts:              ts,
+		opts:            []proxyutil.ExpireCacheOpt{proxyutil.SetMsgType(commonpb.MsgType_DropCollection)},
+	}, &nullStep{})
+	// abandon the pending drop of the collection.
+	undoTask.AddStep(NewSimpleStep("abandon the drop", func(ctx context.Context) ([]nestedStep, error) {
+		t.core.undroppedCollections.Insert(coll.CollectionID)
+		return nil, nil
+	}), &nullStep{})
+
+	if err := undoTask.Execute(ctx); err != nil {
+		return err
+	}
+	log.Info("collection undropped, the indexes have to be created again")
+	return nil
+}
@@ -0,0 +1,163 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func Test_undropCollectionTask_Prepare(t *testing.T) {
+	paramtable.Init()
+	task := &undropCollectionTask{Req: &proxypb.UndropCollectionRequest{CollectionName: "coll"}}
+	err := task.Prepare(context.Background())
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	paramtable.Get().Save(Params.DataCoordCfg.GCRestoreWindow.Key, "3600")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.GCRestoreWindow.Key)
+	assert.NoError(t, task.Prepare(context.Background()))
+
+	task.Req.CollectionName = ""
+	err = task.Prepare(context.Background())
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func Test_undropCollectionTask_Execute(t *testing.T) {
+	paramtable.Init()
+	dropped := &model.Collection{
+		CollectionID:         100,
+		Name:                 "coll",
+		State:                pb.CollectionState_CollectionDropping,
+		CreateTime:           10,
+		VirtualChannelNames:  []string{"by-dev-rootcoord-dml_0_100v0"},
+		PhysicalChannelNames: []string{"by-dev-rootcoord-dml_0"},
+	}
+	newMeta := func(t *testing.T, colls ...*model.Collection) *mockrootcoord.IMetaTable {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll", mock.Anything).Return(nil, merr.WrapErrCollectionNotFound("coll"))
+		meta.EXPECT().ListCollections(mock.Anything, mock.Anything, mock.Anything, false).Return(colls, nil)
+		return meta
+	}
+	newTask := func(core *Core) *undropCollectionTask {
+		return &undropCollectionTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &proxypb.UndropCollectionRequest{CollectionName: "coll"},
+		}
+	}
+
+	t.Run("collection exists", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByName(mock.Anything, mock.Anything, "coll", mock.Anything).Return(&model.Collection{Name: "coll"}, nil)
+		core := newTestCore(withMeta(meta))
+		err := newTask(core).Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("collection not dropped", func(t *testing.T) {
+		core := newTestCore(withMeta(newMeta(t, &model.Collection{Name: "coll", State: pb.CollectionState_CollectionCreating})))
+		err := newTask(core).Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrCollectionNotFound)
+	})
+
+	t.Run("collection still being dropped", func(t *testing.T) {
+		core := newTestCore(withMeta(newMeta(t, dropped)))
+		err := newTask(core).Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("garbage collected", func(t *testing.T) {
+		broker := newMockBroker()
+		broker.GCConfirmFunc = func(ctx context.Context, collectionID, partitionID UniqueID) bool {
+			return true
+		}
+		core := newTestCore(withMeta(newMeta(t, dropped)), withBroker(broker))
+		core.gcPendingCollections.Insert(dropped.CollectionID)
+		err := newTask(core).Execute(context.Background())
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		assert.True(t, core.gcPendingCollections.Contain(dropped.CollectionID))
+	})
+
+	t.Run("failed to restore segments", func(t *testing.T) {
+		meta := newMeta(t, dropped)
+		meta.EXPECT().ChangeCollectionState(mock.Anything, dropped.CollectionID, pb.CollectionState_CollectionCreated, mock.Anything).Return(nil).Once()
+		meta.EXPECT().ChangeCollectionState(mock.Anything, dropped.CollectionID, pb.CollectionState_CollectionDropping, mock.Anything).Return(nil).Once()
+		broker := newMockBroker()
+		broker.GCConfirmFunc = func(ctx context.Context, collectionID, partitionID UniqueID) bool {
+			return false
+		}
+		broker.WatchChannelsFunc = func(ctx context.Context, info *watchInfo) error {
+			return nil
+		}
+		broker.RestoreDroppedSegmentsFunc = func(ctx context.Context, collectionID UniqueID) error {
+			return errors.New("mock")
+		}
+		ticker := newTickerWithMockNormalStream()
+		// the channels are unwatched by the drop msg
+		gc := mockrootcoord.NewGarbageCollector(t)
+		gc.EXPECT().GcCollectionData(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, coll *model.Collection) (Timestamp, error) {
+			assert.Equal(t, dropped.PhysicalChannelNames, coll.PhysicalChannelNames)
+			for _, pchan := range coll.PhysicalChannelNames {
+				ticker.syncedTtHistogram.update(pchan, 101)
+			}
+			return 100, nil
+		})
+		core := newTestCore(withMeta(meta), withBroker(broker), withTtSynchronizer(ticker), withGarbageCollector(gc))
+		core.gcPendingCollections.Insert(dropped.CollectionID)
+		err := newTask(core).Execute(context.Background())
+		assert.Error(t, err)
+		// wait for gc again after undone
+		assert.Eventually(t, func() bool {
+			return core.gcPendingCollections.Contain(dropped.CollectionID)
+		}, time.Second, 10*time.Millisecond)
+		assert.False(t, core.undroppedCollections.Contain(dropped.CollectionID))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		meta := newMeta(t, dropped, &model.Collection{CollectionID: 99, Name: "coll", State: pb.CollectionState_CollectionDropping, CreateTime: 1})
+		meta.EXPECT().ChangeCollectionState(mock.Anything, dropped.CollectionID, pb.CollectionState_CollectionCreated, mock.Anything).Return(nil)
+		broker := newMockBroker()
+		broker.GCConfirmFunc = func(ctx context.Context, collectionID, partitionID UniqueID) bool {
+			return false
+		}
+		broker.WatchChannelsFunc = func(ctx context.Context, info *watchInfo) error {
+			assert.Equal(t, dropped.VirtualChannelNames, info.vChannels)
+			return nil
+		}
+		broker.RestoreDroppedSegmentsFunc = func(ctx context.Context, collectionID UniqueID) error {
+			assert.Equal(t, dropped.CollectionID, collectionID)
+			return nil
+		}
+		core := newTestCore(withMeta(meta), withBroker(broker), withTtSynchronizer(newTickerWithMockNormalStream()), withValidProxyManager())
+		core.gcPendingCollections.Insert(dropped.CollectionID)
+		err := newTask(core).Execute(context.Background())
+		assert.NoError(t, err)
+		assert.False(t, core.gcPendingCollections.Contain(dropped.CollectionID))
+		assert.True(t, core.undroppedCollections.Contain(dropped.CollectionID))
+	})
+}
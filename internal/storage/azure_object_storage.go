@@ -17,12 +17,15 @@
 package storage
 
 import (
+	"bytes"
 	"context"
 	"io"
 	"os"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/streaming"
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
@@ -37,11 +40,31 @@ import (
 
 type AzureObjectStorage struct {
 	*service.Client
+	multipartThreshold int64
+	multipartPartSize  int64
+}
+
+// newAzureClientOptions returns the client options with the retry policy configured,
+// the throttling, timeout and server errors are retried by the sdk with exponential backoff.
+func newAzureClientOptions(c *config) *service.ClientOptions {
+	retryOptions := policy.RetryOptions{}
+	if c.maxRetries > 0 {
+		retryOptions.MaxRetries = int32(c.maxRetries)
+	}
+	if c.requestTimeoutMs > 0 {
+		retryOptions.TryTimeout = time.Duration(c.requestTimeoutMs) * time.Millisecond
+	}
+	return &service.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Retry: retryOptions,
+		},
+	}
 }
 
 func newAzureObjectStorageWithConfig(ctx context.Context, c *config) (*AzureObjectStorage, error) {
 	var client *service.Client
 	var err error
+	clientOptions := newAzureClientOptions(c)
 	if c.useIAM {
 		cred, credErr := azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
 			ClientID:      os.Getenv("AZURE_CLIENT_ID"),
@@ -51,14 +74,14 @@ func newAzureObjectStorageWithConfig(ctx context.Context, c *config) (*AzureObje
 		if credErr != nil {
 			return nil, credErr
 		}
-		client, err = service.NewClient("https://"+c.accessKeyID+".blob."+c.address+"/", cred, &service.ClientOptions{})
+		client, err = service.NewClient("https://"+c.accessKeyID+".blob."+c.address+"/", cred, clientOptions)
 	} else {
 		connectionString := os.Getenv("AZURE_STORAGE_CONNECTION_STRING")
 		if connectionString == "" {
 			connectionString = "DefaultEndpointsProtocol=https;AccountName=" + c.accessKeyID +
 				";AccountKey=" + c.secretAccessKeyID + ";EndpointSuffix=" + c.address
 		}
-		client, err = service.NewClientFromConnectionString(connectionString, &service.ClientOptions{})
+		client, err = service.NewClientFromConnectionString(connectionString, clientOptions)
 	}
 	if err != nil {
 		return nil, err
@@ -87,7 +110,11 @@ func newAzureObjectStorageWithConfig(ctx context.Context, c *config) (*AzureObje
 	if err != nil {
 		return nil, err
 	}
-	return &AzureObjectStorage{Client: client}, nil
+	return &AzureObjectStorage{
+		Client:             client,
+		multipartThreshold: c.multipartThreshold,
+		multipartPartSize:  c.multipartPartSize,
+	}, nil
 }
 
 // BlobReader is implemented because Azure's stream body does not have ReadAt and Seek interfaces.
@@ -184,8 +211,25 @@ func (AzureObjectStorage *AzureObjectStorage) GetObject(ctx context.Context, buc
 	return NewBlobReader(AzureObjectStorage.Client.NewContainerClient(bucketName).NewBlockBlobClient(objectName), offset)
 }
 
+// PutObject uploads the object by a single request if it's not larger than the multipart threshold,
+// otherwise by staging the blocks of the multipart part size and committing them.
 func (AzureObjectStorage *AzureObjectStorage) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
-	_, err := AzureObjectStorage.Client.NewContainerClient(bucketName).NewBlockBlobClient(objectName).UploadStream(ctx, reader, &azblob.UploadStreamOptions{})
+	client := AzureObjectStorage.Client.NewContainerClient(bucketName).NewBlockBlobClient(objectName)
+	if objectSize >= 0 && objectSize <= AzureObjectStorage.multipartThreshold {
+		body, ok := reader.(io.ReadSeeker)
+		if !ok {
+			data, err := io.ReadAll(io.LimitReader(reader, objectSize))
+			if err != nil {
+				return checkObjectStorageError(objectName, err)
+			}
+			body = bytes.NewReader(data)
+		}
+		_, err := client.Upload(ctx, streaming.NopCloser(body), &blockblob.UploadOptions{})
+		return checkObjectStorageError(objectName, err)
+	}
+	_, err := client.UploadStream(ctx, reader, &azblob.UploadStreamOptions{
+		BlockSize: AzureObjectStorage.multipartPartSize,
+	})
 	return checkObjectStorageError(objectName, err)
 }
 
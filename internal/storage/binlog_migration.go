@@ -0,0 +1,131 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// MigrateBinlog rewrites the insert binlog of a single event into the binlog format, in either direction,
+// the binlogs of the other types are returned as is.
+func MigrateBinlog(data []byte, format BinlogFormat) ([]byte, error) {
+	if err := format.Validate(); err != nil {
+		return nil, err
+	}
+	reader, err := NewBinlogReader(data)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	eventReader, err := reader.NextEventReader()
+	if err != nil {
+		return nil, err
+	}
+	if eventReader == nil || eventReader.TypeCode != InsertEventType {
+		return data, nil
+	}
+	if reader.buffer.Len() > 0 {
+		return nil, fmt.Errorf("only the insert binlogs of a single event could be migrated")
+	}
+	values, dim, err := eventReader.GetDataFromPayload()
+	if err != nil {
+		return nil, err
+	}
+
+	writer := newMigrationBinlogWriter(&reader.descriptorEvent, format)
+	defer writer.Close()
+	var eventWriter *insertEventWriter
+	if typeutil.IsVectorType(reader.PayloadDataType) && !typeutil.IsSparseVectorType(reader.PayloadDataType) {
+		eventWriter, err = writer.NextInsertEventWriter(dim)
+	} else {
+		eventWriter, err = writer.NextInsertEventWriter()
+	}
+	if err != nil {
+		return nil, err
+	}
+	insertData := eventReader.eventData.(*insertEventData)
+	eventWriter.SetEventTimestamp(insertData.StartTimestamp, insertData.EndTimestamp)
+	if err := addPayloadData(eventWriter, reader.PayloadDataType, values, dim); err != nil {
+		return nil, err
+	}
+	if err := writer.Finish(); err != nil {
+		return nil, err
+	}
+	return writer.GetBuffer()
+}
+
+// newMigrationBinlogWriter creates the insert binlog writer of the same descriptor and compression codec as the source.
+func newMigrationBinlogWriter(source *descriptorEvent, format BinlogFormat) *InsertBinlogWriter {
+	descriptorEvent := newDescriptorEvent()
+	descriptorEvent.DescriptorEventDataFixPart = source.DescriptorEventDataFixPart
+	for k, v := range source.Extras {
+		if k != binlogVersionKey && k != pageIndexKey {
+			descriptorEvent.AddExtra(k, v)
+		}
+	}
+	// the binlogs without the codec recorded are written before the codec configurable
+	compression := DefaultBinlogCompression
+	if codec, ok := source.Extras[compressionKey].(string); ok {
+		compression.Codec = codec
+	}
+
+	return &InsertBinlogWriter{
+		baseBinlogWriter: baseBinlogWriter{
+			descriptorEvent: *descriptorEvent,
+			magicNumber:     MagicNumber,
+			binlogType:      InsertBinlog,
+			eventWriters:    make([]EventWriter, 0),
+			buffer:          nil,
+			compression:     compression,
+			format:          format,
+		},
+	}
+}
+
+// addPayloadData adds the values read by GetDataFromPayload to the payload writer.
+func addPayloadData(w PayloadWriterInterface, dataType schemapb.DataType, values interface{}, dim int) error {
+	switch dataType {
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		for _, value := range values.([]string) {
+			if err := w.AddOneStringToPayload(value); err != nil {
+				return err
+			}
+		}
+	case schemapb.DataType_Array:
+		for _, value := range values.([]*schemapb.ScalarField) {
+			if err := w.AddOneArrayToPayload(value); err != nil {
+				return err
+			}
+		}
+	case schemapb.DataType_JSON:
+		for _, value := range values.([][]byte) {
+			if err := w.AddOneJSONToPayload(value); err != nil {
+				return err
+			}
+		}
+	default:
+		if typeutil.IsVectorType(dataType) {
+			return w.AddDataToPayload(values, dim)
+		}
+		return w.AddDataToPayload(values)
+	}
+	return nil
+}
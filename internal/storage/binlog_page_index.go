@@ -0,0 +1,510 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/metadata"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// The version 2 insert binlogs keep the layout of the version 1 ones, a single insert event with the parquet payload,
+// so that they remain readable by the readers of version 1. The payload is split into the row groups of at most
+// pageRows rows, which are the pages, and the page index with the byte ranges, the statistics and the checksums
+// of the pages is recorded in the descriptor extras, which the readers of version 1 ignore.
+const (
+	BinlogVersionV1 = 1
+	BinlogVersionV2 = 2
+
+	// binlogVersionKey is the key of the descriptor extras recording the format version, absent in version 1.
+	binlogVersionKey = "binlog_version"
+	// pageIndexKey is the key of the descriptor extras recording the page index of the version 2 binlogs.
+	pageIndexKey = "page_index"
+
+	// parquetFooterTailSize is the size of the metadata length and the magic at the end of the parquet payload.
+	parquetFooterTailSize = 8
+)
+
+var pageChecksumTable = crc32.MakeTable(crc32.Castagnoli)
+
+// BinlogFormat is the format version and the page size of the insert binlogs to write.
+type BinlogFormat struct {
+	Version  int
+	PageRows int
+}
+
+// GetBinlogFormat returns the binlog format configured, or the version 1 if the configured one is invalid.
+func GetBinlogFormat() BinlogFormat {
+	params := paramtable.Get()
+	format := BinlogFormat{
+		Version:  params.DataNodeCfg.BinlogFormatVersion.GetAsInt(),
+		PageRows: params.DataNodeCfg.BinlogPageRows.GetAsInt(),
+	}
+	if err := format.Validate(); err != nil {
+		log.RatedWarn(60, "invalid binlog format, fallback to the version 1", zap.Error(err))
+		return BinlogFormat{Version: BinlogVersionV1}
+	}
+	return format
+}
+
+func (f BinlogFormat) Validate() error {
+	switch f.Version {
+	case BinlogVersionV1:
+	case BinlogVersionV2:
+		if f.PageRows <= 0 {
+			return merr.WrapErrParameterInvalidMsg("page rows of binlog must be positive, got %d", f.PageRows)
+		}
+	default:
+		return merr.WrapErrParameterInvalidMsg("unsupported binlog format version %d", f.Version)
+	}
+	return nil
+}
+
+// BinlogPage is the index entry of a page of the version 2 binlog.
+type BinlogPage struct {
+	// Offset and Length are the byte range of the page in the payload
+	Offset    int64  `json:"offset"`
+	Length    int64  `json:"length"`
+	RowOffset int64  `json:"rowOffset"`
+	Rows      int64  `json:"rows"`
+	Checksum  uint32 `json:"checksum"`
+	// Stats is the min and max of the page, only for the scalar fields supporting the stats
+	Stats *FieldStats `json:"stats,omitempty"`
+}
+
+// BinlogPageIndex is the page index of the version 2 binlog.
+type BinlogPageIndex struct {
+	PayloadSize int64 `json:"payloadSize"`
+	// FooterOffset is the offset of the parquet metadata in the payload
+	FooterOffset int64         `json:"footerOffset"`
+	Pages        []*BinlogPage `json:"pages"`
+}
+
+// RowNum returns the total rows of the pages.
+func (index *BinlogPageIndex) RowNum() int64 {
+	var rows int64
+	for _, page := range index.Pages {
+		rows += page.Rows
+	}
+	return rows
+}
+
+// SelectPagesByRows returns the pages containing any row in [offset, offset+limit).
+func (index *BinlogPageIndex) SelectPagesByRows(offset, limit int64) []int {
+	pages := make([]int, 0)
+	for i, page := range index.Pages {
+		if page.RowOffset < offset+limit && page.RowOffset+page.Rows > offset {
+			pages = append(pages, i)
+		}
+	}
+	return pages
+}
+
+// SelectPagesByRange returns the pages which may contain the values in [min, max],
+// the pages without stats are always selected, nil min or max means unbounded.
+func (index *BinlogPageIndex) SelectPagesByRange(min, max ScalarFieldValue) []int {
+	pages := make([]int, 0)
+	for i, page := range index.Pages {
+		if page.Stats != nil && page.Stats.Min != nil && page.Stats.Max != nil {
+			if min != nil && page.Stats.Max.LT(min) {
+				continue
+			}
+			if max != nil && page.Stats.Min.GT(max) {
+				continue
+			}
+		}
+		pages = append(pages, i)
+	}
+	return pages
+}
+
+func (index *BinlogPageIndex) verify(id int, data []byte) error {
+	if id < 0 || id >= len(index.Pages) {
+		return merr.WrapErrParameterInvalidRange(0, len(index.Pages)-1, id, "page of binlog out of range")
+	}
+	page := index.Pages[id]
+	if int64(len(data)) != page.Length {
+		return fmt.Errorf("page %d length mismatch, expected: %d, actual: %d", id, page.Length, len(data))
+	}
+	if checksum := crc32.Checksum(data, pageChecksumTable); checksum != page.Checksum {
+		return fmt.Errorf("page %d checksum mismatch, expected: %d, actual: %d", id, page.Checksum, checksum)
+	}
+	return nil
+}
+
+// buildPageIndex builds the page index from the row groups of the parquet payload.
+func buildPageIndex(fieldID int64, dataType schemapb.DataType, payload []byte) (*BinlogPageIndex, error) {
+	if len(payload) < parquetFooterTailSize {
+		return nil, fmt.Errorf("payload too small, size: %d", len(payload))
+	}
+	reader, err := file.NewParquetReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	metadataSize := int64(binary.LittleEndian.Uint32(payload[len(payload)-parquetFooterTailSize:]))
+	index := &BinlogPageIndex{
+		PayloadSize:  int64(len(payload)),
+		FooterOffset: int64(len(payload)) - parquetFooterTailSize - metadataSize,
+		Pages:        make([]*BinlogPage, 0, reader.NumRowGroups()),
+	}
+	var rowOffset int64
+	for i := 0; i < reader.NumRowGroups(); i++ {
+		rowGroup := reader.MetaData().RowGroup(i)
+		column, err := rowGroup.ColumnChunk(0)
+		if err != nil {
+			return nil, err
+		}
+		offset := column.DataPageOffset()
+		if column.HasDictionaryPage() && column.DictionaryPageOffset() > 0 && column.DictionaryPageOffset() < offset {
+			offset = column.DictionaryPageOffset()
+		}
+		length := column.TotalCompressedSize()
+		if offset < 0 || length < 0 || offset+length > index.FooterOffset {
+			return nil, fmt.Errorf("invalid column chunk of row group %d, offset: %d, length: %d", i, offset, length)
+		}
+		stats, err := newPageStats(fieldID, dataType, column)
+		if err != nil {
+			return nil, err
+		}
+		index.Pages = append(index.Pages, &BinlogPage{
+			Offset:    offset,
+			Length:    length,
+			RowOffset: rowOffset,
+			Rows:      rowGroup.NumRows(),
+			Checksum:  crc32.Checksum(payload[offset:offset+length], pageChecksumTable),
+			Stats:     stats,
+		})
+		rowOffset += rowGroup.NumRows()
+	}
+	return index, nil
+}
+
+// newPageStats converts the parquet statistics of the column chunk into the field stats.
+func newPageStats(fieldID int64, dataType schemapb.DataType, column *metadata.ColumnChunkMetaData) (*FieldStats, error) {
+	if !IsScalarStatsSupported(dataType) {
+		return nil, nil
+	}
+	if ok, err := column.StatsSet(); err != nil || !ok {
+		return nil, err
+	}
+	typed, err := column.Statistics()
+	if err != nil || !typed.HasMinMax() {
+		return nil, err
+	}
+
+	var min, max ScalarFieldValue
+	switch s := typed.(type) {
+	case *metadata.Int32Statistics:
+		switch dataType {
+		case schemapb.DataType_Int8:
+			min, max = NewInt8FieldValue(int8(s.Min())), NewInt8FieldValue(int8(s.Max()))
+		case schemapb.DataType_Int16:
+			min, max = NewInt16FieldValue(int16(s.Min())), NewInt16FieldValue(int16(s.Max()))
+		default:
+			min, max = NewInt32FieldValue(s.Min()), NewInt32FieldValue(s.Max())
+		}
+	case *metadata.Int64Statistics:
+		min, max = NewInt64FieldValue(s.Min()), NewInt64FieldValue(s.Max())
+	case *metadata.Float32Statistics:
+		min, max = NewFloatFieldValue(s.Min()), NewFloatFieldValue(s.Max())
+	case *metadata.Float64Statistics:
+		min, max = NewDoubleFieldValue(s.Min()), NewDoubleFieldValue(s.Max())
+	case *metadata.ByteArrayStatistics:
+		if dataType == schemapb.DataType_VarChar {
+			min, max = NewVarCharFieldValue(string(s.Min())), NewVarCharFieldValue(string(s.Max()))
+		} else {
+			min, max = NewStringFieldValue(string(s.Min())), NewStringFieldValue(string(s.Max()))
+		}
+	default:
+		return nil, nil
+	}
+	stats := NewScalarFieldStats(fieldID, dataType, false)
+	stats.UpdateMinMax(min)
+	stats.UpdateMinMax(max)
+	return stats, nil
+}
+
+// finishPageIndex finishes the event of the version 2 binlog in advance and records its page index,
+// only the insert binlogs of a single event are paged, the others are kept in version 1.
+func (writer *baseBinlogWriter) finishPageIndex() error {
+	if writer.binlogType != InsertBinlog || len(writer.eventWriters) != 1 {
+		return nil
+	}
+	w := writer.eventWriters[0]
+	if err := w.Finish(); err != nil {
+		return err
+	}
+	payload, err := w.GetPayloadBufferFromWriter()
+	if err != nil {
+		return err
+	}
+	index, err := buildPageIndex(writer.FieldID, writer.PayloadDataType, payload)
+	if err != nil {
+		return err
+	}
+	writer.AddExtra(binlogVersionKey, strconv.Itoa(BinlogVersionV2))
+	writer.AddExtra(pageIndexKey, index)
+	return nil
+}
+
+// GetBinlogVersion returns the format version of the binlog.
+func (data *descriptorEventData) GetBinlogVersion() int {
+	value, ok := data.Extras[binlogVersionKey].(string)
+	if !ok {
+		return BinlogVersionV1
+	}
+	version, err := strconv.Atoi(value)
+	if err != nil {
+		return BinlogVersionV1
+	}
+	return version
+}
+
+// GetPageIndex returns the page index of the version 2 binlog, or nil for the binlogs of the other versions.
+func (data *descriptorEventData) GetPageIndex() (*BinlogPageIndex, error) {
+	if data.GetBinlogVersion() != BinlogVersionV2 {
+		return nil, nil
+	}
+	extras := struct {
+		PageIndex *BinlogPageIndex `json:"page_index"`
+	}{}
+	if err := json.Unmarshal(data.ExtraBytes, &extras); err != nil {
+		return nil, err
+	}
+	if extras.PageIndex == nil {
+		return nil, fmt.Errorf("%s not in extra of version %d binlog", pageIndexKey, BinlogVersionV2)
+	}
+	return extras.PageIndex, nil
+}
+
+// BinlogPageReader reads the selected pages of the insert binlog from the chunk manager,
+// the version 2 binlogs are read by ranges, and the other ones are read entirely.
+type BinlogPageReader struct {
+	cm            ChunkManager
+	path          string
+	dataType      schemapb.DataType
+	index         *BinlogPageIndex
+	payloadOffset int64
+	footer        *metadata.FileMetaData
+	// data is the entire binlog, only for the binlogs not of version 2
+	data []byte
+}
+
+// NewBinlogPageReader reads the descriptor and the parquet metadata of the insert binlog to create BinlogPageReader.
+func NewBinlogPageReader(ctx context.Context, cm ChunkManager, path string) (*BinlogPageReader, error) {
+	headerSize := int64(binary.Size(MagicNumber)) + int64(newDescriptorEventHeader().GetMemoryUsageInBytes())
+	head, err := cm.ReadAt(ctx, path, 0, headerSize)
+	if err != nil {
+		return nil, err
+	}
+	header, err := readDescriptorEventHeader(bytes.NewReader(head[binary.Size(MagicNumber):]))
+	if err != nil {
+		return nil, err
+	}
+	descriptorEnd := int64(binary.Size(MagicNumber)) + int64(header.EventLength)
+	head, err = cm.ReadAt(ctx, path, 0, descriptorEnd)
+	if err != nil {
+		return nil, err
+	}
+	binlogReader, err := NewBinlogReader(head)
+	if err != nil {
+		return nil, err
+	}
+	defer binlogReader.Close()
+
+	reader := &BinlogPageReader{
+		cm:            cm,
+		path:          path,
+		dataType:      binlogReader.PayloadDataType,
+		payloadOffset: descriptorEnd + int64(binary.Size(eventHeader{})) + int64(binary.Size(insertEventData{})),
+	}
+	reader.index, err = binlogReader.GetPageIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	var footer []byte
+	if reader.index != nil {
+		footer, err = cm.ReadAt(ctx, path, reader.payloadOffset+reader.index.FooterOffset,
+			reader.index.PayloadSize-parquetFooterTailSize-reader.index.FooterOffset)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if footer, err = reader.readEntirely(ctx, descriptorEnd); err != nil {
+			return nil, err
+		}
+	}
+	reader.footer, err = metadata.NewFileMetaData(footer, nil)
+	if err != nil {
+		return nil, err
+	}
+	return reader, nil
+}
+
+// readEntirely reads the entire binlog not of version 2 and builds the page index of its payload,
+// returns the parquet metadata of the payload.
+func (reader *BinlogPageReader) readEntirely(ctx context.Context, descriptorEnd int64) ([]byte, error) {
+	data, err := reader.cm.Read(ctx, reader.path)
+	if err != nil {
+		return nil, err
+	}
+	header, err := readEventHeader(bytes.NewReader(data[descriptorEnd:]))
+	if err != nil {
+		return nil, err
+	}
+	if header.TypeCode != InsertEventType || int64(header.NextPosition) != int64(len(data)) {
+		return nil, fmt.Errorf("only the insert binlogs of a single event could be read by pages, path: %s", reader.path)
+	}
+	payload := data[reader.payloadOffset:]
+	reader.index, err = buildPageIndex(0, reader.dataType, payload)
+	if err != nil {
+		return nil, err
+	}
+	reader.data = data
+	return payload[reader.index.FooterOffset : reader.index.PayloadSize-parquetFooterTailSize], nil
+}
+
+// PageIndex returns the page index of the binlog, which is built on read for the binlogs not of version 2.
+func (reader *BinlogPageReader) PageIndex() *BinlogPageIndex {
+	return reader.index
+}
+
+func (reader *BinlogPageReader) readPage(ctx context.Context, id int) ([]byte, error) {
+	page := reader.index.Pages[id]
+	if reader.data != nil {
+		start := reader.payloadOffset + page.Offset
+		return reader.data[start : start+page.Length], nil
+	}
+	return reader.cm.ReadAt(ctx, reader.path, reader.payloadOffset+page.Offset, page.Length)
+}
+
+// ReadPages reads the rows of the pages, verifies the checksums of them, and returns the rows in the order of the pages.
+func (reader *BinlogPageReader) ReadPages(ctx context.Context, field *schemapb.FieldSchema, pages ...int) (FieldData, error) {
+	pages = append([]int{}, pages...)
+	sort.Ints(pages)
+	source := &pageSource{size: reader.index.PayloadSize, pages: make(map[int64][]byte)}
+	selected := make([]int, 0, len(pages))
+	for i, id := range pages {
+		if i > 0 && id == pages[i-1] {
+			continue
+		}
+		if id < 0 || id >= len(reader.index.Pages) {
+			return nil, merr.WrapErrParameterInvalidRange(0, len(reader.index.Pages)-1, id, "page of binlog out of range")
+		}
+		data, err := reader.readPage(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := reader.index.verify(id, data); err != nil {
+			return nil, merr.WrapErrIoFailed(reader.path, err)
+		}
+		source.pages[reader.index.Pages[id].Offset] = data
+		selected = append(selected, id)
+	}
+
+	fieldData, err := NewFieldData(reader.dataType, field)
+	if err != nil {
+		return nil, err
+	}
+	if len(selected) == 0 {
+		return fieldData, nil
+	}
+
+	parquetReader, err := file.NewParquetReader(source, file.WithMetadata(reader.footer))
+	if err != nil {
+		return nil, err
+	}
+	defer parquetReader.Close()
+	arrowReader, err := pqarrow.NewFileReader(parquetReader, pqarrow.ArrowReadProperties{BatchSize: 1024}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+	rr, err := arrowReader.GetRecordReader(ctx, nil, selected)
+	if err != nil {
+		return nil, err
+	}
+	defer rr.Release()
+	for rr.Next() {
+		column := rr.Record().Column(0)
+		for i := 0; i < column.Len(); i++ {
+			value, ok := deserializeCell(column, reader.dataType, i)
+			if !ok {
+				return nil, fmt.Errorf("failed to deserialize the row of type %s in binlog %s", reader.dataType, reader.path)
+			}
+			if err := fieldData.AppendRow(value); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := rr.Err(); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return fieldData, nil
+}
+
+// pageSource serves the pages fetched to the parquet reader.
+type pageSource struct {
+	size   int64
+	offset int64
+	pages  map[int64][]byte
+}
+
+func (s *pageSource) ReadAt(p []byte, off int64) (int, error) {
+	for start, data := range s.pages {
+		if off >= start && off+int64(len(p)) <= start+int64(len(data)) {
+			return copy(p, data[off-start:]), nil
+		}
+	}
+	return 0, fmt.Errorf("range [%d, %d) of the payload not fetched", off, off+int64(len(p)))
+}
+
+func (s *pageSource) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += s.offset
+	case io.SeekEnd:
+		offset += s.size
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("negative position %d", offset)
+	}
+	s.offset = offset
+	return offset, nil
+}
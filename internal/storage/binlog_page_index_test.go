@@ -0,0 +1,243 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func writeTestInsertBinlog(t *testing.T, dataType schemapb.DataType, values interface{}, dim int) []byte {
+	w := NewInsertBinlogWriter(dataType, 10, 20, 30, 40)
+	defer w.Close()
+	var e *insertEventWriter
+	var err error
+	if dim > 0 {
+		e, err = w.NextInsertEventWriter(dim)
+	} else {
+		e, err = w.NextInsertEventWriter()
+	}
+	require.NoError(t, err)
+	e.SetEventTimestamp(100, 200)
+	require.NoError(t, addPayloadData(e, dataType, values, dim))
+	w.SetEventTimeStamp(100, 200)
+	w.AddExtra(originalSizeKey, "1024")
+	require.NoError(t, w.Finish())
+	buf, err := w.GetBuffer()
+	require.NoError(t, err)
+	return buf
+}
+
+func TestBinlogFormat(t *testing.T) {
+	params := paramtable.Get()
+	defer params.Reset(params.DataNodeCfg.BinlogFormatVersion.Key)
+	defer params.Reset(params.DataNodeCfg.BinlogPageRows.Key)
+
+	assert.Equal(t, BinlogFormat{Version: BinlogVersionV1, PageRows: 8192}, GetBinlogFormat())
+	params.Save(params.DataNodeCfg.BinlogFormatVersion.Key, "2")
+	params.Save(params.DataNodeCfg.BinlogPageRows.Key, "100")
+	assert.Equal(t, BinlogFormat{Version: BinlogVersionV2, PageRows: 100}, GetBinlogFormat())
+
+	// fallback to version 1 if invalid
+	params.Save(params.DataNodeCfg.BinlogPageRows.Key, "0")
+	assert.Equal(t, BinlogFormat{Version: BinlogVersionV1}, GetBinlogFormat())
+	params.Save(params.DataNodeCfg.BinlogFormatVersion.Key, "3")
+	assert.Equal(t, BinlogFormat{Version: BinlogVersionV1}, GetBinlogFormat())
+}
+
+func TestBinlogPageIndex(t *testing.T) {
+	ctx := context.Background()
+	params := paramtable.Get()
+	defer params.Reset(params.DataNodeCfg.BinlogFormatVersion.Key)
+	defer params.Reset(params.DataNodeCfg.BinlogPageRows.Key)
+	params.Save(params.DataNodeCfg.BinlogFormatVersion.Key, "2")
+	params.Save(params.DataNodeCfg.BinlogPageRows.Key, "10")
+
+	values := make([]int64, 25)
+	for i := range values {
+		values[i] = int64(i)
+	}
+	v2 := writeTestInsertBinlog(t, schemapb.DataType_Int64, values, 0)
+
+	t.Run("readable as version 1", func(t *testing.T) {
+		reader, err := NewBinlogReader(v2)
+		require.NoError(t, err)
+		defer reader.Close()
+		assert.Equal(t, BinlogVersionV2, reader.GetBinlogVersion())
+		event, err := reader.NextEventReader()
+		require.NoError(t, err)
+		data, err := event.GetInt64FromPayload()
+		assert.NoError(t, err)
+		assert.Equal(t, values, data)
+		event, err = reader.NextEventReader()
+		assert.NoError(t, err)
+		assert.Nil(t, event)
+	})
+
+	t.Run("page index", func(t *testing.T) {
+		reader, err := NewBinlogReader(v2)
+		require.NoError(t, err)
+		defer reader.Close()
+		index, err := reader.GetPageIndex()
+		require.NoError(t, err)
+		require.Len(t, index.Pages, 3)
+		assert.EqualValues(t, 25, index.RowNum())
+		assert.EqualValues(t, 20, index.Pages[2].RowOffset)
+		assert.EqualValues(t, 5, index.Pages[2].Rows)
+		assert.Equal(t, int64(10), index.Pages[1].Stats.Min.GetValue())
+		assert.Equal(t, int64(19), index.Pages[1].Stats.Max.GetValue())
+
+		assert.Equal(t, []int{1, 2}, index.SelectPagesByRows(15, 6))
+		assert.Equal(t, []int{0, 1}, index.SelectPagesByRange(NewInt64FieldValue(5), NewInt64FieldValue(12)))
+		assert.Equal(t, []int{2}, index.SelectPagesByRange(NewInt64FieldValue(20), nil))
+	})
+
+	dir := t.TempDir()
+	cm := NewLocalChunkManager(RootPath(dir))
+	field := &schemapb.FieldSchema{FieldID: 40, DataType: schemapb.DataType_Int64}
+
+	t.Run("read pages", func(t *testing.T) {
+		file := path.Join(dir, "v2")
+		require.NoError(t, cm.Write(ctx, file, v2))
+		reader, err := NewBinlogPageReader(ctx, cm, file)
+		require.NoError(t, err)
+		assert.Len(t, reader.PageIndex().Pages, 3)
+		assert.Nil(t, reader.data)
+
+		data, err := reader.ReadPages(ctx, field, 2, 0, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, append(values[:10:10], values[20:]...), data.(*Int64FieldData).Data)
+		data, err = reader.ReadPages(ctx, field)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, data.RowNum())
+		_, err = reader.ReadPages(ctx, field, 3)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		reader, err := NewBinlogPageReader(ctx, cm, path.Join(dir, "v2"))
+		require.NoError(t, err)
+		corrupted := append([]byte{}, v2...)
+		corrupted[reader.payloadOffset+reader.index.Pages[1].Offset+reader.index.Pages[1].Length-1] ^= 0xff
+		file := path.Join(dir, "corrupted")
+		require.NoError(t, cm.Write(ctx, file, corrupted))
+
+		reader, err = NewBinlogPageReader(ctx, cm, file)
+		require.NoError(t, err)
+		_, err = reader.ReadPages(ctx, field, 0)
+		assert.NoError(t, err)
+		_, err = reader.ReadPages(ctx, field, 1)
+		assert.ErrorIs(t, err, merr.ErrIoFailed)
+	})
+
+	t.Run("read pages of version 1", func(t *testing.T) {
+		params.Save(params.DataNodeCfg.BinlogFormatVersion.Key, "1")
+		file := path.Join(dir, "v1")
+		require.NoError(t, cm.Write(ctx, file, writeTestInsertBinlog(t, schemapb.DataType_Int64, values, 0)))
+		reader, err := NewBinlogPageReader(ctx, cm, file)
+		require.NoError(t, err)
+		assert.NotNil(t, reader.data)
+		require.Len(t, reader.PageIndex().Pages, 1)
+		data, err := reader.ReadPages(ctx, field, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, values, data.(*Int64FieldData).Data)
+	})
+}
+
+func TestMigrateBinlog(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	cm := NewLocalChunkManager(RootPath(dir))
+
+	strs := make([]string, 25)
+	for i := range strs {
+		strs[i] = fmt.Sprintf("str-%02d", i)
+	}
+	vectors := make([]float32, 25*4)
+	for i := range vectors {
+		vectors[i] = float32(i)
+	}
+	cases := []struct {
+		dataType schemapb.DataType
+		values   interface{}
+		dim      int
+		field    *schemapb.FieldSchema
+	}{
+		{schemapb.DataType_VarChar, strs, 0, &schemapb.FieldSchema{DataType: schemapb.DataType_VarChar}},
+		{schemapb.DataType_FloatVector, vectors, 4, &schemapb.FieldSchema{
+			DataType:   schemapb.DataType_FloatVector,
+			TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}},
+		}},
+	}
+	for _, c := range cases {
+		t.Run(c.dataType.String(), func(t *testing.T) {
+			v1 := writeTestInsertBinlog(t, c.dataType, c.values, c.dim)
+			v2, err := MigrateBinlog(v1, BinlogFormat{Version: BinlogVersionV2, PageRows: 10})
+			require.NoError(t, err)
+
+			reader, err := NewBinlogReader(v2)
+			require.NoError(t, err)
+			assert.Equal(t, BinlogVersionV2, reader.GetBinlogVersion())
+			assert.Equal(t, "1024", reader.Extras[originalSizeKey])
+			assert.EqualValues(t, 40, reader.FieldID)
+			event, err := reader.NextEventReader()
+			require.NoError(t, err)
+			values, _, err := event.GetDataFromPayload()
+			assert.NoError(t, err)
+			assert.Equal(t, c.values, values)
+			reader.Close()
+
+			file := path.Join(dir, c.dataType.String())
+			require.NoError(t, os.WriteFile(file, v2, 0o600))
+			pageReader, err := NewBinlogPageReader(ctx, cm, file)
+			require.NoError(t, err)
+			assert.Len(t, pageReader.PageIndex().Pages, 3)
+			data, err := pageReader.ReadPages(ctx, c.field, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, 5, data.RowNum())
+
+			// back to version 1
+			migrated, err := MigrateBinlog(v2, BinlogFormat{Version: BinlogVersionV1})
+			require.NoError(t, err)
+			reader, err = NewBinlogReader(migrated)
+			require.NoError(t, err)
+			defer reader.Close()
+			assert.Equal(t, BinlogVersionV1, reader.GetBinlogVersion())
+			assert.NotContains(t, reader.Extras, pageIndexKey)
+			event, err = reader.NextEventReader()
+			require.NoError(t, err)
+			values, _, err = event.GetDataFromPayload()
+			assert.NoError(t, err)
+			assert.Equal(t, c.values, values)
+		})
+	}
+
+	_, err := MigrateBinlog(nil, BinlogFormat{Version: 3})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
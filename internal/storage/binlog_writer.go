@@ -54,6 +54,8 @@ type baseBinlogWriter struct {
 	eventWriters []EventWriter
 	buffer       *bytes.Buffer
 	length       int32
+	compression  BinlogCompression
+	format       BinlogFormat
 }
 
 func (writer *baseBinlogWriter) isClosed() bool {
@@ -104,6 +106,12 @@ func (writer *baseBinlogWriter) Finish() error {
 		return fmt.Errorf("invalid start/end timestamp")
 	}
 
+	if writer.format.Version == BinlogVersionV2 {
+		if err := writer.finishPageIndex(); err != nil {
+			return err
+		}
+	}
+
 	var offset int32
 	writer.buffer = new(bytes.Buffer)
 	if err := binary.Write(writer.buffer, common.Endian, MagicNumber); err != nil {
@@ -161,13 +169,16 @@ func (writer *InsertBinlogWriter) NextInsertEventWriter(dim ...int) (*insertEven
 		if len(dim) != 1 {
 			return nil, fmt.Errorf("incorrect input numbers")
 		}
-		event, err = newInsertEventWriter(writer.PayloadDataType, dim[0])
+		event, err = newInsertEventWriter(writer.PayloadDataType, writer.compression, dim[0])
 	} else {
-		event, err = newInsertEventWriter(writer.PayloadDataType)
+		event, err = newInsertEventWriter(writer.PayloadDataType, writer.compression)
 	}
 	if err != nil {
 		return nil, err
 	}
+	if payloadWriter, ok := event.PayloadWriterInterface.(*NativePayloadWriter); ok && writer.format.Version == BinlogVersionV2 {
+		payloadWriter.pageRows = writer.format.PageRows
+	}
 
 	writer.eventWriters = append(writer.eventWriters, event)
 	return event, nil
@@ -183,7 +194,7 @@ func (writer *DeleteBinlogWriter) NextDeleteEventWriter() (*deleteEventWriter, e
 	if writer.isClosed() {
 		return nil, fmt.Errorf("binlog has closed")
 	}
-	event, err := newDeleteEventWriter(writer.PayloadDataType)
+	event, err := newDeleteEventWriter(writer.PayloadDataType, writer.compression)
 	if err != nil {
 		return nil, err
 	}
@@ -278,6 +289,8 @@ func NewInsertBinlogWriter(dataType schemapb.DataType, collectionID, partitionID
 	descriptorEvent.PartitionID = partitionID
 	descriptorEvent.SegmentID = segmentID
 	descriptorEvent.FieldID = FieldID
+	compression := GetBinlogCompression()
+	descriptorEvent.AddExtra(compressionKey, compression.Codec)
 
 	w := &InsertBinlogWriter{
 		baseBinlogWriter: baseBinlogWriter{
@@ -286,6 +299,8 @@ func NewInsertBinlogWriter(dataType schemapb.DataType, collectionID, partitionID
 			binlogType:      InsertBinlog,
 			eventWriters:    make([]EventWriter, 0),
 			buffer:          nil,
+			compression:     compression,
+			format:          GetBinlogFormat(),
 		},
 	}
 
@@ -299,6 +314,9 @@ func NewDeleteBinlogWriter(dataType schemapb.DataType, collectionID, partitionID
 	descriptorEvent.CollectionID = collectionID
 	descriptorEvent.PartitionID = partitionID
 	descriptorEvent.SegmentID = segmentID
+	compression := GetBinlogCompression()
+	descriptorEvent.AddExtra(compressionKey, compression.Codec)
+
 	w := &DeleteBinlogWriter{
 		baseBinlogWriter: baseBinlogWriter{
 			descriptorEvent: *descriptorEvent,
@@ -306,6 +324,7 @@ func NewDeleteBinlogWriter(dataType schemapb.DataType, collectionID, partitionID
 			binlogType:      DeleteBinlog,
 			eventWriters:    make([]EventWriter, 0),
 			buffer:          nil,
+			compression:     compression,
 		},
 	}
 	return w
@@ -0,0 +1,492 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/exp/mmap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// The collections could be placed in their own buckets by the storage properties, inherited from the database.
+// The paths of their files are kept under the cluster root path everywhere, the chunk managers rebase them
+// onto the root path of the collection bucket when accessing, so the layout of the bucket is the same as the cluster one.
+
+// collectionStorages are the storages of the collections placed in their own buckets.
+var collectionStorages = typeutil.NewConcurrentMap[int64, common.CollectionStorage]()
+
+// RegisterCollectionStorage registers the storage of the collection by its properties,
+// returns false if the collection is placed in the cluster bucket.
+func RegisterCollectionStorage(collectionID int64, properties []*commonpb.KeyValuePair) (common.CollectionStorage, bool) {
+	storage, ok := common.GetCollectionStorage(properties...)
+	if !ok {
+		collectionStorages.Remove(collectionID)
+		return storage, false
+	}
+	if old, loaded := collectionStorages.Get(collectionID); !loaded || old != storage {
+		collectionStorages.Insert(collectionID, storage)
+		log.Info("collection storage registered", zap.Int64("collectionID", collectionID),
+			zap.String("address", storage.Address), zap.String("bucket", storage.BucketName), zap.String("rootPath", storage.RootPath))
+	}
+	return storage, true
+}
+
+// UnregisterCollectionStorage unregisters the storage of the dropped collection.
+func UnregisterCollectionStorage(collectionID int64) {
+	collectionStorages.Remove(collectionID)
+	resolvedCollections.Remove(collectionID)
+}
+
+// GetCollectionStorage returns the registered storage of the collection.
+func GetCollectionStorage(collectionID int64) (common.CollectionStorage, bool) {
+	return collectionStorages.Get(collectionID)
+}
+
+// CollectionPropertiesResolver describes the properties of the collection,
+// used by the nodes not told the properties of the collections they access.
+type CollectionPropertiesResolver func(ctx context.Context, collectionID int64) ([]*commonpb.KeyValuePair, error)
+
+var (
+	collectionPropertiesResolver = atomic.NewPointer[CollectionPropertiesResolver](nil)
+	// the storage of a collection never changes but the credentials, so it's resolved once
+	resolvedCollections = typeutil.NewConcurrentSet[int64]()
+)
+
+// SetCollectionPropertiesResolver sets the resolver of the storages of the collections not registered.
+func SetCollectionPropertiesResolver(resolver CollectionPropertiesResolver) {
+	collectionPropertiesResolver.Store(&resolver)
+}
+
+// resolveCollectionStorage returns the storage of the collection, resolved if not registered yet.
+func resolveCollectionStorage(ctx context.Context, collectionID int64) (common.CollectionStorage, bool, error) {
+	if storage, ok := GetCollectionStorage(collectionID); ok {
+		return storage, true, nil
+	}
+	resolver := collectionPropertiesResolver.Load()
+	if resolver == nil || *resolver == nil || resolvedCollections.Contain(collectionID) {
+		return common.CollectionStorage{}, false, nil
+	}
+	properties, err := (*resolver)(ctx, collectionID)
+	if err != nil {
+		return common.CollectionStorage{}, false, err
+	}
+	storage, ok := RegisterCollectionStorage(collectionID, properties)
+	resolvedCollections.Insert(collectionID)
+	return storage, ok, nil
+}
+
+// collectionOfPath returns the collection of the file under the root path if it's one of a collection.
+func collectionOfPath(rootPath, filePath string) (int64, bool) {
+	relative := strings.TrimLeft(strings.TrimPrefix(filePath, rootPath), "/")
+	parts := strings.SplitN(relative, "/", 3)
+	if len(parts) < 3 || !collectionLogPaths.Contain(parts[0]) {
+		return 0, false
+	}
+	collectionID, err := strconv.ParseInt(parts[1], 10, 64)
+	return collectionID, err == nil
+}
+
+// rebaseRootPath moves the path from under the root path to under the other one,
+// the path not under the root path is returned as is.
+func rebaseRootPath(filePath, from, to string) string {
+	relative, ok := strings.CutPrefix(filePath, from)
+	if !ok || (from != "" && relative != "" && relative[0] != '/') {
+		return filePath
+	}
+	relative = strings.TrimLeft(relative, "/")
+	if relative == "" || to == "" {
+		return to + relative
+	}
+	return strings.TrimRight(to, "/") + "/" + relative
+}
+
+// withCollectionStorage returns the config to access the collection storage, the unset ones fall back to the cluster config.
+func (c *config) withCollectionStorage(storage common.CollectionStorage) *config {
+	copied := *c
+	copied.bucketName = storage.BucketName
+	if storage.Address != "" {
+		copied.address = storage.Address
+	}
+	if storage.RootPath != "" {
+		copied.rootPath = storage.RootPath
+	}
+	if storage.AccessKeyID != "" || storage.SecretAccessKey != "" {
+		copied.accessKeyID = storage.AccessKeyID
+		copied.secretAccessKeyID = storage.SecretAccessKey
+		copied.useIAM = false
+	}
+	// the bucket is brought by the tenant
+	copied.createBucket = false
+	return &copied
+}
+
+// collectionChunkManager accesses the files in the collection bucket by the paths under the cluster root path.
+type collectionChunkManager struct {
+	ChunkManager
+	rootPath string
+}
+
+var _ ChunkManager = (*collectionChunkManager)(nil)
+
+func (cm *collectionChunkManager) physical(filePath string) string {
+	return rebaseRootPath(filePath, cm.rootPath, cm.ChunkManager.RootPath())
+}
+
+func (cm *collectionChunkManager) logical(filePath string) string {
+	return rebaseRootPath(filePath, cm.ChunkManager.RootPath(), cm.rootPath)
+}
+
+func (cm *collectionChunkManager) RootPath() string {
+	return cm.rootPath
+}
+
+func (cm *collectionChunkManager) Path(ctx context.Context, filePath string) (string, error) {
+	physical, err := cm.ChunkManager.Path(ctx, cm.physical(filePath))
+	if err != nil {
+		return "", err
+	}
+	return cm.logical(physical), nil
+}
+
+func (cm *collectionChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	return cm.ChunkManager.Size(ctx, cm.physical(filePath))
+}
+
+func (cm *collectionChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	return cm.ChunkManager.Write(ctx, cm.physical(filePath), content)
+}
+
+func (cm *collectionChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	physical := make(map[string][]byte, len(contents))
+	for filePath, content := range contents {
+		physical[cm.physical(filePath)] = content
+	}
+	return cm.ChunkManager.MultiWrite(ctx, physical)
+}
+
+func (cm *collectionChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	return cm.ChunkManager.Exist(ctx, cm.physical(filePath))
+}
+
+func (cm *collectionChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	return cm.ChunkManager.Read(ctx, cm.physical(filePath))
+}
+
+func (cm *collectionChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	return cm.ChunkManager.Reader(ctx, cm.physical(filePath))
+}
+
+func (cm *collectionChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	return cm.ChunkManager.MultiRead(ctx, lo.Map(filePaths, func(filePath string, _ int) string { return cm.physical(filePath) }))
+}
+
+func (cm *collectionChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	filePaths, modTimes, err := cm.ChunkManager.ListWithPrefix(ctx, cm.physical(prefix), recursive)
+	if err != nil {
+		return nil, nil, err
+	}
+	return lo.Map(filePaths, func(filePath string, _ int) string { return cm.logical(filePath) }), modTimes, nil
+}
+
+func (cm *collectionChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	filePaths, contents, err := cm.ChunkManager.ReadWithPrefix(ctx, cm.physical(prefix))
+	if err != nil {
+		return nil, nil, err
+	}
+	return lo.Map(filePaths, func(filePath string, _ int) string { return cm.logical(filePath) }), contents, nil
+}
+
+func (cm *collectionChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	return cm.ChunkManager.Mmap(ctx, cm.physical(filePath))
+}
+
+func (cm *collectionChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	return cm.ChunkManager.ReadAt(ctx, cm.physical(filePath), off, length)
+}
+
+func (cm *collectionChunkManager) Remove(ctx context.Context, filePath string) error {
+	return cm.ChunkManager.Remove(ctx, cm.physical(filePath))
+}
+
+func (cm *collectionChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	return cm.ChunkManager.MultiRemove(ctx, lo.Map(filePaths, func(filePath string, _ int) string { return cm.physical(filePath) }))
+}
+
+func (cm *collectionChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	return cm.ChunkManager.RemoveWithPrefix(ctx, cm.physical(prefix))
+}
+
+// RoutingChunkManager routes the files of the collections placed in their own buckets to the collection buckets,
+// the others are passed to the cluster chunk manager.
+type RoutingChunkManager struct {
+	ChunkManager
+	newTarget func(ctx context.Context, storage common.CollectionStorage) (ChunkManager, error)
+
+	mu      sync.Mutex
+	targets map[common.CollectionStorage]*collectionChunkManager
+}
+
+var _ ChunkManager = (*RoutingChunkManager)(nil)
+
+func newRoutingChunkManager(cm ChunkManager, newTarget func(ctx context.Context, storage common.CollectionStorage) (ChunkManager, error)) *RoutingChunkManager {
+	return &RoutingChunkManager{
+		ChunkManager: cm,
+		newTarget:    newTarget,
+		targets:      make(map[common.CollectionStorage]*collectionChunkManager),
+	}
+}
+
+func (cm *RoutingChunkManager) target(ctx context.Context, storage common.CollectionStorage) (*collectionChunkManager, error) {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	if target, ok := cm.targets[storage]; ok {
+		return target, nil
+	}
+	target, err := cm.newTarget(ctx, storage)
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to create chunk manager of collection storage",
+			zap.String("address", storage.Address), zap.String("bucket", storage.BucketName), zap.Error(err))
+		return nil, err
+	}
+	cm.targets[storage] = &collectionChunkManager{ChunkManager: target, rootPath: cm.ChunkManager.RootPath()}
+	return cm.targets[storage], nil
+}
+
+// ForCollection returns the chunk manager accessing all the files of the collection, including the index files,
+// by the paths under the cluster root path.
+func (cm *RoutingChunkManager) ForCollection(ctx context.Context, collectionID int64) (ChunkManager, error) {
+	storage, ok, err := resolveCollectionStorage(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return cm.ChunkManager, nil
+	}
+	return cm.target(ctx, storage)
+}
+
+// CollectionBuckets returns the chunk managers of the distinct collection storages registered,
+// by the paths under the cluster root path.
+func (cm *RoutingChunkManager) CollectionBuckets(ctx context.Context) ([]ChunkManager, error) {
+	storages := typeutil.NewSet[common.CollectionStorage]()
+	collectionStorages.Range(func(_ int64, storage common.CollectionStorage) bool {
+		storages.Insert(storage)
+		return true
+	})
+	targets := make([]ChunkManager, 0, storages.Len())
+	for storage := range storages {
+		target, err := cm.target(ctx, storage)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+func (cm *RoutingChunkManager) route(ctx context.Context, filePath string) (ChunkManager, error) {
+	collectionID, ok := collectionOfPath(cm.ChunkManager.RootPath(), filePath)
+	if !ok {
+		return cm.ChunkManager, nil
+	}
+	return cm.ForCollection(ctx, collectionID)
+}
+
+// routeAll groups the indexes of the files by the chunk managers they routed to.
+func (cm *RoutingChunkManager) routeAll(ctx context.Context, filePaths []string) (map[ChunkManager][]int, error) {
+	groups := make(map[ChunkManager][]int)
+	for i, filePath := range filePaths {
+		target, err := cm.route(ctx, filePath)
+		if err != nil {
+			return nil, err
+		}
+		groups[target] = append(groups[target], i)
+	}
+	return groups, nil
+}
+
+func (cm *RoutingChunkManager) Path(ctx context.Context, filePath string) (string, error) {
+	target, err := cm.route(ctx, filePath)
+	if err != nil {
+		return "", err
+	}
+	return target.Path(ctx, filePath)
+}
+
+func (cm *RoutingChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	target, err := cm.route(ctx, filePath)
+	if err != nil {
+		return 0, err
+	}
+	return target.Size(ctx, filePath)
+}
+
+func (cm *RoutingChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	target, err := cm.route(ctx, filePath)
+	if err != nil {
+		return err
+	}
+	return target.Write(ctx, filePath, content)
+}
+
+func (cm *RoutingChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	filePaths := lo.Keys(contents)
+	groups, err := cm.routeAll(ctx, filePaths)
+	if err != nil {
+		return err
+	}
+	for target, indexes := range groups {
+		group := make(map[string][]byte, len(indexes))
+		for _, i := range indexes {
+			group[filePaths[i]] = contents[filePaths[i]]
+		}
+		if err := target.MultiWrite(ctx, group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cm *RoutingChunkManager) Exist(ctx context.Context, filePath string) (bool, error) {
+	target, err := cm.route(ctx, filePath)
+	if err != nil {
+		return false, err
+	}
+	return target.Exist(ctx, filePath)
+}
+
+func (cm *RoutingChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	target, err := cm.route(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return target.Read(ctx, filePath)
+}
+
+func (cm *RoutingChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	target, err := cm.route(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return target.Reader(ctx, filePath)
+}
+
+func (cm *RoutingChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	groups, err := cm.routeAll(ctx, filePaths)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 1 {
+		for target := range groups {
+			return target.MultiRead(ctx, filePaths)
+		}
+	}
+	contents := make([][]byte, len(filePaths))
+	for target, indexes := range groups {
+		datas, err := target.MultiRead(ctx, lo.Map(indexes, func(i int, _ int) string { return filePaths[i] }))
+		if err != nil {
+			return nil, err
+		}
+		for j, i := range indexes {
+			contents[i] = datas[j]
+		}
+	}
+	return contents, nil
+}
+
+func (cm *RoutingChunkManager) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	target, err := cm.route(ctx, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	return target.ListWithPrefix(ctx, prefix, recursive)
+}
+
+func (cm *RoutingChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	target, err := cm.route(ctx, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	return target.ReadWithPrefix(ctx, prefix)
+}
+
+func (cm *RoutingChunkManager) Mmap(ctx context.Context, filePath string) (*mmap.ReaderAt, error) {
+	target, err := cm.route(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return target.Mmap(ctx, filePath)
+}
+
+func (cm *RoutingChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	target, err := cm.route(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return target.ReadAt(ctx, filePath, off, length)
+}
+
+func (cm *RoutingChunkManager) Remove(ctx context.Context, filePath string) error {
+	target, err := cm.route(ctx, filePath)
+	if err != nil {
+		return err
+	}
+	return target.Remove(ctx, filePath)
+}
+
+func (cm *RoutingChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	groups, err := cm.routeAll(ctx, filePaths)
+	if err != nil {
+		return err
+	}
+	for target, indexes := range groups {
+		if err := target.MultiRemove(ctx, lo.Map(indexes, func(i int, _ int) string { return filePaths[i] })); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cm *RoutingChunkManager) RemoveWithPrefix(ctx context.Context, prefix string) error {
+	target, err := cm.route(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	return target.RemoveWithPrefix(ctx, prefix)
+}
+
+// ForCollection returns the chunk manager accessing all the files of the collection by the paths under the cluster root path,
+// the chunk manager is returned as is if it doesn't route.
+func ForCollection(ctx context.Context, cm ChunkManager, collectionID int64) (ChunkManager, error) {
+	routing, ok := cm.(*RoutingChunkManager)
+	if !ok {
+		return cm, nil
+	}
+	return routing.ForCollection(ctx, collectionID)
+}
@@ -0,0 +1,179 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestRebaseRootPath(t *testing.T) {
+	cases := []struct {
+		filePath string
+		from     string
+		to       string
+		expected string
+	}{
+		{"files/insert_log/1/2", "files", "tenant/files", "tenant/files/insert_log/1/2"},
+		{"files/insert_log/1/", "files", "tenant", "tenant/insert_log/1/"},
+		{"files", "files", "tenant", "tenant"},
+		{"insert_log/1/2", "", "tenant", "tenant/insert_log/1/2"},
+		{"files/insert_log/1/2", "files", "", "insert_log/1/2"},
+		{"filesx/insert_log/1/2", "files", "tenant", "filesx/insert_log/1/2"},
+		{"other/insert_log/1/2", "files", "tenant", "other/insert_log/1/2"},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.expected, rebaseRootPath(c.filePath, c.from, c.to), c.filePath)
+	}
+}
+
+func TestConfigWithCollectionStorage(t *testing.T) {
+	c := newDefaultConfig()
+	for _, opt := range []Option{Address("minio:9000"), BucketName("a-bucket"), RootPath("files"), UseIAM(true), CreateBucket(true)} {
+		opt(c)
+	}
+	got := c.withCollectionStorage(common.CollectionStorage{BucketName: "tenant-bucket", AccessKeyID: "ak", SecretAccessKey: "sk"})
+	assert.Equal(t, "minio:9000", got.address)
+	assert.Equal(t, "tenant-bucket", got.bucketName)
+	assert.Equal(t, "files", got.rootPath)
+	assert.Equal(t, "ak", got.accessKeyID)
+	assert.Equal(t, "sk", got.secretAccessKeyID)
+	assert.False(t, got.useIAM)
+	assert.False(t, got.createBucket)
+	// the cluster config is not modified
+	assert.Equal(t, "a-bucket", c.bucketName)
+	assert.True(t, c.useIAM)
+}
+
+func TestRoutingChunkManager(t *testing.T) {
+	ctx := context.Background()
+	clusterRoot := path.Join(t.TempDir(), "files")
+	tenantBucket := t.TempDir()
+	cluster := NewLocalChunkManager(RootPath(clusterRoot))
+	created := 0
+	cm := newRoutingChunkManager(cluster, func(ctx context.Context, storage common.CollectionStorage) (ChunkManager, error) {
+		created++
+		return NewLocalChunkManager(RootPath(path.Join(tenantBucket, storage.BucketName, storage.RootPath))), nil
+	})
+
+	_, ok := RegisterCollectionStorage(1, []*commonpb.KeyValuePair{
+		{Key: common.CollectionStorageBucketKey, Value: "tenant"},
+		{Key: common.CollectionStorageRootPathKey, Value: "milvus"},
+	})
+	require.True(t, ok)
+	defer UnregisterCollectionStorage(1)
+	_, ok = RegisterCollectionStorage(2, nil)
+	require.False(t, ok)
+
+	tenantLog := path.Join(clusterRoot, common.SegmentInsertLogPath, "1", "10", "100", "101", "1")
+	clusterLog := path.Join(clusterRoot, common.SegmentInsertLogPath, "2", "20", "200", "101", "1")
+	tenantPhysical := path.Join(tenantBucket, "tenant", "milvus", common.SegmentInsertLogPath, "1", "10", "100", "101", "1")
+
+	require.NoError(t, cm.MultiWrite(ctx, map[string][]byte{tenantLog: []byte("tenant"), clusterLog: []byte("cluster")}))
+	data, err := os.ReadFile(tenantPhysical)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("tenant"), data)
+	data, err = os.ReadFile(clusterLog)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("cluster"), data)
+	exist, err := cluster.Exist(ctx, tenantLog)
+	require.NoError(t, err)
+	assert.False(t, exist)
+
+	datas, err := cm.MultiRead(ctx, []string{clusterLog, tenantLog})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("cluster"), []byte("tenant")}, datas)
+	size, err := cm.Size(ctx, tenantLog)
+	require.NoError(t, err)
+	assert.EqualValues(t, 6, size)
+
+	// listed by the paths under the cluster root path
+	files, _, err := cm.ListWithPrefix(ctx, path.Join(clusterRoot, common.SegmentInsertLogPath, "1")+"/", true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{tenantLog}, files)
+
+	t.Run("for collection", func(t *testing.T) {
+		indexFile := path.Join(clusterRoot, common.SegmentIndexPath, "1000", "1", "2", "3", "index")
+		collectionCM, err := cm.ForCollection(ctx, 1)
+		require.NoError(t, err)
+		require.NoError(t, collectionCM.Write(ctx, indexFile, []byte("index")))
+		_, err = os.Stat(path.Join(tenantBucket, "tenant", "milvus", common.SegmentIndexPath, "1000", "1", "2", "3", "index"))
+		assert.NoError(t, err)
+		// not routed by the path
+		exist, err := cm.Exist(ctx, indexFile)
+		require.NoError(t, err)
+		assert.False(t, exist)
+
+		collectionCM, err = ForCollection(ctx, cm, 2)
+		require.NoError(t, err)
+		assert.Equal(t, cluster, collectionCM)
+	})
+
+	t.Run("resolve", func(t *testing.T) {
+		resolved := 0
+		SetCollectionPropertiesResolver(func(ctx context.Context, collectionID int64) ([]*commonpb.KeyValuePair, error) {
+			resolved++
+			if collectionID != 3 {
+				return nil, nil
+			}
+			return []*commonpb.KeyValuePair{{Key: common.CollectionStorageBucketKey, Value: "tenant"}}, nil
+		})
+		defer SetCollectionPropertiesResolver(nil)
+		defer UnregisterCollectionStorage(3)
+		defer UnregisterCollectionStorage(4)
+
+		resolvedLog := path.Join(clusterRoot, common.SegmentDeltaLogPath, "3", "30", "300", "1")
+		require.NoError(t, cm.Write(ctx, resolvedLog, []byte("resolved")))
+		_, err := os.Stat(path.Join(tenantBucket, "tenant", common.SegmentDeltaLogPath, "3", "30", "300", "1"))
+		assert.NoError(t, err)
+		clusterLog := path.Join(clusterRoot, common.SegmentDeltaLogPath, "4", "40", "400", "1")
+		for i := 0; i < 2; i++ {
+			require.NoError(t, cm.Write(ctx, clusterLog, []byte("cluster")))
+			data, err := cm.Read(ctx, resolvedLog)
+			require.NoError(t, err)
+			assert.Equal(t, []byte("resolved"), data)
+		}
+		_, err = os.Stat(clusterLog)
+		assert.NoError(t, err)
+		// resolved once per collection
+		assert.Equal(t, 2, resolved)
+	})
+
+	t.Run("collection buckets", func(t *testing.T) {
+		buckets, err := cm.CollectionBuckets(ctx)
+		require.NoError(t, err)
+		require.Len(t, buckets, 1)
+		assert.Equal(t, 2, created)
+		files, _, err := buckets[0].ListWithPrefix(ctx, path.Join(clusterRoot, common.SegmentInsertLogPath)+"/", true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{tenantLog}, files)
+	})
+
+	require.NoError(t, cm.MultiRemove(ctx, []string{tenantLog, clusterLog}))
+	_, err = os.Stat(tenantPhysical)
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(clusterLog)
+	assert.True(t, os.IsNotExist(err))
+}
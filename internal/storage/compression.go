@@ -0,0 +1,92 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"strings"
+
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// the codecs supported by both the go and the cpp parquet readers
+const (
+	CompressionZstd   = "zstd"
+	CompressionSnappy = "snappy"
+	CompressionNone   = "none"
+)
+
+// compressionKey is the key of the descriptor extras recording the codec of the payload.
+const compressionKey = "compression"
+
+// BinlogCompression is the codec and the level to compress the payload of the insert and delta binlogs with.
+// The parquet payload records the codec of each column chunk, so that the binlogs remain readable
+// after the codec changed.
+type BinlogCompression struct {
+	Codec string
+	Level int
+}
+
+// DefaultBinlogCompression is the compression of the binlogs written before the codec configurable.
+var DefaultBinlogCompression = BinlogCompression{Codec: CompressionZstd, Level: 3}
+
+// GetBinlogCompression returns the binlog compression configured,
+// or the default one if the configured one is invalid.
+func GetBinlogCompression() BinlogCompression {
+	params := paramtable.Get()
+	compression := BinlogCompression{
+		Codec: strings.ToLower(params.DataNodeCfg.BinlogCompressionCodec.GetValue()),
+		Level: params.DataNodeCfg.BinlogCompressionLevel.GetAsInt(),
+	}
+	if err := compression.Validate(); err != nil {
+		log.RatedWarn(60, "invalid binlog compression, fallback to the default one", zap.Error(err))
+		return DefaultBinlogCompression
+	}
+	return compression
+}
+
+func (c BinlogCompression) Validate() error {
+	switch c.Codec {
+	case CompressionZstd:
+		if c.Level < 1 || c.Level > 22 {
+			return merr.WrapErrParameterInvalidRange(1, 22, c.Level, "invalid zstd compression level")
+		}
+	case CompressionSnappy, CompressionNone:
+	default:
+		return merr.WrapErrParameterInvalidMsg("unsupported binlog compression codec %s", c.Codec)
+	}
+	return nil
+}
+
+func (c BinlogCompression) writerProperties() *parquet.WriterProperties {
+	switch c.Codec {
+	case CompressionSnappy:
+		return parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Snappy))
+	case CompressionNone:
+		return parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Uncompressed))
+	default:
+		return parquet.NewWriterProperties(
+			parquet.WithCompression(compress.Codecs.Zstd),
+			parquet.WithCompressionLevel(c.Level),
+		)
+	}
+}
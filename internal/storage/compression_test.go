@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestBinlogCompression(t *testing.T) {
+	params := paramtable.Get()
+	defer params.Reset(params.DataNodeCfg.BinlogCompressionCodec.Key)
+	defer params.Reset(params.DataNodeCfg.BinlogCompressionLevel.Key)
+
+	t.Run("config", func(t *testing.T) {
+		assert.Equal(t, DefaultBinlogCompression, GetBinlogCompression())
+
+		params.Save(params.DataNodeCfg.BinlogCompressionCodec.Key, "Snappy")
+		assert.Equal(t, CompressionSnappy, GetBinlogCompression().Codec)
+
+		// fallback to the default one if invalid
+		params.Save(params.DataNodeCfg.BinlogCompressionCodec.Key, "lz4")
+		assert.Equal(t, DefaultBinlogCompression, GetBinlogCompression())
+		params.Save(params.DataNodeCfg.BinlogCompressionCodec.Key, CompressionZstd)
+		params.Save(params.DataNodeCfg.BinlogCompressionLevel.Key, "23")
+		assert.Equal(t, DefaultBinlogCompression, GetBinlogCompression())
+		params.Save(params.DataNodeCfg.BinlogCompressionLevel.Key, "9")
+		assert.Equal(t, BinlogCompression{Codec: CompressionZstd, Level: 9}, GetBinlogCompression())
+	})
+
+	t.Run("readable after codec changed", func(t *testing.T) {
+		deleteData := NewDeleteData([]PrimaryKey{NewInt64PrimaryKey(1), NewInt64PrimaryKey(2)}, []uint64{100, 200})
+		codecs := map[string]compress.Compression{
+			CompressionZstd:   compress.Codecs.Zstd,
+			CompressionSnappy: compress.Codecs.Snappy,
+			CompressionNone:   compress.Codecs.Uncompressed,
+		}
+		blobs := make([]*Blob, 0)
+		for codec, expected := range codecs {
+			params.Save(params.DataNodeCfg.BinlogCompressionCodec.Key, codec)
+			blob, err := NewDeleteCodec().Serialize(CollectionID, 1, 1, deleteData)
+			require.NoError(t, err)
+			assert.Equal(t, codec, blob.Compression)
+
+			reader, err := NewBinlogReader(blob.GetValue())
+			require.NoError(t, err)
+			assert.Equal(t, codec, reader.Extras[compressionKey])
+			event, err := reader.NextEventReader()
+			require.NoError(t, err)
+			chunk, err := event.PayloadReaderInterface.(*PayloadReader).reader.MetaData().RowGroup(0).ColumnChunk(0)
+			require.NoError(t, err)
+			assert.Equal(t, expected, chunk.Compression())
+			reader.Close()
+			blobs = append(blobs, blob)
+		}
+
+		params.Save(params.DataNodeCfg.BinlogCompressionCodec.Key, CompressionZstd)
+		for _, blob := range blobs {
+			_, _, data, err := NewDeleteCodec().Deserialize([]*Blob{blob})
+			require.NoError(t, err)
+			assert.Equal(t, deleteData, data)
+		}
+	})
+}
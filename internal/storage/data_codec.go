@@ -72,6 +72,8 @@ type Blob struct {
 	Value  []byte
 	Size   int64
 	RowNum int64
+	// Compression is the codec of the binlog payload, empty if unknown
+	Compression string
 }
 
 // BlobList implements sort.Interface for a list of Blob
@@ -418,9 +420,10 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		}
 		blobKey := fmt.Sprintf("%d", field.FieldID)
 		blobs = append(blobs, &Blob{
-			Key:    blobKey,
-			Value:  buffer,
-			RowNum: rowNum,
+			Key:         blobKey,
+			Value:       buffer,
+			RowNum:      rowNum,
+			Compression: writer.compression.Codec,
 		})
 		eventWriter.Close()
 		writer.Close()
@@ -953,6 +956,41 @@ func (data *DeleteData) Size() int64 {
 	return data.memSize
 }
 
+// Compact removes the duplicated deletes of the same primary key and timestamp, and sorts the deletes by primary key
+// and timestamp. The deletes of the same primary key at the different timestamps are all kept, since the reads between
+// them are only filtered by the earlier ones.
+func (data *DeleteData) Compact() {
+	type pkTs struct {
+		pk any
+		ts Timestamp
+	}
+	seen := make(map[pkTs]struct{}, len(data.Pks))
+	indexes := make([]int, 0, len(data.Pks))
+	for i, pk := range data.Pks {
+		key := pkTs{pk: pk.GetValue(), ts: data.Tss[i]}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		indexes = append(indexes, i)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		pki, pkj := data.Pks[indexes[i]], data.Pks[indexes[j]]
+		if pki.EQ(pkj) {
+			return data.Tss[indexes[i]] < data.Tss[indexes[j]]
+		}
+		return pki.LT(pkj)
+	})
+
+	pks := make([]PrimaryKey, 0, len(indexes))
+	tss := make([]Timestamp, 0, len(indexes))
+	for _, idx := range indexes {
+		pks = append(pks, data.Pks[idx])
+		tss = append(tss, data.Tss[idx])
+	}
+	*data = *NewDeleteData(pks, tss)
+}
+
 // DeleteCodec serializes and deserializes the delete data
 type DeleteCodec struct{}
 
@@ -1018,7 +1056,8 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 		return nil, err
 	}
 	blob := &Blob{
-		Value: buffer,
+		Value:       buffer,
+		Compression: binlogWriter.compression.Codec,
 	}
 	return blob, nil
 }
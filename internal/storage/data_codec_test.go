@@ -912,4 +912,42 @@ func TestDeleteData(t *testing.T) {
 		assert.EqualValues(t, dData.RowCount, 3)
 		assert.EqualValues(t, dData.Size(), 72)
 	})
+
+	t.Run("compact", func(t *testing.T) {
+		dData := NewDeleteData(nil, nil)
+		dData.AppendBatch([]PrimaryKey{pks[2], pks[0], pks[2], pks[1], pks[0], pks[2]}, []Timestamp{103, 100, 101, 102, 104, 103})
+		dData.Compact()
+
+		assert.Equal(t, []PrimaryKey{pks[0], pks[0], pks[1], pks[2], pks[2]}, dData.Pks)
+		assert.Equal(t, []Timestamp{100, 104, 102, 101, 103}, dData.Tss)
+		assert.EqualValues(t, 5, dData.RowCount)
+		assert.EqualValues(t, 120, dData.Size())
+
+		varcharPks, err := GenVarcharPrimaryKeys("b", "a", "b", "a")
+		require.NoError(t, err)
+		dData = NewDeleteData(varcharPks, []Timestamp{100, 101, 99, 101})
+		dData.Compact()
+		assert.Equal(t, []PrimaryKey{varcharPks[1], varcharPks[2], varcharPks[0]}, dData.Pks)
+		assert.Equal(t, []Timestamp{101, 99, 100}, dData.Tss)
+	})
+
+	t.Run("compact keeps mvcc", func(t *testing.T) {
+		// the pk is deleted at 100, inserted again at 150, and deleted again at 200
+		dData := NewDeleteData([]PrimaryKey{pks[0], pks[0]}, []Timestamp{200, 100})
+		dData.Compact()
+
+		// whether the row inserted before the read timestamp is deleted at the read timestamp
+		deleted := func(insertTs, readTs Timestamp) bool {
+			for i, pk := range dData.Pks {
+				if pk.EQ(pks[0]) && dData.Tss[i] > insertTs && dData.Tss[i] <= readTs {
+					return true
+				}
+			}
+			return false
+		}
+		// the read between the two deletes sees the first one
+		assert.True(t, deleted(50, 150))
+		assert.False(t, deleted(150, 150))
+		assert.True(t, deleted(150, 250))
+	})
 }
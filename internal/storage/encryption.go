@@ -0,0 +1,726 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// The encrypted objects are the AES-256-GCM ciphertext of the plain ones prefixed by the header,
+//
+//	| magic "MENC" | format version, 1 byte | collection ID, 8 bytes | key version, 8 bytes | nonce, 12 bytes |
+//
+// the integers are little endian and the header is authenticated as the additional data.
+// The data keys are generated per collection and stored in the object storage wrapped by the master key of the KMS,
+// at {root}/encryption_keys/{collectionID}/{version}, the versions are the unix nanoseconds the keys generated at.
+// The files are always encrypted by the latest key of the collection, the older ones are kept to read the files
+// encrypted before the rotation. segcore reads and writes the same format with the keys pushed to it.
+const (
+	encryptionMagic         = "MENC"
+	encryptionFormatVersion = byte(1)
+	encryptionNonceSize     = 12
+	encryptionHeaderSize    = len(encryptionMagic) + 1 + 8 + 8 + encryptionNonceSize
+	encryptionTagSize       = 16
+	encryptionOverhead      = encryptionHeaderSize + encryptionTagSize
+	encryptionKeyPath       = "encryption_keys"
+	LocalKMSName            = "local"
+	DataKeySize             = 32
+)
+
+// the files under these paths are the ones of the collections, prefixed by the collection ID.
+var collectionLogPaths = typeutil.NewSet(
+	common.SegmentInsertLogPath,
+	common.SegmentDeltaLogPath,
+	common.SegmentStatslogPath,
+	common.SegmentArtifactLogPath,
+	common.SegmentParquetLogPath,
+)
+
+// KMS wraps the data keys with the master keys it manages, the master keys never leave it.
+type KMS interface {
+	WrapKey(ctx context.Context, masterKeyID string, key []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, masterKeyID string, wrapped []byte) ([]byte, error)
+}
+
+var kmsRegistry = struct {
+	sync.RWMutex
+	kms map[string]KMS
+}{
+	kms: make(map[string]KMS),
+}
+
+// RegisterKMS registers the named KMS, which is referred by minio.encryption.kms.
+// It is supposed to be called on init.
+func RegisterKMS(name string, kms KMS) {
+	kmsRegistry.Lock()
+	defer kmsRegistry.Unlock()
+	if _, ok := kmsRegistry.kms[name]; ok {
+		panic(fmt.Sprintf("kms %s registered twice", name))
+	}
+	kmsRegistry.kms[name] = kms
+}
+
+func UnregisterKMS(name string) {
+	kmsRegistry.Lock()
+	defer kmsRegistry.Unlock()
+	delete(kmsRegistry.kms, name)
+}
+
+func getKMS(name string) (KMS, error) {
+	kmsRegistry.RLock()
+	defer kmsRegistry.RUnlock()
+	kms, ok := kmsRegistry.kms[name]
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("kms %s not registered", name)
+	}
+	return kms, nil
+}
+
+// localKMS wraps the data keys by the master keys configured locally with AES-256-GCM,
+// the wrapped keys are the nonce followed by the ciphertext.
+type localKMS struct {
+	masterKeys map[string][]byte
+}
+
+// NewLocalKMS creates the KMS of the master keys, which are 32 bytes each.
+func NewLocalKMS(masterKeys map[string][]byte) (KMS, error) {
+	for id, key := range masterKeys {
+		if len(key) != DataKeySize {
+			return nil, merr.WrapErrParameterInvalidMsg("master key %s is %d bytes, expect %d", id, len(key), DataKeySize)
+		}
+	}
+	return &localKMS{masterKeys: masterKeys}, nil
+}
+
+// parseLocalMasterKeys parses the comma separated id:key pairs, the keys are base64 encoded.
+func parseLocalMasterKeys(value string) (map[string][]byte, error) {
+	masterKeys := make(map[string][]byte)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		id, encoded, ok := strings.Cut(pair, ":")
+		if !ok || id == "" {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid master key %s, expect id:key", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid master key %s: %v", id, err)
+		}
+		masterKeys[id] = key
+	}
+	return masterKeys, nil
+}
+
+var initLocalKMSOnce sync.Once
+
+// initLocalKMS registers the local kms of the master keys configured, if any.
+func initLocalKMS(params *paramtable.ComponentParam) {
+	initLocalKMSOnce.Do(func() {
+		if params.MinioCfg.EncryptionLocalMasterKeys.GetValue() == "" {
+			return
+		}
+		masterKeys, err := parseLocalMasterKeys(params.MinioCfg.EncryptionLocalMasterKeys.GetValue())
+		if err == nil {
+			var kms KMS
+			if kms, err = NewLocalKMS(masterKeys); err == nil {
+				RegisterKMS(LocalKMSName, kms)
+				return
+			}
+		}
+		log.Warn("invalid local master keys, local kms not registered", zap.Error(err))
+	})
+}
+
+func (kms *localKMS) getMasterKey(masterKeyID string) (cipher.AEAD, error) {
+	masterKey, ok := kms.masterKeys[masterKeyID]
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("master key %s not found in local kms", masterKeyID)
+	}
+	return newGCM(masterKey)
+}
+
+func (kms *localKMS) WrapKey(ctx context.Context, masterKeyID string, key []byte) ([]byte, error) {
+	gcm, err := kms.getMasterKey(masterKeyID)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, key, []byte(masterKeyID)), nil
+}
+
+func (kms *localKMS) UnwrapKey(ctx context.Context, masterKeyID string, wrapped []byte) ([]byte, error) {
+	gcm, err := kms.getMasterKey(masterKeyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < gcm.NonceSize() {
+		return nil, merr.WrapErrParameterInvalidMsg("wrapped key too short")
+	}
+	key, err := gcm.Open(nil, wrapped[:gcm.NonceSize()], wrapped[gcm.NonceSize():], []byte(masterKeyID))
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("failed to unwrap key by master key %s: %v", masterKeyID, err)
+	}
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// IsEncrypted returns whether the object data is encrypted.
+func IsEncrypted(data []byte) bool {
+	return len(data) > len(encryptionMagic) &&
+		string(data[:len(encryptionMagic)]) == encryptionMagic &&
+		data[len(encryptionMagic)] == encryptionFormatVersion
+}
+
+func encryptObject(collectionID, version int64, key []byte, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, encryptionHeaderSize, encryptionOverhead+len(plaintext))
+	copy(data, encryptionMagic)
+	data[len(encryptionMagic)] = encryptionFormatVersion
+	binary.LittleEndian.PutUint64(data[len(encryptionMagic)+1:], uint64(collectionID))
+	binary.LittleEndian.PutUint64(data[len(encryptionMagic)+9:], uint64(version))
+	nonce := data[encryptionHeaderSize-encryptionNonceSize : encryptionHeaderSize]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(data, nonce, plaintext, data[:encryptionHeaderSize]), nil
+}
+
+// parseEncryptionHeader returns the collection ID and the version of the data key of the encrypted object.
+func parseEncryptionHeader(data []byte) (int64, int64, error) {
+	if !IsEncrypted(data) || len(data) < encryptionOverhead {
+		return 0, 0, merr.WrapErrParameterInvalidMsg("invalid encrypted object of %d bytes", len(data))
+	}
+	collectionID := int64(binary.LittleEndian.Uint64(data[len(encryptionMagic)+1:]))
+	version := int64(binary.LittleEndian.Uint64(data[len(encryptionMagic)+9:]))
+	return collectionID, version, nil
+}
+
+func decryptObject(key []byte, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := data[encryptionHeaderSize-encryptionNonceSize : encryptionHeaderSize]
+	return gcm.Open(nil, nonce, data[encryptionHeaderSize:], data[:encryptionHeaderSize])
+}
+
+// storedDataKey is the data key persisted in the object storage.
+type storedDataKey struct {
+	KMS         string `json:"kms"`
+	MasterKeyID string `json:"masterKeyID"`
+	WrappedKey  []byte `json:"wrappedKey"`
+}
+
+// collectionDataKeys is the data keys of a collection by version, it's never modified once cached.
+type collectionDataKeys struct {
+	current  int64
+	keys     map[int64][]byte
+	loadedAt time.Time
+}
+
+// dataKeyRing caches the data keys unwrapped, the keys of a collection are reloaded periodically
+// to pick up the ones generated by the other nodes, or on reading a file encrypted by an unknown version.
+type dataKeyRing struct {
+	cm              ChunkManager
+	kmsName         string
+	masterKeyID     string
+	rotateInterval  time.Duration
+	refreshInterval time.Duration
+
+	mu          sync.RWMutex
+	collections map[int64]*collectionDataKeys
+	rotateMu    sync.Mutex
+}
+
+func (r *dataKeyRing) keyPrefix(collectionID int64) string {
+	return path.Join(r.cm.RootPath(), encryptionKeyPath, strconv.FormatInt(collectionID, 10)) + "/"
+}
+
+func (r *dataKeyRing) load(ctx context.Context, collectionID int64) (*collectionDataKeys, error) {
+	paths, _, err := r.cm.ListWithPrefix(ctx, r.keyPrefix(collectionID), true)
+	if err != nil {
+		return nil, err
+	}
+	keys := &collectionDataKeys{keys: make(map[int64][]byte, len(paths)), loadedAt: time.Now()}
+	for _, keyPath := range paths {
+		version, err := strconv.ParseInt(path.Base(keyPath), 10, 64)
+		if err != nil {
+			continue
+		}
+		data, err := r.cm.Read(ctx, keyPath)
+		if err != nil {
+			return nil, err
+		}
+		key, err := r.unwrap(ctx, data)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unwrap data key %s", keyPath)
+		}
+		keys.keys[version] = key
+		if version > keys.current {
+			keys.current = version
+		}
+	}
+
+	r.mu.Lock()
+	r.collections[collectionID] = keys
+	r.mu.Unlock()
+	return keys, nil
+}
+
+func (r *dataKeyRing) unwrap(ctx context.Context, data []byte) ([]byte, error) {
+	stored := &storedDataKey{}
+	if err := json.Unmarshal(data, stored); err != nil {
+		return nil, err
+	}
+	kms, err := getKMS(stored.KMS)
+	if err != nil {
+		return nil, err
+	}
+	return kms.UnwrapKey(ctx, stored.MasterKeyID, stored.WrappedKey)
+}
+
+func (r *dataKeyRing) wrap(ctx context.Context, key []byte) ([]byte, error) {
+	kms, err := getKMS(r.kmsName)
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := kms.WrapKey(ctx, r.masterKeyID, key)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&storedDataKey{KMS: r.kmsName, MasterKeyID: r.masterKeyID, WrappedKey: wrapped})
+}
+
+func (r *dataKeyRing) get(ctx context.Context, collectionID int64, reload bool) (*collectionDataKeys, error) {
+	r.mu.RLock()
+	keys, ok := r.collections[collectionID]
+	r.mu.RUnlock()
+	if ok && !reload && (r.refreshInterval <= 0 || time.Since(keys.loadedAt) < r.refreshInterval) {
+		return keys, nil
+	}
+	return r.load(ctx, collectionID)
+}
+
+func (r *dataKeyRing) shouldRotate(keys *collectionDataKeys) bool {
+	return keys.current == 0 || (r.rotateInterval > 0 && time.Since(time.Unix(0, keys.current)) >= r.rotateInterval)
+}
+
+// currentKey returns the data key to encrypt the new files of the collection,
+// a new one is generated if there is none yet or the current one is due to rotate.
+func (r *dataKeyRing) currentKey(ctx context.Context, collectionID int64) (int64, []byte, error) {
+	keys, err := r.get(ctx, collectionID, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	if r.shouldRotate(keys) {
+		return r.rotate(ctx, collectionID, false)
+	}
+	return keys.current, keys.keys[keys.current], nil
+}
+
+// rotate generates a new data key of the collection, unless it's not forced and the latest one is still fresh.
+// The nodes may generate the keys of the same collection concurrently, which are all kept.
+func (r *dataKeyRing) rotate(ctx context.Context, collectionID int64, force bool) (int64, []byte, error) {
+	r.rotateMu.Lock()
+	defer r.rotateMu.Unlock()
+
+	keys, err := r.get(ctx, collectionID, true)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !force && !r.shouldRotate(keys) {
+		return keys.current, keys.keys[keys.current], nil
+	}
+
+	key := make([]byte, DataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return 0, nil, err
+	}
+	data, err := r.wrap(ctx, key)
+	if err != nil {
+		return 0, nil, err
+	}
+	version := time.Now().UnixNano()
+	if version <= keys.current {
+		version = keys.current + 1
+	}
+	if err := r.cm.Write(ctx, r.keyPrefix(collectionID)+strconv.FormatInt(version, 10), data); err != nil {
+		return 0, nil, err
+	}
+
+	updated := &collectionDataKeys{current: version, keys: make(map[int64][]byte, len(keys.keys)+1), loadedAt: keys.loadedAt}
+	for v, k := range keys.keys {
+		updated.keys[v] = k
+	}
+	updated.keys[version] = key
+	r.mu.Lock()
+	r.collections[collectionID] = updated
+	r.mu.Unlock()
+	log.Ctx(ctx).Info("data key of collection generated", zap.Int64("collectionID", collectionID), zap.Int64("version", version))
+	return version, key, nil
+}
+
+func (r *dataKeyRing) key(ctx context.Context, collectionID int64, version int64) ([]byte, error) {
+	keys, err := r.get(ctx, collectionID, false)
+	if err != nil {
+		return nil, err
+	}
+	if key, ok := keys.keys[version]; ok {
+		return key, nil
+	}
+	// the key may be generated by the other nodes after loaded
+	keys, err = r.get(ctx, collectionID, true)
+	if err != nil {
+		return nil, err
+	}
+	if key, ok := keys.keys[version]; ok {
+		return key, nil
+	}
+	return nil, merr.WrapErrServiceInternal(fmt.Sprintf("data key %d of collection %d not found", version, collectionID))
+}
+
+// EncryptedChunkManager encrypts the log files of the collections written by the data keys of the collections,
+// and decrypts the encrypted files on read, the others are read and written as is.
+type EncryptedChunkManager struct {
+	ChunkManager
+	ring    *dataKeyRing
+	encrypt bool
+}
+
+var _ ChunkManager = (*EncryptedChunkManager)(nil)
+
+func newEncryptedChunkManager(cm ChunkManager, c *config) (*EncryptedChunkManager, error) {
+	if c.encryptionEnabled {
+		if _, err := getKMS(c.kmsName); err != nil {
+			return nil, err
+		}
+		if c.masterKeyID == "" {
+			return nil, merr.WrapErrParameterInvalidMsg("master key ID is required to enable encryption")
+		}
+	}
+	return &EncryptedChunkManager{
+		ChunkManager: cm,
+		ring: &dataKeyRing{
+			cm:              cm,
+			kmsName:         c.kmsName,
+			masterKeyID:     c.masterKeyID,
+			rotateInterval:  c.dataKeyRotateInterval,
+			refreshInterval: c.dataKeyRefreshInterval,
+			collections:     make(map[int64]*collectionDataKeys),
+		},
+		encrypt: c.encryptionEnabled,
+	}, nil
+}
+
+// collectionOf returns the collection of the file if it's to be encrypted.
+func (cm *EncryptedChunkManager) collectionOf(filePath string) (int64, bool) {
+	if !cm.encrypt {
+		return 0, false
+	}
+	return collectionOfPath(cm.RootPath(), filePath)
+}
+
+func (cm *EncryptedChunkManager) encryptFile(ctx context.Context, filePath string, content []byte) ([]byte, error) {
+	collectionID, ok := cm.collectionOf(filePath)
+	if !ok {
+		return content, nil
+	}
+	version, key, err := cm.ring.currentKey(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	return encryptObject(collectionID, version, key, content)
+}
+
+func (cm *EncryptedChunkManager) decryptFile(ctx context.Context, filePath string, data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	collectionID, version, err := parseEncryptionHeader(data)
+	if err != nil {
+		return nil, merr.WrapErrIoFailed(filePath, err)
+	}
+	key, err := cm.ring.key(ctx, collectionID, version)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := decryptObject(key, data)
+	if err != nil {
+		return nil, merr.WrapErrIoFailed(filePath, err)
+	}
+	return plaintext, nil
+}
+
+// isEncryptedFile checks the magic of the file without reading it all.
+func (cm *EncryptedChunkManager) isEncryptedFile(ctx context.Context, filePath string, size int64) (bool, error) {
+	if size < int64(encryptionOverhead) {
+		return false, nil
+	}
+	head, err := cm.ChunkManager.ReadAt(ctx, filePath, 0, int64(len(encryptionMagic)+1))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	return IsEncrypted(head), nil
+}
+
+// Size returns the size of the plain content.
+func (cm *EncryptedChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	size, err := cm.ChunkManager.Size(ctx, filePath)
+	if err != nil {
+		return size, err
+	}
+	encrypted, err := cm.isEncryptedFile(ctx, filePath, size)
+	if err != nil {
+		return 0, err
+	}
+	if encrypted {
+		return size - int64(encryptionOverhead), nil
+	}
+	return size, nil
+}
+
+func (cm *EncryptedChunkManager) Write(ctx context.Context, filePath string, content []byte) error {
+	data, err := cm.encryptFile(ctx, filePath, content)
+	if err != nil {
+		return err
+	}
+	return cm.ChunkManager.Write(ctx, filePath, data)
+}
+
+func (cm *EncryptedChunkManager) MultiWrite(ctx context.Context, contents map[string][]byte) error {
+	encrypted := make(map[string][]byte, len(contents))
+	for filePath, content := range contents {
+		data, err := cm.encryptFile(ctx, filePath, content)
+		if err != nil {
+			return err
+		}
+		encrypted[filePath] = data
+	}
+	return cm.ChunkManager.MultiWrite(ctx, encrypted)
+}
+
+func (cm *EncryptedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	data, err := cm.ChunkManager.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return cm.decryptFile(ctx, filePath, data)
+}
+
+// Reader returns the reader of the plain content, the encrypted file is read all and decrypted,
+// as the whole file is required to authenticate.
+func (cm *EncryptedChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	size, err := cm.ChunkManager.Size(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := cm.isEncryptedFile(ctx, filePath, size)
+	if err != nil {
+		return nil, err
+	}
+	if !encrypted {
+		return cm.ChunkManager.Reader(ctx, filePath)
+	}
+	data, err := cm.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	return &plainFileReader{Reader: bytes.NewReader(data)}, nil
+}
+
+func (cm *EncryptedChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	contents, err := cm.ChunkManager.MultiRead(ctx, filePaths)
+	if err != nil {
+		return contents, err
+	}
+	for i := range contents {
+		if contents[i], err = cm.decryptFile(ctx, filePaths[i], contents[i]); err != nil {
+			return nil, err
+		}
+	}
+	return contents, nil
+}
+
+func (cm *EncryptedChunkManager) ReadWithPrefix(ctx context.Context, prefix string) ([]string, [][]byte, error) {
+	filePaths, contents, err := cm.ChunkManager.ReadWithPrefix(ctx, prefix)
+	if err != nil {
+		return filePaths, contents, err
+	}
+	for i := range contents {
+		if contents[i], err = cm.decryptFile(ctx, filePaths[i], contents[i]); err != nil {
+			return nil, nil, err
+		}
+	}
+	return filePaths, contents, nil
+}
+
+// ReadAt reads the range of the plain content, the encrypted file is read all and decrypted.
+func (cm *EncryptedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 {
+		return nil, io.EOF
+	}
+	size, err := cm.ChunkManager.Size(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := cm.isEncryptedFile(ctx, filePath, size)
+	if err != nil {
+		return nil, err
+	}
+	if !encrypted {
+		return cm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	data, err := cm.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if off+length > int64(len(data)) {
+		return nil, merr.WrapErrIoFailed(filePath, io.EOF)
+	}
+	return data[off : off+length], nil
+}
+
+// CollectionDataKeys returns all the data keys of the collection and the current version to encrypt the new files,
+// the keys are reloaded from the object storage to include the ones generated by the other nodes.
+// The current version is zero if the encryption is disabled, then the keys are only for decryption.
+func (cm *EncryptedChunkManager) CollectionDataKeys(ctx context.Context, collectionID int64) (int64, map[int64][]byte, error) {
+	keys, err := cm.ring.get(ctx, collectionID, true)
+	if err != nil {
+		return 0, nil, err
+	}
+	if !cm.encrypt {
+		return 0, keys.keys, nil
+	}
+	if cm.ring.shouldRotate(keys) {
+		if _, _, err := cm.ring.rotate(ctx, collectionID, false); err != nil {
+			return 0, nil, err
+		}
+		if keys, err = cm.ring.get(ctx, collectionID, false); err != nil {
+			return 0, nil, err
+		}
+	}
+	return keys.current, keys.keys, nil
+}
+
+// RotateDataKey generates a new data key of the collection to encrypt the new files,
+// the files encrypted by the older keys are still readable.
+func (cm *EncryptedChunkManager) RotateDataKey(ctx context.Context, collectionID int64) (int64, error) {
+	version, _, err := cm.ring.rotate(ctx, collectionID, true)
+	return version, err
+}
+
+// RewrapDataKeys wraps the data keys of all the collections which aren't wrapped by the current master key
+// with it, and returns the number of the keys rewrapped. It's supposed to be called after the master key rotated,
+// the old master key could be retired after that.
+func (cm *EncryptedChunkManager) RewrapDataKeys(ctx context.Context) (int, error) {
+	keyPaths, _, err := cm.ChunkManager.ListWithPrefix(ctx, path.Join(cm.RootPath(), encryptionKeyPath)+"/", true)
+	if err != nil {
+		return 0, err
+	}
+	rewrapped := 0
+	for _, keyPath := range keyPaths {
+		data, err := cm.ChunkManager.Read(ctx, keyPath)
+		if err != nil {
+			return rewrapped, err
+		}
+		stored := &storedDataKey{}
+		if err := json.Unmarshal(data, stored); err != nil {
+			return rewrapped, errors.Wrapf(err, "invalid data key %s", keyPath)
+		}
+		if stored.KMS == cm.ring.kmsName && stored.MasterKeyID == cm.ring.masterKeyID {
+			continue
+		}
+		key, err := cm.ring.unwrap(ctx, data)
+		if err != nil {
+			return rewrapped, errors.Wrapf(err, "failed to unwrap data key %s", keyPath)
+		}
+		data, err = cm.ring.wrap(ctx, key)
+		if err != nil {
+			return rewrapped, err
+		}
+		if err := cm.ChunkManager.Write(ctx, keyPath, data); err != nil {
+			return rewrapped, err
+		}
+		rewrapped++
+	}
+	log.Ctx(ctx).Info("data keys rewrapped", zap.String("masterKeyID", cm.ring.masterKeyID), zap.Int("num", rewrapped))
+	return rewrapped, nil
+}
+
+// GetCollectionDataKeys returns the data keys of the collection if the chunk manager encrypts,
+// zero version and nil keys otherwise.
+func GetCollectionDataKeys(ctx context.Context, cm ChunkManager, collectionID int64) (int64, map[int64][]byte, error) {
+	// the keys are kept in the bucket of the collection
+	cm, err := ForCollection(ctx, cm, collectionID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if target, ok := cm.(*collectionChunkManager); ok {
+		cm = target.ChunkManager
+	}
+	encrypted, ok := cm.(*EncryptedChunkManager)
+	if !ok {
+		return 0, nil, nil
+	}
+	return encrypted.CollectionDataKeys(ctx, collectionID)
+}
+
+type plainFileReader struct {
+	*bytes.Reader
+}
+
+func (r *plainFileReader) Close() error {
+	return nil
+}
@@ -0,0 +1,256 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"io"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const testKMSName = "test_kms"
+
+func TestLocalKMS(t *testing.T) {
+	ctx := context.Background()
+	masterKeys, err := parseLocalMasterKeys("k1:" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)) +
+		", k2:" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32)))
+	require.NoError(t, err)
+	assert.Len(t, masterKeys, 2)
+
+	kms, err := NewLocalKMS(masterKeys)
+	require.NoError(t, err)
+	key := bytes.Repeat([]byte{3}, DataKeySize)
+	wrapped, err := kms.WrapKey(ctx, "k1", key)
+	require.NoError(t, err)
+	unwrapped, err := kms.UnwrapKey(ctx, "k1", wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, key, unwrapped)
+
+	_, err = kms.UnwrapKey(ctx, "k2", wrapped)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, err = kms.WrapKey(ctx, "k3", key)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, err = parseLocalMasterKeys("k1")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, err = parseLocalMasterKeys("k1:???")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, err = NewLocalKMS(map[string][]byte{"k1": {1}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestEncryptedChunkManager(t *testing.T) {
+	ctx := context.Background()
+	kms, err := NewLocalKMS(map[string][]byte{
+		"k1": bytes.Repeat([]byte{1}, 32),
+		"k2": bytes.Repeat([]byte{2}, 32),
+	})
+	require.NoError(t, err)
+	RegisterKMS(testKMSName, kms)
+	defer UnregisterKMS(testKMSName)
+
+	dir := t.TempDir()
+	local := NewLocalChunkManager(RootPath(dir))
+	newCM := func(enabled bool, masterKeyID string, opts ...Option) *EncryptedChunkManager {
+		c := newDefaultConfig()
+		Encryption(enabled, testKMSName, masterKeyID)(c)
+		for _, opt := range opts {
+			opt(c)
+		}
+		cm, err := newEncryptedChunkManager(local, c)
+		require.NoError(t, err)
+		return cm
+	}
+	cm := newCM(true, "k1")
+
+	content := []byte("binlog content")
+	insertLog := path.Join(dir, common.SegmentInsertLogPath, "1", "2", "3", "100", "1")
+	deltaLog := path.Join(dir, common.SegmentDeltaLogPath, "1", "2", "3", "1")
+	indexFile := path.Join(dir, common.SegmentIndexPath, "1000", "1", "2", "3", "index")
+
+	t.Run("encrypt on write", func(t *testing.T) {
+		require.NoError(t, cm.Write(ctx, insertLog, content))
+		require.NoError(t, cm.MultiWrite(ctx, map[string][]byte{deltaLog: content, indexFile: content}))
+
+		raw, err := local.Read(ctx, insertLog)
+		require.NoError(t, err)
+		assert.True(t, IsEncrypted(raw))
+		assert.Len(t, raw, len(content)+encryptionOverhead)
+		collectionID, version, err := parseEncryptionHeader(raw)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1, collectionID)
+		assert.NotZero(t, version)
+
+		raw, err = local.Read(ctx, deltaLog)
+		require.NoError(t, err)
+		assert.True(t, IsEncrypted(raw))
+		// not a file of the collection
+		raw, err = local.Read(ctx, indexFile)
+		require.NoError(t, err)
+		assert.Equal(t, content, raw)
+	})
+
+	t.Run("decrypt on read", func(t *testing.T) {
+		data, err := cm.Read(ctx, insertLog)
+		require.NoError(t, err)
+		assert.Equal(t, content, data)
+
+		datas, err := cm.MultiRead(ctx, []string{insertLog, indexFile})
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{content, content}, datas)
+
+		_, datas, err = cm.ReadWithPrefix(ctx, path.Join(dir, common.SegmentDeltaLogPath))
+		require.NoError(t, err)
+		assert.Equal(t, [][]byte{content}, datas)
+
+		size, err := cm.Size(ctx, insertLog)
+		require.NoError(t, err)
+		assert.EqualValues(t, len(content), size)
+		size, err = cm.Size(ctx, indexFile)
+		require.NoError(t, err)
+		assert.EqualValues(t, len(content), size)
+
+		data, err = cm.ReadAt(ctx, insertLog, 7, 7)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("content"), data)
+		_, err = cm.ReadAt(ctx, insertLog, 7, 100)
+		assert.Error(t, err)
+		data, err = cm.ReadAt(ctx, indexFile, 0, 6)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("binlog"), data)
+
+		reader, err := cm.Reader(ctx, insertLog)
+		require.NoError(t, err)
+		data, err = io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, content, data)
+		assert.NoError(t, reader.Close())
+
+		// decrypted by the other nodes, even if the encryption disabled
+		data, err = newCM(false, "").Read(ctx, insertLog)
+		require.NoError(t, err)
+		assert.Equal(t, content, data)
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		raw, err := local.Read(ctx, insertLog)
+		require.NoError(t, err)
+		raw[len(raw)-1] ^= 1
+		tampered := path.Join(dir, common.SegmentInsertLogPath, "1", "2", "3", "100", "2")
+		require.NoError(t, local.Write(ctx, tampered, raw))
+		_, err = cm.Read(ctx, tampered)
+		assert.ErrorIs(t, err, merr.ErrIoFailed)
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		current, keys, err := cm.CollectionDataKeys(ctx, 1)
+		require.NoError(t, err)
+		assert.Len(t, keys, 1)
+
+		version, err := cm.RotateDataKey(ctx, 1)
+		require.NoError(t, err)
+		assert.Greater(t, version, current)
+
+		rotatedLog := path.Join(dir, common.SegmentInsertLogPath, "1", "2", "3", "100", "3")
+		require.NoError(t, cm.Write(ctx, rotatedLog, content))
+		raw, err := local.Read(ctx, rotatedLog)
+		require.NoError(t, err)
+		_, keyVersion, err := parseEncryptionHeader(raw)
+		require.NoError(t, err)
+		assert.Equal(t, version, keyVersion)
+
+		// the files encrypted by either key are readable by the other nodes
+		other := newCM(true, "k1")
+		for _, file := range []string{insertLog, rotatedLog} {
+			data, err := other.Read(ctx, file)
+			require.NoError(t, err)
+			assert.Equal(t, content, data)
+		}
+		current, keys, err = other.CollectionDataKeys(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, version, current)
+		assert.Len(t, keys, 2)
+
+		// only for decryption if disabled
+		current, keys, err = newCM(false, "k1").CollectionDataKeys(ctx, 1)
+		require.NoError(t, err)
+		assert.Zero(t, current)
+		assert.Len(t, keys, 2)
+	})
+
+	t.Run("auto rotate", func(t *testing.T) {
+		rotating := newCM(true, "k1", DataKeyRotateInterval(time.Millisecond))
+		current, _, err := rotating.CollectionDataKeys(ctx, 1)
+		require.NoError(t, err)
+		time.Sleep(10 * time.Millisecond)
+		file := path.Join(dir, common.SegmentStatslogPath, "1", "2", "3", "100", "1")
+		require.NoError(t, rotating.Write(ctx, file, content))
+		raw, err := local.Read(ctx, file)
+		require.NoError(t, err)
+		_, version, err := parseEncryptionHeader(raw)
+		require.NoError(t, err)
+		assert.Greater(t, version, current)
+	})
+
+	t.Run("rewrap", func(t *testing.T) {
+		keyPaths, _, err := local.ListWithPrefix(ctx, path.Join(dir, encryptionKeyPath)+"/", true)
+		require.NoError(t, err)
+		rewrapping := newCM(true, "k2")
+		num, err := rewrapping.RewrapDataKeys(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, len(keyPaths), num)
+		num, err = rewrapping.RewrapDataKeys(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, num)
+
+		// readable without the retired master key
+		UnregisterKMS(testKMSName)
+		kms, err := NewLocalKMS(map[string][]byte{"k2": bytes.Repeat([]byte{2}, 32)})
+		require.NoError(t, err)
+		RegisterKMS(testKMSName, kms)
+		data, err := newCM(true, "k2").Read(ctx, insertLog)
+		require.NoError(t, err)
+		assert.Equal(t, content, data)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		c := newDefaultConfig()
+		Encryption(true, "unknown", "k1")(c)
+		_, err := newEncryptedChunkManager(local, c)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		c = newDefaultConfig()
+		Encryption(true, testKMSName, "")(c)
+		_, err = newEncryptedChunkManager(local, c)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		current, keys, err := GetCollectionDataKeys(ctx, local, 1)
+		assert.NoError(t, err)
+		assert.Zero(t, current)
+		assert.Nil(t, keys)
+	})
+}
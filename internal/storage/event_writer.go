@@ -166,6 +166,10 @@ func (writer *baseEventWriter) Close() {
 
 func (writer *baseEventWriter) SetOffset(offset int32) {
 	writer.offset = offset
+	// the event may be finished before the offset known, e.g. the page index of the version 2 binlog
+	if writer.isFinish && !writer.isClosed {
+		writer.NextPosition = writer.EventLength + offset
+	}
 }
 
 type insertEventWriter struct {
@@ -212,16 +216,16 @@ func newDescriptorEvent() *descriptorEvent {
 	}
 }
 
-func newInsertEventWriter(dataType schemapb.DataType, dim ...int) (*insertEventWriter, error) {
+func newInsertEventWriter(dataType schemapb.DataType, compression BinlogCompression, dim ...int) (*insertEventWriter, error) {
 	var payloadWriter PayloadWriterInterface
 	var err error
 	if typeutil.IsVectorType(dataType) && !typeutil.IsSparseVectorType(dataType) {
 		if len(dim) != 1 {
 			return nil, fmt.Errorf("incorrect input numbers")
 		}
-		payloadWriter, err = NewPayloadWriter(dataType, dim[0])
+		payloadWriter, err = newPayloadWriter(dataType, compression, dim[0])
 	} else {
-		payloadWriter, err = NewPayloadWriter(dataType)
+		payloadWriter, err = newPayloadWriter(dataType, compression)
 	}
 	if err != nil {
 		return nil, err
@@ -243,8 +247,8 @@ func newInsertEventWriter(dataType schemapb.DataType, dim ...int) (*insertEventW
 	return writer, nil
 }
 
-func newDeleteEventWriter(dataType schemapb.DataType) (*deleteEventWriter, error) {
-	payloadWriter, err := NewPayloadWriter(dataType)
+func newDeleteEventWriter(dataType schemapb.DataType, compression BinlogCompression) (*deleteEventWriter, error) {
+	payloadWriter, err := newPayloadWriter(dataType, compression)
 	if err != nil {
 		return nil, err
 	}
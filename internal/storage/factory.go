@@ -2,9 +2,11 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/cockroachdb/errors"
 
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -14,6 +16,9 @@ type ChunkManagerFactory struct {
 }
 
 func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkManagerFactory {
+	InitRequestBudget(params)
+	initLocalKMS(params)
+	initReadCache(params)
 	if params.CommonCfg.StorageType.GetValue() == "local" {
 		return NewChunkManagerFactory("local", RootPath(params.LocalStorageCfg.Path.GetValue()))
 	}
@@ -31,6 +36,13 @@ func NewChunkManagerFactoryWithParam(params *paramtable.ComponentParam) *ChunkMa
 		UseVirtualHost(params.MinioCfg.UseVirtualHost.GetAsBool()),
 		Region(params.MinioCfg.Region.GetValue()),
 		RequestTimeout(params.MinioCfg.RequestTimeoutMs.GetAsInt64()),
+		UseNativeClient(params.MinioCfg.UseNativeClient.GetAsBool()),
+		MaxRetries(params.MinioCfg.MaxRetries.GetAsInt()),
+		MultipartThreshold(params.MinioCfg.MultipartThresholdMB.GetAsInt64()*1024*1024),
+		MultipartPartSize(params.MinioCfg.MultipartPartSizeMB.GetAsInt64()*1024*1024),
+		Encryption(params.MinioCfg.EncryptionEnabled.GetAsBool(), params.MinioCfg.EncryptionKMS.GetValue(), params.MinioCfg.EncryptionMasterKeyID.GetValue()),
+		DataKeyRotateInterval(time.Duration(params.MinioCfg.EncryptionKeyRotationDays.GetAsInt64())*24*time.Hour),
+		DataKeyRefreshInterval(params.MinioCfg.EncryptionKeyRefreshSeconds.GetAsDuration(time.Second)),
 		CreateBucket(true))
 }
 
@@ -49,19 +61,68 @@ func (f *ChunkManagerFactory) newChunkManager(ctx context.Context, engine string
 	switch engine {
 	case "local":
 		return NewLocalChunkManager(RootPath(f.config.rootPath)), nil
-	case "minio", "opendal":
-		return newMinioChunkManagerWithConfig(ctx, f.config)
-	case "remote":
-		return NewRemoteChunkManager(ctx, f.config)
+	case "minio", "opendal", "remote":
+		cm, err := f.newRemoteChunkManager(ctx, engine, f.config)
+		if err != nil {
+			return nil, err
+		}
+		return newRoutingChunkManager(cm, func(ctx context.Context, storage common.CollectionStorage) (ChunkManager, error) {
+			return f.newRemoteChunkManager(ctx, engine, f.config.withCollectionStorage(storage))
+		}), nil
 	default:
 		return nil, errors.New("no chunk manager implemented with engine: " + engine)
 	}
 }
 
+func (f *ChunkManagerFactory) newRemoteChunkManager(ctx context.Context, engine string, c *config) (ChunkManager, error) {
+	var cm ChunkManager
+	var err error
+	if engine == "remote" {
+		cm, err = NewRemoteChunkManager(ctx, c)
+	} else {
+		cm, err = newMinioChunkManagerWithConfig(ctx, c)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return wrapEncryption(c, wrapReadCache(c, cm))
+}
+
+// wrapReadCache wraps the chunk manager to read through the read cache if enabled,
+// the encrypted objects are cached as is.
+func wrapReadCache(c *config, cm ChunkManager) ChunkManager {
+	cache := globalReadCache.Load()
+	if cache == nil {
+		return cm
+	}
+	return newCachedChunkManager(cm, cache, c.bucketName)
+}
+
+// wrapEncryption wraps the chunk manager to encrypt and decrypt the files if the master key is configured.
+func wrapEncryption(c *config, cm ChunkManager) (ChunkManager, error) {
+	if !c.encryptionEnabled && c.masterKeyID == "" {
+		return cm, nil
+	}
+	return newEncryptedChunkManager(cm, c)
+}
+
 func (f *ChunkManagerFactory) NewPersistentStorageChunkManager(ctx context.Context) (ChunkManager, error) {
 	return f.newChunkManager(ctx, f.persistentStorage)
 }
 
+// NewStorageChunkManager creates the chunk manager of another storage, like the replica storage of the standby cluster,
+// which is the persistent storage overridden by the given one.
+func (f *ChunkManagerFactory) NewStorageChunkManager(ctx context.Context, storage common.CollectionStorage) (ChunkManager, error) {
+	switch f.persistentStorage {
+	case "local":
+		return NewLocalChunkManager(RootPath(storage.RootPath)), nil
+	case "minio", "opendal", "remote":
+		return f.newRemoteChunkManager(ctx, f.persistentStorage, f.config.withCollectionStorage(storage))
+	default:
+		return nil, errors.New("no chunk manager implemented with engine: " + f.persistentStorage)
+	}
+}
+
 type Factory interface {
 	NewPersistentStorageChunkManager(ctx context.Context) (ChunkManager, error)
 }
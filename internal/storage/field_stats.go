@@ -33,10 +33,11 @@ import (
 type FieldStats struct {
 	FieldID   int64              `json:"fieldID"`
 	Type      schemapb.DataType  `json:"type"`
-	Max       ScalarFieldValue   `json:"max"`       // for scalar field
-	Min       ScalarFieldValue   `json:"min"`       // for scalar field
-	BF        *bloom.BloomFilter `json:"bf"`        // for scalar field
-	Centroids []VectorFieldValue `json:"centroids"` // for vector field
+	Max       ScalarFieldValue   `json:"max"`              // for scalar field
+	Min       ScalarFieldValue   `json:"min"`              // for scalar field
+	BF        *bloom.BloomFilter `json:"bf"`               // for scalar field
+	Sketch    *HyperLogLog       `json:"sketch,omitempty"` // for scalar field
+	Centroids []VectorFieldValue `json:"centroids"`        // for vector field
 }
 
 // UnmarshalJSON unmarshal bytes to FieldStats
@@ -148,6 +149,14 @@ func (stats *FieldStats) UnmarshalJSON(data []byte) error {
 				return err
 			}
 		}
+
+		if sketchMessage, ok := messageMap["sketch"]; ok && sketchMessage != nil && string(*sketchMessage) != "null" {
+			stats.Sketch = &HyperLogLog{}
+			err = json.Unmarshal(*sketchMessage, stats.Sketch)
+			if err != nil {
+				return err
+			}
+		}
 	} else {
 		stats.initCentroids(data, stats.Type)
 		err = json.Unmarshal(*messageMap["centroids"], &stats.Centroids)
@@ -196,7 +205,7 @@ func (stats *FieldStats) UpdateByMsgs(msgs FieldData) {
 			pk := NewInt8FieldValue(int8Value)
 			stats.UpdateMinMax(pk)
 			common.Endian.PutUint64(b, uint64(int8Value))
-			stats.BF.Add(b)
+			stats.addValue(b)
 		}
 	case schemapb.DataType_Int16:
 		data := msgs.(*Int16FieldData).Data
@@ -209,7 +218,7 @@ func (stats *FieldStats) UpdateByMsgs(msgs FieldData) {
 			pk := NewInt16FieldValue(int16Value)
 			stats.UpdateMinMax(pk)
 			common.Endian.PutUint64(b, uint64(int16Value))
-			stats.BF.Add(b)
+			stats.addValue(b)
 		}
 	case schemapb.DataType_Int32:
 		data := msgs.(*Int32FieldData).Data
@@ -222,7 +231,7 @@ func (stats *FieldStats) UpdateByMsgs(msgs FieldData) {
 			pk := NewInt32FieldValue(int32Value)
 			stats.UpdateMinMax(pk)
 			common.Endian.PutUint64(b, uint64(int32Value))
-			stats.BF.Add(b)
+			stats.addValue(b)
 		}
 	case schemapb.DataType_Int64:
 		data := msgs.(*Int64FieldData).Data
@@ -235,7 +244,7 @@ func (stats *FieldStats) UpdateByMsgs(msgs FieldData) {
 			pk := NewInt64FieldValue(int64Value)
 			stats.UpdateMinMax(pk)
 			common.Endian.PutUint64(b, uint64(int64Value))
-			stats.BF.Add(b)
+			stats.addValue(b)
 		}
 	case schemapb.DataType_Float:
 		data := msgs.(*FloatFieldData).Data
@@ -248,7 +257,7 @@ func (stats *FieldStats) UpdateByMsgs(msgs FieldData) {
 			pk := NewFloatFieldValue(floatValue)
 			stats.UpdateMinMax(pk)
 			common.Endian.PutUint64(b, uint64(floatValue))
-			stats.BF.Add(b)
+			stats.addValue(b)
 		}
 	case schemapb.DataType_Double:
 		data := msgs.(*DoubleFieldData).Data
@@ -261,7 +270,7 @@ func (stats *FieldStats) UpdateByMsgs(msgs FieldData) {
 			pk := NewDoubleFieldValue(doubleValue)
 			stats.UpdateMinMax(pk)
 			common.Endian.PutUint64(b, uint64(doubleValue))
-			stats.BF.Add(b)
+			stats.addValue(b)
 		}
 	case schemapb.DataType_String:
 		data := msgs.(*StringFieldData).Data
@@ -272,7 +281,7 @@ func (stats *FieldStats) UpdateByMsgs(msgs FieldData) {
 		for _, str := range data {
 			pk := NewStringFieldValue(str)
 			stats.UpdateMinMax(pk)
-			stats.BF.AddString(str)
+			stats.addStringValue(str)
 		}
 	case schemapb.DataType_VarChar:
 		data := msgs.(*StringFieldData).Data
@@ -283,7 +292,7 @@ func (stats *FieldStats) UpdateByMsgs(msgs FieldData) {
 		for _, str := range data {
 			pk := NewVarCharFieldValue(str)
 			stats.UpdateMinMax(pk)
-			stats.BF.AddString(str)
+			stats.addStringValue(str)
 		}
 	default:
 		// TODO::
@@ -297,38 +306,38 @@ func (stats *FieldStats) Update(pk ScalarFieldValue) {
 		data := pk.GetValue().(int8)
 		b := make([]byte, 8)
 		common.Endian.PutUint64(b, uint64(data))
-		stats.BF.Add(b)
+		stats.addValue(b)
 	case schemapb.DataType_Int16:
 		data := pk.GetValue().(int16)
 		b := make([]byte, 8)
 		common.Endian.PutUint64(b, uint64(data))
-		stats.BF.Add(b)
+		stats.addValue(b)
 	case schemapb.DataType_Int32:
 		data := pk.GetValue().(int32)
 		b := make([]byte, 8)
 		common.Endian.PutUint64(b, uint64(data))
-		stats.BF.Add(b)
+		stats.addValue(b)
 	case schemapb.DataType_Int64:
 		data := pk.GetValue().(int64)
 		b := make([]byte, 8)
 		common.Endian.PutUint64(b, uint64(data))
-		stats.BF.Add(b)
+		stats.addValue(b)
 	case schemapb.DataType_Float:
 		data := pk.GetValue().(float32)
 		b := make([]byte, 8)
 		common.Endian.PutUint64(b, uint64(data))
-		stats.BF.Add(b)
+		stats.addValue(b)
 	case schemapb.DataType_Double:
 		data := pk.GetValue().(float64)
 		b := make([]byte, 8)
 		common.Endian.PutUint64(b, uint64(data))
-		stats.BF.Add(b)
+		stats.addValue(b)
 	case schemapb.DataType_String:
 		data := pk.GetValue().(string)
-		stats.BF.AddString(data)
+		stats.addStringValue(data)
 	case schemapb.DataType_VarChar:
 		data := pk.GetValue().(string)
-		stats.BF.AddString(data)
+		stats.addStringValue(data)
 	default:
 		// todo support vector field
 	}
@@ -354,6 +363,86 @@ func (stats *FieldStats) SetVectorCentroids(centroids ...VectorFieldValue) {
 	stats.Centroids = centroids
 }
 
+// Merge merges the stats of the same field into the stats,
+// the bloom filter or the sketch is dropped if any of them has none or they are not of the same size.
+func (stats *FieldStats) Merge(other *FieldStats) {
+	if other.Min != nil {
+		stats.UpdateMinMax(other.Min)
+	}
+	if other.Max != nil {
+		stats.UpdateMinMax(other.Max)
+	}
+	if stats.BF == nil || other.BF == nil || stats.BF.Merge(other.BF) != nil {
+		stats.BF = nil
+	}
+	if stats.Sketch == nil || other.Sketch == nil || stats.Sketch.Merge(other.Sketch) != nil {
+		stats.Sketch = nil
+	}
+}
+
+func (stats *FieldStats) addValue(b []byte) {
+	if stats.BF != nil {
+		stats.BF.Add(b)
+	}
+	if stats.Sketch != nil {
+		stats.Sketch.Add(b)
+	}
+}
+
+func (stats *FieldStats) addStringValue(str string) {
+	if stats.BF != nil {
+		stats.BF.AddString(str)
+	}
+	if stats.Sketch != nil {
+		stats.Sketch.AddString(str)
+	}
+}
+
+// TestInt64 returns whether the integer value may be in the field by the bloom filter,
+// true if there is no bloom filter.
+func (stats *FieldStats) TestInt64(value int64) bool {
+	if stats.BF == nil {
+		return true
+	}
+	b := make([]byte, 8)
+	common.Endian.PutUint64(b, uint64(value))
+	return stats.BF.Test(b)
+}
+
+// TestString returns whether the string value may be in the field by the bloom filter,
+// true if there is no bloom filter.
+func (stats *FieldStats) TestString(value string) bool {
+	if stats.BF == nil {
+		return true
+	}
+	return stats.BF.TestString(value)
+}
+
+// IsScalarStatsSupported returns whether the scalar stats of the field could be collected.
+func IsScalarStatsSupported(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_String, schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewScalarFieldStats creates the stats of the scalar field values,
+// the bloom filter is of the fixed size common.bloomFilterSize so that the stats could be merged.
+func NewScalarFieldStats(fieldID int64, dataType schemapb.DataType, withBF bool) *FieldStats {
+	stats := &FieldStats{
+		FieldID: fieldID,
+		Type:    dataType,
+	}
+	if withBF {
+		stats.BF = bloom.NewWithEstimates(paramtable.Get().CommonCfg.BloomFilterSize.GetAsUint(),
+			paramtable.Get().CommonCfg.MaxBloomFalsePositive.GetAsFloat())
+	}
+	return stats
+}
+
 func NewFieldStats(fieldID int64, pkType schemapb.DataType, rowNum int64) (*FieldStats, error) {
 	if pkType == schemapb.DataType_FloatVector {
 		return &FieldStats{
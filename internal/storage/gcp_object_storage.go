@@ -0,0 +1,498 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"github.com/milvus-io/milvus/internal/storage/gcp"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+const (
+	gcsScope = "https://www.googleapis.com/auth/devstorage.read_write"
+	// the chunks of resumable upload must be multiple of 256 KiB except the last one
+	gcsChunkAlignment = 256 * 1024
+)
+
+// GcpResponseError is the error responded by the GCS JSON API.
+type GcpResponseError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *GcpResponseError) Error() string {
+	return fmt.Sprintf("gcs responded status %d: %s", e.StatusCode, e.Message)
+}
+
+// isGcpRetryableErr returns whether the request should be retried,
+// the transport errors, request timeout, throttling and server errors are retryable.
+func isGcpRetryableErr(err error) bool {
+	if errors.IsAny(err, context.Canceled, context.DeadlineExceeded) {
+		return false
+	}
+	var respErr *GcpResponseError
+	if errors.As(err, &respErr) {
+		return respErr.StatusCode == http.StatusRequestTimeout || respErr.StatusCode == http.StatusTooManyRequests ||
+			respErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// GcpObjectStorage accesses GCS by its native JSON API rather than the S3 compatible API.
+type GcpObjectStorage struct {
+	client             *http.Client
+	endpoint           string
+	maxRetries         int
+	multipartThreshold int64
+	multipartPartSize  int64
+}
+
+func newGcpHTTPClient(ctx context.Context, c *config) (*http.Client, string, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.requestTimeoutMs > 0 {
+		// only limit the time to wait for the response header, the downloading of large objects takes longer
+		transport.ResponseHeaderTimeout = time.Duration(c.requestTimeoutMs) * time.Millisecond
+	}
+	if c.useSSL && len(c.sslCACert) > 0 {
+		caCert, err := os.ReadFile(c.sslCACert)
+		if err != nil {
+			return nil, "", err
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCert) {
+			return nil, "", merr.WrapErrParameterInvalidMsg("invalid ca cert %s", c.sslCACert)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: certPool, MinVersion: tls.VersionTLS12}
+	}
+
+	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
+	if !c.useIAM {
+		// anonymous access, for the emulators only
+		return &http.Client{Transport: transport}, projectID, nil
+	}
+	// the application default credentials cover the service account key file, the workload identity federation,
+	// and the workload identity of GKE served by the metadata server
+	creds, err := google.FindDefaultCredentials(ctx, gcsScope)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to find gcp default credentials")
+	}
+	if projectID == "" {
+		projectID = creds.ProjectID
+	}
+	return &http.Client{Transport: &oauth2.Transport{
+		Source: oauth2.ReuseTokenSource(nil, creds.TokenSource),
+		Base:   transport,
+	}}, projectID, nil
+}
+
+func newGcpObjectStorageWithConfig(ctx context.Context, c *config) (*GcpObjectStorage, error) {
+	if c.bucketName == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid empty bucket name")
+	}
+	client, projectID, err := newGcpHTTPClient(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	// the 308 responded by the resumable upload isn't a redirection
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	endpoint := "https://" + gcp.GcsDefaultAddress
+	if c.address != "" && !strings.Contains(c.address, gcp.GcsDefaultAddress) {
+		endpoint = "http://" + c.address
+		if c.useSSL {
+			endpoint = "https://" + c.address
+		}
+	}
+	gcs := &GcpObjectStorage{
+		client:             client,
+		endpoint:           endpoint,
+		maxRetries:         c.maxRetries,
+		multipartThreshold: c.multipartThreshold,
+		multipartPartSize:  c.multipartPartSize,
+	}
+
+	// check valid in first query
+	checkBucketFn := func() error {
+		resp, err := gcs.doOnce(ctx, http.MethodGet, gcs.bucketURL(c.bucketName), nil, nil)
+		if err == nil {
+			resp.Body.Close()
+			return nil
+		}
+		var respErr *GcpResponseError
+		if !c.createBucket || !errors.As(err, &respErr) || respErr.StatusCode != http.StatusNotFound {
+			log.Warn("failed to check gcs bucket exist", zap.String("bucket", c.bucketName), zap.Error(err))
+			return err
+		}
+		if projectID == "" {
+			return retry.Unrecoverable(merr.WrapErrParameterInvalidMsg("project id is required to create gcs bucket, set it by GOOGLE_CLOUD_PROJECT"))
+		}
+		log.Info("gcs bucket not exist, create bucket.", zap.String("bucket name", c.bucketName))
+		body, err := json.Marshal(map[string]string{"name": c.bucketName})
+		if err != nil {
+			return err
+		}
+		header := http.Header{"Content-Type": {"application/json"}}
+		resp, err = gcs.doOnce(ctx, http.MethodPost, gcs.endpoint+"/storage/v1/b?project="+url.QueryEscape(projectID), header, body)
+		if err != nil {
+			log.Warn("failed to create gcs bucket", zap.String("bucket", c.bucketName), zap.Error(err))
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	}
+	err = retry.Do(ctx, checkBucketFn, retry.Attempts(CheckBucketRetryAttempts))
+	if err != nil {
+		return nil, err
+	}
+	return gcs, nil
+}
+
+func (gcs *GcpObjectStorage) bucketURL(bucketName string) string {
+	return gcs.endpoint + "/storage/v1/b/" + url.PathEscape(bucketName)
+}
+
+// objectURL returns the url of the object, the slashes of the object name must be escaped as well.
+func (gcs *GcpObjectStorage) objectURL(bucketName, objectName string) string {
+	return gcs.bucketURL(bucketName) + "/o/" + url.PathEscape(objectName)
+}
+
+func (gcs *GcpObjectStorage) uploadURL(bucketName, objectName, uploadType string) string {
+	return gcs.endpoint + "/upload/storage/v1/b/" + url.PathEscape(bucketName) + "/o?uploadType=" + uploadType +
+		"&name=" + url.QueryEscape(objectName)
+}
+
+// doOnce sends the request, the response is returned only if it succeeds, the caller must close its body.
+func (gcs *GcpObjectStorage) doOnce(ctx context.Context, method, rawURL string, header http.Header, body []byte) (*http.Response, error) {
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, reader)
+	if err != nil {
+		return nil, retry.Unrecoverable(err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	resp, err := gcs.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	// 308 means the chunk of the resumable upload is persisted and more are expected
+	if resp.StatusCode < http.StatusMultipleChoices || resp.StatusCode == http.StatusPermanentRedirect {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	respErr := &GcpResponseError{StatusCode: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+	var errBody struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 4096)).Decode(&errBody); err == nil && errBody.Error.Message != "" {
+		respErr.Message = errBody.Error.Message
+	}
+	return nil, respErr
+}
+
+// do sends the request and retries it with exponential backoff if the error is retryable.
+func (gcs *GcpObjectStorage) do(ctx context.Context, method, rawURL string, header http.Header, body []byte) (*http.Response, error) {
+	var resp *http.Response
+	err := retry.Do(ctx, func() error {
+		var err error
+		resp, err = gcs.doOnce(ctx, method, rawURL, header, body)
+		return err
+	}, retry.Attempts(uint(gcs.maxRetries)+1), retry.RetryErr(isGcpRetryableErr))
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GcpObjectReader is implemented because the downloading stream doesn't have ReadAt and Seek interfaces.
+// GcpObjectReader is not concurrency safe.
+type GcpObjectReader struct {
+	gcs             *GcpObjectStorage
+	url             string
+	position        int64
+	body            io.ReadCloser
+	needResetStream bool
+}
+
+func (r *GcpObjectReader) download(ctx context.Context, httpRange string) (io.ReadCloser, error) {
+	var header http.Header
+	if httpRange != "" {
+		header = http.Header{"Range": {httpRange}}
+	}
+	resp, err := r.gcs.do(ctx, http.MethodGet, r.url, header, nil)
+	if err != nil {
+		var respErr *GcpResponseError
+		if errors.As(err, &respErr) && respErr.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	return resp.Body, nil
+}
+
+func (r *GcpObjectReader) Read(p []byte) (n int, err error) {
+	if r.needResetStream {
+		if r.body != nil {
+			r.body.Close()
+		}
+		// the range isn't satisfiable for the empty object, download it all from the start
+		httpRange := ""
+		if r.position > 0 {
+			httpRange = fmt.Sprintf("bytes=%d-", r.position)
+		}
+		body, err := r.download(context.TODO(), httpRange)
+		if err != nil {
+			return 0, err
+		}
+		r.body = body
+		r.needResetStream = false
+	}
+	// the empty body of the empty object returns EOF even reading nothing
+	if len(p) == 0 {
+		return 0, nil
+	}
+
+	n, err = r.body.Read(p)
+	r.position += int64(n)
+	return n, err
+}
+
+func (r *GcpObjectReader) Close() error {
+	if r.body != nil {
+		return r.body.Close()
+	}
+	return nil
+}
+
+func (r *GcpObjectReader) ReadAt(p []byte, off int64) (n int, err error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	body, err := r.download(context.Background(), fmt.Sprintf("bytes=%d-%d", off, off+int64(len(p))-1))
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+	return io.ReadFull(body, p)
+}
+
+func (r *GcpObjectReader) Seek(offset int64, whence int) (int64, error) {
+	var newOffset int64
+	switch whence {
+	case io.SeekStart:
+		newOffset = offset
+	case io.SeekCurrent:
+		newOffset = r.position + offset
+	case io.SeekEnd:
+		size, err := r.gcs.statObject(context.Background(), strings.TrimSuffix(r.url, "?alt=media"))
+		if err != nil {
+			return 0, err
+		}
+		newOffset = size + offset
+	default:
+		return 0, merr.WrapErrIoFailedReason("invalid whence")
+	}
+
+	r.position = newOffset
+	r.needResetStream = true
+	return newOffset, nil
+}
+
+func (gcs *GcpObjectStorage) GetObject(ctx context.Context, bucketName, objectName string, offset int64, size int64) (FileReader, error) {
+	return &GcpObjectReader{
+		gcs:             gcs,
+		url:             gcs.objectURL(bucketName, objectName) + "?alt=media",
+		position:        offset,
+		needResetStream: true,
+	}, nil
+}
+
+// PutObject uploads the object by a single request if it's not larger than the multipart threshold,
+// otherwise by the resumable upload in chunks of the multipart part size, so that only the failed chunk is retried.
+func (gcs *GcpObjectStorage) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
+	if objectSize >= 0 && objectSize <= gcs.multipartThreshold {
+		data, err := io.ReadAll(io.LimitReader(reader, objectSize))
+		if err != nil {
+			return checkObjectStorageError(objectName, err)
+		}
+		header := http.Header{"Content-Type": {"application/octet-stream"}}
+		resp, err := gcs.do(ctx, http.MethodPost, gcs.uploadURL(bucketName, objectName, "media"), header, data)
+		if err != nil {
+			return checkObjectStorageError(objectName, err)
+		}
+		return resp.Body.Close()
+	}
+	return checkObjectStorageError(objectName, gcs.putObjectByChunks(ctx, bucketName, objectName, reader))
+}
+
+func (gcs *GcpObjectStorage) putObjectByChunks(ctx context.Context, bucketName, objectName string, reader io.Reader) error {
+	resp, err := gcs.do(ctx, http.MethodPost, gcs.uploadURL(bucketName, objectName, "resumable"), nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	session := resp.Header.Get("Location")
+	if session == "" {
+		return errors.New("no session uri responded for resumable upload")
+	}
+
+	chunkSize := (gcs.multipartPartSize + gcsChunkAlignment - 1) / gcsChunkAlignment * gcsChunkAlignment
+	if chunkSize <= 0 {
+		chunkSize = gcsChunkAlignment
+	}
+	buf := make([]byte, chunkSize)
+	bufReader := bufio.NewReader(reader)
+	var offset int64
+	for {
+		n, err := io.ReadFull(bufReader, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		last := n < len(buf)
+		if !last {
+			if _, err := bufReader.Peek(1); err == io.EOF {
+				last = true
+			} else if err != nil {
+				return err
+			}
+		}
+
+		total := "*"
+		if last {
+			total = strconv.FormatInt(offset+int64(n), 10)
+		}
+		contentRange := fmt.Sprintf("bytes %d-%d/%s", offset, offset+int64(n)-1, total)
+		if n == 0 {
+			contentRange = "bytes */" + total
+		}
+		// the bytes already persisted are ignored by gcs if the chunk is sent again by retry
+		resp, err := gcs.do(ctx, http.MethodPut, session, http.Header{"Content-Range": {contentRange}}, buf[:n])
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		offset += int64(n)
+		if last {
+			return nil
+		}
+	}
+}
+
+func (gcs *GcpObjectStorage) statObject(ctx context.Context, rawURL string) (int64, error) {
+	resp, err := gcs.do(ctx, http.MethodGet, rawURL, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	var object struct {
+		Size int64 `json:"size,string"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&object); err != nil {
+		return 0, err
+	}
+	return object.Size, nil
+}
+
+func (gcs *GcpObjectStorage) StatObject(ctx context.Context, bucketName, objectName string) (int64, error) {
+	size, err := gcs.statObject(ctx, gcs.objectURL(bucketName, objectName))
+	if err != nil {
+		return 0, checkObjectStorageError(objectName, err)
+	}
+	return size, nil
+}
+
+func (gcs *GcpObjectStorage) ListObjects(ctx context.Context, bucketName string, prefix string, recursive bool) ([]string, []time.Time, error) {
+	var objectsKeys []string
+	var modTimes []time.Time
+	query := url.Values{}
+	query.Set("prefix", prefix)
+	query.Set("fields", "items(name,updated),prefixes,nextPageToken")
+	if !recursive {
+		query.Set("delimiter", "/")
+	}
+	for {
+		resp, err := gcs.do(ctx, http.MethodGet, gcs.bucketURL(bucketName)+"/o?"+query.Encode(), nil, nil)
+		if err != nil {
+			return []string{}, []time.Time{}, checkObjectStorageError(prefix, err)
+		}
+		var page struct {
+			Items []struct {
+				Name    string    `json:"name"`
+				Updated time.Time `json:"updated"`
+			} `json:"items"`
+			Prefixes      []string `json:"prefixes"`
+			NextPageToken string   `json:"nextPageToken"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return []string{}, []time.Time{}, checkObjectStorageError(prefix, err)
+		}
+		for _, item := range page.Items {
+			objectsKeys = append(objectsKeys, item.Name)
+			modTimes = append(modTimes, item.Updated)
+		}
+		for _, prefix := range page.Prefixes {
+			objectsKeys = append(objectsKeys, prefix)
+			modTimes = append(modTimes, time.Now())
+		}
+		if page.NextPageToken == "" {
+			return objectsKeys, modTimes, nil
+		}
+		query.Set("pageToken", page.NextPageToken)
+	}
+}
+
+// RemoveObject removes the object, it's fine if the object doesn't exist, which is the same as S3.
+func (gcs *GcpObjectStorage) RemoveObject(ctx context.Context, bucketName, objectName string) error {
+	resp, err := gcs.do(ctx, http.MethodDelete, gcs.objectURL(bucketName, objectName), nil, nil)
+	if err != nil {
+		var respErr *GcpResponseError
+		if errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return checkObjectStorageError(objectName, err)
+	}
+	return resp.Body.Close()
+}
@@ -0,0 +1,308 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type fakeGcsSession struct {
+	bucket string
+	name   string
+	data   []byte
+}
+
+// fakeGcs serves the subset of the GCS JSON API used by GcpObjectStorage.
+type fakeGcs struct {
+	mu        sync.Mutex
+	buckets   map[string]map[string][]byte
+	sessions  map[string]*fakeGcsSession
+	chunks    int
+	failures  int
+	serverURL string
+}
+
+func newFakeGcs() *fakeGcs {
+	return &fakeGcs{
+		buckets:  make(map[string]map[string][]byte),
+		sessions: make(map[string]*fakeGcsSession),
+	}
+}
+
+func (f *fakeGcs) error(w http.ResponseWriter, code int) {
+	w.WriteHeader(code)
+	fmt.Fprintf(w, `{"error":{"code":%d,"message":"fake %s"}}`, code, http.StatusText(code))
+}
+
+func (f *fakeGcs) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failures > 0 {
+		f.failures--
+		f.error(w, http.StatusServiceUnavailable)
+		return
+	}
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.EscapedPath(), "/"), "/")
+	for i := range parts {
+		parts[i], _ = url.PathUnescape(parts[i])
+	}
+	query := r.URL.Query()
+	switch {
+	case len(parts) == 3 && parts[0] == "storage" && r.Method == http.MethodPost:
+		var bucket struct {
+			Name string `json:"name"`
+		}
+		json.NewDecoder(r.Body).Decode(&bucket)
+		f.buckets[bucket.Name] = make(map[string][]byte)
+	case len(parts) == 4 && parts[0] == "storage":
+		if _, ok := f.buckets[parts[3]]; !ok {
+			f.error(w, http.StatusNotFound)
+		}
+	case len(parts) == 5 && parts[0] == "storage":
+		f.list(w, f.buckets[parts[3]], query.Get("prefix"), query.Get("delimiter"))
+	case len(parts) == 6 && parts[0] == "storage":
+		f.object(w, r, f.buckets[parts[3]], parts[5])
+	case len(parts) == 6 && parts[0] == "upload" && query.Get("uploadType") == "media":
+		data, _ := io.ReadAll(r.Body)
+		f.buckets[parts[4]][query.Get("name")] = data
+	case len(parts) == 6 && parts[0] == "upload" && query.Get("uploadType") == "resumable":
+		id := strconv.Itoa(len(f.sessions))
+		f.sessions[id] = &fakeGcsSession{bucket: parts[4], name: query.Get("name")}
+		w.Header().Set("Location", f.serverURL+"/upload/session/"+id)
+	case len(parts) == 3 && parts[1] == "session":
+		f.chunks++
+		f.upload(w, r, f.sessions[parts[2]])
+	default:
+		f.error(w, http.StatusBadRequest)
+	}
+}
+
+func (f *fakeGcs) list(w http.ResponseWriter, objects map[string][]byte, prefix, delimiter string) {
+	type item struct {
+		Name    string    `json:"name"`
+		Updated time.Time `json:"updated"`
+	}
+	var page struct {
+		Items    []item   `json:"items"`
+		Prefixes []string `json:"prefixes"`
+	}
+	prefixes := make(map[string]struct{})
+	for name := range objects {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if idx := strings.Index(name[len(prefix):], "/"); delimiter != "" && idx >= 0 {
+			prefixes[name[:len(prefix)+idx+1]] = struct{}{}
+			continue
+		}
+		page.Items = append(page.Items, item{Name: name, Updated: time.Now()})
+	}
+	for prefix := range prefixes {
+		page.Prefixes = append(page.Prefixes, prefix)
+	}
+	json.NewEncoder(w).Encode(&page)
+}
+
+func (f *fakeGcs) object(w http.ResponseWriter, r *http.Request, objects map[string][]byte, name string) {
+	data, ok := objects[name]
+	if !ok {
+		f.error(w, http.StatusNotFound)
+		return
+	}
+	switch {
+	case r.Method == http.MethodDelete:
+		delete(objects, name)
+	case r.URL.Query().Get("alt") == "media":
+		var start, end int
+		n, _ := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &start, &end)
+		if n < 2 || end >= len(data) {
+			end = len(data) - 1
+		}
+		if n > 0 && start >= len(data) {
+			f.error(w, http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		w.Write(data[start : end+1])
+	default:
+		fmt.Fprintf(w, `{"name":%q,"size":"%d"}`, name, len(data))
+	}
+}
+
+func (f *fakeGcs) upload(w http.ResponseWriter, r *http.Request, session *fakeGcsSession) {
+	data, _ := io.ReadAll(r.Body)
+	contentRange := strings.TrimPrefix(r.Header.Get("Content-Range"), "bytes ")
+	rangeAndTotal := strings.Split(contentRange, "/")
+	if rangeAndTotal[0] != "*" {
+		var start int
+		fmt.Sscanf(rangeAndTotal[0], "%d-", &start)
+		// the bytes persisted already are ignored
+		session.data = append(session.data[:start], data...)
+	}
+	if rangeAndTotal[1] == "*" {
+		w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(session.data)-1))
+		w.WriteHeader(http.StatusPermanentRedirect)
+		return
+	}
+	f.buckets[session.bucket][session.name] = session.data
+}
+
+func TestGcpObjectStorage(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeGcs()
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	fake.serverURL = server.URL
+
+	config := &config{
+		address:            strings.TrimPrefix(server.URL, "http://"),
+		bucketName:         "gcs-bucket",
+		createBucket:       true,
+		cloudProvider:      CloudProviderGCP,
+		useNativeClient:    true,
+		maxRetries:         2,
+		multipartThreshold: 1024,
+		multipartPartSize:  1,
+		rootPath:           "files",
+	}
+
+	t.Run("create bucket without project", func(t *testing.T) {
+		t.Setenv("GOOGLE_CLOUD_PROJECT", "")
+		_, err := newGcpObjectStorageWithConfig(ctx, config)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Setenv("GOOGLE_CLOUD_PROJECT", "test-project")
+	cm, err := NewRemoteChunkManager(ctx, config)
+	require.NoError(t, err)
+	_, ok := cm.client.(*GcpObjectStorage)
+	require.True(t, ok)
+	assert.Contains(t, fake.buckets, config.bucketName)
+
+	t.Run("write and read", func(t *testing.T) {
+		assert.NoError(t, cm.Write(ctx, "files/a/b/c", []byte("abc")))
+		assert.NoError(t, cm.Write(ctx, "files/a/d", []byte("0123456789")))
+		assert.NoError(t, cm.Write(ctx, "files/e", []byte{}))
+
+		data, err := cm.Read(ctx, "files/a/b/c")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("abc"), data)
+		data, err = cm.ReadAt(ctx, "files/a/d", 2, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("234"), data)
+		size, err := cm.Size(ctx, "files/a/d")
+		assert.NoError(t, err)
+		assert.EqualValues(t, 10, size)
+		data, err = cm.Read(ctx, "files/e")
+		assert.NoError(t, err)
+		assert.Empty(t, data)
+
+		reader, err := cm.Reader(ctx, "files/a/d")
+		assert.NoError(t, err)
+		offset, err := reader.Seek(-3, io.SeekEnd)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 7, offset)
+		data, err = io.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("789"), data)
+		buf := make([]byte, 4)
+		_, err = reader.ReadAt(buf, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("1234"), buf)
+		assert.NoError(t, reader.Close())
+
+		_, err = cm.Read(ctx, "files/not_exist")
+		assert.ErrorIs(t, err, merr.ErrIoKeyNotFound)
+		exist, err := cm.Exist(ctx, "files/not_exist")
+		assert.NoError(t, err)
+		assert.False(t, exist)
+	})
+
+	t.Run("write by chunks", func(t *testing.T) {
+		large := make([]byte, 2*gcsChunkAlignment+100)
+		for i := range large {
+			large[i] = byte(i)
+		}
+		assert.NoError(t, cm.Write(ctx, "files/large", large))
+		assert.Equal(t, 3, fake.chunks)
+		data, err := cm.Read(ctx, "files/large")
+		assert.NoError(t, err)
+		assert.Equal(t, large, data)
+
+		// the size unknown
+		fake.chunks = 0
+		assert.NoError(t, cm.client.PutObject(ctx, config.bucketName, "files/large2", strings.NewReader(string(large[:gcsChunkAlignment])), -1))
+		assert.Equal(t, 1, fake.chunks)
+		data, err = cm.Read(ctx, "files/large2")
+		assert.NoError(t, err)
+		assert.Equal(t, large[:gcsChunkAlignment], data)
+		assert.NoError(t, cm.Remove(ctx, "files/large2"))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		keys, modTimes, err := cm.ListWithPrefix(ctx, "files/a/", false)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"files/a/b/", "files/a/d"}, keys)
+		assert.Len(t, modTimes, 2)
+
+		keys, _, err = cm.ListWithPrefix(ctx, "files/", true)
+		assert.NoError(t, err)
+		sort.Strings(keys)
+		assert.Equal(t, []string{"files/a/b/c", "files/a/d", "files/e", "files/large"}, keys)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		fake.failures = 2
+		assert.NoError(t, cm.Write(ctx, "files/retry", []byte("retry")))
+		data, err := cm.Read(ctx, "files/retry")
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("retry"), data)
+
+		fake.failures = 3
+		err = cm.Write(ctx, "files/retry", []byte("retry"))
+		assert.ErrorIs(t, err, merr.ErrIoThrottled)
+		fake.failures = 0
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		assert.NoError(t, cm.RemoveWithPrefix(ctx, "files/a/"))
+		assert.NoError(t, cm.Remove(ctx, "files/not_exist"))
+		exist, err := cm.Exist(ctx, "files/a/d")
+		assert.NoError(t, err)
+		assert.False(t, exist)
+		exist, err = cm.Exist(ctx, "files/e")
+		assert.NoError(t, err)
+		assert.True(t, exist)
+	})
+}
@@ -0,0 +1,105 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+
+	"github.com/cockroachdb/errors"
+)
+
+// hllPrecision is the number of hash bits to pick the register, 128 registers take 128 bytes
+// with the standard error about 9%, which is enough to tell the low cardinality fields.
+const hllPrecision = 7
+
+// HyperLogLog estimates the number of distinct values of a field, the sketches of the same precision
+// could be merged to estimate the number of distinct values of all of them.
+type HyperLogLog struct {
+	Registers []byte `json:"registers"`
+}
+
+func NewHyperLogLog() *HyperLogLog {
+	return &HyperLogLog{
+		Registers: make([]byte, 1<<hllPrecision),
+	}
+}
+
+// Add adds the value in bytes.
+func (h *HyperLogLog) Add(b []byte) {
+	hasher := fnv.New64a()
+	hasher.Write(b)
+	h.addHash(hasher.Sum64())
+}
+
+// AddString adds the string value.
+func (h *HyperLogLog) AddString(str string) {
+	hasher := fnv.New64a()
+	hasher.Write([]byte(str))
+	h.addHash(hasher.Sum64())
+}
+
+func (h *HyperLogLog) addHash(x uint64) {
+	// fnv doesn't spread the short values to the high bits, mix them up with the splitmix64 finalizer
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	idx := x >> (64 - hllPrecision)
+	rank := byte(bits.LeadingZeros64(x<<hllPrecision|1<<(hllPrecision-1)) + 1)
+	if rank > h.Registers[idx] {
+		h.Registers[idx] = rank
+	}
+}
+
+// Merge merges the other sketch into the sketch.
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	if len(h.Registers) != len(other.Registers) {
+		return errors.Newf("cannot merge hyperloglog of %d registers into %d registers", len(other.Registers), len(h.Registers))
+	}
+	for i, rank := range other.Registers {
+		if rank > h.Registers[i] {
+			h.Registers[i] = rank
+		}
+	}
+	return nil
+}
+
+// Count returns the estimated number of distinct values.
+func (h *HyperLogLog) Count() int64 {
+	m := float64(len(h.Registers))
+	if m == 0 {
+		return 0
+	}
+	sum, zeros := 0.0, 0
+	for _, rank := range h.Registers {
+		sum += math.Ldexp(1, -int(rank))
+		if rank == 0 {
+			zeros++
+		}
+	}
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+	// linear counting is more accurate for the small cardinality
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int64(estimate + 0.5)
+}
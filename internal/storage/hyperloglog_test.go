@@ -0,0 +1,56 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	h := NewHyperLogLog()
+	assert.EqualValues(t, 0, h.Count())
+
+	b := make([]byte, 8)
+	for i := 0; i < 100000; i++ {
+		common.Endian.PutUint64(b, uint64(i%20))
+		h.Add(b)
+	}
+	assert.InDelta(t, 20, h.Count(), 2)
+
+	other := NewHyperLogLog()
+	for i := 0; i < 100000; i++ {
+		other.AddString(fmt.Sprintf("value-%d", i))
+	}
+	assert.InEpsilon(t, 100000, other.Count(), 0.3)
+
+	data, err := json.Marshal(other)
+	assert.NoError(t, err)
+	decoded := &HyperLogLog{}
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, other.Count(), decoded.Count())
+
+	assert.NoError(t, h.Merge(decoded))
+	assert.InEpsilon(t, other.Count(), h.Count(), 0.01)
+
+	assert.Error(t, h.Merge(&HyperLogLog{Registers: make([]byte, 16)}))
+}
@@ -1,5 +1,7 @@
 package storage
 
+import "time"
+
 // Option for setting params used by chunk manager client.
 type config struct {
 	address           string
@@ -16,6 +18,18 @@ type config struct {
 	useVirtualHost    bool
 	region            string
 	requestTimeoutMs  int64
+
+	// only used by the native azure and gcp clients
+	useNativeClient    bool
+	maxRetries         int
+	multipartThreshold int64
+	multipartPartSize  int64
+
+	encryptionEnabled      bool
+	kmsName                string
+	masterKeyID            string
+	dataKeyRotateInterval  time.Duration
+	dataKeyRefreshInterval time.Duration
 }
 
 func newDefaultConfig() *config {
@@ -108,3 +122,49 @@ func RequestTimeout(requestTimeoutMs int64) Option {
 		c.requestTimeoutMs = requestTimeoutMs
 	}
 }
+
+func UseNativeClient(useNativeClient bool) Option {
+	return func(c *config) {
+		c.useNativeClient = useNativeClient
+	}
+}
+
+func MaxRetries(maxRetries int) Option {
+	return func(c *config) {
+		c.maxRetries = maxRetries
+	}
+}
+
+func MultipartThreshold(multipartThreshold int64) Option {
+	return func(c *config) {
+		c.multipartThreshold = multipartThreshold
+	}
+}
+
+func MultipartPartSize(multipartPartSize int64) Option {
+	return func(c *config) {
+		c.multipartPartSize = multipartPartSize
+	}
+}
+
+// Encryption sets whether to encrypt the log files written, and the master key of the kms to wrap the data keys,
+// the encrypted files are decrypted on read as long as the master key ID is set.
+func Encryption(enabled bool, kmsName string, masterKeyID string) Option {
+	return func(c *config) {
+		c.encryptionEnabled = enabled
+		c.kmsName = kmsName
+		c.masterKeyID = masterKeyID
+	}
+}
+
+func DataKeyRotateInterval(interval time.Duration) Option {
+	return func(c *config) {
+		c.dataKeyRotateInterval = interval
+	}
+}
+
+func DataKeyRefreshInterval(interval time.Duration) Option {
+	return func(c *config) {
+		c.dataKeyRefreshInterval = interval
+	}
+}
@@ -0,0 +1,272 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// The parquet segment files hold the rows of a field group synced in one batch as a plain parquet file,
+// one column per field named after the field, so that the external engines could scan them in place.
+// The columns are encoded the same as the payload of the insert binlogs, the vectors are the fixed size binaries
+// of the little endian elements, the arrays are the serialized ScalarField and the JSONs are the raw bytes.
+// The field IDs are recorded as the parquet field ids of the columns.
+// The deletes are not applied to the parquet files, the rows deleted after synced remain in them
+// until the compaction rewrites the segment without them, the deletes after that stay in the deltalogs as well.
+
+// parquetFieldIDKey is the arrow field metadata key converted to the parquet field id by pqarrow.
+const parquetFieldIDKey = "PARQUET:field_id"
+
+// ScalarFieldGroupID is the ID of the field group of all the scalar fields, including the system fields,
+// the other groups hold a vector field each and are identified by the field ID.
+const ScalarFieldGroupID int64 = 0
+
+// ParquetFieldGroup is the fields stored in the same parquet file.
+type ParquetFieldGroup struct {
+	GroupID int64
+	Fields  []*schemapb.FieldSchema
+}
+
+// FieldIDs returns the IDs of the fields of the group.
+func (g *ParquetFieldGroup) FieldIDs() []int64 {
+	ids := make([]int64, 0, len(g.Fields))
+	for _, field := range g.Fields {
+		ids = append(ids, field.GetFieldID())
+	}
+	return ids
+}
+
+// NewParquetFieldGroups splits the fields of the collection into the parquet field groups,
+// the scalar fields in one group and each vector field in its own group.
+func NewParquetFieldGroups(schema *schemapb.CollectionSchema) []*ParquetFieldGroup {
+	scalars := &ParquetFieldGroup{GroupID: ScalarFieldGroupID}
+	groups := []*ParquetFieldGroup{scalars}
+	for _, field := range schema.GetFields() {
+		if typeutil.IsVectorType(field.GetDataType()) {
+			groups = append(groups, &ParquetFieldGroup{GroupID: field.GetFieldID(), Fields: []*schemapb.FieldSchema{field}})
+			continue
+		}
+		scalars.Fields = append(scalars.Fields, field)
+	}
+	return groups
+}
+
+// SerializeParquetGroup serializes the rows of the group fields in the insert data into a parquet file.
+func SerializeParquetGroup(group *ParquetFieldGroup, data *InsertData, compression BinlogCompression) ([]byte, error) {
+	fields := make([]arrow.Field, 0, len(group.Fields))
+	columns := make([]arrow.Column, 0, len(group.Fields))
+	defer func() {
+		for i := range columns {
+			columns[i].Release()
+		}
+	}()
+
+	rows := -1
+	for _, field := range group.Fields {
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok {
+			return nil, merr.WrapErrFieldNotFound(field.GetFieldID(), "field of parquet group not found in insert data")
+		}
+		if rows >= 0 && fieldData.RowNum() != rows {
+			return nil, merr.WrapErrParameterInvalidMsg("row num of field %d mismatch, %d vs %d", field.GetFieldID(), fieldData.RowNum(), rows)
+		}
+		rows = fieldData.RowNum()
+		if rows == 0 {
+			break
+		}
+
+		arr, err := buildParquetColumn(field, fieldData)
+		if err != nil {
+			return nil, err
+		}
+		arrowField := arrow.Field{
+			Name:     field.GetName(),
+			Type:     arr.DataType(),
+			Metadata: arrow.NewMetadata([]string{parquetFieldIDKey}, []string{strconv.FormatInt(field.GetFieldID(), 10)}),
+		}
+		fields = append(fields, arrowField)
+		columns = append(columns, arrow.NewColumnFromArr(arrowField, arr))
+		arr.Release()
+	}
+	if rows <= 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("no rows of parquet group %d", group.GroupID)
+	}
+
+	table := array.NewTable(arrow.NewSchema(fields, nil), columns, int64(rows))
+	defer table.Release()
+
+	buf := new(bytes.Buffer)
+	err := pqarrow.WriteTable(table,
+		buf,
+		int64(rows),
+		compression.writerProperties(),
+		pqarrow.NewArrowWriterProperties(pqarrow.WithStoreSchema()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// buildParquetColumn builds the arrow array of the field data, encoded the same as the insert binlog payload.
+func buildParquetColumn(field *schemapb.FieldSchema, fieldData FieldData) (arrow.Array, error) {
+	dim := 0
+	if typeutil.IsVectorType(field.GetDataType()) && !typeutil.IsSparseVectorType(field.GetDataType()) {
+		var err error
+		if dim, err = GetDimFromParams(field.GetTypeParams()); err != nil {
+			return nil, err
+		}
+	}
+	var writer PayloadWriterInterface
+	var err error
+	if dim > 0 {
+		writer, err = newPayloadWriter(field.GetDataType(), DefaultBinlogCompression, dim)
+	} else {
+		writer, err = newPayloadWriter(field.GetDataType(), DefaultBinlogCompression)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer writer.Close()
+
+	if sparse, ok := fieldData.(*SparseFloatVectorFieldData); ok {
+		err = writer.AddSparseFloatVectorToPayload(sparse)
+	} else {
+		err = addPayloadData(writer, field.GetDataType(), fieldData.GetRows(), dim)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return writer.(*NativePayloadWriter).builder.NewArray(), nil
+}
+
+// ReadParquetGroup reads the rows of the fields from the parquet file of a field group,
+// the fields are matched by the parquet field ids of the columns.
+func ReadParquetGroup(ctx context.Context, data []byte, fields []*schemapb.FieldSchema) (*InsertData, error) {
+	parquetReader, err := file.NewParquetReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer parquetReader.Close()
+	arrowReader, err := pqarrow.NewFileReader(parquetReader, pqarrow.ArrowReadProperties{BatchSize: 1024}, memory.DefaultAllocator)
+	if err != nil {
+		return nil, err
+	}
+	arrowSchema, err := arrowReader.Schema()
+	if err != nil {
+		return nil, err
+	}
+
+	columnOfField := make(map[int64]int, len(arrowSchema.Fields()))
+	for i, field := range arrowSchema.Fields() {
+		if fieldID, ok := parseParquetFieldID(field); ok {
+			columnOfField[fieldID] = i
+		}
+	}
+
+	insertData := &InsertData{Data: make(map[FieldID]FieldData, len(fields))}
+	indices := make([]int, 0, len(fields))
+	for _, field := range fields {
+		column, ok := columnOfField[field.GetFieldID()]
+		if !ok {
+			return nil, merr.WrapErrFieldNotFound(field.GetFieldID(), "field not found in parquet file")
+		}
+		fieldData, err := NewFieldData(field.GetDataType(), field)
+		if err != nil {
+			return nil, err
+		}
+		insertData.Data[field.GetFieldID()] = fieldData
+		indices = append(indices, column)
+	}
+
+	rr, err := arrowReader.GetRecordReader(ctx, indices, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rr.Release()
+	// the columns of the records are in the order of the file, rather than the indices
+	fieldOfColumn := make([]*schemapb.FieldSchema, 0, len(fields))
+	for _, field := range rr.Schema().Fields() {
+		fieldID, _ := parseParquetFieldID(field)
+		schema, _ := lo.Find(fields, func(field *schemapb.FieldSchema) bool { return field.GetFieldID() == fieldID })
+		fieldOfColumn = append(fieldOfColumn, schema)
+	}
+	for rr.Next() {
+		record := rr.Record()
+		for i, field := range fieldOfColumn {
+			column := record.Column(i)
+			fieldData := insertData.Data[field.GetFieldID()]
+			for j := 0; j < column.Len(); j++ {
+				value, ok := deserializeCell(column, field.GetDataType(), j)
+				if !ok {
+					return nil, fmt.Errorf("failed to deserialize the row of field %d in parquet file", field.GetFieldID())
+				}
+				if err := fieldData.AppendRow(value); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if err := rr.Err(); err != nil && err != io.EOF {
+		return nil, err
+	}
+	return insertData, nil
+}
+
+func parseParquetFieldID(field arrow.Field) (int64, bool) {
+	value, ok := field.Metadata.GetValue(parquetFieldIDKey)
+	if !ok {
+		return 0, false
+	}
+	fieldID, err := strconv.ParseInt(value, 10, 64)
+	return fieldID, err == nil
+}
+
+// LoadParquetGroup reads the rows of the fields from the parquet files of a field group in order.
+func LoadParquetGroup(ctx context.Context, cm ChunkManager, paths []string, fields []*schemapb.FieldSchema) (*InsertData, error) {
+	insertData, err := NewInsertData(&schemapb.CollectionSchema{Fields: fields})
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		data, err := cm.Read(ctx, path)
+		if err != nil {
+			return nil, err
+		}
+		batch, err := ReadParquetGroup(ctx, data, fields)
+		if err != nil {
+			return nil, err
+		}
+		MergeInsertData(insertData, batch)
+	}
+	return insertData, nil
+}
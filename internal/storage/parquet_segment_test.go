@@ -0,0 +1,161 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+	"testing"
+
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/testutils"
+)
+
+func genParquetTestData(schema *schemapb.CollectionSchema, offset, num int) *InsertData {
+	data := &InsertData{Data: make(map[FieldID]FieldData)}
+	ids := make([]int64, 0, num)
+	strs := make([]string, 0, num)
+	jsons := make([][]byte, 0, num)
+	vectors := make([]float32, 0, num*4)
+	sparse := &SparseFloatVectorFieldData{}
+	for i := offset; i < offset+num; i++ {
+		ids = append(ids, int64(i))
+		strs = append(strs, string(rune('a'+i)))
+		jsons = append(jsons, []byte(`{"a":1}`))
+		vectors = append(vectors, float32(i), float32(i), float32(i), float32(i))
+		sparse.AppendRow(testutils.CreateSparseFloatRow([]uint32{0, uint32(i + 1)}, []float32{1.1, 0.3}))
+	}
+	for _, field := range schema.GetFields() {
+		switch field.GetDataType() {
+		case schemapb.DataType_Int64:
+			data.Data[field.GetFieldID()] = &Int64FieldData{Data: ids}
+		case schemapb.DataType_VarChar:
+			data.Data[field.GetFieldID()] = &StringFieldData{Data: strs}
+		case schemapb.DataType_JSON:
+			data.Data[field.GetFieldID()] = &JSONFieldData{Data: jsons}
+		case schemapb.DataType_FloatVector:
+			data.Data[field.GetFieldID()] = &FloatVectorFieldData{Data: vectors, Dim: 4}
+		case schemapb.DataType_SparseFloatVector:
+			data.Data[field.GetFieldID()] = sparse
+		}
+	}
+	return data
+}
+
+func TestParquetSegment(t *testing.T) {
+	ctx := context.Background()
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+		{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+		{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+		{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+		{FieldID: 101, Name: "str", DataType: schemapb.DataType_VarChar},
+		{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "4"}}},
+		{FieldID: 103, Name: "json", DataType: schemapb.DataType_JSON},
+		{FieldID: 104, Name: "sparse", DataType: schemapb.DataType_SparseFloatVector},
+	}}
+
+	groups := NewParquetFieldGroups(schema)
+	require.Len(t, groups, 3)
+	assert.Equal(t, ScalarFieldGroupID, groups[0].GroupID)
+	assert.Equal(t, []int64{common.RowIDField, common.TimeStampField, 100, 101, 103}, groups[0].FieldIDs())
+	assert.EqualValues(t, 102, groups[1].GroupID)
+	assert.Equal(t, []int64{104}, groups[2].FieldIDs())
+
+	t.Run("readable as plain parquet", func(t *testing.T) {
+		data, err := SerializeParquetGroup(groups[0], genParquetTestData(schema, 0, 10), DefaultBinlogCompression)
+		require.NoError(t, err)
+
+		parquetReader, err := file.NewParquetReader(bytes.NewReader(data))
+		require.NoError(t, err)
+		defer parquetReader.Close()
+		assert.EqualValues(t, 10, parquetReader.NumRows())
+		assert.Equal(t, "str", parquetReader.MetaData().Schema.Column(3).Name())
+		assert.EqualValues(t, 101, parquetReader.MetaData().Schema.Column(3).SchemaNode().FieldID())
+
+		arrowReader, err := pqarrow.NewFileReader(parquetReader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+		require.NoError(t, err)
+		table, err := arrowReader.ReadTable(ctx)
+		require.NoError(t, err)
+		defer table.Release()
+		assert.Equal(t, "pk", table.Schema().Field(2).Name)
+		assert.Equal(t, int64(9), table.Column(2).Data().Chunk(0).(*array.Int64).Value(9))
+	})
+
+	t.Run("read groups", func(t *testing.T) {
+		dir := t.TempDir()
+		cm := NewLocalChunkManager(RootPath(dir))
+		for _, group := range groups {
+			paths := make([]string, 0, 2)
+			for i, batch := range []*InsertData{genParquetTestData(schema, 0, 10), genParquetTestData(schema, 10, 5)} {
+				data, err := SerializeParquetGroup(group, batch, BinlogCompression{Codec: CompressionSnappy})
+				require.NoError(t, err)
+				file := path.Join(dir, fmt.Sprintf("%d_%d.parquet", group.GroupID, i))
+				require.NoError(t, cm.Write(ctx, file, data))
+				paths = append(paths, file)
+			}
+
+			insertData, err := LoadParquetGroup(ctx, cm, paths, group.Fields)
+			require.NoError(t, err)
+			expected := genParquetTestData(schema, 0, 15)
+			for _, field := range group.Fields {
+				assert.Equal(t, expected.Data[field.GetFieldID()].GetRows(), insertData.Data[field.GetFieldID()].GetRows(), field.GetName())
+			}
+		}
+	})
+
+	t.Run("subset of fields", func(t *testing.T) {
+		data, err := SerializeParquetGroup(groups[0], genParquetTestData(schema, 0, 10), DefaultBinlogCompression)
+		require.NoError(t, err)
+		// the fields in reversed order
+		fields := []*schemapb.FieldSchema{schema.GetFields()[3], schema.GetFields()[2]}
+		insertData, err := ReadParquetGroup(ctx, data, fields)
+		require.NoError(t, err)
+		assert.Len(t, insertData.Data, 2)
+		assert.Equal(t, "j", insertData.Data[101].GetRow(9))
+		assert.Equal(t, int64(9), insertData.Data[100].GetRow(9))
+
+		_, err = ReadParquetGroup(ctx, data, schema.GetFields()[4:5])
+		assert.ErrorIs(t, err, merr.ErrFieldNotFound)
+	})
+
+	t.Run("invalid data", func(t *testing.T) {
+		data := genParquetTestData(schema, 0, 10)
+		delete(data.Data, 101)
+		_, err := SerializeParquetGroup(groups[0], data, DefaultBinlogCompression)
+		assert.ErrorIs(t, err, merr.ErrFieldNotFound)
+
+		data = genParquetTestData(schema, 0, 10)
+		data.Data[101] = &StringFieldData{Data: []string{"a"}}
+		_, err = SerializeParquetGroup(groups[0], data, DefaultBinlogCompression)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		_, err = SerializeParquetGroup(groups[0], genParquetTestData(schema, 0, 0), DefaultBinlogCompression)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+}
@@ -25,8 +25,6 @@ import (
 	"github.com/apache/arrow/go/v12/arrow"
 	"github.com/apache/arrow/go/v12/arrow/array"
 	"github.com/apache/arrow/go/v12/arrow/memory"
-	"github.com/apache/arrow/go/v12/parquet"
-	"github.com/apache/arrow/go/v12/parquet/compress"
 	"github.com/apache/arrow/go/v12/parquet/pqarrow"
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
@@ -46,9 +44,16 @@ type NativePayloadWriter struct {
 	flushedRows int
 	output      *bytes.Buffer
 	releaseOnce sync.Once
+	compression BinlogCompression
+	// pageRows is the max number of rows of a row group, 0 means all the rows in one row group
+	pageRows int
 }
 
 func NewPayloadWriter(colType schemapb.DataType, dim ...int) (PayloadWriterInterface, error) {
+	return newPayloadWriter(colType, DefaultBinlogCompression, dim...)
+}
+
+func newPayloadWriter(colType schemapb.DataType, compression BinlogCompression, dim ...int) (PayloadWriterInterface, error) {
 	var arrowType arrow.DataType
 	// writer for sparse float vector doesn't require dim
 	if typeutil.IsVectorType(colType) && !typeutil.IsSparseVectorType(colType) {
@@ -69,6 +74,7 @@ func NewPayloadWriter(colType schemapb.DataType, dim ...int) (PayloadWriterInter
 		finished:    false,
 		flushedRows: 0,
 		output:      new(bytes.Buffer),
+		compression: compression,
 	}, nil
 }
 
@@ -523,14 +529,14 @@ func (w *NativePayloadWriter) FinishPayloadWriter() error {
 	table := array.NewTable(schema, []arrow.Column{column}, int64(column.Len()))
 	defer table.Release()
 
-	props := parquet.NewWriterProperties(
-		parquet.WithCompression(compress.Codecs.Zstd),
-		parquet.WithCompressionLevel(3),
-	)
+	chunkSize := int64(1024 * 1024 * 1024)
+	if w.pageRows > 0 {
+		chunkSize = int64(w.pageRows)
+	}
 	return pqarrow.WriteTable(table,
 		w.output,
-		1024*1024*1024,
-		props,
+		chunkSize,
+		w.compression.writerProperties(),
 		pqarrow.DefaultWriterProps(),
 	)
 }
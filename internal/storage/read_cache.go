@@ -0,0 +1,362 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"math/rand"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// The read cache keeps the immutable objects read from the object storage on the local disks,
+// the processes on the same host configured with the same dirs share the cache, including segcore,
+// which reads the binlogs and index files itself and caches them in the same layout.
+// The object is cached at {dir}/{hex[:2]}/{hex}, hex is the sha256 of {bucket}/{key},
+// the dir is chosen by the consistent hashing of the digest, so that adding or removing a dir
+// only moves the objects on it. The files are written to a temp file then renamed, and touched on read,
+// the least recently read ones are evicted by every process periodically if the dir is beyond the capacity.
+const (
+	readCacheVirtualNodes  = 64
+	readCacheEvictInterval = time.Minute
+	readCacheTempMaxAge    = 10 * time.Minute
+	readCacheTempInfix     = ".tmp."
+)
+
+// the objects under these paths are never overwritten, which are safe to cache.
+var readCacheablePaths = typeutil.NewSet(
+	common.SegmentInsertLogPath,
+	common.SegmentDeltaLogPath,
+	common.SegmentStatslogPath,
+	common.SegmentArtifactLogPath,
+	common.SegmentParquetLogPath,
+	common.SegmentIndexPath,
+)
+
+// readCacheRing is the consistent hash ring of the cache dirs.
+type readCacheRing struct {
+	dirs   []string
+	points []uint64
+	owners []int
+}
+
+func newReadCacheRing(dirs []string) *readCacheRing {
+	type point struct {
+		hash  uint64
+		owner int
+	}
+	points := make([]point, 0, len(dirs)*readCacheVirtualNodes)
+	for i, dir := range dirs {
+		for v := 0; v < readCacheVirtualNodes; v++ {
+			sum := sha256.Sum256([]byte(dir + "#" + strconv.Itoa(v)))
+			points = append(points, point{hash: binary.BigEndian.Uint64(sum[:8]), owner: i})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].hash < points[j].hash })
+
+	ring := &readCacheRing{dirs: dirs, points: make([]uint64, len(points)), owners: make([]int, len(points))}
+	for i, p := range points {
+		ring.points[i] = p.hash
+		ring.owners[i] = p.owner
+	}
+	return ring
+}
+
+func (r *readCacheRing) locate(digest [sha256.Size]byte) string {
+	hash := binary.BigEndian.Uint64(digest[:8])
+	i := sort.Search(len(r.points), func(i int) bool { return r.points[i] >= hash })
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.dirs[r.owners[i]]
+}
+
+type readCache struct {
+	ring     *readCacheRing
+	capacity int64
+}
+
+func newReadCache(dirs []string, capacity int64) *readCache {
+	return &readCache{ring: newReadCacheRing(dirs), capacity: capacity}
+}
+
+func (c *readCache) filePath(bucket, key string) string {
+	digest := sha256.Sum256([]byte(bucket + "/" + key))
+	name := hex.EncodeToString(digest[:])
+	return path.Join(c.ring.locate(digest), name[:2], name)
+}
+
+func (c *readCache) get(bucket, key string) ([]byte, bool) {
+	file := c.filePath(bucket, key)
+	data, err := os.ReadFile(file)
+	if err != nil {
+		metrics.PersistentDataReadCacheCounter.WithLabelValues(metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	now := time.Now()
+	_ = os.Chtimes(file, now, now)
+	metrics.PersistentDataReadCacheCounter.WithLabelValues(metrics.CacheHitLabel).Inc()
+	return data, true
+}
+
+// open returns the cached file if any, without counting the hit or miss.
+func (c *readCache) open(bucket, key string) (*os.File, bool) {
+	file, err := os.Open(c.filePath(bucket, key))
+	if err != nil {
+		return nil, false
+	}
+	return file, true
+}
+
+// put caches the object, the cache is best effort and the failures are ignored.
+func (c *readCache) put(bucket, key string, data []byte) {
+	file := c.filePath(bucket, key)
+	if err := os.MkdirAll(path.Dir(file), 0o755); err != nil {
+		log.Debug("failed to create read cache dir", zap.String("file", file), zap.Error(err))
+		return
+	}
+	temp := fmt.Sprintf("%s%s%d.%d", file, readCacheTempInfix, os.Getpid(), rand.Int63())
+	if err := os.WriteFile(temp, data, 0o644); err != nil {
+		log.Debug("failed to write read cache", zap.String("file", file), zap.Error(err))
+		_ = os.Remove(temp)
+		return
+	}
+	if err := os.Rename(temp, file); err != nil {
+		log.Debug("failed to write read cache", zap.String("file", file), zap.Error(err))
+		_ = os.Remove(temp)
+	}
+}
+
+func (c *readCache) remove(bucket, key string) {
+	_ = os.Remove(c.filePath(bucket, key))
+}
+
+// evict removes the least recently read files of each dir beyond the capacity, down to 90% of it,
+// and the temp files left by the crashed writers.
+func (c *readCache) evict() {
+	type entry struct {
+		path    string
+		size    int64
+		modTime time.Time
+	}
+	for _, dir := range c.ring.dirs {
+		entries := make([]entry, 0)
+		total := int64(0)
+		_ = filepath.WalkDir(dir, func(file string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil
+			}
+			info, err := d.Info()
+			if err != nil {
+				return nil
+			}
+			if strings.Contains(d.Name(), readCacheTempInfix) {
+				if time.Since(info.ModTime()) > readCacheTempMaxAge {
+					_ = os.Remove(file)
+				}
+				return nil
+			}
+			entries = append(entries, entry{path: file, size: info.Size(), modTime: info.ModTime()})
+			total += info.Size()
+			return nil
+		})
+		if total <= c.capacity {
+			continue
+		}
+
+		sort.Slice(entries, func(i, j int) bool { return entries[i].modTime.Before(entries[j].modTime) })
+		target := c.capacity / 10 * 9
+		evicted := 0
+		for _, e := range entries {
+			if total <= target {
+				break
+			}
+			if err := os.Remove(e.path); err == nil || os.IsNotExist(err) {
+				total -= e.size
+				evicted++
+			}
+		}
+		log.Info("read cache evicted", zap.String("dir", dir), zap.Int("files", evicted), zap.Int64("size", total))
+	}
+}
+
+// globalReadCache is shared by the chunk managers of all the components in the process, nil if disabled.
+var (
+	globalReadCache     = atomic.NewPointer[readCache](nil)
+	globalReadCacheOnce sync.Once
+)
+
+// initReadCache initializes the global read cache by the params and starts evicting it,
+// only the first call takes effect.
+func initReadCache(params *paramtable.ComponentParam) {
+	globalReadCacheOnce.Do(func() {
+		if !params.MinioCfg.ReadCacheEnabled.GetAsBool() {
+			return
+		}
+		dirs := lo.Filter(params.MinioCfg.ReadCacheDirs.GetAsStrings(), func(dir string, _ int) bool { return dir != "" })
+		if len(dirs) == 0 {
+			log.Warn("no dir of the read cache configured, read cache disabled")
+			return
+		}
+		cache := newReadCache(dirs, params.MinioCfg.ReadCacheCapacityGB.GetAsInt64()*1024*1024*1024)
+		globalReadCache.Store(cache)
+		go func() {
+			ticker := time.NewTicker(readCacheEvictInterval)
+			defer ticker.Stop()
+			for range ticker.C {
+				cache.evict()
+			}
+		}()
+		log.Info("read cache enabled", zap.Strings("dirs", dirs), zap.Int64("capacity", cache.capacity))
+	})
+}
+
+// CachedChunkManager reads the immutable objects through the read cache,
+// the other objects and the writes are passed through.
+type CachedChunkManager struct {
+	ChunkManager
+	cache  *readCache
+	bucket string
+}
+
+var _ ChunkManager = (*CachedChunkManager)(nil)
+
+func newCachedChunkManager(cm ChunkManager, cache *readCache, bucket string) *CachedChunkManager {
+	return &CachedChunkManager{ChunkManager: cm, cache: cache, bucket: bucket}
+}
+
+func (cm *CachedChunkManager) cacheable(filePath string) bool {
+	relative := strings.TrimLeft(strings.TrimPrefix(filePath, cm.RootPath()), "/")
+	prefix, _, ok := strings.Cut(relative, "/")
+	return ok && readCacheablePaths.Contain(prefix)
+}
+
+func (cm *CachedChunkManager) Read(ctx context.Context, filePath string) ([]byte, error) {
+	if !cm.cacheable(filePath) {
+		return cm.ChunkManager.Read(ctx, filePath)
+	}
+	if data, ok := cm.cache.get(cm.bucket, filePath); ok {
+		return data, nil
+	}
+	data, err := cm.ChunkManager.Read(ctx, filePath)
+	if err != nil {
+		return nil, err
+	}
+	cm.cache.put(cm.bucket, filePath, data)
+	return data, nil
+}
+
+func (cm *CachedChunkManager) MultiRead(ctx context.Context, filePaths []string) ([][]byte, error) {
+	contents := make([][]byte, len(filePaths))
+	missed := make([]int, 0, len(filePaths))
+	for i, filePath := range filePaths {
+		if cm.cacheable(filePath) {
+			if data, ok := cm.cache.get(cm.bucket, filePath); ok {
+				contents[i] = data
+				continue
+			}
+		}
+		missed = append(missed, i)
+	}
+	if len(missed) == 0 {
+		return contents, nil
+	}
+
+	missedPaths := lo.Map(missed, func(i int, _ int) string { return filePaths[i] })
+	datas, err := cm.ChunkManager.MultiRead(ctx, missedPaths)
+	if err != nil {
+		return nil, err
+	}
+	for j, i := range missed {
+		contents[i] = datas[j]
+		if cm.cacheable(filePaths[i]) {
+			cm.cache.put(cm.bucket, filePaths[i], datas[j])
+		}
+	}
+	return contents, nil
+}
+
+// Reader returns the cached file if any, the object isn't cached on miss as it may not be read all.
+func (cm *CachedChunkManager) Reader(ctx context.Context, filePath string) (FileReader, error) {
+	if cm.cacheable(filePath) {
+		if file, ok := cm.cache.open(cm.bucket, filePath); ok {
+			return file, nil
+		}
+	}
+	return cm.ChunkManager.Reader(ctx, filePath)
+}
+
+func (cm *CachedChunkManager) ReadAt(ctx context.Context, filePath string, off int64, length int64) ([]byte, error) {
+	if off < 0 || length < 0 || !cm.cacheable(filePath) {
+		return cm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	file, ok := cm.cache.open(cm.bucket, filePath)
+	if !ok {
+		return cm.ChunkManager.ReadAt(ctx, filePath, off, length)
+	}
+	defer file.Close()
+	data := make([]byte, length)
+	if _, err := file.ReadAt(data, off); err != nil {
+		return nil, merr.WrapErrIoFailed(filePath, err)
+	}
+	return data, nil
+}
+
+func (cm *CachedChunkManager) Size(ctx context.Context, filePath string) (int64, error) {
+	if cm.cacheable(filePath) {
+		if info, err := os.Stat(cm.cache.filePath(cm.bucket, filePath)); err == nil {
+			return info.Size(), nil
+		}
+	}
+	return cm.ChunkManager.Size(ctx, filePath)
+}
+
+func (cm *CachedChunkManager) Remove(ctx context.Context, filePath string) error {
+	cm.cache.remove(cm.bucket, filePath)
+	return cm.ChunkManager.Remove(ctx, filePath)
+}
+
+// MultiRemove removes the objects and their cached files,
+// the cached files of the objects removed by prefix are left to evict as they are never read again.
+func (cm *CachedChunkManager) MultiRemove(ctx context.Context, filePaths []string) error {
+	for _, filePath := range filePaths {
+		cm.cache.remove(cm.bucket, filePath)
+	}
+	return cm.ChunkManager.MultiRemove(ctx, filePaths)
+}
@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestReadCacheRing(t *testing.T) {
+	dirs := []string{"/disk1", "/disk2", "/disk3"}
+	ring := newReadCacheRing(dirs)
+	grown := newReadCacheRing(append(dirs, "/disk4"))
+
+	counts := make(map[string]int)
+	moved := 0
+	for i := 0; i < 10000; i++ {
+		digest := sha256.Sum256([]byte(fmt.Sprintf("key-%d", i)))
+		dir := ring.locate(digest)
+		counts[dir]++
+		if newDir := grown.locate(digest); newDir != dir {
+			assert.Equal(t, "/disk4", newDir)
+			moved++
+		}
+	}
+	for _, dir := range dirs {
+		assert.Greater(t, counts[dir], 2000, dir)
+	}
+	// about a quarter of the keys move to the new dir
+	assert.Greater(t, moved, 1500)
+	assert.Less(t, moved, 3500)
+}
+
+func TestCachedChunkManager(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	cacheDirs := []string{t.TempDir(), t.TempDir()}
+	local := NewLocalChunkManager(RootPath(root))
+	cache := newReadCache(cacheDirs, 1024)
+	cm := newCachedChunkManager(local, cache, "bucket")
+	other := newCachedChunkManager(local, cache, "bucket")
+
+	insertLog := path.Join(root, common.SegmentInsertLogPath, "1", "2", "3", "100", "1")
+	indexFile := path.Join(root, common.SegmentIndexPath, "1000", "1", "2", "3", "index")
+	meta := path.Join(root, "snapshots", "1")
+	for _, file := range []string{insertLog, indexFile, meta} {
+		require.NoError(t, cm.Write(ctx, file, []byte(file)))
+	}
+
+	data, err := cm.Read(ctx, insertLog)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(insertLog), data)
+	datas, err := cm.MultiRead(ctx, []string{indexFile, meta})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte(indexFile), []byte(meta)}, datas)
+
+	// served from the cache by the other chunk managers, once the objects gone
+	require.NoError(t, os.Remove(insertLog))
+	require.NoError(t, os.Remove(indexFile))
+	data, err = other.Read(ctx, insertLog)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(insertLog), data)
+	datas, err = other.MultiRead(ctx, []string{insertLog, indexFile})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte(insertLog), []byte(indexFile)}, datas)
+	size, err := other.Size(ctx, indexFile)
+	require.NoError(t, err)
+	assert.EqualValues(t, len(indexFile), size)
+	data, err = other.ReadAt(ctx, indexFile, 2, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(indexFile[2:5]), data)
+	reader, err := other.Reader(ctx, insertLog)
+	require.NoError(t, err)
+	data, err = io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(insertLog), data)
+	require.NoError(t, reader.Close())
+
+	// the mutable objects aren't cached
+	require.NoError(t, os.Remove(meta))
+	_, err = other.Read(ctx, meta)
+	assert.Error(t, err)
+
+	// removed along with the object
+	require.NoError(t, local.Write(ctx, insertLog, []byte(insertLog)))
+	require.NoError(t, cm.Remove(ctx, insertLog))
+	_, err = other.Read(ctx, insertLog)
+	assert.Error(t, err)
+
+	t.Run("evict", func(t *testing.T) {
+		dir := t.TempDir()
+		cache := newReadCache([]string{dir}, 1000)
+		for i := 0; i < 5; i++ {
+			key := fmt.Sprintf("key-%d", i)
+			cache.put("bucket", key, make([]byte, 300))
+			past := time.Now().Add(time.Duration(i-10) * time.Minute)
+			require.NoError(t, os.Chtimes(cache.filePath("bucket", key), past, past))
+		}
+		// read recently
+		_, ok := cache.get("bucket", "key-0")
+		assert.True(t, ok)
+		stale := path.Join(dir, "00", "file"+readCacheTempInfix+"1.1")
+		require.NoError(t, os.MkdirAll(path.Dir(stale), 0o755))
+		require.NoError(t, os.WriteFile(stale, []byte{1}, 0o644))
+		past := time.Now().Add(-time.Hour)
+		require.NoError(t, os.Chtimes(stale, past, past))
+
+		cache.evict()
+		for i, cached := range []bool{true, false, false, true, true} {
+			_, err := os.Stat(cache.filePath("bucket", fmt.Sprintf("key-%d", i)))
+			assert.Equal(t, cached, err == nil, i)
+		}
+		_, err := os.Stat(stale)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"net/http"
 	"strings"
 	"time"
 
@@ -68,9 +69,12 @@ var _ ChunkManager = (*RemoteChunkManager)(nil)
 func NewRemoteChunkManager(ctx context.Context, c *config) (*RemoteChunkManager, error) {
 	var client ObjectStorage
 	var err error
-	if c.cloudProvider == CloudProviderAzure {
+	switch {
+	case c.cloudProvider == CloudProviderAzure:
 		client, err = newAzureObjectStorageWithConfig(ctx, c)
-	} else {
+	case c.cloudProvider == CloudProviderGCP && c.useNativeClient:
+		client, err = newGcpObjectStorageWithConfig(ctx, c)
+	default:
 		client, err = newMinioObjectStorageWithConfig(ctx, c)
 	}
 	if err != nil {
@@ -330,15 +334,22 @@ func (mcm *RemoteChunkManager) getObject(ctx context.Context, bucketName, object
 		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataGetLabel, metrics.SuccessLabel).Inc()
 	} else {
 		metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataGetLabel, metrics.FailLabel).Inc()
+		return reader, err
 	}
 
-	return reader, err
+	// the object is requested lazily by the first read, which is made within the budget
+	return newBudgetedReader(ctx, objectName, reader), nil
 }
 
 func (mcm *RemoteChunkManager) putObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) error {
+	done, err := getRequestBudget().acquire(ctx, metrics.DataPutLabel, objectName)
+	if err != nil {
+		return err
+	}
 	start := timerecord.NewTimeRecorder("putObject")
 
-	err := mcm.client.PutObject(ctx, bucketName, objectName, reader, objectSize)
+	err = mcm.client.PutObject(ctx, bucketName, objectName, reader, objectSize)
+	done(err)
 	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataPutLabel, metrics.TotalLabel).Inc()
 	if err == nil {
 		metrics.PersistentDataRequestLatency.WithLabelValues(metrics.DataPutLabel).
@@ -352,9 +363,14 @@ func (mcm *RemoteChunkManager) putObject(ctx context.Context, bucketName, object
 }
 
 func (mcm *RemoteChunkManager) getObjectSize(ctx context.Context, bucketName, objectName string) (int64, error) {
+	done, err := getRequestBudget().acquire(ctx, metrics.DataStatLabel, objectName)
+	if err != nil {
+		return 0, err
+	}
 	start := timerecord.NewTimeRecorder("getObjectSize")
 
 	info, err := mcm.client.StatObject(ctx, bucketName, objectName)
+	done(err)
 	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataStatLabel, metrics.TotalLabel).Inc()
 	if err == nil {
 		metrics.PersistentDataRequestLatency.WithLabelValues(metrics.DataStatLabel).
@@ -368,9 +384,14 @@ func (mcm *RemoteChunkManager) getObjectSize(ctx context.Context, bucketName, ob
 }
 
 func (mcm *RemoteChunkManager) listObjects(ctx context.Context, bucketName string, prefix string, recursive bool) ([]string, []time.Time, error) {
+	done, err := getRequestBudget().acquire(ctx, metrics.DataListLabel, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
 	start := timerecord.NewTimeRecorder("listObjects")
 
 	blobNames, lastModifiedTime, err := mcm.client.ListObjects(ctx, bucketName, prefix, recursive)
+	done(err)
 	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataListLabel, metrics.TotalLabel).Inc()
 	if err == nil {
 		metrics.PersistentDataRequestLatency.WithLabelValues(metrics.DataListLabel).
@@ -384,9 +405,14 @@ func (mcm *RemoteChunkManager) listObjects(ctx context.Context, bucketName strin
 }
 
 func (mcm *RemoteChunkManager) removeObject(ctx context.Context, bucketName, objectName string) error {
+	done, err := getRequestBudget().acquire(ctx, metrics.DataRemoveLabel, objectName)
+	if err != nil {
+		return err
+	}
 	start := timerecord.NewTimeRecorder("removeObject")
 
-	err := mcm.client.RemoveObject(ctx, bucketName, objectName)
+	err = mcm.client.RemoveObject(ctx, bucketName, objectName)
+	done(err)
 	metrics.PersistentDataOpCounter.WithLabelValues(metrics.DataRemoveLabel, metrics.TotalLabel).Inc()
 	if err == nil {
 		metrics.PersistentDataRequestLatency.WithLabelValues(metrics.DataRemoveLabel).
@@ -403,17 +429,35 @@ func checkObjectStorageError(fileName string, err error) error {
 	if err == nil {
 		return nil
 	}
+	// the request shed or throttled already
+	if errors.Is(err, merr.ErrIoThrottled) {
+		return err
+	}
 
 	switch err := err.(type) {
 	case *azcore.ResponseError:
 		if err.ErrorCode == string(bloberror.BlobNotFound) {
 			return merr.WrapErrIoKeyNotFound(fileName, err.Error())
 		}
+		if err.ErrorCode == string(bloberror.ServerBusy) || isThrottledStatusCode(err.StatusCode) {
+			return merr.WrapErrIoThrottled(fileName, err)
+		}
+		return merr.WrapErrIoFailed(fileName, err)
+	case *GcpResponseError:
+		if err.StatusCode == http.StatusNotFound {
+			return merr.WrapErrIoKeyNotFound(fileName, err.Error())
+		}
+		if isThrottledStatusCode(err.StatusCode) {
+			return merr.WrapErrIoThrottled(fileName, err)
+		}
 		return merr.WrapErrIoFailed(fileName, err)
 	case minio.ErrorResponse:
 		if err.Code == "NoSuchKey" {
 			return merr.WrapErrIoKeyNotFound(fileName, err.Error())
 		}
+		if err.Code == "SlowDown" || isThrottledStatusCode(err.StatusCode) {
+			return merr.WrapErrIoThrottled(fileName, err)
+		}
 		return merr.WrapErrIoFailed(fileName, err)
 	}
 	if err == io.ErrUnexpectedEOF {
@@ -421,3 +465,8 @@ func checkObjectStorageError(fileName string, err error) error {
 	}
 	return merr.WrapErrIoFailed(fileName, err)
 }
+
+// isThrottledStatusCode returns whether the object storage is overloaded and asks the client to slow down.
+func isThrottledStatusCode(statusCode int) bool {
+	return statusCode == http.StatusServiceUnavailable || statusCode == http.StatusTooManyRequests
+}
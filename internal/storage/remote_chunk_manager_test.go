@@ -18,10 +18,15 @@ package storage
 
 import (
 	"context"
+	"io"
+	"net/http"
 	"path"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
 	"github.com/cockroachdb/errors"
+	minio "github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -972,3 +977,17 @@ func TestAzureChunkManager(t *testing.T) {
 		assert.True(t, errors.Is(err, merr.ErrIoKeyNotFound))
 	})
 }
+
+func TestCheckObjectStorageError(t *testing.T) {
+	assert.NoError(t, checkObjectStorageError("a", nil))
+	assert.ErrorIs(t, checkObjectStorageError("a", minio.ErrorResponse{Code: "NoSuchKey"}), merr.ErrIoKeyNotFound)
+	assert.ErrorIs(t, checkObjectStorageError("a", minio.ErrorResponse{Code: "SlowDown"}), merr.ErrIoThrottled)
+	assert.ErrorIs(t, checkObjectStorageError("a", minio.ErrorResponse{StatusCode: http.StatusServiceUnavailable}), merr.ErrIoThrottled)
+	assert.ErrorIs(t, checkObjectStorageError("a", minio.ErrorResponse{StatusCode: http.StatusInternalServerError}), merr.ErrIoFailed)
+	assert.ErrorIs(t, checkObjectStorageError("a", &GcpResponseError{StatusCode: http.StatusNotFound}), merr.ErrIoKeyNotFound)
+	assert.ErrorIs(t, checkObjectStorageError("a", &GcpResponseError{StatusCode: http.StatusTooManyRequests}), merr.ErrIoThrottled)
+	assert.ErrorIs(t, checkObjectStorageError("a", &GcpResponseError{StatusCode: http.StatusForbidden}), merr.ErrIoFailed)
+	assert.ErrorIs(t, checkObjectStorageError("a", &azcore.ResponseError{ErrorCode: string(bloberror.ServerBusy)}), merr.ErrIoThrottled)
+	assert.ErrorIs(t, checkObjectStorageError("a", &azcore.ResponseError{StatusCode: http.StatusTooManyRequests}), merr.ErrIoThrottled)
+	assert.ErrorIs(t, checkObjectStorageError("a", io.ErrUnexpectedEOF), merr.ErrIoUnexpectEOF)
+}
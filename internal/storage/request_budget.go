@@ -0,0 +1,270 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+	"golang.org/x/sync/semaphore"
+	"golang.org/x/time/rate"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// RequestClass classifies the requests to the object storage,
+// the non-critical ones are shed first when the object storage throttles.
+type RequestClass string
+
+const (
+	RequestClassCritical RequestClass = "critical"
+	RequestClassGC       RequestClass = "gc"
+	RequestClassStats    RequestClass = "stats"
+)
+
+func (c RequestClass) critical() bool {
+	return c != RequestClassGC && c != RequestClassStats
+}
+
+type requestClassKey struct{}
+
+// WithRequestClass returns the context carrying the request class of the object storage requests made with it.
+func WithRequestClass(ctx context.Context, class RequestClass) context.Context {
+	return context.WithValue(ctx, requestClassKey{}, class)
+}
+
+// GetRequestClass returns the request class carried by the context, critical if not set.
+func GetRequestClass(ctx context.Context) RequestClass {
+	if class, ok := ctx.Value(requestClassKey{}).(RequestClass); ok {
+		return class
+	}
+	return RequestClassCritical
+}
+
+var errRequestShed = errors.New("non-critical request shed since the object storage is throttling")
+
+// circuitBreaker opens if the object storage throttles too many requests within the window,
+// and keeps open for the cooldown after the last time it's tripped.
+type circuitBreaker struct {
+	mu          sync.Mutex
+	threshold   int
+	window      time.Duration
+	cooldown    time.Duration
+	windowStart time.Time
+	throttled   int
+	openUntil   time.Time
+}
+
+func newCircuitBreaker(threshold int, window time.Duration, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		window:    window,
+		cooldown:  cooldown,
+	}
+}
+
+func (cb *circuitBreaker) onThrottled(now time.Time) {
+	if cb.threshold <= 0 {
+		return
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if now.Sub(cb.windowStart) > cb.window {
+		cb.windowStart = now
+		cb.throttled = 0
+	}
+	cb.throttled++
+	if cb.throttled < cb.threshold {
+		return
+	}
+	if !now.Before(cb.openUntil) {
+		log.Warn("object storage circuit breaker opened, shed the non-critical requests",
+			zap.Int("throttled", cb.throttled), zap.Duration("cooldown", cb.cooldown))
+	}
+	cb.openUntil = now.Add(cb.cooldown)
+	cb.windowStart = now
+	cb.throttled = 0
+	metrics.PersistentDataCircuitBreakerOpen.Set(1)
+}
+
+func (cb *circuitBreaker) isOpen(now time.Time) bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if now.Before(cb.openUntil) {
+		return true
+	}
+	metrics.PersistentDataCircuitBreakerOpen.Set(0)
+	return false
+}
+
+// requestBudget limits the rate and the concurrency of the requests to the object storage,
+// and sheds the non-critical requests by the circuit breaker.
+type requestBudget struct {
+	limiter *rate.Limiter
+	sem     *semaphore.Weighted
+	breaker *circuitBreaker
+}
+
+func newRequestBudget(maxRate float64, maxConcurrency int64, breaker *circuitBreaker) *requestBudget {
+	b := &requestBudget{breaker: breaker}
+	if maxRate > 0 {
+		b.limiter = rate.NewLimiter(rate.Limit(maxRate), int(math.Max(1, maxRate)))
+	}
+	if maxConcurrency > 0 {
+		b.sem = semaphore.NewWeighted(maxConcurrency)
+	}
+	return b
+}
+
+// acquire waits for the budget of the request, the returned done must be called with the result of the request.
+func (b *requestBudget) acquire(ctx context.Context, op string, key string) (func(error), error) {
+	class := GetRequestClass(ctx)
+	metrics.PersistentDataClassOpCounter.WithLabelValues(op, string(class), metrics.TotalLabel).Inc()
+	if !class.critical() && b.breaker.isOpen(time.Now()) {
+		metrics.PersistentDataClassOpCounter.WithLabelValues(op, string(class), metrics.ShedLabel).Inc()
+		return nil, merr.WrapErrIoThrottled(key, errRequestShed)
+	}
+
+	start := time.Now()
+	if b.limiter != nil {
+		if err := b.limiter.Wait(ctx); err != nil {
+			metrics.PersistentDataClassOpCounter.WithLabelValues(op, string(class), metrics.FailLabel).Inc()
+			return nil, err
+		}
+	}
+	if b.sem != nil {
+		if err := b.sem.Acquire(ctx, 1); err != nil {
+			metrics.PersistentDataClassOpCounter.WithLabelValues(op, string(class), metrics.FailLabel).Inc()
+			return nil, err
+		}
+	}
+	metrics.PersistentDataBudgetWaitLatency.WithLabelValues(string(class)).Observe(float64(time.Since(start).Milliseconds()))
+
+	return func(err error) {
+		if b.sem != nil {
+			b.sem.Release(1)
+		}
+		if err != nil {
+			metrics.PersistentDataClassOpCounter.WithLabelValues(op, string(class), metrics.FailLabel).Inc()
+			if errors.Is(err, merr.ErrIoThrottled) {
+				b.breaker.onThrottled(time.Now())
+			}
+			return
+		}
+		metrics.PersistentDataClassOpCounter.WithLabelValues(op, string(class), metrics.SuccessLabel).Inc()
+	}, nil
+}
+
+// AcquireRequestBudget waits for the budget of the critical request made out of the chunk managers, e.g. by segcore,
+// the returned done must be called with the result of the request.
+func AcquireRequestBudget(op string) (func(error), error) {
+	return getRequestBudget().acquire(context.Background(), op, "")
+}
+
+// budgetedReader acquires the budget by the first read, which issues the request of the lazy object reader,
+// and releases it with the result of the request once the first read returns.
+type budgetedReader struct {
+	FileReader
+	ctx        context.Context
+	objectName string
+
+	mu     sync.Mutex
+	issued bool
+}
+
+func newBudgetedReader(ctx context.Context, objectName string, reader FileReader) *budgetedReader {
+	return &budgetedReader{
+		FileReader: reader,
+		ctx:        ctx,
+		objectName: objectName,
+	}
+}
+
+func (r *budgetedReader) issue(read func() error) error {
+	r.mu.Lock()
+	if r.issued {
+		r.mu.Unlock()
+		return read()
+	}
+	defer r.mu.Unlock()
+	done, err := getRequestBudget().acquire(r.ctx, metrics.DataGetLabel, r.objectName)
+	if err != nil {
+		return err
+	}
+	r.issued = true
+	err = read()
+	if errors.Is(err, io.EOF) {
+		done(nil)
+	} else {
+		done(checkObjectStorageError(r.objectName, err))
+	}
+	return err
+}
+
+func (r *budgetedReader) Read(p []byte) (int, error) {
+	var n int
+	err := r.issue(func() error {
+		var err error
+		n, err = r.FileReader.Read(p)
+		return err
+	})
+	return n, err
+}
+
+func (r *budgetedReader) ReadAt(p []byte, off int64) (int, error) {
+	var n int
+	err := r.issue(func() error {
+		var err error
+		n, err = r.FileReader.ReadAt(p, off)
+		return err
+	})
+	return n, err
+}
+
+// globalRequestBudget is shared by the chunk managers of all the components in the process.
+var (
+	globalRequestBudget     = atomic.NewPointer(newRequestBudget(0, 0, newCircuitBreaker(0, 0, 0)))
+	globalRequestBudgetOnce sync.Once
+)
+
+// InitRequestBudget initializes the global request budget by the params, only the first call takes effect.
+func InitRequestBudget(params *paramtable.ComponentParam) {
+	globalRequestBudgetOnce.Do(func() {
+		globalRequestBudget.Store(newRequestBudget(
+			params.MinioCfg.RequestBudgetRate.GetAsFloat(),
+			params.MinioCfg.RequestBudgetConcurrency.GetAsInt64(),
+			newCircuitBreaker(
+				params.MinioCfg.CircuitBreakerThreshold.GetAsInt(),
+				params.MinioCfg.CircuitBreakerWindow.GetAsDuration(time.Second),
+				params.MinioCfg.CircuitBreakerCooldown.GetAsDuration(time.Second),
+			),
+		))
+	})
+}
+
+func getRequestBudget() *requestBudget {
+	return globalRequestBudget.Load()
+}
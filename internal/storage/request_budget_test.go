@@ -0,0 +1,167 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestRequestClass(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, RequestClassCritical, GetRequestClass(ctx))
+	assert.Equal(t, RequestClassGC, GetRequestClass(WithRequestClass(ctx, RequestClassGC)))
+	assert.True(t, RequestClassCritical.critical())
+	assert.False(t, RequestClassGC.critical())
+	assert.False(t, RequestClassStats.critical())
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+
+	t.Run("disabled", func(t *testing.T) {
+		cb := newCircuitBreaker(0, time.Second, time.Second)
+		cb.onThrottled(now)
+		assert.False(t, cb.isOpen(now))
+	})
+
+	t.Run("open and cooldown", func(t *testing.T) {
+		cb := newCircuitBreaker(2, time.Second, time.Second)
+		cb.onThrottled(now)
+		assert.False(t, cb.isOpen(now))
+		cb.onThrottled(now.Add(500 * time.Millisecond))
+		assert.True(t, cb.isOpen(now.Add(time.Second)))
+		assert.False(t, cb.isOpen(now.Add(2*time.Second)))
+	})
+
+	t.Run("out of window", func(t *testing.T) {
+		cb := newCircuitBreaker(2, time.Second, time.Second)
+		cb.onThrottled(now)
+		cb.onThrottled(now.Add(2 * time.Second))
+		assert.False(t, cb.isOpen(now.Add(2*time.Second)))
+	})
+}
+
+func TestRequestBudget(t *testing.T) {
+	ctx := context.Background()
+	gcCtx := WithRequestClass(ctx, RequestClassGC)
+
+	t.Run("concurrency", func(t *testing.T) {
+		b := newRequestBudget(0, 1, newCircuitBreaker(0, 0, 0))
+		done, err := b.acquire(ctx, metrics.DataGetLabel, "a")
+		assert.NoError(t, err)
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err = b.acquire(timeoutCtx, metrics.DataGetLabel, "b")
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		done(nil)
+		done, err = b.acquire(ctx, metrics.DataGetLabel, "b")
+		assert.NoError(t, err)
+		done(nil)
+	})
+
+	t.Run("rate", func(t *testing.T) {
+		b := newRequestBudget(1, 0, newCircuitBreaker(0, 0, 0))
+		done, err := b.acquire(ctx, metrics.DataGetLabel, "a")
+		assert.NoError(t, err)
+		done(nil)
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		_, err = b.acquire(timeoutCtx, metrics.DataGetLabel, "b")
+		assert.Error(t, err)
+	})
+
+	t.Run("shed non-critical", func(t *testing.T) {
+		b := newRequestBudget(0, 0, newCircuitBreaker(1, time.Minute, time.Minute))
+		done, err := b.acquire(gcCtx, metrics.DataListLabel, "a")
+		assert.NoError(t, err)
+		done(merr.WrapErrIoFailed("a", errors.New("mock")))
+		_, err = b.acquire(gcCtx, metrics.DataListLabel, "a")
+		assert.NoError(t, err)
+
+		done, err = b.acquire(ctx, metrics.DataGetLabel, "a")
+		assert.NoError(t, err)
+		done(merr.WrapErrIoThrottled("a", errors.New("slow down")))
+
+		_, err = b.acquire(gcCtx, metrics.DataListLabel, "a")
+		assert.ErrorIs(t, err, merr.ErrIoThrottled)
+		done, err = b.acquire(ctx, metrics.DataGetLabel, "a")
+		assert.NoError(t, err)
+		done(nil)
+	})
+}
+
+func TestRemoteChunkManagerRequestBudget(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeGcs()
+	fake.buckets["budget-bucket"] = make(map[string][]byte)
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	cm, err := NewRemoteChunkManager(ctx, &config{
+		address:            strings.TrimPrefix(server.URL, "http://"),
+		bucketName:         "budget-bucket",
+		cloudProvider:      CloudProviderGCP,
+		useNativeClient:    true,
+		multipartThreshold: 1024,
+	})
+	require.NoError(t, err)
+
+	origin := getRequestBudget()
+	defer globalRequestBudget.Store(origin)
+	globalRequestBudget.Store(newRequestBudget(0, 0, newCircuitBreaker(1, time.Minute, time.Minute)))
+
+	assert.NoError(t, cm.Write(ctx, "a", []byte("a")))
+	fake.failures = 1
+	assert.ErrorIs(t, cm.Write(ctx, "b", []byte("b")), merr.ErrIoThrottled)
+
+	// the gc requests are shed without reaching the object storage
+	gcCtx := WithRequestClass(ctx, RequestClassGC)
+	_, _, err = cm.ListWithPrefix(gcCtx, "", true)
+	assert.ErrorIs(t, err, merr.ErrIoThrottled)
+	assert.ErrorIs(t, cm.Remove(gcCtx, "a"), merr.ErrIoThrottled)
+	exist, err := cm.Exist(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, exist)
+
+	// the object is requested lazily by the first read within the budget, which observes the throttling
+	globalRequestBudget.Store(newRequestBudget(0, 1, newCircuitBreaker(1, time.Minute, time.Minute)))
+	data, err := cm.Read(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("a"), data)
+	reader, err := cm.Reader(ctx, "a")
+	require.NoError(t, err)
+	defer reader.Close()
+	fake.failures = 1
+	_, err = reader.Read(make([]byte, 1))
+	assert.Error(t, err)
+	_, _, err = cm.ListWithPrefix(gcCtx, "", true)
+	assert.ErrorIs(t, err, merr.ErrIoThrottled)
+}
@@ -0,0 +1,127 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"github.com/bits-and-blooms/bloom/v3"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// ScalarBloomFilterArtifact is the name of the artifact logs storing the bloom filters of the scalar fields,
+// which are kept in the object storage rather than the segment meta as they are large.
+const ScalarBloomFilterArtifact = "scalar_bloom_filter"
+
+// ScalarStatsCollector collects the min/max, the optional bloom filter and cardinality sketch of the scalar
+// fields of the segment, the primary key field is excluded as it has the pk stats already.
+type ScalarStatsCollector struct {
+	stats []*FieldStats
+	// nullCounts is the number of rows without value of the field, fieldID -> count
+	nullCounts map[int64]int64
+}
+
+func NewScalarStatsCollector(schema *schemapb.CollectionSchema, withBF bool, withSketch bool) *ScalarStatsCollector {
+	c := &ScalarStatsCollector{nullCounts: make(map[int64]int64)}
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || field.GetIsPrimaryKey() ||
+			!IsScalarStatsSupported(field.GetDataType()) {
+			continue
+		}
+		stats := NewScalarFieldStats(field.GetFieldID(), field.GetDataType(), withBF)
+		if withSketch {
+			stats.Sketch = NewHyperLogLog()
+		}
+		c.stats = append(c.stats, stats)
+	}
+	return c
+}
+
+// Update updates the stats by the field data of the insert data,
+// the rows without the field data are counted as null.
+func (c *ScalarStatsCollector) Update(data *InsertData) {
+	rowNum := 0
+	if tsData, ok := data.Data[common.TimeStampField]; ok {
+		rowNum = tsData.RowNum()
+	}
+	for _, stats := range c.stats {
+		fieldRows := 0
+		if fieldData, ok := data.Data[stats.FieldID]; ok && fieldData.RowNum() > 0 {
+			stats.UpdateByMsgs(fieldData)
+			fieldRows = fieldData.RowNum()
+		}
+		if rowNum > fieldRows {
+			c.nullCounts[stats.FieldID] += int64(rowNum - fieldRows)
+		}
+	}
+}
+
+// NullCount returns the number of rows without value of the field.
+func (c *ScalarStatsCollector) NullCount(fieldID int64) int64 {
+	return c.nullCounts[fieldID]
+}
+
+// Stats returns the stats of the fields with any value collected.
+func (c *ScalarStatsCollector) Stats() []*FieldStats {
+	result := make([]*FieldStats, 0, len(c.stats))
+	for _, stats := range c.stats {
+		if stats.Min != nil {
+			result = append(result, stats)
+		}
+	}
+	return result
+}
+
+// BloomFilterArtifacts returns the bloom filters of the stats as the artifacts to store.
+func (c *ScalarStatsCollector) BloomFilterArtifacts() ([]*StatsArtifact, error) {
+	var artifacts []*StatsArtifact
+	for _, stats := range c.Stats() {
+		if stats.BF == nil {
+			continue
+		}
+		value, err := stats.BF.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		artifacts = append(artifacts, &StatsArtifact{
+			FieldID: stats.FieldID,
+			Name:    ScalarBloomFilterArtifact,
+			Value:   value,
+		})
+	}
+	return artifacts, nil
+}
+
+// MergeScalarBloomFilters merges the bloom filters of the scalar field stored in the artifacts,
+// which are of the same size as created by NewScalarFieldStats.
+func MergeScalarBloomFilters(artifacts [][]byte) (*bloom.BloomFilter, error) {
+	var merged *bloom.BloomFilter
+	for _, artifact := range artifacts {
+		bf := &bloom.BloomFilter{}
+		if err := bf.UnmarshalJSON(artifact); err != nil {
+			return nil, err
+		}
+		if merged == nil {
+			merged = bf
+			continue
+		}
+		if err := merged.Merge(bf); err != nil {
+			return nil, err
+		}
+	}
+	return merged, nil
+}
@@ -0,0 +1,121 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestScalarStatsCollector(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, DataType: schemapb.DataType_Int32},
+			{FieldID: 102, DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, DataType: schemapb.DataType_Bool},
+			{FieldID: 104, DataType: schemapb.DataType_Double},
+		},
+	}
+
+	collector := NewScalarStatsCollector(schema, true, true)
+	collector.Update(&InsertData{Data: map[FieldID]FieldData{
+		common.TimeStampField: &Int64FieldData{Data: []int64{1, 1, 1}},
+		100:                   &Int64FieldData{Data: []int64{1, 2, 3}},
+		101:                   &Int32FieldData{Data: []int32{5, -1, 3}},
+		102:                   &StringFieldData{Data: []string{"b", "c", "a"}},
+		103:                   &BoolFieldData{Data: []bool{true, false, true}},
+	}})
+	collector.Update(&InsertData{Data: map[FieldID]FieldData{
+		common.TimeStampField: &Int64FieldData{Data: []int64{2}},
+		101:                   &Int32FieldData{Data: []int32{10}},
+		102:                   &StringFieldData{Data: []string{"d"}},
+	}})
+
+	stats := collector.Stats()
+	assert.Len(t, stats, 2)
+	assert.EqualValues(t, 101, stats[0].FieldID)
+	assert.Equal(t, int32(-1), stats[0].Min.GetValue())
+	assert.Equal(t, int32(10), stats[0].Max.GetValue())
+	assert.EqualValues(t, 102, stats[1].FieldID)
+	assert.Equal(t, "a", stats[1].Min.GetValue())
+	assert.Equal(t, "d", stats[1].Max.GetValue())
+	assert.True(t, stats[1].BF.TestString("c"))
+	assert.True(t, stats[1].TestString("c"))
+	assert.False(t, stats[1].TestString("e"))
+	assert.True(t, stats[0].TestInt64(-1))
+	assert.False(t, stats[0].TestInt64(4))
+	assert.EqualValues(t, 4, stats[1].Sketch.Count())
+	assert.EqualValues(t, 0, collector.NullCount(101))
+	assert.EqualValues(t, 4, collector.NullCount(104))
+
+	// the bloom filters are stored as artifacts
+	artifacts, err := collector.BloomFilterArtifacts()
+	assert.NoError(t, err)
+	assert.Len(t, artifacts, 2)
+	assert.EqualValues(t, 102, artifacts[1].FieldID)
+	assert.Equal(t, ScalarBloomFilterArtifact, artifacts[1].Name)
+	other := NewScalarFieldStats(102, schemapb.DataType_VarChar, true)
+	other.UpdateByMsgs(&StringFieldData{Data: []string{"e"}})
+	otherArtifact, err := other.BF.MarshalJSON()
+	assert.NoError(t, err)
+	bf, err := MergeScalarBloomFilters([][]byte{artifacts[1].Value, otherArtifact})
+	assert.NoError(t, err)
+	assert.True(t, bf.TestString("c"))
+	assert.True(t, bf.TestString("e"))
+	_, err = MergeScalarBloomFilters([][]byte{artifacts[1].Value, []byte("invalid")})
+	assert.Error(t, err)
+
+	// serialized and merged
+	data, err := json.Marshal(stats[1])
+	assert.NoError(t, err)
+	other = &FieldStats{}
+	assert.NoError(t, json.Unmarshal(data, other))
+	other.Merge(newVarCharScalarStats("x", "z"))
+	assert.Equal(t, "a", other.Min.GetValue())
+	assert.Equal(t, "z", other.Max.GetValue())
+	assert.True(t, other.BF.TestString("x"))
+	assert.True(t, other.BF.TestString("c"))
+	assert.EqualValues(t, 6, other.Sketch.Count())
+
+	// without bloom filter
+	collector = NewScalarStatsCollector(schema, false, false)
+	collector.Update(&InsertData{Data: map[FieldID]FieldData{
+		102: &StringFieldData{Data: []string{"0"}},
+	}})
+	stats = collector.Stats()
+	assert.Len(t, stats, 1)
+	assert.Nil(t, stats[0].BF)
+	assert.Nil(t, stats[0].Sketch)
+	other.Merge(stats[0])
+	assert.Equal(t, "0", other.Min.GetValue())
+	assert.Nil(t, other.BF)
+	assert.Nil(t, other.Sketch)
+}
+
+func newVarCharScalarStats(values ...string) *FieldStats {
+	stats := NewScalarFieldStats(102, schemapb.DataType_VarChar, true)
+	stats.Sketch = NewHyperLogLog()
+	stats.UpdateByMsgs(&StringFieldData{Data: values})
+	return stats
+}
@@ -0,0 +1,192 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// StatsGenerator generates an artifact of a field from the rows synced, e.g. a sketch or a term dictionary.
+// The artifact of each sync batch is stored alongside the binlogs as an artifact log of the segment.
+type StatsGenerator interface {
+	// Update collects the field data of the rows.
+	Update(data FieldData) error
+	// Serialize returns the artifact of the rows collected, nil if there is nothing to store.
+	Serialize() ([]byte, error)
+}
+
+// StatsGeneratorFactory creates the stats generator for the field.
+type StatsGeneratorFactory func(field *schemapb.FieldSchema) (StatsGenerator, error)
+
+// StatsConsumer consumes the artifacts of the stats generator of the same name on the query nodes.
+type StatsConsumer interface {
+	// Load is called with the artifacts of the field after the sealed segment loaded,
+	// the ones loaded before shall be replaced as the segment could be loaded again.
+	Load(segmentID, fieldID int64, artifacts [][]byte) error
+	// Release is called after the segment released.
+	Release(segmentID int64)
+}
+
+var statsRegistry = struct {
+	sync.RWMutex
+	generators map[schemapb.DataType]map[string]StatsGeneratorFactory
+	consumers  map[string]StatsConsumer
+}{
+	generators: make(map[schemapb.DataType]map[string]StatsGeneratorFactory),
+	consumers:  make(map[string]StatsConsumer),
+}
+
+// RegisterStatsGenerator registers the named stats generator for the fields of the data type,
+// the name shall be unique within the data type. It is supposed to be called on init.
+func RegisterStatsGenerator(dataType schemapb.DataType, name string, factory StatsGeneratorFactory) {
+	statsRegistry.Lock()
+	defer statsRegistry.Unlock()
+	if _, ok := statsRegistry.generators[dataType][name]; ok {
+		panic(fmt.Sprintf("stats generator %s of %s registered twice", name, dataType.String()))
+	}
+	if _, ok := statsRegistry.generators[dataType]; !ok {
+		statsRegistry.generators[dataType] = make(map[string]StatsGeneratorFactory)
+	}
+	statsRegistry.generators[dataType][name] = factory
+}
+
+func UnregisterStatsGenerator(dataType schemapb.DataType, name string) {
+	statsRegistry.Lock()
+	defer statsRegistry.Unlock()
+	delete(statsRegistry.generators[dataType], name)
+}
+
+// RegisterStatsConsumer registers the consumer of the artifacts of the named stats generator.
+func RegisterStatsConsumer(name string, consumer StatsConsumer) {
+	statsRegistry.Lock()
+	defer statsRegistry.Unlock()
+	if _, ok := statsRegistry.consumers[name]; ok {
+		panic(fmt.Sprintf("stats consumer %s registered twice", name))
+	}
+	statsRegistry.consumers[name] = consumer
+}
+
+func UnregisterStatsConsumer(name string) {
+	statsRegistry.Lock()
+	defer statsRegistry.Unlock()
+	delete(statsRegistry.consumers, name)
+}
+
+func GetStatsConsumer(name string) (StatsConsumer, bool) {
+	statsRegistry.RLock()
+	defer statsRegistry.RUnlock()
+	consumer, ok := statsRegistry.consumers[name]
+	return consumer, ok
+}
+
+// GetStatsConsumers returns all the consumers registered.
+func GetStatsConsumers() []StatsConsumer {
+	statsRegistry.RLock()
+	defer statsRegistry.RUnlock()
+	return lo.Values(statsRegistry.consumers)
+}
+
+// StatsArtifact is the artifact generated for a field by the named stats generator.
+type StatsArtifact struct {
+	FieldID int64
+	Name    string
+	Value   []byte
+}
+
+type fieldStatsGenerator struct {
+	fieldID   int64
+	name      string
+	generator StatsGenerator
+}
+
+// StatsGenerators generates the artifacts of a batch of rows by the stats generators registered
+// for the fields of the collection.
+type StatsGenerators struct {
+	generators []*fieldStatsGenerator
+}
+
+func NewStatsGenerators(schema *schemapb.CollectionSchema) (*StatsGenerators, error) {
+	statsRegistry.RLock()
+	defer statsRegistry.RUnlock()
+	g := &StatsGenerators{}
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		factories := statsRegistry.generators[field.GetDataType()]
+		names := lo.Keys(factories)
+		sort.Strings(names)
+		for _, name := range names {
+			generator, err := factories[name](field)
+			if err != nil {
+				return nil, err
+			}
+			g.generators = append(g.generators, &fieldStatsGenerator{
+				fieldID:   field.GetFieldID(),
+				name:      name,
+				generator: generator,
+			})
+		}
+	}
+	return g, nil
+}
+
+// Empty returns whether there is no stats generator for the collection.
+func (g *StatsGenerators) Empty() bool {
+	return len(g.generators) == 0
+}
+
+// Update updates the generators by the field data of the insert data.
+func (g *StatsGenerators) Update(data *InsertData) error {
+	for _, generator := range g.generators {
+		fieldData, ok := data.Data[generator.fieldID]
+		if !ok || fieldData.RowNum() == 0 {
+			continue
+		}
+		if err := generator.generator.Update(fieldData); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Serialize returns the artifacts generated, the empty ones are skipped.
+func (g *StatsGenerators) Serialize() ([]*StatsArtifact, error) {
+	var artifacts []*StatsArtifact
+	for _, generator := range g.generators {
+		value, err := generator.generator.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		if len(value) == 0 {
+			continue
+		}
+		artifacts = append(artifacts, &StatsArtifact{
+			FieldID: generator.fieldID,
+			Name:    generator.name,
+			Value:   value,
+		})
+	}
+	return artifacts, nil
+}
@@ -0,0 +1,110 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// termsGenerator joins the terms of the varchar field
+type termsGenerator struct {
+	terms []string
+}
+
+func (g *termsGenerator) Update(data FieldData) error {
+	g.terms = append(g.terms, data.(*StringFieldData).Data...)
+	return nil
+}
+
+func (g *termsGenerator) Serialize() ([]byte, error) {
+	return []byte(strings.Join(g.terms, ",")), nil
+}
+
+type noopStatsConsumer struct{}
+
+func (noopStatsConsumer) Load(segmentID, fieldID int64, artifacts [][]byte) error { return nil }
+
+func (noopStatsConsumer) Release(segmentID int64) {}
+
+func TestStatsGenerators(t *testing.T) {
+	RegisterStatsGenerator(schemapb.DataType_VarChar, "terms", func(field *schemapb.FieldSchema) (StatsGenerator, error) {
+		return &termsGenerator{}, nil
+	})
+	defer UnregisterStatsGenerator(schemapb.DataType_VarChar, "terms")
+	assert.Panics(t, func() {
+		RegisterStatsGenerator(schemapb.DataType_VarChar, "terms", nil)
+	})
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, DataType: schemapb.DataType_VarChar},
+		},
+	}
+	generators, err := NewStatsGenerators(schema)
+	assert.NoError(t, err)
+	assert.False(t, generators.Empty())
+
+	err = generators.Update(&InsertData{Data: map[FieldID]FieldData{
+		100: &Int64FieldData{Data: []int64{1, 2}},
+		101: &StringFieldData{Data: []string{"a", "b"}},
+	}})
+	assert.NoError(t, err)
+	artifacts, err := generators.Serialize()
+	assert.NoError(t, err)
+	// field 102 without any row is skipped
+	assert.Len(t, artifacts, 1)
+	assert.EqualValues(t, 101, artifacts[0].FieldID)
+	assert.Equal(t, "terms", artifacts[0].Name)
+	assert.Equal(t, []byte("a,b"), artifacts[0].Value)
+
+	RegisterStatsGenerator(schemapb.DataType_VarChar, "broken", func(field *schemapb.FieldSchema) (StatsGenerator, error) {
+		return nil, errors.New("mocked")
+	})
+	_, err = NewStatsGenerators(schema)
+	assert.Error(t, err)
+	UnregisterStatsGenerator(schemapb.DataType_VarChar, "broken")
+
+	generators, err = NewStatsGenerators(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true}},
+	})
+	assert.NoError(t, err)
+	assert.True(t, generators.Empty())
+}
+
+func TestStatsConsumers(t *testing.T) {
+	RegisterStatsConsumer("terms", noopStatsConsumer{})
+	defer UnregisterStatsConsumer("terms")
+	assert.Panics(t, func() {
+		RegisterStatsConsumer("terms", noopStatsConsumer{})
+	})
+
+	_, ok := GetStatsConsumer("terms")
+	assert.True(t, ok)
+	_, ok = GetStatsConsumer("other")
+	assert.False(t, ok)
+	assert.Len(t, GetStatsConsumers(), 1)
+}
@@ -501,7 +501,11 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 	for _, field := range collSchema.Fields {
 		srcField, ok := srcFields[field.GetFieldID()]
 		if !ok && field.GetFieldID() >= common.StartOfUserFieldID {
-			return nil, merr.WrapErrFieldNotFound(field.GetFieldID(), fmt.Sprintf("field %s not found when converting insert msg to insert data", field.GetName()))
+			// the field may be added after the msg produced, fill it with default value
+			srcField, ok = defaultValueFieldData(field, int(msg.GetNumRows()))
+			if !ok {
+				return nil, merr.WrapErrFieldNotFound(field.GetFieldID(), fmt.Sprintf("field %s not found when converting insert msg to insert data", field.GetName()))
+			}
 		}
 		var fieldData FieldData
 		switch field.DataType {
@@ -666,6 +670,79 @@ func ColumnBasedInsertMsgToInsertData(msg *msgstream.InsertMsg, collSchema *sche
 	return idata, nil
 }
 
+// defaultValueFieldData generates the field data of rows with the default value of the field,
+// returns false if the field has no default value.
+func defaultValueFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, bool) {
+	defaultValue := field.GetDefaultValue()
+	if defaultValue == nil {
+		return nil, false
+	}
+	scalars := &schemapb.ScalarField{}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{
+			Data: lo.RepeatBy(numRows, func(int) bool { return defaultValue.GetBoolData() }),
+		}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{
+			Data: lo.RepeatBy(numRows, func(int) int32 { return defaultValue.GetIntData() }),
+		}}
+	case schemapb.DataType_Int64:
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{
+			Data: lo.RepeatBy(numRows, func(int) int64 { return defaultValue.GetLongData() }),
+		}}
+	case schemapb.DataType_Float:
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{
+			Data: lo.RepeatBy(numRows, func(int) float32 { return defaultValue.GetFloatData() }),
+		}}
+	case schemapb.DataType_Double:
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{
+			Data: lo.RepeatBy(numRows, func(int) float64 { return defaultValue.GetDoubleData() }),
+		}}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{
+			Data: lo.RepeatBy(numRows, func(int) string { return defaultValue.GetStringData() }),
+		}}
+	default:
+		return nil, false
+	}
+	return &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		FieldId:   field.GetFieldID(),
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}, true
+}
+
+// GetDefaultValueRow returns the default value of the field in the row format of InsertData,
+// returns false if the field has no default value.
+func GetDefaultValueRow(field *schemapb.FieldSchema) (interface{}, bool) {
+	defaultValue := field.GetDefaultValue()
+	if defaultValue == nil {
+		return nil, false
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return defaultValue.GetBoolData(), true
+	case schemapb.DataType_Int8:
+		return int8(defaultValue.GetIntData()), true
+	case schemapb.DataType_Int16:
+		return int16(defaultValue.GetIntData()), true
+	case schemapb.DataType_Int32:
+		return defaultValue.GetIntData(), true
+	case schemapb.DataType_Int64:
+		return defaultValue.GetLongData(), true
+	case schemapb.DataType_Float:
+		return defaultValue.GetFloatData(), true
+	case schemapb.DataType_Double:
+		return defaultValue.GetDoubleData(), true
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return defaultValue.GetStringData(), true
+	default:
+		return nil, false
+	}
+}
+
 func InsertMsgToInsertData(msg *msgstream.InsertMsg, schema *schemapb.CollectionSchema) (idata *InsertData, err error) {
 	if msg.IsRowBased() {
 		return RowBasedInsertMsgToInsertData(msg, schema)
@@ -1266,6 +1343,19 @@ func TransferInsertMsgToInsertRecord(schema *schemapb.CollectionSchema, msg *msg
 
 	insertRecord.FieldsData = append(insertRecord.FieldsData, msg.FieldsData...)
 
+	// fill the fields added after the msg produced with default value
+	srcFields := typeutil.NewSet(lo.Map(msg.FieldsData, func(field *schemapb.FieldData, _ int) int64 {
+		return field.GetFieldId()
+	})...)
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || srcFields.Contain(field.GetFieldID()) {
+			continue
+		}
+		if fieldData, ok := defaultValueFieldData(field, int(msg.GetNumRows())); ok {
+			insertRecord.FieldsData = append(insertRecord.FieldsData, fieldData)
+		}
+	}
+
 	return insertRecord, nil
 }
 
@@ -1106,6 +1106,62 @@ func TestColumnBasedInsertMsgToInsertData(t *testing.T) {
 	}
 }
 
+func TestColumnBasedInsertMsgToInsertDataWithAddedField(t *testing.T) {
+	numRows, fVecDim, bVecDim, f16VecDim, bf16VecDim := 2, 2, 8, 2, 2
+	schema, _, _ := genAllFieldsSchema(fVecDim, bVecDim, f16VecDim, bf16VecDim, true)
+	msg, _, _ := genColumnBasedInsertMsg(schema, numRows, fVecDim, bVecDim, f16VecDim, bf16VecDim)
+
+	// fields added after the msg produced
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:      1000,
+		Name:         "added_int64",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 7}},
+	}, &schemapb.FieldSchema{
+		FieldID:      1001,
+		Name:         "added_varchar",
+		DataType:     schemapb.DataType_VarChar,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "default"}},
+	})
+
+	idata, err := ColumnBasedInsertMsgToInsertData(msg, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{7, 7}, idata.Data[1000].(*Int64FieldData).Data)
+	assert.Equal(t, []string{"default", "default"}, idata.Data[1001].(*StringFieldData).Data)
+
+	record, err := TransferInsertMsgToInsertRecord(schema, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, len(msg.FieldsData)+2, len(record.GetFieldsData()))
+
+	// field without default value
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:  1002,
+		Name:     "added_double",
+		DataType: schemapb.DataType_Double,
+	})
+	_, err = ColumnBasedInsertMsgToInsertData(msg, schema)
+	assert.Error(t, err)
+}
+
+func TestGetDefaultValueRow(t *testing.T) {
+	v, ok := GetDefaultValueRow(&schemapb.FieldSchema{
+		DataType:     schemapb.DataType_Int8,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 3}},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, int8(3), v)
+
+	v, ok = GetDefaultValueRow(&schemapb.FieldSchema{
+		DataType:     schemapb.DataType_VarChar,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "a"}},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "a", v)
+
+	_, ok = GetDefaultValueRow(&schemapb.FieldSchema{DataType: schemapb.DataType_Int64})
+	assert.False(t, ok)
+}
+
 func TestColumnBasedInsertMsgToInsertFloat16VectorDataError(t *testing.T) {
 	msg := &msgstream.InsertMsg{
 		BaseMsg: msgstream.BaseMsg{
@@ -0,0 +1,489 @@
+package aggregateutil
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const countStar = "count(*)"
+
+var aggregatePattern = regexp.MustCompile(`^(?i)\s*(count|sum|min|max|avg)\s*\(\s*([^()\s]*)\s*\)\s*$`)
+
+// ParseAggregate parses the aggregate output field such as "sum(price)",
+// ok is false if the output field is not an aggregate.
+func ParseAggregate(output string) (op internalpb.AggregateOp, fieldName string, ok bool, err error) {
+	matches := aggregatePattern.FindStringSubmatch(output)
+	if matches == nil {
+		return 0, "", false, nil
+	}
+	fieldName = matches[2]
+	switch strings.ToLower(matches[1]) {
+	case "count":
+		if fieldName != "*" {
+			return 0, "", true, merr.WrapErrParameterInvalidMsg("only count(*) is supported, got %s", output)
+		}
+		return internalpb.AggregateOp_Count, "", true, nil
+	case "sum":
+		op = internalpb.AggregateOp_Sum
+	case "min":
+		op = internalpb.AggregateOp_Min
+	case "max":
+		op = internalpb.AggregateOp_Max
+	case "avg":
+		op = internalpb.AggregateOp_Avg
+	}
+	if fieldName == "" || fieldName == "*" {
+		return 0, "", true, merr.WrapErrParameterInvalidMsg("field name is required by %s", output)
+	}
+	return op, fieldName, true, nil
+}
+
+// AggregateName returns the output field name of the aggregate, such as "sum(price)".
+func AggregateName(op internalpb.AggregateOp, fieldName string) string {
+	if op == internalpb.AggregateOp_Count {
+		return countStar
+	}
+	return fmt.Sprintf("%s(%s)", strings.ToLower(op.String()), fieldName)
+}
+
+func isIntegerType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		return true
+	}
+	return false
+}
+
+func isFloatType(dataType schemapb.DataType) bool {
+	return dataType == schemapb.DataType_Float || dataType == schemapb.DataType_Double
+}
+
+// CheckGroupByField checks whether the rows could be grouped by the field.
+func CheckGroupByField(field *schemapb.FieldSchema) error {
+	if isIntegerType(field.GetDataType()) || field.GetDataType() == schemapb.DataType_Bool || field.GetDataType() == schemapb.DataType_VarChar {
+		return nil
+	}
+	return merr.WrapErrParameterInvalidMsg("group by field %s of type %s is not supported by aggregation", field.GetName(), field.GetDataType().String())
+}
+
+// CheckAggregatedField checks whether the field could be aggregated.
+func CheckAggregatedField(field *schemapb.FieldSchema) error {
+	if isIntegerType(field.GetDataType()) || isFloatType(field.GetDataType()) {
+		return nil
+	}
+	return merr.WrapErrParameterInvalidMsg("field %s of type %s could not be aggregated", field.GetName(), field.GetDataType().String())
+}
+
+// accumulator is the partial value of sum/min/max/avg, in int64 for the integer fields and float64 for the float fields.
+type accumulator struct {
+	i int64
+	f float64
+}
+
+type group struct {
+	key   interface{}
+	count int64
+	accs  []accumulator
+}
+
+// Aggregator computes the aggregates of the rows, grouped by the field if specified.
+// The query nodes aggregate the rows of the segments into the partial results,
+// which are merged by the delegators and the proxy, and finalized by the proxy.
+type Aggregator struct {
+	groupBy    *schemapb.FieldSchema
+	aggregates []*internalpb.Aggregate
+	// fields are the aggregated fields, nil for count(*)
+	fields []*schemapb.FieldSchema
+	groups map[interface{}]*group
+}
+
+func NewAggregator(schema *schemapb.CollectionSchema, groupByFieldID int64, aggregates []*internalpb.Aggregate) (*Aggregator, error) {
+	fieldByID := func(fieldID int64) (*schemapb.FieldSchema, error) {
+		for _, field := range schema.GetFields() {
+			if field.GetFieldID() == fieldID {
+				return field, nil
+			}
+		}
+		return nil, merr.WrapErrFieldNotFound(fieldID)
+	}
+
+	a := &Aggregator{
+		aggregates: aggregates,
+		fields:     make([]*schemapb.FieldSchema, len(aggregates)),
+		groups:     make(map[interface{}]*group),
+	}
+	if groupByFieldID != 0 {
+		field, err := fieldByID(groupByFieldID)
+		if err != nil {
+			return nil, err
+		}
+		if err := CheckGroupByField(field); err != nil {
+			return nil, err
+		}
+		a.groupBy = field
+	}
+	for i, aggregate := range aggregates {
+		if aggregate.GetOp() == internalpb.AggregateOp_Count {
+			continue
+		}
+		field, err := fieldByID(aggregate.GetFieldID())
+		if err != nil {
+			return nil, err
+		}
+		if err := CheckAggregatedField(field); err != nil {
+			return nil, err
+		}
+		a.fields[i] = field
+	}
+	return a, nil
+}
+
+func (a *Aggregator) isFloat(i int) bool {
+	return a.fields[i] != nil && isFloatType(a.fields[i].GetDataType())
+}
+
+func (a *Aggregator) getGroup(key interface{}) (*group, bool) {
+	g, ok := a.groups[key]
+	if !ok {
+		g = &group{key: key, accs: make([]accumulator, len(a.aggregates))}
+		a.groups[key] = g
+	}
+	return g, ok
+}
+
+func (a *Aggregator) accumulate(g *group, i int, acc accumulator, first bool) {
+	current := &g.accs[i]
+	if first {
+		*current = acc
+		return
+	}
+	switch a.aggregates[i].GetOp() {
+	case internalpb.AggregateOp_Sum, internalpb.AggregateOp_Avg:
+		current.i += acc.i
+		current.f += acc.f
+	case internalpb.AggregateOp_Min:
+		if a.isFloat(i) && acc.f < current.f || !a.isFloat(i) && acc.i < current.i {
+			*current = acc
+		}
+	case internalpb.AggregateOp_Max:
+		if a.isFloat(i) && acc.f > current.f || !a.isFloat(i) && acc.i > current.i {
+			*current = acc
+		}
+	}
+}
+
+func findField(fieldsData []*schemapb.FieldData, fieldID int64) (*schemapb.FieldData, error) {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() == fieldID {
+			return fieldData, nil
+		}
+	}
+	return nil, merr.WrapErrFieldNotFound(fieldID, "field not found in the retrieved rows")
+}
+
+// AddRows aggregates the rows retrieved from the segment.
+func (a *Aggregator) AddRows(fieldsData []*schemapb.FieldData) error {
+	var keys *schemapb.FieldData
+	if a.groupBy != nil {
+		var err error
+		if keys, err = findField(fieldsData, a.groupBy.GetFieldID()); err != nil {
+			return err
+		}
+	}
+	columns := make([]*schemapb.FieldData, len(a.aggregates))
+	for i, field := range a.fields {
+		if field == nil {
+			continue
+		}
+		var err error
+		if columns[i], err = findField(fieldsData, field.GetFieldID()); err != nil {
+			return err
+		}
+	}
+	return a.add(keys, nil, columns)
+}
+
+// AddPartial merges the partial result aggregated by the other aggregator.
+func (a *Aggregator) AddPartial(fieldsData []*schemapb.FieldData) error {
+	expected := 1 + len(a.aggregates)
+	if a.groupBy != nil {
+		expected++
+	}
+	if len(fieldsData) != expected {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("invalid partial aggregation with %d columns, expected %d", len(fieldsData), expected))
+	}
+	var keys *schemapb.FieldData
+	if a.groupBy != nil {
+		keys, fieldsData = fieldsData[0], fieldsData[1:]
+	}
+	return a.add(keys, fieldsData[0], fieldsData[1:])
+}
+
+// add aggregates the rows, counts is nil for the raw rows.
+func (a *Aggregator) add(keys *schemapb.FieldData, counts *schemapb.FieldData, columns []*schemapb.FieldData) error {
+	rows := -1
+	for _, column := range append([]*schemapb.FieldData{keys, counts}, columns...) {
+		if column == nil {
+			continue
+		}
+		n := rowCount(column)
+		if rows >= 0 && n != rows {
+			return merr.WrapErrServiceInternal("the aggregated columns have different row counts")
+		}
+		rows = n
+	}
+
+	for row := 0; row < rows; row++ {
+		var key interface{}
+		if keys != nil {
+			key = keyAt(keys, row)
+		}
+		g, exist := a.getGroup(key)
+		count := int64(1)
+		if counts != nil {
+			count = counts.GetScalars().GetLongData().GetData()[row]
+		}
+		g.count += count
+		for i, column := range columns {
+			if column == nil || a.aggregates[i].GetOp() == internalpb.AggregateOp_Count {
+				continue
+			}
+			var acc accumulator
+			if a.isFloat(i) {
+				acc.f = floatAt(column, row)
+			} else {
+				acc.i = intAt(column, row)
+			}
+			a.accumulate(g, i, acc, !exist)
+		}
+	}
+	return nil
+}
+
+// Partial returns the partial result, which is the group keys if grouped, the row counts,
+// and the accumulators of the aggregates in order.
+func (a *Aggregator) Partial() []*schemapb.FieldData {
+	groups := a.sortedGroups()
+	fieldsData := make([]*schemapb.FieldData, 0, len(a.aggregates)+2)
+	if a.groupBy != nil {
+		fieldsData = append(fieldsData, a.keyColumn(groups))
+	}
+	counts := make([]int64, len(groups))
+	for i, g := range groups {
+		counts[i] = g.count
+	}
+	fieldsData = append(fieldsData, longColumn(countStar, counts))
+	for i, aggregate := range a.aggregates {
+		name := AggregateName(aggregate.GetOp(), a.fields[i].GetName())
+		if a.isFloat(i) {
+			values := make([]float64, len(groups))
+			for j, g := range groups {
+				values[j] = g.accs[i].f
+			}
+			fieldsData = append(fieldsData, doubleColumn(name, values))
+		} else {
+			values := make([]int64, len(groups))
+			for j, g := range groups {
+				values[j] = g.accs[i].i
+			}
+			fieldsData = append(fieldsData, longColumn(name, values))
+		}
+	}
+	return fieldsData
+}
+
+// Finalize returns the aggregates of the groups sorted by the group keys, paginated by the offset and limit,
+// the group keys come first if grouped. limit <= 0 means unlimited.
+func (a *Aggregator) Finalize(offset int64, limit int64) []*schemapb.FieldData {
+	groups := a.sortedGroups()
+	if offset >= int64(len(groups)) {
+		groups = nil
+	} else {
+		groups = groups[offset:]
+	}
+	if limit > 0 && limit < int64(len(groups)) {
+		groups = groups[:limit]
+	}
+
+	fieldsData := make([]*schemapb.FieldData, 0, len(a.aggregates)+1)
+	if a.groupBy != nil {
+		fieldsData = append(fieldsData, a.keyColumn(groups))
+	}
+	for i, aggregate := range a.aggregates {
+		name := AggregateName(aggregate.GetOp(), a.fields[i].GetName())
+		switch aggregate.GetOp() {
+		case internalpb.AggregateOp_Count:
+			counts := make([]int64, len(groups))
+			for j, g := range groups {
+				counts[j] = g.count
+			}
+			fieldsData = append(fieldsData, longColumn(name, counts))
+		case internalpb.AggregateOp_Avg:
+			values := make([]float64, len(groups))
+			for j, g := range groups {
+				if a.isFloat(i) {
+					values[j] = g.accs[i].f / float64(g.count)
+				} else {
+					values[j] = float64(g.accs[i].i) / float64(g.count)
+				}
+			}
+			fieldsData = append(fieldsData, doubleColumn(name, values))
+		case internalpb.AggregateOp_Sum:
+			fieldsData = append(fieldsData, a.accColumn(name, i, groups, a.isFloat(i), schemapb.DataType_Int64, schemapb.DataType_Double))
+		default:
+			// min and max are of the field type
+			dataType := a.fields[i].GetDataType()
+			fieldsData = append(fieldsData, a.accColumn(name, i, groups, a.isFloat(i), dataType, dataType))
+		}
+	}
+	return fieldsData
+}
+
+func (a *Aggregator) accColumn(name string, i int, groups []*group, isFloat bool, intType, floatType schemapb.DataType) *schemapb.FieldData {
+	if isFloat {
+		values := make([]float64, len(groups))
+		for j, g := range groups {
+			values[j] = g.accs[i].f
+		}
+		if floatType == schemapb.DataType_Float {
+			floats := make([]float32, len(values))
+			for j, v := range values {
+				floats[j] = float32(v)
+			}
+			return scalarColumn(name, floatType, &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: floats}}})
+		}
+		return doubleColumn(name, values)
+	}
+	values := make([]int64, len(groups))
+	for j, g := range groups {
+		values[j] = g.accs[i].i
+	}
+	if intType == schemapb.DataType_Int64 {
+		return longColumn(name, values)
+	}
+	ints := make([]int32, len(values))
+	for j, v := range values {
+		ints[j] = int32(v)
+	}
+	return scalarColumn(name, intType, &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: ints}}})
+}
+
+func (a *Aggregator) sortedGroups() []*group {
+	groups := make([]*group, 0, len(a.groups))
+	for _, g := range a.groups {
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		switch ki := groups[i].key.(type) {
+		case int64:
+			return ki < groups[j].key.(int64)
+		case string:
+			return ki < groups[j].key.(string)
+		case bool:
+			return !ki && groups[j].key.(bool)
+		}
+		return false
+	})
+	return groups
+}
+
+func (a *Aggregator) keyColumn(groups []*group) *schemapb.FieldData {
+	scalars := &schemapb.ScalarField{}
+	switch a.groupBy.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, len(groups))
+		for i, g := range groups {
+			data[i] = g.key.(bool)
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case schemapb.DataType_Int64:
+		data := make([]int64, len(groups))
+		for i, g := range groups {
+			data[i] = g.key.(int64)
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_VarChar:
+		data := make([]string, len(groups))
+		for i, g := range groups {
+			data[i] = g.key.(string)
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	default:
+		data := make([]int32, len(groups))
+		for i, g := range groups {
+			data[i] = int32(g.key.(int64))
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	}
+	column := scalarColumn(a.groupBy.GetName(), a.groupBy.GetDataType(), scalars)
+	column.FieldId = a.groupBy.GetFieldID()
+	return column
+}
+
+func scalarColumn(name string, dataType schemapb.DataType, scalars *schemapb.ScalarField) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      dataType,
+		FieldName: name,
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}
+}
+
+func longColumn(name string, data []int64) *schemapb.FieldData {
+	return scalarColumn(name, schemapb.DataType_Int64, &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}})
+}
+
+func doubleColumn(name string, data []float64) *schemapb.FieldData {
+	return scalarColumn(name, schemapb.DataType_Double, &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}})
+}
+
+func rowCount(fieldData *schemapb.FieldData) int {
+	scalars := fieldData.GetScalars()
+	switch {
+	case scalars.GetBoolData() != nil:
+		return len(scalars.GetBoolData().GetData())
+	case scalars.GetIntData() != nil:
+		return len(scalars.GetIntData().GetData())
+	case scalars.GetLongData() != nil:
+		return len(scalars.GetLongData().GetData())
+	case scalars.GetFloatData() != nil:
+		return len(scalars.GetFloatData().GetData())
+	case scalars.GetDoubleData() != nil:
+		return len(scalars.GetDoubleData().GetData())
+	case scalars.GetStringData() != nil:
+		return len(scalars.GetStringData().GetData())
+	}
+	return 0
+}
+
+func keyAt(fieldData *schemapb.FieldData, row int) interface{} {
+	scalars := fieldData.GetScalars()
+	switch {
+	case scalars.GetBoolData() != nil:
+		return scalars.GetBoolData().GetData()[row]
+	case scalars.GetStringData() != nil:
+		return scalars.GetStringData().GetData()[row]
+	}
+	return intAt(fieldData, row)
+}
+
+func intAt(fieldData *schemapb.FieldData, row int) int64 {
+	scalars := fieldData.GetScalars()
+	if scalars.GetIntData() != nil {
+		return int64(scalars.GetIntData().GetData()[row])
+	}
+	return scalars.GetLongData().GetData()[row]
+}
+
+func floatAt(fieldData *schemapb.FieldData, row int) float64 {
+	scalars := fieldData.GetScalars()
+	if scalars.GetFloatData() != nil {
+		return float64(scalars.GetFloatData().GetData()[row])
+	}
+	return scalars.GetDoubleData().GetData()[row]
+}
@@ -0,0 +1,160 @@
+package aggregateutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func testSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "count", DataType: schemapb.DataType_Int32},
+			{FieldID: 103, Name: "price", DataType: schemapb.DataType_Double},
+			{FieldID: 104, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+}
+
+func testRows(categories []string, counts []int32, prices []float64) []*schemapb.FieldData {
+	category := scalarColumn("category", schemapb.DataType_VarChar, &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: categories}}})
+	category.FieldId = 101
+	count := scalarColumn("count", schemapb.DataType_Int32, &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: counts}}})
+	count.FieldId = 102
+	price := doubleColumn("price", prices)
+	price.FieldId = 103
+	return []*schemapb.FieldData{category, count, price}
+}
+
+func TestParseAggregate(t *testing.T) {
+	cases := []struct {
+		output string
+		op     internalpb.AggregateOp
+		field  string
+		ok     bool
+		err    bool
+	}{
+		{output: "count(*)", op: internalpb.AggregateOp_Count, ok: true},
+		{output: " COUNT( * ) ", op: internalpb.AggregateOp_Count, ok: true},
+		{output: "sum(price)", op: internalpb.AggregateOp_Sum, field: "price", ok: true},
+		{output: "Avg( price )", op: internalpb.AggregateOp_Avg, field: "price", ok: true},
+		{output: "min(count)", op: internalpb.AggregateOp_Min, field: "count", ok: true},
+		{output: "max(count)", op: internalpb.AggregateOp_Max, field: "count", ok: true},
+		{output: "price"},
+		{output: "median(price)"},
+		{output: "count(price)", ok: true, err: true},
+		{output: "sum(*)", ok: true, err: true},
+		{output: "sum()", ok: true, err: true},
+	}
+	for _, c := range cases {
+		op, field, ok, err := ParseAggregate(c.output)
+		assert.Equal(t, c.ok, ok, c.output)
+		if c.err {
+			assert.ErrorIs(t, err, merr.ErrParameterInvalid, c.output)
+			continue
+		}
+		assert.NoError(t, err, c.output)
+		if c.ok {
+			assert.Equal(t, c.op, op, c.output)
+			assert.Equal(t, c.field, field, c.output)
+		}
+	}
+
+	assert.Equal(t, "count(*)", AggregateName(internalpb.AggregateOp_Count, ""))
+	assert.Equal(t, "avg(price)", AggregateName(internalpb.AggregateOp_Avg, "price"))
+}
+
+func TestNewAggregatorFailed(t *testing.T) {
+	schema := testSchema()
+	_, err := NewAggregator(schema, 999, nil)
+	assert.Error(t, err)
+	_, err = NewAggregator(schema, 103, nil)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, err = NewAggregator(schema, 0, []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Sum, FieldID: 101}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, err = NewAggregator(schema, 0, []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Max, FieldID: 104}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestAggregator(t *testing.T) {
+	schema := testSchema()
+	aggregates := []*internalpb.Aggregate{
+		{Op: internalpb.AggregateOp_Count},
+		{Op: internalpb.AggregateOp_Sum, FieldID: 102},
+		{Op: internalpb.AggregateOp_Min, FieldID: 103},
+		{Op: internalpb.AggregateOp_Max, FieldID: 102},
+		{Op: internalpb.AggregateOp_Avg, FieldID: 103},
+	}
+
+	// two segments aggregated by different query nodes
+	partial := func(rows ...[]*schemapb.FieldData) []*schemapb.FieldData {
+		agg, err := NewAggregator(schema, 101, aggregates)
+		require.NoError(t, err)
+		for _, fieldsData := range rows {
+			require.NoError(t, agg.AddRows(fieldsData))
+		}
+		return agg.Partial()
+	}
+	partial1 := partial(testRows([]string{"a", "b", "a"}, []int32{1, 2, 3}, []float64{1.5, 2.5, 3.5}))
+	partial2 := partial(testRows([]string{"c", "a"}, []int32{4, 5}, []float64{4.5, 0.5}), testRows(nil, nil, nil))
+
+	agg, err := NewAggregator(schema, 101, aggregates)
+	require.NoError(t, err)
+	require.NoError(t, agg.AddPartial(partial1))
+	require.NoError(t, agg.AddPartial(partial2))
+
+	result := agg.Finalize(0, 0)
+	require.Len(t, result, 6)
+	assert.Equal(t, "category", result[0].GetFieldName())
+	assert.Equal(t, []string{"a", "b", "c"}, result[0].GetScalars().GetStringData().GetData())
+	assert.Equal(t, "count(*)", result[1].GetFieldName())
+	assert.Equal(t, []int64{3, 1, 1}, result[1].GetScalars().GetLongData().GetData())
+	assert.Equal(t, "sum(count)", result[2].GetFieldName())
+	assert.Equal(t, []int64{9, 2, 4}, result[2].GetScalars().GetLongData().GetData())
+	assert.Equal(t, "min(price)", result[3].GetFieldName())
+	assert.Equal(t, []float64{0.5, 2.5, 4.5}, result[3].GetScalars().GetDoubleData().GetData())
+	assert.Equal(t, "max(count)", result[4].GetFieldName())
+	assert.Equal(t, schemapb.DataType_Int32, result[4].GetType())
+	assert.Equal(t, []int32{5, 2, 4}, result[4].GetScalars().GetIntData().GetData())
+	assert.Equal(t, "avg(price)", result[5].GetFieldName())
+	assert.Equal(t, []float64{5.5 / 3, 2.5, 4.5}, result[5].GetScalars().GetDoubleData().GetData())
+
+	// paginated by the groups
+	result = agg.Finalize(1, 1)
+	assert.Equal(t, []string{"b"}, result[0].GetScalars().GetStringData().GetData())
+	result = agg.Finalize(5, 1)
+	assert.Empty(t, result[0].GetScalars().GetStringData().GetData())
+
+	assert.Error(t, agg.AddPartial(partial1[1:]))
+}
+
+func TestAggregatorWithoutGroupBy(t *testing.T) {
+	schema := testSchema()
+	aggregates := []*internalpb.Aggregate{
+		{Op: internalpb.AggregateOp_Sum, FieldID: 103},
+		{Op: internalpb.AggregateOp_Count},
+	}
+	agg, err := NewAggregator(schema, 0, aggregates)
+	require.NoError(t, err)
+
+	// no rows, no result
+	result := agg.Finalize(0, 0)
+	require.Len(t, result, 2)
+	assert.Empty(t, result[0].GetScalars().GetDoubleData().GetData())
+
+	require.NoError(t, agg.AddRows(testRows([]string{"a", "b"}, []int32{1, 2}, []float64{1.5, 2.5})))
+	require.NoError(t, agg.AddRows(testRows([]string{"c"}, []int32{3}, []float64{3})))
+	result = agg.Finalize(0, 0)
+	assert.Equal(t, []float64{7}, result[0].GetScalars().GetDoubleData().GetData())
+	assert.Equal(t, []int64{3}, result[1].GetScalars().GetLongData().GetData())
+
+	// the aggregated fields are required
+	assert.Error(t, agg.AddRows(testRows(nil, nil, nil)[:2]))
+}
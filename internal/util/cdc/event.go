@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+)
+
+// Event is a change of a collection published to the sinks, the consumers shall deduplicate the events by the id
+// since the events are delivered at least once.
+type Event struct {
+	// ID is unique among the events, which is the channel and the id of the message
+	ID             string `json:"id"`
+	Type           string `json:"type"`
+	DbName         string `json:"db_name"`
+	CollectionID   int64  `json:"collection_id"`
+	CollectionName string `json:"collection_name"`
+	PartitionName  string `json:"partition_name,omitempty"`
+	Channel        string `json:"channel"`
+	Timestamp      uint64 `json:"timestamp"`
+	// Payload is the request of the change in json, such as the msgpb.InsertRequest of the insert
+	Payload json.RawMessage `json:"payload"`
+}
+
+type collectionRequest interface {
+	proto.Message
+	GetDbName() string
+	GetCollectionName() string
+	GetCollectionID() int64
+}
+
+type partitionRequest interface {
+	GetPartitionName() string
+}
+
+var marshaler = jsonpb.Marshaler{}
+
+// request returns the request of the change carried by the message, ok is false if it's not a change captured.
+func request(msg msgstream.TsMsg) (req collectionRequest, ok bool) {
+	switch msg := msg.(type) {
+	case *msgstream.InsertMsg:
+		return &msg.InsertRequest, true
+	case *msgstream.DeleteMsg:
+		return &msg.DeleteRequest, true
+	case *msgstream.CreateCollectionMsg:
+		return &msg.CreateCollectionRequest, true
+	case *msgstream.DropCollectionMsg:
+		return &msg.DropCollectionRequest, true
+	case *msgstream.CreatePartitionMsg:
+		return &msg.CreatePartitionRequest, true
+	case *msgstream.DropPartitionMsg:
+		return &msg.DropPartitionRequest, true
+	default:
+		return nil, false
+	}
+}
+
+// newEvent converts the message consumed from the channel to the event.
+func newEvent(channel string, msg msgstream.TsMsg, req collectionRequest) (*Event, error) {
+	payload, err := marshaler.MarshalToString(req)
+	if err != nil {
+		return nil, err
+	}
+	event := &Event{
+		ID:             fmt.Sprintf("%s-%d", channel, msg.ID()),
+		Type:           msg.Type().String(),
+		DbName:         req.GetDbName(),
+		CollectionID:   req.GetCollectionID(),
+		CollectionName: req.GetCollectionName(),
+		Channel:        channel,
+		Timestamp:      msg.EndTs(),
+		Payload:        json.RawMessage(payload),
+	}
+	if req, ok := req.(partitionRequest); ok {
+		event.PartitionName = req.GetPartitionName()
+	}
+	return event, nil
+}
+
+// isDropCollection returns whether the event drops the collection.
+func isDropCollection(event *Event) bool {
+	return event.Type == commonpb.MsgType_DropCollection.String()
+}
@@ -0,0 +1,239 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// CheckpointPrefix is the prefix of the keys of the channel checkpoints.
+const CheckpointPrefix = "cdc-checkpoint"
+
+// Filter returns whether the changes of the collection are published.
+type Filter func(ctx context.Context, collectionID int64) bool
+
+// Publisher consumes the physical dml channels by its own subscription and publishes the changes of the collections
+// filtered to the sink. The checkpoint of a channel is saved only after the events before it are published,
+// and the publisher resumes from the checkpoints once restarted, so the events are delivered at least once.
+type Publisher struct {
+	factory   msgstream.Factory
+	store     kv.BaseKV
+	sink      Sink
+	pchannels []string
+	subName   string
+	filter    Filter
+
+	// captured are the collections ever published, whose drops are published even if gone already
+	mu       sync.RWMutex
+	captured typeutil.UniqueSet
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewPublisher creates a publisher of the physical channels, the checkpoints are saved to the store.
+func NewPublisher(factory msgstream.Factory, store kv.BaseKV, sink Sink, pchannels []string, subName string, filter Filter) *Publisher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Publisher{
+		factory:   factory,
+		store:     store,
+		sink:      sink,
+		pchannels: pchannels,
+		subName:   subName,
+		filter:    filter,
+		captured:  typeutil.NewUniqueSet(),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+func (p *Publisher) Start() {
+	for _, pchannel := range p.pchannels {
+		p.wg.Add(1)
+		go p.publishLoop(pchannel)
+	}
+}
+
+func (p *Publisher) Stop() {
+	p.cancel()
+	p.wg.Wait()
+	p.sink.Close()
+}
+
+// publishLoop keeps publishing the channel, resuming from the checkpoint once failed.
+func (p *Publisher) publishLoop(pchannel string) {
+	defer p.wg.Done()
+	log := log.With(zap.String("pchannel", pchannel), zap.String("sink", p.sink.Name()))
+	log.Info("start publishing changes of channel")
+	for {
+		err := p.publish(pchannel)
+		if p.ctx.Err() != nil {
+			log.Info("stop publishing changes of channel")
+			return
+		}
+		log.Warn("failed to publish changes of channel, retry later", zap.Error(err))
+		select {
+		case <-p.ctx.Done():
+			return
+		case <-time.After(paramtable.Get().DataCoordCfg.CDCCheckpointInterval.GetAsDuration(time.Second)):
+		}
+	}
+}
+
+func (p *Publisher) publish(pchannel string) error {
+	checkpoint, err := p.loadCheckpoint(pchannel)
+	if err != nil {
+		return err
+	}
+	stream, err := p.factory.NewTtMsgStream(p.ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+	if checkpoint == nil {
+		// nothing published before, starts from now on
+		err = stream.AsConsumer(p.ctx, []string{pchannel}, p.subName, mqwrapper.SubscriptionPositionLatest)
+	} else {
+		err = stream.AsConsumer(p.ctx, []string{pchannel}, p.subName, mqwrapper.SubscriptionPositionUnknown)
+		if err == nil {
+			err = stream.Seek(p.ctx, []*msgpb.MsgPosition{checkpoint})
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	interval := paramtable.Get().DataCoordCfg.CDCCheckpointInterval.GetAsDuration(time.Second)
+	// the first position is saved at once so that the publisher resumes from it
+	var savedAt time.Time
+	for {
+		select {
+		case <-p.ctx.Done():
+			return nil
+		case pack, ok := <-stream.Chan():
+			if !ok {
+				return merr.WrapErrMqInternal(errors.New("msgstream closed"), pchannel)
+			}
+			if pack == nil || len(pack.EndPositions) == 0 {
+				continue
+			}
+			events, err := p.collect(pchannel, pack)
+			if err != nil {
+				return err
+			}
+			if len(events) > 0 {
+				err := retry.Do(p.ctx, func() error {
+					return p.sink.Publish(p.ctx, events)
+				}, retry.Attempts(10), retry.Sleep(200*time.Millisecond), retry.MaxSleepTime(10*time.Second))
+				if err != nil {
+					return err
+				}
+				for _, event := range events {
+					metrics.DataCoordCDCPublishedEventCount.WithLabelValues(pchannel, event.Type).Inc()
+				}
+			}
+			if len(events) > 0 || time.Since(savedAt) >= interval {
+				if err := p.saveCheckpoint(pchannel, pack.EndPositions[0]); err != nil {
+					return err
+				}
+				savedAt = time.Now()
+			}
+		}
+	}
+}
+
+// collect converts the changes of the collections filtered in the pack to the events.
+func (p *Publisher) collect(pchannel string, pack *msgstream.MsgPack) ([]*Event, error) {
+	events := make([]*Event, 0)
+	for _, msg := range pack.Msgs {
+		req, ok := request(msg)
+		if !ok || !p.isCaptured(req.GetCollectionID()) {
+			continue
+		}
+		event, err := newEvent(pchannel, msg, req)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (p *Publisher) isCaptured(collectionID int64) bool {
+	if p.filter(p.ctx, collectionID) {
+		p.mu.Lock()
+		p.captured.Insert(collectionID)
+		p.mu.Unlock()
+		return true
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.captured.Contain(collectionID)
+}
+
+func checkpointKey(pchannel string) string {
+	return path.Join(CheckpointPrefix, pchannel)
+}
+
+// loadCheckpoint returns the checkpoint of the channel, nil if not saved yet.
+func (p *Publisher) loadCheckpoint(pchannel string) (*msgpb.MsgPosition, error) {
+	value, err := p.store.Load(checkpointKey(pchannel))
+	if errors.Is(err, merr.ErrIoKeyNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	checkpoint := &msgpb.MsgPosition{}
+	if err := proto.Unmarshal([]byte(value), checkpoint); err != nil {
+		return nil, err
+	}
+	return checkpoint, nil
+}
+
+func (p *Publisher) saveCheckpoint(pchannel string, checkpoint *msgpb.MsgPosition) error {
+	value, err := proto.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	if err := p.store.Save(checkpointKey(pchannel), string(value)); err != nil {
+		return err
+	}
+	lag := time.Since(tsoutil.PhysicalTime(checkpoint.GetTimestamp()))
+	metrics.DataCoordCDCCheckpointLagSeconds.WithLabelValues(pchannel).Set(lag.Seconds())
+	return nil
+}
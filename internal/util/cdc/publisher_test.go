@@ -0,0 +1,222 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/nmq"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+
+	storeDir, _ := os.MkdirTemp("", "milvus_cdc")
+	defer os.RemoveAll(storeDir)
+
+	cfg := nmq.ParseServerOption(paramtable.Get())
+	// random port
+	cfg.Opts.Port = -1
+	cfg.Opts.StoreDir = storeDir
+	nmq.MustInitNatsMQ(cfg)
+	defer nmq.CloseNatsMQ()
+
+	os.Exit(m.Run())
+}
+
+type memorySink struct {
+	mu       sync.Mutex
+	events   []*Event
+	failures int
+}
+
+func (s *memorySink) Name() string {
+	return "memory"
+}
+
+func (s *memorySink) Publish(ctx context.Context, events []*Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("mock failure")
+	}
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *memorySink) Close() {}
+
+func (s *memorySink) list() []*Event {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*Event{}, s.events...)
+}
+
+type PublisherSuite struct {
+	suite.Suite
+
+	factory  msgstream.Factory
+	pchannel string
+	producer msgstream.MsgStream
+	ts       uint64
+	msgID    int64
+}
+
+func (s *PublisherSuite) SetupTest() {
+	s.factory = msgstream.NewNatsmqFactory()
+	s.pchannel = fmt.Sprintf("cdc-dml-%d", time.Now().UnixNano())
+	producer, err := s.factory.NewMsgStream(context.Background())
+	s.Require().NoError(err)
+	producer.AsProducer([]string{s.pchannel})
+	s.producer = producer
+	s.ts = tsoutil.ComposeTSByTime(time.Now(), 0)
+}
+
+func (s *PublisherSuite) TearDownTest() {
+	s.producer.Close()
+}
+
+func (s *PublisherSuite) nextBase(msgType commonpb.MsgType) (*commonpb.MsgBase, uint64) {
+	s.ts++
+	s.msgID++
+	return &commonpb.MsgBase{MsgType: msgType, MsgID: s.msgID, Timestamp: s.ts}, s.ts
+}
+
+func newBaseMsg(ts uint64) msgstream.BaseMsg {
+	return msgstream.BaseMsg{BeginTimestamp: ts, EndTimestamp: ts, HashValues: []uint32{0}}
+}
+
+// produce sends an insert of the collection, a delete of the other collection and the time tick after them.
+func (s *PublisherSuite) produce(collectionID, otherCollectionID int64) {
+	base, ts := s.nextBase(commonpb.MsgType_Insert)
+	s.Require().NoError(s.producer.Produce(&msgstream.MsgPack{Msgs: []msgstream.TsMsg{&msgstream.InsertMsg{BaseMsg: newBaseMsg(ts), InsertRequest: msgpb.InsertRequest{
+		Base:           base,
+		DbName:         "default",
+		CollectionID:   collectionID,
+		CollectionName: "captured",
+		PartitionName:  "_default",
+		RowIDs:         []int64{1},
+		Timestamps:     []uint64{ts},
+		NumRows:        1,
+		Version:        msgpb.InsertDataVersion_ColumnBased,
+	}}}}))
+
+	base, ts = s.nextBase(commonpb.MsgType_Delete)
+	s.Require().NoError(s.producer.Produce(&msgstream.MsgPack{Msgs: []msgstream.TsMsg{&msgstream.DeleteMsg{BaseMsg: newBaseMsg(ts), DeleteRequest: msgpb.DeleteRequest{
+		Base:         base,
+		CollectionID: otherCollectionID,
+		PrimaryKeys:  &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
+		Timestamps:   []uint64{ts},
+		NumRows:      1,
+	}}}}))
+
+	s.produceTimeTick()
+}
+
+func (s *PublisherSuite) produceTimeTick() {
+	base, ts := s.nextBase(commonpb.MsgType_TimeTick)
+	_, err := s.producer.Broadcast(&msgstream.MsgPack{Msgs: []msgstream.TsMsg{&msgstream.TimeTickMsg{BaseMsg: newBaseMsg(ts), TimeTickMsg: msgpb.TimeTickMsg{Base: base}}}})
+	s.Require().NoError(err)
+}
+
+func (s *PublisherSuite) TestPublish() {
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.CDCCheckpointInterval.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.CDCCheckpointInterval.Key)
+
+	store := memkv.NewMemoryKV()
+	filter := func(ctx context.Context, collectionID int64) bool {
+		return collectionID == 1
+	}
+	sink := &memorySink{failures: 1}
+	publisher := NewPublisher(s.factory, store, sink, []string{s.pchannel}, "cdc-test", filter)
+	publisher.Start()
+
+	// the publisher starts from the latest without checkpoint
+	s.Eventually(func() bool {
+		s.produceTimeTick()
+		checkpoint, err := publisher.loadCheckpoint(s.pchannel)
+		return err == nil && checkpoint != nil
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// published after the failure retried
+	s.produce(1, 2)
+	inserted := s.msgID - 2
+	s.Eventually(func() bool {
+		return len(sink.list()) > 0
+	}, 10*time.Second, 100*time.Millisecond)
+	publisher.Stop()
+
+	events := sink.list()
+	s.Len(events, 1)
+	s.Equal(fmt.Sprintf("%s-%d", s.pchannel, inserted), events[0].ID)
+	s.Equal(commonpb.MsgType_Insert.String(), events[0].Type)
+	s.EqualValues(1, events[0].CollectionID)
+	s.Equal("captured", events[0].CollectionName)
+	s.Equal("_default", events[0].PartitionName)
+	s.Equal(s.pchannel, events[0].Channel)
+	s.Contains(string(events[0].Payload), `"collectionName":"captured"`)
+	checkpoint, err := publisher.loadCheckpoint(s.pchannel)
+	s.NoError(err)
+	s.GreaterOrEqual(checkpoint.GetTimestamp(), events[0].Timestamp)
+
+	// the changes while stopped are published once resumed from the checkpoint
+	s.produce(1, 2)
+	inserted = s.msgID - 2
+	sink = &memorySink{}
+	publisher = NewPublisher(s.factory, store, sink, []string{s.pchannel}, "cdc-test", filter)
+	publisher.Start()
+	defer publisher.Stop()
+	s.Eventually(func() bool {
+		return len(sink.list()) > 0
+	}, 10*time.Second, 100*time.Millisecond)
+	s.Equal(fmt.Sprintf("%s-%d", s.pchannel, inserted), sink.list()[0].ID)
+}
+
+func (s *PublisherSuite) TestCaptured() {
+	enabled := true
+	filter := func(ctx context.Context, collectionID int64) bool {
+		return enabled && collectionID == 1
+	}
+	publisher := NewPublisher(s.factory, memkv.NewMemoryKV(), &memorySink{}, nil, "cdc-test", filter)
+	s.False(publisher.isCaptured(2))
+	s.True(publisher.isCaptured(1))
+
+	// the collection dropped is still captured
+	enabled = false
+	s.True(publisher.isCaptured(1))
+	s.False(publisher.isCaptured(2))
+}
+
+func TestPublisher(t *testing.T) {
+	suite.Run(t, new(PublisherSuite))
+}
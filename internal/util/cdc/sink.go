@@ -0,0 +1,192 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const (
+	SinkKafka   = "kafka"
+	SinkWebhook = "webhook"
+
+	// the properties of the messages published to the mq sink
+	EventIDProperty   = "cdc_event_id"
+	EventTypeProperty = "cdc_event_type"
+)
+
+// Sink is the external system the change events published to,
+// Publish returns only after all the events are persisted by the sink.
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, events []*Event) error
+	Close()
+}
+
+// MQSink publishes the events of each collection to its own topic of the mq, such as kafka.
+type MQSink struct {
+	client      mqwrapper.Client
+	topicPrefix string
+
+	mu        sync.Mutex
+	producers map[int64]mqwrapper.Producer
+}
+
+var _ Sink = (*MQSink)(nil)
+
+// NewMQSink creates a mq sink, the topic of a collection is the prefix and the collection id.
+func NewMQSink(client mqwrapper.Client, topicPrefix string) *MQSink {
+	return &MQSink{
+		client:      client,
+		topicPrefix: topicPrefix,
+		producers:   make(map[int64]mqwrapper.Producer),
+	}
+}
+
+func (s *MQSink) Name() string {
+	return SinkKafka
+}
+
+// Topic returns the topic the events of the collection published to.
+func (s *MQSink) Topic(collectionID int64) string {
+	return s.topicPrefix + strconv.FormatInt(collectionID, 10)
+}
+
+func (s *MQSink) Publish(ctx context.Context, events []*Event) error {
+	for _, event := range events {
+		producer, err := s.getProducer(event.CollectionID)
+		if err != nil {
+			return err
+		}
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		_, err = producer.Send(ctx, &mqwrapper.ProducerMessage{
+			Payload: payload,
+			Properties: map[string]string{
+				EventIDProperty:   event.ID,
+				EventTypeProperty: event.Type,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if isDropCollection(event) {
+			s.closeProducer(event.CollectionID)
+		}
+	}
+	return nil
+}
+
+func (s *MQSink) getProducer(collectionID int64) (mqwrapper.Producer, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if producer, ok := s.producers[collectionID]; ok {
+		return producer, nil
+	}
+	producer, err := s.client.CreateProducer(mqwrapper.ProducerOptions{
+		Topic:             s.Topic(collectionID),
+		EnableCompression: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.producers[collectionID] = producer
+	return producer, nil
+}
+
+func (s *MQSink) closeProducer(collectionID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if producer, ok := s.producers[collectionID]; ok {
+		producer.Close()
+		delete(s.producers, collectionID)
+	}
+}
+
+func (s *MQSink) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, producer := range s.producers {
+		producer.Close()
+	}
+	s.producers = make(map[int64]mqwrapper.Producer)
+	s.client.Close()
+}
+
+// WebhookBody is the body of the requests posted to the webhook.
+type WebhookBody struct {
+	Events []*Event `json:"events"`
+}
+
+// WebhookSink posts the events in batches to the url, the batch is accepted only if the webhook responds 2xx.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+var _ Sink = (*WebhookSink)(nil)
+
+// NewWebhookSink creates a webhook sink, timeout is the timeout of each post.
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return SinkWebhook
+}
+
+func (s *WebhookSink) Publish(ctx context.Context, events []*Event) error {
+	body, err := json.Marshal(&WebhookBody{Events: events})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return merr.WrapErrServiceUnavailable(fmt.Sprintf("webhook responded %s", resp.Status), string(msg))
+	}
+	return nil
+}
+
+func (s *WebhookSink) Close() {
+	s.client.CloseIdleConnections()
+}
@@ -0,0 +1,115 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/nmq"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func newTestEvent(id string, collectionID int64, msgType commonpb.MsgType) *Event {
+	return &Event{
+		ID:           id,
+		Type:         msgType.String(),
+		CollectionID: collectionID,
+		Payload:      json.RawMessage(`{}`),
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var received []*Event
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := &WebhookBody{}
+		if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if status == http.StatusOK {
+			received = append(received, body.Events...)
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, time.Second)
+	defer sink.Close()
+	assert.Equal(t, SinkWebhook, sink.Name())
+
+	events := []*Event{newTestEvent("a", 1, commonpb.MsgType_Insert), newTestEvent("b", 1, commonpb.MsgType_Delete)}
+	require.NoError(t, sink.Publish(context.Background(), events))
+	require.Len(t, received, 2)
+	assert.Equal(t, "a", received[0].ID)
+	assert.Equal(t, commonpb.MsgType_Delete.String(), received[1].Type)
+
+	// not accepted unless 2xx
+	status = http.StatusInternalServerError
+	err := sink.Publish(context.Background(), events)
+	assert.ErrorIs(t, err, merr.ErrServiceUnavailable)
+	assert.Len(t, received, 2)
+}
+
+func TestMQSink(t *testing.T) {
+	client, err := nmq.NewClientWithDefaultOptions(context.Background())
+	require.NoError(t, err)
+	sink := NewMQSink(client, fmt.Sprintf("cdc-sink-%d-", time.Now().UnixNano()))
+	defer sink.Close()
+	assert.Equal(t, SinkKafka, sink.Name())
+
+	events := []*Event{newTestEvent("a", 1, commonpb.MsgType_Insert), newTestEvent("b", 1, commonpb.MsgType_DropCollection)}
+	require.NoError(t, sink.Publish(context.Background(), events))
+	// the producer of the collection dropped is closed
+	assert.Empty(t, sink.producers)
+
+	consumerClient, err := nmq.NewClientWithDefaultOptions(context.Background())
+	require.NoError(t, err)
+	defer consumerClient.Close()
+	consumer, err := consumerClient.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       sink.Topic(1),
+		SubscriptionName:            "cdc-sink-test",
+		SubscriptionInitialPosition: mqwrapper.SubscriptionPositionEarliest,
+		BufSize:                     16,
+	})
+	require.NoError(t, err)
+	defer consumer.Close()
+
+	for _, expected := range events {
+		select {
+		case msg := <-consumer.Chan():
+			event := &Event{}
+			require.NoError(t, json.Unmarshal(msg.Payload(), event))
+			assert.Equal(t, expected.ID, event.ID)
+			assert.Equal(t, expected.ID, msg.Properties()[EventIDProperty])
+			assert.Equal(t, expected.Type, msg.Properties()[EventTypeProperty])
+		case <-time.After(10 * time.Second):
+			t.Fatal("event not consumed")
+		}
+	}
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/tiered"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -65,7 +67,7 @@ func NewFactory(standAlone bool) *DefaultFactory {
 // In order to guarantee backward compatibility of config file, we still support multiple mq configs.
 // The initialization of MQ follows the following rules, if the mq.type is default.
 // 1. standalone(local) mode: rocksmq(default) > natsmq > Pulsar > Kafka
-// 2. cluster mode:  Pulsar(default) > Kafka (rocksmq and natsmq is unsupported in cluster mode)
+// 2. cluster mode:  Pulsar(default) > Kafka (rocksmq and the embedded natsmq is unsupported in cluster mode)
 func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 	// skip if using default factory
 	if f.msgStreamFactory != nil {
@@ -78,6 +80,25 @@ func (f *DefaultFactory) Init(params *paramtable.ComponentParam) {
 	if err := f.initMQ(f.standAlone, params); err != nil {
 		panic(err)
 	}
+
+	if params.MQCfg.TieringEnabled.GetAsBool() {
+		if err := f.initTiering(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// initTiering wraps the mq clients of the msgstreams to read back the messages offloaded to the object storage.
+func (f *DefaultFactory) initTiering() error {
+	cm, err := f.chunkManagerFactory.NewPersistentStorageChunkManager(context.Background())
+	if err != nil {
+		return err
+	}
+	msgstream.SetClientWrapper(func(client mqwrapper.Client) mqwrapper.Client {
+		return tiered.NewClient(client, cm)
+	})
+	log.Info("mq tiering enabled", zap.String("rootPath", cm.RootPath()))
+	return nil
 }
 
 func (f *DefaultFactory) initMQ(standalone bool, params *paramtable.ComponentParam) error {
@@ -130,8 +151,10 @@ func validateMQType(standalone bool, mqType string) error {
 	if mqType != mqTypeNatsmq && mqType != mqTypeRocksmq && mqType != mqTypeKafka && mqType != mqTypePulsar {
 		return errors.Newf("mq type %s is invalid", mqType)
 	}
-	if !standalone && (mqType == mqTypeRocksmq || mqType == mqTypeNatsmq) {
-		return errors.Newf("mq %s is only valid in standalone mode")
+	// natsmq is valid in cluster mode only if connecting to the external nats server
+	embedded := mqType == mqTypeRocksmq || (mqType == mqTypeNatsmq && paramtable.Get().NatsmqCfg.Address.GetValue() == "")
+	if !standalone && embedded {
+		return errors.Newf("mq %s is only valid in standalone mode", mqType)
 	}
 	return nil
 }
@@ -148,6 +171,15 @@ func (f *DefaultFactory) NewMsgStreamDisposer(ctx context.Context) func([]string
 	return f.msgStreamFactory.NewMsgStreamDisposer(ctx)
 }
 
+// NewClient creates the client of the mq, which is not wrapped to read back the messages offloaded.
+func (f *DefaultFactory) NewClient(ctx context.Context) (mqwrapper.Client, error) {
+	clientFactory, ok := f.msgStreamFactory.(msgstream.ClientFactory)
+	if !ok {
+		return nil, errors.New("the mq does not support creating client")
+	}
+	return clientFactory.NewClient(ctx)
+}
+
 func (f *DefaultFactory) NewPersistentStorageChunkManager(ctx context.Context) (storage.ChunkManager, error) {
 	return f.chunkManagerFactory.NewPersistentStorageChunkManager(ctx)
 }
@@ -4,13 +4,21 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func TestValidateMQType(t *testing.T) {
+	paramtable.Init()
 	assert.Error(t, validateMQType(true, mqTypeDefault))
 	assert.Error(t, validateMQType(false, mqTypeDefault))
 	assert.Error(t, validateMQType(false, mqTypeNatsmq))
 	assert.Error(t, validateMQType(false, mqTypeRocksmq))
+
+	paramtable.Get().Save(paramtable.Get().NatsmqCfg.Address.Key, "nats://localhost:4222")
+	defer paramtable.Get().Reset(paramtable.Get().NatsmqCfg.Address.Key)
+	assert.NoError(t, validateMQType(false, mqTypeNatsmq))
+	assert.Error(t, validateMQType(false, mqTypeRocksmq))
 }
 
 func TestSelectMQType(t *testing.T) {
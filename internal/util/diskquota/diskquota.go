@@ -0,0 +1,111 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diskquota accounts the local disk used by the components of the process,
+// all of them share the quota of localStorage.quotaRatio of the local disk capacity.
+// The consumers writing the local files reserve the room before written and release it once removed,
+// the others measuring the usage by walking the files report it by Set.
+package diskquota
+
+import (
+	"sync"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	// ConsumerMmap is the mmap files of the sealed segments of query node
+	ConsumerMmap = "mmap"
+	// ConsumerChunkCache is the chunk cache of query node
+	ConsumerChunkCache = "chunk_cache"
+	// ConsumerLazyLoad is the data of the lazy load segments of query node
+	ConsumerLazyLoad = "lazy_load"
+	// ConsumerDeleteBuffer is the delete buffer spilled by the delegators
+	ConsumerDeleteBuffer = "delete_buffer"
+	// ConsumerWriteBuffer is the write buffer spilled by data node
+	ConsumerWriteBuffer = "write_buffer"
+	// ConsumerCompactionSort is the sorted runs spilled by the compactions sorting by the clustering key
+	ConsumerCompactionSort = "compaction_sort"
+)
+
+var (
+	mu    sync.Mutex
+	usage = make(map[string]int64)
+)
+
+// Limit returns the quota in bytes of the local disk.
+func Limit() int64 {
+	params := paramtable.Get()
+	return int64(float64(params.LocalStorageCfg.DiskCapacityLimit.GetAsInt64()) * params.LocalStorageCfg.QuotaRatio.GetAsFloat())
+}
+
+// Reserve reserves the room of size for the consumer before writing the local files,
+// returns ErrServiceDiskLimitExceeded if the quota would be exceeded.
+func Reserve(consumer string, size int64) error {
+	mu.Lock()
+	defer mu.Unlock()
+	total := totalLocked()
+	if limit := Limit(); total+size > limit {
+		return merr.WrapErrServiceDiskLimitExceeded(float32(total+size), float32(limit), consumer)
+	}
+	usage[consumer] += size
+	return nil
+}
+
+// Release releases the room of size reserved by the consumer once the local files removed.
+func Release(consumer string, size int64) {
+	mu.Lock()
+	defer mu.Unlock()
+	usage[consumer] -= size
+	if usage[consumer] < 0 {
+		usage[consumer] = 0
+	}
+}
+
+// Set sets the usage of the consumer measured by walking the local files.
+func Set(consumer string, size int64) {
+	mu.Lock()
+	defer mu.Unlock()
+	usage[consumer] = size
+}
+
+// Usage returns the usage in bytes of the consumer.
+func Usage(consumer string) int64 {
+	mu.Lock()
+	defer mu.Unlock()
+	return usage[consumer]
+}
+
+// Total returns the usage in bytes of all the consumers.
+func Total() int64 {
+	mu.Lock()
+	defer mu.Unlock()
+	return totalLocked()
+}
+
+// Available returns the room in bytes left by all the consumers, which is negative if the quota exceeded.
+func Available() int64 {
+	return Limit() - Total()
+}
+
+func totalLocked() int64 {
+	var total int64
+	for _, size := range usage {
+		total += size
+	}
+	return total
+}
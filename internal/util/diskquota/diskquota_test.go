@@ -0,0 +1,59 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskquota
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestDiskQuota(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	// 1GB * 0.5
+	params.Save(params.LocalStorageCfg.DiskCapacityLimit.Key, "1")
+	params.Save(params.LocalStorageCfg.QuotaRatio.Key, "0.5")
+	defer params.Reset(params.LocalStorageCfg.DiskCapacityLimit.Key)
+	defer params.Reset(params.LocalStorageCfg.QuotaRatio.Key)
+
+	limit := Limit()
+	assert.EqualValues(t, 512*1024*1024, limit)
+
+	Set(ConsumerMmap, limit/2)
+	defer Set(ConsumerMmap, 0)
+	assert.NoError(t, Reserve(ConsumerCompactionSort, limit/4))
+	assert.EqualValues(t, limit/4, Usage(ConsumerCompactionSort))
+	assert.EqualValues(t, limit*3/4, Total())
+	assert.EqualValues(t, limit/4, Available())
+
+	err := Reserve(ConsumerDeleteBuffer, limit/2)
+	assert.ErrorIs(t, err, merr.ErrServiceDiskLimitExceeded)
+	assert.EqualValues(t, 0, Usage(ConsumerDeleteBuffer))
+
+	Release(ConsumerCompactionSort, limit/4)
+	assert.EqualValues(t, 0, Usage(ConsumerCompactionSort))
+	assert.NoError(t, Reserve(ConsumerDeleteBuffer, limit/2))
+	assert.EqualValues(t, 0, Available())
+
+	// released more than reserved
+	Release(ConsumerDeleteBuffer, limit)
+	assert.EqualValues(t, 0, Usage(ConsumerDeleteBuffer))
+}
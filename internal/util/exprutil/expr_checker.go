@@ -174,6 +174,15 @@ func (planRange *PlanRange) ToStrRange() *StrRange {
 	return sRange
 }
 
+// PointValue returns the only value in the range, such as the ranges parsed from the equal and term exprs.
+func (planRange *PlanRange) PointValue() (*planpb.GenericValue, bool) {
+	if planRange.lower == nil || planRange.upper == nil || !planRange.includeLower || !planRange.includeUpper ||
+		compareGenericValue(planRange.lower, planRange.upper) != 0 {
+		return nil, false
+	}
+	return planRange.lower, true
+}
+
 type IntRange struct {
 	lower        int64
 	upper        int64
@@ -246,16 +255,33 @@ principles for range parsing
 4. no handling Term and Range at the same time
 */
 
+// columnMatcher tells whether the column is the one to parse ranges for.
+type columnMatcher func(column *planpb.ColumnInfo) bool
+
 func ParseRanges(expr *planpb.Expr, kType KeyType) ([]*PlanRange, bool) {
+	return parseRanges(expr, func(column *planpb.ColumnInfo) bool {
+		return column.GetIsPartitionKey() && kType == PartitionKey ||
+			column.GetIsClusteringKey() && kType == ClusteringKey
+	})
+}
+
+// ParseFieldRanges parses the ranges of the field from the expr, nested json paths are not matched.
+func ParseFieldRanges(expr *planpb.Expr, fieldID int64) ([]*PlanRange, bool) {
+	return parseRanges(expr, func(column *planpb.ColumnInfo) bool {
+		return column.GetFieldId() == fieldID && len(column.GetNestedPath()) == 0
+	})
+}
+
+func parseRanges(expr *planpb.Expr, match columnMatcher) ([]*PlanRange, bool) {
 	var res []*PlanRange
 	matchALL := true
 	switch expr := expr.GetExpr().(type) {
 	case *planpb.Expr_BinaryExpr:
-		res, matchALL = ParseRangesFromBinaryExpr(expr.BinaryExpr, kType)
+		res, matchALL = parseRangesFromBinaryExpr(expr.BinaryExpr, match)
 	case *planpb.Expr_UnaryRangeExpr:
-		res, matchALL = ParseRangesFromUnaryRangeExpr(expr.UnaryRangeExpr, kType)
+		res, matchALL = parseRangesFromUnaryRangeExpr(expr.UnaryRangeExpr, match)
 	case *planpb.Expr_TermExpr:
-		res, matchALL = ParseRangesFromTermExpr(expr.TermExpr, kType)
+		res, matchALL = parseRangesFromTermExpr(expr.TermExpr, match)
 	case *planpb.Expr_UnaryExpr:
 		res, matchALL = nil, true
 		// we don't handle NOT operation, just consider as unable_to_parse_range
@@ -263,7 +289,7 @@ func ParseRanges(expr *planpb.Expr, kType KeyType) ([]*PlanRange, bool) {
 	return res, matchALL
 }
 
-func ParseRangesFromBinaryExpr(expr *planpb.BinaryExpr, kType KeyType) ([]*PlanRange, bool) {
+func parseRangesFromBinaryExpr(expr *planpb.BinaryExpr, match columnMatcher) ([]*PlanRange, bool) {
 	if expr.Op == planpb.BinaryExpr_LogicalOr {
 		return nil, true
 	}
@@ -274,8 +300,8 @@ func ParseRangesFromBinaryExpr(expr *planpb.BinaryExpr, kType KeyType) ([]*PlanR
 		// we will terminate the prune process
 		return nil, true
 	}
-	leftRanges, leftALL := ParseRanges(expr.Left, kType)
-	rightRanges, rightALL := ParseRanges(expr.Right, kType)
+	leftRanges, leftALL := parseRanges(expr.Left, match)
+	rightRanges, rightALL := parseRanges(expr.Right, match)
 	if leftALL && rightALL {
 		return nil, true
 	} else if leftALL && !rightALL {
@@ -294,9 +320,8 @@ func ParseRangesFromBinaryExpr(expr *planpb.BinaryExpr, kType KeyType) ([]*PlanR
 	return []*PlanRange{intersected}, matchALL
 }
 
-func ParseRangesFromUnaryRangeExpr(expr *planpb.UnaryRangeExpr, kType KeyType) ([]*PlanRange, bool) {
-	if expr.GetColumnInfo().GetIsPartitionKey() && kType == PartitionKey ||
-		expr.GetColumnInfo().GetIsClusteringKey() && kType == ClusteringKey {
+func parseRangesFromUnaryRangeExpr(expr *planpb.UnaryRangeExpr, match columnMatcher) ([]*PlanRange, bool) {
+	if match(expr.GetColumnInfo()) {
 		switch expr.GetOp() {
 		case planpb.OpType_Equal:
 			{
@@ -358,9 +383,8 @@ func ParseRangesFromUnaryRangeExpr(expr *planpb.UnaryRangeExpr, kType KeyType) (
 	return nil, true
 }
 
-func ParseRangesFromTermExpr(expr *planpb.TermExpr, kType KeyType) ([]*PlanRange, bool) {
-	if expr.GetColumnInfo().GetIsPartitionKey() && kType == PartitionKey ||
-		expr.GetColumnInfo().GetIsClusteringKey() && kType == ClusteringKey {
+func parseRangesFromTermExpr(expr *planpb.TermExpr, match columnMatcher) ([]*PlanRange, bool) {
+	if match(expr.GetColumnInfo()) {
 		res := make([]*PlanRange, 0)
 		for _, value := range expr.GetValues() {
 			res = append(res, &PlanRange{
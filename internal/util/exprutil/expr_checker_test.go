@@ -277,3 +277,47 @@ func TestParseStrRanges(t *testing.T) {
 		assert.Equal(t, range0.includeUpper, false)
 	}
 }
+
+func TestParseFieldRanges(t *testing.T) {
+	fieldName2Type := make(map[string]schemapb.DataType)
+	fieldName2Type["int64_field"] = schemapb.DataType_Int64
+	fieldName2Type["int32_field"] = schemapb.DataType_Int32
+	fieldName2Type["varChar_field"] = schemapb.DataType_VarChar
+	fieldName2Type["fvec_field"] = schemapb.DataType_FloatVector
+	schema := testutil.ConstructCollectionSchemaByDataType("TestParseFieldRanges"+funcutil.GenRandomStr(), fieldName2Type,
+		"int64_field", false, 8)
+	fieldIDs := make(map[string]int64)
+	for i, field := range schema.Fields {
+		field.FieldID = int64(common.StartOfUserFieldID + i)
+		fieldIDs[field.GetName()] = field.GetFieldID()
+	}
+	schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+	require.NoError(t, err)
+
+	parse := func(expr string, fieldName string) ([]*PlanRange, bool) {
+		queryPlan, err := planparserv2.CreateRetrievePlan(schemaHelper, expr)
+		require.NoError(t, err)
+		planExpr, err := ParseExprFromPlan(queryPlan)
+		require.NoError(t, err)
+		return ParseFieldRanges(planExpr, fieldIDs[fieldName])
+	}
+
+	expr := "int32_field > 10 and varChar_field == \"abc\""
+	parsedRanges, matchALL := parse(expr, "int32_field")
+	assert.False(t, matchALL)
+	require.Equal(t, 1, len(parsedRanges))
+	assert.Equal(t, int64(10), parsedRanges[0].lower.GetInt64Val())
+	assert.Nil(t, parsedRanges[0].upper)
+
+	parsedRanges, matchALL = parse(expr, "varChar_field")
+	assert.False(t, matchALL)
+	require.Equal(t, 1, len(parsedRanges))
+	assert.Equal(t, "abc", parsedRanges[0].lower.GetStringVal())
+	assert.Equal(t, "abc", parsedRanges[0].upper.GetStringVal())
+
+	_, matchALL = parse(expr, "int64_field")
+	assert.True(t, matchALL)
+
+	_, matchALL = parse("int32_field > 10 or varChar_field == \"abc\"", "int32_field")
+	assert.True(t, matchALL)
+}
@@ -111,6 +111,42 @@ func (fg *TimeTickedFlowGraph) SetCloseMethod(gracefully bool) {
 	}
 }
 
+// Pause stops the input nodes consuming the inputs until resumed.
+func (fg *TimeTickedFlowGraph) Pause() {
+	for _, v := range fg.nodeCtx {
+		if v.node.IsInputNode() {
+			v.node.(*InputNode).Pause()
+		}
+	}
+}
+
+func (fg *TimeTickedFlowGraph) Resume() {
+	for _, v := range fg.nodeCtx {
+		if v.node.IsInputNode() {
+			v.node.(*InputNode).Resume()
+		}
+	}
+}
+
+// SetParallelism sets the number of workers running the nodes, the nodes are split into the stages
+// pipelined by the node queues if the parallelism is above 1. It shall be called after the nodes assembled.
+func (fg *TimeTickedFlowGraph) SetParallelism(parallelism int) {
+	fg.nodeCtxManager.SetParallelism(parallelism)
+}
+
+func (fg *TimeTickedFlowGraph) Parallelism() int {
+	return fg.nodeCtxManager.Parallelism()
+}
+
+// EnableMetrics reports the processing latency and the queue length of the nodes with the labels of the channel,
+// which shall be called after the nodes assembled and before started.
+func (fg *TimeTickedFlowGraph) EnableMetrics(nodeID int64, channel string) {
+	fg.nodeCtxManager.metrics = &nodeMetrics{
+		nodeID:  fmt.Sprint(nodeID),
+		channel: channel,
+	}
+}
+
 // Close closes all nodes in flowgraph
 func (fg *TimeTickedFlowGraph) Close() {
 	fg.stopOnce.Do(func() {
@@ -221,6 +221,42 @@ func TestTimeTickedFlowGraph_Start(t *testing.T) {
 	time.Sleep(50 * time.Millisecond)
 }
 
+func TestTimeTickedFlowGraph_Parallelism(t *testing.T) {
+	fg, inputChan, outputChan, cancel, err := createExampleFlowGraph()
+	assert.NoError(t, err)
+	defer cancel()
+	assert.Equal(t, 1, fg.Parallelism())
+	fg.SetParallelism(3)
+	fg.EnableMetrics(1, "ch-1")
+	fg.Start()
+	defer fg.Close()
+
+	check := func() {
+		for i := 0; i < 10; i++ {
+			a := float64(i)
+			inputChan <- a
+			select {
+			case d := <-outputChan:
+				assert.Equal(t, math.Pow(a, 2)+2, d)
+			case <-time.After(5 * time.Second):
+				assert.FailNow(t, "no output")
+			}
+		}
+	}
+	check()
+
+	// restarted with the new parallelism on the next input
+	fg.SetParallelism(2)
+	assert.Equal(t, 2, fg.Parallelism())
+	check()
+
+	// ignored
+	fg.SetParallelism(0)
+	assert.Equal(t, 2, fg.Parallelism())
+	fg.SetParallelism(1)
+	check()
+}
+
 func TestTimeTickedFlowGraph_Close(t *testing.T) {
 	fg, _, _, cancel, err := createExampleFlowGraph()
 	assert.NoError(t, err)
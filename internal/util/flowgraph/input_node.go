@@ -19,6 +19,7 @@ package flowgraph
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -53,6 +54,10 @@ type InputNode struct {
 
 	closeGracefully *atomic.Bool
 
+	pauseMu  sync.Mutex
+	resumeCh chan struct{} // not nil while paused
+	closed   bool
+
 	skipMode            bool
 	skipCount           int
 	lastNotTimetickTime time.Time
@@ -80,8 +85,58 @@ func (inNode *InputNode) SetCloseMethod(gracefully bool) {
 		zap.Bool("gracefully", gracefully))
 }
 
+// Pause stops consuming the input until resumed, the messages are left in the input.
+func (inNode *InputNode) Pause() {
+	inNode.pauseMu.Lock()
+	defer inNode.pauseMu.Unlock()
+	if inNode.resumeCh == nil && !inNode.closed {
+		inNode.resumeCh = make(chan struct{})
+		log.Info("input node paused", zap.String("node", inNode.Name()), zap.Int64("collection", inNode.collectionID))
+	}
+}
+
+// Resume continues consuming the input paused.
+func (inNode *InputNode) Resume() {
+	inNode.pauseMu.Lock()
+	defer inNode.pauseMu.Unlock()
+	inNode.resume()
+}
+
+func (inNode *InputNode) resume() {
+	if inNode.resumeCh != nil {
+		close(inNode.resumeCh)
+		inNode.resumeCh = nil
+		log.Info("input node resumed", zap.String("node", inNode.Name()), zap.Int64("collection", inNode.collectionID))
+	}
+}
+
+func (inNode *InputNode) IsPaused() bool {
+	inNode.pauseMu.Lock()
+	defer inNode.pauseMu.Unlock()
+	return inNode.resumeCh != nil
+}
+
+// waitResumed blocks until the input node resumed or closed.
+func (inNode *InputNode) waitResumed() {
+	inNode.pauseMu.Lock()
+	resumeCh := inNode.resumeCh
+	inNode.pauseMu.Unlock()
+	if resumeCh != nil {
+		<-resumeCh
+	}
+}
+
+// Close resumes the input node paused, so that it could consume the input closed and quit.
+func (inNode *InputNode) Close() {
+	inNode.pauseMu.Lock()
+	defer inNode.pauseMu.Unlock()
+	inNode.closed = true
+	inNode.resume()
+}
+
 // Operate consume a message pack from msgstream and return
 func (inNode *InputNode) Operate(in []Msg) []Msg {
+	inNode.waitResumed()
 	msgPack, ok := <-inNode.input
 	if !ok {
 		log := log.With(
@@ -27,6 +27,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/util/dependency"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -73,6 +74,42 @@ func Test_NewInputNode(t *testing.T) {
 	assert.Equal(t, node.maxParallelism, maxParallelism)
 }
 
+func Test_InputNodePause(t *testing.T) {
+	input := make(chan *msgstream.MsgPack, 1)
+	node := NewInputNode(input, "input_node", 100, 100, "", 0, 0, "")
+	node.Pause()
+	assert.True(t, node.IsPaused())
+
+	input <- &msgstream.MsgPack{}
+	outputCh := make(chan []Msg, 1)
+	go func() {
+		outputCh <- node.Operate(nil)
+	}()
+	select {
+	case <-outputCh:
+		assert.Fail(t, "input consumed while paused")
+	case <-time.After(100 * time.Millisecond):
+	}
+	assert.Len(t, input, 1)
+
+	node.Resume()
+	assert.False(t, node.IsPaused())
+	output := <-outputCh
+	assert.Len(t, output, 1)
+
+	// closed input node is not paused, so that it could quit
+	node.Pause()
+	close(input)
+	go func() {
+		outputCh <- node.Operate(nil)
+	}()
+	node.Close()
+	output = <-outputCh
+	assert.True(t, isCloseMsg(output))
+	node.Pause()
+	assert.False(t, node.IsPaused())
+}
+
 func Test_InputNodeSkipMode(t *testing.T) {
 	t.Setenv("ROCKSMQ_PATH", "/tmp/MilvusTest/FlowGraph/Test_InputNodeSkipMode")
 	factory := dependency.NewDefaultFactory(true)
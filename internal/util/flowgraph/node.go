@@ -21,9 +21,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 )
 
@@ -59,6 +61,13 @@ type nodeCtxManager struct {
 	closeWg      *sync.WaitGroup
 	closeOnce    sync.Once
 	closeCh      chan struct{} // notify nodes to exit
+
+	mu sync.Mutex
+	// parallelism is the number of workers running the stages of the pipeline
+	parallelism int
+	resetCh     chan struct{} // closed to restart the workers with the new parallelism
+
+	metrics *nodeMetrics
 }
 
 // NewNodeCtxManager init with the inputNode and fg.closeWg
@@ -67,6 +76,8 @@ func NewNodeCtxManager(nodeCtx *nodeCtx, closeWg *sync.WaitGroup) *nodeCtxManage
 		inputNodeCtx: nodeCtx,
 		closeWg:      closeWg,
 		closeCh:      make(chan struct{}),
+		parallelism:  1,
+		resetCh:      make(chan struct{}),
 	}
 }
 
@@ -78,6 +89,42 @@ func (nodeCtxManager *nodeCtxManager) Start() {
 	go nodeCtxManager.workNodeStart()
 }
 
+// SetParallelism sets the number of workers running the stages of the pipeline,
+// the nodes are split into the stages evenly in order, and the stages are pipelined by the node queues.
+// It takes effect once the messages in process are delivered.
+func (nodeCtxManager *nodeCtxManager) SetParallelism(parallelism int) {
+	nodeCtxManager.mu.Lock()
+	defer nodeCtxManager.mu.Unlock()
+	if parallelism < 1 || parallelism == nodeCtxManager.parallelism {
+		return
+	}
+	nodeCtxManager.parallelism = parallelism
+	if nodeCtxManager.resetCh != nil {
+		close(nodeCtxManager.resetCh)
+	}
+	nodeCtxManager.resetCh = make(chan struct{})
+}
+
+func (nodeCtxManager *nodeCtxManager) Parallelism() int {
+	nodeCtxManager.mu.Lock()
+	defer nodeCtxManager.mu.Unlock()
+	return lo.Max([]int{nodeCtxManager.parallelism, 1})
+}
+
+// stages splits the nodes into the stages by the parallelism, returns the head node of each stage.
+func (nodeCtxManager *nodeCtxManager) stages(parallelism int) []*nodeCtx {
+	var nodes []*nodeCtx
+	for curNode := nodeCtxManager.inputNodeCtx; curNode != nil; curNode = curNode.downstream {
+		nodes = append(nodes, curNode)
+	}
+	parallelism = lo.Clamp(parallelism, 1, len(nodes))
+	heads := make([]*nodeCtx, 0, parallelism)
+	for i := 0; i < parallelism; i++ {
+		heads = append(heads, nodes[i*len(nodes)/parallelism])
+	}
+	return heads
+}
+
 func (nodeCtxManager *nodeCtxManager) workNodeStart() {
 	defer nodeCtxManager.closeWg.Done()
 	inputNode := nodeCtxManager.inputNodeCtx
@@ -97,51 +144,111 @@ func (nodeCtxManager *nodeCtxManager) workNodeStart() {
 	}
 
 	for {
+		nodeCtxManager.mu.Lock()
+		resetCh := nodeCtxManager.resetCh
+		heads := nodeCtxManager.stages(nodeCtxManager.parallelism)
+		nodeCtxManager.mu.Unlock()
+
+		// each stage exits after the upstream one exited and the messages left in its queue processed,
+		// so that no message is left in the queues between the stages on restart
+		wg := &sync.WaitGroup{}
+		var upstreamDone chan struct{}
+		for i, head := range heads {
+			var tail *nodeCtx
+			if i < len(heads)-1 {
+				tail = heads[i+1]
+			}
+			done := make(chan struct{})
+			wg.Add(1)
+			go func(head, tail *nodeCtx, upstreamDone chan struct{}) {
+				defer wg.Done()
+				defer close(done)
+				nodeCtxManager.runStage(head, tail, checker, resetCh, upstreamDone)
+			}(head, tail, upstreamDone)
+			upstreamDone = done
+		}
+		wg.Wait()
+
 		select {
 		case <-nodeCtxManager.closeCh:
 			return
-		// handles node work spinning
-		// 1. collectMessage from upstream or just produce Msg from InputNode
-		// 2. invoke node.Operate
-		// 3. deliver the Operate result to downstream nodes
 		default:
-			curNode = inputNode
-			for curNode != nil {
-				// inputs from inputsMessages for Operate
-				var input, output []Msg
-				if curNode != inputNode {
-					// inputNode.input not from nodeCtx.inputChannel
-					input = <-curNode.inputChannel
-				}
-				// the input message decides whether the operate method is executed
-				n := curNode.node
-				curNode.blockMutex.RLock()
-				if !n.IsValidInMsg(input) {
-					curNode.blockMutex.RUnlock()
-					curNode = inputNode
-					continue
-				}
+			log.Info("flow graph restarted with new parallelism",
+				zap.String("inputNode", inputNode.node.Name()),
+				zap.Int("parallelism", nodeCtxManager.Parallelism()))
+		}
+	}
+}
 
-				output = n.Operate(input)
-				curNode.blockMutex.RUnlock()
-				// the output decide whether the node should be closed.
-				if isCloseMsg(output) {
-					nodeCtxManager.closeOnce.Do(func() {
-						close(nodeCtxManager.closeCh)
-					})
-					if curNode.inputChannel != nil {
-						close(curNode.inputChannel)
-					}
-				}
-				// deliver to all following flow graph node.
-				if curNode.downstream != nil {
-					curNode.downstream.inputChannel <- output
-				}
-				if enableTtChecker {
-					checker.Check(fmt.Sprintf("nodeCtxTtChecker-%s", curNode.node.Name()))
+// runStage runs the nodes from head until tail (exclusive) repeatedly.
+func (nodeCtxManager *nodeCtxManager) runStage(head, tail *nodeCtx, checker *timerecord.GroupChecker, resetCh, upstreamDone chan struct{}) {
+	for {
+		var input []Msg
+		if head == nodeCtxManager.inputNodeCtx {
+			select {
+			case <-nodeCtxManager.closeCh:
+				return
+			case <-resetCh:
+				return
+			default:
+			}
+		} else {
+			var ok bool
+			select {
+			case input, ok = <-head.inputChannel:
+			case <-upstreamDone:
+				select {
+				case input, ok = <-head.inputChannel:
+				default:
+					return
 				}
-				curNode = curNode.downstream
 			}
+			if !ok {
+				return
+			}
+		}
+		nodeCtxManager.operate(head, tail, input, checker)
+	}
+}
+
+// handles node work spinning
+// 1. collectMessage from upstream or just produce Msg from InputNode
+// 2. invoke node.Operate
+// 3. deliver the Operate result to downstream nodes
+func (nodeCtxManager *nodeCtxManager) operate(head, tail *nodeCtx, input []Msg, checker *timerecord.GroupChecker) {
+	for curNode := head; curNode != tail; curNode = curNode.downstream {
+		if curNode != head {
+			// inputs from inputsMessages for Operate
+			input = <-curNode.inputChannel
+		}
+		// the input message decides whether the operate method is executed
+		n := curNode.node
+		curNode.blockMutex.RLock()
+		if !n.IsValidInMsg(input) {
+			curNode.blockMutex.RUnlock()
+			return
+		}
+
+		start := time.Now()
+		output := n.Operate(input)
+		curNode.blockMutex.RUnlock()
+		nodeCtxManager.metrics.observeLatency(n.Name(), time.Since(start))
+		// the output decide whether the node should be closed.
+		if isCloseMsg(output) {
+			nodeCtxManager.closeOnce.Do(func() {
+				close(nodeCtxManager.closeCh)
+			})
+			if curNode.inputChannel != nil {
+				close(curNode.inputChannel)
+			}
+		}
+		// deliver to all following flow graph node.
+		if curNode.downstream != nil {
+			curNode.downstream.inputChannel <- output
+			nodeCtxManager.metrics.observeQueueLength(curNode.downstream.node.Name(), len(curNode.downstream.inputChannel))
+		}
+		if enableTtChecker {
+			checker.Check(fmt.Sprintf("nodeCtxTtChecker-%s", curNode.node.Name()))
 		}
 	}
 }
@@ -152,6 +259,27 @@ func (nodeCtxManager *nodeCtxManager) Close() {
 	nodeCtx.Close()
 }
 
+// nodeMetrics reports the processing latency and the queue length of the nodes of the flowgraph
+// consuming the channel, nil if the metrics not enabled.
+type nodeMetrics struct {
+	nodeID  string
+	channel string
+}
+
+func (m *nodeMetrics) observeLatency(name string, elapse time.Duration) {
+	if m == nil {
+		return
+	}
+	metrics.DataNodeFlowGraphNodeLatency.WithLabelValues(m.nodeID, m.channel, name).Observe(float64(elapse.Microseconds()) / 1000)
+}
+
+func (m *nodeMetrics) observeQueueLength(name string, length int) {
+	if m == nil {
+		return
+	}
+	metrics.DataNodeFlowGraphQueueLength.WithLabelValues(m.nodeID, m.channel, name).Set(float64(length))
+}
+
 // nodeCtx maintains the running context for a Node in flowgragh
 type nodeCtx struct {
 	node         Node
@@ -118,6 +118,26 @@ func TestNodeManager_Start(t *testing.T) {
 	nodeCtxManager.Close()
 }
 
+func TestNodeManager_Stages(t *testing.T) {
+	nodes := make([]*nodeCtx, 5)
+	for i := range nodes {
+		nodes[i] = &nodeCtx{}
+		if i > 0 {
+			nodes[i-1].downstream = nodes[i]
+		}
+	}
+	nodeCtxManager := NewNodeCtxManager(nodes[0], &sync.WaitGroup{})
+	assert.Equal(t, []*nodeCtx{nodes[0]}, nodeCtxManager.stages(1))
+	assert.Equal(t, []*nodeCtx{nodes[0], nodes[2]}, nodeCtxManager.stages(2))
+	assert.Equal(t, []*nodeCtx{nodes[0], nodes[1], nodes[3]}, nodeCtxManager.stages(3))
+	assert.Equal(t, nodes, nodeCtxManager.stages(10))
+
+	nodeCtxManager.SetParallelism(-1)
+	assert.Equal(t, 1, nodeCtxManager.Parallelism())
+	nodeCtxManager.SetParallelism(4)
+	assert.Equal(t, 4, nodeCtxManager.Parallelism())
+}
+
 func TestBaseNode(t *testing.T) {
 	node := &BaseNode{
 		maxQueueLength: 10,
@@ -34,6 +34,10 @@ const (
 	EndTs      = "end_ts"
 	EndTs2     = "endTs"
 	BackupFlag = "backup"
+
+	// IdempotencyKey deduplicates the retries of the import request,
+	// the requests with the same key within the deduplication window share the same import job.
+	IdempotencyKey = "idempotency_key"
 )
 
 type Options []*commonpb.KeyValuePair
@@ -76,3 +80,11 @@ func IsBackup(options Options) bool {
 	}
 	return true
 }
+
+func GetIdempotencyKey(options Options) string {
+	key, err := funcutil.GetAttrByKeyFromRepeatedKV(IdempotencyKey, options)
+	if err != nil {
+		return ""
+	}
+	return key
+}
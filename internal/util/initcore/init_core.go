@@ -28,12 +28,15 @@ package initcore
 import "C"
 
 import (
+	"context"
 	"fmt"
 	"unsafe"
 
 	"github.com/cockroachdb/errors"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -65,48 +68,93 @@ func InitTraceConfig(params *paramtable.ComponentParam) {
 }
 
 func InitRemoteChunkManager(params *paramtable.ComponentParam) error {
-	cAddress := C.CString(params.MinioCfg.Address.GetValue())
-	cBucketName := C.CString(params.MinioCfg.BucketName.GetValue())
-	cAccessKey := C.CString(params.MinioCfg.AccessKeyID.GetValue())
-	cAccessValue := C.CString(params.MinioCfg.SecretAccessKey.GetValue())
-	cRootPath := C.CString(params.MinioCfg.RootPath.GetValue())
-	cStorageType := C.CString(params.CommonCfg.StorageType.GetValue())
-	cIamEndPoint := C.CString(params.MinioCfg.IAMEndpoint.GetValue())
-	cCloudProvider := C.CString(params.MinioCfg.CloudProvider.GetValue())
-	cLogLevel := C.CString(params.MinioCfg.LogLevel.GetValue())
-	cRegion := C.CString(params.MinioCfg.Region.GetValue())
-	cSslCACert := C.CString(params.MinioCfg.SslCACert.GetValue())
-	defer C.free(unsafe.Pointer(cAddress))
-	defer C.free(unsafe.Pointer(cBucketName))
-	defer C.free(unsafe.Pointer(cAccessKey))
-	defer C.free(unsafe.Pointer(cAccessValue))
-	defer C.free(unsafe.Pointer(cRootPath))
-	defer C.free(unsafe.Pointer(cStorageType))
-	defer C.free(unsafe.Pointer(cIamEndPoint))
-	defer C.free(unsafe.Pointer(cLogLevel))
-	defer C.free(unsafe.Pointer(cRegion))
-	defer C.free(unsafe.Pointer(cCloudProvider))
-	defer C.free(unsafe.Pointer(cSslCACert))
+	initStorageRequestBudget(params)
+	storageConfig, free := newCStorageConfig(params, common.CollectionStorage{})
+	defer free()
+	status := C.InitRemoteChunkManagerSingleton(storageConfig)
+	return HandleCStatus(&status, "InitRemoteChunkManagerSingleton failed")
+}
+
+// newCStorageConfig builds the storage config of segcore, which is the one of the cluster
+// overridden by the collection storage if set, the returned func frees the C strings.
+func newCStorageConfig(params *paramtable.ComponentParam, collectionStorage common.CollectionStorage) (C.CStorageConfig, func()) {
+	address := params.MinioCfg.Address.GetValue()
+	bucketName := params.MinioCfg.BucketName.GetValue()
+	accessKeyID := params.MinioCfg.AccessKeyID.GetValue()
+	secretAccessKey := params.MinioCfg.SecretAccessKey.GetValue()
+	rootPath := params.MinioCfg.RootPath.GetValue()
+	useIAM := params.MinioCfg.UseIAM.GetAsBool()
+	if collectionStorage.BucketName != "" {
+		bucketName = collectionStorage.BucketName
+		if collectionStorage.Address != "" {
+			address = collectionStorage.Address
+		}
+		if collectionStorage.RootPath != "" {
+			rootPath = collectionStorage.RootPath
+		}
+		if collectionStorage.AccessKeyID != "" {
+			accessKeyID = collectionStorage.AccessKeyID
+			secretAccessKey = collectionStorage.SecretAccessKey
+			useIAM = false
+		}
+	}
+
+	cStrings := make([]*C.char, 0, 11)
+	cString := func(s string) *C.char {
+		cs := C.CString(s)
+		cStrings = append(cStrings, cs)
+		return cs
+	}
 	storageConfig := C.CStorageConfig{
-		address:          cAddress,
-		bucket_name:      cBucketName,
-		access_key_id:    cAccessKey,
-		access_key_value: cAccessValue,
-		root_path:        cRootPath,
-		storage_type:     cStorageType,
-		iam_endpoint:     cIamEndPoint,
-		cloud_provider:   cCloudProvider,
+		address:          cString(address),
+		bucket_name:      cString(bucketName),
+		access_key_id:    cString(accessKeyID),
+		access_key_value: cString(secretAccessKey),
+		root_path:        cString(rootPath),
+		storage_type:     cString(params.CommonCfg.StorageType.GetValue()),
+		iam_endpoint:     cString(params.MinioCfg.IAMEndpoint.GetValue()),
+		cloud_provider:   cString(params.MinioCfg.CloudProvider.GetValue()),
 		useSSL:           C.bool(params.MinioCfg.UseSSL.GetAsBool()),
-		sslCACert:        cSslCACert,
-		useIAM:           C.bool(params.MinioCfg.UseIAM.GetAsBool()),
-		log_level:        cLogLevel,
-		region:           cRegion,
+		sslCACert:        cString(params.MinioCfg.SslCACert.GetValue()),
+		useIAM:           C.bool(useIAM),
+		log_level:        cString(params.MinioCfg.LogLevel.GetValue()),
+		region:           cString(params.MinioCfg.Region.GetValue()),
 		useVirtualHost:   C.bool(params.MinioCfg.UseVirtualHost.GetAsBool()),
 		requestTimeoutMs: C.int64_t(params.MinioCfg.RequestTimeoutMs.GetAsInt64()),
 	}
+	return storageConfig, func() {
+		for _, cs := range cStrings {
+			C.free(unsafe.Pointer(cs))
+		}
+	}
+}
 
-	status := C.InitRemoteChunkManagerSingleton(storageConfig)
-	return HandleCStatus(&status, "InitRemoteChunkManagerSingleton failed")
+// SyncCollectionStorage pushes the storage of the collection registered to segcore,
+// which reads the binlogs and the index files of the collection from its own bucket.
+func SyncCollectionStorage(params *paramtable.ComponentParam, collectionID int64) error {
+	collectionStorage, ok := storage.GetCollectionStorage(collectionID)
+	if !ok {
+		C.RemoveCollectionStorage(C.int64_t(collectionID))
+		return nil
+	}
+	storageConfig, free := newCStorageConfig(params, collectionStorage)
+	defer free()
+	status := C.SetCollectionStorage(C.int64_t(collectionID), storageConfig)
+	return HandleCStatus(&status, "SetCollectionStorage failed")
+}
+
+// InitReadCache enables the read cache of segcore if configured, which shares the cache dirs
+// with the chunk managers of the go side.
+func InitReadCache(params *paramtable.ComponentParam) error {
+	if !params.MinioCfg.ReadCacheEnabled.GetAsBool() {
+		return nil
+	}
+	cDirs := C.CString(params.MinioCfg.ReadCacheDirs.GetValue())
+	defer C.free(unsafe.Pointer(cDirs))
+	cBucketName := C.CString(params.MinioCfg.BucketName.GetValue())
+	defer C.free(unsafe.Pointer(cBucketName))
+	status := C.InitReadCacheSingleton(cDirs, cBucketName)
+	return HandleCStatus(&status, "InitReadCacheSingleton failed")
 }
 
 func InitChunkCache(mmapDirPath string, readAheadPolicy string) error {
@@ -118,6 +166,26 @@ func InitChunkCache(mmapDirPath string, readAheadPolicy string) error {
 	return HandleCStatus(&status, "InitChunkCacheSingleton failed")
 }
 
+// SyncCollectionDataKeys pushes the data keys of the collection to segcore if the chunk manager encrypts,
+// as segcore reads the binlogs and writes the index files itself.
+func SyncCollectionDataKeys(ctx context.Context, cm storage.ChunkManager, collectionID int64) error {
+	current, keys, err := storage.GetCollectionDataKeys(ctx, cm, collectionID)
+	if err != nil {
+		return err
+	}
+	for version, key := range keys {
+		status := C.SetCollectionDataKey(C.int64_t(collectionID),
+			C.int64_t(version),
+			(*C.uint8_t)(unsafe.Pointer(&key[0])),
+			C.int64_t(len(key)),
+			C.bool(version == current))
+		if err := HandleCStatus(&status, "SetCollectionDataKey failed"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func CleanRemoteChunkManager() {
 	C.CleanRemoteChunkManagerSingleton()
 }
@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package initcore
+
+/*
+#cgo pkg-config: milvus_storage
+
+#include <stdbool.h>
+#include <stdint.h>
+#include "storage/storage_c.h"
+
+extern int64_t goAcquireStorageRequestBudget(char* op);
+extern void goReleaseStorageRequestBudget(int64_t handle, bool failed);
+*/
+import "C"
+
+import (
+	"github.com/cockroachdb/errors"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+var errSegcoreRequestFailed = errors.New("segcore request to the object storage failed")
+
+// the budgets acquired by segcore, which releases them by the handles
+var (
+	requestBudgetHandle = atomic.NewInt64(0)
+	requestBudgetDones  = typeutil.NewConcurrentMap[int64, func(error)]()
+)
+
+//export goAcquireStorageRequestBudget
+func goAcquireStorageRequestBudget(op *C.char) C.int64_t {
+	done, err := storage.AcquireRequestBudget(C.GoString(op))
+	if err != nil {
+		log.RatedWarn(10, "failed to acquire the request budget for segcore", zap.Error(err))
+		return 0
+	}
+	handle := requestBudgetHandle.Inc()
+	requestBudgetDones.Insert(handle, done)
+	return C.int64_t(handle)
+}
+
+//export goReleaseStorageRequestBudget
+func goReleaseStorageRequestBudget(handle C.int64_t, failed C.bool) {
+	done, ok := requestBudgetDones.GetAndRemove(int64(handle))
+	if !ok {
+		return
+	}
+	if bool(failed) {
+		done(errSegcoreRequestFailed)
+		return
+	}
+	done(nil)
+}
+
+// initStorageRequestBudget makes the requests of segcore to the object storage share the request budget of the process.
+func initStorageRequestBudget(params *paramtable.ComponentParam) {
+	storage.InitRequestBudget(params)
+	C.SetStorageRequestBudget(
+		C.CAcquireRequestBudgetFunc(C.goAcquireStorageRequestBudget),
+		C.CReleaseRequestBudgetFunc(C.goReleaseStorageRequestBudget),
+	)
+}
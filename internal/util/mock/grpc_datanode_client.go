@@ -73,10 +73,22 @@ func (m *GrpcDataNodeClient) SyncSegments(ctx context.Context, in *datapb.SyncSe
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcDataNodeClient) StopCompaction(ctx context.Context, in *datapb.StopCompactionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcDataNodeClient) FlushChannels(ctx context.Context, in *datapb.FlushChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcDataNodeClient) PauseChannels(ctx context.Context, in *datapb.PauseChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *GrpcDataNodeClient) SetChannelParallelism(ctx context.Context, in *datapb.SetChannelParallelismRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcDataNodeClient) NotifyChannelOperation(ctx context.Context, in *datapb.ChannelOperationsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, m.Err
 }
@@ -98,6 +98,10 @@ func (m *GrpcQueryNodeClient) QueryStream(ctx context.Context, in *querypb.Query
 	return &streamrpc.LocalQueryClient{}, m.Err
 }
 
+func (m *GrpcQueryNodeClient) SubscribeStandingQuery(ctx context.Context, in *querypb.SubscribeStandingQueryRequest, opts ...grpc.CallOption) (querypb.QueryNode_SubscribeStandingQueryClient, error) {
+	return streamrpc.NewInMemoryStreamer[*querypb.StandingQueryEvent](ctx, 0), m.Err
+}
+
 func (m *GrpcQueryNodeClient) QuerySegments(ctx context.Context, in *querypb.QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error) {
 	return &internalpb.RetrieveResults{}, m.Err
 }
@@ -134,6 +138,10 @@ func (m *GrpcQueryNodeClient) Delete(ctx context.Context, in *querypb.DeleteRequ
 	return &commonpb.Status{}, m.Err
 }
 
+func (m *GrpcQueryNodeClient) SwitchShardLeader(ctx context.Context, in *querypb.SwitchShardLeaderRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcQueryNodeClient) Close() error {
 	return m.Err
 }
@@ -53,6 +53,50 @@ func (m *GrpcRootCoordClient) RenameCollection(ctx context.Context, in *milvuspb
 	return merr.Success(), nil
 }
 
+func (m *GrpcRootCoordClient) AddCollectionField(ctx context.Context, in *proxypb.AddCollectionFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) AlterDatabase(ctx context.Context, in *proxypb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) UndropCollection(ctx context.Context, in *proxypb.UndropCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *GrpcRootCoordClient) DropCollectionAsync(ctx context.Context, in *milvuspb.DropCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	return &proxypb.DDLJobResponse{Status: merr.Success()}, m.Err
+}
+
+func (m *GrpcRootCoordClient) AlterCollectionAsync(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*proxypb.DDLJobResponse, error) {
+	return &proxypb.DDLJobResponse{Status: merr.Success()}, m.Err
+}
+
+func (m *GrpcRootCoordClient) DescribeDDLJob(ctx context.Context, in *proxypb.DescribeDDLJobRequest, opts ...grpc.CallOption) (*proxypb.DescribeDDLJobResponse, error) {
+	return &proxypb.DescribeDDLJobResponse{Status: merr.Success()}, m.Err
+}
+
+func (m *GrpcRootCoordClient) CreateAPIKey(ctx context.Context, in *proxypb.CreateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	return &proxypb.APIKeyResponse{Status: merr.Success()}, m.Err
+}
+
+func (m *GrpcRootCoordClient) RotateAPIKey(ctx context.Context, in *proxypb.RotateAPIKeyRequest, opts ...grpc.CallOption) (*proxypb.APIKeyResponse, error) {
+	return &proxypb.APIKeyResponse{Status: merr.Success()}, m.Err
+}
+
+func (m *GrpcRootCoordClient) RevokeAPIKey(ctx context.Context, in *proxypb.RevokeAPIKeyRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), m.Err
+}
+
+func (m *GrpcRootCoordClient) ListAPIKeys(ctx context.Context, in *proxypb.ListAPIKeysRequest, opts ...grpc.CallOption) (*proxypb.ListAPIKeysResponse, error) {
+	return &proxypb.ListAPIKeysResponse{Status: merr.Success()}, m.Err
+}
+
+func (m *GrpcRootCoordClient) VerifyAPIKey(ctx context.Context, in *rootcoordpb.VerifyAPIKeyRequest, opts ...grpc.CallOption) (*rootcoordpb.VerifyAPIKeyResponse, error) {
+	return &rootcoordpb.VerifyAPIKeyResponse{Status: merr.Success()}, m.Err
+}
+
 func (m *GrpcRootCoordClient) CheckHealth(ctx context.Context, in *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{}, m.Err
 }
@@ -0,0 +1,305 @@
+package multivectorutil
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// ScoreFieldName is the name of the column carrying the max-sim scores of the rows.
+const ScoreFieldName = "$score"
+
+// IsMultiVectorField returns whether the field stores multiple vectors per entity,
+// which is a float array field with the multi_vector type param set.
+func IsMultiVectorField(field *schemapb.FieldSchema) bool {
+	if field.GetDataType() != schemapb.DataType_Array {
+		return false
+	}
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.MultiVectorKey {
+			ok, _ := strconv.ParseBool(kv.GetValue())
+			return ok
+		}
+	}
+	return false
+}
+
+// GetDim returns the dim of the vectors stored by the multi-vector field.
+func GetDim(field *schemapb.FieldSchema) (int64, error) {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.DimKey {
+			dim, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err != nil || dim <= 0 {
+				return 0, merr.WrapErrParameterInvalidMsg("invalid dim %s of multi-vector field %s", kv.GetValue(), field.GetName())
+			}
+			return dim, nil
+		}
+	}
+	return 0, merr.WrapErrParameterInvalidMsg("dim not specified for multi-vector field %s", field.GetName())
+}
+
+// CheckField checks the schema of the multi-vector field.
+func CheckField(field *schemapb.FieldSchema) error {
+	if field.GetElementType() != schemapb.DataType_Float {
+		return merr.WrapErrParameterInvalidMsg("multi-vector field %s must be an array of float, got %s", field.GetName(), field.GetElementType().String())
+	}
+	dim, err := GetDim(field)
+	if err != nil {
+		return err
+	}
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.MaxCapacityKey {
+			capacity, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err == nil && capacity < dim {
+				return merr.WrapErrParameterInvalidMsg("max capacity %d of multi-vector field %s is less than the dim %d", capacity, field.GetName(), dim)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckFieldData checks each row of the multi-vector field holds one or more vectors of the dim.
+func CheckFieldData(field *schemapb.FieldSchema, fieldData *schemapb.FieldData) error {
+	dim, err := GetDim(field)
+	if err != nil {
+		return err
+	}
+	for i, row := range fieldData.GetScalars().GetArrayData().GetData() {
+		n := int64(len(row.GetFloatData().GetData()))
+		if n == 0 || n%dim != 0 {
+			return merr.WrapErrParameterInvalidMsg("the row %d of multi-vector field %s has %d floats, which should be a positive multiple of dim %d", i, field.GetName(), n, dim)
+		}
+	}
+	return nil
+}
+
+// CheckMetricType checks the metric type is supported by the max-sim.
+func CheckMetricType(metricType string) error {
+	if !strings.EqualFold(metricType, metric.IP) && !strings.EqualFold(metricType, metric.COSINE) {
+		return merr.WrapErrParameterInvalidMsg("metric type %s is not supported by multi-vector search, only %s and %s are supported", metricType, metric.IP, metric.COSINE)
+	}
+	return nil
+}
+
+// MaxSim returns the sum of the max similarities of the query vectors against the vectors of an entity.
+func MaxSim(queries []float32, vectors []float32, dim int, metricType string) float32 {
+	cosine := strings.EqualFold(metricType, metric.COSINE)
+	var sum float32
+	for q := 0; q+dim <= len(queries); q += dim {
+		query := queries[q : q+dim]
+		best := float32(math.Inf(-1))
+		for v := 0; v+dim <= len(vectors); v += dim {
+			sim := similarity(query, vectors[v:v+dim], cosine)
+			if sim > best {
+				best = sim
+			}
+		}
+		sum += best
+	}
+	return sum
+}
+
+func similarity(left, right []float32, cosine bool) float32 {
+	var dot, leftNorm, rightNorm float64
+	for i := range left {
+		dot += float64(left[i]) * float64(right[i])
+		if cosine {
+			leftNorm += float64(left[i]) * float64(left[i])
+			rightNorm += float64(right[i]) * float64(right[i])
+		}
+	}
+	if !cosine {
+		return float32(dot)
+	}
+	if leftNorm == 0 || rightNorm == 0 {
+		return 0
+	}
+	return float32(dot / (math.Sqrt(leftNorm) * math.Sqrt(rightNorm)))
+}
+
+type hit struct {
+	pk     interface{}
+	score  float32
+	fields []*schemapb.FieldData
+	row    int64
+}
+
+// Searcher keeps the topk rows of the highest max-sim scores against the query vectors.
+// The query nodes score the rows of the segments into the partial results,
+// which are merged by the delegators and the proxy, and finalized by the proxy.
+type Searcher struct {
+	search *internalpb.MultiVectorSearch
+	pkID   int64
+	dim    int
+	hits   []*hit
+}
+
+func NewSearcher(schema *schemapb.CollectionSchema, search *internalpb.MultiVectorSearch) (*Searcher, error) {
+	field := typeutil.GetField(schema, search.GetFieldID())
+	if field == nil {
+		return nil, merr.WrapErrFieldNotFound(search.GetFieldID())
+	}
+	if !IsMultiVectorField(field) {
+		return nil, merr.WrapErrParameterInvalidMsg("field %s is not a multi-vector field", field.GetName())
+	}
+	dim, err := GetDim(field)
+	if err != nil {
+		return nil, err
+	}
+	if len(search.GetVectors()) == 0 || int64(len(search.GetVectors()))%dim != 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("the query has %d floats, which should be a positive multiple of dim %d", len(search.GetVectors()), dim)
+	}
+	if err := CheckMetricType(search.GetMetricType()); err != nil {
+		return nil, err
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &Searcher{
+		search: search,
+		pkID:   pkField.GetFieldID(),
+		dim:    int(dim),
+	}, nil
+}
+
+func findField(fieldsData []*schemapb.FieldData, fieldID int64) (*schemapb.FieldData, error) {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldId() == fieldID {
+			return fieldData, nil
+		}
+	}
+	return nil, merr.WrapErrFieldNotFound(fieldID, "field not found in the retrieved rows")
+}
+
+// AddRows scores the rows retrieved from the segment.
+func (s *Searcher) AddRows(fieldsData []*schemapb.FieldData) error {
+	if len(fieldsData) == 0 {
+		return nil
+	}
+	column, err := findField(fieldsData, s.search.GetFieldID())
+	if err != nil {
+		return err
+	}
+	pks, err := findField(fieldsData, s.pkID)
+	if err != nil {
+		return err
+	}
+	for i, row := range column.GetScalars().GetArrayData().GetData() {
+		score := MaxSim(s.search.GetVectors(), row.GetFloatData().GetData(), s.dim, s.search.GetMetricType())
+		s.add(&hit{pk: typeutil.GetData(pks, i), score: score, fields: fieldsData, row: int64(i)})
+	}
+	return nil
+}
+
+// AddPartial merges the partial result scored by the other searcher.
+func (s *Searcher) AddPartial(fieldsData []*schemapb.FieldData) error {
+	if len(fieldsData) == 0 {
+		return nil
+	}
+	scores := fieldsData[len(fieldsData)-1]
+	if scores.GetFieldName() != ScoreFieldName {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("invalid partial multi-vector search result, the last column is %s", scores.GetFieldName()))
+	}
+	fieldsData = fieldsData[:len(fieldsData)-1]
+	pks, err := findField(fieldsData, s.pkID)
+	if err != nil {
+		return err
+	}
+	for i, score := range scores.GetScalars().GetFloatData().GetData() {
+		s.add(&hit{pk: typeutil.GetData(pks, i), score: score, fields: fieldsData, row: int64(i)})
+	}
+	return nil
+}
+
+func (s *Searcher) add(h *hit) {
+	s.hits = append(s.hits, h)
+	if int64(len(s.hits)) >= 2*s.search.GetTopk()+1024 {
+		s.compact()
+	}
+}
+
+// compact sorts the hits by the scores descending, removes the duplicated primary keys
+// and keeps the topk hits only.
+func (s *Searcher) compact() {
+	sort.SliceStable(s.hits, func(i, j int) bool {
+		return s.hits[i].score > s.hits[j].score
+	})
+	seen := make(map[interface{}]struct{}, len(s.hits))
+	hits := s.hits[:0]
+	for _, h := range s.hits {
+		if _, ok := seen[h.pk]; ok {
+			continue
+		}
+		seen[h.pk] = struct{}{}
+		hits = append(hits, h)
+		if s.search.GetTopk() > 0 && int64(len(hits)) >= s.search.GetTopk() {
+			break
+		}
+	}
+	s.hits = hits
+}
+
+func (s *Searcher) rows(hits []*hit) []*schemapb.FieldData {
+	if len(hits) == 0 {
+		return nil
+	}
+	fieldsData := typeutil.PrepareResultFieldData(hits[0].fields, int64(len(hits)))
+	for _, h := range hits {
+		typeutil.AppendFieldData(fieldsData, h.fields, h.row)
+	}
+	return fieldsData
+}
+
+func scoreColumn(scores []float32) *schemapb.FieldData {
+	return &schemapb.FieldData{
+		Type:      schemapb.DataType_Float,
+		FieldName: ScoreFieldName,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: scores}},
+		}},
+	}
+}
+
+// Partial returns the partial result, which is the topk rows sorted by the scores descending,
+// followed by the column of the scores.
+func (s *Searcher) Partial() []*schemapb.FieldData {
+	s.compact()
+	return s.scoredRows(s.hits)
+}
+
+func (s *Searcher) scoredRows(hits []*hit) []*schemapb.FieldData {
+	if len(hits) == 0 {
+		return nil
+	}
+	scores := make([]float32, len(hits))
+	for i, h := range hits {
+		scores[i] = h.score
+	}
+	return append(s.rows(hits), scoreColumn(scores))
+}
+
+// Finalize returns the rows sorted by the scores descending followed by the column of the scores,
+// paginated by the offset and limit. limit <= 0 means unlimited.
+func (s *Searcher) Finalize(offset int64, limit int64) []*schemapb.FieldData {
+	s.compact()
+	hits := s.hits
+	if offset >= int64(len(hits)) {
+		hits = nil
+	} else {
+		hits = hits[offset:]
+	}
+	if limit > 0 && limit < int64(len(hits)) {
+		hits = hits[:limit]
+	}
+	return s.scoredRows(hits)
+}
@@ -0,0 +1,173 @@
+package multivectorutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+)
+
+func testField() *schemapb.FieldSchema {
+	return &schemapb.FieldSchema{
+		FieldID:     102,
+		Name:        "passages",
+		DataType:    schemapb.DataType_Array,
+		ElementType: schemapb.DataType_Float,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.DimKey, Value: "2"},
+			{Key: common.MaxCapacityKey, Value: "8"},
+			{Key: common.MultiVectorKey, Value: "true"},
+		},
+	}
+}
+
+func testSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "title", DataType: schemapb.DataType_VarChar},
+			testField(),
+		},
+	}
+}
+
+func testRows(pks []int64, titles []string, vectors [][]float32) []*schemapb.FieldData {
+	arrays := make([]*schemapb.ScalarField, len(vectors))
+	for i, v := range vectors {
+		arrays[i] = &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: v}}}
+	}
+	return []*schemapb.FieldData{
+		{
+			Type: schemapb.DataType_Int64, FieldName: "pk", FieldId: 100,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+			}},
+		},
+		{
+			Type: schemapb.DataType_VarChar, FieldName: "title", FieldId: 101,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: titles}},
+			}},
+		},
+		{
+			Type: schemapb.DataType_Array, FieldName: "passages", FieldId: 102,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_ArrayData{ArrayData: &schemapb.ArrayArray{Data: arrays, ElementType: schemapb.DataType_Float}},
+			}},
+		},
+	}
+}
+
+func TestCheckField(t *testing.T) {
+	field := testField()
+	assert.True(t, IsMultiVectorField(field))
+	assert.NoError(t, CheckField(field))
+	dim, err := GetDim(field)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), dim)
+
+	assert.False(t, IsMultiVectorField(&schemapb.FieldSchema{DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Float}))
+	assert.False(t, IsMultiVectorField(&schemapb.FieldSchema{DataType: schemapb.DataType_FloatVector}))
+
+	field.ElementType = schemapb.DataType_Int64
+	assert.ErrorIs(t, CheckField(field), merr.ErrParameterInvalid)
+
+	field = testField()
+	field.TypeParams[0].Value = "16"
+	assert.ErrorIs(t, CheckField(field), merr.ErrParameterInvalid)
+
+	field.TypeParams[0].Value = "-1"
+	assert.ErrorIs(t, CheckField(field), merr.ErrParameterInvalid)
+
+	field.TypeParams = field.TypeParams[1:]
+	assert.ErrorIs(t, CheckField(field), merr.ErrParameterInvalid)
+}
+
+func TestCheckFieldData(t *testing.T) {
+	field := testField()
+	rows := testRows([]int64{1, 2}, []string{"a", "b"}, [][]float32{{1, 0}, {1, 0, 0, 1}})
+	assert.NoError(t, CheckFieldData(field, rows[2]))
+
+	rows = testRows([]int64{1, 2}, []string{"a", "b"}, [][]float32{{1, 0}, {1, 0, 0}})
+	assert.ErrorIs(t, CheckFieldData(field, rows[2]), merr.ErrParameterInvalid)
+
+	rows = testRows([]int64{1}, []string{"a"}, [][]float32{{}})
+	assert.ErrorIs(t, CheckFieldData(field, rows[2]), merr.ErrParameterInvalid)
+}
+
+func TestMaxSim(t *testing.T) {
+	queries := []float32{1, 0, 0, 1}
+	vectors := []float32{1, 0, 0.5, 0.5, 0, 2}
+	// max(1, 0.5, 0) + max(0, 0.5, 2)
+	assert.InDelta(t, 3, MaxSim(queries, vectors, 2, metric.IP), 1e-6)
+	// max(1, 0.707, 0) + max(0, 0.707, 1)
+	assert.InDelta(t, 2, MaxSim(queries, vectors, 2, metric.COSINE), 1e-6)
+	assert.InDelta(t, 0, MaxSim(queries, []float32{0, 0}, 2, metric.COSINE), 1e-6)
+
+	assert.NoError(t, CheckMetricType("ip"))
+	assert.NoError(t, CheckMetricType(metric.COSINE))
+	assert.ErrorIs(t, CheckMetricType(metric.L2), merr.ErrParameterInvalid)
+}
+
+func TestNewSearcherFailed(t *testing.T) {
+	schema := testSchema()
+	_, err := NewSearcher(schema, &internalpb.MultiVectorSearch{FieldID: 999, Vectors: []float32{1, 0}, MetricType: metric.IP})
+	assert.ErrorIs(t, err, merr.ErrFieldNotFound)
+
+	_, err = NewSearcher(schema, &internalpb.MultiVectorSearch{FieldID: 101, Vectors: []float32{1, 0}, MetricType: metric.IP})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, err = NewSearcher(schema, &internalpb.MultiVectorSearch{FieldID: 102, Vectors: []float32{1, 0, 1}, MetricType: metric.IP})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	_, err = NewSearcher(schema, &internalpb.MultiVectorSearch{FieldID: 102, Vectors: []float32{1, 0}, MetricType: metric.L2})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestSearcher(t *testing.T) {
+	schema := testSchema()
+	search := &internalpb.MultiVectorSearch{FieldID: 102, Vectors: []float32{1, 0}, MetricType: metric.IP, Topk: 3}
+
+	// two segments of the same query node
+	first, err := NewSearcher(schema, search)
+	require.NoError(t, err)
+	require.NoError(t, first.AddRows(testRows([]int64{1, 2}, []string{"a", "b"}, [][]float32{{0.1, 0, 0.5, 0}, {0.9, 0}})))
+	require.NoError(t, first.AddRows(testRows([]int64{3, 2}, []string{"c", "b"}, [][]float32{{0.3, 0}, {0.9, 0}})))
+	require.NoError(t, first.AddRows(nil))
+
+	second, err := NewSearcher(schema, search)
+	require.NoError(t, err)
+	require.NoError(t, second.AddRows(testRows([]int64{4, 5}, []string{"d", "e"}, [][]float32{{0.7, 0}, {0.2, 0, 0, 1}})))
+
+	partial := first.Partial()
+	require.Len(t, partial, 4)
+	assert.Equal(t, ScoreFieldName, partial[3].GetFieldName())
+	assert.Equal(t, []int64{2, 1, 3}, partial[0].GetScalars().GetLongData().GetData())
+
+	merged, err := NewSearcher(schema, search)
+	require.NoError(t, err)
+	require.NoError(t, merged.AddPartial(partial))
+	require.NoError(t, merged.AddPartial(second.Partial()))
+	require.NoError(t, merged.AddPartial(nil))
+
+	fieldsData := merged.Finalize(1, 2)
+	require.Len(t, fieldsData, 4)
+	assert.Equal(t, []int64{4, 1}, fieldsData[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []string{"d", "a"}, fieldsData[1].GetScalars().GetStringData().GetData())
+	assert.Len(t, fieldsData[2].GetScalars().GetArrayData().GetData(), 2)
+	assert.InDeltaSlice(t, []float32{0.7, 0.5}, fieldsData[3].GetScalars().GetFloatData().GetData(), 1e-6)
+
+	assert.Empty(t, merged.Finalize(5, 2))
+
+	// the last column must be the scores
+	assert.Error(t, merged.AddPartial(testRows([]int64{1}, []string{"a"}, [][]float32{{1, 0}})))
+	// the multi-vector field is required to score the rows
+	assert.ErrorIs(t, merged.AddRows(testRows([]int64{1}, []string{"a"}, [][]float32{{1, 0}})[:2]), merr.ErrFieldNotFound)
+}
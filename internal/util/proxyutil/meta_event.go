@@ -0,0 +1,182 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	v3rpc "go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// The collection meta changes are not pushed, rootcoord invalidates the meta cache of the proxies by rpc,
+// so that the ddl doesn't return until all the proxies dropped the stale meta.
+const (
+	// MetaEventShardLeader is pushed by querycoord once the shard leaders of the collection changed
+	MetaEventShardLeader = "shard_leader"
+
+	metaEventPrefix = "proxy-meta-event"
+	// the events are kept for a while, so that the proxies reconnecting to etcd won't miss them
+	metaEventTTL = 60
+)
+
+// MetaEvent notifies the proxies to invalidate the cached meta of a collection.
+type MetaEvent struct {
+	Type         string `json:"type"`
+	CollectionID int64  `json:"collection_id"`
+}
+
+func metaEventRoot() string {
+	return path.Join(paramtable.Get().EtcdCfg.MetaRootPath.GetValue(), metaEventPrefix)
+}
+
+// MetaEventPublisher pushes the meta events to the proxies through etcd,
+// the event of a collection overwrites the previous one as the proxies only need the latest change.
+type MetaEventPublisher struct {
+	etcdCli *clientv3.Client
+}
+
+func NewMetaEventPublisher(etcdCli *clientv3.Client) *MetaEventPublisher {
+	return &MetaEventPublisher{etcdCli: etcdCli}
+}
+
+func (p *MetaEventPublisher) publish(ctx context.Context, event *MetaEvent) error {
+	value, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	lease, err := p.etcdCli.Grant(ctx, metaEventTTL)
+	if err != nil {
+		return err
+	}
+	key := path.Join(metaEventRoot(), event.Type, fmt.Sprint(event.CollectionID))
+	_, err = p.etcdCli.Put(ctx, key, string(value), clientv3.WithLease(lease.ID))
+	return err
+}
+
+// PublishShardLeaderChanged notifies the proxies to refresh the shard leaders of the collections.
+func (p *MetaEventPublisher) PublishShardLeaderChanged(ctx context.Context, collectionIDs ...int64) error {
+	for _, collectionID := range collectionIDs {
+		if err := p.publish(ctx, &MetaEvent{
+			Type:         MetaEventShardLeader,
+			CollectionID: collectionID,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MetaEventWatcher watches the meta events pushed by the coordinators,
+// the handler is called in the order of the events.
+type MetaEventWatcher struct {
+	etcdCli *clientv3.Client
+	handler func(context.Context, *MetaEvent)
+
+	wg        sync.WaitGroup
+	cancel    context.CancelFunc
+	closeOnce sync.Once
+}
+
+func NewMetaEventWatcher(etcdCli *clientv3.Client, handler func(context.Context, *MetaEvent)) *MetaEventWatcher {
+	return &MetaEventWatcher{
+		etcdCli: etcdCli,
+		handler: handler,
+	}
+}
+
+// Start watches the events since now, the events before are covered by the meta cache loaded lazily.
+func (w *MetaEventWatcher) Start(ctx context.Context) error {
+	ctx, w.cancel = context.WithCancel(ctx)
+	rev, err := w.currentRevision(ctx)
+	if err != nil {
+		w.cancel()
+		return err
+	}
+	w.wg.Add(1)
+	go w.watch(ctx, rev)
+	return nil
+}
+
+func (w *MetaEventWatcher) currentRevision(ctx context.Context) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().EtcdCfg.RequestTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+	resp, err := w.etcdCli.Get(ctx, metaEventRoot(), clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get the revision of meta events")
+	}
+	return resp.Header.Revision, nil
+}
+
+func (w *MetaEventWatcher) watch(ctx context.Context, rev int64) {
+	defer w.wg.Done()
+	log.Info("start to watch meta events", zap.Int64("revision", rev))
+	for {
+		eventCh := w.etcdCli.Watch(ctx, metaEventRoot(), clientv3.WithPrefix(), clientv3.WithRev(rev+1))
+		for resp := range eventCh {
+			if err := resp.Err(); err != nil {
+				if errors.Is(err, v3rpc.ErrCompacted) {
+					// the compacted events are older than the ttl, rewatch from the compacted revision
+					log.Warn("meta events compacted, rewatch", zap.Int64("compactRevision", resp.CompactRevision))
+					rev = resp.CompactRevision - 1
+				} else {
+					log.Warn("failed to watch meta events", zap.Error(err))
+				}
+				break
+			}
+			for _, e := range resp.Events {
+				rev = e.Kv.ModRevision
+				if e.Type != mvccpb.PUT {
+					continue
+				}
+				event := &MetaEvent{}
+				if err := json.Unmarshal(e.Kv.Value, event); err != nil {
+					log.Warn("failed to unmarshal meta event", zap.String("key", string(e.Kv.Key)), zap.Error(err))
+					continue
+				}
+				w.handler(ctx, event)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			log.Info("stop watching meta events")
+			return
+		case <-time.After(time.Second):
+			// the watch channel closed unexpectedly, rewatch since the last handled event
+		}
+	}
+}
+
+func (w *MetaEventWatcher) Stop() {
+	w.closeOnce.Do(func() {
+		if w.cancel != nil {
+			w.cancel()
+		}
+		w.wg.Wait()
+	})
+}
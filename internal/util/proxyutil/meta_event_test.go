@@ -0,0 +1,70 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxyutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestMetaEvent(t *testing.T) {
+	paramtable.Init()
+
+	etcdCli, err := etcd.GetEtcdClient(
+		paramtable.Get().EtcdCfg.UseEmbedEtcd.GetAsBool(),
+		paramtable.Get().EtcdCfg.EtcdUseSSL.GetAsBool(),
+		paramtable.Get().EtcdCfg.Endpoints.GetAsStrings(),
+		paramtable.Get().EtcdCfg.EtcdTLSCert.GetValue(),
+		paramtable.Get().EtcdCfg.EtcdTLSKey.GetValue(),
+		paramtable.Get().EtcdCfg.EtcdTLSCACert.GetValue(),
+		paramtable.Get().EtcdCfg.EtcdTLSMinVersion.GetValue())
+	assert.NoError(t, err)
+	defer etcdCli.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	defer etcdCli.Delete(ctx, metaEventRoot(), clientv3.WithPrefix())
+
+	// the events published before the watcher started are not received
+	publisher := NewMetaEventPublisher(etcdCli)
+	err = publisher.PublishShardLeaderChanged(ctx, 99)
+	assert.NoError(t, err)
+
+	events := make(chan *MetaEvent, 10)
+	watcher := NewMetaEventWatcher(etcdCli, func(ctx context.Context, event *MetaEvent) {
+		events <- event
+	})
+	err = watcher.Start(ctx)
+	assert.NoError(t, err)
+	defer watcher.Stop()
+
+	err = publisher.PublishShardLeaderChanged(ctx, 101, 102)
+	assert.NoError(t, err)
+
+	for _, collectionID := range []int64{101, 102} {
+		event := <-events
+		assert.Equal(t, MetaEventShardLeader, event.Type)
+		assert.Equal(t, collectionID, event.CollectionID)
+	}
+	assert.Empty(t, events)
+}
@@ -0,0 +1,130 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sessionutil
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"time"
+
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+// DefaultFenceRoot is the root path of the fences of the sessions in kv,
+// the server is fenced while the fence key of its session exists.
+const DefaultFenceRoot = "session_fence/"
+
+const fenceRewatchInterval = time.Second
+
+// FenceCallback is called with the server id when the session of the server is fenced or unfenced.
+type FenceCallback func(serverID int64, reason string)
+
+func (s *Session) getFencePrefix() string {
+	return path.Join(s.metaRoot, DefaultFenceRoot) + "/"
+}
+
+func (s *Session) getFenceKey(serverID int64) string {
+	return s.getFencePrefix() + strconv.FormatInt(serverID, 10)
+}
+
+// FenceSession fences the session of the server with the reason,
+// the server is expected to stop serving and unfence itself once it's ready to serve again.
+func (s *Session) FenceSession(ctx context.Context, serverID int64, reason string) error {
+	_, err := s.etcdCli.Put(ctx, s.getFenceKey(serverID), reason)
+	return err
+}
+
+// UnfenceSession removes the fence of the session of the server.
+func (s *Session) UnfenceSession(ctx context.Context, serverID int64) error {
+	_, err := s.etcdCli.Delete(ctx, s.getFenceKey(serverID))
+	return err
+}
+
+// WatchFence watches the fence of the session of the server until the ctx done.
+func (s *Session) WatchFence(ctx context.Context, serverID int64, onFence FenceCallback, onUnfence FenceCallback) {
+	s.watchFences(ctx, s.getFenceKey(serverID), false, onFence, onUnfence)
+}
+
+// WatchFences watches the fences of all the sessions until the ctx done.
+func (s *Session) WatchFences(ctx context.Context, onFence FenceCallback, onUnfence FenceCallback) {
+	s.watchFences(ctx, s.getFencePrefix(), true, onFence, onUnfence)
+}
+
+// watchFences calls onFence for the fences existing, then the callbacks for the fences changed,
+// the fences are reloaded if the watch failed, the unfences during the failure may be missed.
+func (s *Session) watchFences(ctx context.Context, key string, withPrefix bool, onFence FenceCallback, onUnfence FenceCallback) {
+	var opts []clientv3.OpOption
+	if withPrefix {
+		opts = append(opts, clientv3.WithPrefix())
+	}
+	serverIDOf := func(k []byte) (int64, bool) {
+		id, err := strconv.ParseInt(path.Base(string(k)), 10, 64)
+		if err != nil {
+			log.Warn("invalid session fence key", zap.ByteString("key", k))
+			return 0, false
+		}
+		return id, true
+	}
+
+	for ctx.Err() == nil {
+		resp, err := s.etcdCli.Get(ctx, key, opts...)
+		if err != nil {
+			log.Warn("fail to load the session fences", zap.String("key", key), zap.Error(err))
+			time.Sleep(fenceRewatchInterval)
+			continue
+		}
+		for _, kv := range resp.Kvs {
+			if id, ok := serverIDOf(kv.Key); ok && onFence != nil {
+				onFence(id, string(kv.Value))
+			}
+		}
+
+		watchCtx, cancel := context.WithCancel(ctx)
+		rch := s.etcdCli.Watch(watchCtx, key, append(opts, clientv3.WithRev(resp.Header.Revision+1))...)
+		for wresp := range rch {
+			if wresp.Err() != nil {
+				log.Warn("watch session fences failed", zap.String("key", key), zap.Error(wresp.Err()))
+				break
+			}
+			for _, event := range wresp.Events {
+				id, ok := serverIDOf(event.Kv.Key)
+				if !ok {
+					continue
+				}
+				switch event.Type {
+				case mvccpb.PUT:
+					if onFence != nil {
+						onFence(id, string(event.Kv.Value))
+					}
+				case mvccpb.DELETE:
+					if onUnfence != nil {
+						onUnfence(id, "")
+					}
+				}
+			}
+		}
+		cancel()
+		if ctx.Err() == nil {
+			time.Sleep(fenceRewatchInterval)
+		}
+	}
+}
@@ -102,6 +102,8 @@ type SessionRaw struct {
 
 	HostName   string `json:"HostName,omitempty"`
 	EnableDisk bool   `json:"EnableDisk,omitempty"`
+	// ChannelGroup is the exclusive channel group the datanode serves
+	ChannelGroup string `json:"ChannelGroup,omitempty"`
 }
 
 func (s *SessionRaw) GetAddress() string {
@@ -183,6 +185,12 @@ func WithEnableDisk(enableDisk bool) SessionOption {
 	}
 }
 
+func WithChannelGroup(group string) SessionOption {
+	return func(s *Session) {
+		s.ChannelGroup = group
+	}
+}
+
 func (s *Session) apply(opts ...SessionOption) {
 	for _, opt := range opts {
 		opt(s)
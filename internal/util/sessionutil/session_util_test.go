@@ -625,6 +625,60 @@ func (suite *SessionWithVersionSuite) TestWatchServicesWithVersionRange() {
 	})
 }
 
+func (suite *SessionWithVersionSuite) TestFence() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewSessionWithEtcd(ctx, suite.metaRoot, suite.client, WithResueNodeID(false))
+	serverID := suite.sessions[0].ServerID
+
+	// the fence existing before watching is notified as well
+	suite.Require().NoError(s.FenceSession(ctx, serverID, "stalled"))
+
+	fenced := make(chan string, 10)
+	allFenced := make(chan int64, 10)
+	unfenced := make(chan int64, 10)
+	go suite.sessions[0].WatchFence(ctx, serverID, func(id int64, reason string) {
+		fenced <- reason
+	}, nil)
+	go s.WatchFences(ctx, func(id int64, _ string) {
+		allFenced <- id
+	}, func(id int64, _ string) {
+		unfenced <- id
+	})
+
+	select {
+	case reason := <-fenced:
+		suite.Equal("stalled", reason)
+	case <-time.After(5 * time.Second):
+		suite.Fail("fence not received")
+	}
+	select {
+	case id := <-allFenced:
+		suite.Equal(serverID, id)
+	case <-time.After(5 * time.Second):
+		suite.Fail("fence not received")
+	}
+
+	// the fences of the other servers are not watched by the server
+	otherID := suite.sessions[1].ServerID
+	suite.Require().NoError(s.FenceSession(ctx, otherID, "other"))
+	select {
+	case id := <-allFenced:
+		suite.Equal(otherID, id)
+	case <-time.After(5 * time.Second):
+		suite.Fail("fence not received")
+	}
+
+	suite.Require().NoError(suite.sessions[0].UnfenceSession(ctx, serverID))
+	select {
+	case id := <-unfenced:
+		suite.Equal(serverID, id)
+	case <-time.After(5 * time.Second):
+		suite.Fail("unfence not received")
+	}
+	suite.Empty(fenced)
+}
+
 func TestSessionWithVersionRange(t *testing.T) {
 	suite.Run(t, new(SessionWithVersionSuite))
 }
@@ -12,29 +12,12 @@ import (
 func HashKey2Partitions(fieldSchema *schemapb.FieldSchema, keys []*planpb.GenericValue, partitionNames []string) ([]string, error) {
 	selectedPartitions := make(map[string]struct{})
 	numPartitions := uint32(len(partitionNames))
-	switch fieldSchema.GetDataType() {
-	case schemapb.DataType_Int64:
-		for _, key := range keys {
-			if int64Val, ok := key.GetVal().(*planpb.GenericValue_Int64Val); ok {
-				value, _ := typeutil.Hash32Int64(int64Val.Int64Val)
-				partitionName := partitionNames[value%numPartitions]
-				selectedPartitions[partitionName] = struct{}{}
-			} else {
-				return nil, errors.New("the data type of the data and the schema do not match")
-			}
-		}
-	case schemapb.DataType_VarChar:
-		for _, key := range keys {
-			if stringVal, ok := key.GetVal().(*planpb.GenericValue_StringVal); ok {
-				value := typeutil.HashString2Uint32(stringVal.StringVal)
-				partitionName := partitionNames[value%numPartitions]
-				selectedPartitions[partitionName] = struct{}{}
-			} else {
-				return nil, errors.New("the data type of the data and the schema do not match")
-			}
+	for _, key := range keys {
+		value, err := HashPartitionKey(fieldSchema, key)
+		if err != nil {
+			return nil, err
 		}
-	default:
-		return nil, errors.New("currently only support DataType Int64 or VarChar as partition keys")
+		selectedPartitions[partitionNames[value%numPartitions]] = struct{}{}
 	}
 
 	result := make([]string, 0)
@@ -44,3 +27,22 @@ func HashKey2Partitions(fieldSchema *schemapb.FieldSchema, keys []*planpb.Generi
 
 	return result, nil
 }
+
+// HashPartitionKey returns the hash value of the partition key.
+func HashPartitionKey(fieldSchema *schemapb.FieldSchema, key *planpb.GenericValue) (uint32, error) {
+	switch fieldSchema.GetDataType() {
+	case schemapb.DataType_Int64:
+		if int64Val, ok := key.GetVal().(*planpb.GenericValue_Int64Val); ok {
+			value, _ := typeutil.Hash32Int64(int64Val.Int64Val)
+			return value, nil
+		}
+		return 0, errors.New("the data type of the data and the schema do not match")
+	case schemapb.DataType_VarChar:
+		if stringVal, ok := key.GetVal().(*planpb.GenericValue_StringVal); ok {
+			return typeutil.HashString2Uint32(stringVal.StringVal), nil
+		}
+		return 0, errors.New("the data type of the data and the schema do not match")
+	default:
+		return 0, errors.New("currently only support DataType Int64 or VarChar as partition keys")
+	}
+}
@@ -137,3 +137,15 @@ func GetClusteringKeyField(fields []*schemapb.FieldSchema) *schemapb.FieldSchema
 	}
 	return nil
 }
+
+// GetSortKeyField returns the scalar clustering key field, which the rows of the compacted
+// segments are sorted by, nil if the collection has no clustering key or the key is not sortable.
+func GetSortKeyField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	field := GetClusteringKeyField(schema.GetFields())
+	switch field.GetDataType() {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_String, schemapb.DataType_VarChar:
+		return field
+	}
+	return nil
+}
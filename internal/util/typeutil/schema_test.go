@@ -71,3 +71,17 @@ func TestConvertArrowSchemaWithoutDim(t *testing.T) {
 	_, err := ConvertToArrowSchema(fieldSchemas)
 	assert.Error(t, err)
 }
+
+func TestGetSortKeyField(t *testing.T) {
+	assert.Nil(t, GetSortKeyField(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+		{FieldID: 100, DataType: schemapb.DataType_Int64},
+	}}))
+	assert.Nil(t, GetSortKeyField(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+		{FieldID: 100, DataType: schemapb.DataType_FloatVector, IsClusteringKey: true},
+	}}))
+	field := GetSortKeyField(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+		{FieldID: 100, DataType: schemapb.DataType_Int64},
+		{FieldID: 101, DataType: schemapb.DataType_VarChar, IsClusteringKey: true},
+	}})
+	assert.EqualValues(t, 101, field.GetFieldID())
+}
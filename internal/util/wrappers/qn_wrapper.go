@@ -116,6 +116,17 @@ func (qn *qnServerWrapper) QueryStream(ctx context.Context, in *querypb.QueryReq
 	return streamer, nil
 }
 
+func (qn *qnServerWrapper) SubscribeStandingQuery(ctx context.Context, in *querypb.SubscribeStandingQueryRequest, opts ...grpc.CallOption) (querypb.QueryNode_SubscribeStandingQueryClient, error) {
+	streamer := streamrpc.NewInMemoryStreamer[*querypb.StandingQueryEvent](ctx, 16)
+
+	go func() {
+		qn.QueryNode.SubscribeStandingQuery(in, streamer)
+		streamer.Close()
+	}()
+
+	return streamer, nil
+}
+
 func (qn *qnServerWrapper) QuerySegments(ctx context.Context, in *querypb.QueryRequest, opts ...grpc.CallOption) (*internalpb.RetrieveResults, error) {
 	return qn.QueryNode.QuerySegments(ctx, in)
 }
@@ -152,6 +163,10 @@ func (qn *qnServerWrapper) Delete(ctx context.Context, in *querypb.DeleteRequest
 	return qn.QueryNode.Delete(ctx, in)
 }
 
+func (qn *qnServerWrapper) SwitchShardLeader(ctx context.Context, in *querypb.SwitchShardLeaderRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return qn.QueryNode.SwitchShardLeader(ctx, in)
+}
+
 func WrapQueryNodeServerAsClient(qn types.QueryNode) types.QueryNodeClient {
 	return &qnServerWrapper{
 		QueryNode: qn,
@@ -18,6 +18,7 @@ package common
 
 import (
 	"encoding/binary"
+	"strconv"
 	"strings"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -86,9 +87,18 @@ const (
 	// SegmentStatslogPath storage path const for segment stats log.
 	SegmentStatslogPath = `stats_log`
 
+	// SegmentArtifactLogPath storage path const for segment artifacts generated by the stats generators.
+	SegmentArtifactLogPath = `artifact_log`
+
+	// SegmentParquetLogPath storage path const for segment parquet files of the field groups.
+	SegmentParquetLogPath = `parquet_log`
+
 	// SegmentIndexPath storage path const for segment index files.
 	SegmentIndexPath = `index_files`
 
+	// SegmentIndexCachePath storage path const for the index cache entries keyed by the content hash.
+	SegmentIndexCachePath = `index_cache`
+
 	// PartitionStatsPath storage path const for partition stats files
 	PartitionStatsPath = `part_stats`
 )
@@ -108,13 +118,22 @@ const (
 	DimKey         = "dim"
 	MaxLengthKey   = "max_length"
 	MaxCapacityKey = "max_capacity"
+	// MultiVectorKey marks the float array field storing multiple vectors of the dim per entity
+	MultiVectorKey = "multi_vector"
+	// PartitionedIndexKey lists the partitions of the index built across index nodes,
+	// formatted as "buildID:indexVersion:numRows,..." in the order of the rows
+	PartitionedIndexKey = "partitioned_index"
 )
 
 //  Collection properties key
 
 const (
-	CollectionTTLConfigKey      = "collection.ttl.seconds"
-	CollectionAutoCompactionKey = "collection.autocompaction.enabled"
+	CollectionTTLConfigKey        = "collection.ttl.seconds"
+	CollectionAutoCompactionKey   = "collection.autocompaction.enabled"
+	CollectionCompactionPolicyKey = "collection.compaction.policies"
+	// CollectionPartitionKeyBucketNumKey is the number of buckets the partition key values hashed into
+	// in each partition, the rows of different buckets are written into different segments.
+	CollectionPartitionKeyBucketNumKey = "collection.partitionkey.bucket.num"
 
 	// rate limit
 	CollectionInsertRateMaxKey   = "collection.insertRate.max.mb"
@@ -130,12 +149,97 @@ const (
 	CollectionSearchRateMaxKey   = "collection.searchRate.max.vps"
 	CollectionSearchRateMinKey   = "collection.searchRate.min.vps"
 	CollectionDiskQuotaKey       = "collection.diskProtection.diskQuota.mb"
+
+	// CollectionReplicaNumberKey is the replica number used when loading the collection without specifying it.
+	CollectionReplicaNumberKey = "collection.replica.number"
+	// CollectionResourceGroupsKey is the comma separated resource groups used when loading the collection without specifying them.
+	CollectionResourceGroupsKey = "collection.resource_groups"
+
+	// CollectionRankStrategyKey is the rank strategy of the hybrid search if not specified in the request.
+	CollectionRankStrategyKey = "collection.rank.strategy"
+	// CollectionRankParamsKey is the json encoded params of the rank strategy in the collection properties.
+	CollectionRankParamsKey = "collection.rank.params"
+
+	// CollectionSearchProfilePrefix is the prefix of the named search profiles in the collection properties,
+	// such as "collection.search.profile.fast": {"ef": 32, "consistency_level": "Eventually"}.
+	CollectionSearchProfilePrefix = "collection.search.profile."
+
+	// CollectionFieldMaskPrefix is the prefix of the fields masked for the roles in the collection properties,
+	// such as "collection.field.mask.analyst": "ssn,phone", the masked fields are never output to the users of the role.
+	CollectionFieldMaskPrefix = "collection.field.mask."
+
+	// CollectionRowFilterPrefix is the prefix of the row filters for the roles in the collection properties,
+	// such as "collection.row.filter.analyst": "region == 'eu'", the filter is AND-ed into the requests of the users of the role.
+	CollectionRowFilterPrefix = "collection.row.filter."
+
+	// The storage of the collection placed in its own bucket, the unset ones fall back to the cluster storage config.
+	CollectionStorageAddressKey         = "collection.storage.address"
+	CollectionStorageBucketKey          = "collection.storage.bucket"
+	CollectionStorageRootPathKey        = "collection.storage.rootPath"
+	CollectionStorageAccessKeyIDKey     = "collection.storage.accessKeyID"
+	CollectionStorageSecretAccessKeyKey = "collection.storage.secretAccessKey"
+
+	// The msgstream settings of the dml channels of the collection, the bulk ingest collections batch more
+	// for the throughput while the low latency collections send the messages at once,
+	// they take effect when the proxies create the dml streams of the collection.
+	// CollectionMsgStreamCompressionKey is the compression codec, one of none, lz4, zlib and zstd.
+	CollectionMsgStreamCompressionKey = "collection.msgstream.compression"
+	// CollectionMsgStreamBatchSizeKey is the max number of messages sent in a batch, batching is disabled if not greater than 1.
+	CollectionMsgStreamBatchSizeKey = "collection.msgstream.batch.size"
+	// CollectionMsgStreamLingerMsKey is the max time in milliseconds the messages wait to fill a batch.
+	CollectionMsgStreamLingerMsKey = "collection.msgstream.linger.ms"
+
+	// CollectionCDCEnabledKey is whether the inserts, deletes and ddls of the collection are published to the cdc sink,
+	// which takes effect only if the cdc is enabled by datacoord.
+	CollectionCDCEnabledKey = "collection.cdc.enabled"
+)
+
+// CollectionStorageKeys are the collection properties of the storage.
+var CollectionStorageKeys = []string{
+	CollectionStorageAddressKey,
+	CollectionStorageBucketKey,
+	CollectionStorageRootPathKey,
+	CollectionStorageAccessKeyIDKey,
+	CollectionStorageSecretAccessKeyKey,
+}
+
+// Database properties key
+const (
+	// DatabaseMaxCollectionsKey is the max number of collections in the database,
+	// which overrides the max collection number per database of the quota config.
+	DatabaseMaxCollectionsKey = "database.max.collections"
+	// DatabaseLoadedSizeQuotaKey is the max binlog size of the loaded collections in the database,
+	// writing to the database is denied once it's exceeded.
+	DatabaseLoadedSizeQuotaKey = "database.loadedSize.max.mb"
+	// DatabaseDDLRateMaxKey is the max rate of the collection and partition DDL requests of the database.
+	DatabaseDDLRateMaxKey = "database.ddlRate.max.qps"
+
+	// DatabaseDefaultPropertyPrefix is the prefix of the database default properties,
+	// the collections created in the database inherit them unless overridden.
+	DatabaseDefaultPropertyPrefix      = "database.default."
+	DatabaseDefaultCollectionTTLKey    = DatabaseDefaultPropertyPrefix + CollectionTTLConfigKey
+	DatabaseDefaultMmapEnabledKey      = DatabaseDefaultPropertyPrefix + MmapEnabledKey
+	DatabaseDefaultReplicaNumberKey    = DatabaseDefaultPropertyPrefix + CollectionReplicaNumberKey
+	DatabaseDefaultResourceGroupsKey   = DatabaseDefaultPropertyPrefix + CollectionResourceGroupsKey
+	DatabaseDefaultConsistencyLevelKey = DatabaseDefaultPropertyPrefix + "consistency.level"
+
+	DatabaseDefaultStorageAddressKey         = DatabaseDefaultPropertyPrefix + CollectionStorageAddressKey
+	DatabaseDefaultStorageBucketKey          = DatabaseDefaultPropertyPrefix + CollectionStorageBucketKey
+	DatabaseDefaultStorageRootPathKey        = DatabaseDefaultPropertyPrefix + CollectionStorageRootPathKey
+	DatabaseDefaultStorageAccessKeyIDKey     = DatabaseDefaultPropertyPrefix + CollectionStorageAccessKeyIDKey
+	DatabaseDefaultStorageSecretAccessKeyKey = DatabaseDefaultPropertyPrefix + CollectionStorageSecretAccessKeyKey
 )
 
 // common properties
 const (
-	MmapEnabledKey    = "mmap.enabled"
-	LazyLoadEnableKey = "lazyload.enabled"
+	MmapEnabledKey        = "mmap.enabled"
+	LazyLoadEnableKey     = "lazyload.enabled"
+	TieringEnableKey      = "tiering.enabled"
+	TieringIdleTimeoutKey = "tiering.idleTimeout"
+	// LoadFieldsKey is the comma separated names of the fields loaded into memory when the collection loaded,
+	// the other fields of the sealed segments are loaded on demand by the first request using them,
+	// all the fields are loaded if not set.
+	LoadFieldsKey = "load.fields"
 )
 
 const (
@@ -197,6 +301,267 @@ func IsCollectionLazyLoadEnabled(kvs ...*commonpb.KeyValuePair) bool {
 	return false
 }
 
+// GetCollectionTieringEnabled returns whether tiering is enabled in the collection properties,
+// ok is false if not set.
+func GetCollectionTieringEnabled(kvs ...*commonpb.KeyValuePair) (enabled bool, ok bool) {
+	for _, kv := range kvs {
+		if kv.Key == TieringEnableKey {
+			return strings.ToLower(kv.Value) == "true", true
+		}
+	}
+	return false, false
+}
+
+// IsCollectionCDCEnabled returns whether the changes of the collection are published to the cdc sink.
+func IsCollectionCDCEnabled(kvs ...*commonpb.KeyValuePair) bool {
+	for _, kv := range kvs {
+		if kv.Key == CollectionCDCEnabledKey && strings.ToLower(kv.Value) == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCollectionTieringIdleTimeout returns the tiering idle timeout in seconds in the collection properties,
+// ok is false if not set or invalid.
+func GetCollectionTieringIdleTimeout(kvs ...*commonpb.KeyValuePair) (timeout int64, ok bool) {
+	for _, kv := range kvs {
+		if kv.Key == TieringIdleTimeoutKey {
+			timeout, err := strconv.ParseInt(kv.Value, 10, 64)
+			if err != nil || timeout <= 0 {
+				return 0, false
+			}
+			return timeout, true
+		}
+	}
+	return 0, false
+}
+
+// GetCollectionPartitionKeyBucketNum returns the partition key bucket num in the collection properties,
+// ok is false if not set or invalid.
+func GetCollectionPartitionKeyBucketNum(kvs ...*commonpb.KeyValuePair) (num int64, ok bool) {
+	for _, kv := range kvs {
+		if kv.Key == CollectionPartitionKeyBucketNumKey {
+			num, err := strconv.ParseInt(kv.Value, 10, 64)
+			if err != nil || num <= 0 {
+				return 0, false
+			}
+			return num, true
+		}
+	}
+	return 0, false
+}
+
+// GetCollectionLoadFields returns the names of the fields to load in the collection properties,
+// ok is false if not set.
+func GetCollectionLoadFields(kvs ...*commonpb.KeyValuePair) (fields []string, ok bool) {
+	for _, kv := range kvs {
+		if kv.Key == LoadFieldsKey {
+			for _, field := range strings.Split(kv.Value, ",") {
+				if field = strings.TrimSpace(field); field != "" {
+					fields = append(fields, field)
+				}
+			}
+			return fields, len(fields) > 0
+		}
+	}
+	return nil, false
+}
+
+// GetCollectionReplicaNumber returns the replica number in the collection properties,
+// ok is false if not set or invalid.
+func GetCollectionReplicaNumber(kvs ...*commonpb.KeyValuePair) (num int32, ok bool) {
+	for _, kv := range kvs {
+		if kv.Key == CollectionReplicaNumberKey {
+			num, err := strconv.ParseInt(kv.Value, 10, 32)
+			if err != nil || num <= 0 {
+				return 0, false
+			}
+			return int32(num), true
+		}
+	}
+	return 0, false
+}
+
+// GetCollectionResourceGroups returns the resource groups in the collection properties,
+// ok is false if not set.
+func GetCollectionResourceGroups(kvs ...*commonpb.KeyValuePair) (rgs []string, ok bool) {
+	for _, kv := range kvs {
+		if kv.Key == CollectionResourceGroupsKey {
+			for _, rg := range strings.Split(kv.Value, ",") {
+				if rg = strings.TrimSpace(rg); rg != "" {
+					rgs = append(rgs, rg)
+				}
+			}
+			return rgs, len(rgs) > 0
+		}
+	}
+	return nil, false
+}
+
+// GetCollectionRankStrategy returns the rank strategy and its params in the collection properties,
+// ok is false if the strategy not set.
+func GetCollectionRankStrategy(kvs ...*commonpb.KeyValuePair) (strategy string, params string, ok bool) {
+	for _, kv := range kvs {
+		switch kv.Key {
+		case CollectionRankStrategyKey:
+			strategy = strings.TrimSpace(kv.Value)
+		case CollectionRankParamsKey:
+			params = kv.Value
+		}
+	}
+	if strategy == "" {
+		return "", "", false
+	}
+	if params == "" {
+		params = "{}"
+	}
+	return strategy, params, true
+}
+
+// GetCollectionSearchProfiles returns the json encoded search profiles by name in the collection properties.
+func GetCollectionSearchProfiles(kvs ...*commonpb.KeyValuePair) map[string]string {
+	profiles := make(map[string]string)
+	for _, kv := range kvs {
+		if name, ok := strings.CutPrefix(kv.Key, CollectionSearchProfilePrefix); ok {
+			profiles[name] = kv.Value
+		}
+	}
+	return profiles
+}
+
+// GetCollectionFieldMasks returns the fields masked for each role in the collection properties.
+func GetCollectionFieldMasks(kvs ...*commonpb.KeyValuePair) map[string][]string {
+	masks := make(map[string][]string)
+	for _, kv := range kvs {
+		role, ok := strings.CutPrefix(kv.Key, CollectionFieldMaskPrefix)
+		if !ok || role == "" {
+			continue
+		}
+		fields := make([]string, 0)
+		for _, field := range strings.Split(kv.Value, ",") {
+			if field = strings.TrimSpace(field); field != "" {
+				fields = append(fields, field)
+			}
+		}
+		if len(fields) > 0 {
+			masks[role] = fields
+		}
+	}
+	return masks
+}
+
+// GetCollectionRowFilters returns the row filter of each role in the collection properties.
+func GetCollectionRowFilters(kvs ...*commonpb.KeyValuePair) map[string]string {
+	filters := make(map[string]string)
+	for _, kv := range kvs {
+		role, ok := strings.CutPrefix(kv.Key, CollectionRowFilterPrefix)
+		if !ok || role == "" {
+			continue
+		}
+		if filter := strings.TrimSpace(kv.Value); filter != "" {
+			filters[role] = filter
+		}
+	}
+	return filters
+}
+
+// IsStorageProperty returns whether the key is a storage property of the collection, or the default of the database.
+func IsStorageProperty(key string) bool {
+	key = strings.TrimPrefix(key, DatabaseDefaultPropertyPrefix)
+	for _, storageKey := range CollectionStorageKeys {
+		if key == storageKey {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactProperties returns the properties without the secret access keys of the storages,
+// which are never returned to the clients or logged.
+func RedactProperties(kvs ...*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	ret := make([]*commonpb.KeyValuePair, 0, len(kvs))
+	for _, kv := range kvs {
+		if kv.GetKey() == CollectionStorageSecretAccessKeyKey || kv.GetKey() == DatabaseDefaultStorageSecretAccessKeyKey {
+			continue
+		}
+		ret = append(ret, kv)
+	}
+	return ret
+}
+
+// CollectionStorage is the storage of the collection placed in its own bucket.
+type CollectionStorage struct {
+	Address         string
+	BucketName      string
+	RootPath        string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// GetCollectionStorage returns the storage in the collection properties,
+// ok is false if the collection placed in the cluster bucket.
+func GetCollectionStorage(kvs ...*commonpb.KeyValuePair) (storage CollectionStorage, ok bool) {
+	for _, kv := range kvs {
+		value := strings.TrimSpace(kv.Value)
+		switch kv.Key {
+		case CollectionStorageAddressKey:
+			storage.Address = value
+		case CollectionStorageBucketKey:
+			storage.BucketName = value
+		case CollectionStorageRootPathKey:
+			storage.RootPath = value
+		case CollectionStorageAccessKeyIDKey:
+			storage.AccessKeyID = value
+		case CollectionStorageSecretAccessKeyKey:
+			storage.SecretAccessKey = value
+		}
+	}
+	if storage.BucketName == "" {
+		return CollectionStorage{}, false
+	}
+	return storage, true
+}
+
+// The compression codecs of the msgstream.
+const (
+	MsgStreamCompressionNone = "none"
+	MsgStreamCompressionLZ4  = "lz4"
+	MsgStreamCompressionZLib = "zlib"
+	MsgStreamCompressionZSTD = "zstd"
+)
+
+// CollectionMsgStream is the msgstream settings of the dml channels of the collection.
+type CollectionMsgStream struct {
+	Compression string
+	BatchSize   int
+	LingerMs    int64
+}
+
+// GetCollectionMsgStream returns the msgstream settings in the collection properties, the invalid ones are ignored,
+// ok is false if none set.
+func GetCollectionMsgStream(kvs ...*commonpb.KeyValuePair) (settings CollectionMsgStream, ok bool) {
+	for _, kv := range kvs {
+		value := strings.TrimSpace(kv.Value)
+		switch kv.Key {
+		case CollectionMsgStreamCompressionKey:
+			switch codec := strings.ToLower(value); codec {
+			case MsgStreamCompressionNone, MsgStreamCompressionLZ4, MsgStreamCompressionZLib, MsgStreamCompressionZSTD:
+				settings.Compression, ok = codec, true
+			}
+		case CollectionMsgStreamBatchSizeKey:
+			if size, err := strconv.Atoi(value); err == nil && size > 0 {
+				settings.BatchSize, ok = size, true
+			}
+		case CollectionMsgStreamLingerMsKey:
+			if linger, err := strconv.ParseInt(value, 10, 64); err == nil && linger >= 0 {
+				settings.LingerMs, ok = linger, true
+			}
+		}
+	}
+	return settings, ok
+}
+
 const (
 	// LatestVerision is the magic number for watch latest revision
 	LatestRevision = int64(-1)
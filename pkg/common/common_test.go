@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 )
 
 func TestIsSystemField(t *testing.T) {
@@ -38,3 +40,174 @@ func TestIsSystemField(t *testing.T) {
 		})
 	}
 }
+
+func TestCollectionTieringProperties(t *testing.T) {
+	_, ok := GetCollectionTieringEnabled()
+	assert.False(t, ok)
+	_, ok = GetCollectionTieringIdleTimeout()
+	assert.False(t, ok)
+
+	enabled, ok := GetCollectionTieringEnabled(&commonpb.KeyValuePair{Key: TieringEnableKey, Value: "True"})
+	assert.True(t, ok)
+	assert.True(t, enabled)
+	enabled, ok = GetCollectionTieringEnabled(&commonpb.KeyValuePair{Key: TieringEnableKey, Value: "false"})
+	assert.True(t, ok)
+	assert.False(t, enabled)
+
+	timeout, ok := GetCollectionTieringIdleTimeout(&commonpb.KeyValuePair{Key: TieringIdleTimeoutKey, Value: "600"})
+	assert.True(t, ok)
+	assert.EqualValues(t, 600, timeout)
+	_, ok = GetCollectionTieringIdleTimeout(&commonpb.KeyValuePair{Key: TieringIdleTimeoutKey, Value: "abc"})
+	assert.False(t, ok)
+	_, ok = GetCollectionTieringIdleTimeout(&commonpb.KeyValuePair{Key: TieringIdleTimeoutKey, Value: "-1"})
+	assert.False(t, ok)
+}
+
+func TestCollectionFieldMasks(t *testing.T) {
+	assert.Empty(t, GetCollectionFieldMasks())
+	masks := GetCollectionFieldMasks(
+		&commonpb.KeyValuePair{Key: CollectionFieldMaskPrefix + "analyst", Value: "ssn, phone,"},
+		&commonpb.KeyValuePair{Key: CollectionFieldMaskPrefix + "guest", Value: " "},
+		&commonpb.KeyValuePair{Key: CollectionFieldMaskPrefix, Value: "ssn"},
+		&commonpb.KeyValuePair{Key: CollectionTTLConfigKey, Value: "100"},
+	)
+	assert.Equal(t, map[string][]string{"analyst": {"ssn", "phone"}}, masks)
+}
+
+func TestCollectionRowFilters(t *testing.T) {
+	assert.Empty(t, GetCollectionRowFilters())
+	filters := GetCollectionRowFilters(
+		&commonpb.KeyValuePair{Key: CollectionRowFilterPrefix + "analyst", Value: " region == 'eu' "},
+		&commonpb.KeyValuePair{Key: CollectionRowFilterPrefix + "guest", Value: " "},
+		&commonpb.KeyValuePair{Key: CollectionRowFilterPrefix, Value: "id > 0"},
+		&commonpb.KeyValuePair{Key: CollectionTTLConfigKey, Value: "100"},
+	)
+	assert.Equal(t, map[string]string{"analyst": "region == 'eu'"}, filters)
+}
+
+func TestIsCollectionCDCEnabled(t *testing.T) {
+	assert.False(t, IsCollectionCDCEnabled())
+	assert.True(t, IsCollectionCDCEnabled(&commonpb.KeyValuePair{Key: CollectionCDCEnabledKey, Value: "True"}))
+	assert.False(t, IsCollectionCDCEnabled(&commonpb.KeyValuePair{Key: CollectionCDCEnabledKey, Value: "false"}))
+}
+
+func TestCollectionLoadFields(t *testing.T) {
+	_, ok := GetCollectionLoadFields()
+	assert.False(t, ok)
+	_, ok = GetCollectionLoadFields(&commonpb.KeyValuePair{Key: LoadFieldsKey, Value: " , "})
+	assert.False(t, ok)
+
+	fields, ok := GetCollectionLoadFields(&commonpb.KeyValuePair{Key: LoadFieldsKey, Value: "pk, vec,,title"})
+	assert.True(t, ok)
+	assert.Equal(t, []string{"pk", "vec", "title"}, fields)
+}
+
+func TestCollectionPartitionKeyBucketNum(t *testing.T) {
+	_, ok := GetCollectionPartitionKeyBucketNum()
+	assert.False(t, ok)
+
+	num, ok := GetCollectionPartitionKeyBucketNum(&commonpb.KeyValuePair{Key: CollectionPartitionKeyBucketNumKey, Value: "4"})
+	assert.True(t, ok)
+	assert.EqualValues(t, 4, num)
+	_, ok = GetCollectionPartitionKeyBucketNum(&commonpb.KeyValuePair{Key: CollectionPartitionKeyBucketNumKey, Value: "abc"})
+	assert.False(t, ok)
+	_, ok = GetCollectionPartitionKeyBucketNum(&commonpb.KeyValuePair{Key: CollectionPartitionKeyBucketNumKey, Value: "0"})
+	assert.False(t, ok)
+}
+
+func TestCollectionLoadConfig(t *testing.T) {
+	_, ok := GetCollectionReplicaNumber()
+	assert.False(t, ok)
+	num, ok := GetCollectionReplicaNumber(&commonpb.KeyValuePair{Key: CollectionReplicaNumberKey, Value: "2"})
+	assert.True(t, ok)
+	assert.EqualValues(t, 2, num)
+	_, ok = GetCollectionReplicaNumber(&commonpb.KeyValuePair{Key: CollectionReplicaNumberKey, Value: "0"})
+	assert.False(t, ok)
+
+	_, ok = GetCollectionResourceGroups(&commonpb.KeyValuePair{Key: CollectionResourceGroupsKey, Value: ","})
+	assert.False(t, ok)
+	rgs, ok := GetCollectionResourceGroups(&commonpb.KeyValuePair{Key: CollectionResourceGroupsKey, Value: "rg1, rg2"})
+	assert.True(t, ok)
+	assert.Equal(t, []string{"rg1", "rg2"}, rgs)
+}
+
+func TestCollectionRankStrategy(t *testing.T) {
+	_, _, ok := GetCollectionRankStrategy(&commonpb.KeyValuePair{Key: CollectionRankParamsKey, Value: `{"k": 60}`})
+	assert.False(t, ok)
+	strategy, params, ok := GetCollectionRankStrategy(&commonpb.KeyValuePair{Key: CollectionRankStrategyKey, Value: "rrf"})
+	assert.True(t, ok)
+	assert.Equal(t, "rrf", strategy)
+	assert.Equal(t, "{}", params)
+	strategy, params, ok = GetCollectionRankStrategy(
+		&commonpb.KeyValuePair{Key: CollectionRankStrategyKey, Value: "weighted"},
+		&commonpb.KeyValuePair{Key: CollectionRankParamsKey, Value: `{"weights": [0.4, 0.6]}`},
+	)
+	assert.True(t, ok)
+	assert.Equal(t, "weighted", strategy)
+	assert.Equal(t, `{"weights": [0.4, 0.6]}`, params)
+}
+
+func TestCollectionSearchProfiles(t *testing.T) {
+	profiles := GetCollectionSearchProfiles(
+		&commonpb.KeyValuePair{Key: CollectionSearchProfilePrefix + "fast", Value: `{"ef": 32}`},
+		&commonpb.KeyValuePair{Key: CollectionSearchProfilePrefix + "accurate", Value: `{"ef": 256}`},
+		&commonpb.KeyValuePair{Key: CollectionRankStrategyKey, Value: "rrf"},
+	)
+	assert.Equal(t, map[string]string{"fast": `{"ef": 32}`, "accurate": `{"ef": 256}`}, profiles)
+	assert.Empty(t, GetCollectionSearchProfiles())
+}
+
+func TestCollectionStorage(t *testing.T) {
+	_, ok := GetCollectionStorage(&commonpb.KeyValuePair{Key: CollectionStorageRootPathKey, Value: "tenant"})
+	assert.False(t, ok)
+	storage, ok := GetCollectionStorage(
+		&commonpb.KeyValuePair{Key: CollectionStorageBucketKey, Value: " tenant-bucket "},
+		&commonpb.KeyValuePair{Key: CollectionStorageRootPathKey, Value: "tenant"},
+		&commonpb.KeyValuePair{Key: CollectionStorageAccessKeyIDKey, Value: "ak"},
+		&commonpb.KeyValuePair{Key: CollectionStorageSecretAccessKeyKey, Value: "sk"},
+		&commonpb.KeyValuePair{Key: CollectionTTLConfigKey, Value: "10"},
+	)
+	assert.True(t, ok)
+	assert.Equal(t, CollectionStorage{
+		BucketName:      "tenant-bucket",
+		RootPath:        "tenant",
+		AccessKeyID:     "ak",
+		SecretAccessKey: "sk",
+	}, storage)
+}
+
+func TestStorageProperties(t *testing.T) {
+	assert.True(t, IsStorageProperty(CollectionStorageBucketKey))
+	assert.True(t, IsStorageProperty(DatabaseDefaultStorageRootPathKey))
+	assert.False(t, IsStorageProperty(CollectionTTLConfigKey))
+
+	props := RedactProperties(
+		&commonpb.KeyValuePair{Key: CollectionStorageAccessKeyIDKey, Value: "ak"},
+		&commonpb.KeyValuePair{Key: CollectionStorageSecretAccessKeyKey, Value: "sk"},
+		&commonpb.KeyValuePair{Key: DatabaseDefaultStorageSecretAccessKeyKey, Value: "sk"},
+		&commonpb.KeyValuePair{Key: CollectionTTLConfigKey, Value: "10"},
+	)
+	assert.Equal(t, []*commonpb.KeyValuePair{
+		{Key: CollectionStorageAccessKeyIDKey, Value: "ak"},
+		{Key: CollectionTTLConfigKey, Value: "10"},
+	}, props)
+}
+
+func TestCollectionMsgStream(t *testing.T) {
+	_, ok := GetCollectionMsgStream(&commonpb.KeyValuePair{Key: CollectionTTLConfigKey, Value: "10"})
+	assert.False(t, ok)
+	_, ok = GetCollectionMsgStream(
+		&commonpb.KeyValuePair{Key: CollectionMsgStreamCompressionKey, Value: "snappy"},
+		&commonpb.KeyValuePair{Key: CollectionMsgStreamBatchSizeKey, Value: "0"},
+		&commonpb.KeyValuePair{Key: CollectionMsgStreamLingerMsKey, Value: "-1"},
+	)
+	assert.False(t, ok)
+
+	settings, ok := GetCollectionMsgStream(
+		&commonpb.KeyValuePair{Key: CollectionMsgStreamCompressionKey, Value: " LZ4 "},
+		&commonpb.KeyValuePair{Key: CollectionMsgStreamBatchSizeKey, Value: "1000"},
+		&commonpb.KeyValuePair{Key: CollectionMsgStreamLingerMsKey, Value: "10"},
+	)
+	assert.True(t, ok)
+	assert.Equal(t, CollectionMsgStream{Compression: MsgStreamCompressionLZ4, BatchSize: 1000, LingerMs: 10}, settings)
+}
@@ -29,6 +29,8 @@ const (
 	InsertFileLabel          = "insert_file"
 	DeleteFileLabel          = "delete_file"
 	StatFileLabel            = "stat_file"
+	ArtifactFileLabel        = "artifact_file"
+	ParquetFileLabel         = "parquet_file"
 	IndexFileLabel           = "index_file"
 	segmentFileTypeLabelName = "segment_file_type"
 )
@@ -136,6 +138,60 @@ var (
 			channelNameLabelName,
 		})
 
+	DataCoordCheckpointLagSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "channel_checkpoint_lag_seconds",
+			Help:      "now time minus the channel checkpoint timestamp in seconds",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		})
+
+	DataCoordChannelUnflushedRows = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "channel_unflushed_rows",
+			Help:      "the rows of the channel not covered by the channel checkpoint",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		})
+
+	DataCoordCDCPublishedEventCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "cdc_published_event_count",
+			Help:      "count of the change events published to the cdc sink",
+		}, []string{
+			channelNameLabelName,
+			msgTypeLabelName,
+		})
+
+	DataCoordCDCCheckpointLagSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "cdc_checkpoint_lag_seconds",
+			Help:      "now time minus the cdc checkpoint of the channel in seconds",
+		}, []string{
+			channelNameLabelName,
+		})
+
+	DataCoordReplicationLagSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "replication_lag_seconds",
+			Help:      "now time minus the oldest channel checkpoint of the collection replicated in seconds",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
 	DataCoordStoredBinlogSize = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -189,6 +245,16 @@ var (
 			statusLabelName,
 		})
 
+	DataCoordExpiredRows = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "expired_rows_count",
+			Help:      "count of the rows reclaimed by dropping the segments expired by collection ttl",
+		}, []string{
+			collectionIDLabelName,
+		})
+
 	FlushedSegmentFileNum = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -301,11 +367,17 @@ func RegisterDataCoord(registry *prometheus.Registry) {
 	registry.MustRegister(DataCoordNumStoredRowsCounter)
 	registry.MustRegister(DataCoordConsumeDataNodeTimeTickLag)
 	registry.MustRegister(DataCoordCheckpointUnixSeconds)
+	registry.MustRegister(DataCoordCheckpointLagSeconds)
+	registry.MustRegister(DataCoordChannelUnflushedRows)
+	registry.MustRegister(DataCoordReplicationLagSeconds)
+	registry.MustRegister(DataCoordCDCPublishedEventCount)
+	registry.MustRegister(DataCoordCDCCheckpointLagSeconds)
 	registry.MustRegister(DataCoordStoredBinlogSize)
 	registry.MustRegister(DataCoordSegmentBinLogFileCount)
 	registry.MustRegister(DataCoordDmlChannelNum)
 	registry.MustRegister(DataCoordCompactedSegmentSize)
 	registry.MustRegister(DataCoordCompactionTaskNum)
+	registry.MustRegister(DataCoordExpiredRows)
 	registry.MustRegister(DataCoordSizeStoredL0Segment)
 	registry.MustRegister(DataCoordRateStoredL0Segment)
 	registry.MustRegister(FlushedSegmentFileNum)
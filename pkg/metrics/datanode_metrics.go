@@ -175,6 +175,17 @@ var (
 			nodeIDLabelName,
 		})
 
+	DataNodeCompactionExpiredRows = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "compaction_expired_rows_count",
+			Help:      "count of the rows expired by collection ttl and dropped in compaction",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
 	// DataNodeFlushReqCounter counts the num of calls of FlushSegments
 	DataNodeFlushReqCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -216,6 +227,42 @@ var (
 			collectionIDLabelName,
 		})
 
+	DataNodeFlowGraphNodeLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "fg_node_latency",
+			Help:      "latency of the flow graph node processing a message pack",
+			Buckets:   buckets, // unit: ms
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			flowGraphNodeLabelName,
+		})
+
+	DataNodeFlowGraphQueueLength = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "fg_queue_length",
+			Help:      "number of message packs queued for the flow graph node",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+			flowGraphNodeLabelName,
+		})
+
+	DataNodeChannelConsumeLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataNodeRole,
+			Name:      "channel_consume_lag_ms",
+			Help:      "now time minus the time tick processed per virtual channel",
+		}, []string{
+			nodeIDLabelName,
+			channelNameLabelName,
+		})
+
 	DataNodeMsgDispatcherTtLag = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -239,6 +286,9 @@ func RegisterDataNode(registry *prometheus.Registry) {
 	registry.MustRegister(DataNodeConsumeBytesCount)
 	// in memory
 	registry.MustRegister(DataNodeFlowGraphBufferDataSize)
+	registry.MustRegister(DataNodeFlowGraphNodeLatency)
+	registry.MustRegister(DataNodeFlowGraphQueueLength)
+	registry.MustRegister(DataNodeChannelConsumeLag)
 	// output related
 	registry.MustRegister(DataNodeAutoFlushBufferCount)
 	registry.MustRegister(DataNodeEncodeBufferLatency)
@@ -249,12 +299,24 @@ func RegisterDataNode(registry *prometheus.Registry) {
 	// compaction related
 	registry.MustRegister(DataNodeCompactionLatency)
 	registry.MustRegister(DataNodeCompactionLatencyInQueue)
+	registry.MustRegister(DataNodeCompactionExpiredRows)
 	// deprecated metrics
 	registry.MustRegister(DataNodeForwardDeleteMsgTimeTaken)
 	registry.MustRegister(DataNodeNumProducers)
 	registry.MustRegister(DataNodeProduceTimeTickLag)
 }
 
+// CleanupDataNodeChannelMetrics removes the metrics of the flow graph of the channel.
+func CleanupDataNodeChannelMetrics(nodeID int64, channel string) {
+	labels := prometheus.Labels{
+		nodeIDLabelName:      fmt.Sprint(nodeID),
+		channelNameLabelName: channel,
+	}
+	DataNodeFlowGraphNodeLatency.DeletePartialMatch(labels)
+	DataNodeFlowGraphQueueLength.DeletePartialMatch(labels)
+	DataNodeChannelConsumeLag.Delete(labels)
+}
+
 func CleanupDataNodeCollectionMetrics(nodeID int64, collectionID int64, channel string) {
 	DataNodeConsumeTimeTickLag.
 		Delete(
@@ -78,6 +78,7 @@ const (
 	collectionIDLabelName    = "collection_id"
 	partitionIDLabelName     = "partition_id"
 	channelNameLabelName     = "channel_name"
+	flowGraphNodeLabelName   = "fg_node"
 	functionLabelName        = "function_name"
 	queryTypeLabelName       = "query_type"
 	collectionName           = "collection_name"
@@ -92,6 +93,7 @@ const (
 	roleNameLabelName        = "role_name"
 	cacheNameLabelName       = "cache_name"
 	cacheStateLabelName      = "cache_state"
+	resourceLabelName        = "resource"
 	indexCountLabelName      = "indexed_field_count"
 	requestScope             = "scope"
 	fullMethodLabelName      = "full_method"
@@ -101,6 +103,7 @@ const (
 	lockType                 = "lock_type"
 	lockOp                   = "lock_op"
 	loadTypeName             = "load_type"
+	diskCacheCategoryName    = "category"
 
 	// entities label
 	LoadedLabel         = "loaded"
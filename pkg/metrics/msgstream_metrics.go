@@ -26,6 +26,13 @@ const (
 	CreateConsumerLabel = "create_consumer"
 
 	msgStreamOpType = "message_op_type"
+
+	// the anomalies of the sequences of the producers found by the consumers
+	SequenceDuplicateLabel = "duplicate"
+	SequenceGapLabel       = "gap"
+	SequenceReorderLabel   = "reorder"
+
+	sequenceAnomalyType = "anomaly_type"
 )
 
 var (
@@ -56,6 +63,14 @@ var (
 			Name:      "op_count",
 			Help:      "count of stream message operation",
 		}, []string{msgStreamOpType, statusLabelName})
+
+	MsgStreamSequenceAnomalyCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "msgstream",
+			Name:      "sequence_anomaly_count",
+			Help:      "count of the messages duplicated or following a gap in the sequence of the producer",
+		}, []string{roleNameLabelName, channelNameLabelName, sequenceAnomalyType})
 )
 
 // RegisterMsgStreamMetrics registers msg stream metrics
@@ -63,4 +78,5 @@ func RegisterMsgStreamMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(NumConsumers)
 	registry.MustRegister(MsgStreamRequestLatency)
 	registry.MustRegister(MsgStreamOpCounter)
+	registry.MustRegister(MsgStreamSequenceAnomalyCounter)
 }
@@ -25,7 +25,11 @@ const (
 	DataListLabel   = "list"
 	DataStatLabel   = "stat"
 
+	// ShedLabel is the status of the requests shed by the circuit breaker.
+	ShedLabel = "shed"
+
 	persistentDataOpType = "persistent_data_op_type"
+	requestClassLabel    = "request_class"
 )
 
 var (
@@ -54,6 +58,39 @@ var (
 			Name:      "op_count",
 			Help:      "count of persistent data operation",
 		}, []string{persistentDataOpType, statusLabelName})
+
+	PersistentDataClassOpCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "class_op_count",
+			Help:      "count of persistent data operation by request class",
+		}, []string{persistentDataOpType, requestClassLabel, statusLabelName})
+
+	PersistentDataBudgetWaitLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "budget_wait_latency",
+			Help:      "latency to wait for the request budget in milliseconds",
+			Buckets:   buckets,
+		}, []string{requestClassLabel})
+
+	PersistentDataCircuitBreakerOpen = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "circuit_breaker_open",
+			Help:      "whether the circuit breaker shedding the non-critical requests is open",
+		})
+
+	PersistentDataReadCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: "storage",
+			Name:      "read_cache_count",
+			Help:      "count of the reads served by the host local read cache of the object storage",
+		}, []string{cacheStateLabelName})
 )
 
 // RegisterStorageMetrics registers storage metrics
@@ -61,4 +98,8 @@ func RegisterStorageMetrics(registry *prometheus.Registry) {
 	registry.MustRegister(PersistentDataKvSize)
 	registry.MustRegister(PersistentDataRequestLatency)
 	registry.MustRegister(PersistentDataOpCounter)
+	registry.MustRegister(PersistentDataClassOpCounter)
+	registry.MustRegister(PersistentDataBudgetWaitLatency)
+	registry.MustRegister(PersistentDataCircuitBreakerOpen)
+	registry.MustRegister(PersistentDataReadCacheCounter)
 }
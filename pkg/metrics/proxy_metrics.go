@@ -325,6 +325,24 @@ var (
 			Name:      "slow_query_count",
 			Help:      "count of slow query executed",
 		}, []string{nodeIDLabelName, msgTypeLabelName})
+
+	// ProxyHedgedReadCount records the hedged search/query requests issued and the ones responded first.
+	ProxyHedgedReadCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "hedged_read_count",
+			Help:      "count of hedged search/query requests",
+		}, []string{nodeIDLabelName, statusLabelName})
+
+	// ProxyAuditLogCount records the audit logs emitted, failed to emit and abandoned since the buffer is full.
+	ProxyAuditLogCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "audit_log_count",
+			Help:      "count of audit logs",
+		}, []string{nodeIDLabelName, statusLabelName})
 )
 
 // RegisterProxy registers Proxy metrics
@@ -373,6 +391,8 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyRateLimitReqCount)
 
 	registry.MustRegister(ProxySlowQueryCount)
+	registry.MustRegister(ProxyHedgedReadCount)
+	registry.MustRegister(ProxyAuditLogCount)
 }
 
 func CleanupProxyDBMetrics(nodeID int64, dbName string) {
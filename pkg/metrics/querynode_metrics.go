@@ -240,6 +240,17 @@ var (
 			nodeIDLabelName,
 		})
 
+	QueryNodeSegmentWarmupLatency = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "segment_warmup_latency",
+			Help:      "latency of warming up the local files per segment after loaded",
+			Buckets:   longTaskBuckets, // unit milliseconds
+		}, []string{
+			nodeIDLabelName,
+		})
+
 	QueryNodeReadTaskUnsolveLen = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: milvusNamespace,
@@ -502,6 +513,166 @@ var (
 		}, []string{
 			nodeIDLabelName,
 		})
+
+	QueryNodeDiskCacheLoadTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "disk_cache_load_total",
+			Help:      "number of lazy load segments loaded into disk cache",
+		}, []string{
+			nodeIDLabelName,
+			statusLabelName,
+		})
+
+	QueryNodeDiskCacheLoadBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "disk_cache_load_bytes",
+			Help:      "estimated size in bytes of lazy load segments loaded into disk cache",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeDiskCacheLoadDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "disk_cache_load_duration",
+			Help:      "latency of loading lazy load segment into disk cache, in milliseconds",
+			Buckets:   longTaskBuckets, // unit milliseconds
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeDiskCacheEvictTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "disk_cache_evict_total",
+			Help:      "number of lazy load segments evicted from disk cache",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeDiskCacheEvictBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "disk_cache_evict_bytes",
+			Help:      "estimated size in bytes of lazy load segments evicted from disk cache",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeTieringEvictTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "tiering_evict_total",
+			Help:      "number of idle sealed segments evicted from memory to disk cache",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
+	QueryNodeTieringEvictBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "tiering_evict_bytes",
+			Help:      "estimated memory size in bytes of idle sealed segments evicted from memory to disk cache",
+		}, []string{
+			nodeIDLabelName,
+			collectionIDLabelName,
+		})
+
+	QueryNodeResultCacheAccessTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "result_cache_access_total",
+			Help:      "number of delegator search result cache accesses",
+		}, []string{
+			nodeIDLabelName,
+			cacheStateLabelName,
+		})
+
+	QueryNodeRequestBudgetExceededTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "request_budget_exceeded_total",
+			Help:      "number of search/query requests cancelled for exceeding the resource budget",
+		}, []string{
+			nodeIDLabelName,
+			queryTypeLabelName,
+			resourceLabelName,
+		})
+
+	QueryNodePartitionKeyPrunedSegmentNum = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "partition_key_pruned_segment_num",
+			Help:      "number of segments skipped by the delegator for not matching the partition keys of search/query",
+		}, []string{
+			nodeIDLabelName,
+			queryTypeLabelName,
+		})
+
+	QueryNodeDiskCacheUsage = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "disk_cache_usage",
+			Help:      "disk usage in bytes of the local disk cache by category",
+		}, []string{
+			nodeIDLabelName,
+			diskCacheCategoryName,
+		})
+
+	QueryNodeDiskCacheCorruptedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "disk_cache_corrupted_total",
+			Help:      "number of local disk cache files found corrupted by checksum",
+		}, []string{
+			nodeIDLabelName,
+			diskCacheCategoryName,
+		})
+
+	QueryNodeAdmissionMemoryRatio = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "admission_memory_ratio",
+			Help:      "ratio of memory in use including the mmap page cache working set to total memory, sampled by admission control",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeAdmissionQueuedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "admission_queued_total",
+			Help:      "number of read tasks queued for the memory headroom by admission control",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	QueryNodeAdmissionRejectedTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "admission_rejected_total",
+			Help:      "number of read tasks rejected for lack of memory headroom by admission control",
+		}, []string{
+			nodeIDLabelName,
+		})
 )
 
 // RegisterQueryNode registers QueryNode metrics
@@ -549,6 +720,22 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(StoppingBalanceSegmentNum)
 	registry.MustRegister(QueryNodeLoadSegmentConcurrency)
 	registry.MustRegister(QueryNodeLoadIndexLatency)
+	registry.MustRegister(QueryNodeDiskCacheLoadTotal)
+	registry.MustRegister(QueryNodeDiskCacheLoadBytes)
+	registry.MustRegister(QueryNodeDiskCacheLoadDuration)
+	registry.MustRegister(QueryNodeDiskCacheEvictTotal)
+	registry.MustRegister(QueryNodeDiskCacheEvictBytes)
+	registry.MustRegister(QueryNodeTieringEvictTotal)
+	registry.MustRegister(QueryNodeTieringEvictBytes)
+	registry.MustRegister(QueryNodeResultCacheAccessTotal)
+	registry.MustRegister(QueryNodeRequestBudgetExceededTotal)
+	registry.MustRegister(QueryNodeSegmentWarmupLatency)
+	registry.MustRegister(QueryNodePartitionKeyPrunedSegmentNum)
+	registry.MustRegister(QueryNodeDiskCacheUsage)
+	registry.MustRegister(QueryNodeDiskCacheCorruptedTotal)
+	registry.MustRegister(QueryNodeAdmissionMemoryRatio)
+	registry.MustRegister(QueryNodeAdmissionQueuedTotal)
+	registry.MustRegister(QueryNodeAdmissionRejectedTotal)
 }
 
 func CleanupQueryNodeCollectionMetrics(nodeID int64, collectionID int64) {
@@ -575,4 +762,10 @@ func CleanupQueryNodeCollectionMetrics(nodeID int64, collectionID int64) {
 					collectionIDLabelName: fmt.Sprint(collectionID),
 				})
 	}
+	for _, vec := range []*prometheus.CounterVec{QueryNodeTieringEvictTotal, QueryNodeTieringEvictBytes} {
+		vec.Delete(prometheus.Labels{
+			nodeIDLabelName:       fmt.Sprint(nodeID),
+			collectionIDLabelName: fmt.Sprint(collectionID),
+		})
+	}
 }
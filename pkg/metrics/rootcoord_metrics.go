@@ -6,6 +6,16 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
+const (
+	// the anomalies of the time ticks of the proxies and channels
+	TimeTickStallLabel = "stall"
+	TimeTickSkewLabel  = "skew"
+	TimeTickFenceLabel = "fence"
+
+	timeTickSourceLabelName  = "source"
+	timeTickAnomalyLabelName = "anomaly_type"
+)
+
 var (
 	// RootCoordProxyCounter counts the num of registered proxy nodes
 	RootCoordProxyCounter = prometheus.NewGaugeVec(
@@ -28,6 +38,33 @@ var (
 			Help:      "now time minus tt per physical channel",
 		}, []string{channelNameLabelName})
 
+	// RootCoordProxyTimeTickLag records the now time minus the latest time tick per proxy.
+	RootCoordProxyTimeTickLag = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "proxy_tt_lag_ms",
+			Help:      "now time minus the latest tt per proxy",
+		}, []string{nodeIDLabelName})
+
+	// RootCoordProxyTimeTickSkew records the latest time tick of all the proxies minus the one per proxy.
+	RootCoordProxyTimeTickSkew = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "proxy_tt_skew_ms",
+			Help:      "the latest tt of all the proxies minus the tt per proxy",
+		}, []string{nodeIDLabelName})
+
+	// RootCoordTimeTickAnomalyCounter counts the time tick stalls, skews of the proxies and channels and the proxies fenced.
+	RootCoordTimeTickAnomalyCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.RootCoordRole,
+			Name:      "tt_anomaly_count",
+			Help:      "count of the time tick anomalies",
+		}, []string{timeTickSourceLabelName, timeTickAnomalyLabelName})
+
 	RootCoordDDLReqCounter = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: milvusNamespace,
@@ -218,6 +255,9 @@ func RegisterRootCoord(registry *prometheus.Registry) {
 	// for time tick
 	registry.MustRegister(RootCoordInsertChannelTimeTick)
 	registry.MustRegister(RootCoordSyncTimeTickLatency)
+	registry.MustRegister(RootCoordProxyTimeTickLag)
+	registry.MustRegister(RootCoordProxyTimeTickSkew)
+	registry.MustRegister(RootCoordTimeTickAnomalyCounter)
 
 	// for DDL
 	registry.MustRegister(RootCoordDDLReqCounter)
@@ -21,6 +21,11 @@ type CommonFactory struct {
 	MQBufSize         int64
 }
 
+// NewClient creates a client by the newer
+func (f *CommonFactory) NewClient(ctx context.Context) (mqwrapper.Client, error) {
+	return f.Newer(ctx)
+}
+
 // NewMsgStream is used to generate a new Msgstream object
 func (f *CommonFactory) NewMsgStream(ctx context.Context) (ms MsgStream, err error) {
 	defer wrapError(&err, "NewMsgStream")
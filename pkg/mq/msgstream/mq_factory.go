@@ -74,13 +74,12 @@ func NewPmsFactory(serviceParam *paramtable.ServiceParam) *PmsFactory {
 	return f
 }
 
-// NewMsgStream is used to generate a new Msgstream object
-func (f *PmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+// NewClient creates a pulsar client, the deadline of ctx is used as the operation timeout if set
+func (f *PmsFactory) NewClient(ctx context.Context) (mqwrapper.Client, error) {
 	var timeout time.Duration = f.RequestTimeout
-
 	if deadline, ok := ctx.Deadline(); ok {
 		if deadline.Before(time.Now()) {
-			return nil, errors.New("context timeout when NewMsgStream")
+			return nil, errors.New("context timeout when create pulsar client")
 		}
 		timeout = time.Until(deadline)
 	}
@@ -95,8 +94,12 @@ func (f *PmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
 		OperationTimeout:  timeout,
 		MetricsRegisterer: f.metricRegisterer,
 	}
+	return pulsarmqwrapper.NewClient(f.PulsarTenant, f.PulsarNameSpace, clientOpts)
+}
 
-	pulsarClient, err := pulsarmqwrapper.NewClient(f.PulsarTenant, f.PulsarNameSpace, clientOpts)
+// NewMsgStream is used to generate a new Msgstream object
+func (f *PmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
+	pulsarClient, err := f.NewClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -105,29 +108,10 @@ func (f *PmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
 
 // NewTtMsgStream is used to generate a new TtMsgstream object
 func (f *PmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
-	var timeout time.Duration = f.RequestTimeout
-	if deadline, ok := ctx.Deadline(); ok {
-		if deadline.Before(time.Now()) {
-			return nil, errors.New("context timeout when NewTtMsgStream")
-		}
-		timeout = time.Until(deadline)
-	}
-	auth, err := f.getAuthentication()
+	pulsarClient, err := f.NewClient(ctx)
 	if err != nil {
 		return nil, err
 	}
-	clientOpts := pulsar.ClientOptions{
-		URL:               f.PulsarAddress,
-		Authentication:    auth,
-		OperationTimeout:  timeout,
-		MetricsRegisterer: f.metricRegisterer,
-	}
-
-	pulsarClient, err := pulsarmqwrapper.NewClient(f.PulsarTenant, f.PulsarNameSpace, clientOpts)
-	if err != nil {
-		return nil, err
-	}
-
 	return NewMqTtMsgStream(context.Background(), f.ReceiveBufSize, f.MQBufSize, pulsarClient, f.dispatcherFactory.NewUnmarshalDispatcher())
 }
 
@@ -183,8 +167,13 @@ type KmsFactory struct {
 	MQBufSize         int64
 }
 
+// NewClient creates a kafka client
+func (f *KmsFactory) NewClient(ctx context.Context) (mqwrapper.Client, error) {
+	return kafkawrapper.NewKafkaClientInstanceWithConfig(ctx, f.config)
+}
+
 func (f *KmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
-	kafkaClient, err := kafkawrapper.NewKafkaClientInstanceWithConfig(ctx, f.config)
+	kafkaClient, err := f.NewClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -192,7 +181,7 @@ func (f *KmsFactory) NewMsgStream(ctx context.Context) (MsgStream, error) {
 }
 
 func (f *KmsFactory) NewTtMsgStream(ctx context.Context) (MsgStream, error) {
-	kafkaClient, err := kafkawrapper.NewKafkaClientInstanceWithConfig(ctx, f.config)
+	kafkaClient, err := f.NewClient(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -221,11 +210,13 @@ func NewKmsFactory(config *paramtable.ServiceParam) Factory {
 	return f
 }
 
-// NewNatsmqFactory create a new nats-mq factory.
+// NewNatsmqFactory create a new nats-mq factory, the embedded nats server is started unless the external one configured.
 func NewNatsmqFactory() Factory {
 	paramtable.Init()
 	paramtable := paramtable.Get()
-	nmq.MustInitNatsMQ(nmq.ParseServerOption(paramtable))
+	if paramtable.NatsmqCfg.Address.GetValue() == "" {
+		nmq.MustInitNatsMQ(nmq.ParseServerOption(paramtable))
+	}
 	return &CommonFactory{
 		Newer:             nmq.NewClientWithDefaultOptions,
 		DispatcherFactory: ProtoUDFactory{},
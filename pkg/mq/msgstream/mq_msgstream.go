@@ -28,6 +28,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
 	uatomic "go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -44,15 +45,35 @@ import (
 )
 
 var (
-	_             MsgStream = (*mqMsgStream)(nil)
+	_             MsgStream            = (*mqMsgStream)(nil)
+	_             ProducerConfigurable = (*mqMsgStream)(nil)
 	streamCounter uatomic.Int64
+
+	clientWrapper atomic.Value
 )
 
+// SetClientWrapper sets the function wrapping the clients of the msgstreams created afterwards,
+// such as reading back the messages offloaded to the object storage.
+func SetClientWrapper(wrapper func(mqwrapper.Client) mqwrapper.Client) {
+	clientWrapper.Store(wrapper)
+}
+
+func wrapClient(client mqwrapper.Client) mqwrapper.Client {
+	wrapper, ok := clientWrapper.Load().(func(mqwrapper.Client) mqwrapper.Client)
+	if !ok || wrapper == nil {
+		return client
+	}
+	return wrapper(client)
+}
+
 type mqMsgStream struct {
 	ctx              context.Context
 	client           mqwrapper.Client
 	producers        map[string]mqwrapper.Producer
 	producerChannels []string
+	producerOptions  mqwrapper.ProducerOptions
+	producerSeqs     map[string]*producerSequence
+	seqChecker       *sequenceChecker
 	consumers        map[string]mqwrapper.Consumer
 	consumerChannels []string
 
@@ -78,6 +99,7 @@ func NewMqMsgStream(ctx context.Context,
 	unmarshal UnmarshalDispatcher,
 ) (*mqMsgStream, error) {
 	streamCtx, streamCancel := context.WithCancel(ctx)
+	client = wrapClient(client)
 	producers := make(map[string]mqwrapper.Producer)
 	consumers := make(map[string]mqwrapper.Consumer)
 	producerChannels := make([]string, 0)
@@ -90,6 +112,8 @@ func NewMqMsgStream(ctx context.Context,
 		client:           client,
 		producers:        producers,
 		producerChannels: producerChannels,
+		producerSeqs:     make(map[string]*producerSequence),
+		producerOptions:  mqwrapper.ProducerOptions{EnableCompression: true},
 		consumers:        consumers,
 		consumerChannels: consumerChannels,
 
@@ -101,6 +125,9 @@ func NewMqMsgStream(ctx context.Context,
 		closeRWMutex: &sync.RWMutex{},
 		closed:       0,
 	}
+	if paramtable.Get().MQCfg.IdempotenceEnabled.GetAsBool() {
+		stream.seqChecker = newSequenceChecker()
+	}
 	ctxLog := log.Ctx(ctx)
 	stream.enableProduce.Store(paramtable.Get().CommonCfg.TTMsgEnabled.GetAsBool())
 	stream.configEvent = config.NewHandler("enable send tt msg "+fmt.Sprint(streamCounter.Inc()), func(event *config.Event) {
@@ -118,6 +145,11 @@ func NewMqMsgStream(ctx context.Context,
 	return stream, nil
 }
 
+// SetProducerOptions sets the options of the producers created by AsProducer afterwards.
+func (ms *mqMsgStream) SetProducerOptions(options mqwrapper.ProducerOptions) {
+	ms.producerOptions = options
+}
+
 // AsProducer create producer to send message to channels
 func (ms *mqMsgStream) AsProducer(channels []string) {
 	for _, channel := range channels {
@@ -127,7 +159,9 @@ func (ms *mqMsgStream) AsProducer(channels []string) {
 		}
 
 		fn := func() error {
-			pp, err := ms.client.CreateProducer(mqwrapper.ProducerOptions{Topic: channel, EnableCompression: true})
+			options := ms.producerOptions
+			options.Topic = channel
+			pp, err := ms.client.CreateProducer(options)
 			if err != nil {
 				return err
 			}
@@ -139,6 +173,9 @@ func (ms *mqMsgStream) AsProducer(channels []string) {
 			defer ms.producerLock.Unlock()
 			ms.producers[channel] = pp
 			ms.producerChannels = append(ms.producerChannels, channel)
+			if paramtable.Get().MQCfg.IdempotenceEnabled.GetAsBool() {
+				ms.producerSeqs[channel] = newProducerSequence()
+			}
 			return nil
 		}
 		err := retry.Do(context.TODO(), fn, retry.Attempts(20), retry.Sleep(time.Millisecond*200), retry.MaxSleepTime(5*time.Second))
@@ -305,6 +342,12 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 	}
 	for k, v := range result {
 		channel := ms.producerChannels[k]
+		if ms.producerOptions.BatchingMaxMessages > 1 {
+			if err := ms.produceBatch(channel, v.Msgs); err != nil {
+				return err
+			}
+			continue
+		}
 		for i := 0; i < len(v.Msgs); i++ {
 			spanCtx, sp := MsgSpanFromCtx(v.Msgs[i].TraceCtx(), v.Msgs[i])
 			defer sp.End()
@@ -323,7 +366,7 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 			InjectCtx(spanCtx, msg.Properties)
 
 			ms.producerLock.RLock()
-			if _, err := ms.producers[channel].Send(spanCtx, msg); err != nil {
+			if _, err := ms.producerSeqs[channel].send(spanCtx, ms.producers[channel], msg); err != nil {
 				ms.producerLock.RUnlock()
 				sp.RecordError(err)
 				return err
@@ -334,6 +377,67 @@ func (ms *mqMsgStream) Produce(msgPack *MsgPack) error {
 	return nil
 }
 
+// produceBatch sends the messages of the channel in batches if supported by the producer.
+func (ms *mqMsgStream) produceBatch(channel string, tsMsgs []TsMsg) error {
+	msgs := make([]*mqwrapper.ProducerMessage, 0, len(tsMsgs))
+	spans := make([]trace.Span, 0, len(tsMsgs))
+	defer func() {
+		for _, sp := range spans {
+			sp.End()
+		}
+	}()
+	var ctx context.Context
+	for _, tsMsg := range tsMsgs {
+		spanCtx, sp := MsgSpanFromCtx(tsMsg.TraceCtx(), tsMsg)
+		spans = append(spans, sp)
+		if ctx == nil {
+			ctx = spanCtx
+		}
+
+		mb, err := tsMsg.Marshal(tsMsg)
+		if err != nil {
+			return err
+		}
+		m, err := convertToByteArray(mb)
+		if err != nil {
+			return err
+		}
+		msg := &mqwrapper.ProducerMessage{Payload: m, Properties: map[string]string{}}
+		InjectCtx(spanCtx, msg.Properties)
+		msgs = append(msgs, msg)
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	ms.producerLock.RLock()
+	defer ms.producerLock.RUnlock()
+	producer, seq := ms.producers[channel], ms.producerSeqs[channel]
+	batchProducer, ok := producer.(mqwrapper.BatchProducer)
+	if !ok {
+		for _, msg := range msgs {
+			if _, err := seq.send(ctx, producer, msg); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	batchSize := ms.producerOptions.BatchingMaxMessages
+	for start := 0; start < len(msgs); start += batchSize {
+		end := start + batchSize
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+		if _, err := seq.sendBatch(ctx, batchProducer, msgs[start:end]); err != nil {
+			for _, sp := range spans[start:end] {
+				sp.RecordError(err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
 // BroadcastMark broadcast msg pack to all producers and returns corresponding msg id
 // the returned message id serves as marking
 func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) (map[string][]MessageID, error) {
@@ -367,7 +471,7 @@ func (ms *mqMsgStream) Broadcast(msgPack *MsgPack) (map[string][]MessageID, erro
 
 		ms.producerLock.Lock()
 		for channel, producer := range ms.producers {
-			id, err := producer.Send(spanCtx, msg)
+			id, err := ms.producerSeqs[channel].send(spanCtx, producer, msg)
 			if err != nil {
 				ms.producerLock.Unlock()
 				sp.RecordError(err)
@@ -427,6 +531,9 @@ func (ms *mqMsgStream) receiveMsg(consumer mqwrapper.Consumer) {
 				log.Warn("MqMsgStream get msg whose payload is nil")
 				continue
 			}
+			if !ms.seqChecker.check(msg) {
+				continue
+			}
 			// not need to check the preCreatedTopic is empty, related issue: https://github.com/milvus-io/milvus/issues/27295
 			// if the message not belong to the topic, will skip it
 			tsMsg, err := ms.getTsMsgFromConsumerMsg(msg)
@@ -782,6 +889,9 @@ func (ms *MqTtMsgStream) consumeToTtMsg(consumer mqwrapper.Consumer) {
 				log.Warn("MqTtMsgStream get msg whose payload is nil")
 				continue
 			}
+			if !ms.seqChecker.check(msg) {
+				continue
+			}
 			// not need to check the preCreatedTopic is empty, related issue: https://github.com/milvus-io/milvus/issues/27295
 			// if the message not belong to the topic, will skip it
 			tsMsg, err := ms.getTsMsgFromConsumerMsg(msg)
@@ -893,6 +1003,9 @@ func (ms *MqTtMsgStream) Seek(ctx context.Context, msgPositions []*msgpb.MsgPosi
 					return fmt.Errorf("consumer closed")
 				}
 				consumer.Ack(msg)
+				if !ms.seqChecker.check(msg) {
+					continue
+				}
 
 				headerMsg := commonpb.MsgHeader{}
 				err := proto.Unmarshal(msg.Payload(), &headerMsg)
@@ -16,6 +16,8 @@
 
 package mqwrapper
 
+import "time"
+
 // SubscriptionInitialPosition is the type of a subscription initial position
 type SubscriptionInitialPosition int
 
@@ -74,3 +76,9 @@ type Consumer interface {
 	// check created topic whether vaild or not
 	CheckTopicValid(channel string) error
 }
+
+// TimeSeeker is implemented by the consumers which could seek by the publish time besides the message ID.
+type TimeSeeker interface {
+	// SeekByTime seeks to the first message published at or after the time, it should be called before Chan
+	SeekByTime(time.Time) error
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 )
 
+var _ mqwrapper.BatchProducer = (*kafkaProducer)(nil)
+
 type kafkaProducer struct {
 	p            *kafka.Producer
 	topic        string
@@ -38,16 +40,7 @@ func (kp *kafkaProducer) Send(ctx context.Context, message *mqwrapper.ProducerMe
 		return nil, common.NewIgnorableError(fmt.Errorf("kafka producer is closed"))
 	}
 
-	headers := make([]kafka.Header, 0, len(message.Properties))
-	for key, value := range message.Properties {
-		header := kafka.Header{Key: key, Value: []byte(value)}
-		headers = append(headers, header)
-	}
-	err := kp.p.Produce(&kafka.Message{
-		TopicPartition: kafka.TopicPartition{Topic: &kp.topic, Partition: mqwrapper.DefaultPartitionIdx},
-		Value:          message.Payload,
-		Headers:        headers,
-	}, kp.deliveryChan)
+	err := kp.p.Produce(kp.newMessage(message), kp.deliveryChan)
 	if err != nil {
 		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Inc()
 		return nil, err
@@ -73,6 +66,62 @@ func (kp *kafkaProducer) Send(ctx context.Context, message *mqwrapper.ProducerMe
 	return &kafkaID{messageID: int64(m.TopicPartition.Offset)}, nil
 }
 
+func (kp *kafkaProducer) newMessage(message *mqwrapper.ProducerMessage) *kafka.Message {
+	headers := make([]kafka.Header, 0, len(message.Properties))
+	for key, value := range message.Properties {
+		header := kafka.Header{Key: key, Value: []byte(value)}
+		headers = append(headers, header)
+	}
+	return &kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &kp.topic, Partition: mqwrapper.DefaultPartitionIdx},
+		Value:          message.Payload,
+		Headers:        headers,
+	}
+}
+
+// SendBatch produces the messages at once to be batched within the linger time of the producer,
+// and waits for all of them delivered.
+func (kp *kafkaProducer) SendBatch(ctx context.Context, messages []*mqwrapper.ProducerMessage) ([]mqwrapper.MessageID, error) {
+	start := timerecord.NewTimeRecorder("send msgs to stream")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Add(float64(len(messages)))
+
+	if kp.isClosed {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Add(float64(len(messages)))
+		log.Error("kafka produce message fail because the producer has been closed", zap.String("topic", kp.topic))
+		return nil, common.NewIgnorableError(fmt.Errorf("kafka producer is closed"))
+	}
+
+	// the deliveries of the batch are not mixed with the ones sent concurrently
+	deliveryChan := make(chan kafka.Event, len(messages))
+	produced := 0
+	var err error
+	for _, message := range messages {
+		if err = kp.p.Produce(kp.newMessage(message), deliveryChan); err != nil {
+			break
+		}
+		produced++
+	}
+
+	ids := make([]mqwrapper.MessageID, len(messages))
+	for i := 0; i < produced; i++ {
+		m := (<-deliveryChan).(*kafka.Message)
+		if m.TopicPartition.Error != nil {
+			err = m.TopicPartition.Error
+			continue
+		}
+		// the messages are delivered in order within the partition
+		ids[i] = &kafkaID{messageID: int64(m.TopicPartition.Offset)}
+	}
+	if err != nil {
+		metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Add(float64(len(messages)))
+		return ids, err
+	}
+
+	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.SendMsgLabel).Observe(float64(start.ElapseSpan().Milliseconds()))
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.SuccessLabel).Add(float64(len(messages)))
+	return ids, nil
+}
+
 func (kp *kafkaProducer) Close() {
 	kp.closeOnce.Do(func() {
 		kp.isClosed = true
@@ -58,9 +58,12 @@ func (d *nmqDialer) Dial(network, address string) (net.Conn, error) {
 }
 
 // NewClientWithDefaultOptions returns a new NMQ client with default options.
-// It retrieves the NMQ client URL from the server configuration.
+// It connects to the external nats server if configured, otherwise retrieves the URL of the embedded server.
 func NewClientWithDefaultOptions(ctx context.Context) (mqwrapper.Client, error) {
-	url := Nmq.ClientURL()
+	url := paramtable.Get().NatsmqCfg.Address.GetValue()
+	if url == "" {
+		url = Nmq.ClientURL()
+	}
 
 	opt := nats.SetCustomDialer(&nmqDialer{
 		ctx: func() context.Context { return ctx },
@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
 func createNmqClient() (*nmqClient, error) {
@@ -77,6 +78,21 @@ func Test_NewNmqClient(t *testing.T) {
 	}
 }
 
+func TestNmqClient_ExternalAddress(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.NatsmqCfg.Address.Key, natsServerAddress)
+	defer params.Reset(params.NatsmqCfg.Address.Key)
+
+	client, err := NewClientWithDefaultOptions(context.Background())
+	require.NoError(t, err)
+	defer client.Close()
+	assert.Equal(t, natsServerAddress, client.(*nmqClient).conn.ConnectedUrl())
+
+	params.Save(params.NatsmqCfg.Address.Key, "nats://127.0.0.1:1")
+	_, err = NewClientWithDefaultOptions(context.Background())
+	assert.Error(t, err)
+}
+
 func TestNmqClient_CreateProducer(t *testing.T) {
 	client, err := createNmqClient()
 	assert.NoError(t, err)
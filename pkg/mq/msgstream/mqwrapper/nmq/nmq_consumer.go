@@ -19,6 +19,7 @@ package nmq
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/nats-io/nats.go"
@@ -29,6 +30,8 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
+var _ mqwrapper.TimeSeeker = (*Consumer)(nil)
+
 // Consumer is a client that used to consume messages from natsmq
 type Consumer struct {
 	options   mqwrapper.ConsumerOptions
@@ -111,6 +114,27 @@ func (nc *Consumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
 	return err
 }
 
+// SeekByTime is used to seek to the first message published at or after the time in natsmq topic
+func (nc *Consumer) SeekByTime(t time.Time) error {
+	if err := nc.closed(); err != nil {
+		return err
+	}
+	if nc.sub != nil {
+		return errors.New("can not seek() on an initilized consumer")
+	}
+	if nc.msgChan != nil {
+		return errors.New("Seek should be called before Chan")
+	}
+	log.Info("SeekByTime is called", zap.String("topic", nc.topic), zap.Time("time", t))
+	nc.skip = false
+	var err error
+	nc.sub, err = nc.js.ChanSubscribe(nc.topic, nc.natsChan, nats.StartTime(t))
+	if err != nil {
+		log.Warn("fail to SeekByTime", zap.Error(err))
+	}
+	return err
+}
+
 // Ack is used to ask a natsmq message
 func (nc *Consumer) Ack(message mqwrapper.Message) {
 	if err := message.(*nmqMessage).raw.Ack(); err != nil {
@@ -416,6 +416,31 @@ func TestNatsConsumer_SeekInclusive(t *testing.T) {
 	assert.Equal(t, "333", string(msg.Payload()))
 }
 
+func TestNatsConsumer_SeekByTime(t *testing.T) {
+	topic := t.Name()
+	c, p := newProducer(t, topic)
+	defer c.Close()
+	defer p.Close()
+
+	process(t, []string{"111", "222"}, p)
+	time.Sleep(10 * time.Millisecond)
+	seekTime := time.Now()
+	process(t, []string{"333", "444"}, p)
+
+	consumer, err := newTestConsumer(t, topic, mqwrapper.SubscriptionPositionUnknown)
+	assert.NoError(t, err)
+	defer consumer.Close()
+	err = consumer.(mqwrapper.TimeSeeker).SeekByTime(seekTime)
+	assert.NoError(t, err)
+	assert.Error(t, consumer.Seek(&nmqID{messageID: 1}, true))
+
+	msg := <-consumer.Chan()
+	assert.Equal(t, "333", string(msg.Payload()))
+	msg = <-consumer.Chan()
+	assert.Equal(t, "444", string(msg.Payload()))
+	assert.Error(t, consumer.(mqwrapper.TimeSeeker).SeekByTime(seekTime))
+}
+
 func TestNatsConsumer_NoDoubleSeek(t *testing.T) {
 	topic := t.Name()
 	c, p := newProducer(t, topic)
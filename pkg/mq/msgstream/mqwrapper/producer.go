@@ -16,7 +16,10 @@
 
 package mqwrapper
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // ProducerOptions contains the options of a producer
 type ProducerOptions struct {
@@ -26,6 +29,18 @@ type ProducerOptions struct {
 	// Enable compression
 	// For Pulsar, this enables ZSTD compression with default compression level
 	EnableCompression bool
+
+	// CompressionType overrides EnableCompression if set, one of none, lz4, zlib and zstd
+	// For Kafka, the producer shared by the topics keeps its own compression codec
+	CompressionType string
+
+	// BatchingMaxMessages is the max number of the messages sent in a batch by SendBatch,
+	// batching is disabled if not greater than 1
+	BatchingMaxMessages int
+
+	// BatchingMaxPublishDelay is the max time the messages wait to fill a batch
+	// For Kafka, the producer shared by the topics keeps its own linger time
+	BatchingMaxPublishDelay time.Duration
 }
 
 // ProducerMessage contains the messages of a producer
@@ -47,3 +62,12 @@ type Producer interface {
 
 	Close()
 }
+
+// BatchProducer is the producer which sends the messages in batches
+type BatchProducer interface {
+	Producer
+
+	// SendBatch publishes the messages in order and waits for all of them persisted,
+	// the ids are in the same order of the messages
+	SendBatch(ctx context.Context, messages []*ProducerMessage) ([]MessageID, error)
+}
@@ -79,10 +79,30 @@ func (pc *pulsarClient) CreateProducer(options mqwrapper.ProducerOptions) (mqwra
 		opts.CompressionType = pulsar.ZSTD
 		opts.CompressionLevel = pulsar.Faster
 	}
-	// disable automatic batching
-	opts.DisableBatching = true
-	// change the batching max publish delay higher to avoid extra cpu consumption
-	opts.BatchingMaxPublishDelay = 1 * time.Minute
+	switch options.CompressionType {
+	case "none":
+		opts.CompressionType = pulsar.NoCompression
+	case "lz4":
+		opts.CompressionType = pulsar.LZ4
+	case "zlib":
+		opts.CompressionType = pulsar.ZLib
+	case "zstd":
+		opts.CompressionType = pulsar.ZSTD
+		opts.CompressionLevel = pulsar.Faster
+	}
+	if options.BatchingMaxMessages > 1 {
+		// the messages sent together by SendBatch are batched
+		opts.BatchingMaxMessages = uint(options.BatchingMaxMessages)
+		opts.BatchingMaxPublishDelay = options.BatchingMaxPublishDelay
+		if opts.BatchingMaxPublishDelay <= 0 {
+			opts.BatchingMaxPublishDelay = time.Millisecond
+		}
+	} else {
+		// disable automatic batching
+		opts.DisableBatching = true
+		// change the batching max publish delay higher to avoid extra cpu consumption
+		opts.BatchingMaxPublishDelay = 1 * time.Minute
+	}
 
 	pp, err := pc.client.CreateProducer(opts)
 	if err != nil {
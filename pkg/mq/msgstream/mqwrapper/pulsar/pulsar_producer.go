@@ -18,6 +18,7 @@ package pulsar
 
 import (
 	"context"
+	"sync"
 
 	"github.com/apache/pulsar-client-go/pulsar"
 
@@ -27,7 +28,7 @@ import (
 )
 
 // implementation assertion
-var _ mqwrapper.Producer = (*pulsarProducer)(nil)
+var _ mqwrapper.BatchProducer = (*pulsarProducer)(nil)
 
 type pulsarProducer struct {
 	p pulsar.Producer
@@ -54,6 +55,37 @@ func (pp *pulsarProducer) Send(ctx context.Context, message *mqwrapper.ProducerM
 	return &pulsarID{messageID: pmID}, nil
 }
 
+// SendBatch sends the messages asynchronously to be batched with the ones sent concurrently by the producer,
+// and waits for all of them persisted.
+func (pp *pulsarProducer) SendBatch(ctx context.Context, messages []*mqwrapper.ProducerMessage) ([]mqwrapper.MessageID, error) {
+	start := timerecord.NewTimeRecorder("send msgs to stream")
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.TotalLabel).Add(float64(len(messages)))
+
+	ids := make([]mqwrapper.MessageID, len(messages))
+	errs := make([]error, len(messages))
+	wg := sync.WaitGroup{}
+	wg.Add(len(messages))
+	for i, message := range messages {
+		i := i
+		ppm := &pulsar.ProducerMessage{Payload: message.Payload, Properties: message.Properties}
+		pp.p.SendAsync(ctx, ppm, func(pmID pulsar.MessageID, _ *pulsar.ProducerMessage, err error) {
+			defer wg.Done()
+			ids[i], errs[i] = &pulsarID{messageID: pmID}, err
+		})
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.FailLabel).Add(float64(len(messages)))
+			return ids, err
+		}
+	}
+	metrics.MsgStreamRequestLatency.WithLabelValues(metrics.SendMsgLabel).Observe(float64(start.ElapseSpan().Milliseconds()))
+	metrics.MsgStreamOpCounter.WithLabelValues(metrics.SendMsgLabel, metrics.SuccessLabel).Add(float64(len(messages)))
+	return ids, nil
+}
+
 func (pp *pulsarProducer) Close() {
 	pp.p.Close()
 }
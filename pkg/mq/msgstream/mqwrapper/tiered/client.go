@@ -0,0 +1,207 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tiered
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+// client wraps the client of the mq, the consumers seeking to the positions offloaded
+// read the segments back from the object storage before the messages left in the mq.
+type client struct {
+	mqwrapper.Client
+	storage  ObjectStorage
+	rootPath string
+}
+
+var _ mqwrapper.Client = (*client)(nil)
+
+// NewClient wraps the client of the mq to read back the messages offloaded to the storage.
+func NewClient(inner mqwrapper.Client, storage ObjectStorage) mqwrapper.Client {
+	return &client{
+		Client:   inner,
+		storage:  storage,
+		rootPath: rootPath(storage),
+	}
+}
+
+func (c *client) Subscribe(options mqwrapper.ConsumerOptions) (mqwrapper.Consumer, error) {
+	consumer, err := c.Client.Subscribe(options)
+	if err != nil {
+		return nil, err
+	}
+	return &tieredConsumer{
+		Consumer: consumer,
+		client:   c,
+		topic:    options.Topic,
+		bufSize:  options.BufSize,
+		closeCh:  make(chan struct{}),
+	}, nil
+}
+
+type tieredConsumer struct {
+	mqwrapper.Consumer
+	client  *client
+	topic   string
+	bufSize int64
+
+	// the segments to read back and the position seeked
+	segments  []*segment
+	seekID    []byte
+	inclusive bool
+
+	once      sync.Once
+	msgChan   <-chan mqwrapper.Message
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+// Seek reads back the segments from the one covering the position if offloaded,
+// and seeks the consumer of the mq to the end of the last segment.
+func (c *tieredConsumer) Seek(id mqwrapper.MessageID, inclusive bool) error {
+	ctx := context.Background()
+	segments, err := listSegments(ctx, c.client.storage, c.client.rootPath, c.topic)
+	if err != nil {
+		return err
+	}
+	for i, segment := range segments {
+		afterLast, err := id.LessOrEqualThan(segment.lastID)
+		if err != nil {
+			return err
+		}
+		if !afterLast {
+			continue
+		}
+		firstID, err := c.client.BytesToMsgID(segment.firstID)
+		if err != nil {
+			return err
+		}
+		covered, err := firstID.LessOrEqualThan(id.Serialize())
+		if err != nil {
+			return err
+		}
+		if !covered {
+			// the position is before the segments retained, leave it to the mq
+			break
+		}
+		lastID, err := c.client.BytesToMsgID(segments[len(segments)-1].lastID)
+		if err != nil {
+			return err
+		}
+		if err := c.Consumer.Seek(lastID, false); err != nil {
+			return err
+		}
+		log.Info("seek to the segments offloaded", zap.String("topic", c.topic), zap.String("segment", segment.path), zap.Int("numSegments", len(segments)-i))
+		c.segments, c.seekID, c.inclusive = segments[i:], id.Serialize(), inclusive
+		return nil
+	}
+	return c.Consumer.Seek(id, inclusive)
+}
+
+func (c *tieredConsumer) Chan() <-chan mqwrapper.Message {
+	c.once.Do(func() {
+		if len(c.segments) == 0 {
+			c.msgChan = c.Consumer.Chan()
+			return
+		}
+		msgChan := make(chan mqwrapper.Message, c.bufSize)
+		c.msgChan = msgChan
+		c.wg.Add(1)
+		go c.readBack(msgChan)
+	})
+	return c.msgChan
+}
+
+// readBack sends the messages of the segments after the position seeked, then the messages of the mq.
+func (c *tieredConsumer) readBack(msgChan chan<- mqwrapper.Message) {
+	defer c.wg.Done()
+	defer close(msgChan)
+	send := func(msg mqwrapper.Message) bool {
+		select {
+		case msgChan <- msg:
+			return true
+		case <-c.closeCh:
+			return false
+		}
+	}
+
+	for _, segment := range c.segments {
+		data, err := c.client.storage.Read(context.Background(), segment.path)
+		if err != nil {
+			log.Error("failed to read segment offloaded", zap.String("segment", segment.path), zap.Error(err))
+			return
+		}
+		msgs, err := decodeMessages(c.topic, data, c.client.BytesToMsgID)
+		if err != nil {
+			log.Error("failed to decode segment offloaded", zap.String("segment", segment.path), zap.Error(err))
+			return
+		}
+		for _, msg := range msgs {
+			if c.seekID != nil {
+				before, err := msg.ID().LessOrEqualThan(c.seekID)
+				if err != nil {
+					log.Error("failed to compare message id", zap.String("segment", segment.path), zap.Error(err))
+					return
+				}
+				if before {
+					if equal, _ := msg.ID().Equal(c.seekID); !equal || !c.inclusive {
+						continue
+					}
+				}
+				c.seekID = nil
+			}
+			if !send(msg) {
+				return
+			}
+		}
+	}
+
+	inner := c.Consumer.Chan()
+	for {
+		select {
+		case msg, ok := <-inner:
+			if !ok || !send(msg) {
+				return
+			}
+		case <-c.closeCh:
+			return
+		}
+	}
+}
+
+// Ack acks the messages of the mq, the messages read back are acked already.
+func (c *tieredConsumer) Ack(msg mqwrapper.Message) {
+	if _, ok := msg.(*message); ok {
+		return
+	}
+	c.Consumer.Ack(msg)
+}
+
+func (c *tieredConsumer) Close() {
+	c.closeOnce.Do(func() {
+		close(c.closeCh)
+		c.wg.Wait()
+		c.Consumer.Close()
+	})
+}
@@ -0,0 +1,227 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tiered
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const offloaderBufSize = 1024
+
+// Offloader consumes the topics by its own subscription and offloads the messages to the object storage by segments,
+// the messages are acked only after offloaded, so the subscription keeps the messages not offloaded yet in the mq.
+type Offloader struct {
+	client   mqwrapper.Client
+	storage  ObjectStorage
+	rootPath string
+	subName  string
+	topics   []string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewOffloader creates an offloader of the topics, subName is the name of the subscription consuming them.
+func NewOffloader(client mqwrapper.Client, storage ObjectStorage, topics []string, subName string) *Offloader {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Offloader{
+		client:   client,
+		storage:  storage,
+		rootPath: rootPath(storage),
+		subName:  subName,
+		topics:   topics,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+func (o *Offloader) Start() {
+	for _, topic := range o.topics {
+		o.wg.Add(1)
+		go o.offloadLoop(topic)
+	}
+}
+
+func (o *Offloader) Stop() {
+	o.cancel()
+	o.wg.Wait()
+}
+
+// offloadLoop keeps offloading the topic, resubscribing it once failed.
+func (o *Offloader) offloadLoop(topic string) {
+	defer o.wg.Done()
+	log := log.With(zap.String("topic", topic))
+	log.Info("start offloading topic")
+	for {
+		err := o.offload(topic)
+		if o.ctx.Err() != nil {
+			log.Info("stop offloading topic")
+			return
+		}
+		log.Warn("failed to offload topic, retry later", zap.Error(err))
+		select {
+		case <-o.ctx.Done():
+			return
+		case <-time.After(paramtable.Get().MQCfg.TieringFlushInterval.GetAsDuration(time.Second)):
+		}
+	}
+}
+
+type topicOffloader struct {
+	*Offloader
+	topic    string
+	consumer mqwrapper.Consumer
+	lastSeq  int64
+	lastID   []byte
+	buffer   []mqwrapper.Message
+	size     int64
+}
+
+func (o *Offloader) offload(topic string) error {
+	segments, err := listSegments(o.ctx, o.storage, o.rootPath, topic)
+	if err != nil {
+		return err
+	}
+	t := &topicOffloader{Offloader: o, topic: topic, lastSeq: -1}
+	position := mqwrapper.SubscriptionPositionEarliest
+	if len(segments) > 0 {
+		last := segments[len(segments)-1]
+		t.lastSeq, t.lastID = last.seq, last.lastID
+		position = mqwrapper.SubscriptionPositionUnknown
+	}
+	t.consumer, err = o.client.Subscribe(mqwrapper.ConsumerOptions{
+		Topic:                       topic,
+		SubscriptionName:            o.subName,
+		SubscriptionInitialPosition: position,
+		BufSize:                     offloaderBufSize,
+	})
+	if err != nil {
+		return err
+	}
+	defer t.consumer.Close()
+	if t.lastID != nil {
+		lastID, err := o.client.BytesToMsgID(t.lastID)
+		if err != nil {
+			return err
+		}
+		if err := t.consumer.Seek(lastID, false); err != nil {
+			return err
+		}
+	}
+
+	ticker := time.NewTicker(paramtable.Get().MQCfg.TieringFlushInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-o.ctx.Done():
+			return nil
+		case msg, ok := <-t.consumer.Chan():
+			if !ok {
+				return t.flush()
+			}
+			if err := t.append(msg); err != nil {
+				return err
+			}
+			if t.size >= paramtable.Get().MQCfg.TieringSegmentSize.GetAsInt64()*1024*1024 {
+				if err := t.flush(); err != nil {
+					return err
+				}
+			}
+		case <-ticker.C:
+			if err := t.flush(); err != nil {
+				return err
+			}
+			t.truncate()
+		}
+	}
+}
+
+// append buffers the message, the messages offloaded already before restarting are skipped.
+func (t *topicOffloader) append(msg mqwrapper.Message) error {
+	if t.lastID != nil {
+		offloaded, err := msg.ID().LessOrEqualThan(t.lastID)
+		if err != nil {
+			return err
+		}
+		if offloaded {
+			t.consumer.Ack(msg)
+			return nil
+		}
+	}
+	t.buffer = append(t.buffer, msg)
+	t.size += int64(len(msg.Payload()))
+	return nil
+}
+
+// flush writes the messages buffered as a new segment and acks them.
+func (t *topicOffloader) flush() error {
+	if len(t.buffer) == 0 {
+		return nil
+	}
+	firstID := t.buffer[0].ID().Serialize()
+	lastID := t.buffer[len(t.buffer)-1].ID().Serialize()
+	filePath := segmentPath(t.rootPath, t.topic, t.lastSeq+1, firstID, lastID)
+	if err := t.storage.Write(t.ctx, filePath, encodeMessages(t.buffer)); err != nil {
+		return err
+	}
+	for _, msg := range t.buffer {
+		t.consumer.Ack(msg)
+	}
+	log.Debug("segment offloaded", zap.String("topic", t.topic), zap.String("path", filePath), zap.Int("numMessages", len(t.buffer)))
+	t.lastSeq++
+	t.lastID = lastID
+	t.buffer = nil
+	t.size = 0
+	return nil
+}
+
+// truncate removes the segments older than the retention, the last segment is always kept to resume from.
+func (t *topicOffloader) truncate() {
+	retention := paramtable.Get().MQCfg.TieringRetention.GetAsDuration(time.Hour)
+	if retention <= 0 {
+		return
+	}
+	segments, err := listSegments(t.ctx, t.storage, t.rootPath, t.topic)
+	if err != nil {
+		log.Warn("failed to list segments", zap.String("topic", t.topic), zap.Error(err))
+		return
+	}
+	expired := make([]string, 0)
+	for i := 0; i < len(segments)-1; i++ {
+		if time.Since(segments[i].modTime) <= retention {
+			break
+		}
+		expired = append(expired, segments[i].path)
+	}
+	if len(expired) == 0 {
+		return
+	}
+	if err := t.storage.MultiRemove(t.ctx, expired); err != nil {
+		log.Warn("failed to remove expired segments", zap.String("topic", t.topic), zap.Error(err))
+		return
+	}
+	log.Info("expired segments removed", zap.String("topic", t.topic), zap.Int("num", len(expired)))
+}
@@ -0,0 +1,211 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tiered
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// ObjectStorage is the storage the messages offloaded to, which is satisfied by the chunk managers.
+type ObjectStorage interface {
+	RootPath() string
+	Read(ctx context.Context, filePath string) ([]byte, error)
+	Write(ctx context.Context, filePath string, content []byte) error
+	ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error)
+	MultiRemove(ctx context.Context, filePaths []string) error
+}
+
+// segment is a file holding the continuous messages of a topic, named <seq>_<first id>_<last id>
+// under <root>/<topic>, the ids are the serialized message ids in hex.
+type segment struct {
+	path    string
+	seq     int64
+	firstID []byte
+	lastID  []byte
+	modTime time.Time
+}
+
+func rootPath(storage ObjectStorage) string {
+	return path.Join(storage.RootPath(), paramtable.Get().MQCfg.TieringRootPath.GetValue())
+}
+
+func topicPath(rootPath, topic string) string {
+	return path.Join(rootPath, topic)
+}
+
+func segmentPath(rootPath, topic string, seq int64, firstID, lastID []byte) string {
+	return path.Join(topicPath(rootPath, topic), fmt.Sprintf("%020d_%s_%s", seq, hex.EncodeToString(firstID), hex.EncodeToString(lastID)))
+}
+
+func parseSegment(filePath string, modTime time.Time) (*segment, error) {
+	parts := strings.Split(path.Base(filePath), "_")
+	if len(parts) != 3 {
+		return nil, errors.Newf("invalid segment name %s", filePath)
+	}
+	seq, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid segment name %s", filePath)
+	}
+	firstID, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid segment name %s", filePath)
+	}
+	lastID, err := hex.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid segment name %s", filePath)
+	}
+	return &segment{path: filePath, seq: seq, firstID: firstID, lastID: lastID, modTime: modTime}, nil
+}
+
+// listSegments returns the segments of the topic sorted by seq.
+func listSegments(ctx context.Context, storage ObjectStorage, rootPath, topic string) ([]*segment, error) {
+	files, modTimes, err := storage.ListWithPrefix(ctx, topicPath(rootPath, topic)+"/", false)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*segment, 0, len(files))
+	for i, file := range files {
+		segment, err := parseSegment(file, modTimes[i])
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+	sort.Slice(segments, func(i, j int) bool { return segments[i].seq < segments[j].seq })
+	return segments, nil
+}
+
+// message is a message read back from the segments.
+type message struct {
+	topic      string
+	properties map[string]string
+	payload    []byte
+	id         mqwrapper.MessageID
+}
+
+var _ mqwrapper.Message = (*message)(nil)
+
+func (m *message) Topic() string {
+	return m.topic
+}
+
+func (m *message) Properties() map[string]string {
+	return m.properties
+}
+
+func (m *message) Payload() []byte {
+	return m.payload
+}
+
+func (m *message) ID() mqwrapper.MessageID {
+	return m.id
+}
+
+func appendBytes(buf []byte, data []byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(data)))
+	return append(buf, data...)
+}
+
+// encodeMessages encodes the messages as the sequence of id, properties and payload, each prefixed by the length.
+func encodeMessages(msgs []mqwrapper.Message) []byte {
+	buf := make([]byte, 0)
+	for _, msg := range msgs {
+		buf = appendBytes(buf, msg.ID().Serialize())
+		keys := make([]string, 0, len(msg.Properties()))
+		for key := range msg.Properties() {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		buf = binary.AppendUvarint(buf, uint64(len(keys)))
+		for _, key := range keys {
+			buf = appendBytes(buf, []byte(key))
+			buf = appendBytes(buf, []byte(msg.Properties()[key]))
+		}
+		buf = appendBytes(buf, msg.Payload())
+	}
+	return buf
+}
+
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func (d *decoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.err = errors.New("corrupted segment")
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) bytes() []byte {
+	l := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if uint64(len(d.data)) < l {
+		d.err = errors.New("corrupted segment")
+		return nil
+	}
+	v := d.data[:l]
+	d.data = d.data[l:]
+	return v
+}
+
+// decodeMessages decodes the messages of the topic, the ids are deserialized by toMsgID.
+func decodeMessages(topic string, data []byte, toMsgID func([]byte) (mqwrapper.MessageID, error)) ([]mqwrapper.Message, error) {
+	d := &decoder{data: data}
+	msgs := make([]mqwrapper.Message, 0)
+	for len(d.data) > 0 {
+		idBytes := d.bytes()
+		num := d.uvarint()
+		properties := make(map[string]string, num)
+		for i := uint64(0); i < num && d.err == nil; i++ {
+			key := d.bytes()
+			properties[string(key)] = string(d.bytes())
+		}
+		payload := d.bytes()
+		if d.err != nil {
+			return nil, d.err
+		}
+		id, err := toMsgID(idBytes)
+		if err != nil {
+			return nil, err
+		}
+		msgs = append(msgs, &message{topic: topic, properties: properties, payload: payload, id: id})
+	}
+	return msgs, nil
+}
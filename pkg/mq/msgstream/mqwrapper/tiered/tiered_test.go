@@ -0,0 +1,297 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tiered
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper/nmq"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+
+	storeDir, _ := os.MkdirTemp("", "milvus_mq_tiered")
+	defer os.RemoveAll(storeDir)
+
+	cfg := nmq.ParseServerOption(paramtable.Get())
+	cfg.Opts.Port = server.RANDOM_PORT
+	cfg.Opts.StoreDir = storeDir
+	nmq.MustInitNatsMQ(cfg)
+	defer nmq.CloseNatsMQ()
+
+	os.Exit(m.Run())
+}
+
+type memoryStorage struct {
+	mu       sync.Mutex
+	files    map[string][]byte
+	modTimes map[string]time.Time
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{files: make(map[string][]byte), modTimes: make(map[string]time.Time)}
+}
+
+func (s *memoryStorage) RootPath() string {
+	return "files"
+}
+
+func (s *memoryStorage) Read(ctx context.Context, filePath string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.files[filePath]
+	if !ok {
+		return nil, merr.WrapErrIoKeyNotFound(filePath)
+	}
+	return data, nil
+}
+
+func (s *memoryStorage) Write(ctx context.Context, filePath string, content []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[filePath] = content
+	s.modTimes[filePath] = time.Now()
+	return nil
+}
+
+func (s *memoryStorage) ListWithPrefix(ctx context.Context, prefix string, recursive bool) ([]string, []time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files := make([]string, 0)
+	modTimes := make([]time.Time, 0)
+	for file := range s.files {
+		if strings.HasPrefix(file, prefix) {
+			files = append(files, file)
+			modTimes = append(modTimes, s.modTimes[file])
+		}
+	}
+	return files, modTimes, nil
+}
+
+func (s *memoryStorage) MultiRemove(ctx context.Context, filePaths []string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, filePath := range filePaths {
+		delete(s.files, filePath)
+		delete(s.modTimes, filePath)
+	}
+	return nil
+}
+
+type TieredSuite struct {
+	suite.Suite
+
+	client  mqwrapper.Client
+	storage *memoryStorage
+	topic   string
+	ids     []mqwrapper.MessageID
+}
+
+func (s *TieredSuite) SetupTest() {
+	params := paramtable.Get()
+	params.Save(params.MQCfg.TieringFlushInterval.Key, "0.1")
+
+	var err error
+	s.client, err = nmq.NewClientWithDefaultOptions(context.Background())
+	s.Require().NoError(err)
+	s.storage = newMemoryStorage()
+	s.topic = fmt.Sprintf("tiered-%d", time.Now().UnixNano())
+	s.ids = nil
+}
+
+func (s *TieredSuite) TearDownTest() {
+	s.client.Close()
+	paramtable.Get().Reset(paramtable.Get().MQCfg.TieringFlushInterval.Key)
+	paramtable.Get().Reset(paramtable.Get().MQCfg.TieringRetention.Key)
+}
+
+func (s *TieredSuite) produce(num int) {
+	producer, err := s.client.CreateProducer(mqwrapper.ProducerOptions{Topic: s.topic})
+	s.Require().NoError(err)
+	defer producer.Close()
+	for i := 0; i < num; i++ {
+		id, err := producer.Send(context.Background(), &mqwrapper.ProducerMessage{
+			Payload:    []byte(fmt.Sprintf("msg-%d", len(s.ids))),
+			Properties: map[string]string{"index": fmt.Sprint(len(s.ids))},
+		})
+		s.Require().NoError(err)
+		s.ids = append(s.ids, id)
+	}
+}
+
+// waitOffloaded waits until the messages produced are all offloaded.
+func (s *TieredSuite) waitOffloaded() {
+	last := s.ids[len(s.ids)-1].Serialize()
+	s.Eventually(func() bool {
+		segments, err := listSegments(context.Background(), s.storage, rootPath(s.storage), s.topic)
+		s.Require().NoError(err)
+		return len(segments) > 0 && string(segments[len(segments)-1].lastID) == string(last)
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func (s *TieredSuite) consume(consumer mqwrapper.Consumer, num int) []string {
+	payloads := make([]string, 0, num)
+	for len(payloads) < num {
+		select {
+		case msg := <-consumer.Chan():
+			consumer.Ack(msg)
+			payloads = append(payloads, string(msg.Payload()))
+		case <-time.After(10 * time.Second):
+			s.FailNow("timeout consuming messages")
+		}
+	}
+	return payloads
+}
+
+func (s *TieredSuite) TestOffloadAndReadBack() {
+	s.produce(10)
+	offloader := NewOffloader(s.client, s.storage, []string{s.topic}, "wal-offloader")
+	offloader.Start()
+	s.waitOffloaded()
+	offloader.Stop()
+
+	// the offloader resumes after the messages offloaded
+	s.produce(5)
+	offloader = NewOffloader(s.client, s.storage, []string{s.topic}, "wal-offloader")
+	offloader.Start()
+	s.waitOffloaded()
+	offloader.Stop()
+	segments, err := listSegments(context.Background(), s.storage, rootPath(s.storage), s.topic)
+	s.Require().NoError(err)
+	s.Require().Len(segments, 2)
+	s.Equal(s.ids[10].Serialize(), segments[1].firstID)
+
+	s.produce(2)
+	tiered := NewClient(s.client, s.storage)
+	s.Run("read back exclusive", func() {
+		consumer, err := tiered.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       s.topic,
+			SubscriptionName:            fmt.Sprintf("sub-%d", time.Now().UnixNano()),
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+			BufSize:                     16,
+		})
+		s.Require().NoError(err)
+		defer consumer.Close()
+		s.NoError(consumer.Seek(s.ids[3], false))
+		payloads := s.consume(consumer, 13)
+		s.Equal("msg-4", payloads[0])
+		s.Equal("msg-14", payloads[10])
+		// the messages left in the mq follow the segments
+		s.Equal("msg-16", payloads[12])
+	})
+
+	s.Run("read back inclusive", func() {
+		consumer, err := tiered.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       s.topic,
+			SubscriptionName:            fmt.Sprintf("sub-%d", time.Now().UnixNano()),
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+			BufSize:                     16,
+		})
+		s.Require().NoError(err)
+		defer consumer.Close()
+		s.NoError(consumer.Seek(s.ids[12], true))
+		s.Equal([]string{"msg-12", "msg-13", "msg-14", "msg-15", "msg-16"}, s.consume(consumer, 5))
+	})
+
+	s.Run("not offloaded", func() {
+		consumer, err := tiered.Subscribe(mqwrapper.ConsumerOptions{
+			Topic:                       s.topic,
+			SubscriptionName:            fmt.Sprintf("sub-%d", time.Now().UnixNano()),
+			SubscriptionInitialPosition: mqwrapper.SubscriptionPositionUnknown,
+			BufSize:                     16,
+		})
+		s.Require().NoError(err)
+		defer consumer.Close()
+		s.NoError(consumer.Seek(s.ids[15], false))
+		s.Equal([]string{"msg-16"}, s.consume(consumer, 1))
+	})
+}
+
+func (s *TieredSuite) TestTruncate() {
+	paramtable.Get().Save(paramtable.Get().MQCfg.TieringRetention.Key, "0.0001")
+	offloader := NewOffloader(s.client, s.storage, []string{s.topic}, "wal-offloader")
+	offloader.Start()
+	defer offloader.Stop()
+	s.produce(1)
+	s.waitOffloaded()
+	s.produce(1)
+	s.waitOffloaded()
+
+	// the expired segments are removed except the last one
+	s.Eventually(func() bool {
+		segments, err := listSegments(context.Background(), s.storage, rootPath(s.storage), s.topic)
+		s.Require().NoError(err)
+		return len(segments) == 1 && segments[0].seq == 1
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func (s *TieredSuite) TestSegment() {
+	msgs := []mqwrapper.Message{
+		&message{properties: map[string]string{"a": "1", "b": ""}, payload: []byte("payload"), id: &testID{id: []byte{1}}},
+		&message{properties: map[string]string{}, payload: nil, id: &testID{id: []byte{2}}},
+	}
+	decoded, err := decodeMessages("topic", encodeMessages(msgs), func(b []byte) (mqwrapper.MessageID, error) {
+		return &testID{id: b}, nil
+	})
+	s.Require().NoError(err)
+	s.Require().Len(decoded, 2)
+	s.Equal("topic", decoded[0].Topic())
+	s.Equal(msgs[0].Properties(), decoded[0].Properties())
+	s.Equal([]byte("payload"), decoded[0].Payload())
+	s.Equal([]byte{2}, decoded[1].ID().Serialize())
+	s.Empty(decoded[1].Payload())
+
+	_, err = decodeMessages("topic", encodeMessages(msgs)[:5], func(b []byte) (mqwrapper.MessageID, error) {
+		return &testID{id: b}, nil
+	})
+	s.Error(err)
+
+	filePath := segmentPath("root", "topic", 3, []byte{1}, []byte{2})
+	segment, err := parseSegment(filePath, time.Now())
+	s.NoError(err)
+	s.EqualValues(3, segment.seq)
+	s.Equal([]byte{1}, segment.firstID)
+	s.Equal([]byte{2}, segment.lastID)
+	_, err = parseSegment("root/topic/invalid", time.Now())
+	s.Error(err)
+}
+
+type testID struct {
+	mqwrapper.MessageID
+	id []byte
+}
+
+func (id *testID) Serialize() []byte {
+	return id.id
+}
+
+func TestTiered(t *testing.T) {
+	suite.Run(t, new(TieredSuite))
+}
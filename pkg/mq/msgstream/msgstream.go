@@ -76,3 +76,16 @@ type Factory interface {
 	NewTtMsgStream(ctx context.Context) (MsgStream, error)
 	NewMsgStreamDisposer(ctx context.Context) func([]string, string) error
 }
+
+// ClientFactory is implemented by the factories which could create the mq clients directly,
+// for the components consuming the physical channels without the msgstream, such as the wal offloader.
+type ClientFactory interface {
+	NewClient(ctx context.Context) (mqwrapper.Client, error)
+}
+
+// ProducerConfigurable is implemented by the msgstreams whose producers are configurable,
+// such as the compression and batching of the dml channels of a collection.
+type ProducerConfigurable interface {
+	// SetProducerOptions sets the options of the producers created by AsProducer afterwards, the topic is ignored.
+	SetProducerOptions(options mqwrapper.ProducerOptions)
+}
@@ -0,0 +1,246 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	uatomic "go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/retry"
+)
+
+const (
+	producerIDProperty  = "producer_id"
+	producerSeqProperty = "producer_seq"
+
+	// maxFailedSequences is the max number of the sequences of the messages failed to send remembered by a producer.
+	maxFailedSequences = 1024
+	// maxMissingSequences is the max number of the sequences not received yet tracked per producer by the consumers.
+	maxMissingSequences = 4096
+)
+
+var producerCounter uatomic.Int64
+
+// producerSequence assigns the increasing sequence numbers to the messages sent by a producer,
+// the id is unique across the restarts so that the sequences restarted are not regarded as duplicates.
+// The sequence is assigned and the message is sent under the lock, so the messages of a producer
+// reach the mq in the order of the sequences.
+type producerSequence struct {
+	id string
+
+	mu  sync.Mutex
+	seq int64
+	// the sequences of the messages failed to send, keyed by the hash of the payload,
+	// the messages resent by the callers after failures, such as timeout, reuse the sequences
+	failed     map[uint64]int64
+	failedKeys []uint64
+}
+
+func newProducerSequence() *producerSequence {
+	return &producerSequence{
+		id:     fmt.Sprintf("%d-%d-%d", paramtable.GetNodeID(), time.Now().UnixNano(), producerCounter.Inc()),
+		failed: make(map[uint64]int64),
+	}
+}
+
+// send sends the message with the next sequence number, the message is resent with the same sequence number
+// once failed, such as timeout, the duplicates persisted by the mq are dropped by the consumers.
+func (s *producerSequence) send(ctx context.Context, producer mqwrapper.Producer, msg *mqwrapper.ProducerMessage) (MessageID, error) {
+	if s == nil {
+		return producer.Send(ctx, msg)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	msg = s.next(msg)
+
+	var id MessageID
+	err := retry.Do(ctx, func() error {
+		var err error
+		id, err = producer.Send(ctx, msg)
+		return err
+	}, retry.Attempts(paramtable.Get().MQCfg.ProduceRetryAttempts.GetAsUint()), retry.Sleep(50*time.Millisecond))
+	if err != nil {
+		s.fail(msg)
+	}
+	return id, err
+}
+
+// sendBatch sends the messages with the next sequence numbers in a batch, the batch is resent with the same sequence numbers once failed.
+func (s *producerSequence) sendBatch(ctx context.Context, producer mqwrapper.BatchProducer, msgs []*mqwrapper.ProducerMessage) ([]MessageID, error) {
+	if s == nil {
+		return producer.SendBatch(ctx, msgs)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sequenced := make([]*mqwrapper.ProducerMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		sequenced = append(sequenced, s.next(msg))
+	}
+
+	var ids []MessageID
+	err := retry.Do(ctx, func() error {
+		var err error
+		ids, err = producer.SendBatch(ctx, sequenced)
+		return err
+	}, retry.Attempts(paramtable.Get().MQCfg.ProduceRetryAttempts.GetAsUint()), retry.Sleep(50*time.Millisecond))
+	if err != nil {
+		for _, msg := range sequenced {
+			s.fail(msg)
+		}
+	}
+	return ids, err
+}
+
+// next copies the message with the sequence number assigned, the message failed to send before reuses its sequence number.
+func (s *producerSequence) next(msg *mqwrapper.ProducerMessage) *mqwrapper.ProducerMessage {
+	seq, ok := int64(0), false
+	if len(s.failed) > 0 {
+		key := payloadKey(msg.Payload)
+		if seq, ok = s.failed[key]; ok {
+			delete(s.failed, key)
+		}
+	}
+	if !ok {
+		s.seq++
+		seq = s.seq
+	}
+
+	properties := make(map[string]string, len(msg.Properties)+2)
+	for k, v := range msg.Properties {
+		properties[k] = v
+	}
+	properties[producerIDProperty] = s.id
+	properties[producerSeqProperty] = strconv.FormatInt(seq, 10)
+	return &mqwrapper.ProducerMessage{Payload: msg.Payload, Properties: properties}
+}
+
+// fail remembers the sequence of the message failed to send, the oldest ones are forgotten if too many.
+func (s *producerSequence) fail(msg *mqwrapper.ProducerMessage) {
+	seq, err := strconv.ParseInt(msg.Properties[producerSeqProperty], 10, 64)
+	if err != nil {
+		return
+	}
+	key := payloadKey(msg.Payload)
+	if _, ok := s.failed[key]; !ok {
+		s.failedKeys = append(s.failedKeys, key)
+	}
+	s.failed[key] = seq
+	for len(s.failedKeys) > maxFailedSequences {
+		delete(s.failed, s.failedKeys[0])
+		s.failedKeys = s.failedKeys[1:]
+	}
+}
+
+func payloadKey(payload []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(payload)
+	return h.Sum64()
+}
+
+// producerState is the sequences of a producer received by a consumer.
+type producerState struct {
+	maxSeq int64
+	// the sequences less than maxSeq not received yet, accepted once received
+	missing map[int64]struct{}
+}
+
+// sequenceChecker checks the continuity of the sequences of the producers per channel,
+// a message is dropped only if its sequence has been received exactly, the sequences out of order
+// are accepted as reordered and the gaps, the messages lost by the produces failed, are reported.
+type sequenceChecker struct {
+	mu sync.Mutex
+	// channel -> producer id -> the sequences received
+	states map[string]map[string]*producerState
+}
+
+func newSequenceChecker() *sequenceChecker {
+	return &sequenceChecker{states: make(map[string]map[string]*producerState)}
+}
+
+// check returns false if the message is a duplicate, the messages without the sequence are always accepted.
+func (c *sequenceChecker) check(msg mqwrapper.Message) bool {
+	if c == nil {
+		return true
+	}
+	producerID, ok := msg.Properties()[producerIDProperty]
+	if !ok {
+		return true
+	}
+	seq, err := strconv.ParseInt(msg.Properties()[producerSeqProperty], 10, 64)
+	if err != nil {
+		return true
+	}
+	channel := filepath.Base(msg.Topic())
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	states, ok := c.states[channel]
+	if !ok {
+		states = make(map[string]*producerState)
+		c.states[channel] = states
+	}
+	state, ok := states[producerID]
+	if !ok {
+		states[producerID] = &producerState{maxSeq: seq, missing: make(map[int64]struct{})}
+		return true
+	}
+
+	if seq <= state.maxSeq {
+		if _, ok := state.missing[seq]; ok {
+			delete(state.missing, seq)
+			log.Info("receive the message reordered", zap.String("channel", channel), zap.String("producer", producerID), zap.Int64("seq", seq), zap.Int64("maxSeq", state.maxSeq))
+			metrics.MsgStreamSequenceAnomalyCounter.WithLabelValues(paramtable.GetRole(), channel, metrics.SequenceReorderLabel).Inc()
+			return true
+		}
+		log.Debug("drop the message duplicated", zap.String("channel", channel), zap.String("producer", producerID), zap.Int64("seq", seq), zap.Int64("maxSeq", state.maxSeq))
+		metrics.MsgStreamSequenceAnomalyCounter.WithLabelValues(paramtable.GetRole(), channel, metrics.SequenceDuplicateLabel).Inc()
+		return false
+	}
+	if seq > state.maxSeq+1 {
+		log.Warn("gap in the sequence of the producer, the messages may be lost", zap.String("channel", channel), zap.String("producer", producerID), zap.Int64("seq", seq), zap.Int64("maxSeq", state.maxSeq))
+		metrics.MsgStreamSequenceAnomalyCounter.WithLabelValues(paramtable.GetRole(), channel, metrics.SequenceGapLabel).Inc()
+		// only the latest missing sequences are tracked, the older ones are accepted as new if received
+		from := state.maxSeq + 1
+		if seq-from > maxMissingSequences {
+			from = seq - maxMissingSequences
+		}
+		for missing := from; missing < seq; missing++ {
+			state.missing[missing] = struct{}{}
+		}
+		if len(state.missing) > maxMissingSequences {
+			for missing := range state.missing {
+				if missing < seq-maxMissingSequences {
+					delete(state.missing, missing)
+				}
+			}
+		}
+	}
+	state.maxSeq = seq
+	return true
+}
@@ -0,0 +1,251 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgstream
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
+)
+
+// sequenceProducer persists every message sent, the first failures are timeouts with the message persisted.
+type sequenceProducer struct {
+	topic    string
+	failures int
+	sent     []mqwrapper.Message
+}
+
+func (p *sequenceProducer) Send(ctx context.Context, message *mqwrapper.ProducerMessage) (mqwrapper.MessageID, error) {
+	p.sent = append(p.sent, &sequenceMessage{topic: p.topic, payload: message.Payload, properties: message.Properties})
+	if p.failures > 0 {
+		p.failures--
+		return nil, errors.New("timeout")
+	}
+	return sequenceID{}, nil
+}
+
+func (p *sequenceProducer) Close() {}
+
+type sequenceMessage struct {
+	topic      string
+	payload    []byte
+	properties map[string]string
+}
+
+func (m *sequenceMessage) Topic() string                 { return m.topic }
+func (m *sequenceMessage) Properties() map[string]string { return m.properties }
+func (m *sequenceMessage) Payload() []byte               { return m.payload }
+func (m *sequenceMessage) ID() mqwrapper.MessageID       { return sequenceID{} }
+
+type sequenceID struct {
+	mqwrapper.MessageID
+}
+
+func (id sequenceID) Serialize() []byte { return nil }
+
+type sequenceClient struct {
+	mqwrapper.Client
+}
+
+func (c *sequenceClient) Close() {}
+
+type sequenceConsumer struct {
+	mqwrapper.Consumer
+	ch chan mqwrapper.Message
+}
+
+func (c *sequenceConsumer) Chan() <-chan mqwrapper.Message { return c.ch }
+func (c *sequenceConsumer) Ack(mqwrapper.Message)          {}
+func (c *sequenceConsumer) Subscription() string           { return "sub" }
+
+func TestSequence_ProduceAndDedup(t *testing.T) {
+	ctx := context.Background()
+	Params.Save(Params.MQCfg.IdempotenceEnabled.Key, "true")
+	defer Params.Reset(Params.MQCfg.IdempotenceEnabled.Key)
+	stream, err := NewMqMsgStream(ctx, 100, 100, &sequenceClient{}, (&ProtoUDFactory{}).NewUnmarshalDispatcher())
+	require.NoError(t, err)
+	defer stream.Close()
+	producer := &sequenceProducer{topic: "persistent://public/default/ch", failures: 1}
+	stream.producers["ch"] = producer
+	stream.producerChannels = []string{"ch"}
+	stream.producerSeqs["ch"] = newProducerSequence()
+
+	// the message timed out is resent with the same sequence
+	require.NoError(t, stream.Produce(getTimeTickMsgPack(1)))
+	require.NoError(t, stream.Produce(getTimeTickMsgPack(2)))
+	require.Len(t, producer.sent, 3)
+	assert.Equal(t, producer.sent[0].Properties(), producer.sent[1].Properties())
+	assert.Equal(t, "1", producer.sent[1].Properties()[producerSeqProperty])
+	assert.Equal(t, "2", producer.sent[2].Properties()[producerSeqProperty])
+
+	consumer := &sequenceConsumer{ch: make(chan mqwrapper.Message, len(producer.sent))}
+	for _, msg := range producer.sent {
+		consumer.ch <- msg
+	}
+	close(consumer.ch)
+	stream.receiveMsg(consumer)
+
+	packs := make([]*MsgPack, 0)
+	for len(stream.receiveBuf) > 0 {
+		packs = append(packs, <-stream.receiveBuf)
+	}
+	require.Len(t, packs, 2)
+	assert.EqualValues(t, 1, packs[0].BeginTs)
+	assert.EqualValues(t, 2, packs[1].BeginTs)
+}
+
+func TestSequence_Checker(t *testing.T) {
+	checker := newSequenceChecker()
+	msg := func(topic, producer, seq string) mqwrapper.Message {
+		return &sequenceMessage{topic: topic, properties: map[string]string{producerIDProperty: producer, producerSeqProperty: seq}}
+	}
+
+	assert.True(t, checker.check(msg("ch1", "p1", "5")))
+	assert.False(t, checker.check(msg("ch1", "p1", "5")))
+	assert.False(t, checker.check(msg("ch1", "p1", "4")))
+	// the gap is reported and the message accepted
+	assert.True(t, checker.check(msg("ch1", "p1", "7")))
+	assert.EqualValues(t, 7, checker.states["ch1"]["p1"].maxSeq)
+	// the message reordered is accepted once
+	assert.True(t, checker.check(msg("ch1", "p1", "6")))
+	assert.False(t, checker.check(msg("ch1", "p1", "6")))
+	assert.False(t, checker.check(msg("ch1", "p1", "7")))
+	assert.Empty(t, checker.states["ch1"]["p1"].missing)
+
+	// only the latest missing sequences are tracked
+	assert.True(t, checker.check(msg("ch1", "p1", strconv.Itoa(8+2*maxMissingSequences))))
+	assert.Len(t, checker.states["ch1"]["p1"].missing, maxMissingSequences)
+
+	// the sequences are per channel and producer
+	assert.True(t, checker.check(msg("ch1", "p2", "1")))
+	assert.True(t, checker.check(msg("ch2", "p1", "1")))
+
+	// the messages without the sequence are accepted
+	assert.True(t, checker.check(&sequenceMessage{topic: "ch1", properties: map[string]string{}}))
+	assert.True(t, checker.check(msg("ch1", "p1", "invalid")))
+
+	var disabled *sequenceChecker
+	assert.True(t, disabled.check(msg("ch1", "p1", "1")))
+}
+
+func TestSequence_RetryExhausted(t *testing.T) {
+	ctx := context.Background()
+	producer := &sequenceProducer{topic: "ch", failures: 10}
+	seq := newProducerSequence()
+	_, err := seq.send(ctx, producer, &mqwrapper.ProducerMessage{Properties: map[string]string{"k": "v"}})
+	assert.Error(t, err)
+	assert.Len(t, producer.sent, Params.MQCfg.ProduceRetryAttempts.GetAsInt())
+
+	// the sequence moves on, the consumers report the gap if the message is lost
+	_, err = seq.send(ctx, producer, &mqwrapper.ProducerMessage{Payload: []byte("other")})
+	assert.Error(t, err)
+	assert.Equal(t, "2", producer.sent[len(producer.sent)-1].Properties()[producerSeqProperty])
+	assert.Equal(t, "v", producer.sent[0].Properties()["k"])
+
+	// the message resent by the caller reuses its sequence, so the copies persisted are dropped as duplicates
+	producer.failures = 0
+	_, err = seq.send(ctx, producer, &mqwrapper.ProducerMessage{Properties: map[string]string{"k": "v"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", producer.sent[len(producer.sent)-1].Properties()[producerSeqProperty])
+	_, err = seq.send(ctx, producer, &mqwrapper.ProducerMessage{Properties: map[string]string{"k": "v"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "3", producer.sent[len(producer.sent)-1].Properties()[producerSeqProperty])
+
+	// the producers without the sequence send once
+	var disabled *producerSequence
+	producer = &sequenceProducer{topic: "ch", failures: 10}
+	_, err = disabled.send(ctx, producer, &mqwrapper.ProducerMessage{})
+	assert.Error(t, err)
+	assert.Len(t, producer.sent, 1)
+}
+
+func TestSequence_ConcurrentSend(t *testing.T) {
+	ctx := context.Background()
+	producer := &sequenceProducer{topic: "ch"}
+	seq := newProducerSequence()
+	wg := sync.WaitGroup{}
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				_, err := seq.send(ctx, producer, &mqwrapper.ProducerMessage{Payload: []byte(fmt.Sprintf("%d-%d", i, j))})
+				assert.NoError(t, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// the messages reach the mq in the order of the sequences
+	require.Len(t, producer.sent, 400)
+	for i, msg := range producer.sent {
+		assert.Equal(t, strconv.Itoa(i+1), msg.Properties()[producerSeqProperty])
+	}
+}
+
+// sequenceBatchProducer records the batches sent.
+type sequenceBatchProducer struct {
+	*sequenceProducer
+	batches []int
+}
+
+func (p *sequenceBatchProducer) SendBatch(ctx context.Context, messages []*mqwrapper.ProducerMessage) ([]mqwrapper.MessageID, error) {
+	p.batches = append(p.batches, len(messages))
+	ids := make([]mqwrapper.MessageID, 0, len(messages))
+	var err error
+	for _, message := range messages {
+		id, sendErr := p.Send(ctx, message)
+		if sendErr != nil {
+			err = sendErr
+		}
+		ids = append(ids, id)
+	}
+	return ids, err
+}
+
+func TestSequence_ProduceBatch(t *testing.T) {
+	ctx := context.Background()
+	stream, err := NewMqMsgStream(ctx, 100, 100, &sequenceClient{}, (&ProtoUDFactory{}).NewUnmarshalDispatcher())
+	require.NoError(t, err)
+	defer stream.Close()
+	stream.SetProducerOptions(mqwrapper.ProducerOptions{BatchingMaxMessages: 2})
+	producer := &sequenceBatchProducer{sequenceProducer: &sequenceProducer{topic: "ch", failures: 1}}
+	stream.producers["ch"] = producer
+	stream.producerChannels = []string{"ch"}
+	stream.producerSeqs["ch"] = newProducerSequence()
+
+	msgPack := &MsgPack{}
+	for i := 1; i <= 3; i++ {
+		msgPack.Msgs = append(msgPack.Msgs, getTsMsg(commonpb.MsgType_TimeTick, int64(i)))
+	}
+	require.NoError(t, stream.Produce(msgPack))
+	// the batch failed is resent with the same sequences
+	assert.Equal(t, []int{2, 2, 1}, producer.batches)
+	require.Len(t, producer.sent, 5)
+	assert.Equal(t, producer.sent[0].Properties(), producer.sent[2].Properties())
+	assert.Equal(t, "2", producer.sent[3].Properties()[producerSeqProperty])
+	assert.Equal(t, "3", producer.sent[4].Properties()[producerSeqProperty])
+}
@@ -49,12 +49,18 @@ type Scavenger[K comparable] interface {
 }
 
 type LazyScavenger[K comparable] struct {
-	capacity int64
+	capacity func() int64
 	size     int64
 	weight   func(K) int64
 }
 
 func NewLazyScavenger[K comparable](weight func(K) int64, capacity int64) *LazyScavenger[K] {
+	return NewDynamicLazyScavenger(weight, func() int64 { return capacity })
+}
+
+// NewDynamicLazyScavenger creates a LazyScavenger whose capacity is evaluated on each collection,
+// so that the room of cache could be shared with others.
+func NewDynamicLazyScavenger[K comparable](weight func(K) int64, capacity func() int64) *LazyScavenger[K] {
 	return &LazyScavenger[K]{
 		capacity: capacity,
 		weight:   weight,
@@ -63,8 +69,8 @@ func NewLazyScavenger[K comparable](weight func(K) int64, capacity int64) *LazyS
 
 func (s *LazyScavenger[K]) Collect(key K) (bool, func(K) bool) {
 	w := s.weight(key)
-	if s.size+w > s.capacity {
-		needCollect := s.size + w - s.capacity
+	if capacity := s.capacity(); s.size+w > capacity {
+		needCollect := s.size + w - capacity
 		return false, func(key K) bool {
 			needCollect -= s.weight(key)
 			return needCollect <= 0
@@ -80,7 +86,7 @@ func (s *LazyScavenger[K]) Throw(key K) {
 
 func (s *LazyScavenger[K]) Spare(key K) func(K) bool {
 	w := s.weight(key)
-	available := s.capacity - s.size + w
+	available := s.capacity() - s.size + w
 	return func(k K) bool {
 		available -= s.weight(k)
 		return available >= 0
@@ -90,6 +96,8 @@ func (s *LazyScavenger[K]) Spare(key K) func(K) bool {
 type Cache[K comparable, V any] interface {
 	Do(key K, doer func(V) error) error
 	DoWait(key K, timeout time.Duration, doer func(V) error) error
+	// Expire evicts the item of the key if it's not pinned, returns whether it's evicted.
+	Expire(key K) bool
 }
 
 type Waiter[K comparable] struct {
@@ -153,6 +161,11 @@ func (b *CacheBuilder[K, V]) WithLazyScavenger(weight func(K) int64, capacity in
 	return b
 }
 
+func (b *CacheBuilder[K, V]) WithDynamicLazyScavenger(weight func(K) int64, capacity func() int64) *CacheBuilder[K, V] {
+	b.scavenger = NewDynamicLazyScavenger(weight, capacity)
+	return b
+}
+
 func (b *CacheBuilder[K, V]) WithCapacity(capacity int64) *CacheBuilder[K, V] {
 	b.scavenger = NewLazyScavenger(
 		func(key K) int64 {
@@ -263,6 +276,26 @@ func (c *lruCache[K, V]) Unpin(key K) {
 	}
 }
 
+func (c *lruCache[K, V]) Expire(key K) bool {
+	c.rwlock.Lock()
+	defer c.rwlock.Unlock()
+	e, ok := c.items[key]
+	if !ok {
+		return false
+	}
+	item := e.Value.(*cacheItem[K, V])
+	if item.pinCount.Load() > 0 {
+		return false
+	}
+	delete(c.items, key)
+	c.accessList.Remove(e)
+	c.scavenger.Throw(key)
+	if c.finalizer != nil {
+		c.finalizer(key, item.value)
+	}
+	return true
+}
+
 func (c *lruCache[K, V]) peekAndPin(key K) *cacheItem[K, V] {
 	c.rwlock.Lock()
 	defer c.rwlock.Unlock()
@@ -76,6 +76,45 @@ func TestLRUCache(t *testing.T) {
 		assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}, finalizeSeq)
 	})
 
+	t.Run("test dynamic scavenger", func(t *testing.T) {
+		finalizeSeq := make([]int, 0)
+		capacity := atomic.NewInt64(10)
+		cache := cacheBuilder.WithDynamicLazyScavenger(func(key int) int64 {
+			return 1
+		}, capacity.Load).WithFinalizer(func(key, value int) error {
+			finalizeSeq = append(finalizeSeq, key)
+			return nil
+		}).Build()
+
+		for i := 0; i < 10; i++ {
+			assert.NoError(t, cache.Do(i, func(v int) error { return nil }))
+		}
+		assert.Empty(t, finalizeSeq)
+
+		// shrink the capacity, the least recently used ones are evicted
+		capacity.Store(5)
+		assert.NoError(t, cache.Do(10, func(v int) error { return nil }))
+		assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, finalizeSeq)
+	})
+
+	t.Run("test expire", func(t *testing.T) {
+		finalizeSeq := make([]int, 0)
+		cache := cacheBuilder.WithCapacity(10).WithFinalizer(func(key, value int) error {
+			finalizeSeq = append(finalizeSeq, key)
+			return nil
+		}).Build()
+
+		assert.False(t, cache.Expire(1))
+		assert.NoError(t, cache.Do(1, func(v int) error {
+			// pinned item can't be expired
+			assert.False(t, cache.Expire(1))
+			return nil
+		}))
+		assert.True(t, cache.Expire(1))
+		assert.Equal(t, []int{1}, finalizeSeq)
+		assert.False(t, cache.Expire(1))
+	})
+
 	t.Run("test do negative", func(t *testing.T) {
 		cache := cacheBuilder.Build()
 		theErr := errors.New("error")
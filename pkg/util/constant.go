@@ -58,11 +58,22 @@ const (
 
 	PrivilegeWord = "Privilege"
 	AnyWord       = "*"
+	// PartitionSeparator separates the collection and the partition in the object names of the partition level grants,
+	// like col1:p1, and col1:* for all the partitions of col1.
+	PartitionSeparator = ":"
+	// APIKeyPrefix prefixes the api keys, which are presented as the tokens like mk-<name>-<secret>
+	APIKeyPrefix = "mk-"
+	// APIKeyUserPrefix prefixes the name of the api key to the user the key authenticates as, like apikey/<name>
+	APIKeyUserPrefix = "apikey/"
+	// OIDCUserPrefix prefixes the username in the claims of the oidc token to the user the token authenticates as, like oidc/<name>
+	OIDCUserPrefix = "oidc/"
 
 	IdentifierKey = "identifier"
 
 	HeaderUserAgent = "user-agent"
 	HeaderDBName    = "dbName"
+	// HeaderIdempotencyKey carries the key to deduplicate the retries of the insert request
+	HeaderIdempotencyKey = "idempotency-key"
 
 	RoleConfigPrivileges = "privileges"
 	RoleConfigObjectType = "object_type"
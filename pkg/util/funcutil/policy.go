@@ -106,6 +106,16 @@ func CombineObjectName(dbName string, objectName string) string {
 	return fmt.Sprintf("%s.%s", dbName, objectName)
 }
 
+// CombinePartitionObjectName returns the object name of the partition level grants.
+func CombinePartitionObjectName(collectionName string, partitionName string) string {
+	return collectionName + util.PartitionSeparator + partitionName
+}
+
+// SplitPartitionObjectName splits the object name of the partition level grants, ok is false if not partition level.
+func SplitPartitionObjectName(objectName string) (collectionName string, partitionName string, ok bool) {
+	return strings.Cut(objectName, util.PartitionSeparator)
+}
+
 func SplitObjectName(objectName string) (string, string) {
 	if !strings.Contains(objectName, ".") {
 		return util.DefaultDBName, objectName
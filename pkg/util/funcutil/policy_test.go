@@ -72,3 +72,15 @@ func Test_PolicyForResource(t *testing.T) {
 		`COLLECTION-db.col1`,
 		PolicyForResource("db", "COLLECTION", "col1"))
 }
+
+func Test_PartitionObjectName(t *testing.T) {
+	assert.Equal(t, "col1:p1", CombinePartitionObjectName("col1", "p1"))
+
+	collectionName, partitionName, ok := SplitPartitionObjectName("col1:p1")
+	assert.True(t, ok)
+	assert.Equal(t, "col1", collectionName)
+	assert.Equal(t, "p1", partitionName)
+
+	_, _, ok = SplitPartitionObjectName("col1")
+	assert.False(t, ok)
+}
@@ -51,3 +51,14 @@ func GetUsedMemoryCount() uint64 {
 	// sub the shared memory to filter out the file-backed map memory usage
 	return memInfo.RSS - memInfo.Shared
 }
+
+// GetMappedMemoryCount returns the resident memory of the file-backed maps in bytes,
+// which is the working set of the mmap page cache.
+func GetMappedMemoryCount() uint64 {
+	memInfo, err := proc.MemoryInfoEx()
+	if err != nil {
+		log.Warn("failed to get memory info", zap.Error(err))
+		return 0
+	}
+	return memInfo.Shared
+}
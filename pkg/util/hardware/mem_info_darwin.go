@@ -36,3 +36,9 @@ func GetUsedMemoryCount() uint64 {
 
 	return stats.Used
 }
+
+// GetMappedMemoryCount returns the resident memory of the file-backed maps in bytes,
+// it's included in the used memory on this platform.
+func GetMappedMemoryCount() uint64 {
+	return 0
+}
@@ -43,6 +43,8 @@ var (
 	ErrServiceQuotaExceeded        = newMilvusError("quota exceeded", 9, false)
 	ErrServiceUnimplemented        = newMilvusError("service unimplemented", 10, false)
 	ErrServiceTimeTickLongDelay    = newMilvusError("time tick long delay", 11, false)
+	ErrServiceResourceExhausted    = newMilvusError("resource exhausted", 12, false)
+	ErrServiceOverloaded           = newMilvusError("service overloaded", 13, true)
 
 	// Collection related
 	ErrCollectionNotFound         = newMilvusError("collection not found", 100, false)
@@ -51,6 +53,7 @@ var (
 	ErrCollectionNotFullyLoaded   = newMilvusError("collection not fully loaded", 103, true)
 	ErrCollectionLoaded           = newMilvusError("collection already loaded", 104, false)
 	ErrCollectionIllegalSchema    = newMilvusError("illegal collection schema", 105, false)
+	ErrCollectionFrozen           = newMilvusError("collection frozen", 106, false)
 
 	// Partition related
 	ErrPartitionNotFound       = newMilvusError("partition not found", 200, false)
@@ -88,6 +91,7 @@ var (
 	ErrDatabaseNotFound         = newMilvusError("database not found", 800, false)
 	ErrDatabaseNumLimitExceeded = newMilvusError("exceeded the limit number of database", 801, false)
 	ErrDatabaseInvalidName      = newMilvusError("invalid database name", 802, false)
+	ErrDatabaseQuotaExceeded    = newMilvusError("exceeded the quota of database", 803, false)
 
 	// Node related
 	ErrNodeNotFound        = newMilvusError("node not found", 901, false)
@@ -101,6 +105,7 @@ var (
 	ErrIoKeyNotFound = newMilvusError("key not found", 1000, false)
 	ErrIoFailed      = newMilvusError("IO failed", 1001, false)
 	ErrIoUnexpectEOF = newMilvusError("unexpected EOF", 1002, true)
+	ErrIoThrottled   = newMilvusError("IO throttled", 1003, true)
 
 	// Parameter related
 	ErrParameterInvalid = newMilvusError("invalid parameter", 1100, false)
@@ -158,6 +163,13 @@ var (
 
 	// Search/Query related
 	ErrInconsistentRequery = newMilvusError("inconsistent requery result", 2200, true)
+
+	// DDL job related
+	ErrDDLJobNotFound = newMilvusError("ddl job not found", 2300, false)
+
+	// API key related
+	ErrAPIKeyNotFound     = newMilvusError("api key not found", 2400, false)
+	ErrAPIKeyAlreadyExist = newMilvusError("api key already exist", 2401, false)
 )
 
 type milvusError struct {
@@ -80,12 +80,18 @@ func (s *ErrSuite) TestWrap() {
 	s.ErrorIs(WrapErrServiceDiskLimitExceeded(110, 100, "DLE"), ErrServiceDiskLimitExceeded)
 	s.ErrorIs(WrapErrNodeNotMatch(0, 1, "SIM"), ErrNodeNotMatch)
 	s.ErrorIs(WrapErrServiceUnimplemented(errors.New("mock grpc err")), ErrServiceUnimplemented)
+	s.ErrorIs(WrapErrServiceResourceExhausted("memory", 110, 100, "search"), ErrServiceResourceExhausted)
+	s.ErrorIs(WrapErrServiceOverloaded("memory", 110, 100, "search"), ErrServiceOverloaded)
+
+	// Database related
+	s.ErrorIs(WrapErrDatabaseQuotaExceeded("test_db", "max collections", 10, "failed to create collection"), ErrDatabaseQuotaExceeded)
 
 	// Collection related
 	s.ErrorIs(WrapErrCollectionNotFound("test_collection", "failed to get collection"), ErrCollectionNotFound)
 	s.ErrorIs(WrapErrCollectionNotLoaded("test_collection", "failed to query"), ErrCollectionNotLoaded)
 	s.ErrorIs(WrapErrCollectionNotFullyLoaded("test_collection", "failed to query"), ErrCollectionNotFullyLoaded)
 	s.ErrorIs(WrapErrCollectionNotLoaded("test_collection", "failed to alter index %s", "hnsw"), ErrCollectionNotLoaded)
+	s.ErrorIs(WrapErrCollectionFrozen("test_collection", "failed to load"), ErrCollectionFrozen)
 
 	// Partition related
 	s.ErrorIs(WrapErrPartitionNotFound("test_partition", "failed to get partition"), ErrPartitionNotFound)
@@ -126,6 +132,7 @@ func (s *ErrSuite) TestWrap() {
 	s.ErrorIs(WrapErrIoKeyNotFound("test_key", "failed to read"), ErrIoKeyNotFound)
 	s.ErrorIs(WrapErrIoFailed("test_key", os.ErrClosed), ErrIoFailed)
 	s.ErrorIs(WrapErrIoUnexpectEOF("test_key", os.ErrClosed), ErrIoUnexpectEOF)
+	s.ErrorIs(WrapErrIoThrottled("test_key", os.ErrClosed), ErrIoThrottled)
 
 	// Parameter related
 	s.ErrorIs(WrapErrParameterInvalid(8, 1, "failed to create"), ErrParameterInvalid)
@@ -149,6 +156,13 @@ func (s *ErrSuite) TestWrap() {
 
 	// Search/Query related
 	s.ErrorIs(WrapErrInconsistentRequery("unknown"), ErrInconsistentRequery)
+
+	// DDL job related
+	s.ErrorIs(WrapErrDDLJobNotFound(1, "failed to describe ddl job"), ErrDDLJobNotFound)
+
+	// API key related
+	s.ErrorIs(WrapErrAPIKeyNotFound("key1", "failed to revoke api key"), ErrAPIKeyNotFound)
+	s.ErrorIs(WrapErrAPIKeyAlreadyExist("key1", "failed to create api key"), ErrAPIKeyAlreadyExist)
 }
 
 func (s *ErrSuite) TestOldCode() {
@@ -161,6 +175,7 @@ func (s *ErrSuite) TestOldCode() {
 	s.ErrorIs(OldCodeToMerr(commonpb.ErrorCode_DiskQuotaExhausted), ErrServiceDiskLimitExceeded)
 	s.ErrorIs(OldCodeToMerr(commonpb.ErrorCode_RateLimit), ErrServiceRateLimit)
 	s.ErrorIs(OldCodeToMerr(commonpb.ErrorCode_ForceDeny), ErrServiceQuotaExceeded)
+	s.Equal(commonpb.ErrorCode_RateLimit, oldCode(ErrServiceResourceExhausted.code()))
 	s.ErrorIs(OldCodeToMerr(commonpb.ErrorCode_UnexpectedError), errUnexpected)
 }
 
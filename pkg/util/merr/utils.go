@@ -159,7 +159,7 @@ func oldCode(code int32) commonpb.ErrorCode {
 	case ErrServiceTimeTickLongDelay.code():
 		return commonpb.ErrorCode_TimeTickLongDelay
 
-	case ErrServiceRateLimit.code():
+	case ErrServiceRateLimit.code(), ErrServiceResourceExhausted.code():
 		return commonpb.ErrorCode_RateLimit
 
 	case ErrServiceQuotaExceeded.code():
@@ -380,6 +380,33 @@ func WrapErrServiceQuotaExceeded(reason string, msg ...string) error {
 	return err
 }
 
+// WrapErrServiceResourceExhausted makes an error for the request which exceeds its budget of the resource
+func WrapErrServiceResourceExhausted(resource string, used, limit int64, msg ...string) error {
+	err := wrapFields(ErrServiceResourceExhausted,
+		value("resource", resource),
+		value("used", used),
+		value("limit", limit),
+	)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
+// WrapErrServiceOverloaded makes an error for the request rejected as the node is overloaded,
+// the request could be retried on another replica
+func WrapErrServiceOverloaded(resource string, used, limit int64, msg ...string) error {
+	err := wrapFields(ErrServiceOverloaded,
+		value("resource", resource),
+		value("used", used),
+		value("limit", limit),
+	)
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
 func WrapErrServiceUnimplemented(grpcErr error) error {
 	return wrapFieldsWithDesc(ErrServiceUnimplemented, grpcErr.Error())
 }
@@ -409,6 +436,14 @@ func WrapErrDatabaseNameInvalid(database any, msg ...string) error {
 	return err
 }
 
+func WrapErrDatabaseQuotaExceeded(database any, quota string, limit any, msg ...string) error {
+	err := wrapFields(ErrDatabaseQuotaExceeded, value("database", database), value("quota", quota), value("limit", limit))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
 // Collection related
 func WrapErrCollectionNotFound(collection any, msg ...string) error {
 	err := wrapFields(ErrCollectionNotFound, value("collection", collection))
@@ -479,6 +514,14 @@ func WrapErrCollectionIllegalSchema(collection string, msgAndArgs ...any) error
 	return err
 }
 
+func WrapErrCollectionFrozen(collection any, msg ...string) error {
+	err := wrapFields(ErrCollectionFrozen, value("collection", collection))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
 func WrapErrAliasNotFound(db any, alias any, msg ...string) error {
 	err := wrapFields(ErrAliasNotFound,
 		value("database", db),
@@ -781,6 +824,13 @@ func WrapErrIoUnexpectEOF(key string, err error) error {
 	return wrapFieldsWithDesc(ErrIoUnexpectEOF, err.Error(), value("key", key))
 }
 
+func WrapErrIoThrottled(key string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return wrapFieldsWithDesc(ErrIoThrottled, err.Error(), value("key", key))
+}
+
 // Parameter related
 func WrapErrParameterInvalid[T any](expected, actual T, msg ...string) error {
 	err := wrapFields(ErrParameterInvalid,
@@ -959,3 +1009,27 @@ func WrapErrInconsistentRequery(msg ...string) error {
 	}
 	return err
 }
+
+func WrapErrDDLJobNotFound(jobID int64, msg ...string) error {
+	err := wrapFields(ErrDDLJobNotFound, value("jobID", jobID))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
+func WrapErrAPIKeyNotFound(name string, msg ...string) error {
+	err := wrapFields(ErrAPIKeyNotFound, value("name", name))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
+
+func WrapErrAPIKeyAlreadyExist(name string, msg ...string) error {
+	err := wrapFields(ErrAPIKeyAlreadyExist, value("name", name))
+	if len(msg) > 0 {
+		err = errors.Wrap(err, strings.Join(msg, "->"))
+	}
+	return err
+}
@@ -52,6 +52,16 @@ func BuildStatsLogPath(rootPath string, collectionID, partitionID, segmentID, fi
 	return path.Join(rootPath, common.SegmentStatslogPath, k)
 }
 
+func BuildArtifactLogPath(rootPath string, collectionID, partitionID, segmentID, fieldID, logID typeutil.UniqueID) string {
+	k := JoinIDPath(collectionID, partitionID, segmentID, fieldID, logID)
+	return path.Join(rootPath, common.SegmentArtifactLogPath, k)
+}
+
+func BuildParquetLogPath(rootPath string, collectionID, partitionID, segmentID, groupID, logID typeutil.UniqueID) string {
+	k := JoinIDPath(collectionID, partitionID, segmentID, groupID, logID)
+	return path.Join(rootPath, common.SegmentParquetLogPath, k) + ".parquet"
+}
+
 func GetSegmentIDFromStatsLogPath(logPath string) typeutil.UniqueID {
 	return getSegmentIDFromPath(logPath, 3)
 }
@@ -104,6 +104,7 @@ type QueryNodeInfos struct {
 	SystemConfigurations QueryNodeConfiguration      `json:"system_configurations"`
 	QuotaMetrics         *QueryNodeQuotaMetrics      `json:"quota_metrics"`
 	CollectionMetrics    *QueryNodeCollectionMetrics `json:"collection_metrics"`
+	SegmentSearchStats   []SegmentSearchStats        `json:"segment_search_stats"`
 }
 
 // QueryCoordConfiguration records the configuration of QueryCoord.
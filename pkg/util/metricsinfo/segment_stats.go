@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsinfo
+
+import (
+	"sort"
+)
+
+// SegmentSearchStats records the search statistics of a segment on query node since it's loaded.
+type SegmentSearchStats struct {
+	SegmentID    int64 `json:"segment_id"`
+	CollectionID int64 `json:"collection_id"`
+	NodeID       int64 `json:"node_id"`
+	// Hits is the number of searches on the segment
+	Hits         int64   `json:"hits"`
+	AvgLatencyMs float64 `json:"avg_latency_ms"`
+	RowsScanned  int64   `json:"rows_scanned"`
+	// CacheHitRatio is the ratio of searches served without loading the segment into the disk cache
+	CacheHitRatio float64 `json:"cache_hit_ratio"`
+}
+
+// CollectionSearchStats aggregates the search statistics of segments in a collection.
+type CollectionSearchStats struct {
+	CollectionID  int64   `json:"collection_id"`
+	Hits          int64   `json:"hits"`
+	AvgLatencyMs  float64 `json:"avg_latency_ms"`
+	RowsScanned   int64   `json:"rows_scanned"`
+	CacheHitRatio float64 `json:"cache_hit_ratio"`
+	// Segments are sorted by hits in descending order, so the hot segments come first
+	Segments []SegmentSearchStats `json:"segments"`
+}
+
+// AggregateSegmentSearchStats aggregates the segment search statistics per collection,
+// the average latency and cache hit ratio are weighted by the hits of segments.
+func AggregateSegmentSearchStats(stats []SegmentSearchStats) []CollectionSearchStats {
+	collections := make(map[int64]*CollectionSearchStats)
+	for _, stat := range stats {
+		collection, ok := collections[stat.CollectionID]
+		if !ok {
+			collection = &CollectionSearchStats{CollectionID: stat.CollectionID}
+			collections[stat.CollectionID] = collection
+		}
+		collection.Hits += stat.Hits
+		collection.AvgLatencyMs += stat.AvgLatencyMs * float64(stat.Hits)
+		collection.RowsScanned += stat.RowsScanned
+		collection.CacheHitRatio += stat.CacheHitRatio * float64(stat.Hits)
+		collection.Segments = append(collection.Segments, stat)
+	}
+
+	ret := make([]CollectionSearchStats, 0, len(collections))
+	for _, collection := range collections {
+		if collection.Hits > 0 {
+			collection.AvgLatencyMs /= float64(collection.Hits)
+			collection.CacheHitRatio /= float64(collection.Hits)
+		}
+		sort.SliceStable(collection.Segments, func(i, j int) bool {
+			return collection.Segments[i].Hits > collection.Segments[j].Hits
+		})
+		ret = append(ret, *collection)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].CollectionID < ret[j].CollectionID
+	})
+	return ret
+}
@@ -0,0 +1,48 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricsinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateSegmentSearchStats(t *testing.T) {
+	stats := []SegmentSearchStats{
+		{SegmentID: 1, CollectionID: 100, NodeID: 1, Hits: 1, AvgLatencyMs: 10, RowsScanned: 100, CacheHitRatio: 0},
+		{SegmentID: 2, CollectionID: 100, NodeID: 2, Hits: 3, AvgLatencyMs: 2, RowsScanned: 300, CacheHitRatio: 1},
+		{SegmentID: 3, CollectionID: 200, NodeID: 1, Hits: 0},
+	}
+
+	ret := AggregateSegmentSearchStats(stats)
+	assert.Len(t, ret, 2)
+
+	assert.EqualValues(t, 100, ret[0].CollectionID)
+	assert.EqualValues(t, 4, ret[0].Hits)
+	assert.EqualValues(t, 400, ret[0].RowsScanned)
+	assert.InDelta(t, 4, ret[0].AvgLatencyMs, 1e-9)
+	assert.InDelta(t, 0.75, ret[0].CacheHitRatio, 1e-9)
+	assert.Len(t, ret[0].Segments, 2)
+	assert.EqualValues(t, 2, ret[0].Segments[0].SegmentID)
+
+	assert.EqualValues(t, 200, ret[1].CollectionID)
+	assert.EqualValues(t, 0, ret[1].Hits)
+	assert.Zero(t, ret[1].AvgLatencyMs)
+
+	assert.Empty(t, AggregateSegmentSearchStats(nil))
+}
@@ -44,6 +44,8 @@ func UnmarshalTopology(s string, topology Topology) error {
 type QueryClusterTopology struct {
 	Self           QueryCoordInfos  `json:"self"`
 	ConnectedNodes []QueryNodeInfos `json:"connected_nodes"`
+	// CollectionSearchStats aggregates the segment search statistics of connected nodes per collection
+	CollectionSearchStats []CollectionSearchStats `json:"collection_search_stats"`
 }
 
 // ConnectionType is the type of connection between nodes
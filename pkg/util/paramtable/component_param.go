@@ -219,6 +219,14 @@ type commonConfig struct {
 	AuthorizationEnabled ParamItem `refreshable:"false"`
 	SuperUsers           ParamItem `refreshable:"true"`
 
+	OIDCEnabled             ParamItem `refreshable:"false"`
+	OIDCIssuer              ParamItem `refreshable:"false"`
+	OIDCAudience            ParamItem `refreshable:"true"`
+	OIDCUsernameClaim       ParamItem `refreshable:"true"`
+	OIDCRolesClaim          ParamItem `refreshable:"true"`
+	OIDCRoleMapping         ParamItem `refreshable:"true"`
+	OIDCJWKSRefreshInterval ParamItem `refreshable:"true"`
+
 	ClusterName ParamItem `refreshable:"false"`
 
 	SessionTTL        ParamItem `refreshable:"false"`
@@ -244,6 +252,8 @@ type commonConfig struct {
 	TraceLogMode          ParamItem `refreshable:"true"`
 	BloomFilterSize       ParamItem `refreshable:"true"`
 	MaxBloomFalsePositive ParamItem `refreshable:"true"`
+
+	MetaCacheWatchEnabled ParamItem `refreshable:"false"`
 }
 
 func (p *commonConfig) init(base *BaseTable) {
@@ -580,6 +590,69 @@ like the old password verification when updating the credential`,
 	}
 	p.SuperUsers.Init(base.mgr)
 
+	p.OIDCEnabled = ParamItem{
+		Key:          "common.security.oidc.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether to accept the OIDC id tokens as the credentials when the authorization is enabled",
+		Export:       true,
+	}
+	p.OIDCEnabled.Init(base.mgr)
+
+	p.OIDCIssuer = ParamItem{
+		Key:          "common.security.oidc.issuer",
+		Version:      "2.4.0",
+		DefaultValue: "",
+		Doc:          "the issuer url of the OIDC provider, the jwks is discovered from its openid configuration",
+		Export:       true,
+	}
+	p.OIDCIssuer.Init(base.mgr)
+
+	p.OIDCAudience = ParamItem{
+		Key:          "common.security.oidc.audience",
+		Version:      "2.4.0",
+		DefaultValue: "",
+		Doc:          "the audience the tokens must be issued for, the audience is not checked if empty",
+		Export:       true,
+	}
+	p.OIDCAudience.Init(base.mgr)
+
+	p.OIDCUsernameClaim = ParamItem{
+		Key:          "common.security.oidc.usernameClaim",
+		Version:      "2.4.0",
+		DefaultValue: "sub",
+		Doc:          "the claim used as the username of the token, the token authenticates as the user oidc/<username>",
+		Export:       true,
+	}
+	p.OIDCUsernameClaim.Init(base.mgr)
+
+	p.OIDCRolesClaim = ParamItem{
+		Key:          "common.security.oidc.rolesClaim",
+		Version:      "2.4.0",
+		DefaultValue: "groups",
+		Doc:          "the claim holding the groups of the token, which are mapped to the roles by the roleMapping",
+		Export:       true,
+	}
+	p.OIDCRolesClaim.Init(base.mgr)
+
+	p.OIDCRoleMapping = ParamItem{
+		Key:          "common.security.oidc.roleMapping",
+		Version:      "2.4.0",
+		DefaultValue: "{}",
+		Doc:          `the json map from the groups to the roles, like {"milvus-admins": "admin"}, the groups not mapped are ignored`,
+		Export:       true,
+	}
+	p.OIDCRoleMapping.Init(base.mgr)
+
+	p.OIDCJWKSRefreshInterval = ParamItem{
+		Key:          "common.security.oidc.jwksRefreshInterval",
+		Version:      "2.4.0",
+		DefaultValue: "3600",
+		Doc:          "the interval in seconds to refresh the cached jwks of the issuer",
+		Export:       true,
+	}
+	p.OIDCJWKSRefreshInterval.Init(base.mgr)
+
 	p.ClusterName = ParamItem{
 		Key:          "common.cluster.name",
 		Version:      "2.0.0",
@@ -717,6 +790,15 @@ like the old password verification when updating the credential`,
 		Doc:          "max false positive rate for bloom filter",
 	}
 	p.MaxBloomFalsePositive.Init(base.mgr)
+
+	p.MetaCacheWatchEnabled = ParamItem{
+		Key:          "common.metaCacheWatch.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether querycoord pushes the shard leader changes to the proxies through etcd watch, the collection meta is still invalidated by rpc",
+		Export:       true,
+	}
+	p.MetaCacheWatchEnabled.Init(base.mgr)
 }
 
 type gpuConfig struct {
@@ -886,6 +968,12 @@ type rootCoordConfig struct {
 	MaxDatabaseNum              ParamItem `refreshable:"false"`
 	MaxGeneralCapacity          ParamItem `refreshable:"true"`
 	GracefulStopTimeout         ParamItem `refreshable:"true"`
+
+	TimeTickMonitorEnabled       ParamItem `refreshable:"false"`
+	TimeTickMonitorCheckInterval ParamItem `refreshable:"false"`
+	TimeTickStallThreshold       ParamItem `refreshable:"true"`
+	TimeTickSkewThreshold        ParamItem `refreshable:"true"`
+	TimeTickAutoFence            ParamItem `refreshable:"true"`
 }
 
 func (p *rootCoordConfig) init(base *BaseTable) {
@@ -957,6 +1045,51 @@ func (p *rootCoordConfig) init(base *BaseTable) {
 		Export:       true,
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
+
+	p.TimeTickMonitorEnabled = ParamItem{
+		Key:          "rootCoord.timeTickMonitor.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "true",
+		Doc:          "whether to monitor the time tick progress of the proxies and the dml channels",
+		Export:       true,
+	}
+	p.TimeTickMonitorEnabled.Init(base.mgr)
+
+	p.TimeTickMonitorCheckInterval = ParamItem{
+		Key:          "rootCoord.timeTickMonitor.checkInterval",
+		Version:      "2.4.0",
+		DefaultValue: "10",
+		Doc:          "seconds, the interval to check the time tick progress",
+		Export:       true,
+	}
+	p.TimeTickMonitorCheckInterval.Init(base.mgr)
+
+	p.TimeTickStallThreshold = ParamItem{
+		Key:          "rootCoord.timeTickMonitor.stallThreshold",
+		Version:      "2.4.0",
+		DefaultValue: "60",
+		Doc:          "seconds, the time tick of a proxy or a dml channel not advanced for longer is regarded as stalled",
+		Export:       true,
+	}
+	p.TimeTickStallThreshold.Init(base.mgr)
+
+	p.TimeTickSkewThreshold = ParamItem{
+		Key:          "rootCoord.timeTickMonitor.skewThreshold",
+		Version:      "2.4.0",
+		DefaultValue: "10",
+		Doc:          "seconds, the time tick of a proxy behind the latest one of all the proxies for longer is regarded as skewed",
+		Export:       true,
+	}
+	p.TimeTickSkewThreshold.Init(base.mgr)
+
+	p.TimeTickAutoFence = ParamItem{
+		Key:          "rootCoord.timeTickMonitor.autoFence",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether to fence the proxy whose time tick stalled, it's removed from the time tick sync and denied until restarted",
+		Export:       true,
+	}
+	p.TimeTickAutoFence.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -975,6 +1108,22 @@ type AccessLogConfig struct {
 	Formatter     ParamGroup `refreshable:"false"`
 }
 
+type AuditLogConfig struct {
+	Enable       ParamItem `refreshable:"false"`
+	Sink         ParamItem `refreshable:"false"`
+	SampleRate   ParamItem `refreshable:"true"`
+	Methods      ParamItem `refreshable:"true"`
+	BufferSize   ParamItem `refreshable:"false"`
+	BlockOnFull  ParamItem `refreshable:"true"`
+	LocalPath    ParamItem `refreshable:"false"`
+	Filename     ParamItem `refreshable:"false"`
+	MaxSize      ParamItem `refreshable:"false"`
+	MaxBackups   ParamItem `refreshable:"false"`
+	KafkaTopic   ParamItem `refreshable:"false"`
+	OtlpEndpoint ParamItem `refreshable:"false"`
+	OtlpSecure   ParamItem `refreshable:"false"`
+}
+
 type proxyConfig struct {
 	// Alias  string
 	SoPath ParamItem `refreshable:"false"`
@@ -999,11 +1148,16 @@ type proxyConfig struct {
 	ReplicaSelectionPolicy       ParamItem `refreshable:"false"`
 	CheckQueryNodeHealthInterval ParamItem `refreshable:"false"`
 	CostMetricsExpireTime        ParamItem `refreshable:"true"`
+	EjectionLatencyRatio         ParamItem `refreshable:"true"`
+	EjectionMinLatency           ParamItem `refreshable:"true"`
+	EjectionDuration             ParamItem `refreshable:"true"`
+	MaxEjectionRatio             ParamItem `refreshable:"true"`
 	RetryTimesOnReplica          ParamItem `refreshable:"true"`
 	RetryTimesOnHealthCheck      ParamItem `refreshable:"true"`
 	PartitionNameRegexp          ParamItem `refreshable:"true"`
 
 	AccessLog AccessLogConfig
+	AuditLog  AuditLogConfig
 
 	// connection manager
 	ConnectionCheckIntervalSeconds ParamItem `refreshable:"true"`
@@ -1013,6 +1167,21 @@ type proxyConfig struct {
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 
 	SlowQuerySpanInSeconds ParamItem `refreshable:"true"`
+
+	// tso batching
+	TSOBatchEnabled      ParamItem `refreshable:"true"`
+	TSOBatchSize         ParamItem `refreshable:"true"`
+	TSOBatchWindow       ParamItem `refreshable:"true"`
+	TSOBatchMaxClockSkew ParamItem `refreshable:"true"`
+
+	HedgedReadEnabled    ParamItem `refreshable:"true"`
+	HedgedReadPercentile ParamItem `refreshable:"true"`
+	HedgedReadMinDelay   ParamItem `refreshable:"true"`
+	HedgedReadBudget     ParamItem `refreshable:"true"`
+
+	PartialResultTimeoutRatio ParamItem `refreshable:"true"`
+	PreparedExprCacheSize     ParamItem `refreshable:"true"`
+	ModelRankerEndpoints      ParamItem `refreshable:"true"`
 }
 
 func (p *proxyConfig) init(base *BaseTable) {
@@ -1264,6 +1433,110 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.Formatter.Init(base.mgr)
 
+	p.AuditLog.Enable = ParamItem{
+		Key:          "proxy.auditLog.enable",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "if use audit log",
+	}
+	p.AuditLog.Enable.Init(base.mgr)
+
+	p.AuditLog.Sink = ParamItem{
+		Key:          "proxy.auditLog.sink",
+		Version:      "2.4.0",
+		DefaultValue: "file",
+		Doc:          "where to emit the audit logs, options: file, kafka, otlp",
+	}
+	p.AuditLog.Sink.Init(base.mgr)
+
+	p.AuditLog.SampleRate = ParamItem{
+		Key:          "proxy.auditLog.sampleRate",
+		Version:      "2.4.0",
+		DefaultValue: "1",
+		Doc:          "ratio of the successful requests to audit, in [0, 1], the failed requests are always audited",
+	}
+	p.AuditLog.SampleRate.Init(base.mgr)
+
+	p.AuditLog.Methods = ParamItem{
+		Key:          "proxy.auditLog.methods",
+		Version:      "2.4.0",
+		DefaultValue: "",
+		Doc:          "methods to audit separated by comma, audit all methods if empty",
+	}
+	p.AuditLog.Methods.Init(base.mgr)
+
+	p.AuditLog.BufferSize = ParamItem{
+		Key:          "proxy.auditLog.bufferSize",
+		Version:      "2.4.0",
+		DefaultValue: "10240",
+		Doc:          "max number of the audit logs pending to emit, the new logs are dropped and counted once full unless blockOnFull",
+	}
+	p.AuditLog.BufferSize.Init(base.mgr)
+
+	p.AuditLog.BlockOnFull = ParamItem{
+		Key:          "proxy.auditLog.blockOnFull",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether to block the requests until the audit logs are buffered once the buffer is full, instead of dropping the audit logs",
+	}
+	p.AuditLog.BlockOnFull.Init(base.mgr)
+
+	p.AuditLog.LocalPath = ParamItem{
+		Key:          "proxy.auditLog.localPath",
+		Version:      "2.4.0",
+		DefaultValue: "/tmp/milvus_audit",
+		Doc:          "root path of the audit log files, used by file sink",
+	}
+	p.AuditLog.LocalPath.Init(base.mgr)
+
+	p.AuditLog.Filename = ParamItem{
+		Key:          "proxy.auditLog.filename",
+		Version:      "2.4.0",
+		DefaultValue: "audit.log",
+		Doc:          "audit log filename, used by file sink",
+	}
+	p.AuditLog.Filename.Init(base.mgr)
+
+	p.AuditLog.MaxSize = ParamItem{
+		Key:          "proxy.auditLog.maxSize",
+		Version:      "2.4.0",
+		DefaultValue: "64",
+		Doc:          "Max size for a single file, in MB.",
+	}
+	p.AuditLog.MaxSize.Init(base.mgr)
+
+	p.AuditLog.MaxBackups = ParamItem{
+		Key:          "proxy.auditLog.maxBackups",
+		Version:      "2.4.0",
+		DefaultValue: "8",
+		Doc:          "Maximum number of old log files to retain.",
+	}
+	p.AuditLog.MaxBackups.Init(base.mgr)
+
+	p.AuditLog.KafkaTopic = ParamItem{
+		Key:          "proxy.auditLog.kafka.topic",
+		Version:      "2.4.0",
+		DefaultValue: "milvus-audit",
+		Doc:          "topic of the audit logs, used by kafka sink with the brokers configured by kafka.brokerList",
+	}
+	p.AuditLog.KafkaTopic.Init(base.mgr)
+
+	p.AuditLog.OtlpEndpoint = ParamItem{
+		Key:          "proxy.auditLog.otlp.endpoint",
+		Version:      "2.4.0",
+		DefaultValue: "127.0.0.1:4317",
+		Doc:          "grpc endpoint of the OTLP logs collector, used by otlp sink",
+	}
+	p.AuditLog.OtlpEndpoint.Init(base.mgr)
+
+	p.AuditLog.OtlpSecure = ParamItem{
+		Key:          "proxy.auditLog.otlp.secure",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "if connect to the OTLP logs collector with TLS",
+	}
+	p.AuditLog.OtlpSecure.Init(base.mgr)
+
 	p.ShardLeaderCacheInterval = ParamItem{
 		Key:          "proxy.shardLeaderCacheInterval",
 		Version:      "2.2.4",
@@ -1275,8 +1548,8 @@ please adjust in embedded Milvus: false`,
 	p.ReplicaSelectionPolicy = ParamItem{
 		Key:          "proxy.replicaSelectionPolicy",
 		Version:      "2.3.0",
-		DefaultValue: "look_aside",
-		Doc:          "replica selection policy in multiple replicas load balancing, support round_robin and look_aside",
+		DefaultValue: "adaptive",
+		Doc:          "replica selection policy in multiple replicas load balancing, support round_robin, look_aside and adaptive",
 	}
 	p.ReplicaSelectionPolicy.Init(base.mgr)
 
@@ -1296,6 +1569,38 @@ please adjust in embedded Milvus: false`,
 	}
 	p.CostMetricsExpireTime.Init(base.mgr)
 
+	p.EjectionLatencyRatio = ParamItem{
+		Key:          "proxy.adaptiveBalancer.ejectionLatencyRatio",
+		Version:      "2.4.0",
+		DefaultValue: "3",
+		Doc:          "the query node is ejected from replica selection if its recent latency exceeds the ratio of the median latency, used by adaptive policy",
+	}
+	p.EjectionLatencyRatio.Init(base.mgr)
+
+	p.EjectionMinLatency = ParamItem{
+		Key:          "proxy.adaptiveBalancer.ejectionMinLatency",
+		Version:      "2.4.0",
+		DefaultValue: "100",
+		Doc:          "the query node is not ejected unless its recent latency exceeds the median latency by it, in ms, used by adaptive policy",
+	}
+	p.EjectionMinLatency.Init(base.mgr)
+
+	p.EjectionDuration = ParamItem{
+		Key:          "proxy.adaptiveBalancer.ejectionDuration",
+		Version:      "2.4.0",
+		DefaultValue: "5000",
+		Doc:          "base duration a query node is ejected before probing it, doubled for consecutive ejections, in ms, used by adaptive policy",
+	}
+	p.EjectionDuration.Init(base.mgr)
+
+	p.MaxEjectionRatio = ParamItem{
+		Key:          "proxy.adaptiveBalancer.maxEjectionRatio",
+		Version:      "2.4.0",
+		DefaultValue: "0.5",
+		Doc:          "the max ratio of the query nodes ejected at the same time, used by adaptive policy",
+	}
+	p.MaxEjectionRatio.Init(base.mgr)
+
 	p.RetryTimesOnReplica = ParamItem{
 		Key:          "proxy.retryTimesOnReplica",
 		Version:      "2.3.0",
@@ -1364,6 +1669,105 @@ please adjust in embedded Milvus: false`,
 		Export:       true,
 	}
 	p.SlowQuerySpanInSeconds.Init(base.mgr)
+
+	p.TSOBatchEnabled = ParamItem{
+		Key:          "proxy.tsoBatch.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether to serve the timestamps of the ddl, dml requests and the time ticks from the batch allocated from rootcoord, reduces the TSO requests of rootcoord, the strong consistency requests always allocate from rootcoord",
+		Export:       true,
+	}
+	p.TSOBatchEnabled.Init(base.mgr)
+
+	p.TSOBatchSize = ParamItem{
+		Key:          "proxy.tsoBatch.size",
+		Version:      "2.4.0",
+		DefaultValue: "1000",
+		Doc:          "the number of timestamps allocated from rootcoord in one request",
+		Export:       true,
+	}
+	p.TSOBatchSize.Init(base.mgr)
+
+	p.TSOBatchWindow = ParamItem{
+		Key:          "proxy.tsoBatch.window",
+		Version:      "2.4.0",
+		DefaultValue: "50",
+		Doc:          "ms, the batched timestamps are served within the window after allocated, bounds how stale a served timestamp could be",
+		Export:       true,
+	}
+	p.TSOBatchWindow.Init(base.mgr)
+
+	p.TSOBatchMaxClockSkew = ParamItem{
+		Key:          "proxy.tsoBatch.maxClockSkew",
+		Version:      "2.4.0",
+		DefaultValue: "1000",
+		Doc:          "ms, the batched timestamps are not served if the clock of proxy skews from rootcoord more than it",
+		Export:       true,
+	}
+	p.TSOBatchMaxClockSkew.Init(base.mgr)
+
+	p.HedgedReadEnabled = ParamItem{
+		Key:          "proxy.hedgedRead.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether to issue the search/query of a channel to another replica if the first replica responds slowly, the first response is taken",
+		Export:       true,
+	}
+	p.HedgedReadEnabled.Init(base.mgr)
+
+	p.HedgedReadPercentile = ParamItem{
+		Key:          "proxy.hedgedRead.latencyPercentile",
+		Version:      "2.4.0",
+		DefaultValue: "0.95",
+		Doc:          "the hedged request is issued once the request takes longer than the percentile of the recent latencies",
+		Export:       true,
+	}
+	p.HedgedReadPercentile.Init(base.mgr)
+
+	p.HedgedReadMinDelay = ParamItem{
+		Key:          "proxy.hedgedRead.minDelay",
+		Version:      "2.4.0",
+		DefaultValue: "10",
+		Doc:          "ms, the min delay before issuing the hedged request",
+		Export:       true,
+	}
+	p.HedgedReadMinDelay.Init(base.mgr)
+
+	p.HedgedReadBudget = ParamItem{
+		Key:          "proxy.hedgedRead.budget",
+		Version:      "2.4.0",
+		DefaultValue: "0.1",
+		Doc:          "the max ratio of the hedged requests to all the search/query requests, caps the extra load of the query nodes",
+		Export:       true,
+	}
+	p.HedgedReadBudget.Init(base.mgr)
+
+	p.PartialResultTimeoutRatio = ParamItem{
+		Key:          "proxy.partialResult.timeoutRatio",
+		Version:      "2.4.0",
+		DefaultValue: "0.8",
+		Doc:          "the ratio of the request timeout to wait for the channels of the search allowing partial result, the channels not responded in time are skipped",
+		Export:       true,
+	}
+	p.PartialResultTimeoutRatio.Init(base.mgr)
+
+	p.PreparedExprCacheSize = ParamItem{
+		Key:          "proxy.preparedExpr.cacheSize",
+		Version:      "2.4.0",
+		DefaultValue: "128",
+		Doc:          "the max number of the prepared expression templates cached for each collection, the templates are parsed every time if 0",
+		Export:       true,
+	}
+	p.PreparedExprCacheSize.Init(base.mgr)
+
+	p.ModelRankerEndpoints = ParamItem{
+		Key:          "proxy.modelRanker.endpoints",
+		Version:      "2.4.0",
+		DefaultValue: "",
+		Doc:          "the endpoints separated by comma the model rankers are allowed to request, the model ranker is disabled if empty",
+		Export:       true,
+	}
+	p.ModelRankerEndpoints.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1400,6 +1804,10 @@ type queryCoordConfig struct {
 	RandomMaxSteps                      ParamItem `refreshable:"true"`
 	GrowingRowCountWeight               ParamItem `refreshable:"true"`
 	BalanceCostThreshold                ParamItem `refreshable:"true"`
+	EnableLeaderBalance                 ParamItem `refreshable:"true"`
+	LeaderBalanceCountThreshold         ParamItem `refreshable:"true"`
+	LeaderBalanceQPSFactor              ParamItem `refreshable:"true"`
+	LeaderBalanceCheckInterval          ParamItem `refreshable:"true"`
 
 	SegmentCheckInterval       ParamItem `refreshable:"true"`
 	ChannelCheckInterval       ParamItem `refreshable:"true"`
@@ -1417,6 +1825,7 @@ type queryCoordConfig struct {
 	// Deprecated: Since 2.2.2, QueryCoord do not use HandOff logic anymore
 	CheckHandoffInterval ParamItem `refreshable:"true"`
 	EnableActiveStandby  ParamItem `refreshable:"false"`
+	EnableStandbyWarmup  ParamItem `refreshable:"false"`
 
 	// Deprecated: Since 2.2.2, use different interval for different checker
 	CheckInterval ParamItem `refreshable:"true"`
@@ -1639,6 +2048,46 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.BalanceCostThreshold.Init(base.mgr)
 
+	p.EnableLeaderBalance = ParamItem{
+		Key:          "queryCoord.enableLeaderBalance",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		PanicIfEmpty: true,
+		Doc:          "whether to transfer shard leaders from the overloaded query nodes to the followers holding the channel's data",
+		Export:       true,
+	}
+	p.EnableLeaderBalance.Init(base.mgr)
+
+	p.LeaderBalanceCountThreshold = ParamItem{
+		Key:          "queryCoord.leaderBalanceCountThreshold",
+		Version:      "2.4.0",
+		DefaultValue: "2",
+		PanicIfEmpty: true,
+		Doc:          "a query node is overloaded if it holds at least this many more shard leaders than the least loaded node in the replica",
+		Export:       true,
+	}
+	p.LeaderBalanceCountThreshold.Init(base.mgr)
+
+	p.LeaderBalanceQPSFactor = ParamItem{
+		Key:          "queryCoord.leaderBalanceQPSFactor",
+		Version:      "2.4.0",
+		DefaultValue: "2.0",
+		PanicIfEmpty: true,
+		Doc:          "a query node is overloaded if the qps of its shard leaders exceeds the average of the replica by this factor",
+		Export:       true,
+	}
+	p.LeaderBalanceQPSFactor.Init(base.mgr)
+
+	p.LeaderBalanceCheckInterval = ParamItem{
+		Key:          "queryCoord.checkLeaderBalanceInterval",
+		Version:      "2.4.0",
+		DefaultValue: "30000",
+		PanicIfEmpty: true,
+		Doc:          "the interval of checking the shard leader load, in milliseconds",
+		Export:       true,
+	}
+	p.LeaderBalanceCheckInterval.Init(base.mgr)
+
 	p.MemoryUsageMaxDifferencePercentage = ParamItem{
 		Key:          "queryCoord.memoryUsageMaxDifferencePercentage",
 		Version:      "2.0.0",
@@ -1758,6 +2207,15 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.EnableActiveStandby.Init(base.mgr)
 
+	p.EnableStandbyWarmup = ParamItem{
+		Key:          "queryCoord.enableStandbyWarmup",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether the standby querycoord keeps mirroring meta, target and data distribution, only works when enableActiveStandby is true",
+		Export:       true,
+	}
+	p.EnableStandbyWarmup.Init(base.mgr)
+
 	p.NextTargetSurviveTime = ParamItem{
 		Key:          "queryCoord.NextTargetSurviveTime",
 		Version:      "2.0.0",
@@ -1924,13 +2382,19 @@ type queryNodeConfig struct {
 	StatsPublishInterval ParamItem `refreshable:"true"`
 
 	// segcore
-	KnowhereThreadPoolSize        ParamItem `refreshable:"false"`
-	ChunkRows                     ParamItem `refreshable:"false"`
-	EnableTempSegmentIndex        ParamItem `refreshable:"false"`
-	InterimIndexNlist             ParamItem `refreshable:"false"`
-	InterimIndexNProbe            ParamItem `refreshable:"false"`
-	InterimIndexMemExpandRate     ParamItem `refreshable:"false"`
-	InterimIndexBuildParallelRate ParamItem `refreshable:"false"`
+	KnowhereThreadPoolSize         ParamItem `refreshable:"false"`
+	ChunkRows                      ParamItem `refreshable:"false"`
+	EnableTempSegmentIndex         ParamItem `refreshable:"false"`
+	InterimIndexNlist              ParamItem `refreshable:"false"`
+	InterimIndexNProbe             ParamItem `refreshable:"false"`
+	InterimIndexMemExpandRate      ParamItem `refreshable:"false"`
+	InterimIndexBuildParallelRate  ParamItem `refreshable:"false"`
+	InterimIndexAsyncBuild         ParamItem `refreshable:"false"`
+	InterimIndexAsyncBuildMinRows  ParamItem `refreshable:"true"`
+	InterimIndexAsyncBuildInterval ParamItem `refreshable:"false"`
+
+	// number of evaluated filter bitsets cached per sealed segment
+	ExprResCacheSize ParamItem `refreshable:"false"`
 
 	// memory limit
 	LoadMemoryUsageFactor               ParamItem `refreshable:"true"`
@@ -1947,12 +2411,47 @@ type queryNodeConfig struct {
 	MmapDirPath      ParamItem `refreshable:"false"`
 	MmapEnabled      ParamItem `refreshable:"false"`
 
-	LazyLoadEnabled ParamItem `refreshable:"false"`
+	LazyLoadEnabled            ParamItem `refreshable:"false"`
+	LazyLoadWaitTimeout        ParamItem `refreshable:"true"`
+	LazyLoadCacheCapacityRatio ParamItem `refreshable:"false"`
+
+	// tiering
+	TieringEnabled       ParamItem `refreshable:"true"`
+	TieringIdleTimeout   ParamItem `refreshable:"true"`
+	TieringCheckInterval ParamItem `refreshable:"false"`
+
+	// local disk cache
+	DiskCacheRefreshInterval ParamItem `refreshable:"false"`
+	DiskCacheVerifyInterval  ParamItem `refreshable:"false"`
+	DiskCacheVerifyBatchSize ParamItem `refreshable:"true"`
+
+	// standing query
+	StandingQueryMaxNum     ParamItem `refreshable:"true"`
+	StandingQueryBufferSize ParamItem `refreshable:"false"`
+
+	// search result cache of delegator
+	ResultCacheEnabled  ParamItem `refreshable:"true"`
+	ResultCacheTTL      ParamItem `refreshable:"false"`
+	ResultCacheCapacity ParamItem `refreshable:"false"`
+
+	// batching of the deletes forwarded by delegator
+	ForwardDeleteBatchEnabled  ParamItem `refreshable:"false"`
+	ForwardDeleteFlushInterval ParamItem `refreshable:"false"`
+	ForwardDeleteFlushSize     ParamItem `refreshable:"true"`
+	ForwardDeleteCompression   ParamItem `refreshable:"true"`
+
+	// resource budget of each request
+	RequestMaxMemorySize ParamItem `refreshable:"true"`
+	RequestMaxCPUTime    ParamItem `refreshable:"true"`
 
 	// chunk cache
 	ReadAheadPolicy     ParamItem `refreshable:"false"`
 	ChunkCacheWarmingUp ParamItem `refreshable:"true"`
 
+	// warmup of the local files after segment loaded
+	SegmentWarmupEnabled ParamItem `refreshable:"true"`
+	SegmentWarmupRatio   ParamItem `refreshable:"true"`
+
 	GroupEnabled          ParamItem `refreshable:"true"`
 	MaxReceiveChanSize    ParamItem `refreshable:"false"`
 	MaxUnsolvedQueueSize  ParamItem `refreshable:"true"`
@@ -1968,11 +2467,17 @@ type queryNodeConfig struct {
 	MinimumGOGCConfig   ParamItem `refreshable:"false"`
 	MaximumGOGCConfig   ParamItem `refreshable:"false"`
 	GracefulStopTimeout ParamItem `refreshable:"false"`
+	QueryDrainTimeout   ParamItem `refreshable:"true"`
 
 	// delete buffer
 	MaxSegmentDeleteBuffer ParamItem `refreshable:"false"`
 	DeleteBufferBlockSize  ParamItem `refreshable:"false"`
 
+	DeleteBufferSpillEnabled          ParamItem `refreshable:"false"`
+	DeleteBufferMemoryLimit           ParamItem `refreshable:"false"`
+	DeleteBufferSpillFormat           ParamItem `refreshable:"false"`
+	DeleteBufferSpillCompactThreshold ParamItem `refreshable:"false"`
+
 	// loader
 	IoPoolSize             ParamItem `refreshable:"false"`
 	DeltaDataExpansionRate ParamItem `refreshable:"true"`
@@ -1983,6 +2488,16 @@ type queryNodeConfig struct {
 	SchedulePolicyEnableCrossUserGrouping ParamItem `refreshable:"true"`
 	SchedulePolicyMaxPendingTaskPerUser   ParamItem `refreshable:"true"`
 
+	// priority lanes of read task scheduler
+	PriorityLaneEnabled           ParamItem `refreshable:"false"`
+	PriorityLaneInteractiveWeight ParamItem `refreshable:"true"`
+
+	// memory admission control of read tasks
+	AdmissionEnabled       ParamItem `refreshable:"true"`
+	AdmissionHighWatermark ParamItem `refreshable:"true"`
+	AdmissionLowWatermark  ParamItem `refreshable:"true"`
+	AdmissionQueueTimeout  ParamItem `refreshable:"true"`
+
 	// CGOPoolSize ratio to MaxReadConcurrency
 	CGOPoolSizeRatio ParamItem `refreshable:"true"`
 
@@ -1998,6 +2513,8 @@ type queryNodeConfig struct {
 	MemoryIndexLoadPredictMemoryUsageFactor ParamItem `refreshable:"true"`
 	EnableSegmentPrune                      ParamItem `refreshable:"false"`
 	DefaultSegmentFilterRatio               ParamItem `refreshable:"false"`
+	EnablePartitionKeyPrune                 ParamItem `refreshable:"true"`
+	EnableScalarStatsPrune                  ParamItem `refreshable:"true"`
 }
 
 func (p *queryNodeConfig) init(base *BaseTable) {
@@ -2124,6 +2641,42 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 	}
 	p.InterimIndexNProbe.Init(base.mgr)
 
+	p.InterimIndexAsyncBuild = ParamItem{
+		Key:          "queryNode.segcore.interimIndex.asyncBuild.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "build the interim index of growing segments in background instead of in the insertion",
+		Export:       true,
+	}
+	p.InterimIndexAsyncBuild.Init(base.mgr)
+
+	p.InterimIndexAsyncBuildMinRows = ParamItem{
+		Key:          "queryNode.segcore.interimIndex.asyncBuild.minRows",
+		Version:      "2.4.0",
+		DefaultValue: "10000",
+		Doc:          "min number of rows of growing segment to build the interim index in background",
+		Export:       true,
+	}
+	p.InterimIndexAsyncBuildMinRows.Init(base.mgr)
+
+	p.InterimIndexAsyncBuildInterval = ParamItem{
+		Key:          "queryNode.segcore.interimIndex.asyncBuild.interval",
+		Version:      "2.4.0",
+		DefaultValue: "5",
+		Doc:          "interval in seconds to check the growing segments to build the interim index in background",
+		Export:       true,
+	}
+	p.InterimIndexAsyncBuildInterval.Init(base.mgr)
+
+	p.ExprResCacheSize = ParamItem{
+		Key:          "queryNode.segcore.exprResCache.size",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "number of evaluated filter bitsets cached per sealed segment, so that the repeated filtered searches skip evaluating the same filter, 0 means disabled",
+		Export:       true,
+	}
+	p.ExprResCacheSize.Init(base.mgr)
+
 	p.LoadMemoryUsageFactor = ParamItem{
 		Key:          "queryNode.loadMemoryUsageFactor",
 		Version:      "2.0.0",
@@ -2189,13 +2742,184 @@ func (p *queryNodeConfig) init(base *BaseTable) {
 	}
 	p.LazyLoadEnabled.Init(base.mgr)
 
-	p.ReadAheadPolicy = ParamItem{
-		Key:          "queryNode.cache.readAheadPolicy",
-		Version:      "2.3.2",
-		DefaultValue: "willneed",
-		Doc:          "The read ahead policy of chunk cache, options: `normal, random, sequential, willneed, dontneed`",
+	p.LazyLoadWaitTimeout = ParamItem{
+		Key:          "queryNode.lazyload.waitTimeout",
+		Version:      "2.4.0",
+		DefaultValue: "30000",
+		Doc:          "max wait timeout in milliseconds for the lazy load segments to be cached when the disk cache is full",
+		Export:       true,
 	}
-	p.ReadAheadPolicy.Init(base.mgr)
+	p.LazyLoadWaitTimeout.Init(base.mgr)
+
+	p.LazyLoadCacheCapacityRatio = ParamItem{
+		Key:          "queryNode.lazyload.cacheCapacityRatio",
+		Version:      "2.4.0",
+		DefaultValue: "1.0",
+		Doc:          "ratio of the disk capacity used to cache the data of lazy load segments, the least recently used segments are evicted if exceeded",
+		Export:       true,
+	}
+	p.LazyLoadCacheCapacityRatio.Init(base.mgr)
+
+	p.TieringEnabled = ParamItem{
+		Key:          "queryNode.tiering.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether to evict the idle sealed segments from memory to the local disk cache, could be overridden by collection property tiering.enabled",
+		Export:       true,
+	}
+	p.TieringEnabled.Init(base.mgr)
+
+	p.TieringIdleTimeout = ParamItem{
+		Key:          "queryNode.tiering.idleTimeout",
+		Version:      "2.4.0",
+		DefaultValue: "1800",
+		Doc:          "the sealed segments not accessed for idleTimeout seconds are evicted to the local disk cache, and loaded back on access, could be overridden by collection property tiering.idleTimeout",
+		Export:       true,
+	}
+	p.TieringIdleTimeout.Init(base.mgr)
+
+	p.TieringCheckInterval = ParamItem{
+		Key:          "queryNode.tiering.checkInterval",
+		Version:      "2.4.0",
+		DefaultValue: "60",
+		Doc:          "interval in seconds to check the idle sealed segments",
+		Export:       true,
+	}
+	p.TieringCheckInterval.Init(base.mgr)
+
+	p.DiskCacheRefreshInterval = ParamItem{
+		Key:          "queryNode.diskCache.refreshInterval",
+		Version:      "2.4.0",
+		DefaultValue: "60",
+		Doc:          "interval in seconds to refresh the disk usage of the local disk cache, which consists of the mmap files, the chunk cache and the lazy load segments sharing the quota of localStorage.quotaRatio",
+		Export:       true,
+	}
+	p.DiskCacheRefreshInterval.Init(base.mgr)
+
+	p.DiskCacheVerifyInterval = ParamItem{
+		Key:          "queryNode.diskCache.verifyInterval",
+		Version:      "2.4.0",
+		DefaultValue: "60",
+		Doc:          "interval in seconds of the rounds verifying the checksums of the local disk cache files, the corrupted lazy load segments are evicted and cached again on access, 0 to disable",
+		Export:       true,
+	}
+	p.DiskCacheVerifyInterval.Init(base.mgr)
+
+	p.DiskCacheVerifyBatchSize = ParamItem{
+		Key:          "queryNode.diskCache.verifyBatchSize",
+		Version:      "2.4.0",
+		DefaultValue: "268435456",
+		Doc:          "max bytes of the local disk cache files read by each verify round, the files are checksummed in turn and incrementally across rounds",
+		Export:       true,
+	}
+	p.DiskCacheVerifyBatchSize.Init(base.mgr)
+
+	p.StandingQueryMaxNum = ParamItem{
+		Key:          "queryNode.standingQuery.maxNum",
+		Version:      "2.4.0",
+		DefaultValue: "16",
+		Doc:          "max number of the standing queries subscribed on each delegator",
+		Export:       true,
+	}
+	p.StandingQueryMaxNum.Init(base.mgr)
+
+	p.StandingQueryBufferSize = ParamItem{
+		Key:          "queryNode.standingQuery.bufferSize",
+		Version:      "2.4.0",
+		DefaultValue: "1024",
+		Doc:          "max number of the events buffered for each standing query, the subscription is closed if the subscriber lags behind more than it",
+		Export:       true,
+	}
+	p.StandingQueryBufferSize.Init(base.mgr)
+
+	p.ResultCacheEnabled = ParamItem{
+		Key:          "queryNode.resultCache.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether the delegator caches the search results for the repeated searches, the cache is invalidated once the data of the shard changes",
+		Export:       true,
+	}
+	p.ResultCacheEnabled.Init(base.mgr)
+
+	p.ResultCacheTTL = ParamItem{
+		Key:          "queryNode.resultCache.ttl",
+		Version:      "2.4.0",
+		DefaultValue: "60",
+		Doc:          "time to live in seconds of the cached search results",
+		Export:       true,
+	}
+	p.ResultCacheTTL.Init(base.mgr)
+
+	p.ResultCacheCapacity = ParamItem{
+		Key:          "queryNode.resultCache.capacity",
+		Version:      "2.4.0",
+		DefaultValue: "1024",
+		Doc:          "max number of the cached search results for each delegator",
+		Export:       true,
+	}
+	p.ResultCacheCapacity.Init(base.mgr)
+
+	p.ForwardDeleteBatchEnabled = ParamItem{
+		Key:          "queryNode.forwardDelete.batchEnabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether the delegator batches the deletes forwarded to each remote worker into one request, all the query nodes must support it before enabled",
+		Export:       true,
+	}
+	p.ForwardDeleteBatchEnabled.Init(base.mgr)
+
+	p.ForwardDeleteFlushInterval = ParamItem{
+		Key:          "queryNode.forwardDelete.flushInterval",
+		Version:      "2.4.0",
+		DefaultValue: "100",
+		Doc:          "max interval in milliseconds the forwarded deletes are kept in batch, the batch is flushed before serving search/query anyway",
+		Export:       true,
+	}
+	p.ForwardDeleteFlushInterval.Init(base.mgr)
+
+	p.ForwardDeleteFlushSize = ParamItem{
+		Key:          "queryNode.forwardDelete.flushSize",
+		Version:      "2.4.0",
+		DefaultValue: "100000",
+		Doc:          "max number of the forwarded delete rows kept in batch",
+		Export:       true,
+	}
+	p.ForwardDeleteFlushSize.Init(base.mgr)
+
+	p.ForwardDeleteCompression = ParamItem{
+		Key:          "queryNode.forwardDelete.compression",
+		Version:      "2.4.0",
+		DefaultValue: "true",
+		Doc:          "whether to compress the batched forwarded deletes with zstd",
+		Export:       true,
+	}
+	p.ForwardDeleteCompression.Init(base.mgr)
+
+	p.RequestMaxMemorySize = ParamItem{
+		Key:          "queryNode.requestBudget.maxMemorySize",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "max memory size in MB of the results held by a search/query request on the query node, the request is cancelled if exceeded, 0 means unlimited",
+		Export:       true,
+	}
+	p.RequestMaxMemorySize.Init(base.mgr)
+
+	p.RequestMaxCPUTime = ParamItem{
+		Key:          "queryNode.requestBudget.maxCPUTime",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "max cpu time in milliseconds spent in segcore by a search/query request on the query node, the request is cancelled if exceeded, 0 means unlimited",
+		Export:       true,
+	}
+	p.RequestMaxCPUTime.Init(base.mgr)
+
+	p.ReadAheadPolicy = ParamItem{
+		Key:          "queryNode.cache.readAheadPolicy",
+		Version:      "2.3.2",
+		DefaultValue: "willneed",
+		Doc:          "The read ahead policy of chunk cache, options: `normal, random, sequential, willneed, dontneed`",
+	}
+	p.ReadAheadPolicy.Init(base.mgr)
 
 	p.ChunkCacheWarmingUp = ParamItem{
 		Key:          "queryNode.cache.warmup",
@@ -2210,6 +2934,24 @@ for a specific duration post-load, albeit accompanied by a concurrent increase i
 	}
 	p.ChunkCacheWarmingUp.Init(base.mgr)
 
+	p.SegmentWarmupEnabled = ParamItem{
+		Key:          "queryNode.segmentWarmup.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether to touch the local disk index files and mmap files of the loaded segment before it's serviceable, which prevents the latency cliff of the first search/query with mmap enabled",
+		Export:       true,
+	}
+	p.SegmentWarmupEnabled.Init(base.mgr)
+
+	p.SegmentWarmupRatio = ParamItem{
+		Key:          "queryNode.segmentWarmup.ratio",
+		Version:      "2.4.0",
+		DefaultValue: "100",
+		Doc:          "percentage of each local file of the loaded segment to touch in warmup, in range (0, 100]",
+		Export:       true,
+	}
+	p.SegmentWarmupRatio.Init(base.mgr)
+
 	p.GroupEnabled = ParamItem{
 		Key:          "queryNode.grouping.enabled",
 		Version:      "2.0.0",
@@ -2378,6 +3120,15 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
 
+	p.QueryDrainTimeout = ParamItem{
+		Key:          "queryNode.queryDrainTimeout",
+		Version:      "2.4.0",
+		DefaultValue: "30",
+		Doc:          "seconds to wait for the in-flight search/query requests done in graceful stop after the segments and channels handed over, 0 means waiting until all done",
+		Export:       true,
+	}
+	p.QueryDrainTimeout.Init(base.mgr)
+
 	p.MaxSegmentDeleteBuffer = ParamItem{
 		Key:          "queryNode.maxSegmentDeleteBuffer",
 		Version:      "2.3.0",
@@ -2393,6 +3144,42 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 	}
 	p.DeleteBufferBlockSize.Init(base.mgr)
 
+	p.DeleteBufferSpillEnabled = ParamItem{
+		Key:          "queryNode.deleteBufferSpill.enabled",
+		Version:      "2.4.0",
+		Doc:          "whether the delegator spills the earliest delete buffer blocks to local disk when the memory limit exceeded",
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.DeleteBufferSpillEnabled.Init(base.mgr)
+
+	p.DeleteBufferMemoryLimit = ParamItem{
+		Key:          "queryNode.deleteBufferSpill.memoryLimit",
+		Version:      "2.4.0",
+		Doc:          "max size in bytes of the delete buffer blocks kept in memory for each delegator, only works when spill enabled",
+		DefaultValue: "67108864", // 64MB
+		Export:       true,
+	}
+	p.DeleteBufferMemoryLimit.Init(base.mgr)
+
+	p.DeleteBufferSpillFormat = ParamItem{
+		Key:          "queryNode.deleteBufferSpill.format",
+		Version:      "2.4.0",
+		Doc:          "encoding format of the spilled delete buffer blocks, binary or json",
+		DefaultValue: "binary",
+		Export:       true,
+	}
+	p.DeleteBufferSpillFormat.Init(base.mgr)
+
+	p.DeleteBufferSpillCompactThreshold = ParamItem{
+		Key:          "queryNode.deleteBufferSpill.compactThreshold",
+		Version:      "2.4.0",
+		Doc:          "max number of spilled delete buffer files for each delegator, the adjacent files are merged if exceeded",
+		DefaultValue: "16",
+		Export:       true,
+	}
+	p.DeleteBufferSpillCompactThreshold.Init(base.mgr)
+
 	p.IoPoolSize = ParamItem{
 		Key:          "queryNode.ioPoolSize",
 		Version:      "2.3.0",
@@ -2439,6 +3226,60 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 	}
 	p.SchedulePolicyMaxPendingTaskPerUser.Init(base.mgr)
 
+	p.PriorityLaneEnabled = ParamItem{
+		Key:          "queryNode.scheduler.priorityLane.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether to schedule the read tasks in the interactive and batch lanes by the priority hinted in the requests",
+		Export:       true,
+	}
+	p.PriorityLaneEnabled.Init(base.mgr)
+
+	p.PriorityLaneInteractiveWeight = ParamItem{
+		Key:          "queryNode.scheduler.priorityLane.interactiveWeight",
+		Version:      "2.4.0",
+		DefaultValue: "8",
+		Doc:          "number of interactive tasks scheduled before a batch task if both lanes are pending, 0 means the batch tasks are scheduled only if no interactive task pending",
+		Export:       true,
+	}
+	p.PriorityLaneInteractiveWeight.Init(base.mgr)
+
+	p.AdmissionEnabled = ParamItem{
+		Key:          "queryNode.scheduler.admission.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether to admit the read tasks by the memory headroom, the memory in use includes the working set of mmap page cache",
+		Export:       true,
+	}
+	p.AdmissionEnabled.Init(base.mgr)
+
+	p.AdmissionHighWatermark = ParamItem{
+		Key:          "queryNode.scheduler.admission.highWatermark",
+		Version:      "2.4.0",
+		DefaultValue: "0.9",
+		Doc:          "ratio of memory in use to total memory, above which the new read tasks are queued",
+		Export:       true,
+	}
+	p.AdmissionHighWatermark.Init(base.mgr)
+
+	p.AdmissionLowWatermark = ParamItem{
+		Key:          "queryNode.scheduler.admission.lowWatermark",
+		Version:      "2.4.0",
+		DefaultValue: "0.85",
+		Doc:          "ratio of memory in use to total memory, below which the read tasks are admitted again after the high watermark reached",
+		Export:       true,
+	}
+	p.AdmissionLowWatermark.Init(base.mgr)
+
+	p.AdmissionQueueTimeout = ParamItem{
+		Key:          "queryNode.scheduler.admission.queueTimeout",
+		Version:      "2.4.0",
+		DefaultValue: "1000",
+		Doc:          "max time in milliseconds a read task is queued for the memory headroom before rejected to be retried on another replica, 0 means rejected immediately",
+		Export:       true,
+	}
+	p.AdmissionQueueTimeout.Init(base.mgr)
+
 	p.CGOPoolSizeRatio = ParamItem{
 		Key:          "queryNode.segcore.cgoPoolSizeRatio",
 		Version:      "2.3.0",
@@ -2494,17 +3335,39 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 		Doc:          "filter ratio used for pruning segments when searching",
 	}
 	p.DefaultSegmentFilterRatio.Init(base.mgr)
+
+	p.EnablePartitionKeyPrune = ParamItem{
+		Key:          "queryNode.enablePartitionKeyPrune",
+		Version:      "2.4.0",
+		DefaultValue: "true",
+		Doc:          "skip the segments of the partitions not matching the partition keys in the filter expr on shard delegator",
+		Export:       true,
+	}
+	p.EnablePartitionKeyPrune.Init(base.mgr)
+
+	p.EnableScalarStatsPrune = ParamItem{
+		Key:          "queryNode.enableScalarStatsPrune",
+		Version:      "2.4.0",
+		DefaultValue: "true",
+		Doc:          "skip the sealed segments whose scalar field min/max not matching the range predicates in the filter expr on shard delegator",
+		Export:       true,
+	}
+	p.EnableScalarStatsPrune.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
 // --- datacoord ---
 type dataCoordConfig struct {
 	// --- CHANNEL ---
-	WatchTimeoutInterval         ParamItem `refreshable:"false"`
-	ChannelBalanceSilentDuration ParamItem `refreshable:"true"`
-	ChannelBalanceInterval       ParamItem `refreshable:"true"`
-	ChannelCheckInterval         ParamItem `refreshable:"true"`
-	ChannelOperationRPCTimeout   ParamItem `refreshable:"true"`
+	WatchTimeoutInterval          ParamItem `refreshable:"false"`
+	ChannelBalanceSilentDuration  ParamItem `refreshable:"true"`
+	ChannelBalanceInterval        ParamItem `refreshable:"true"`
+	ChannelCheckInterval          ParamItem `refreshable:"true"`
+	ChannelOperationRPCTimeout    ParamItem `refreshable:"true"`
+	ChannelGroups                 ParamItem `refreshable:"true"`
+	ChannelCPLagCheckInterval     ParamItem `refreshable:"false"`
+	ChannelCPLagThreshold         ParamItem `refreshable:"true"`
+	ChannelUnflushedRowsThreshold ParamItem `refreshable:"true"`
 
 	// --- SEGMENTS ---
 	SegmentMaxSize                 ParamItem `refreshable:"false"`
@@ -2539,6 +3402,10 @@ type dataCoordConfig struct {
 	SingleCompactionDeltalogMaxNum    ParamItem `refreshable:"true"`
 	GlobalCompactionInterval          ParamItem `refreshable:"false"`
 	ChannelCheckpointMaxLag           ParamItem `refreshable:"true"`
+	CompactionPolicies                ParamItem `refreshable:"true"`
+	CompactionMaxConcurrentSize       ParamItem `refreshable:"true"`
+	CompactionNodeMemoryRatio         ParamItem `refreshable:"true"`
+	CompactionNodeCPURatio            ParamItem `refreshable:"true"`
 
 	// LevelZero Segment
 	EnableLevelZeroSegment                   ParamItem `refreshable:"false"`
@@ -2552,6 +3419,7 @@ type dataCoordConfig struct {
 	GCInterval              ParamItem `refreshable:"false"`
 	GCMissingTolerance      ParamItem `refreshable:"false"`
 	GCDropTolerance         ParamItem `refreshable:"false"`
+	GCRestoreWindow         ParamItem `refreshable:"false"`
 	GCRemoveConcurrent      ParamItem `refreshable:"false"`
 	GCScanIntervalInHour    ParamItem `refreshable:"false"`
 	EnableActiveStandby     ParamItem `refreshable:"false"`
@@ -2563,6 +3431,14 @@ type dataCoordConfig struct {
 	IndexTaskSchedulerInterval ParamItem `refreshable:"false"`
 
 	MinSegmentNumRowsToEnableIndex ParamItem `refreshable:"true"`
+	PartitionedBuildMinRows        ParamItem `refreshable:"true"`
+	PartitionedBuildRows           ParamItem `refreshable:"true"`
+	IndexCacheEnabled              ParamItem `refreshable:"true"`
+	IndexCacheTTL                  ParamItem `refreshable:"true"`
+	IndexAdvisorAutoCreate         ParamItem `refreshable:"true"`
+	IndexAdvisorInterval           ParamItem `refreshable:"false"`
+	IndexAdvisorMinRows            ParamItem `refreshable:"true"`
+	IndexAdvisorLowCardinality     ParamItem `refreshable:"true"`
 	BrokerTimeout                  ParamItem `refreshable:"false"`
 
 	// auto balance channel on datanode
@@ -2577,7 +3453,31 @@ type dataCoordConfig struct {
 	ImportCheckIntervalHigh  ParamItem `refreshable:"true"`
 	ImportCheckIntervalLow   ParamItem `refreshable:"true"`
 	MaxFilesPerImportReq     ParamItem `refreshable:"true"`
+	MaxImportJobPerColl      ParamItem `refreshable:"true"`
+	MaxImportJobPerDB        ParamItem `refreshable:"true"`
+	MaxImportSizeInMB        ParamItem `refreshable:"true"`
 	WaitForIndex             ParamItem `refreshable:"true"`
+	ImportCompactionSegNum   ParamItem `refreshable:"true"`
+
+	IdempotencyWindow ParamItem `refreshable:"true"`
+
+	// replication
+	ReplicationEnabled         ParamItem `refreshable:"false"`
+	ReplicationInterval        ParamItem `refreshable:"true"`
+	ReplicationAddress         ParamItem `refreshable:"false"`
+	ReplicationBucketName      ParamItem `refreshable:"false"`
+	ReplicationRootPath        ParamItem `refreshable:"false"`
+	ReplicationAccessKeyID     ParamItem `refreshable:"false"`
+	ReplicationSecretAccessKey ParamItem `refreshable:"false"`
+
+	// cdc
+	CDCEnabled            ParamItem `refreshable:"false"`
+	CDCSink               ParamItem `refreshable:"false"`
+	CDCKafkaAddress       ParamItem `refreshable:"false"`
+	CDCKafkaTopicPrefix   ParamItem `refreshable:"false"`
+	CDCWebhookURL         ParamItem `refreshable:"false"`
+	CDCWebhookTimeout     ParamItem `refreshable:"true"`
+	CDCCheckpointInterval ParamItem `refreshable:"true"`
 
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 }
@@ -2628,6 +3528,45 @@ func (p *dataCoordConfig) init(base *BaseTable) {
 	}
 	p.ChannelOperationRPCTimeout.Init(base.mgr)
 
+	p.ChannelGroups = ParamItem{
+		Key:          "dataCoord.channel.groups",
+		Version:      "2.4.0",
+		DefaultValue: "{}",
+		Doc: `The exclusive channel groups in json, maps the group name to the comma separated collection IDs or virtual channel names,
+e.g. {"bulk": "448865387129513985,by-dev-rootcoord-dml_0_448865387129513986v0"}.
+The channels of a group are only watched by the datanodes declaring the group by dataNode.channelGroup,
+and the other channels are only watched by the datanodes without group.`,
+		Export: true,
+	}
+	p.ChannelGroups.Init(base.mgr)
+
+	p.ChannelCPLagCheckInterval = ParamItem{
+		Key:          "dataCoord.channel.checkpointLagCheckInterval",
+		Version:      "2.4.0",
+		DefaultValue: "30",
+		Doc:          "The interval in seconds to check the checkpoint lags of the channels",
+		Export:       true,
+	}
+	p.ChannelCPLagCheckInterval.Init(base.mgr)
+
+	p.ChannelCPLagThreshold = ParamItem{
+		Key:          "dataCoord.channel.checkpointLagThreshold",
+		Version:      "2.4.0",
+		DefaultValue: "600",
+		Doc:          "The channel is reported lagging once its checkpoint lags behind the wall clock more than this many seconds, 0 to disable",
+		Export:       true,
+	}
+	p.ChannelCPLagThreshold.Init(base.mgr)
+
+	p.ChannelUnflushedRowsThreshold = ParamItem{
+		Key:          "dataCoord.channel.unflushedRowsThreshold",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "The channel is reported lagging once the rows not covered by its checkpoint exceed this number, 0 to disable",
+		Export:       true,
+	}
+	p.ChannelUnflushedRowsThreshold.Init(base.mgr)
+
 	p.SegmentMaxSize = ParamItem{
 		Key:          "dataCoord.segment.maxSize",
 		Version:      "2.0.0",
@@ -2861,6 +3800,42 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.ChannelCheckpointMaxLag.Init(base.mgr)
 
+	p.CompactionPolicies = ParamItem{
+		Key:          "dataCoord.compaction.policies",
+		Version:      "2.4.0",
+		DefaultValue: "mix,l0",
+		Doc:          "comma separated names of the compaction policies applied to the collections, could be overridden by collection property collection.compaction.policies",
+		Export:       true,
+	}
+	p.CompactionPolicies.Init(base.mgr)
+
+	p.CompactionMaxConcurrentSize = ParamItem{
+		Key:          "dataCoord.compaction.maxConcurrentSize",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "max total size in MB of the compaction tasks executing concurrently in the cluster, 0 means no limit",
+		Export:       true,
+	}
+	p.CompactionMaxConcurrentSize.Init(base.mgr)
+
+	p.CompactionNodeMemoryRatio = ParamItem{
+		Key:          "dataCoord.compaction.nodeMemoryRatio",
+		Version:      "2.4.0",
+		DefaultValue: "0.5",
+		Doc:          "ratio of the datanode memory could be used by the executing compaction tasks",
+		Export:       true,
+	}
+	p.CompactionNodeMemoryRatio.Init(base.mgr)
+
+	p.CompactionNodeCPURatio = ParamItem{
+		Key:          "dataCoord.compaction.nodeCPURatio",
+		Version:      "2.4.0",
+		DefaultValue: "1.0",
+		Doc:          "max compaction tasks executing concurrently in the datanode per CPU core, the task number is still limited by workerMaxParallelTaskNum",
+		Export:       true,
+	}
+	p.CompactionNodeCPURatio.Init(base.mgr)
+
 	// LevelZeroCompaction
 	p.EnableLevelZeroSegment = ParamItem{
 		Key:          "dataCoord.segment.enableLevelZero",
@@ -2948,6 +3923,15 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.GCDropTolerance.Init(base.mgr)
 
+	p.GCRestoreWindow = ParamItem{
+		Key:          "dataCoord.gc.restoreWindow",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "duration in seconds the dropped segments and collections could be restored, which are not collected within it, 0 to disable the restore",
+		Export:       true,
+	}
+	p.GCRestoreWindow.Init(base.mgr)
+
 	p.GCRemoveConcurrent = ParamItem{
 		Key:          "dataCoord.gc.removeConcurrent",
 		Version:      "2.3.4",
@@ -2974,6 +3958,78 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.MinSegmentNumRowsToEnableIndex.Init(base.mgr)
 
+	p.PartitionedBuildMinRows = ParamItem{
+		Key:          "indexCoord.segment.partitionedBuildMinRows",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "The in-memory vector index of the segment with rows no less than this value is built by partitions across index nodes, 0 means disabled",
+		Export:       true,
+	}
+	p.PartitionedBuildMinRows.Init(base.mgr)
+
+	p.PartitionedBuildRows = ParamItem{
+		Key:          "indexCoord.segment.partitionedBuildRows",
+		Version:      "2.4.0",
+		DefaultValue: "10000000",
+		Doc:          "The max rows of each partition of the partitioned index build, split at the binlog boundaries",
+		Export:       true,
+	}
+	p.PartitionedBuildRows.Init(base.mgr)
+
+	p.IndexCacheEnabled = ParamItem{
+		Key:          "indexCoord.indexCache.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "Whether to reuse the index files of the same source binlogs and index params instead of building, e.g. for restored backups or re-created indexes",
+		Export:       true,
+	}
+	p.IndexCacheEnabled.Init(base.mgr)
+
+	p.IndexCacheTTL = ParamItem{
+		Key:          "indexCoord.indexCache.ttl",
+		Version:      "2.4.0",
+		DefaultValue: "86400",
+		Doc:          "The index cache entries and the index files they reference are recycled after this time, in seconds",
+		Export:       true,
+	}
+	p.IndexCacheTTL.Init(base.mgr)
+
+	p.IndexAdvisorAutoCreate = ParamItem{
+		Key:          "indexCoord.indexAdvisor.autoCreate",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "Whether to create the advised scalar indexes on the fields without index automatically",
+		Export:       true,
+	}
+	p.IndexAdvisorAutoCreate.Init(base.mgr)
+
+	p.IndexAdvisorInterval = ParamItem{
+		Key:          "indexCoord.indexAdvisor.interval",
+		Version:      "2.4.0",
+		DefaultValue: "600",
+		Doc:          "The interval to create the advised scalar indexes automatically, in seconds",
+		Export:       true,
+	}
+	p.IndexAdvisorInterval.Init(base.mgr)
+
+	p.IndexAdvisorMinRows = ParamItem{
+		Key:          "indexCoord.indexAdvisor.minRows",
+		Version:      "2.4.0",
+		DefaultValue: "100000",
+		Doc:          "The advised scalar index is created automatically only if the cardinality is collected from no less rows of the collection",
+		Export:       true,
+	}
+	p.IndexAdvisorMinRows.Init(base.mgr)
+
+	p.IndexAdvisorLowCardinality = ParamItem{
+		Key:          "indexCoord.indexAdvisor.lowCardinality",
+		Version:      "2.4.0",
+		DefaultValue: "1000",
+		Doc:          "The fields with no more distinct values are advised the inverted index, the others the sorted index for numbers or the trie index for strings",
+		Export:       true,
+	}
+	p.IndexAdvisorLowCardinality.Init(base.mgr)
+
 	p.BindIndexNodeMode = ParamItem{
 		Key:          "indexCoord.bindIndexNodeMode.enable",
 		Version:      "2.0.0",
@@ -3123,6 +4179,36 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.MaxFilesPerImportReq.Init(base.mgr)
 
+	p.MaxImportJobPerColl = ParamItem{
+		Key:          "dataCoord.import.maxConcurrentJobPerCollection",
+		Version:      "2.4.0",
+		Doc:          "The maximum number of import jobs of a collection running concurrently, the exceeded jobs are queued, 0 for no limit.",
+		DefaultValue: "4",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxImportJobPerColl.Init(base.mgr)
+
+	p.MaxImportJobPerDB = ParamItem{
+		Key:          "dataCoord.import.maxConcurrentJobPerDatabase",
+		Version:      "2.4.0",
+		Doc:          "The maximum number of import jobs of a database running concurrently, the exceeded jobs are queued, 0 for no limit.",
+		DefaultValue: "16",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxImportJobPerDB.Init(base.mgr)
+
+	p.MaxImportSizeInMB = ParamItem{
+		Key:          "dataCoord.import.maxConcurrentImportSizeInMB",
+		Version:      "2.4.0",
+		Doc:          "The maximum total file size in MB of the running import jobs, new jobs are queued once exceeded, 0 for no limit.",
+		DefaultValue: "0",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxImportSizeInMB.Init(base.mgr)
+
 	p.WaitForIndex = ParamItem{
 		Key:          "dataCoord.import.waitForIndex",
 		Version:      "2.4.0",
@@ -3133,6 +4219,166 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.WaitForIndex.Init(base.mgr)
 
+	p.ImportCompactionSegNum = ParamItem{
+		Key:          "dataCoord.import.compactionSmallSegmentNum",
+		Version:      "2.4.0",
+		Doc:          "The minimum number of small segments produced by an import job to trigger the compaction of the collection once the job completed, 0 to disable.",
+		DefaultValue: "4",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ImportCompactionSegNum.Init(base.mgr)
+
+	p.IdempotencyWindow = ParamItem{
+		Key:          "dataCoord.idempotency.window",
+		Version:      "2.4.0",
+		Doc:          "The duration in seconds the idempotency keys of the insert and import requests are kept to deduplicate the retries, 0 to disable the deduplication.",
+		DefaultValue: "86400",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.IdempotencyWindow.Init(base.mgr)
+
+	p.ReplicationEnabled = ParamItem{
+		Key:          "dataCoord.replication.enabled",
+		Version:      "2.4.0",
+		Doc:          "Whether to ship the flushed segments and the meta of the collections to the replica storage of the standby cluster.",
+		DefaultValue: "false",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ReplicationEnabled.Init(base.mgr)
+
+	p.ReplicationInterval = ParamItem{
+		Key:          "dataCoord.replication.interval",
+		Version:      "2.4.0",
+		Doc:          "The interval in seconds to ship the changes to the replica storage, which bounds the lag of the standby cluster.",
+		DefaultValue: "300",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ReplicationInterval.Init(base.mgr)
+
+	p.ReplicationAddress = ParamItem{
+		Key:          "dataCoord.replication.storage.address",
+		Version:      "2.4.0",
+		Doc:          "The address of the replica storage, the one of the cluster if empty.",
+		DefaultValue: "",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ReplicationAddress.Init(base.mgr)
+
+	p.ReplicationBucketName = ParamItem{
+		Key:          "dataCoord.replication.storage.bucketName",
+		Version:      "2.4.0",
+		Doc:          "The bucket of the replica storage, which shall be the bucket of the standby cluster, the replication is disabled if empty.",
+		DefaultValue: "",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ReplicationBucketName.Init(base.mgr)
+
+	p.ReplicationRootPath = ParamItem{
+		Key:          "dataCoord.replication.storage.rootPath",
+		Version:      "2.4.0",
+		Doc:          "The root path of the replica storage, which shall not be the root path of the standby cluster, or the replicated files are recycled by its gc.",
+		DefaultValue: "replica",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ReplicationRootPath.Init(base.mgr)
+
+	p.ReplicationAccessKeyID = ParamItem{
+		Key:          "dataCoord.replication.storage.accessKeyID",
+		Version:      "2.4.0",
+		Doc:          "The access key of the replica storage, the one of the cluster if empty.",
+		DefaultValue: "",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ReplicationAccessKeyID.Init(base.mgr)
+
+	p.ReplicationSecretAccessKey = ParamItem{
+		Key:          "dataCoord.replication.storage.secretAccessKey",
+		Version:      "2.4.0",
+		Doc:          "The secret key of the replica storage.",
+		DefaultValue: "",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ReplicationSecretAccessKey.Init(base.mgr)
+
+	p.CDCEnabled = ParamItem{
+		Key:          "dataCoord.cdc.enabled",
+		Version:      "2.4.0",
+		Doc:          "Whether to publish the inserts, deletes and ddls of the collections with the property collection.cdc.enabled to the cdc sink.",
+		DefaultValue: "false",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.CDCEnabled.Init(base.mgr)
+
+	p.CDCSink = ParamItem{
+		Key:          "dataCoord.cdc.sink",
+		Version:      "2.4.0",
+		Doc:          "The sink the change events published to, kafka or webhook.",
+		DefaultValue: "kafka",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.CDCSink.Init(base.mgr)
+
+	p.CDCKafkaAddress = ParamItem{
+		Key:          "dataCoord.cdc.kafka.address",
+		Version:      "2.4.0",
+		Doc:          "The brokers of the kafka sink, the events of a collection are published to the topic of the prefix and the collection id.",
+		DefaultValue: "",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.CDCKafkaAddress.Init(base.mgr)
+
+	p.CDCKafkaTopicPrefix = ParamItem{
+		Key:          "dataCoord.cdc.kafka.topicPrefix",
+		Version:      "2.4.0",
+		Doc:          "The prefix of the topics of the kafka sink.",
+		DefaultValue: "milvus-cdc-",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.CDCKafkaTopicPrefix.Init(base.mgr)
+
+	p.CDCWebhookURL = ParamItem{
+		Key:          "dataCoord.cdc.webhook.url",
+		Version:      "2.4.0",
+		Doc:          "The url the change events are posted to in json batches, the webhook shall respond 2xx once the events are accepted.",
+		DefaultValue: "",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.CDCWebhookURL.Init(base.mgr)
+
+	p.CDCWebhookTimeout = ParamItem{
+		Key:          "dataCoord.cdc.webhook.timeout",
+		Version:      "2.4.0",
+		Doc:          "The timeout in seconds of posting a batch of the change events to the webhook.",
+		DefaultValue: "10",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.CDCWebhookTimeout.Init(base.mgr)
+
+	p.CDCCheckpointInterval = ParamItem{
+		Key:          "dataCoord.cdc.checkpointInterval",
+		Version:      "2.4.0",
+		Doc:          "The interval in seconds to save the checkpoint of the channels without the change events, the events published are always checkpointed.",
+		DefaultValue: "10",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.CDCCheckpointInterval.Init(base.mgr)
+
 	p.GracefulStopTimeout = ParamItem{
 		Key:          "dataCoord.gracefulStopTimeout",
 		Version:      "2.3.7",
@@ -3148,9 +4394,16 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 type dataNodeConfig struct {
 	FlowGraphMaxQueueLength ParamItem `refreshable:"false"`
 	FlowGraphMaxParallelism ParamItem `refreshable:"false"`
+	FlowGraphParallelism    ParamItem `refreshable:"false"`
 	MaxParallelSyncTaskNum  ParamItem `refreshable:"false"`
 	MaxParallelSyncMgrTasks ParamItem `refreshable:"true"`
 
+	// adaptive sync
+	SyncAdaptiveEnabled          ParamItem `refreshable:"true"`
+	SyncAdaptiveLatencyThreshold ParamItem `refreshable:"true"`
+	SyncAdaptiveMaxBatchSize     ParamItem `refreshable:"true"`
+	SyncAdaptiveMaxBackoff       ParamItem `refreshable:"true"`
+
 	// skip mode
 	FlowGraphSkipModeEnable   ParamItem `refreshable:"true"`
 	FlowGraphSkipModeSkipNum  ParamItem `refreshable:"true"`
@@ -3161,6 +4414,14 @@ type dataNodeConfig struct {
 	FlushDeleteBufferBytes ParamItem `refreshable:"true"`
 	BinLogMaxSize          ParamItem `refreshable:"true"`
 	SyncPeriod             ParamItem `refreshable:"true"`
+	ScalarStatsEnabled     ParamItem `refreshable:"true"`
+	ScalarStatsBloomFilter ParamItem `refreshable:"true"`
+	ScalarStatsCardinality ParamItem `refreshable:"true"`
+	BinlogCompressionCodec ParamItem `refreshable:"true"`
+	BinlogCompressionLevel ParamItem `refreshable:"true"`
+	BinlogFormatVersion    ParamItem `refreshable:"true"`
+	BinlogPageRows         ParamItem `refreshable:"true"`
+	ParquetSegmentEnabled  ParamItem `refreshable:"true"`
 
 	// watchEvent
 	WatchEventTicklerInterval ParamItem `refreshable:"false"`
@@ -3171,11 +4432,16 @@ type dataNodeConfig struct {
 	// Concurrency to handle compaction file read
 	FileReadConcurrency ParamItem `refreshable:"false"`
 
+	CompactionVerifyEnabled  ParamItem `refreshable:"true"`
+	CompactionSortBufferSize ParamItem `refreshable:"true"`
+
 	// memory management
 	MemoryForceSyncEnable     ParamItem `refreshable:"true"`
 	MemoryForceSyncSegmentNum ParamItem `refreshable:"true"`
 	MemoryCheckInterval       ParamItem `refreshable:"true"`
 	MemoryWatermark           ParamItem `refreshable:"true"`
+	MemoryBufferPoolSize      ParamItem `refreshable:"true"`
+	MemorySpillEnable         ParamItem `refreshable:"true"`
 
 	DataNodeTimeTickByRPC ParamItem `refreshable:"false"`
 	// DataNode send timetick interval per collection
@@ -3186,6 +4452,7 @@ type dataNodeConfig struct {
 
 	// channel
 	ChannelWorkPoolSize ParamItem `refreshable:"true"`
+	ChannelGroup        ParamItem `refreshable:"false"`
 
 	UpdateChannelCheckpointMaxParallel   ParamItem `refreshable:"true"`
 	UpdateChannelCheckpointInterval      ParamItem `refreshable:"true"`
@@ -3222,6 +4489,15 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.FlowGraphMaxParallelism.Init(base.mgr)
 
+	p.FlowGraphParallelism = ParamItem{
+		Key:          "dataNode.dataSync.flowGraph.parallelism",
+		Version:      "2.4.0",
+		DefaultValue: "1",
+		Doc:          "Number of workers running the nodes of the flowgraph of each channel, the nodes are pipelined if above 1, could be tuned per channel at runtime",
+		Export:       true,
+	}
+	p.FlowGraphParallelism.Init(base.mgr)
+
 	p.FlowGraphSkipModeEnable = ParamItem{
 		Key:          "datanode.dataSync.skipMode.enable",
 		Version:      "2.3.4",
@@ -3270,6 +4546,42 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.MaxParallelSyncMgrTasks.Init(base.mgr)
 
+	p.SyncAdaptiveEnabled = ParamItem{
+		Key:          "dataNode.dataSync.adaptive.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "Whether to adapt the sync parallelism and the write batch size to the latency and the throttling of the object storage",
+		Export:       true,
+	}
+	p.SyncAdaptiveEnabled.Init(base.mgr)
+
+	p.SyncAdaptiveLatencyThreshold = ParamItem{
+		Key:          "dataNode.dataSync.adaptive.latencyThreshold",
+		Version:      "2.4.0",
+		DefaultValue: "1000",
+		Doc:          "The average PUT latency per MB written in milliseconds, above which the sync parallelism and the write batch size are reduced, the writes smaller than 1 MB are counted as 1 MB",
+		Export:       true,
+	}
+	p.SyncAdaptiveLatencyThreshold.Init(base.mgr)
+
+	p.SyncAdaptiveMaxBatchSize = ParamItem{
+		Key:          "dataNode.dataSync.adaptive.maxBatchSize",
+		Version:      "2.4.0",
+		DefaultValue: "16",
+		Doc:          "The max number of objects written to the object storage in one batch by a sync task",
+		Export:       true,
+	}
+	p.SyncAdaptiveMaxBatchSize.Init(base.mgr)
+
+	p.SyncAdaptiveMaxBackoff = ParamItem{
+		Key:          "dataNode.dataSync.adaptive.maxBackoff",
+		Version:      "2.4.0",
+		DefaultValue: "30",
+		Doc:          "The max backoff in seconds before writing to the object storage again once throttled",
+		Export:       true,
+	}
+	p.SyncAdaptiveMaxBackoff.Init(base.mgr)
+
 	p.FlushInsertBufferSize = ParamItem{
 		Key:          "dataNode.segment.insertBufSize",
 		Version:      "2.0.0",
@@ -3326,6 +4638,24 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.MemoryWatermark.Init(base.mgr)
 
+	p.MemoryBufferPoolSize = ParamItem{
+		Key:          "datanode.memory.bufferPoolSize",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "The global cap in bytes of the write buffers of all the channels on the datanode, the memory watermark is used if not positive",
+		Export:       true,
+	}
+	p.MemoryBufferPoolSize.Init(base.mgr)
+
+	p.MemorySpillEnable = ParamItem{
+		Key:          "datanode.memory.spill.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "Whether to spill the largest write buffers to the local disk rather than force sync them once the buffer pool exceeded",
+		Export:       true,
+	}
+	p.MemorySpillEnable.Init(base.mgr)
+
 	p.FlushDeleteBufferBytes = ParamItem{
 		Key:          "dataNode.segment.deleteBufBytes",
 		Version:      "2.0.0",
@@ -3351,6 +4681,96 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.SyncPeriod.Init(base.mgr)
 
+	p.ScalarStatsEnabled = ParamItem{
+		Key:          "dataNode.segment.scalarStats.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "true",
+		Doc:          "Whether to collect the min/max of the scalar fields into the segment meta when syncing and compacting segments, which are used to prune segments on range predicates.",
+		Export:       true,
+	}
+	p.ScalarStatsEnabled.Init(base.mgr)
+
+	p.ScalarStatsBloomFilter = ParamItem{
+		Key:          "dataNode.segment.scalarStats.bloomFilter",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "Whether to collect the bloom filters of the scalar fields as well, which are stored as the artifact logs of the segments and used to prune segments on equal and term predicates, the size of the bloom filters is determined by common.bloomFilterSize.",
+		Export:       true,
+	}
+	p.ScalarStatsBloomFilter.Init(base.mgr)
+
+	p.ScalarStatsCardinality = ParamItem{
+		Key:          "dataNode.segment.scalarStats.cardinality",
+		Version:      "2.4.0",
+		DefaultValue: "true",
+		Doc:          "Whether to collect the cardinality sketches of the scalar fields into the segment meta as well, which are used to advise the scalar index types.",
+		Export:       true,
+	}
+	p.ScalarStatsCardinality.Init(base.mgr)
+
+	p.BinlogCompressionCodec = ParamItem{
+		Key:          "dataNode.segment.binlog.compression.codec",
+		Version:      "2.4.0",
+		DefaultValue: "zstd",
+		Doc:          "The codec to compress the insert and delta binlogs with, options: zstd, snappy, none. The codec is recorded in the binlog meta, the binlogs written with the other codecs remain readable.",
+		Export:       true,
+	}
+	p.BinlogCompressionCodec.Init(base.mgr)
+
+	p.BinlogCompressionLevel = ParamItem{
+		Key:          "dataNode.segment.binlog.compression.level",
+		Version:      "2.4.0",
+		DefaultValue: "3",
+		Doc:          "The compression level of zstd, from 1 to 22, the higher level trades the cpu for the smaller binlogs.",
+		Export:       true,
+	}
+	p.BinlogCompressionLevel.Init(base.mgr)
+
+	p.BinlogFormatVersion = ParamItem{
+		Key:          "dataNode.segment.binlog.formatVersion",
+		Version:      "2.4.0",
+		DefaultValue: "1",
+		Doc:          "The format version of the insert binlogs to write, options: 1, 2. The version 2 binlogs are split into pages with the page index, page statistics and checksums, which remain readable by the readers of version 1.",
+		Export:       true,
+	}
+	p.BinlogFormatVersion.Init(base.mgr)
+
+	p.BinlogPageRows = ParamItem{
+		Key:          "dataNode.segment.binlog.pageRows",
+		Version:      "2.4.0",
+		DefaultValue: "8192",
+		Doc:          "Max number of rows of a page in the version 2 insert binlogs.",
+		Export:       true,
+	}
+	p.BinlogPageRows.Init(base.mgr)
+
+	p.ParquetSegmentEnabled = ParamItem{
+		Key:          "dataNode.segment.parquet.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "Whether to store the synced rows as parquet files of the field groups as well, one file of all the scalar fields and one file of each vector field, which could be scanned by the external engines in place. The deletes are not applied to the parquet files, the deleted rows remain in them until the segment compacted, so the external engines shall apply the deltalogs of the segment to exclude them.",
+		Export:       true,
+	}
+	p.ParquetSegmentEnabled.Init(base.mgr)
+
+	p.CompactionVerifyEnabled = ParamItem{
+		Key:          "dataNode.compaction.verify",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "Whether to read back the compacted segment and verify its row count, primary key uniqueness and checksum against the inputs before reporting the compaction completed.",
+		Export:       true,
+	}
+	p.CompactionVerifyEnabled.Init(base.mgr)
+
+	p.CompactionSortBufferSize = ParamItem{
+		Key:          "dataNode.compaction.sortBufferSize",
+		Version:      "2.4.0",
+		DefaultValue: "268435456",
+		Doc:          "The memory size in bytes of the rows buffered when compaction sorts them by the clustering key, the sorted rows are spilled to the local disk and merged once exceeds.",
+		Export:       true,
+	}
+	p.CompactionSortBufferSize.Init(base.mgr)
+
 	p.WatchEventTicklerInterval = ParamItem{
 		Key:          "datanode.segment.watchEventTicklerInterval",
 		Version:      "2.2.3",
@@ -3404,6 +4824,15 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.ChannelWorkPoolSize.Init(base.mgr)
 
+	p.ChannelGroup = ParamItem{
+		Key:          "dataNode.channelGroup",
+		Version:      "2.4.0",
+		DefaultValue: "",
+		Doc:          "The exclusive channel group of the datanode, only the channels of the group configured by dataCoord.channel.groups are watched by the datanode",
+		Export:       true,
+	}
+	p.ChannelGroup.Init(base.mgr)
+
 	p.UpdateChannelCheckpointMaxParallel = ParamItem{
 		Key:          "datanode.channel.updateChannelCheckpointMaxParallel",
 		Version:      "2.3.4",
@@ -3486,7 +4915,12 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 // /////////////////////////////////////////////////////////////////////////////
 // --- indexnode ---
 type indexNodeConfig struct {
-	BuildParallel ParamItem `refreshable:"false"`
+	BuildParallel     ParamItem  `refreshable:"false"`
+	SmallTaskParallel ParamItem  `refreshable:"false"`
+	SmallTaskMaxRows  ParamItem  `refreshable:"true"`
+	EnablePreemption  ParamItem  `refreshable:"true"`
+	BuildLimitEnabled ParamItem  `refreshable:"true"`
+	BuildLimits       ParamGroup `refreshable:"true"`
 	// enable disk
 	EnableDisk             ParamItem `refreshable:"false"`
 	DiskCapacityLimit      ParamItem `refreshable:"true"`
@@ -3504,6 +4938,50 @@ func (p *indexNodeConfig) init(base *BaseTable) {
 	}
 	p.BuildParallel.Init(base.mgr)
 
+	p.SmallTaskParallel = ParamItem{
+		Key:          "indexNode.scheduler.smallTaskParallel",
+		Version:      "2.4.0",
+		DefaultValue: "1",
+		Doc:          "extra build slots only for the small tasks, so that they aren't stuck behind the builds of huge segments",
+		Export:       true,
+	}
+	p.SmallTaskParallel.Init(base.mgr)
+
+	p.SmallTaskMaxRows = ParamItem{
+		Key:          "indexNode.scheduler.smallTaskMaxRows",
+		Version:      "2.4.0",
+		DefaultValue: "100000",
+		Doc:          "max number of rows of the segment for its index task to be small, 0 means disabled",
+		Export:       true,
+	}
+	p.SmallTaskMaxRows.Init(base.mgr)
+
+	p.EnablePreemption = ParamItem{
+		Key:          "indexNode.scheduler.enablePreemption",
+		Version:      "2.4.0",
+		DefaultValue: "true",
+		Doc:          "run the waiting small tasks between the stages of the disk index builds, the finished stages are kept",
+		Export:       true,
+	}
+	p.EnablePreemption.Init(base.mgr)
+
+	p.BuildLimitEnabled = ParamItem{
+		Key:          "indexNode.buildLimit.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether to limit the memory and CPU cores taken by the concurrent builds of each index type",
+		Export:       true,
+	}
+	p.BuildLimitEnabled.Init(base.mgr)
+
+	p.BuildLimits = ParamGroup{
+		KeyPrefix: "indexNode.buildLimit.indexTypes.",
+		Version:   "2.4.0",
+		Doc: `limits of the concurrent builds of the index type, like indexTypes.<index type>.maxMemoryRatio,
+the ratios are of the memory and CPU cores of the index node, non-positive means no limit`,
+	}
+	p.BuildLimits.Init(base.mgr)
+
 	p.EnableDisk = ParamItem{
 		Key:          "indexNode.enableDisk",
 		Version:      "2.2.0",
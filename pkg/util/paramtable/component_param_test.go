@@ -101,6 +101,13 @@ func TestComponentParam(t *testing.T) {
 		params.Save("common.security.superUsers", "")
 		assert.Equal(t, []string{""}, Params.SuperUsers.GetAsStrings())
 
+		assert.False(t, Params.OIDCEnabled.GetAsBool())
+		assert.Equal(t, "sub", Params.OIDCUsernameClaim.GetValue())
+		assert.Equal(t, "groups", Params.OIDCRolesClaim.GetValue())
+		assert.Equal(t, time.Hour, Params.OIDCJWKSRefreshInterval.GetAsDuration(time.Second))
+		params.Save("common.security.oidc.roleMapping", `{"milvus-admins": "admin"}`)
+		assert.Equal(t, map[string]string{"milvus-admins": "admin"}, Params.OIDCRoleMapping.GetAsJSONMap())
+
 		assert.Equal(t, false, Params.PreCreatedTopicEnabled.GetAsBool())
 
 		params.Save("common.preCreatedTopic.names", "topic1,topic2,topic3")
@@ -108,6 +115,8 @@ func TestComponentParam(t *testing.T) {
 
 		params.Save("common.preCreatedTopic.timeticker", "timeticker")
 		assert.Equal(t, []string{"timeticker"}, Params.TimeTicker.GetAsStrings())
+
+		assert.False(t, Params.MetaCacheWatchEnabled.GetAsBool())
 	})
 
 	t.Run("test rootCoordConfig", func(t *testing.T) {
@@ -123,6 +132,12 @@ func TestComponentParam(t *testing.T) {
 		params.Save("rootCoord.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
 
+		assert.True(t, Params.TimeTickMonitorEnabled.GetAsBool())
+		assert.Equal(t, 10*time.Second, Params.TimeTickMonitorCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 60*time.Second, Params.TimeTickStallThreshold.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Second, Params.TimeTickSkewThreshold.GetAsDuration(time.Second))
+		assert.False(t, Params.TimeTickAutoFence.GetAsBool())
+
 		SetCreateTime(time.Now())
 		SetUpdateTime(time.Now())
 	})
@@ -156,9 +171,15 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("AccessLog.MaxDays: %d", Params.AccessLog.RotatedTime.GetAsInt64())
 
+		assert.False(t, Params.AuditLog.Enable.GetAsBool())
+		assert.Equal(t, "file", Params.AuditLog.Sink.GetValue())
+		assert.Equal(t, 1.0, Params.AuditLog.SampleRate.GetAsFloat())
+		assert.Empty(t, Params.AuditLog.Methods.GetValue())
+		assert.False(t, Params.AuditLog.BlockOnFull.GetAsBool())
+
 		t.Logf("ShardLeaderCacheInterval: %d", Params.ShardLeaderCacheInterval.GetAsInt64())
 
-		assert.Equal(t, Params.ReplicaSelectionPolicy.GetValue(), "look_aside")
+		assert.Equal(t, Params.ReplicaSelectionPolicy.GetValue(), "adaptive")
 		params.Save(Params.ReplicaSelectionPolicy.Key, "round_robin")
 		assert.Equal(t, Params.ReplicaSelectionPolicy.GetValue(), "round_robin")
 		params.Save(Params.ReplicaSelectionPolicy.Key, "look_aside")
@@ -170,6 +191,24 @@ func TestComponentParam(t *testing.T) {
 
 		params.Save("proxy.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
+
+		assert.False(t, Params.TSOBatchEnabled.GetAsBool())
+		assert.Equal(t, 1000, Params.TSOBatchSize.GetAsInt())
+		assert.Equal(t, 50*time.Millisecond, Params.TSOBatchWindow.GetAsDuration(time.Millisecond))
+		assert.Equal(t, time.Second, Params.TSOBatchMaxClockSkew.GetAsDuration(time.Millisecond))
+
+		assert.Equal(t, 3.0, Params.EjectionLatencyRatio.GetAsFloat())
+		assert.Equal(t, 100*time.Millisecond, Params.EjectionMinLatency.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 5*time.Second, Params.EjectionDuration.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 0.5, Params.MaxEjectionRatio.GetAsFloat())
+
+		assert.False(t, Params.HedgedReadEnabled.GetAsBool())
+		assert.Equal(t, 0.95, Params.HedgedReadPercentile.GetAsFloat())
+		assert.Equal(t, 10*time.Millisecond, Params.HedgedReadMinDelay.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 0.1, Params.HedgedReadBudget.GetAsFloat())
+		assert.Equal(t, 0.8, Params.PartialResultTimeoutRatio.GetAsFloat())
+		assert.Equal(t, 128, Params.PreparedExprCacheSize.GetAsInt())
+		assert.Empty(t, Params.ModelRankerEndpoints.GetValue())
 	})
 
 	// t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -240,6 +279,11 @@ func TestComponentParam(t *testing.T) {
 		Params := &params.QueryCoordCfg
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("queryCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
+		assert.False(t, Params.EnableStandbyWarmup.GetAsBool())
+		assert.False(t, Params.EnableLeaderBalance.GetAsBool())
+		assert.Equal(t, 2, Params.LeaderBalanceCountThreshold.GetAsInt())
+		assert.Equal(t, 2.0, Params.LeaderBalanceQPSFactor.GetAsFloat())
+		assert.Equal(t, 30000, Params.LeaderBalanceCheckInterval.GetAsInt())
 
 		params.Save("queryCoord.NextTargetSurviveTime", "100")
 		NextTargetSurviveTime := &Params.NextTargetSurviveTime
@@ -318,6 +362,41 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, int64(16), nprobe)
 
 		assert.Equal(t, true, Params.GroupEnabled.GetAsBool())
+		assert.False(t, Params.DeleteBufferSpillEnabled.GetAsBool())
+		assert.Equal(t, int64(64*1024*1024), Params.DeleteBufferMemoryLimit.GetAsInt64())
+		assert.Equal(t, "binary", Params.DeleteBufferSpillFormat.GetValue())
+		assert.Equal(t, 16, Params.DeleteBufferSpillCompactThreshold.GetAsInt())
+
+		assert.Equal(t, 30*time.Second, Params.LazyLoadWaitTimeout.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 1.0, Params.LazyLoadCacheCapacityRatio.GetAsFloat())
+
+		assert.False(t, Params.TieringEnabled.GetAsBool())
+		assert.Equal(t, 30*time.Minute, Params.TieringIdleTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, time.Minute, Params.TieringCheckInterval.GetAsDuration(time.Second))
+
+		assert.Equal(t, time.Minute, Params.DiskCacheRefreshInterval.GetAsDuration(time.Second))
+		assert.Equal(t, time.Minute, Params.DiskCacheVerifyInterval.GetAsDuration(time.Second))
+		assert.EqualValues(t, 256*1024*1024, Params.DiskCacheVerifyBatchSize.GetAsInt64())
+
+		assert.Equal(t, 16, Params.StandingQueryMaxNum.GetAsInt())
+		assert.Equal(t, 1024, Params.StandingQueryBufferSize.GetAsInt())
+
+		assert.False(t, Params.ResultCacheEnabled.GetAsBool())
+		assert.Equal(t, time.Minute, Params.ResultCacheTTL.GetAsDuration(time.Second))
+		assert.Equal(t, 1024, Params.ResultCacheCapacity.GetAsInt())
+
+		assert.False(t, Params.ForwardDeleteBatchEnabled.GetAsBool())
+		assert.Equal(t, 100*time.Millisecond, Params.ForwardDeleteFlushInterval.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 100000, Params.ForwardDeleteFlushSize.GetAsInt())
+		assert.True(t, Params.ForwardDeleteCompression.GetAsBool())
+		assert.Equal(t, int64(0), Params.RequestMaxMemorySize.GetAsInt64())
+		assert.Equal(t, int64(0), Params.RequestMaxCPUTime.GetAsInt64())
+		assert.False(t, Params.PriorityLaneEnabled.GetAsBool())
+		assert.Equal(t, 8, Params.PriorityLaneInteractiveWeight.GetAsInt())
+		assert.False(t, Params.AdmissionEnabled.GetAsBool())
+		assert.Equal(t, 0.9, Params.AdmissionHighWatermark.GetAsFloat())
+		assert.Equal(t, 0.85, Params.AdmissionLowWatermark.GetAsFloat())
+		assert.Equal(t, 1000, Params.AdmissionQueueTimeout.GetAsInt())
 		assert.Equal(t, int32(10240), Params.MaxReceiveChanSize.GetAsInt32())
 		assert.Equal(t, int32(10240), Params.MaxUnsolvedQueueSize.GetAsInt32())
 		assert.Equal(t, 10.0, Params.CPURatio.GetAsFloat())
@@ -326,6 +405,17 @@ func TestComponentParam(t *testing.T) {
 		// chunk cache
 		assert.Equal(t, "willneed", Params.ReadAheadPolicy.GetValue())
 		assert.Equal(t, "async", Params.ChunkCacheWarmingUp.GetValue())
+		assert.False(t, Params.SegmentWarmupEnabled.GetAsBool())
+		assert.Equal(t, 100.0, Params.SegmentWarmupRatio.GetAsFloat())
+
+		assert.True(t, Params.EnablePartitionKeyPrune.GetAsBool())
+		assert.True(t, Params.EnableScalarStatsPrune.GetAsBool())
+		assert.Equal(t, 30*time.Second, Params.QueryDrainTimeout.GetAsDuration(time.Second))
+
+		assert.False(t, Params.InterimIndexAsyncBuild.GetAsBool())
+		assert.Equal(t, int64(10000), Params.InterimIndexAsyncBuildMinRows.GetAsInt64())
+		assert.Equal(t, 5*time.Second, Params.InterimIndexAsyncBuildInterval.GetAsDuration(time.Second))
+		assert.Equal(t, int64(0), Params.ExprResCacheSize.GetAsInt64())
 
 		// test small indexNlist/NProbe default
 		params.Remove("queryNode.segcore.smallIndex.nlist")
@@ -370,6 +460,10 @@ func TestComponentParam(t *testing.T) {
 	t.Run("test dataCoordConfig", func(t *testing.T) {
 		Params := &params.DataCoordCfg
 		assert.Equal(t, 24*60*60*time.Second, Params.SegmentMaxLifetime.GetAsDuration(time.Second))
+		assert.Empty(t, Params.ChannelGroups.GetAsJSONMap())
+		assert.Equal(t, 30*time.Second, Params.ChannelCPLagCheckInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 600*time.Second, Params.ChannelCPLagThreshold.GetAsDuration(time.Second))
+		assert.Equal(t, int64(0), Params.ChannelUnflushedRowsThreshold.GetAsInt64())
 		assert.True(t, Params.EnableGarbageCollection.GetAsBool())
 		assert.Equal(t, Params.EnableActiveStandby.GetAsBool(), false)
 		t.Logf("dataCoord EnableActiveStandby = %t", Params.EnableActiveStandby.GetAsBool())
@@ -384,7 +478,34 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 2*time.Second, Params.ImportCheckIntervalHigh.GetAsDuration(time.Second))
 		assert.Equal(t, 120*time.Second, Params.ImportCheckIntervalLow.GetAsDuration(time.Second))
 		assert.Equal(t, 1024, Params.MaxFilesPerImportReq.GetAsInt())
+		assert.Equal(t, 4, Params.MaxImportJobPerColl.GetAsInt())
+		assert.Equal(t, 16, Params.MaxImportJobPerDB.GetAsInt())
+		assert.Equal(t, 0, Params.MaxImportSizeInMB.GetAsInt())
 		assert.Equal(t, true, Params.WaitForIndex.GetAsBool())
+		assert.Equal(t, 24*time.Hour, Params.IdempotencyWindow.GetAsDuration(time.Second))
+		assert.False(t, Params.ReplicationEnabled.GetAsBool())
+		assert.Equal(t, 5*time.Minute, Params.ReplicationInterval.GetAsDuration(time.Second))
+		assert.Equal(t, "", Params.ReplicationBucketName.GetValue())
+		assert.Equal(t, "replica", Params.ReplicationRootPath.GetValue())
+		assert.False(t, Params.CDCEnabled.GetAsBool())
+		assert.Equal(t, "kafka", Params.CDCSink.GetValue())
+		assert.Equal(t, "milvus-cdc-", Params.CDCKafkaTopicPrefix.GetValue())
+		assert.Equal(t, 10*time.Second, Params.CDCWebhookTimeout.GetAsDuration(time.Second))
+		assert.Equal(t, 10*time.Second, Params.CDCCheckpointInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 4, Params.ImportCompactionSegNum.GetAsInt())
+		assert.Equal(t, []string{"mix", "l0"}, Params.CompactionPolicies.GetAsStrings())
+		assert.Equal(t, int64(0), Params.CompactionMaxConcurrentSize.GetAsInt64())
+		assert.Equal(t, 0.5, Params.CompactionNodeMemoryRatio.GetAsFloat())
+		assert.Equal(t, 1.0, Params.CompactionNodeCPURatio.GetAsFloat())
+		assert.Equal(t, time.Duration(0), Params.GCRestoreWindow.GetAsDuration(time.Second))
+		assert.Equal(t, int64(0), Params.PartitionedBuildMinRows.GetAsInt64())
+		assert.Equal(t, int64(10000000), Params.PartitionedBuildRows.GetAsInt64())
+		assert.False(t, Params.IndexCacheEnabled.GetAsBool())
+		assert.Equal(t, 24*time.Hour, Params.IndexCacheTTL.GetAsDuration(time.Second))
+		assert.False(t, Params.IndexAdvisorAutoCreate.GetAsBool())
+		assert.Equal(t, 10*time.Minute, Params.IndexAdvisorInterval.GetAsDuration(time.Second))
+		assert.Equal(t, int64(100000), Params.IndexAdvisorMinRows.GetAsInt64())
+		assert.Equal(t, int64(1000), Params.IndexAdvisorLowCardinality.GetAsInt64())
 
 		params.Save("datacoord.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
@@ -422,10 +543,27 @@ func TestComponentParam(t *testing.T) {
 		period := &Params.SyncPeriod
 		t.Logf("SyncPeriod: %v", period)
 		assert.Equal(t, 10*time.Minute, Params.SyncPeriod.GetAsDuration(time.Second))
+		assert.True(t, Params.ScalarStatsEnabled.GetAsBool())
+		assert.False(t, Params.ScalarStatsBloomFilter.GetAsBool())
+		assert.True(t, Params.ScalarStatsCardinality.GetAsBool())
+		assert.Equal(t, "zstd", Params.BinlogCompressionCodec.GetValue())
+		assert.Equal(t, 3, Params.BinlogCompressionLevel.GetAsInt())
+		assert.Equal(t, 1, Params.BinlogFormatVersion.GetAsInt())
+		assert.Equal(t, 8192, Params.BinlogPageRows.GetAsInt())
+		assert.False(t, Params.ParquetSegmentEnabled.GetAsBool())
+		assert.False(t, Params.CompactionVerifyEnabled.GetAsBool())
+		assert.Equal(t, int64(268435456), Params.CompactionSortBufferSize.GetAsInt64())
+		assert.False(t, Params.SyncAdaptiveEnabled.GetAsBool())
+		assert.Equal(t, int64(0), Params.MemoryBufferPoolSize.GetAsInt64())
+		assert.False(t, Params.MemorySpillEnable.GetAsBool())
+		assert.Equal(t, 1000, Params.SyncAdaptiveLatencyThreshold.GetAsInt())
+		assert.Equal(t, 16, Params.SyncAdaptiveMaxBatchSize.GetAsInt())
+		assert.Equal(t, 30*time.Second, Params.SyncAdaptiveMaxBackoff.GetAsDuration(time.Second))
 
 		channelWorkPoolSize := Params.ChannelWorkPoolSize.GetAsInt()
 		t.Logf("channelWorkPoolSize: %d", channelWorkPoolSize)
 		assert.Equal(t, -1, Params.ChannelWorkPoolSize.GetAsInt())
+		assert.Equal(t, "", Params.ChannelGroup.GetValue())
 
 		updateChannelCheckpointMaxParallel := Params.UpdateChannelCheckpointMaxParallel.GetAsInt()
 		t.Logf("updateChannelCheckpointMaxParallel: %d", updateChannelCheckpointMaxParallel)
@@ -448,6 +586,15 @@ func TestComponentParam(t *testing.T) {
 
 		params.Save("indexnode.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
+
+		assert.Equal(t, 1, Params.SmallTaskParallel.GetAsInt())
+		assert.Equal(t, int64(100000), Params.SmallTaskMaxRows.GetAsInt64())
+		assert.True(t, Params.EnablePreemption.GetAsBool())
+
+		assert.False(t, Params.BuildLimitEnabled.GetAsBool())
+		assert.Empty(t, Params.BuildLimits.GetValue())
+		params.SaveGroup(map[string]string{Params.BuildLimits.KeyPrefix + "INVERTED.maxMemoryRatio": "0.2"})
+		assert.Equal(t, map[string]string{"inverted.maxmemoryratio": "0.2"}, Params.BuildLimits.GetValue())
 	})
 
 	t.Run("channel config priority", func(t *testing.T) {
@@ -482,6 +629,7 @@ func TestCachedParam(t *testing.T) {
 
 	assert.Equal(t, int32(16), params.DataNodeCfg.FlowGraphMaxQueueLength.GetAsInt32())
 	assert.Equal(t, int32(16), params.DataNodeCfg.FlowGraphMaxQueueLength.GetAsInt32())
+	assert.Equal(t, 1, params.DataNodeCfg.FlowGraphParallelism.GetAsInt())
 
 	assert.Equal(t, uint(100000), params.CommonCfg.BloomFilterSize.GetAsUint())
 	assert.Equal(t, uint(100000), params.CommonCfg.BloomFilterSize.GetAsUint())
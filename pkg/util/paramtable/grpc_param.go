@@ -138,6 +138,7 @@ type GrpcServerConfig struct {
 	ServerMaxRecvSize ParamItem `refreshable:"false"`
 
 	GracefulStopTimeout ParamItem `refreshable:"true"`
+	ReflectionEnabled   ParamItem `refreshable:"false"`
 }
 
 func (p *GrpcServerConfig) Init(domain string, base *BaseTable) {
@@ -195,6 +196,16 @@ func (p *GrpcServerConfig) Init(domain string, base *BaseTable) {
 		Export:       true,
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
+
+	p.ReflectionEnabled = ParamItem{
+		Key:          p.Domain + ".grpc.reflectionEnabled",
+		Version:      "2.4.0",
+		DefaultValue: "true",
+		FallbackKeys: []string{"grpc.reflectionEnabled"},
+		Doc:          "whether to register the grpc server reflection, which lists the services and methods for the grpc tools",
+		Export:       true,
+	}
+	p.ReflectionEnabled.Init(base.mgr)
 }
 
 // GrpcClientConfig is configuration for grpc client.
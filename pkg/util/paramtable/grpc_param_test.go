@@ -64,6 +64,10 @@ func TestGrpcServerParams(t *testing.T) {
 
 	base.Save(serverConfig.GracefulStopTimeout.Key, "1")
 	assert.Equal(t, serverConfig.GracefulStopTimeout.GetAsInt(), 1)
+
+	assert.True(t, serverConfig.ReflectionEnabled.GetAsBool())
+	base.Save(serverConfig.ReflectionEnabled.Key, "false")
+	assert.False(t, serverConfig.ReflectionEnabled.GetAsBool())
 }
 
 func TestGrpcClientParams(t *testing.T) {
@@ -99,6 +99,16 @@ type quotaConfig struct {
 	MaxQueryResultWindow  ParamItem `refreshable:"true"`
 	MaxOutputSize         ParamItem `refreshable:"true"`
 
+	// user and connection level limits
+	UserLimitEnabled         ParamItem  `refreshable:"true"`
+	UserMaxQPS               ParamItem  `refreshable:"true"`
+	UserMaxConcurrency       ParamItem  `refreshable:"true"`
+	UserMaxResultRate        ParamItem  `refreshable:"true"`
+	UserRoleLimits           ParamGroup `refreshable:"true"`
+	ConnectionMaxQPS         ParamItem  `refreshable:"true"`
+	ConnectionMaxConcurrency ParamItem  `refreshable:"true"`
+	ConnectionMaxResultRate  ParamItem  `refreshable:"true"`
+
 	// limit writing
 	ForceDenyWriting                     ParamItem `refreshable:"true"`
 	TtProtectionEnabled                  ParamItem `refreshable:"true"`
@@ -893,6 +903,77 @@ Check https://milvus.io/docs/limitations.md for more details.`,
 	}
 	p.MaxOutputSize.Init(base.mgr)
 
+	p.UserLimitEnabled = ParamItem{
+		Key:          "quotaAndLimits.limits.user.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc:          "whether to limit the requests per user and per connection in proxy",
+		Export:       true,
+	}
+	p.UserLimitEnabled.Init(base.mgr)
+
+	p.UserMaxQPS = ParamItem{
+		Key:          "quotaAndLimits.limits.user.maxQPS",
+		Version:      "2.4.0",
+		DefaultValue: "-1",
+		Doc:          "maximum requests per second of each user in one proxy, non-positive means no limit",
+		Export:       true,
+	}
+	p.UserMaxQPS.Init(base.mgr)
+
+	p.UserMaxConcurrency = ParamItem{
+		Key:          "quotaAndLimits.limits.user.maxConcurrency",
+		Version:      "2.4.0",
+		DefaultValue: "-1",
+		Doc:          "maximum concurrent requests of each user in one proxy, non-positive means no limit",
+		Export:       true,
+	}
+	p.UserMaxConcurrency.Init(base.mgr)
+
+	p.UserMaxResultRate = ParamItem{
+		Key:          "quotaAndLimits.limits.user.maxResultRate",
+		Version:      "2.4.0",
+		DefaultValue: "-1",
+		Doc:          "maximum search/query result size per second of each user in one proxy, MB/s, non-positive means no limit",
+		Export:       true,
+	}
+	p.UserMaxResultRate.Init(base.mgr)
+
+	p.UserRoleLimits = ParamGroup{
+		KeyPrefix: "quotaAndLimits.limits.user.roles.",
+		Version:   "2.4.0",
+		Doc: `limits of the users granted the role, overrides the default user limits, like roles.<role>.maxQPS,
+the most permissive one applies if the user is granted multiple roles`,
+	}
+	p.UserRoleLimits.Init(base.mgr)
+
+	p.ConnectionMaxQPS = ParamItem{
+		Key:          "quotaAndLimits.limits.connection.maxQPS",
+		Version:      "2.4.0",
+		DefaultValue: "-1",
+		Doc:          "maximum requests per second of each client connection, non-positive means no limit",
+		Export:       true,
+	}
+	p.ConnectionMaxQPS.Init(base.mgr)
+
+	p.ConnectionMaxConcurrency = ParamItem{
+		Key:          "quotaAndLimits.limits.connection.maxConcurrency",
+		Version:      "2.4.0",
+		DefaultValue: "-1",
+		Doc:          "maximum concurrent requests of each client connection, non-positive means no limit",
+		Export:       true,
+	}
+	p.ConnectionMaxConcurrency.Init(base.mgr)
+
+	p.ConnectionMaxResultRate = ParamItem{
+		Key:          "quotaAndLimits.limits.connection.maxResultRate",
+		Version:      "2.4.0",
+		DefaultValue: "-1",
+		Doc:          "maximum search/query result size per second of each client connection, MB/s, non-positive means no limit",
+		Export:       true,
+	}
+	p.ConnectionMaxResultRate.Init(base.mgr)
+
 	// limit writing
 	p.ForceDenyWriting = ParamItem{
 		Key:          "quotaAndLimits.limitWriting.forceDeny",
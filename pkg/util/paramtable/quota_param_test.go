@@ -179,6 +179,20 @@ func TestQuotaParam(t *testing.T) {
 		assert.Equal(t, 65536, qc.MaxCollectionNumPerDB.GetAsInt())
 	})
 
+	t.Run("test user limits", func(t *testing.T) {
+		assert.False(t, qc.UserLimitEnabled.GetAsBool())
+		assert.Equal(t, float64(-1), qc.UserMaxQPS.GetAsFloat())
+		assert.Equal(t, int64(-1), qc.UserMaxConcurrency.GetAsInt64())
+		assert.Equal(t, float64(-1), qc.UserMaxResultRate.GetAsFloat())
+		assert.Equal(t, float64(-1), qc.ConnectionMaxQPS.GetAsFloat())
+		assert.Equal(t, int64(-1), qc.ConnectionMaxConcurrency.GetAsInt64())
+		assert.Equal(t, float64(-1), qc.ConnectionMaxResultRate.GetAsFloat())
+		assert.Empty(t, qc.UserRoleLimits.GetValue())
+
+		baseParams.SaveGroup(map[string]string{qc.UserRoleLimits.KeyPrefix + "admin.maxQPS": "100"})
+		assert.Equal(t, map[string]string{"admin.maxqps": "100"}, qc.UserRoleLimits.GetValue())
+	})
+
 	t.Run("test limit writing", func(t *testing.T) {
 		assert.False(t, qc.ForceDenyWriting.GetAsBool())
 		assert.Equal(t, false, qc.TtProtectionEnabled.GetAsBool())
@@ -25,6 +25,12 @@ func TestServiceParam(t *testing.T) {
 	var SParams ServiceParam
 	bt := NewBaseTable(SkipRemote(true))
 	SParams.init(bt)
+	t.Run("test localStorageConfig", func(t *testing.T) {
+		Params := &SParams.LocalStorageCfg
+		assert.Greater(t, Params.DiskCapacityLimit.GetAsInt64(), int64(0))
+		assert.Equal(t, 0.9, Params.QuotaRatio.GetAsFloat())
+	})
+
 	t.Run("test etcdConfig", func(t *testing.T) {
 		Params := &SParams.EtcdCfg
 
@@ -159,6 +165,30 @@ func TestServiceParam(t *testing.T) {
 		t.Logf("rocksmq path = %s", Params.Path.GetValue())
 	})
 
+	t.Run("test natsmqConfig", func(t *testing.T) {
+		Params := &SParams.NatsmqCfg
+
+		assert.Empty(t, Params.Address.GetValue())
+		assert.True(t, SParams.NatsmqEnable())
+	})
+
+	t.Run("test mq tiering", func(t *testing.T) {
+		Params := &SParams.MQCfg
+
+		assert.False(t, Params.TieringEnabled.GetAsBool())
+		assert.Equal(t, "wal", Params.TieringRootPath.GetValue())
+		assert.Equal(t, int64(64), Params.TieringSegmentSize.GetAsInt64())
+		assert.Equal(t, 60*time.Second, Params.TieringFlushInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 168*time.Hour, Params.TieringRetention.GetAsDuration(time.Hour))
+	})
+
+	t.Run("test mq idempotence", func(t *testing.T) {
+		Params := &SParams.MQCfg
+
+		assert.False(t, Params.IdempotenceEnabled.GetAsBool())
+		assert.Equal(t, 3, Params.ProduceRetryAttempts.GetAsInt())
+	})
+
 	t.Run("test kafkaConfig", func(t *testing.T) {
 		// test default value
 		{
@@ -199,6 +229,25 @@ func TestServiceParam(t *testing.T) {
 
 		assert.Equal(t, Params.IAMEndpoint.GetValue(), "")
 
+		assert.False(t, Params.UseNativeClient.GetAsBool())
+		assert.Equal(t, 3, Params.MaxRetries.GetAsInt())
+		assert.Equal(t, int64(32), Params.MultipartThresholdMB.GetAsInt64())
+		assert.Equal(t, int64(8), Params.MultipartPartSizeMB.GetAsInt64())
+
+		assert.Equal(t, 0.0, Params.RequestBudgetRate.GetAsFloat())
+		assert.Equal(t, int64(0), Params.RequestBudgetConcurrency.GetAsInt64())
+		assert.Equal(t, 10, Params.CircuitBreakerThreshold.GetAsInt())
+		assert.Equal(t, 10*time.Second, Params.CircuitBreakerWindow.GetAsDuration(time.Second))
+		assert.Equal(t, 30*time.Second, Params.CircuitBreakerCooldown.GetAsDuration(time.Second))
+		assert.False(t, Params.EncryptionEnabled.GetAsBool())
+		assert.Equal(t, "local", Params.EncryptionKMS.GetValue())
+		assert.Equal(t, "", Params.EncryptionMasterKeyID.GetValue())
+		assert.Equal(t, 0, Params.EncryptionKeyRotationDays.GetAsInt())
+		assert.Equal(t, 600*time.Second, Params.EncryptionKeyRefreshSeconds.GetAsDuration(time.Second))
+		assert.False(t, Params.ReadCacheEnabled.GetAsBool())
+		assert.Equal(t, []string{"/var/lib/milvus/read_cache"}, Params.ReadCacheDirs.GetAsStrings())
+		assert.Equal(t, int64(64), Params.ReadCacheCapacityGB.GetAsInt64())
+
 		t.Logf("Minio BucketName = %s", Params.BucketName.GetValue())
 
 		t.Logf("Minio rootpath = %s", Params.RootPath.GetValue())
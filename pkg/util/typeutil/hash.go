@@ -101,8 +101,28 @@ func HashPK2Channels(primaryKeys *schemapb.IDs, shardNames []string) []uint32 {
 
 // HashKey2Partitions hash partition keys to partitions
 func HashKey2Partitions(keys *schemapb.FieldData, partitionNames []string) ([]uint32, error) {
-	var hashValues []uint32
 	numPartitions := uint32(len(partitionNames))
+	return hashPartitionKeys(keys, func(value uint32) uint32 {
+		return value % numPartitions
+	})
+}
+
+// HashKey2Buckets hash partition keys to the IDs of the buckets in the partitions.
+func HashKey2Buckets(keys *schemapb.FieldData, numPartitions int, numBuckets int64) ([]uint32, error) {
+	return hashPartitionKeys(keys, func(value uint32) uint32 {
+		return HashValue2BucketID(value, numPartitions, numBuckets)
+	})
+}
+
+// HashValue2BucketID returns the ID of the bucket the hash value of partition key belongs to,
+// the keys hashed to the same partition are spread among the buckets by the rest bits of the hash value.
+// The bucket IDs start from 1, 0 is the bucket ID of the segments not bucketed.
+func HashValue2BucketID(value uint32, numPartitions int, numBuckets int64) uint32 {
+	return value/uint32(numPartitions)%uint32(numBuckets) + 1
+}
+
+func hashPartitionKeys(keys *schemapb.FieldData, mapper func(value uint32) uint32) ([]uint32, error) {
+	var hashValues []uint32
 	switch keys.Field.(type) {
 	case *schemapb.FieldData_Scalars:
 		scalarField := keys.GetScalars()
@@ -111,13 +131,13 @@ func HashKey2Partitions(keys *schemapb.FieldData, partitionNames []string) ([]ui
 			longKeys := scalarField.GetLongData().Data
 			for _, key := range longKeys {
 				value, _ := Hash32Int64(key)
-				hashValues = append(hashValues, value%numPartitions)
+				hashValues = append(hashValues, mapper(value))
 			}
 		case *schemapb.ScalarField_StringData:
 			stringKeys := scalarField.GetStringData().Data
 			for _, key := range stringKeys {
 				value := HashString2Uint32(key)
-				hashValues = append(hashValues, value%numPartitions)
+				hashValues = append(hashValues, mapper(value))
 			}
 		default:
 			return nil, errors.New("currently only support DataType Int64 or VarChar as partition key Field")
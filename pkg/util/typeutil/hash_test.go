@@ -167,6 +167,33 @@ func TestHashPK2Channels(t *testing.T) {
 	assert.Equal(t, ret[1], ret[2])
 }
 
+func TestHashKey2Buckets(t *testing.T) {
+	keys := &schemapb.FieldData{Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+		Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3, 4, 5, 6, 7, 8}}},
+	}}}
+	partitions, err := HashKey2Partitions(keys, []string{"p_0", "p_1"})
+	assert.NoError(t, err)
+	buckets, err := HashKey2Buckets(keys, 2, 4)
+	assert.NoError(t, err)
+	assert.Len(t, buckets, 8)
+	for i, key := range keys.GetScalars().GetLongData().GetData() {
+		value, _ := Hash32Int64(key)
+		assert.Equal(t, value%2, partitions[i])
+		assert.Equal(t, value/2%4+1, buckets[i])
+		assert.Equal(t, buckets[i], HashValue2BucketID(value, 2, 4))
+	}
+
+	keys = &schemapb.FieldData{Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+		Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "b"}}},
+	}}}
+	buckets, err = HashKey2Buckets(keys, 3, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{1, 1}, buckets)
+
+	_, err = HashKey2Buckets(&schemapb.FieldData{Field: &schemapb.FieldData_Vectors{}}, 2, 4)
+	assert.Error(t, err)
+}
+
 func TestRearrangePartitionsForPartitionKey(t *testing.T) {
 	// invalid partition name
 	partitions := map[string]int64{
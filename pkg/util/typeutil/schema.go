@@ -1055,6 +1055,41 @@ func GetPartitionKeyFieldSchema(schema *schemapb.CollectionSchema) (*schemapb.Fi
 	return nil, errors.New("partition key field is not found")
 }
 
+// GetLoadFieldIDs returns the IDs of the fields loaded into memory specified by the collection properties,
+// the system fields, the primary key and the partition key are always loaded.
+// Returns nil if not specified, which means all the fields are loaded.
+func GetLoadFieldIDs(schema *schemapb.CollectionSchema) (Set[int64], error) {
+	names, ok := common.GetCollectionLoadFields(schema.GetProperties()...)
+	if !ok {
+		return nil, nil
+	}
+
+	fields := lo.SliceToMap(schema.GetFields(), func(field *schemapb.FieldSchema) (string, *schemapb.FieldSchema) {
+		return field.GetName(), field
+	})
+	ret := NewSet[int64]()
+	for _, name := range names {
+		field, ok := fields[name]
+		if !ok {
+			return nil, fmt.Errorf("load field %s not found in collection %s", name, schema.GetName())
+		}
+		ret.Insert(field.GetFieldID())
+	}
+	hasVector := false
+	for _, field := range schema.GetFields() {
+		if common.IsSystemField(field.GetFieldID()) || field.GetIsPrimaryKey() || field.GetIsPartitionKey() {
+			ret.Insert(field.GetFieldID())
+		}
+		if ret.Contain(field.GetFieldID()) && IsVectorType(field.GetDataType()) {
+			hasVector = true
+		}
+	}
+	if !hasVector {
+		return nil, fmt.Errorf("no vector field to load in collection %s", schema.GetName())
+	}
+	return ret, nil
+}
+
 // GetDynamicField returns the dynamic field if it exists.
 func GetDynamicField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
 	for _, fieldSchema := range schema.GetFields() {
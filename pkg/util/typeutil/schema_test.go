@@ -2073,3 +2073,34 @@ func TestValidateSparseFloatRows(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestGetLoadFieldIDs(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: "title", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "body", DataType: schemapb.DataType_VarChar},
+		},
+	}
+
+	fields, err := GetLoadFieldIDs(schema)
+	assert.NoError(t, err)
+	assert.Nil(t, fields)
+
+	schema.Properties = []*commonpb.KeyValuePair{{Key: common.LoadFieldsKey, Value: "vec,title"}}
+	fields, err = GetLoadFieldIDs(schema)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{common.RowIDField, common.TimeStampField, 100, 101, 102}, fields.Collect())
+
+	schema.Properties = []*commonpb.KeyValuePair{{Key: common.LoadFieldsKey, Value: "title"}}
+	_, err = GetLoadFieldIDs(schema)
+	assert.Error(t, err)
+
+	schema.Properties = []*commonpb.KeyValuePair{{Key: common.LoadFieldsKey, Value: "vec,unknown"}}
+	_, err = GetLoadFieldIDs(schema)
+	assert.Error(t, err)
+}